@@ -0,0 +1,170 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roles
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// localStorageManager manages the local data directories of standalone milvus.
+// It creates the directories on start, reports their disk usage periodically,
+// and defragments the embedded etcd when its db has too much free space.
+type localStorageManager struct {
+	// storage type label -> directory
+	dirs map[string]string
+
+	reportInterval      time.Duration
+	maintenanceInterval time.Duration
+	embedEtcd           bool
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func newLocalStorageManager(params *paramtable.ComponentParam) *localStorageManager {
+	dirs := map[string]string{
+		metrics.LocalStorageDataLabel: params.LocalStorageCfg.Path.GetValue(),
+	}
+	if params.EtcdCfg.UseEmbedEtcd.GetAsBool() {
+		dirs[metrics.LocalStorageEtcdLabel] = params.EtcdCfg.DataDir.GetValue()
+	}
+	if params.RocksmqEnable() {
+		dirs[metrics.LocalStorageMQLabel] = params.RocksmqCfg.Path.GetValue()
+	} else if params.NatsmqEnable() {
+		dirs[metrics.LocalStorageMQLabel] = params.NatsmqCfg.ServerStoreDir.GetValue()
+	}
+	return &localStorageManager{
+		dirs:                dirs,
+		reportInterval:      params.LocalStorageCfg.DiskUsageReportInterval.GetAsDuration(time.Second),
+		maintenanceInterval: params.EtcdCfg.EmbedMaintenanceInterval.GetAsDuration(time.Second),
+		embedEtcd:           params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		closeCh:             make(chan struct{}),
+	}
+}
+
+// Prepare creates the local data directories if not exist.
+func (m *localStorageManager) Prepare() error {
+	for storageType, dir := range m.dirs {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			log.Warn("failed to create local data dir", zap.String("type", storageType), zap.String("dir", dir), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Start starts the background disk usage reporting and embedded etcd maintenance.
+func (m *localStorageManager) Start() {
+	if m.reportInterval > 0 {
+		m.wg.Add(1)
+		go m.loop(m.reportInterval, m.reportDiskUsage)
+	}
+	if m.embedEtcd && m.maintenanceInterval > 0 {
+		m.wg.Add(1)
+		go m.loop(m.maintenanceInterval, m.maintainEmbedEtcd)
+	}
+}
+
+// Stop stops the background routines, it shall be called before the embedded etcd is stopped.
+func (m *localStorageManager) Stop() {
+	m.closeOnce.Do(func() {
+		close(m.closeCh)
+		m.wg.Wait()
+	})
+}
+
+func (m *localStorageManager) loop(interval time.Duration, fn func()) {
+	defer m.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.closeCh:
+			return
+		case <-ticker.C:
+			fn()
+		}
+	}
+}
+
+func (m *localStorageManager) reportDiskUsage() {
+	for storageType, dir := range m.dirs {
+		size, err := dirSize(dir)
+		if err != nil {
+			log.RatedWarn(60, "failed to get disk usage of local data dir", zap.String("type", storageType), zap.String("dir", dir), zap.Error(err))
+			continue
+		}
+		metrics.LocalStorageDiskUsage.WithLabelValues(storageType, dir).Set(float64(size))
+	}
+}
+
+func (m *localStorageManager) maintainEmbedEtcd() {
+	size, inUse, err := etcd.GetEmbedEtcdDBSize()
+	if err != nil {
+		log.Warn("failed to get embedded etcd db size", zap.Error(err))
+		return
+	}
+	metrics.EmbedEtcdDBSize.WithLabelValues(metrics.EmbedEtcdDBSizeLabel).Set(float64(size))
+	metrics.EmbedEtcdDBSize.WithLabelValues(metrics.EmbedEtcdDBSizeInUseLabel).Set(float64(inUse))
+
+	threshold := paramtable.Get().EtcdCfg.EmbedDefragThreshold.GetAsFloat()
+	if threshold <= 0 || threshold > 1 {
+		return
+	}
+	if _, err := etcd.DefragEmbedEtcd(threshold); err != nil {
+		log.Warn("failed to defragment embedded etcd", zap.Error(err))
+	}
+}
+
+// dirSize returns the total size of regular files under the dir.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// files may be removed during walking
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
@@ -345,6 +345,8 @@ func (mr *MilvusRoles) Run() {
 		}
 
 		params := paramtable.Get()
+		// deferred functions run in reverse order, the shutdown order of local dependencies is:
+		// local storage manager -> embedded etcd -> mq, after all components and the etcd client are closed.
 		if paramtable.Get().RocksmqEnable() {
 			defer stopRocksmq()
 		} else if paramtable.Get().NatsmqEnable() {
@@ -352,16 +354,26 @@ func (mr *MilvusRoles) Run() {
 		} else {
 			panic("only support Rocksmq and Natsmq in standalone mode")
 		}
+		storageManager := newLocalStorageManager(params)
+		if err := storageManager.Prepare(); err != nil {
+			panic(err)
+		}
 		if params.EtcdCfg.UseEmbedEtcd.GetAsBool() {
 			// Start etcd server.
-			etcd.InitEtcdServer(
+			err := etcd.InitEtcdServer(
 				params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
 				params.EtcdCfg.ConfigPath.GetValue(),
 				params.EtcdCfg.DataDir.GetValue(),
 				params.EtcdCfg.EtcdLogPath.GetValue(),
-				params.EtcdCfg.EtcdLogLevel.GetValue())
+				params.EtcdCfg.EtcdLogLevel.GetValue(),
+				etcd.WithAutoCompactionRetention(params.EtcdCfg.EmbedAutoCompactionRetention.GetValue()))
+			if err != nil {
+				panic(err)
+			}
 			defer etcd.StopEtcdServer()
 		}
+		storageManager.Start()
+		defer storageManager.Stop()
 		paramtable.SetRole(typeutil.StandaloneRole)
 	} else {
 		if err := os.Setenv(metricsinfo.DeployModeEnvKey, metricsinfo.ClusterDeployMode); err != nil {
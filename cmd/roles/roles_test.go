@@ -21,9 +21,12 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -94,3 +97,35 @@ func TestCleanLocalDir(t *testing.T) {
 		cleanLocalDir(localPath)
 	})
 }
+
+func TestLocalStorageManager(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	dataDir := filepath.Join(t.TempDir(), "data")
+	params.Save(params.LocalStorageCfg.Path.Key, dataDir)
+	defer params.Reset(params.LocalStorageCfg.Path.Key)
+
+	m := newLocalStorageManager(params)
+	assert.NoError(t, m.Prepare())
+	_, err := os.Stat(dataDir)
+	assert.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(dataDir, "child"), []byte("12345"), 0o600)
+	assert.NoError(t, err)
+	err = os.MkdirAll(filepath.Join(dataDir, "sub"), os.ModePerm)
+	assert.NoError(t, err)
+	err = os.WriteFile(filepath.Join(dataDir, "sub", "child"), []byte("123"), 0o600)
+	assert.NoError(t, err)
+	size, err := dirSize(dataDir)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8), size)
+
+	m.reportInterval = 10 * time.Millisecond
+	m.embedEtcd = false
+	m.Start()
+	assert.Eventually(t, func() bool {
+		return testutil.ToFloat64(metrics.LocalStorageDiskUsage.WithLabelValues(metrics.LocalStorageDataLabel, dataDir)) == 8
+	}, time.Second, 10*time.Millisecond)
+	m.Stop()
+	m.Stop()
+}
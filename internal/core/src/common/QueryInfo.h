@@ -34,6 +34,8 @@ struct SearchInfo {
     std::optional<FieldId> group_by_field_id_;
     tracer::TraceContext trace_ctx_;
     bool materialized_view_involved = false;
+    // search by brute force on the raw data instead of the indexes
+    bool force_brute_force = false;
 };
 
 using SearchInfoPtr = std::shared_ptr<SearchInfo>;
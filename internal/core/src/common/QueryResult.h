@@ -29,6 +29,7 @@
 
 #include "common/FieldMeta.h"
 #include "pb/schema.pb.h"
+#include "knowhere/comp/index_param.h"
 #include "knowhere/index/index_node.h"
 
 namespace milvus {
@@ -144,6 +145,40 @@ struct VectorIterator {
     //we may need to add mutex to protect the variable sealed
 };
 
+// stats of the vector search of a single search result, bridged to the
+// querynode metrics per collection.
+struct SearchStats {
+    int64_t index_search_nq = 0;
+    // the nq searched on the indexes of the quantization codes
+    int64_t quantized_search_nq = 0;
+    // the nq searched on the graph indexes
+    int64_t graph_search_nq = 0;
+    int64_t brute_force_search_nq = 0;
+    // estimated by nq * rows searched, the distance computations inside
+    // the index are not exposed by knowhere
+    int64_t brute_force_distance_computations = 0;
+
+    void
+    RecordIndexSearch(const std::string& index_type, int64_t nq) {
+        index_search_nq += nq;
+        if (index_type == knowhere::IndexEnum::INDEX_FAISS_IVFPQ ||
+            index_type == knowhere::IndexEnum::INDEX_FAISS_IVFSQ8 ||
+            index_type == knowhere::IndexEnum::INDEX_DISKANN) {
+            quantized_search_nq += nq;
+        }
+        if (index_type == knowhere::IndexEnum::INDEX_HNSW ||
+            index_type == knowhere::IndexEnum::INDEX_DISKANN) {
+            graph_search_nq += nq;
+        }
+    }
+
+    void
+    RecordBruteForceSearch(int64_t nq, int64_t row_count) {
+        brute_force_search_nq += nq;
+        brute_force_distance_computations += nq * row_count;
+    }
+};
+
 struct SearchResult {
     SearchResult() = default;
 
@@ -218,6 +253,9 @@ struct SearchResult {
     //Vector iterators, used for group by
     std::optional<std::vector<std::shared_ptr<VectorIterator>>>
         vector_iterators_;
+
+    // filled during the vector search
+    SearchStats search_stats_;
 };
 
 using SearchResultPtr = std::shared_ptr<SearchResult>;
@@ -65,6 +65,9 @@ constexpr const char* DISK_ANN_RAW_DATA_PATH = "data_path";
 // VecIndex node filtering
 constexpr const char* VEC_OPT_FIELDS_PATH = "opt_fields_path";
 
+// VecIndex build over the raw data mmaped from the local file
+constexpr const char* BUILD_WITH_MMAP = "build_with_mmap";
+
 // DiskAnn build params
 constexpr const char* DISK_ANN_MAX_DEGREE = "max_degree";
 constexpr const char* DISK_ANN_SEARCH_LIST_SIZE = "search_list_size";
@@ -16,6 +16,7 @@
 
 #include "index/VectorMemIndex.h"
 
+#include <sys/mman.h>
 #include <unistd.h>
 #include <cmath>
 #include <cstring>
@@ -46,6 +47,7 @@
 #include "common/Utils.h"
 #include "log/Log.h"
 #include "storage/DataCodec.h"
+#include "storage/LocalChunkManagerSingleton.h"
 #include "storage/MemFileManagerImpl.h"
 #include "storage/ThreadPools.h"
 #include "storage/space.h"
@@ -501,13 +503,20 @@ VectorMemIndex<T>::Build(const Config& config) {
         GetValueFromConfig<std::vector<std::string>>(config, "insert_files");
     AssertInfo(insert_files.has_value(),
                "insert file paths is empty when building in memory index");
-    auto field_datas =
-        file_manager_->CacheRawDataToMemory(insert_files.value());
 
     Config build_config;
     build_config.update(config);
     build_config.erase("insert_files");
     build_config.erase(VEC_OPT_FIELDS);
+    build_config.erase(BUILD_WITH_MMAP);
+    if (!IndexIsSparse(GetIndexType()) &&
+        GetValueFromConfig<bool>(config, BUILD_WITH_MMAP).value_or(false)) {
+        BuildWithMmap(insert_files.value(), build_config);
+        return;
+    }
+
+    auto field_datas =
+        file_manager_->CacheRawDataToMemory(insert_files.value());
     if (!IndexIsSparse(GetIndexType())) {
         int64_t total_size = 0;
         int64_t total_num_rows = 0;
@@ -565,6 +574,58 @@ VectorMemIndex<T>::Build(const Config& config) {
     }
 }
 
+template <typename T>
+void
+VectorMemIndex<T>::BuildWithMmap(const std::vector<std::string>& insert_files,
+                                 const Config& build_config) {
+    // the raw data is decoded into the local file and mmaped as the dataset,
+    // the pages are backed by the file and reclaimable, which cuts the peak
+    // memory of holding both the decoded field datas and the copy of them
+    std::string local_data_path;
+    int64_t total_num_rows = 0;
+    int64_t dim = 0;
+    std::tie(local_data_path, total_num_rows, dim) =
+        file_manager_->CacheRawDataToMmapFile(insert_files);
+    auto local_chunk_manager =
+        storage::LocalChunkManagerSingleton::GetInstance().GetChunkManager();
+    auto total_size = local_chunk_manager->Size(local_data_path);
+
+    void* buf = nullptr;
+    auto release = [&]() {
+        if (buf != nullptr && buf != MAP_FAILED) {
+            munmap(buf, total_size);
+        }
+        local_chunk_manager->Remove(local_data_path);
+    };
+    try {
+        if (total_size > 0) {
+            auto file = File::Open(local_data_path, O_RDONLY);
+            buf = mmap(nullptr,
+                       total_size,
+                       PROT_READ,
+                       MAP_SHARED,
+                       file.Descriptor(),
+                       0);
+            AssertInfo(buf != MAP_FAILED,
+                       "failed to mmap raw data file {}: {}",
+                       local_data_path,
+                       strerror(errno));
+        }
+        LOG_INFO(
+            "build vector index over mmaped raw data, path:{}, rows:{}, "
+            "size:{}",
+            local_data_path,
+            total_num_rows,
+            total_size);
+        auto dataset = GenDataset(total_num_rows, dim, buf);
+        BuildWithDataset(dataset, build_config);
+    } catch (...) {
+        release();
+        throw;
+    }
+    release();
+}
+
 template <typename T>
 void
 VectorMemIndex<T>::AddWithDataset(const DatasetPtr& dataset,
@@ -110,6 +110,10 @@ class VectorMemIndex : public VectorIndex {
     void
     LoadFromFileV2(const Config& config);
 
+    void
+    BuildWithMmap(const std::vector<std::string>& insert_files,
+                  const Config& build_config);
+
  protected:
     Config config_;
     knowhere::Index<knowhere::IndexNode> index_;
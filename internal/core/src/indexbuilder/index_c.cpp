@@ -145,6 +145,9 @@ get_config(std::unique_ptr<milvus::proto::indexcgo::BuildIndexInfo>& info) {
     if (info->partition_key_isolation()) {
         config["partition_key_isolation"] = info->partition_key_isolation();
     }
+    if (info->build_with_mmap()) {
+        config[milvus::index::BUILD_WITH_MMAP] = info->build_with_mmap();
+    }
 
     return config;
 }
@@ -205,6 +205,7 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
         nlohmann::json::parse(query_info_proto.search_params());
     search_info.materialized_view_involved =
         query_info_proto.materialized_view_involved();
+    search_info.force_brute_force = query_info_proto.force_brute_force();
 
     if (query_info_proto.group_by_field_id() > 0) {
         auto group_by_field_id = FieldId(query_info_proto.group_by_field_id());
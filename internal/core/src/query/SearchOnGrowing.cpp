@@ -90,7 +90,8 @@ SearchOnGrowing(const segcore::SegmentGrowingImpl& segment,
     auto round_decimal = info.round_decimal_;
 
     // step 2: small indexing search
-    if (segment.get_indexing_record().SyncDataWithIndex(field.get_id())) {
+    if (segment.get_indexing_record().SyncDataWithIndex(field.get_id()) &&
+        !info.force_brute_force) {
         FloatSegmentIndexSearch(
             segment, info, query_data, num_queries, bitset, search_result);
     } else {
@@ -10,10 +10,12 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <cmath>
+#include <numeric>
 #include <string>
 
 #include "common/QueryInfo.h"
 #include "common/Types.h"
+#include "common/Utils.h"
 #include "query/SearchBruteForce.h"
 #include "query/SearchOnSealed.h"
 #include "query/helper.h"
@@ -111,4 +113,69 @@ SearchOnSealed(const Schema& schema,
     result.total_nq_ = dataset.num_queries;
 }
 
+void
+SearchOnSealedIndexRawData(const Schema& schema,
+                           const segcore::SealedIndexingRecord& record,
+                           const SearchInfo& search_info,
+                           const void* query_data,
+                           int64_t num_queries,
+                           int64_t row_count,
+                           const BitsetView& bitset,
+                           SearchResult& result) {
+    // the raw data is fetched from the index batch by batch to bound the memory
+    constexpr int64_t batch_rows = 64 * 1024;
+
+    auto field_id = search_info.field_id_;
+    auto& field = schema[field_id];
+    auto data_type = field.get_data_type();
+    AssertInfo(data_type != DataType::VECTOR_SPARSE_FLOAT,
+               "[SearchOnSealedIndexRawData]sparse vector is not supported");
+    AssertInfo(!search_info.group_by_field_id_.has_value(),
+               "[SearchOnSealedIndexRawData]group by is not supported");
+
+    AssertInfo(record.is_ready(field_id),
+               "[SearchOnSealedIndexRawData]Record isn't ready");
+    auto field_indexing = record.get_field_indexing(field_id);
+    auto vec_index =
+        dynamic_cast<index::VectorIndex*>(field_indexing->indexing_.get());
+    AssertInfo(vec_index != nullptr && vec_index->HasRawData(),
+               "[SearchOnSealedIndexRawData]index has no raw data");
+
+    auto topk = search_info.topk_;
+    query::dataset::SearchDataset dataset{search_info.metric_type_,
+                                          num_queries,
+                                          topk,
+                                          search_info.round_decimal_,
+                                          field.get_dim(),
+                                          query_data};
+    CheckBruteForceSearchParam(field, search_info);
+
+    SubSearchResult final_qr(num_queries,
+                             topk,
+                             search_info.metric_type_,
+                             search_info.round_decimal_);
+    std::vector<int64_t> ids;
+    for (int64_t begin = 0; begin < row_count; begin += batch_rows) {
+        auto rows = std::min(batch_rows, row_count - begin);
+        ids.resize(rows);
+        std::iota(ids.begin(), ids.end(), begin);
+        auto vectors = vec_index->GetVector(GenIdsDataset(rows, ids.data()));
+
+        auto sub_view = bitset.subview(begin, rows);
+        auto sub_qr = BruteForceSearch(
+            dataset, vectors.data(), rows, search_info, sub_view, data_type);
+        // convert the offsets in the batch to the offsets in the segment
+        for (auto& x : sub_qr.mutable_seg_offsets()) {
+            if (x != -1) {
+                x += begin;
+            }
+        }
+        final_qr.merge(sub_qr);
+    }
+    result.distances_ = std::move(final_qr.mutable_distances());
+    result.seg_offsets_ = std::move(final_qr.mutable_seg_offsets());
+    result.unity_topK_ = topk;
+    result.total_nq_ = num_queries;
+}
+
 }  // namespace milvus::query
@@ -37,4 +37,16 @@ SearchOnSealed(const Schema& schema,
                const BitsetView& bitset,
                SearchResult& result);
 
+// SearchOnSealedIndexRawData searches by brute force on the raw data kept in
+// the index, the index must have the raw data of the dense vectors.
+void
+SearchOnSealedIndexRawData(const Schema& schema,
+                           const segcore::SealedIndexingRecord& record,
+                           const SearchInfo& search_info,
+                           const void* query_data,
+                           int64_t num_queries,
+                           int64_t row_count,
+                           const BitsetView& bitset,
+                           SearchResult& result);
+
 }  // namespace milvus::query
@@ -461,6 +461,17 @@ SegmentGrowingImpl::vector_search(SearchInfo& search_info,
                                   SearchResult& output) const {
     query::SearchOnGrowing(
         *this, search_info, query_data, query_count, timestamp, bitset, output);
+    if (indexing_record_.SyncDataWithIndex(search_info.field_id_) &&
+        !search_info.force_brute_force) {
+        // the interim index of the growing segment is neither quantized nor
+        // a graph index
+        output.search_stats_.index_search_nq += query_count;
+    } else {
+        auto active_count = std::min(int64_t(bitset.size()),
+                                     get_active_count(timestamp));
+        output.search_stats_.RecordBruteForceSearch(query_count,
+                                                    active_count);
+    }
 }
 
 std::unique_ptr<DataArray>
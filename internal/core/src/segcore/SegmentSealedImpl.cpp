@@ -796,7 +796,34 @@ SegmentSealedImpl::vector_search(SearchInfo& search_info,
 
     AssertInfo(field_meta.is_vector(),
                "The meta type of vector field is not vector type");
-    if (get_bit(binlog_index_bitset_, field_id)) {
+    // the brute force search is done on the loaded raw data, or the raw data
+    // kept in the index, and falls back to the index search if neither exists
+    auto brute_force = search_info.force_brute_force &&
+                       get_bit(field_data_ready_bitset_, field_id);
+    if (search_info.force_brute_force && !brute_force) {
+        if (index_has_raw_data(field_id, search_info)) {
+            AssertInfo(num_rows_.has_value(), "Can't get row count value");
+            auto row_count = num_rows_.value();
+            query::SearchOnSealedIndexRawData(*schema_,
+                                              vector_indexings_,
+                                              search_info,
+                                              query_data,
+                                              query_count,
+                                              row_count,
+                                              bitset,
+                                              output);
+            output.search_stats_.RecordBruteForceSearch(query_count,
+                                                        row_count);
+            milvus::tracer::AddEvent("finish_searching_vector_index_raw_data");
+            return;
+        }
+        LOG_WARN(
+            "segment {} has no raw data of field {} to search by brute "
+            "force, search by the index instead",
+            id_,
+            field_id.get());
+    }
+    if (!brute_force && get_bit(binlog_index_bitset_, field_id)) {
         AssertInfo(
             vec_binlog_config_.find(field_id) != vec_binlog_config_.end(),
             "The binlog params is not generate.");
@@ -813,9 +840,12 @@ SegmentSealedImpl::vector_search(SearchInfo& search_info,
                                    query_count,
                                    bitset,
                                    output);
+        output.search_stats_.RecordIndexSearch(
+            vector_indexings_.get_field_indexing(field_id)->indexing_->Type(),
+            query_count);
         milvus::tracer::AddEvent(
             "finish_searching_vector_temperate_binlog_index");
-    } else if (get_bit(index_ready_bitset_, field_id)) {
+    } else if (!brute_force && get_bit(index_ready_bitset_, field_id)) {
         AssertInfo(vector_indexings_.is_ready(field_id),
                    "vector indexes isn't ready for field " +
                        std::to_string(field_id.get()));
@@ -826,6 +856,9 @@ SegmentSealedImpl::vector_search(SearchInfo& search_info,
                                    query_count,
                                    bitset,
                                    output);
+        output.search_stats_.RecordIndexSearch(
+            vector_indexings_.get_field_indexing(field_id)->indexing_->Type(),
+            query_count);
         milvus::tracer::AddEvent("finish_searching_vector_index");
     } else {
         AssertInfo(
@@ -842,10 +875,32 @@ SegmentSealedImpl::vector_search(SearchInfo& search_info,
                               row_count,
                               bitset,
                               output);
+        output.search_stats_.RecordBruteForceSearch(query_count, row_count);
         milvus::tracer::AddEvent("finish_searching_vector_data");
     }
 }
 
+bool
+SegmentSealedImpl::index_has_raw_data(FieldId field_id,
+                                      const SearchInfo& search_info) const {
+    auto& field_meta = schema_->operator[](field_id);
+    if (field_meta.get_data_type() == DataType::VECTOR_SPARSE_FLOAT ||
+        search_info.group_by_field_id_.has_value()) {
+        return false;
+    }
+    if (!get_bit(index_ready_bitset_, field_id) &&
+        !get_bit(binlog_index_bitset_, field_id)) {
+        return false;
+    }
+    if (!vector_indexings_.is_ready(field_id)) {
+        return false;
+    }
+    auto field_indexing = vector_indexings_.get_field_indexing(field_id);
+    auto vec_index =
+        dynamic_cast<index::VectorIndex*>(field_indexing->indexing_.get());
+    return vec_index != nullptr && vec_index->HasRawData();
+}
+
 std::tuple<std::string, int64_t>
 SegmentSealedImpl::GetFieldDataPath(FieldId field_id, int64_t offset) const {
     auto offset_in_binlog = offset;
@@ -271,6 +271,10 @@ class SegmentSealedImpl : public SegmentSealed {
                   const BitsetView& bitset,
                   SearchResult& output) const override;
 
+    // whether the index of the field keeps the raw data to search by brute force
+    bool
+    index_has_raw_data(FieldId field_id, const SearchInfo& search_info) const;
+
     void
     mask_with_delete(BitsetType& bitset,
                      int64_t ins_barrier,
@@ -84,6 +84,20 @@ DeleteSearchResult(CSearchResult search_result) {
     delete res;
 }
 
+CSearchStats
+GetSearchStats(CSearchResult search_result) {
+    auto res = static_cast<milvus::SearchResult*>(search_result);
+    auto& stats = res->search_stats_;
+    CSearchStats c_stats{};
+    c_stats.index_search_nq = stats.index_search_nq;
+    c_stats.quantized_search_nq = stats.quantized_search_nq;
+    c_stats.graph_search_nq = stats.graph_search_nq;
+    c_stats.brute_force_search_nq = stats.brute_force_search_nq;
+    c_stats.brute_force_distance_computations =
+        stats.brute_force_distance_computations;
+    return c_stats;
+}
+
 CFuture*  // Future<milvus::SearchResult*>
 AsyncSearch(CTraceContext c_trace,
             CSegmentInterface c_segment,
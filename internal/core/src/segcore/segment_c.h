@@ -28,6 +28,14 @@ extern "C" {
 typedef void* CSearchResult;
 typedef CProto CRetrieveResult;
 
+typedef struct CSearchStats {
+    int64_t index_search_nq;
+    int64_t quantized_search_nq;
+    int64_t graph_search_nq;
+    int64_t brute_force_search_nq;
+    int64_t brute_force_distance_computations;
+} CSearchStats;
+
 //////////////////////////////    common interfaces    //////////////////////////////
 CStatus
 NewSegment(CCollection collection,
@@ -44,6 +52,9 @@ ClearSegmentData(CSegmentInterface c_segment);
 void
 DeleteSearchResult(CSearchResult search_result);
 
+CSearchStats
+GetSearchStats(CSearchResult search_result);
+
 CFuture*  // Future<CSearchResultBody>
 AsyncSearch(CTraceContext c_trace,
             CSegmentInterface c_segment,
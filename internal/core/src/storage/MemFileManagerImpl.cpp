@@ -21,6 +21,7 @@
 #include "common/Common.h"
 #include "common/FieldData.h"
 #include "log/Log.h"
+#include "storage/LocalChunkManagerSingleton.h"
 #include "storage/Util.h"
 #include "storage/FileManager.h"
 
@@ -213,6 +214,65 @@ MemFileManagerImpl::CacheRawDataToMemory(
     return field_datas;
 }
 
+std::tuple<std::string, int64_t, int64_t>
+MemFileManagerImpl::CacheRawDataToMmapFile(
+    std::vector<std::string> remote_files) {
+    std::sort(remote_files.begin(),
+              remote_files.end(),
+              [](const std::string& a, const std::string& b) {
+                  return std::stol(a.substr(a.find_last_of("/") + 1)) <
+                         std::stol(b.substr(b.find_last_of("/") + 1));
+              });
+
+    auto local_chunk_manager =
+        LocalChunkManagerSingleton::GetInstance().GetChunkManager();
+    auto local_data_path =
+        storage::GenFieldRawDataPathPrefix(local_chunk_manager,
+                                           field_meta_.segment_id,
+                                           field_meta_.field_id) +
+        "raw_data_" + std::to_string(index_meta_.build_id) + ".mmap";
+    local_chunk_manager->CreateFile(local_data_path);
+
+    // file format: index_data ([]uint8_t), without header to be mmaped as
+    // the dataset directly
+    int64_t num_rows = 0;
+    int64_t dim = 0;
+    int64_t write_offset = 0;
+
+    auto parallel_degree =
+        uint64_t(DEFAULT_FIELD_MAX_MEMORY_LIMIT / FILE_SLICE_SIZE);
+    std::vector<std::string> batch_files;
+
+    auto FetchRawData = [&]() {
+        auto raw_datas = GetObjectData(rcm_.get(), batch_files);
+        for (auto& raw_data : raw_datas) {
+            auto field_data = raw_data.get()->GetFieldData();
+            AssertInfo(dim == 0 || dim == field_data->get_dim(),
+                       "inconsistent dim value in multi binlogs!");
+            dim = field_data->get_dim();
+            num_rows += field_data->get_num_rows();
+            local_chunk_manager->Write(local_data_path,
+                                       write_offset,
+                                       const_cast<void*>(field_data->Data()),
+                                       field_data->Size());
+            write_offset += field_data->Size();
+        }
+    };
+
+    for (auto& file : remote_files) {
+        if (batch_files.size() >= parallel_degree) {
+            FetchRawData();
+            batch_files.clear();
+        }
+        batch_files.emplace_back(file);
+    }
+    if (batch_files.size() > 0) {
+        FetchRawData();
+    }
+
+    return {local_data_path, num_rows, dim};
+}
+
 std::optional<bool>
 MemFileManagerImpl::IsExisted(const std::string& filename) noexcept {
     // TODO: implement this interface
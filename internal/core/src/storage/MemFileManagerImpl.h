@@ -19,6 +19,7 @@
 #include <cstdint>
 #include <map>
 #include <string>
+#include <tuple>
 #include <vector>
 #include <memory>
 
@@ -60,6 +61,13 @@ class MemFileManagerImpl : public FileManagerImpl {
     std::vector<FieldDataPtr>
     CacheRawDataToMemory(std::vector<std::string> remote_files);
 
+    // decode the raw data of the dense vectors into the local file batch by
+    // batch, the index is built over the mmaped file without holding all the
+    // raw data in memory, returns the path of the file, the number of the rows
+    // and the dim
+    std::tuple<std::string, int64_t, int64_t>
+    CacheRawDataToMmapFile(std::vector<std::string> remote_files);
+
     bool
     AddFile(const BinarySet& binary_set);
 
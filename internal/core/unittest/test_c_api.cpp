@@ -1320,6 +1320,15 @@ TEST(CApiTest, SearchTest) {
         CSearch(segment, plan, placeholderGroup, ts_offset, &search_result2);
     ASSERT_EQ(res2.error_code, Success);
 
+    // the stats are collected per search result, the growing segment
+    // without index is searched by brute force
+    for (auto result : {search_result, search_result2}) {
+        auto stats = GetSearchStats(result);
+        EXPECT_EQ(stats.index_search_nq, 0);
+        EXPECT_EQ(stats.brute_force_search_nq, num_queries);
+        EXPECT_GT(stats.brute_force_distance_computations, 0);
+    }
+
     DeleteSearchPlan(plan);
     DeletePlaceholderGroup(placeholderGroup);
     DeleteSearchResult(search_result);
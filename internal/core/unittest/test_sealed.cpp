@@ -94,6 +94,10 @@ TEST(Sealed, without_predicate) {
 
     auto sr = segment->Search(plan.get(), ph_group.get(), timestamp);
     auto pre_result = SearchResultToJson(*sr);
+    EXPECT_EQ(sr->search_stats_.index_search_nq, 0);
+    EXPECT_EQ(sr->search_stats_.brute_force_search_nq, num_queries);
+    EXPECT_EQ(sr->search_stats_.brute_force_distance_computations,
+              num_queries * N);
     milvus::index::CreateIndexInfo create_index_info;
     create_index_info.field_type = DataType::VECTOR_FLOAT;
     create_index_info.metric_type = knowhere::metric::L2;
@@ -138,6 +142,10 @@ TEST(Sealed, without_predicate) {
     sealed_segment->LoadIndex(load_info);
 
     sr = sealed_segment->Search(plan.get(), ph_group.get(), timestamp);
+    EXPECT_EQ(sr->search_stats_.index_search_nq, num_queries);
+    EXPECT_EQ(sr->search_stats_.quantized_search_nq, 0);
+    EXPECT_EQ(sr->search_stats_.graph_search_nq, 0);
+    EXPECT_EQ(sr->search_stats_.brute_force_search_nq, 0);
 
     auto post_result = SearchResultToJson(*sr);
     std::cout << "ref_result" << std::endl;
@@ -150,6 +158,20 @@ TEST(Sealed, without_predicate) {
     EXPECT_EQ(sr->get_total_result_count(), 0);
 }
 
+TEST(Sealed, SearchStats) {
+    SearchStats stats;
+    stats.RecordIndexSearch(knowhere::IndexEnum::INDEX_FAISS_IVFFLAT, 1);
+    stats.RecordIndexSearch(knowhere::IndexEnum::INDEX_FAISS_IVFPQ, 2);
+    stats.RecordIndexSearch(knowhere::IndexEnum::INDEX_HNSW, 3);
+    stats.RecordIndexSearch(knowhere::IndexEnum::INDEX_DISKANN, 4);
+    stats.RecordBruteForceSearch(5, 100);
+    EXPECT_EQ(stats.index_search_nq, 10);
+    EXPECT_EQ(stats.quantized_search_nq, 6);
+    EXPECT_EQ(stats.graph_search_nq, 7);
+    EXPECT_EQ(stats.brute_force_search_nq, 5);
+    EXPECT_EQ(stats.brute_force_distance_computations, 500);
+}
+
 TEST(Sealed, with_predicate) {
     auto schema = std::make_shared<Schema>();
     auto dim = 16;
@@ -18,7 +18,11 @@ package datacoord
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"sync"
 
 	"go.uber.org/zap"
@@ -27,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 )
 
@@ -90,6 +95,10 @@ func (m *analyzeMeta) AddAnalyzeTask(task *indexpb.AnalyzeTask) error {
 	m.Lock()
 	defer m.Unlock()
 
+	if task.GetSegmentsHash() == "" {
+		task.SegmentsHash = analyzeSegmentsHash(task.GetSegmentIDs())
+	}
+
 	log.Info("add analyze task", zap.Int64("taskID", task.TaskID),
 		zap.Int64("collectionID", task.CollectionID), zap.Int64("partitionID", task.PartitionID))
 	return m.saveTask(task)
@@ -110,7 +119,9 @@ func (m *analyzeMeta) DropAnalyzeTask(taskID int64) error {
 	return nil
 }
 
-func (m *analyzeMeta) UpdateVersion(taskID int64) error {
+// UpdateVersion bumps the version of the task as the epoch of a new assignment,
+// and checkpoints the node the task is assigned to.
+func (m *analyzeMeta) UpdateVersion(taskID, nodeID int64) error {
 	m.Lock()
 	defer m.Unlock()
 
@@ -121,7 +132,9 @@ func (m *analyzeMeta) UpdateVersion(taskID int64) error {
 
 	cloneT := proto.Clone(t).(*indexpb.AnalyzeTask)
 	cloneT.Version++
-	log.Info("update task version", zap.Int64("taskID", taskID), zap.Int64("newVersion", cloneT.Version))
+	cloneT.NodeID = nodeID
+	log.Info("update task version", zap.Int64("taskID", taskID), zap.Int64("newVersion", cloneT.Version),
+		zap.Int64("nodeID", nodeID))
 	return m.saveTask(cloneT)
 }
 
@@ -161,6 +174,79 @@ func (m *analyzeMeta) FinishTask(taskID int64, result *indexpb.AnalyzeResult) er
 	return m.saveTask(cloneT)
 }
 
+// UpdateTaskTiming records the timing breakdown of the current attempt of the task in memory,
+// it's persisted along with the state of the task.
+func (m *analyzeMeta) UpdateTaskTiming(taskID int64, timing *indexpb.TaskTiming) {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[taskID]
+	if !ok {
+		return
+	}
+	cloneT := proto.Clone(t).(*indexpb.AnalyzeTask)
+	cloneT.Timing = timing
+	m.tasks[taskID] = cloneT
+}
+
+// CancelTask marks the unfinished task as failed with the reason of cancellation,
+// the failed task is not retried and the clustering compaction waiting for it fails.
+func (m *analyzeMeta) CancelTask(taskID int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[taskID]
+	if !ok {
+		return merr.WrapErrAnalyzeTaskNotFound(taskID)
+	}
+	if t.GetState() == indexpb.JobState_JobStateFinished || t.GetState() == indexpb.JobState_JobStateFailed {
+		return merr.WrapErrParameterInvalidMsg("analyze task %d is already %s", taskID, t.GetState().String())
+	}
+
+	cloneT := proto.Clone(t).(*indexpb.AnalyzeTask)
+	cloneT.State = indexpb.JobState_JobStateFailed
+	cloneT.FailReason = "analyze task is cancelled"
+	log.Info("cancel analyze task", zap.Int64("taskID", taskID), zap.Int64("nodeID", t.GetNodeID()))
+	return m.saveTask(cloneT)
+}
+
+// GetTasksBySegmentsHash returns the tasks analyzing the field of the same segments.
+func (m *analyzeMeta) GetTasksBySegmentsHash(collectionID, fieldID int64, segmentsHash string) []*indexpb.AnalyzeTask {
+	m.RLock()
+	defer m.RUnlock()
+
+	tasks := make([]*indexpb.AnalyzeTask, 0)
+	for _, t := range m.tasks {
+		if t.GetCollectionID() != collectionID || t.GetFieldID() != fieldID {
+			continue
+		}
+		hash := t.GetSegmentsHash()
+		if hash == "" {
+			// the task is added before the hash is introduced
+			hash = analyzeSegmentsHash(t.GetSegmentIDs())
+		}
+		if hash == segmentsHash {
+			tasks = append(tasks, t)
+		}
+	}
+	return tasks
+}
+
+// GetFinishedTaskBySegments returns the latest finished task analyzing the field of the same segments,
+// the centroids of the task can be reused instead of analyzing the segments again.
+func (m *analyzeMeta) GetFinishedTaskBySegments(collectionID, fieldID int64, segmentIDs []int64) *indexpb.AnalyzeTask {
+	var latest *indexpb.AnalyzeTask
+	for _, t := range m.GetTasksBySegmentsHash(collectionID, fieldID, analyzeSegmentsHash(segmentIDs)) {
+		if t.GetState() != indexpb.JobState_JobStateFinished || t.GetCentroidsFile() == "" {
+			continue
+		}
+		if latest == nil || t.GetTaskID() > latest.GetTaskID() {
+			latest = t
+		}
+	}
+	return latest
+}
+
 func (m *analyzeMeta) GetAllTasks() map[int64]*indexpb.AnalyzeTask {
 	m.RLock()
 	defer m.RUnlock()
@@ -180,3 +266,18 @@ func (m *analyzeMeta) CheckCleanAnalyzeTask(taskID UniqueID) (bool, *indexpb.Ana
 	}
 	return true, nil
 }
+
+// analyzeSegmentsHash is the content hash of the segment set, the order of segmentIDs doesn't matter.
+func analyzeSegmentsHash(segmentIDs []int64) string {
+	ids := make([]int64, len(segmentIDs))
+	copy(ids, segmentIDs)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	h := sha256.New()
+	buf := make([]byte, 8)
+	for _, id := range ids {
+		binary.LittleEndian.PutUint64(buf, uint64(id))
+		h.Write(buf)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
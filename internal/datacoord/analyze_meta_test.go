@@ -26,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type AnalyzeMetaSuite struct {
@@ -141,7 +142,7 @@ func (s *AnalyzeMetaSuite) Test_AnalyzeMeta() {
 	})
 
 	s.Run("UpdateVersion", func() {
-		err := am.UpdateVersion(1)
+		err := am.UpdateVersion(1, 1)
 		s.NoError(err)
 		s.Equal(int64(1), am.GetTask(1).Version)
 	})
@@ -152,6 +153,19 @@ func (s *AnalyzeMetaSuite) Test_AnalyzeMeta() {
 		s.Equal(indexpb.JobState_JobStateInProgress, am.GetTask(1).State)
 	})
 
+	s.Run("CancelTask", func() {
+		err := am.CancelTask(2)
+		s.NoError(err)
+		s.Equal(indexpb.JobState_JobStateFailed, am.GetTask(2).State)
+		s.NotEmpty(am.GetTask(2).FailReason)
+
+		err = am.CancelTask(2)
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+
+		err = am.CancelTask(100)
+		s.ErrorIs(err, merr.ErrAnalyzeTaskNotFound)
+	})
+
 	s.Run("FinishTask", func() {
 		err := am.FinishTask(1, &indexpb.AnalyzeResult{
 			TaskID: 1,
@@ -160,6 +174,27 @@ func (s *AnalyzeMetaSuite) Test_AnalyzeMeta() {
 		s.NoError(err)
 		s.Equal(indexpb.JobState_JobStateFinished, am.GetTask(1).State)
 	})
+
+	s.Run("GetFinishedTaskBySegments", func() {
+		hash := analyzeSegmentsHash(s.segmentIDs)
+		s.Equal(hash, analyzeSegmentsHash([]int64{1003, 1001, 1002, 1000}))
+		s.Equal(6, len(am.GetTasksBySegmentsHash(s.collectionID, s.fieldID, hash)))
+		s.Empty(am.GetTasksBySegmentsHash(s.collectionID, s.fieldID+1, hash))
+
+		// no centroids
+		s.Nil(am.GetFinishedTaskBySegments(s.collectionID, s.fieldID, s.segmentIDs))
+
+		err := am.FinishTask(3, &indexpb.AnalyzeResult{
+			TaskID:        3,
+			State:         indexpb.JobState_JobStateFinished,
+			CentroidsFile: "centroids",
+		})
+		s.NoError(err)
+		t := am.GetFinishedTaskBySegments(s.collectionID, s.fieldID, []int64{1003, 1002, 1001, 1000})
+		s.NotNil(t)
+		s.Equal(int64(3), t.GetTaskID())
+		s.Nil(am.GetFinishedTaskBySegments(s.collectionID, s.fieldID, []int64{1000, 1001}))
+	})
 }
 
 func (s *AnalyzeMetaSuite) Test_failCase() {
@@ -217,10 +252,10 @@ func (s *AnalyzeMetaSuite) Test_failCase() {
 	})
 
 	s.Run("UpdateVersion", func() {
-		err := am.UpdateVersion(777)
+		err := am.UpdateVersion(777, 1)
 		s.Error(err)
 
-		err = am.UpdateVersion(1)
+		err = am.UpdateVersion(1, 1)
 		s.Error(err)
 		s.Equal(int64(0), am.GetTask(1).Version)
 	})
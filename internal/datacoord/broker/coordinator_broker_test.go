@@ -27,8 +27,10 @@ import (
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -136,6 +138,41 @@ func (s *BrokerSuite) TestShowPartitionsInternal() {
 	})
 }
 
+func (s *BrokerSuite) TestShowPartitions() {
+	s.Run("return_success", func() {
+		s.SetupTest()
+
+		collID := int64(1000 + rand.Intn(500))
+
+		s.rootCoordClient.EXPECT().ShowPartitionsInternal(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.ShowPartitionsRequest, options ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error) {
+			s.Equal(collID, req.GetCollectionID())
+			return &milvuspb.ShowPartitionsResponse{
+				Status:         merr.Status(nil),
+				PartitionIDs:   []int64{1, 2},
+				PartitionNames: []string{"_default_1", "_default_2"},
+			}, nil
+		})
+
+		resp, err := s.broker.ShowPartitions(context.Background(), collID)
+		s.NoError(err)
+		s.ElementsMatch([]int64{1, 2}, resp.GetPartitionIDs())
+		s.ElementsMatch([]string{"_default_1", "_default_2"}, resp.GetPartitionNames())
+
+		s.TearDownTest()
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+
+		s.rootCoordClient.EXPECT().ShowPartitionsInternal(mock.Anything, mock.Anything).Return(nil, errors.New("mocked"))
+
+		_, err := s.broker.ShowPartitions(context.Background(), 1000)
+		s.Error(err)
+
+		s.TearDownTest()
+	})
+}
+
 func (s *BrokerSuite) TestShowCollections() {
 	s.Run("return_success", func() {
 		s.SetupTest()
@@ -210,6 +247,41 @@ func (s *BrokerSuite) TestListDatabases() {
 	})
 }
 
+func (s *BrokerSuite) TestDescribeDatabase() {
+	s.Run("return_success", func() {
+		s.SetupTest()
+
+		s.rootCoordClient.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.DescribeDatabaseRequest, options ...grpc.CallOption) (*rootcoordpb.DescribeDatabaseResponse, error) {
+			s.Equal("db_1", req.GetDbName())
+			return &rootcoordpb.DescribeDatabaseResponse{
+				Status:     merr.Status(nil),
+				DbName:     "db_1",
+				Properties: []*commonpb.KeyValuePair{{Key: "key", Value: "value"}},
+			}, nil
+		})
+
+		resp, err := s.broker.DescribeDatabase(context.Background(), "db_1")
+		s.NoError(err)
+		s.Equal("db_1", resp.GetDbName())
+		s.Len(resp.GetProperties(), 1)
+
+		s.TearDownTest()
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+
+		s.rootCoordClient.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.DescribeDatabaseRequest, options ...grpc.CallOption) (*rootcoordpb.DescribeDatabaseResponse, error) {
+			return nil, errors.New("mocked")
+		})
+
+		_, err := s.broker.DescribeDatabase(context.Background(), "db_1")
+		s.Error(err)
+
+		s.TearDownTest()
+	})
+}
+
 func (s *BrokerSuite) TestHasCollection() {
 	s.Run("return_success", func() {
 		s.SetupTest()
@@ -0,0 +1,231 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// NodeLabelGetter returns the labels of the DataNode, nil if the node is not found.
+type NodeLabelGetter func(nodeID int64) map[string]string
+
+// channelIsolation decides the DataNodes allowed to watch each channel. The channels of the collections with
+// property collection.datanode.labels are only watched by the DataNodes with all the labels, and the other
+// channels are kept away from these dedicated nodes unless there is no other node.
+type channelIsolation struct {
+	nodes      []int64                     // sorted, the legacy nodes excluded
+	nodeLabels map[int64]map[string]string // labels of the nodes
+	selectors  map[int64]map[string]string // labels required by the isolated collections
+	dedicated  typeutil.UniqueSet          // the nodes matching any isolated collection
+}
+
+func newChannelIsolation(nodes []int64, nodeLabels map[int64]map[string]string, selectors map[int64]map[string]string) *channelIsolation {
+	nodes = append([]int64(nil), nodes...)
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	iso := &channelIsolation{
+		nodes:      nodes,
+		nodeLabels: nodeLabels,
+		selectors:  selectors,
+		dedicated:  typeutil.NewUniqueSet(),
+	}
+	for _, nodeID := range nodes {
+		for _, selector := range selectors {
+			if matchNodeLabels(nodeLabels[nodeID], selector) {
+				iso.dedicated.Insert(nodeID)
+				break
+			}
+		}
+	}
+	return iso
+}
+
+// matchNodeLabels returns true if the node has all the labels of the selector.
+func matchNodeLabels(labels, selector map[string]string) bool {
+	for key, value := range selector {
+		if v, ok := labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// allowedNodes returns the sorted nodes allowed to watch the channel, all the nodes if no isolation,
+// empty if the collection of the channel is isolated but no node matches.
+func (iso *channelIsolation) allowedNodes(ch RWChannel) []int64 {
+	if selector, ok := iso.selectors[ch.GetCollectionID()]; ok {
+		return lo.Filter(iso.nodes, func(nodeID int64, _ int) bool {
+			return matchNodeLabels(iso.nodeLabels[nodeID], selector)
+		})
+	}
+	shared := lo.Filter(iso.nodes, func(nodeID int64, _ int) bool {
+		return !iso.dedicated.Contain(nodeID)
+	})
+	if len(shared) == 0 {
+		return iso.nodes
+	}
+	return shared
+}
+
+// allowed returns true if the node is allowed to watch the channel, always true if iso is nil.
+func (iso *channelIsolation) allowed(ch RWChannel, nodeID int64) bool {
+	if iso == nil {
+		return true
+	}
+	return lo.Contains(iso.allowedNodes(ch), nodeID)
+}
+
+// getChannelIsolation collects the labels of the nodes and the isolated collections of the channels in the store,
+// nil if the node labels are not provided or no collection is isolated. The caller must hold the lock.
+func (m *ChannelManagerImpl) getChannelIsolation() *channelIsolation {
+	if m.nodeLabelGetter == nil {
+		return nil
+	}
+	collectionIDs := typeutil.NewUniqueSet()
+	for _, info := range m.store.GetNodeChannelsBy(WithAllNodes()) {
+		for _, ch := range info.Channels {
+			collectionIDs.Insert(ch.GetCollectionID())
+		}
+	}
+	selectors := make(map[int64]map[string]string)
+	for _, collectionID := range collectionIDs.Collect() {
+		coll, err := m.h.GetCollection(context.TODO(), collectionID)
+		if err != nil || coll == nil {
+			log.RatedWarn(10, "failed to get collection, assign its channels without isolation",
+				zap.Int64("collectionID", collectionID), zap.Error(err))
+			continue
+		}
+		selector, err := common.CollectionLevelDataNodeLabels(coll.Properties)
+		if err != nil {
+			log.RatedWarn(10, "invalid datanode labels of collection, assign its channels without isolation",
+				zap.Int64("collectionID", collectionID), zap.Error(err))
+			continue
+		}
+		if len(selector) > 0 {
+			selectors[collectionID] = selector
+		}
+	}
+	if len(selectors) == 0 {
+		return nil
+	}
+
+	nodes := lo.Filter(m.store.GetNodes(), func(nodeID int64, _ int) bool {
+		return !m.legacyNodes.Contain(nodeID)
+	})
+	nodeLabels := make(map[int64]map[string]string, len(nodes))
+	for _, nodeID := range nodes {
+		nodeLabels[nodeID] = m.nodeLabelGetter(nodeID)
+	}
+	return newChannelIsolation(nodes, nodeLabels, selectors)
+}
+
+// assign assigns the channels to the nodes by the assign policy, the channels allowed on the same nodes
+// are assigned together, see channelIsolation. The caller must hold the lock.
+func (m *ChannelManagerImpl) assign(toAssign *NodeChannelInfo) *ChannelOpSet {
+	cluster := m.store.GetNodesChannels()
+	iso := m.getChannelIsolation()
+	if iso == nil || toAssign == nil || len(toAssign.Channels) == 0 {
+		return m.assignPolicy(cluster, toAssign, m.legacyNodes.Collect())
+	}
+
+	groups := make(map[string]*NodeChannelInfo)
+	groupNodes := make(map[string][]int64)
+	for _, ch := range toAssign.Channels {
+		nodes := iso.allowedNodes(ch)
+		if len(nodes) == 0 {
+			log.RatedWarn(10, "no datanode matches the labels of the collection, the channel is left unassigned",
+				zap.String("channel", ch.GetName()),
+				zap.Int64("collectionID", ch.GetCollectionID()),
+				zap.Any("labels", iso.selectors[ch.GetCollectionID()]))
+			continue
+		}
+		key := fmt.Sprint(nodes)
+		if _, ok := groups[key]; !ok {
+			groups[key] = NewNodeChannelInfo(toAssign.NodeID)
+			groupNodes[key] = nodes
+		}
+		groups[key].AddChannel(ch)
+	}
+
+	var updates *ChannelOpSet
+	for key, group := range groups {
+		exclusiveNodes := lo.Without(m.store.GetNodes(), groupNodes[key]...)
+		ops := m.assignPolicy(cluster, group, exclusiveNodes)
+		if ops == nil {
+			continue
+		}
+		if updates == nil {
+			updates = NewChannelOpSet()
+		}
+		updates.Insert(ops.Collect()...)
+	}
+	return updates
+}
+
+// releaseMisplacedChannels releases the watched channels on the nodes not allowed to watch them, if there are
+// other nodes allowed. Returns true if any channel is released. The caller must hold the lock.
+func (m *ChannelManagerImpl) releaseMisplacedChannels(iso *channelIsolation, watchedCluster Assignments) bool {
+	if iso == nil {
+		return false
+	}
+	updates := NewChannelOpSet()
+	for _, info := range watchedCluster {
+		misplaced := lo.Filter(lo.Values(info.Channels), func(ch RWChannel, _ int) bool {
+			return len(iso.allowedNodes(ch)) > 0 && !iso.allowed(ch, info.NodeID)
+		})
+		if len(misplaced) > 0 {
+			updates.Append(info.NodeID, Release, misplaced...)
+		}
+	}
+	if updates.Len() == 0 {
+		return false
+	}
+
+	log.Info("Release the channels watched by the nodes not matching the isolation", zap.Array("assignment", updates))
+	if err := m.execute(updates); err != nil {
+		log.Warn("Channel balancer fail to execute", zap.Array("assignment", updates), zap.Error(err))
+	}
+	return true
+}
+
+// filterIsolatedReleases drops the channels released by the balance policy that have no other node to move to.
+func filterIsolatedReleases(iso *channelIsolation, updates *ChannelOpSet) *ChannelOpSet {
+	if iso == nil || updates == nil {
+		return updates
+	}
+	filtered := NewChannelOpSet()
+	for _, op := range updates.Collect() {
+		channels := lo.Filter(op.Channels, func(ch RWChannel, _ int) bool {
+			return op.Type != Release || len(lo.Without(iso.allowedNodes(ch), op.NodeID)) > 0
+		})
+		if len(channels) > 0 {
+			filtered.Append(op.NodeID, op.Type, channels...)
+		}
+	}
+	if filtered.Len() == 0 {
+		return nil
+	}
+	return filtered
+}
@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelIsolation(t *testing.T) {
+	nodeLabels := map[int64]map[string]string{
+		2: {"tenant": "a", "disk": "ssd"},
+		3: {"tenant": "a"},
+	}
+	selectors := map[int64]map[string]string{
+		1: {"tenant": "a", "disk": "ssd"},
+		2: {"tenant": "b"},
+	}
+	iso := newChannelIsolation([]int64{3, 2, 1}, nodeLabels, selectors)
+
+	assert.Equal(t, []int64{2}, iso.allowedNodes(getChannel("ch1", 1)))
+	assert.Empty(t, iso.allowedNodes(getChannel("ch2", 2)))
+	// node 3 is not dedicated to any collection
+	assert.Equal(t, []int64{1, 3}, iso.allowedNodes(getChannel("ch3", 3)))
+	assert.True(t, iso.allowed(getChannel("ch1", 1), 2))
+	assert.False(t, iso.allowed(getChannel("ch3", 3), 2))
+
+	var nilIso *channelIsolation
+	assert.True(t, nilIso.allowed(getChannel("ch1", 1), 1))
+
+	// the shared channels fall back to the dedicated nodes if there is no other node
+	iso = newChannelIsolation([]int64{2}, nodeLabels, selectors)
+	assert.Equal(t, []int64{2}, iso.allowedNodes(getChannel("ch3", 3)))
+}
+
+func TestFilterIsolatedReleases(t *testing.T) {
+	iso := newChannelIsolation([]int64{1, 2}, map[int64]map[string]string{2: {"tenant": "a"}}, map[int64]map[string]string{1: {"tenant": "a"}})
+
+	assert.Nil(t, filterIsolatedReleases(iso, nil))
+	updates := NewChannelOpSet(
+		NewChannelOp(2, Release, getChannel("ch1", 1)),
+	)
+	assert.Nil(t, filterIsolatedReleases(iso, updates))
+
+	updates = NewChannelOpSet(
+		NewChannelOp(2, Release, getChannel("ch1", 1), getChannel("ch2", 2)),
+	)
+	filtered := filterIsolatedReleases(iso, updates)
+	assert.Equal(t, 1, filtered.Len())
+	assert.Equal(t, []string{"ch2"}, filtered.Collect()[0].GetChannelNames())
+	assert.Equal(t, updates, filterIsolatedReleases(nil, updates))
+}
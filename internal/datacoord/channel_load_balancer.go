@@ -0,0 +1,245 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"math"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ChannelRowCounter returns the current row count of each channel.
+type ChannelRowCounter func() map[string]int64
+
+// channelRowRateTracker samples the row count of the channels in each balance round
+// to estimate the rows inserted per second of each channel.
+type channelRowRateTracker struct {
+	counter    ChannelRowCounter
+	lastRows   map[string]int64
+	lastSample time.Time
+}
+
+func newChannelRowRateTracker(counter ChannelRowCounter) *channelRowRateTracker {
+	return &channelRowRateTracker{counter: counter}
+}
+
+// sample returns the rows inserted per second of each channel since the last sample,
+// false if there is no previous sample to compare with.
+func (t *channelRowRateTracker) sample(now time.Time) (map[string]float64, bool) {
+	rows := t.counter()
+	lastRows, lastSample := t.lastRows, t.lastSample
+	t.lastRows, t.lastSample = rows, now
+
+	elapsed := now.Sub(lastSample).Seconds()
+	if lastRows == nil || elapsed <= 0 {
+		return nil, false
+	}
+	rates := make(map[string]float64, len(rows))
+	for channel, num := range rows {
+		last, ok := lastRows[channel]
+		// the row count decreases after the compaction removes the deleted rows
+		if !ok || num <= last {
+			continue
+		}
+		rates[channel] = float64(num-last) / elapsed
+	}
+	return rates, true
+}
+
+// channelMove is the channel to move from the node to another node for balance.
+type channelMove struct {
+	channel RWChannel
+	from    int64
+	to      int64
+}
+
+// balanceChannelByLoad picks at most one channel to move from the most loaded node to the least loaded node.
+// The load of a node is the weighted sum of its channel count and the rows inserted per second of its channels,
+// both normalized by the average of the nodes. The exclusive nodes are not chosen as the target,
+// and the channels not allowed on the target by the isolation are not moved.
+func balanceChannelByLoad(cluster Assignments, rowRates map[string]float64, exclusiveNodes []int64, iso *channelIsolation) *channelMove {
+	if len(cluster) < 2 {
+		return nil
+	}
+	countWeight := Params.DataCoordCfg.ChannelLoadCountWeight.GetAsFloat()
+	rateWeight := Params.DataCoordCfg.ChannelLoadRowRateWeight.GetAsFloat()
+
+	nodeCount := make(map[int64]float64, len(cluster))
+	nodeRate := make(map[int64]float64, len(cluster))
+	totalCount, totalRate := 0.0, 0.0
+	for _, info := range cluster {
+		nodeCount[info.NodeID] = float64(len(info.Channels))
+		for name := range info.Channels {
+			nodeRate[info.NodeID] += rowRates[name]
+		}
+		totalCount += nodeCount[info.NodeID]
+		totalRate += nodeRate[info.NodeID]
+	}
+	avgCount := totalCount / float64(len(cluster))
+	avgRate := totalRate / float64(len(cluster))
+	loadOf := func(count, rate float64) float64 {
+		load := 0.0
+		if avgCount > 0 {
+			load += countWeight * count / avgCount
+		}
+		if avgRate > 0 {
+			load += rateWeight * rate / avgRate
+		}
+		return load
+	}
+	avgLoad := loadOf(avgCount, avgRate)
+	if avgLoad <= 0 {
+		return nil
+	}
+
+	var source, target *NodeChannelInfo
+	for _, info := range cluster {
+		load := loadOf(nodeCount[info.NodeID], nodeRate[info.NodeID])
+		if source == nil || load > loadOf(nodeCount[source.NodeID], nodeRate[source.NodeID]) {
+			source = info
+		}
+		if lo.Contains(exclusiveNodes, info.NodeID) {
+			continue
+		}
+		if target == nil || load < loadOf(nodeCount[target.NodeID], nodeRate[target.NodeID]) {
+			target = info
+		}
+	}
+	if target == nil || source.NodeID == target.NodeID {
+		return nil
+	}
+	gap := loadOf(nodeCount[source.NodeID], nodeRate[source.NodeID]) - loadOf(nodeCount[target.NodeID], nodeRate[target.NodeID])
+	if gap <= Params.DataCoordCfg.ChannelLoadUnbalanceToleration.GetAsFloat()*avgLoad {
+		return nil
+	}
+
+	// moving a channel with load less than the gap narrows the gap, pick the one closest to half of the gap
+	var channelToMove RWChannel
+	minDiff := math.MaxFloat64
+	for name, ch := range source.Channels {
+		load := loadOf(1, rowRates[name])
+		if load >= gap || !iso.allowed(ch, target.NodeID) {
+			continue
+		}
+		if diff := math.Abs(load - gap/2); diff < minDiff {
+			minDiff = diff
+			channelToMove = ch
+		}
+	}
+	if channelToMove == nil {
+		return nil
+	}
+
+	log.Info("balance channel by load",
+		zap.String("channel", channelToMove.GetName()),
+		zap.Int64("from", source.NodeID),
+		zap.Int64("to", target.NodeID),
+		zap.Float64("loadGap", gap),
+		zap.Float64("averageLoad", avgLoad))
+	return &channelMove{
+		channel: channelToMove,
+		from:    source.NodeID,
+		to:      target.NodeID,
+	}
+}
+
+// balanceByLoad releases at most one channel from the most loaded node in each round,
+// the released channel is watched by the least loaded node then, see assignToBalanceTargets.
+// The caller must hold the lock.
+func (m *ChannelManagerImpl) balanceByLoad(watchedCluster Assignments, iso *channelIsolation) {
+	rowRates, ok := m.sampleRowRates(time.Now())
+	if !ok {
+		return
+	}
+
+	// forget the targets of the channels moved or dropped, and wait for the channels still being moved
+	moving := typeutil.NewSet[string]()
+	for _, info := range m.store.GetNodeChannelsBy(WithAllNodes(), WithChannelStates(ToRelease, Releasing, Standby)) {
+		moving.Insert(lo.Keys(info.Channels)...)
+	}
+	for name := range m.balanceTargets {
+		if !moving.Contain(name) {
+			delete(m.balanceTargets, name)
+		}
+	}
+	if len(m.balanceTargets) > 0 {
+		log.Info("channels are being moved by load balance, skip this round", zap.Strings("channels", lo.Keys(m.balanceTargets)))
+		return
+	}
+
+	move := balanceChannelByLoad(watchedCluster, rowRates, m.legacyNodes.Collect(), iso)
+	if move == nil {
+		return
+	}
+	updates := NewChannelOpSet(NewChannelOp(move.from, Release, move.channel))
+	if err := m.execute(updates); err != nil {
+		log.Warn("Channel balancer fail to execute", zap.Array("assignment", updates), zap.Error(err))
+		return
+	}
+	m.balanceTargets[move.channel.GetName()] = move.to
+}
+
+// sampleRowRates returns the write rates of the channels reported by the datanodes if any,
+// otherwise the row rates sampled from the row counts of the channels.
+func (m *ChannelManagerImpl) sampleRowRates(now time.Time) (map[string]float64, bool) {
+	var rowRates map[string]float64
+	var ok bool
+	// keep sampling to fall back without waiting for another round
+	if m.rowRateTracker != nil {
+		rowRates, ok = m.rowRateTracker.sample(now)
+	}
+	if m.writeRates != nil {
+		if rates := m.writeRates(); len(rates) > 0 {
+			return rates, true
+		}
+	}
+	return rowRates, ok
+}
+
+// assignToBalanceTargets watches the channels released by the load balance on their target nodes,
+// and returns the channels left to assign. The caller must hold the lock.
+func (m *ChannelManagerImpl) assignToBalanceTargets(original *NodeChannelInfo) (*NodeChannelInfo, error) {
+	if len(m.balanceTargets) == 0 {
+		return original, nil
+	}
+	nodes := typeutil.NewUniqueSet(m.store.GetNodes()...)
+	left := NewNodeChannelInfo(original.NodeID)
+	updates := NewChannelOpSet()
+	for name, ch := range original.Channels {
+		target, ok := m.balanceTargets[name]
+		delete(m.balanceTargets, name)
+		if !ok || target == original.NodeID || !nodes.Contain(target) {
+			left.AddChannel(ch)
+			continue
+		}
+		updates.Append(target, Watch, ch)
+		updates.Delete(original.NodeID, ch)
+	}
+	if updates.Len() == 0 {
+		return left, nil
+	}
+	if err := m.execute(updates); err != nil {
+		return original, err
+	}
+	log.Info("Assign channels to the targets of load balance", zap.Array("assignment", updates))
+	return left, nil
+}
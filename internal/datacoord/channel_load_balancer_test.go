@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestChannelRowRateTracker(t *testing.T) {
+	rows := map[string]int64{"ch1": 100, "ch2": 100}
+	tracker := newChannelRowRateTracker(func() map[string]int64 {
+		ret := make(map[string]int64, len(rows))
+		for k, v := range rows {
+			ret[k] = v
+		}
+		return ret
+	})
+
+	now := time.Now()
+	_, ok := tracker.sample(now)
+	assert.False(t, ok)
+
+	rows["ch1"] = 1100
+	rows["ch2"] = 50
+	rows["ch3"] = 1000
+	rates, ok := tracker.sample(now.Add(10 * time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, map[string]float64{"ch1": 100}, rates)
+
+	rows["ch3"] = 2000
+	rates, ok = tracker.sample(now.Add(20 * time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, map[string]float64{"ch3": 100}, rates)
+}
+
+func TestBalanceChannelByLoad(t *testing.T) {
+	paramtable.Init()
+
+	t.Run("move channel from hot node", func(t *testing.T) {
+		cluster := Assignments{
+			{1, getChannels(map[string]int64{"ch1": 1, "ch2": 1})},
+			{2, getChannels(map[string]int64{"ch3": 1})},
+			{3, getChannels(map[string]int64{"ch4": 1})},
+		}
+		rowRates := map[string]float64{"ch1": 1000, "ch2": 10, "ch3": 10}
+
+		// moving ch1 makes node 3 the hot one
+		move := balanceChannelByLoad(cluster, rowRates, nil, nil)
+		assert.NotNil(t, move)
+		assert.Equal(t, "ch2", move.channel.GetName())
+		assert.EqualValues(t, 1, move.from)
+		assert.EqualValues(t, 3, move.to)
+
+		move = balanceChannelByLoad(cluster, rowRates, []int64{3}, nil)
+		assert.NotNil(t, move)
+		assert.EqualValues(t, 2, move.to)
+	})
+
+	t.Run("move channel to idle node", func(t *testing.T) {
+		cluster := Assignments{
+			{1, getChannels(map[string]int64{"ch1": 1, "ch2": 1})},
+			{2, getChannels(map[string]int64{})},
+		}
+		move := balanceChannelByLoad(cluster, nil, nil, nil)
+		assert.NotNil(t, move)
+		assert.EqualValues(t, 1, move.from)
+		assert.EqualValues(t, 2, move.to)
+
+		// the idle node is dedicated to another collection
+		iso := newChannelIsolation([]int64{1, 2}, map[int64]map[string]string{2: {"tenant": "a"}}, map[int64]map[string]string{5: {"tenant": "a"}})
+		assert.Nil(t, balanceChannelByLoad(cluster, nil, nil, iso))
+	})
+
+	t.Run("balanced", func(t *testing.T) {
+		cluster := Assignments{
+			{1, getChannels(map[string]int64{"ch1": 1, "ch2": 1})},
+			{2, getChannels(map[string]int64{"ch3": 1, "ch4": 1})},
+		}
+		rowRates := map[string]float64{"ch1": 100, "ch2": 100, "ch3": 120, "ch4": 100}
+		assert.Nil(t, balanceChannelByLoad(cluster, rowRates, nil, nil))
+
+		// the only hot channel can't be moved to narrow the gap
+		rowRates = map[string]float64{"ch1": 1000}
+		cluster = Assignments{
+			{1, getChannels(map[string]int64{"ch1": 1})},
+			{2, getChannels(map[string]int64{"ch2": 1})},
+		}
+		assert.Nil(t, balanceChannelByLoad(cluster, rowRates, nil, nil))
+
+		assert.Nil(t, balanceChannelByLoad(cluster[:1], rowRates, nil, nil))
+		assert.Nil(t, balanceChannelByLoad(cluster, rowRates, []int64{2}, nil))
+	})
+}
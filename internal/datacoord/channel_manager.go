@@ -61,7 +61,7 @@ type SubCluster interface {
 
 type ChannelManagerImpl struct {
 	cancel context.CancelFunc
-	mu     lock.RWMutex
+	mu     lock.NamedRWMutex
 	wg     sync.WaitGroup
 
 	h          Handler
@@ -75,6 +75,16 @@ type ChannelManagerImpl struct {
 
 	balanceCheckLoop ChannelBGChecker
 
+	// rowRateTracker estimates the row throughput of the channels to balance channels by load, nil if not provided
+	rowRateTracker *channelRowRateTracker
+	// writeRates returns the write rates of the channels reported by the datanodes, which are preferred
+	// to the sampled row rates to balance channels by load, nil if not provided
+	writeRates func() map[string]float64
+	// balanceTargets is the target node of each channel released by the load balance
+	balanceTargets map[string]int64
+	// nodeLabelGetter provides the labels of the nodes to isolate the channels of collections, nil if not provided
+	nodeLabelGetter NodeLabelGetter
+
 	legacyNodes typeutil.UniqueSet
 
 	lastActiveTimestamp time.Time
@@ -94,6 +104,18 @@ func withCheckerV2() ChannelmanagerOpt {
 	return func(c *ChannelManagerImpl) { c.balanceCheckLoop = c.CheckLoop }
 }
 
+func withChannelRowCounter(counter ChannelRowCounter) ChannelmanagerOpt {
+	return func(c *ChannelManagerImpl) { c.rowRateTracker = newChannelRowRateTracker(counter) }
+}
+
+func withChannelWriteRates(getter func() map[string]float64) ChannelmanagerOpt {
+	return func(c *ChannelManagerImpl) { c.writeRates = getter }
+}
+
+func withNodeLabelGetter(getter NodeLabelGetter) ChannelmanagerOpt {
+	return func(c *ChannelManagerImpl) { c.nodeLabelGetter = getter }
+}
+
 func NewChannelManager(
 	kv kv.TxnKV,
 	h Handler,
@@ -102,11 +124,14 @@ func NewChannelManager(
 	options ...ChannelmanagerOpt,
 ) (*ChannelManagerImpl, error) {
 	m := &ChannelManagerImpl{
+		mu:         lock.NewNamedRWMutex("datacoord_channel_manager"),
 		h:          h,
 		factory:    NewChannelPolicyFactoryV1(),
 		store:      NewChannelStoreV2(kv),
 		subCluster: subCluster,
 		allocator:  alloc,
+
+		balanceTargets: make(map[string]int64),
 	}
 
 	if err := m.store.Reload(); err != nil {
@@ -191,7 +216,7 @@ func (m *ChannelManagerImpl) AddNode(nodeID UniqueID) error {
 	log.Info("register node", zap.Int64("registered node", nodeID))
 
 	m.store.AddNode(nodeID)
-	updates := m.assignPolicy(m.store.GetNodesChannels(), m.store.GetBufferChannelInfo(), m.legacyNodes.Collect())
+	updates := m.assign(m.store.GetBufferChannelInfo())
 
 	if updates == nil {
 		log.Info("register node with no reassignment", zap.Int64("registered node", nodeID))
@@ -244,7 +269,7 @@ func (m *ChannelManagerImpl) Watch(ctx context.Context, ch RWChannel) error {
 
 	// channel already written into meta, try to assign it to the cluster
 	// not error is returned if failed, the assignment will retry later
-	updates = m.assignPolicy(m.store.GetNodesChannels(), m.store.GetBufferChannelInfo(), m.legacyNodes.Collect())
+	updates = m.assign(m.store.GetBufferChannelInfo())
 	if updates == nil {
 		return nil
 	}
@@ -294,7 +319,15 @@ func (m *ChannelManagerImpl) reassign(original *NodeChannelInfo) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	updates := m.assignPolicy(m.store.GetNodesChannels(), original, m.legacyNodes.Collect())
+	original, err := m.assignToBalanceTargets(original)
+	if err != nil {
+		return err
+	}
+	if len(original.Channels) == 0 {
+		return nil
+	}
+
+	updates := m.assign(original)
 	if updates != nil {
 		return m.execute(updates)
 	}
@@ -316,7 +349,15 @@ func (m *ChannelManagerImpl) Balance() {
 	defer m.mu.Unlock()
 
 	watchedCluster := m.store.GetNodeChannelsBy(WithoutBufferNode(), WithChannelStates(Watched))
-	updates := m.balancePolicy(watchedCluster)
+	iso := m.getChannelIsolation()
+	if m.releaseMisplacedChannels(iso, watchedCluster) {
+		return
+	}
+	if (m.rowRateTracker != nil || m.writeRates != nil) && Params.DataCoordCfg.ChannelBalanceByLoad.GetAsBool() {
+		m.balanceByLoad(watchedCluster, iso)
+		return
+	}
+	updates := filterIsolatedReleases(iso, m.balancePolicy(watchedCluster))
 	if updates == nil {
 		return
 	}
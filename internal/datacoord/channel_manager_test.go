@@ -30,6 +30,7 @@ import (
 
 	kvmock "github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/kv/predicates"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -729,3 +730,113 @@ func (s *ChannelManagerSuite) TestStartupRootCoordFailed() {
 
 func (s *ChannelManagerSuite) TestCheckLoop() {}
 func (s *ChannelManagerSuite) TestGet()       {}
+
+func (s *ChannelManagerSuite) TestBalanceByLoad() {
+	paramtable.Get().Save(Params.DataCoordCfg.ChannelBalanceByLoad.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.ChannelBalanceByLoad.Key)
+
+	chNodes := map[string]int64{
+		"ch1": 1,
+		"ch2": 1,
+		"ch3": 2,
+		"ch4": 3,
+	}
+	s.prepareMeta(chNodes, datapb.ChannelWatchState_WatchSuccess)
+	var round int64
+	counter := func() map[string]int64 {
+		round++
+		return map[string]int64{"ch1": 1000 * round, "ch2": 10 * round, "ch3": 10 * round}
+	}
+	m, err := NewChannelManager(s.mockKv, s.mockHandler, s.mockCluster, s.mockAlloc, withChannelRowCounter(counter))
+	s.Require().NoError(err)
+
+	// the first round samples the row count only
+	m.Balance()
+	s.checkAssignment(m, 1, "ch2", Watched)
+
+	m.Balance()
+	s.checkAssignment(m, 1, "ch1", Watched)
+	s.checkAssignment(m, 1, "ch2", ToRelease)
+	s.Equal(map[string]int64{"ch2": 3}, m.balanceTargets)
+
+	// wait for the channel being moved
+	m.Balance()
+	s.checkAssignment(m, 1, "ch1", Watched)
+	s.checkAssignment(m, 1, "ch2", ToRelease)
+
+	// the released channel is watched by the target node
+	ch, ok := m.GetChannel(1, "ch2")
+	s.Require().True(ok)
+	s.NoError(m.reassign(NewNodeChannelInfo(1, ch)))
+	s.checkNoAssignment(m, 1, "ch2")
+	s.checkAssignment(m, 3, "ch2", ToWatch)
+	s.Empty(m.balanceTargets)
+}
+
+func (s *ChannelManagerSuite) TestCollectionIsolation() {
+	nodeLabels := map[int64]map[string]string{
+		3: {"tenant": "a"},
+	}
+	getter := func(nodeID int64) map[string]string {
+		return nodeLabels[nodeID]
+	}
+	mockGetCollection := func(collLabels map[int64]string) {
+		s.mockHandler.EXPECT().GetCollection(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, collectionID int64) (*collectionInfo, error) {
+				coll := &collectionInfo{ID: collectionID, Properties: map[string]string{}}
+				if labels, ok := collLabels[collectionID]; ok {
+					coll.Properties[common.CollectionDataNodeLabelsKey] = labels
+				}
+				return coll, nil
+			}).Maybe()
+	}
+
+	s.Run("assign to the dedicated nodes", func() {
+		s.prepareMeta(nil, 0)
+		mockGetCollection(map[int64]string{1: "tenant=a", 3: "tenant=b"})
+		m, err := NewChannelManager(s.mockKv, s.mockHandler, s.mockCluster, s.mockAlloc, withNodeLabelGetter(getter))
+		s.Require().NoError(err)
+		for _, nodeID := range []int64{1, 2, 3} {
+			s.Require().NoError(m.AddNode(nodeID))
+		}
+
+		s.NoError(m.Watch(context.TODO(), getChannel("ch1", 1)))
+		s.NoError(m.Watch(context.TODO(), getChannel("ch2", 1)))
+		s.checkAssignment(m, 3, "ch1", ToWatch)
+		s.checkAssignment(m, 3, "ch2", ToWatch)
+
+		// the channels of the other collections are kept away from the dedicated nodes
+		for _, name := range []string{"ch3", "ch4", "ch5"} {
+			s.NoError(m.Watch(context.TODO(), getChannel(name, 2)))
+			nodeID, err := m.FindWatcher(name)
+			s.NoError(err)
+			s.NotEqualValues(3, nodeID)
+		}
+
+		// no node matches
+		s.NoError(m.Watch(context.TODO(), getChannel("ch6", 3)))
+		s.checkAssignment(m, bufferID, "ch6", Standby)
+
+		nodeLabels[2] = map[string]string{"tenant": "b"}
+		defer delete(nodeLabels, 2)
+		s.NoError(m.AddNode(4))
+		s.checkAssignment(m, 2, "ch6", ToWatch)
+	})
+
+	s.Run("release the misplaced channels", func() {
+		s.prepareMeta(map[string]int64{"ch1": 1, "ch2": 3}, datapb.ChannelWatchState_WatchSuccess)
+		mockGetCollection(map[int64]string{0: "tenant=a"})
+		m, err := NewChannelManager(s.mockKv, s.mockHandler, s.mockCluster, s.mockAlloc, withNodeLabelGetter(getter))
+		s.Require().NoError(err)
+
+		m.Balance()
+		s.checkAssignment(m, 1, "ch1", ToRelease)
+		s.checkAssignment(m, 3, "ch2", Watched)
+
+		ch, ok := m.GetChannel(1, "ch1")
+		s.Require().True(ok)
+		s.NoError(m.reassign(NewNodeChannelInfo(1, ch)))
+		s.checkNoAssignment(m, 1, "ch1")
+		s.checkAssignment(m, 3, "ch1", ToWatch)
+	})
+}
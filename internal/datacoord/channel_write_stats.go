@@ -0,0 +1,214 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+)
+
+// channelWriteRateSmoothing is the weight of the latest window in the smoothed write rate.
+const channelWriteRateSmoothing = 0.5
+
+// channelWriteStats aggregates the rows written to the vchannels reported by the datanodes
+// through the timeticks and the flushes, to estimate the write rates and detect the hot channels.
+type channelWriteStats struct {
+	mu       sync.Mutex
+	channels map[string]*channelWriteStat
+	now      func() time.Time
+
+	// the hot channels detected in the current round, which lasts for a window of the write rates
+	hot         map[string]bool
+	hotExpireAt time.Time
+}
+
+type channelWriteStat struct {
+	// the reported rows of the segments, to get the rows written since the last report
+	segmentRows map[int64]int64
+	// the rows written since windowStart
+	windowRows  int64
+	windowStart time.Time
+	// the rows written per second, smoothed over the windows
+	rate    float64
+	sampled bool
+}
+
+func newChannelWriteStats() *channelWriteStats {
+	return &channelWriteStats{
+		channels: make(map[string]*channelWriteStat),
+		now:      time.Now,
+	}
+}
+
+// Record records the rows of the segment reported by the datanode,
+// knownRows is the rows of the segment known before the report, used if the segment is never reported.
+func (s *channelWriteStats) Record(channel string, segmentID int64, knownRows int64, reportedRows int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stat, ok := s.channels[channel]
+	if !ok {
+		stat = &channelWriteStat{
+			segmentRows: make(map[int64]int64),
+			windowStart: s.now(),
+		}
+		s.channels[channel] = stat
+	}
+	last, ok := stat.segmentRows[segmentID]
+	if !ok {
+		last = knownRows
+	}
+	// the rows reported may be stale, count the increment only
+	if reportedRows > last {
+		stat.windowRows += reportedRows - last
+		stat.segmentRows[segmentID] = reportedRows
+	} else if !ok {
+		stat.segmentRows[segmentID] = last
+	}
+	s.roll(channel, stat)
+}
+
+// RemoveSegment forgets the segment flushed or dropped, which is never reported again.
+func (s *channelWriteStats) RemoveSegment(channel string, segmentID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if stat, ok := s.channels[channel]; ok {
+		delete(stat.segmentRows, segmentID)
+	}
+}
+
+// RemoveChannel forgets the dropped channel.
+func (s *channelWriteStats) RemoveChannel(channel string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.channels, channel)
+	metrics.DataCoordChannelWriteRate.DeleteLabelValues(channel)
+}
+
+// roll samples the write rate once the window passes, the caller must hold the lock.
+func (s *channelWriteStats) roll(channel string, stat *channelWriteStat) {
+	now := s.now()
+	elapsed := now.Sub(stat.windowStart)
+	if elapsed < Params.DataCoordCfg.ChannelWriteRateWindow.GetAsDuration(time.Second) || elapsed <= 0 {
+		return
+	}
+	rate := float64(stat.windowRows) / elapsed.Seconds()
+	if stat.sampled {
+		rate = channelWriteRateSmoothing*rate + (1-channelWriteRateSmoothing)*stat.rate
+	}
+	stat.rate, stat.sampled = rate, true
+	stat.windowRows, stat.windowStart = 0, now
+	metrics.DataCoordChannelWriteRate.WithLabelValues(channel).Set(rate)
+}
+
+// GetRates returns the rows written per second of the channels sampled at least once.
+func (s *channelWriteStats) GetRates() map[string]float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.getRates()
+}
+
+// getRates returns the write rates of the channels, the caller must hold the lock.
+func (s *channelWriteStats) getRates() map[string]float64 {
+	rates := make(map[string]float64, len(s.channels))
+	for channel, stat := range s.channels {
+		// roll the idle channels, whose rates decay without any report
+		s.roll(channel, stat)
+		if stat.sampled {
+			rates[channel] = stat.rate
+		}
+	}
+	return rates
+}
+
+// GetHotChannels returns the channels with write rates exceeding the ratio of the average write rate,
+// there is no hot channel if the detection is disabled or less than 2 channels are sampled.
+// The hot channels are detected once per round as the rates are sampled once per window,
+// the returned map is shared by the callers in the round and must not be modified.
+func (s *channelWriteStats) GetHotChannels() map[string]bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.hot == nil || !now.Before(s.hotExpireAt) {
+		s.setHotChannels(hotChannels(s.getRates()))
+		s.hotExpireAt = now.Add(Params.DataCoordCfg.ChannelWriteRateWindow.GetAsDuration(time.Second))
+	}
+	return s.hot
+}
+
+// setHotChannels updates the hot channels and the gauge, the caller must hold the lock.
+func (s *channelWriteStats) setHotChannels(hot map[string]bool) {
+	s.hot = hot
+	metrics.DataCoordHotChannelNum.Set(float64(len(hot)))
+}
+
+func hotChannels(rates map[string]float64) map[string]bool {
+	hot := make(map[string]bool)
+	ratio := Params.DataCoordCfg.HotChannelWriteRateRatio.GetAsFloat()
+	if ratio <= 0 || len(rates) < 2 {
+		return hot
+	}
+	total := 0.0
+	for _, rate := range rates {
+		total += rate
+	}
+	avg := total / float64(len(rates))
+	if avg <= 0 {
+		return hot
+	}
+	for channel, rate := range rates {
+		if rate > ratio*avg {
+			hot[channel] = true
+		}
+	}
+	return hot
+}
+
+// GetMetrics returns the write rates of the channels, with the hottest first.
+func (s *channelWriteStats) GetMetrics() *metricsinfo.DataCoordChannelWriteMetrics {
+	s.mu.Lock()
+	rates := s.getRates()
+	hot := hotChannels(rates)
+	s.setHotChannels(hot)
+	s.mu.Unlock()
+
+	ret := &metricsinfo.DataCoordChannelWriteMetrics{
+		Channels: make([]*metricsinfo.ChannelWriteInfo, 0, len(rates)),
+	}
+	for channel, rate := range rates {
+		ret.Channels = append(ret.Channels, &metricsinfo.ChannelWriteInfo{
+			Name:          channel,
+			RowsPerSecond: rate,
+			Hot:           hot[channel],
+		})
+	}
+	sort.Slice(ret.Channels, func(i, j int) bool {
+		if ret.Channels[i].RowsPerSecond != ret.Channels[j].RowsPerSecond {
+			return ret.Channels[i].RowsPerSecond > ret.Channels[j].RowsPerSecond
+		}
+		return ret.Channels[i].Name < ret.Channels[j].Name
+	})
+	return ret
+}
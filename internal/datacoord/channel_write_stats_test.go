@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelWriteStats(t *testing.T) {
+	now := time.Now()
+	stats := newChannelWriteStats()
+	stats.now = func() time.Time { return now }
+
+	// the rows known before the first report are not counted
+	stats.Record("ch1", 1, 100, 100)
+	stats.Record("ch2", 2, 0, 10)
+	assert.Empty(t, stats.GetRates())
+
+	now = now.Add(10 * time.Second)
+	stats.Record("ch1", 1, 0, 300)
+	// the stale report is ignored
+	stats.Record("ch1", 1, 0, 200)
+	assert.Equal(t, map[string]float64{"ch1": 20, "ch2": 1}, stats.GetRates())
+
+	// the rates are smoothed over the windows
+	now = now.Add(10 * time.Second)
+	stats.Record("ch1", 1, 0, 400)
+	assert.Equal(t, map[string]float64{"ch1": 15, "ch2": 0.5}, stats.GetRates())
+
+	// the flushed segment is reported by the checkpoint once more, then forgotten
+	stats.Record("ch1", 1, 0, 500)
+	stats.RemoveSegment("ch1", 1)
+	stats.Record("ch1", 3, 0, 100)
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, map[string]float64{"ch1": 17.5, "ch2": 0.25}, stats.GetRates())
+
+	stats.RemoveChannel("ch2")
+	assert.Equal(t, map[string]float64{"ch1": 17.5}, stats.GetRates())
+}
+
+func TestHotChannels(t *testing.T) {
+	rates := map[string]float64{"ch1": 100, "ch2": 1, "ch3": 1, "ch4": 0}
+	assert.Equal(t, map[string]bool{"ch1": true}, hotChannels(rates))
+
+	// no hot channel among a single channel or the idle channels
+	assert.Empty(t, hotChannels(map[string]float64{"ch1": 100}))
+	assert.Empty(t, hotChannels(map[string]float64{"ch1": 0, "ch2": 0}))
+
+	Params.Save(Params.DataCoordCfg.HotChannelWriteRateRatio.Key, "0")
+	defer Params.Reset(Params.DataCoordCfg.HotChannelWriteRateRatio.Key)
+	assert.Empty(t, hotChannels(rates))
+}
+
+func TestChannelWriteStatsGetMetrics(t *testing.T) {
+	now := time.Now()
+	stats := newChannelWriteStats()
+	stats.now = func() time.Time { return now }
+	for i, channel := range []string{"ch1", "ch2", "ch3", "ch4"} {
+		stats.Record(channel, int64(i), 0, 0)
+	}
+	now = now.Add(10 * time.Second)
+	stats.Record("ch2", 1, 0, 1000)
+	stats.Record("ch3", 2, 0, 10)
+
+	ret := stats.GetMetrics()
+	assert.Equal(t, 4, len(ret.Channels))
+	assert.Equal(t, "ch2", ret.Channels[0].Name)
+	assert.Equal(t, 100.0, ret.Channels[0].RowsPerSecond)
+	assert.True(t, ret.Channels[0].Hot)
+	assert.Equal(t, "ch3", ret.Channels[1].Name)
+	assert.False(t, ret.Channels[1].Hot)
+	assert.Equal(t, "ch1", ret.Channels[2].Name)
+	assert.Equal(t, map[string]bool{"ch2": true}, stats.GetHotChannels())
+}
+
+func TestChannelWriteStatsGetHotChannels(t *testing.T) {
+	now := time.Now()
+	stats := newChannelWriteStats()
+	stats.now = func() time.Time { return now }
+	for i, channel := range []string{"ch1", "ch2", "ch3", "ch4"} {
+		stats.Record(channel, int64(i), 0, 0)
+	}
+	now = now.Add(5 * time.Second)
+	assert.Empty(t, stats.GetHotChannels())
+
+	// the hot channels are kept in the round even if the rates are sampled
+	now = now.Add(5 * time.Second)
+	stats.Record("ch2", 1, 0, 1000)
+	assert.Empty(t, stats.GetHotChannels())
+
+	// and detected again in the next round
+	now = now.Add(5 * time.Second)
+	assert.Equal(t, map[string]bool{"ch2": true}, stats.GetHotChannels())
+}
@@ -46,6 +46,8 @@ type Cluster interface {
 	QueryPreImport(nodeID int64, in *datapb.QueryPreImportRequest) (*datapb.QueryPreImportResponse, error)
 	QueryImport(nodeID int64, in *datapb.QueryImportRequest) (*datapb.QueryImportResponse, error)
 	DropImport(nodeID int64, in *datapb.DropImportRequest) error
+	ExportV2(nodeID int64, in *datapb.ExportRequest) error
+	QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error)
 	QuerySlots() map[int64]int64
 	GetSessions() []*Session
 	Close()
@@ -179,6 +181,14 @@ func (c *ClusterImpl) DropImport(nodeID int64, in *datapb.DropImportRequest) err
 	return c.sessionManager.DropImport(nodeID, in)
 }
 
+func (c *ClusterImpl) ExportV2(nodeID int64, in *datapb.ExportRequest) error {
+	return c.sessionManager.ExportV2(nodeID, in)
+}
+
+func (c *ClusterImpl) QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	return c.sessionManager.QueryExport(nodeID, in)
+}
+
 func (c *ClusterImpl) QuerySlots() map[int64]int64 {
 	nodeIDs := c.sessionManager.GetSessionIDs()
 	nodeSlots := make(map[int64]int64)
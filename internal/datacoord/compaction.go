@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/conc"
 	"github.com/milvus-io/milvus/pkg/util/lock"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -52,6 +53,10 @@ type compactionPlanContext interface {
 	getCompactionTasksNumBySignalID(signalID int64) int
 	getCompactionInfo(signalID int64) *compactionInfo
 	removeTasksByChannel(channel string)
+	// getDatabaseSlotUsage returns the slots taken by the compaction tasks assigned to the datanodes of each database
+	getDatabaseSlotUsage() map[string]int64
+	// getCompactionPlanDetails returns the details of the compaction plans matching the request
+	getCompactionPlanDetails(req *datapb.GetCompactionPlanDetailsRequest) []*datapb.CompactionPlanDetail
 }
 
 var (
@@ -85,6 +90,10 @@ type compactionPlanHandler struct {
 	cluster          Cluster
 	analyzeScheduler *taskScheduler
 	handler          Handler
+	// databaseQuotas limits the compaction slots taken by each database, nothing is limited if it's nil
+	databaseQuotas *databaseQuotas
+	// history retains the details of the latest finished plans
+	history *compactionHistory
 
 	stopCh   chan struct{}
 	stopOnce sync.Once
@@ -190,6 +199,7 @@ func newCompactionPlanHandler(cluster Cluster, sessions SessionManager, cm Chann
 		taskNumber:       atomic.NewInt32(0),
 		analyzeScheduler: analyzeScheduler,
 		handler:          handler,
+		history:          newCompactionHistory(),
 	}
 }
 
@@ -550,6 +560,10 @@ func (c *compactionPlanHandler) getCompactionTask(planID int64) CompactionTask {
 
 func (c *compactionPlanHandler) enqueueCompaction(task *datapb.CompactionTask) error {
 	log := log.With(zap.Int64("planID", task.GetPlanID()), zap.Int64("triggerID", task.GetTriggerID()), zap.Int64("collectionID", task.GetCollectionID()), zap.String("type", task.GetType().String()))
+	// the segments of the secondary region are shipped from the primary region, which compacts them
+	if Params.CommonCfg.RegionReplicaRole.GetValue() == util.RegionReplicaRoleSecondary {
+		return merr.WrapErrServiceUnavailable("compaction is disabled in the secondary region")
+	}
 	t, err := c.createCompactTask(task)
 	if err != nil {
 		// Conflict is normal
@@ -612,22 +626,53 @@ func (c *compactionPlanHandler) assignNodeIDs(tasks []CompactionTask) {
 		return
 	}
 
-	for _, t := range tasks {
+	var dbUsage map[string]int64
+	if c.databaseQuotas != nil {
+		dbUsage = c.getDatabaseSlotUsage()
+	}
+	ioBudget := getCompactionIOBudget()
+	ioUsage, runningPlans := c.getIORateUsage()
+	for i, t := range tasks {
+		var dbName string
+		if c.databaseQuotas != nil {
+			dbName = c.getDatabase(t.GetCollectionID())
+		}
+		if maxSlots := c.databaseQuotas.get(dbName).compactionSlots; maxSlots > 0 &&
+			dbUsage[dbName]+getCompactionSlotUsage(t) > maxSlots {
+			log.Info("database of the compaction task reaches the compaction slots quota, wait for the next round",
+				zap.Int64("planID", t.GetPlanID()), zap.String("dbName", dbName),
+				zap.Int64("usedSlots", dbUsage[dbName]), zap.Int64("maxSlots", maxSlots))
+			continue
+		}
+		var ioRate int64
+		if ioBudget > 0 {
+			ioRate = allocateIORate(ioBudget, ioUsage, runningPlans+len(tasks)-i)
+			if ioRate == 0 {
+				log.Info("compaction I/O budget is exhausted, wait for the next round",
+					zap.Int64("planID", t.GetPlanID()), zap.Int64("allocatedIORate", ioUsage), zap.Int64("ioBudget", ioBudget))
+				continue
+			}
+		}
 		nodeID, useSlot := c.pickAnyNode(slots, t)
 		if nodeID == NullNodeID {
 			log.Info("compactionHandler cannot find datanode for compaction task",
 				zap.Int64("planID", t.GetPlanID()), zap.String("type", t.GetType().String()), zap.String("vchannel", t.GetChannel()))
 			continue
 		}
-		err := t.SetNodeID(nodeID)
+		err := t.SetNodeID(nodeID, setIORateLimit(ioRate))
 		if err != nil {
 			log.Info("compactionHandler assignNodeID failed",
 				zap.Int64("planID", t.GetPlanID()), zap.String("vchannel", t.GetChannel()), zap.Error(err))
 		} else {
 			// update the input nodeSlots
 			slots[nodeID] = slots[nodeID] - useSlot
+			if dbUsage != nil {
+				dbUsage[dbName] += useSlot
+			}
+			ioUsage += ioRate
+			runningPlans++
 			log.Info("compactionHandler assignNodeID success",
-				zap.Int64("planID", t.GetPlanID()), zap.String("vchannel", t.GetChannel()), zap.Any("nodeID", nodeID))
+				zap.Int64("planID", t.GetPlanID()), zap.String("vchannel", t.GetChannel()), zap.Any("nodeID", nodeID), zap.Int64("ioRateLimit", ioRate))
 			metrics.DataCoordCompactionTaskNum.WithLabelValues(fmt.Sprintf("%d", NullNodeID), t.GetType().String(), metrics.Executing).Dec()
 			metrics.DataCoordCompactionTaskNum.WithLabelValues(fmt.Sprintf("%d", t.GetNodeID()), t.GetType().String(), metrics.Executing).Inc()
 		}
@@ -669,21 +714,90 @@ func (c *compactionPlanHandler) checkCompaction() error {
 	}
 	c.executingGuard.Unlock()
 	c.taskNumber.Sub(int32(len(finishedTasks)))
+	ioUsage, _ := c.getIORateUsage()
+	metrics.DataCoordCompactionIORateAllocated.Set(float64(ioUsage))
+
+	finishTime := time.Now().Unix()
+	for _, t := range finishedTasks {
+		c.history.record(newCompactionPlanDetail(c.meta, t.ShadowClone(), t.GetResult(), finishTime))
+	}
 	return nil
 }
 
-func (c *compactionPlanHandler) pickAnyNode(nodeSlots map[int64]int64, task CompactionTask) (nodeID int64, useSlot int64) {
-	nodeID = NullNodeID
-	var maxSlots int64 = -1
-
+// getCompactionSlotUsage returns the datanode slots taken by the compaction task.
+func getCompactionSlotUsage(task CompactionTask) int64 {
 	switch task.GetType() {
 	case datapb.CompactionType_ClusteringCompaction:
-		useSlot = paramtable.Get().DataCoordCfg.ClusteringCompactionSlotUsage.GetAsInt64()
+		return paramtable.Get().DataCoordCfg.ClusteringCompactionSlotUsage.GetAsInt64()
 	case datapb.CompactionType_MixCompaction:
-		useSlot = paramtable.Get().DataCoordCfg.MixCompactionSlotUsage.GetAsInt64()
+		return paramtable.Get().DataCoordCfg.MixCompactionSlotUsage.GetAsInt64()
 	case datapb.CompactionType_Level0DeleteCompaction:
-		useSlot = paramtable.Get().DataCoordCfg.L0DeleteCompactionSlotUsage.GetAsInt64()
+		return paramtable.Get().DataCoordCfg.L0DeleteCompactionSlotUsage.GetAsInt64()
+	}
+	return 0
+}
+
+// getDatabase returns the database of the collection, empty if the collection is not cached.
+func (c *compactionPlanHandler) getDatabase(collectionID int64) string {
+	coll := c.meta.GetCollection(collectionID)
+	if coll == nil {
+		return ""
+	}
+	return coll.DatabaseName
+}
+
+func (c *compactionPlanHandler) getDatabaseSlotUsage() map[string]int64 {
+	c.executingGuard.RLock()
+	defer c.executingGuard.RUnlock()
+
+	usage := make(map[string]int64)
+	for _, t := range c.executingTasks {
+		if t.GetNodeID() == 0 || t.GetNodeID() == NullNodeID {
+			continue
+		}
+		usage[c.getDatabase(t.GetCollectionID())] += getCompactionSlotUsage(t)
+	}
+	return usage
+}
+
+// getIORateUsage returns the I/O rate allocated to the compaction tasks assigned to the datanodes and the number of them.
+func (c *compactionPlanHandler) getIORateUsage() (int64, int) {
+	c.executingGuard.RLock()
+	defer c.executingGuard.RUnlock()
+
+	var usage int64
+	var num int
+	for _, t := range c.executingTasks {
+		if t.GetNodeID() == 0 || t.GetNodeID() == NullNodeID {
+			continue
+		}
+		usage += t.GetIoRateLimit()
+		num++
 	}
+	return usage, num
+}
+
+// getCompactionIOBudget returns the read and write bytes per second shared by all the running compaction plans, 0 means no limit.
+func getCompactionIOBudget() int64 {
+	return int64(Params.DataCoordCfg.CompactionIOBudget.GetAsFloat() * 1024 * 1024)
+}
+
+// allocateIORate allocates the I/O rate to a compaction plan from the budget left, the budget is shared evenly by
+// the plans running and waiting, 0 is returned if the budget left is less than the minimum rate of a plan.
+func allocateIORate(budget, used int64, plans int) int64 {
+	minRate := max(int64(Params.DataCoordCfg.CompactionMinIORatePerPlan.GetAsFloat()*1024*1024), 1)
+	left := budget - used
+	if left < minRate {
+		return 0
+	}
+	return min(max(budget/int64(max(plans, 1)), minRate), left)
+}
+
+func (c *compactionPlanHandler) pickAnyNode(nodeSlots map[int64]int64, task CompactionTask) (nodeID int64, useSlot int64) {
+	nodeID = NullNodeID
+	var maxSlots int64 = -1
+
+	useSlot = getCompactionSlotUsage(task)
 
 	for id, slots := range nodeSlots {
 		if slots >= useSlot && slots > maxSlots {
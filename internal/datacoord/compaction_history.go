@@ -0,0 +1,176 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sort"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/lock"
+)
+
+// compactionHistory retains the details of the latest finished compaction plans in memory,
+// the oldest ones are evicted once the number exceeds dataCoord.compaction.historySize.
+type compactionHistory struct {
+	mu    lock.RWMutex
+	plans []*datapb.CompactionPlanDetail // ordered by the time they finished
+}
+
+func newCompactionHistory() *compactionHistory {
+	return &compactionHistory{
+		plans: make([]*datapb.CompactionPlanDetail, 0),
+	}
+}
+
+func (h *compactionHistory) record(plan *datapb.CompactionPlanDetail) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.plans = append(h.plans, plan)
+	size := Params.DataCoordCfg.CompactionHistorySize.GetAsInt()
+	if size < 0 {
+		size = 0
+	}
+	if len(h.plans) > size {
+		h.plans = append(make([]*datapb.CompactionPlanDetail, 0, size), h.plans[len(h.plans)-size:]...)
+	}
+}
+
+func (h *compactionHistory) list() []*datapb.CompactionPlanDetail {
+	if h == nil {
+		return nil
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return append([]*datapb.CompactionPlanDetail(nil), h.plans...)
+}
+
+// isCompactionTaskFinished returns true if the task will not be processed any more.
+func isCompactionTaskFinished(state datapb.CompactionTaskState) bool {
+	return state == datapb.CompactionTaskState_completed || state == datapb.CompactionTaskState_failed ||
+		state == datapb.CompactionTaskState_timeout || state == datapb.CompactionTaskState_cleaned
+}
+
+// newCompactionPlanDetail builds the detail of the plan of the task, the end time in unix seconds is 0 if unknown,
+// the result segments are taken from the result reported by the datanode if any.
+func newCompactionPlanDetail(mt CompactionMeta, task *datapb.CompactionTask, result *datapb.CompactionPlanResult, endTime int64) *datapb.CompactionPlanDetail {
+	detail := &datapb.CompactionPlanDetail{
+		PlanID:        task.GetPlanID(),
+		CompactionID:  task.GetTriggerID(),
+		CollectionID:  task.GetCollectionID(),
+		PartitionID:   task.GetPartitionID(),
+		Channel:       task.GetChannel(),
+		Type:          task.GetType(),
+		State:         task.GetState(),
+		NodeID:        task.GetNodeID(),
+		InputSegments: task.GetInputSegments(),
+		StartTime:     task.GetStartTime(),
+		EndTime:       endTime,
+		FailReason:    task.GetFailReason(),
+	}
+	for _, segmentID := range task.GetInputSegments() {
+		if segment := mt.GetSegment(segmentID); segment != nil {
+			detail.BytesRead += segment.getSegmentSize()
+		}
+	}
+
+	if len(result.GetSegments()) > 0 {
+		// the bytes written by the datanode, the deltalogs only for the level zero compaction
+		for _, segment := range result.GetSegments() {
+			detail.ResultSegments = append(detail.ResultSegments, segment.GetSegmentID())
+			for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetInsertLogs(), segment.GetField2StatslogPaths(), segment.GetDeltalogs()} {
+				for _, fieldBinlog := range fieldBinlogs {
+					for _, binlog := range fieldBinlog.GetBinlogs() {
+						detail.BytesWritten += binlog.GetMemorySize()
+					}
+				}
+			}
+		}
+	} else if task.GetState() == datapb.CompactionTaskState_completed {
+		// the result is not retained after restart
+		detail.ResultSegments = task.GetResultSegments()
+		for _, segmentID := range task.GetResultSegments() {
+			if segment := mt.GetSegment(segmentID); segment != nil {
+				detail.BytesWritten += segment.getSegmentSize()
+			}
+		}
+	}
+
+	if task.GetStartTime() > 0 {
+		end := time.Now()
+		if endTime > 0 {
+			end = time.Unix(endTime, 0)
+		}
+		if endTime > 0 || !isCompactionTaskFinished(task.GetState()) {
+			detail.DurationMs = end.Sub(time.Unix(task.GetStartTime(), 0)).Milliseconds()
+		}
+	}
+	return detail
+}
+
+// matchCompactionPlan returns true if the plan matches the filters of the request,
+// the plan still running lasts until now.
+func matchCompactionPlan(plan *datapb.CompactionPlanDetail, req *datapb.GetCompactionPlanDetailsRequest) bool {
+	if req.GetCompactionID() != 0 && plan.GetCompactionID() != req.GetCompactionID() {
+		return false
+	}
+	if req.GetCollectionID() != 0 && plan.GetCollectionID() != req.GetCollectionID() {
+		return false
+	}
+	end := plan.GetEndTime()
+	if end == 0 {
+		end = time.Now().Unix()
+	}
+	if req.GetStartTime() != 0 && end < req.GetStartTime() {
+		return false
+	}
+	if req.GetEndTime() != 0 && plan.GetStartTime() > req.GetEndTime() {
+		return false
+	}
+	return true
+}
+
+// getCompactionPlanDetails returns the details of the plans in the meta and the finished ones retained in the history.
+func (c *compactionPlanHandler) getCompactionPlanDetails(req *datapb.GetCompactionPlanDetailsRequest) []*datapb.CompactionPlanDetail {
+	details := make(map[int64]*datapb.CompactionPlanDetail)
+	for _, tasks := range c.meta.GetCompactionTasks() {
+		for _, task := range tasks {
+			if req.GetCompactionID() != 0 && task.GetTriggerID() != req.GetCompactionID() ||
+				req.GetCollectionID() != 0 && task.GetCollectionID() != req.GetCollectionID() {
+				continue
+			}
+			// the finished plans not in the history, e.g. finished before restart
+			details[task.GetPlanID()] = newCompactionPlanDetail(c.meta, task, nil, task.GetEndTime())
+		}
+	}
+	for _, plan := range c.history.list() {
+		details[plan.GetPlanID()] = plan
+	}
+
+	plans := lo.Filter(lo.Values(details), func(plan *datapb.CompactionPlanDetail, _ int) bool {
+		return matchCompactionPlan(plan, req)
+	})
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].GetPlanID() < plans[j].GetPlanID()
+	})
+	return plans
+}
@@ -0,0 +1,138 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestCompactionHistory(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.DataCoordCfg.CompactionHistorySize.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionHistorySize.Key)
+
+	var history *compactionHistory
+	history.record(&datapb.CompactionPlanDetail{PlanID: 1})
+	assert.Empty(t, history.list())
+
+	history = newCompactionHistory()
+	for i := int64(1); i <= 3; i++ {
+		history.record(&datapb.CompactionPlanDetail{PlanID: i})
+	}
+	// the oldest one is evicted
+	assert.Equal(t, []int64{2, 3}, lo.Map(history.list(), func(plan *datapb.CompactionPlanDetail, _ int) int64 {
+		return plan.GetPlanID()
+	}))
+
+	paramtable.Get().Save(Params.DataCoordCfg.CompactionHistorySize.Key, "0")
+	history.record(&datapb.CompactionPlanDetail{PlanID: 4})
+	assert.Empty(t, history.list())
+}
+
+func TestMatchCompactionPlan(t *testing.T) {
+	now := time.Now().Unix()
+	finished := &datapb.CompactionPlanDetail{CompactionID: 1, CollectionID: 10, StartTime: now - 100, EndTime: now - 50}
+	running := &datapb.CompactionPlanDetail{CompactionID: 2, CollectionID: 20, StartTime: now - 10}
+
+	assert.True(t, matchCompactionPlan(finished, &datapb.GetCompactionPlanDetailsRequest{}))
+	assert.True(t, matchCompactionPlan(finished, &datapb.GetCompactionPlanDetailsRequest{CompactionID: 1, CollectionID: 10}))
+	assert.False(t, matchCompactionPlan(finished, &datapb.GetCompactionPlanDetailsRequest{CompactionID: 2}))
+	assert.False(t, matchCompactionPlan(finished, &datapb.GetCompactionPlanDetailsRequest{CollectionID: 20}))
+
+	// the plans running at any moment in the time range
+	assert.True(t, matchCompactionPlan(finished, &datapb.GetCompactionPlanDetailsRequest{StartTime: now - 60, EndTime: now}))
+	assert.False(t, matchCompactionPlan(finished, &datapb.GetCompactionPlanDetailsRequest{StartTime: now - 40}))
+	assert.False(t, matchCompactionPlan(finished, &datapb.GetCompactionPlanDetailsRequest{EndTime: now - 200}))
+	assert.True(t, matchCompactionPlan(running, &datapb.GetCompactionPlanDetailsRequest{StartTime: now - 5}))
+	assert.False(t, matchCompactionPlan(running, &datapb.GetCompactionPlanDetailsRequest{EndTime: now - 20}))
+}
+
+func TestCompactionPlanHandlerGetPlanDetails(t *testing.T) {
+	paramtable.Init()
+	now := time.Now().Unix()
+
+	m := &meta{
+		segments:           NewSegmentsInfo(),
+		compactionTaskMeta: newTestCompactionTaskMeta(t),
+	}
+	for _, segmentID := range []int64{100, 101, 102} {
+		m.segments.SetSegment(segmentID, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:      segmentID,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []*datapb.Binlog{{MemorySize: 1024}}}},
+		}))
+	}
+	// running
+	m.compactionTaskMeta.SaveCompactionTask(&datapb.CompactionTask{
+		TriggerID: 1, PlanID: 10, CollectionID: 1, NodeID: 2, Type: datapb.CompactionType_MixCompaction,
+		State: datapb.CompactionTaskState_executing, StartTime: now - 10, InputSegments: []int64{100, 101},
+	})
+	// finished before restart, not in the history
+	m.compactionTaskMeta.SaveCompactionTask(&datapb.CompactionTask{
+		TriggerID: 1, PlanID: 11, CollectionID: 1, NodeID: 2, Type: datapb.CompactionType_ClusteringCompaction,
+		State: datapb.CompactionTaskState_completed, StartTime: now - 100, EndTime: now - 50,
+		InputSegments: []int64{100}, ResultSegments: []int64{102},
+	})
+	// finished and retained in the history
+	m.compactionTaskMeta.SaveCompactionTask(&datapb.CompactionTask{
+		TriggerID: 2, PlanID: 12, CollectionID: 2, NodeID: 3, Type: datapb.CompactionType_MixCompaction,
+		State: datapb.CompactionTaskState_completed, StartTime: now - 100, InputSegments: []int64{101},
+	})
+
+	handler := newCompactionPlanHandler(nil, nil, nil, m, nil, nil, nil)
+	handler.history.record(&datapb.CompactionPlanDetail{
+		PlanID: 12, CompactionID: 2, CollectionID: 2, NodeID: 3, State: datapb.CompactionTaskState_completed,
+		StartTime: now - 100, EndTime: now - 90, DurationMs: 10000, BytesRead: 1024, BytesWritten: 512,
+	})
+	// the meta of the task is dropped
+	handler.history.record(&datapb.CompactionPlanDetail{
+		PlanID: 9, CompactionID: 3, CollectionID: 1, State: datapb.CompactionTaskState_failed,
+		StartTime: now - 1000, EndTime: now - 900,
+	})
+
+	plans := handler.getCompactionPlanDetails(&datapb.GetCompactionPlanDetailsRequest{})
+	assert.Equal(t, []int64{9, 10, 11, 12}, lo.Map(plans, func(plan *datapb.CompactionPlanDetail, _ int) int64 {
+		return plan.GetPlanID()
+	}))
+
+	running := plans[1]
+	assert.EqualValues(t, 2, running.GetNodeID())
+	assert.EqualValues(t, 2048, running.GetBytesRead())
+	assert.Zero(t, running.GetEndTime())
+	assert.GreaterOrEqual(t, running.GetDurationMs(), int64(10000))
+
+	finished := plans[2]
+	assert.Equal(t, []int64{102}, finished.GetResultSegments())
+	assert.EqualValues(t, 1024, finished.GetBytesRead())
+	assert.EqualValues(t, 1024, finished.GetBytesWritten())
+	assert.EqualValues(t, 50000, finished.GetDurationMs())
+
+	assert.EqualValues(t, 512, plans[3].GetBytesWritten())
+
+	plans = handler.getCompactionPlanDetails(&datapb.GetCompactionPlanDetailsRequest{CollectionID: 1, StartTime: now - 60})
+	assert.Equal(t, []int64{10, 11}, lo.Map(plans, func(plan *datapb.CompactionPlanDetail, _ int) int64 {
+		return plan.GetPlanID()
+	}))
+	plans = handler.getCompactionPlanDetails(&datapb.GetCompactionPlanDetailsRequest{CompactionID: 3})
+	assert.Equal(t, 1, len(plans))
+}
@@ -19,29 +19,49 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
 	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/clusteringpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/util/clustering"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type clusteringCompactionPolicy struct {
 	meta      *meta
 	allocator allocator
 	handler   Handler
+
+	// channel-partition label -> the centroids of the latest analyze task, to avoid reading them every round
+	analyzeCentroids *typeutil.ConcurrentMap[string, *analyzeCentroids]
+}
+
+// analyzeCentroids is the centroids of the vector clustering key computed by an analyze task.
+type analyzeCentroids struct {
+	analyzeTaskID int64
+	centroids     [][]float32
 }
 
 func newClusteringCompactionPolicy(meta *meta, allocator allocator, handler Handler) *clusteringCompactionPolicy {
-	return &clusteringCompactionPolicy{meta: meta, allocator: allocator, handler: handler}
+	return &clusteringCompactionPolicy{
+		meta:             meta,
+		allocator:        allocator,
+		handler:          handler,
+		analyzeCentroids: typeutil.NewConcurrentMap[string, *analyzeCentroids](),
+	}
 }
 
 func (policy *clusteringCompactionPolicy) Enable() bool {
@@ -152,7 +172,7 @@ func (policy *clusteringCompactionPolicy) triggerOneCollection(ctx context.Conte
 		}
 
 		if !manual {
-			execute, err := triggerClusteringCompactionPolicy(ctx, policy.meta, group.collectionID, group.partitionID, group.channelName, group.segments)
+			execute, err := policy.triggerClusteringCompactionPolicy(ctx, group.collectionID, group.partitionID, group.channelName, group.segments, clusteringKeyField)
 			if err != nil {
 				log.Warn("failed to trigger clustering compaction", zap.Error(err))
 				continue
@@ -210,9 +230,11 @@ func calculateClusteringCompactionConfig(view CompactionView) (segmentIDs []int6
 	return
 }
 
-func triggerClusteringCompactionPolicy(ctx context.Context, meta *meta, collectionID int64, partitionID int64, channel string, segments []*SegmentInfo) (bool, error) {
+func (policy *clusteringCompactionPolicy) triggerClusteringCompactionPolicy(ctx context.Context, collectionID int64, partitionID int64, channel string,
+	segments []*SegmentInfo, clusteringKeyField *schemapb.FieldSchema,
+) (bool, error) {
 	log := log.With(zap.Int64("collectionID", collectionID), zap.Int64("partitionID", partitionID))
-	partitionStatsInfos := meta.partitionStatsMeta.ListPartitionStatsInfos(collectionID, partitionID, channel)
+	partitionStatsInfos := policy.meta.partitionStatsMeta.ListPartitionStatsInfos(collectionID, partitionID, channel)
 	sort.Slice(partitionStatsInfos, func(i, j int) bool {
 		return partitionStatsInfos[i].Version > partitionStatsInfos[j].Version
 	})
@@ -244,11 +266,13 @@ func triggerClusteringCompactionPolicy(ctx context.Context, meta *meta, collecti
 
 	var compactedSegmentSize int64 = 0
 	var uncompactedSegmentSize int64 = 0
+	uncompactedSegments := make([]*SegmentInfo, 0)
 	for _, seg := range segments {
 		if lo.Contains(partitionStats.SegmentIDs, seg.ID) {
 			compactedSegmentSize += seg.getSegmentSize()
 		} else {
 			uncompactedSegmentSize += seg.getSegmentSize()
+			uncompactedSegments = append(uncompactedSegments, seg)
 		}
 	}
 
@@ -257,10 +281,126 @@ func triggerClusteringCompactionPolicy(ctx context.Context, meta *meta, collecti
 		log.Info("New data is larger than threshold, do compaction", zap.Int64("newDataSize", uncompactedSegmentSize))
 		return true, nil
 	}
+
+	// drift based
+	driftThreshold := Params.DataCoordCfg.ClusteringCompactionDriftThreshold.GetAsFloat()
+	if driftThreshold > 0 && len(uncompactedSegments) > 0 && clusteringKeyField.GetDataType() == schemapb.DataType_FloatVector {
+		drift, ok := policy.getClusteringDrift(ctx, partitionStats, uncompactedSegments, clusteringKeyField.GetFieldID())
+		if ok && drift > driftThreshold {
+			log.Info("New data drifts from the analyze result, do compaction", zap.Float64("drift", drift),
+				zap.Int64("newDataSize", uncompactedSegmentSize))
+			return true, nil
+		}
+	}
 	log.Info("New data is smaller than threshold, skip compaction", zap.Int64("newDataSize", uncompactedSegmentSize))
 	return false, nil
 }
 
+// getClusteringDrift returns how far the center of the new data drifts from the center of the centroids computed by
+// the analyze task of the partition stats, in the unit of the mean distance from the centroids to their center.
+// The center of the new data is the mean of the segment centroids weighted by the rows, the segments whose stats
+// have not been computed are ignored. ok is false if the drift is unknown.
+func (policy *clusteringCompactionPolicy) getClusteringDrift(ctx context.Context, partitionStats *datapb.PartitionStatsInfo,
+	segments []*SegmentInfo, fieldID int64,
+) (drift float64, ok bool) {
+	log := log.With(zap.Int64("collectionID", partitionStats.GetCollectionID()), zap.Int64("partitionID", partitionStats.GetPartitionID()),
+		zap.String("channel", partitionStats.GetVChannel()), zap.Int64("analyzeTaskID", partitionStats.GetAnalyzeTaskID()))
+	if partitionStats.GetAnalyzeTaskID() == 0 {
+		return 0, false
+	}
+	centroids, err := policy.getAnalyzeCentroids(ctx, partitionStats)
+	if err != nil {
+		log.Warn("failed to get the centroids of the analyze task", zap.Error(err))
+		return 0, false
+	}
+	if len(centroids) < 2 {
+		return 0, false
+	}
+	dim := len(centroids[0])
+
+	// the analyzed centroids are weighted equally as their sizes are unknown
+	baseline := make([]float64, dim)
+	for _, centroid := range centroids {
+		for i, v := range centroid {
+			baseline[i] += float64(v) / float64(len(centroids))
+		}
+	}
+	var radius float64
+	for _, centroid := range centroids {
+		radius += l2Distance(centroid, baseline) / float64(len(centroids))
+	}
+	if radius == 0 {
+		return 0, false
+	}
+
+	current := make([]float64, dim)
+	var totalRows int64
+	for _, segment := range segments {
+		fieldStats, found := lo.Find(segment.GetFieldStats(), func(stats *indexpb.FieldStats) bool {
+			return stats.GetFieldID() == fieldID
+		})
+		if !found || len(fieldStats.GetCentroid()) != dim || segment.GetNumOfRows() == 0 {
+			continue
+		}
+		for i, v := range fieldStats.GetCentroid() {
+			current[i] += float64(v) * float64(segment.GetNumOfRows())
+		}
+		totalRows += segment.GetNumOfRows()
+	}
+	if totalRows == 0 {
+		return 0, false
+	}
+	currentCenter := make([]float32, dim)
+	for i := range current {
+		currentCenter[i] = float32(current[i] / float64(totalRows))
+	}
+	drift = l2Distance(currentCenter, baseline) / radius
+	log.Debug("clustering drift of the new data", zap.Float64("drift", drift), zap.Int64("newRows", totalRows))
+	return drift, true
+}
+
+// getAnalyzeCentroids returns the centroids computed by the analyze task of the partition stats.
+func (policy *clusteringCompactionPolicy) getAnalyzeCentroids(ctx context.Context, partitionStats *datapb.PartitionStatsInfo) ([][]float32, error) {
+	label := fmt.Sprintf("%d-%d-%s", partitionStats.GetCollectionID(), partitionStats.GetPartitionID(), partitionStats.GetVChannel())
+	if cached, ok := policy.analyzeCentroids.Get(label); ok && cached.analyzeTaskID == partitionStats.GetAnalyzeTaskID() {
+		return cached.centroids, nil
+	}
+
+	analyzeTask := policy.meta.GetAnalyzeMeta().GetTask(partitionStats.GetAnalyzeTaskID())
+	if analyzeTask == nil || analyzeTask.GetCentroidsFile() == "" {
+		return nil, merr.WrapErrAnalyzeTaskNotFound(partitionStats.GetAnalyzeTaskID())
+	}
+	if policy.meta.chunkManager == nil {
+		return nil, merr.WrapErrServiceInternal("no chunk manager to read the centroids")
+	}
+	data, err := policy.meta.chunkManager.Read(ctx, analyzeTask.GetCentroidsFile())
+	if err != nil {
+		return nil, err
+	}
+	stats := &clusteringpb.ClusteringCentroidsStats{}
+	if err := proto.Unmarshal(data, stats); err != nil {
+		return nil, err
+	}
+	centroids := make([][]float32, 0, len(stats.GetCentroids()))
+	for _, centroid := range stats.GetCentroids() {
+		centroids = append(centroids, centroid.GetFloatVector().GetData())
+	}
+	policy.analyzeCentroids.Insert(label, &analyzeCentroids{
+		analyzeTaskID: partitionStats.GetAnalyzeTaskID(),
+		centroids:     centroids,
+	})
+	return centroids, nil
+}
+
+func l2Distance(vector []float32, center []float64) float64 {
+	var sum float64
+	for i, v := range vector {
+		d := float64(v) - center[i]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
 var _ CompactionView = (*ClusteringSegmentsView)(nil)
 
 type ClusteringSegmentsView struct {
@@ -18,12 +18,19 @@ package datacoord
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/clusteringpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
 func TestClusteringCompactionPolicySuite(t *testing.T) {
@@ -182,3 +189,73 @@ func (s *ClusteringCompactionPolicySuite) TestCollectionIsClusteringCompacting()
 		}
 	})
 }
+
+func (s *ClusteringCompactionPolicySuite) TestTriggerByClusteringDrift() {
+	centroids := &clusteringpb.ClusteringCentroidsStats{}
+	for _, centroid := range [][]float32{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+		centroids.Centroids = append(centroids.Centroids, &schemapb.VectorField{
+			Dim:  2,
+			Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: centroid}},
+		})
+	}
+	centroidsBytes, err := proto.Marshal(centroids)
+	s.Require().NoError(err)
+	cm := mocks.NewChunkManager(s.T())
+	cm.EXPECT().Read(mock.Anything, "centroids").Return(centroidsBytes, nil).Once()
+
+	partitionStats := &datapb.PartitionStatsInfo{
+		CollectionID:  1,
+		PartitionID:   10,
+		VChannel:      "ch-1",
+		Version:       int64(tsoutil.ComposeTSByTime(time.Now().Add(-2*time.Hour), 0)),
+		SegmentIDs:    []int64{1},
+		AnalyzeTaskID: 100,
+	}
+	s.clusteringCompactionPolicy.meta = &meta{
+		chunkManager: cm,
+		analyzeMeta: &analyzeMeta{tasks: map[int64]*indexpb.AnalyzeTask{
+			100: {TaskID: 100, State: indexpb.JobState_JobStateFinished, CentroidsFile: "centroids"},
+		}},
+		partitionStatsMeta: &partitionStatsMeta{partitionStatsInfos: map[string]map[int64]*partitionStatsInfo{
+			"ch-1": {10: {infos: map[int64]*datapb.PartitionStatsInfo{partitionStats.GetVersion(): partitionStats}}},
+		}},
+	}
+	newSegment := func(segmentID int64, centroid []float32) *SegmentInfo {
+		return NewSegmentInfo(&datapb.SegmentInfo{
+			ID:         segmentID,
+			NumOfRows:  100,
+			FieldStats: []*indexpb.FieldStats{{FieldID: 101, Centroid: centroid}},
+		})
+	}
+	field := &schemapb.FieldSchema{FieldID: 101, DataType: schemapb.DataType_FloatVector, IsClusteringKey: true}
+
+	// the new data is close to the center of the centroids
+	segments := []*SegmentInfo{newSegment(1, []float32{5, 5}), newSegment(2, []float32{0.2, 0})}
+	drift, ok := s.clusteringCompactionPolicy.getClusteringDrift(context.TODO(), partitionStats, segments[1:], 101)
+	s.True(ok)
+	s.InDelta(0.2, drift, 1e-6)
+	execute, err := s.clusteringCompactionPolicy.triggerClusteringCompactionPolicy(context.TODO(), 1, 10, "ch-1", segments, field)
+	s.NoError(err)
+	s.False(execute)
+
+	// the new data drifts, the centroids are cached
+	segments = append(segments, newSegment(3, []float32{2, 0}))
+	drift, ok = s.clusteringCompactionPolicy.getClusteringDrift(context.TODO(), partitionStats, segments[1:], 101)
+	s.True(ok)
+	s.InDelta(1.1, drift, 1e-6)
+	execute, err = s.clusteringCompactionPolicy.triggerClusteringCompactionPolicy(context.TODO(), 1, 10, "ch-1", segments, field)
+	s.NoError(err)
+	s.True(execute)
+
+	// the drift is unknown without the stats of the new data or the analyze result
+	_, ok = s.clusteringCompactionPolicy.getClusteringDrift(context.TODO(), partitionStats, []*SegmentInfo{newSegment(4, nil)}, 101)
+	s.False(ok)
+	_, ok = s.clusteringCompactionPolicy.getClusteringDrift(context.TODO(), &datapb.PartitionStatsInfo{AnalyzeTaskID: 200}, segments[1:], 101)
+	s.False(ok)
+
+	// the drift is not checked for the scalar clustering key
+	scalarField := &schemapb.FieldSchema{FieldID: 101, DataType: schemapb.DataType_Int64, IsClusteringKey: true}
+	execute, err = s.clusteringCompactionPolicy.triggerClusteringCompactionPolicy(context.TODO(), 1, 10, "ch-1", segments, scalarField)
+	s.NoError(err)
+	s.False(execute)
+}
@@ -0,0 +1,238 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// expiryCompactionPolicy compacts the segments with the rows expired by the collection TTL periodically,
+// each segment is compacted alone to drop its expired rows, and dropped if all of its rows are expired.
+type expiryCompactionPolicy struct {
+	meta      *meta
+	allocator allocator
+	handler   Handler
+
+	mu sync.RWMutex
+	// collectionID -> the latest round of expiry compaction
+	rounds map[int64]*datapb.ExpiryCompactionInfo
+}
+
+func newExpiryCompactionPolicy(meta *meta, allocator allocator, handler Handler) *expiryCompactionPolicy {
+	return &expiryCompactionPolicy{
+		meta:      meta,
+		allocator: allocator,
+		handler:   handler,
+		rounds:    make(map[int64]*datapb.ExpiryCompactionInfo),
+	}
+}
+
+func (policy *expiryCompactionPolicy) Enable() bool {
+	return Params.DataCoordCfg.EnableAutoCompaction.GetAsBool() &&
+		Params.DataCoordCfg.ExpiryCompactionEnable.GetAsBool()
+}
+
+func (policy *expiryCompactionPolicy) Trigger() (map[CompactionTriggerType][]CompactionView, error) {
+	log.Info("start trigger expiryCompactionPolicy...")
+	ctx := context.Background()
+	collections := policy.meta.GetCollections()
+
+	events := make(map[CompactionTriggerType][]CompactionView, 0)
+	views := make([]CompactionView, 0)
+	for _, collection := range collections {
+		collectionViews, err := policy.triggerOneCollection(ctx, collection.ID)
+		if err != nil {
+			// not throw this error because no need to fail because of one collection
+			log.Warn("fail to trigger collection expiry compaction", zap.Int64("collectionID", collection.ID), zap.Error(err))
+		}
+		views = append(views, collectionViews...)
+	}
+	events[TriggerTypeExpiry] = views
+	return events, nil
+}
+
+func (policy *expiryCompactionPolicy) triggerOneCollection(ctx context.Context, collectionID int64) ([]CompactionView, error) {
+	log := log.With(zap.Int64("collectionID", collectionID))
+	collection, err := policy.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		log.Warn("fail to get collection from handler")
+		return nil, err
+	}
+	if collection == nil {
+		log.Warn("collection not exist")
+		return nil, nil
+	}
+	ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), collection)
+	if err != nil {
+		log.Warn("get compact time failed, skip to handle expiry compaction", zap.Error(err))
+		return nil, err
+	}
+	if ct.collectionTTL <= 0 {
+		return nil, nil
+	}
+	if policy.isExpiryCompacting(collectionID) {
+		log.Info("the last round of expiry compaction is executing, skip")
+		return nil, nil
+	}
+
+	ratioThreshold := Params.DataCoordCfg.ExpiryCompactionRatioThreshold.GetAsFloat()
+	var expiredRows int64
+	candidates := make([]*SegmentInfo, 0)
+	segmentExpiredRows := make(map[int64]int64)
+	segments := policy.meta.SelectSegments(WithCollection(collectionID), SegmentFilterFunc(isMixCompactionCandidate))
+	for _, segment := range segments {
+		rows := getExpiredRows(segment, ct.expireTime)
+		if segment.GetNumOfRows() <= 0 || rows == 0 || float64(rows)/float64(segment.GetNumOfRows()) < ratioThreshold {
+			continue
+		}
+		candidates = append(candidates, segment)
+		segmentExpiredRows[segment.GetID()] = rows
+		expiredRows += rows
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	triggerID, err := policy.allocator.allocID(ctx)
+	if err != nil {
+		log.Warn("fail to allocate triggerID", zap.Error(err))
+		return nil, err
+	}
+	views := lo.Map(GetViewsByInfo(candidates...), func(segmentView *SegmentView, _ int) CompactionView {
+		return &ExpirySegmentsView{
+			label:         segmentView.label,
+			segments:      []*SegmentView{segmentView},
+			collectionTTL: ct.collectionTTL,
+			expiredRows:   segmentExpiredRows[segmentView.ID],
+			triggerID:     triggerID,
+		}
+	})
+
+	policy.mu.Lock()
+	policy.rounds[collectionID] = &datapb.ExpiryCompactionInfo{
+		CollectionID: collectionID,
+		CompactionID: triggerID,
+		TriggerTime:  time.Now().UnixMilli(),
+		TtlSeconds:   int64(ct.collectionTTL.Seconds()),
+		NumSegments:  int64(len(candidates)),
+		ExpiredRows:  expiredRows,
+	}
+	policy.mu.Unlock()
+	log.Info("finish trigger collection expiry compaction", zap.Int64("triggerID", triggerID),
+		zap.Int("segmentNum", len(candidates)), zap.Int64("expiredRows", expiredRows))
+	return views, nil
+}
+
+// isExpiryCompacting checks whether the latest round of expiry compaction of the collection is executing.
+func (policy *expiryCompactionPolicy) isExpiryCompacting(collectionID int64) bool {
+	policy.mu.RLock()
+	round, ok := policy.rounds[collectionID]
+	policy.mu.RUnlock()
+	if !ok {
+		return false
+	}
+	tasks := policy.meta.compactionTaskMeta.GetCompactionTasksByTriggerID(round.GetCompactionID())
+	return len(tasks) > 0 && summaryCompactionState(tasks).state == commonpb.CompactionState_Executing
+}
+
+// getRounds returns the latest rounds of expiry compaction of the collections, all the collections if not specified.
+func (policy *expiryCompactionPolicy) getRounds(collectionIDs []int64) []*datapb.ExpiryCompactionInfo {
+	policy.mu.RLock()
+	defer policy.mu.RUnlock()
+
+	if len(collectionIDs) == 0 {
+		collectionIDs = lo.Keys(policy.rounds)
+	}
+	rounds := make([]*datapb.ExpiryCompactionInfo, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		if round, ok := policy.rounds[collectionID]; ok {
+			rounds = append(rounds, round)
+		}
+	}
+	return rounds
+}
+
+// getExpiredRows estimates the expired rows of the segment by the binlogs of the timestamp field,
+// the binlog is expired if its max timestamp is before the expire time.
+func getExpiredRows(segment *SegmentInfo, expireTime Timestamp) int64 {
+	var rows int64
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if fieldBinlog.GetFieldID() != common.TimeStampField {
+			continue
+		}
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampTo() < expireTime {
+				rows += binlog.GetEntriesNum()
+			}
+		}
+	}
+	return rows
+}
+
+// ExpirySegmentsView is a segment with expired rows to be compacted alone.
+type ExpirySegmentsView struct {
+	label         *CompactionGroupLabel
+	segments      []*SegmentView
+	collectionTTL time.Duration
+	expiredRows   int64
+	triggerID     int64
+}
+
+func (v *ExpirySegmentsView) GetGroupLabel() *CompactionGroupLabel {
+	if v == nil {
+		return &CompactionGroupLabel{}
+	}
+	return v.label
+}
+
+func (v *ExpirySegmentsView) GetSegmentsView() []*SegmentView {
+	if v == nil {
+		return nil
+	}
+	return v.segments
+}
+
+func (v *ExpirySegmentsView) Append(segments ...*SegmentView) {
+	v.segments = append(v.segments, segments...)
+}
+
+func (v *ExpirySegmentsView) String() string {
+	strs := lo.Map(v.segments, func(segView *SegmentView, _ int) string {
+		return segView.String()
+	})
+	return fmt.Sprintf("label=<%s>, expiredRows=%d, segments=%v", v.label.String(), v.expiredRows, strs)
+}
+
+func (v *ExpirySegmentsView) Trigger() (CompactionView, string) {
+	return v, fmt.Sprintf("%d rows expired", v.expiredRows)
+}
+
+func (v *ExpirySegmentsView) ForceTrigger() (CompactionView, string) {
+	return v.Trigger()
+}
@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestExpiryCompactionPolicySuite(t *testing.T) {
+	suite.Run(t, new(ExpiryCompactionPolicySuite))
+}
+
+type ExpiryCompactionPolicySuite struct {
+	suite.Suite
+
+	collectionID int64
+	handler      *NMockHandler
+	meta         *meta
+
+	expiryCompactionPolicy *expiryCompactionPolicy
+}
+
+func (s *ExpiryCompactionPolicySuite) genSegment(segmentID int64, state commonpb.SegmentState, expiredRows, freshRows int64) *SegmentInfo {
+	expiredTs := tsoutil.ComposeTSByTime(time.Now().Add(-2*time.Hour), 0)
+	freshTs := tsoutil.ComposeTSByTime(time.Now(), 0)
+	binlogs := make([]*datapb.Binlog, 0)
+	if expiredRows > 0 {
+		binlogs = append(binlogs, &datapb.Binlog{EntriesNum: expiredRows, TimestampTo: expiredTs})
+	}
+	if freshRows > 0 {
+		binlogs = append(binlogs, &datapb.Binlog{EntriesNum: freshRows, TimestampTo: freshTs})
+	}
+	return NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            segmentID,
+		CollectionID:  s.collectionID,
+		PartitionID:   10,
+		InsertChannel: "ch-1",
+		State:         state,
+		Level:         datapb.SegmentLevel_L1,
+		NumOfRows:     expiredRows + freshRows,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: common.TimeStampField, Binlogs: binlogs},
+			{FieldID: common.StartOfUserFieldID, Binlogs: binlogs},
+		},
+	})
+}
+
+func (s *ExpiryCompactionPolicySuite) SetupTest() {
+	s.collectionID = 100
+	s.meta = &meta{
+		segments:           NewSegmentsInfo(),
+		compactionTaskMeta: newTestCompactionTaskMeta(s.T()),
+	}
+	for _, segment := range []*SegmentInfo{
+		s.genSegment(1, commonpb.SegmentState_Flushed, 60, 40),
+		s.genSegment(2, commonpb.SegmentState_Flushed, 10, 90),
+		s.genSegment(3, commonpb.SegmentState_Flushed, 100, 0),
+		s.genSegment(4, commonpb.SegmentState_Growing, 100, 0),
+	} {
+		s.meta.segments.SetSegment(segment.GetID(), segment)
+	}
+	s.handler = NewNMockHandler(s.T())
+	s.expiryCompactionPolicy = newExpiryCompactionPolicy(s.meta, newMockAllocator(), s.handler)
+}
+
+func (s *ExpiryCompactionPolicySuite) TestTriggerOneCollection() {
+	s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(&collectionInfo{
+		ID:         s.collectionID,
+		Schema:     newTestSchema(),
+		Properties: map[string]string{common.CollectionTTLConfigKey: "3600"},
+	}, nil)
+
+	views, err := s.expiryCompactionPolicy.triggerOneCollection(context.TODO(), s.collectionID)
+	s.NoError(err)
+	s.Equal(2, len(views))
+	segmentIDs := make([]int64, 0)
+	for _, view := range views {
+		s.Equal(1, len(view.GetSegmentsView()))
+		segmentIDs = append(segmentIDs, view.GetSegmentsView()[0].ID)
+		outView, reason := view.Trigger()
+		s.Equal(view, outView)
+		s.NotEmpty(reason)
+	}
+	s.ElementsMatch([]int64{1, 3}, segmentIDs)
+
+	rounds := s.expiryCompactionPolicy.getRounds(nil)
+	s.Equal(1, len(rounds))
+	s.Equal(views[0].(*ExpirySegmentsView).triggerID, rounds[0].GetCompactionID())
+	s.Equal(int64(3600), rounds[0].GetTtlSeconds())
+	s.Equal(int64(2), rounds[0].GetNumSegments())
+	s.Equal(int64(160), rounds[0].GetExpiredRows())
+	s.Empty(s.expiryCompactionPolicy.getRounds([]int64{200}))
+
+	// the next round waits for the executing one
+	s.meta.compactionTaskMeta.SaveCompactionTask(&datapb.CompactionTask{
+		TriggerID:    rounds[0].GetCompactionID(),
+		PlanID:       1000,
+		CollectionID: s.collectionID,
+		State:        datapb.CompactionTaskState_executing,
+	})
+	views, err = s.expiryCompactionPolicy.triggerOneCollection(context.TODO(), s.collectionID)
+	s.NoError(err)
+	s.Empty(views)
+}
+
+func (s *ExpiryCompactionPolicySuite) TestTriggerOneCollectionWithoutTTL() {
+	s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(&collectionInfo{
+		ID:     s.collectionID,
+		Schema: newTestSchema(),
+	}, nil)
+
+	views, err := s.expiryCompactionPolicy.triggerOneCollection(context.TODO(), s.collectionID)
+	s.NoError(err)
+	s.Empty(views)
+	s.Empty(s.expiryCompactionPolicy.getRounds(nil))
+}
+
+func (s *ExpiryCompactionPolicySuite) TestTriggerOneCollectionAbnormal() {
+	s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(nil, errors.New("mock error")).Once()
+	views, err := s.expiryCompactionPolicy.triggerOneCollection(context.TODO(), s.collectionID)
+	s.Error(err)
+	s.Nil(views)
+
+	s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(&collectionInfo{
+		ID:         s.collectionID,
+		Properties: map[string]string{common.CollectionTTLConfigKey: "bad_value"},
+	}, nil).Once()
+	views, err = s.expiryCompactionPolicy.triggerOneCollection(context.TODO(), s.collectionID)
+	s.Error(err)
+	s.Nil(views)
+}
+
+func (s *ExpiryCompactionPolicySuite) TestSubmitExpiryView() {
+	collection := &collectionInfo{
+		ID:         s.collectionID,
+		Schema:     newTestSchema(),
+		Properties: map[string]string{common.CollectionTTLConfigKey: "3600"},
+	}
+	s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(collection, nil)
+	planContext := NewMockCompactionPlanContext(s.T())
+	manager := NewCompactionTriggerManager(newMockAllocator(), s.handler, planContext, s.meta)
+
+	views, err := manager.expiryPolicy.triggerOneCollection(context.TODO(), s.collectionID)
+	s.NoError(err)
+	s.Equal(2, len(views))
+
+	triggerID := views[0].(*ExpirySegmentsView).triggerID
+	planContext.EXPECT().enqueueCompaction(mock.Anything).RunAndReturn(func(task *datapb.CompactionTask) error {
+		s.Equal(triggerID, task.GetTriggerID())
+		s.Equal(datapb.CompactionType_MixCompaction, task.GetType())
+		s.Equal(time.Hour.Nanoseconds(), task.GetCollectionTtl())
+		s.Equal(1, len(task.GetInputSegments()))
+		s.Equal([]int64{task.GetPlanID() + 1}, task.GetResultSegments())
+		s.Equal(int64(100), task.GetTotalRows())
+		return nil
+	}).Times(2)
+	manager.notify(context.TODO(), TriggerTypeExpiry, views)
+
+	rounds := manager.GetExpiryCompactions([]int64{s.collectionID})
+	s.Equal(1, len(rounds))
+	s.Equal(triggerID, rounds[0].GetCompactionID())
+}
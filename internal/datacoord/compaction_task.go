@@ -45,9 +45,11 @@ type CompactionTask interface {
 	GetResult() *datapb.CompactionPlanResult
 
 	GetNodeID() UniqueID
+	GetIoRateLimit() int64
 	GetSpan() trace.Span
 	ShadowClone(opts ...compactionTaskOpt) *datapb.CompactionTask
-	SetNodeID(UniqueID) error
+	// SetNodeID assigns the task to the node, the opts are saved along with the node
+	SetNodeID(id UniqueID, opts ...compactionTaskOpt) error
 	SetTask(*datapb.CompactionTask)
 	SetSpan(trace.Span)
 	SetResult(*datapb.CompactionPlanResult)
@@ -65,6 +67,12 @@ func setNodeID(nodeID int64) compactionTaskOpt {
 	}
 }
 
+func setIORateLimit(ioRateLimit int64) compactionTaskOpt {
+	return func(task *datapb.CompactionTask) {
+		task.IoRateLimit = ioRateLimit
+	}
+}
+
 func setFailReason(reason string) compactionTaskOpt {
 	return func(task *datapb.CompactionTask) {
 		task.FailReason = reason
@@ -107,6 +115,12 @@ func setRetryTimes(retryTimes int32) compactionTaskOpt {
 	}
 }
 
+func setAnalyzeTaskID(taskID int64) compactionTaskOpt {
+	return func(task *datapb.CompactionTask) {
+		task.AnalyzeTaskID = taskID
+	}
+}
+
 func setLastStateStartTime(lastStateStartTime int64) compactionTaskOpt {
 	return func(task *datapb.CompactionTask) {
 		task.LastStateStartTime = lastStateStartTime
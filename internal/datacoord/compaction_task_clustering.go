@@ -174,7 +174,8 @@ func (t *clusteringCompactionTask) BuildCompactionRequest() (*datapb.CompactionP
 			Begin: t.GetResultSegments()[0],
 			End:   t.GetResultSegments()[1],
 		},
-		SlotUsage: Params.DataCoordCfg.ClusteringCompactionSlotUsage.GetAsInt64(),
+		SlotUsage:   Params.DataCoordCfg.ClusteringCompactionSlotUsage.GetAsInt64(),
+		IoRateLimit: t.GetIoRateLimit(),
 	}
 	log := log.With(zap.Int64("taskID", t.GetTriggerID()), zap.Int64("planID", plan.GetPlanID()))
 
@@ -183,13 +184,17 @@ func (t *clusteringCompactionTask) BuildCompactionRequest() (*datapb.CompactionP
 		if segInfo == nil {
 			return nil, merr.WrapErrSegmentNotFound(segID)
 		}
+		insertLogs, err := getInsertBinlogs(segInfo)
+		if err != nil {
+			return nil, err
+		}
 		plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
 			SegmentID:           segID,
 			CollectionID:        segInfo.GetCollectionID(),
 			PartitionID:         segInfo.GetPartitionID(),
 			Level:               segInfo.GetLevel(),
 			InsertChannel:       segInfo.GetInsertChannel(),
-			FieldBinlogs:        segInfo.GetBinlogs(),
+			FieldBinlogs:        insertLogs,
 			Field2StatslogPaths: segInfo.GetStatslogs(),
 			Deltalogs:           segInfo.GetDeltalogs(),
 		})
@@ -401,6 +406,14 @@ func (t *clusteringCompactionTask) processFailedOrTimeout() error {
 }
 
 func (t *clusteringCompactionTask) doAnalyze() error {
+	// reuse the centroids if the same segments have been analyzed, e.g. by the previous failed plan
+	if cached := t.meta.GetAnalyzeMeta().GetFinishedTaskBySegments(t.GetCollectionID(),
+		t.GetClusteringKeyField().GetFieldID(), t.GetInputSegments()); cached != nil {
+		log.Info("reuse the result of analyze task", zap.Int64("planID", t.GetPlanID()),
+			zap.Int64("triggerID", t.GetTriggerID()), zap.Int64("id", cached.GetTaskID()))
+		return t.updateAndSaveTaskMeta(setState(datapb.CompactionTaskState_analyzing), setAnalyzeTaskID(cached.GetTaskID()))
+	}
+
 	newAnalyzeTask := &indexpb.AnalyzeTask{
 		CollectionID: t.GetCollectionID(),
 		PartitionID:  t.GetPartitionID(),
@@ -499,6 +512,7 @@ func (t *clusteringCompactionTask) ShadowClone(opts ...compactionTaskOpt) *datap
 		AnalyzeTaskID:      t.GetAnalyzeTaskID(),
 		AnalyzeVersion:     t.GetAnalyzeVersion(),
 		LastStateStartTime: t.GetLastStateStartTime(),
+		IoRateLimit:        t.GetIoRateLimit(),
 	}
 	for _, opt := range opts {
 		opt(taskClone)
@@ -577,8 +591,8 @@ func (t *clusteringCompactionTask) SetTask(ct *datapb.CompactionTask) {
 	t.CompactionTask = ct
 }
 
-func (t *clusteringCompactionTask) SetNodeID(id UniqueID) error {
-	return t.updateAndSaveTaskMeta(setNodeID(id))
+func (t *clusteringCompactionTask) SetNodeID(id UniqueID, opts ...compactionTaskOpt) error {
+	return t.updateAndSaveTaskMeta(append(opts, setNodeID(id))...)
 }
 
 func (t *clusteringCompactionTask) GetLabel() string {
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -212,6 +213,27 @@ func (s *ClusteringCompactionTaskSuite) generateBasicTask() *clusteringCompactio
 	return task
 }
 
+func (s *ClusteringCompactionTaskSuite) TestDoAnalyzeReuseResult() {
+	task := s.generateBasicTask()
+	task.AnalyzeTaskID = 2
+	err := s.meta.GetAnalyzeMeta().AddAnalyzeTask(&indexpb.AnalyzeTask{
+		CollectionID:  1,
+		PartitionID:   10,
+		FieldID:       100,
+		SegmentIDs:    []int64{102, 101},
+		TaskID:        1,
+		State:         indexpb.JobState_JobStateFinished,
+		CentroidsFile: "centroids",
+	})
+	s.NoError(err)
+
+	err = task.doAnalyze()
+	s.NoError(err)
+	s.Equal(datapb.CompactionTaskState_analyzing, task.GetState())
+	s.Equal(int64(1), task.GetAnalyzeTaskID())
+	s.Nil(s.meta.GetAnalyzeMeta().GetTask(2))
+}
+
 func (s *ClusteringCompactionTaskSuite) TestProcessRetryLogic() {
 	task := s.generateBasicTask()
 	task.maxRetryTimes = 3
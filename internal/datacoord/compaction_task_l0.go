@@ -180,6 +180,7 @@ func (t *l0CompactionTask) ShadowClone(opts ...compactionTaskOpt) *datapb.Compac
 		FailReason:       t.GetFailReason(),
 		RetryTimes:       t.GetRetryTimes(),
 		Pos:              t.GetPos(),
+		IoRateLimit:      t.GetIoRateLimit(),
 	}
 	for _, opt := range opts {
 		opt(taskClone)
@@ -249,6 +250,7 @@ func (t *l0CompactionTask) BuildCompactionRequest() (*datapb.CompactionPlan, err
 		Schema:           t.GetSchema(),
 		BeginLogID:       beginLogID,
 		SlotUsage:        Params.DataCoordCfg.L0DeleteCompactionSlotUsage.GetAsInt64(),
+		IoRateLimit:      t.GetIoRateLimit(),
 	}
 
 	log := log.With(zap.Int64("taskID", t.GetTriggerID()), zap.Int64("planID", plan.GetPlanID()))
@@ -387,8 +389,8 @@ func (t *l0CompactionTask) updateAndSaveTaskMeta(opts ...compactionTaskOpt) erro
 	return nil
 }
 
-func (t *l0CompactionTask) SetNodeID(id UniqueID) error {
-	return t.updateAndSaveTaskMeta(setNodeID(id))
+func (t *l0CompactionTask) SetNodeID(id UniqueID, opts ...compactionTaskOpt) error {
+	return t.updateAndSaveTaskMeta(append(opts, setNodeID(id))...)
 }
 
 func (t *l0CompactionTask) saveTaskMeta(task *datapb.CompactionTask) error {
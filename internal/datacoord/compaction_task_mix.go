@@ -234,6 +234,8 @@ func (t *mixCompactionTask) ShadowClone(opts ...compactionTaskOpt) *datapb.Compa
 		FailReason:       t.GetFailReason(),
 		RetryTimes:       t.GetRetryTimes(),
 		Pos:              t.GetPos(),
+		DedupPrimaryKeys: t.GetDedupPrimaryKeys(),
+		IoRateLimit:      t.GetIoRateLimit(),
 	}
 	for _, opt := range opts {
 		opt(taskClone)
@@ -277,8 +279,8 @@ func (t *mixCompactionTask) updateAndSaveTaskMeta(opts ...compactionTaskOpt) err
 	return nil
 }
 
-func (t *mixCompactionTask) SetNodeID(id UniqueID) error {
-	return t.updateAndSaveTaskMeta(setNodeID(id))
+func (t *mixCompactionTask) SetNodeID(id UniqueID, opts ...compactionTaskOpt) error {
+	return t.updateAndSaveTaskMeta(append(opts, setNodeID(id))...)
 }
 
 func (t *mixCompactionTask) GetSpan() trace.Span {
@@ -347,7 +349,9 @@ func (t *mixCompactionTask) BuildCompactionRequest() (*datapb.CompactionPlan, er
 		PreAllocatedSegments: &datapb.IDRange{
 			Begin: t.GetResultSegments()[0],
 		},
-		SlotUsage: Params.DataCoordCfg.MixCompactionSlotUsage.GetAsInt64(),
+		SlotUsage:        Params.DataCoordCfg.MixCompactionSlotUsage.GetAsInt64(),
+		DedupPrimaryKeys: t.GetDedupPrimaryKeys(),
+		IoRateLimit:      t.GetIoRateLimit(),
 	}
 	log := log.With(zap.Int64("taskID", t.GetTriggerID()), zap.Int64("planID", plan.GetPlanID()))
 
@@ -357,13 +361,17 @@ func (t *mixCompactionTask) BuildCompactionRequest() (*datapb.CompactionPlan, er
 		if segInfo == nil {
 			return nil, merr.WrapErrSegmentNotFound(segID)
 		}
+		insertLogs, err := getInsertBinlogs(segInfo)
+		if err != nil {
+			return nil, err
+		}
 		plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
 			SegmentID:           segID,
 			CollectionID:        segInfo.GetCollectionID(),
 			PartitionID:         segInfo.GetPartitionID(),
 			Level:               segInfo.GetLevel(),
 			InsertChannel:       segInfo.GetInsertChannel(),
-			FieldBinlogs:        segInfo.GetBinlogs(),
+			FieldBinlogs:        insertLogs,
 			Field2StatslogPaths: segInfo.GetStatslogs(),
 			Deltalogs:           segInfo.GetDeltalogs(),
 		})
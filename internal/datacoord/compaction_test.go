@@ -414,6 +414,95 @@ func (s *CompactionPlanHandlerSuite) TestPickAnyNodeForClusteringTask() {
 	s.Equal(int64(NullNodeID), node)
 }
 
+func (s *CompactionPlanHandlerSuite) TestAssignNodeIDsWithDatabaseQuota() {
+	s.SetupTest()
+	mixSlot := Params.DataCoordCfg.MixCompactionSlotUsage.GetAsInt64()
+	mt := &meta{
+		collections: newCollectionInfos(map[UniqueID]*collectionInfo{
+			1: {ID: 1, DatabaseName: "db1"},
+			2: {ID: 2, DatabaseName: "db2"},
+		}),
+	}
+	handler := newCompactionPlanHandler(s.cluster, s.mockSessMgr, s.mockCm, mt, s.mockAlloc, nil, nil)
+	expireAt := time.Now().Add(time.Hour)
+	handler.databaseQuotas = &databaseQuotas{databases: map[string]*cachedDatabase{
+		"db1": {quota: databaseQuota{compactionSlots: mixSlot}, expireAt: expireAt},
+		"db2": {expireAt: expireAt},
+	}}
+	newTask := func(planID, collectionID, nodeID int64, state datapb.CompactionTaskState) CompactionTask {
+		return &mixCompactionTask{
+			CompactionTask: &datapb.CompactionTask{
+				PlanID:       planID,
+				CollectionID: collectionID,
+				Type:         datapb.CompactionType_MixCompaction,
+				State:        state,
+				NodeID:       nodeID,
+			},
+			meta: s.mockMeta,
+		}
+	}
+	executing := newTask(1, 1, 100, datapb.CompactionTaskState_executing)
+	pending := newTask(2, 1, NullNodeID, datapb.CompactionTaskState_pipelining)
+	other := newTask(3, 2, NullNodeID, datapb.CompactionTaskState_pipelining)
+	handler.executingTasks = map[int64]CompactionTask{1: executing, 2: pending, 3: other}
+
+	s.cluster.(*MockCluster).EXPECT().QuerySlots().Return(map[int64]int64{100: 100})
+	s.mockMeta.EXPECT().SaveCompactionTask(mock.Anything).Return(nil).Once()
+	handler.assignNodeIDs([]CompactionTask{pending, other})
+
+	// db1 runs out of its compaction slots, db2 is not limited
+	s.EqualValues(NullNodeID, pending.GetNodeID())
+	s.EqualValues(100, other.GetNodeID())
+	s.Equal(map[string]int64{"db1": mixSlot, "db2": mixSlot}, handler.getDatabaseSlotUsage())
+}
+
+func (s *CompactionPlanHandlerSuite) TestAssignNodeIDsWithIOBudget() {
+	s.SetupTest()
+	Params.Save(Params.DataCoordCfg.CompactionIOBudget.Key, "64")
+	defer Params.Reset(Params.DataCoordCfg.CompactionIOBudget.Key)
+
+	newTask := func(planID, nodeID, ioRateLimit int64, state datapb.CompactionTaskState) CompactionTask {
+		return &mixCompactionTask{
+			CompactionTask: &datapb.CompactionTask{
+				PlanID:      planID,
+				Type:        datapb.CompactionType_MixCompaction,
+				State:       state,
+				NodeID:      nodeID,
+				IoRateLimit: ioRateLimit,
+			},
+			meta: s.mockMeta,
+		}
+	}
+	executing := newTask(1, 100, 48*1024*1024, datapb.CompactionTaskState_executing)
+	pending := newTask(2, NullNodeID, 0, datapb.CompactionTaskState_pipelining)
+	other := newTask(3, NullNodeID, 0, datapb.CompactionTaskState_pipelining)
+	s.handler.executingTasks = map[int64]CompactionTask{1: executing, 2: pending, 3: other}
+
+	s.cluster.(*MockCluster).EXPECT().QuerySlots().Return(map[int64]int64{100: 100})
+	s.mockMeta.EXPECT().SaveCompactionTask(mock.Anything).Return(nil).Once()
+	s.handler.assignNodeIDs([]CompactionTask{pending, other})
+
+	// the 16MB/s left is allocated to the first plan, the other one is queued
+	s.EqualValues(100, pending.GetNodeID())
+	s.EqualValues(16*1024*1024, pending.GetIoRateLimit())
+	s.EqualValues(NullNodeID, other.GetNodeID())
+	usage, num := s.handler.getIORateUsage()
+	s.EqualValues(64*1024*1024, usage)
+	s.Equal(2, num)
+}
+
+func (s *CompactionPlanHandlerSuite) TestAllocateIORate() {
+	mb := int64(1024 * 1024)
+	// shared evenly by the plans
+	s.Equal(25*mb, allocateIORate(100*mb, 0, 4))
+	// no less than the minimum rate
+	s.Equal(16*mb, allocateIORate(100*mb, 0, 10))
+	// no more than the budget left
+	s.Equal(20*mb, allocateIORate(100*mb, 80*mb, 2))
+	// the budget left is less than the minimum rate
+	s.Equal(int64(0), allocateIORate(100*mb, 90*mb, 2))
+}
+
 func (s *CompactionPlanHandlerSuite) TestPickShardNode() {
 	s.SetupTest()
 	nodeSlots := map[int64]int64{
@@ -875,6 +964,12 @@ func (s *CompactionPlanHandlerSuite) TestProcessCompleteCompaction() {
 
 	s.mockSessMgr.EXPECT().GetCompactionPlanResult(UniqueID(111), int64(1)).Return(&compactionResult, nil).Once()
 	s.mockSessMgr.EXPECT().DropCompactionPlan(mock.Anything, mock.Anything).Return(nil)
+	s.mockMeta.EXPECT().GetSegment(mock.Anything).RunAndReturn(func(segmentID int64) *SegmentInfo {
+		return NewSegmentInfo(&datapb.SegmentInfo{
+			ID:      segmentID,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{{MemorySize: 100}}}},
+		})
+	})
 
 	s.handler.submitTask(task)
 	s.handler.doSchedule()
@@ -882,6 +977,18 @@ func (s *CompactionPlanHandlerSuite) TestProcessCompleteCompaction() {
 	err := s.handler.checkCompaction()
 	s.NoError(err)
 	s.Equal(0, len(s.handler.getTasksByState(datapb.CompactionTaskState_completed)))
+
+	// the finished plan is retained in the history
+	s.mockMeta.EXPECT().GetCompactionTasks().Return(nil).Once()
+	plans := s.handler.getCompactionPlanDetails(&datapb.GetCompactionPlanDetailsRequest{CompactionID: 1})
+	s.Require().Equal(1, len(plans))
+	s.EqualValues(1, plans[0].GetPlanID())
+	s.Equal(datapb.CompactionTaskState_completed, plans[0].GetState())
+	s.EqualValues(dataNodeID, plans[0].GetNodeID())
+	s.Equal([]int64{1, 2}, plans[0].GetInputSegments())
+	s.Equal([]int64{3}, plans[0].GetResultSegments())
+	s.EqualValues(200, plans[0].GetBytesRead())
+	s.NotZero(plans[0].GetEndTime())
 }
 
 func getFieldBinlogIDs(fieldID int64, logIDs ...int64) *datapb.FieldBinlog {
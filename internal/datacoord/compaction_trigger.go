@@ -31,11 +31,13 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/lifetime"
 	"github.com/milvus-io/milvus/pkg/util/lock"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -45,6 +47,16 @@ type compactTime struct {
 	startTime     Timestamp
 	expireTime    Timestamp
 	collectionTTL time.Duration
+	// aggressiveness divides the thresholds of the single compaction, 0 is treated as 1
+	aggressiveness float64
+}
+
+// thresholdOf returns the threshold of the single compaction scaled by the aggressiveness of the collection.
+func (ct *compactTime) thresholdOf(threshold float64) float64 {
+	if ct.aggressiveness <= 0 {
+		return threshold
+	}
+	return threshold / ct.aggressiveness
 }
 
 // todo: migrate to compaction_trigger_v2
@@ -55,6 +67,10 @@ type trigger interface {
 	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string, blockToSendSignal bool) error
 	// triggerManualCompaction force to start a compaction
 	triggerManualCompaction(collectionID int64) (UniqueID, error)
+	// previewManualCompaction generates the plans of the manual compaction without executing them
+	previewManualCompaction(collectionID int64, segmentIDs []int64) ([]*datapb.CompactionPlanPreview, error)
+	// executeCompactionPlans executes the previewed plans as they are
+	executeCompactionPlans(collectionID int64, plans []*datapb.CompactionPlanPreview) (UniqueID, error)
 }
 
 type compactionSignal struct {
@@ -192,6 +208,17 @@ func (t *compactionTrigger) isCollectionAutoCompactionEnabled(coll *collectionIn
 	return enabled
 }
 
+// isInCollectionCompactionWindow returns true if the time is in the compaction window of the collection,
+// or the window is not set.
+func (t *compactionTrigger) isInCollectionCompactionWindow(coll *collectionInfo, now time.Time) bool {
+	start, end, ok, err := common.CollectionLevelCompactionWindow(coll.Properties)
+	if err != nil {
+		log.RatedWarn(60, "collection properties compaction window not valid, ignored", zap.Int64("collectionID", coll.ID), zap.Error(err))
+		return true
+	}
+	return !ok || common.InCompactionWindow(start, end, now.Hour()*60+now.Minute())
+}
+
 func (t *compactionTrigger) isChannelCheckpointHealthy(vchanName string) bool {
 	if paramtable.Get().DataCoordCfg.ChannelCheckpointMaxLag.GetAsInt64() <= 0 {
 		return true
@@ -212,16 +239,22 @@ func getCompactTime(ts Timestamp, coll *collectionInfo) (*compactTime, error) {
 		return nil, err
 	}
 
+	aggressiveness, err := common.CollectionLevelCompactionAggressiveness(coll.Properties)
+	if err != nil {
+		log.RatedWarn(60, "collection properties compaction aggressiveness not valid, use the default one",
+			zap.Int64("collectionID", coll.ID), zap.Error(err))
+	}
+
 	pts, _ := tsoutil.ParseTS(ts)
 
 	if collectionTTL > 0 {
 		ttexpired := pts.Add(-collectionTTL)
 		ttexpiredLogic := tsoutil.ComposeTS(ttexpired.UnixNano()/int64(time.Millisecond), 0)
-		return &compactTime{ts, ttexpiredLogic, collectionTTL}, nil
+		return &compactTime{ts, ttexpiredLogic, collectionTTL, aggressiveness}, nil
 	}
 
 	// no expiration time
-	return &compactTime{ts, 0, 0}, nil
+	return &compactTime{ts, 0, 0, aggressiveness}, nil
 }
 
 // triggerCompaction trigger a compaction if any compaction condition satisfy.
@@ -295,6 +328,130 @@ func (t *compactionTrigger) triggerManualCompaction(collectionID int64) (UniqueI
 	return id, nil
 }
 
+func (t *compactionTrigger) previewManualCompaction(collectionID int64, segmentIDs []int64) ([]*datapb.CompactionPlanPreview, error) {
+	t.forceMu.Lock()
+	defer t.forceMu.Unlock()
+
+	selected := typeutil.NewUniqueSet(segmentIDs...)
+	partSegments := t.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
+		return segment.CollectionID == collectionID && isMixCompactionCandidate(segment) &&
+			(selected.Len() == 0 || selected.Contain(segment.GetID()))
+	})
+	plans := make([]*datapb.CompactionPlanPreview, 0)
+	if len(partSegments) == 0 {
+		return plans, nil
+	}
+
+	coll, err := t.getCollection(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll)
+	if err != nil {
+		return nil, err
+	}
+	signal := &compactionSignal{
+		isForce:      true,
+		isGlobal:     true,
+		collectionID: collectionID,
+	}
+	for _, group := range partSegments {
+		if Params.DataCoordCfg.IndexBasedCompaction.GetAsBool() {
+			group.segments = FilterInIndexedSegments(t.handler, t.meta, group.segments...)
+		}
+		segments := lo.SliceToMap(group.segments, func(segment *SegmentInfo) (int64, *SegmentInfo) {
+			return segment.GetID(), segment
+		})
+		for _, plan := range t.generatePlans(group.segments, signal, ct) {
+			plans = append(plans, &datapb.CompactionPlanPreview{
+				PartitionID:   group.partitionID,
+				Channel:       group.channelName,
+				Type:          datapb.CompactionType_MixCompaction,
+				InputSegments: plan.B,
+				TotalRows:     plan.A,
+				EstimatedOutputSize: lo.SumBy(plan.B, func(segmentID int64) int64 {
+					return segments[segmentID].getSegmentSize()
+				}),
+			})
+		}
+	}
+	return plans, nil
+}
+
+// executeCompactionPlans executes the previewed plans as they are, all the input segments should be still
+// compactable in the partition and channel of the plan, otherwise none of the plans is executed.
+func (t *compactionTrigger) executeCompactionPlans(collectionID int64, plans []*datapb.CompactionPlanPreview) (UniqueID, error) {
+	t.forceMu.Lock()
+	defer t.forceMu.Unlock()
+
+	planned := typeutil.NewUniqueSet()
+	for _, plan := range plans {
+		if plan.GetType() != datapb.CompactionType_MixCompaction {
+			return -1, merr.WrapErrParameterInvalid(datapb.CompactionType_MixCompaction.String(), plan.GetType().String(), "unsupported compaction type")
+		}
+		if len(plan.GetInputSegments()) == 0 {
+			return -1, merr.WrapErrParameterInvalidMsg("no input segments in the compaction plan")
+		}
+		for _, segmentID := range plan.GetInputSegments() {
+			segment := t.meta.GetSegment(segmentID)
+			if segment == nil || segment.GetCollectionID() != collectionID || segment.GetPartitionID() != plan.GetPartitionID() ||
+				segment.GetInsertChannel() != plan.GetChannel() || planned.Contain(segmentID) || !isMixCompactionCandidate(segment) {
+				return -1, merr.WrapErrSegmentLack(segmentID, "segment is not compactable as planned, preview the compaction again")
+			}
+			planned.Insert(segmentID)
+		}
+	}
+
+	coll, err := t.getCollection(collectionID)
+	if err != nil {
+		return -1, err
+	}
+	ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll)
+	if err != nil {
+		return -1, err
+	}
+	id, err := t.allocSignalID()
+	if err != nil {
+		return -1, err
+	}
+	currentID, _, err := t.allocator.allocN(int64(len(plans) * 2))
+	if err != nil {
+		return -1, err
+	}
+	pts, _ := tsoutil.ParseTS(ct.startTime)
+	for _, plan := range plans {
+		planID := currentID
+		currentID++
+		targetSegmentID := currentID
+		currentID++
+		totalRows := lo.SumBy(plan.GetInputSegments(), func(segmentID int64) int64 {
+			return t.meta.GetSegment(segmentID).GetNumOfRows()
+		})
+		err := t.compactionHandler.enqueueCompaction(&datapb.CompactionTask{
+			PlanID:           planID,
+			TriggerID:        id,
+			State:            datapb.CompactionTaskState_pipelining,
+			StartTime:        pts.Unix(),
+			TimeoutInSeconds: Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt32(),
+			Type:             datapb.CompactionType_MixCompaction,
+			CollectionTtl:    ct.collectionTTL.Nanoseconds(),
+			CollectionID:     collectionID,
+			PartitionID:      plan.GetPartitionID(),
+			Channel:          plan.GetChannel(),
+			InputSegments:    plan.GetInputSegments(),
+			ResultSegments:   []int64{targetSegmentID}, // pre-allocated target segment
+			TotalRows:        totalRows,
+			Schema:           coll.Schema,
+		})
+		if err != nil {
+			log.Warn("failed to execute the previewed compaction plan", zap.Int64("collectionID", collectionID),
+				zap.Int64s("segmentIDs", plan.GetInputSegments()), zap.Error(err))
+			continue
+		}
+	}
+	return id, nil
+}
+
 func (t *compactionTrigger) allocSignalID() (UniqueID, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -342,12 +499,7 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) error {
 		zap.Int64("signal.segmentID", signal.segmentID))
 	partSegments := t.meta.GetSegmentsChanPart(func(segment *SegmentInfo) bool {
 		return (signal.collectionID == 0 || segment.CollectionID == signal.collectionID) &&
-			isSegmentHealthy(segment) &&
-			isFlush(segment) &&
-			!segment.isCompacting && // not compacting now
-			!segment.GetIsImporting() && // not importing now
-			segment.GetLevel() != datapb.SegmentLevel_L0 && // ignore level zero segments
-			segment.GetLevel() != datapb.SegmentLevel_L2 // ignore l2 segment
+			isMixCompactionCandidate(segment)
 	}) // partSegments is list of chanPartSegments, which is channel-partition organized segments
 
 	if len(partSegments) == 0 {
@@ -380,6 +532,9 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) error {
 		if Params.DataCoordCfg.IndexBasedCompaction.GetAsBool() {
 			group.segments = FilterInIndexedSegments(t.handler, t.meta, group.segments...)
 		}
+		if !signal.isForce {
+			group.segments = t.filterIndexBuildingSegments(group.segments)
+		}
 
 		coll, err := t.getCollection(group.collectionID)
 		if err != nil {
@@ -391,6 +546,10 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) error {
 			log.RatedInfo(20, "collection auto compaction disabled")
 			return nil
 		}
+		if !signal.isForce && !t.isInCollectionCompactionWindow(coll, time.Now()) {
+			log.RatedInfo(20, "out of the compaction window of collection")
+			return nil
+		}
 
 		ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll)
 		if err != nil {
@@ -474,6 +633,9 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 	partitionID := segment.GetPartitionID()
 	collectionID := segment.GetCollectionID()
 	segments := t.getCandidateSegments(channel, partitionID)
+	if !signal.isForce {
+		segments = t.filterIndexBuildingSegments(segments)
+	}
 
 	if len(segments) == 0 {
 		log.Info("the number of candidate segments is 0, skip to handle compaction")
@@ -497,6 +659,12 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 		)
 		return
 	}
+	if !signal.isForce && !t.isInCollectionCompactionWindow(coll, time.Now()) {
+		log.RatedInfo(20, "out of the compaction window of collection",
+			zap.Int64("collectionID", collectionID),
+		)
+		return
+	}
 	ts := tsoutil.ComposeTSByTime(time.Now(), 0)
 	ct, err := getCompactTime(ts, coll)
 	if err != nil {
@@ -758,6 +926,24 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 	return res
 }
 
+// filterIndexBuildingSegments filters out the segments whose index builds are in progress if configured,
+// the compaction of them is delayed until the builds finish so that the nearly finished index work is not wasted,
+// but no longer than the max delay. The segments not indexed are already filtered out if the compaction is index based.
+func (t *compactionTrigger) filterIndexBuildingSegments(segments []*SegmentInfo) []*SegmentInfo {
+	if !Params.DataCoordCfg.DelayOnIndexBuilding.GetAsBool() || Params.DataCoordCfg.IndexBasedCompaction.GetAsBool() {
+		return segments
+	}
+	startedAfter := time.Now().Add(-Params.DataCoordCfg.DelayOnIndexBuildingMaxTime.GetAsDuration(time.Second))
+	return lo.Filter(segments, func(segment *SegmentInfo, _ int) bool {
+		if t.meta.indexMeta.HasIndexBuildInProgress(segment.GetCollectionID(), segment.GetID(), startedAfter) {
+			log.RatedInfo(60, "delay the compaction of the segment whose index build is in progress",
+				zap.Int64("collectionID", segment.GetCollectionID()), zap.Int64("segmentID", segment.GetID()))
+			return false
+		}
+		return true
+	})
+}
+
 func (t *compactionTrigger) isSmallSegment(segment *SegmentInfo, expectedSize int64) bool {
 	return segment.getSegmentSize() < int64(float64(expectedSize)*Params.DataCoordCfg.SegmentSmallProportion.GetAsFloat())
 }
@@ -783,7 +969,7 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 
 	binlogCount := GetBinlogCount(segment.GetBinlogs())
 	deltaLogCount := GetBinlogCount(segment.GetDeltalogs())
-	if deltaLogCount > Params.DataCoordCfg.SingleCompactionDeltalogMaxNum.GetAsInt() {
+	if float64(deltaLogCount) > compactTime.thresholdOf(Params.DataCoordCfg.SingleCompactionDeltalogMaxNum.GetAsFloat()) {
 		log.Info("total delta number is too much, trigger compaction", zap.Int64("segmentID", segment.ID), zap.Int("Bin logs", binlogCount), zap.Int("Delta logs", deltaLogCount))
 		return true
 	}
@@ -806,8 +992,8 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 		}
 	}
 
-	if float64(totalExpiredRows)/float64(segment.GetNumOfRows()) >= Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat() ||
-		totalExpiredSize > Params.DataCoordCfg.SingleCompactionExpiredLogMaxSize.GetAsInt64() {
+	if float64(totalExpiredRows)/float64(segment.GetNumOfRows()) >= compactTime.thresholdOf(Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat()) ||
+		float64(totalExpiredSize) > compactTime.thresholdOf(Params.DataCoordCfg.SingleCompactionExpiredLogMaxSize.GetAsFloat()) {
 		log.Info("total expired entities is too much, trigger compaction", zap.Int64("segmentID", segment.ID),
 			zap.Int("expiredRows", totalExpiredRows), zap.Int64("expiredLogSize", totalExpiredSize),
 			zap.Bool("createdByCompaction", segment.CreatedByCompaction), zap.Int64s("compactionFrom", segment.CompactionFrom))
@@ -824,7 +1010,8 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 	}
 
 	// currently delta log size and delete ratio policy is applied
-	if float64(totalDeletedRows)/float64(segment.GetNumOfRows()) >= Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat() || totalDeleteLogSize > Params.DataCoordCfg.SingleCompactionDeltaLogMaxSize.GetAsInt64() {
+	if float64(totalDeletedRows)/float64(segment.GetNumOfRows()) >= compactTime.thresholdOf(Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat()) ||
+		float64(totalDeleteLogSize) > compactTime.thresholdOf(Params.DataCoordCfg.SingleCompactionDeltaLogMaxSize.GetAsFloat()) {
 		log.Info("total delete entities is too much, trigger compaction",
 			zap.Int64("segmentID", segment.ID),
 			zap.Int64("numRows", segment.GetNumOfRows()),
@@ -853,6 +1040,16 @@ func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compa
 	return false
 }
 
+// isMixCompactionCandidate checks whether the segment can be compacted by the mix compaction.
+func isMixCompactionCandidate(segment *SegmentInfo) bool {
+	return isSegmentHealthy(segment) &&
+		isFlush(segment) &&
+		!segment.isCompacting && // not compacting now
+		!segment.GetIsImporting() && // not importing now
+		segment.GetLevel() != datapb.SegmentLevel_L0 && // ignore level zero segments
+		segment.GetLevel() != datapb.SegmentLevel_L2 // ignore l2 segment
+}
+
 func isFlush(segment *SegmentInfo) bool {
 	return segment.GetState() == commonpb.SegmentState_Flushed || segment.GetState() == commonpb.SegmentState_Flushing
 }
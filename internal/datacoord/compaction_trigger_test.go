@@ -39,8 +39,10 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/lifetime"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type spyCompactionHandler struct {
@@ -60,6 +62,14 @@ var _ compactionPlanContext = (*spyCompactionHandler)(nil)
 
 func (h *spyCompactionHandler) removeTasksByChannel(channel string) {}
 
+func (h *spyCompactionHandler) getDatabaseSlotUsage() map[string]int64 {
+	return nil
+}
+
+func (h *spyCompactionHandler) getCompactionPlanDetails(req *datapb.GetCompactionPlanDetailsRequest) []*datapb.CompactionPlanDetail {
+	return nil
+}
+
 // enqueueCompaction start to execute plan and return immediately
 func (h *spyCompactionHandler) enqueueCompaction(task *datapb.CompactionTask) error {
 	t := &mixCompactionTask{
@@ -310,7 +320,7 @@ func Test_compactionTrigger_force(t *testing.T) {
 							},
 						},
 					},
-					collections: map[int64]*collectionInfo{
+					collections: newCollectionInfos(map[int64]*collectionInfo{
 						2: {
 							ID:     2,
 							Schema: schema,
@@ -417,7 +427,7 @@ func Test_compactionTrigger_force(t *testing.T) {
 								},
 							},
 						},
-					},
+					}),
 				},
 				&MockAllocator0{},
 				nil,
@@ -698,7 +708,7 @@ func Test_compactionTrigger_force_maxSegmentLimit(t *testing.T) {
 				&meta{
 					segments:   segmentInfos,
 					channelCPs: newChannelCps(),
-					collections: map[int64]*collectionInfo{
+					collections: newCollectionInfos(map[int64]*collectionInfo{
 						2: {
 							ID: 2,
 							Schema: &schemapb.CollectionSchema{
@@ -716,7 +726,7 @@ func Test_compactionTrigger_force_maxSegmentLimit(t *testing.T) {
 								},
 							},
 						},
-					},
+					}),
 					indexMeta: indexMeta,
 				},
 				newMockAllocator(),
@@ -898,7 +908,7 @@ func Test_compactionTrigger_noplan(t *testing.T) {
 							},
 						},
 					},
-					collections: map[int64]*collectionInfo{
+					collections: newCollectionInfos(map[int64]*collectionInfo{
 						2: {
 							ID: 2,
 							Schema: &schemapb.CollectionSchema{
@@ -916,7 +926,7 @@ func Test_compactionTrigger_noplan(t *testing.T) {
 								},
 							},
 						},
-					},
+					}),
 				},
 				newMockAllocator(),
 				make(chan *compactionSignal, 1),
@@ -1087,7 +1097,7 @@ func Test_compactionTrigger_PrioritizedCandi(t *testing.T) {
 							},
 						},
 					},
-					collections: map[int64]*collectionInfo{
+					collections: newCollectionInfos(map[int64]*collectionInfo{
 						2: {
 							ID: 2,
 							Schema: &schemapb.CollectionSchema{
@@ -1105,7 +1115,7 @@ func Test_compactionTrigger_PrioritizedCandi(t *testing.T) {
 								},
 							},
 						},
-					},
+					}),
 				},
 				newMockAllocator(),
 				make(chan *compactionSignal, 1),
@@ -1280,7 +1290,7 @@ func Test_compactionTrigger_SmallCandi(t *testing.T) {
 							},
 						},
 					},
-					collections: map[int64]*collectionInfo{
+					collections: newCollectionInfos(map[int64]*collectionInfo{
 						2: {
 							ID: 2,
 							Schema: &schemapb.CollectionSchema{
@@ -1292,7 +1302,7 @@ func Test_compactionTrigger_SmallCandi(t *testing.T) {
 								},
 							},
 						},
-					},
+					}),
 				},
 				newMockAllocator(),
 				make(chan *compactionSignal, 1),
@@ -1470,7 +1480,7 @@ func Test_compactionTrigger_SqueezeNonPlannedSegs(t *testing.T) {
 							},
 						},
 					},
-					collections: map[int64]*collectionInfo{
+					collections: newCollectionInfos(map[int64]*collectionInfo{
 						2: {
 							ID: 2,
 							Schema: &schemapb.CollectionSchema{
@@ -1482,7 +1492,7 @@ func Test_compactionTrigger_SqueezeNonPlannedSegs(t *testing.T) {
 								},
 							},
 						},
-					},
+					}),
 				},
 				newMockAllocator(),
 				make(chan *compactionSignal, 1),
@@ -1640,7 +1650,7 @@ func Test_compactionTrigger_noplan_random_size(t *testing.T) {
 					channelCPs: newChannelCps(),
 
 					segments: segmentInfos,
-					collections: map[int64]*collectionInfo{
+					collections: newCollectionInfos(map[int64]*collectionInfo{
 						2: {
 							ID: 2,
 							Schema: &schemapb.CollectionSchema{
@@ -1658,7 +1668,7 @@ func Test_compactionTrigger_noplan_random_size(t *testing.T) {
 								},
 							},
 						},
-					},
+					}),
 					indexMeta: indexMeta,
 				},
 				newMockAllocator(),
@@ -1816,6 +1826,10 @@ func Test_compactionTrigger_shouldDoSingleCompaction(t *testing.T) {
 	couldDo = trigger.ShouldDoSingleCompaction(info2, &compactTime{expireTime: 600})
 	assert.False(t, couldDo)
 
+	// the thresholds are halved for the aggressive collection
+	couldDo = trigger.ShouldDoSingleCompaction(info2, &compactTime{expireTime: 600, aggressiveness: 2})
+	assert.True(t, couldDo)
+
 	// expire time < Timestamp False
 	couldDo = trigger.ShouldDoSingleCompaction(info2, &compactTime{expireTime: 1200})
 	assert.True(t, couldDo)
@@ -1977,13 +1991,36 @@ func Test_compactionTrigger_getCompactTime(t *testing.T) {
 		Schema:     newTestSchema(),
 		Partitions: []UniqueID{1},
 		Properties: map[string]string{
-			common.CollectionTTLConfigKey: "10",
+			common.CollectionTTLConfigKey:                "10",
+			common.CollectionCompactionAggressivenessKey: "2",
 		},
 	}
 	now := tsoutil.GetCurrentTime()
 	ct, err := getCompactTime(now, coll)
 	assert.NoError(t, err)
 	assert.NotNil(t, ct)
+	assert.Equal(t, 2.0, ct.aggressiveness)
+	assert.Equal(t, 5.0, ct.thresholdOf(10))
+
+	coll.Properties[common.CollectionCompactionAggressivenessKey] = "high"
+	ct, err = getCompactTime(now, coll)
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, ct.thresholdOf(10))
+}
+
+func Test_compactionTrigger_isInCollectionCompactionWindow(t *testing.T) {
+	trigger := &compactionTrigger{}
+	coll := &collectionInfo{ID: 1, Properties: map[string]string{}}
+	now := time.Date(2024, 1, 1, 23, 30, 0, 0, time.Local)
+	assert.True(t, trigger.isInCollectionCompactionWindow(coll, now))
+
+	coll.Properties[common.CollectionCompactionWindowKey] = "22:00-06:00"
+	assert.True(t, trigger.isInCollectionCompactionWindow(coll, now))
+	assert.False(t, trigger.isInCollectionCompactionWindow(coll, now.Add(-12*time.Hour)))
+
+	// invalid window is ignored
+	coll.Properties[common.CollectionCompactionWindowKey] = "night"
+	assert.True(t, trigger.isInCollectionCompactionWindow(coll, now.Add(-12*time.Hour)))
 }
 
 func Test_triggerSingleCompaction(t *testing.T) {
@@ -1994,7 +2031,7 @@ func Test_triggerSingleCompaction(t *testing.T) {
 	}()
 	m := &meta{
 		channelCPs: newChannelCps(),
-		segments:   NewSegmentsInfo(), collections: make(map[UniqueID]*collectionInfo),
+		segments:   NewSegmentsInfo(), collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 	}
 	got := newCompactionTrigger(m, &compactionPlanHandler{}, newMockAllocator(),
 		&ServerHandler{
@@ -2220,7 +2257,7 @@ func (s *CompactionTriggerSuite) SetupTest() {
 				},
 			},
 		},
-		collections: map[int64]*collectionInfo{
+		collections: newCollectionInfos(map[int64]*collectionInfo{
 			s.collectionID: {
 				ID: s.collectionID,
 				Schema: &schemapb.CollectionSchema{
@@ -2232,7 +2269,7 @@ func (s *CompactionTriggerSuite) SetupTest() {
 					},
 				},
 			},
-		},
+		}),
 	}
 	s.meta.UpdateChannelCheckpoint(s.channel, &msgpb.MsgPosition{
 		ChannelName: s.channel,
@@ -2527,6 +2564,96 @@ func (s *CompactionTriggerSuite) TestHandleGlobalSignal() {
 	})
 }
 
+func (s *CompactionTriggerSuite) TestPreviewAndExecuteCompactionPlans() {
+	paramtable.Get().Save(Params.DataCoordCfg.IndexBasedCompaction.Key, "false")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.IndexBasedCompaction.Key)
+
+	s.Run("preview", func() {
+		defer s.SetupTest()
+		s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(s.meta.GetCollection(s.collectionID), nil)
+
+		plans, err := s.tr.previewManualCompaction(s.collectionID, []int64{1, 2, 3})
+		s.NoError(err)
+		s.Equal(1, len(plans))
+		s.ElementsMatch([]int64{1, 2, 3}, plans[0].GetInputSegments())
+		s.Equal(datapb.CompactionType_MixCompaction, plans[0].GetType())
+		s.Equal(s.partitionID, plans[0].GetPartitionID())
+		s.Equal(s.channel, plans[0].GetChannel())
+		s.Equal(int64(180), plans[0].GetTotalRows())
+		s.Equal(int64(300), plans[0].GetEstimatedOutputSize())
+
+		// no compactable segments
+		plans, err = s.tr.previewManualCompaction(s.collectionID, []int64{100})
+		s.NoError(err)
+		s.Empty(plans)
+	})
+
+	s.Run("preview_get_collection_failed", func() {
+		defer s.SetupTest()
+		s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(nil, errors.New("mocked"))
+
+		_, err := s.tr.previewManualCompaction(s.collectionID, nil)
+		s.Error(err)
+	})
+
+	s.Run("execute", func() {
+		defer s.SetupTest()
+		s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(s.meta.GetCollection(s.collectionID), nil)
+		s.allocator.EXPECT().allocID(mock.Anything).Return(19530, nil)
+		s.allocator.EXPECT().allocN(int64(2)).Return(20000, 20002, nil)
+
+		plans, err := s.tr.previewManualCompaction(s.collectionID, nil)
+		s.NoError(err)
+		s.Equal(1, len(plans))
+		s.compactionHandler.EXPECT().enqueueCompaction(mock.Anything).RunAndReturn(func(task *datapb.CompactionTask) error {
+			s.Equal(int64(19530), task.GetTriggerID())
+			s.Equal(int64(20000), task.GetPlanID())
+			s.Equal([]int64{20001}, task.GetResultSegments())
+			s.Equal(plans[0].GetInputSegments(), task.GetInputSegments())
+			s.Equal(int64(360), task.GetTotalRows())
+			return nil
+		})
+		id, err := s.tr.executeCompactionPlans(s.collectionID, plans)
+		s.NoError(err)
+		s.Equal(int64(19530), id)
+	})
+
+	s.Run("execute_outdated_plans", func() {
+		defer s.SetupTest()
+		plan := &datapb.CompactionPlanPreview{
+			PartitionID:   s.partitionID,
+			Channel:       s.channel,
+			Type:          datapb.CompactionType_MixCompaction,
+			InputSegments: []int64{1, 2},
+		}
+
+		s.meta.segments.segments[2].isCompacting = true
+		_, err := s.tr.executeCompactionPlans(s.collectionID, []*datapb.CompactionPlanPreview{plan})
+		s.ErrorIs(err, merr.ErrSegmentLack)
+		s.meta.segments.segments[2].isCompacting = false
+
+		// segment planned twice
+		_, err = s.tr.executeCompactionPlans(s.collectionID, []*datapb.CompactionPlanPreview{plan, plan})
+		s.ErrorIs(err, merr.ErrSegmentLack)
+
+		_, err = s.tr.executeCompactionPlans(s.collectionID, []*datapb.CompactionPlanPreview{{
+			PartitionID:   s.partitionID,
+			Channel:       "ch2",
+			Type:          datapb.CompactionType_MixCompaction,
+			InputSegments: []int64{1, 2},
+		}})
+		s.ErrorIs(err, merr.ErrSegmentLack)
+
+		_, err = s.tr.executeCompactionPlans(s.collectionID, []*datapb.CompactionPlanPreview{{
+			PartitionID:   s.partitionID,
+			Channel:       s.channel,
+			Type:          datapb.CompactionType_ClusteringCompaction,
+			InputSegments: []int64{1, 2},
+		}})
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+	})
+}
+
 func (s *CompactionTriggerSuite) TestIsChannelCheckpointHealthy() {
 	ptKey := paramtable.Get().DataCoordCfg.ChannelCheckpointMaxLag.Key
 	s.Run("ok", func() {
@@ -2597,6 +2724,134 @@ func (s *CompactionTriggerSuite) TestSqueezeSmallSegments() {
 	log.Info("buckets", zap.Any("buckets", buckets))
 }
 
+func (s *CompactionTriggerSuite) TestFilterIndexBuildingSegments() {
+	paramtable.Get().Save(Params.DataCoordCfg.IndexBasedCompaction.Key, "false")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.IndexBasedCompaction.Key)
+	s.meta.indexMeta.segmentIndexes[2][indexID].IndexState = commonpb.IndexState_InProgress
+	s.meta.indexMeta.segmentIndexes[2][indexID].BuildStartTime = time.Now().UnixMilli()
+	s.meta.indexMeta.segmentIndexes[3][indexID].IndexState = commonpb.IndexState_Unissued
+	segments := s.meta.SelectSegments(WithCollection(s.collectionID))
+	s.Require().Equal(6, len(segments))
+
+	s.Run("delay on index building", func() {
+		filtered := s.tr.filterIndexBuildingSegments(segments)
+		s.Equal(5, len(filtered))
+		for _, segment := range filtered {
+			s.NotEqual(int64(2), segment.GetID())
+		}
+	})
+
+	s.Run("no delay", func() {
+		paramtable.Get().Save(paramtable.Get().DataCoordCfg.DelayOnIndexBuilding.Key, "false")
+		defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.DelayOnIndexBuilding.Key)
+		s.Equal(6, len(s.tr.filterIndexBuildingSegments(segments)))
+	})
+
+	s.Run("exceed max delay", func() {
+		paramtable.Get().Save(paramtable.Get().DataCoordCfg.DelayOnIndexBuildingMaxTime.Key, "60")
+		defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.DelayOnIndexBuildingMaxTime.Key)
+		s.meta.indexMeta.segmentIndexes[2][indexID].BuildStartTime = time.Now().Add(-time.Hour).UnixMilli()
+		defer func() {
+			s.meta.indexMeta.segmentIndexes[2][indexID].BuildStartTime = time.Now().UnixMilli()
+		}()
+		s.Equal(6, len(s.tr.filterIndexBuildingSegments(segments)))
+	})
+
+	s.Run("index based compaction", func() {
+		// the segments not indexed are filtered out by FilterInIndexedSegments already
+		paramtable.Get().Save(Params.DataCoordCfg.IndexBasedCompaction.Key, "true")
+		defer paramtable.Get().Save(Params.DataCoordCfg.IndexBasedCompaction.Key, "false")
+		s.Equal(6, len(s.tr.filterIndexBuildingSegments(segments)))
+	})
+}
+
+func (s *CompactionTriggerSuite) TestDelayOnIndexBuilding() {
+	paramtable.Get().Save(Params.DataCoordCfg.IndexBasedCompaction.Key, "false")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.IndexBasedCompaction.Key)
+
+	// returns the input segments of the enqueued compaction tasks
+	expectCompaction := func() *[]int64 {
+		inputs := make([]int64, 0)
+		s.compactionHandler.EXPECT().isFull().Return(false)
+		s.allocator.EXPECT().allocN(mock.Anything).RunAndReturn(func(i int64) (int64, int64, error) {
+			return 20000, 20000 + i, nil
+		})
+		s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(&collectionInfo{
+			ID: s.collectionID,
+			Schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{
+						FieldID:  s.vecFieldID,
+						DataType: schemapb.DataType_FloatVector,
+					},
+				},
+			},
+		}, nil)
+		s.compactionHandler.EXPECT().enqueueCompaction(mock.Anything).RunAndReturn(func(task *datapb.CompactionTask) error {
+			inputs = append(inputs, task.GetInputSegments()...)
+			return nil
+		})
+		return &inputs
+	}
+	signal := func(isForce bool) *compactionSignal {
+		return &compactionSignal{
+			segmentID:    1,
+			collectionID: s.collectionID,
+			partitionID:  s.partitionID,
+			channel:      s.channel,
+			isForce:      isForce,
+		}
+	}
+	setBuildStartTime := func(startTime time.Time) {
+		s.meta.indexMeta.segmentIndexes[2][indexID].IndexState = commonpb.IndexState_InProgress
+		s.meta.indexMeta.segmentIndexes[2][indexID].BuildStartTime = startTime.UnixMilli()
+	}
+
+	s.Run("handleSignal", func() {
+		defer s.SetupTest()
+		setBuildStartTime(time.Now())
+		inputs := expectCompaction()
+		s.tr.handleSignal(signal(false))
+		s.Contains(*inputs, int64(1))
+		s.NotContains(*inputs, int64(2))
+	})
+
+	s.Run("handleSignal_exceed_max_delay", func() {
+		defer s.SetupTest()
+		setBuildStartTime(time.Now().Add(-2 * Params.DataCoordCfg.DelayOnIndexBuildingMaxTime.GetAsDuration(time.Second)))
+		inputs := expectCompaction()
+		s.tr.handleSignal(signal(false))
+		s.Contains(*inputs, int64(2))
+	})
+
+	s.Run("handleSignal_force", func() {
+		defer s.SetupTest()
+		setBuildStartTime(time.Now())
+		inputs := expectCompaction()
+		s.tr.handleSignal(signal(true))
+		s.Contains(*inputs, int64(2))
+	})
+
+	s.Run("handleGlobalSignal", func() {
+		defer s.SetupTest()
+		setBuildStartTime(time.Now())
+		inputs := expectCompaction()
+		err := s.tr.handleGlobalSignal(signal(false))
+		s.NoError(err)
+		s.Contains(*inputs, int64(1))
+		s.NotContains(*inputs, int64(2))
+	})
+
+	s.Run("handleGlobalSignal_exceed_max_delay", func() {
+		defer s.SetupTest()
+		setBuildStartTime(time.Now().Add(-2 * Params.DataCoordCfg.DelayOnIndexBuildingMaxTime.GetAsDuration(time.Second)))
+		inputs := expectCompaction()
+		err := s.tr.handleGlobalSignal(signal(false))
+		s.NoError(err)
+		s.Contains(*inputs, int64(2))
+	})
+}
+
 //func Test_compactionTrigger_clustering(t *testing.T) {
 //	paramtable.Init()
 //	catalog := mocks.NewDataCoordCatalog(t)
@@ -2604,7 +2859,7 @@ func (s *CompactionTriggerSuite) TestSqueezeSmallSegments() {
 //	vecFieldID := int64(201)
 //	meta := &meta{
 //		catalog: catalog,
-//		collections: map[int64]*collectionInfo{
+//		collections: newCollectionInfos(map[int64]*collectionInfo{
 //			1: {
 //				ID: 1,
 //				Schema: &schemapb.CollectionSchema{
@@ -2622,7 +2877,7 @@ func (s *CompactionTriggerSuite) TestSqueezeSmallSegments() {
 //					},
 //				},
 //			},
-//		},
+//		}),
 //	}
 //
 //	paramtable.Get().Save(paramtable.Get().DataCoordCfg.ClusteringCompactionEnable.Key, "false")
@@ -37,12 +37,15 @@ const (
 	TriggerTypeLevelZeroViewIDLE
 	TriggerTypeSegmentSizeViewChange
 	TriggerTypeClustering
+	TriggerTypeExpiry
 )
 
 type TriggerManager interface {
 	Start()
 	Stop()
 	ManualTrigger(ctx context.Context, collectionID int64, clusteringCompaction bool) (UniqueID, error)
+	// GetExpiryCompactions returns the latest rounds of the expiry compaction of the collections
+	GetExpiryCompactions(collectionIDs []int64) []*datapb.ExpiryCompactionInfo
 }
 
 var _ TriggerManager = (*CompactionTriggerManager)(nil)
@@ -69,6 +72,7 @@ type CompactionTriggerManager struct {
 	meta             *meta
 	l0Policy         *l0CompactionPolicy
 	clusteringPolicy *clusteringCompactionPolicy
+	expiryPolicy     *expiryCompactionPolicy
 
 	closeSig chan struct{}
 	closeWg  sync.WaitGroup
@@ -87,6 +91,7 @@ func NewCompactionTriggerManager(alloc allocator, handler Handler, compactionHan
 	}
 	m.l0Policy = newL0CompactionPolicy(meta)
 	m.clusteringPolicy = newClusteringCompactionPolicy(meta, m.allocator, m.handler)
+	m.expiryPolicy = newExpiryCompactionPolicy(meta, m.allocator, m.handler)
 	return m
 }
 
@@ -108,6 +113,8 @@ func (m *CompactionTriggerManager) startLoop() {
 	defer l0Ticker.Stop()
 	clusteringTicker := time.NewTicker(Params.DataCoordCfg.ClusteringCompactionTriggerInterval.GetAsDuration(time.Second))
 	defer clusteringTicker.Stop()
+	expiryTicker := time.NewTicker(Params.DataCoordCfg.ExpiryCompactionTriggerInterval.GetAsDuration(time.Second))
+	defer expiryTicker.Stop()
 	log.Info("Compaction trigger manager start")
 	for {
 		select {
@@ -152,6 +159,25 @@ func (m *CompactionTriggerManager) startLoop() {
 					m.notify(ctx, triggerType, views)
 				}
 			}
+		case <-expiryTicker.C:
+			if !m.expiryPolicy.Enable() {
+				continue
+			}
+			if m.compactionHandler.isFull() {
+				log.RatedInfo(10, "Skip trigger expiry compaction since compactionHandler is full")
+				continue
+			}
+			events, err := m.expiryPolicy.Trigger()
+			if err != nil {
+				log.Warn("Fail to trigger expiry policy", zap.Error(err))
+				continue
+			}
+			ctx := context.Background()
+			if len(events) > 0 {
+				for triggerType, views := range events {
+					m.notify(ctx, triggerType, views)
+				}
+			}
 		}
 	}
 }
@@ -207,10 +233,22 @@ func (m *CompactionTriggerManager) notify(ctx context.Context, eventType Compact
 					zap.String("output view", outView.String()))
 				m.SubmitClusteringViewToScheduler(ctx, outView)
 			}
+		case TriggerTypeExpiry:
+			outView, reason := view.Trigger()
+			if outView != nil {
+				log.Info("Success to trigger an ExpiryCompaction output view, try to submit",
+					zap.String("reason", reason),
+					zap.String("output view", outView.String()))
+				m.SubmitExpiryViewToScheduler(ctx, outView)
+			}
 		}
 	}
 }
 
+func (m *CompactionTriggerManager) GetExpiryCompactions(collectionIDs []int64) []*datapb.ExpiryCompactionInfo {
+	return m.expiryPolicy.getRounds(collectionIDs)
+}
+
 func (m *CompactionTriggerManager) SubmitL0ViewToScheduler(ctx context.Context, view CompactionView) {
 	taskID, err := m.allocator.allocID(ctx)
 	if err != nil {
@@ -317,6 +355,53 @@ func (m *CompactionTriggerManager) SubmitClusteringViewToScheduler(ctx context.C
 	)
 }
 
+// SubmitExpiryViewToScheduler submits a mix compaction of the single segment of the view,
+// the expired rows are dropped by the collection TTL carried in the task.
+func (m *CompactionTriggerManager) SubmitExpiryViewToScheduler(ctx context.Context, view CompactionView) {
+	planID, _, err := m.allocator.allocN(2)
+	if err != nil {
+		log.Warn("Failed to submit compaction view to scheduler because allocate id fail", zap.String("view", view.String()))
+		return
+	}
+	collection, err := m.handler.GetCollection(ctx, view.GetGroupLabel().CollectionID)
+	if err != nil {
+		log.Warn("Failed to submit compaction view to scheduler because get collection fail", zap.String("view", view.String()))
+		return
+	}
+	expiryView := view.(*ExpirySegmentsView)
+	task := &datapb.CompactionTask{
+		PlanID:           planID,
+		TriggerID:        expiryView.triggerID,
+		State:            datapb.CompactionTaskState_pipelining,
+		StartTime:        time.Now().Unix(),
+		CollectionTtl:    expiryView.collectionTTL.Nanoseconds(),
+		TimeoutInSeconds: Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt32(),
+		Type:             datapb.CompactionType_MixCompaction,
+		CollectionID:     view.GetGroupLabel().CollectionID,
+		PartitionID:      view.GetGroupLabel().PartitionID,
+		Channel:          view.GetGroupLabel().Channel,
+		Schema:           collection.Schema,
+		InputSegments:    lo.Map(view.GetSegmentsView(), func(segmentView *SegmentView, _ int) int64 { return segmentView.ID }),
+		ResultSegments:   []int64{planID + 1}, // pre-allocated target segment
+		TotalRows:        lo.SumBy(view.GetSegmentsView(), func(segmentView *SegmentView) int64 { return segmentView.NumOfRows }),
+	}
+	err = m.compactionHandler.enqueueCompaction(task)
+	if err != nil {
+		log.Warn("Failed to execute compaction task",
+			zap.Int64("collection", task.CollectionID),
+			zap.Int64("planID", task.GetPlanID()),
+			zap.Int64s("segmentIDs", task.GetInputSegments()),
+			zap.Error(err))
+		return
+	}
+	log.Info("Finish to submit an expiry compaction task",
+		zap.Int64("triggerID", task.GetTriggerID()),
+		zap.Int64("planID", task.GetPlanID()),
+		zap.Int64s("segmentIDs", task.GetInputSegments()),
+		zap.Int64("expiredRows", expiryView.expiredRows),
+	)
+}
+
 // chanPartSegments is an internal result struct, which is aggregates of SegmentInfos with same collectionID, partitionID and channelName
 type chanPartSegments struct {
 	collectionID UniqueID
@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// databaseQuota is the quotas of the background tasks of a database set by the database properties,
+// 0 means unlimited.
+type databaseQuota struct {
+	indexTasks      int
+	analyzeTasks    int
+	compactionSlots int64
+}
+
+func (q databaseQuota) isUnlimited() bool {
+	return q.indexTasks <= 0 && q.analyzeTasks <= 0 && q.compactionSlots <= 0
+}
+
+// parseDatabaseQuota parses the quotas from the properties of the database, the invalid ones are taken as unlimited.
+func parseDatabaseQuota(dbName string, properties []*commonpb.KeyValuePair) databaseQuota {
+	parse := func(key string) int64 {
+		for _, kv := range properties {
+			if kv.GetKey() != key {
+				continue
+			}
+			value, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err != nil {
+				log.Warn("invalid background task quota of the database, take it as unlimited",
+					zap.String("dbName", dbName), zap.String("key", key), zap.String("value", kv.GetValue()))
+				return 0
+			}
+			return value
+		}
+		return 0
+	}
+	return databaseQuota{
+		indexTasks:      int(parse(common.DatabaseMaxIndexTasksKey)),
+		analyzeTasks:    int(parse(common.DatabaseMaxAnalyzeTasksKey)),
+		compactionSlots: parse(common.DatabaseMaxCompactionSlotsKey),
+	}
+}
+
+// cachedDatabase is the quotas and the tenant of a database described from the rootcoord.
+type cachedDatabase struct {
+	quota    databaseQuota
+	tenant   string
+	expireAt time.Time
+}
+
+// databaseQuotas caches the background task quotas and the tenants of the databases, a database is described
+// from the rootcoord the first time it's checked and again once its cache expires, so that the schedulers
+// check the quotas without calling the rootcoord for every task, and the idle databases are never described.
+// The nil databaseQuotas limits nothing.
+type databaseQuotas struct {
+	ctx    context.Context
+	broker broker.Broker
+
+	mu        sync.RWMutex
+	databases map[string]*cachedDatabase
+}
+
+func newDatabaseQuotas(ctx context.Context, broker broker.Broker) *databaseQuotas {
+	return &databaseQuotas{
+		ctx:       ctx,
+		broker:    broker,
+		databases: make(map[string]*cachedDatabase),
+	}
+}
+
+// describe returns the cached database, the database is described again if it's not cached or expired,
+// and the stale one is kept if failed to describe it.
+func (q *databaseQuotas) describe(dbName string) *cachedDatabase {
+	q.mu.RLock()
+	cached, ok := q.databases[dbName]
+	q.mu.RUnlock()
+	now := time.Now()
+	if ok && now.Before(cached.expireAt) {
+		return cached
+	}
+
+	ttl := Params.DataCoordCfg.DatabaseQuotaCacheTTL.GetAsDuration(time.Second)
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+	fresh := &cachedDatabase{expireAt: now.Add(ttl)}
+	db, err := q.broker.DescribeDatabase(q.ctx, dbName)
+	switch {
+	case errors.Is(err, merr.ErrDatabaseNotFound):
+		q.mu.Lock()
+		delete(q.databases, dbName)
+		q.mu.Unlock()
+		return fresh
+	case err != nil:
+		log.Warn("failed to describe the database, keep the cached background task quotas",
+			zap.String("dbName", dbName), zap.Error(err))
+		if ok {
+			fresh.quota, fresh.tenant = cached.quota, cached.tenant
+		}
+	default:
+		fresh.quota = parseDatabaseQuota(dbName, db.GetProperties())
+		fresh.tenant = db.GetTenantId()
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.databases[dbName] = fresh
+	return fresh
+}
+
+// getTenant returns the TenantID of the database, empty if the database has no TenantID.
+func (q *databaseQuotas) getTenant(dbName string) string {
+	if q == nil {
+		return ""
+	}
+	return q.describe(dbName).tenant
+}
+
+// get returns the quotas of the database, unlimited if the database has no quota.
+func (q *databaseQuotas) get(dbName string) databaseQuota {
+	if q == nil {
+		return databaseQuota{}
+	}
+	return q.describe(dbName).quota
+}
+
+// list returns the cached quotas of the databases having any quota.
+func (q *databaseQuotas) list() map[string]databaseQuota {
+	if q == nil {
+		return nil
+	}
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	quotas := make(map[string]databaseQuota)
+	for dbName, db := range q.databases {
+		if !db.quota.isUnlimited() {
+			quotas[dbName] = db.quota
+		}
+	}
+	return quotas
+}
@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestParseDatabaseQuota(t *testing.T) {
+	quota := parseDatabaseQuota("db1", []*commonpb.KeyValuePair{
+		{Key: common.DatabaseMaxIndexTasksKey, Value: "4"},
+		{Key: common.DatabaseMaxAnalyzeTasksKey, Value: "abc"},
+		{Key: common.DatabaseMaxCompactionSlotsKey, Value: "16"},
+		{Key: common.DatabaseReplicaNumber, Value: "2"},
+	})
+	assert.Equal(t, databaseQuota{indexTasks: 4, compactionSlots: 16}, quota)
+	assert.False(t, quota.isUnlimited())
+	assert.True(t, parseDatabaseQuota("db1", nil).isUnlimited())
+}
+
+func TestDatabaseQuotas(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.DatabaseQuotaCacheTTL.Key, "3600")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.DatabaseQuotaCacheTTL.Key)
+
+	b := broker.NewMockBroker(t)
+	quotas := newDatabaseQuotas(context.Background(), b)
+
+	describe := func(dbName string, properties ...*commonpb.KeyValuePair) *rootcoordpb.DescribeDatabaseResponse {
+		return &rootcoordpb.DescribeDatabaseResponse{Status: merr.Success(), DbName: dbName, Properties: properties}
+	}
+	db1 := describe("db1", &commonpb.KeyValuePair{Key: common.DatabaseMaxIndexTasksKey, Value: "2"})
+	db1.TenantId = "tenant1"
+	b.EXPECT().DescribeDatabase(mock.Anything, "db1").Return(db1, nil).Once()
+	b.EXPECT().DescribeDatabase(mock.Anything, "db2").Return(describe("db2"), nil).Once()
+	assert.Equal(t, databaseQuota{indexTasks: 2}, quotas.get("db1"))
+	assert.True(t, quotas.get("db2").isUnlimited())
+	assert.Equal(t, "tenant1", quotas.getTenant("db1"))
+	assert.Empty(t, quotas.getTenant("db2"))
+	// the databases are described once until the cache expires
+	assert.Equal(t, databaseQuota{indexTasks: 2}, quotas.get("db1"))
+	assert.Equal(t, map[string]databaseQuota{"db1": {indexTasks: 2}}, quotas.list())
+
+	// the cached database is kept if failed to describe it
+	quotas.databases["db1"].expireAt = time.Now()
+	b.EXPECT().DescribeDatabase(mock.Anything, "db1").Return(nil, errors.New("mock")).Once()
+	assert.Equal(t, databaseQuota{indexTasks: 2}, quotas.get("db1"))
+	assert.Equal(t, "tenant1", quotas.getTenant("db1"))
+
+	// the dropped database is uncached
+	quotas.databases["db1"].expireAt = time.Now()
+	b.EXPECT().DescribeDatabase(mock.Anything, "db1").Return(nil, merr.WrapErrDatabaseNotFound("db1")).Once()
+	assert.True(t, quotas.get("db1").isUnlimited())
+	assert.NotContains(t, quotas.databases, "db1")
+	assert.Empty(t, quotas.list())
+
+	// the nil quotas limits nothing
+	var nilQuotas *databaseQuotas
+	assert.True(t, nilQuotas.get("db1").isUnlimited())
+	assert.Empty(t, nilQuotas.list())
+	assert.Empty(t, nilQuotas.getTenant("db1"))
+}
@@ -0,0 +1,554 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// maxDuplicatePKReports is the number of reports kept, the oldest finished ones are evicted.
+const maxDuplicatePKReports = 64
+
+// duplicatePKChecker runs the jobs to detect the primary keys duplicated across the flushed segments of a collection.
+// A job estimates the duplicates by the pk statslogs first, the segments overlapping with others on pk range are scanned,
+// each pk is tested against the bloom filters of the overlapping segments, and the hits are confirmed exactly
+// with the deletes applied. The reports are kept in memory only, and lost after datacoord restarts.
+type duplicatePKChecker struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	meta              *meta
+	handler           Handler
+	allocator         allocator
+	compactionHandler compactionPlanContext
+
+	mu      sync.RWMutex
+	reports map[int64]*datapb.DuplicatePrimaryKeysReport // jobID -> report
+	running typeutil.UniqueSet                           // collections with running job
+}
+
+func newDuplicatePKChecker(meta *meta, handler Handler, allocator allocator, compactionHandler compactionPlanContext) *duplicatePKChecker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &duplicatePKChecker{
+		ctx:               ctx,
+		cancel:            cancel,
+		meta:              meta,
+		handler:           handler,
+		allocator:         allocator,
+		compactionHandler: compactionHandler,
+		reports:           make(map[int64]*datapb.DuplicatePrimaryKeysReport),
+		running:           typeutil.NewUniqueSet(),
+	}
+}
+
+// Submit starts a job to check the duplicate primary keys of the collection, and returns the job id.
+func (c *duplicatePKChecker) Submit(ctx context.Context, collectionID int64, scheduleCompaction bool) (int64, error) {
+	coll, err := c.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		return 0, err
+	}
+	if coll == nil {
+		return 0, merr.WrapErrCollectionNotFound(collectionID)
+	}
+	jobID, err := c.allocator.allocID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.running.Contain(collectionID) {
+		return 0, merr.WrapErrServiceUnavailable(fmt.Sprintf("duplicate primary keys check of collection %d is running", collectionID))
+	}
+	report := &datapb.DuplicatePrimaryKeysReport{
+		JobID:        jobID,
+		CollectionID: collectionID,
+		State:        datapb.DuplicatePrimaryKeysJobState_DuplicateJobEstimating,
+		StartTime:    tsoutil.GetCurrentTime(),
+	}
+	c.reports[jobID] = report
+	c.running.Insert(collectionID)
+	c.evictReports()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.run(jobID, coll, scheduleCompaction)
+	}()
+	return jobID, nil
+}
+
+// GetReport returns a snapshot of the report of the job.
+func (c *duplicatePKChecker) GetReport(jobID int64) (*datapb.DuplicatePrimaryKeysReport, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	report, ok := c.reports[jobID]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(report).(*datapb.DuplicatePrimaryKeysReport), true
+}
+
+func (c *duplicatePKChecker) Close() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+// evictReports removes the oldest finished reports beyond the limit, it must be called with lock held.
+func (c *duplicatePKChecker) evictReports() {
+	if len(c.reports) <= maxDuplicatePKReports {
+		return
+	}
+	finished := lo.Filter(lo.Values(c.reports), func(report *datapb.DuplicatePrimaryKeysReport, _ int) bool {
+		return isDuplicateJobFinished(report.GetState())
+	})
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].GetJobID() < finished[j].GetJobID()
+	})
+	for _, report := range finished {
+		if len(c.reports) <= maxDuplicatePKReports {
+			return
+		}
+		delete(c.reports, report.GetJobID())
+	}
+}
+
+func (c *duplicatePKChecker) update(jobID int64, fn func(report *datapb.DuplicatePrimaryKeysReport)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if report, ok := c.reports[jobID]; ok {
+		fn(report)
+	}
+}
+
+func isDuplicateJobFinished(state datapb.DuplicatePrimaryKeysJobState) bool {
+	return state == datapb.DuplicatePrimaryKeysJobState_DuplicateJobCompleted ||
+		state == datapb.DuplicatePrimaryKeysJobState_DuplicateJobFailed
+}
+
+func (c *duplicatePKChecker) run(jobID int64, coll *collectionInfo, scheduleCompaction bool) {
+	log := log.With(zap.Int64("jobID", jobID), zap.Int64("collectionID", coll.ID))
+	log.Info("start to check duplicate primary keys", zap.Bool("scheduleCompaction", scheduleCompaction))
+	defer func() {
+		c.mu.Lock()
+		c.running.Remove(coll.ID)
+		c.mu.Unlock()
+	}()
+
+	err := c.check(c.ctx, jobID, coll, scheduleCompaction)
+	c.update(jobID, func(report *datapb.DuplicatePrimaryKeysReport) {
+		report.EndTime = tsoutil.GetCurrentTime()
+		if err != nil {
+			report.State = datapb.DuplicatePrimaryKeysJobState_DuplicateJobFailed
+			report.FailReason = err.Error()
+			return
+		}
+		report.State = datapb.DuplicatePrimaryKeysJobState_DuplicateJobCompleted
+	})
+	if err != nil {
+		log.Warn("failed to check duplicate primary keys", zap.Error(err))
+		return
+	}
+	report, _ := c.GetReport(jobID)
+	log.Info("check duplicate primary keys done",
+		zap.Int64("scannedSegments", report.GetScannedSegments()),
+		zap.Int64("estimatedDuplicates", report.GetEstimatedDuplicates()),
+		zap.Int64("exactDuplicates", report.GetExactDuplicates()),
+		zap.Int64("compactionID", report.GetCompactionID()))
+}
+
+// pkSegment is a flushed segment with its pk statistics loaded,
+// nil stats means the statslogs are absent, the segment may contain any pk.
+type pkSegment struct {
+	*SegmentInfo
+	stats []*storage.PkStatistics
+}
+
+func (s *pkSegment) mayContain(pk storage.PrimaryKey) bool {
+	if s.stats == nil {
+		return true
+	}
+	for _, stats := range s.stats {
+		if stats.PkExist(pk) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *pkSegment) overlaps(other *pkSegment) bool {
+	if s.stats == nil || other.stats == nil {
+		return true
+	}
+	for _, a := range s.stats {
+		for _, b := range other.stats {
+			if a.MinPK == nil || b.MinPK == nil || (a.MinPK.LE(b.MaxPK) && b.MinPK.LE(a.MaxPK)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *duplicatePKChecker) check(ctx context.Context, jobID int64, coll *collectionInfo, scheduleCompaction bool) error {
+	pkField, err := typeutil.GetPrimaryFieldSchema(coll.Schema)
+	if err != nil {
+		return err
+	}
+
+	// estimate by the pk statslogs
+	infos := c.meta.SelectSegments(WithCollection(coll.ID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetState() == commonpb.SegmentState_Flushed && segment.GetLevel() != datapb.SegmentLevel_L0
+	}))
+	segments := make([]*pkSegment, 0, len(infos))
+	for _, info := range infos {
+		cloned := info.Clone()
+		if err := binlog.DecompressBinLogs(cloned.SegmentInfo); err != nil {
+			return err
+		}
+		stats, err := c.loadPkStats(ctx, cloned, pkField.GetFieldID())
+		if err != nil {
+			return err
+		}
+		segments = append(segments, &pkSegment{SegmentInfo: cloned, stats: stats})
+	}
+	overlaps := make(map[int64][]*pkSegment, len(segments))
+	for i, a := range segments {
+		for _, b := range segments[i+1:] {
+			if a.overlaps(b) {
+				overlaps[a.GetID()] = append(overlaps[a.GetID()], b)
+				overlaps[b.GetID()] = append(overlaps[b.GetID()], a)
+			}
+		}
+	}
+	c.update(jobID, func(report *datapb.DuplicatePrimaryKeysReport) {
+		report.State = datapb.DuplicatePrimaryKeysJobState_DuplicateJobIdentifying
+		report.TotalSegments = int64(len(segments))
+		report.ScannedSegments = int64(len(overlaps))
+	})
+	if len(overlaps) == 0 {
+		return nil
+	}
+
+	// identify by scanning the pks
+	deleted, err := c.loadDeletes(ctx, coll.ID)
+	if err != nil {
+		return err
+	}
+	occurrences := make(map[any][]int64)
+	for _, segment := range segments {
+		others, ok := overlaps[segment.GetID()]
+		if !ok {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		pks, tss, err := c.loadPks(ctx, segment, pkField)
+		if err != nil {
+			return err
+		}
+		var hits int64
+		for i, pk := range pks {
+			if ts, ok := deleted[pk.GetValue()]; ok && tss[i] < ts {
+				continue
+			}
+			for _, other := range others {
+				if other.mayContain(pk) {
+					hits++
+					occurrences[pk.GetValue()] = append(occurrences[pk.GetValue()], segment.GetID())
+					break
+				}
+			}
+		}
+		c.update(jobID, func(report *datapb.DuplicatePrimaryKeysReport) {
+			report.ScannedRows += int64(len(pks))
+			report.EstimatedDuplicates += hits
+		})
+	}
+
+	duplicates := make([]*datapb.DuplicatePrimaryKey, 0)
+	segmentIDs := typeutil.NewUniqueSet()
+	for pk, ids := range occurrences {
+		ids = lo.Uniq(ids)
+		if len(ids) < 2 {
+			continue
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		duplicates = append(duplicates, &datapb.DuplicatePrimaryKey{Pk: fmt.Sprint(pk), SegmentIDs: ids})
+		segmentIDs.Insert(ids...)
+	}
+	sort.Slice(duplicates, func(i, j int) bool {
+		return duplicates[i].GetPk() < duplicates[j].GetPk()
+	})
+	samples := duplicates
+	if maxSamples := Params.DataCoordCfg.DuplicatePKMaxSamples.GetAsInt(); len(samples) > maxSamples {
+		samples = samples[:maxSamples]
+	}
+	c.update(jobID, func(report *datapb.DuplicatePrimaryKeysReport) {
+		report.ExactDuplicates = int64(len(duplicates))
+		report.Samples = samples
+		report.SegmentIDs = segmentIDs.Collect()
+		sort.Slice(report.SegmentIDs, func(i, j int) bool { return report.SegmentIDs[i] < report.SegmentIDs[j] })
+	})
+
+	if !scheduleCompaction || len(duplicates) == 0 {
+		return nil
+	}
+	return c.scheduleCompaction(ctx, jobID, coll, segments, duplicates)
+}
+
+// loadPkStats loads the pk statistics from the statslogs of the pk field,
+// the compound stats log is preferred if exists.
+func (c *duplicatePKChecker) loadPkStats(ctx context.Context, segment *SegmentInfo, pkFieldID int64) ([]*storage.PkStatistics, error) {
+	var paths []string
+	compound := false
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		if fieldBinlog.GetFieldID() != pkFieldID {
+			continue
+		}
+		for _, statslog := range fieldBinlog.GetBinlogs() {
+			_, logidx := path.Split(statslog.GetLogPath())
+			if logidx == storage.CompoundStatsType.LogIdx() {
+				paths = []string{statslog.GetLogPath()}
+				compound = true
+				break
+			}
+			paths = append(paths, statslog.GetLogPath())
+		}
+	}
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	values, err := c.meta.chunkManager.MultiRead(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := lo.Map(values, func(value []byte, _ int) *storage.Blob {
+		return &storage.Blob{Value: value}
+	})
+	var stats []*storage.PrimaryKeyStats
+	if compound {
+		stats, err = storage.DeserializeStatsList(blobs[0])
+	} else {
+		stats, err = storage.DeserializeStats(blobs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(stats, func(stats *storage.PrimaryKeyStats, _ int) *storage.PkStatistics {
+		return &storage.PkStatistics{
+			PkFilter: stats.BF,
+			MinPK:    stats.MinPk,
+			MaxPK:    stats.MaxPk,
+		}
+	}), nil
+}
+
+// loadDeletes loads the latest delete timestamp of each pk from the deltalogs of the collection.
+func (c *duplicatePKChecker) loadDeletes(ctx context.Context, collectionID int64) (map[any]Timestamp, error) {
+	deleted := make(map[any]Timestamp)
+	segments := c.meta.SelectSegments(WithCollection(collectionID), SegmentFilterFunc(isSegmentHealthy))
+	for _, segment := range segments {
+		if len(segment.GetDeltalogs()) == 0 {
+			continue
+		}
+		cloned := segment.Clone()
+		if err := binlog.DecompressBinLog(storage.DeleteBinlog, cloned.GetCollectionID(), cloned.GetPartitionID(), cloned.GetID(), cloned.GetDeltalogs()); err != nil {
+			return nil, err
+		}
+		paths := make([]string, 0)
+		for _, fieldBinlog := range cloned.GetDeltalogs() {
+			for _, deltalog := range fieldBinlog.GetBinlogs() {
+				paths = append(paths, deltalog.GetLogPath())
+			}
+		}
+		values, err := c.meta.chunkManager.MultiRead(ctx, paths)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			_, _, data, err := storage.NewDeleteCodec().Deserialize([]*storage.Blob{{Value: value}})
+			if err != nil {
+				return nil, err
+			}
+			for i, pk := range data.Pks {
+				if ts, ok := deleted[pk.GetValue()]; !ok || data.Tss[i] > ts {
+					deleted[pk.GetValue()] = data.Tss[i]
+				}
+			}
+		}
+	}
+	return deleted, nil
+}
+
+// loadPks reads the pk and timestamp columns of the segment.
+func (c *duplicatePKChecker) loadPks(ctx context.Context, segment *pkSegment, pkField *schemapb.FieldSchema) ([]storage.PrimaryKey, []Timestamp, error) {
+	paths := make([]string, 0)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if fieldBinlog.GetFieldID() != pkField.GetFieldID() && fieldBinlog.GetFieldID() != common.TimeStampField {
+			continue
+		}
+		for _, insertlog := range fieldBinlog.GetBinlogs() {
+			paths = append(paths, insertlog.GetLogPath())
+		}
+	}
+	if len(paths) == 0 {
+		return nil, nil, nil
+	}
+	values, err := c.meta.chunkManager.MultiRead(ctx, paths)
+	if err != nil {
+		return nil, nil, err
+	}
+	blobs := lo.Map(values, func(value []byte, i int) *storage.Blob {
+		return &storage.Blob{Key: paths[i], Value: value}
+	})
+	_, _, _, data, err := storage.NewInsertCodec().DeserializeAll(blobs)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkData, ok := data.Data[pkField.GetFieldID()]
+	if !ok {
+		return nil, nil, errors.Newf("pk field %d not found in binlogs of segment %d", pkField.GetFieldID(), segment.GetID())
+	}
+	tsData, err := storage.GetTimestampFromInsertData(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pkData.RowNum() != tsData.RowNum() {
+		return nil, nil, errors.Newf("row num of pk %d mismatches with timestamp %d in segment %d", pkData.RowNum(), tsData.RowNum(), segment.GetID())
+	}
+
+	pks := make([]storage.PrimaryKey, 0, pkData.RowNum())
+	tss := make([]Timestamp, 0, pkData.RowNum())
+	for i := 0; i < pkData.RowNum(); i++ {
+		switch pkField.GetDataType() {
+		case schemapb.DataType_Int64:
+			pks = append(pks, storage.NewInt64PrimaryKey(pkData.GetRow(i).(int64)))
+		case schemapb.DataType_VarChar:
+			pks = append(pks, storage.NewVarCharPrimaryKey(pkData.GetRow(i).(string)))
+		default:
+			return nil, nil, merr.WrapErrParameterInvalidMsg("unsupported primary key type %s", pkField.GetDataType().String())
+		}
+		tss = append(tss, Timestamp(tsData.Data[i]))
+	}
+	return pks, tss, nil
+}
+
+// scheduleCompaction schedules a mix compaction with primary keys deduplicated for each partition and channel,
+// the duplicates across partitions or channels can not be removed by compaction, they are left in the report.
+func (c *duplicatePKChecker) scheduleCompaction(ctx context.Context, jobID int64, coll *collectionInfo,
+	segments []*pkSegment, duplicates []*datapb.DuplicatePrimaryKey,
+) error {
+	type group struct {
+		partitionID int64
+		channel     string
+	}
+	segmentGroups := make(map[int64]group, len(segments))
+	segmentRows := make(map[int64]int64, len(segments))
+	for _, segment := range segments {
+		segmentGroups[segment.GetID()] = group{partitionID: segment.GetPartitionID(), channel: segment.GetInsertChannel()}
+		segmentRows[segment.GetID()] = segment.GetNumOfRows()
+	}
+	inputs := make(map[group]typeutil.UniqueSet)
+	for _, duplicate := range duplicates {
+		groups := lo.Uniq(lo.Map(duplicate.GetSegmentIDs(), func(id int64, _ int) group { return segmentGroups[id] }))
+		if len(groups) != 1 {
+			continue
+		}
+		if _, ok := inputs[groups[0]]; !ok {
+			inputs[groups[0]] = typeutil.NewUniqueSet()
+		}
+		inputs[groups[0]].Insert(duplicate.GetSegmentIDs()...)
+	}
+	if len(inputs) == 0 {
+		log.Info("no duplicate primary keys can be removed by compaction", zap.Int64("jobID", jobID))
+		return nil
+	}
+
+	ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll)
+	if err != nil {
+		return err
+	}
+	currentID, _, err := c.allocator.allocN(int64(len(inputs) * 2))
+	if err != nil {
+		return err
+	}
+	pts, _ := tsoutil.ParseTS(ct.startTime)
+	var enqueued int
+	for g, segmentIDs := range inputs {
+		planID, targetSegmentID := currentID, currentID+1
+		currentID += 2
+		var totalRows int64
+		for _, id := range segmentIDs.Collect() {
+			totalRows += segmentRows[id]
+		}
+		err := c.compactionHandler.enqueueCompaction(&datapb.CompactionTask{
+			PlanID:           planID,
+			TriggerID:        jobID,
+			State:            datapb.CompactionTaskState_pipelining,
+			StartTime:        pts.Unix(),
+			TimeoutInSeconds: Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt32(),
+			Type:             datapb.CompactionType_MixCompaction,
+			CollectionTtl:    ct.collectionTTL.Nanoseconds(),
+			CollectionID:     coll.ID,
+			PartitionID:      g.partitionID,
+			Channel:          g.channel,
+			InputSegments:    segmentIDs.Collect(),
+			ResultSegments:   []int64{targetSegmentID},
+			TotalRows:        totalRows,
+			Schema:           coll.Schema,
+			DedupPrimaryKeys: true,
+		})
+		if err != nil {
+			log.Warn("failed to enqueue dedup compaction", zap.Int64("jobID", jobID),
+				zap.Int64("partitionID", g.partitionID), zap.String("channel", g.channel), zap.Error(err))
+			continue
+		}
+		enqueued++
+	}
+	if enqueued == 0 {
+		return merr.WrapErrServiceUnavailable("failed to enqueue dedup compaction")
+	}
+	c.update(jobID, func(report *datapb.DuplicatePrimaryKeysReport) {
+		report.CompactionID = jobID
+	})
+	return nil
+}
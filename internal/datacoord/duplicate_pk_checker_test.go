@@ -0,0 +1,279 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+type DuplicatePKCheckerSuite struct {
+	suite.Suite
+
+	collectionID int64
+	partitionID  int64
+	channel      string
+	schema       *schemapb.CollectionSchema
+	cm           storage.ChunkManager
+	meta         *meta
+	handler      *NMockHandler
+	alloc        *NMockAllocator
+	compaction   *MockCompactionPlanContext
+	checker      *duplicatePKChecker
+}
+
+func (s *DuplicatePKCheckerSuite) SetupTest() {
+	s.collectionID = 100
+	s.partitionID = 10
+	s.channel = "ch-1"
+	s.schema = &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		},
+	}
+	s.cm = storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	s.meta = &meta{segments: NewSegmentsInfo(), chunkManager: s.cm}
+	s.handler = NewNMockHandler(s.T())
+	s.handler.EXPECT().GetCollection(mock.Anything, s.collectionID).Return(&collectionInfo{
+		ID:     s.collectionID,
+		Schema: s.schema,
+	}, nil).Maybe()
+	s.alloc = NewNMockAllocator(s.T())
+	var id int64 = 1000
+	s.alloc.EXPECT().allocID(mock.Anything).RunAndReturn(func(ctx context.Context) (int64, error) {
+		id++
+		return id, nil
+	}).Maybe()
+	s.alloc.EXPECT().allocN(mock.Anything).RunAndReturn(func(n int64) (int64, int64, error) {
+		start := id + 1
+		id += n
+		return start, id + 1, nil
+	}).Maybe()
+	s.compaction = NewMockCompactionPlanContext(s.T())
+	s.checker = newDuplicatePKChecker(s.meta, s.handler, s.alloc, s.compaction)
+}
+
+func (s *DuplicatePKCheckerSuite) TearDownTest() {
+	s.checker.Close()
+}
+
+// addSegment writes the binlogs and statslog of the pks with the same timestamp.
+func (s *DuplicatePKCheckerSuite) addSegment(segmentID int64, pks []int64, ts int64, state commonpb.SegmentState) {
+	ctx := context.Background()
+	data := &storage.InsertData{Data: map[int64]storage.FieldData{
+		common.RowIDField:     &storage.Int64FieldData{Data: pks},
+		common.TimeStampField: &storage.Int64FieldData{Data: lo.RepeatBy(len(pks), func(int) int64 { return ts })},
+		101:                   &storage.Int64FieldData{Data: pks},
+	}}
+	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: s.collectionID, Schema: s.schema})
+	blobs, err := codec.Serialize(s.partitionID, segmentID, data)
+	s.Require().NoError(err)
+
+	binlogs := make([]*datapb.FieldBinlog, 0, len(blobs))
+	for i, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		s.Require().NoError(err)
+		path := metautil.BuildInsertLogPath(s.cm.RootPath(), s.collectionID, s.partitionID, segmentID, fieldID, int64(i+1))
+		s.Require().NoError(s.cm.Write(ctx, path, blob.GetValue()))
+		binlogs = append(binlogs, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogID: int64(i + 1), LogPath: path, EntriesNum: int64(len(pks))}},
+		})
+	}
+	statsBlob, err := codec.SerializePkStatsByData(data)
+	s.Require().NoError(err)
+	statsPath := metautil.BuildStatsLogPath(s.cm.RootPath(), s.collectionID, s.partitionID, segmentID, 101, 100)
+	s.Require().NoError(s.cm.Write(ctx, statsPath, statsBlob.GetValue()))
+
+	s.meta.segments.SetSegment(segmentID, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            segmentID,
+		CollectionID:  s.collectionID,
+		PartitionID:   s.partitionID,
+		InsertChannel: s.channel,
+		State:         state,
+		Level:         datapb.SegmentLevel_L1,
+		NumOfRows:     int64(len(pks)),
+		Binlogs:       binlogs,
+		Statslogs: []*datapb.FieldBinlog{{
+			FieldID: 101,
+			Binlogs: []*datapb.Binlog{{LogID: 100, LogPath: statsPath, EntriesNum: int64(len(pks))}},
+		}},
+	}))
+}
+
+// addDeletes writes the deletes into a L0 segment.
+func (s *DuplicatePKCheckerSuite) addDeletes(segmentID int64, pks []int64, ts uint64) {
+	deleteData := storage.NewDeleteData(nil, nil)
+	for _, pk := range pks {
+		deleteData.Append(storage.NewInt64PrimaryKey(pk), ts)
+	}
+	blob, err := storage.NewDeleteCodec().Serialize(s.collectionID, s.partitionID, segmentID, deleteData)
+	s.Require().NoError(err)
+	path := metautil.BuildDeltaLogPath(s.cm.RootPath(), s.collectionID, s.partitionID, segmentID, 200)
+	s.Require().NoError(s.cm.Write(context.Background(), path, blob.GetValue()))
+
+	s.meta.segments.SetSegment(segmentID, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            segmentID,
+		CollectionID:  s.collectionID,
+		PartitionID:   s.partitionID,
+		InsertChannel: s.channel,
+		State:         commonpb.SegmentState_Flushed,
+		Level:         datapb.SegmentLevel_L0,
+		Deltalogs: []*datapb.FieldBinlog{{
+			Binlogs: []*datapb.Binlog{{LogID: 200, LogPath: path, EntriesNum: int64(len(pks))}},
+		}},
+	}))
+}
+
+func (s *DuplicatePKCheckerSuite) waitReport(jobID int64) *datapb.DuplicatePrimaryKeysReport {
+	var report *datapb.DuplicatePrimaryKeysReport
+	s.Eventually(func() bool {
+		var ok bool
+		report, ok = s.checker.GetReport(jobID)
+		s.Require().True(ok)
+		return isDuplicateJobFinished(report.GetState())
+	}, 10*time.Second, 10*time.Millisecond)
+	return report
+}
+
+func (s *DuplicatePKCheckerSuite) prepareSegments() {
+	s.addSegment(1, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 100, commonpb.SegmentState_Flushed)
+	s.addSegment(2, []int64{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, 200, commonpb.SegmentState_Flushed)
+	s.addSegment(3, []int64{100, 101, 102}, 200, commonpb.SegmentState_Flushed)
+	// growing and dropped segments are not checked
+	s.addSegment(4, []int64{1, 2, 3}, 300, commonpb.SegmentState_Growing)
+	s.addSegment(5, []int64{1, 2, 3}, 300, commonpb.SegmentState_Dropped)
+	// pk 5 is deleted after both rows, pk 6 is deleted between them
+	s.addDeletes(6, []int64{5}, 250)
+	s.addDeletes(7, []int64{6}, 150)
+}
+
+func (s *DuplicatePKCheckerSuite) TestCheck() {
+	s.prepareSegments()
+
+	jobID, err := s.checker.Submit(context.Background(), s.collectionID, false)
+	s.Require().NoError(err)
+	report := s.waitReport(jobID)
+
+	s.Equal(datapb.DuplicatePrimaryKeysJobState_DuplicateJobCompleted, report.GetState(), report.GetFailReason())
+	s.EqualValues(s.collectionID, report.GetCollectionID())
+	s.EqualValues(3, report.GetTotalSegments())
+	s.EqualValues(2, report.GetScannedSegments())
+	s.EqualValues(21, report.GetScannedRows())
+	// 7~10 in segment 1 and 6~10 in segment 2
+	s.EqualValues(9, report.GetEstimatedDuplicates())
+	s.EqualValues(4, report.GetExactDuplicates())
+	s.ElementsMatch([]string{"7", "8", "9", "10"}, lo.Map(report.GetSamples(), func(pk *datapb.DuplicatePrimaryKey, _ int) string {
+		s.Equal([]int64{1, 2}, pk.GetSegmentIDs())
+		return pk.GetPk()
+	}))
+	s.Equal([]int64{1, 2}, report.GetSegmentIDs())
+	s.Zero(report.GetCompactionID())
+	s.NotZero(report.GetEndTime())
+}
+
+func (s *DuplicatePKCheckerSuite) TestCheckWithCompaction() {
+	s.prepareSegments()
+
+	var task *datapb.CompactionTask
+	s.compaction.EXPECT().enqueueCompaction(mock.Anything).RunAndReturn(func(t *datapb.CompactionTask) error {
+		task = t
+		return nil
+	}).Once()
+	jobID, err := s.checker.Submit(context.Background(), s.collectionID, true)
+	s.Require().NoError(err)
+	report := s.waitReport(jobID)
+
+	s.Equal(datapb.DuplicatePrimaryKeysJobState_DuplicateJobCompleted, report.GetState(), report.GetFailReason())
+	s.Equal(jobID, report.GetCompactionID())
+	s.Require().NotNil(task)
+	s.Equal(jobID, task.GetTriggerID())
+	s.True(task.GetDedupPrimaryKeys())
+	s.Equal(datapb.CompactionType_MixCompaction, task.GetType())
+	s.Equal(s.channel, task.GetChannel())
+	s.ElementsMatch([]int64{1, 2}, task.GetInputSegments())
+	s.EqualValues(21, task.GetTotalRows())
+
+	// failed to enqueue
+	s.compaction.EXPECT().enqueueCompaction(mock.Anything).Return(merr.WrapErrCompactionPlanConflict()).Once()
+	jobID, err = s.checker.Submit(context.Background(), s.collectionID, true)
+	s.Require().NoError(err)
+	report = s.waitReport(jobID)
+	s.Equal(datapb.DuplicatePrimaryKeysJobState_DuplicateJobFailed, report.GetState())
+	s.EqualValues(4, report.GetExactDuplicates())
+}
+
+func (s *DuplicatePKCheckerSuite) TestNoOverlap() {
+	s.addSegment(1, []int64{1, 2, 3}, 100, commonpb.SegmentState_Flushed)
+	s.addSegment(2, []int64{4, 5, 6}, 100, commonpb.SegmentState_Flushed)
+
+	jobID, err := s.checker.Submit(context.Background(), s.collectionID, true)
+	s.Require().NoError(err)
+	report := s.waitReport(jobID)
+	s.Equal(datapb.DuplicatePrimaryKeysJobState_DuplicateJobCompleted, report.GetState())
+	s.EqualValues(2, report.GetTotalSegments())
+	s.Zero(report.GetScannedSegments())
+	s.Zero(report.GetExactDuplicates())
+}
+
+func (s *DuplicatePKCheckerSuite) TestFailure() {
+	s.Run("collection not found", func() {
+		s.handler.EXPECT().GetCollection(mock.Anything, int64(999)).Return(nil, errors.New("mock")).Once()
+		_, err := s.checker.Submit(context.Background(), 999, false)
+		s.Error(err)
+	})
+
+	s.Run("job not found", func() {
+		_, ok := s.checker.GetReport(999)
+		s.False(ok)
+	})
+
+	s.Run("binlog missing", func() {
+		s.addSegment(1, []int64{1, 2, 3}, 100, commonpb.SegmentState_Flushed)
+		s.addSegment(2, []int64{1, 2, 3}, 100, commonpb.SegmentState_Flushed)
+		s.Require().NoError(s.cm.RemoveWithPrefix(context.Background(), metautil.BuildInsertLogPath(s.cm.RootPath(), s.collectionID, s.partitionID, 2, 101, 3)))
+
+		jobID, err := s.checker.Submit(context.Background(), s.collectionID, false)
+		s.Require().NoError(err)
+		report := s.waitReport(jobID)
+		s.Equal(datapb.DuplicatePrimaryKeysJobState_DuplicateJobFailed, report.GetState())
+		s.NotEmpty(report.GetFailReason())
+	})
+}
+
+func TestDuplicatePKChecker(t *testing.T) {
+	suite.Run(t, new(DuplicatePKCheckerSuite))
+}
@@ -0,0 +1,278 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/lock"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+type ExportJobFilter func(job *datapb.ExportJob) bool
+
+func WithExportJobStates(states ...datapb.ExportJobState) ExportJobFilter {
+	return func(job *datapb.ExportJob) bool {
+		return lo.Contains(states, job.GetState())
+	}
+}
+
+type UpdateExportJobAction func(job *datapb.ExportJob)
+
+func UpdateExportJobState(state datapb.ExportJobState) UpdateExportJobAction {
+	return func(job *datapb.ExportJob) {
+		job.State = state
+		if state == datapb.ExportJobState_ExportJobCompleted || state == datapb.ExportJobState_ExportJobFailed {
+			job.CompleteTime = time.Now().Format("2006-01-02T15:04:05Z07:00")
+			dur := Params.DataCoordCfg.ImportTaskRetention.GetAsDuration(time.Second)
+			job.CleanupTs = tsoutil.ComposeTSByTime(time.Now().Add(dur), 0)
+		}
+	}
+}
+
+func UpdateExportJobReason(reason string) UpdateExportJobAction {
+	return func(job *datapb.ExportJob) {
+		job.Reason = reason
+	}
+}
+
+type ExportTaskFilter func(task *datapb.ExportTask) bool
+
+func WithExportJob(jobID int64) ExportTaskFilter {
+	return func(task *datapb.ExportTask) bool {
+		return task.GetJobID() == jobID
+	}
+}
+
+func WithExportTaskStates(states ...datapb.ImportTaskStateV2) ExportTaskFilter {
+	return func(task *datapb.ExportTask) bool {
+		return lo.Contains(states, task.GetState())
+	}
+}
+
+type UpdateExportTaskAction func(task *datapb.ExportTask)
+
+func UpdateExportTaskState(state datapb.ImportTaskStateV2) UpdateExportTaskAction {
+	return func(task *datapb.ExportTask) {
+		task.State = state
+	}
+}
+
+func UpdateExportTaskReason(reason string) UpdateExportTaskAction {
+	return func(task *datapb.ExportTask) {
+		task.Reason = reason
+	}
+}
+
+func UpdateExportTaskNodeID(nodeID int64) UpdateExportTaskAction {
+	return func(task *datapb.ExportTask) {
+		task.NodeID = nodeID
+	}
+}
+
+// UpdateExportedSegments merges the progress reported by the datanode into the segments of the task,
+// the segments already exported are kept as they are, so that a retried task never rolls back the progress.
+func UpdateExportedSegments(exported []*datapb.ExportSegment) UpdateExportTaskAction {
+	return func(task *datapb.ExportTask) {
+		exportedMap := lo.KeyBy(exported, func(segment *datapb.ExportSegment) int64 {
+			return segment.GetSegmentID()
+		})
+		for _, segment := range task.GetSegments() {
+			if segment.GetExported() {
+				continue
+			}
+			if e, ok := exportedMap[segment.GetSegmentID()]; ok && e.GetExported() {
+				segment.Exported = true
+				segment.ExportedRows = e.GetExportedRows()
+				segment.OutputFiles = e.GetOutputFiles()
+			}
+		}
+	}
+}
+
+type ExportMeta interface {
+	AddJob(job *datapb.ExportJob) error
+	UpdateJob(jobID int64, actions ...UpdateExportJobAction) error
+	GetJob(jobID int64) *datapb.ExportJob
+	GetJobBy(filters ...ExportJobFilter) []*datapb.ExportJob
+	RemoveJob(jobID int64) error
+
+	AddTask(task *datapb.ExportTask) error
+	UpdateTask(taskID int64, actions ...UpdateExportTaskAction) error
+	GetTask(taskID int64) *datapb.ExportTask
+	GetTaskBy(filters ...ExportTaskFilter) []*datapb.ExportTask
+	RemoveTask(taskID int64) error
+}
+
+type exportMeta struct {
+	mu    lock.RWMutex // guards jobs and tasks
+	jobs  map[int64]*datapb.ExportJob
+	tasks map[int64]*datapb.ExportTask
+
+	catalog metastore.DataCoordCatalog
+}
+
+func NewExportMeta(catalog metastore.DataCoordCatalog) (ExportMeta, error) {
+	restoredJobs, err := catalog.ListExportJobs()
+	if err != nil {
+		return nil, err
+	}
+	restoredTasks, err := catalog.ListExportTasks()
+	if err != nil {
+		return nil, err
+	}
+	return &exportMeta{
+		jobs: lo.KeyBy(restoredJobs, func(job *datapb.ExportJob) int64 {
+			return job.GetJobID()
+		}),
+		tasks: lo.KeyBy(restoredTasks, func(task *datapb.ExportTask) int64 {
+			return task.GetTaskID()
+		}),
+		catalog: catalog,
+	}, nil
+}
+
+func (m *exportMeta) AddJob(job *datapb.ExportJob) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.catalog.SaveExportJob(job)
+	if err != nil {
+		return err
+	}
+	m.jobs[job.GetJobID()] = job
+	return nil
+}
+
+func (m *exportMeta) UpdateJob(jobID int64, actions ...UpdateExportJobAction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if job, ok := m.jobs[jobID]; ok {
+		updatedJob := proto.Clone(job).(*datapb.ExportJob)
+		for _, action := range actions {
+			action(updatedJob)
+		}
+		err := m.catalog.SaveExportJob(updatedJob)
+		if err != nil {
+			return err
+		}
+		m.jobs[jobID] = updatedJob
+	}
+	return nil
+}
+
+func (m *exportMeta) GetJob(jobID int64) *datapb.ExportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.jobs[jobID]
+}
+
+func (m *exportMeta) GetJobBy(filters ...ExportJobFilter) []*datapb.ExportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]*datapb.ExportJob, 0)
+OUTER:
+	for _, job := range m.jobs {
+		for _, f := range filters {
+			if !f(job) {
+				continue OUTER
+			}
+		}
+		ret = append(ret, job)
+	}
+	return ret
+}
+
+func (m *exportMeta) RemoveJob(jobID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.jobs[jobID]; ok {
+		err := m.catalog.DropExportJob(jobID)
+		if err != nil {
+			return err
+		}
+		delete(m.jobs, jobID)
+	}
+	return nil
+}
+
+func (m *exportMeta) AddTask(task *datapb.ExportTask) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.catalog.SaveExportTask(task)
+	if err != nil {
+		return err
+	}
+	m.tasks[task.GetTaskID()] = task
+	return nil
+}
+
+func (m *exportMeta) UpdateTask(taskID int64, actions ...UpdateExportTaskAction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if task, ok := m.tasks[taskID]; ok {
+		updatedTask := proto.Clone(task).(*datapb.ExportTask)
+		for _, action := range actions {
+			action(updatedTask)
+		}
+		err := m.catalog.SaveExportTask(updatedTask)
+		if err != nil {
+			return err
+		}
+		m.tasks[taskID] = updatedTask
+	}
+	return nil
+}
+
+func (m *exportMeta) GetTask(taskID int64) *datapb.ExportTask {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.tasks[taskID]
+}
+
+func (m *exportMeta) GetTaskBy(filters ...ExportTaskFilter) []*datapb.ExportTask {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]*datapb.ExportTask, 0)
+OUTER:
+	for _, task := range m.tasks {
+		for _, f := range filters {
+			if !f(task) {
+				continue OUTER
+			}
+		}
+		ret = append(ret, task)
+	}
+	return ret
+}
+
+func (m *exportMeta) RemoveTask(taskID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.tasks[taskID]; ok {
+		err := m.catalog.DropExportTask(taskID)
+		if err != nil {
+			return err
+		}
+		delete(m.tasks, taskID)
+	}
+	return nil
+}
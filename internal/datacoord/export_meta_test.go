@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreementassert. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestExportMeta_Restore(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs().Return([]*datapb.ExportJob{{JobID: 1}}, nil)
+	catalog.EXPECT().ListExportTasks().Return([]*datapb.ExportTask{{JobID: 1, TaskID: 2}, {JobID: 3, TaskID: 4}}, nil)
+
+	em, err := NewExportMeta(catalog)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(em.GetJobBy()))
+	assert.Equal(t, int64(2), em.GetTaskBy(WithExportJob(1))[0].GetTaskID())
+	assert.Equal(t, 2, len(em.GetTaskBy()))
+
+	mockErr := errors.New("mock error")
+	catalog = mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs().Return(nil, mockErr)
+	_, err = NewExportMeta(catalog)
+	assert.Error(t, err)
+
+	catalog = mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs().Return(nil, nil)
+	catalog.EXPECT().ListExportTasks().Return(nil, mockErr)
+	_, err = NewExportMeta(catalog)
+	assert.Error(t, err)
+}
+
+func TestExportMeta_Job(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs().Return(nil, nil)
+	catalog.EXPECT().ListExportTasks().Return(nil, nil)
+	catalog.EXPECT().SaveExportJob(mock.Anything).Return(nil)
+	catalog.EXPECT().DropExportJob(mock.Anything).Return(nil)
+
+	em, err := NewExportMeta(catalog)
+	assert.NoError(t, err)
+
+	err = em.AddJob(&datapb.ExportJob{JobID: 1, State: datapb.ExportJobState_ExportJobExporting})
+	assert.NoError(t, err)
+	job := em.GetJob(1)
+	err = em.UpdateJob(1, UpdateExportJobState(datapb.ExportJobState_ExportJobFailed), UpdateExportJobReason("mock reason"))
+	assert.NoError(t, err)
+	// the job got before is not changed
+	assert.Equal(t, datapb.ExportJobState_ExportJobExporting, job.GetState())
+	job = em.GetJob(1)
+	assert.Equal(t, datapb.ExportJobState_ExportJobFailed, job.GetState())
+	assert.Equal(t, "mock reason", job.GetReason())
+	assert.NotEmpty(t, job.GetCompleteTime())
+	assert.NotZero(t, job.GetCleanupTs())
+	assert.Equal(t, 1, len(em.GetJobBy(WithExportJobStates(datapb.ExportJobState_ExportJobFailed))))
+	assert.Equal(t, 0, len(em.GetJobBy(WithExportJobStates(datapb.ExportJobState_ExportJobExporting))))
+
+	err = em.RemoveJob(1)
+	assert.NoError(t, err)
+	assert.Nil(t, em.GetJob(1))
+
+	// save failed
+	catalog.ExpectedCalls = nil
+	catalog.EXPECT().SaveExportJob(mock.Anything).Return(errors.New("mock error"))
+	err = em.AddJob(&datapb.ExportJob{JobID: 2})
+	assert.Error(t, err)
+	assert.Nil(t, em.GetJob(2))
+}
+
+func TestExportMeta_Task(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListExportJobs().Return(nil, nil)
+	catalog.EXPECT().ListExportTasks().Return(nil, nil)
+	catalog.EXPECT().SaveExportTask(mock.Anything).Return(nil)
+	catalog.EXPECT().DropExportTask(mock.Anything).Return(nil)
+
+	em, err := NewExportMeta(catalog)
+	assert.NoError(t, err)
+
+	err = em.AddTask(&datapb.ExportTask{
+		JobID:  1,
+		TaskID: 2,
+		Segments: []*datapb.ExportSegment{
+			{SegmentID: 10, NumRows: 100},
+			{SegmentID: 11, NumRows: 100},
+		},
+		NodeID: NullNodeID,
+		State:  datapb.ImportTaskStateV2_Pending,
+	})
+	assert.NoError(t, err)
+
+	err = em.UpdateTask(2,
+		UpdateExportTaskState(datapb.ImportTaskStateV2_InProgress),
+		UpdateExportTaskNodeID(5),
+		UpdateExportedSegments([]*datapb.ExportSegment{
+			{SegmentID: 10, Exported: true, ExportedRows: 90, OutputFiles: []string{"a"}},
+			{SegmentID: 11, ExportedRows: 50},
+		}))
+	assert.NoError(t, err)
+	task := em.GetTask(2)
+	assert.Equal(t, datapb.ImportTaskStateV2_InProgress, task.GetState())
+	assert.Equal(t, int64(5), task.GetNodeID())
+	assert.True(t, task.GetSegments()[0].GetExported())
+	assert.Equal(t, int64(90), task.GetSegments()[0].GetExportedRows())
+	// the segments not exported completely are not merged
+	assert.False(t, task.GetSegments()[1].GetExported())
+	assert.Zero(t, task.GetSegments()[1].GetExportedRows())
+
+	// a retried task never rolls back the exported segments
+	err = em.UpdateTask(2, UpdateExportedSegments([]*datapb.ExportSegment{
+		{SegmentID: 11, Exported: true, ExportedRows: 100, OutputFiles: []string{"b"}},
+	}), UpdateExportTaskReason("retried"))
+	assert.NoError(t, err)
+	task = em.GetTask(2)
+	assert.True(t, task.GetSegments()[0].GetExported())
+	assert.Equal(t, []string{"a"}, task.GetSegments()[0].GetOutputFiles())
+	assert.True(t, task.GetSegments()[1].GetExported())
+	assert.Equal(t, "retried", task.GetReason())
+	assert.Equal(t, 1, len(em.GetTaskBy(WithExportTaskStates(datapb.ImportTaskStateV2_InProgress))))
+
+	err = em.RemoveTask(2)
+	assert.NoError(t, err)
+	assert.Nil(t, em.GetTask(2))
+}
@@ -0,0 +1,265 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// exportScheduler dispatches the export tasks to the DataNodes and tracks the export jobs,
+// the export tasks share the slots of the DataNodes with the import tasks.
+type exportScheduler struct {
+	cluster Cluster
+	emeta   ExportMeta
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func newExportScheduler(cluster Cluster, emeta ExportMeta) *exportScheduler {
+	return &exportScheduler{
+		cluster:   cluster,
+		emeta:     emeta,
+		closeChan: make(chan struct{}),
+	}
+}
+
+func (s *exportScheduler) Start() {
+	log.Info("start export scheduler")
+	ticker := time.NewTicker(Params.DataCoordCfg.ImportScheduleInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closeChan:
+			log.Info("export scheduler exited")
+			return
+		case <-ticker.C:
+			s.process()
+		}
+	}
+}
+
+func (s *exportScheduler) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closeChan)
+	})
+}
+
+func (s *exportScheduler) process() {
+	// the slots are peeked only if there are pending tasks
+	var nodeSlots map[int64]int64
+	getNodeID := func() int64 {
+		if nodeSlots == nil {
+			nodeSlots = peekSlots(s.cluster)
+		}
+		var (
+			nodeID   int64 = NullNodeID
+			maxSlots int64 = 0
+		)
+		for id, slots := range nodeSlots {
+			if slots > maxSlots {
+				nodeID = id
+				maxSlots = slots
+			}
+		}
+		if nodeID != NullNodeID {
+			nodeSlots[nodeID]--
+		}
+		return nodeID
+	}
+
+	for _, job := range s.emeta.GetJobBy() {
+		switch job.GetState() {
+		case datapb.ExportJobState_ExportJobExporting:
+			s.processExportingJob(job, getNodeID)
+		case datapb.ExportJobState_ExportJobCompleted, datapb.ExportJobState_ExportJobFailed:
+			s.processFinishedJob(job)
+		}
+	}
+}
+
+func (s *exportScheduler) processExportingJob(job *datapb.ExportJob, getNodeID func() int64) {
+	tasks := s.emeta.GetTaskBy(WithExportJob(job.GetJobID()))
+	for _, task := range tasks {
+		switch task.GetState() {
+		case datapb.ImportTaskStateV2_Pending:
+			s.processPendingTask(job, task, getNodeID)
+		case datapb.ImportTaskStateV2_InProgress:
+			s.processInProgressTask(task)
+		}
+	}
+
+	tasks = s.emeta.GetTaskBy(WithExportJob(job.GetJobID()))
+	if failed, ok := lo.Find(tasks, func(task *datapb.ExportTask) bool {
+		return task.GetState() == datapb.ImportTaskStateV2_Failed
+	}); ok {
+		reason := fmt.Sprintf("export task %d failed, reason: %s", failed.GetTaskID(), failed.GetReason())
+		err := s.emeta.UpdateJob(job.GetJobID(), UpdateExportJobState(datapb.ExportJobState_ExportJobFailed), UpdateExportJobReason(reason))
+		if err != nil {
+			log.Warn("failed to update export job state", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
+			return
+		}
+		log.Warn("export job failed", zap.Int64("jobID", job.GetJobID()), zap.String("reason", reason))
+		return
+	}
+	if lo.EveryBy(tasks, func(task *datapb.ExportTask) bool {
+		return task.GetState() == datapb.ImportTaskStateV2_Completed
+	}) {
+		err := s.emeta.UpdateJob(job.GetJobID(), UpdateExportJobState(datapb.ExportJobState_ExportJobCompleted))
+		if err != nil {
+			log.Warn("failed to update export job state", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
+			return
+		}
+		log.Info("export job completed", zap.Int64("jobID", job.GetJobID()), zap.Int("taskNum", len(tasks)))
+	}
+}
+
+func (s *exportScheduler) processPendingTask(job *datapb.ExportJob, task *datapb.ExportTask, getNodeID func() int64) {
+	nodeID := getNodeID()
+	if nodeID == NullNodeID {
+		return
+	}
+	log := log.With(zap.Int64("jobID", task.GetJobID()), zap.Int64("taskID", task.GetTaskID()), zap.Int64("nodeID", nodeID))
+	// the segments exported by the previous attempts are skipped
+	segments := lo.Filter(task.GetSegments(), func(segment *datapb.ExportSegment, _ int) bool {
+		return !segment.GetExported()
+	})
+	req := &datapb.ExportRequest{
+		JobID:        task.GetJobID(),
+		TaskID:       task.GetTaskID(),
+		CollectionID: task.GetCollectionID(),
+		Schema:       job.GetSchema(),
+		Segments:     segments,
+		L0Segments:   task.GetL0Segments(),
+		OutputPath:   job.GetOutputPath(),
+		Ts:           job.GetTs(),
+	}
+	err := s.cluster.ExportV2(nodeID, req)
+	if err != nil {
+		log.Warn("export failed", zap.Error(err))
+		return
+	}
+	err = s.emeta.UpdateTask(task.GetTaskID(),
+		UpdateExportTaskState(datapb.ImportTaskStateV2_InProgress),
+		UpdateExportTaskNodeID(nodeID))
+	if err != nil {
+		log.Warn("update export task failed", zap.Error(err))
+		return
+	}
+	log.Info("process pending export task done", zap.Int("segmentNum", len(segments)))
+}
+
+func (s *exportScheduler) processInProgressTask(task *datapb.ExportTask) {
+	log := log.With(zap.Int64("jobID", task.GetJobID()), zap.Int64("taskID", task.GetTaskID()), zap.Int64("nodeID", task.GetNodeID()))
+	req := &datapb.QueryExportRequest{
+		JobID:  task.GetJobID(),
+		TaskID: task.GetTaskID(),
+	}
+	resp, err := s.cluster.QueryExport(task.GetNodeID(), req)
+	if err != nil {
+		// the task is lost with the datanode, resends the segments not exported yet
+		updateErr := s.emeta.UpdateTask(task.GetTaskID(),
+			UpdateExportTaskState(datapb.ImportTaskStateV2_Pending),
+			UpdateExportTaskNodeID(NullNodeID))
+		if updateErr != nil {
+			log.Warn("failed to update export task state to pending", zap.Error(updateErr))
+		}
+		log.Info("reset export task state to pending due to error occurs", zap.Error(err))
+		return
+	}
+	actions := []UpdateExportTaskAction{UpdateExportedSegments(resp.GetExportedSegments())}
+	switch resp.GetState() {
+	case datapb.ImportTaskStateV2_Failed:
+		actions = append(actions,
+			UpdateExportTaskState(datapb.ImportTaskStateV2_Failed),
+			UpdateExportTaskReason(resp.GetReason()))
+	case datapb.ImportTaskStateV2_Completed:
+		actions = append(actions, UpdateExportTaskState(datapb.ImportTaskStateV2_Completed))
+	}
+	err = s.emeta.UpdateTask(task.GetTaskID(), actions...)
+	if err != nil {
+		log.Warn("update export task failed", zap.Error(err))
+		return
+	}
+	if resp.GetState() == datapb.ImportTaskStateV2_Completed {
+		s.dropTask(task)
+	}
+	log.Info("query export", zap.String("state", resp.GetState().String()),
+		zap.String("reason", resp.GetReason()), zap.Int("exportedSegmentNum", len(resp.GetExportedSegments())))
+}
+
+// processFinishedJob releases the tasks on the DataNodes and removes the job once it reaches the retention.
+func (s *exportScheduler) processFinishedJob(job *datapb.ExportJob) {
+	tasks := s.emeta.GetTaskBy(WithExportJob(job.GetJobID()))
+	for _, task := range tasks {
+		s.dropTask(task)
+	}
+
+	cleanupTime := tsoutil.PhysicalTime(job.GetCleanupTs())
+	if time.Now().Before(cleanupTime) {
+		return
+	}
+	for _, task := range s.emeta.GetTaskBy(WithExportJob(job.GetJobID())) {
+		if task.GetNodeID() != NullNodeID {
+			return
+		}
+		err := s.emeta.RemoveTask(task.GetTaskID())
+		if err != nil {
+			log.Warn("remove export task failed during GC", zap.Int64("jobID", job.GetJobID()),
+				zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+			return
+		}
+	}
+	err := s.emeta.RemoveJob(job.GetJobID())
+	if err != nil {
+		log.Warn("remove export job failed", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
+		return
+	}
+	log.Info("export job removed", zap.Int64("jobID", job.GetJobID()))
+}
+
+// dropTask drops the task from the DataNode, export tasks are dropped by DropImport as the import tasks.
+func (s *exportScheduler) dropTask(task *datapb.ExportTask) {
+	if task.GetNodeID() == NullNodeID {
+		return
+	}
+	req := &datapb.DropImportRequest{
+		JobID:  task.GetJobID(),
+		TaskID: task.GetTaskID(),
+	}
+	err := s.cluster.DropImport(task.GetNodeID(), req)
+	if err != nil && !errors.Is(err, merr.ErrNodeNotFound) {
+		log.Warn("drop export task failed", zap.Int64("jobID", task.GetJobID()),
+			zap.Int64("taskID", task.GetTaskID()), zap.Int64("nodeID", task.GetNodeID()), zap.Error(err))
+		return
+	}
+	err = s.emeta.UpdateTask(task.GetTaskID(), UpdateExportTaskNodeID(NullNodeID))
+	if err != nil {
+		log.Warn("update export task failed", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+	}
+}
@@ -0,0 +1,203 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreementassert. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type ExportSchedulerSuite struct {
+	suite.Suite
+
+	catalog   *mocks.DataCoordCatalog
+	cluster   *MockCluster
+	emeta     ExportMeta
+	scheduler *exportScheduler
+}
+
+func (s *ExportSchedulerSuite) SetupTest() {
+	var err error
+	s.catalog = mocks.NewDataCoordCatalog(s.T())
+	s.catalog.EXPECT().ListExportJobs().Return(nil, nil)
+	s.catalog.EXPECT().ListExportTasks().Return(nil, nil)
+	s.catalog.EXPECT().SaveExportJob(mock.Anything).Return(nil)
+	s.catalog.EXPECT().SaveExportTask(mock.Anything).Return(nil)
+	s.cluster = NewMockCluster(s.T())
+	s.emeta, err = NewExportMeta(s.catalog)
+	s.NoError(err)
+	s.scheduler = newExportScheduler(s.cluster, s.emeta)
+
+	err = s.emeta.AddTask(&datapb.ExportTask{
+		JobID:        1,
+		TaskID:       2,
+		CollectionID: 100,
+		Segments: []*datapb.ExportSegment{
+			{SegmentID: 10, NumRows: 100},
+			{SegmentID: 11, NumRows: 100},
+		},
+		L0Segments: []*datapb.ExportSegment{{SegmentID: 12}},
+		NodeID:     NullNodeID,
+		State:      datapb.ImportTaskStateV2_Pending,
+	})
+	s.NoError(err)
+	err = s.emeta.AddJob(&datapb.ExportJob{
+		JobID:        1,
+		CollectionID: 100,
+		Schema:       &schemapb.CollectionSchema{},
+		OutputPath:   "export",
+		Ts:           1000,
+		State:        datapb.ExportJobState_ExportJobExporting,
+	})
+	s.NoError(err)
+}
+
+func (s *ExportSchedulerSuite) mockSlots(nodeID int64, slots int64) {
+	s.cluster.EXPECT().GetSessions().Return([]*Session{{info: &NodeInfo{NodeID: nodeID}}}).Once()
+	s.cluster.EXPECT().QueryImport(nodeID, mock.Anything).Return(&datapb.QueryImportResponse{
+		Status: merr.Success(),
+		Slots:  slots,
+	}, nil).Once()
+}
+
+func (s *ExportSchedulerSuite) TestProcess() {
+	const nodeID = 10
+
+	// no free slots
+	s.mockSlots(nodeID, 0)
+	s.scheduler.process()
+	s.Equal(datapb.ImportTaskStateV2_Pending, s.emeta.GetTask(2).GetState())
+
+	// pending -> inProgress
+	s.mockSlots(nodeID, 1)
+	s.cluster.EXPECT().ExportV2(int64(nodeID), mock.Anything).RunAndReturn(func(nodeID int64, req *datapb.ExportRequest) error {
+		s.Equal(int64(2), req.GetTaskID())
+		s.Equal(2, len(req.GetSegments()))
+		s.Equal(1, len(req.GetL0Segments()))
+		s.Equal(uint64(1000), req.GetTs())
+		s.Equal("export", req.GetOutputPath())
+		return nil
+	}).Once()
+	s.scheduler.process()
+	task := s.emeta.GetTask(2)
+	s.Equal(datapb.ImportTaskStateV2_InProgress, task.GetState())
+	s.Equal(int64(nodeID), task.GetNodeID())
+
+	// query failed, inProgress -> pending
+	s.cluster.EXPECT().QueryExport(int64(nodeID), mock.Anything).Return(nil, merr.ErrNodeNotFound).Once()
+	s.scheduler.process()
+	task = s.emeta.GetTask(2)
+	s.Equal(datapb.ImportTaskStateV2_Pending, task.GetState())
+	s.Equal(int64(NullNodeID), task.GetNodeID())
+
+	// resend, then one segment exported
+	s.mockSlots(nodeID, 1)
+	s.cluster.EXPECT().ExportV2(int64(nodeID), mock.Anything).Return(nil).Once()
+	s.scheduler.process()
+	s.cluster.EXPECT().QueryExport(int64(nodeID), mock.Anything).Return(&datapb.QueryExportResponse{
+		Status: merr.Success(),
+		State:  datapb.ImportTaskStateV2_InProgress,
+		ExportedSegments: []*datapb.ExportSegment{
+			{SegmentID: 10, Exported: true, ExportedRows: 100, OutputFiles: []string{"export/1/10_0.parquet"}},
+		},
+	}, nil).Once()
+	s.scheduler.process()
+	task = s.emeta.GetTask(2)
+	s.Equal(datapb.ImportTaskStateV2_InProgress, task.GetState())
+	s.True(task.GetSegments()[0].GetExported())
+
+	// lost again, only the segment not exported is resent
+	s.cluster.EXPECT().QueryExport(int64(nodeID), mock.Anything).Return(nil, merr.ErrNodeNotFound).Once()
+	s.scheduler.process()
+	s.mockSlots(nodeID, 1)
+	s.cluster.EXPECT().ExportV2(int64(nodeID), mock.Anything).RunAndReturn(func(nodeID int64, req *datapb.ExportRequest) error {
+		s.Equal(1, len(req.GetSegments()))
+		s.Equal(int64(11), req.GetSegments()[0].GetSegmentID())
+		return nil
+	}).Once()
+	s.scheduler.process()
+
+	// inProgress -> completed, the job is completed
+	s.cluster.EXPECT().QueryExport(int64(nodeID), mock.Anything).Return(&datapb.QueryExportResponse{
+		Status: merr.Success(),
+		State:  datapb.ImportTaskStateV2_Completed,
+		ExportedSegments: []*datapb.ExportSegment{
+			{SegmentID: 11, Exported: true, ExportedRows: 100, OutputFiles: []string{"export/1/11_0.parquet"}},
+		},
+	}, nil).Once()
+	s.cluster.EXPECT().DropImport(int64(nodeID), mock.Anything).Return(nil).Once()
+	s.scheduler.process()
+	task = s.emeta.GetTask(2)
+	s.Equal(datapb.ImportTaskStateV2_Completed, task.GetState())
+	s.Equal(int64(NullNodeID), task.GetNodeID())
+	s.True(task.GetSegments()[1].GetExported())
+	job := s.emeta.GetJob(1)
+	s.Equal(datapb.ExportJobState_ExportJobCompleted, job.GetState())
+	s.NotZero(job.GetCleanupTs())
+
+	// reached the retention, the job and the tasks are removed
+	s.catalog.EXPECT().DropExportTask(int64(2)).Return(nil).Once()
+	s.catalog.EXPECT().DropExportJob(int64(1)).Return(nil).Once()
+	err := s.emeta.UpdateJob(1, func(job *datapb.ExportJob) {
+		job.CleanupTs = 0
+	})
+	s.NoError(err)
+	s.scheduler.process()
+	s.Nil(s.emeta.GetJob(1))
+	s.Nil(s.emeta.GetTask(2))
+}
+
+func (s *ExportSchedulerSuite) TestProcessFailed() {
+	const nodeID = 10
+
+	s.mockSlots(nodeID, 1)
+	s.cluster.EXPECT().ExportV2(int64(nodeID), mock.Anything).Return(nil).Once()
+	s.scheduler.process()
+
+	// inProgress -> failed, the job is failed and the task is dropped from the datanode
+	s.cluster.EXPECT().QueryExport(int64(nodeID), mock.Anything).Return(&datapb.QueryExportResponse{
+		Status: merr.Success(),
+		State:  datapb.ImportTaskStateV2_Failed,
+		Reason: "mock reason",
+	}, nil).Once()
+	s.scheduler.process()
+	task := s.emeta.GetTask(2)
+	s.Equal(datapb.ImportTaskStateV2_Failed, task.GetState())
+	job := s.emeta.GetJob(1)
+	s.Equal(datapb.ExportJobState_ExportJobFailed, job.GetState())
+	s.Contains(job.GetReason(), "mock reason")
+
+	// drop failed, retried in the next round
+	s.cluster.EXPECT().DropImport(int64(nodeID), mock.Anything).Return(errors.New("mock error")).Once()
+	s.scheduler.process()
+	s.Equal(int64(nodeID), s.emeta.GetTask(2).GetNodeID())
+	s.cluster.EXPECT().DropImport(int64(nodeID), mock.Anything).Return(nil).Once()
+	s.scheduler.process()
+	s.Equal(int64(NullNodeID), s.emeta.GetTask(2).GetNodeID())
+}
+
+func TestExportScheduler(t *testing.T) {
+	suite.Run(t, new(ExportSchedulerSuite))
+}
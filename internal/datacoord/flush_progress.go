@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// flushProgressTTL is how long the reported flush progress of a segment is kept without being reported again,
+// the DataNodes report the progress of the unflushed segments with each time tick.
+const flushProgressTTL = time.Minute
+
+type reportedFlushProgress struct {
+	progress   *datapb.SegmentFlushProgress
+	reportTime time.Time
+}
+
+// flushProgressTracker keeps the latest flush progress of the segments reported by the DataNodes in memory.
+type flushProgressTracker struct {
+	mu       sync.RWMutex
+	segments map[int64]*reportedFlushProgress // segmentID -> progress
+}
+
+func newFlushProgressTracker() *flushProgressTracker {
+	return &flushProgressTracker{
+		segments: make(map[int64]*reportedFlushProgress),
+	}
+}
+
+// update records the flush progress reported by the DataNode, and evicts the expired ones.
+func (t *flushProgressTracker) update(nodeID int64, progress []*datapb.SegmentFlushProgress) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	for _, p := range progress {
+		p.NodeID = nodeID
+		t.segments[p.GetSegmentID()] = &reportedFlushProgress{
+			progress:   p,
+			reportTime: now,
+		}
+	}
+	for segmentID, reported := range t.segments {
+		if now.Sub(reported.reportTime) > flushProgressTTL {
+			delete(t.segments, segmentID)
+		}
+	}
+}
+
+// get returns the copy of the flush progress of the segment if it's reported and not expired.
+func (t *flushProgressTracker) get(segmentID int64) (*datapb.SegmentFlushProgress, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	reported, ok := t.segments[segmentID]
+	if !ok || time.Since(reported.reportTime) > flushProgressTTL {
+		return nil, false
+	}
+	return proto.Clone(reported.progress).(*datapb.SegmentFlushProgress), true
+}
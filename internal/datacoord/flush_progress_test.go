@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestFlushProgressTracker(t *testing.T) {
+	tracker := newFlushProgressTracker()
+
+	_, ok := tracker.get(1)
+	assert.False(t, ok)
+
+	tracker.update(10, []*datapb.SegmentFlushProgress{
+		{SegmentID: 1, Channel: "ch1", PendingBinlogBytes: 1024},
+		{SegmentID: 2, Channel: "ch1"},
+	})
+	progress, ok := tracker.get(1)
+	assert.True(t, ok)
+	assert.EqualValues(t, 10, progress.GetNodeID())
+	assert.EqualValues(t, 1024, progress.GetPendingBinlogBytes())
+
+	// the returned progress is a copy
+	progress.PendingBinlogBytes = 0
+	progress, _ = tracker.get(1)
+	assert.EqualValues(t, 1024, progress.GetPendingBinlogBytes())
+
+	// the segment is reported by another node after the channel is moved
+	tracker.update(11, []*datapb.SegmentFlushProgress{
+		{SegmentID: 1, Channel: "ch1", PendingBinlogBytes: 2048},
+	})
+	progress, _ = tracker.get(1)
+	assert.EqualValues(t, 11, progress.GetNodeID())
+	assert.EqualValues(t, 2048, progress.GetPendingBinlogBytes())
+
+	// the progress not reported within the ttl is expired and evicted
+	tracker.segments[2].reportTime = time.Now().Add(-2 * flushProgressTTL)
+	_, ok = tracker.get(2)
+	assert.False(t, ok)
+	tracker.update(11, nil)
+	assert.NotContains(t, tracker.segments, int64(2))
+	assert.Contains(t, tracker.segments, int64(1))
+}
@@ -23,7 +23,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -41,6 +40,8 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -53,6 +54,8 @@ type GcOption struct {
 	dropTolerance    time.Duration        // dropped segment related key tolerance time
 	scanInterval     time.Duration        // interval for scan residue for interupted log wrttien
 
+	segmentIndexRetention time.Duration // retention of terminal segment index records of dropped segments
+
 	removeObjectPool *conc.Pool[struct{}]
 }
 
@@ -66,6 +69,9 @@ type garbageCollector struct {
 	meta    *meta
 	handler Handler
 
+	// limits the batched remove requests to object storage
+	removeLimiter *ratelimitutil.Limiter
+
 	startOnce  sync.Once
 	stopOnce   sync.Once
 	wg         sync.WaitGroup
@@ -85,16 +91,19 @@ func newGarbageCollector(meta *meta, handler Handler, opt GcOption) *garbageColl
 		zap.Duration("interval", opt.checkInterval),
 		zap.Duration("scanInterval", opt.scanInterval),
 		zap.Duration("missingTolerance", opt.missingTolerance),
-		zap.Duration("dropTolerance", opt.dropTolerance))
+		zap.Duration("dropTolerance", opt.dropTolerance),
+		zap.Duration("segmentIndexRetention", opt.segmentIndexRetention))
 	opt.removeObjectPool = conc.NewPool[struct{}](Params.DataCoordCfg.GCRemoveConcurrent.GetAsInt(), conc.WithExpiryDuration(time.Minute))
 	ctx, cancel := context.WithCancel(context.Background())
+	removeLimit := getRemoveLimit()
 	return &garbageCollector{
-		ctx:     ctx,
-		cancel:  cancel,
-		meta:    meta,
-		handler: handler,
-		option:  opt,
-		cmdCh:   make(chan gcCmd),
+		ctx:           ctx,
+		cancel:        cancel,
+		meta:          meta,
+		handler:       handler,
+		option:        opt,
+		removeLimiter: ratelimitutil.NewLimiter(removeLimit, float64(removeLimit)),
+		cmdCh:         make(chan gcCmd),
 	}
 }
 
@@ -160,7 +169,10 @@ func (gc *garbageCollector) work(ctx context.Context) {
 			gc.recycleChannelCPMeta(ctx)
 			gc.recycleUnusedIndexes(ctx)
 			gc.recycleUnusedSegIndexes(ctx)
+			gc.pruneSegmentIndexes(ctx, gc.option.segmentIndexRetention)
 			gc.recycleUnusedAnalyzeFiles(ctx)
+			gc.recycleUnusedStatsTasks(ctx)
+			gc.recycleExpiredSegmentReferences(ctx)
 		})
 	}()
 	go func() {
@@ -234,6 +246,15 @@ func (gc *garbageCollector) close() {
 	})
 }
 
+// isInsertLogReferenced returns whether the insert log is referenced by its segment or by the segments cloned from it.
+func (gc *garbageCollector) isInsertLogReferenced(filePath string, segment *SegmentInfo, logID int64) bool {
+	if segment != nil && segment.IsInsertLogExists(logID) {
+		return true
+	}
+	segmentID, err := storage.ParseSegmentIDByBinlog(gc.option.cli.RootPath(), filePath)
+	return err == nil && gc.meta.IsInsertLogShared(segmentID, logID)
+}
+
 // recycleUnusedBinlogFiles load meta file info and compares OSS keys
 // if missing found, performs gc cleanup
 func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
@@ -242,16 +263,31 @@ func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
 	log.Info("start recycleUnusedBinlogFiles...")
 	defer func() { log.Info("recycleUnusedBinlogFiles done", zap.Duration("timeCost", time.Since(start))) }()
 
-	type scanTask struct {
-		prefix  string
-		checker func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool
-		label   string
+	for _, task := range gc.getBinlogScanTasks() {
+		gc.recycleUnusedBinLogWithChecker(ctx, task.prefix, task.label, task.checker)
 	}
-	scanTasks := []scanTask{
+	metrics.GarbageCollectorRunCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Add(1)
+}
+
+// binlogScanTask is the prefix of binlogs to scan, the file is not referenced by the meta if the checker returns false.
+type binlogScanTask struct {
+	prefix  string
+	checker func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool
+	label   string
+}
+
+func (gc *garbageCollector) getBinlogScanTasks() []binlogScanTask {
+	return []binlogScanTask{
 		{
 			prefix: path.Join(gc.option.cli.RootPath(), common.SegmentInsertLogPath),
 			checker: func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool {
-				return segment != nil
+				// the insert logs not referenced by the segment are the leftovers of the failed or retried syncs
+				logID, err := binlog.GetLogIDFromBingLogPath(objectInfo.FilePath)
+				if err != nil {
+					log.Warn("garbageCollector find dirty insert log", zap.String("filePath", objectInfo.FilePath), zap.Error(err))
+					return false
+				}
+				return gc.isInsertLogReferenced(objectInfo.FilePath, segment, logID)
 			},
 			label: metrics.InsertFileLabel,
 		},
@@ -267,6 +303,19 @@ func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
 			},
 			label: metrics.StatFileLabel,
 		},
+		{
+			prefix: path.Join(gc.option.cli.RootPath(), common.SegmentQuantizedLogPath),
+			checker: func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool {
+				// the quantized codes live as long as the insert log of the same log id
+				logID, err := binlog.GetLogIDFromBingLogPath(objectInfo.FilePath)
+				if err != nil {
+					log.Warn("garbageCollector find dirty quantized log", zap.String("filePath", objectInfo.FilePath), zap.Error(err))
+					return false
+				}
+				return gc.isInsertLogReferenced(objectInfo.FilePath, segment, logID)
+			},
+			label: metrics.QuantizedFileLabel,
+		},
 		{
 			prefix: path.Join(gc.option.cli.RootPath(), common.SegmentDeltaLogPath),
 			checker: func(objectInfo *storage.ChunkObjectInfo, segment *SegmentInfo) bool {
@@ -280,11 +329,6 @@ func (gc *garbageCollector) recycleUnusedBinlogFiles(ctx context.Context) {
 			label: metrics.DeleteFileLabel,
 		},
 	}
-
-	for _, task := range scanTasks {
-		gc.recycleUnusedBinLogWithChecker(ctx, task.prefix, task.label, task.checker)
-	}
-	metrics.GarbageCollectorRunCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Add(1)
 }
 
 // recycleUnusedBinLogWithChecker scans the prefix and checks the path with checker.
@@ -295,11 +339,10 @@ func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context,
 	lastFilePath := ""
 	total := 0
 	valid := 0
-	unexpectedFailure := atomic.NewInt32(0)
-	removed := atomic.NewInt32(0)
+	unexpectedFailure := 0
 	start := time.Now()
 
-	futures := make([]*conc.Future[struct{}], 0)
+	batcher := gc.newRemoveBatcher(ctx)
 	err := gc.option.cli.WalkWithPrefix(ctx, prefix, true, func(chunkInfo *storage.ChunkObjectInfo) bool {
 		total++
 		lastFilePath = chunkInfo.FilePath
@@ -314,7 +357,7 @@ func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context,
 		// TODO: Does all files in the same segment have the same segmentID?
 		segmentID, err := storage.ParseSegmentIDByBinlog(gc.option.cli.RootPath(), chunkInfo.FilePath)
 		if err != nil {
-			unexpectedFailure.Inc()
+			unexpectedFailure++
 			logger.Warn("garbageCollector recycleUnusedBinlogFiles parse segment id error",
 				zap.String("filePath", chunkInfo.FilePath),
 				zap.Error(err))
@@ -329,25 +372,12 @@ func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context,
 		}
 
 		// ignore error since it could be cleaned up next time
-		file := chunkInfo.FilePath
-		future := gc.option.removeObjectPool.Submit(func() (struct{}, error) {
-			logger := logger.With(zap.String("file", file))
-			logger.Info("garbageCollector recycleUnusedBinlogFiles remove file...")
-
-			if err = gc.option.cli.Remove(ctx, file); err != nil {
-				log.Warn("garbageCollector recycleUnusedBinlogFiles remove file failed", zap.Error(err))
-				unexpectedFailure.Inc()
-				return struct{}{}, err
-			}
-			log.Info("garbageCollector recycleUnusedBinlogFiles remove file success")
-			removed.Inc()
-			return struct{}{}, nil
-		})
-		futures = append(futures, future)
+		logger.Info("garbageCollector recycleUnusedBinlogFiles remove file...", zap.String("file", chunkInfo.FilePath))
+		batcher.add(chunkInfo.FilePath)
 		return true
 	})
-	// Wait for all remove tasks done.
-	if err := conc.BlockOnAll(futures...); err != nil {
+	// Wait for all remove batches done.
+	if err := batcher.wait(); err != nil {
 		// error is logged, and can be ignored here.
 		logger.Warn("some task failure in remove object pool", zap.Error(err))
 	}
@@ -356,8 +386,8 @@ func (gc *garbageCollector) recycleUnusedBinLogWithChecker(ctx context.Context,
 	logger.Info("garbageCollector recycleUnusedBinlogFiles done",
 		zap.Int("total", total),
 		zap.Int("valid", valid),
-		zap.Int("unexpectedFailure", int(unexpectedFailure.Load())),
-		zap.Int("removed", int(removed.Load())),
+		zap.Int("unexpectedFailure", unexpectedFailure+int(batcher.failed.Load())),
+		zap.Int("removed", int(batcher.removed.Load())),
 		zap.String("lastFilePath", lastFilePath),
 		zap.Duration("cost", cost),
 		zap.Error(err))
@@ -371,10 +401,11 @@ func (gc *garbageCollector) checkDroppedSegmentGC(segment *SegmentInfo,
 	childSegment *SegmentInfo,
 	indexSet typeutil.UniqueSet,
 	cpTimestamp Timestamp,
+	dropTolerance time.Duration,
 ) bool {
 	log := log.With(zap.Int64("segmentID", segment.ID))
 
-	if !gc.isExpire(segment.GetDroppedAt()) {
+	if !isExpire(segment.GetDroppedAt(), dropTolerance) {
 		return false
 	}
 	isCompacted := childSegment != nil || segment.GetCompacted()
@@ -413,6 +444,50 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 	log.Info("start clear dropped segments...")
 	defer func() { log.Info("clear dropped segments done", zap.Duration("timeCost", time.Since(start))) }()
 
+	drops := gc.getRecyclableDroppedSegments(ctx, gc.option.dropTolerance)
+	log.Info("start to GC segments", zap.Int("drop_num", len(drops)))
+	for _, segment := range drops {
+		if ctx.Err() != nil {
+			// process canceled, stop.
+			return
+		}
+
+		log := log.With(zap.Int64("segmentID", segment.GetID()))
+		logs := getLogs(segment)
+		if segment.GetBinlogsSource() != nil || gc.meta.HasSegmentClones(segment.GetID()) {
+			// the shared insert logs are recycled by the binlog scan once no segment references them
+			for _, flog := range segment.GetBinlogs() {
+				for _, l := range flog.GetBinlogs() {
+					delete(logs, l.GetLogPath())
+				}
+			}
+		}
+		log.Info("GC segment start...", zap.Int("insert_logs", len(segment.GetBinlogs())),
+			zap.Int("delta_logs", len(segment.GetDeltalogs())),
+			zap.Int("stats_logs", len(segment.GetStatslogs())))
+		// the safe ts is advanced before any file is removed, so that it never claims a recycled snapshot
+		droppedTs := tsoutil.ComposeTSByTime(time.Unix(0, int64(segment.GetDroppedAt())), 0)
+		if err := gc.meta.AdvanceGcSafeTs(segment.GetCollectionID(), droppedTs); err != nil {
+			log.Warn("GC segment failed to advance gc safe ts", zap.Error(err))
+			continue
+		}
+		if err := gc.removeObjectFiles(ctx, logs); err != nil {
+			log.Warn("GC segment remove logs failed", zap.Error(err))
+			continue
+		}
+
+		if err := gc.meta.DropSegment(segment.GetID()); err != nil {
+			log.Warn("GC segment meta failed to drop segment", zap.Error(err))
+			continue
+		}
+		log.Info("GC segment meta drop segment done")
+	}
+}
+
+// getRecyclableDroppedSegments returns the dropped segments which can be recycled with the drop tolerance,
+// the GC retention in the collection properties overrides the drop tolerance of the collection,
+// the binlogs of the returned segments are decompressed.
+func (gc *garbageCollector) getRecyclableDroppedSegments(ctx context.Context, dropTolerance time.Duration) []*SegmentInfo {
 	all := gc.meta.SelectSegments()
 	drops := make(map[int64]*SegmentInfo, 0)
 	compactTo := make(map[int64]*SegmentInfo)
@@ -449,34 +524,54 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 		channelCPs[channel] = pos.GetTimestamp()
 	}
 
-	log.Info("start to GC segments", zap.Int("drop_num", len(drops)))
-	for segmentID, segment := range drops {
+	// the binlogs of the segments referenced by the external readers are kept until the references are released or expire
+	referenced := typeutil.NewUniqueSet()
+	if gc.meta.segmentReferenceMeta != nil {
+		referenced = gc.meta.segmentReferenceMeta.GetReferencedSegments()
+	}
+
+	collectionTolerances := make(map[int64]time.Duration)
+	recyclable := make([]*SegmentInfo, 0, len(drops))
+	for _, segment := range drops {
 		if ctx.Err() != nil {
-			// process canceled, stop.
-			return
+			break
 		}
-
-		log := log.With(zap.Int64("segmentID", segmentID))
-		segInsertChannel := segment.GetInsertChannel()
-		if !gc.checkDroppedSegmentGC(segment, compactTo[segment.GetID()], indexedSet, channelCPs[segInsertChannel]) {
+		if referenced.Contain(segment.GetID()) {
+			log.Ctx(ctx).WithRateGroup("GC_FAIL_SEGMENT_REFERENCED", 1, 60).
+				RatedInfo(60, "dropped segment is referenced by external readers, skip meta gc",
+					zap.Int64("segmentID", segment.GetID()))
 			continue
 		}
-
-		logs := getLogs(segment)
-		log.Info("GC segment start...", zap.Int("insert_logs", len(segment.GetBinlogs())),
-			zap.Int("delta_logs", len(segment.GetDeltalogs())),
-			zap.Int("stats_logs", len(segment.GetStatslogs())))
-		if err := gc.removeObjectFiles(ctx, logs); err != nil {
-			log.Warn("GC segment remove logs failed", zap.Error(err))
-			continue
+		tolerance, ok := collectionTolerances[segment.GetCollectionID()]
+		if !ok {
+			tolerance = gc.getCollectionDropTolerance(segment.GetCollectionID(), dropTolerance)
+			collectionTolerances[segment.GetCollectionID()] = tolerance
 		}
-
-		if err := gc.meta.DropSegment(segment.GetID()); err != nil {
-			log.Warn("GC segment meta failed to drop segment", zap.Error(err))
-			continue
+		segInsertChannel := segment.GetInsertChannel()
+		if gc.checkDroppedSegmentGC(segment, compactTo[segment.GetID()], indexedSet, channelCPs[segInsertChannel], tolerance) {
+			recyclable = append(recyclable, segment)
 		}
-		log.Info("GC segment meta drop segment done")
 	}
+	return recyclable
+}
+
+// getCollectionDropTolerance returns the GC retention in the collection properties,
+// the drop tolerance is used if not set or the collection is gone.
+func (gc *garbageCollector) getCollectionDropTolerance(collectionID int64, dropTolerance time.Duration) time.Duration {
+	collection := gc.meta.GetCollection(collectionID)
+	if collection == nil {
+		return dropTolerance
+	}
+	retention, ok, err := common.CollectionLevelGCRetention(collection.Properties)
+	if err != nil {
+		log.Warn("invalid gc retention of collection, use the drop tolerance",
+			zap.Int64("collectionID", collectionID), zap.Error(err))
+		return dropTolerance
+	}
+	if !ok {
+		return dropTolerance
+	}
+	return retention
 }
 
 func (gc *garbageCollector) recycleChannelCPMeta(ctx context.Context) {
@@ -502,6 +597,12 @@ func (gc *garbageCollector) recycleChannelCPMeta(ctx context.Context) {
 
 		if _, ok := collectionID2GcStatus[collectionID]; !ok {
 			collectionID2GcStatus[collectionID] = gc.meta.catalog.GcConfirm(ctx, collectionID, -1)
+			if collectionID2GcStatus[collectionID] {
+				// all segments of the collection are recycled, no snapshot of it is left to read
+				if err := gc.meta.DropGcSafeTs(collectionID); err != nil {
+					log.Warn("failed to drop gc safe ts during gc", zap.Int64("collectionID", collectionID), zap.Error(err))
+				}
+			}
 		}
 
 		// Skip to GC if all segments meta of the corresponding collection are not removed
@@ -519,9 +620,9 @@ func (gc *garbageCollector) recycleChannelCPMeta(ctx context.Context) {
 	log.Info("GC channel cp done", zap.Int("skippedChannelCP", skippedCnt))
 }
 
-func (gc *garbageCollector) isExpire(dropts Timestamp) bool {
+func isExpire(dropts Timestamp, dropTolerance time.Duration) bool {
 	droptime := time.Unix(0, int64(dropts))
-	return time.Since(droptime) > gc.option.dropTolerance
+	return time.Since(droptime) > dropTolerance
 }
 
 func getLogs(sinfo *SegmentInfo) map[string]struct{} {
@@ -544,27 +645,13 @@ func getLogs(sinfo *SegmentInfo) map[string]struct{} {
 	return logs
 }
 
-// removeObjectFiles remove file from oss storage, return error if any log failed to remove.
+// removeObjectFiles remove file from oss storage in batches, return error if any log failed to remove.
 func (gc *garbageCollector) removeObjectFiles(ctx context.Context, filePaths map[string]struct{}) error {
-	futures := make([]*conc.Future[struct{}], 0)
+	batcher := gc.newRemoveBatcher(ctx)
 	for filePath := range filePaths {
-		filePath := filePath
-		future := gc.option.removeObjectPool.Submit(func() (struct{}, error) {
-			err := gc.option.cli.Remove(ctx, filePath)
-			// ignore the error Key Not Found
-			if err != nil {
-				if !errors.Is(err, merr.ErrIoKeyNotFound) {
-					return struct{}{}, err
-				}
-				log.Info("remove log failed, key not found, may be removed at previous GC, ignore the error",
-					zap.String("path", filePath),
-					zap.Error(err))
-			}
-			return struct{}{}, nil
-		})
-		futures = append(futures, future)
+		batcher.add(filePath)
 	}
-	return conc.BlockOnAll(futures...)
+	return batcher.wait()
 }
 
 // recycleUnusedIndexes is used to delete those indexes that is deleted by collection.
@@ -606,8 +693,10 @@ func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context) {
 
 		// 1. segment belongs to is deleted.
 		// 2. index is deleted.
-		if gc.meta.GetSegment(segIdx.SegmentID) == nil || !gc.meta.indexMeta.IsIndexExist(segIdx.CollectionID, segIdx.IndexID) {
-			indexFiles := gc.getAllIndexFilesOfIndex(segIdx)
+		// 3. segment index is replaced by its rebuild for longer than the drop tolerance, or the rebuild failed.
+		if gc.meta.GetSegment(segIdx.SegmentID) == nil || !gc.meta.indexMeta.IsIndexExist(segIdx.CollectionID, segIdx.IndexID) ||
+			isRecyclableRebuild(segIdx, gc.option.dropTolerance) {
+			indexFiles := gc.getRemovableIndexFiles(segIdx)
 			log := log.With(zap.Int64("collectionID", segIdx.CollectionID),
 				zap.Int64("partitionID", segIdx.PartitionID),
 				zap.Int64("segmentID", segIdx.SegmentID),
@@ -633,6 +722,118 @@ func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context) {
 	}
 }
 
+// isRecyclableRebuild checks whether the segment index is left by the rebuilds, the replaced one is kept within
+// the drop tolerance for the QueryNodes which have loaded it.
+func isRecyclableRebuild(segIdx *model.SegmentIndex, dropTolerance time.Duration) bool {
+	if segIdx.ReplacedTime != 0 {
+		return time.Since(time.UnixMilli(segIdx.ReplacedTime)) > dropTolerance
+	}
+	return segIdx.ReplacedBuildID != 0 && segIdx.IndexState == commonpb.IndexState_Failed
+}
+
+// PruneSegmentIndexes prunes the terminal segment index records of segments dropped before the retention,
+// it returns the number of pruned records.
+func (gc *garbageCollector) PruneSegmentIndexes(ctx context.Context, retention time.Duration) (int, error) {
+	if gc.option.cli == nil {
+		return 0, merr.WrapErrServiceUnavailable("garbage collection chunk manager not provided")
+	}
+	if retention <= 0 {
+		return 0, merr.WrapErrParameterInvalidMsg("segment index retention must be positive, got %s", retention)
+	}
+	return gc.pruneSegmentIndexes(ctx, retention), nil
+}
+
+// pruneSegmentIndexes removes the segment index records which are in terminal state and whose segment
+// has been dropped for longer than the retention. Such records are only removed together with the segment
+// meta otherwise, which may be retained for a long time and bloat the index meta.
+func (gc *garbageCollector) pruneSegmentIndexes(ctx context.Context, retention time.Duration) int {
+	if retention <= 0 {
+		return 0
+	}
+	start := time.Now()
+	log := log.With(zap.String("gcName", "pruneSegmentIndexes"), zap.Time("startAt", start), zap.Duration("retention", retention))
+	log.Info("start pruneSegmentIndexes...")
+
+	candidates := make([]*model.SegmentIndex, 0)
+	for _, segIdx := range gc.meta.indexMeta.GetAllSegIndexes() {
+		if !isSegmentIndexTerminal(segIdx) {
+			continue
+		}
+		// records of non-existent segments are recycled by recycleUnusedSegIndexes
+		segment := gc.meta.GetSegment(segIdx.SegmentID)
+		if segment == nil || segment.GetState() != commonpb.SegmentState_Dropped {
+			continue
+		}
+		if time.Since(time.Unix(0, int64(segment.GetDroppedAt()))) <= retention {
+			continue
+		}
+		candidates = append(candidates, segIdx)
+	}
+	if len(candidates) == 0 {
+		log.Info("pruneSegmentIndexes done, no segment index to prune", zap.Duration("timeCost", time.Since(start)))
+		return 0
+	}
+
+	// For compact A, B -> C, keep the index of A and B while C is not indexed,
+	// the dropped segments may still be loaded in place of C.
+	candidateSegments := typeutil.NewUniqueSet(lo.Map(candidates, func(segIdx *model.SegmentIndex, _ int) int64 {
+		return segIdx.SegmentID
+	})...)
+	compactTo := make(map[int64]*SegmentInfo)
+	for _, segment := range gc.meta.SelectSegments(SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetState() != commonpb.SegmentState_Dropped
+	})) {
+		for _, from := range segment.GetCompactionFrom() {
+			if candidateSegments.Contain(from) {
+				compactTo[from] = segment
+			}
+		}
+	}
+	indexedSet := make(typeutil.UniqueSet)
+	for _, segment := range FilterInIndexedSegments(gc.handler, gc.meta, lo.Uniq(lo.Values(compactTo))...) {
+		indexedSet.Insert(segment.GetID())
+	}
+
+	pruned := 0
+	for _, segIdx := range candidates {
+		if ctx.Err() != nil {
+			// process canceled.
+			break
+		}
+		if child, ok := compactTo[segIdx.SegmentID]; ok && !indexedSet.Contain(child.GetID()) {
+			continue
+		}
+
+		indexFiles := gc.getRemovableIndexFiles(segIdx)
+		log := log.With(zap.Int64("collectionID", segIdx.CollectionID),
+			zap.Int64("partitionID", segIdx.PartitionID),
+			zap.Int64("segmentID", segIdx.SegmentID),
+			zap.Int64("indexID", segIdx.IndexID),
+			zap.Int64("buildID", segIdx.BuildID),
+			zap.String("indexState", segIdx.IndexState.String()),
+			zap.Int("indexFiles", len(indexFiles)))
+
+		if err := gc.removeObjectFiles(ctx, indexFiles); err != nil {
+			log.Warn("fail to remove index files of pruned segment index", zap.Error(err))
+			continue
+		}
+		if err := gc.meta.indexMeta.RemoveSegmentIndex(segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexID, segIdx.BuildID); err != nil {
+			log.Warn("fail to remove pruned segment index meta, wait to retry", zap.Error(err))
+			continue
+		}
+		pruned++
+		log.Info("segment index record pruned")
+	}
+	log.Info("pruneSegmentIndexes done", zap.Int("candidates", len(candidates)), zap.Int("pruned", pruned), zap.Duration("timeCost", time.Since(start)))
+	return pruned
+}
+
+func isSegmentIndexTerminal(segIdx *model.SegmentIndex) bool {
+	return segIdx.IsDeleted ||
+		segIdx.IndexState == commonpb.IndexState_Finished ||
+		segIdx.IndexState == commonpb.IndexState_Failed
+}
+
 // recycleUnusedIndexFiles is used to delete those index files that no longer exist in the meta.
 func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 	start := time.Now()
@@ -675,37 +876,25 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 		filesMap := gc.getAllIndexFilesOfIndex(segIdx)
 
 		logger.Info("recycle index files", zap.Int("meta files num", len(filesMap)))
-		deletedFilesNum := atomic.NewInt32(0)
 		fileNum := 0
 
-		futures := make([]*conc.Future[struct{}], 0)
+		batcher := gc.newRemoveBatcher(ctx)
 		err = gc.option.cli.WalkWithPrefix(ctx, key, true, func(indexFile *storage.ChunkObjectInfo) bool {
 			fileNum++
 			file := indexFile.FilePath
 			if _, ok := filesMap[file]; !ok {
-				future := gc.option.removeObjectPool.Submit(func() (struct{}, error) {
-					logger := logger.With(zap.String("file", file))
-					logger.Info("garbageCollector recycleUnusedIndexFiles remove file...")
-
-					if err := gc.option.cli.Remove(ctx, file); err != nil {
-						logger.Warn("garbageCollector recycleUnusedIndexFiles remove file failed", zap.Error(err))
-						return struct{}{}, err
-					}
-					deletedFilesNum.Inc()
-					logger.Info("garbageCollector recycleUnusedIndexFiles remove file success")
-					return struct{}{}, nil
-				})
-				futures = append(futures, future)
+				logger.Info("garbageCollector recycleUnusedIndexFiles remove file...", zap.String("file", file))
+				batcher.add(file)
 			}
 			return true
 		})
-		// Wait for all remove tasks done.
-		if err := conc.BlockOnAll(futures...); err != nil {
+		// Wait for all remove batches done.
+		if err := batcher.wait(); err != nil {
 			// error is logged, and can be ignored here.
 			logger.Warn("some task failure in remove object pool", zap.Error(err))
 		}
 
-		logger = logger.With(zap.Int("deleteIndexFilesNum", int(deletedFilesNum.Load())), zap.Int("walkFileNum", fileNum))
+		logger = logger.With(zap.Int("deleteIndexFilesNum", int(batcher.removed.Load())), zap.Int("walkFileNum", fileNum))
 		if err != nil {
 			logger.Warn("index files recycle failed when walk with prefix", zap.Error(err))
 			return true
@@ -732,6 +921,15 @@ func (gc *garbageCollector) getAllIndexFilesOfIndex(segmentIndex *model.SegmentI
 	return filesMap
 }
 
+// getRemovableIndexFiles returns the index files to remove along with the segment index. The files reused
+// by other segment indexes are kept, they are recycled with the source build once no longer reused.
+func (gc *garbageCollector) getRemovableIndexFiles(segmentIndex *model.SegmentIndex) map[string]struct{} {
+	if segmentIndex.FilesSource != nil || gc.meta.indexMeta.IsIndexFilesReused(segmentIndex.BuildID) {
+		return make(map[string]struct{})
+	}
+	return gc.getAllIndexFilesOfIndex(segmentIndex)
+}
+
 // recycleUnusedAnalyzeFiles is used to delete those analyze stats files that no longer exist in the meta.
 func (gc *garbageCollector) recycleUnusedAnalyzeFiles(ctx context.Context) {
 	log.Info("start recycleUnusedAnalyzeFiles")
@@ -801,3 +999,43 @@ func (gc *garbageCollector) recycleUnusedAnalyzeFiles(ctx context.Context) {
 		log.Info("analyze stats files recycle success", zap.Int64("taskID", taskID))
 	}
 }
+
+// recycleUnusedStatsTasks removes the stats tasks whose segments have been recycled,
+// the BM25 stats logs of the segments are removed along with the stats logs.
+func (gc *garbageCollector) recycleUnusedStatsTasks(ctx context.Context) {
+	start := time.Now()
+	log := log.With(zap.String("gcName", "recycleUnusedStatsTasks"), zap.Time("startAt", start))
+	log.Info("start recycleUnusedStatsTasks...")
+	defer func() { log.Info("recycleUnusedStatsTasks done", zap.Duration("timeCost", time.Since(start))) }()
+
+	for taskID, task := range gc.meta.statsMeta.GetAllTasks() {
+		if ctx.Err() != nil {
+			// process canceled.
+			return
+		}
+
+		if gc.meta.GetSegment(task.GetSegmentID()) != nil {
+			continue
+		}
+		if err := gc.meta.statsMeta.DropStatsTask(taskID); err != nil {
+			log.Warn("delete stats task meta failed, wait to retry", zap.Int64("taskID", taskID),
+				zap.Int64("segmentID", task.GetSegmentID()), zap.Error(err))
+			continue
+		}
+		log.Info("stats task meta recycle success", zap.Int64("taskID", taskID), zap.Int64("segmentID", task.GetSegmentID()))
+	}
+}
+
+// recycleExpiredSegmentReferences removes the segment references whose leases have expired,
+// so that the segments referenced by them are recyclable again.
+func (gc *garbageCollector) recycleExpiredSegmentReferences(ctx context.Context) {
+	if gc.meta.segmentReferenceMeta == nil {
+		return
+	}
+	start := time.Now()
+	log := log.With(zap.String("gcName", "recycleExpiredSegmentReferences"), zap.Time("startAt", start))
+	removed := gc.meta.segmentReferenceMeta.RemoveExpiredReferences()
+	if removed > 0 {
+		log.Info("expired segment references recycled", zap.Int("removed", removed), zap.Duration("timeCost", time.Since(start)))
+	}
+}
@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// gcDryRunReport collects the files the garbage collection would remove.
+type gcDryRunReport struct {
+	limit int64
+	resp  *datapb.GcDryRunResponse
+}
+
+func (r *gcDryRunReport) add(category datapb.GcFileCategory, filePath string, size int64) {
+	r.resp.NumFiles++
+	r.resp.TotalBytes += size
+	if r.limit > 0 && int64(len(r.resp.Files)) >= r.limit {
+		r.resp.Truncated = true
+		return
+	}
+	r.resp.Files = append(r.resp.Files, &datapb.GcDryRunFile{
+		Path:     filePath,
+		Size:     size,
+		Category: category,
+	})
+}
+
+// DryRun lists the orphan binlogs, the files of the dropped segments and the stale index files which
+// the garbage collection would remove with the tolerances, nothing is removed.
+func (gc *garbageCollector) DryRun(ctx context.Context, missingTolerance, dropTolerance time.Duration, limit int64) (*datapb.GcDryRunResponse, error) {
+	if gc.option.cli == nil {
+		return nil, merr.WrapErrServiceUnavailable("garbage collection chunk manager not provided")
+	}
+	if missingTolerance < 0 || dropTolerance < 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("gc tolerance must not be negative, got missing tolerance %s, drop tolerance %s",
+			missingTolerance, dropTolerance)
+	}
+
+	start := time.Now()
+	report := &gcDryRunReport{
+		limit: limit,
+		resp:  &datapb.GcDryRunResponse{Files: make([]*datapb.GcDryRunFile, 0)},
+	}
+	if err := gc.dryRunUnusedBinlogFiles(ctx, missingTolerance, report); err != nil {
+		return nil, err
+	}
+	gc.dryRunDroppedSegments(ctx, dropTolerance, report)
+	if err := gc.dryRunUnusedIndexFiles(ctx, report); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	log.Info("garbage collection dry run done",
+		zap.Duration("missingTolerance", missingTolerance),
+		zap.Duration("dropTolerance", dropTolerance),
+		zap.Int64("numFiles", report.resp.GetNumFiles()),
+		zap.Int64("totalBytes", report.resp.GetTotalBytes()),
+		zap.Duration("timeCost", time.Since(start)))
+	return report.resp, nil
+}
+
+// dryRunUnusedBinlogFiles lists the binlogs not referenced by the meta like recycleUnusedBinlogFiles.
+func (gc *garbageCollector) dryRunUnusedBinlogFiles(ctx context.Context, missingTolerance time.Duration, report *gcDryRunReport) error {
+	for _, task := range gc.getBinlogScanTasks() {
+		err := gc.option.cli.WalkWithPrefix(ctx, task.prefix, true, func(chunkInfo *storage.ChunkObjectInfo) bool {
+			if time.Since(chunkInfo.ModifyTime) <= missingTolerance {
+				return true
+			}
+			// the file with invalid path is never removed
+			segmentID, err := storage.ParseSegmentIDByBinlog(gc.option.cli.RootPath(), chunkInfo.FilePath)
+			if err != nil {
+				return true
+			}
+			if !task.checker(chunkInfo, gc.meta.GetSegment(segmentID)) {
+				report.add(datapb.GcFileCategory_OrphanBinlog, chunkInfo.FilePath, chunkInfo.Size)
+			}
+			return true
+		})
+		if err != nil {
+			log.Warn("garbage collection dry run failed to walk binlogs", zap.String("prefix", task.prefix), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// dryRunDroppedSegments lists the files of the dropped segments like recycleDroppedSegments,
+// the sizes are the ones recorded in meta.
+func (gc *garbageCollector) dryRunDroppedSegments(ctx context.Context, dropTolerance time.Duration, report *gcDryRunReport) {
+	for _, segment := range gc.getRecyclableDroppedSegments(ctx, dropTolerance) {
+		listed := typeutil.NewSet[string]()
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs(), segment.GetDeltalogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, l := range fieldBinlog.GetBinlogs() {
+					if listed.Contain(l.GetLogPath()) {
+						continue
+					}
+					listed.Insert(l.GetLogPath())
+					report.add(datapb.GcFileCategory_DroppedSegment, l.GetLogPath(), l.GetLogSize())
+				}
+			}
+		}
+	}
+}
+
+// dryRunUnusedIndexFiles lists the index files no longer in the meta like recycleUnusedIndexFiles.
+func (gc *garbageCollector) dryRunUnusedIndexFiles(ctx context.Context, report *gcDryRunReport) error {
+	prefix := path.Join(gc.option.cli.RootPath(), common.SegmentIndexPath) + "/"
+	return gc.option.cli.WalkWithPrefix(ctx, prefix, false, func(indexPathInfo *storage.ChunkObjectInfo) bool {
+		key := indexPathInfo.FilePath
+		buildID, err := parseBuildIDFromFilePath(key)
+		if err != nil {
+			return true
+		}
+		canRecycle, segIdx := gc.meta.indexMeta.CheckCleanSegmentIndex(buildID)
+		if !canRecycle {
+			return true
+		}
+		var filesMap map[string]struct{}
+		if segIdx != nil {
+			filesMap = gc.getAllIndexFilesOfIndex(segIdx)
+		}
+		err = gc.option.cli.WalkWithPrefix(ctx, key, true, func(indexFile *storage.ChunkObjectInfo) bool {
+			if _, ok := filesMap[indexFile.FilePath]; !ok {
+				report.add(datapb.GcFileCategory_StaleIndex, indexFile.FilePath, indexFile.Size)
+			}
+			return true
+		})
+		if err != nil {
+			log.Warn("garbage collection dry run failed to walk index files", zap.String("key", key), zap.Error(err))
+		}
+		return true
+	})
+}
@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+)
+
+// removeQuotaWaitInterval is the interval to check the quota of the remove requests again when exhausted.
+const removeQuotaWaitInterval = 10 * time.Millisecond
+
+// getRemoveLimit returns the limit of the batched remove requests per second of the garbage collection.
+func getRemoveLimit() ratelimitutil.Limit {
+	qps := Params.DataCoordCfg.GCRemoveQPS.GetAsFloat()
+	if qps <= 0 {
+		return ratelimitutil.Inf
+	}
+	return ratelimitutil.Limit(qps)
+}
+
+// removeBatcher collects the files to remove and removes them in batches by the remove pool,
+// each batch is removed by one multi-object delete request.
+type removeBatcher struct {
+	gc        *garbageCollector
+	ctx       context.Context
+	batchSize int
+	batch     []string
+	futures   []*conc.Future[struct{}]
+
+	removed *atomic.Int32
+	failed  *atomic.Int32
+}
+
+func (gc *garbageCollector) newRemoveBatcher(ctx context.Context) *removeBatcher {
+	batchSize := Params.DataCoordCfg.GCRemoveBatchSize.GetAsInt()
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	return &removeBatcher{
+		gc:        gc,
+		ctx:       ctx,
+		batchSize: batchSize,
+		batch:     make([]string, 0, batchSize),
+		futures:   make([]*conc.Future[struct{}], 0),
+		removed:   atomic.NewInt32(0),
+		failed:    atomic.NewInt32(0),
+	}
+}
+
+// add adds the file to the current batch, the batch is submitted once full.
+func (b *removeBatcher) add(filePath string) {
+	b.batch = append(b.batch, filePath)
+	if len(b.batch) >= b.batchSize {
+		b.flush()
+	}
+}
+
+func (b *removeBatcher) flush() {
+	if len(b.batch) == 0 {
+		return
+	}
+	files := b.batch
+	b.batch = make([]string, 0, b.batchSize)
+	future := b.gc.option.removeObjectPool.Submit(func() (struct{}, error) {
+		if err := b.gc.removeObjects(b.ctx, files); err != nil {
+			b.failed.Add(int32(len(files)))
+			return struct{}{}, err
+		}
+		b.removed.Add(int32(len(files)))
+		return struct{}{}, nil
+	})
+	b.futures = append(b.futures, future)
+}
+
+// wait submits the remaining files and waits for all the batches done, return error if any batch failed.
+func (b *removeBatcher) wait() error {
+	b.flush()
+	return conc.BlockOnAll(b.futures...)
+}
+
+// removeObjects removes the files by one multi-object delete request once the remove QPS allows.
+func (gc *garbageCollector) removeObjects(ctx context.Context, files []string) error {
+	if err := gc.waitRemoveQuota(ctx); err != nil {
+		return err
+	}
+	err := gc.option.cli.MultiRemove(ctx, files)
+	if err != nil {
+		// ignore the error Key Not Found
+		if !errors.Is(err, merr.ErrIoKeyNotFound) {
+			log.Warn("garbageCollector remove files failed", zap.Int("num", len(files)), zap.Strings("files", files), zap.Error(err))
+			return err
+		}
+		log.Info("remove files failed, key not found, may be removed at previous GC, ignore the error",
+			zap.Int("num", len(files)), zap.Error(err))
+	}
+	log.Info("garbageCollector remove files success", zap.Int("num", len(files)))
+	return nil
+}
+
+// waitRemoveQuota blocks until the remove limiter allows one more remove request or the context is done.
+func (gc *garbageCollector) waitRemoveQuota(ctx context.Context) error {
+	if limit := getRemoveLimit(); gc.removeLimiter.Limit() != limit {
+		gc.removeLimiter.SetLimit(limit)
+	}
+	for !gc.removeLimiter.AllowN(time.Now(), 1) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(removeQuotaWaitInterval):
+		}
+	}
+	return nil
+}
@@ -31,10 +31,12 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
@@ -50,9 +52,10 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
-	"github.com/milvus-io/milvus/pkg/util/lock"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func Test_garbageCollector_basic(t *testing.T) {
@@ -361,10 +364,9 @@ func createMetaForRecycleUnusedIndexes(catalog metastore.DataCoordCatalog) *meta
 		indexID = UniqueID(400)
 	)
 	return &meta{
-		RWMutex:      lock.RWMutex{},
 		ctx:          ctx,
 		catalog:      catalog,
-		collections:  nil,
+		collections:  typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 		segments:     nil,
 		channelCPs:   newChannelCps(),
 		chunkManager: nil,
@@ -476,10 +478,9 @@ func createMetaForRecycleUnusedSegIndexes(catalog metastore.DataCoordCatalog) *m
 		},
 	}
 	meta := &meta{
-		RWMutex:     lock.RWMutex{},
 		ctx:         ctx,
 		catalog:     catalog,
-		collections: nil,
+		collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 		segments:    NewSegmentsInfo(),
 		indexMeta: &indexMeta{
 			catalog: catalog,
@@ -574,7 +575,7 @@ func TestGarbageCollector_recycleUnusedSegIndexes(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockChunkManager := mocks.NewChunkManager(t)
 		mockChunkManager.EXPECT().RootPath().Return("root")
-		mockChunkManager.EXPECT().Remove(mock.Anything, mock.Anything).Return(nil)
+		mockChunkManager.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(nil)
 		catalog := catalogmocks.NewDataCoordCatalog(t)
 		catalog.On("DropSegmentIndex",
 			mock.Anything,
@@ -593,7 +594,7 @@ func TestGarbageCollector_recycleUnusedSegIndexes(t *testing.T) {
 		catalog := catalogmocks.NewDataCoordCatalog(t)
 		mockChunkManager := mocks.NewChunkManager(t)
 		mockChunkManager.EXPECT().RootPath().Return("root")
-		mockChunkManager.EXPECT().Remove(mock.Anything, mock.Anything).Return(nil)
+		mockChunkManager.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(nil)
 		catalog.On("DropSegmentIndex",
 			mock.Anything,
 			mock.Anything,
@@ -608,6 +609,157 @@ func TestGarbageCollector_recycleUnusedSegIndexes(t *testing.T) {
 	})
 }
 
+func Test_isRecyclableRebuild(t *testing.T) {
+	tolerance := time.Hour
+	cases := []struct {
+		name   string
+		segIdx *model.SegmentIndex
+		expect bool
+	}{
+		{"serving", &model.SegmentIndex{IndexState: commonpb.IndexState_Finished}, false},
+		{"replaced long ago", &model.SegmentIndex{ReplacedTime: time.Now().Add(-2 * time.Hour).UnixMilli()}, true},
+		{"replaced recently", &model.SegmentIndex{ReplacedTime: time.Now().UnixMilli()}, false},
+		{"rebuilding", &model.SegmentIndex{ReplacedBuildID: 1, IndexState: commonpb.IndexState_InProgress}, false},
+		{"rebuild failed", &model.SegmentIndex{ReplacedBuildID: 1, IndexState: commonpb.IndexState_Failed}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expect, isRecyclableRebuild(c.segIdx, tolerance))
+		})
+	}
+}
+
+func TestGarbageCollector_pruneSegmentIndexes(t *testing.T) {
+	var (
+		collID  = UniqueID(100)
+		partID  = UniqueID(200)
+		indexID = UniqueID(400)
+	)
+	longAgo := uint64(time.Now().Add(-2 * time.Hour).UnixNano())
+	recently := uint64(time.Now().UnixNano())
+
+	newSegment := func(segID UniqueID, state commonpb.SegmentState, droppedAt uint64, compactionFrom ...UniqueID) *SegmentInfo {
+		return NewSegmentInfo(&datapb.SegmentInfo{
+			ID:             segID,
+			CollectionID:   collID,
+			PartitionID:    partID,
+			NumOfRows:      1026,
+			State:          state,
+			DroppedAt:      droppedAt,
+			CompactionFrom: compactionFrom,
+		})
+	}
+	createMeta := func(catalog metastore.DataCoordCatalog) *meta {
+		segments := []*SegmentInfo{
+			// dropped long ago, pruned
+			newSegment(1, commonpb.SegmentState_Dropped, longAgo),
+			// dropped recently, retained
+			newSegment(2, commonpb.SegmentState_Dropped, recently),
+			// not dropped, retained
+			newSegment(3, commonpb.SegmentState_Flushed, 0),
+			// index not in terminal state, retained
+			newSegment(4, commonpb.SegmentState_Dropped, longAgo),
+			// compacted to an unindexed segment, retained
+			newSegment(5, commonpb.SegmentState_Dropped, longAgo),
+			newSegment(6, commonpb.SegmentState_Flushed, 0, 5),
+		}
+		states := map[UniqueID]commonpb.IndexState{
+			1: commonpb.IndexState_Finished,
+			2: commonpb.IndexState_Finished,
+			3: commonpb.IndexState_Finished,
+			4: commonpb.IndexState_InProgress,
+			5: commonpb.IndexState_Failed,
+		}
+		m := &meta{
+			catalog:  catalog,
+			segments: NewSegmentsInfo(),
+			indexMeta: &indexMeta{
+				catalog:              catalog,
+				segmentIndexes:       make(map[UniqueID]map[UniqueID]*model.SegmentIndex),
+				buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
+				indexes: map[UniqueID]map[UniqueID]*model.Index{
+					collID: {
+						indexID: {
+							CollectionID: collID,
+							FieldID:      fieldID,
+							IndexID:      indexID,
+							IndexName:    "_default_idx",
+						},
+					},
+				},
+			},
+		}
+		for _, segment := range segments {
+			m.segments.SetSegment(segment.GetID(), segment)
+		}
+		for segID, state := range states {
+			m.indexMeta.updateSegmentIndex(&model.SegmentIndex{
+				SegmentID:     segID,
+				CollectionID:  collID,
+				PartitionID:   partID,
+				IndexID:       indexID,
+				BuildID:       segID + 600,
+				IndexVersion:  1,
+				IndexState:    state,
+				IndexFileKeys: []string{"file1"},
+			})
+		}
+		return m
+	}
+	newHandler := func() *NMockHandler {
+		handler := NewNMockHandler(t)
+		handler.EXPECT().GetCollection(mock.Anything, collID).Return(&collectionInfo{
+			ID: collID,
+			Schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: fieldID, DataType: schemapb.DataType_FloatVector},
+				},
+			},
+		}, nil)
+		return handler
+	}
+
+	t.Run("success", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(nil)
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().DropSegmentIndex(mock.Anything, collID, partID, int64(1), int64(601)).Return(nil).Once()
+		m := createMeta(catalog)
+		gc := newGarbageCollector(m, newHandler(), GcOption{cli: cm})
+
+		assert.Equal(t, 1, gc.pruneSegmentIndexes(context.TODO(), time.Hour))
+		assert.Nil(t, m.indexMeta.getSegmentIndexes(1))
+		for _, segID := range []UniqueID{2, 3, 4, 5} {
+			assert.Len(t, m.indexMeta.getSegmentIndexes(segID), 1)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		gc := newGarbageCollector(createMeta(catalog), nil, GcOption{cli: mocks.NewChunkManager(t)})
+		assert.Equal(t, 0, gc.pruneSegmentIndexes(context.TODO(), 0))
+
+		_, err := gc.PruneSegmentIndexes(context.TODO(), 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("root")
+		cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(nil)
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().DropSegmentIndex(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("fail"))
+		m := createMeta(catalog)
+		gc := newGarbageCollector(m, newHandler(), GcOption{cli: cm})
+
+		pruned, err := gc.PruneSegmentIndexes(context.TODO(), time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, pruned)
+		assert.Len(t, m.indexMeta.getSegmentIndexes(1), 1)
+	})
+}
+
 func createMetaTableForRecycleUnusedIndexFiles(catalog *datacoord.Catalog) *meta {
 	var (
 		ctx    = context.Background()
@@ -641,10 +793,9 @@ func createMetaTableForRecycleUnusedIndexFiles(catalog *datacoord.Catalog) *meta
 		},
 	}
 	meta := &meta{
-		RWMutex:     lock.RWMutex{},
 		ctx:         ctx,
 		catalog:     catalog,
-		collections: nil,
+		collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 		segments:    NewSegmentsInfo(),
 		indexMeta: &indexMeta{
 			catalog: catalog,
@@ -764,7 +915,7 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 			})
 
 		cm.EXPECT().RemoveWithPrefix(mock.Anything, mock.Anything).Return(nil)
-		cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(nil)
+		cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(nil)
 		gc := newGarbageCollector(
 			createMetaTableForRecycleUnusedIndexFiles(&datacoord.Catalog{MetaKv: kvmocks.NewMetaKv(t)}),
 			nil,
@@ -794,7 +945,7 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 	t.Run("remove fail", func(t *testing.T) {
 		cm := &mocks.ChunkManager{}
 		cm.EXPECT().RootPath().Return("root")
-		cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(errors.New("error"))
+		cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(errors.New("error"))
 		cm.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
 			func(ctx context.Context, s string, b bool, cowf storage.ChunkObjectWalkFunc) error {
 				for _, file := range []string{"a/b/c/", "a/b/600/", "a/b/601/", "a/b/602/"} {
@@ -815,7 +966,7 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 	t.Run("remove with prefix fail", func(t *testing.T) {
 		cm := &mocks.ChunkManager{}
 		cm.EXPECT().RootPath().Return("root")
-		cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(errors.New("error"))
+		cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(errors.New("error"))
 		cm.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
 			func(ctx context.Context, s string, b bool, cowf storage.ChunkObjectWalkFunc) error {
 				for _, file := range []string{"a/b/c/", "a/b/600/", "a/b/601/", "a/b/602/"} {
@@ -834,6 +985,103 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 	})
 }
 
+func TestGarbageCollector_DryRun(t *testing.T) {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	err = meta.AddSegment(context.TODO(), NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           100,
+		CollectionID: 1,
+		PartitionID:  10,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs:      []*datapb.FieldBinlog{getFieldBinlogIDs(0, 1)},
+	}))
+	require.NoError(t, err)
+	err = meta.AddSegment(context.TODO(), NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           200,
+		CollectionID: 1,
+		PartitionID:  10,
+		State:        commonpb.SegmentState_Dropped,
+		DroppedAt:    uint64(time.Now().Add(-time.Hour).UnixNano()),
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []*datapb.Binlog{
+			{LogID: 3, LogSize: 100},
+			{LogID: 4, LogSize: 200},
+		}}},
+		Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogID: 5, LogSize: 50}}}},
+	}))
+	require.NoError(t, err)
+
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().RootPath().Return("root")
+	cm.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, prefix string, recursive bool, walkFunc storage.ChunkObjectWalkFunc) error {
+			if prefix != path.Join("root", common.SegmentInsertLogPath) {
+				return nil
+			}
+			for _, info := range []*storage.ChunkObjectInfo{
+				// referenced
+				{FilePath: "root/insert_log/1/10/100/0/1", ModifyTime: time.Now().Add(-48 * time.Hour), Size: 10},
+				// not referenced
+				{FilePath: "root/insert_log/1/10/100/0/2", ModifyTime: time.Now().Add(-48 * time.Hour), Size: 20},
+				// segment not exist
+				{FilePath: "root/insert_log/1/10/300/0/6", ModifyTime: time.Now().Add(-48 * time.Hour), Size: 40},
+				// not referenced but within the tolerance
+				{FilePath: "root/insert_log/1/10/100/0/7", ModifyTime: time.Now(), Size: 80},
+				// invalid path
+				{FilePath: "root/insert_log/1/10/error-seg-id/0/8", ModifyTime: time.Now().Add(-48 * time.Hour), Size: 160},
+			} {
+				walkFunc(info)
+			}
+			return nil
+		})
+	gc := newGarbageCollector(meta, newMockHandler(), GcOption{
+		cli:              cm,
+		missingTolerance: 24 * time.Hour,
+		dropTolerance:    24 * time.Hour,
+	})
+
+	t.Run("with tolerances", func(t *testing.T) {
+		resp, err := gc.DryRun(context.TODO(), 24*time.Hour, 0, 0)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 5, resp.GetNumFiles())
+		assert.EqualValues(t, 20+40+100+200+50, resp.GetTotalBytes())
+		assert.False(t, resp.GetTruncated())
+		categories := lo.GroupBy(resp.GetFiles(), func(file *datapb.GcDryRunFile) datapb.GcFileCategory {
+			return file.GetCategory()
+		})
+		assert.Equal(t, 2, len(categories[datapb.GcFileCategory_OrphanBinlog]))
+		assert.Equal(t, 3, len(categories[datapb.GcFileCategory_DroppedSegment]))
+
+		resp, err = gc.DryRun(context.TODO(), 24*time.Hour, 24*time.Hour, 0)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, resp.GetNumFiles())
+		assert.ElementsMatch(t, []string{"root/insert_log/1/10/100/0/2", "root/insert_log/1/10/300/0/6"},
+			lo.Map(resp.GetFiles(), func(file *datapb.GcDryRunFile, _ int) string { return file.GetPath() }))
+	})
+
+	t.Run("with limit", func(t *testing.T) {
+		resp, err := gc.DryRun(context.TODO(), 0, 0, 2)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 6, resp.GetNumFiles())
+		assert.Equal(t, 2, len(resp.GetFiles()))
+		assert.True(t, resp.GetTruncated())
+	})
+
+	t.Run("nothing removed", func(t *testing.T) {
+		assert.NotNil(t, meta.GetSegment(200))
+		cm.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything)
+		cm.AssertNotCalled(t, "RemoveWithPrefix", mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := gc.DryRun(context.TODO(), -time.Second, 0, 0)
+		assert.Error(t, err)
+
+		gc := newGarbageCollector(meta, newMockHandler(), GcOption{})
+		_, err = gc.DryRun(context.TODO(), 0, 0, 0)
+		assert.Error(t, err)
+	})
+}
+
 func TestGarbageCollector_clearETCD(t *testing.T) {
 	catalog := catalogmocks.NewDataCoordCatalog(t)
 	catalog.On("ChannelExists",
@@ -856,6 +1104,11 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 		mock.Anything,
 		mock.Anything,
 	).Return(nil)
+	catalog.On("SaveGcSafeTs",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
 
 	channelCPs := newChannelCps()
 	channelCPs.checkpoints["dmlChannel"] = &msgpb.MsgPosition{
@@ -1146,7 +1399,7 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 			},
 		},
 
-		collections: map[UniqueID]*collectionInfo{
+		collections: newCollectionInfos(map[UniqueID]*collectionInfo{
 			collID: {
 				ID: collID,
 				Schema: &schemapb.CollectionSchema{
@@ -1171,7 +1424,7 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 				StartPositions: nil,
 				Properties:     nil,
 			},
-		},
+		}),
 	}
 
 	for id, segment := range segments {
@@ -1383,7 +1636,7 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 	}
 
 	cm := &mocks.ChunkManager{}
-	cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(nil)
+	cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(nil)
 	gc := newGarbageCollector(
 		m,
 		newMockHandlerWithMeta(m),
@@ -1430,6 +1683,7 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 	assert.NotNil(t, segH)
 	segG = gc.meta.GetSegment(segID + 8)
 	assert.Nil(t, segG)
+	assert.NotZero(t, gc.meta.GetGcSafeTs(collID))
 	err := gc.meta.indexMeta.AddSegmentIndex(&model.SegmentIndex{
 		SegmentID:    segID + 4,
 		CollectionID: collID,
@@ -1488,6 +1742,10 @@ func TestGarbageCollector_recycleChannelMeta(t *testing.T) {
 		"cluster-id-rootcoord-dm_0_123v0": nil,
 		"cluster-id-rootcoord-dm_0_124v0": nil,
 	}
+	m.gcSafeTs.safeTs = map[UniqueID]Timestamp{
+		123: 1000,
+		124: 1000,
+	}
 
 	gc := newGarbageCollector(m, newMockHandlerWithMeta(m), GcOption{})
 
@@ -1512,9 +1770,12 @@ func TestGarbageCollector_recycleChannelMeta(t *testing.T) {
 		})
 
 	t.Run("drop channel cp fail", func(t *testing.T) {
+		catalog.EXPECT().DropGcSafeTs(mock.Anything, int64(123)).Return(nil).Once()
 		catalog.EXPECT().DropChannelCheckpoint(mock.Anything, mock.Anything).Return(errors.New("mock error")).Once()
 		gc.recycleChannelCPMeta(context.TODO())
 		assert.Equal(t, 2, len(m.channelCPs.checkpoints))
+		assert.Zero(t, m.GetGcSafeTs(123))
+		assert.Equal(t, uint64(1000), m.GetGcSafeTs(124))
 	})
 
 	t.Run("gc ok", func(t *testing.T) {
@@ -1524,6 +1785,122 @@ func TestGarbageCollector_recycleChannelMeta(t *testing.T) {
 	})
 }
 
+func TestGarbageCollector_collectionRetention(t *testing.T) {
+	paramtable.Init()
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ChannelExists(mock.Anything, mock.Anything).Return(false)
+
+	droppedAt := time.Now().Add(-25 * time.Hour)
+	newDroppedSegment := func(segmentID, collectionID int64) *SegmentInfo {
+		return NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            segmentID,
+			CollectionID:  collectionID,
+			InsertChannel: "dmlChannel",
+			State:         commonpb.SegmentState_Dropped,
+			DroppedAt:     uint64(droppedAt.UnixNano()),
+		})
+	}
+	m := &meta{
+		catalog:    catalog,
+		channelCPs: newChannelCps(),
+		segments:   NewSegmentsInfo(),
+		collections: newCollectionInfos(map[UniqueID]*collectionInfo{
+			1: {ID: 1, Properties: map[string]string{common.CollectionGCRetentionKey: "48"}},
+			2: {ID: 2},
+			3: {ID: 3, Properties: map[string]string{common.CollectionGCRetentionKey: "1"}},
+		}),
+	}
+	m.segments.SetSegment(100, newDroppedSegment(100, 1))
+	m.segments.SetSegment(200, newDroppedSegment(200, 2))
+	m.segments.SetSegment(300, newDroppedSegment(300, 3))
+
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(nil).Maybe()
+	gc := newGarbageCollector(m, newMockHandlerWithMeta(m), GcOption{
+		cli:           cm,
+		dropTolerance: 24 * time.Hour,
+	})
+
+	droppedTs := tsoutil.ComposeTSByTime(time.Unix(0, droppedAt.UnixNano()), 0)
+	recyclable := gc.getRecyclableDroppedSegments(context.TODO(), 24*time.Hour)
+	assert.ElementsMatch(t, []int64{200, 300}, lo.Map(recyclable, func(segment *SegmentInfo, _ int) int64 {
+		return segment.GetID()
+	}))
+
+	// the segment is kept if the safe ts fails to advance
+	catalog.EXPECT().SaveGcSafeTs(mock.Anything, int64(2), droppedTs).Return(errors.New("mock error")).Once()
+	catalog.EXPECT().SaveGcSafeTs(mock.Anything, int64(3), droppedTs).Return(nil).Once()
+	catalog.EXPECT().DropSegment(mock.Anything, mock.Anything).Return(nil).Once()
+	gc.recycleDroppedSegments(context.TODO())
+	assert.NotNil(t, m.GetSegment(100))
+	assert.NotNil(t, m.GetSegment(200))
+	assert.Nil(t, m.GetSegment(300))
+	assert.Zero(t, m.GetGcSafeTs(1))
+	assert.Zero(t, m.GetGcSafeTs(2))
+	assert.Equal(t, droppedTs, m.GetGcSafeTs(3))
+
+	catalog.EXPECT().SaveGcSafeTs(mock.Anything, int64(2), droppedTs).Return(nil).Once()
+	catalog.EXPECT().DropSegment(mock.Anything, mock.Anything).Return(nil).Once()
+	gc.recycleDroppedSegments(context.TODO())
+	assert.NotNil(t, m.GetSegment(100))
+	assert.Nil(t, m.GetSegment(200))
+	assert.Equal(t, droppedTs, m.GetGcSafeTs(2))
+
+	// the safe ts never moves backward
+	assert.NoError(t, m.AdvanceGcSafeTs(2, droppedTs-1))
+	assert.Equal(t, droppedTs, m.GetGcSafeTs(2))
+}
+
+func TestGarbageCollector_segmentReference(t *testing.T) {
+	paramtable.Init()
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ChannelExists(mock.Anything, mock.Anything).Return(false)
+	catalog.EXPECT().ListSegmentReferences(mock.Anything).Return([]*datapb.SegmentReference{
+		{ReferenceID: 1, CollectionID: 1, SegmentIDs: []int64{100}, ExpireTime: time.Now().Add(time.Hour).UnixMilli()},
+		{ReferenceID: 2, CollectionID: 1, SegmentIDs: []int64{200}, ExpireTime: time.Now().Add(-time.Second).UnixMilli()},
+	}, nil)
+	srm, err := newSegmentReferenceMeta(context.TODO(), catalog)
+	assert.NoError(t, err)
+
+	droppedAt := time.Now().Add(-25 * time.Hour)
+	m := &meta{
+		catalog:              catalog,
+		channelCPs:           newChannelCps(),
+		segments:             NewSegmentsInfo(),
+		collections:          newCollectionInfos(map[UniqueID]*collectionInfo{1: {ID: 1}}),
+		segmentReferenceMeta: srm,
+	}
+	for _, segmentID := range []int64{100, 200, 300} {
+		m.segments.SetSegment(segmentID, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            segmentID,
+			CollectionID:  1,
+			InsertChannel: "dmlChannel",
+			State:         commonpb.SegmentState_Dropped,
+			DroppedAt:     uint64(droppedAt.UnixNano()),
+		}))
+	}
+
+	gc := newGarbageCollector(m, newMockHandlerWithMeta(m), GcOption{
+		cli:           mocks.NewChunkManager(t),
+		dropTolerance: 24 * time.Hour,
+	})
+
+	// the segments referenced by the expired references are recyclable
+	recyclable := gc.getRecyclableDroppedSegments(context.TODO(), 24*time.Hour)
+	assert.ElementsMatch(t, []int64{200, 300}, lo.Map(recyclable, func(segment *SegmentInfo, _ int) int64 {
+		return segment.GetID()
+	}))
+
+	catalog.EXPECT().DropSegmentReference(mock.Anything, int64(2)).Return(errors.New("mock error")).Once()
+	gc.recycleExpiredSegmentReferences(context.TODO())
+	assert.NotNil(t, srm.GetReference(2))
+
+	catalog.EXPECT().DropSegmentReference(mock.Anything, int64(2)).Return(nil).Once()
+	gc.recycleExpiredSegmentReferences(context.TODO())
+	assert.Nil(t, srm.GetReference(2))
+	assert.NotNil(t, srm.GetReference(1))
+}
+
 func TestGarbageCollector_removeObjectPool(t *testing.T) {
 	paramtable.Init()
 	cm := mocks.NewChunkManager(t)
@@ -1540,32 +1917,73 @@ func TestGarbageCollector_removeObjectPool(t *testing.T) {
 	}
 
 	t.Run("success", func(t *testing.T) {
-		call := cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(nil)
+		call := cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(nil)
 		defer call.Unset()
 		b := gc.removeObjectFiles(context.TODO(), logs)
 		assert.NoError(t, b)
 	})
 
 	t.Run("oss not found error", func(t *testing.T) {
-		call := cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(merr.WrapErrIoKeyNotFound("not found"))
+		call := cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(merr.WrapErrIoKeyNotFound("not found"))
 		defer call.Unset()
 		b := gc.removeObjectFiles(context.TODO(), logs)
 		assert.NoError(t, b)
 	})
 
 	t.Run("oss server error", func(t *testing.T) {
-		call := cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(merr.WrapErrIoFailed("server error", errors.New("err")))
+		call := cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(merr.WrapErrIoFailed("server error", errors.New("err")))
 		defer call.Unset()
 		b := gc.removeObjectFiles(context.TODO(), logs)
 		assert.Error(t, b)
 	})
 
 	t.Run("other type error", func(t *testing.T) {
-		call := cm.EXPECT().Remove(mock.Anything, mock.Anything).Return(errors.New("other error"))
+		call := cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).Return(errors.New("other error"))
 		defer call.Unset()
 		b := gc.removeObjectFiles(context.TODO(), logs)
 		assert.Error(t, b)
 	})
+
+	t.Run("batched", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.GCRemoveBatchSize.Key, "20")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.GCRemoveBatchSize.Key)
+
+		removed := atomic.NewInt32(0)
+		calls := atomic.NewInt32(0)
+		call := cm.EXPECT().MultiRemove(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, files []string) error {
+			assert.LessOrEqual(t, len(files), 20)
+			calls.Inc()
+			removed.Add(int32(len(files)))
+			return nil
+		})
+		defer call.Unset()
+		b := gc.removeObjectFiles(context.TODO(), logs)
+		assert.NoError(t, b)
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Equal(t, int32(len(logs)), removed.Load())
+	})
+}
+
+func TestGarbageCollector_removeQuota(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.DataCoordCfg.GCRemoveQPS.Key, "1")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.GCRemoveQPS.Key)
+
+	gc := newGarbageCollector(nil, nil, GcOption{cli: mocks.NewChunkManager(t)})
+	// the limiter allows the request as long as its tokens are not negative
+	assert.NoError(t, gc.waitRemoveQuota(context.TODO()))
+	assert.NoError(t, gc.waitRemoveQuota(context.TODO()))
+
+	// quota exhausted
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, gc.waitRemoveQuota(ctx), context.DeadlineExceeded)
+
+	// no limit
+	paramtable.Get().Save(Params.DataCoordCfg.GCRemoveQPS.Key, "0")
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, gc.waitRemoveQuota(context.TODO()))
+	}
 }
 
 type GarbageCollectorSuite struct {
@@ -17,7 +17,6 @@
 package datacoord
 
 import (
-	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -32,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/lock"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 const (
@@ -93,12 +93,16 @@ func (s *importScheduler) Close() {
 }
 
 func (s *importScheduler) process() {
-	getNodeID := func(nodeSlots map[int64]int64) int64 {
+	maxTasksPerNode := Params.DataCoordCfg.MaxImportTasksPerNode.GetAsInt64()
+	getNodeID := func(nodeSlots map[int64]int64, nodeTasks map[int64]int64) int64 {
 		var (
 			nodeID   int64 = NullNodeID
 			maxSlots int64 = -1
 		)
 		for id, slots := range nodeSlots {
+			if maxTasksPerNode > 0 && nodeTasks[id] >= maxTasksPerNode {
+				continue
+			}
 			if slots > 0 && slots > maxSlots {
 				nodeID = id
 				maxSlots = slots
@@ -106,21 +110,21 @@ func (s *importScheduler) process() {
 		}
 		if nodeID != NullNodeID {
 			nodeSlots[nodeID]--
+			nodeTasks[nodeID]++
 		}
 		return nodeID
 	}
 
 	jobs := s.imeta.GetJobBy()
-	sort.Slice(jobs, func(i, j int) bool {
-		return jobs[i].GetJobID() < jobs[j].GetJobID()
-	})
+	SortJobsByPriority(jobs)
 	nodeSlots := s.peekSlots()
+	nodeTasks := s.countRunningTasks()
 	for _, job := range jobs {
 		tasks := s.imeta.GetTaskBy(WithJob(job.GetJobID()))
 		for _, task := range tasks {
 			switch task.GetState() {
 			case datapb.ImportTaskStateV2_Pending:
-				nodeID := getNodeID(nodeSlots)
+				nodeID := getNodeID(nodeSlots, nodeTasks)
 				switch task.GetType() {
 				case PreImportTaskType:
 					s.processPendingPreImport(task, nodeID)
@@ -143,8 +147,22 @@ func (s *importScheduler) process() {
 	}
 }
 
+// countRunningTasks returns the number of the preimport and import tasks running on each DataNode.
+func (s *importScheduler) countRunningTasks() map[int64]int64 {
+	nodeTasks := make(map[int64]int64)
+	for _, task := range s.imeta.GetTaskBy(WithStates(datapb.ImportTaskStateV2_InProgress)) {
+		nodeTasks[task.GetNodeID()]++
+	}
+	return nodeTasks
+}
+
 func (s *importScheduler) peekSlots() map[int64]int64 {
-	nodeIDs := lo.Map(s.cluster.GetSessions(), func(s *Session, _ int) int64 {
+	return peekSlots(s.cluster)
+}
+
+// peekSlots returns the free slots of each DataNode, which are shared by the import and export tasks.
+func peekSlots(cluster Cluster) map[int64]int64 {
+	nodeIDs := lo.Map(cluster.GetSessions(), func(s *Session, _ int) int64 {
 		return s.info.NodeID
 	})
 	nodeSlots := make(map[int64]int64)
@@ -154,7 +172,7 @@ func (s *importScheduler) peekSlots() map[int64]int64 {
 		wg.Add(1)
 		go func(nodeID int64) {
 			defer wg.Done()
-			resp, err := s.cluster.QueryImport(nodeID, &datapb.QueryImportRequest{QuerySlot: true})
+			resp, err := cluster.QueryImport(nodeID, &datapb.QueryImportRequest{QuerySlot: true})
 			if err != nil {
 				log.Warn("query import failed", zap.Error(err))
 				return
@@ -264,7 +282,8 @@ func (s *importScheduler) processInProgressImport(task ImportTask) {
 		if updateErr != nil {
 			log.Warn("failed to update import task state to pending", WrapTaskLog(task, zap.Error(updateErr))...)
 		}
-		log.Info("reset import task state to pending due to error occurs", WrapTaskLog(task, zap.Error(err))...)
+		log.Info("reset import task state to pending due to error occurs, the import will resume from the checkpoint",
+			WrapTaskLog(task, zap.Int64s("completedFiles", task.(*importTask).GetCompletedFileIDs()), zap.Error(err))...)
 		return
 	}
 	if resp.GetState() == datapb.ImportTaskStateV2_Failed {
@@ -301,6 +320,24 @@ func (s *importScheduler) processInProgressImport(task ImportTask) {
 			strconv.FormatInt(task.GetCollectionID(), 10),
 		).Add(float64(diff))
 	}
+	// The resumed task only reports the files completed after the resumption.
+	completedFiles := typeutil.NewSet(task.(*importTask).GetCompletedFileIDs()...)
+	if resp.GetState() == datapb.ImportTaskStateV2_InProgress &&
+		!completedFiles.Contain(resp.GetCompletedFileIDs()...) {
+		completedFiles.Insert(resp.GetCompletedFileIDs()...)
+		checkpoint, err := CheckpointImportSegments(resp.GetImportSegmentsInfo())
+		if err != nil {
+			log.Warn("fail to checkpoint import segments", WrapTaskLog(task, zap.Error(err))...)
+			return
+		}
+		err = s.imeta.UpdateTask(task.GetTaskID(), UpdateCheckpoint(completedFiles.Collect(), checkpoint))
+		if err != nil {
+			log.Warn("update import task checkpoint failed", WrapTaskLog(task, zap.Error(err))...)
+			return
+		}
+		log.Info("import task checkpointed", WrapTaskLog(task, zap.Int("completedFiles", completedFiles.Len()),
+			zap.Int("totalFiles", len(task.GetFileStats())))...)
+	}
 	if resp.GetState() == datapb.ImportTaskStateV2_Completed {
 		for _, info := range resp.GetImportSegmentsInfo() {
 			// try to parse path and fill logID
@@ -29,6 +29,10 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 type ImportSchedulerSuite struct {
@@ -55,10 +59,13 @@ func (s *ImportSchedulerSuite) SetupTest() {
 	s.catalog.EXPECT().ListImportTasks().Return(nil, nil)
 	s.catalog.EXPECT().ListSegments(mock.Anything).Return(nil, nil)
 	s.catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
+	s.catalog.EXPECT().ListGcSafeTs(mock.Anything).Return(nil, nil)
 	s.catalog.EXPECT().ListIndexes(mock.Anything).Return(nil, nil)
 	s.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return(nil, nil)
 	s.catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
+	s.catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
 	s.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+	s.catalog.EXPECT().ListSegmentReferences(mock.Anything).Return(nil, nil)
 	s.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 
 	s.cluster = NewMockCluster(s.T())
@@ -210,6 +217,103 @@ func (s *ImportSchedulerSuite) TestProcessImport() {
 	s.Equal(int64(NullNodeID), task.GetNodeID())
 }
 
+func (s *ImportSchedulerSuite) TestProcessImportResume() {
+	s.catalog.EXPECT().SaveImportJob(mock.Anything).Return(nil)
+	s.catalog.EXPECT().SaveImportTask(mock.Anything).Return(nil)
+	s.catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil)
+	s.catalog.EXPECT().AlterSegments(mock.Anything, mock.Anything).Return(nil)
+	const nodeID = 10
+	var task ImportTask = &importTask{
+		ImportTaskV2: &datapb.ImportTaskV2{
+			JobID:        0,
+			TaskID:       1,
+			CollectionID: s.collectionID,
+			NodeID:       nodeID,
+			SegmentIDs:   []int64{5},
+			State:        datapb.ImportTaskStateV2_InProgress,
+			FileStats: []*datapb.ImportFileStats{
+				{ImportFile: &internalpb.ImportFile{Id: 1, Paths: []string{"a.json"}}, TotalRows: 100},
+				{ImportFile: &internalpb.ImportFile{Id: 2, Paths: []string{"b.json"}}, TotalRows: 100},
+			},
+		},
+	}
+	err := s.imeta.AddTask(task)
+	s.NoError(err)
+	err = s.imeta.AddJob(&importJob{
+		ImportJob: &datapb.ImportJob{
+			JobID:        0,
+			CollectionID: s.collectionID,
+			PartitionIDs: []int64{2},
+			Vchannels:    []string{"channel1"},
+			Schema:       &schemapb.CollectionSchema{},
+			TimeoutTs:    math.MaxUint64,
+		},
+	})
+	s.NoError(err)
+	err = s.meta.AddSegment(context.Background(), &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{ID: 5, PartitionID: 2, InsertChannel: "channel1", State: commonpb.SegmentState_Importing, IsImporting: true},
+	})
+	s.NoError(err)
+	s.cluster.EXPECT().GetSessions().Return([]*Session{{info: &NodeInfo{NodeID: nodeID}}})
+
+	// the first file is completed, the rows of the file in progress are not checkpointed
+	s.cluster.EXPECT().QueryImport(mock.Anything, mock.Anything).RunAndReturn(
+		func(nodeID int64, req *datapb.QueryImportRequest) (*datapb.QueryImportResponse, error) {
+			if req.GetQuerySlot() {
+				return &datapb.QueryImportResponse{Slots: 1}, nil
+			}
+			return &datapb.QueryImportResponse{
+				State:            datapb.ImportTaskStateV2_InProgress,
+				CompletedFileIDs: []int64{1},
+				ImportSegmentsInfo: []*datapb.ImportSegmentInfo{{
+					SegmentID:    5,
+					ImportedRows: 150,
+					Binlogs: []*datapb.FieldBinlog{
+						{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1000, EntriesNum: 100}}},
+						{FieldID: 101, Binlogs: []*datapb.Binlog{{LogID: 1001, EntriesNum: 100}}},
+					},
+				}},
+			}, nil
+		})
+	s.scheduler.process()
+	task = s.imeta.GetTask(task.GetTaskID())
+	s.Equal(datapb.ImportTaskStateV2_InProgress, task.GetState())
+	s.ElementsMatch([]int64{1}, task.(*importTask).GetCompletedFileIDs())
+	s.Equal(1, len(task.(*importTask).GetCheckpointSegmentsInfo()))
+	s.EqualValues(100, task.(*importTask).GetCheckpointSegmentsInfo()[0].GetImportedRows())
+	s.EqualValues(150, s.meta.GetSegment(5).GetNumOfRows())
+
+	// the node is lost, the task is reset to pending
+	s.cluster.ExpectedCalls = lo.Filter(s.cluster.ExpectedCalls, func(call *mock.Call, _ int) bool {
+		return call.Method != "QueryImport"
+	})
+	s.cluster.EXPECT().QueryImport(mock.Anything, mock.Anything).RunAndReturn(
+		func(nodeID int64, req *datapb.QueryImportRequest) (*datapb.QueryImportResponse, error) {
+			if req.GetQuerySlot() {
+				return &datapb.QueryImportResponse{Slots: 1}, nil
+			}
+			return nil, merr.WrapErrNodeNotFound(nodeID)
+		})
+	s.scheduler.process()
+	task = s.imeta.GetTask(task.GetTaskID())
+	s.Equal(datapb.ImportTaskStateV2_Pending, task.GetState())
+
+	// only the remaining file is imported with the checkpoint
+	s.alloc.EXPECT().allocN(mock.Anything).Return(100, 200, nil)
+	s.alloc.EXPECT().allocTimestamp(mock.Anything).Return(300, nil)
+	s.cluster.EXPECT().ImportV2(mock.Anything, mock.Anything).RunAndReturn(func(nodeID int64, req *datapb.ImportRequest) error {
+		s.Equal(1, len(req.GetFiles()))
+		s.EqualValues(2, req.GetFiles()[0].GetId())
+		s.Equal(1, len(req.GetCheckpointSegmentsInfo()))
+		s.EqualValues(100, req.GetCheckpointSegmentsInfo()[0].GetImportedRows())
+		return nil
+	})
+	s.scheduler.process()
+	task = s.imeta.GetTask(task.GetTaskID())
+	s.Equal(datapb.ImportTaskStateV2_InProgress, task.GetState())
+	s.ElementsMatch([]int64{1}, task.(*importTask).GetCompletedFileIDs())
+}
+
 func (s *ImportSchedulerSuite) TestProcessFailed() {
 	s.catalog.EXPECT().SaveImportJob(mock.Anything).Return(nil)
 	s.catalog.EXPECT().SaveImportTask(mock.Anything).Return(nil)
@@ -274,6 +378,68 @@ func (s *ImportSchedulerSuite) TestProcessFailed() {
 	s.Equal(int64(NullNodeID), task.GetNodeID())
 }
 
+func (s *ImportSchedulerSuite) TestProcessByPriority() {
+	s.catalog.EXPECT().SaveImportJob(mock.Anything).Return(nil)
+	s.catalog.EXPECT().SavePreImportTask(mock.Anything).Return(nil)
+	for _, jobID := range []int64{1, 2, 3} {
+		var options []*commonpb.KeyValuePair
+		if jobID == 3 {
+			options = []*commonpb.KeyValuePair{{Key: importutilv2.Priority, Value: "10"}}
+		}
+		err := s.imeta.AddJob(&importJob{
+			ImportJob: &datapb.ImportJob{
+				JobID:        jobID,
+				CollectionID: s.collectionID,
+				TimeoutTs:    math.MaxUint64,
+				Schema:       &schemapb.CollectionSchema{},
+				Options:      options,
+			},
+		})
+		s.NoError(err)
+		err = s.imeta.AddTask(&preImportTask{
+			PreImportTask: &datapb.PreImportTask{
+				JobID:        jobID,
+				TaskID:       jobID * 10,
+				CollectionID: s.collectionID,
+				State:        datapb.ImportTaskStateV2_Pending,
+			},
+		})
+		s.NoError(err)
+	}
+	s.EqualValues(10, GetJobPriority(s.imeta.GetJob(3)))
+	s.EqualValues(1, GetJobQueuePosition(3, s.imeta))
+	s.EqualValues(2, GetJobQueuePosition(1, s.imeta))
+	s.EqualValues(3, GetJobQueuePosition(2, s.imeta))
+
+	// the job of higher priority is scheduled first
+	const nodeID = 10
+	s.cluster.EXPECT().GetSessions().Return([]*Session{{info: &NodeInfo{NodeID: nodeID}}})
+	s.cluster.EXPECT().QueryImport(mock.Anything, mock.Anything).Return(&datapb.QueryImportResponse{
+		Slots: 1,
+	}, nil).Once()
+	s.cluster.EXPECT().PreImport(mock.Anything, mock.Anything).Return(nil)
+	s.scheduler.process()
+	s.Equal(datapb.ImportTaskStateV2_InProgress, s.imeta.GetTask(30).GetState())
+	s.Equal(datapb.ImportTaskStateV2_Pending, s.imeta.GetTask(10).GetState())
+	s.Equal(datapb.ImportTaskStateV2_Pending, s.imeta.GetTask(20).GetState())
+	s.EqualValues(0, GetJobQueuePosition(3, s.imeta))
+	s.EqualValues(1, GetJobQueuePosition(1, s.imeta))
+
+	// limited by the concurrent tasks per node
+	paramtable.Get().Save(Params.DataCoordCfg.MaxImportTasksPerNode.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.MaxImportTasksPerNode.Key)
+	s.cluster.EXPECT().QueryImport(mock.Anything, mock.Anything).Return(&datapb.QueryImportResponse{
+		Slots: 10,
+	}, nil)
+	s.cluster.EXPECT().QueryPreImport(mock.Anything, mock.Anything).Return(&datapb.QueryPreImportResponse{
+		State: datapb.ImportTaskStateV2_InProgress,
+	}, nil)
+	s.scheduler.process()
+	s.Equal(datapb.ImportTaskStateV2_InProgress, s.imeta.GetTask(10).GetState())
+	s.Equal(datapb.ImportTaskStateV2_Pending, s.imeta.GetTask(20).GetState())
+	s.EqualValues(1, GetJobQueuePosition(2, s.imeta))
+}
+
 func TestImportScheduler(t *testing.T) {
 	suite.Run(t, new(ImportSchedulerSuite))
 }
@@ -122,6 +122,15 @@ func UpdateSegmentIDs(segmentIDs []UniqueID) UpdateAction {
 	}
 }
 
+func UpdateCheckpoint(completedFileIDs []int64, segmentsInfo []*datapb.ImportSegmentInfo) UpdateAction {
+	return func(t ImportTask) {
+		if task, ok := t.(*importTask); ok {
+			task.ImportTaskV2.CompletedFileIDs = completedFileIDs
+			task.ImportTaskV2.CheckpointSegmentsInfo = segmentsInfo
+		}
+	}
+}
+
 type ImportTask interface {
 	GetJobID() int64
 	GetTaskID() int64
@@ -26,7 +26,9 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -34,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func WrapTaskLog(task ImportTask, fields ...zap.Field) []zap.Field {
@@ -196,7 +199,12 @@ func AssembleImportRequest(task ImportTask, job ImportJob, meta *meta, alloc all
 		return nil, err
 	}
 
-	totalRows := lo.SumBy(task.GetFileStats(), func(stat *datapb.ImportFileStats) int64 {
+	// Only the files not checkpointed yet are imported if the task is resumed.
+	completedFiles := typeutil.NewSet(task.(*importTask).GetCompletedFileIDs()...)
+	fileStats := lo.Filter(task.GetFileStats(), func(fileStat *datapb.ImportFileStats, _ int) bool {
+		return !completedFiles.Contain(fileStat.GetImportFile().GetId())
+	})
+	totalRows := lo.SumBy(fileStats, func(stat *datapb.ImportFileStats) int64 {
 		return stat.GetTotalRows()
 	})
 
@@ -208,7 +216,7 @@ func AssembleImportRequest(task ImportTask, job ImportJob, meta *meta, alloc all
 		return nil, err
 	}
 
-	importFiles := lo.Map(task.GetFileStats(), func(fileStat *datapb.ImportFileStats, _ int) *internalpb.ImportFile {
+	importFiles := lo.Map(fileStats, func(fileStat *datapb.ImportFileStats, _ int) *internalpb.ImportFile {
 		return fileStat.GetImportFile()
 	})
 	return &datapb.ImportRequest{
@@ -223,9 +231,31 @@ func AssembleImportRequest(task ImportTask, job ImportJob, meta *meta, alloc all
 		Ts:              ts,
 		IDRange:         &datapb.IDRange{Begin: idBegin, End: idEnd},
 		RequestSegments: requestSegments,
+
+		CheckpointSegmentsInfo: task.(*importTask).GetCheckpointSegmentsInfo(),
 	}, nil
 }
 
+// CheckpointImportSegments returns the segments info of the completed files reported by the datanode with the
+// binlogs compressed. The imported rows are counted by the binlogs, since the rows reported by the datanode
+// may include the rows of the files still in progress.
+func CheckpointImportSegments(infos []*datapb.ImportSegmentInfo) ([]*datapb.ImportSegmentInfo, error) {
+	checkpoint := make([]*datapb.ImportSegmentInfo, 0, len(infos))
+	for _, info := range infos {
+		info = proto.Clone(info).(*datapb.ImportSegmentInfo)
+		err := binlog.CompressBinLogs(info.GetBinlogs(), info.GetDeltalogs(), info.GetStatslogs())
+		if err != nil {
+			return nil, err
+		}
+		info.ImportedRows = 0
+		if len(info.GetBinlogs()) > 0 {
+			info.ImportedRows = int64(GetBinlogEntriesNum(info.GetBinlogs()[:1]))
+		}
+		checkpoint = append(checkpoint, info)
+	}
+	return checkpoint, nil
+}
+
 func RegroupImportFiles(job ImportJob, files []*datapb.ImportFileStats) [][]*datapb.ImportFileStats {
 	if len(files) == 0 {
 		return nil
@@ -435,14 +465,16 @@ func GetTaskProgresses(jobID int64, imeta ImportMeta, meta *meta) []*internalpb.
 		}
 		for _, fileStat := range task.GetFileStats() {
 			progresses = append(progresses, &internalpb.ImportTaskProgress{
-				FileName:     fmt.Sprintf("%v", fileStat.GetImportFile().GetPaths()),
-				FileSize:     fileStat.GetFileSize(),
-				Reason:       task.GetReason(),
-				Progress:     progress,
-				CompleteTime: task.(*importTask).GetCompleteTime(),
-				State:        task.GetState().String(),
-				ImportedRows: progress * fileStat.GetTotalRows() / 100,
-				TotalRows:    fileStat.GetTotalRows(),
+				FileName:       fmt.Sprintf("%v", fileStat.GetImportFile().GetPaths()),
+				FileSize:       fileStat.GetFileSize(),
+				Reason:         task.GetReason(),
+				Progress:       progress,
+				CompleteTime:   task.(*importTask).GetCompleteTime(),
+				State:          task.GetState().String(),
+				ImportedRows:   progress * fileStat.GetTotalRows() / 100,
+				TotalRows:      fileStat.GetTotalRows(),
+				RejectedRows:   fileStat.GetRejectedRows(),
+				QuarantinePath: fileStat.GetQuarantinePath(),
 			})
 		}
 	}
@@ -506,3 +538,74 @@ func ListBinlogsAndGroupBySegment(ctx context.Context, cm storage.ChunkManager,
 	}
 	return segmentImportFiles, nil
 }
+
+// ListParquetDataset lists the Parquet files in the directory of the dataset recursively, each file is imported
+// as a single import file, the Hive-style partition columns are kept in the paths and parsed by the reader.
+func ListParquetDataset(ctx context.Context, cm storage.ChunkManager, importFile *internalpb.ImportFile) ([]*internalpb.ImportFile, error) {
+	dataset := importFile.GetPaths()[0]
+	paths, _, err := storage.ListAllChunkWithPrefix(ctx, cm, dataset, true)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*internalpb.ImportFile, 0, len(paths))
+	for _, filePath := range paths {
+		if importutilv2.IsParquetDatasetFile(dataset, filePath) {
+			files = append(files, &internalpb.ImportFile{Paths: []string{filePath}})
+		}
+	}
+	if len(files) == 0 {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("no parquet file in the dataset %s", dataset))
+	}
+	return files, nil
+}
+
+// GetJobRejectedRows returns the number of the rows rejected by the schema validation during preimport.
+func GetJobRejectedRows(jobID int64, imeta ImportMeta) int64 {
+	tasks := imeta.GetTaskBy(WithJob(jobID), WithType(PreImportTaskType))
+	return lo.SumBy(tasks, func(task ImportTask) int64 {
+		return lo.SumBy(task.GetFileStats(), func(file *datapb.ImportFileStats) int64 {
+			return file.GetRejectedRows()
+		})
+	})
+}
+
+// GetJobPriority returns the scheduling priority of the import job, the invalid priority is rejected on import.
+func GetJobPriority(job ImportJob) int64 {
+	priority, _ := importutilv2.ParsePriority(job.GetOptions())
+	return priority
+}
+
+// SortJobsByPriority sorts the import jobs in the scheduling order,
+// the jobs of higher priority come first, and the earlier jobs come first among the same priority.
+func SortJobsByPriority(jobs []ImportJob) {
+	priorities := lo.SliceToMap(jobs, func(job ImportJob) (int64, int64) {
+		return job.GetJobID(), GetJobPriority(job)
+	})
+	sort.Slice(jobs, func(i, j int) bool {
+		pi, pj := priorities[jobs[i].GetJobID()], priorities[jobs[j].GetJobID()]
+		if pi != pj {
+			return pi > pj
+		}
+		return jobs[i].GetJobID() < jobs[j].GetJobID()
+	})
+}
+
+// GetJobQueuePosition returns the position of the job among the jobs waiting for the DataNodes in the scheduling order,
+// 1 for the next job to be scheduled, 0 if the job has no pending task.
+func GetJobQueuePosition(jobID int64, imeta ImportMeta) int64 {
+	pendingJobs := lo.SliceToMap(imeta.GetTaskBy(WithStates(datapb.ImportTaskStateV2_Pending)), func(task ImportTask) (int64, struct{}) {
+		return task.GetJobID(), struct{}{}
+	})
+	if _, ok := pendingJobs[jobID]; !ok {
+		return 0
+	}
+	jobs := lo.Filter(imeta.GetJobBy(), func(job ImportJob, _ int) bool {
+		_, ok := pendingJobs[job.GetJobID()]
+		return ok
+	})
+	SortJobsByPriority(jobs)
+	_, index, _ := lo.FindIndexOf(jobs, func(job ImportJob) bool {
+		return job.GetJobID() == jobID
+	})
+	return int64(index + 1)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -150,11 +151,14 @@ func TestImportUtil_AssembleRequest(t *testing.T) {
 	catalog := mocks.NewDataCoordCatalog(t)
 	catalog.EXPECT().ListSegments(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListGcSafeTs(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListIndexes(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil)
 	catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListSegmentReferences(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 
 	alloc := NewNMockAllocator(t)
@@ -234,9 +238,12 @@ func TestImportUtil_CheckDiskQuota(t *testing.T) {
 	catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListSegments(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListGcSafeTs(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil)
 	catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListSegmentReferences(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 
 	imeta, err := NewImportMeta(catalog)
@@ -395,6 +402,39 @@ func TestImportUtil_ListBinlogsAndGroupBySegment(t *testing.T) {
 	})
 }
 
+func TestImportUtil_ListParquetDataset(t *testing.T) {
+	const dataset = "lakehouse/events/"
+	paths := []string{
+		"lakehouse/events/_SUCCESS",
+		"lakehouse/events/dt=2024-01-01/part-0.parquet",
+		"lakehouse/events/dt=2024-01-01/.part-0.parquet.crc",
+		"lakehouse/events/dt=2024-01-02/part-0.parquet",
+		"lakehouse/events/_temporary/dt=2024-01-03/part-0.parquet",
+	}
+	cm := mocks2.NewChunkManager(t)
+	cm.EXPECT().WalkWithPrefix(mock.Anything, dataset, true, mock.Anything).RunAndReturn(
+		func(ctx context.Context, s string, b bool, cowf storage.ChunkObjectWalkFunc) error {
+			for _, p := range paths {
+				if !cowf(&storage.ChunkObjectInfo{FilePath: p}) {
+					return nil
+				}
+			}
+			return nil
+		})
+
+	file := &internalpb.ImportFile{Paths: []string{dataset}}
+	assert.True(t, importutilv2.IsParquetDataset(file))
+	files, err := ListParquetDataset(context.Background(), cm, file)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{paths[1], paths[3]}, lo.Map(files, func(f *internalpb.ImportFile, _ int) string {
+		return f.GetPaths()[0]
+	}))
+
+	paths = paths[:1]
+	_, err = ListParquetDataset(context.Background(), cm, file)
+	assert.Error(t, err)
+}
+
 func TestImportUtil_GetImportProgress(t *testing.T) {
 	ctx := context.Background()
 	mockErr := "mock err"
@@ -405,6 +445,7 @@ func TestImportUtil_GetImportProgress(t *testing.T) {
 	catalog.EXPECT().ListImportTasks().Return(nil, nil)
 	catalog.EXPECT().ListSegments(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListGcSafeTs(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListIndexes(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().SaveImportJob(mock.Anything).Return(nil)
@@ -413,7 +454,9 @@ func TestImportUtil_GetImportProgress(t *testing.T) {
 	catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil)
 	catalog.EXPECT().AlterSegments(mock.Anything, mock.Anything).Return(nil)
 	catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListSegmentReferences(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 
 	imeta, err := NewImportMeta(catalog)
@@ -469,13 +512,16 @@ func TestImportUtil_GetImportProgress(t *testing.T) {
 			State:  datapb.ImportTaskStateV2_Completed,
 			FileStats: []*datapb.ImportFileStats{
 				{
-					ImportFile: file3,
+					ImportFile:     file3,
+					RejectedRows:   5,
+					QuarantinePath: "import_quarantine/0/2/3.json",
 				},
 			},
 		},
 	}
 	err = imeta.AddTask(pit2)
 	assert.NoError(t, err)
+	assert.Equal(t, int64(5), GetJobRejectedRows(job.GetJobID(), imeta))
 
 	it1 := &importTask{
 		ImportTaskV2: &datapb.ImportTaskV2{
@@ -20,12 +20,14 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
-	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -35,12 +37,14 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/lock"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type indexMeta struct {
-	sync.RWMutex
+	lock.NamedRWMutex
 	ctx     context.Context
 	catalog metastore.DataCoordCatalog
 
@@ -53,11 +57,20 @@ type indexMeta struct {
 
 	// segmentID -> indexID -> segmentIndex
 	segmentIndexes map[UniqueID]map[UniqueID]*model.SegmentIndex
+
+	// reusedFiles records the builds whose index files are reused by other segment indexes
+	// source buildID -> the buildIDs reusing the files
+	reusedFiles map[UniqueID]typeutil.UniqueSet
+
+	// indexTaskStates counts the builds in each state for the metrics
+	// collID -> state -> number of the builds
+	indexTaskStates map[UniqueID]map[commonpb.IndexState]int
 }
 
 // NewMeta creates meta from provided `kv.TxnKV`
 func newIndexMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*indexMeta, error) {
 	mt := &indexMeta{
+		NamedRWMutex:         lock.NewNamedRWMutex("datacoord_index_meta"),
 		ctx:                  ctx,
 		catalog:              catalog,
 		indexes:              make(map[UniqueID]map[UniqueID]*model.Index),
@@ -92,6 +105,9 @@ func (m *indexMeta) reloadFromKV() error {
 		m.updateSegmentIndex(segIdx)
 		metrics.FlushedSegmentFileNum.WithLabelValues(metrics.IndexFileLabel).Observe(float64(len(segIdx.IndexFileKeys)))
 	}
+	for collectionID := range m.indexTaskStates {
+		m.updateIndexTasksMetrics(collectionID)
+	}
 	log.Info("indexMeta reloadFromKV done", zap.Duration("duration", record.ElapseSpan()))
 	return nil
 }
@@ -103,15 +119,39 @@ func (m *indexMeta) updateCollectionIndex(index *model.Index) {
 	m.indexes[index.CollectionID][index.IndexID] = index
 }
 
+// isServingSegmentIndex checks whether the segment index is the one serving the segment, the unfinished rebuilds
+// and the segment indexes replaced by their rebuilds are tracked by the builds only.
+func isServingSegmentIndex(segIdx *model.SegmentIndex) bool {
+	if segIdx.ReplacedTime != 0 {
+		return false
+	}
+	return segIdx.ReplacedBuildID == 0 || segIdx.IndexState == commonpb.IndexState_Finished
+}
+
 func (m *indexMeta) updateSegmentIndex(segIdx *model.SegmentIndex) {
-	indexes, ok := m.segmentIndexes[segIdx.SegmentID]
-	if ok {
-		indexes[segIdx.IndexID] = segIdx
-	} else {
-		m.segmentIndexes[segIdx.SegmentID] = make(map[UniqueID]*model.SegmentIndex)
-		m.segmentIndexes[segIdx.SegmentID][segIdx.IndexID] = segIdx
+	if isServingSegmentIndex(segIdx) {
+		indexes, ok := m.segmentIndexes[segIdx.SegmentID]
+		if ok {
+			indexes[segIdx.IndexID] = segIdx
+		} else {
+			m.segmentIndexes[segIdx.SegmentID] = make(map[UniqueID]*model.SegmentIndex)
+			m.segmentIndexes[segIdx.SegmentID][segIdx.IndexID] = segIdx
+		}
+	}
+	if old, ok := m.buildID2SegmentIndex[segIdx.BuildID]; ok {
+		m.countIndexTask(old, -1)
 	}
+	m.countIndexTask(segIdx, 1)
 	m.buildID2SegmentIndex[segIdx.BuildID] = segIdx
+	if source := segIdx.FilesSource; source != nil {
+		if m.reusedFiles == nil {
+			m.reusedFiles = make(map[UniqueID]typeutil.UniqueSet)
+		}
+		if _, ok := m.reusedFiles[source.GetBuildID()]; !ok {
+			m.reusedFiles[source.GetBuildID()] = typeutil.NewUniqueSet()
+		}
+		m.reusedFiles[source.GetBuildID()].Insert(segIdx.BuildID)
+	}
 }
 
 func (m *indexMeta) alterSegmentIndexes(segIdxes []*model.SegmentIndex) error {
@@ -135,35 +175,31 @@ func (m *indexMeta) updateSegIndexMeta(segIdx *model.SegmentIndex, updateFunc fu
 	return updateFunc(model.CloneSegmentIndex(segIdx))
 }
 
-func (m *indexMeta) updateIndexTasksMetrics() {
-	taskMetrics := make(map[UniqueID]map[commonpb.IndexState]int)
-	for _, segIdx := range m.buildID2SegmentIndex {
-		if segIdx.IsDeleted {
-			continue
-		}
-		if _, ok := taskMetrics[segIdx.CollectionID]; !ok {
-			taskMetrics[segIdx.CollectionID] = make(map[commonpb.IndexState]int)
-			taskMetrics[segIdx.CollectionID][commonpb.IndexState_Unissued] = 0
-			taskMetrics[segIdx.CollectionID][commonpb.IndexState_InProgress] = 0
-			taskMetrics[segIdx.CollectionID][commonpb.IndexState_Finished] = 0
-			taskMetrics[segIdx.CollectionID][commonpb.IndexState_Failed] = 0
-		}
-		taskMetrics[segIdx.CollectionID][segIdx.IndexState]++
-	}
-	for collID, m := range taskMetrics {
-		for k, v := range m {
-			switch k {
-			case commonpb.IndexState_Unissued:
-				metrics.IndexTaskNum.WithLabelValues(strconv.FormatInt(collID, 10), metrics.UnissuedIndexTaskLabel).Set(float64(v))
-			case commonpb.IndexState_InProgress:
-				metrics.IndexTaskNum.WithLabelValues(strconv.FormatInt(collID, 10), metrics.InProgressIndexTaskLabel).Set(float64(v))
-			case commonpb.IndexState_Finished:
-				metrics.IndexTaskNum.WithLabelValues(strconv.FormatInt(collID, 10), metrics.FinishedIndexTaskLabel).Set(float64(v))
-			case commonpb.IndexState_Failed:
-				metrics.IndexTaskNum.WithLabelValues(strconv.FormatInt(collID, 10), metrics.FailedIndexTaskLabel).Set(float64(v))
-			}
-		}
+// countIndexTask adds delta to the number of the index tasks in the state of the segment index.
+func (m *indexMeta) countIndexTask(segIdx *model.SegmentIndex, delta int) {
+	if segIdx.IsDeleted {
+		return
+	}
+	if m.indexTaskStates == nil {
+		m.indexTaskStates = make(map[UniqueID]map[commonpb.IndexState]int)
 	}
+	states, ok := m.indexTaskStates[segIdx.CollectionID]
+	if !ok {
+		states = make(map[commonpb.IndexState]int)
+		m.indexTaskStates[segIdx.CollectionID] = states
+	}
+	states[segIdx.IndexState] += delta
+}
+
+// updateIndexTasksMetrics updates the index task metrics of the collection from the counted states,
+// the builds are not scanned under the lock on each update.
+func (m *indexMeta) updateIndexTasksMetrics(collectionID UniqueID) {
+	states := m.indexTaskStates[collectionID]
+	collection := strconv.FormatInt(collectionID, 10)
+	metrics.IndexTaskNum.WithLabelValues(collection, metrics.UnissuedIndexTaskLabel).Set(float64(states[commonpb.IndexState_Unissued]))
+	metrics.IndexTaskNum.WithLabelValues(collection, metrics.InProgressIndexTaskLabel).Set(float64(states[commonpb.IndexState_InProgress]))
+	metrics.IndexTaskNum.WithLabelValues(collection, metrics.FinishedIndexTaskLabel).Set(float64(states[commonpb.IndexState_Finished]))
+	metrics.IndexTaskNum.WithLabelValues(collection, metrics.FailedIndexTaskLabel).Set(float64(states[commonpb.IndexState_Failed]))
 }
 
 func checkParams(fieldIndex *model.Index, req *indexpb.CreateIndexRequest) bool {
@@ -345,7 +381,7 @@ func (m *indexMeta) AddSegmentIndex(segIndex *model.SegmentIndex) error {
 	log.Info("meta update: adding segment index success", zap.Int64("collectionID", segIndex.CollectionID),
 		zap.Int64("segmentID", segIndex.SegmentID), zap.Int64("indexID", segIndex.IndexID),
 		zap.Int64("buildID", buildID))
-	m.updateIndexTasksMetrics()
+	m.updateIndexTasksMetrics(segIndex.CollectionID)
 	return nil
 }
 
@@ -394,6 +430,7 @@ func (m *indexMeta) GetSegmentIndexState(collID, segmentID UniqueID, indexID Uni
 			state.IndexName = index.IndexName
 			state.State = segIdx.IndexState
 			state.FailReason = segIdx.FailReason
+			state.Progress = segmentIndexProgress(segIdx)
 			return state
 		}
 		state.State = commonpb.IndexState_Unissued
@@ -540,6 +577,25 @@ func (m *indexMeta) IsUnIndexedSegment(collectionID UniqueID, segID UniqueID) bo
 	return false
 }
 
+// HasIndexBuildInProgress returns true if any index build of the segment started after startedAfter
+// is in progress on the IndexNodes.
+func (m *indexMeta) HasIndexBuildInProgress(collectionID UniqueID, segID UniqueID, startedAfter time.Time) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	fieldIndexes, ok := m.indexes[collectionID]
+	if !ok {
+		return false
+	}
+	for _, segIdx := range m.segmentIndexes[segID] {
+		if index, ok := fieldIndexes[segIdx.IndexID]; ok && !index.IsDeleted &&
+			segIdx.IndexState == commonpb.IndexState_InProgress && segIdx.BuildStartTime > startedAfter.UnixMilli() {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *indexMeta) getSegmentIndexes(segID UniqueID) map[UniqueID]*model.SegmentIndex {
 	m.RLock()
 	defer m.RUnlock()
@@ -672,11 +728,13 @@ func (m *indexMeta) IsIndexExist(collID, indexID UniqueID) bool {
 }
 
 // UpdateVersion updates the version and nodeID of the index meta, whenever the task is built once, the version will be updated once.
-func (m *indexMeta) UpdateVersion(buildID UniqueID) error {
+// UpdateVersion bumps the index version of the build as the epoch of a new assignment, and checkpoints
+// the node the build is assigned to, so that the assignment is resumed with the same epoch after failover.
+func (m *indexMeta) UpdateVersion(buildID, nodeID UniqueID) error {
 	m.Lock()
 	defer m.Unlock()
 
-	log.Debug("IndexCoord metaTable UpdateVersion receive", zap.Int64("buildID", buildID))
+	log.Debug("IndexCoord metaTable UpdateVersion receive", zap.Int64("buildID", buildID), zap.Int64("nodeID", nodeID))
 	segIdx, ok := m.buildID2SegmentIndex[buildID]
 	if !ok {
 		return fmt.Errorf("there is no index with buildID: %d", buildID)
@@ -684,6 +742,7 @@ func (m *indexMeta) UpdateVersion(buildID UniqueID) error {
 
 	updateFunc := func(segIdx *model.SegmentIndex) error {
 		segIdx.IndexVersion++
+		segIdx.NodeID = nodeID
 		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
 	}
 
@@ -705,7 +764,19 @@ func (m *indexMeta) FinishTask(taskInfo *indexpb.IndexTaskInfo) error {
 		segIdx.FailReason = taskInfo.GetFailReason()
 		segIdx.IndexSize = taskInfo.GetSerializedSize()
 		segIdx.CurrentIndexVersion = taskInfo.GetCurrentIndexVersion()
-		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
+		segIdx.RequiredCPUFeatures = common.CloneStringList(taskInfo.GetRequiredCpuFeatures())
+		segIdx.Paused = false
+		appendBuildRecord(segIdx, taskInfo.GetState(), taskInfo.GetFailReason())
+		segIdxes := []*model.SegmentIndex{segIdx}
+		// the finished rebuild swaps with the segment index it replaces in the same transaction
+		if segIdx.ReplacedBuildID != 0 && segIdx.IndexState == commonpb.IndexState_Finished {
+			if replaced, ok := m.buildID2SegmentIndex[segIdx.ReplacedBuildID]; ok && replaced.ReplacedTime == 0 {
+				replaced = model.CloneSegmentIndex(replaced)
+				replaced.ReplacedTime = time.Now().UnixMilli()
+				segIdxes = append(segIdxes, replaced)
+			}
+		}
+		return m.alterSegmentIndexes(segIdxes)
 	}
 
 	if err := m.updateSegIndexMeta(segIdx, updateFunc); err != nil {
@@ -716,11 +787,176 @@ func (m *indexMeta) FinishTask(taskInfo *indexpb.IndexTaskInfo) error {
 		zap.String("state", taskInfo.GetState().String()), zap.String("fail reason", taskInfo.GetFailReason()),
 		zap.Int32("current_index_version", taskInfo.GetCurrentIndexVersion()),
 	)
-	m.updateIndexTasksMetrics()
+	m.updateIndexTasksMetrics(segIdx.CollectionID)
 	metrics.FlushedSegmentFileNum.WithLabelValues(metrics.IndexFileLabel).Observe(float64(len(taskInfo.GetIndexFileKeys())))
 	return nil
 }
 
+// LinkIndexFiles finishes the build by reusing the index files of the source segment index,
+// which is built on the binlogs with the same content hash.
+func (m *indexMeta) LinkIndexFiles(buildID UniqueID, source *model.SegmentIndex, contentHash string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok {
+		log.Warn("there is no index with buildID", zap.Int64("buildID", buildID))
+		return nil
+	}
+	filesSource := getIndexFilesSource(source)
+	updateFunc := func(segIdx *model.SegmentIndex) error {
+		segIdx.IndexState = commonpb.IndexState_Finished
+		segIdx.IndexFileKeys = common.CloneStringList(source.IndexFileKeys)
+		segIdx.FailReason = ""
+		segIdx.IndexSize = source.IndexSize
+		segIdx.CurrentIndexVersion = source.CurrentIndexVersion
+		segIdx.RequiredCPUFeatures = common.CloneStringList(source.RequiredCPUFeatures)
+		segIdx.Paused = false
+		segIdx.FilesSource = proto.Clone(filesSource).(*indexpb.IndexFilesSource)
+		segIdx.ContentHash = contentHash
+		appendBuildRecord(segIdx, commonpb.IndexState_Finished, "")
+		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
+	}
+	if err := m.updateSegIndexMeta(segIdx, updateFunc); err != nil {
+		return err
+	}
+
+	log.Info("finish index task by reusing index files", zap.Int64("buildID", buildID),
+		zap.Int64("sourceBuildID", filesSource.GetBuildID()), zap.Int64("sourceSegmentID", filesSource.GetSegmentID()))
+	m.updateIndexTasksMetrics(segIdx.CollectionID)
+	return nil
+}
+
+// AddClonedSegmentIndex adds the finished index of the cloned segment, which shares the index files of the source segment index.
+func (m *indexMeta) AddClonedSegmentIndex(segIndex *model.SegmentIndex, source *model.SegmentIndex) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIndex.IndexState = commonpb.IndexState_Finished
+	segIndex.NumRows = source.NumRows
+	segIndex.IndexVersion = source.IndexVersion
+	segIndex.IndexFileKeys = common.CloneStringList(source.IndexFileKeys)
+	segIndex.IndexSize = source.IndexSize
+	segIndex.CurrentIndexVersion = source.CurrentIndexVersion
+	segIndex.IndexStoreVersion = source.IndexStoreVersion
+	segIndex.RequiredCPUFeatures = common.CloneStringList(source.RequiredCPUFeatures)
+	segIndex.FilesSource = getIndexFilesSource(source)
+	segIndex.ContentHash = source.ContentHash
+	if err := m.catalog.CreateSegmentIndex(m.ctx, segIndex); err != nil {
+		log.Warn("meta update: adding cloned segment index failed",
+			zap.Int64("segmentID", segIndex.SegmentID), zap.Int64("indexID", segIndex.IndexID),
+			zap.Int64("buildID", segIndex.BuildID), zap.Error(err))
+		return err
+	}
+	m.updateSegmentIndex(segIndex)
+	log.Info("meta update: adding cloned segment index success", zap.Int64("collectionID", segIndex.CollectionID),
+		zap.Int64("segmentID", segIndex.SegmentID), zap.Int64("indexID", segIndex.IndexID), zap.Int64("buildID", segIndex.BuildID),
+		zap.Int64("sourceBuildID", segIndex.FilesSource.GetBuildID()), zap.Int64("sourceSegmentID", segIndex.FilesSource.GetSegmentID()))
+	m.updateIndexTasksMetrics(segIndex.CollectionID)
+	return nil
+}
+
+// AddShippedSegmentIndex adds the finished index of the segment shipped from the primary region,
+// the index files are copied under the build of the shipped segment index before.
+func (m *indexMeta) AddShippedSegmentIndex(segIndex *model.SegmentIndex, source *model.SegmentIndex) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIndex.IndexState = commonpb.IndexState_Finished
+	segIndex.NumRows = source.NumRows
+	segIndex.IndexVersion = source.IndexVersion
+	segIndex.IndexFileKeys = common.CloneStringList(source.IndexFileKeys)
+	segIndex.IndexSize = source.IndexSize
+	segIndex.CurrentIndexVersion = source.CurrentIndexVersion
+	segIndex.IndexStoreVersion = source.IndexStoreVersion
+	segIndex.RequiredCPUFeatures = common.CloneStringList(source.RequiredCPUFeatures)
+	segIndex.ContentHash = source.ContentHash
+	if err := m.catalog.CreateSegmentIndex(m.ctx, segIndex); err != nil {
+		log.Warn("meta update: adding shipped segment index failed",
+			zap.Int64("segmentID", segIndex.SegmentID), zap.Int64("indexID", segIndex.IndexID),
+			zap.Int64("buildID", segIndex.BuildID), zap.Error(err))
+		return err
+	}
+	m.updateSegmentIndex(segIndex)
+	log.Info("meta update: adding shipped segment index success", zap.Int64("collectionID", segIndex.CollectionID),
+		zap.Int64("segmentID", segIndex.SegmentID), zap.Int64("indexID", segIndex.IndexID),
+		zap.Int64("buildID", segIndex.BuildID), zap.Int64("sourceBuildID", source.BuildID))
+	m.updateIndexTasksMetrics(segIndex.CollectionID)
+	return nil
+}
+
+// getIndexFilesSource returns the owner of the index files of the segment index.
+func getIndexFilesSource(segIdx *model.SegmentIndex) *indexpb.IndexFilesSource {
+	if segIdx.FilesSource != nil {
+		return proto.Clone(segIdx.FilesSource).(*indexpb.IndexFilesSource)
+	}
+	return &indexpb.IndexFilesSource{
+		BuildID:      segIdx.BuildID,
+		IndexVersion: segIdx.IndexVersion,
+		PartitionID:  segIdx.PartitionID,
+		SegmentID:    segIdx.SegmentID,
+	}
+}
+
+// UpdateBuildProgress records the build progress reported by the worker in memory,
+// the worker reports it again after the coordinator recovers.
+func (m *indexMeta) UpdateBuildProgress(buildID UniqueID, progress int32) {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok || segIdx.Progress == progress {
+		return
+	}
+	cloned := model.CloneSegmentIndex(segIdx)
+	cloned.Progress = progress
+	m.updateSegmentIndex(cloned)
+}
+
+// UpdateBuildTiming records the timing breakdown of the current attempt of the build in memory,
+// it's persisted along with the state of the build and moved to the build history once the attempt ends.
+func (m *indexMeta) UpdateBuildTiming(buildID UniqueID, timing *indexpb.TaskTiming) {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok {
+		return
+	}
+	cloned := model.CloneSegmentIndex(segIdx)
+	cloned.BuildTiming = timing
+	m.updateSegmentIndex(cloned)
+}
+
+// TakeBuildAttempt records the worker and the version of the attempt whose result is taken in memory,
+// it's persisted along with the result when the task finishes.
+func (m *indexMeta) TakeBuildAttempt(buildID UniqueID, nodeID UniqueID, version int64) {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok || (segIdx.NodeID == nodeID && segIdx.IndexVersion == version) {
+		return
+	}
+	cloned := model.CloneSegmentIndex(segIdx)
+	cloned.NodeID = nodeID
+	cloned.IndexVersion = version
+	m.updateSegmentIndex(cloned)
+}
+
+// segmentIndexProgress returns the build progress in percentage of the segment index,
+// the progress reported by the worker counts only while the build is in progress.
+func segmentIndexProgress(segIdx *model.SegmentIndex) int32 {
+	switch segIdx.IndexState {
+	case commonpb.IndexState_Finished:
+		return 100
+	case commonpb.IndexState_InProgress:
+		return segIdx.Progress
+	default:
+		return 0
+	}
+}
+
 func (m *indexMeta) DeleteTask(buildID int64) error {
 	m.Lock()
 	defer m.Unlock()
@@ -741,7 +977,7 @@ func (m *indexMeta) DeleteTask(buildID int64) error {
 	}
 
 	log.Info("delete index task success", zap.Int64("buildID", buildID))
-	m.updateIndexTasksMetrics()
+	m.updateIndexTasksMetrics(segIdx.CollectionID)
 	return nil
 }
 
@@ -754,10 +990,14 @@ func (m *indexMeta) BuildIndex(buildID, nodeID UniqueID) error {
 	if !ok {
 		return fmt.Errorf("there is no index with buildID: %d", buildID)
 	}
+	if segIdx.Paused {
+		return fmt.Errorf("index build %d is paused", buildID)
+	}
 
 	updateFunc := func(segIdx *model.SegmentIndex) error {
 		segIdx.NodeID = nodeID
 		segIdx.IndexState = commonpb.IndexState_InProgress
+		segIdx.BuildStartTime = time.Now().UnixMilli()
 
 		err := m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
 		if err != nil {
@@ -772,10 +1012,236 @@ func (m *indexMeta) BuildIndex(buildID, nodeID UniqueID) error {
 	log.Info("meta update: segment index in progress success", zap.Int64("buildID", segIdx.BuildID),
 		zap.Int64("segmentID", segIdx.SegmentID))
 
-	m.updateIndexTasksMetrics()
+	m.updateIndexTasksMetrics(segIdx.CollectionID)
 	return nil
 }
 
+// selectUnfinishedBuilds returns the unfinished builds of the collection with the paused flag,
+// or the specified builds of the collection if buildIDs is not empty.
+func (m *indexMeta) selectUnfinishedBuilds(collectionID UniqueID, buildIDs []UniqueID, paused bool) []*model.SegmentIndex {
+	isTarget := func(segIdx *model.SegmentIndex) bool {
+		return !segIdx.IsDeleted && segIdx.Paused == paused &&
+			(collectionID == 0 || segIdx.CollectionID == collectionID) &&
+			segIdx.IndexState != commonpb.IndexState_Finished && segIdx.IndexState != commonpb.IndexState_Failed
+	}
+
+	segIdxes := make([]*model.SegmentIndex, 0)
+	if len(buildIDs) > 0 {
+		for _, buildID := range buildIDs {
+			if segIdx, ok := m.buildID2SegmentIndex[buildID]; ok && isTarget(segIdx) {
+				segIdxes = append(segIdxes, segIdx)
+			}
+		}
+		return segIdxes
+	}
+	for _, segIdx := range m.buildID2SegmentIndex {
+		if isTarget(segIdx) {
+			segIdxes = append(segIdxes, segIdx)
+		}
+	}
+	return segIdxes
+}
+
+// PauseTasks marks the unfinished builds as paused and resets them to Unissued,
+// the nodeID is kept for the scheduler to drop the job on the worker.
+// It returns the buildIDs paused.
+func (m *indexMeta) PauseTasks(collectionID UniqueID, buildIDs []UniqueID) ([]UniqueID, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.setTasksPaused(collectionID, buildIDs, true)
+}
+
+// ResumeTasks clears the paused mark of the builds, the scheduler builds them from Init again.
+// It returns the buildIDs resumed.
+func (m *indexMeta) ResumeTasks(collectionID UniqueID, buildIDs []UniqueID) ([]UniqueID, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.setTasksPaused(collectionID, buildIDs, false)
+}
+
+func (m *indexMeta) setTasksPaused(collectionID UniqueID, buildIDs []UniqueID, paused bool) ([]UniqueID, error) {
+	segIdxes := m.selectUnfinishedBuilds(collectionID, buildIDs, !paused)
+	if len(segIdxes) == 0 {
+		return nil, nil
+	}
+
+	updated := make([]*model.SegmentIndex, 0, len(segIdxes))
+	for _, segIdx := range segIdxes {
+		cloned := model.CloneSegmentIndex(segIdx)
+		cloned.Paused = paused
+		cloned.IndexState = commonpb.IndexState_Unissued
+		cloned.FailReason = ""
+		if !paused {
+			cloned.NodeID = 0
+		}
+		updated = append(updated, cloned)
+	}
+	if err := m.alterSegmentIndexes(updated); err != nil {
+		log.Warn("failed to update paused state of index builds", zap.Int64("collectionID", collectionID),
+			zap.Bool("paused", paused), zap.Error(err))
+		return nil, err
+	}
+
+	ids := lo.Map(updated, func(segIdx *model.SegmentIndex, _ int) UniqueID {
+		return segIdx.BuildID
+	})
+	log.Info("update paused state of index builds success", zap.Int64("collectionID", collectionID),
+		zap.Bool("paused", paused), zap.Int64s("buildIDs", ids))
+	m.updateIndexTasksMetrics(collectionID)
+	return ids, nil
+}
+
+// maxBuildHistory is the max number of the recent attempts kept in the build history of a segment index.
+const maxBuildHistory = 20
+
+// appendBuildRecord ends the current attempt of the build and appends it to the build history.
+func appendBuildRecord(segIdx *model.SegmentIndex, state commonpb.IndexState, failReason string) {
+	segIdx.BuildHistory = append(segIdx.BuildHistory, &indexpb.IndexBuildRecord{
+		BuildID:        segIdx.BuildID,
+		IndexVersion:   segIdx.IndexVersion,
+		State:          state,
+		FailReason:     failReason,
+		SerializedSize: segIdx.IndexSize,
+		NodeID:         segIdx.NodeID,
+		StartTime:      segIdx.BuildStartTime,
+		EndTime:        time.Now().UnixMilli(),
+		Timing:         segIdx.BuildTiming,
+	})
+	if len(segIdx.BuildHistory) > maxBuildHistory {
+		segIdx.BuildHistory = segIdx.BuildHistory[len(segIdx.BuildHistory)-maxBuildHistory:]
+	}
+	segIdx.BuildStartTime = 0
+	segIdx.BuildTiming = nil
+}
+
+// GetBuildHistories returns the build histories of the segment indexes of the collection in the order of segmentID,
+// at most limit segments after the cursor segmentID are returned, all the indexes are returned if indexIDs is empty.
+// The unfinished attempt is returned as the last record without end time.
+// It returns the cursor of the next page, which is 0 if there are no more segments.
+func (m *indexMeta) GetBuildHistories(collectionID UniqueID, indexIDs []UniqueID, cursor UniqueID, limit int) ([]*indexpb.SegmentIndexBuildHistory, UniqueID) {
+	m.RLock()
+	defer m.RUnlock()
+
+	indexSet := typeutil.NewUniqueSet(indexIDs...)
+	isTarget := func(segIdx *model.SegmentIndex) bool {
+		return segIdx.CollectionID == collectionID && !segIdx.IsDeleted &&
+			(indexSet.Len() == 0 || indexSet.Contain(segIdx.IndexID))
+	}
+	segmentIDs := make([]UniqueID, 0)
+	for segmentID, segIdxes := range m.segmentIndexes {
+		if segmentID <= cursor {
+			continue
+		}
+		for _, segIdx := range segIdxes {
+			if isTarget(segIdx) {
+				segmentIDs = append(segmentIDs, segmentID)
+				break
+			}
+		}
+	}
+	sort.Slice(segmentIDs, func(i, j int) bool {
+		return segmentIDs[i] < segmentIDs[j]
+	})
+
+	var nextCursor UniqueID
+	if limit > 0 && len(segmentIDs) > limit {
+		segmentIDs = segmentIDs[:limit]
+		nextCursor = segmentIDs[limit-1]
+	}
+	histories := make([]*indexpb.SegmentIndexBuildHistory, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		for _, segIdx := range m.segmentIndexes[segmentID] {
+			if !isTarget(segIdx) {
+				continue
+			}
+			cloned := model.CloneSegmentIndex(segIdx)
+			records := cloned.BuildHistory
+			if segIdx.IndexState != commonpb.IndexState_Finished && segIdx.IndexState != commonpb.IndexState_Failed {
+				records = append(records, &indexpb.IndexBuildRecord{
+					BuildID:      segIdx.BuildID,
+					IndexVersion: segIdx.IndexVersion,
+					State:        segIdx.IndexState,
+					FailReason:   segIdx.FailReason,
+					NodeID:       segIdx.NodeID,
+					StartTime:    segIdx.BuildStartTime,
+					Timing:       cloned.BuildTiming,
+				})
+			}
+			var indexName string
+			if index, ok := m.indexes[collectionID][segIdx.IndexID]; ok {
+				indexName = index.IndexName
+			}
+			histories = append(histories, &indexpb.SegmentIndexBuildHistory{
+				SegmentID: segmentID,
+				IndexID:   segIdx.IndexID,
+				IndexName: indexName,
+				Records:   records,
+			})
+		}
+	}
+	return histories, nextCursor
+}
+
+// maxRetryFailReasons is the max number of the recent fail reasons kept for a build.
+const maxRetryFailReasons = 10
+
+// RecordRetry increases the retry times of the build and records the fail reason of the attempt,
+// it returns the retry times and the recent fail reasons after updated.
+func (m *indexMeta) RecordRetry(buildID UniqueID, failReason string) (int64, []string, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok {
+		return 0, nil, fmt.Errorf("there is no index with buildID: %d", buildID)
+	}
+
+	updateFunc := func(segIdx *model.SegmentIndex) error {
+		appendBuildRecord(segIdx, commonpb.IndexState_Retry, failReason)
+		segIdx.RetryTimes++
+		if failReason != "" {
+			segIdx.RetryFailReasons = append(segIdx.RetryFailReasons, failReason)
+			if len(segIdx.RetryFailReasons) > maxRetryFailReasons {
+				segIdx.RetryFailReasons = segIdx.RetryFailReasons[len(segIdx.RetryFailReasons)-maxRetryFailReasons:]
+			}
+		}
+		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
+	}
+	if err := m.updateSegIndexMeta(segIdx, updateFunc); err != nil {
+		return 0, nil, err
+	}
+
+	segIdx = m.buildID2SegmentIndex[buildID]
+	log.Info("record retry of index build", zap.Int64("buildID", buildID),
+		zap.Int64("retryTimes", segIdx.RetryTimes), zap.String("failReason", failReason))
+	return segIdx.RetryTimes, common.CloneStringList(segIdx.RetryFailReasons), nil
+}
+
+// IsTaskPaused returns whether the build is paused.
+func (m *indexMeta) IsTaskPaused(buildID UniqueID) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	return ok && segIdx.Paused
+}
+
+// GetRebuildingBuilds returns the builds being replaced by the unfinished rebuilds.
+func (m *indexMeta) GetRebuildingBuilds() typeutil.UniqueSet {
+	m.RLock()
+	defer m.RUnlock()
+
+	builds := typeutil.NewUniqueSet()
+	for _, segIdx := range m.buildID2SegmentIndex {
+		if segIdx.ReplacedBuildID != 0 && segIdx.IndexState != commonpb.IndexState_Finished {
+			builds.Insert(segIdx.ReplacedBuildID)
+		}
+	}
+	return builds
+}
+
 func (m *indexMeta) GetAllSegIndexes() map[int64]*model.SegmentIndex {
 	m.RLock()
 	defer m.RUnlock()
@@ -796,7 +1262,8 @@ func (m *indexMeta) RemoveSegmentIndex(collID, partID, segID, indexID, buildID U
 		return err
 	}
 
-	if _, ok := m.segmentIndexes[segID]; ok {
+	// the segment index serving the segment may be the rebuild of the removed one
+	if segIdx, ok := m.segmentIndexes[segID][indexID]; ok && segIdx.BuildID == buildID {
 		delete(m.segmentIndexes[segID], indexID)
 	}
 
@@ -804,8 +1271,18 @@ func (m *indexMeta) RemoveSegmentIndex(collID, partID, segID, indexID, buildID U
 		delete(m.segmentIndexes, segID)
 	}
 
+	if segIdx, ok := m.buildID2SegmentIndex[buildID]; ok && segIdx.FilesSource != nil {
+		sourceID := segIdx.FilesSource.GetBuildID()
+		m.reusedFiles[sourceID].Remove(buildID)
+		if m.reusedFiles[sourceID].Len() == 0 {
+			delete(m.reusedFiles, sourceID)
+		}
+	}
+	if segIdx, ok := m.buildID2SegmentIndex[buildID]; ok {
+		m.countIndexTask(segIdx, -1)
+	}
 	delete(m.buildID2SegmentIndex, buildID)
-	m.updateIndexTasksMetrics()
+	m.updateIndexTasksMetrics(collID)
 	return nil
 }
 
@@ -851,6 +1328,10 @@ func (m *indexMeta) CheckCleanSegmentIndex(buildID UniqueID) (bool, *model.Segme
 	m.RLock()
 	defer m.RUnlock()
 
+	// the index files are still reused by other segment indexes
+	if m.reusedFiles[buildID].Len() > 0 {
+		return false, nil
+	}
 	if segIndex, ok := m.buildID2SegmentIndex[buildID]; ok {
 		if segIndex.IndexState == commonpb.IndexState_Finished {
 			return true, model.CloneSegmentIndex(segIndex)
@@ -860,6 +1341,78 @@ func (m *indexMeta) CheckCleanSegmentIndex(buildID UniqueID) (bool, *model.Segme
 	return true, nil
 }
 
+// IsIndexFilesReused checks whether the index files of the build are reused by other segment indexes.
+func (m *indexMeta) IsIndexFilesReused(buildID UniqueID) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.reusedFiles[buildID].Len() > 0
+}
+
+// GetReusableSegmentIndexes returns the finished segment indexes with the same num rows built by the same params
+// as the index of the build, their index files can be reused by the build if their binlogs are identical.
+func (m *indexMeta) GetReusableSegmentIndexes(buildID UniqueID) []*model.SegmentIndex {
+	m.RLock()
+	defer m.RUnlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	// the rebuild upgrades the index, which can't be done by reusing
+	if !ok || segIdx.ReplacedBuildID != 0 {
+		return nil
+	}
+	index, ok := m.indexes[segIdx.CollectionID][segIdx.IndexID]
+	if !ok || index.IsDeleted {
+		return nil
+	}
+	sameIndexes := typeutil.NewUniqueSet()
+	for _, fieldIndexes := range m.indexes {
+		for _, fieldIndex := range fieldIndexes {
+			if !fieldIndex.IsDeleted && isSameBuildParams(index, fieldIndex) {
+				sameIndexes.Insert(fieldIndex.IndexID)
+			}
+		}
+	}
+
+	candidates := make([]*model.SegmentIndex, 0)
+	for _, candidate := range m.buildID2SegmentIndex {
+		if candidate.BuildID == buildID || candidate.IsDeleted || candidate.ReplacedTime != 0 || candidate.IndexState != commonpb.IndexState_Finished ||
+			candidate.NumRows != segIdx.NumRows || len(candidate.IndexFileKeys) == 0 || !sameIndexes.Contain(candidate.IndexID) {
+			continue
+		}
+		candidates = append(candidates, model.CloneSegmentIndex(candidate))
+	}
+	return candidates
+}
+
+// isSameBuildParams checks whether the index files built by the two indexes on the same data are identical,
+// the mmap switch is ignored as it only takes effect on loading.
+func isSameBuildParams(index1, index2 *model.Index) bool {
+	buildParams := func(index *model.Index) (map[string]string, map[string]string) {
+		indexParams := funcutil.KeyValuePair2Map(index.IndexParams)
+		delete(indexParams, common.MmapEnabledKey)
+		return funcutil.KeyValuePair2Map(index.TypeParams), indexParams
+	}
+	typeParams1, indexParams1 := buildParams(index1)
+	typeParams2, indexParams2 := buildParams(index2)
+	return maps.Equal(typeParams1, typeParams2) && maps.Equal(indexParams1, indexParams2)
+}
+
+// SetContentHash records the hash of the binlogs of the indexed field of the build.
+func (m *indexMeta) SetContentHash(buildID UniqueID, contentHash string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok {
+		return fmt.Errorf("there is no index with buildID: %d", buildID)
+	}
+	updateFunc := func(segIdx *model.SegmentIndex) error {
+		segIdx.ContentHash = contentHash
+		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
+	}
+	return m.updateSegIndexMeta(segIdx, updateFunc)
+}
+
 func (m *indexMeta) GetMetasByNodeID(nodeID UniqueID) []*model.SegmentIndex {
 	m.RLock()
 	defer m.RUnlock()
@@ -900,6 +1453,7 @@ func (m *indexMeta) getSegmentsIndexStates(collectionID UniqueID, segmentIDs []U
 					State:      segIdx.IndexState,
 					FailReason: segIdx.FailReason,
 					IndexName:  index.IndexName,
+					Progress:   segmentIndexProgress(segIdx),
 				}
 			}
 		}
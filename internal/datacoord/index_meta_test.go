@@ -19,7 +19,6 @@ package datacoord
 
 import (
 	"context"
-	"sync"
 	"testing"
 
 	"github.com/cockroachdb/errors"
@@ -304,7 +303,6 @@ func TestMeta_HasSameReq(t *testing.T) {
 
 func newSegmentIndexMeta(catalog metastore.DataCoordCatalog) *indexMeta {
 	return &indexMeta{
-		RWMutex:              sync.RWMutex{},
 		ctx:                  context.Background(),
 		catalog:              catalog,
 		indexes:              make(map[UniqueID]map[UniqueID]*model.Index),
@@ -1116,18 +1114,26 @@ func TestMeta_UpdateVersion(t *testing.T) {
 	).Return(errors.New("fail"))
 
 	t.Run("success", func(t *testing.T) {
-		err := m.UpdateVersion(buildID)
+		segIdx, ok := m.GetIndexJob(buildID)
+		assert.True(t, ok)
+		err := m.UpdateVersion(buildID, nodeID)
 		assert.NoError(t, err)
+
+		// the version is bumped and the assignment is checkpointed
+		updated, ok := m.GetIndexJob(buildID)
+		assert.True(t, ok)
+		assert.Equal(t, segIdx.IndexVersion+1, updated.IndexVersion)
+		assert.Equal(t, nodeID, updated.NodeID)
 	})
 
 	t.Run("fail", func(t *testing.T) {
 		m.catalog = ec
-		err := m.UpdateVersion(buildID)
+		err := m.UpdateVersion(buildID, nodeID)
 		assert.Error(t, err)
 	})
 
 	t.Run("not exist", func(t *testing.T) {
-		err := m.UpdateVersion(buildID + 1)
+		err := m.UpdateVersion(buildID+1, nodeID)
 		assert.Error(t, err)
 	})
 }
@@ -1175,6 +1181,178 @@ func TestMeta_FinishTask(t *testing.T) {
 	})
 }
 
+func TestMeta_IndexTaskStates(t *testing.T) {
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().DropSegmentIndex(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	m := newSegmentIndexMeta(catalog)
+	for i := int64(0); i < 3; i++ {
+		m.updateSegmentIndex(&model.SegmentIndex{
+			SegmentID:    segID + i,
+			CollectionID: collID,
+			PartitionID:  partID,
+			IndexID:      indexID,
+			BuildID:      buildID + i,
+			IndexState:   commonpb.IndexState_Unissued,
+		})
+	}
+	assert.Equal(t, 3, m.indexTaskStates[collID][commonpb.IndexState_Unissued])
+
+	assert.NoError(t, m.BuildIndex(buildID, nodeID))
+	assert.NoError(t, m.FinishTask(&indexpb.IndexTaskInfo{
+		BuildID: buildID + 1,
+		State:   commonpb.IndexState_Finished,
+	}))
+	assert.NoError(t, m.RemoveSegmentIndex(collID, partID, segID+2, indexID, buildID+2))
+	assert.Equal(t, 0, m.indexTaskStates[collID][commonpb.IndexState_Unissued])
+	assert.Equal(t, 1, m.indexTaskStates[collID][commonpb.IndexState_InProgress])
+	assert.Equal(t, 1, m.indexTaskStates[collID][commonpb.IndexState_Finished])
+}
+
+func TestMeta_RebuildSegmentIndex(t *testing.T) {
+	m := updateSegmentIndexMeta(t)
+	assert.NoError(t, m.FinishTask(&indexpb.IndexTaskInfo{
+		BuildID:             buildID,
+		State:               commonpb.IndexState_Finished,
+		IndexFileKeys:       []string{"file1"},
+		CurrentIndexVersion: 1,
+	}))
+
+	rebuild := model.CloneSegmentIndex(m.buildID2SegmentIndex[buildID])
+	rebuild.BuildID = buildID + 1
+	rebuild.ReplacedBuildID = buildID
+	rebuild.IndexState = commonpb.IndexState_InProgress
+	rebuild.IndexFileKeys = nil
+	m.updateSegmentIndex(rebuild)
+
+	// the replaced segment index keeps serving until the rebuild finishes
+	assert.EqualValues(t, buildID, m.GetSegmentIndexes(collID, segID)[indexID].BuildID)
+	assert.True(t, m.GetRebuildingBuilds().Contain(buildID))
+	assert.Nil(t, m.GetReusableSegmentIndexes(rebuild.BuildID))
+
+	assert.NoError(t, m.FinishTask(&indexpb.IndexTaskInfo{
+		BuildID:             buildID + 1,
+		State:               commonpb.IndexState_Finished,
+		IndexFileKeys:       []string{"file2"},
+		CurrentIndexVersion: 2,
+	}))
+	segIdx := m.GetSegmentIndexes(collID, segID)[indexID]
+	assert.EqualValues(t, buildID+1, segIdx.BuildID)
+	assert.EqualValues(t, 2, segIdx.CurrentIndexVersion)
+	assert.NotZero(t, m.buildID2SegmentIndex[buildID].ReplacedTime)
+	assert.Zero(t, m.GetRebuildingBuilds().Len())
+
+	// removing the replaced segment index keeps the rebuild serving
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().DropSegmentIndex(mock.Anything, collID, partID, segID, buildID).Return(nil)
+	m.catalog = catalog
+	assert.NoError(t, m.RemoveSegmentIndex(collID, partID, segID, indexID, buildID))
+	assert.EqualValues(t, buildID+1, m.GetSegmentIndexes(collID, segID)[indexID].BuildID)
+	_, ok := m.GetIndexJob(buildID)
+	assert.False(t, ok)
+}
+
+func TestMeta_LinkIndexFiles(t *testing.T) {
+	m := updateSegmentIndexMeta(t)
+	source := &model.SegmentIndex{
+		SegmentID:           segID + 1,
+		CollectionID:        collID,
+		PartitionID:         partID,
+		NumRows:             1025,
+		IndexID:             indexID,
+		BuildID:             buildID + 1,
+		IndexVersion:        2,
+		IndexState:          commonpb.IndexState_Finished,
+		IndexFileKeys:       []string{"file1", "file2"},
+		IndexSize:           1024,
+		CurrentIndexVersion: 3,
+		ContentHash:         "hash",
+	}
+	m.updateSegmentIndex(source)
+
+	t.Run("reusable segment indexes", func(t *testing.T) {
+		candidates := m.GetReusableSegmentIndexes(buildID)
+		assert.Equal(t, 1, len(candidates))
+		assert.Equal(t, buildID+1, candidates[0].BuildID)
+
+		// the built index is not reusable by itself
+		assert.Empty(t, m.GetReusableSegmentIndexes(buildID+1))
+		assert.Empty(t, m.GetReusableSegmentIndexes(buildID+2))
+	})
+
+	t.Run("set content hash", func(t *testing.T) {
+		err := m.SetContentHash(buildID, "hash")
+		assert.NoError(t, err)
+		segIdx, ok := m.GetIndexJob(buildID)
+		assert.True(t, ok)
+		assert.Equal(t, "hash", segIdx.ContentHash)
+
+		err = m.SetContentHash(buildID+2, "hash")
+		assert.Error(t, err)
+	})
+
+	t.Run("link", func(t *testing.T) {
+		err := m.LinkIndexFiles(buildID, source, "hash")
+		assert.NoError(t, err)
+
+		segIdx, ok := m.GetIndexJob(buildID)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Finished, segIdx.IndexState)
+		assert.Equal(t, []string{"file1", "file2"}, segIdx.IndexFileKeys)
+		assert.Equal(t, uint64(1024), segIdx.IndexSize)
+		assert.Equal(t, int32(3), segIdx.CurrentIndexVersion)
+		assert.Equal(t, buildID+1, segIdx.FilesSource.GetBuildID())
+
+		reusedBuildID, indexVersion, partitionID, segmentID := indexFilesLocation(segIdx)
+		assert.Equal(t, buildID+1, reusedBuildID)
+		assert.Equal(t, int64(2), indexVersion)
+		assert.Equal(t, partID, partitionID)
+		assert.Equal(t, segID+1, segmentID)
+
+		// the files of the source are kept while reused
+		assert.True(t, m.IsIndexFilesReused(buildID+1))
+		canRecycle, _ := m.CheckCleanSegmentIndex(buildID + 1)
+		assert.False(t, canRecycle)
+
+		err = m.LinkIndexFiles(buildID+2, source, "hash")
+		assert.NoError(t, err)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().DropSegmentIndex(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		m.catalog = catalog
+
+		err := m.RemoveSegmentIndex(collID, partID, segID, indexID, buildID)
+		assert.NoError(t, err)
+		assert.False(t, m.IsIndexFilesReused(buildID+1))
+		canRecycle, _ := m.CheckCleanSegmentIndex(buildID + 1)
+		assert.True(t, canRecycle)
+	})
+}
+
+func TestMeta_UpdateBuildProgress(t *testing.T) {
+	m := updateSegmentIndexMeta(t)
+
+	m.UpdateBuildProgress(buildID, 30)
+	segIdx, ok := m.GetIndexJob(buildID)
+	assert.True(t, ok)
+	assert.EqualValues(t, 30, segIdx.Progress)
+
+	// the progress counts only while the build is in progress
+	segIdx.IndexState = commonpb.IndexState_Unissued
+	assert.EqualValues(t, 0, segmentIndexProgress(segIdx))
+	segIdx.IndexState = commonpb.IndexState_InProgress
+	assert.EqualValues(t, 30, segmentIndexProgress(segIdx))
+	segIdx.IndexState = commonpb.IndexState_Finished
+	assert.EqualValues(t, 100, segmentIndexProgress(segIdx))
+
+	// not exist
+	m.UpdateBuildProgress(buildID+1, 30)
+	_, ok = m.GetIndexJob(buildID + 1)
+	assert.False(t, ok)
+}
+
 func TestMeta_BuildIndex(t *testing.T) {
 	m := updateSegmentIndexMeta(t)
 	ec := catalogmocks.NewDataCoordCatalog(t)
@@ -1200,7 +1378,153 @@ func TestMeta_BuildIndex(t *testing.T) {
 	})
 }
 
+func TestMeta_PauseResumeTasks(t *testing.T) {
+	m := updateSegmentIndexMeta(t)
+	assert.NoError(t, m.BuildIndex(buildID, nodeID))
+
+	t.Run("pause", func(t *testing.T) {
+		// not exist or not matched collection
+		buildIDs, err := m.PauseTasks(collID, []UniqueID{buildID + 1})
+		assert.NoError(t, err)
+		assert.Empty(t, buildIDs)
+		buildIDs, err = m.PauseTasks(collID+1, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, buildIDs)
+
+		buildIDs, err = m.PauseTasks(collID, nil)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []UniqueID{buildID}, buildIDs)
+		assert.True(t, m.IsTaskPaused(buildID))
+		segIdx, ok := m.GetIndexJob(buildID)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Unissued, segIdx.IndexState)
+		assert.Equal(t, nodeID, segIdx.NodeID)
+
+		// paused already
+		buildIDs, err = m.PauseTasks(0, []UniqueID{buildID})
+		assert.NoError(t, err)
+		assert.Empty(t, buildIDs)
+
+		// paused build can't be assigned
+		assert.Error(t, m.BuildIndex(buildID, nodeID))
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		buildIDs, err := m.ResumeTasks(0, []UniqueID{buildID})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []UniqueID{buildID}, buildIDs)
+		assert.False(t, m.IsTaskPaused(buildID))
+		segIdx, ok := m.GetIndexJob(buildID)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Unissued, segIdx.IndexState)
+		assert.EqualValues(t, 0, segIdx.NodeID)
+
+		buildIDs, err = m.ResumeTasks(collID, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, buildIDs)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		catalog := m.catalog
+		defer func() { m.catalog = catalog }()
+		ec := catalogmocks.NewDataCoordCatalog(t)
+		ec.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(errors.New("fail"))
+		m.catalog = ec
+		_, err := m.PauseTasks(collID, nil)
+		assert.Error(t, err)
+		assert.False(t, m.IsTaskPaused(buildID))
+	})
+
+	t.Run("finished", func(t *testing.T) {
+		_, err := m.PauseTasks(collID, nil)
+		assert.NoError(t, err)
+		err = m.FinishTask(&indexpb.IndexTaskInfo{
+			BuildID: buildID,
+			State:   commonpb.IndexState_Finished,
+		})
+		assert.NoError(t, err)
+		assert.False(t, m.IsTaskPaused(buildID))
+
+		buildIDs, err := m.PauseTasks(collID, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, buildIDs)
+	})
+
+}
+
 // see also: https://github.com/milvus-io/milvus/issues/21660
+func TestMeta_BuildHistory(t *testing.T) {
+	m := updateSegmentIndexMeta(t)
+
+	// the first attempt is retried
+	assert.NoError(t, m.UpdateVersion(buildID, nodeID))
+	assert.NoError(t, m.BuildIndex(buildID, nodeID))
+	m.UpdateBuildTiming(buildID, &indexpb.TaskTiming{EnqueueTime: 1, DispatchTime: 2, NodeStartTime: 3, CompleteTime: 4})
+	retryTimes, _, err := m.RecordRetry(buildID, "mock error")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, retryTimes)
+
+	// the second attempt is in progress
+	assert.NoError(t, m.UpdateVersion(buildID, nodeID+1))
+	assert.NoError(t, m.BuildIndex(buildID, nodeID+1))
+	histories, cursor := m.GetBuildHistories(collID, nil, 0, 10)
+	assert.Zero(t, cursor)
+	assert.Len(t, histories, 1)
+	assert.Equal(t, segID, histories[0].GetSegmentID())
+	assert.Equal(t, indexName, histories[0].GetIndexName())
+	records := histories[0].GetRecords()
+	assert.Len(t, records, 2)
+	assert.Equal(t, commonpb.IndexState_Retry, records[0].GetState())
+	assert.Equal(t, "mock error", records[0].GetFailReason())
+	assert.EqualValues(t, 1, records[0].GetIndexVersion())
+	assert.Equal(t, nodeID, records[0].GetNodeID())
+	assert.NotZero(t, records[0].GetStartTime())
+	assert.GreaterOrEqual(t, records[0].GetEndTime(), records[0].GetStartTime())
+	assert.EqualValues(t, 1, records[0].GetTiming().GetEnqueueTime())
+	assert.EqualValues(t, 4, records[0].GetTiming().GetCompleteTime())
+	assert.Equal(t, commonpb.IndexState_InProgress, records[1].GetState())
+	assert.EqualValues(t, 2, records[1].GetIndexVersion())
+	assert.Equal(t, nodeID+1, records[1].GetNodeID())
+	assert.Zero(t, records[1].GetEndTime())
+	assert.Nil(t, records[1].GetTiming())
+
+	// finished
+	assert.NoError(t, m.FinishTask(&indexpb.IndexTaskInfo{
+		BuildID:        buildID,
+		State:          commonpb.IndexState_Finished,
+		SerializedSize: 1024,
+	}))
+	histories, _ = m.GetBuildHistories(collID, []UniqueID{indexID}, 0, 10)
+	assert.Len(t, histories, 1)
+	records = histories[0].GetRecords()
+	assert.Len(t, records, 2)
+	assert.Equal(t, commonpb.IndexState_Finished, records[1].GetState())
+	assert.EqualValues(t, 1024, records[1].GetSerializedSize())
+	assert.NotZero(t, records[1].GetEndTime())
+
+	t.Run("pagination", func(t *testing.T) {
+		segIdx := model.CloneSegmentIndex(m.buildID2SegmentIndex[buildID])
+		segIdx.SegmentID = segID + 1
+		segIdx.BuildID = buildID + 1
+		m.updateSegmentIndex(segIdx)
+
+		histories, cursor := m.GetBuildHistories(collID, nil, 0, 1)
+		assert.Len(t, histories, 1)
+		assert.Equal(t, segID, histories[0].GetSegmentID())
+		assert.Equal(t, segID, cursor)
+
+		histories, cursor = m.GetBuildHistories(collID, nil, cursor, 1)
+		assert.Len(t, histories, 1)
+		assert.Equal(t, segID+1, histories[0].GetSegmentID())
+		assert.Zero(t, cursor)
+
+		histories, _ = m.GetBuildHistories(collID, []UniqueID{indexID + 1}, 0, 1)
+		assert.Empty(t, histories)
+		histories, _ = m.GetBuildHistories(collID+1, nil, 0, 1)
+		assert.Empty(t, histories)
+	})
+}
+
 func TestUpdateSegmentIndexNotExists(t *testing.T) {
 	m := newSegmentIndexMeta(nil)
 	assert.NotPanics(t, func() {
@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// hashFieldBinlogs computes the content hash of the insert binlogs of the field of the segment.
+// Only the payloads are hashed, the binlogs of the segments cloned from each other hash equal
+// though their ids and timestamps in the headers differ.
+func hashFieldBinlogs(ctx context.Context, cm storage.ChunkManager, segment *SegmentInfo, fieldID int64) (string, error) {
+	segment = segment.Clone()
+	if err := binlog.DecompressInsertBinLogs(segment.SegmentInfo); err != nil {
+		return "", err
+	}
+
+	hasher := sha256.New()
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if fieldBinlog.GetFieldID() != fieldID {
+			continue
+		}
+		for _, insertlog := range fieldBinlog.GetBinlogs() {
+			data, err := cm.Read(ctx, insertlog.GetLogPath())
+			if err != nil {
+				return "", err
+			}
+			if err := storage.HashInsertBinlogPayloads(hasher, data); err != nil {
+				return "", err
+			}
+		}
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// fieldBinlogEntries returns the rows of each insert binlog of the field of the segment in order.
+func fieldBinlogEntries(segment *SegmentInfo, fieldID int64) []int64 {
+	entries := make([]int64, 0)
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if fieldBinlog.GetFieldID() != fieldID {
+			continue
+		}
+		for _, insertlog := range fieldBinlog.GetBinlogs() {
+			entries = append(entries, insertlog.GetEntriesNum())
+		}
+	}
+	return entries
+}
+
+// indexFilesLocation returns the location of the index files of the segment index,
+// which is the location of the source segment index if the files are reused.
+func indexFilesLocation(segIdx *model.SegmentIndex) (buildID, indexVersion, partitionID, segmentID int64) {
+	if source := segIdx.FilesSource; source != nil {
+		return source.GetBuildID(), source.GetIndexVersion(), source.GetPartitionID(), source.GetSegmentID()
+	}
+	return segIdx.BuildID, segIdx.IndexVersion, segIdx.PartitionID, segIdx.SegmentID
+}
+
+// reuseIndexFiles looks for the finished segment index built by the same params on the binlogs with the same content,
+// the task is finished by linking its index files instead of building again if found. The content is compared by the
+// binlogs layout first, only the segments laid out the same are hashed, and the hashes are recorded in meta.
+func (it *indexBuildTask) reuseIndexFiles(ctx context.Context, dependency *taskScheduler, segIndex *model.SegmentIndex, segment *SegmentInfo) bool {
+	// the binlogs of the segment are immutable, it's checked once for the task
+	if it.reuseChecked {
+		return false
+	}
+	it.reuseChecked = true
+
+	candidates := dependency.meta.indexMeta.GetReusableSegmentIndexes(it.taskID)
+	if len(candidates) == 0 {
+		return false
+	}
+	log := log.Ctx(ctx).With(zap.Int64("taskID", it.taskID), zap.Int64("segmentID", segIndex.SegmentID))
+	fieldID := dependency.meta.indexMeta.GetFieldIDByIndexID(segIndex.CollectionID, segIndex.IndexID)
+	entries := fieldBinlogEntries(segment, fieldID)
+
+	contentHash := segIndex.ContentHash
+	for _, candidate := range candidates {
+		candidateSegment := dependency.meta.GetSegment(candidate.SegmentID)
+		if !isSegmentHealthy(candidateSegment) {
+			continue
+		}
+		candidateFieldID := dependency.meta.indexMeta.GetFieldIDByIndexID(candidate.CollectionID, candidate.IndexID)
+		if !slices.Equal(entries, fieldBinlogEntries(candidateSegment, candidateFieldID)) {
+			continue
+		}
+
+		if contentHash == "" {
+			hash, err := hashFieldBinlogs(ctx, dependency.chunkManager, segment, fieldID)
+			if err != nil {
+				log.Warn("failed to hash the binlogs of the segment, build the index as usual", zap.Error(err))
+				return false
+			}
+			contentHash = hash
+			if err := dependency.meta.indexMeta.SetContentHash(it.taskID, contentHash); err != nil {
+				log.Warn("failed to record the content hash of the segment", zap.Error(err))
+			}
+		}
+		candidateHash := candidate.ContentHash
+		if candidateHash == "" {
+			hash, err := hashFieldBinlogs(ctx, dependency.chunkManager, candidateSegment, candidateFieldID)
+			if err != nil {
+				log.Warn("failed to hash the binlogs of the candidate segment", zap.Int64("candidateSegmentID", candidate.SegmentID), zap.Error(err))
+				continue
+			}
+			candidateHash = hash
+			if err := dependency.meta.indexMeta.SetContentHash(candidate.BuildID, candidateHash); err != nil {
+				log.Warn("failed to record the content hash of the candidate segment", zap.Error(err))
+			}
+		}
+		if candidateHash != contentHash {
+			continue
+		}
+
+		log.Info("segment is identical with the indexed one, reuse its index files",
+			zap.Int64("sourceBuildID", candidate.BuildID), zap.Int64("sourceSegmentID", candidate.SegmentID))
+		it.filesSource = candidate
+		it.contentHash = contentHash
+		it.SetState(indexpb.JobState_JobStateFinished, "")
+		return true
+	}
+	return false
+}
@@ -27,11 +27,14 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/indexparams"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -50,6 +53,8 @@ func (s *Server) serverID() int64 {
 func (s *Server) startIndexService(ctx context.Context) {
 	s.serverLoopWg.Add(1)
 	go s.createIndexForSegmentLoop(ctx)
+	s.serverLoopWg.Add(1)
+	go s.upgradeIndexLoop(ctx)
 }
 
 func (s *Server) createIndexForSegment(segment *SegmentInfo, indexID UniqueID) error {
@@ -81,7 +86,51 @@ func (s *Server) createIndexForSegment(segment *SegmentInfo, indexID UniqueID) e
 	return nil
 }
 
+// rebuildSegmentIndex builds the index of the segment again to replace the outdated one, the outdated index
+// keeps serving until the rebuild finishes, and is swapped with the rebuilt one then.
+func (s *Server) rebuildSegmentIndex(ctx context.Context, outdated *model.SegmentIndex) error {
+	return s.rebuildSegmentIndexWithin(ctx, outdated, outdated.AllowedCPUFeatures)
+}
+
+// rebuildSegmentIndexWithin rebuilds the segment index as rebuildSegmentIndex does,
+// on the index nodes within the allowed CPU features if specified.
+func (s *Server) rebuildSegmentIndexWithin(ctx context.Context, outdated *model.SegmentIndex, allowedCPUFeatures []string) error {
+	buildID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		return err
+	}
+	log.Ctx(ctx).Info("rebuild the outdated segment index", zap.Int64("segmentID", outdated.SegmentID),
+		zap.Int64("indexID", outdated.IndexID), zap.Int64("replacedBuildID", outdated.BuildID),
+		zap.Int64("buildID", buildID), zap.Strings("allowedCPUFeatures", allowedCPUFeatures))
+	segIndex := &model.SegmentIndex{
+		SegmentID:          outdated.SegmentID,
+		CollectionID:       outdated.CollectionID,
+		PartitionID:        outdated.PartitionID,
+		NumRows:            outdated.NumRows,
+		IndexID:            outdated.IndexID,
+		BuildID:            buildID,
+		CreateTime:         outdated.CreateTime,
+		ReplacedBuildID:    outdated.BuildID,
+		AllowedCPUFeatures: common.CloneStringList(allowedCPUFeatures),
+	}
+	if err = s.meta.indexMeta.AddSegmentIndex(segIndex); err != nil {
+		return err
+	}
+	s.taskScheduler.enqueue(&indexBuildTask{
+		taskID: buildID,
+		taskInfo: &indexpb.IndexTaskInfo{
+			BuildID: buildID,
+			State:   commonpb.IndexState_Unissued,
+		},
+	})
+	return nil
+}
+
 func (s *Server) createIndexesForSegment(segment *SegmentInfo) error {
+	if segment.GetIsCorrupted() {
+		log.Warn("skip creating index for the corrupted segment", zap.Int64("segmentID", segment.ID))
+		return nil
+	}
 	indexes := s.meta.indexMeta.GetIndexesForCollection(segment.CollectionID, "")
 	indexIDToSegIndexes := s.meta.indexMeta.GetSegmentIndexes(segment.CollectionID, segment.ID)
 	for _, index := range indexes {
@@ -96,6 +145,40 @@ func (s *Server) createIndexesForSegment(segment *SegmentInfo) error {
 	return nil
 }
 
+// createStatsTaskForSegment creates the stats task computing the statistics of the fields of the flushed segment
+// if the stats job is enabled and the statistics have not been computed yet.
+func (s *Server) createStatsTaskForSegment(segment *SegmentInfo) error {
+	if !Params.DataCoordCfg.EnableStatsJob.GetAsBool() ||
+		segment.GetIsCorrupted() ||
+		segment.GetLevel() == datapb.SegmentLevel_L0 ||
+		len(segment.GetFieldStats()) > 0 ||
+		s.meta.statsMeta.GetTaskBySegment(segment.GetID()) != nil {
+		return nil
+	}
+	taskID, err := s.allocator.allocID(context.Background())
+	if err != nil {
+		return err
+	}
+	if err = s.meta.statsMeta.AddStatsTask(&indexpb.StatsTask{
+		CollectionID: segment.GetCollectionID(),
+		PartitionID:  segment.GetPartitionID(),
+		SegmentID:    segment.GetID(),
+		TaskID:       taskID,
+		State:        indexpb.JobState_JobStateInit,
+	}); err != nil {
+		return err
+	}
+	s.taskScheduler.enqueue(&statsTask{
+		taskID:    taskID,
+		segmentID: segment.GetID(),
+		taskInfo: &indexpb.StatsResult{
+			TaskID: taskID,
+			State:  indexpb.JobState_JobStateInit,
+		},
+	})
+	return nil
+}
+
 func (s *Server) getUnIndexTaskSegments() []*SegmentInfo {
 	flushedSegments := s.meta.SelectSegments(SegmentFilterFunc(func(seg *SegmentInfo) bool {
 		return isFlush(seg)
@@ -129,6 +212,16 @@ func (s *Server) createIndexForSegmentLoop(ctx context.Context) {
 					continue
 				}
 			}
+			if Params.DataCoordCfg.EnableStatsJob.GetAsBool() {
+				segments := s.meta.SelectSegments(SegmentFilterFunc(func(info *SegmentInfo) bool {
+					return isFlush(info)
+				}))
+				for _, segment := range segments {
+					if err := s.createStatsTaskForSegment(segment); err != nil {
+						log.Warn("create stats task for segment fail, wait for retry", zap.Int64("segmentID", segment.ID), zap.Error(err))
+					}
+				}
+			}
 		case collectionID := <-s.notifyIndexChan:
 			log.Info("receive create index notify", zap.Int64("collectionID", collectionID))
 			segments := s.meta.SelectSegments(WithCollection(collectionID), SegmentFilterFunc(func(info *SegmentInfo) bool {
@@ -147,6 +240,9 @@ func (s *Server) createIndexForSegmentLoop(ctx context.Context) {
 				log.Warn("segment is not exist, no need to build index", zap.Int64("segmentID", segID))
 				continue
 			}
+			if err := s.createStatsTaskForSegment(segment); err != nil {
+				log.Warn("create stats task for segment fail, wait for retry", zap.Int64("segmentID", segment.ID), zap.Error(err))
+			}
 			if err := s.createIndexesForSegment(segment); err != nil {
 				log.Warn("create index for segment fail, wait for retry", zap.Int64("segmentID", segment.ID))
 				continue
@@ -217,6 +313,15 @@ func (s *Server) CreateIndex(ctx context.Context, req *indexpb.CreateIndexReques
 	}
 
 	if indexID == 0 {
+		if maxQueued := Params.DataCoordCfg.TenantMaxQueuedTasks.GetAsInt(); maxQueued > 0 && s.taskScheduler != nil {
+			tenant, _, _ := s.taskScheduler.getTenant(req.GetCollectionID())
+			if queued := s.taskScheduler.countTenantTasks(tenant); queued >= maxQueued {
+				log.Warn("too many unfinished index tasks of the tenant, reject the index creation",
+					zap.String("tenant", tenant), zap.Int("queued", queued), zap.Int("maxQueued", maxQueued))
+				metrics.IndexRequestCounter.WithLabelValues(metrics.FailLabel).Inc()
+				return merr.Status(merr.WrapErrServiceQuotaExceeded(fmt.Sprintf("tenant %s has %d unfinished index tasks", tenant, queued))), nil
+			}
+		}
 		indexID, err = s.allocator.allocID(ctx)
 		if err != nil {
 			log.Warn("failed to alloc indexID", zap.Error(err))
@@ -283,6 +388,10 @@ func ValidateIndexParams(index *model.Index) error {
 				if _, err := strconv.ParseBool(param.GetValue()); err != nil {
 					return merr.WrapErrParameterInvalidMsg("invalid %s value: %s, expected: true, false", param.GetKey(), param.GetValue())
 				}
+			case common.IndexBuildProfileKey:
+				if _, err := indexparams.GetBuildProfile(param.GetValue()); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -656,16 +765,36 @@ func (s *Server) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetInde
 	}))
 
 	s.completeIndexInfo(indexInfo, indexes[0], segments, false, indexes[0].CreateTime)
+	progress := int32(100)
+	if indexInfo.TotalRows > 0 {
+		buildingRows := countBuildingRows(indexes[0].IndexID, segments)
+		progress = int32(min((indexInfo.IndexedRows+buildingRows)*100/indexInfo.TotalRows, 100))
+	}
 	log.Info("GetIndexBuildProgress success", zap.Int64("collectionID", req.GetCollectionID()),
-		zap.String("indexName", req.GetIndexName()))
+		zap.String("indexName", req.GetIndexName()), zap.Int32("progress", progress))
 	return &indexpb.GetIndexBuildProgressResponse{
 		Status:           merr.Success(),
 		IndexedRows:      indexInfo.IndexedRows,
 		TotalRows:        indexInfo.TotalRows,
 		PendingIndexRows: indexInfo.PendingIndexRows,
+		Progress:         progress,
 	}, nil
 }
 
+// countBuildingRows counts the rows indexed so far of the segments in building by the build progress.
+func countBuildingRows(indexID UniqueID, segments map[int64]*indexStats) int64 {
+	var rows int64
+	for _, seg := range segments {
+		if seg.state != commonpb.SegmentState_Flushed && seg.state != commonpb.SegmentState_Flushing {
+			continue
+		}
+		if segIdx, ok := seg.indexStates[indexID]; ok && segIdx.GetState() == commonpb.IndexState_InProgress {
+			rows += seg.numRows * int64(segIdx.GetProgress()) / 100
+		}
+	}
+	return rows
+}
+
 // indexStats just for indexing statistics.
 // Please use it judiciously.
 type indexStats struct {
@@ -728,10 +857,28 @@ func (s *Server) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRe
 		s.completeIndexInfo(indexInfo, index, segments, false, createTs)
 		indexInfos = append(indexInfos, indexInfo)
 	}
-	return &indexpb.DescribeIndexResponse{
+	resp := &indexpb.DescribeIndexResponse{
 		Status:     merr.Success(),
 		IndexInfos: indexInfos,
-	}, nil
+	}
+	if req.GetIncludeBuildHistory() {
+		indexIDs := lo.Map(indexes, func(index *model.Index, _ int) UniqueID {
+			return index.IndexID
+		})
+		resp.BuildHistories, resp.NextBuildHistoryCursor = s.getBuildHistories(req.GetCollectionID(), indexIDs,
+			req.GetBuildHistoryCursor(), req.GetBuildHistoryLimit())
+	}
+	return resp, nil
+}
+
+// defaultBuildHistoryLimit is the default max number of segments whose build histories are returned in a request.
+const defaultBuildHistoryLimit = 100
+
+func (s *Server) getBuildHistories(collectionID UniqueID, indexIDs []UniqueID, cursor UniqueID, limit int64) ([]*indexpb.SegmentIndexBuildHistory, UniqueID) {
+	if limit <= 0 {
+		limit = defaultBuildHistoryLimit
+	}
+	return s.meta.indexMeta.GetBuildHistories(collectionID, indexIDs, cursor, int(limit))
 }
 
 // GetIndexStatistics get the statistics of the index. DescribeIndex doesn't contain statistics.
@@ -866,8 +1013,9 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 			ret.SegmentInfo[segID].EnableIndex = true
 			for _, segIdx := range segIdxes {
 				if segIdx.IndexState == commonpb.IndexState_Finished {
-					indexFilePaths := metautil.BuildSegmentIndexFilePaths(s.meta.chunkManager.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
-						segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys)
+					buildID, indexVersion, partitionID, segmentID := indexFilesLocation(segIdx)
+					indexFilePaths := metautil.BuildSegmentIndexFilePaths(s.meta.chunkManager.RootPath(), buildID, indexVersion,
+						partitionID, segmentID, segIdx.IndexFileKeys)
 					indexParams := s.meta.indexMeta.GetIndexParams(segIdx.CollectionID, segIdx.IndexID)
 					indexParams = append(indexParams, s.meta.indexMeta.GetTypeParams(segIdx.CollectionID, segIdx.IndexID)...)
 					ret.SegmentInfo[segID].IndexInfos = append(ret.SegmentInfo[segID].IndexInfos,
@@ -883,6 +1031,7 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 							IndexVersion:        segIdx.IndexVersion,
 							NumRows:             segIdx.NumRows,
 							CurrentIndexVersion: segIdx.CurrentIndexVersion,
+							RequiredCpuFeatures: segIdx.RequiredCPUFeatures,
 						})
 				}
 			}
@@ -921,9 +1070,244 @@ func (s *Server) ListIndexes(ctx context.Context, req *indexpb.ListIndexesReques
 			UserIndexParams: index.UserIndexParams,
 		}
 	})
-	log.Debug("List index success")
-	return &indexpb.ListIndexesResponse{
+	resp := &indexpb.ListIndexesResponse{
 		Status:     merr.Success(),
 		IndexInfos: indexInfos,
+	}
+	if req.GetIncludeBuildHistory() {
+		resp.BuildHistories, resp.NextBuildHistoryCursor = s.getBuildHistories(req.GetCollectionID(), nil,
+			req.GetBuildHistoryCursor(), req.GetBuildHistoryLimit())
+	}
+	log.Debug("List index success")
+	return resp, nil
+}
+
+// PauseIndexBuilds pauses the unfinished index builds of the collection or the specified builds,
+// the paused builds are kept in meta and skipped by the scheduler until resumed.
+func (s *Server) PauseIndexBuilds(ctx context.Context, req *indexpb.PauseIndexBuildsRequest) (*indexpb.PauseIndexBuildsResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("buildIDs", req.GetBuildIDs()),
+	)
+	log.Info("receive PauseIndexBuilds request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return &indexpb.PauseIndexBuildsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if req.GetCollectionID() == 0 && len(req.GetBuildIDs()) == 0 {
+		return &indexpb.PauseIndexBuildsResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("either collectionID or buildIDs must be specified")),
+		}, nil
+	}
+
+	buildIDs, err := s.meta.indexMeta.PauseTasks(req.GetCollectionID(), req.GetBuildIDs())
+	if err != nil {
+		log.Warn("failed to pause index builds", zap.Error(err))
+		return &indexpb.PauseIndexBuildsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	s.taskScheduler.notify()
+	log.Info("PauseIndexBuilds success", zap.Int64s("paused", buildIDs))
+	return &indexpb.PauseIndexBuildsResponse{
+		Status:   merr.Success(),
+		BuildIDs: buildIDs,
 	}, nil
 }
+
+// ResumeIndexBuilds resumes the paused index builds of the collection or the specified builds,
+// the resumed builds are scheduled from Init again.
+func (s *Server) ResumeIndexBuilds(ctx context.Context, req *indexpb.ResumeIndexBuildsRequest) (*indexpb.ResumeIndexBuildsResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("buildIDs", req.GetBuildIDs()),
+	)
+	log.Info("receive ResumeIndexBuilds request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return &indexpb.ResumeIndexBuildsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if req.GetCollectionID() == 0 && len(req.GetBuildIDs()) == 0 {
+		return &indexpb.ResumeIndexBuildsResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("either collectionID or buildIDs must be specified")),
+		}, nil
+	}
+
+	buildIDs, err := s.meta.indexMeta.ResumeTasks(req.GetCollectionID(), req.GetBuildIDs())
+	if err != nil {
+		log.Warn("failed to resume index builds", zap.Error(err))
+		return &indexpb.ResumeIndexBuildsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	s.taskScheduler.notify()
+	log.Info("ResumeIndexBuilds success", zap.Int64s("resumed", buildIDs))
+	return &indexpb.ResumeIndexBuildsResponse{
+		Status:   merr.Success(),
+		BuildIDs: buildIDs,
+	}, nil
+}
+
+// RebuildIncompatibleIndexes rebuilds the finished indexes of the segment requiring the CPU features not supported
+// by the query nodes, the rebuilds are restricted to the index nodes within the supported features.
+// The incompatible index keeps serving the query nodes supporting it until the rebuild replaces it.
+func (s *Server) RebuildIncompatibleIndexes(ctx context.Context, req *indexpb.RebuildIncompatibleIndexesRequest) (*indexpb.RebuildIncompatibleIndexesResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("segmentID", req.GetSegmentID()),
+		zap.Strings("supportedCPUFeatures", req.GetSupportedCpuFeatures()),
+	)
+	log.Info("receive RebuildIncompatibleIndexes request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return &indexpb.RebuildIncompatibleIndexesResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if req.GetSegmentID() == 0 || len(req.GetSupportedCpuFeatures()) == 0 {
+		return &indexpb.RebuildIncompatibleIndexesResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("segmentID and supported CPU features must be specified")),
+		}, nil
+	}
+
+	supported := req.GetSupportedCpuFeatures()
+	rebuilding := s.meta.indexMeta.GetRebuildingBuilds()
+	incompatible := make([]*model.SegmentIndex, 0)
+	for _, segIdx := range s.meta.indexMeta.GetSegmentIndexes(req.GetCollectionID(), req.GetSegmentID()) {
+		if segIdx.IndexState == commonpb.IndexState_Finished && segIdx.ReplacedTime == 0 &&
+			len(hardware.MissingCPUFeatures(segIdx.RequiredCPUFeatures, supported)) > 0 {
+			incompatible = append(incompatible, segIdx)
+		}
+	}
+	if len(incompatible) == 0 {
+		return &indexpb.RebuildIncompatibleIndexesResponse{
+			Status:   merr.Success(),
+			BuildIDs: []int64{},
+		}, nil
+	}
+
+	// the rebuild waits forever without an index node building the compatible index
+	compatibleWorker := lo.SomeBy(lo.Values(s.indexNodeManager.QuerySlots()), func(worker *WorkerSlots) bool {
+		return fitCPUFeatures(supported, worker.CPUFeatures)
+	})
+	if !compatibleWorker {
+		err := merr.WrapErrServiceUnavailable("no index node within the supported CPU features")
+		log.Warn("failed to rebuild the incompatible indexes", zap.Error(err))
+		return &indexpb.RebuildIncompatibleIndexesResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	buildIDs := make([]int64, 0, len(incompatible))
+	for _, segIdx := range incompatible {
+		if !rebuilding.Contain(segIdx.BuildID) {
+			if err := s.rebuildSegmentIndexWithin(ctx, segIdx, supported); err != nil {
+				log.Warn("failed to rebuild the incompatible index", zap.Int64("buildID", segIdx.BuildID), zap.Error(err))
+				return &indexpb.RebuildIncompatibleIndexesResponse{
+					Status: merr.Status(err),
+				}, nil
+			}
+		}
+		buildIDs = append(buildIDs, segIdx.BuildID)
+	}
+	log.Info("RebuildIncompatibleIndexes success", zap.Int64s("buildIDs", buildIDs))
+	return &indexpb.RebuildIncompatibleIndexesResponse{
+		Status:   merr.Success(),
+		BuildIDs: buildIDs,
+	}, nil
+}
+
+// ListIndexTasks lists the tasks tracked by the task scheduler for debugging,
+// filtered by the collection and the states if specified.
+func (s *Server) ListIndexTasks(ctx context.Context, req *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Any("states", req.GetStates()),
+	)
+	log.Info("receive ListIndexTasks request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return &indexpb.ListIndexTasksResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	tasks := s.taskScheduler.listTasks(req.GetCollectionID(), req.GetStates())
+	log.Info("ListIndexTasks success", zap.Int("taskNum", len(tasks)))
+	return &indexpb.ListIndexTasksResponse{
+		Status: merr.Success(),
+		Tasks:  tasks,
+	}, nil
+}
+
+// DropAnalyzeTask cancels the unfinished analyze task, the task is dropped on the worker
+// and marked as failed instead of being retried.
+func (s *Server) DropAnalyzeTask(ctx context.Context, req *indexpb.DropAnalyzeTaskRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("taskID", req.GetTaskID()),
+	)
+	log.Info("receive DropAnalyzeTask request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := s.taskScheduler.cancelAnalyzeTask(ctx, req.GetTaskID()); err != nil {
+		log.Warn("failed to drop analyze task", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("DropAnalyzeTask success")
+	return merr.Success(), nil
+}
+
+// ForceCompleteTask completes the task wedged in the index task scheduler with the finished result on its worker,
+// the task without the finished result should be forced to fail instead.
+func (s *Server) ForceCompleteTask(ctx context.Context, req *indexpb.ForceTaskRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("taskID", req.GetTaskID()),
+		zap.String("reason", req.GetReason()),
+	)
+	log.Info("receive ForceCompleteTask request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := s.taskScheduler.forceCompleteTask(ctx, req.GetTaskID(), req.GetReason()); err != nil {
+		log.Warn("failed to force complete task", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("ForceCompleteTask success")
+	return merr.Success(), nil
+}
+
+// ForceFailTask fails the task wedged in the index task scheduler without retry.
+func (s *Server) ForceFailTask(ctx context.Context, req *indexpb.ForceTaskRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("taskID", req.GetTaskID()),
+		zap.String("reason", req.GetReason()),
+	)
+	log.Info("receive ForceFailTask request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := s.taskScheduler.forceFailTask(ctx, req.GetTaskID(), req.GetReason()); err != nil {
+		log.Warn("failed to force fail task", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("ForceFailTask success")
+	return merr.Success(), nil
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func TestServerId(t *testing.T) {
@@ -87,7 +88,7 @@ func TestServer_CreateIndex(t *testing.T) {
 	s := &Server{
 		meta: &meta{
 			catalog: catalog,
-			collections: map[UniqueID]*collectionInfo{
+			collections: newCollectionInfos(map[UniqueID]*collectionInfo{
 				collID: {
 					ID: collID,
 
@@ -96,7 +97,7 @@ func TestServer_CreateIndex(t *testing.T) {
 					Properties:     nil,
 					CreatedAt:      0,
 				},
-			},
+			}),
 			indexMeta: indexMeta,
 		},
 		allocator:       newMockAllocator(),
@@ -241,6 +242,49 @@ func TestServer_CreateIndex(t *testing.T) {
 		assert.Error(t, merr.CheckRPCCall(resp, err))
 	})
 
+	t.Run("invalid build profile", func(t *testing.T) {
+		s.allocator = newMockAllocator()
+		s.meta.indexMeta.indexes = map[UniqueID]map[UniqueID]*model.Index{}
+		req.IndexParams = []*commonpb.KeyValuePair{
+			{
+				Key:   common.IndexTypeKey,
+				Value: "IVF_FLAT",
+			},
+			{
+				Key:   common.IndexBuildProfileKey,
+				Value: "turbo",
+			},
+		}
+		resp, err := s.CreateIndex(ctx, req)
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrParameterInvalid)
+	})
+
+	t.Run("tenant queue quota exceeded", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.TenantMaxQueuedTasks.Key, "1")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.TenantMaxQueuedTasks.Key)
+
+		s.allocator = newMockAllocator()
+		s.meta.indexMeta.indexes = map[UniqueID]map[UniqueID]*model.Index{}
+		req.IndexParams = []*commonpb.KeyValuePair{
+			{
+				Key:   common.IndexTypeKey,
+				Value: "IVF_FLAT",
+			},
+		}
+		s.meta.analyzeMeta = &analyzeMeta{
+			ctx:   ctx,
+			tasks: map[int64]*indexpb.AnalyzeTask{1: {TaskID: 1, CollectionID: collID}},
+		}
+		s.taskScheduler = &taskScheduler{
+			meta:  s.meta,
+			tasks: make(map[int64]Task),
+		}
+		s.taskScheduler.enqueue(&analyzeTask{taskID: 1, taskInfo: &indexpb.AnalyzeResult{TaskID: 1, State: indexpb.JobState_JobStateInit}})
+		defer func() { s.taskScheduler = nil }()
+		resp, err := s.CreateIndex(ctx, req)
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrServiceQuotaExceeded)
+	})
+
 	t.Run("save index fail", func(t *testing.T) {
 		metakv := mockkv.NewMetaKv(t)
 		metakv.EXPECT().Save(mock.Anything, mock.Anything).Return(errors.New("failed")).Maybe()
@@ -1076,6 +1120,27 @@ func TestServer_GetIndexBuildProgress(t *testing.T) {
 		assert.Equal(t, int64(0), resp.GetIndexedRows())
 	})
 
+	t.Run("in progress", func(t *testing.T) {
+		s.meta.indexMeta.updateSegmentIndex(&model.SegmentIndex{
+			SegmentID:    segID,
+			CollectionID: collID,
+			PartitionID:  partID,
+			NumRows:      10250,
+			IndexID:      indexID,
+			BuildID:      10,
+			IndexVersion: 1,
+			IndexState:   commonpb.IndexState_InProgress,
+			CreateTime:   createTS,
+		})
+		s.meta.indexMeta.UpdateBuildProgress(10, 50)
+
+		resp, err := s.GetIndexBuildProgress(ctx, req)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(0), resp.GetIndexedRows())
+		assert.EqualValues(t, 50, resp.GetProgress())
+	})
+
 	t.Run("finish", func(t *testing.T) {
 		s.meta.indexMeta.updateSegmentIndex(&model.SegmentIndex{
 			SegmentID:     segID,
@@ -1121,6 +1186,7 @@ func TestServer_GetIndexBuildProgress(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 		assert.Equal(t, int64(10250), resp.GetTotalRows())
 		assert.Equal(t, int64(10250), resp.GetIndexedRows())
+		assert.EqualValues(t, 100, resp.GetProgress())
 	})
 
 	t.Run("multiple index", func(t *testing.T) {
@@ -1514,6 +1580,23 @@ func TestServer_DescribeIndex(t *testing.T) {
 		assert.Equal(t, 5, len(resp.GetIndexInfos()))
 	})
 
+	t.Run("with build history", func(t *testing.T) {
+		resp, err := s.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
+			CollectionID:        collID,
+			IndexName:           indexName + "_3",
+			IncludeBuildHistory: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.NotEmpty(t, resp.GetBuildHistories())
+		for _, history := range resp.GetBuildHistories() {
+			assert.Equal(t, indexID+3, history.GetIndexID())
+			assert.Len(t, history.GetRecords(), 1)
+			assert.Equal(t, commonpb.IndexState_InProgress, history.GetRecords()[0].GetState())
+		}
+		assert.Zero(t, resp.GetNextBuildHistoryCursor())
+	})
+
 	t.Run("describe after drop index", func(t *testing.T) {
 		status, err := s.DropIndex(ctx, &indexpb.DropIndexRequest{
 			CollectionID: collID,
@@ -1649,7 +1732,8 @@ func TestServer_ListIndexes(t *testing.T) {
 						},
 					},
 				},
-				segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{},
+				segmentIndexes:       map[UniqueID]map[UniqueID]*model.SegmentIndex{},
+				buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{},
 			},
 
 			segments: NewSegmentsInfo(),
@@ -1673,6 +1757,427 @@ func TestServer_ListIndexes(t *testing.T) {
 
 		// assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 		assert.Equal(t, 5, len(resp.GetIndexInfos()))
+		assert.Empty(t, resp.GetBuildHistories())
+	})
+
+	t.Run("with build history", func(t *testing.T) {
+		for i := int64(0); i < 3; i++ {
+			s.meta.indexMeta.updateSegmentIndex(&model.SegmentIndex{
+				SegmentID:    segID + i,
+				CollectionID: collID,
+				IndexID:      indexID,
+				BuildID:      buildID + i,
+				IndexState:   commonpb.IndexState_Finished,
+				BuildHistory: []*indexpb.IndexBuildRecord{
+					{BuildID: buildID + i, State: commonpb.IndexState_Retry, FailReason: "mock error"},
+					{BuildID: buildID + i, State: commonpb.IndexState_Finished},
+				},
+			})
+		}
+		resp, err := s.ListIndexes(ctx, &indexpb.ListIndexesRequest{
+			CollectionID:        collID,
+			IncludeBuildHistory: true,
+			BuildHistoryLimit:   2,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetBuildHistories(), 2)
+		assert.Len(t, resp.GetBuildHistories()[0].GetRecords(), 2)
+		assert.Equal(t, indexName, resp.GetBuildHistories()[0].GetIndexName())
+		assert.Equal(t, segID+1, resp.GetNextBuildHistoryCursor())
+
+		resp, err = s.ListIndexes(ctx, &indexpb.ListIndexesRequest{
+			CollectionID:        collID,
+			IncludeBuildHistory: true,
+			BuildHistoryCursor:  resp.GetNextBuildHistoryCursor(),
+		})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetBuildHistories(), 1)
+		assert.Equal(t, segID+2, resp.GetBuildHistories()[0].GetSegmentID())
+		assert.Zero(t, resp.GetNextBuildHistoryCursor())
+	})
+}
+
+func TestServer_PauseResumeIndexBuilds(t *testing.T) {
+	var (
+		collID  = UniqueID(1)
+		segID   = UniqueID(1000)
+		indexID = UniqueID(100)
+		buildID = UniqueID(10000)
+		ctx     = context.Background()
+	)
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(nil)
+	segIndexes := make(map[UniqueID]*model.SegmentIndex)
+	for _, state := range []commonpb.IndexState{
+		commonpb.IndexState_Unissued, commonpb.IndexState_InProgress, commonpb.IndexState_Finished,
+	} {
+		id := buildID + int64(state)
+		segIndexes[id] = &model.SegmentIndex{
+			SegmentID:    segID + int64(state),
+			CollectionID: collID,
+			IndexID:      indexID,
+			BuildID:      id,
+			IndexState:   state,
+		}
+	}
+	s := &Server{
+		meta: &meta{
+			catalog: catalog,
+			indexMeta: &indexMeta{
+				catalog:              catalog,
+				buildID2SegmentIndex: segIndexes,
+				segmentIndexes:       map[UniqueID]map[UniqueID]*model.SegmentIndex{},
+			},
+		},
+		taskScheduler: &taskScheduler{notifyChan: make(chan struct{}, 1)},
+	}
+
+	t.Run("server not available", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.PauseIndexBuilds(ctx, &indexpb.PauseIndexBuildsRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+		resp2, err := s.ResumeIndexBuilds(ctx, &indexpb.ResumeIndexBuildsRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp2.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	t.Run("invalid request", func(t *testing.T) {
+		resp, err := s.PauseIndexBuilds(ctx, &indexpb.PauseIndexBuildsRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+		resp2, err := s.ResumeIndexBuilds(ctx, &indexpb.ResumeIndexBuildsRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp2.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		resp, err := s.PauseIndexBuilds(ctx, &indexpb.PauseIndexBuildsRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.ElementsMatch(t, []UniqueID{
+			buildID + int64(commonpb.IndexState_Unissued),
+			buildID + int64(commonpb.IndexState_InProgress),
+		}, resp.GetBuildIDs())
+
+		resumeID := buildID + int64(commonpb.IndexState_InProgress)
+		resp2, err := s.ResumeIndexBuilds(ctx, &indexpb.ResumeIndexBuildsRequest{BuildIDs: []UniqueID{resumeID}})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp2.GetStatus()))
+		assert.Equal(t, []UniqueID{resumeID}, resp2.GetBuildIDs())
+		assert.False(t, s.meta.indexMeta.IsTaskPaused(resumeID))
+		assert.True(t, s.meta.indexMeta.IsTaskPaused(buildID+int64(commonpb.IndexState_Unissued)))
+	})
+}
+
+func TestServer_RebuildIncompatibleIndexes(t *testing.T) {
+	var (
+		collID  = UniqueID(1)
+		segID   = UniqueID(1000)
+		indexID = UniqueID(100)
+		buildID = UniqueID(10000)
+		ctx     = context.Background()
+	)
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().CreateSegmentIndex(mock.Anything, mock.Anything).Return(nil).Maybe()
+	indexMeta := newSegmentIndexMeta(catalog)
+	indexMeta.indexes[collID] = map[UniqueID]*model.Index{
+		indexID: {CollectionID: collID, IndexID: indexID, IndexName: "_default_idx"},
+	}
+	indexMeta.updateSegmentIndex(&model.SegmentIndex{
+		SegmentID:           segID,
+		CollectionID:        collID,
+		IndexID:             indexID,
+		BuildID:             buildID,
+		IndexState:          commonpb.IndexState_Finished,
+		IndexFileKeys:       []string{"file1"},
+		RequiredCPUFeatures: []string{"avx512f"},
+	})
+
+	allocator := NewNMockAllocator(t)
+	allocator.EXPECT().allocID(mock.Anything).Return(buildID+1, nil).Once()
+	jobStats := &indexpb.GetJobStatsResponse{
+		Status:      merr.Success(),
+		TaskSlots:   1,
+		CpuFeatures: []string{"avx512f"},
+	}
+	ic := mocks.NewMockIndexNodeClient(t)
+	ic.EXPECT().GetJobStats(mock.Anything, mock.Anything, mock.Anything).Return(jobStats, nil).Maybe()
+	nodeManager := NewNodeManager(ctx, defaultIndexNodeCreatorFunc)
+	nodeManager.setClient(1, ic)
+	s := &Server{
+		meta:             &meta{catalog: catalog, indexMeta: indexMeta},
+		allocator:        allocator,
+		indexNodeManager: nodeManager,
+		taskScheduler:    &taskScheduler{tasks: make(map[int64]Task), notifyChan: make(chan struct{}, 1)},
+	}
+	supported := []string{"amd64", "avx2"}
+
+	t.Run("server not available", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.RebuildIncompatibleIndexes(ctx, &indexpb.RebuildIncompatibleIndexesRequest{CollectionID: collID, SegmentID: segID})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	t.Run("invalid request", func(t *testing.T) {
+		resp, err := s.RebuildIncompatibleIndexes(ctx, &indexpb.RebuildIncompatibleIndexesRequest{CollectionID: collID, SegmentID: segID})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("compatible", func(t *testing.T) {
+		resp, err := s.RebuildIncompatibleIndexes(ctx, &indexpb.RebuildIncompatibleIndexesRequest{
+			CollectionID:         collID,
+			SegmentID:            segID,
+			SupportedCpuFeatures: []string{"amd64", "avx2", "avx512f"},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Empty(t, resp.GetBuildIDs())
+	})
+
+	t.Run("no compatible index node", func(t *testing.T) {
+		resp, err := s.RebuildIncompatibleIndexes(ctx, &indexpb.RebuildIncompatibleIndexesRequest{
+			CollectionID:         collID,
+			SegmentID:            segID,
+			SupportedCpuFeatures: supported,
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+	})
+
+	t.Run("rebuild", func(t *testing.T) {
+		jobStats.CpuFeatures = nil
+		for i := 0; i < 2; i++ {
+			// the rebuild is scheduled only once
+			resp, err := s.RebuildIncompatibleIndexes(ctx, &indexpb.RebuildIncompatibleIndexesRequest{
+				CollectionID:         collID,
+				SegmentID:            segID,
+				SupportedCpuFeatures: supported,
+			})
+			assert.NoError(t, err)
+			assert.NoError(t, merr.Error(resp.GetStatus()))
+			assert.Equal(t, []UniqueID{buildID}, resp.GetBuildIDs())
+		}
+		rebuild, ok := s.meta.indexMeta.GetIndexJob(buildID + 1)
+		assert.True(t, ok)
+		assert.Equal(t, buildID, rebuild.ReplacedBuildID)
+		assert.Equal(t, supported, rebuild.AllowedCPUFeatures)
+		assert.Len(t, s.taskScheduler.tasks, 1)
+	})
+}
+
+func TestServer_DropAnalyzeTask(t *testing.T) {
+	var (
+		collID    = UniqueID(1)
+		analyzeID = UniqueID(20000)
+		ctx       = context.Background()
+	)
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().SaveAnalyzeTask(mock.Anything, mock.Anything).Return(nil)
+	s := &Server{
+		meta: &meta{
+			analyzeMeta: &analyzeMeta{
+				ctx:     ctx,
+				catalog: catalog,
+				tasks: map[int64]*indexpb.AnalyzeTask{
+					analyzeID: {CollectionID: collID, TaskID: analyzeID, State: indexpb.JobState_JobStateInit},
+				},
+			},
+		},
+	}
+	s.taskScheduler = &taskScheduler{
+		tasks: make(map[int64]Task),
+		meta:  s.meta,
+	}
+	s.taskScheduler.tasks[analyzeID] = &analyzeTask{
+		taskID:   analyzeID,
+		taskInfo: &indexpb.AnalyzeResult{TaskID: analyzeID, State: indexpb.JobState_JobStateInit},
+	}
+
+	t.Run("server not available", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		status, err := s.DropAnalyzeTask(ctx, &indexpb.DropAnalyzeTaskRequest{TaskID: analyzeID})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	})
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	t.Run("task not found", func(t *testing.T) {
+		status, err := s.DropAnalyzeTask(ctx, &indexpb.DropAnalyzeTaskRequest{TaskID: analyzeID + 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrAnalyzeTaskNotFound)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		status, err := s.DropAnalyzeTask(ctx, &indexpb.DropAnalyzeTaskRequest{TaskID: analyzeID})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+		assert.Nil(t, s.taskScheduler.getTask(analyzeID))
+		assert.Equal(t, indexpb.JobState_JobStateFailed, s.meta.analyzeMeta.GetTask(analyzeID).GetState())
+	})
+}
+
+func TestServer_ForceTask(t *testing.T) {
+	var (
+		taskID = UniqueID(20000)
+		ctx    = context.Background()
+	)
+
+	s := &Server{}
+	s.taskScheduler = &taskScheduler{
+		tasks: make(map[int64]Task),
+	}
+	task := &analyzeTask{
+		taskID:   taskID,
+		taskInfo: &indexpb.AnalyzeResult{TaskID: taskID, State: indexpb.JobState_JobStateInit},
+	}
+	s.taskScheduler.tasks[taskID] = task
+
+	t.Run("server not available", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		status, err := s.ForceCompleteTask(ctx, &indexpb.ForceTaskRequest{TaskID: taskID, Reason: "stuck"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+		status, err = s.ForceFailTask(ctx, &indexpb.ForceTaskRequest{TaskID: taskID, Reason: "stuck"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	})
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	t.Run("complete unassigned task", func(t *testing.T) {
+		status, err := s.ForceCompleteTask(ctx, &indexpb.ForceTaskRequest{TaskID: taskID, Reason: "stuck"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+	})
+
+	t.Run("fail without reason", func(t *testing.T) {
+		status, err := s.ForceFailTask(ctx, &indexpb.ForceTaskRequest{TaskID: taskID})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		status, err := s.ForceFailTask(ctx, &indexpb.ForceTaskRequest{TaskID: taskID, Reason: "stuck"})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+		assert.Equal(t, indexpb.JobState_JobStateFailed, task.GetState())
+	})
+}
+
+func TestServer_ListIndexTasks(t *testing.T) {
+	var (
+		collID    = UniqueID(1)
+		segID     = UniqueID(1000)
+		buildID   = UniqueID(10000)
+		analyzeID = UniqueID(20000)
+		ctx       = context.Background()
+	)
+
+	s := &Server{
+		meta: &meta{
+			indexMeta: &indexMeta{
+				buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{
+					buildID: {
+						SegmentID:    segID,
+						CollectionID: collID,
+						BuildID:      buildID,
+						RetryTimes:   2,
+					},
+					buildID + 1: {
+						SegmentID:    segID + 1,
+						CollectionID: collID + 1,
+						BuildID:      buildID + 1,
+					},
+				},
+			},
+			analyzeMeta: &analyzeMeta{
+				tasks: map[int64]*indexpb.AnalyzeTask{
+					analyzeID: {CollectionID: collID, TaskID: analyzeID},
+				},
+			},
+		},
+		taskScheduler: newTaskScheduler(ctx, &meta{
+			segments:    NewSegmentsInfo(),
+			indexMeta:   &indexMeta{segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{}},
+			analyzeMeta: &analyzeMeta{},
+			statsMeta:   &statsMeta{},
+		}, nil, nil, nil, nil),
+	}
+	s.taskScheduler.meta = s.meta
+	s.taskScheduler.enqueue(&indexBuildTask{
+		taskID: buildID,
+		nodeID: 1,
+		taskInfo: &indexpb.IndexTaskInfo{
+			BuildID:    buildID,
+			State:      commonpb.IndexState(indexpb.JobState_JobStateRetry),
+			FailReason: "mock error",
+		},
+	})
+	s.taskScheduler.enqueue(&indexBuildTask{
+		taskID:   buildID + 1,
+		taskInfo: &indexpb.IndexTaskInfo{BuildID: buildID + 1, State: commonpb.IndexState_Unissued},
+	})
+	s.taskScheduler.enqueue(&analyzeTask{
+		taskID:   analyzeID,
+		taskInfo: &indexpb.AnalyzeResult{TaskID: analyzeID, State: indexpb.JobState_JobStateInit},
+	})
+
+	t.Run("server not available", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.ListIndexTasks(ctx, &indexpb.ListIndexTasksRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	t.Run("list all", func(t *testing.T) {
+		resp, err := s.ListIndexTasks(ctx, &indexpb.ListIndexTasksRequest{})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		tasks := resp.GetTasks()
+		assert.Len(t, tasks, 3)
+		assert.Equal(t, buildID, tasks[0].GetTaskID())
+		assert.Equal(t, indexpb.JobType_JobTypeIndexJob, tasks[0].GetType())
+		assert.Equal(t, indexpb.JobState_JobStateRetry, tasks[0].GetState())
+		assert.EqualValues(t, 1, tasks[0].GetNodeID())
+		assert.Equal(t, collID, tasks[0].GetCollectionID())
+		assert.Equal(t, segID, tasks[0].GetSegmentID())
+		assert.EqualValues(t, 2, tasks[0].GetRetryTimes())
+		assert.Equal(t, "mock error", tasks[0].GetFailReason())
+		assert.NotZero(t, tasks[0].GetEnqueueTime())
+		assert.Equal(t, tasks[0].GetEnqueueTime(), tasks[0].GetTiming().GetEnqueueTime())
+		assert.Equal(t, analyzeID, tasks[2].GetTaskID())
+		assert.Equal(t, indexpb.JobType_JobTypeAnalyzeJob, tasks[2].GetType())
+		assert.Equal(t, collID, tasks[2].GetCollectionID())
+	})
+
+	t.Run("filter by collection and state", func(t *testing.T) {
+		resp, err := s.ListIndexTasks(ctx, &indexpb.ListIndexTasksRequest{CollectionID: collID})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Len(t, resp.GetTasks(), 2)
+
+		resp, err = s.ListIndexTasks(ctx, &indexpb.ListIndexTasksRequest{
+			CollectionID: collID,
+			States:       []indexpb.JobState{indexpb.JobState_JobStateInit},
+		})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Len(t, resp.GetTasks(), 1)
+		assert.Equal(t, analyzeID, resp.GetTasks()[0].GetTaskID())
+
+		resp, err = s.ListIndexTasks(ctx, &indexpb.ListIndexTasksRequest{
+			States: []indexpb.JobState{indexpb.JobState_JobStateFailed},
+		})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Empty(t, resp.GetTasks())
 	})
 }
 
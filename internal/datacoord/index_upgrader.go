@@ -0,0 +1,244 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// indexUpgradePolicyReindex rebuilds the outdated indexes instead of rewriting the segments.
+const indexUpgradePolicyReindex = "reindex"
+
+// indexUpgrader upgrades the segments indexed by the older index engine versions in background,
+// with one of the policies:
+//   - rewrite: the segments are rewritten by compaction, and indexed with the current version.
+//   - reindex: the outdated indexes are rebuilt with the current version, and swapped with the
+//     rebuilt ones once finished, the outdated ones keep serving until then.
+//
+// The upgrades are throttled to a limited number of segments in each round, and optionally limited
+// to an off-peak window, so the clusters converge to the new index format gradually without manual intervention.
+type indexUpgrader struct {
+	meta           *meta
+	versionManager IndexEngineVersionManager
+	rewriteSegment func(ctx context.Context, segment *SegmentInfo) error
+	rebuildIndex   func(ctx context.Context, outdated *model.SegmentIndex) error
+
+	// currentVersion is the current index engine version of the last round
+	currentVersion int32
+}
+
+func newIndexUpgrader(meta *meta, versionManager IndexEngineVersionManager,
+	rewriteSegment func(ctx context.Context, segment *SegmentInfo) error,
+	rebuildIndex func(ctx context.Context, outdated *model.SegmentIndex) error,
+) *indexUpgrader {
+	return &indexUpgrader{
+		meta:           meta,
+		versionManager: versionManager,
+		rewriteSegment: rewriteSegment,
+		rebuildIndex:   rebuildIndex,
+	}
+}
+
+// upgrade upgrades at most maxSegments segments with the outdated indexes in a round by the policy,
+// the older segments are upgraded first. It returns the number of the segments upgraded.
+func (u *indexUpgrader) upgrade(ctx context.Context, policy string, maxSegments int) int {
+	version := u.versionManager.GetCurrentIndexEngineVersion()
+	if version != u.currentVersion {
+		log.Ctx(ctx).Info("current index engine version changed, upgrade the outdated segment indexes",
+			zap.Int32("oldVersion", u.currentVersion), zap.Int32("newVersion", version))
+		u.currentVersion = version
+	}
+
+	rebuilding := u.meta.indexMeta.GetRebuildingBuilds()
+	segments := u.meta.SelectSegments(SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isFlush(segment) && !segment.isCompacting && !segment.GetIsImporting() &&
+			!segment.GetIsCorrupted() && segment.GetLevel() != datapb.SegmentLevel_L0 &&
+			len(u.outdatedIndexes(segment, version, rebuilding)) > 0
+	}))
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() < segments[j].GetID()
+	})
+
+	upgraded := 0
+	for _, segment := range segments {
+		if upgraded >= maxSegments {
+			break
+		}
+		var err error
+		if policy == indexUpgradePolicyReindex {
+			err = u.rebuildIndexes(ctx, segment, version, rebuilding)
+		} else {
+			err = u.rewriteSegment(ctx, segment)
+		}
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to upgrade the index of the segment, wait for the next round",
+				zap.Int64("segmentID", segment.GetID()), zap.String("policy", policy), zap.Error(err))
+			continue
+		}
+		upgraded++
+	}
+	if len(segments) > 0 {
+		log.Ctx(ctx).Info("segments are upgraded to the current index version", zap.Int32("version", version),
+			zap.String("policy", policy), zap.Int("outdated", len(segments)), zap.Int("upgraded", upgraded))
+	}
+	return upgraded
+}
+
+func (u *indexUpgrader) rebuildIndexes(ctx context.Context, segment *SegmentInfo, version int32, rebuilding typeutil.UniqueSet) error {
+	for _, segIdx := range u.outdatedIndexes(segment, version, rebuilding) {
+		if err := u.rebuildIndex(ctx, segIdx); err != nil {
+			return err
+		}
+		rebuilding.Insert(segIdx.BuildID)
+	}
+	return nil
+}
+
+// outdatedIndexes returns the indexes of the segment built by an older index engine version,
+// the ones being rebuilt are excluded.
+func (u *indexUpgrader) outdatedIndexes(segment *SegmentInfo, version int32, rebuilding typeutil.UniqueSet) []*model.SegmentIndex {
+	outdated := make([]*model.SegmentIndex, 0)
+	for _, segIdx := range u.meta.indexMeta.GetSegmentIndexes(segment.GetCollectionID(), segment.GetID()) {
+		if isOutdatedIndex(segIdx, version) && !rebuilding.Contain(segIdx.BuildID) {
+			outdated = append(outdated, segIdx)
+		}
+	}
+	sort.Slice(outdated, func(i, j int) bool {
+		return outdated[i].IndexID < outdated[j].IndexID
+	})
+	return outdated
+}
+
+func isOutdatedIndex(segIdx *model.SegmentIndex, version int32) bool {
+	return segIdx.IndexState == commonpb.IndexState_Finished && len(segIdx.IndexFileKeys) > 0 &&
+		segIdx.CurrentIndexVersion < version
+}
+
+// indexUpgradeProgress is the number of the built segment indexes of a collection in each upgrade state.
+type indexUpgradeProgress struct {
+	current    int
+	outdated   int
+	rebuilding int
+}
+
+// percentage returns the percentage of the segment indexes built by the current index engine version.
+func (p *indexUpgradeProgress) percentage() float64 {
+	total := p.current + p.outdated
+	if total == 0 {
+		return 100
+	}
+	return float64(p.current) * 100 / float64(total)
+}
+
+// reportProgress reports the upgrade progress of the flushed segments for each collection.
+func (u *indexUpgrader) reportProgress(ctx context.Context) map[int64]*indexUpgradeProgress {
+	version := u.currentVersion
+	rebuilding := u.meta.indexMeta.GetRebuildingBuilds()
+	progress := make(map[int64]*indexUpgradeProgress)
+	segments := u.meta.SelectSegments(SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isFlush(segment) && segment.GetLevel() != datapb.SegmentLevel_L0
+	}))
+	for _, segment := range segments {
+		for _, segIdx := range u.meta.indexMeta.GetSegmentIndexes(segment.GetCollectionID(), segment.GetID()) {
+			if segIdx.IndexState != commonpb.IndexState_Finished || len(segIdx.IndexFileKeys) == 0 {
+				continue
+			}
+			p, ok := progress[segment.GetCollectionID()]
+			if !ok {
+				p = &indexUpgradeProgress{}
+				progress[segment.GetCollectionID()] = p
+			}
+			switch {
+			case segIdx.CurrentIndexVersion >= version:
+				p.current++
+			case rebuilding.Contain(segIdx.BuildID):
+				p.outdated++
+				p.rebuilding++
+			default:
+				p.outdated++
+			}
+		}
+	}
+
+	metrics.DataCoordIndexUpgradeProgress.Reset()
+	for collectionID, p := range progress {
+		metrics.DataCoordIndexUpgradeProgress.WithLabelValues(fmt.Sprint(collectionID)).Set(p.percentage())
+		if p.outdated > 0 {
+			log.Ctx(ctx).Info("index upgrade progress of the collection", zap.Int64("collectionID", collectionID),
+				zap.Int32("version", version), zap.Int("current", p.current), zap.Int("outdated", p.outdated),
+				zap.Int("rebuilding", p.rebuilding), zap.Float64("percentage", p.percentage()))
+		}
+	}
+	return progress
+}
+
+// inIndexUpgradeWindow checks whether now is in the configured off-peak window of the upgrades,
+// the upgrades are not limited if the window is not configured.
+func inIndexUpgradeWindow(now time.Time) bool {
+	window := Params.DataCoordCfg.IndexUpgradeWindow.GetValue()
+	if window == "" {
+		return true
+	}
+	start, end, err := common.ParseDailyWindow(window)
+	if err != nil {
+		log.RatedWarn(60, "invalid index upgrade window, skip the upgrade", zap.String("window", window), zap.Error(err))
+		return false
+	}
+	return common.InCompactionWindow(start, end, now.Hour()*60+now.Minute())
+}
+
+func (s *Server) upgradeIndexLoop(ctx context.Context) {
+	log.Info("start upgrade index loop...")
+	defer s.serverLoopWg.Done()
+
+	upgrader := newIndexUpgrader(s.meta, s.indexEngineVersionManager, s.rewriteSegment, s.rebuildSegmentIndex)
+	ticker := time.NewTicker(Params.DataCoordCfg.IndexUpgradeInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("upgrade index loop exit")
+			return
+		case <-ticker.C:
+			if !Params.DataCoordCfg.IndexUpgradeEnabled.GetAsBool() {
+				continue
+			}
+			policy := Params.DataCoordCfg.IndexUpgradePolicy.GetValue()
+			// the rewrite policy upgrades the indexes by compaction
+			if policy != indexUpgradePolicyReindex && !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+				continue
+			}
+			if inIndexUpgradeWindow(time.Now()) {
+				upgrader.upgrade(ctx, policy, Params.DataCoordCfg.IndexUpgradeMaxSegments.GetAsInt())
+			}
+			upgrader.reportProgress(ctx)
+		}
+	}
+}
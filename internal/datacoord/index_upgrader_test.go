@@ -0,0 +1,159 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func Test_indexUpgrader_upgrade(t *testing.T) {
+	ctx := context.Background()
+	newUpgrader := func(t *testing.T, version int32) (*indexUpgrader, *[]int64, *[]int64) {
+		segments := NewSegmentsInfo()
+		segmentIndexes := make(map[UniqueID]map[UniqueID]*model.SegmentIndex)
+		addSegment := func(id int64, state commonpb.SegmentState, level datapb.SegmentLevel, indexVersion int32) {
+			segments.SetSegment(id, NewSegmentInfo(&datapb.SegmentInfo{
+				ID:           id,
+				CollectionID: collID,
+				PartitionID:  partID,
+				State:        state,
+				Level:        level,
+			}))
+			segmentIndexes[id] = map[UniqueID]*model.SegmentIndex{
+				indexID: {
+					SegmentID:           id,
+					BuildID:             id + 100,
+					CollectionID:        collID,
+					IndexID:             indexID,
+					IndexState:          commonpb.IndexState_Finished,
+					IndexFileKeys:       []string{"file"},
+					CurrentIndexVersion: indexVersion,
+				},
+			}
+		}
+		addSegment(segID+3, commonpb.SegmentState_Flushed, datapb.SegmentLevel_L1, 1)
+		addSegment(segID+1, commonpb.SegmentState_Flushed, datapb.SegmentLevel_L1, 1)
+		addSegment(segID+2, commonpb.SegmentState_Flushed, datapb.SegmentLevel_L1, 1)
+		// up to date
+		addSegment(segID+4, commonpb.SegmentState_Flushed, datapb.SegmentLevel_L1, 2)
+		// not flushed
+		addSegment(segID+5, commonpb.SegmentState_Growing, datapb.SegmentLevel_L1, 1)
+		// compacting
+		addSegment(segID+6, commonpb.SegmentState_Flushed, datapb.SegmentLevel_L1, 1)
+		segments.SetIsCompacting(segID+6, true)
+
+		mt := &meta{
+			segments: segments,
+			indexMeta: &indexMeta{
+				indexes: map[UniqueID]map[UniqueID]*model.Index{
+					collID: {indexID: {CollectionID: collID, FieldID: fieldID, IndexID: indexID}},
+				},
+				segmentIndexes:       segmentIndexes,
+				buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
+			},
+		}
+		versionManager := NewMockVersionManager(t)
+		versionManager.EXPECT().GetCurrentIndexEngineVersion().Return(version)
+		rewritten := make([]int64, 0)
+		rebuilt := make([]int64, 0)
+		upgrader := newIndexUpgrader(mt, versionManager, func(ctx context.Context, segment *SegmentInfo) error {
+			if segment.GetID() == segID+1 {
+				return errors.New("mock error")
+			}
+			rewritten = append(rewritten, segment.GetID())
+			return nil
+		}, func(ctx context.Context, outdated *model.SegmentIndex) error {
+			if outdated.SegmentID == segID+1 {
+				return errors.New("mock error")
+			}
+			rebuild := model.CloneSegmentIndex(outdated)
+			rebuild.BuildID = outdated.BuildID + 100
+			rebuild.ReplacedBuildID = outdated.BuildID
+			rebuild.IndexState = commonpb.IndexState_Unissued
+			mt.indexMeta.updateSegmentIndex(rebuild)
+			rebuilt = append(rebuilt, outdated.SegmentID)
+			return nil
+		})
+		return upgrader, &rewritten, &rebuilt
+	}
+
+	t.Run("throttled", func(t *testing.T) {
+		upgrader, rewritten, _ := newUpgrader(t, 2)
+		assert.Equal(t, 1, upgrader.upgrade(ctx, "rewrite", 1))
+		assert.Equal(t, []int64{segID + 2}, *rewritten)
+		assert.EqualValues(t, 2, upgrader.currentVersion)
+	})
+
+	t.Run("all outdated", func(t *testing.T) {
+		upgrader, rewritten, _ := newUpgrader(t, 2)
+		assert.Equal(t, 2, upgrader.upgrade(ctx, "rewrite", 10))
+		assert.Equal(t, []int64{segID + 2, segID + 3}, *rewritten)
+	})
+
+	t.Run("up to date", func(t *testing.T) {
+		upgrader, rewritten, _ := newUpgrader(t, 1)
+		assert.Equal(t, 0, upgrader.upgrade(ctx, "rewrite", 10))
+		assert.Empty(t, *rewritten)
+	})
+
+	t.Run("reindex", func(t *testing.T) {
+		upgrader, rewritten, rebuilt := newUpgrader(t, 2)
+		assert.Equal(t, 1, upgrader.upgrade(ctx, indexUpgradePolicyReindex, 1))
+		assert.Equal(t, []int64{segID + 2}, *rebuilt)
+		assert.Empty(t, *rewritten)
+
+		// the segment indexes being rebuilt are skipped
+		assert.Equal(t, 1, upgrader.upgrade(ctx, indexUpgradePolicyReindex, 10))
+		assert.Equal(t, []int64{segID + 2, segID + 3}, *rebuilt)
+
+		progress := upgrader.reportProgress(ctx)
+		assert.Len(t, progress, 1)
+		assert.Equal(t, 1, progress[collID].current)
+		assert.Equal(t, 4, progress[collID].outdated)
+		assert.Equal(t, 2, progress[collID].rebuilding)
+		assert.Equal(t, float64(20), progress[collID].percentage())
+	})
+}
+
+func Test_inIndexUpgradeWindow(t *testing.T) {
+	key := Params.DataCoordCfg.IndexUpgradeWindow.Key
+	defer paramtable.Get().Reset(key)
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.Local)
+	}
+
+	paramtable.Get().Save(key, "")
+	assert.True(t, inIndexUpgradeWindow(at(12, 0)))
+
+	paramtable.Get().Save(key, "22:00-06:00")
+	assert.True(t, inIndexUpgradeWindow(at(23, 30)))
+	assert.True(t, inIndexUpgradeWindow(at(5, 59)))
+	assert.False(t, inIndexUpgradeWindow(at(12, 0)))
+
+	paramtable.Get().Save(key, "invalid")
+	assert.False(t, inIndexUpgradeWindow(at(23, 30)))
+}
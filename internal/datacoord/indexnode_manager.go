@@ -18,8 +18,11 @@ package datacoord
 
 import (
 	"context"
+	"strconv"
 	"sync"
+	"time"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -37,15 +40,18 @@ type WorkerManager interface {
 	RemoveNode(nodeID UniqueID)
 	StoppingNode(nodeID UniqueID)
 	PickClient() (UniqueID, types.IndexNodeClient)
+	QuerySlots() map[UniqueID]*WorkerSlots
 	ClientSupportDisk() bool
 	GetAllClients() map[UniqueID]types.IndexNodeClient
 	GetClientByID(nodeID UniqueID) (types.IndexNodeClient, bool)
+	ReportResult(nodeID UniqueID, success bool)
 }
 
 // IndexNodeManager is used to manage the client of IndexNode.
 type IndexNodeManager struct {
 	nodeClients      map[UniqueID]types.IndexNodeClient
 	stoppingNodes    map[UniqueID]struct{}
+	nodeHealth       map[UniqueID]*nodeHealth
 	lock             lock.RWMutex
 	ctx              context.Context
 	indexNodeCreator indexNodeCreatorFunc
@@ -56,6 +62,7 @@ func NewNodeManager(ctx context.Context, indexNodeCreator indexNodeCreatorFunc)
 	return &IndexNodeManager{
 		nodeClients:      make(map[UniqueID]types.IndexNodeClient),
 		stoppingNodes:    make(map[UniqueID]struct{}),
+		nodeHealth:       make(map[UniqueID]*nodeHealth),
 		lock:             lock.RWMutex{},
 		ctx:              ctx,
 		indexNodeCreator: indexNodeCreator,
@@ -79,6 +86,7 @@ func (nm *IndexNodeManager) RemoveNode(nodeID UniqueID) {
 	defer nm.lock.Unlock()
 	delete(nm.nodeClients, nodeID)
 	delete(nm.stoppingNodes, nodeID)
+	delete(nm.nodeHealth, nodeID)
 	metrics.IndexNodeNum.WithLabelValues().Set(float64(len(nm.nodeClients)))
 }
 
@@ -120,7 +128,7 @@ func (nm *IndexNodeManager) PickClient() (UniqueID, types.IndexNodeClient) {
 	)
 
 	for nodeID, client := range nm.nodeClients {
-		if _, ok := nm.stoppingNodes[nodeID]; !ok {
+		if _, ok := nm.stoppingNodes[nodeID]; !ok && !nm.isQuarantined(nodeID) {
 			nodeID := nodeID
 			client := client
 			wg.Add(1)
@@ -159,6 +167,61 @@ func (nm *IndexNodeManager) PickClient() (UniqueID, types.IndexNodeClient) {
 	return 0, nil
 }
 
+// WorkerSlots is the free task slots and the resources in bytes of an IndexNode,
+// the capacity is 0 if the resource is not reported.
+type WorkerSlots struct {
+	NodeID          UniqueID
+	Client          types.IndexNodeClient
+	Slots           int64
+	MemoryCapacity  int64
+	AvailableMemory int64
+	DiskCapacity    int64
+	AvailableDisk   int64
+	// the gated CPU features the indexes built by the IndexNode require
+	CPUFeatures []string
+}
+
+// QuerySlots queries the free task slots of all the available IndexNodes,
+// the quarantined nodes and the nodes failed to respond are not included.
+func (nm *IndexNodeManager) QuerySlots() map[UniqueID]*WorkerSlots {
+	clients := nm.getAvailableClients()
+	ctx, cancel := context.WithTimeout(nm.ctx, reqTimeoutInterval)
+	defer cancel()
+
+	var (
+		slots     = make(map[UniqueID]*WorkerSlots, len(clients))
+		nodeMutex = sync.Mutex{}
+		wg        = sync.WaitGroup{}
+	)
+	for nodeID, client := range clients {
+		nodeID := nodeID
+		client := client
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.GetJobStats(ctx, &indexpb.GetJobStatsRequest{})
+			if err := merr.CheckRPCCall(resp, err); err != nil {
+				log.Warn("get IndexNode slots failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+				return
+			}
+			nodeMutex.Lock()
+			defer nodeMutex.Unlock()
+			slots[nodeID] = &WorkerSlots{
+				NodeID:          nodeID,
+				Client:          client,
+				Slots:           resp.GetTaskSlots(),
+				MemoryCapacity:  resp.GetMemoryCapacity(),
+				AvailableMemory: resp.GetAvailableMemory(),
+				DiskCapacity:    resp.GetDiskCapacity(),
+				AvailableDisk:   resp.GetAvailableDisk(),
+				CPUFeatures:     resp.GetCpuFeatures(),
+			}
+		}()
+	}
+	wg.Wait()
+	return slots
+}
+
 func (nm *IndexNodeManager) ClientSupportDisk() bool {
 	log.Debug("check if client support disk index")
 	allClients := nm.GetAllClients()
@@ -231,6 +294,90 @@ func (nm *IndexNodeManager) GetClientByID(nodeID UniqueID) (types.IndexNodeClien
 	return client, ok
 }
 
+// getAvailableClients returns the clients of the IndexNodes which are neither stopping nor quarantined.
+func (nm *IndexNodeManager) getAvailableClients() map[UniqueID]types.IndexNodeClient {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+
+	clients := make(map[UniqueID]types.IndexNodeClient, len(nm.nodeClients))
+	for nodeID, client := range nm.nodeClients {
+		if _, ok := nm.stoppingNodes[nodeID]; !ok && !nm.isQuarantined(nodeID) {
+			clients[nodeID] = client
+		}
+	}
+	return clients
+}
+
+// nodeHealth tracks the recent failed requests to an IndexNode to quarantine it.
+type nodeHealth struct {
+	failures        []time.Time
+	quarantineUntil time.Time
+	probation       bool
+}
+
+// ReportResult records the result of creating or querying the tasks on the IndexNode.
+// The node is quarantined if the requests fail as many times as the threshold within the failure window,
+// or fail once during the probation after the cool-down, and the passed request ends the probation.
+func (nm *IndexNodeManager) ReportResult(nodeID UniqueID, success bool) {
+	threshold := Params.DataCoordCfg.IndexNodeQuarantineFailureThreshold.GetAsInt()
+	if threshold <= 0 {
+		return
+	}
+
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+
+	if _, ok := nm.nodeClients[nodeID]; !ok {
+		return
+	}
+	health, ok := nm.nodeHealth[nodeID]
+	if !ok {
+		health = &nodeHealth{}
+		nm.nodeHealth[nodeID] = health
+	}
+	if success {
+		if health.probation {
+			log.Info("IndexNode passes the probation", zap.Int64("nodeID", nodeID))
+			health.probation = false
+		}
+		return
+	}
+
+	now := time.Now()
+	window := Params.DataCoordCfg.IndexNodeQuarantineFailureWindow.GetAsDuration(time.Second)
+	health.failures = append(lo.Filter(health.failures, func(t time.Time, _ int) bool {
+		return now.Sub(t) <= window
+	}), now)
+	if !health.probation && len(health.failures) < threshold {
+		return
+	}
+
+	coolDown := Params.DataCoordCfg.IndexNodeQuarantineCoolDown.GetAsDuration(time.Second)
+	log.Warn("quarantine the IndexNode for the repeated failures", zap.Int64("nodeID", nodeID),
+		zap.Int("failures", len(health.failures)), zap.Bool("probation", health.probation),
+		zap.Duration("coolDown", coolDown))
+	health.failures = nil
+	health.probation = false
+	health.quarantineUntil = now.Add(coolDown)
+	metrics.IndexNodeQuarantineCounter.WithLabelValues(strconv.FormatInt(nodeID, 10)).Inc()
+}
+
+// isQuarantined checks whether the IndexNode is quarantined, the node is put on probation once the cool-down expires.
+// The caller must hold the write lock.
+func (nm *IndexNodeManager) isQuarantined(nodeID UniqueID) bool {
+	health, ok := nm.nodeHealth[nodeID]
+	if !ok || health.quarantineUntil.IsZero() {
+		return false
+	}
+	if time.Now().Before(health.quarantineUntil) {
+		return true
+	}
+	log.Info("the cool-down of the quarantined IndexNode expires, put it on probation", zap.Int64("nodeID", nodeID))
+	health.quarantineUntil = time.Time{}
+	health.probation = true
+	return false
+}
+
 // indexNodeGetMetricsResponse record the metrics information of IndexNode.
 type indexNodeGetMetricsResponse struct {
 	resp *milvuspb.GetMetricsResponse
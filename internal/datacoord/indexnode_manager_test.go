@@ -19,6 +19,7 @@ package datacoord
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
@@ -29,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/util/lock"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func TestIndexNodeManager_AddNode(t *testing.T) {
@@ -96,6 +98,100 @@ func TestIndexNodeManager_PickClient(t *testing.T) {
 	})
 }
 
+func TestIndexNodeManager_QuerySlots(t *testing.T) {
+	getMockedGetJobStatsClient := func(resp *indexpb.GetJobStatsResponse, err error) types.IndexNodeClient {
+		ic := mocks.NewMockIndexNodeClient(t)
+		ic.EXPECT().GetJobStats(mock.Anything, mock.Anything, mock.Anything).Return(resp, err).Maybe()
+		return ic
+	}
+
+	err := errors.New("error")
+	nm := &IndexNodeManager{
+		ctx: context.Background(),
+		nodeClients: map[UniqueID]types.IndexNodeClient{
+			1: getMockedGetJobStatsClient(nil, err),
+			2: getMockedGetJobStatsClient(&indexpb.GetJobStatsResponse{
+				Status: merr.Status(err),
+			}, nil),
+			3: getMockedGetJobStatsClient(&indexpb.GetJobStatsResponse{
+				Status:    merr.Success(),
+				TaskSlots: 0,
+			}, nil),
+			4: getMockedGetJobStatsClient(&indexpb.GetJobStatsResponse{
+				Status:    merr.Success(),
+				TaskSlots: 3,
+			}, nil),
+			5: getMockedGetJobStatsClient(&indexpb.GetJobStatsResponse{
+				Status:    merr.Success(),
+				TaskSlots: 2,
+			}, nil),
+		},
+		stoppingNodes: map[UniqueID]struct{}{5: {}},
+	}
+
+	slots := nm.QuerySlots()
+	assert.Len(t, slots, 2)
+	assert.EqualValues(t, 0, slots[3].Slots)
+	assert.EqualValues(t, 3, slots[4].Slots)
+	assert.Equal(t, nm.nodeClients[4], slots[4].Client)
+}
+
+func TestIndexNodeManager_Quarantine(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.IndexNodeQuarantineFailureThreshold.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.IndexNodeQuarantineFailureThreshold.Key)
+
+	ic := mocks.NewMockIndexNodeClient(t)
+	ic.EXPECT().GetJobStats(mock.Anything, mock.Anything, mock.Anything).Return(&indexpb.GetJobStatsResponse{
+		Status:    merr.Success(),
+		TaskSlots: 1,
+	}, nil).Maybe()
+	nm := NewNodeManager(context.Background(), defaultIndexNodeCreatorFunc)
+	nm.setClient(1, ic)
+
+	t.Run("quarantine on repeated failures", func(t *testing.T) {
+		nm.ReportResult(1, false)
+		assert.Len(t, nm.QuerySlots(), 1)
+		nm.ReportResult(1, false)
+		assert.Len(t, nm.QuerySlots(), 0)
+		nodeID, _ := nm.PickClient()
+		assert.Zero(t, nodeID)
+
+		// still accessible to the assigned tasks
+		_, ok := nm.GetClientByID(1)
+		assert.True(t, ok)
+	})
+
+	t.Run("quarantine again on failure during probation", func(t *testing.T) {
+		nm.nodeHealth[1].quarantineUntil = time.Now().Add(-time.Second)
+		assert.Len(t, nm.QuerySlots(), 1)
+		assert.True(t, nm.nodeHealth[1].probation)
+
+		nm.ReportResult(1, false)
+		assert.Len(t, nm.QuerySlots(), 0)
+	})
+
+	t.Run("pass probation", func(t *testing.T) {
+		nm.nodeHealth[1].quarantineUntil = time.Now().Add(-time.Second)
+		nodeID, _ := nm.PickClient()
+		assert.EqualValues(t, 1, nodeID)
+
+		nm.ReportResult(1, true)
+		assert.False(t, nm.nodeHealth[1].probation)
+		nm.ReportResult(1, false)
+		assert.Len(t, nm.QuerySlots(), 1)
+	})
+
+	t.Run("never quarantine", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.IndexNodeQuarantineFailureThreshold.Key, "0")
+		nm.ReportResult(1, false)
+		nm.ReportResult(1, false)
+		assert.Len(t, nm.QuerySlots(), 1)
+	})
+
+	nm.RemoveNode(1)
+	assert.Empty(t, nm.nodeHealth)
+}
+
 func TestIndexNodeManager_ClientSupportDisk(t *testing.T) {
 	getMockedGetJobStatsClient := func(resp *indexpb.GetJobStatsResponse, err error) types.IndexNodeClient {
 		ic := mocks.NewMockIndexNodeClient(t)
@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datacoord/broker"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/segmentutil"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -50,9 +51,11 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type CompactionMeta interface {
+	GetCollection(collectionID UniqueID) *collectionInfo
 	GetSegment(segID UniqueID) *SegmentInfo
 	SelectSegments(filters ...SegmentFilter) []*SegmentInfo
 	GetHealthySegment(segID UniqueID) *SegmentInfo
@@ -76,18 +79,21 @@ type CompactionMeta interface {
 var _ CompactionMeta = (*meta)(nil)
 
 type meta struct {
-	lock.RWMutex
+	lock.NamedRWMutex
 	ctx          context.Context
 	catalog      metastore.DataCoordCatalog
-	collections  map[UniqueID]*collectionInfo // collection id to collection info
-	segments     *SegmentsInfo                // segment id to segment info
-	channelCPs   *channelCPs                  // vChannel -> channel checkpoint/see position
+	collections  *typeutil.ConcurrentMap[UniqueID, *collectionInfo] // collection id to collection info, read without the meta lock
+	segments     *SegmentsInfo                                      // segment id to segment info
+	channelCPs   *channelCPs                                        // vChannel -> channel checkpoint/see position
+	gcSafeTs     gcSafeTs                                           // collection id -> oldest timestamp safe from GC
 	chunkManager storage.ChunkManager
 
-	indexMeta          *indexMeta
-	analyzeMeta        *analyzeMeta
-	partitionStatsMeta *partitionStatsMeta
-	compactionTaskMeta *compactionTaskMeta
+	indexMeta            *indexMeta
+	analyzeMeta          *analyzeMeta
+	statsMeta            *statsMeta
+	partitionStatsMeta   *partitionStatsMeta
+	compactionTaskMeta   *compactionTaskMeta
+	segmentReferenceMeta *segmentReferenceMeta
 }
 
 func (m *meta) GetIndexMeta() *indexMeta {
@@ -117,6 +123,13 @@ func newChannelCps() *channelCPs {
 	}
 }
 
+// gcSafeTs tracks the oldest timestamp the snapshot reads of each collection are safe from,
+// i.e. no segment visible at or after the timestamp has been recycled by GC.
+type gcSafeTs struct {
+	lock.RWMutex
+	safeTs map[UniqueID]Timestamp
+}
+
 // A local cache of segment metric update. Must call commit() to take effect.
 type segMetricMutation struct {
 	stateChange       map[string]map[string]int // segment state, seg level -> state change count (to increase or decrease).
@@ -138,6 +151,12 @@ type collectionInfo struct {
 
 // NewMeta creates meta from provided `kv.TxnKV`
 func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManager storage.ChunkManager) (*meta, error) {
+	return newMetaWithWarmCache(ctx, catalog, chunkManager, nil)
+}
+
+// newMetaWithWarmCache creates meta with the segments taken from the warm cache if it catches up in time,
+// the segments are listed from the catalog otherwise.
+func newMetaWithWarmCache(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManager storage.ChunkManager, warmCache *metaWarmCache) (*meta, error) {
 	im, err := newIndexMeta(ctx, catalog)
 	if err != nil {
 		return nil, err
@@ -148,6 +167,11 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 		return nil, err
 	}
 
+	sm, err := newStatsMeta(ctx, catalog)
+	if err != nil {
+		return nil, err
+	}
+
 	psm, err := newPartitionStatsMeta(ctx, catalog)
 	if err != nil {
 		return nil, err
@@ -157,19 +181,27 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 	if err != nil {
 		return nil, err
 	}
+
+	srm, err := newSegmentReferenceMeta(ctx, catalog)
+	if err != nil {
+		return nil, err
+	}
 	mt := &meta{
-		ctx:                ctx,
-		catalog:            catalog,
-		collections:        make(map[UniqueID]*collectionInfo),
-		segments:           NewSegmentsInfo(),
-		channelCPs:         newChannelCps(),
-		indexMeta:          im,
-		analyzeMeta:        am,
-		chunkManager:       chunkManager,
-		partitionStatsMeta: psm,
-		compactionTaskMeta: ctm,
-	}
-	err = mt.reloadFromKV()
+		NamedRWMutex:         lock.NewNamedRWMutex("datacoord_meta"),
+		ctx:                  ctx,
+		catalog:              catalog,
+		collections:          typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
+		segments:             NewSegmentsInfo(),
+		channelCPs:           newChannelCps(),
+		indexMeta:            im,
+		analyzeMeta:          am,
+		statsMeta:            sm,
+		chunkManager:         chunkManager,
+		partitionStatsMeta:   psm,
+		compactionTaskMeta:   ctm,
+		segmentReferenceMeta: srm,
+	}
+	err = mt.reloadFromKV(warmCache)
 	if err != nil {
 		return nil, err
 	}
@@ -177,9 +209,9 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 }
 
 // reloadFromKV loads meta from KV storage
-func (m *meta) reloadFromKV() error {
+func (m *meta) reloadFromKV(warmCache *metaWarmCache) error {
 	record := timerecord.NewTimeRecorder("datacoord")
-	segments, err := m.catalog.ListSegments(m.ctx)
+	segments, err := m.listSegments(warmCache)
 	if err != nil {
 		return err
 	}
@@ -223,10 +255,32 @@ func (m *meta) reloadFromKV() error {
 		m.channelCPs.checkpoints[vChannel] = pos
 	}
 
+	safeTs, err := m.catalog.ListGcSafeTs(m.ctx)
+	if err != nil {
+		return err
+	}
+	m.gcSafeTs.safeTs = safeTs
+	for collectionID, ts := range safeTs {
+		metrics.DataCoordGcSafeTsUnixSeconds.WithLabelValues(fmt.Sprint(collectionID)).
+			Set(float64(tsoutil.PhysicalTime(ts).Unix()))
+	}
+
 	log.Info("DataCoord meta reloadFromKV done", zap.Duration("duration", record.ElapseSpan()))
 	return nil
 }
 
+// listSegments takes the segments from the warm cache, falls back to list the segments from the catalog.
+func (m *meta) listSegments(warmCache *metaWarmCache) ([]*datapb.SegmentInfo, error) {
+	if warmCache != nil {
+		segments, ok := warmCache.take(Params.DataCoordCfg.StandbyWarmCacheCatchUpTimeout.GetAsDuration(time.Second))
+		if ok {
+			return segments, nil
+		}
+		log.Warn("meta warm cache is not ready, list segments from the catalog")
+	}
+	return m.catalog.ListSegments(m.ctx)
+}
+
 func (m *meta) reloadCollectionsFromRootcoord(ctx context.Context, broker broker.Broker) error {
 	resp, err := broker.ListDatabases(ctx)
 	if err != nil {
@@ -267,29 +321,24 @@ func (m *meta) reloadCollectionsFromRootcoord(ctx context.Context, broker broker
 // Note that collection info is just for caching and will not be set into etcd from datacoord
 func (m *meta) AddCollection(collection *collectionInfo) {
 	log.Info("meta update: add collection", zap.Int64("collectionID", collection.ID))
-	m.Lock()
-	defer m.Unlock()
-	m.collections[collection.ID] = collection
-	metrics.DataCoordNumCollections.WithLabelValues().Set(float64(len(m.collections)))
+	m.collections.Insert(collection.ID, collection)
+	metrics.DataCoordNumCollections.WithLabelValues().Set(float64(m.collections.Len()))
 	log.Info("meta update: add collection - complete", zap.Int64("collectionID", collection.ID))
 }
 
 // DropCollection drop a collection from meta
 func (m *meta) DropCollection(collectionID int64) {
 	log.Info("meta update: drop collection", zap.Int64("collectionID", collectionID))
-	m.Lock()
-	defer m.Unlock()
-	delete(m.collections, collectionID)
+	m.collections.Remove(collectionID)
 	metrics.CleanupDataCoordWithCollectionID(collectionID)
-	metrics.DataCoordNumCollections.WithLabelValues().Set(float64(len(m.collections)))
+	metrics.DataCoordNumCollections.WithLabelValues().Set(float64(m.collections.Len()))
 	log.Info("meta update: drop collection - complete", zap.Int64("collectionID", collectionID))
 }
 
-// GetCollection returns collection info with provided collection id from local cache
+// GetCollection returns collection info with provided collection id from local cache,
+// the collections are read without the meta lock as they're looked up on the hot paths of the schedulers.
 func (m *meta) GetCollection(collectionID UniqueID) *collectionInfo {
-	m.RLock()
-	defer m.RUnlock()
-	collection, ok := m.collections[collectionID]
+	collection, ok := m.collections.Get(collectionID)
 	if !ok {
 		return nil
 	}
@@ -298,20 +347,11 @@ func (m *meta) GetCollection(collectionID UniqueID) *collectionInfo {
 
 // GetCollections returns collections from local cache
 func (m *meta) GetCollections() []*collectionInfo {
-	m.RLock()
-	defer m.RUnlock()
-	collections := make([]*collectionInfo, 0)
-	for _, coll := range m.collections {
-		collections = append(collections, coll)
-	}
-	return collections
+	return m.collections.Values()
 }
 
 func (m *meta) GetClonedCollectionInfo(collectionID UniqueID) *collectionInfo {
-	m.RLock()
-	defer m.RUnlock()
-
-	coll, ok := m.collections[collectionID]
+	coll, ok := m.collections.Get(collectionID)
 	if !ok {
 		return nil
 	}
@@ -384,6 +424,43 @@ func (m *meta) GetNumRowsOfCollection(collectionID UniqueID) int64 {
 	return m.getNumRowsOfCollectionUnsafe(collectionID)
 }
 
+// GetCollectionDataSummaries returns the summaries of the healthy segments of the collections,
+// the collection without any segment has an empty summary.
+func (m *meta) GetCollectionDataSummaries(collectionIDs []UniqueID) map[UniqueID]*datapb.CollectionDataSummary {
+	summaries := make(map[UniqueID]*datapb.CollectionDataSummary, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		summaries[collectionID] = &datapb.CollectionDataSummary{CollectionID: collectionID}
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+	for _, segment := range m.segments.GetSegments() {
+		summary, ok := summaries[segment.GetCollectionID()]
+		if !ok || !isSegmentHealthy(segment) {
+			continue
+		}
+		summary.NumRows += segment.GetNumOfRows()
+		summary.DataSize += segment.getSegmentSize()
+		summary.NumSegments++
+		summary.LastModifiedTimestamp = max(summary.LastModifiedTimestamp, getLastModifiedTimestamp(segment))
+	}
+	return summaries
+}
+
+// getLastModifiedTimestamp returns the latest timestamp of the data persisted in the segment,
+// or the checkpoint of the segment if it's not persisted yet.
+func getLastModifiedTimestamp(segment *SegmentInfo) uint64 {
+	ts := segment.GetDmlPosition().GetTimestamp()
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				ts = max(ts, binlog.GetTimestampTo())
+			}
+		}
+	}
+	return ts
+}
+
 func (m *meta) GetQuotaInfo() *metricsinfo.DataCoordQuotaMetrics {
 	info := &metricsinfo.DataCoordQuotaMetrics{}
 	m.RLock()
@@ -409,7 +486,7 @@ func (m *meta) GetQuotaInfo() *metricsinfo.DataCoordQuotaMetrics {
 			}
 			partBinlogSize[segment.GetPartitionID()] += segmentSize
 
-			coll, ok := m.collections[segment.GetCollectionID()]
+			coll, ok := m.collections.Get(segment.GetCollectionID())
 			if ok {
 				metrics.DataCoordStoredBinlogSize.WithLabelValues(coll.DatabaseName,
 					fmt.Sprint(segment.GetCollectionID()), fmt.Sprint(segment.GetID())).Set(float64(segmentSize))
@@ -431,7 +508,7 @@ func (m *meta) GetQuotaInfo() *metricsinfo.DataCoordQuotaMetrics {
 	metrics.DataCoordNumStoredRows.Reset()
 	for collectionID, statesRows := range collectionRowsNum {
 		for state, rows := range statesRows {
-			coll, ok := m.collections[collectionID]
+			coll, ok := m.collections.Get(collectionID)
 			if ok {
 				metrics.DataCoordNumStoredRows.WithLabelValues(coll.DatabaseName, fmt.Sprint(collectionID), state.String()).Set(float64(rows))
 			}
@@ -452,7 +529,7 @@ func (m *meta) GetCollectionIndexFilesSize() uint64 {
 	defer m.RUnlock()
 	var total uint64
 	for _, segmentIdx := range m.indexMeta.GetAllSegIndexes() {
-		coll, ok := m.collections[segmentIdx.CollectionID]
+		coll, ok := m.collections.Get(segmentIdx.CollectionID)
 		if ok {
 			metrics.DataCoordStoredIndexFilesSize.WithLabelValues(coll.DatabaseName,
 				fmt.Sprint(segmentIdx.CollectionID), fmt.Sprint(segmentIdx.SegmentID)).Set(float64(segmentIdx.IndexSize))
@@ -465,7 +542,7 @@ func (m *meta) GetCollectionIndexFilesSize() uint64 {
 func (m *meta) GetAllCollectionNumRows() map[int64]int64 {
 	m.RLock()
 	defer m.RUnlock()
-	ret := make(map[int64]int64, len(m.collections))
+	ret := make(map[int64]int64, m.collections.Len())
 	segments := m.segments.GetSegments()
 	for _, segment := range segments {
 		if isSegmentHealthy(segment) {
@@ -475,6 +552,21 @@ func (m *meta) GetAllCollectionNumRows() map[int64]int64 {
 	return ret
 }
 
+// GetAllChannelNumRows returns the current row count of the healthy segments of each insert channel,
+// including the rows of the growing segments not flushed yet.
+func (m *meta) GetAllChannelNumRows() map[string]int64 {
+	m.RLock()
+	defer m.RUnlock()
+	ret := make(map[string]int64)
+	segments := m.segments.GetSegments()
+	for _, segment := range segments {
+		if isSegmentHealthy(segment) {
+			ret[segment.GetInsertChannel()] += segment.currRows
+		}
+	}
+	return ret
+}
+
 // AddSegment records segment info, persisting info into kv store
 func (m *meta) AddSegment(ctx context.Context, segment *SegmentInfo) error {
 	log := log.Ctx(ctx)
@@ -512,7 +604,7 @@ func (m *meta) DropSegment(segmentID UniqueID) error {
 		return err
 	}
 	metrics.DataCoordNumSegments.WithLabelValues(segment.GetState().String(), segment.GetLevel().String()).Dec()
-	coll, ok := m.collections[segment.CollectionID]
+	coll, ok := m.collections.Get(segment.CollectionID)
 	if ok {
 		metrics.CleanupDataCoordSegmentMetrics(coll.DatabaseName, segment.CollectionID, segment.ID)
 	}
@@ -979,6 +1071,33 @@ func UpdateIsImporting(segmentID int64, isImporting bool) UpdateOperator {
 	}
 }
 
+func UpdateIsCorrupted(segmentID int64) UpdateOperator {
+	return func(modPack *updateSegmentPack) bool {
+		segment := modPack.Get(segmentID)
+		if segment == nil {
+			log.Warn("meta update: update isCorrupted failed - segment not found",
+				zap.Int64("segmentID", segmentID))
+			return false
+		}
+		segment.IsCorrupted = true
+		return true
+	}
+}
+
+// UpdateFieldStats sets the statistics of the fields computed by the stats job.
+func UpdateFieldStats(segmentID int64, fieldStats []*indexpb.FieldStats) UpdateOperator {
+	return func(modPack *updateSegmentPack) bool {
+		segment := modPack.Get(segmentID)
+		if segment == nil {
+			log.Warn("meta update: update field stats failed - segment not found",
+				zap.Int64("segmentID", segmentID))
+			return false
+		}
+		segment.FieldStats = fieldStats
+		return true
+	}
+}
+
 // updateSegmentsInfo update segment infos
 // will exec all operators, and update all changed segments
 func (m *meta) UpdateSegmentsInfo(operators ...UpdateOperator) error {
@@ -1622,6 +1741,27 @@ func (m *meta) GetCompactionTo(segmentID int64) (*SegmentInfo, bool) {
 	return m.segments.GetCompactionTo(segmentID)
 }
 
+// HasSegmentClones returns whether any segment in meta shares the insert binlogs of the segment.
+func (m *meta) HasSegmentClones(segmentID int64) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	return len(m.segments.GetClones(segmentID)) > 0
+}
+
+// IsInsertLogShared returns whether the insert binlog of the segment is still referenced by any cloned segment.
+func (m *meta) IsInsertLogShared(segmentID int64, logID int64) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, clone := range m.segments.GetClones(segmentID) {
+		if clone.IsInsertLogExists(logID) {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateChannelCheckpoint updates and saves channel checkpoint.
 func (m *meta) UpdateChannelCheckpoint(vChannel string, pos *msgpb.MsgPosition) error {
 	if pos == nil || pos.GetMsgID() == nil {
@@ -1734,6 +1874,51 @@ func (m *meta) GetChannelCheckpoints() map[string]*msgpb.MsgPosition {
 	return checkpoints
 }
 
+// GetGcSafeTs returns the oldest timestamp the snapshot reads of the collection are safe from,
+// 0 if no dropped segment of the collection has been recycled.
+func (m *meta) GetGcSafeTs(collectionID UniqueID) Timestamp {
+	m.gcSafeTs.RLock()
+	defer m.gcSafeTs.RUnlock()
+	return m.gcSafeTs.safeTs[collectionID]
+}
+
+// AdvanceGcSafeTs moves the GC safe timestamp of the collection forward to ts, it never moves backward.
+func (m *meta) AdvanceGcSafeTs(collectionID UniqueID, ts Timestamp) error {
+	m.gcSafeTs.Lock()
+	defer m.gcSafeTs.Unlock()
+	if ts <= m.gcSafeTs.safeTs[collectionID] {
+		return nil
+	}
+	err := m.catalog.SaveGcSafeTs(m.ctx, collectionID, ts)
+	if err != nil {
+		return err
+	}
+	if m.gcSafeTs.safeTs == nil {
+		m.gcSafeTs.safeTs = make(map[UniqueID]Timestamp)
+	}
+	m.gcSafeTs.safeTs[collectionID] = ts
+	metrics.DataCoordGcSafeTsUnixSeconds.WithLabelValues(fmt.Sprint(collectionID)).
+		Set(float64(tsoutil.PhysicalTime(ts).Unix()))
+	return nil
+}
+
+// DropGcSafeTs removes the GC safe timestamp of the collection once all its segments are recycled.
+func (m *meta) DropGcSafeTs(collectionID UniqueID) error {
+	m.gcSafeTs.Lock()
+	defer m.gcSafeTs.Unlock()
+	if _, ok := m.gcSafeTs.safeTs[collectionID]; !ok {
+		return nil
+	}
+	err := m.catalog.DropGcSafeTs(m.ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	delete(m.gcSafeTs.safeTs, collectionID)
+	metrics.DataCoordGcSafeTsUnixSeconds.DeleteLabelValues(fmt.Sprint(collectionID))
+	log.Info("DropGcSafeTs done", zap.Int64("collectionID", collectionID))
+	return nil
+}
+
 func (m *meta) GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool {
 	return m.catalog.GcConfirm(ctx, collectionID, partitionID)
 }
@@ -1834,10 +2019,12 @@ func updateSegStateAndPrepareMetrics(segToUpdate *SegmentInfo, targetState commo
 }
 
 func (m *meta) ListCollections() []int64 {
-	m.RLock()
-	defer m.RUnlock()
-
-	return lo.Keys(m.collections)
+	collectionIDs := make([]int64, 0, m.collections.Len())
+	m.collections.Range(func(collectionID UniqueID, _ *collectionInfo) bool {
+		collectionIDs = append(collectionIDs, collectionID)
+		return true
+	})
+	return collectionIDs
 }
 
 func (m *meta) DropCompactionTask(task *datapb.CompactionTask) error {
@@ -44,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/testutils"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // MetaReloadSuite tests meta reload & meta creation related logic
@@ -72,7 +73,9 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{}, nil)
 		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
 		suite.catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSegmentReferences(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 
 		_, err := newMeta(ctx, suite.catalog, nil)
@@ -87,7 +90,27 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{}, nil)
 		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
 		suite.catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSegmentReferences(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
+
+		_, err := newMeta(ctx, suite.catalog, nil)
+		suite.Error(err)
+	})
+
+	suite.Run("ListGcSafeTs_fail", func() {
+		defer suite.resetMock()
+
+		suite.catalog.EXPECT().ListSegments(mock.Anything).Return([]*datapb.SegmentInfo{}, nil)
+		suite.catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListGcSafeTs(mock.Anything).Return(nil, errors.New("mock"))
+		suite.catalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{}, nil)
+		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
+		suite.catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSegmentReferences(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 
 		_, err := newMeta(ctx, suite.catalog, nil)
@@ -99,7 +122,9 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{}, nil)
 		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
 		suite.catalog.EXPECT().ListAnalyzeTasks(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListSegmentReferences(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListSegments(mock.Anything).Return([]*datapb.SegmentInfo{
 			{
@@ -117,8 +142,11 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 			},
 		}, nil)
 
-		_, err := newMeta(ctx, suite.catalog, nil)
+		suite.catalog.EXPECT().ListGcSafeTs(mock.Anything).Return(map[int64]uint64{1: 1000}, nil)
+
+		m, err := newMeta(ctx, suite.catalog, nil)
 		suite.NoError(err)
+		suite.Equal(uint64(1000), m.GetGcSafeTs(1))
 
 		suite.MetricsEqual(metrics.DataCoordNumSegments.WithLabelValues(metrics.FlushedSegmentLabel, datapb.SegmentLevel_Legacy.String()), 1)
 	})
@@ -364,6 +392,15 @@ func (suite *MetaBasicSuite) TestSetSegment() {
 	})
 }
 
+// newCollectionInfos returns the collections cached by the test meta.
+func newCollectionInfos(collections map[UniqueID]*collectionInfo) *typeutil.ConcurrentMap[UniqueID, *collectionInfo] {
+	infos := typeutil.NewConcurrentMap[UniqueID, *collectionInfo]()
+	for collectionID, collection := range collections {
+		infos.Insert(collectionID, collection)
+	}
+	return infos
+}
+
 func TestMeta(t *testing.T) {
 	suite.Run(t, new(MetaBasicSuite))
 	suite.Run(t, new(MetaReloadSuite))
@@ -594,7 +631,7 @@ func TestMeta_Basic(t *testing.T) {
 		assert.Equal(t, int64(size0+size1), quotaInfo.CollectionBinlogSize[collID])
 		assert.Equal(t, int64(size0+size1), quotaInfo.TotalBinlogSize)
 
-		meta.collections[collID] = collInfo
+		meta.collections.Insert(collID, collInfo)
 		quotaInfo = meta.GetQuotaInfo()
 		assert.Len(t, quotaInfo.CollectionBinlogSize, 1)
 		assert.Equal(t, int64(size0+size1), quotaInfo.CollectionBinlogSize[collID])
@@ -606,12 +643,12 @@ func TestMeta_Basic(t *testing.T) {
 		ret := meta.GetCollectionIndexFilesSize()
 		assert.Equal(t, uint64(0), ret)
 
-		meta.collections = map[UniqueID]*collectionInfo{
+		meta.collections = newCollectionInfos(map[UniqueID]*collectionInfo{
 			100: {
 				ID:           100,
 				DatabaseName: "db",
 			},
-		}
+		})
 		ret = meta.GetCollectionIndexFilesSize()
 		assert.Equal(t, uint64(11), ret)
 	})
@@ -700,6 +737,36 @@ func TestUpdateSegmentsInfo(t *testing.T) {
 		assert.Equal(t, updated.NumOfRows, expected.NumOfRows)
 	})
 
+	t.Run("add binlogs idempotently", func(t *testing.T) {
+		meta, err := newMemoryMeta()
+		assert.NoError(t, err)
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID: 1, State: commonpb.SegmentState_Growing,
+			Binlogs: []*datapb.FieldBinlog{getFieldBinlogIDsWithEntry(1, 10, 1)},
+		}}
+		err = meta.AddSegment(context.TODO(), segment1)
+		assert.NoError(t, err)
+
+		// the binlogs of a retried sync are saved again
+		for i := 0; i < 2; i++ {
+			err = meta.UpdateSegmentsInfo(
+				AddBinlogsOperator(1,
+					[]*datapb.FieldBinlog{getFieldBinlogIDsWithEntry(1, 10, 2, 3)},
+					[]*datapb.FieldBinlog{getFieldBinlogIDs(1, 4)},
+					nil,
+				),
+				UpdateCheckPointOperator(1, []*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 30, Position: &msgpb.MsgPosition{Timestamp: 100}}}),
+			)
+			assert.NoError(t, err)
+		}
+
+		updated := meta.GetHealthySegment(1)
+		assert.ElementsMatch(t, []int64{1, 2, 3}, lo.Map(updated.GetBinlogs()[0].GetBinlogs(), func(l *datapb.Binlog, _ int) int64 { return l.GetLogID() }))
+		assert.Equal(t, 1, len(updated.GetStatslogs()[0].GetBinlogs()))
+		assert.EqualValues(t, 30, updated.GetNumOfRows())
+	})
+
 	t.Run("update compacted segment", func(t *testing.T) {
 		meta, err := newMemoryMeta()
 		assert.NoError(t, err)
@@ -1063,6 +1130,54 @@ func TestMeta_GetAllSegments(t *testing.T) {
 	assert.NotNil(t, seg2All)
 }
 
+func TestMeta_GetCollectionDataSummaries(t *testing.T) {
+	segments := NewSegmentsInfo()
+	segments.SetSegment(1, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           1,
+		CollectionID: 100,
+		State:        commonpb.SegmentState_Flushed,
+		NumOfRows:    100,
+		DmlPosition:  &msgpb.MsgPosition{Timestamp: 1000},
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{MemorySize: 1024, TimestampTo: 900}}},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{MemorySize: 64, TimestampTo: 2000}}},
+		},
+	}))
+	segments.SetSegment(2, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           2,
+		CollectionID: 100,
+		State:        commonpb.SegmentState_Growing,
+		NumOfRows:    10,
+		DmlPosition:  &msgpb.MsgPosition{Timestamp: 1500},
+	}))
+	segments.SetSegment(3, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           3,
+		CollectionID: 100,
+		State:        commonpb.SegmentState_Dropped,
+		NumOfRows:    1000,
+		DmlPosition:  &msgpb.MsgPosition{Timestamp: 3000},
+	}))
+	segments.SetSegment(4, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           4,
+		CollectionID: 200,
+		State:        commonpb.SegmentState_Flushed,
+		NumOfRows:    1000,
+	}))
+	m := &meta{segments: segments}
+
+	summaries := m.GetCollectionDataSummaries([]UniqueID{100, 300})
+	assert.Len(t, summaries, 2)
+	assert.Equal(t, int64(110), summaries[100].GetNumRows())
+	assert.Equal(t, int64(1088), summaries[100].GetDataSize())
+	assert.Equal(t, int64(2), summaries[100].GetNumSegments())
+	assert.Equal(t, uint64(2000), summaries[100].GetLastModifiedTimestamp())
+	// the collection without any segment
+	assert.Equal(t, int64(0), summaries[300].GetNumRows())
+	assert.Equal(t, uint64(0), summaries[300].GetLastModifiedTimestamp())
+}
+
 func TestMeta_isSegmentHealthy_issue17823_panic(t *testing.T) {
 	var seg *SegmentInfo
 
@@ -1161,7 +1276,7 @@ func Test_meta_GcConfirm(t *testing.T) {
 func Test_meta_ReloadCollectionsFromRootcoords(t *testing.T) {
 	t.Run("fail to list database", func(t *testing.T) {
 		m := &meta{
-			collections: make(map[UniqueID]*collectionInfo),
+			collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 		}
 		mockBroker := broker.NewMockBroker(t)
 		mockBroker.EXPECT().ListDatabases(mock.Anything).Return(nil, errors.New("list database failed, mocked"))
@@ -1171,7 +1286,7 @@ func Test_meta_ReloadCollectionsFromRootcoords(t *testing.T) {
 
 	t.Run("fail to show collections", func(t *testing.T) {
 		m := &meta{
-			collections: make(map[UniqueID]*collectionInfo),
+			collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 		}
 		mockBroker := broker.NewMockBroker(t)
 
@@ -1185,7 +1300,7 @@ func Test_meta_ReloadCollectionsFromRootcoords(t *testing.T) {
 
 	t.Run("fail to describe collection", func(t *testing.T) {
 		m := &meta{
-			collections: make(map[UniqueID]*collectionInfo),
+			collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 		}
 		mockBroker := broker.NewMockBroker(t)
 
@@ -1203,7 +1318,7 @@ func Test_meta_ReloadCollectionsFromRootcoords(t *testing.T) {
 
 	t.Run("fail to show partitions", func(t *testing.T) {
 		m := &meta{
-			collections: make(map[UniqueID]*collectionInfo),
+			collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 		}
 		mockBroker := broker.NewMockBroker(t)
 
@@ -1222,7 +1337,7 @@ func Test_meta_ReloadCollectionsFromRootcoords(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		m := &meta{
-			collections: make(map[UniqueID]*collectionInfo),
+			collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo](),
 		}
 		mockBroker := broker.NewMockBroker(t)
 
@@ -0,0 +1,268 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	kvdatacoord "github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// segmentKey identifies the segment the changed key of the meta store belongs to.
+type segmentKey struct {
+	collectionID int64
+	partitionID  int64
+	segmentID    int64
+}
+
+// metaWarmCache keeps the segments warm on the standby DataCoord, it lists the segments once and then replays
+// the changes of the segment and binlog keys watched from etcd, each changed segment is read again from the catalog.
+// On activation, the meta takes the segments once the cache catches up the current revision of etcd,
+// instead of the full scan of the meta store, which takes minutes on the large clusters.
+type metaWarmCache struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	etcdCli  *clientv3.Client
+	rootPath string
+	catalog  metastore.DataCoordCatalog
+
+	mu       sync.Mutex
+	segments map[int64]*datapb.SegmentInfo
+	revision int64 // the etcd revision the segments are replayed to, 0 if not warmed up
+	taken    bool
+
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func newMetaWarmCache(ctx context.Context, etcdCli *clientv3.Client, rootPath string, catalog metastore.DataCoordCatalog) *metaWarmCache {
+	ctx, cancel := context.WithCancel(ctx)
+	return &metaWarmCache{
+		ctx:      ctx,
+		cancel:   cancel,
+		etcdCli:  etcdCli,
+		rootPath: rootPath,
+		catalog:  catalog,
+	}
+}
+
+func (c *metaWarmCache) Start() {
+	c.wg.Add(1)
+	go c.run()
+	log.Info("meta warm cache started")
+}
+
+func (c *metaWarmCache) Close() {
+	c.closeOnce.Do(func() {
+		c.cancel()
+		c.wg.Wait()
+		log.Info("meta warm cache closed")
+	})
+}
+
+func (c *metaWarmCache) run() {
+	defer c.wg.Done()
+	for {
+		err := c.warmUp()
+		if err == nil {
+			err = c.replay()
+		}
+		if c.ctx.Err() != nil {
+			return
+		}
+		// the watch may be compacted or broken, warm up again from a full list
+		log.Warn("meta warm cache broken, warm up again", zap.Error(err))
+		c.mu.Lock()
+		c.segments = nil
+		c.revision = 0
+		c.mu.Unlock()
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (c *metaWarmCache) metaPrefix() string {
+	return path.Join(c.rootPath, kvdatacoord.MetaPrefix) + "/"
+}
+
+// currentRevision returns the current revision of etcd.
+func (c *metaWarmCache) currentRevision(ctx context.Context) (int64, error) {
+	resp, err := c.etcdCli.Get(ctx, c.metaPrefix(), clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return 0, err
+	}
+	return resp.Header.Revision, nil
+}
+
+// warmUp lists all the segments, the changes after the revision are replayed on them,
+// the changes already listed are replayed again harmlessly since each changed segment is read again.
+func (c *metaWarmCache) warmUp() error {
+	start := time.Now()
+	revision, err := c.currentRevision(c.ctx)
+	if err != nil {
+		return err
+	}
+	segments, err := c.catalog.ListSegments(c.ctx)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.segments = lo.SliceToMap(segments, func(segment *datapb.SegmentInfo) (int64, *datapb.SegmentInfo) {
+		return segment.GetID(), segment
+	})
+	c.revision = revision
+	log.Info("meta warm cache warmed up", zap.Int("segmentNum", len(segments)),
+		zap.Int64("revision", revision), zap.Duration("duration", time.Since(start)))
+	return nil
+}
+
+func (c *metaWarmCache) replay() error {
+	c.mu.Lock()
+	revision := c.revision
+	c.mu.Unlock()
+
+	watchCh := c.etcdCli.Watch(c.ctx, c.metaPrefix(), clientv3.WithPrefix(),
+		clientv3.WithRev(revision+1), clientv3.WithProgressNotify())
+	for resp := range watchCh {
+		if err := resp.Err(); err != nil {
+			return err
+		}
+		if resp.IsProgressNotify() {
+			c.advance(resp.Header.Revision)
+			continue
+		}
+
+		changed := make(map[segmentKey]struct{})
+		for _, event := range resp.Events {
+			if key, ok := c.parseSegmentKey(string(event.Kv.Key)); ok {
+				changed[key] = struct{}{}
+			}
+			revision = max(revision, event.Kv.ModRevision)
+		}
+		for key := range changed {
+			segment, err := c.catalog.GetSegment(c.ctx, key.collectionID, key.partitionID, key.segmentID)
+			if err != nil {
+				return err
+			}
+			c.mu.Lock()
+			if segment == nil {
+				delete(c.segments, key.segmentID)
+			} else {
+				c.segments[key.segmentID] = segment
+			}
+			c.mu.Unlock()
+		}
+		c.advance(revision)
+	}
+	return errors.New("meta watch channel closed")
+}
+
+func (c *metaWarmCache) advance(revision int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.revision = max(c.revision, revision)
+}
+
+// parseSegmentKey returns the segment of the segment key or binlog keys, false for the other keys.
+func (c *metaWarmCache) parseSegmentKey(key string) (segmentKey, bool) {
+	key = strings.TrimPrefix(key, c.rootPath+"/")
+	for _, prefix := range []string{
+		kvdatacoord.SegmentPrefix,
+		kvdatacoord.SegmentBinlogPathPrefix,
+		kvdatacoord.SegmentDeltalogPathPrefix,
+		kvdatacoord.SegmentStatslogPathPrefix,
+	} {
+		if !strings.HasPrefix(key, prefix+"/") {
+			continue
+		}
+		ids := strings.Split(strings.TrimPrefix(key, prefix+"/"), "/")
+		if len(ids) < 3 {
+			return segmentKey{}, false
+		}
+		var parsed [3]int64
+		for i := range parsed {
+			id, err := strconv.ParseInt(ids[i], 10, 64)
+			if err != nil {
+				return segmentKey{}, false
+			}
+			parsed[i] = id
+		}
+		return segmentKey{collectionID: parsed[0], partitionID: parsed[1], segmentID: parsed[2]}, true
+	}
+	return segmentKey{}, false
+}
+
+// take waits for the cache to replay the changes up to the current revision of etcd and returns the segments,
+// the cache is closed after. False is returned if the cache fails to catch up in the timeout or is taken already.
+func (c *metaWarmCache) take(timeout time.Duration) ([]*datapb.SegmentInfo, bool) {
+	defer c.Close()
+	ctx, cancel := context.WithTimeout(c.ctx, timeout)
+	defer cancel()
+
+	c.mu.Lock()
+	taken := c.taken
+	c.taken = true
+	c.mu.Unlock()
+	if taken {
+		return nil, false
+	}
+
+	target, err := c.currentRevision(ctx)
+	if err != nil {
+		log.Warn("failed to get the current revision for meta warm cache", zap.Error(err))
+		return nil, false
+	}
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		c.mu.Lock()
+		if c.revision >= target {
+			segments := lo.Values(c.segments)
+			c.mu.Unlock()
+			log.Info("meta warm cache caught up", zap.Int("segmentNum", len(segments)), zap.Int64("revision", target))
+			return segments, true
+		}
+		c.mu.Unlock()
+
+		// the progress notify advances the revision if no segment is changed
+		_ = c.etcdCli.RequestProgress(c.ctx)
+		select {
+		case <-ctx.Done():
+			log.Warn("meta warm cache failed to catch up", zap.Int64("targetRevision", target), zap.Error(ctx.Err()))
+			return nil, false
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/kv"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type MetaWarmCacheSuite struct {
+	suite.Suite
+
+	etcdCli  *clientv3.Client
+	rootPath string
+	metaKV   kv.MetaKv
+	catalog  *datacoord.Catalog
+}
+
+func (s *MetaWarmCacheSuite) SetupSuite() {
+	paramtable.Init()
+	var err error
+	s.etcdCli, err = etcd.GetEtcdClient(
+		Params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		Params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		Params.EtcdCfg.Endpoints.GetAsStrings(),
+		Params.EtcdCfg.EtcdTLSCert.GetValue(),
+		Params.EtcdCfg.EtcdTLSKey.GetValue(),
+		Params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		Params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	s.Require().NoError(err)
+	s.rootPath = "/test/meta/warm/cache"
+}
+
+func (s *MetaWarmCacheSuite) TearDownSuite() {
+	s.etcdCli.Close()
+}
+
+func (s *MetaWarmCacheSuite) SetupTest() {
+	s.metaKV = etcdkv.NewEtcdKV(s.etcdCli, s.rootPath)
+	s.metaKV.RemoveWithPrefix("")
+	s.catalog = datacoord.NewCatalog(s.metaKV, "", "")
+}
+
+func (s *MetaWarmCacheSuite) TearDownTest() {
+	s.metaKV.RemoveWithPrefix("")
+}
+
+func (s *MetaWarmCacheSuite) newSegment(segmentID int64) *datapb.SegmentInfo {
+	return &datapb.SegmentInfo{
+		ID:            segmentID,
+		CollectionID:  100,
+		PartitionID:   10,
+		InsertChannel: "ch",
+		State:         commonpb.SegmentState_Growing,
+		Binlogs: []*datapb.FieldBinlog{{
+			FieldID: 1,
+			Binlogs: []*datapb.Binlog{{LogID: segmentID*10 + 1, EntriesNum: 10, LogSize: 100, MemorySize: 100}},
+		}},
+	}
+}
+
+func (s *MetaWarmCacheSuite) TestReplay() {
+	ctx := context.Background()
+	s.NoError(s.catalog.AddSegment(ctx, s.newSegment(1)))
+	s.NoError(s.catalog.AddSegment(ctx, s.newSegment(2)))
+
+	cache := newMetaWarmCache(ctx, s.etcdCli, s.rootPath, s.catalog)
+	cache.Start()
+	s.Eventually(func() bool {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+		return cache.revision > 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// changes after the warm up are replayed
+	s.NoError(s.catalog.AddSegment(ctx, s.newSegment(11)))
+	s.NoError(s.catalog.DropSegment(ctx, s.newSegment(2)))
+	flushed := s.newSegment(1)
+	flushed.State = commonpb.SegmentState_Flushed
+	flushed.Binlogs[0].Binlogs = append(flushed.Binlogs[0].Binlogs,
+		&datapb.Binlog{LogID: 12, EntriesNum: 10, LogSize: 100, MemorySize: 100})
+	s.NoError(s.catalog.AlterSegments(ctx, []*datapb.SegmentInfo{flushed}, metastore.BinlogsIncrement{Segment: flushed}))
+
+	segments, ok := cache.take(10 * time.Second)
+	s.True(ok)
+	s.ElementsMatch([]int64{1, 11}, lo.Map(segments, func(segment *datapb.SegmentInfo, _ int) int64 {
+		return segment.GetID()
+	}))
+	listed, err := s.catalog.ListSegments(ctx)
+	s.NoError(err)
+	for _, expected := range listed {
+		actual, ok := lo.Find(segments, func(segment *datapb.SegmentInfo) bool {
+			return segment.GetID() == expected.GetID()
+		})
+		s.True(ok)
+		s.Equal(expected.GetState(), actual.GetState())
+		s.Equal(len(expected.GetBinlogs()[0].GetBinlogs()), len(actual.GetBinlogs()[0].GetBinlogs()))
+	}
+
+	// the cache is closed after taken
+	_, ok = cache.take(time.Second)
+	s.False(ok)
+}
+
+func (s *MetaWarmCacheSuite) TestTakeTimeout() {
+	cache := newMetaWarmCache(context.Background(), s.etcdCli, s.rootPath, s.catalog)
+	// not started, never catches up
+	_, ok := cache.take(200 * time.Millisecond)
+	s.False(ok)
+}
+
+func (s *MetaWarmCacheSuite) TestReloadMeta() {
+	ctx := context.Background()
+	s.NoError(s.catalog.AddSegment(ctx, s.newSegment(1)))
+
+	cache := newMetaWarmCache(ctx, s.etcdCli, s.rootPath, s.catalog)
+	cache.Start()
+	s.NoError(s.catalog.AddSegment(ctx, s.newSegment(2)))
+
+	m, err := newMetaWithWarmCache(ctx, s.catalog, nil, cache)
+	s.NoError(err)
+	s.NotNil(m.GetSegment(1))
+	s.NotNil(m.GetSegment(2))
+}
+
+func TestMetaWarmCache(t *testing.T) {
+	suite.Run(t, new(MetaWarmCacheSuite))
+}
+
+func TestMetaWarmCache_parseSegmentKey(t *testing.T) {
+	cache := &metaWarmCache{rootPath: "by-dev/meta"}
+	for _, prefix := range []string{
+		datacoord.SegmentPrefix,
+		datacoord.SegmentBinlogPathPrefix,
+		datacoord.SegmentDeltalogPathPrefix,
+		datacoord.SegmentStatslogPathPrefix,
+	} {
+		key, ok := cache.parseSegmentKey(fmt.Sprintf("by-dev/meta/%s/100/10/1/101", prefix))
+		assert.True(t, ok)
+		assert.Equal(t, segmentKey{collectionID: 100, partitionID: 10, segmentID: 1}, key)
+	}
+
+	_, ok := cache.parseSegmentKey("by-dev/meta/" + datacoord.ChannelCheckpointPrefix + "/ch")
+	assert.False(t, ok)
+	_, ok = cache.parseSegmentKey("by-dev/meta/" + datacoord.SegmentPrefix + "/100/10")
+	assert.False(t, ok)
+	_, ok = cache.parseSegmentKey("by-dev/meta/" + datacoord.SegmentPrefix + "/100/10/abc")
+	assert.False(t, ok)
+}
@@ -18,6 +18,8 @@ package datacoord
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
@@ -25,10 +27,12 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -79,6 +83,86 @@ func (s *Server) getCollectionMetrics(ctx context.Context) *metricsinfo.DataCoor
 	return ret
 }
 
+// getVectorStatsMetrics aggregates the vector statistics persisted in statslogs of the flushed segments of the collection.
+func (s *Server) getVectorStatsMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	collectionID, err := metricsinfo.ParseCollectionID(req.GetRequest())
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+
+	segments := s.meta.SelectSegments(WithCollection(collectionID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) && isFlushState(segment.GetState())
+	}))
+	paths := make([]string, 0)
+	for _, segment := range segments {
+		for _, fieldBinlog := range segment.GetStatslogs() {
+			for _, statslog := range fieldBinlog.GetBinlogs() {
+				if statslog.GetLogID() != int64(storage.VectorStatsType) {
+					continue
+				}
+				logPath := statslog.GetLogPath()
+				if logPath == "" {
+					logPath = metautil.BuildStatsLogPath(s.meta.chunkManager.RootPath(), segment.GetCollectionID(),
+						segment.GetPartitionID(), segment.GetID(), fieldBinlog.GetFieldID(), statslog.GetLogID())
+				}
+				paths = append(paths, logPath)
+			}
+		}
+	}
+
+	fields := make(map[int64]*storage.VectorFieldStats)
+	segmentNum := make(map[int64]int64)
+	if len(paths) > 0 {
+		values, err := s.meta.chunkManager.MultiRead(ctx, paths)
+		if err != nil {
+			return nil, err
+		}
+		for i, value := range values {
+			stats, err := storage.DeserializeVectorStats(value)
+			if err != nil {
+				log.Ctx(ctx).Warn("failed to deserialize vector stats log, skip it", zap.String("path", paths[i]), zap.Error(err))
+				continue
+			}
+			if _, ok := fields[stats.FieldID]; !ok {
+				fields[stats.FieldID] = storage.NewVectorFieldStats(stats.FieldID)
+			}
+			fields[stats.FieldID].Merge(stats)
+			segmentNum[stats.FieldID]++
+		}
+	}
+
+	ret := &metricsinfo.CollectionVectorStats{
+		CollectionID: collectionID,
+		Fields:       make([]*metricsinfo.VectorFieldStatsInfo, 0, len(fields)),
+	}
+	for fieldID, stats := range fields {
+		ret.Fields = append(ret.Fields, &metricsinfo.VectorFieldStatsInfo{
+			FieldID:    fieldID,
+			SegmentNum: segmentNum[fieldID],
+			RowNum:     stats.RowNum,
+			ZeroNum:    stats.ZeroNum,
+			InvalidNum: stats.InvalidNum,
+			MinNorm:    stats.MinNorm,
+			MaxNorm:    stats.MaxNorm,
+			MeanNorm:   stats.MeanNorm(),
+			StdDevNorm: stats.StdDevNorm(),
+		})
+	}
+	sort.Slice(ret.Fields, func(i, j int) bool {
+		return ret.Fields[i].FieldID < ret.Fields[j].FieldID
+	})
+
+	bs, err := json.Marshal(ret)
+	if err != nil {
+		return nil, err
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:        merr.Success(),
+		Response:      string(bs),
+		ComponentName: metricsinfo.ConstructComponentName(typeutil.DataCoordRole, paramtable.GetNodeID()),
+	}, nil
+}
+
 // getSystemInfoMetrics composes data cluster metrics
 func (s *Server) getSystemInfoMetrics(
 	ctx context.Context,
@@ -161,8 +245,9 @@ func (s *Server) getDataCoordMetrics(ctx context.Context) metricsinfo.DataCoordI
 		SystemConfigurations: metricsinfo.DataCoordConfiguration{
 			SegmentMaxSize: Params.DataCoordCfg.SegmentMaxSize.GetAsFloat(),
 		},
-		QuotaMetrics:      s.getQuotaMetrics(),
-		CollectionMetrics: s.getCollectionMetrics(ctx),
+		QuotaMetrics:        s.getQuotaMetrics(),
+		CollectionMetrics:   s.getCollectionMetrics(ctx),
+		ChannelWriteMetrics: s.channelWriteStats.GetMetrics(),
 	}
 
 	metricsinfo.FillDeployMetricsWithEnv(&ret.BaseComponentInfos.SystemInfo)
@@ -18,15 +18,21 @@ package datacoord
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -198,3 +204,54 @@ func TestGetIndexNodeMetrics(t *testing.T) {
 	assert.False(t, info.HasError)
 	assert.Equal(t, metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, 100), info.BaseComponentInfos.Name)
 }
+
+func TestGetVectorStatsMetrics(t *testing.T) {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	segments := NewSegmentsInfo()
+	writeStats := func(segmentID int64, stats *storage.VectorFieldStats, state commonpb.SegmentState) {
+		blob, err := storage.SerializeVectorStats(stats)
+		require.NoError(t, err)
+		path := metautil.BuildStatsLogPath(cm.RootPath(), 100, 10, segmentID, stats.FieldID, int64(storage.VectorStatsType))
+		require.NoError(t, cm.Write(ctx, path, blob.GetValue()))
+		segments.SetSegment(segmentID, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           segmentID,
+			CollectionID: 100,
+			PartitionID:  10,
+			State:        state,
+			Statslogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: int64(storage.CompoundStatsType)}}},
+				{FieldID: stats.FieldID, Binlogs: []*datapb.Binlog{{LogID: int64(storage.VectorStatsType)}}},
+			},
+		}))
+	}
+	writeStats(1, &storage.VectorFieldStats{FieldID: 101, RowNum: 2, MinNorm: 1, MaxNorm: 2, NormSum: 3, NormSquareSum: 5}, commonpb.SegmentState_Flushed)
+	writeStats(2, &storage.VectorFieldStats{FieldID: 101, RowNum: 2, InvalidNum: 1, MinNorm: 3, MaxNorm: 3, NormSum: 3, NormSquareSum: 9}, commonpb.SegmentState_Flushed)
+	writeStats(3, &storage.VectorFieldStats{FieldID: 102, RowNum: 1, ZeroNum: 1}, commonpb.SegmentState_Flushed)
+	// dropped segments are not counted
+	writeStats(4, &storage.VectorFieldStats{FieldID: 101, RowNum: 100}, commonpb.SegmentState_Dropped)
+
+	svr := &Server{meta: &meta{segments: segments, chunkManager: cm}}
+	req, err := metricsinfo.ConstructVectorStatsRequest(100)
+	require.NoError(t, err)
+	resp, err := svr.getVectorStatsMetrics(ctx, req)
+	require.NoError(t, err)
+
+	ret := &metricsinfo.CollectionVectorStats{}
+	require.NoError(t, json.Unmarshal([]byte(resp.GetResponse()), ret))
+	assert.EqualValues(t, 100, ret.CollectionID)
+	require.Len(t, ret.Fields, 2)
+	assert.EqualValues(t, 101, ret.Fields[0].FieldID)
+	assert.EqualValues(t, 2, ret.Fields[0].SegmentNum)
+	assert.EqualValues(t, 4, ret.Fields[0].RowNum)
+	assert.EqualValues(t, 1, ret.Fields[0].InvalidNum)
+	assert.EqualValues(t, 1, ret.Fields[0].MinNorm)
+	assert.EqualValues(t, 3, ret.Fields[0].MaxNorm)
+	assert.InDelta(t, 2, ret.Fields[0].MeanNorm, 1e-6)
+	assert.EqualValues(t, 102, ret.Fields[1].FieldID)
+	assert.EqualValues(t, 1, ret.Fields[1].ZeroNum)
+
+	// invalid request
+	_, err = svr.getVectorStatsMetrics(ctx, &milvuspb.GetMetricsRequest{Request: `{"metric_type": "vector_stats"}`})
+	assert.Error(t, err)
+}
@@ -263,6 +263,50 @@ func (_c *MockCompactionMeta_GetAnalyzeMeta_Call) RunAndReturn(run func() *analy
 	return _c
 }
 
+// GetCollection provides a mock function with given fields: collectionID
+func (_m *MockCompactionMeta) GetCollection(collectionID int64) *collectionInfo {
+	ret := _m.Called(collectionID)
+
+	var r0 *collectionInfo
+	if rf, ok := ret.Get(0).(func(int64) *collectionInfo); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*collectionInfo)
+		}
+	}
+
+	return r0
+}
+
+// MockCompactionMeta_GetCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollection'
+type MockCompactionMeta_GetCollection_Call struct {
+	*mock.Call
+}
+
+// GetCollection is a helper method to define mock.On call
+//   - collectionID int64
+func (_e *MockCompactionMeta_Expecter) GetCollection(collectionID interface{}) *MockCompactionMeta_GetCollection_Call {
+	return &MockCompactionMeta_GetCollection_Call{Call: _e.mock.On("GetCollection", collectionID)}
+}
+
+func (_c *MockCompactionMeta_GetCollection_Call) Run(run func(collectionID int64)) *MockCompactionMeta_GetCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCompactionMeta_GetCollection_Call) Return(_a0 *collectionInfo) *MockCompactionMeta_GetCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCompactionMeta_GetCollection_Call) RunAndReturn(run func(int64) *collectionInfo) *MockCompactionMeta_GetCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCompactionTaskMeta provides a mock function with given fields:
 func (_m *MockCompactionMeta) GetCompactionTaskMeta() *compactionTaskMeta {
 	ret := _m.Called()
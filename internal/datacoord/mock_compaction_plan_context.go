@@ -106,6 +106,50 @@ func (_c *MockCompactionPlanContext_getCompactionInfo_Call) RunAndReturn(run fun
 	return _c
 }
 
+// getCompactionPlanDetails provides a mock function with given fields: req
+func (_m *MockCompactionPlanContext) getCompactionPlanDetails(req *datapb.GetCompactionPlanDetailsRequest) []*datapb.CompactionPlanDetail {
+	ret := _m.Called(req)
+
+	var r0 []*datapb.CompactionPlanDetail
+	if rf, ok := ret.Get(0).(func(*datapb.GetCompactionPlanDetailsRequest) []*datapb.CompactionPlanDetail); ok {
+		r0 = rf(req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.CompactionPlanDetail)
+		}
+	}
+
+	return r0
+}
+
+// MockCompactionPlanContext_getCompactionPlanDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'getCompactionPlanDetails'
+type MockCompactionPlanContext_getCompactionPlanDetails_Call struct {
+	*mock.Call
+}
+
+// getCompactionPlanDetails is a helper method to define mock.On call
+//   - req *datapb.GetCompactionPlanDetailsRequest
+func (_e *MockCompactionPlanContext_Expecter) getCompactionPlanDetails(req interface{}) *MockCompactionPlanContext_getCompactionPlanDetails_Call {
+	return &MockCompactionPlanContext_getCompactionPlanDetails_Call{Call: _e.mock.On("getCompactionPlanDetails", req)}
+}
+
+func (_c *MockCompactionPlanContext_getCompactionPlanDetails_Call) Run(run func(req *datapb.GetCompactionPlanDetailsRequest)) *MockCompactionPlanContext_getCompactionPlanDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*datapb.GetCompactionPlanDetailsRequest))
+	})
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_getCompactionPlanDetails_Call) Return(_a0 []*datapb.CompactionPlanDetail) *MockCompactionPlanContext_getCompactionPlanDetails_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_getCompactionPlanDetails_Call) RunAndReturn(run func(*datapb.GetCompactionPlanDetailsRequest) []*datapb.CompactionPlanDetail) *MockCompactionPlanContext_getCompactionPlanDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // getCompactionTasksNumBySignalID provides a mock function with given fields: signalID
 func (_m *MockCompactionPlanContext) getCompactionTasksNumBySignalID(signalID int64) int {
 	ret := _m.Called(signalID)
@@ -148,6 +192,49 @@ func (_c *MockCompactionPlanContext_getCompactionTasksNumBySignalID_Call) RunAnd
 	return _c
 }
 
+// getDatabaseSlotUsage provides a mock function with given fields:
+func (_m *MockCompactionPlanContext) getDatabaseSlotUsage() map[string]int64 {
+	ret := _m.Called()
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func() map[string]int64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	return r0
+}
+
+// MockCompactionPlanContext_getDatabaseSlotUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'getDatabaseSlotUsage'
+type MockCompactionPlanContext_getDatabaseSlotUsage_Call struct {
+	*mock.Call
+}
+
+// getDatabaseSlotUsage is a helper method to define mock.On call
+func (_e *MockCompactionPlanContext_Expecter) getDatabaseSlotUsage() *MockCompactionPlanContext_getDatabaseSlotUsage_Call {
+	return &MockCompactionPlanContext_getDatabaseSlotUsage_Call{Call: _e.mock.On("getDatabaseSlotUsage")}
+}
+
+func (_c *MockCompactionPlanContext_getDatabaseSlotUsage_Call) Run(run func()) *MockCompactionPlanContext_getDatabaseSlotUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_getDatabaseSlotUsage_Call) Return(_a0 map[string]int64) *MockCompactionPlanContext_getDatabaseSlotUsage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_getDatabaseSlotUsage_Call) RunAndReturn(run func() map[string]int64) *MockCompactionPlanContext_getDatabaseSlotUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // isFull provides a mock function with given fields:
 func (_m *MockCompactionPlanContext) isFull() bool {
 	ret := _m.Called()
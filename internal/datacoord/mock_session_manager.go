@@ -155,6 +155,49 @@ func (_c *MockSessionManager_CheckHealth_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CheckNodeHealth provides a mock function with given fields: ctx, nodeID
+func (_m *MockSessionManager) CheckNodeHealth(ctx context.Context, nodeID int64) error {
+	ret := _m.Called(ctx, nodeID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, nodeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionManager_CheckNodeHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckNodeHealth'
+type MockSessionManager_CheckNodeHealth_Call struct {
+	*mock.Call
+}
+
+// CheckNodeHealth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+func (_e *MockSessionManager_Expecter) CheckNodeHealth(ctx interface{}, nodeID interface{}) *MockSessionManager_CheckNodeHealth_Call {
+	return &MockSessionManager_CheckNodeHealth_Call{Call: _e.mock.On("CheckNodeHealth", ctx, nodeID)}
+}
+
+func (_c *MockSessionManager_CheckNodeHealth_Call) Run(run func(ctx context.Context, nodeID int64)) *MockSessionManager_CheckNodeHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockSessionManager_CheckNodeHealth_Call) Return(_a0 error) *MockSessionManager_CheckNodeHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionManager_CheckNodeHealth_Call) RunAndReturn(run func(context.Context, int64) error) *MockSessionManager_CheckNodeHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *MockSessionManager) Close() {
 	_m.Called()
@@ -350,6 +393,49 @@ func (_c *MockSessionManager_DropImport_Call) RunAndReturn(run func(int64, *data
 	return _c
 }
 
+// ExportV2 provides a mock function with given fields: nodeID, in
+func (_m *MockSessionManager) ExportV2(nodeID int64, in *datapb.ExportRequest) error {
+	ret := _m.Called(nodeID, in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, *datapb.ExportRequest) error); ok {
+		r0 = rf(nodeID, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionManager_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockSessionManager_ExportV2_Call struct {
+	*mock.Call
+}
+
+// ExportV2 is a helper method to define mock.On call
+//   - nodeID int64
+//   - in *datapb.ExportRequest
+func (_e *MockSessionManager_Expecter) ExportV2(nodeID interface{}, in interface{}) *MockSessionManager_ExportV2_Call {
+	return &MockSessionManager_ExportV2_Call{Call: _e.mock.On("ExportV2", nodeID, in)}
+}
+
+func (_c *MockSessionManager_ExportV2_Call) Run(run func(nodeID int64, in *datapb.ExportRequest)) *MockSessionManager_ExportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(*datapb.ExportRequest))
+	})
+	return _c
+}
+
+func (_c *MockSessionManager_ExportV2_Call) Return(_a0 error) *MockSessionManager_ExportV2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionManager_ExportV2_Call) RunAndReturn(run func(int64, *datapb.ExportRequest) error) *MockSessionManager_ExportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Flush provides a mock function with given fields: ctx, nodeID, req
 func (_m *MockSessionManager) Flush(ctx context.Context, nodeID int64, req *datapb.FlushSegmentsRequest) {
 	_m.Called(ctx, nodeID, req)
@@ -807,6 +893,61 @@ func (_c *MockSessionManager_PreImport_Call) RunAndReturn(run func(int64, *datap
 	return _c
 }
 
+// QueryExport provides a mock function with given fields: nodeID, in
+func (_m *MockSessionManager) QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	ret := _m.Called(nodeID, in)
+
+	var r0 *datapb.QueryExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error)); ok {
+		return rf(nodeID, in)
+	}
+	if rf, ok := ret.Get(0).(func(int64, *datapb.QueryExportRequest) *datapb.QueryExportResponse); ok {
+		r0 = rf(nodeID, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.QueryExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, *datapb.QueryExportRequest) error); ok {
+		r1 = rf(nodeID, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSessionManager_QueryExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryExport'
+type MockSessionManager_QueryExport_Call struct {
+	*mock.Call
+}
+
+// QueryExport is a helper method to define mock.On call
+//   - nodeID int64
+//   - in *datapb.QueryExportRequest
+func (_e *MockSessionManager_Expecter) QueryExport(nodeID interface{}, in interface{}) *MockSessionManager_QueryExport_Call {
+	return &MockSessionManager_QueryExport_Call{Call: _e.mock.On("QueryExport", nodeID, in)}
+}
+
+func (_c *MockSessionManager_QueryExport_Call) Run(run func(nodeID int64, in *datapb.QueryExportRequest)) *MockSessionManager_QueryExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(*datapb.QueryExportRequest))
+	})
+	return _c
+}
+
+func (_c *MockSessionManager_QueryExport_Call) Return(_a0 *datapb.QueryExportResponse, _a1 error) *MockSessionManager_QueryExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSessionManager_QueryExport_Call) RunAndReturn(run func(int64, *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error)) *MockSessionManager_QueryExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // QueryImport provides a mock function with given fields: nodeID, in
 func (_m *MockSessionManager) QueryImport(nodeID int64, in *datapb.QueryImportRequest) (*datapb.QueryImportResponse, error) {
 	ret := _m.Called(nodeID, in)
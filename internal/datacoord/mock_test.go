@@ -330,6 +330,14 @@ func (c *mockDataNodeClient) DropImport(ctx context.Context, req *datapb.DropImp
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (c *mockDataNodeClient) ExportV2(ctx context.Context, req *datapb.ExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (c *mockDataNodeClient) QueryExport(ctx context.Context, req *datapb.QueryExportRequest, opts ...grpc.CallOption) (*datapb.QueryExportResponse, error) {
+	return &datapb.QueryExportResponse{Status: merr.Success()}, nil
+}
+
 func (c *mockDataNodeClient) QuerySlot(ctx context.Context, req *datapb.QuerySlotRequest, opts ...grpc.CallOption) (*datapb.QuerySlotResponse, error) {
 	return &datapb.QuerySlotResponse{Status: merr.Success()}, nil
 }
@@ -485,6 +493,22 @@ func (m *mockRootCoordClient) AlterDatabase(ctx context.Context, in *rootcoordpb
 	panic("not implemented") // TODO: Implement
 }
 
+func (m *mockRootCoordClient) GetDropCollectionProgress(ctx context.Context, in *rootcoordpb.GetDropCollectionProgressRequest, opts ...grpc.CallOption) (*rootcoordpb.GetDropCollectionProgressResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordClient) UpdatePartitionKeyStats(ctx context.Context, in *rootcoordpb.UpdatePartitionKeyStatsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordClient) GetPartitionKeyStats(ctx context.Context, in *rootcoordpb.GetPartitionKeyStatsRequest, opts ...grpc.CallOption) (*rootcoordpb.GetPartitionKeyStatsResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordClient) GetMetaSnapshot(ctx context.Context, in *rootcoordpb.GetMetaSnapshotRequest, opts ...grpc.CallOption) (*rootcoordpb.GetMetaSnapshotResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *mockRootCoordClient) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -648,6 +672,24 @@ func (t *mockCompactionTrigger) triggerManualCompaction(collectionID int64) (Uni
 	panic("not implemented")
 }
 
+func (t *mockCompactionTrigger) previewManualCompaction(collectionID int64, segmentIDs []int64) ([]*datapb.CompactionPlanPreview, error) {
+	if f, ok := t.methods["previewManualCompaction"]; ok {
+		if ff, ok := f.(func(collectionID int64, segmentIDs []int64) ([]*datapb.CompactionPlanPreview, error)); ok {
+			return ff(collectionID, segmentIDs)
+		}
+	}
+	panic("not implemented")
+}
+
+func (t *mockCompactionTrigger) executeCompactionPlans(collectionID int64, plans []*datapb.CompactionPlanPreview) (UniqueID, error) {
+	if f, ok := t.methods["executeCompactionPlans"]; ok {
+		if ff, ok := f.(func(collectionID int64, plans []*datapb.CompactionPlanPreview) (UniqueID, error)); ok {
+			return ff(collectionID, plans)
+		}
+	}
+	panic("not implemented")
+}
+
 func (t *mockCompactionTrigger) start() {
 	if f, ok := t.methods["start"]; ok {
 		if ff, ok := f.(func()); ok {
@@ -5,6 +5,7 @@ package datacoord
 import (
 	context "context"
 
+	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,6 +22,50 @@ func (_m *MockTriggerManager) EXPECT() *MockTriggerManager_Expecter {
 	return &MockTriggerManager_Expecter{mock: &_m.Mock}
 }
 
+// GetExpiryCompactions provides a mock function with given fields: collectionIDs
+func (_m *MockTriggerManager) GetExpiryCompactions(collectionIDs []int64) []*datapb.ExpiryCompactionInfo {
+	ret := _m.Called(collectionIDs)
+
+	var r0 []*datapb.ExpiryCompactionInfo
+	if rf, ok := ret.Get(0).(func([]int64) []*datapb.ExpiryCompactionInfo); ok {
+		r0 = rf(collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.ExpiryCompactionInfo)
+		}
+	}
+
+	return r0
+}
+
+// MockTriggerManager_GetExpiryCompactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpiryCompactions'
+type MockTriggerManager_GetExpiryCompactions_Call struct {
+	*mock.Call
+}
+
+// GetExpiryCompactions is a helper method to define mock.On call
+//   - collectionIDs []int64
+func (_e *MockTriggerManager_Expecter) GetExpiryCompactions(collectionIDs interface{}) *MockTriggerManager_GetExpiryCompactions_Call {
+	return &MockTriggerManager_GetExpiryCompactions_Call{Call: _e.mock.On("GetExpiryCompactions", collectionIDs)}
+}
+
+func (_c *MockTriggerManager_GetExpiryCompactions_Call) Run(run func(collectionIDs []int64)) *MockTriggerManager_GetExpiryCompactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockTriggerManager_GetExpiryCompactions_Call) Return(_a0 []*datapb.ExpiryCompactionInfo) *MockTriggerManager_GetExpiryCompactions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTriggerManager_GetExpiryCompactions_Call) RunAndReturn(run func([]int64) []*datapb.ExpiryCompactionInfo) *MockTriggerManager_GetExpiryCompactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ManualTrigger provides a mock function with given fields: ctx, collectionID, clusteringCompaction
 func (_m *MockTriggerManager) ManualTrigger(ctx context.Context, collectionID int64, clusteringCompaction bool) (int64, error) {
 	ret := _m.Called(ctx, collectionID, clusteringCompaction)
@@ -254,6 +254,49 @@ func (_c *MockWorkerManager_PickClient_Call) RunAndReturn(run func() (int64, typ
 	return _c
 }
 
+// QuerySlots provides a mock function with given fields:
+func (_m *MockWorkerManager) QuerySlots() map[int64]*WorkerSlots {
+	ret := _m.Called()
+
+	var r0 map[int64]*WorkerSlots
+	if rf, ok := ret.Get(0).(func() map[int64]*WorkerSlots); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]*WorkerSlots)
+		}
+	}
+
+	return r0
+}
+
+// MockWorkerManager_QuerySlots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuerySlots'
+type MockWorkerManager_QuerySlots_Call struct {
+	*mock.Call
+}
+
+// QuerySlots is a helper method to define mock.On call
+func (_e *MockWorkerManager_Expecter) QuerySlots() *MockWorkerManager_QuerySlots_Call {
+	return &MockWorkerManager_QuerySlots_Call{Call: _e.mock.On("QuerySlots")}
+}
+
+func (_c *MockWorkerManager_QuerySlots_Call) Run(run func()) *MockWorkerManager_QuerySlots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWorkerManager_QuerySlots_Call) Return(_a0 map[int64]*WorkerSlots) *MockWorkerManager_QuerySlots_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWorkerManager_QuerySlots_Call) RunAndReturn(run func() map[int64]*WorkerSlots) *MockWorkerManager_QuerySlots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveNode provides a mock function with given fields: nodeID
 func (_m *MockWorkerManager) RemoveNode(nodeID int64) {
 	_m.Called(nodeID)
@@ -287,6 +330,40 @@ func (_c *MockWorkerManager_RemoveNode_Call) RunAndReturn(run func(int64)) *Mock
 	return _c
 }
 
+// ReportResult provides a mock function with given fields: nodeID, success
+func (_m *MockWorkerManager) ReportResult(nodeID int64, success bool) {
+	_m.Called(nodeID, success)
+}
+
+// MockWorkerManager_ReportResult_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportResult'
+type MockWorkerManager_ReportResult_Call struct {
+	*mock.Call
+}
+
+// ReportResult is a helper method to define mock.On call
+//   - nodeID int64
+//   - success bool
+func (_e *MockWorkerManager_Expecter) ReportResult(nodeID interface{}, success interface{}) *MockWorkerManager_ReportResult_Call {
+	return &MockWorkerManager_ReportResult_Call{Call: _e.mock.On("ReportResult", nodeID, success)}
+}
+
+func (_c *MockWorkerManager_ReportResult_Call) Run(run func(nodeID int64, success bool)) *MockWorkerManager_ReportResult_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockWorkerManager_ReportResult_Call) Return() *MockWorkerManager_ReportResult_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockWorkerManager_ReportResult_Call) RunAndReturn(run func(int64, bool)) *MockWorkerManager_ReportResult_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StoppingNode provides a mock function with given fields: nodeID
 func (_m *MockWorkerManager) StoppingNode(nodeID int64) {
 	_m.Called(nodeID)
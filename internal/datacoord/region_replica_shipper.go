@@ -0,0 +1,680 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// regionReplicaShipper mirrors the collections of the primary region into the secondary region, whose querynodes
+// serve them as the read-only replicas. Each round the flushed segments of the primary region are shipped by copying
+// the binlogs and the finished index files into the local storage, the new delta logs of the shipped segments
+// are tailed, and the segments dropped by the primary region, e.g. the compacted ones, are dropped locally.
+// The collections should be created with the same names and fields in the secondary region before shipping.
+type regionReplicaShipper struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+
+	meta      *meta
+	handler   Handler
+	broker    broker.Broker
+	allocator allocator
+
+	primaryMeta    metastore.RootCoordCatalog
+	primaryCatalog metastore.DataCoordCatalog
+	primaryStorage storage.ChunkManager
+
+	mu       sync.RWMutex
+	shipped  map[int64]*datapb.ShippedSegment                // primary segment id -> shipped segment, nil if not loaded
+	statuses map[int64]*datapb.RegionReplicaCollectionStatus // primary collection id -> status
+}
+
+func newRegionReplicaShipper(ctx context.Context, meta *meta, handler Handler, broker broker.Broker, allocator allocator,
+	primaryMeta metastore.RootCoordCatalog, primaryCatalog metastore.DataCoordCatalog, primaryStorage storage.ChunkManager,
+) *regionReplicaShipper {
+	ctx, cancel := context.WithCancel(ctx)
+	return &regionReplicaShipper{
+		ctx:            ctx,
+		cancel:         cancel,
+		meta:           meta,
+		handler:        handler,
+		broker:         broker,
+		allocator:      allocator,
+		primaryMeta:    primaryMeta,
+		primaryCatalog: primaryCatalog,
+		primaryStorage: primaryStorage,
+		statuses:       make(map[int64]*datapb.RegionReplicaCollectionStatus),
+	}
+}
+
+func (s *regionReplicaShipper) Start() {
+	s.wg.Add(1)
+	go s.run()
+	log.Info("region replica shipper started")
+}
+
+func (s *regionReplicaShipper) Close() {
+	s.closeOnce.Do(func() {
+		s.cancel()
+		s.wg.Wait()
+		log.Info("region replica shipper closed")
+	})
+}
+
+func (s *regionReplicaShipper) run() {
+	defer s.wg.Done()
+	for {
+		s.ship(s.ctx)
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(Params.DataCoordCfg.RegionReplicaShipInterval.GetAsDuration(time.Second)):
+		}
+	}
+}
+
+// primarySnapshot is the meta of the primary region listed in a round, the segments and the segment indexes
+// are listed only for the collections mirrored by the secondary region.
+type primarySnapshot struct {
+	dbNames     map[int64]string
+	collections []*model.Collection
+	segments    map[int64][]*datapb.SegmentInfo // collection id -> segments
+	checkpoints map[string]*msgpb.MsgPosition
+	indexes     map[int64][]*model.Index        // collection id -> indexes
+	segIndexes  map[int64][]*model.SegmentIndex // segment id -> segment indexes
+}
+
+// shippedTs returns the ts before which the data written to the collection is flushed in the primary region,
+// which is the start of the oldest unflushed segment or the checkpoint of each channel.
+func (p *primarySnapshot) shippedTs(coll *model.Collection) uint64 {
+	shippedTs := uint64(math.MaxUint64)
+	for _, channel := range coll.VirtualChannelNames {
+		ts := p.checkpoints[channel].GetTimestamp()
+		for _, segment := range p.segments[coll.CollectionID] {
+			if segment.GetInsertChannel() != channel || segment.GetStartPosition() == nil {
+				continue
+			}
+			switch segment.GetState() {
+			case commonpb.SegmentState_Growing, commonpb.SegmentState_Sealed, commonpb.SegmentState_Flushing:
+				ts = min(ts, segment.GetStartPosition().GetTimestamp())
+			}
+		}
+		shippedTs = min(shippedTs, ts)
+	}
+	if shippedTs == math.MaxUint64 {
+		return 0
+	}
+	return shippedTs
+}
+
+func (s *regionReplicaShipper) listPrimary(ctx context.Context) (*primarySnapshot, error) {
+	snapshot := &primarySnapshot{
+		dbNames:    make(map[int64]string),
+		segments:   make(map[int64][]*datapb.SegmentInfo),
+		indexes:    make(map[int64][]*model.Index),
+		segIndexes: make(map[int64][]*model.SegmentIndex),
+	}
+	dbs, err := s.primaryMeta.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	for _, db := range dbs {
+		collections, err := s.primaryMeta.ListCollections(ctx, db.ID, typeutil.MaxTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.dbNames[db.ID] = db.Name
+		for _, coll := range collections {
+			if coll.Available() {
+				snapshot.collections = append(snapshot.collections, coll)
+			}
+		}
+	}
+	snapshot.checkpoints, err = s.primaryCatalog.ListChannelCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := s.primaryCatalog.ListIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indexes {
+		if !index.IsDeleted {
+			snapshot.indexes[index.CollectionID] = append(snapshot.indexes[index.CollectionID], index)
+		}
+	}
+	return snapshot, nil
+}
+
+// listPrimaryCollection lists the segments and the segment indexes of the mirrored collection into the snapshot.
+func (s *regionReplicaShipper) listPrimaryCollection(ctx context.Context, snapshot *primarySnapshot, collectionID int64) error {
+	segments, err := s.primaryCatalog.ListCollectionSegments(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	snapshot.segments[collectionID] = segments
+	segIndexes, err := s.primaryCatalog.ListCollectionSegmentIndexes(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	for _, segIdx := range segIndexes {
+		if !segIdx.IsDeleted && segIdx.IndexState == commonpb.IndexState_Finished {
+			snapshot.segIndexes[segIdx.SegmentID] = append(snapshot.segIndexes[segIdx.SegmentID], segIdx)
+		}
+	}
+	return nil
+}
+
+func (s *regionReplicaShipper) loadShipped(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.shipped != nil {
+		return nil
+	}
+	shipped, err := s.meta.catalog.ListShippedSegments(ctx)
+	if err != nil {
+		return err
+	}
+	s.shipped = lo.SliceToMap(shipped, func(segment *datapb.ShippedSegment) (int64, *datapb.ShippedSegment) {
+		return segment.GetPrimarySegmentID(), segment
+	})
+	return nil
+}
+
+// ship runs a round of shipping, which is skipped once the secondary region fails over to the primary.
+func (s *regionReplicaShipper) ship(ctx context.Context) {
+	if Params.CommonCfg.RegionReplicaRole.GetValue() != util.RegionReplicaRoleSecondary {
+		return
+	}
+	if err := s.loadShipped(ctx); err != nil {
+		log.Warn("failed to load the shipped segments", zap.Error(err))
+		return
+	}
+	snapshot, err := s.listPrimary(ctx)
+	if err != nil {
+		log.Warn("failed to list the meta of the primary region", zap.Error(err))
+		return
+	}
+
+	primaryCollections := typeutil.NewSet[int64]()
+	for _, coll := range snapshot.collections {
+		primaryCollections.Insert(coll.CollectionID)
+		dbName := snapshot.dbNames[coll.DBID]
+		target, err := s.shipCollection(ctx, snapshot, dbName, coll)
+		s.updateStatus(snapshot, dbName, coll, target, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for collectionID, status := range s.statuses {
+		if !primaryCollections.Contain(collectionID) {
+			delete(s.statuses, collectionID)
+			metrics.DataCoordRegionReplicaStalenessSeconds.DeleteLabelValues(fmt.Sprint(status.GetCollectionID()))
+		}
+	}
+}
+
+func (s *regionReplicaShipper) updateStatus(snapshot *primarySnapshot, dbName string, coll *model.Collection, target *shipTarget, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status, ok := s.statuses[coll.CollectionID]
+	if !ok {
+		status = &datapb.RegionReplicaCollectionStatus{
+			DbName:              dbName,
+			CollectionName:      coll.Name,
+			PrimaryCollectionID: coll.CollectionID,
+		}
+		s.statuses[coll.CollectionID] = status
+	}
+	if target != nil {
+		status.CollectionID = target.collectionID
+		status.ShippedSegments = int64(lo.CountBy(lo.Values(s.shipped), func(shipped *datapb.ShippedSegment) bool {
+			return shipped.GetCollectionID() == target.collectionID
+		}))
+	}
+	if err != nil {
+		log.Warn("failed to ship the collection from the primary region", zap.String("dbName", dbName),
+			zap.String("collectionName", coll.Name), zap.Int64("primaryCollectionID", coll.CollectionID), zap.Error(err))
+		status.Reason = err.Error()
+		return
+	}
+	status.Reason = ""
+	status.ShippedTs = max(status.GetShippedTs(), snapshot.shippedTs(coll))
+	if status.GetShippedTs() > 0 {
+		metrics.DataCoordRegionReplicaStalenessSeconds.WithLabelValues(fmt.Sprint(status.GetCollectionID())).
+			Set(time.Since(tsoutil.PhysicalTime(status.GetShippedTs())).Seconds())
+	}
+}
+
+// GetStatus returns the shipping status of the collections of the primary region.
+func (s *regionReplicaShipper) GetStatus() []*datapb.RegionReplicaCollectionStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	now := time.Now()
+	statuses := make([]*datapb.RegionReplicaCollectionStatus, 0, len(s.statuses))
+	for _, status := range s.statuses {
+		status = proto.Clone(status).(*datapb.RegionReplicaCollectionStatus)
+		if status.GetShippedTs() > 0 {
+			status.StalenessSeconds = int64(now.Sub(tsoutil.PhysicalTime(status.GetShippedTs())).Seconds())
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].GetPrimaryCollectionID() < statuses[j].GetPrimaryCollectionID()
+	})
+	return statuses
+}
+
+// shipTarget maps the primary collection to the local collection.
+type shipTarget struct {
+	collectionID int64
+	partitions   map[int64]int64               // primary partition id -> local partition id
+	channels     map[string]string             // primary channel -> local channel
+	positions    map[string]*msgpb.MsgPosition // local channel -> base position of the shipped segments
+	indexes      map[int64]*model.Index        // primary index id -> local index
+}
+
+// resolveTarget finds the local collection of the same name for the primary collection, the partitions are
+// mapped by names, the channels by orders and the indexes by names with the same build params.
+func (s *regionReplicaShipper) resolveTarget(ctx context.Context, snapshot *primarySnapshot, dbName string, coll *model.Collection) (*shipTarget, error) {
+	resp, err := s.broker.ShowCollections(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	idx := lo.IndexOf(resp.GetCollectionNames(), coll.Name)
+	if idx < 0 || idx >= len(resp.GetCollectionIds()) {
+		return nil, merr.WrapErrCollectionNotFoundWithDB(dbName, coll.Name, "collection is not created in the secondary region")
+	}
+	local, err := s.handler.GetCollection(ctx, resp.GetCollectionIds()[idx])
+	if err != nil {
+		return nil, err
+	}
+	if local == nil {
+		return nil, merr.WrapErrCollectionNotFound(resp.GetCollectionIds()[idx])
+	}
+	primary := &collectionInfo{
+		ID:            coll.CollectionID,
+		Schema:        &schemapb.CollectionSchema{Fields: model.MarshalFieldModels(coll.Fields)},
+		VChannelNames: coll.VirtualChannelNames,
+	}
+	if err := checkCloneCollections(primary, local); err != nil {
+		return nil, err
+	}
+
+	partitions, err := s.broker.ShowPartitions(ctx, local.ID)
+	if err != nil {
+		return nil, err
+	}
+	localPartitions := make(map[string]int64, len(partitions.GetPartitionNames()))
+	for i, name := range partitions.GetPartitionNames() {
+		if i < len(partitions.GetPartitionIDs()) {
+			localPartitions[name] = partitions.GetPartitionIDs()[i]
+		}
+	}
+
+	target := &shipTarget{
+		collectionID: local.ID,
+		partitions:   make(map[int64]int64),
+		channels:     make(map[string]string),
+		positions:    make(map[string]*msgpb.MsgPosition),
+		indexes:      make(map[int64]*model.Index),
+	}
+	for _, partition := range coll.Partitions {
+		if partitionID, ok := localPartitions[partition.PartitionName]; ok {
+			target.partitions[partition.PartitionID] = partitionID
+		}
+	}
+	for i, channel := range coll.VirtualChannelNames {
+		localChannel := local.VChannelNames[i]
+		pos := s.meta.GetChannelCheckpoint(localChannel)
+		if pos == nil {
+			pos = getCollectionStartPosition(localChannel, local)
+		}
+		if pos == nil {
+			return nil, merr.WrapErrChannelNotFound(localChannel, "no position to ship the segments")
+		}
+		target.channels[channel] = localChannel
+		target.positions[localChannel] = pos
+	}
+	for _, index := range snapshot.indexes[coll.CollectionID] {
+		localIndexes := s.meta.indexMeta.GetIndexesForCollection(local.ID, index.IndexName)
+		if len(localIndexes) > 0 && localIndexes[0].FieldID == index.FieldID && isSameBuildParams(index, localIndexes[0]) {
+			target.indexes[index.IndexID] = localIndexes[0]
+		}
+	}
+	return target, nil
+}
+
+func (s *regionReplicaShipper) shipCollection(ctx context.Context, snapshot *primarySnapshot, dbName string, coll *model.Collection) (*shipTarget, error) {
+	target, err := s.resolveTarget(ctx, snapshot, dbName, coll)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.listPrimaryCollection(ctx, snapshot, coll.CollectionID); err != nil {
+		return target, err
+	}
+	alive := typeutil.NewSet[int64]()
+	for _, segment := range snapshot.segments[coll.CollectionID] {
+		if segment.GetState() != commonpb.SegmentState_Flushed || segment.GetIsImporting() {
+			continue
+		}
+		alive.Insert(segment.GetID())
+		if err := s.shipSegment(ctx, snapshot, target, segment); err != nil {
+			return target, err
+		}
+	}
+	// the segments are dropped after the new segments are shipped, so the compacted segments are replaced
+	// by the results without a gap
+	for _, shipped := range s.listShipped(target.collectionID) {
+		if alive.Contain(shipped.GetPrimarySegmentID()) {
+			continue
+		}
+		if err := s.dropShipped(ctx, shipped); err != nil {
+			return target, err
+		}
+	}
+	return target, nil
+}
+
+func (s *regionReplicaShipper) getShipped(primarySegmentID int64) (*datapb.ShippedSegment, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	shipped, ok := s.shipped[primarySegmentID]
+	return shipped, ok
+}
+
+func (s *regionReplicaShipper) listShipped(collectionID int64) []*datapb.ShippedSegment {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return lo.Filter(lo.Values(s.shipped), func(shipped *datapb.ShippedSegment, _ int) bool {
+		return shipped.GetCollectionID() == collectionID
+	})
+}
+
+// shipSegment ships the flushed segment of the primary region, or tails the delta logs and the late finished
+// indexes if the segment is shipped already.
+func (s *regionReplicaShipper) shipSegment(ctx context.Context, snapshot *primarySnapshot, target *shipTarget, source *datapb.SegmentInfo) error {
+	shipped, ok := s.getShipped(source.GetID())
+	if ok {
+		if local := s.meta.GetSegment(shipped.GetSegmentID()); local != nil {
+			if !isSegmentHealthy(local) {
+				return nil
+			}
+			if err := s.tailDeltalogs(ctx, source, local); err != nil {
+				return err
+			}
+			return s.shipSegmentIndexes(ctx, snapshot, target, source, local.SegmentInfo)
+		}
+	}
+
+	partitionID := source.GetPartitionID()
+	if partitionID != common.AllPartitionsID {
+		if partitionID, ok = target.partitions[source.GetPartitionID()]; !ok {
+			return merr.WrapErrPartitionNotFound(source.GetPartitionID(), "partition is not created in the secondary region")
+		}
+	}
+	channel, ok := target.channels[source.GetInsertChannel()]
+	if !ok {
+		return merr.WrapErrChannelNotFound(source.GetInsertChannel())
+	}
+
+	if shipped == nil {
+		segmentID, err := s.allocator.allocID(ctx)
+		if err != nil {
+			return err
+		}
+		shipped = &datapb.ShippedSegment{
+			PrimarySegmentID: source.GetID(),
+			SegmentID:        segmentID,
+			CollectionID:     target.collectionID,
+		}
+		// the mapping is saved before the segment, the failed segment is shipped again with the same id
+		if err := s.meta.catalog.SaveShippedSegment(ctx, shipped); err != nil {
+			return err
+		}
+		s.mu.Lock()
+		s.shipped[source.GetID()] = shipped
+		s.mu.Unlock()
+	}
+
+	level := source.GetLevel()
+	// the partition stats of the primary region are not shipped
+	if level == datapb.SegmentLevel_L2 {
+		level = datapb.SegmentLevel_L1
+	}
+	basePos := target.positions[channel]
+	segment := &datapb.SegmentInfo{
+		ID:                  shipped.GetSegmentID(),
+		CollectionID:        target.collectionID,
+		PartitionID:         partitionID,
+		InsertChannel:       channel,
+		NumOfRows:           source.GetNumOfRows(),
+		State:               commonpb.SegmentState_Flushed,
+		MaxRowNum:           source.GetMaxRowNum(),
+		StartPosition:       cloneSegmentPosition(basePos, source.GetStartPosition()),
+		DmlPosition:         cloneSegmentPosition(basePos, source.GetDmlPosition()),
+		CreatedByCompaction: source.GetCreatedByCompaction(),
+		CompactionFrom:      s.mapShipped(source.GetCompactionFrom()),
+		Level:               level,
+		StorageVersion:      source.GetStorageVersion(),
+	}
+	for _, fieldStats := range source.GetFieldStats() {
+		segment.FieldStats = append(segment.FieldStats, proto.Clone(fieldStats).(*indexpb.FieldStats))
+	}
+	var err error
+	if segment.Binlogs, err = s.copyLogs(ctx, storage.InsertBinlog, source, segment, source.GetBinlogs()); err != nil {
+		return err
+	}
+	if segment.Statslogs, err = s.copyLogs(ctx, storage.StatsBinlog, source, segment, source.GetStatslogs()); err != nil {
+		return err
+	}
+	if segment.Deltalogs, err = s.copyLogs(ctx, storage.DeleteBinlog, source, segment, source.GetDeltalogs()); err != nil {
+		return err
+	}
+	// the indexes are added before the segment, otherwise the index inspector builds them again
+	if err := s.shipSegmentIndexes(ctx, snapshot, target, source, segment); err != nil {
+		return err
+	}
+	if err := s.meta.AddSegment(ctx, NewSegmentInfo(segment)); err != nil {
+		return err
+	}
+	log.Info("segment shipped from the primary region", zap.Int64("primarySegmentID", source.GetID()),
+		zap.Int64("segmentID", segment.GetID()), zap.Int64("collectionID", segment.GetCollectionID()),
+		zap.Int64("numRows", segment.GetNumOfRows()))
+	return nil
+}
+
+// mapShipped maps the primary segment ids to the shipped segment ids, the segments not shipped are omitted.
+func (s *regionReplicaShipper) mapShipped(primarySegmentIDs []int64) []int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return lo.FilterMap(primarySegmentIDs, func(id int64, _ int) (int64, bool) {
+		shipped, ok := s.shipped[id]
+		return shipped.GetSegmentID(), ok
+	})
+}
+
+// copyLogs copies the logs of the primary segment into the local storage under the shipped segment with the
+// same log ids, the copied binlogs are returned with empty paths as the meta keeps them.
+func (s *regionReplicaShipper) copyLogs(ctx context.Context, binlogType storage.BinlogType,
+	source, target *datapb.SegmentInfo, fieldBinlogs []*datapb.FieldBinlog,
+) ([]*datapb.FieldBinlog, error) {
+	buildPath := func(rootPath string, collectionID, partitionID, segmentID, fieldID, logID int64) string {
+		switch binlogType {
+		case storage.InsertBinlog:
+			return metautil.BuildInsertLogPath(rootPath, collectionID, partitionID, segmentID, fieldID, logID)
+		case storage.DeleteBinlog:
+			return metautil.BuildDeltaLogPath(rootPath, collectionID, partitionID, segmentID, logID)
+		default:
+			return metautil.BuildStatsLogPath(rootPath, collectionID, partitionID, segmentID, fieldID, logID)
+		}
+	}
+	// the paths decompressed by the catalog are under the local root path, so they are built again
+	collectionID, partitionID, segmentID := source.GetCollectionID(), source.GetPartitionID(), source.GetID()
+	if binlogType == storage.InsertBinlog {
+		collectionID, partitionID, segmentID = binlog.InsertLogOwner(source)
+	}
+
+	copied := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		fieldBinlog = proto.Clone(fieldBinlog).(*datapb.FieldBinlog)
+		for _, l := range fieldBinlog.GetBinlogs() {
+			srcPath := buildPath(s.primaryStorage.RootPath(), collectionID, partitionID, segmentID, fieldBinlog.GetFieldID(), l.GetLogID())
+			data, err := s.primaryStorage.Read(ctx, srcPath)
+			if err != nil {
+				log.Ctx(ctx).Warn("failed to read the log to ship", zap.String("path", srcPath), zap.Error(err))
+				return nil, err
+			}
+			dstPath := buildPath(s.meta.chunkManager.RootPath(), target.GetCollectionID(), target.GetPartitionID(),
+				target.GetID(), fieldBinlog.GetFieldID(), l.GetLogID())
+			if err := s.meta.chunkManager.Write(ctx, dstPath, data); err != nil {
+				return nil, err
+			}
+			l.LogPath = ""
+		}
+		copied = append(copied, fieldBinlog)
+	}
+	return copied, nil
+}
+
+// tailDeltalogs ships the delta logs appended to the primary segment after it was shipped.
+func (s *regionReplicaShipper) tailDeltalogs(ctx context.Context, source *datapb.SegmentInfo, local *SegmentInfo) error {
+	localLogs := typeutil.NewSet[int64]()
+	for _, fieldBinlog := range local.GetDeltalogs() {
+		for _, l := range fieldBinlog.GetBinlogs() {
+			localLogs.Insert(l.GetLogID())
+		}
+	}
+	newLogs := make([]*datapb.FieldBinlog, 0)
+	for _, fieldBinlog := range source.GetDeltalogs() {
+		binlogs := lo.Filter(fieldBinlog.GetBinlogs(), func(l *datapb.Binlog, _ int) bool {
+			return !localLogs.Contain(l.GetLogID())
+		})
+		if len(binlogs) > 0 {
+			newLogs = append(newLogs, &datapb.FieldBinlog{FieldID: fieldBinlog.GetFieldID(), Binlogs: binlogs})
+		}
+	}
+	if len(newLogs) == 0 {
+		return nil
+	}
+	deltalogs, err := s.copyLogs(ctx, storage.DeleteBinlog, source, local.SegmentInfo, newLogs)
+	if err != nil {
+		return err
+	}
+	if err := s.meta.UpdateSegmentsInfo(AddBinlogsOperator(local.GetID(), nil, nil, deltalogs)); err != nil {
+		return err
+	}
+	log.Info("delta logs shipped from the primary region", zap.Int64("primarySegmentID", source.GetID()),
+		zap.Int64("segmentID", local.GetID()), zap.Int("deltalogNum", len(deltalogs)))
+	return nil
+}
+
+// shipSegmentIndexes ships the finished indexes of the primary segment matched with the local indexes,
+// the index files are copied under a new build of the shipped segment. The indexes built locally are kept.
+func (s *regionReplicaShipper) shipSegmentIndexes(ctx context.Context, snapshot *primarySnapshot, target *shipTarget,
+	source *datapb.SegmentInfo, segment *datapb.SegmentInfo,
+) error {
+	existing := s.meta.indexMeta.GetSegmentIndexes(segment.GetCollectionID(), segment.GetID())
+	for _, segIdx := range snapshot.segIndexes[source.GetID()] {
+		index, ok := target.indexes[segIdx.IndexID]
+		if !ok {
+			continue
+		}
+		if _, ok := existing[index.IndexID]; ok {
+			continue
+		}
+		buildID, err := s.allocator.allocID(ctx)
+		if err != nil {
+			return err
+		}
+		filesSource := getIndexFilesSource(segIdx)
+		for _, key := range segIdx.IndexFileKeys {
+			srcPath := metautil.BuildSegmentIndexFilePath(s.primaryStorage.RootPath(), filesSource.GetBuildID(),
+				filesSource.GetIndexVersion(), filesSource.GetPartitionID(), filesSource.GetSegmentID(), key)
+			data, err := s.primaryStorage.Read(ctx, srcPath)
+			if err != nil {
+				log.Ctx(ctx).Warn("failed to read the index file to ship", zap.String("path", srcPath), zap.Error(err))
+				return err
+			}
+			dstPath := metautil.BuildSegmentIndexFilePath(s.meta.chunkManager.RootPath(), buildID,
+				segIdx.IndexVersion, segment.GetPartitionID(), segment.GetID(), key)
+			if err := s.meta.chunkManager.Write(ctx, dstPath, data); err != nil {
+				return err
+			}
+		}
+		err = s.meta.indexMeta.AddShippedSegmentIndex(&model.SegmentIndex{
+			SegmentID:    segment.GetID(),
+			CollectionID: segment.GetCollectionID(),
+			PartitionID:  segment.GetPartitionID(),
+			IndexID:      index.IndexID,
+			BuildID:      buildID,
+			CreateTime:   segIdx.CreateTime,
+		}, segIdx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropShipped drops the shipped segment whose primary segment is dropped.
+func (s *regionReplicaShipper) dropShipped(ctx context.Context, shipped *datapb.ShippedSegment) error {
+	if segment := s.meta.GetHealthySegment(shipped.GetSegmentID()); segment != nil {
+		if err := s.meta.UpdateSegmentsInfo(UpdateStatusOperator(shipped.GetSegmentID(), commonpb.SegmentState_Dropped)); err != nil {
+			return err
+		}
+	}
+	if err := s.meta.catalog.DropShippedSegment(ctx, shipped.GetPrimarySegmentID()); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	delete(s.shipped, shipped.GetPrimarySegmentID())
+	s.mu.Unlock()
+	log.Info("shipped segment dropped as the primary region dropped it",
+		zap.Int64("primarySegmentID", shipped.GetPrimarySegmentID()), zap.Int64("segmentID", shipped.GetSegmentID()))
+	return nil
+}
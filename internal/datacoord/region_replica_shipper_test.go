@@ -0,0 +1,209 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestRegionReplicaShipper(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().CommonCfg.RegionReplicaRole.Key, util.RegionReplicaRoleSecondary)
+	defer paramtable.Get().Reset(paramtable.Get().CommonCfg.RegionReplicaRole.Key)
+
+	ctx := context.Background()
+	primaryStorage := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+
+	// the primary region has the collection 100 with a flushed segment, a growing segment and a dropped segment
+	primaryMeta := mocks.NewRootCoordCatalog(t)
+	primaryMeta.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return([]*model.Database{{ID: 1, Name: "default"}}, nil)
+	primaryMeta.EXPECT().ListCollections(mock.Anything, int64(1), mock.Anything).Return([]*model.Collection{
+		{
+			CollectionID:        100,
+			DBID:                1,
+			Name:                "coll",
+			Fields:              model.UnmarshalFieldModels(newTestSchema().GetFields()),
+			Partitions:          []*model.Partition{{PartitionID: 10, PartitionName: "_default"}},
+			VirtualChannelNames: []string{"ch-100"},
+			State:               pb.CollectionState_CollectionCreated,
+		},
+		{
+			CollectionID:        101,
+			DBID:                1,
+			Name:                "missing",
+			Fields:              model.UnmarshalFieldModels(newTestSchema().GetFields()),
+			VirtualChannelNames: []string{"ch-101"},
+			State:               pb.CollectionState_CollectionCreated,
+		},
+	}, nil)
+
+	flushed := &datapb.SegmentInfo{
+		ID:            1,
+		CollectionID:  100,
+		PartitionID:   10,
+		InsertChannel: "ch-100",
+		State:         commonpb.SegmentState_Flushed,
+		Level:         datapb.SegmentLevel_L1,
+		NumOfRows:     100,
+		StartPosition: &msgpb.MsgPosition{ChannelName: "ch-100", Timestamp: 100},
+		DmlPosition:   &msgpb.MsgPosition{ChannelName: "ch-100", Timestamp: 200},
+		Binlogs:       []*datapb.FieldBinlog{getFieldBinlogIDs(2, 1001)},
+		Statslogs:     []*datapb.FieldBinlog{getFieldBinlogIDs(2, 3001)},
+		Deltalogs:     []*datapb.FieldBinlog{getFieldBinlogIDs(0, 2001)},
+	}
+	require.NoError(t, primaryStorage.Write(ctx, metautil.BuildInsertLogPath(primaryStorage.RootPath(), 100, 10, 1, 2, 1001), []byte("insert")))
+	require.NoError(t, primaryStorage.Write(ctx, metautil.BuildStatsLogPath(primaryStorage.RootPath(), 100, 10, 1, 2, 3001), []byte("stats")))
+	require.NoError(t, primaryStorage.Write(ctx, metautil.BuildDeltaLogPath(primaryStorage.RootPath(), 100, 10, 1, 2001), []byte("delta")))
+	require.NoError(t, primaryStorage.Write(ctx, metautil.BuildDeltaLogPath(primaryStorage.RootPath(), 100, 10, 1, 2002), []byte("delta")))
+	require.NoError(t, primaryStorage.Write(ctx, metautil.BuildSegmentIndexFilePath(primaryStorage.RootPath(), 3000, 1, 10, 1, "file"), []byte("index")))
+
+	primaryCatalog := mocks.NewDataCoordCatalog(t)
+	// the segments of the collection 101 not mirrored are never listed
+	primaryCatalog.EXPECT().ListCollectionSegments(mock.Anything, int64(100)).RunAndReturn(func(ctx context.Context, collectionID int64) ([]*datapb.SegmentInfo, error) {
+		return []*datapb.SegmentInfo{
+			flushed,
+			{ID: 2, CollectionID: 100, PartitionID: 10, InsertChannel: "ch-100", State: commonpb.SegmentState_Growing, StartPosition: &msgpb.MsgPosition{Timestamp: 800}},
+			{ID: 3, CollectionID: 100, PartitionID: 10, InsertChannel: "ch-100", State: commonpb.SegmentState_Dropped},
+		}, nil
+	})
+	primaryCatalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(map[string]*msgpb.MsgPosition{
+		"ch-100": {ChannelName: "ch-100", Timestamp: 1000},
+	}, nil)
+	indexParams := []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}}
+	primaryCatalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{
+		{CollectionID: 100, FieldID: 2, IndexID: 1000, IndexName: "idx", IndexParams: indexParams},
+	}, nil)
+	primaryCatalog.EXPECT().ListCollectionSegmentIndexes(mock.Anything, int64(100)).Return([]*model.SegmentIndex{
+		{
+			SegmentID: 1, CollectionID: 100, PartitionID: 10, IndexID: 1000, BuildID: 3000, IndexVersion: 1,
+			IndexState: commonpb.IndexState_Finished, IndexFileKeys: []string{"file"}, NumRows: 100,
+		},
+	}, nil)
+
+	// the secondary region has the collection 200 of the same name, the segment 20003 was shipped from the segment 3
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListShippedSegments(mock.Anything).Return([]*datapb.ShippedSegment{
+		{PrimarySegmentID: 3, SegmentID: 20003, CollectionID: 200},
+	}, nil)
+	catalog.EXPECT().SaveShippedSegment(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().DropShippedSegment(mock.Anything, int64(3)).Return(nil)
+	catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().AlterSegments(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().CreateSegmentIndex(mock.Anything, mock.Anything).Return(nil)
+
+	b := broker.NewMockBroker(t)
+	b.EXPECT().ShowCollections(mock.Anything, "default").Return(&milvuspb.ShowCollectionsResponse{
+		Status:          merr.Success(),
+		CollectionNames: []string{"coll"},
+		CollectionIds:   []int64{200},
+	}, nil)
+	b.EXPECT().ShowPartitions(mock.Anything, int64(200)).Return(&milvuspb.ShowPartitionsResponse{
+		Status:         merr.Success(),
+		PartitionNames: []string{"_default"},
+		PartitionIDs:   []int64{30},
+	}, nil)
+	handler := NewNMockHandler(t)
+	handler.EXPECT().GetCollection(mock.Anything, int64(200)).Return(&collectionInfo{ID: 200, Schema: newTestSchema(), VChannelNames: []string{"ch-200"}}, nil)
+	allocator := NewNMockAllocator(t)
+	allocator.EXPECT().allocID(mock.Anything).Return(10000, nil).Once()
+	allocator.EXPECT().allocID(mock.Anything).Return(10001, nil).Once()
+
+	indexMeta := newSegmentIndexMeta(catalog)
+	indexMeta.updateCollectionIndex(&model.Index{CollectionID: 200, FieldID: 2, IndexID: 2000, IndexName: "idx", IndexParams: indexParams})
+	m := &meta{
+		catalog:      catalog,
+		segments:     NewSegmentsInfo(),
+		channelCPs:   newChannelCps(),
+		indexMeta:    indexMeta,
+		chunkManager: cm,
+	}
+	m.channelCPs.checkpoints["ch-200"] = &msgpb.MsgPosition{ChannelName: "ch-200", MsgID: []byte{1}, Timestamp: 500}
+	m.segments.SetSegment(20003, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 20003, CollectionID: 200, PartitionID: 30, InsertChannel: "ch-200", State: commonpb.SegmentState_Flushed,
+	}))
+
+	shipper := newRegionReplicaShipper(ctx, m, handler, b, allocator, primaryMeta, primaryCatalog, primaryStorage)
+	shipper.ship(ctx)
+
+	shipped := m.GetSegment(10000)
+	require.NotNil(t, shipped)
+	assert.Equal(t, int64(200), shipped.GetCollectionID())
+	assert.Equal(t, int64(30), shipped.GetPartitionID())
+	assert.Equal(t, "ch-200", shipped.GetInsertChannel())
+	assert.Equal(t, commonpb.SegmentState_Flushed, shipped.GetState())
+	assert.Equal(t, []byte{1}, shipped.GetStartPosition().GetMsgID())
+	assert.Equal(t, uint64(100), shipped.GetStartPosition().GetTimestamp())
+	for _, p := range []string{
+		metautil.BuildInsertLogPath(cm.RootPath(), 200, 30, 10000, 2, 1001),
+		metautil.BuildStatsLogPath(cm.RootPath(), 200, 30, 10000, 2, 3001),
+		metautil.BuildDeltaLogPath(cm.RootPath(), 200, 30, 10000, 2001),
+		metautil.BuildSegmentIndexFilePath(cm.RootPath(), 10001, 1, 30, 10000, "file"),
+	} {
+		exist, err := cm.Exist(ctx, p)
+		assert.NoError(t, err)
+		assert.True(t, exist, p)
+	}
+	segIdx := m.indexMeta.GetSegmentIndexes(200, 10000)[2000]
+	require.NotNil(t, segIdx)
+	assert.Equal(t, commonpb.IndexState_Finished, segIdx.IndexState)
+	assert.Equal(t, int64(10001), segIdx.BuildID)
+	assert.Nil(t, segIdx.FilesSource)
+	// the segment dropped by the primary region is dropped
+	assert.Equal(t, commonpb.SegmentState_Dropped, m.GetSegment(20003).GetState())
+
+	statuses := shipper.GetStatus()
+	require.Equal(t, 2, len(statuses))
+	assert.Equal(t, int64(200), statuses[0].GetCollectionID())
+	assert.Equal(t, uint64(800), statuses[0].GetShippedTs())
+	assert.Equal(t, int64(1), statuses[0].GetShippedSegments())
+	assert.Empty(t, statuses[0].GetReason())
+	assert.Equal(t, int64(101), statuses[1].GetPrimaryCollectionID())
+	assert.Contains(t, statuses[1].GetReason(), "secondary region")
+
+	// the new delta logs are tailed
+	flushed.Deltalogs = []*datapb.FieldBinlog{getFieldBinlogIDs(0, 2001, 2002)}
+	shipper.ship(ctx)
+	assert.Equal(t, 2, len(m.GetSegment(10000).GetDeltalogs()[0].GetBinlogs()))
+	exist, err := cm.Exist(ctx, metautil.BuildDeltaLogPath(cm.RootPath(), 200, 30, 10000, 2002))
+	assert.NoError(t, err)
+	assert.True(t, exist)
+
+	// the shipping stops once failed over
+	paramtable.Get().Save(paramtable.Get().CommonCfg.RegionReplicaRole.Key, util.RegionReplicaRolePrimary)
+	shipper.ship(ctx)
+}
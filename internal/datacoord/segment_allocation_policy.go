@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math/rand"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -67,6 +68,113 @@ func calBySchemaPolicyWithDiskIndex(schema *schemapb.CollectionSchema) (int, err
 	return int(threshold / float64(sizePerRecord)), nil
 }
 
+// SegmentSizePolicy estimates the max number of rows of the new L1 segments of the collection, the growing
+// segments are sealed by the row capacity, so the closer the estimation, the closer the sizes of the sealed
+// segments land to the max segment size.
+type SegmentSizePolicy interface {
+	EstimateMaxNumOfRows(collection *collectionInfo) (int, error)
+}
+
+// EstimateMaxNumOfRows implements SegmentSizePolicy by the schema of the collection.
+func (f calUpperLimitPolicy) EstimateMaxNumOfRows(collection *collectionInfo) (int, error) {
+	return f(collection.Schema)
+}
+
+const (
+	segmentSizePolicyPrediction = "prediction"
+
+	// sizePredictionSampleSegments is the number of the latest flushed segments sampled to predict the size per row
+	sizePredictionSampleSegments = 10
+	// sizePredictionMinRows is the min number of the sampled rows to predict the size per row by the binlogs
+	sizePredictionMinRows = 10000
+	// sizePredictionTTL is how long the predicted size per row of a collection is cached
+	sizePredictionTTL = time.Minute
+)
+
+type predictedRowSize struct {
+	size        float64
+	predictTime time.Time
+}
+
+// sizePredictionPolicy predicts the size per row of the collection by the binlog sizes of its latest flushed
+// segments, and falls back to the size per row estimated by the schema with the average length of the varchar
+// fields if not enough rows are flushed.
+type sizePredictionPolicy struct {
+	meta *meta
+
+	mu    sync.Mutex
+	cache map[UniqueID]*predictedRowSize // collectionID -> predicted size per row
+}
+
+func newSizePredictionPolicy(meta *meta) *sizePredictionPolicy {
+	return &sizePredictionPolicy{
+		meta:  meta,
+		cache: make(map[UniqueID]*predictedRowSize),
+	}
+}
+
+func (p *sizePredictionPolicy) EstimateMaxNumOfRows(collection *collectionInfo) (int, error) {
+	sizePerRecord, err := p.predictSizePerRecord(collection)
+	if err != nil {
+		return -1, err
+	}
+	threshold := Params.DataCoordCfg.SegmentMaxSize.GetAsFloat() * 1024 * 1024
+	return int(threshold / sizePerRecord), nil
+}
+
+func (p *sizePredictionPolicy) predictSizePerRecord(collection *collectionInfo) (float64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if predicted, ok := p.cache[collection.ID]; ok && time.Since(predicted.predictTime) < sizePredictionTTL {
+		return predicted.size, nil
+	}
+	size := p.sizePerRecordByBinlogs(collection.ID)
+	if size <= 0 {
+		if collection.Schema == nil {
+			return -1, errors.New("nil schema")
+		}
+		sizePerRecord, err := typeutil.EstimateSizePerRecordWithVarCharLength(collection.Schema,
+			Params.DataCoordCfg.SegmentVarCharAvgLength.GetAsInt())
+		if err != nil {
+			return -1, err
+		}
+		// check zero value, preventing panicking
+		if sizePerRecord == 0 {
+			return -1, errors.New("zero size record schema found")
+		}
+		size = float64(sizePerRecord)
+	}
+	p.cache[collection.ID] = &predictedRowSize{size: size, predictTime: time.Now()}
+	return size, nil
+}
+
+// sizePerRecordByBinlogs returns the average size per row of the insert binlogs of the latest flushed segments,
+// or 0 if not enough rows are flushed.
+func (p *sizePredictionPolicy) sizePerRecordByBinlogs(collectionID UniqueID) float64 {
+	segments := p.meta.SelectSegments(WithCollection(collectionID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetState() == commonpb.SegmentState_Flushed && segment.GetLevel() != datapb.SegmentLevel_L0 &&
+			segment.GetNumOfRows() > 0
+	}))
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() > segments[j].GetID()
+	})
+
+	var rows, size int64
+	for _, segment := range segments[:min(len(segments), sizePredictionSampleSegments)] {
+		rows += segment.GetNumOfRows()
+		for _, fieldBinlogs := range segment.GetBinlogs() {
+			for _, binlog := range fieldBinlogs.GetBinlogs() {
+				size += binlog.GetMemorySize()
+			}
+		}
+	}
+	if rows < sizePredictionMinRows || size == 0 {
+		return 0
+	}
+	return float64(size) / float64(rows)
+}
+
 // AllocatePolicy helper function definition to allocate Segment space
 type AllocatePolicy func(segments []*SegmentInfo, count int64,
 	maxCountPerL1Segment int64, level datapb.SegmentLevel) ([]*Allocation, []*Allocation)
@@ -172,6 +280,26 @@ func sealL1SegmentByIdleTime(idleTimeTolerance time.Duration, minSizeToSealIdleS
 	}
 }
 
+// sealL1SegmentByInactivity seals the growing segments which receive no writes for the inactive seal time of
+// the collection regardless of their sizes, so the data of the low-traffic collections gets flushed and indexed.
+func sealL1SegmentByInactivity(getCollection func(collectionID UniqueID) *collectionInfo) segmentSealPolicyFunc {
+	return func(segment *SegmentInfo, ts Timestamp) (bool, string) {
+		if segment.currRows == 0 {
+			return false, ""
+		}
+		collection := getCollection(segment.GetCollectionID())
+		if collection == nil {
+			return false, ""
+		}
+		inactiveTime, err := getCollectionInactiveSealTime(collection.Properties)
+		if err != nil || inactiveTime <= 0 {
+			return false, ""
+		}
+		return time.Since(segment.lastWrittenTime) > inactiveTime,
+			fmt.Sprintf("segment inactive, segment row number: %d, last written time: %v, inactive seal time: %v", segment.currRows, segment.lastWrittenTime, inactiveTime)
+	}
+}
+
 // channelSealPolicy seal policy applies to channel
 type channelSealPolicy func(string, []*SegmentInfo, Timestamp) ([]*SegmentInfo, string)
 
@@ -194,27 +322,45 @@ func getChannelOpenSegCapacityPolicy(limit int) channelSealPolicy {
 // if the total size of growing segments exceeds the threshold.
 func sealByTotalGrowingSegmentsSize() channelSealPolicy {
 	return func(channel string, segments []*SegmentInfo, ts Timestamp) ([]*SegmentInfo, string) {
-		growingSegments := lo.Filter(segments, func(segment *SegmentInfo, _ int) bool {
-			return segment != nil && segment.GetState() == commonpb.SegmentState_Growing
-		})
-
-		var totalSize int64
-		sizeMap := lo.SliceToMap(growingSegments, func(segment *SegmentInfo) (int64, int64) {
-			size := segment.getSegmentSize()
-			totalSize += size
-			return segment.GetID(), size
-		})
-
 		threshold := paramtable.Get().DataCoordCfg.GrowingSegmentsMemSizeInMB.GetAsInt64() * 1024 * 1024
-		if totalSize >= threshold {
-			target := lo.MaxBy(growingSegments, func(s1, s2 *SegmentInfo) bool {
-				return sizeMap[s1.GetID()] > sizeMap[s2.GetID()]
-			})
-			return []*SegmentInfo{target}, fmt.Sprintf("seal by total growing segments size, "+
-				"totalSize=%d, threshold=%d", totalSize, threshold)
+		return sealLargestGrowingSegment(segments, threshold, "seal by total growing segments size")
+	}
+}
+
+// sealHotChannelByTotalGrowingSegmentsSize seals the largest growing segment of the hot channel
+// if the total size of growing segments exceeds the lowered threshold, the hot channels fill the memory
+// of the datanodes faster than the others. getHotChannels returns the hot channels detected in the round.
+func sealHotChannelByTotalGrowingSegmentsSize(getHotChannels func() map[string]bool) channelSealPolicy {
+	return func(channel string, segments []*SegmentInfo, ts Timestamp) ([]*SegmentInfo, string) {
+		if !getHotChannels()[channel] {
+			return nil, ""
 		}
-		return nil, ""
+		threshold := float64(paramtable.Get().DataCoordCfg.GrowingSegmentsMemSizeInMB.GetAsInt64()*1024*1024) *
+			paramtable.Get().DataCoordCfg.HotChannelGrowingSizeRatio.GetAsFloat()
+		return sealLargestGrowingSegment(segments, int64(threshold), "seal by total growing segments size of hot channel")
+	}
+}
+
+func sealLargestGrowingSegment(segments []*SegmentInfo, threshold int64, reason string) ([]*SegmentInfo, string) {
+	growingSegments := lo.Filter(segments, func(segment *SegmentInfo, _ int) bool {
+		return segment != nil && segment.GetState() == commonpb.SegmentState_Growing
+	})
+
+	var totalSize int64
+	sizeMap := lo.SliceToMap(growingSegments, func(segment *SegmentInfo) (int64, int64) {
+		size := segment.getSegmentSize()
+		totalSize += size
+		return segment.GetID(), size
+	})
+
+	if len(growingSegments) > 0 && totalSize >= threshold {
+		target := lo.MaxBy(growingSegments, func(s1, s2 *SegmentInfo) bool {
+			return sizeMap[s1.GetID()] > sizeMap[s2.GetID()]
+		})
+		return []*SegmentInfo{target}, fmt.Sprintf("%s, "+
+			"totalSize=%d, threshold=%d", reason, totalSize, threshold)
 	}
+	return nil, ""
 }
 
 // sortSegmentsByLastExpires sort segmentStatus with lastExpireTime ascending order
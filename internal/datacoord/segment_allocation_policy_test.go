@@ -95,6 +95,57 @@ func TestUpperLimitCalBySchema(t *testing.T) {
 	}
 }
 
+func Test_sizePredictionPolicy(t *testing.T) {
+	collection := &collectionInfo{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{DataType: schemapb.DataType_Int64},
+				{
+					DataType:   schemapb.DataType_VarChar,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "1000"}},
+				},
+			},
+		},
+	}
+	threshold := Params.DataCoordCfg.SegmentMaxSize.GetAsFloat() * 1024 * 1024
+	mt := &meta{segments: NewSegmentsInfo()}
+	addSegment := func(id int64, state commonpb.SegmentState, level datapb.SegmentLevel, rows int64, size int64) {
+		mt.segments.SetSegment(id, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           id,
+			CollectionID: collection.ID,
+			State:        state,
+			Level:        level,
+			NumOfRows:    rows,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{MemorySize: size}}},
+			},
+		}))
+	}
+
+	// not enough rows flushed, predicted by the schema with the average length of the varchar fields
+	addSegment(1, commonpb.SegmentState_Flushed, datapb.SegmentLevel_L1, 100, 100*1000)
+	policy := newSizePredictionPolicy(mt)
+	rows, err := policy.EstimateMaxNumOfRows(collection)
+	assert.NoError(t, err)
+	assert.Equal(t, int(threshold/float64(8+256)), rows)
+
+	// predicted by the binlogs of the flushed segments
+	addSegment(2, commonpb.SegmentState_Flushed, datapb.SegmentLevel_L1, 19900, 19900*100)
+	addSegment(3, commonpb.SegmentState_Growing, datapb.SegmentLevel_L1, 100000, 100000*1000)
+	addSegment(4, commonpb.SegmentState_Flushed, datapb.SegmentLevel_L0, 100000, 100000*1000)
+	// the prediction is cached
+	rows, err = policy.EstimateMaxNumOfRows(collection)
+	assert.NoError(t, err)
+	assert.Equal(t, int(threshold/float64(8+256)), rows)
+	rows, err = newSizePredictionPolicy(mt).EstimateMaxNumOfRows(collection)
+	assert.NoError(t, err)
+	assert.Equal(t, int(threshold/(float64(100*1000+19900*100)/20000)), rows)
+
+	_, err = newSizePredictionPolicy(mt).EstimateMaxNumOfRows(&collectionInfo{ID: 2})
+	assert.Error(t, err)
+}
+
 func TestGetChannelOpenSegCapacityPolicy(t *testing.T) {
 	p := getChannelOpenSegCapacityPolicy(3)
 	type testCase struct {
@@ -197,6 +248,43 @@ func Test_sealLongTimeIdlePolicy(t *testing.T) {
 	assert.True(t, shouldSeal)
 }
 
+func Test_sealL1SegmentByInactivity(t *testing.T) {
+	collections := map[UniqueID]*collectionInfo{
+		1: {ID: 1},
+		2: {ID: 2, Properties: map[string]string{common.CollectionSegmentIdleSealKey: "60"}},
+	}
+	policy := sealL1SegmentByInactivity(func(collectionID UniqueID) *collectionInfo {
+		return collections[collectionID]
+	})
+	newSegment := func(collectionID UniqueID, rows int64, lastWrittenTime time.Time) *SegmentInfo {
+		return &SegmentInfo{
+			SegmentInfo:     &datapb.SegmentInfo{CollectionID: collectionID, MaxRowNum: 10000},
+			currRows:        rows,
+			lastWrittenTime: lastWrittenTime,
+		}
+	}
+
+	// disabled by default
+	shouldSeal, _ := policy.ShouldSeal(newSegment(1, 1, time.Now().Add(-time.Hour)), 100)
+	assert.False(t, shouldSeal)
+	// sealed regardless of the size by the collection property
+	shouldSeal, _ = policy.ShouldSeal(newSegment(2, 1, time.Now().Add(-time.Hour)), 100)
+	assert.True(t, shouldSeal)
+	shouldSeal, _ = policy.ShouldSeal(newSegment(2, 1, time.Now()), 100)
+	assert.False(t, shouldSeal)
+	// no rows
+	shouldSeal, _ = policy.ShouldSeal(newSegment(2, 0, time.Now().Add(-time.Hour)), 100)
+	assert.False(t, shouldSeal)
+	// unknown collection
+	shouldSeal, _ = policy.ShouldSeal(newSegment(3, 1, time.Now().Add(-time.Hour)), 100)
+	assert.False(t, shouldSeal)
+
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.SegmentInactiveSealTime.Key, "60")
+	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.SegmentInactiveSealTime.Key)
+	shouldSeal, _ = policy.ShouldSeal(newSegment(1, 1, time.Now().Add(-time.Hour)), 100)
+	assert.True(t, shouldSeal)
+}
+
 func Test_sealByTotalGrowingSegmentsSize(t *testing.T) {
 	paramtable.Get().Save(paramtable.Get().DataCoordCfg.GrowingSegmentsMemSizeInMB.Key, "100")
 	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.GrowingSegmentsMemSizeInMB.Key)
@@ -230,3 +318,31 @@ func Test_sealByTotalGrowingSegmentsSize(t *testing.T) {
 	assert.Equal(t, 1, len(res))
 	assert.Equal(t, seg2.GetID(), res[0].GetID())
 }
+
+func Test_sealHotChannelByTotalGrowingSegmentsSize(t *testing.T) {
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.GrowingSegmentsMemSizeInMB.Key, "100")
+	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.GrowingSegmentsMemSizeInMB.Key)
+
+	seg0 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+		ID:      0,
+		State:   commonpb.SegmentState_Growing,
+		Binlogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{MemorySize: 30 * MB}}}},
+	}}
+	seg1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+		ID:      1,
+		State:   commonpb.SegmentState_Growing,
+		Binlogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{MemorySize: 40 * MB}}}},
+	}}
+
+	fn := sealHotChannelByTotalGrowingSegmentsSize(func() map[string]bool { return map[string]bool{"hot": true} })
+	// the channel is not hot
+	res, _ := fn("cold", []*SegmentInfo{seg0, seg1}, 0)
+	assert.Equal(t, 0, len(res))
+	// size not reach the lowered threshold
+	res, _ = fn("hot", []*SegmentInfo{seg0}, 0)
+	assert.Equal(t, 0, len(res))
+	// size reached the lowered threshold
+	res, _ = fn("hot", []*SegmentInfo{seg0, seg1}, 0)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, seg1.GetID(), res[0].GetID())
+}
@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+)
+
+// checkCloneCollections checks the segments of the source collection can be cloned into the target collection,
+// the collections should have the same fields and the same number of channels.
+func checkCloneCollections(source, target *collectionInfo) error {
+	if len(source.VChannelNames) != len(target.VChannelNames) {
+		return merr.WrapErrParameterInvalidMsg("the source collection has %d channels, but the target collection has %d channels",
+			len(source.VChannelNames), len(target.VChannelNames))
+	}
+	targetFields := make(map[int64]string, len(target.Schema.GetFields()))
+	for _, field := range target.Schema.GetFields() {
+		targetFields[field.GetFieldID()] = fmt.Sprintf("%s:%s", field.GetName(), field.GetDataType())
+	}
+	if len(targetFields) != len(source.Schema.GetFields()) {
+		return merr.WrapErrParameterInvalidMsg("the source collection and the target collection have different fields")
+	}
+	for _, field := range source.Schema.GetFields() {
+		if targetFields[field.GetFieldID()] != fmt.Sprintf("%s:%s", field.GetName(), field.GetDataType()) {
+			return merr.WrapErrParameterInvalidMsg("field %s of the source collection mismatches with the target collection", field.GetName())
+		}
+	}
+	return nil
+}
+
+// cloneSegmentPosition returns the position of the cloned segment in the target channel. The message id of the base
+// position is taken with the timestamp of the source position, which keeps the order of the cloned segments,
+// e.g. the deletes of the cloned L0 segments still apply to the cloned segments started before them.
+func cloneSegmentPosition(base *msgpb.MsgPosition, source *msgpb.MsgPosition) *msgpb.MsgPosition {
+	pos := proto.Clone(base).(*msgpb.MsgPosition)
+	pos.Timestamp = source.GetTimestamp()
+	return pos
+}
+
+// newClonedSegment builds the flushed segment cloned from the source segment in the target partition and channel.
+// The insert binlogs are shared with the source segment, while the stats logs and the delta logs are copied
+// under the cloned segment since the workers append to them by the ids of the segment.
+func newClonedSegment(ctx context.Context, cm storage.ChunkManager, source *SegmentInfo, target *datapb.SegmentInfo, basePos *msgpb.MsgPosition) (*SegmentInfo, error) {
+	level := source.GetLevel()
+	// the partition stats of the source are not cloned
+	if level == datapb.SegmentLevel_L2 {
+		level = datapb.SegmentLevel_L1
+	}
+	target.NumOfRows = source.GetNumOfRows()
+	target.State = commonpb.SegmentState_Flushed
+	target.MaxRowNum = source.GetMaxRowNum()
+	target.StartPosition = cloneSegmentPosition(basePos, source.GetStartPosition())
+	target.DmlPosition = cloneSegmentPosition(basePos, source.GetDmlPosition())
+	target.Level = level
+	target.StorageVersion = source.GetStorageVersion()
+	for _, fieldBinlog := range source.GetBinlogs() {
+		target.Binlogs = append(target.Binlogs, proto.Clone(fieldBinlog).(*datapb.FieldBinlog))
+	}
+	for _, fieldStats := range source.GetFieldStats() {
+		target.FieldStats = append(target.FieldStats, proto.Clone(fieldStats).(*indexpb.FieldStats))
+	}
+	if len(target.Binlogs) > 0 {
+		collectionID, partitionID, segmentID := binlog.InsertLogOwner(source.SegmentInfo)
+		target.BinlogsSource = &datapb.SegmentBinlogsSource{
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+			SegmentID:    segmentID,
+		}
+	}
+
+	var err error
+	target.Statslogs, err = copySegmentLogs(ctx, cm, storage.StatsBinlog, source.SegmentInfo, target, source.GetStatslogs())
+	if err != nil {
+		return nil, err
+	}
+	target.Deltalogs, err = copySegmentLogs(ctx, cm, storage.DeleteBinlog, source.SegmentInfo, target, source.GetDeltalogs())
+	if err != nil {
+		return nil, err
+	}
+	return NewSegmentInfo(target), nil
+}
+
+// copySegmentLogs copies the stats logs or the delta logs of the source segment under the target segment
+// with the same log ids, the copied binlogs are returned with empty paths as the meta keeps them.
+func copySegmentLogs(ctx context.Context, cm storage.ChunkManager, binlogType storage.BinlogType,
+	source, target *datapb.SegmentInfo, fieldBinlogs []*datapb.FieldBinlog,
+) ([]*datapb.FieldBinlog, error) {
+	buildPath := func(segment *datapb.SegmentInfo, fieldID, logID int64) string {
+		if binlogType == storage.DeleteBinlog {
+			return metautil.BuildDeltaLogPath(cm.RootPath(), segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), logID)
+		}
+		return metautil.BuildStatsLogPath(cm.RootPath(), segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), fieldID, logID)
+	}
+
+	copied := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		fieldBinlog = proto.Clone(fieldBinlog).(*datapb.FieldBinlog)
+		for _, l := range fieldBinlog.GetBinlogs() {
+			srcPath := l.GetLogPath()
+			if srcPath == "" {
+				srcPath = buildPath(source, fieldBinlog.GetFieldID(), l.GetLogID())
+			}
+			data, err := cm.Read(ctx, srcPath)
+			if err != nil {
+				log.Ctx(ctx).Warn("failed to read the log to clone", zap.String("path", srcPath), zap.Error(err))
+				return nil, err
+			}
+			if err := cm.Write(ctx, buildPath(target, fieldBinlog.GetFieldID(), l.GetLogID()), data); err != nil {
+				return nil, err
+			}
+			l.LogPath = ""
+		}
+		copied = append(copied, fieldBinlog)
+	}
+	return copied, nil
+}
+
+// cloneSegmentIndexes adds the finished indexes of the source segment to the cloned segment sharing the index files.
+// The indexes of the target collection are matched with the source indexes by name, the unmatched indexes
+// are built for the cloned segment by the index inspector as for the flushed segments.
+func (s *Server) cloneSegmentIndexes(ctx context.Context, source *SegmentInfo, cloned *SegmentInfo) error {
+	for _, segIdx := range s.meta.indexMeta.GetSegmentIndexes(source.GetCollectionID(), source.GetID()) {
+		if segIdx.IndexState != commonpb.IndexState_Finished {
+			continue
+		}
+		sourceIndexes := s.meta.indexMeta.GetIndexesForCollection(source.GetCollectionID(),
+			s.meta.indexMeta.GetIndexNameByID(source.GetCollectionID(), segIdx.IndexID))
+		targetIndexes := s.meta.indexMeta.GetIndexesForCollection(cloned.GetCollectionID(),
+			s.meta.indexMeta.GetIndexNameByID(source.GetCollectionID(), segIdx.IndexID))
+		if len(sourceIndexes) == 0 || len(targetIndexes) == 0 ||
+			sourceIndexes[0].FieldID != targetIndexes[0].FieldID || !isSameBuildParams(sourceIndexes[0], targetIndexes[0]) {
+			continue
+		}
+
+		buildID, err := s.allocator.allocID(ctx)
+		if err != nil {
+			return err
+		}
+		err = s.meta.indexMeta.AddClonedSegmentIndex(&model.SegmentIndex{
+			SegmentID:    cloned.GetID(),
+			CollectionID: cloned.GetCollectionID(),
+			PartitionID:  cloned.GetPartitionID(),
+			IndexID:      targetIndexes[0].IndexID,
+			BuildID:      buildID,
+			CreateTime:   uint64(cloned.GetID()),
+		}, segIdx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -42,6 +42,8 @@ type SegmentsInfo struct {
 type segmentInfoIndexes struct {
 	coll2Segments    map[UniqueID]map[UniqueID]*SegmentInfo
 	channel2Segments map[string]map[UniqueID]*SegmentInfo
+	// source2Clones maps the source segments to the cloned segments sharing their insert binlogs
+	source2Clones map[UniqueID]map[UniqueID]*SegmentInfo
 }
 
 // SegmentInfo wraps datapb.SegmentInfo and patches some extra info on it
@@ -86,6 +88,7 @@ func NewSegmentsInfo() *SegmentsInfo {
 		secondaryIndexes: segmentInfoIndexes{
 			coll2Segments:    make(map[UniqueID]map[UniqueID]*SegmentInfo),
 			channel2Segments: make(map[string]map[UniqueID]*SegmentInfo),
+			source2Clones:    make(map[UniqueID]map[UniqueID]*SegmentInfo),
 		},
 		compactionTo: make(map[UniqueID]UniqueID),
 	}
@@ -180,6 +183,11 @@ func (s *SegmentsInfo) GetCompactionTo(fromSegmentID int64) (*SegmentInfo, bool)
 	return nil, true
 }
 
+// GetClones returns the segments sharing the insert binlogs of the source segment.
+func (s *SegmentsInfo) GetClones(sourceID UniqueID) []*SegmentInfo {
+	return lo.Values(s.secondaryIndexes.source2Clones[sourceID])
+}
+
 // DropSegment deletes provided segmentID
 // no extra method is taken when segmentID not exists
 func (s *SegmentsInfo) DropSegment(segmentID UniqueID) {
@@ -279,6 +287,17 @@ func (s *SegmentsInfo) SetIsCompacting(segmentID UniqueID, isCompacting bool) {
 	}
 }
 
+func (s *SegmentInfo) IsInsertLogExists(logID int64) bool {
+	for _, insertLogs := range s.GetBinlogs() {
+		for _, l := range insertLogs.GetBinlogs() {
+			if l.GetLogID() == logID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (s *SegmentInfo) IsDeltaLogExists(logID int64) bool {
 	for _, deltaLogs := range s.GetDeltalogs() {
 		for _, l := range deltaLogs.GetBinlogs() {
@@ -298,6 +317,12 @@ func (s *SegmentInfo) IsStatsLogExists(logID int64) bool {
 			}
 		}
 	}
+	// the BM25 stats logs written by the stats job are kept in the field stats
+	for _, fieldStats := range s.GetFieldStats() {
+		if fieldStats.GetBm25Stats() != nil && fieldStats.GetBm25Stats().GetLogID() == logID {
+			return true
+		}
+	}
 	return false
 }
 
@@ -357,6 +382,13 @@ func (s *SegmentsInfo) addSecondaryIndex(segment *SegmentInfo) {
 		s.secondaryIndexes.channel2Segments[channel] = make(map[UniqueID]*SegmentInfo)
 	}
 	s.secondaryIndexes.channel2Segments[channel][segment.ID] = segment
+
+	if source := segment.GetBinlogsSource(); source != nil {
+		if _, ok := s.secondaryIndexes.source2Clones[source.GetSegmentID()]; !ok {
+			s.secondaryIndexes.source2Clones[source.GetSegmentID()] = make(map[UniqueID]*SegmentInfo)
+		}
+		s.secondaryIndexes.source2Clones[source.GetSegmentID()][segment.ID] = segment
+	}
 }
 
 func (s *SegmentsInfo) removeSecondaryIndex(segment *SegmentInfo) {
@@ -375,6 +407,15 @@ func (s *SegmentsInfo) removeSecondaryIndex(segment *SegmentInfo) {
 			delete(s.secondaryIndexes.channel2Segments, channel)
 		}
 	}
+
+	if source := segment.GetBinlogsSource(); source != nil {
+		if segments, ok := s.secondaryIndexes.source2Clones[source.GetSegmentID()]; ok {
+			delete(segments, segment.ID)
+			if len(segments) == 0 {
+				delete(s.secondaryIndexes.source2Clones, source.GetSegmentID())
+			}
+		}
+	}
 }
 
 // addCompactTo adds the compact relation to the segment
@@ -3,6 +3,7 @@ package datacoord
 import (
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -97,6 +98,52 @@ func TestCompactionTo(t *testing.T) {
 	assert.Nil(t, s)
 }
 
+func TestGetClones(t *testing.T) {
+	segments := NewSegmentsInfo()
+	segments.SetSegment(1, NewSegmentInfo(&datapb.SegmentInfo{ID: 1}))
+	segments.SetSegment(2, NewSegmentInfo(&datapb.SegmentInfo{ID: 2, BinlogsSource: &datapb.SegmentBinlogsSource{SegmentID: 1}}))
+	segments.SetSegment(3, NewSegmentInfo(&datapb.SegmentInfo{ID: 3, BinlogsSource: &datapb.SegmentBinlogsSource{SegmentID: 1}}))
+
+	assert.ElementsMatch(t, []int64{2, 3}, lo.Map(segments.GetClones(1), func(segment *SegmentInfo, _ int) int64 {
+		return segment.GetID()
+	}))
+	assert.Empty(t, segments.GetClones(2))
+
+	segments.DropSegment(2)
+	segments.DropSegment(3)
+	assert.Empty(t, segments.GetClones(1))
+	assert.Empty(t, segments.secondaryIndexes.source2Clones)
+}
+
+func TestIsInsertLogExists(t *testing.T) {
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			Binlogs: []*datapb.FieldBinlog{
+				{
+					FieldID: 100,
+					Binlogs: []*datapb.Binlog{
+						{
+							LogID: 1,
+						},
+					},
+				},
+				{
+					FieldID: 101,
+					Binlogs: []*datapb.Binlog{
+						{
+							LogID: 2,
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.True(t, segment.IsInsertLogExists(1))
+	assert.True(t, segment.IsInsertLogExists(2))
+	assert.False(t, segment.IsInsertLogExists(3))
+	assert.False(t, segment.IsInsertLogExists(0))
+}
+
 func TestIsDeltaLogExists(t *testing.T) {
 	segment := &SegmentInfo{
 		SegmentInfo: &datapb.SegmentInfo{
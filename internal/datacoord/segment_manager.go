@@ -118,7 +118,7 @@ type SegmentManager struct {
 	allocator           allocator
 	helper              allocHelper
 	segments            []UniqueID
-	estimatePolicy      calUpperLimitPolicy
+	estimatePolicy      SegmentSizePolicy
 	allocPolicy         AllocatePolicy
 	segmentSealPolicies []SegmentSealPolicy
 	channelSealPolicies []channelSealPolicy
@@ -159,6 +159,11 @@ func withCalUpperLimitPolicy(policy calUpperLimitPolicy) allocOption {
 	return allocFunc(func(manager *SegmentManager) { manager.estimatePolicy = policy })
 }
 
+// get allocOption with segmentSizePolicy
+func withSegmentSizePolicy(policy SegmentSizePolicy) allocOption {
+	return allocFunc(func(manager *SegmentManager) { manager.estimatePolicy = policy })
+}
+
 // get allocOption with allocPolicy
 func withAllocPolicy(policy AllocatePolicy) allocOption {
 	return allocFunc(func(manager *SegmentManager) { manager.allocPolicy = policy })
@@ -180,6 +185,13 @@ func withChannelSealPolices(policies ...channelSealPolicy) allocOption {
 	})
 }
 
+// get allocOption with channelWriteStats, the growing segments of the hot channels are sealed earlier
+func withChannelWriteStats(stats *channelWriteStats) allocOption {
+	return allocFunc(func(manager *SegmentManager) {
+		manager.channelSealPolicies = append(manager.channelSealPolicies, sealHotChannelByTotalGrowingSegmentsSize(stats.GetHotChannels))
+	})
+}
+
 // get allocOption with flushPolicy
 func withFlushPolicy(policy flushPolicy) allocOption {
 	return allocFunc(func(manager *SegmentManager) { manager.flushPolicy = policy })
@@ -193,12 +205,13 @@ func defaultAllocatePolicy() AllocatePolicy {
 	return AllocatePolicyL1
 }
 
-func defaultSegmentSealPolicy() []SegmentSealPolicy {
+func defaultSegmentSealPolicy(meta *meta) []SegmentSealPolicy {
 	return []SegmentSealPolicy{
 		sealL1SegmentByBinlogFileNumber(Params.DataCoordCfg.SegmentMaxBinlogFileNumber.GetAsInt()),
 		sealL1SegmentByLifetime(Params.DataCoordCfg.SegmentMaxLifetime.GetAsDuration(time.Second)),
 		sealL1SegmentByCapacity(Params.DataCoordCfg.SegmentSealProportion.GetAsFloat()),
 		sealL1SegmentByIdleTime(Params.DataCoordCfg.SegmentMaxIdleTime.GetAsDuration(time.Second), Params.DataCoordCfg.SegmentMinSizeFromIdleToSealed.GetAsFloat(), Params.DataCoordCfg.SegmentMaxSize.GetAsFloat()),
+		sealL1SegmentByInactivity(meta.GetCollection),
 	}
 }
 
@@ -221,7 +234,7 @@ func newSegmentManager(meta *meta, allocator allocator, opts ...allocOption) (*S
 		segments:            make([]UniqueID, 0),
 		estimatePolicy:      defaultCalUpperLimitPolicy(),
 		allocPolicy:         defaultAllocatePolicy(),
-		segmentSealPolicies: defaultSegmentSealPolicy(),
+		segmentSealPolicies: defaultSegmentSealPolicy(meta),
 		channelSealPolicies: defaultChannelSealPolicy(),
 		flushPolicy:         defaultFlushPolicy(),
 	}
@@ -477,7 +490,7 @@ func (s *SegmentManager) estimateMaxNumOfRows(collectionID UniqueID) (int, error
 	if collMeta == nil {
 		return -1, fmt.Errorf("failed to get collection %d", collectionID)
 	}
-	return s.estimatePolicy(collMeta.Schema)
+	return s.estimatePolicy.EstimateMaxNumOfRows(collMeta)
 }
 
 // DropSegment drop the segment from manager.
@@ -72,7 +72,7 @@ func TestManagerOptions(t *testing.T) {
 	})
 
 	t.Run("test withSegmentSealPolicy", func(t *testing.T) {
-		opt := withSegmentSealPolices(defaultSegmentSealPolicy()...)
+		opt := withSegmentSealPolices(defaultSegmentSealPolicy(meta)...)
 		assert.NotNil(t, opt)
 		// manual set nil
 		segmentManager.segmentSealPolicies = []SegmentSealPolicy{}
@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// segmentReferenceMeta tracks the leases held by the external readers on the binlogs of the segments,
+// the segments referenced by an unexpired lease are kept from GC.
+type segmentReferenceMeta struct {
+	sync.RWMutex
+
+	ctx     context.Context
+	catalog metastore.DataCoordCatalog
+
+	// referenceID -> segment reference
+	references map[int64]*datapb.SegmentReference
+}
+
+func newSegmentReferenceMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*segmentReferenceMeta, error) {
+	mt := &segmentReferenceMeta{
+		ctx:        ctx,
+		catalog:    catalog,
+		references: make(map[int64]*datapb.SegmentReference),
+	}
+
+	references, err := catalog.ListSegmentReferences(ctx)
+	if err != nil {
+		log.Warn("segmentReferenceMeta load segment references failed", zap.Error(err))
+		return nil, err
+	}
+	for _, reference := range references {
+		mt.references[reference.GetReferenceID()] = reference
+	}
+	return mt, nil
+}
+
+func (m *segmentReferenceMeta) AddReference(reference *datapb.SegmentReference) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.catalog.SaveSegmentReference(m.ctx, reference); err != nil {
+		return err
+	}
+	m.references[reference.GetReferenceID()] = reference
+	return nil
+}
+
+// RenewReference moves the expire time of the unexpired reference to expireTime, unix time in milliseconds.
+func (m *segmentReferenceMeta) RenewReference(referenceID int64, expireTime int64) (*datapb.SegmentReference, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	reference, ok := m.references[referenceID]
+	if !ok || reference.GetExpireTime() <= time.Now().UnixMilli() {
+		return nil, merr.WrapErrParameterInvalidMsg("segment reference %d not found or expired", referenceID)
+	}
+	renewed := proto.Clone(reference).(*datapb.SegmentReference)
+	renewed.ExpireTime = expireTime
+	if err := m.catalog.SaveSegmentReference(m.ctx, renewed); err != nil {
+		return nil, err
+	}
+	m.references[referenceID] = renewed
+	return renewed, nil
+}
+
+// RemoveReference removes the reference, it's a no-op if the reference doesn't exist.
+func (m *segmentReferenceMeta) RemoveReference(referenceID int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.references[referenceID]; !ok {
+		return nil
+	}
+	if err := m.catalog.DropSegmentReference(m.ctx, referenceID); err != nil {
+		return err
+	}
+	delete(m.references, referenceID)
+	return nil
+}
+
+func (m *segmentReferenceMeta) GetReference(referenceID int64) *datapb.SegmentReference {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.references[referenceID]
+}
+
+// GetReferencedSegments returns the segments referenced by the unexpired references.
+func (m *segmentReferenceMeta) GetReferencedSegments() typeutil.UniqueSet {
+	m.RLock()
+	defer m.RUnlock()
+
+	now := time.Now().UnixMilli()
+	segments := typeutil.NewUniqueSet()
+	for _, reference := range m.references {
+		if reference.GetExpireTime() > now {
+			segments.Insert(reference.GetSegmentIDs()...)
+		}
+	}
+	return segments
+}
+
+// RemoveExpiredReferences removes the expired references, the references failed to be removed are retried next time.
+func (m *segmentReferenceMeta) RemoveExpiredReferences() int {
+	m.Lock()
+	defer m.Unlock()
+
+	now := time.Now().UnixMilli()
+	removed := 0
+	for referenceID, reference := range m.references {
+		if reference.GetExpireTime() > now {
+			continue
+		}
+		if err := m.catalog.DropSegmentReference(m.ctx, referenceID); err != nil {
+			log.Warn("failed to remove the expired segment reference", zap.Int64("referenceID", referenceID), zap.Error(err))
+			continue
+		}
+		delete(m.references, referenceID)
+		removed++
+	}
+	return removed
+}
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestSegmentReferenceMeta(t *testing.T) {
+	t.Run("reload failed", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListSegmentReferences(mock.Anything).Return(nil, errors.New("mock error"))
+		_, err := newSegmentReferenceMeta(context.TODO(), catalog)
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListSegmentReferences(mock.Anything).Return([]*datapb.SegmentReference{
+			{ReferenceID: 1, SegmentIDs: []int64{100, 101}, ExpireTime: time.Now().Add(time.Hour).UnixMilli()},
+		}, nil)
+		m, err := newSegmentReferenceMeta(context.TODO(), catalog)
+		assert.NoError(t, err)
+		assert.True(t, m.GetReferencedSegments().Contain(100, 101))
+
+		catalog.EXPECT().SaveSegmentReference(mock.Anything, mock.Anything).Return(errors.New("mock error")).Once()
+		err = m.AddReference(&datapb.SegmentReference{ReferenceID: 2, SegmentIDs: []int64{200}, ExpireTime: time.Now().Add(time.Hour).UnixMilli()})
+		assert.Error(t, err)
+		assert.Nil(t, m.GetReference(2))
+
+		catalog.EXPECT().SaveSegmentReference(mock.Anything, mock.Anything).Return(nil)
+		err = m.AddReference(&datapb.SegmentReference{ReferenceID: 2, SegmentIDs: []int64{200}, ExpireTime: time.Now().Add(time.Hour).UnixMilli()})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, m.GetReferencedSegments().Len())
+
+		// the expired reference can't be renewed
+		expireTime := time.Now().Add(-time.Second).UnixMilli()
+		reference, err := m.RenewReference(2, expireTime)
+		assert.NoError(t, err)
+		assert.Equal(t, expireTime, reference.GetExpireTime())
+		assert.False(t, m.GetReferencedSegments().Contain(200))
+		_, err = m.RenewReference(2, time.Now().Add(time.Hour).UnixMilli())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		_, err = m.RenewReference(3, time.Now().Add(time.Hour).UnixMilli())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		catalog.EXPECT().DropSegmentReference(mock.Anything, int64(2)).Return(nil).Once()
+		assert.Equal(t, 1, m.RemoveExpiredReferences())
+		assert.Nil(t, m.GetReference(2))
+
+		catalog.EXPECT().DropSegmentReference(mock.Anything, int64(1)).Return(errors.New("mock error")).Once()
+		assert.Error(t, m.RemoveReference(1))
+		assert.NotNil(t, m.GetReference(1))
+		catalog.EXPECT().DropSegmentReference(mock.Anything, int64(1)).Return(nil).Once()
+		assert.NoError(t, m.RemoveReference(1))
+		assert.NoError(t, m.RemoveReference(1))
+		assert.Equal(t, 0, m.GetReferencedSegments().Len())
+	})
+}
@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+// segmentIntegrity is the result of verifying the binlogs of a segment.
+type segmentIntegrity int
+
+const (
+	// segmentIntact means all the binlogs are readable and consistent with the meta.
+	segmentIntact segmentIntegrity = iota
+	// segmentInconsistent means the binlogs are readable but the rows mismatch with the meta,
+	// the segment can be repaired by rewriting it with compaction.
+	segmentInconsistent
+	// segmentCorrupted means some binlogs are missing or truncated, the segment can't be repaired.
+	segmentCorrupted
+)
+
+// verifySegmentBinlogs verifies the insert binlogs of the field of the segment, each binlog should exist
+// with the size recorded in the meta, and the rows of the binlogs should sum up to the rows of the segment.
+// The error is returned if the storage fails to respond, the integrity of the segment is unknown.
+func verifySegmentBinlogs(ctx context.Context, cm storage.ChunkManager, segment *SegmentInfo, fieldID int64) (segmentIntegrity, string, error) {
+	segment = segment.Clone()
+	if err := binlog.DecompressInsertBinLogs(segment.SegmentInfo); err != nil {
+		return segmentIntact, "", err
+	}
+
+	var rows int64
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if fieldBinlog.GetFieldID() != fieldID {
+			continue
+		}
+		for _, insertlog := range fieldBinlog.GetBinlogs() {
+			size, err := cm.Size(ctx, insertlog.GetLogPath())
+			if errors.Is(err, merr.ErrIoKeyNotFound) {
+				return segmentCorrupted, fmt.Sprintf("binlog %s is missing", insertlog.GetLogPath()), nil
+			}
+			if err != nil {
+				return segmentIntact, "", err
+			}
+			if insertlog.GetLogSize() > 0 && size != insertlog.GetLogSize() {
+				return segmentCorrupted, fmt.Sprintf("size of binlog %s is %d, but %d in meta",
+					insertlog.GetLogPath(), size, insertlog.GetLogSize()), nil
+			}
+			rows += insertlog.GetEntriesNum()
+		}
+	}
+	if rows != segment.GetNumOfRows() {
+		return segmentInconsistent, fmt.Sprintf("binlogs of field %d have %d rows, but %d rows in segment meta",
+			fieldID, rows, segment.GetNumOfRows()), nil
+	}
+	return segmentIntact, "", nil
+}
+
+// rewriteSegment schedules a mix compaction to rewrite the segment alone, to repair the inconsistent segment
+// or upgrade its index, the segment is dropped once the compaction is done and indexed on the result segment.
+func (s *Server) rewriteSegment(ctx context.Context, segment *SegmentInfo) error {
+	coll, err := s.handler.GetCollection(ctx, segment.GetCollectionID())
+	if err != nil {
+		return err
+	}
+	if coll == nil {
+		return merr.WrapErrCollectionNotFound(segment.GetCollectionID())
+	}
+	ct, err := getCompactTime(tsoutil.ComposeTSByTime(time.Now(), 0), coll)
+	if err != nil {
+		return err
+	}
+	planID, _, err := s.allocator.allocN(2)
+	if err != nil {
+		return err
+	}
+	pts, _ := tsoutil.ParseTS(ct.startTime)
+	err = s.compactionHandler.enqueueCompaction(&datapb.CompactionTask{
+		PlanID:           planID,
+		TriggerID:        planID,
+		State:            datapb.CompactionTaskState_pipelining,
+		StartTime:        pts.Unix(),
+		TimeoutInSeconds: Params.DataCoordCfg.CompactionTimeoutInSeconds.GetAsInt32(),
+		Type:             datapb.CompactionType_MixCompaction,
+		CollectionTtl:    ct.collectionTTL.Nanoseconds(),
+		CollectionID:     segment.GetCollectionID(),
+		PartitionID:      segment.GetPartitionID(),
+		Channel:          segment.GetInsertChannel(),
+		InputSegments:    []int64{segment.GetID()},
+		ResultSegments:   []int64{planID + 1},
+		TotalRows:        segment.GetNumOfRows(),
+		Schema:           coll.Schema,
+	})
+	if err != nil {
+		return err
+	}
+	log.Ctx(ctx).Info("compaction to rewrite the segment is scheduled",
+		zap.Int64("segmentID", segment.GetID()), zap.Int64("planID", planID))
+	return nil
+}
@@ -0,0 +1,156 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	catalogmocks "github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_verifySegmentBinlogs(t *testing.T) {
+	ctx := context.Background()
+	segment := NewSegmentInfo(&datapb.SegmentInfo{
+		ID:        segID,
+		NumOfRows: 200,
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: fieldID, Binlogs: []*datapb.Binlog{
+				{LogPath: "binlog/1", LogSize: 1024, EntriesNum: 100},
+				{LogPath: "binlog/2", LogSize: 1024, EntriesNum: 100},
+			}},
+			{FieldID: fieldID + 1, Binlogs: []*datapb.Binlog{{LogPath: "binlog/3", LogSize: 1024, EntriesNum: 100}}},
+		},
+	})
+
+	t.Run("intact", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().Size(mock.Anything, mock.Anything).Return(1024, nil).Twice()
+		integrity, _, err := verifySegmentBinlogs(ctx, cm, segment, fieldID)
+		assert.NoError(t, err)
+		assert.Equal(t, segmentIntact, integrity)
+	})
+
+	t.Run("missing binlog", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().Size(mock.Anything, "binlog/1").Return(0, merr.WrapErrIoKeyNotFound("binlog/1"))
+		integrity, reason, err := verifySegmentBinlogs(ctx, cm, segment, fieldID)
+		assert.NoError(t, err)
+		assert.Equal(t, segmentCorrupted, integrity)
+		assert.Contains(t, reason, "binlog/1")
+	})
+
+	t.Run("truncated binlog", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().Size(mock.Anything, "binlog/1").Return(1024, nil)
+		cm.EXPECT().Size(mock.Anything, "binlog/2").Return(512, nil)
+		integrity, reason, err := verifySegmentBinlogs(ctx, cm, segment, fieldID)
+		assert.NoError(t, err)
+		assert.Equal(t, segmentCorrupted, integrity)
+		assert.Contains(t, reason, "binlog/2")
+	})
+
+	t.Run("rows mismatch", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().Size(mock.Anything, mock.Anything).Return(1024, nil).Once()
+		integrity, _, err := verifySegmentBinlogs(ctx, cm, segment, fieldID+1)
+		assert.NoError(t, err)
+		assert.Equal(t, segmentInconsistent, integrity)
+	})
+
+	t.Run("storage unavailable", func(t *testing.T) {
+		cm := mocks.NewChunkManager(t)
+		cm.EXPECT().Size(mock.Anything, mock.Anything).Return(0, errors.New("mock error")).Once()
+		_, _, err := verifySegmentBinlogs(ctx, cm, segment, fieldID)
+		assert.Error(t, err)
+	})
+}
+
+func Test_indexBuildTask_VerifySource(t *testing.T) {
+	ctx := context.Background()
+	newScheduler := func(t *testing.T) (*taskScheduler, *catalogmocks.DataCoordCatalog, *mocks.ChunkManager) {
+		catalog := catalogmocks.NewDataCoordCatalog(t)
+		mt := createMeta(catalog, nil, createIndexMeta(catalog))
+		mt.segments.segments[segID].Binlogs = []*datapb.FieldBinlog{
+			{FieldID: fieldID, Binlogs: []*datapb.Binlog{{LogPath: "binlog/1", LogSize: 1024, EntriesNum: 1025}}},
+		}
+		cm := mocks.NewChunkManager(t)
+		return &taskScheduler{ctx: ctx, meta: mt, chunkManager: cm}, catalog, cm
+	}
+	newTask := func() *indexBuildTask {
+		return &indexBuildTask{
+			taskID: buildID,
+			taskInfo: &indexpb.IndexTaskInfo{
+				BuildID: buildID,
+				State:   commonpb.IndexState_Retry,
+			},
+			failedOnWorker: true,
+		}
+	}
+
+	t.Run("not failed on worker", func(t *testing.T) {
+		scheduler, _, _ := newScheduler(t)
+		task := newTask()
+		task.failedOnWorker = false
+		task.VerifySource(ctx, scheduler)
+		assert.Equal(t, indexpb.JobState_JobStateRetry, task.GetState())
+	})
+
+	t.Run("intact", func(t *testing.T) {
+		scheduler, _, cm := newScheduler(t)
+		cm.EXPECT().Size(mock.Anything, "binlog/1").Return(1024, nil).Once()
+		task := newTask()
+		task.VerifySource(ctx, scheduler)
+		assert.Equal(t, indexpb.JobState_JobStateRetry, task.GetState())
+		assert.False(t, task.failedOnWorker)
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		scheduler, catalog, cm := newScheduler(t)
+		cm.EXPECT().Size(mock.Anything, "binlog/1").Return(0, merr.WrapErrIoKeyNotFound("binlog/1")).Once()
+		catalog.EXPECT().AlterSegments(mock.Anything, mock.Anything).Return(nil).Once()
+		task := newTask()
+		task.VerifySource(ctx, scheduler)
+		assert.Equal(t, indexpb.JobState_JobStateFailed, task.GetState())
+		assert.True(t, scheduler.meta.GetSegment(segID).GetIsCorrupted())
+	})
+
+	t.Run("repaired", func(t *testing.T) {
+		scheduler, _, cm := newScheduler(t)
+		cm.EXPECT().Size(mock.Anything, "binlog/1").Return(1024, nil).Once()
+		scheduler.meta.segments.segments[segID].NumOfRows = 2048
+		repaired := false
+		scheduler.repairSegment = func(ctx context.Context, segment *SegmentInfo) error {
+			repaired = true
+			return nil
+		}
+		task := newTask()
+		task.VerifySource(ctx, scheduler)
+		assert.True(t, repaired)
+		assert.Equal(t, indexpb.JobState_JobStateFailed, task.GetState())
+		assert.False(t, scheduler.meta.GetSegment(segID).GetIsCorrupted())
+	})
+}
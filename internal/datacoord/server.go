@@ -42,6 +42,7 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/kv/tikv"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	kvmetestore "github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	streamingcoord "github.com/milvus-io/milvus/internal/streamingcoord/server"
@@ -53,6 +54,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/expr"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -122,10 +124,23 @@ type Server struct {
 	importMeta       ImportMeta
 	importScheduler  ImportScheduler
 	importChecker    ImportChecker
+	exportMeta       ExportMeta
+	exportScheduler  *exportScheduler
+	metaWarmCache    *metaWarmCache
+	// regionReplicaShipper ships the segments from the primary region, nil if not the secondary region
+	regionReplicaShipper *regionReplicaShipper
+
+	duplicatePKChecker *duplicatePKChecker
+	slowTaskDetector   *slowTaskDetector
+	flushProgress      *flushProgressTracker
 
 	compactionTrigger        trigger
 	compactionHandler        compactionPlanContext
 	compactionTriggerManager TriggerManager
+	// databaseQuotas is the background task quotas of the databases shared by the schedulers
+	databaseQuotas *databaseQuotas
+	// channelWriteStats is the write rates of the channels reported by the datanodes
+	channelWriteStats *channelWriteStats
 
 	syncSegmentsScheduler *SyncSegmentsScheduler
 	metricsCacheManager   *metricsinfo.MetricsCacheManager
@@ -137,6 +152,9 @@ type Server struct {
 
 	session   sessionutil.SessionInterface
 	icSession *sessionutil.Session
+
+	dataNodeDetector *sessionutil.FailureDetector
+
 	dnEventCh <-chan *sessionutil.SessionEvent
 	inEventCh <-chan *sessionutil.SessionEvent
 	// qcEventCh <-chan *sessionutil.SessionEvent
@@ -213,6 +231,8 @@ func CreateServer(ctx context.Context, factory dependency.Factory, opts ...Optio
 		indexNodeCreator:       defaultIndexNodeCreatorFunc,
 		rootCoordClientCreator: defaultRootCoordCreatorFunc,
 		metricsCacheManager:    metricsinfo.NewMetricsCacheManager(),
+		flushProgress:          newFlushProgressTracker(),
+		channelWriteStats:      newChannelWriteStats(),
 		enableActiveStandBy:    Params.DataCoordCfg.EnableActiveStandby.GetAsBool(),
 	}
 
@@ -316,6 +336,7 @@ func (s *Server) Init() error {
 			}
 			return nil
 		}
+		s.startMetaWarmCache()
 		s.stateCode.Store(commonpb.StateCode_StandBy)
 		log.Info("DataCoord enter standby mode successfully")
 		return nil
@@ -337,6 +358,7 @@ func (s *Server) initDataCoord() error {
 	log.Info("init rootcoord client done")
 
 	s.broker = broker.NewCoordinatorBroker(s.rootCoordClient)
+	s.databaseQuotas = newDatabaseQuotas(s.ctx, s.broker)
 	s.allocator = newRootCoordAllocator(s.rootCoordClient)
 
 	storageCli, err := s.newChunkManagerFactory()
@@ -397,8 +419,19 @@ func (s *Server) initDataCoord() error {
 	}
 	s.importScheduler = NewImportScheduler(s.meta, s.cluster, s.allocator, s.importMeta, s.buildIndexCh)
 	s.importChecker = NewImportChecker(s.meta, s.broker, s.cluster, s.allocator, s.segmentManager, s.importMeta)
+	s.exportMeta, err = NewExportMeta(s.meta.catalog)
+	if err != nil {
+		return err
+	}
+	s.exportScheduler = newExportScheduler(s.cluster, s.exportMeta)
 
 	s.syncSegmentsScheduler = newSyncSegmentsScheduler(s.meta, s.channelManager, s.sessionManager)
+	s.duplicatePKChecker = newDuplicatePKChecker(s.meta, s.handler, s.allocator, s.compactionHandler)
+	s.slowTaskDetector = newSlowTaskDetector(s.meta, s.taskScheduler, s.indexNodeManager, s.sessionManager, storageCli)
+
+	if err = s.initRegionReplicaShipper(); err != nil {
+		return err
+	}
 
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
 
@@ -429,6 +462,7 @@ func (s *Server) Start() error {
 func (s *Server) startDataCoord() {
 	s.taskScheduler.Start()
 	s.startServerLoop()
+	s.startDataNodeDetector()
 
 	// http.Register(&http.Handler{
 	// 	Path: "/datacoord/garbage_collection/pause",
@@ -486,10 +520,13 @@ func (s *Server) initCluster() error {
 		return nil
 	}
 
-	s.sessionManager = NewSessionManagerImpl(withSessionCreator(s.dataNodeCreator))
+	sessionManager := NewSessionManagerImpl(withSessionCreator(s.dataNodeCreator))
+	s.sessionManager = sessionManager
 
 	var err error
-	s.channelManager, err = NewChannelManager(s.watchClient, s.handler, s.sessionManager, s.allocator, withCheckerV2())
+	s.channelManager, err = NewChannelManager(s.watchClient, s.handler, s.sessionManager, s.allocator,
+		withCheckerV2(), withChannelRowCounter(s.meta.GetAllChannelNumRows),
+		withChannelWriteRates(s.channelWriteStats.GetRates), withNodeLabelGetter(sessionManager.getNodeLabels))
 	if err != nil {
 		return err
 	}
@@ -540,9 +577,77 @@ func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 		scanInterval:     Params.DataCoordCfg.GCScanIntervalInHour.GetAsDuration(time.Hour),
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance.GetAsDuration(time.Second),
 		dropTolerance:    Params.DataCoordCfg.GCDropTolerance.GetAsDuration(time.Second),
+
+		segmentIndexRetention: Params.DataCoordCfg.GCSegmentIndexRetention.GetAsDuration(time.Second),
 	})
 }
 
+// initRegionReplicaShipper connects to the meta store and the storage of the primary region,
+// the shipper is only initialized for the secondary region.
+func (s *Server) initRegionReplicaShipper() error {
+	if Params.CommonCfg.RegionReplicaRole.GetValue() != util.RegionReplicaRoleSecondary {
+		return nil
+	}
+	endpoints := Params.DataCoordCfg.RegionReplicaPrimaryEtcdEndpoints.GetValue()
+	if endpoints == "" || Params.DataCoordCfg.RegionReplicaPrimaryAddress.GetValue() == "" {
+		log.Warn("the primary region is not configured, region replica shipper is disabled")
+		return nil
+	}
+	etcdCli, err := etcd.GetRemoteEtcdClient(Params.DataCoordCfg.RegionReplicaPrimaryEtcdEndpoints.GetAsStrings())
+	if err != nil {
+		return err
+	}
+	metaRootPath := Params.DataCoordCfg.RegionReplicaPrimaryMetaRootPath.GetValue()
+	metaKV := etcdkv.NewEtcdKV(etcdCli, metaRootPath,
+		etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+	snapshot, err := kvmetestore.NewSuffixSnapshot(metaKV, kvmetestore.SnapshotsSep, metaRootPath, kvmetestore.SnapshotPrefix)
+	if err != nil {
+		return err
+	}
+	primaryStorage, err := storage.NewChunkManagerFactory(Params.CommonCfg.StorageType.GetValue(),
+		storage.RootPath(Params.DataCoordCfg.RegionReplicaPrimaryRootPath.GetValue()),
+		storage.Address(Params.DataCoordCfg.RegionReplicaPrimaryAddress.GetValue()),
+		storage.AccessKeyID(Params.DataCoordCfg.RegionReplicaPrimaryAccessKeyID.GetValue()),
+		storage.SecretAccessKeyID(Params.DataCoordCfg.RegionReplicaPrimarySecretAccessKey.GetValue()),
+		storage.UseSSL(Params.DataCoordCfg.RegionReplicaPrimaryUseSSL.GetAsBool()),
+		storage.BucketName(Params.DataCoordCfg.RegionReplicaPrimaryBucketName.GetValue()),
+		storage.CloudProvider(Params.MinioCfg.CloudProvider.GetValue()),
+		storage.UseVirtualHost(Params.MinioCfg.UseVirtualHost.GetAsBool()),
+		storage.RequestTimeout(Params.MinioCfg.RequestTimeoutMs.GetAsInt64()),
+	).NewPersistentStorageChunkManager(s.ctx)
+	if err != nil {
+		return err
+	}
+	s.regionReplicaShipper = newRegionReplicaShipper(s.ctx, s.meta, s.handler, s.broker, s.allocator,
+		&kvmetestore.Catalog{Txn: metaKV, Snapshot: snapshot},
+		datacoord.NewCatalog(metaKV, Params.DataCoordCfg.RegionReplicaPrimaryRootPath.GetValue(), metaRootPath),
+		primaryStorage)
+	log.Info("region replica shipper initialized", zap.String("primaryEtcdEndpoints", endpoints),
+		zap.String("primaryMetaRootPath", metaRootPath))
+	return nil
+}
+
+// startDataNodeDetector probes the datanodes directly, so a dead datanode is detected before its session expires.
+func (s *Server) startDataNodeDetector() {
+	s.dataNodeDetector = sessionutil.NewFailureDetector(s.session, typeutil.DataNodeRole, s.sessionManager.GetSessionIDs,
+		s.sessionManager.CheckNodeHealth,
+		sessionutil.WithProbeInterval(func() time.Duration {
+			return dataNodeHealthCheckParam(&Params.DataCoordCfg.DataNodeHealthCheckInterval, &Params.CommonCfg.SessionFastDetectionProbeInterval)
+		}),
+		sessionutil.WithProbeTimeout(func() time.Duration {
+			return dataNodeHealthCheckParam(&Params.DataCoordCfg.DataNodeHealthCheckTimeout, &Params.CommonCfg.SessionFastDetectionProbeTimeout)
+		}))
+	s.dataNodeDetector.Start()
+}
+
+// dataNodeHealthCheckParam returns the duration in seconds of the datacoord param, the common one if not positive.
+func dataNodeHealthCheckParam(param, common *paramtable.ParamItem) time.Duration {
+	if param.GetAsFloat() > 0 {
+		return param.GetAsDuration(time.Second)
+	}
+	return common.GetAsDuration(time.Second)
+}
+
 func (s *Server) initServiceDiscovery() error {
 	r := semver.MustParseRange(">=2.2.3")
 	sessions, rev, err := s.session.GetSessionsWithVersionRange(typeutil.DataNodeRole, r)
@@ -562,6 +667,7 @@ func (s *Server) initServiceDiscovery() error {
 		info := &NodeInfo{
 			NodeID:  session.ServerID,
 			Address: session.Address,
+			Labels:  session.ServerLabels,
 		}
 
 		if session.Version.LTE(legacyVersion) {
@@ -617,7 +723,11 @@ func (s *Server) initServiceDiscovery() error {
 
 func (s *Server) initSegmentManager() error {
 	if s.segmentManager == nil {
-		manager, err := newSegmentManager(s.meta, s.allocator)
+		opts := []allocOption{withChannelWriteStats(s.channelWriteStats)}
+		if Params.DataCoordCfg.SegmentSizePolicy.GetValue() == segmentSizePolicyPrediction {
+			opts = append(opts, withSegmentSizePolicy(newSizePredictionPolicy(s.meta)))
+		}
+		manager, err := newSegmentManager(s.meta, s.allocator, opts...)
 		if err != nil {
 			return err
 		}
@@ -626,6 +736,21 @@ func (s *Server) initSegmentManager() error {
 	return nil
 }
 
+// startMetaWarmCache keeps the segments warm during standby, so that the meta is reloaded fast on activation.
+func (s *Server) startMetaWarmCache() {
+	if !Params.DataCoordCfg.StandbyWarmCacheEnabled.GetAsBool() ||
+		Params.MetaStoreCfg.MetaStoreType.GetValue() != util.MetaStoreTypeEtcd {
+		return
+	}
+	metaRootPath := Params.EtcdCfg.MetaRootPath.GetValue()
+	metaKV := etcdkv.NewEtcdKV(s.etcdCli, metaRootPath,
+		etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+	// the chunk manager root path is not required to read the segments
+	catalog := datacoord.NewCatalog(metaKV, "", metaRootPath)
+	s.metaWarmCache = newMetaWarmCache(s.ctx, s.etcdCli, metaRootPath, catalog)
+	s.metaWarmCache.Start()
+}
+
 func (s *Server) initMeta(chunkManager storage.ChunkManager) error {
 	if s.meta != nil {
 		return nil
@@ -651,7 +776,7 @@ func (s *Server) initMeta(chunkManager storage.ChunkManager) error {
 	reloadEtcdFn := func() error {
 		var err error
 		catalog := datacoord.NewCatalog(s.kv, chunkManager.RootPath(), metaRootPath)
-		s.meta, err = newMeta(s.ctx, catalog, chunkManager)
+		s.meta, err = newMetaWithWarmCache(s.ctx, catalog, chunkManager, s.metaWarmCache)
 		if err != nil {
 			return err
 		}
@@ -671,6 +796,7 @@ func (s *Server) initMeta(chunkManager storage.ChunkManager) error {
 func (s *Server) initTaskScheduler(manager storage.ChunkManager) {
 	if s.taskScheduler == nil {
 		s.taskScheduler = newTaskScheduler(s.ctx, s.meta, s.indexNodeManager, manager, s.indexEngineVersionManager, s.handler)
+		s.taskScheduler.databaseQuotas = s.databaseQuotas
 	}
 }
 
@@ -681,9 +807,14 @@ func (s *Server) initIndexNodeManager() {
 }
 
 func (s *Server) initCompaction() {
-	s.compactionHandler = newCompactionPlanHandler(s.cluster, s.sessionManager, s.channelManager, s.meta, s.allocator, s.taskScheduler, s.handler)
+	compactionHandler := newCompactionPlanHandler(s.cluster, s.sessionManager, s.channelManager, s.meta, s.allocator, s.taskScheduler, s.handler)
+	compactionHandler.databaseQuotas = s.databaseQuotas
+	s.compactionHandler = compactionHandler
 	s.compactionTriggerManager = NewCompactionTriggerManager(s.allocator, s.handler, s.compactionHandler, s.meta)
 	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator, s.handler, s.indexEngineVersionManager)
+	if s.taskScheduler != nil {
+		s.taskScheduler.repairSegment = s.rewriteSegment
+	}
 }
 
 func (s *Server) stopCompaction() {
@@ -721,13 +852,29 @@ func (s *Server) startServerLoop() {
 	s.serverLoopWg.Add(2)
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
+	s.startSlowTaskDetectLoop(s.serverLoopCtx)
 	s.startIndexService(s.serverLoopCtx)
 	go s.importScheduler.Start()
 	go s.importChecker.Start()
+	go s.exportScheduler.Start()
+	if s.regionReplicaShipper != nil {
+		s.regionReplicaShipper.Start()
+	}
 	s.garbageCollector.start()
 	s.syncSegmentsScheduler.Start()
 }
 
+func (s *Server) startSlowTaskDetectLoop(ctx context.Context) {
+	if s.slowTaskDetector == nil {
+		return
+	}
+	s.serverLoopWg.Add(1)
+	go func() {
+		defer s.serverLoopWg.Done()
+		s.slowTaskDetector.detectLoop(ctx)
+	}()
+}
+
 func (s *Server) updateSegmentStatistics(stats []*commonpb.SegmentStats) {
 	for _, stat := range stats {
 		segment := s.meta.GetSegment(stat.GetSegmentID())
@@ -744,6 +891,7 @@ func (s *Server) updateSegmentStatistics(stats []*commonpb.SegmentStats) {
 				zap.Int64("new value", stat.GetNumRows()))
 			continue
 		}
+		s.channelWriteStats.Record(segment.GetInsertChannel(), segment.GetID(), segment.currRows, stat.GetNumRows())
 
 		// Log if # of rows is updated.
 		if segment.currRows < stat.GetNumRows() {
@@ -860,6 +1008,7 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 		node := &NodeInfo{
 			NodeID:  event.Session.ServerID,
 			Address: event.Session.Address,
+			Labels:  event.Session.ServerLabels,
 		}
 		switch event.EventType {
 		case sessionutil.SessionAddEvent:
@@ -1033,6 +1182,9 @@ func (s *Server) initRootCoordClient() error {
 //
 //	stop message stream client and stop server loops
 func (s *Server) Stop() error {
+	if s.metaWarmCache != nil {
+		s.metaWarmCache.Close()
+	}
 	if !s.stateCode.CompareAndSwap(commonpb.StateCode_Healthy, commonpb.StateCode_Abnormal) {
 		return nil
 	}
@@ -1048,14 +1200,23 @@ func (s *Server) Stop() error {
 
 	s.stopServerLoop()
 
+	if s.dataNodeDetector != nil {
+		s.dataNodeDetector.Stop()
+	}
+
 	s.importScheduler.Close()
 	s.importChecker.Close()
+	s.exportScheduler.Close()
+	if s.regionReplicaShipper != nil {
+		s.regionReplicaShipper.Close()
+	}
 	s.syncSegmentsScheduler.Stop()
+	s.duplicatePKChecker.Close()
 
 	s.stopCompaction()
 	logutil.Logger(s.ctx).Info("datacoord compaction stopped")
 
-	s.taskScheduler.Stop()
+	s.taskScheduler.Drain(Params.DataCoordCfg.IndexTaskDrainTimeout.GetAsDuration(time.Second))
 	logutil.Logger(s.ctx).Info("datacoord index builder stopped")
 
 	s.cluster.Close()
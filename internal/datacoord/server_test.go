@@ -270,6 +270,22 @@ func TestGetCollectionStatistics(t *testing.T) {
 		resp, err := svr.GetCollectionStatistics(svr.ctx, req)
 		assert.NoError(t, err)
 		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Len(t, resp.GetStats(), 1)
+	})
+	t.Run("with gc safe ts", func(t *testing.T) {
+		svr := newTestServer(t)
+		defer closeTestServer(t, svr)
+
+		err := svr.meta.AdvanceGcSafeTs(0, 100)
+		assert.NoError(t, err)
+		resp, err := svr.GetCollectionStatistics(svr.ctx, &datapb.GetCollectionStatisticsRequest{
+			CollectionID: 0,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Len(t, resp.GetStats(), 2)
+		assert.Equal(t, "gc_safe_ts", resp.GetStats()[1].GetKey())
+		assert.Equal(t, "100", resp.GetStats()[1].GetValue())
 	})
 	t.Run("with closed server", func(t *testing.T) {
 		svr := newTestServer(t)
@@ -2406,6 +2422,124 @@ func TestManualCompaction(t *testing.T) {
 	})
 }
 
+func TestPreviewCompaction(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.EnableCompaction.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.EnableCompaction.Key)
+	t.Run("test preview compaction successfully", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"previewManualCompaction": func(collectionID int64, segmentIDs []int64) ([]*datapb.CompactionPlanPreview, error) {
+					return []*datapb.CompactionPlanPreview{{InputSegments: segmentIDs}}, nil
+				},
+			},
+		}
+
+		resp, err := svr.PreviewCompaction(context.TODO(), &datapb.PreviewCompactionRequest{
+			CollectionID: 1,
+			SegmentIDs:   []int64{1, 2},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(resp.GetPlans()))
+		assert.Equal(t, []int64{1, 2}, resp.GetPlans()[0].GetInputSegments())
+	})
+
+	t.Run("test preview compaction failure", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"previewManualCompaction": func(collectionID int64, segmentIDs []int64) ([]*datapb.CompactionPlanPreview, error) {
+					return nil, errors.New("mock error")
+				},
+			},
+		}
+
+		resp, err := svr.PreviewCompaction(context.TODO(), &datapb.PreviewCompactionRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("test preview compaction with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		resp, err := svr.PreviewCompaction(context.TODO(), &datapb.PreviewCompactionRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+}
+
+func TestExecuteCompactionPlans(t *testing.T) {
+	paramtable.Get().Save(Params.DataCoordCfg.EnableCompaction.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.EnableCompaction.Key)
+	plans := []*datapb.CompactionPlanPreview{{InputSegments: []int64{1, 2}}}
+	t.Run("test execute compaction plans successfully", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"executeCompactionPlans": func(collectionID int64, plans []*datapb.CompactionPlanPreview) (UniqueID, error) {
+					return 1, nil
+				},
+			},
+		}
+		mockHandler := NewMockCompactionPlanContext(t)
+		mockHandler.EXPECT().getCompactionTasksNumBySignalID(int64(1)).Return(1)
+		svr.compactionHandler = mockHandler
+
+		resp, err := svr.ExecuteCompactionPlans(context.TODO(), &datapb.ExecuteCompactionPlansRequest{
+			CollectionID: 1,
+			Plans:        plans,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(1), resp.GetCompactionID())
+		assert.Equal(t, int32(1), resp.GetCompactionPlanCount())
+
+		// nothing to execute
+		resp, err = svr.ExecuteCompactionPlans(context.TODO(), &datapb.ExecuteCompactionPlansRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(-1), resp.GetCompactionID())
+	})
+
+	t.Run("test execute compaction plans failure", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"executeCompactionPlans": func(collectionID int64, plans []*datapb.CompactionPlanPreview) (UniqueID, error) {
+					return -1, merr.WrapErrSegmentLack(1)
+				},
+			},
+		}
+
+		resp, err := svr.ExecuteCompactionPlans(context.TODO(), &datapb.ExecuteCompactionPlansRequest{
+			CollectionID: 1,
+			Plans:        plans,
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrSegmentLack)
+	})
+
+	t.Run("test execute compaction plans with compaction disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.EnableCompaction.Key, "false")
+		defer paramtable.Get().Save(Params.DataCoordCfg.EnableCompaction.Key, "true")
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := svr.ExecuteCompactionPlans(context.TODO(), &datapb.ExecuteCompactionPlansRequest{
+			CollectionID: 1,
+			Plans:        plans,
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+	})
+}
+
 func TestGetCompactionStateWithPlans(t *testing.T) {
 	t.Run("test get compaction state successfully", func(t *testing.T) {
 		svr := &Server{}
@@ -2437,6 +2571,34 @@ func TestGetCompactionStateWithPlans(t *testing.T) {
 	})
 }
 
+func TestGetCompactionPlanDetails(t *testing.T) {
+	t.Run("test get compaction plan details successfully", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+
+		mockHandler := NewMockCompactionPlanContext(t)
+		mockHandler.EXPECT().getCompactionPlanDetails(mock.Anything).Return([]*datapb.CompactionPlanDetail{
+			{PlanID: 1, CompactionID: 1, State: datapb.CompactionTaskState_executing},
+		})
+		svr.compactionHandler = mockHandler
+
+		resp, err := svr.GetCompactionPlanDetails(context.TODO(), &datapb.GetCompactionPlanDetailsRequest{
+			CompactionID: 1,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, 1, len(resp.GetPlans()))
+	})
+
+	t.Run("test get compaction plan details with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err := svr.GetCompactionPlanDetails(context.TODO(), &datapb.GetCompactionPlanDetailsRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+}
+
 func TestOptions(t *testing.T) {
 	kv := getWatchKV(t)
 	defer func() {
@@ -3161,13 +3323,13 @@ func Test_CheckHealth(t *testing.T) {
 		return channelManager
 	}
 
-	collections := map[UniqueID]*collectionInfo{
+	collections := newCollectionInfos(map[UniqueID]*collectionInfo{
 		449684528748778322: {
 			ID:            449684528748778322,
 			VChannelNames: []string{"ch1", "ch2"},
 		},
 		2: nil,
-	}
+	})
 
 	t.Run("not healthy", func(t *testing.T) {
 		ctx := context.Background()
@@ -3330,7 +3492,7 @@ func TestLoadCollectionFromRootCoord(t *testing.T) {
 	broker := broker.NewMockBroker(t)
 	s := &Server{
 		broker: broker,
-		meta:   &meta{collections: make(map[UniqueID]*collectionInfo)},
+		meta:   &meta{collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo]()},
 	}
 
 	t.Run("has collection fail with error", func(t *testing.T) {
@@ -3371,9 +3533,8 @@ func TestLoadCollectionFromRootCoord(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		err := s.loadCollectionFromRootCoord(context.TODO(), 0)
 		assert.NoError(t, err)
-		assert.Equal(t, 1, len(s.meta.collections))
-		_, ok := s.meta.collections[1]
-		assert.True(t, ok)
+		assert.Equal(t, 1, s.meta.collections.Len())
+		assert.NotNil(t, s.meta.GetCollection(1))
 	})
 }
 
@@ -3433,3 +3594,14 @@ func TestUpdateAutoBalanceConfigLoop(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func TestDataNodeHealthCheckParam(t *testing.T) {
+	paramtable.Init()
+	interval := &Params.DataCoordCfg.DataNodeHealthCheckInterval
+	common := &Params.CommonCfg.SessionFastDetectionProbeInterval
+	assert.Equal(t, common.GetAsDuration(time.Second), dataNodeHealthCheckParam(interval, common))
+
+	Params.Save(interval.Key, "1")
+	defer Params.Reset(interval.Key)
+	assert.Equal(t, time.Second, dataNodeHealthCheckParam(interval, common))
+}
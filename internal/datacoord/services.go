@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"path"
+	"sort"
 	"strconv"
 	"time"
 
@@ -33,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/componentutil"
@@ -41,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
@@ -312,7 +316,7 @@ func (s *Server) GetInsertBinlogPaths(ctx context.Context, req *datapb.GetInsert
 
 	segment = segment.Clone()
 
-	err := binlog.DecompressBinLog(storage.InsertBinlog, segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), segment.GetBinlogs())
+	err := binlog.DecompressInsertBinLogs(segment.SegmentInfo)
 	if err != nil {
 		return &datapb.GetInsertBinlogPathsResponse{
 			Status: merr.Status(err),
@@ -339,7 +343,7 @@ func (s *Server) GetInsertBinlogPaths(ctx context.Context, req *datapb.GetInsert
 }
 
 // GetCollectionStatistics returns statistics for collection
-// for now only row count is returned
+// for now the row count and the GC safe timestamp are returned
 func (s *Server) GetCollectionStatistics(ctx context.Context, req *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", req.GetCollectionID()),
@@ -356,6 +360,10 @@ func (s *Server) GetCollectionStatistics(ctx context.Context, req *datapb.GetCol
 	}
 	nums := s.meta.GetNumRowsOfCollection(req.CollectionID)
 	resp.Stats = append(resp.Stats, &commonpb.KeyValuePair{Key: "row_count", Value: strconv.FormatInt(nums, 10)})
+	// the snapshot reads and the CDC catch-up before the GC safe timestamp may miss the collected binlogs
+	if safeTs := s.meta.GetGcSafeTs(req.GetCollectionID()); safeTs > 0 {
+		resp.Stats = append(resp.Stats, &commonpb.KeyValuePair{Key: "gc_safe_ts", Value: strconv.FormatUint(safeTs, 10)})
+	}
 	log.Info("success to get collection statistics", zap.Any("response", resp))
 	return resp, nil
 }
@@ -524,6 +532,13 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 			return merr.Status(err), nil
 		}
 
+		// the rows synced since the last timetick are reported by the checkpoint
+		for _, cp := range req.GetCheckPoints() {
+			if cp.GetSegmentID() == segment.GetID() {
+				s.channelWriteStats.Record(segment.GetInsertChannel(), segment.GetID(), segment.currRows, cp.GetNumOfRows())
+			}
+		}
+
 		// Set segment state
 		if req.GetDropped() {
 			// segmentManager manages growing segments
@@ -565,6 +580,10 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 		return merr.Success(), nil
 	}
 
+	if req.GetFlushed() || req.GetDropped() {
+		s.channelWriteStats.RemoveSegment(req.GetChannel(), req.GetSegmentID())
+	}
+
 	// notify building index and compaction for "flushing/flushed" level one segment
 	if req.GetFlushed() {
 		// notify building index
@@ -638,6 +657,7 @@ func (s *Server) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtual
 	}
 	s.segmentManager.DropSegmentsOfChannel(ctx, channel)
 	s.compactionHandler.removeTasksByChannel(channel)
+	s.channelWriteStats.RemoveChannel(channel)
 	metrics.DataCoordCheckpointUnixSeconds.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), channel)
 	s.meta.MarkChannelCheckpointDropped(ctx, channel)
 
@@ -884,12 +904,13 @@ func (s *Server) GetRecoveryInfoV2(ctx context.Context, req *datapb.GetRecoveryI
 
 		if Params.CommonCfg.EnableStorageV2.GetAsBool() {
 			segmentInfos = append(segmentInfos, &datapb.SegmentInfo{
-				ID:            segment.ID,
-				PartitionID:   segment.PartitionID,
-				CollectionID:  segment.CollectionID,
-				InsertChannel: segment.InsertChannel,
-				NumOfRows:     segment.NumOfRows,
-				Level:         segment.GetLevel(),
+				ID:             segment.ID,
+				PartitionID:    segment.PartitionID,
+				CollectionID:   segment.CollectionID,
+				InsertChannel:  segment.InsertChannel,
+				NumOfRows:      segment.NumOfRows,
+				Level:          segment.GetLevel(),
+				CompactionFrom: segment.GetCompactionFrom(),
 			})
 			continue
 		}
@@ -909,12 +930,13 @@ func (s *Server) GetRecoveryInfoV2(ctx context.Context, req *datapb.GetRecoveryI
 		}
 
 		segmentInfos = append(segmentInfos, &datapb.SegmentInfo{
-			ID:            segment.ID,
-			PartitionID:   segment.PartitionID,
-			CollectionID:  segment.CollectionID,
-			InsertChannel: segment.InsertChannel,
-			NumOfRows:     rowCount,
-			Level:         segment.GetLevel(),
+			ID:             segment.ID,
+			PartitionID:    segment.PartitionID,
+			CollectionID:   segment.CollectionID,
+			InsertChannel:  segment.InsertChannel,
+			NumOfRows:      rowCount,
+			Level:          segment.GetLevel(),
+			CompactionFrom: segment.GetCompactionFrom(),
 		})
 	}
 
@@ -1075,6 +1097,17 @@ func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.VectorStatsMetrics {
+		metrics, err := s.getVectorStatsMetrics(ctx, req)
+		if err != nil {
+			log.Warn("DataCoord GetMetrics of vector stats failed", zap.String("req", req.Request), zap.Error(err))
+			return &milvuspb.GetMetricsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		return metrics, nil
+	}
+
 	log.RatedWarn(60.0, "DataCoord.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("nodeID", paramtable.GetNodeID()),
 		zap.String("req", req.Request),
@@ -1134,6 +1167,682 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 	return resp, nil
 }
 
+// PreviewCompaction generates the mix compaction plans of the collection as ManualCompaction does without executing them,
+// only the given segments are planned if specified.
+func (s *Server) PreviewCompaction(ctx context.Context, req *datapb.PreviewCompactionRequest) (*datapb.PreviewCompactionResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int("segmentNum", len(req.GetSegmentIDs())),
+	)
+	log.Info("received preview compaction")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.PreviewCompactionResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return &datapb.PreviewCompactionResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("compaction disabled")),
+		}, nil
+	}
+
+	plans, err := s.compactionTrigger.previewManualCompaction(req.GetCollectionID(), req.GetSegmentIDs())
+	if err != nil {
+		log.Warn("failed to preview compaction", zap.Error(err))
+		return &datapb.PreviewCompactionResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	log.Info("success to preview compaction", zap.Int("planNum", len(plans)))
+	return &datapb.PreviewCompactionResponse{
+		Status: merr.Success(),
+		Plans:  plans,
+	}, nil
+}
+
+// ExecuteCompactionPlans executes the plans previewed by PreviewCompaction as they are,
+// the plans are rejected if any of the input segments is no longer compactable as planned.
+func (s *Server) ExecuteCompactionPlans(ctx context.Context, req *datapb.ExecuteCompactionPlansRequest) (*datapb.ExecuteCompactionPlansResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int("planNum", len(req.GetPlans())),
+	)
+	log.Info("received execute compaction plans")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ExecuteCompactionPlansResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return &datapb.ExecuteCompactionPlansResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("compaction disabled")),
+		}, nil
+	}
+
+	resp := &datapb.ExecuteCompactionPlansResponse{
+		Status:       merr.Success(),
+		CompactionID: -1,
+	}
+	if len(req.GetPlans()) == 0 {
+		return resp, nil
+	}
+
+	id, err := s.compactionTrigger.executeCompactionPlans(req.GetCollectionID(), req.GetPlans())
+	if err != nil {
+		log.Warn("failed to execute compaction plans", zap.Error(err))
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	if taskCnt := s.compactionHandler.getCompactionTasksNumBySignalID(id); taskCnt > 0 {
+		resp.CompactionID = id
+		resp.CompactionPlanCount = int32(taskCnt)
+	}
+	log.Info("success to execute compaction plans", zap.Int64("compactionID", resp.GetCompactionID()),
+		zap.Int32("taskNum", resp.GetCompactionPlanCount()))
+	return resp, nil
+}
+
+// ListExpiryCompactions returns the latest rounds of the expiry compaction of the collections,
+// the progress of a round is got by GetCompactionState with its compactionID.
+func (s *Server) ListExpiryCompactions(ctx context.Context, req *datapb.ListExpiryCompactionsRequest) (*datapb.ListExpiryCompactionsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListExpiryCompactionsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &datapb.ListExpiryCompactionsResponse{
+		Status: merr.Success(),
+		Infos:  s.compactionTriggerManager.GetExpiryCompactions(req.GetCollectionIDs()),
+	}, nil
+}
+
+// ExportSegmentManifests returns the manifest of the flushed segments of the collection at the timestamp,
+// the external readers scan the files listed from the object storage directly and apply the deltalogs.
+// The segments compacted after the manifest exported are kept until the gc drop tolerance is passed.
+func (s *Server) ExportSegmentManifests(ctx context.Context, req *datapb.ExportSegmentManifestsRequest) (*datapb.ExportSegmentManifestsResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()),
+		zap.Uint64("timestamp", req.GetTimestamp()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ExportSegmentManifestsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	collection, err := s.handler.GetCollection(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to get collection", zap.Error(err))
+		return &datapb.ExportSegmentManifestsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if collection == nil {
+		return &datapb.ExportSegmentManifestsResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotFound(req.GetCollectionID())),
+		}, nil
+	}
+
+	ts := req.GetTimestamp()
+	if ts == 0 {
+		ts, err = s.allocator.allocTimestamp(ctx)
+		if err != nil {
+			log.Warn("failed to allocate timestamp", zap.Error(err))
+			return &datapb.ExportSegmentManifestsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+	}
+
+	partitionSet := typeutil.NewSet(req.GetPartitionIDs()...)
+	segments := s.meta.SelectSegments(WithCollection(req.GetCollectionID()), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetState() == commonpb.SegmentState_Flushed &&
+			!segment.GetIsImporting() &&
+			(partitionSet.Len() == 0 || partitionSet.Contain(segment.GetPartitionID())) &&
+			segment.GetStartPosition().GetTimestamp() <= ts
+	}))
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+
+	manifests := make([]*datapb.SegmentManifest, 0, len(segments))
+	for _, segment := range segments {
+		manifest, err := newSegmentManifest(segment)
+		if err != nil {
+			log.Warn("failed to export segment manifest", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			return &datapb.ExportSegmentManifestsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		manifests = append(manifests, manifest)
+	}
+	log.Info("export segment manifests done", zap.Int("segmentNum", len(manifests)))
+	return &datapb.ExportSegmentManifestsResponse{
+		Status:       merr.Success(),
+		CollectionID: req.GetCollectionID(),
+		Schema:       collection.Schema,
+		Timestamp:    ts,
+		BucketName:   Params.MinioCfg.BucketName.GetValue(),
+		Segments:     manifests,
+	}, nil
+}
+
+// newSegmentManifest lists the files of the segment with the full log paths.
+func newSegmentManifest(segment *SegmentInfo) (*datapb.SegmentManifest, error) {
+	cloned := segment.Clone()
+	if err := binlog.DecompressBinLogs(cloned.SegmentInfo); err != nil {
+		return nil, err
+	}
+	return &datapb.SegmentManifest{
+		SegmentID:     cloned.GetID(),
+		PartitionID:   cloned.GetPartitionID(),
+		InsertChannel: cloned.GetInsertChannel(),
+		Level:         cloned.GetLevel(),
+		NumOfRows:     cloned.GetNumOfRows(),
+		Binlogs:       cloned.GetBinlogs(),
+		Statslogs:     cloned.GetStatslogs(),
+		Deltalogs:     cloned.GetDeltalogs(),
+		FieldStats:    cloned.GetFieldStats(),
+		StartPosition: cloned.GetStartPosition(),
+		DmlPosition:   cloned.GetDmlPosition(),
+	}, nil
+}
+
+// AcquireSegmentReference acquires a lease on the binlogs of the segments for an external reader,
+// the segments are kept from GC until the reference is released or its lease expires.
+// The lease of an existing reference is renewed if the referenceID is set.
+func (s *Server) AcquireSegmentReference(ctx context.Context, req *datapb.AcquireSegmentReferenceRequest) (*datapb.AcquireSegmentReferenceResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()),
+		zap.Int64("referenceID", req.GetReferenceID()),
+		zap.Int64("leaseSeconds", req.GetLeaseSeconds()),
+		zap.String("holder", req.GetHolder()),
+	)
+	log.Info("receive AcquireSegmentReference request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.AcquireSegmentReferenceResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if req.GetLeaseSeconds() < 0 {
+		return &datapb.AcquireSegmentReferenceResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("lease seconds must not be negative")),
+		}, nil
+	}
+	lease := Params.DataCoordCfg.SegmentReferenceDefaultLease.GetAsDuration(time.Second)
+	if req.GetLeaseSeconds() > 0 {
+		lease = time.Duration(req.GetLeaseSeconds()) * time.Second
+	}
+	if maxLease := Params.DataCoordCfg.SegmentReferenceMaxLease.GetAsDuration(time.Second); lease > maxLease {
+		lease = maxLease
+	}
+	expireTime := time.Now().Add(lease).UnixMilli()
+
+	if req.GetReferenceID() != 0 {
+		reference, err := s.meta.segmentReferenceMeta.RenewReference(req.GetReferenceID(), expireTime)
+		if err != nil {
+			log.Warn("failed to renew segment reference", zap.Error(err))
+			return &datapb.AcquireSegmentReferenceResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		log.Info("segment reference renewed", zap.Int64("expireTime", reference.GetExpireTime()))
+		return &datapb.AcquireSegmentReferenceResponse{
+			Status:      merr.Success(),
+			ReferenceID: reference.GetReferenceID(),
+			ExpireTime:  reference.GetExpireTime(),
+		}, nil
+	}
+
+	if len(req.GetSegmentIDs()) == 0 {
+		return &datapb.AcquireSegmentReferenceResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("no segment to reference")),
+		}, nil
+	}
+	for _, segmentID := range req.GetSegmentIDs() {
+		segment := s.meta.GetSegment(segmentID)
+		if segment == nil {
+			return &datapb.AcquireSegmentReferenceResponse{
+				Status: merr.Status(merr.WrapErrSegmentNotFound(segmentID)),
+			}, nil
+		}
+		if segment.GetCollectionID() != req.GetCollectionID() {
+			return &datapb.AcquireSegmentReferenceResponse{
+				Status: merr.Status(merr.WrapErrParameterInvalidMsg("segment %d doesn't belong to collection %d", segmentID, req.GetCollectionID())),
+			}, nil
+		}
+	}
+
+	referenceID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		log.Warn("failed to allocate referenceID", zap.Error(err))
+		return &datapb.AcquireSegmentReferenceResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	reference := &datapb.SegmentReference{
+		ReferenceID:  referenceID,
+		CollectionID: req.GetCollectionID(),
+		SegmentIDs:   lo.Uniq(req.GetSegmentIDs()),
+		Holder:       req.GetHolder(),
+		ExpireTime:   expireTime,
+	}
+	if err := s.meta.segmentReferenceMeta.AddReference(reference); err != nil {
+		log.Warn("failed to save segment reference", zap.Error(err))
+		return &datapb.AcquireSegmentReferenceResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log.Info("segment reference acquired", zap.Int64("newReferenceID", referenceID), zap.Int64("expireTime", expireTime))
+	return &datapb.AcquireSegmentReferenceResponse{
+		Status:      merr.Success(),
+		ReferenceID: referenceID,
+		ExpireTime:  expireTime,
+	}, nil
+}
+
+// ReleaseSegmentReference releases the reference acquired by AcquireSegmentReference,
+// releasing a reference already released or expired succeeds.
+func (s *Server) ReleaseSegmentReference(ctx context.Context, req *datapb.ReleaseSegmentReferenceRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("referenceID", req.GetReferenceID()))
+	log.Info("receive ReleaseSegmentReference request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if err := s.meta.segmentReferenceMeta.RemoveReference(req.GetReferenceID()); err != nil {
+		log.Warn("failed to release segment reference", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("segment reference released")
+	return merr.Success(), nil
+}
+
+// CloneSegments clones the flushed segments of the source collection into the target collection with the same schema,
+// the cloned segments share the insert binlogs and the index files of the source segments until compaction rewrites them.
+// The segments of the partitions absent from the partition mapping and the growing data are not cloned.
+func (s *Server) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("sourceCollectionID", req.GetSourceCollectionID()),
+		zap.Int64("targetCollectionID", req.GetTargetCollectionID()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.CloneSegmentsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	collections := make([]*collectionInfo, 0, 2)
+	for _, collectionID := range []int64{req.GetSourceCollectionID(), req.GetTargetCollectionID()} {
+		collection, err := s.handler.GetCollection(ctx, collectionID)
+		if err != nil {
+			log.Warn("failed to get collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+			return &datapb.CloneSegmentsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		if collection == nil {
+			return &datapb.CloneSegmentsResponse{
+				Status: merr.Status(merr.WrapErrCollectionNotFound(collectionID)),
+			}, nil
+		}
+		collections = append(collections, collection)
+	}
+	source, target := collections[0], collections[1]
+	if err := checkCloneCollections(source, target); err != nil {
+		log.Warn("failed to clone segments", zap.Error(err))
+		return &datapb.CloneSegmentsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if source.ID == target.ID || len(req.GetPartitionMapping()) == 0 {
+		return &datapb.CloneSegmentsResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("the target collection should differ from the source and the partition mapping should not be empty")),
+		}, nil
+	}
+
+	// the channels are mapped by the shard indexes, the L0 segments of all partitions are cloned as well
+	channelMapping := make(map[string]string, len(source.VChannelNames))
+	for i, channel := range source.VChannelNames {
+		channelMapping[channel] = target.VChannelNames[i]
+	}
+	partitionMapping := req.GetPartitionMapping()
+	segments := s.meta.SelectSegments(WithCollection(source.ID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		_, ok := partitionMapping[segment.GetPartitionID()]
+		return segment.GetState() == commonpb.SegmentState_Flushed &&
+			!segment.GetIsImporting() &&
+			(ok || segment.GetPartitionID() == common.AllPartitionsID)
+	}))
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+	if len(segments) == 0 {
+		log.Info("no segment to clone")
+		return &datapb.CloneSegmentsResponse{
+			Status: merr.Success(),
+		}, nil
+	}
+
+	startID, _, err := s.allocator.allocN(int64(len(segments)))
+	if err != nil {
+		log.Warn("failed to allocate segment ids", zap.Error(err))
+		return &datapb.CloneSegmentsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	basePositions := make(map[string]*msgpb.MsgPosition)
+	segmentIDs := make([]int64, 0, len(segments))
+	var numRows int64
+	for i, segment := range segments {
+		channel := channelMapping[segment.GetInsertChannel()]
+		basePos, ok := basePositions[channel]
+		if !ok {
+			basePos = s.meta.GetChannelCheckpoint(channel)
+			if basePos == nil {
+				basePos = getCollectionStartPosition(channel, target)
+			}
+			if basePos == nil {
+				err := merr.WrapErrChannelNotFound(channel, "no checkpoint or start position")
+				log.Warn("failed to clone segments", zap.Error(err))
+				return &datapb.CloneSegmentsResponse{
+					Status: merr.Status(err),
+				}, nil
+			}
+			basePositions[channel] = basePos
+		}
+		partitionID := segment.GetPartitionID()
+		if partitionID != common.AllPartitionsID {
+			partitionID = partitionMapping[partitionID]
+		}
+
+		cloned, err := newClonedSegment(ctx, s.meta.chunkManager, segment, &datapb.SegmentInfo{
+			ID:            startID + int64(i),
+			CollectionID:  target.ID,
+			PartitionID:   partitionID,
+			InsertChannel: channel,
+		}, basePos)
+		if err == nil {
+			err = s.cloneSegmentIndexes(ctx, segment, cloned)
+		}
+		if err == nil {
+			err = s.meta.AddSegment(ctx, cloned)
+		}
+		if err != nil {
+			log.Warn("failed to clone segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			return &datapb.CloneSegmentsResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		segmentIDs = append(segmentIDs, cloned.GetID())
+		if cloned.GetLevel() != datapb.SegmentLevel_L0 {
+			numRows += cloned.GetNumOfRows()
+		}
+	}
+	log.Info("clone segments done", zap.Int("segmentNum", len(segmentIDs)), zap.Int64("numRows", numRows))
+	return &datapb.CloneSegmentsResponse{
+		Status:     merr.Success(),
+		SegmentIDs: segmentIDs,
+		NumOfRows:  numRows,
+	}, nil
+}
+
+// ExportV2 creates the job to export the flushed segments of the collection as Parquet files,
+// the rows deleted by the L0 segments before the allocated timestamp are filtered out by the DataNodes.
+func (s *Server) ExportV2(ctx context.Context, req *datapb.ExportV2Request) (*datapb.ExportV2Response, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()),
+		zap.String("outputPath", req.GetOutputPath()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ExportV2Response{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if req.GetOutputPath() == "" {
+		return &datapb.ExportV2Response{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("output path is empty")),
+		}, nil
+	}
+
+	collection, err := s.handler.GetCollection(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to get collection", zap.Error(err))
+		return &datapb.ExportV2Response{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if collection == nil {
+		return &datapb.ExportV2Response{
+			Status: merr.Status(merr.WrapErrCollectionNotFound(req.GetCollectionID())),
+		}, nil
+	}
+	for _, partitionID := range req.GetPartitionIDs() {
+		if !lo.Contains(collection.Partitions, partitionID) {
+			return &datapb.ExportV2Response{
+				Status: merr.Status(merr.WrapErrPartitionNotFound(partitionID)),
+			}, nil
+		}
+	}
+
+	ts, err := s.allocator.allocTimestamp(ctx)
+	if err != nil {
+		log.Warn("failed to allocate timestamp", zap.Error(err))
+		return &datapb.ExportV2Response{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	partitionSet := typeutil.NewSet(req.GetPartitionIDs()...)
+	segments := s.meta.SelectSegments(WithCollection(req.GetCollectionID()), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetState() == commonpb.SegmentState_Flushed &&
+			segment.GetLevel() != datapb.SegmentLevel_L0 &&
+			!segment.GetIsImporting() &&
+			segment.GetNumOfRows() > 0 &&
+			(partitionSet.Len() == 0 || partitionSet.Contain(segment.GetPartitionID()))
+	}))
+	l0Segments := s.meta.SelectSegments(WithCollection(req.GetCollectionID()), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetState() == commonpb.SegmentState_Flushed &&
+			segment.GetLevel() == datapb.SegmentLevel_L0
+	}))
+	toExportSegment := func(segment *SegmentInfo, _ int) *datapb.ExportSegment {
+		return &datapb.ExportSegment{
+			SegmentID:   segment.GetID(),
+			PartitionID: segment.GetPartitionID(),
+			NumRows:     segment.GetNumOfRows(),
+		}
+	}
+	groups := groupSegmentsBySize(segments, Params.DataCoordCfg.MaxSizeInMBPerExportTask.GetAsInt64()*1024*1024)
+
+	idStart, _, err := s.allocator.allocN(int64(len(groups)) + 1)
+	if err != nil {
+		log.Warn("failed to allocate ids", zap.Error(err))
+		return &datapb.ExportV2Response{
+			Status: merr.Status(err),
+		}, nil
+	}
+	jobID := idStart
+	// the tasks are saved before the job, so that a restored job always sees all its tasks
+	for i, group := range groups {
+		task := &datapb.ExportTask{
+			JobID:        jobID,
+			TaskID:       idStart + int64(i) + 1,
+			CollectionID: req.GetCollectionID(),
+			Segments:     lo.Map(group, toExportSegment),
+			L0Segments:   lo.Map(l0Segments, toExportSegment),
+			NodeID:       NullNodeID,
+			State:        datapb.ImportTaskStateV2_Pending,
+		}
+		err = s.exportMeta.AddTask(task)
+		if err != nil {
+			log.Warn("failed to add export task", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+			return &datapb.ExportV2Response{
+				Status: merr.Status(err),
+			}, nil
+		}
+	}
+	job := &datapb.ExportJob{
+		JobID:        jobID,
+		DbID:         collection.DatabaseID,
+		CollectionID: req.GetCollectionID(),
+		PartitionIDs: req.GetPartitionIDs(),
+		Schema:       collection.Schema,
+		OutputPath:   req.GetOutputPath(),
+		Ts:           ts,
+		State:        datapb.ExportJobState_ExportJobExporting,
+		StartTime:    time.Now().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	err = s.exportMeta.AddJob(job)
+	if err != nil {
+		log.Warn("failed to add export job", zap.Error(err))
+		return &datapb.ExportV2Response{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log.Info("export job created", zap.Int64("jobID", jobID), zap.Uint64("ts", ts),
+		zap.Int("segmentNum", len(segments)), zap.Int("l0SegmentNum", len(l0Segments)), zap.Int("taskNum", len(groups)))
+	return &datapb.ExportV2Response{
+		Status: merr.Success(),
+		JobID:  jobID,
+	}, nil
+}
+
+// groupSegmentsBySize groups the segments in the order of segment ID, the total size of each group
+// does not exceed maxSize unless the group has only one segment.
+func groupSegmentsBySize(segments []*SegmentInfo, maxSize int64) [][]*SegmentInfo {
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+	groups := make([][]*SegmentInfo, 0)
+	var (
+		group []*SegmentInfo
+		size  int64
+	)
+	for _, segment := range segments {
+		segmentSize := segment.getSegmentSize()
+		if len(group) > 0 && size+segmentSize > maxSize {
+			groups = append(groups, group)
+			group, size = nil, 0
+		}
+		group = append(group, segment)
+		size += segmentSize
+	}
+	if len(group) > 0 {
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// GetExportProgress returns the state of the export job and the files written so far.
+func (s *Server) GetExportProgress(ctx context.Context, req *datapb.GetExportProgressRequest) (*datapb.GetExportProgressResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetExportProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	job := s.exportMeta.GetJob(req.GetJobID())
+	if job == nil {
+		return &datapb.GetExportProgressResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("export job does not exist, jobID=%d", req.GetJobID())),
+		}, nil
+	}
+	resp := &datapb.GetExportProgressResponse{
+		Status:       merr.Success(),
+		State:        job.GetState(),
+		Reason:       job.GetReason(),
+		StartTime:    job.GetStartTime(),
+		CompleteTime: job.GetCompleteTime(),
+	}
+	tasks := s.exportMeta.GetTaskBy(WithExportJob(job.GetJobID()))
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].GetTaskID() < tasks[j].GetTaskID() })
+	for _, task := range tasks {
+		for _, segment := range task.GetSegments() {
+			resp.TotalSegments++
+			if segment.GetExported() {
+				resp.ExportedSegments++
+				resp.ExportedRows += segment.GetExportedRows()
+				resp.OutputFiles = append(resp.OutputFiles, segment.GetOutputFiles()...)
+			}
+		}
+	}
+	switch {
+	case job.GetState() == datapb.ExportJobState_ExportJobCompleted:
+		resp.Progress = 100
+	case resp.GetTotalSegments() > 0:
+		resp.Progress = resp.GetExportedSegments() * 100 / resp.GetTotalSegments()
+	}
+	return resp, nil
+}
+
+// GetRegionReplicaStatus returns the role of the region and the staleness of the collections shipped from the primary region.
+func (s *Server) GetRegionReplicaStatus(ctx context.Context, req *datapb.GetRegionReplicaStatusRequest) (*datapb.GetRegionReplicaStatusResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetRegionReplicaStatusResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp := &datapb.GetRegionReplicaStatusResponse{
+		Status: merr.Success(),
+		Role:   Params.CommonCfg.RegionReplicaRole.GetValue(),
+	}
+	if s.regionReplicaShipper != nil {
+		resp.Collections = s.regionReplicaShipper.GetStatus()
+	}
+	return resp, nil
+}
+
+// FailoverRegionReplica turns the secondary region into the primary region, the shipping stops
+// and the writes are accepted once the role is refreshed by the other components.
+func (s *Server) FailoverRegionReplica(ctx context.Context, req *datapb.FailoverRegionReplicaRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if Params.CommonCfg.RegionReplicaRole.GetValue() != util.RegionReplicaRoleSecondary {
+		return merr.Status(merr.WrapErrServiceUnavailable("the region is not a secondary region")), nil
+	}
+
+	if s.regionReplicaShipper != nil {
+		s.regionReplicaShipper.Close()
+	}
+	// the role is saved into the config of etcd, which is refreshed by all the components of the region
+	key := path.Join(Params.EtcdCfg.RootPath.GetValue(), "config", Params.CommonCfg.RegionReplicaRole.Key)
+	if _, err := s.etcdCli.Put(ctx, key, util.RegionReplicaRolePrimary); err != nil {
+		log.Warn("failed to save the region replica role", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	Params.Save(Params.CommonCfg.RegionReplicaRole.Key, util.RegionReplicaRolePrimary)
+	log.Info("region replica failed over to the primary region")
+	return merr.Success(), nil
+}
+
+// GetCompactionPlanDetails returns the details of the compaction plans, including the input and result segments,
+// the bytes read and written, the duration and the executor node, the finished plans are retained in a bounded history.
+func (s *Server) GetCompactionPlanDetails(ctx context.Context, req *datapb.GetCompactionPlanDetailsRequest) (*datapb.GetCompactionPlanDetailsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetCompactionPlanDetailsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return &datapb.GetCompactionPlanDetailsResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("compaction disabled")),
+		}, nil
+	}
+
+	return &datapb.GetCompactionPlanDetailsResponse{
+		Status: merr.Success(),
+		Plans:  s.compactionHandler.getCompactionPlanDetails(req),
+	}, nil
+}
+
 // GetCompactionState gets the state of a compaction
 func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	log := log.Ctx(ctx).With(
@@ -1237,17 +1946,20 @@ func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsReq
 }
 
 // GetFlushState gets the flush state of the collection based on the provided flush ts and segment IDs.
-func (s *Server) GetFlushState(ctx context.Context, req *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+func (s *Server) GetFlushState(ctx context.Context, req *datapb.GetFlushStateRequest) (*datapb.GetFlushStateResponse, error) {
 	log := log.Ctx(ctx).With(zap.Int64("collection", req.GetCollectionID()),
 		zap.Time("flushTs", tsoutil.PhysicalTime(req.GetFlushTs()))).
 		WithRateGroup("dc.GetFlushState", 1, 60)
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
-		return &milvuspb.GetFlushStateResponse{
+		return &datapb.GetFlushStateResponse{
 			Status: merr.Status(err),
 		}, nil
 	}
 
-	resp := &milvuspb.GetFlushStateResponse{Status: merr.Success()}
+	resp := &datapb.GetFlushStateResponse{Status: merr.Success()}
+	if req.GetWithSegmentProgress() {
+		resp.SegmentProgress = s.getSegmentFlushProgress(req)
+	}
 	if len(req.GetSegmentIDs()) > 0 {
 		var unflushed []UniqueID
 		for _, sid := range req.GetSegmentIDs() {
@@ -1292,6 +2004,36 @@ func (s *Server) GetFlushState(ctx context.Context, req *datapb.GetFlushStateReq
 	return resp, nil
 }
 
+// getSegmentFlushProgress returns the flush progress of the unflushed segments of the request,
+// or the ones of the collection if no segment is provided.
+func (s *Server) getSegmentFlushProgress(req *datapb.GetFlushStateRequest) []*datapb.SegmentFlushProgress {
+	var segments []*SegmentInfo
+	if len(req.GetSegmentIDs()) > 0 {
+		for _, sid := range req.GetSegmentIDs() {
+			segment := s.meta.GetHealthySegment(sid)
+			if segment == nil || isFlushState(segment.GetState()) {
+				continue
+			}
+			segments = append(segments, segment)
+		}
+	} else {
+		segments = s.meta.SelectSegments(WithCollection(req.GetCollectionID()), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+			return isSegmentHealthy(segment) && !isFlushState(segment.GetState())
+		}))
+	}
+
+	return lo.Map(segments, func(segment *SegmentInfo, _ int) *datapb.SegmentFlushProgress {
+		if progress, ok := s.flushProgress.get(segment.GetID()); ok {
+			return progress
+		}
+		// not reported yet, the owning DataNode is unknown
+		return &datapb.SegmentFlushProgress{
+			SegmentID: segment.GetID(),
+			Channel:   segment.GetInsertChannel(),
+		}
+	})
+}
+
 // GetFlushAllState checks if all DML messages before `FlushAllTs` have been flushed.
 func (s *Server) GetFlushAllState(ctx context.Context, req *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
 	log := log.Ctx(ctx)
@@ -1420,6 +2162,7 @@ func (s *Server) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDat
 			return merr.Status(merr.WrapErrServiceInternal("fail to handle Datanode Timetick Msg")), nil
 		}
 	}
+	s.flushProgress.update(req.GetBase().GetSourceID(), req.GetFlushProgress())
 
 	return merr.Success(), nil
 }
@@ -1608,6 +2351,27 @@ func (s *Server) GcControl(ctx context.Context, request *datapb.GcControlRequest
 			status.Reason = fmt.Sprintf("failed to pause gc, %s", err.Error())
 			return status, nil
 		}
+	case datapb.GcCommand_PruneSegmentIndex:
+		retention := Params.DataCoordCfg.GCSegmentIndexRetention.GetAsDuration(time.Second)
+		kv := lo.FindOrElse(request.GetParams(), nil, func(kv *commonpb.KeyValuePair) bool {
+			return kv.GetKey() == "retention"
+		})
+		if kv != nil && kv.GetValue() != "" {
+			retentionSeconds, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err != nil {
+				status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+				status.Reason = fmt.Sprintf("retention not valid, %s", err.Error())
+				return status, nil
+			}
+			retention = time.Duration(retentionSeconds) * time.Second
+		}
+		pruned, err := s.garbageCollector.PruneSegmentIndexes(ctx, retention)
+		if err != nil {
+			status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			status.Reason = fmt.Sprintf("failed to prune segment index, %s", err.Error())
+			return status, nil
+		}
+		log.Ctx(ctx).Info("prune segment index done", zap.Duration("retention", retention), zap.Int("pruned", pruned))
 	default:
 		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		status.Reason = fmt.Sprintf("unknown gc command: %d", request.GetCommand())
@@ -1617,6 +2381,167 @@ func (s *Server) GcControl(ctx context.Context, request *datapb.GcControlRequest
 	return status, nil
 }
 
+// GcDryRun lists the files the garbage collection would remove with the tolerances, nothing is removed.
+func (s *Server) GcDryRun(ctx context.Context, req *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GcDryRunResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	getTolerance := func(key string, defaultValue time.Duration) (time.Duration, error) {
+		kv := lo.FindOrElse(req.GetParams(), nil, func(kv *commonpb.KeyValuePair) bool {
+			return kv.GetKey() == key
+		})
+		if kv == nil || kv.GetValue() == "" {
+			return defaultValue, nil
+		}
+		seconds, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+		if err != nil {
+			return 0, merr.WrapErrParameterInvalidMsg("%s not valid, %s", key, err.Error())
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	missingTolerance, err := getTolerance("missing_tolerance", s.garbageCollector.option.missingTolerance)
+	if err != nil {
+		return &datapb.GcDryRunResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	dropTolerance, err := getTolerance("drop_tolerance", s.garbageCollector.option.dropTolerance)
+	if err != nil {
+		return &datapb.GcDryRunResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	resp, err := s.garbageCollector.DryRun(ctx, missingTolerance, dropTolerance, req.GetLimit())
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to dry run garbage collection", zap.Error(err))
+		return &datapb.GcDryRunResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	resp.Status = merr.Success()
+	return resp, nil
+}
+
+// CheckDuplicatePrimaryKeys starts a job to detect the primary keys duplicated across the segments of the collection.
+func (s *Server) CheckDuplicatePrimaryKeys(ctx context.Context, req *datapb.CheckDuplicatePrimaryKeysRequest) (*datapb.CheckDuplicatePrimaryKeysResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.CheckDuplicatePrimaryKeysResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	jobID, err := s.duplicatePKChecker.Submit(ctx, req.GetCollectionID(), req.GetScheduleCompaction())
+	if err != nil {
+		log.Warn("failed to submit duplicate primary keys check", zap.Error(err))
+		return &datapb.CheckDuplicatePrimaryKeysResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log.Info("duplicate primary keys check submitted", zap.Int64("jobID", jobID), zap.Bool("scheduleCompaction", req.GetScheduleCompaction()))
+	return &datapb.CheckDuplicatePrimaryKeysResponse{
+		Status: merr.Success(),
+		JobID:  jobID,
+	}, nil
+}
+
+// GetDuplicatePrimaryKeysReport returns the report of the duplicate primary keys check job.
+func (s *Server) GetDuplicatePrimaryKeysReport(ctx context.Context, req *datapb.GetDuplicatePrimaryKeysReportRequest) (*datapb.GetDuplicatePrimaryKeysReportResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetDuplicatePrimaryKeysReportResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	report, ok := s.duplicatePKChecker.GetReport(req.GetJobID())
+	if !ok {
+		return &datapb.GetDuplicatePrimaryKeysReportResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("duplicate primary keys check job %d not found", req.GetJobID())),
+		}, nil
+	}
+	return &datapb.GetDuplicatePrimaryKeysReportResponse{
+		Status: merr.Success(),
+		Report: report,
+	}, nil
+}
+
+// ListDatabaseTaskQuotas returns the background task quotas of the databases and their usage.
+func (s *Server) ListDatabaseTaskQuotas(ctx context.Context, req *datapb.ListDatabaseTaskQuotasRequest) (*datapb.ListDatabaseTaskQuotasResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListDatabaseTaskQuotasResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	var quotas map[string]databaseQuota
+	if req.GetDbName() != "" {
+		// the database is described if it's not cached yet
+		quotas = map[string]databaseQuota{req.GetDbName(): s.databaseQuotas.get(req.GetDbName())}
+	} else {
+		quotas = s.databaseQuotas.list()
+	}
+	var running map[string]map[indexpb.JobType]int
+	if s.taskScheduler != nil {
+		running = s.taskScheduler.countDatabaseTasks()
+	}
+	var slotUsage map[string]int64
+	if s.compactionHandler != nil {
+		slotUsage = s.compactionHandler.getDatabaseSlotUsage()
+	}
+
+	dbNames := typeutil.NewSet[string]()
+	if req.GetDbName() != "" {
+		dbNames.Insert(req.GetDbName())
+	} else {
+		dbNames.Insert(lo.Keys(quotas)...)
+		dbNames.Insert(lo.Keys(running)...)
+		dbNames.Insert(lo.Keys(slotUsage)...)
+	}
+	result := make([]*datapb.DatabaseTaskQuota, 0, dbNames.Len())
+	for _, dbName := range dbNames.Collect() {
+		quota := quotas[dbName]
+		result = append(result, &datapb.DatabaseTaskQuota{
+			DbName:              dbName,
+			MaxIndexTasks:       int64(quota.indexTasks),
+			RunningIndexTasks:   int64(running[dbName][indexpb.JobType_JobTypeIndexJob]),
+			MaxAnalyzeTasks:     int64(quota.analyzeTasks),
+			RunningAnalyzeTasks: int64(running[dbName][indexpb.JobType_JobTypeAnalyzeJob]),
+			MaxCompactionSlots:  quota.compactionSlots,
+			UsedCompactionSlots: slotUsage[dbName],
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetDbName() < result[j].GetDbName()
+	})
+	return &datapb.ListDatabaseTaskQuotasResponse{
+		Status: merr.Success(),
+		Quotas: result,
+	}, nil
+}
+
+// GetCollectionDataSummaries returns the row counts, sizes and last modifications of the data of the collections.
+func (s *Server) GetCollectionDataSummaries(ctx context.Context, req *datapb.GetCollectionDataSummariesRequest) (*datapb.GetCollectionDataSummariesResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetCollectionDataSummariesResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	summaries := s.meta.GetCollectionDataSummaries(req.GetCollectionIDs())
+	result := make([]*datapb.CollectionDataSummary, 0, len(req.GetCollectionIDs()))
+	for _, collectionID := range lo.Uniq(req.GetCollectionIDs()) {
+		result = append(result, summaries[collectionID])
+	}
+	return &datapb.GetCollectionDataSummariesResponse{
+		Status:    merr.Success(),
+		Summaries: result,
+	}, nil
+}
+
 func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
 		return &internalpb.ImportResponse{
@@ -1646,6 +2571,15 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 		timeoutTs = tsoutil.AddPhysicalDurationOnTs(curTs, dur)
 	}
 
+	if _, err = importutilv2.ParsePriority(in.GetOptions()); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	if _, err = importutilv2.GetCSVSep(in.GetOptions()); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
 	files := in.GetFiles()
 	isBackup := importutilv2.IsBackup(in.GetOptions())
 	if isBackup {
@@ -1666,6 +2600,26 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 			return resp, nil
 		}
 		log.Info("list binlogs prefixes for import", zap.Any("binlog_prefixes", files))
+	} else if lo.ContainsBy(files, importutilv2.IsParquetDataset) {
+		files = make([]*internalpb.ImportFile, 0)
+		for _, importFile := range in.GetFiles() {
+			if !importutilv2.IsParquetDataset(importFile) {
+				files = append(files, importFile)
+				continue
+			}
+			datasetFiles, err := ListParquetDataset(ctx, s.meta.chunkManager, importFile)
+			if err != nil {
+				resp.Status = merr.Status(merr.WrapErrImportFailed(fmt.Sprintf("list parquet dataset failed, err=%s", err)))
+				return resp, nil
+			}
+			files = append(files, datasetFiles...)
+		}
+		if len(files) > Params.DataCoordCfg.MaxFilesPerImportReq.GetAsInt() {
+			resp.Status = merr.Status(merr.WrapErrImportFailed(fmt.Sprintf("The max number of import files should not exceed %d, but got %d",
+				Params.DataCoordCfg.MaxFilesPerImportReq.GetAsInt(), len(files))))
+			return resp, nil
+		}
+		log.Info("list parquet datasets for import", zap.Int("files", len(files)))
 	}
 
 	idStart, _, err := s.allocator.allocN(int64(len(files)) + 1)
@@ -1735,7 +2689,10 @@ func (s *Server) GetImportProgress(ctx context.Context, in *internalpb.GetImport
 	resp.CompleteTime = job.GetCompleteTime()
 	resp.ImportedRows = importedRows
 	resp.TotalRows = totalRows
+	resp.RejectedRows = GetJobRejectedRows(jobID, s.importMeta)
 	resp.TaskProgresses = GetTaskProgresses(jobID, s.importMeta, s.meta)
+	resp.Priority = GetJobPriority(job)
+	resp.QueuePosition = GetJobQueuePosition(jobID, s.importMeta)
 	log.Info("GetImportProgress done", zap.Any("resp", resp))
 	return resp, nil
 }
@@ -2,6 +2,8 @@ package datacoord
 
 import (
 	"context"
+	"math"
+	"path"
 	"testing"
 	"time"
 
@@ -16,7 +18,6 @@ import (
 	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
-	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
@@ -25,12 +26,17 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type ServerSuite struct {
@@ -113,7 +119,7 @@ func (s *ServerSuite) TestGetFlushState_ByFlushTs() {
 		s.Run(test.description, func() {
 			resp, err := s.testServer.GetFlushState(context.TODO(), &datapb.GetFlushStateRequest{FlushTs: test.inTs})
 			s.NoError(err)
-			s.EqualValues(&milvuspb.GetFlushStateResponse{
+			s.EqualValues(&datapb.GetFlushStateResponse{
 				Status:  merr.Success(),
 				Flushed: test.expected,
 			}, resp)
@@ -122,7 +128,7 @@ func (s *ServerSuite) TestGetFlushState_ByFlushTs() {
 
 	resp, err := s.testServer.GetFlushState(context.TODO(), &datapb.GetFlushStateRequest{CollectionID: 1, FlushTs: 13})
 	s.NoError(err)
-	s.EqualValues(&milvuspb.GetFlushStateResponse{
+	s.EqualValues(&datapb.GetFlushStateResponse{
 		Status:  merr.Success(),
 		Flushed: true,
 	}, resp)
@@ -163,7 +169,7 @@ func (s *ServerSuite) TestGetFlushState_BySegment() {
 
 			resp, err := s.testServer.GetFlushState(context.TODO(), &datapb.GetFlushStateRequest{SegmentIDs: []int64{test.segID}})
 			s.NoError(err)
-			s.EqualValues(&milvuspb.GetFlushStateResponse{
+			s.EqualValues(&datapb.GetFlushStateResponse{
 				Status:  merr.Success(),
 				Flushed: test.expected,
 			}, resp)
@@ -171,6 +177,65 @@ func (s *ServerSuite) TestGetFlushState_BySegment() {
 	}
 }
 
+func (s *ServerSuite) TestGetFlushState_WithSegmentProgress() {
+	for _, segment := range []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 100, InsertChannel: "ch1", State: commonpb.SegmentState_Growing},
+		{ID: 2, CollectionID: 100, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed},
+		{ID: 3, CollectionID: 100, InsertChannel: "ch2", State: commonpb.SegmentState_Sealed},
+	} {
+		err := s.testServer.meta.AddSegment(context.TODO(), &SegmentInfo{SegmentInfo: segment})
+		s.Require().NoError(err)
+	}
+
+	status, err := s.testServer.ReportDataNodeTtMsgs(context.TODO(), &datapb.ReportDataNodeTtMsgsRequest{
+		Base: &commonpb.MsgBase{SourceID: 10},
+		FlushProgress: []*datapb.SegmentFlushProgress{
+			{SegmentID: 1, Channel: "ch1", PendingBinlogBytes: 1024, LastSyncTime: 1000},
+		},
+	})
+	s.Require().NoError(merr.CheckRPCCall(status, err))
+
+	s.Run("by segment", func() {
+		resp, err := s.testServer.GetFlushState(context.TODO(), &datapb.GetFlushStateRequest{
+			SegmentIDs:          []int64{1, 2},
+			WithSegmentProgress: true,
+		})
+		s.NoError(merr.CheckRPCCall(resp, err))
+		s.False(resp.GetFlushed())
+		s.Require().Len(resp.GetSegmentProgress(), 1)
+		progress := resp.GetSegmentProgress()[0]
+		s.EqualValues(1, progress.GetSegmentID())
+		s.EqualValues(10, progress.GetNodeID())
+		s.EqualValues(1024, progress.GetPendingBinlogBytes())
+		s.EqualValues(1000, progress.GetLastSyncTime())
+	})
+
+	s.Run("by collection", func() {
+		s.mockChMgr.EXPECT().GetChannelsByCollectionID(int64(100)).Return(nil).Once()
+		resp, err := s.testServer.GetFlushState(context.TODO(), &datapb.GetFlushStateRequest{
+			CollectionID:        100,
+			WithSegmentProgress: true,
+		})
+		s.NoError(merr.CheckRPCCall(resp, err))
+		s.ElementsMatch([]int64{1, 3}, lo.Map(resp.GetSegmentProgress(), func(p *datapb.SegmentFlushProgress, _ int) int64 {
+			return p.GetSegmentID()
+		}))
+		for _, progress := range resp.GetSegmentProgress() {
+			if progress.GetSegmentID() == 3 {
+				// not reported by any DataNode
+				s.Equal("ch2", progress.GetChannel())
+				s.Zero(progress.GetNodeID())
+			}
+		}
+	})
+
+	s.Run("without progress", func() {
+		resp, err := s.testServer.GetFlushState(context.TODO(), &datapb.GetFlushStateRequest{SegmentIDs: []int64{1}})
+		s.NoError(merr.CheckRPCCall(resp, err))
+		s.Empty(resp.GetSegmentProgress())
+	})
+}
+
 func (s *ServerSuite) TestSaveBinlogPath_ClosedServer() {
 	s.TearDownTest()
 	resp, err := s.testServer.SaveBinlogPaths(context.Background(), &datapb.SaveBinlogPathsRequest{
@@ -689,7 +754,7 @@ func TestBroadcastAlteredCollection(t *testing.T) {
 	})
 
 	t.Run("test meta non exist", func(t *testing.T) {
-		s := &Server{meta: &meta{collections: make(map[UniqueID]*collectionInfo, 1)}}
+		s := &Server{meta: &meta{collections: typeutil.NewConcurrentMap[UniqueID, *collectionInfo]()}}
 		s.stateCode.Store(commonpb.StateCode_Healthy)
 		ctx := context.Background()
 		req := &datapb.AlterCollectionRequest{
@@ -700,13 +765,13 @@ func TestBroadcastAlteredCollection(t *testing.T) {
 		resp, err := s.BroadcastAlteredCollection(ctx, req)
 		assert.NotNil(t, resp)
 		assert.NoError(t, err)
-		assert.Equal(t, 1, len(s.meta.collections))
+		assert.Equal(t, 1, s.meta.collections.Len())
 	})
 
 	t.Run("test update meta", func(t *testing.T) {
-		s := &Server{meta: &meta{collections: map[UniqueID]*collectionInfo{
+		s := &Server{meta: &meta{collections: newCollectionInfos(map[UniqueID]*collectionInfo{
 			1: {ID: 1},
-		}}}
+		})}}
 		s.stateCode.Store(commonpb.StateCode_Healthy)
 		ctx := context.Background()
 		req := &datapb.AlterCollectionRequest{
@@ -715,11 +780,11 @@ func TestBroadcastAlteredCollection(t *testing.T) {
 			Properties:   []*commonpb.KeyValuePair{{Key: "k", Value: "v"}},
 		}
 
-		assert.Nil(t, s.meta.collections[1].Properties)
+		assert.Nil(t, s.meta.GetCollection(1).Properties)
 		resp, err := s.BroadcastAlteredCollection(ctx, req)
 		assert.NotNil(t, resp)
 		assert.NoError(t, err)
-		assert.NotNil(t, s.meta.collections[1].Properties)
+		assert.NotNil(t, s.meta.GetCollection(1).Properties)
 	})
 }
 
@@ -755,6 +820,517 @@ func TestServer_GcConfirm(t *testing.T) {
 	})
 }
 
+func TestServer_ExportSegmentManifests(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.ExportSegmentManifests(context.TODO(), &datapb.ExportSegmentManifestsRequest{CollectionID: 100})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		handler := NewNMockHandler(t)
+		handler.EXPECT().GetCollection(mock.Anything, int64(100)).Return(&collectionInfo{ID: 100, Schema: newTestSchema()}, nil)
+		allocator := NewNMockAllocator(t)
+		allocator.EXPECT().allocTimestamp(mock.Anything).Return(1000, nil).Once()
+		s := &Server{
+			meta:      &meta{segments: NewSegmentsInfo()},
+			handler:   handler,
+			allocator: allocator,
+		}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed, StartPosition: &msgpb.MsgPosition{Timestamp: 100}},
+			{ID: 2, CollectionID: 100, PartitionID: 20, State: commonpb.SegmentState_Flushed, StartPosition: &msgpb.MsgPosition{Timestamp: 100}},
+			{ID: 3, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed, StartPosition: &msgpb.MsgPosition{Timestamp: 300}},
+			{ID: 4, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Growing, StartPosition: &msgpb.MsgPosition{Timestamp: 100}},
+			{ID: 5, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Dropped, StartPosition: &msgpb.MsgPosition{Timestamp: 100}},
+			{ID: 6, CollectionID: 200, PartitionID: 10, State: commonpb.SegmentState_Flushed, StartPosition: &msgpb.MsgPosition{Timestamp: 100}},
+		} {
+			segment.Binlogs = []*datapb.FieldBinlog{getFieldBinlogIDs(101, 1000)}
+			segment.Deltalogs = []*datapb.FieldBinlog{getFieldBinlogIDs(0, 2000)}
+			s.meta.segments.SetSegment(segment.GetID(), NewSegmentInfo(segment))
+		}
+
+		resp, err := s.ExportSegmentManifests(context.TODO(), &datapb.ExportSegmentManifestsRequest{
+			CollectionID: 100,
+			PartitionIDs: []int64{10},
+			Timestamp:    200,
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+		assert.Equal(t, uint64(200), resp.GetTimestamp())
+		assert.NotNil(t, resp.GetSchema())
+		assert.Equal(t, 1, len(resp.GetSegments()))
+		manifest := resp.GetSegments()[0]
+		assert.Equal(t, int64(1), manifest.GetSegmentID())
+		assert.Equal(t, metautil.BuildInsertLogPath(paramtable.Get().MinioCfg.RootPath.GetValue(), 100, 10, 1, 101, 1000),
+			manifest.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		assert.Equal(t, metautil.BuildDeltaLogPath(paramtable.Get().MinioCfg.RootPath.GetValue(), 100, 10, 1, 2000),
+			manifest.GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+		// the meta is not changed
+		assert.Empty(t, s.meta.GetSegment(1).GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+
+		// all the partitions at the current time
+		resp, err = s.ExportSegmentManifests(context.TODO(), &datapb.ExportSegmentManifestsRequest{CollectionID: 100})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+		assert.ElementsMatch(t, []int64{1, 2, 3}, lo.Map(resp.GetSegments(), func(manifest *datapb.SegmentManifest, _ int) int64 {
+			return manifest.GetSegmentID()
+		}))
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		handler := NewNMockHandler(t)
+		handler.EXPECT().GetCollection(mock.Anything, int64(100)).Return(nil, nil).Once()
+		handler.EXPECT().GetCollection(mock.Anything, int64(100)).Return(nil, errors.New("mock error")).Once()
+		s := &Server{handler: handler}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := s.ExportSegmentManifests(context.TODO(), &datapb.ExportSegmentManifestsRequest{CollectionID: 100})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+
+		resp, err = s.ExportSegmentManifests(context.TODO(), &datapb.ExportSegmentManifestsRequest{CollectionID: 100})
+		assert.NoError(t, err)
+		assert.False(t, merr.Ok(resp.GetStatus()))
+	})
+}
+
+func TestServer_SegmentReference(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.AcquireSegmentReference(context.TODO(), &datapb.AcquireSegmentReferenceRequest{CollectionID: 100, SegmentIDs: []int64{1}})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+
+		status, err := s.ReleaseSegmentReference(context.TODO(), &datapb.ReleaseSegmentReferenceRequest{ReferenceID: 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	})
+
+	newServer := func(t *testing.T) *Server {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListSegmentReferences(mock.Anything).Return(nil, nil)
+		catalog.EXPECT().SaveSegmentReference(mock.Anything, mock.Anything).Return(nil).Maybe()
+		catalog.EXPECT().DropSegmentReference(mock.Anything, mock.Anything).Return(nil).Maybe()
+		srm, err := newSegmentReferenceMeta(context.TODO(), catalog)
+		assert.NoError(t, err)
+		allocator := NewNMockAllocator(t)
+		allocator.EXPECT().allocID(mock.Anything).Return(1000, nil).Maybe()
+		s := &Server{
+			meta:      &meta{segments: NewSegmentsInfo(), segmentReferenceMeta: srm},
+			allocator: allocator,
+		}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 100, State: commonpb.SegmentState_Flushed},
+			{ID: 2, CollectionID: 100, State: commonpb.SegmentState_Dropped},
+			{ID: 3, CollectionID: 200, State: commonpb.SegmentState_Flushed},
+		} {
+			s.meta.segments.SetSegment(segment.GetID(), NewSegmentInfo(segment))
+		}
+		return s
+	}
+
+	t.Run("acquire, renew and release", func(t *testing.T) {
+		s := newServer(t)
+		start := time.Now()
+		resp, err := s.AcquireSegmentReference(context.TODO(), &datapb.AcquireSegmentReferenceRequest{
+			CollectionID: 100,
+			SegmentIDs:   []int64{1, 2, 2},
+			Holder:       "backup",
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+		assert.Equal(t, int64(1000), resp.GetReferenceID())
+		defaultLease := paramtable.Get().DataCoordCfg.SegmentReferenceDefaultLease.GetAsDuration(time.Second)
+		assert.GreaterOrEqual(t, resp.GetExpireTime(), start.Add(defaultLease).UnixMilli())
+		assert.ElementsMatch(t, []int64{1, 2}, s.meta.segmentReferenceMeta.GetReference(1000).GetSegmentIDs())
+		assert.True(t, s.meta.segmentReferenceMeta.GetReferencedSegments().Contain(1, 2))
+
+		// the lease is clamped to the max lease
+		resp, err = s.AcquireSegmentReference(context.TODO(), &datapb.AcquireSegmentReferenceRequest{
+			ReferenceID:  1000,
+			LeaseSeconds: math.MaxInt32,
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+		maxLease := paramtable.Get().DataCoordCfg.SegmentReferenceMaxLease.GetAsDuration(time.Second)
+		assert.LessOrEqual(t, resp.GetExpireTime(), time.Now().Add(maxLease).UnixMilli())
+		assert.Greater(t, resp.GetExpireTime(), time.Now().Add(defaultLease).UnixMilli())
+
+		status, err := s.ReleaseSegmentReference(context.TODO(), &datapb.ReleaseSegmentReferenceRequest{ReferenceID: 1000})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(status))
+		assert.Nil(t, s.meta.segmentReferenceMeta.GetReference(1000))
+
+		// release again
+		status, err = s.ReleaseSegmentReference(context.TODO(), &datapb.ReleaseSegmentReferenceRequest{ReferenceID: 1000})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(status))
+
+		// renew the released reference
+		resp, err = s.AcquireSegmentReference(context.TODO(), &datapb.AcquireSegmentReferenceRequest{ReferenceID: 1000})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		s := newServer(t)
+		for _, req := range []*datapb.AcquireSegmentReferenceRequest{
+			{CollectionID: 100},
+			{CollectionID: 100, SegmentIDs: []int64{1}, LeaseSeconds: -1},
+			{CollectionID: 100, SegmentIDs: []int64{1, 3}},
+		} {
+			resp, err := s.AcquireSegmentReference(context.TODO(), req)
+			assert.NoError(t, err)
+			assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+		}
+
+		resp, err := s.AcquireSegmentReference(context.TODO(), &datapb.AcquireSegmentReferenceRequest{CollectionID: 100, SegmentIDs: []int64{4}})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrSegmentNotFound)
+	})
+}
+
+func TestServer_ExportV2(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.ExportV2(context.TODO(), &datapb.ExportV2Request{CollectionID: 100, OutputPath: "export"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		handler := NewNMockHandler(t)
+		handler.EXPECT().GetCollection(mock.Anything, int64(100)).Return(&collectionInfo{ID: 100, Partitions: []int64{10}}, nil)
+		s := &Server{handler: handler}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := s.ExportV2(context.TODO(), &datapb.ExportV2Request{CollectionID: 100})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+		resp, err = s.ExportV2(context.TODO(), &datapb.ExportV2Request{CollectionID: 100, PartitionIDs: []int64{20}, OutputPath: "export"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrPartitionNotFound)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		paramtable.Get().Save(paramtable.Get().DataCoordCfg.MaxSizeInMBPerExportTask.Key, "0")
+		defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.MaxSizeInMBPerExportTask.Key)
+
+		handler := NewNMockHandler(t)
+		handler.EXPECT().GetCollection(mock.Anything, int64(100)).Return(&collectionInfo{ID: 100, Schema: newTestSchema(), Partitions: []int64{10, 20}}, nil)
+		allocator := NewNMockAllocator(t)
+		allocator.EXPECT().allocTimestamp(mock.Anything).Return(1000, nil)
+		allocator.EXPECT().allocN(int64(3)).Return(1, 4, nil)
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListExportJobs().Return(nil, nil)
+		catalog.EXPECT().ListExportTasks().Return(nil, nil)
+		catalog.EXPECT().SaveExportTask(mock.Anything).Return(nil)
+		catalog.EXPECT().SaveExportJob(mock.Anything).Return(nil)
+		exportMeta, err := NewExportMeta(catalog)
+		assert.NoError(t, err)
+		s := &Server{
+			meta:       &meta{segments: NewSegmentsInfo()},
+			handler:    handler,
+			allocator:  allocator,
+			exportMeta: exportMeta,
+		}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 100},
+			{ID: 2, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 200},
+			{ID: 3, CollectionID: 100, PartitionID: 20, State: commonpb.SegmentState_Flushed, NumOfRows: 100},
+			{ID: 4, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Growing, NumOfRows: 100},
+			{ID: 5, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 100, IsImporting: true},
+			{ID: 6, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L0},
+			{ID: 7, CollectionID: 200, PartitionID: 10, State: commonpb.SegmentState_Flushed, NumOfRows: 100},
+		} {
+			s.meta.segments.SetSegment(segment.GetID(), NewSegmentInfo(segment))
+		}
+
+		resp, err := s.ExportV2(context.TODO(), &datapb.ExportV2Request{
+			CollectionID: 100,
+			PartitionIDs: []int64{10},
+			OutputPath:   "export",
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+		assert.Equal(t, int64(1), resp.GetJobID())
+
+		job := s.exportMeta.GetJob(1)
+		assert.Equal(t, datapb.ExportJobState_ExportJobExporting, job.GetState())
+		assert.Equal(t, uint64(1000), job.GetTs())
+		assert.Equal(t, "export", job.GetOutputPath())
+		tasks := s.exportMeta.GetTaskBy(WithExportJob(1))
+		assert.Equal(t, 2, len(tasks))
+		for _, task := range tasks {
+			assert.Equal(t, 1, len(task.GetSegments()))
+			assert.Contains(t, []int64{1, 2}, task.GetSegments()[0].GetSegmentID())
+			assert.Equal(t, []int64{6}, lo.Map(task.GetL0Segments(), func(segment *datapb.ExportSegment, _ int) int64 {
+				return segment.GetSegmentID()
+			}))
+			assert.Equal(t, int64(NullNodeID), task.GetNodeID())
+			assert.Equal(t, datapb.ImportTaskStateV2_Pending, task.GetState())
+		}
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		handler := NewNMockHandler(t)
+		handler.EXPECT().GetCollection(mock.Anything, int64(100)).Return(nil, nil)
+		s := &Server{handler: handler}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := s.ExportV2(context.TODO(), &datapb.ExportV2Request{CollectionID: 100, OutputPath: "export"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+	})
+}
+
+func TestServer_GetExportProgress(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.GetExportProgress(context.TODO(), &datapb.GetExportProgressRequest{JobID: 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListExportJobs().Return([]*datapb.ExportJob{
+			{JobID: 1, State: datapb.ExportJobState_ExportJobExporting, StartTime: "start"},
+		}, nil)
+		catalog.EXPECT().ListExportTasks().Return([]*datapb.ExportTask{
+			{JobID: 1, TaskID: 2, Segments: []*datapb.ExportSegment{
+				{SegmentID: 10, NumRows: 100, Exported: true, ExportedRows: 90, OutputFiles: []string{"export/1/10_0.parquet"}},
+				{SegmentID: 11, NumRows: 100},
+			}},
+			{JobID: 1, TaskID: 3, Segments: []*datapb.ExportSegment{
+				{SegmentID: 12, NumRows: 100},
+				{SegmentID: 13, NumRows: 100},
+			}},
+		}, nil)
+		exportMeta, err := NewExportMeta(catalog)
+		assert.NoError(t, err)
+		s := &Server{exportMeta: exportMeta}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := s.GetExportProgress(context.TODO(), &datapb.GetExportProgressRequest{JobID: 1})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+		assert.Equal(t, datapb.ExportJobState_ExportJobExporting, resp.GetState())
+		assert.Equal(t, int64(25), resp.GetProgress())
+		assert.Equal(t, int64(4), resp.GetTotalSegments())
+		assert.Equal(t, int64(1), resp.GetExportedSegments())
+		assert.Equal(t, int64(90), resp.GetExportedRows())
+		assert.Equal(t, []string{"export/1/10_0.parquet"}, resp.GetOutputFiles())
+		assert.Equal(t, "start", resp.GetStartTime())
+
+		// job does not exist
+		resp, err = s.GetExportProgress(context.TODO(), &datapb.GetExportProgressRequest{JobID: 2})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+}
+
+func TestServer_GetRegionReplicaStatus(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.GetRegionReplicaStatus(context.TODO(), &datapb.GetRegionReplicaStatusRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := s.GetRegionReplicaStatus(context.TODO(), &datapb.GetRegionReplicaStatusRequest{})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+		assert.Equal(t, util.RegionReplicaRolePrimary, resp.GetRole())
+		assert.Empty(t, resp.GetCollections())
+
+		s.regionReplicaShipper = newRegionReplicaShipper(context.TODO(), nil, nil, nil, nil, nil, nil, nil)
+		s.regionReplicaShipper.statuses[100] = &datapb.RegionReplicaCollectionStatus{
+			PrimaryCollectionID: 100,
+			CollectionID:        200,
+			ShippedTs:           tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0),
+		}
+		resp, err = s.GetRegionReplicaStatus(context.TODO(), &datapb.GetRegionReplicaStatusRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(resp.GetCollections()))
+		assert.GreaterOrEqual(t, resp.GetCollections()[0].GetStalenessSeconds(), int64(60))
+	})
+}
+
+func TestServer_FailoverRegionReplica(t *testing.T) {
+	svr := newTestServer(t)
+	defer closeTestServer(t, svr)
+	defer paramtable.Get().Reset(paramtable.Get().CommonCfg.RegionReplicaRole.Key)
+
+	// the primary region does not fail over
+	status, err := svr.FailoverRegionReplica(context.TODO(), &datapb.FailoverRegionReplicaRequest{})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(status), merr.ErrServiceUnavailable)
+
+	paramtable.Get().Save(paramtable.Get().CommonCfg.RegionReplicaRole.Key, util.RegionReplicaRoleSecondary)
+	key := path.Join(paramtable.Get().EtcdCfg.RootPath.GetValue(), "config", paramtable.Get().CommonCfg.RegionReplicaRole.Key)
+	defer svr.etcdCli.Delete(context.TODO(), key)
+	status, err = svr.FailoverRegionReplica(context.TODO(), &datapb.FailoverRegionReplicaRequest{})
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(status))
+	assert.Equal(t, util.RegionReplicaRolePrimary, paramtable.Get().CommonCfg.RegionReplicaRole.GetValue())
+	resp, err := svr.etcdCli.Get(context.TODO(), key)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(resp.Kvs))
+	assert.Equal(t, util.RegionReplicaRolePrimary, string(resp.Kvs[0].Value))
+}
+
+func TestServer_CloneSegments(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.CloneSegments(context.TODO(), &datapb.CloneSegmentsRequest{SourceCollectionID: 100, TargetCollectionID: 200})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		ctx := context.Background()
+		cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil)
+		catalog.EXPECT().CreateSegmentIndex(mock.Anything, mock.Anything).Return(nil)
+		handler := NewNMockHandler(t)
+		handler.EXPECT().GetCollection(mock.Anything, int64(100)).Return(&collectionInfo{ID: 100, Schema: newTestSchema(), VChannelNames: []string{"ch-100"}}, nil)
+		handler.EXPECT().GetCollection(mock.Anything, int64(200)).Return(&collectionInfo{ID: 200, Schema: newTestSchema(), VChannelNames: []string{"ch-200"}}, nil)
+		allocator := NewNMockAllocator(t)
+		allocator.EXPECT().allocN(int64(2)).Return(10000, 10002, nil)
+		allocator.EXPECT().allocID(mock.Anything).Return(20000, nil)
+
+		indexMeta := newSegmentIndexMeta(catalog)
+		indexParams := []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}}
+		indexMeta.updateCollectionIndex(&model.Index{CollectionID: 100, FieldID: 2, IndexID: 1000, IndexName: "idx", IndexParams: indexParams})
+		indexMeta.updateCollectionIndex(&model.Index{CollectionID: 200, FieldID: 2, IndexID: 2000, IndexName: "idx", IndexParams: indexParams})
+		indexMeta.updateSegmentIndex(&model.SegmentIndex{
+			SegmentID: 1, CollectionID: 100, PartitionID: 10, IndexID: 1000, BuildID: 3000, IndexVersion: 1,
+			IndexState: commonpb.IndexState_Finished, IndexFileKeys: []string{"file"}, NumRows: 100,
+		})
+		s := &Server{
+			meta: &meta{
+				catalog:      catalog,
+				segments:     NewSegmentsInfo(),
+				channelCPs:   newChannelCps(),
+				indexMeta:    indexMeta,
+				chunkManager: cm,
+			},
+			handler:   handler,
+			allocator: allocator,
+		}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		s.meta.channelCPs.checkpoints["ch-200"] = &msgpb.MsgPosition{ChannelName: "ch-200", MsgID: []byte{1}, Timestamp: 500}
+
+		for _, segment := range []*datapb.SegmentInfo{
+			{ID: 1, PartitionID: 10, State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L1, NumOfRows: 100},
+			{ID: 2, PartitionID: common.AllPartitionsID, State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L0},
+			{ID: 3, PartitionID: 20, State: commonpb.SegmentState_Flushed, Level: datapb.SegmentLevel_L1, NumOfRows: 100},
+			{ID: 4, PartitionID: 10, State: commonpb.SegmentState_Growing, Level: datapb.SegmentLevel_L1, NumOfRows: 100},
+		} {
+			segment.CollectionID = 100
+			segment.InsertChannel = "ch-100"
+			segment.StartPosition = &msgpb.MsgPosition{ChannelName: "ch-100", Timestamp: uint64(segment.GetID() * 100)}
+			segment.DmlPosition = segment.StartPosition
+			segment.Deltalogs = []*datapb.FieldBinlog{getFieldBinlogIDs(0, 2000+segment.GetID())}
+			require.NoError(t, cm.Write(ctx, metautil.BuildDeltaLogPath(cm.RootPath(), 100, segment.GetPartitionID(), segment.GetID(), 2000+segment.GetID()), []byte("delta")))
+			if segment.GetLevel() != datapb.SegmentLevel_L0 {
+				segment.Binlogs = []*datapb.FieldBinlog{getFieldBinlogIDs(2, 1000+segment.GetID())}
+				segment.Statslogs = []*datapb.FieldBinlog{getFieldBinlogIDs(2, 3000+segment.GetID())}
+				require.NoError(t, cm.Write(ctx, metautil.BuildStatsLogPath(cm.RootPath(), 100, segment.GetPartitionID(), segment.GetID(), 2, 3000+segment.GetID()), []byte("stats")))
+			}
+			s.meta.segments.SetSegment(segment.GetID(), NewSegmentInfo(segment))
+		}
+
+		resp, err := s.CloneSegments(ctx, &datapb.CloneSegmentsRequest{
+			SourceCollectionID: 100,
+			TargetCollectionID: 200,
+			PartitionMapping:   map[int64]int64{10: 30},
+		})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+		assert.Equal(t, []int64{10000, 10001}, resp.GetSegmentIDs())
+		assert.Equal(t, int64(100), resp.GetNumOfRows())
+
+		cloned := s.meta.GetSegment(10000)
+		assert.Equal(t, int64(200), cloned.GetCollectionID())
+		assert.Equal(t, int64(30), cloned.GetPartitionID())
+		assert.Equal(t, "ch-200", cloned.GetInsertChannel())
+		assert.Equal(t, []byte{1}, cloned.GetStartPosition().GetMsgID())
+		assert.Equal(t, uint64(100), cloned.GetStartPosition().GetTimestamp())
+		assert.Equal(t, int64(1), cloned.GetBinlogsSource().GetSegmentID())
+		// the insert logs are shared, the stats logs and delta logs are copied
+		insertLogs, err := getInsertBinlogs(cloned)
+		assert.NoError(t, err)
+		assert.Equal(t, metautil.BuildInsertLogPath(paramtable.Get().MinioCfg.RootPath.GetValue(), 100, 10, 1, 2, 1001),
+			insertLogs[0].GetBinlogs()[0].GetLogPath())
+		assert.Empty(t, cloned.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		exist, err := cm.Exist(ctx, metautil.BuildStatsLogPath(cm.RootPath(), 200, 30, 10000, 2, 3001))
+		assert.NoError(t, err)
+		assert.True(t, exist)
+		exist, err = cm.Exist(ctx, metautil.BuildDeltaLogPath(cm.RootPath(), 200, 30, 10000, 2001))
+		assert.NoError(t, err)
+		assert.True(t, exist)
+		assert.True(t, s.meta.HasSegmentClones(1))
+		assert.True(t, s.meta.IsInsertLogShared(1, 1001))
+		assert.False(t, s.meta.IsInsertLogShared(1, 1003))
+
+		// the index files are shared
+		segIdx := s.meta.indexMeta.GetSegmentIndexes(200, 10000)[2000]
+		assert.Equal(t, commonpb.IndexState_Finished, segIdx.IndexState)
+		assert.Equal(t, int64(20000), segIdx.BuildID)
+		assert.Equal(t, int64(3000), segIdx.FilesSource.GetBuildID())
+		assert.True(t, s.meta.indexMeta.IsIndexFilesReused(3000))
+
+		l0 := s.meta.GetSegment(10001)
+		assert.Equal(t, datapb.SegmentLevel_L0, l0.GetLevel())
+		assert.Equal(t, common.AllPartitionsID, l0.GetPartitionID())
+		assert.Nil(t, l0.GetBinlogsSource())
+		assert.Less(t, cloned.GetStartPosition().GetTimestamp(), l0.GetDmlPosition().GetTimestamp())
+	})
+
+	t.Run("incompatible collections", func(t *testing.T) {
+		handler := NewNMockHandler(t)
+		handler.EXPECT().GetCollection(mock.Anything, int64(100)).Return(&collectionInfo{ID: 100, Schema: newTestSchema(), VChannelNames: []string{"ch-100"}}, nil)
+		handler.EXPECT().GetCollection(mock.Anything, int64(200)).Return(&collectionInfo{ID: 200, Schema: newTestSchema(), VChannelNames: []string{"ch-200-0", "ch-200-1"}}, nil).Once()
+		handler.EXPECT().GetCollection(mock.Anything, int64(200)).Return(&collectionInfo{ID: 200, Schema: newTestScalarClusteringKeySchema(), VChannelNames: []string{"ch-200"}}, nil).Once()
+		handler.EXPECT().GetCollection(mock.Anything, int64(200)).Return(nil, nil).Once()
+		s := &Server{handler: handler}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		req := &datapb.CloneSegmentsRequest{SourceCollectionID: 100, TargetCollectionID: 200, PartitionMapping: map[int64]int64{10: 30}}
+		resp, err := s.CloneSegments(context.TODO(), req)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+		resp, err = s.CloneSegments(context.TODO(), req)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+		resp, err = s.CloneSegments(context.TODO(), req)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+	})
+}
+
 func TestGetRecoveryInfoV2(t *testing.T) {
 	t.Run("test get recovery info with no segments", func(t *testing.T) {
 		svr := newTestServer(t)
@@ -1526,6 +2102,64 @@ func (s *GcControlServiceSuite) TestResume() {
 	s.True(merr.Ok(resp))
 }
 
+func (s *GcControlServiceSuite) TestPruneSegmentIndex() {
+	resp, err := s.server.GcControl(context.TODO(), &datapb.GcControlRequest{
+		Command: datapb.GcCommand_PruneSegmentIndex,
+		Params: []*commonpb.KeyValuePair{
+			{Key: "retention", Value: "not_int"},
+		},
+	})
+	s.Nil(err)
+	s.False(merr.Ok(resp))
+
+	resp, err = s.server.GcControl(context.TODO(), &datapb.GcControlRequest{
+		Command: datapb.GcCommand_PruneSegmentIndex,
+		Params: []*commonpb.KeyValuePair{
+			{Key: "retention", Value: "0"},
+		},
+	})
+	s.Nil(err)
+	s.False(merr.Ok(resp))
+
+	resp, err = s.server.GcControl(context.TODO(), &datapb.GcControlRequest{
+		Command: datapb.GcCommand_PruneSegmentIndex,
+		Params: []*commonpb.KeyValuePair{
+			{Key: "retention", Value: "3600"},
+		},
+	})
+	s.Nil(err)
+	s.True(merr.Ok(resp))
+}
+
+func (s *GcControlServiceSuite) TestDryRun() {
+	resp, err := s.server.GcDryRun(context.TODO(), &datapb.GcDryRunRequest{
+		Params: []*commonpb.KeyValuePair{
+			{Key: "missing_tolerance", Value: "not_int"},
+		},
+	})
+	s.NoError(err)
+	s.False(merr.Ok(resp.GetStatus()))
+
+	resp, err = s.server.GcDryRun(context.TODO(), &datapb.GcDryRunRequest{
+		Params: []*commonpb.KeyValuePair{
+			{Key: "drop_tolerance", Value: "-1"},
+		},
+	})
+	s.NoError(err)
+	s.False(merr.Ok(resp.GetStatus()))
+
+	resp, err = s.server.GcDryRun(context.TODO(), &datapb.GcDryRunRequest{
+		Params: []*commonpb.KeyValuePair{
+			{Key: "missing_tolerance", Value: "86400"},
+			{Key: "drop_tolerance", Value: "3600"},
+		},
+		Limit: 10,
+	})
+	s.NoError(err)
+	s.True(merr.Ok(resp.GetStatus()))
+	s.LessOrEqual(len(resp.GetFiles()), 10)
+}
+
 func (s *GcControlServiceSuite) TestTimeoutCtx() {
 	s.server.garbageCollector.close()
 
@@ -33,6 +33,7 @@ type NodeInfo struct {
 	NodeID   int64
 	Address  string
 	IsLegacy bool
+	Labels   map[string]string
 }
 
 // Session contains session info of a node
@@ -19,9 +19,7 @@ package datacoord
 import (
 	"context"
 	"fmt"
-	"time"
 
-	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
@@ -37,17 +35,10 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/lock"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
-	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
-const (
-	flushTimeout      = 15 * time.Second
-	importTaskTimeout = 10 * time.Second
-	querySlotTimeout  = 10 * time.Second
-)
-
 //go:generate mockery --name=SessionManager --structname=MockSessionManager --output=./  --filename=mock_session_manager.go --with-expecter --inpackage
 type SessionManager interface {
 	AddSession(node *NodeInfo)
@@ -69,7 +60,10 @@ type SessionManager interface {
 	QueryPreImport(nodeID int64, in *datapb.QueryPreImportRequest) (*datapb.QueryPreImportResponse, error)
 	QueryImport(nodeID int64, in *datapb.QueryImportRequest) (*datapb.QueryImportResponse, error)
 	DropImport(nodeID int64, in *datapb.DropImportRequest) error
+	ExportV2(nodeID int64, in *datapb.ExportRequest) error
+	QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error)
 	CheckHealth(ctx context.Context) error
+	CheckNodeHealth(ctx context.Context, nodeID int64) error
 	QuerySlot(nodeID int64) (*datapb.QuerySlotResponse, error)
 	DropCompactionPlan(nodeID int64, req *datapb.DropCompactionPlanRequest) error
 	Close()
@@ -132,6 +126,15 @@ func (c *SessionManagerImpl) GetSession(nodeID int64) (*Session, bool) {
 	return s, ok
 }
 
+// getNodeLabels returns the labels of the node, nil if the node is not found
+func (c *SessionManagerImpl) getNodeLabels(nodeID int64) map[string]string {
+	session, ok := c.GetSession(nodeID)
+	if !ok {
+		return nil
+	}
+	return session.info.Labels
+}
+
 // DeleteSession removes the node session
 func (c *SessionManagerImpl) DeleteSession(node *NodeInfo) {
 	c.sessions.Lock()
@@ -187,16 +190,11 @@ func (c *SessionManagerImpl) Flush(ctx context.Context, nodeID int64, req *datap
 
 func (c *SessionManagerImpl) execFlush(ctx context.Context, nodeID int64, req *datapb.FlushSegmentsRequest) {
 	log := log.Ctx(ctx).With(zap.Int64("nodeID", nodeID), zap.String("channel", req.GetChannelName()))
-	cli, err := c.getClient(ctx, nodeID)
+	err := c.call(ctx, nodeID, "FlushSegments", func(ctx context.Context, cli types.DataNodeClient) error {
+		resp, err := cli.FlushSegments(ctx, req)
+		return VerifyResponse(resp, err)
+	})
 	if err != nil {
-		log.Warn("failed to get dataNode client", zap.Error(err))
-		return
-	}
-	ctx, cancel := context.WithTimeout(ctx, flushTimeout)
-	defer cancel()
-
-	resp, err := cli.FlushSegments(ctx, req)
-	if err := VerifyResponse(resp, err); err != nil {
 		log.Error("flush call (perhaps partially) failed", zap.Error(err))
 	} else {
 		log.Info("flush call succeeded")
@@ -205,16 +203,11 @@ func (c *SessionManagerImpl) execFlush(ctx context.Context, nodeID int64, req *d
 
 // Compaction is a grpc interface. It will send request to DataNode with provided `nodeID` synchronously.
 func (c *SessionManagerImpl) Compaction(ctx context.Context, nodeID int64, plan *datapb.CompactionPlan) error {
-	ctx, cancel := context.WithTimeout(ctx, Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second))
-	defer cancel()
-	cli, err := c.getClient(ctx, nodeID)
+	err := c.call(ctx, nodeID, "CompactionV2", func(ctx context.Context, cli types.DataNodeClient) error {
+		resp, err := cli.CompactionV2(ctx, plan)
+		return VerifyResponse(resp, err)
+	})
 	if err != nil {
-		log.Warn("failed to get client", zap.Int64("nodeID", nodeID), zap.Error(err))
-		return err
-	}
-
-	resp, err := cli.CompactionV2(ctx, plan)
-	if err := VerifyResponse(resp, err); err != nil {
 		log.Warn("failed to execute compaction", zap.Int64("node", nodeID), zap.Error(err), zap.Int64("planID", plan.GetPlanID()))
 		return err
 	}
@@ -229,17 +222,8 @@ func (c *SessionManagerImpl) SyncSegments(nodeID int64, req *datapb.SyncSegments
 		zap.Int64("nodeID", nodeID),
 		zap.Int64("planID", req.GetPlanID()),
 	)
-	ctx, cancel := context.WithTimeout(context.Background(), Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second))
-	cli, err := c.getClient(ctx, nodeID)
-	cancel()
-	if err != nil {
-		log.Warn("failed to get client", zap.Error(err))
-		return err
-	}
-
-	err = retry.Do(context.Background(), func() error {
-		// doesn't set timeout
-		resp, err := cli.SyncSegments(context.Background(), req)
+	err := c.call(context.Background(), nodeID, "SyncSegments", func(ctx context.Context, cli types.DataNodeClient) error {
+		resp, err := cli.SyncSegments(ctx, req)
 		if err := VerifyResponse(resp, err); err != nil {
 			log.Warn("failed to sync segments", zap.Error(err))
 			return err
@@ -261,26 +245,23 @@ func (c *SessionManagerImpl) GetCompactionPlansResults() (map[int64]*typeutil.Pa
 	errorGroup, ctx := errgroup.WithContext(ctx)
 
 	plans := typeutil.NewConcurrentMap[int64, *typeutil.Pair[int64, *datapb.CompactionPlanResult]]()
-	c.sessions.RLock()
-	for nodeID, s := range c.sessions.data {
-		nodeID, s := nodeID, s // https://golang.org/doc/faq#closures_and_goroutines
+	for _, nodeID := range c.GetSessionIDs() {
+		nodeID := nodeID // https://golang.org/doc/faq#closures_and_goroutines
 		errorGroup.Go(func() error {
-			cli, err := s.GetOrCreateClient(ctx)
-			if err != nil {
-				log.Info("Cannot Create Client", zap.Int64("NodeID", nodeID))
+			var resp *datapb.CompactionStateResponse
+			err := c.call(ctx, nodeID, "GetCompactionState", func(ctx context.Context, cli types.DataNodeClient) error {
+				var err error
+				resp, err = cli.GetCompactionState(ctx, &datapb.CompactionStateRequest{
+					Base: commonpbutil.NewMsgBase(
+						commonpbutil.WithMsgType(commonpb.MsgType_GetSystemConfigs),
+						commonpbutil.WithSourceID(paramtable.GetNodeID()),
+					),
+				})
 				return err
-			}
-			ctx, cancel := context.WithTimeout(ctx, Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second))
-			defer cancel()
-			resp, err := cli.GetCompactionState(ctx, &datapb.CompactionStateRequest{
-				Base: commonpbutil.NewMsgBase(
-					commonpbutil.WithMsgType(commonpb.MsgType_GetSystemConfigs),
-					commonpbutil.WithSourceID(paramtable.GetNodeID()),
-				),
 			})
 
 			if err != nil || resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
-				log.Info("Get State failed", zap.Error(err))
+				log.Info("Get State failed", zap.Int64("NodeID", nodeID), zap.Error(err))
 				return err
 			}
 
@@ -292,7 +273,6 @@ func (c *SessionManagerImpl) GetCompactionPlansResults() (map[int64]*typeutil.Pa
 			return nil
 		})
 	}
-	c.sessions.RUnlock()
 
 	// wait for all request done
 	if err := errorGroup.Wait(); err != nil {
@@ -309,31 +289,21 @@ func (c *SessionManagerImpl) GetCompactionPlansResults() (map[int64]*typeutil.Pa
 }
 
 func (c *SessionManagerImpl) GetCompactionPlanResult(nodeID int64, planID int64) (*datapb.CompactionPlanResult, error) {
-	ctx := context.Background()
-	c.sessions.RLock()
-	s, ok := c.sessions.data[nodeID]
-	if !ok {
-		c.sessions.RUnlock()
-		return nil, merr.WrapErrNodeNotFound(nodeID)
-	}
-	c.sessions.RUnlock()
-	cli, err := s.GetOrCreateClient(ctx)
+	var resp *datapb.CompactionStateResponse
+	err := c.call(context.Background(), nodeID, "GetCompactionState", func(ctx context.Context, cli types.DataNodeClient) error {
+		var err error
+		resp, err = cli.GetCompactionState(ctx, &datapb.CompactionStateRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			PlanID: planID,
+		})
+		return err
+	})
 	if err != nil {
-		log.Info("Cannot Create Client", zap.Int64("NodeID", nodeID))
+		log.Info("failed to get compaction state", zap.Int64("NodeID", nodeID), zap.Error(err))
 		return nil, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second))
-	defer cancel()
-	resp, err2 := cli.GetCompactionState(ctx, &datapb.CompactionStateRequest{
-		Base: commonpbutil.NewMsgBase(
-			commonpbutil.WithSourceID(paramtable.GetNodeID()),
-		),
-		PlanID: planID,
-	})
-
-	if err2 != nil {
-		return nil, err2
-	}
 
 	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
 		log.Info("GetCompactionState state is not", zap.Error(err))
@@ -356,15 +326,11 @@ func (c *SessionManagerImpl) FlushChannels(ctx context.Context, nodeID int64, re
 	log := log.Ctx(ctx).With(zap.Int64("nodeID", nodeID),
 		zap.Time("flushTs", tsoutil.PhysicalTime(req.GetFlushTs())),
 		zap.Strings("channels", req.GetChannels()))
-	cli, err := c.getClient(ctx, nodeID)
-	if err != nil {
-		log.Warn("failed to get client", zap.Error(err))
-		return err
-	}
-
 	log.Info("SessionManagerImpl.FlushChannels start")
-	resp, err := cli.FlushChannels(ctx, req)
-	err = VerifyResponse(resp, err)
+	err := c.call(ctx, nodeID, "FlushChannels", func(ctx context.Context, cli types.DataNodeClient) error {
+		resp, err := cli.FlushChannels(ctx, req)
+		return VerifyResponse(resp, err)
+	})
 	if err != nil {
 		log.Warn("SessionManagerImpl.FlushChannels failed", zap.Error(err))
 		return err
@@ -375,15 +341,11 @@ func (c *SessionManagerImpl) FlushChannels(ctx context.Context, nodeID int64, re
 
 func (c *SessionManagerImpl) NotifyChannelOperation(ctx context.Context, nodeID int64, req *datapb.ChannelOperationsRequest) error {
 	log := log.Ctx(ctx).With(zap.Int64("nodeID", nodeID))
-	cli, err := c.getClient(ctx, nodeID)
+	err := c.call(ctx, nodeID, "NotifyChannelOperation", func(ctx context.Context, cli types.DataNodeClient) error {
+		resp, err := cli.NotifyChannelOperation(ctx, req)
+		return merr.CheckRPCCall(resp, err)
+	})
 	if err != nil {
-		log.Info("failed to get dataNode client", zap.Error(err))
-		return err
-	}
-	ctx, cancel := context.WithTimeout(ctx, Params.DataCoordCfg.ChannelOperationRPCTimeout.GetAsDuration(time.Second))
-	defer cancel()
-	resp, err := cli.NotifyChannelOperation(ctx, req)
-	if err := merr.CheckRPCCall(resp, err); err != nil {
 		log.Warn("Notify channel operations failed", zap.Error(err))
 		return err
 	}
@@ -396,16 +358,13 @@ func (c *SessionManagerImpl) CheckChannelOperationProgress(ctx context.Context,
 		zap.String("channel", info.GetVchan().GetChannelName()),
 		zap.String("operation", info.GetState().String()),
 	)
-	cli, err := c.getClient(ctx, nodeID)
+	var resp *datapb.ChannelOperationProgressResponse
+	err := c.call(ctx, nodeID, "CheckChannelOperationProgress", func(ctx context.Context, cli types.DataNodeClient) error {
+		var err error
+		resp, err = cli.CheckChannelOperationProgress(ctx, info)
+		return merr.CheckRPCCall(resp, err)
+	})
 	if err != nil {
-		log.Info("failed to get dataNode client", zap.Error(err))
-		return nil, err
-	}
-
-	ctx, cancel := context.WithTimeout(ctx, Params.DataCoordCfg.ChannelOperationRPCTimeout.GetAsDuration(time.Second))
-	defer cancel()
-	resp, err := cli.CheckChannelOperationProgress(ctx, info)
-	if err := merr.CheckRPCCall(resp, err); err != nil {
 		log.Warn("Check channel operation failed", zap.Error(err))
 		return nil, err
 	}
@@ -421,15 +380,14 @@ func (c *SessionManagerImpl) PreImport(nodeID int64, in *datapb.PreImportRequest
 		zap.Int64("collectionID", in.GetCollectionID()),
 		zap.Int64s("partitionIDs", in.GetPartitionIDs()),
 	)
-	ctx, cancel := context.WithTimeout(context.Background(), importTaskTimeout)
-	defer cancel()
-	cli, err := c.getClient(ctx, nodeID)
+	err := c.call(context.Background(), nodeID, "PreImport", func(ctx context.Context, cli types.DataNodeClient) error {
+		status, err := cli.PreImport(ctx, in)
+		return VerifyResponse(status, err)
+	})
 	if err != nil {
-		log.Info("failed to get client", zap.Error(err))
-		return err
+		log.Info("failed to pre-import", zap.Error(err))
 	}
-	status, err := cli.PreImport(ctx, in)
-	return VerifyResponse(status, err)
+	return err
 }
 
 func (c *SessionManagerImpl) ImportV2(nodeID int64, in *datapb.ImportRequest) error {
@@ -439,15 +397,14 @@ func (c *SessionManagerImpl) ImportV2(nodeID int64, in *datapb.ImportRequest) er
 		zap.Int64("taskID", in.GetTaskID()),
 		zap.Int64("collectionID", in.GetCollectionID()),
 	)
-	ctx, cancel := context.WithTimeout(context.Background(), importTaskTimeout)
-	defer cancel()
-	cli, err := c.getClient(ctx, nodeID)
+	err := c.call(context.Background(), nodeID, "ImportV2", func(ctx context.Context, cli types.DataNodeClient) error {
+		status, err := cli.ImportV2(ctx, in)
+		return VerifyResponse(status, err)
+	})
 	if err != nil {
-		log.Info("failed to get client", zap.Error(err))
-		return err
+		log.Info("failed to import", zap.Error(err))
 	}
-	status, err := cli.ImportV2(ctx, in)
-	return VerifyResponse(status, err)
+	return err
 }
 
 func (c *SessionManagerImpl) QueryPreImport(nodeID int64, in *datapb.QueryPreImportRequest) (*datapb.QueryPreImportResponse, error) {
@@ -456,15 +413,14 @@ func (c *SessionManagerImpl) QueryPreImport(nodeID int64, in *datapb.QueryPreImp
 		zap.Int64("jobID", in.GetJobID()),
 		zap.Int64("taskID", in.GetTaskID()),
 	)
-	ctx, cancel := context.WithTimeout(context.Background(), importTaskTimeout)
-	defer cancel()
-	cli, err := c.getClient(ctx, nodeID)
+	var resp *datapb.QueryPreImportResponse
+	err := c.call(context.Background(), nodeID, "QueryPreImport", func(ctx context.Context, cli types.DataNodeClient) error {
+		var err error
+		resp, err = cli.QueryPreImport(ctx, in)
+		return VerifyResponse(resp.GetStatus(), err)
+	})
 	if err != nil {
-		log.Info("failed to get client", zap.Error(err))
-		return nil, err
-	}
-	resp, err := cli.QueryPreImport(ctx, in)
-	if err = VerifyResponse(resp.GetStatus(), err); err != nil {
+		log.Info("failed to query pre-import", zap.Error(err))
 		return nil, err
 	}
 	return resp, nil
@@ -476,15 +432,14 @@ func (c *SessionManagerImpl) QueryImport(nodeID int64, in *datapb.QueryImportReq
 		zap.Int64("jobID", in.GetJobID()),
 		zap.Int64("taskID", in.GetTaskID()),
 	)
-	ctx, cancel := context.WithTimeout(context.Background(), importTaskTimeout)
-	defer cancel()
-	cli, err := c.getClient(ctx, nodeID)
+	var resp *datapb.QueryImportResponse
+	err := c.call(context.Background(), nodeID, "QueryImport", func(ctx context.Context, cli types.DataNodeClient) error {
+		var err error
+		resp, err = cli.QueryImport(ctx, in)
+		return VerifyResponse(resp.GetStatus(), err)
+	})
 	if err != nil {
-		log.Info("failed to get client", zap.Error(err))
-		return nil, err
-	}
-	resp, err := cli.QueryImport(ctx, in)
-	if err = VerifyResponse(resp.GetStatus(), err); err != nil {
+		log.Info("failed to query import", zap.Error(err))
 		return nil, err
 	}
 	return resp, nil
@@ -496,15 +451,50 @@ func (c *SessionManagerImpl) DropImport(nodeID int64, in *datapb.DropImportReque
 		zap.Int64("jobID", in.GetJobID()),
 		zap.Int64("taskID", in.GetTaskID()),
 	)
-	ctx, cancel := context.WithTimeout(context.Background(), importTaskTimeout)
-	defer cancel()
-	cli, err := c.getClient(ctx, nodeID)
+	err := c.call(context.Background(), nodeID, "DropImport", func(ctx context.Context, cli types.DataNodeClient) error {
+		status, err := cli.DropImport(ctx, in)
+		return VerifyResponse(status, err)
+	})
 	if err != nil {
-		log.Info("failed to get client", zap.Error(err))
-		return err
+		log.Info("failed to drop import", zap.Error(err))
+	}
+	return err
+}
+
+func (c *SessionManagerImpl) ExportV2(nodeID int64, in *datapb.ExportRequest) error {
+	log := log.With(
+		zap.Int64("nodeID", nodeID),
+		zap.Int64("jobID", in.GetJobID()),
+		zap.Int64("taskID", in.GetTaskID()),
+		zap.Int64("collectionID", in.GetCollectionID()),
+	)
+	err := c.call(context.Background(), nodeID, "ExportV2", func(ctx context.Context, cli types.DataNodeClient) error {
+		status, err := cli.ExportV2(ctx, in)
+		return VerifyResponse(status, err)
+	})
+	if err != nil {
+		log.Info("failed to export", zap.Error(err))
+	}
+	return err
+}
+
+func (c *SessionManagerImpl) QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	log := log.With(
+		zap.Int64("nodeID", nodeID),
+		zap.Int64("jobID", in.GetJobID()),
+		zap.Int64("taskID", in.GetTaskID()),
+	)
+	var resp *datapb.QueryExportResponse
+	err := c.call(context.Background(), nodeID, "QueryExport", func(ctx context.Context, cli types.DataNodeClient) error {
+		var err error
+		resp, err = cli.QueryExport(ctx, in)
+		return VerifyResponse(resp.GetStatus(), err)
+	})
+	if err != nil {
+		log.Info("failed to query export", zap.Error(err))
+		return nil, err
 	}
-	status, err := cli.DropImport(ctx, in)
-	return VerifyResponse(status, err)
+	return resp, nil
 }
 
 func (c *SessionManagerImpl) CheckHealth(ctx context.Context) error {
@@ -531,17 +521,33 @@ func (c *SessionManagerImpl) CheckHealth(ctx context.Context) error {
 	return group.Wait()
 }
 
-func (c *SessionManagerImpl) QuerySlot(nodeID int64) (*datapb.QuerySlotResponse, error) {
-	log := log.With(zap.Int64("nodeID", nodeID))
-	ctx, cancel := context.WithTimeout(context.Background(), querySlotTimeout)
-	defer cancel()
+// CheckNodeHealth checks the health of the DataNode by grpc directly. A stopping DataNode is considered alive,
+// it is still flushing its channels and removed once its session is gone.
+func (c *SessionManagerImpl) CheckNodeHealth(ctx context.Context, nodeID int64) error {
 	cli, err := c.getClient(ctx, nodeID)
 	if err != nil {
-		log.Info("failed to get client", zap.Error(err))
-		return nil, err
+		return err
+	}
+	resp, err := cli.GetComponentStates(ctx, &milvuspb.GetComponentStatesRequest{})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return err
+	}
+	if state := resp.GetState().GetStateCode(); state == commonpb.StateCode_Abnormal {
+		return merr.WrapErrServiceNotReady(typeutil.DataNodeRole, nodeID, state.String())
 	}
-	resp, err := cli.QuerySlot(ctx, &datapb.QuerySlotRequest{})
-	if err = VerifyResponse(resp.GetStatus(), err); err != nil {
+	return nil
+}
+
+func (c *SessionManagerImpl) QuerySlot(nodeID int64) (*datapb.QuerySlotResponse, error) {
+	log := log.With(zap.Int64("nodeID", nodeID))
+	var resp *datapb.QuerySlotResponse
+	err := c.call(context.Background(), nodeID, "QuerySlot", func(ctx context.Context, cli types.DataNodeClient) error {
+		var err error
+		resp, err = cli.QuerySlot(ctx, &datapb.QuerySlotRequest{})
+		return VerifyResponse(resp.GetStatus(), err)
+	})
+	if err != nil {
+		log.Info("failed to query slot", zap.Error(err))
 		return nil, err
 	}
 	return resp, nil
@@ -552,22 +558,12 @@ func (c *SessionManagerImpl) DropCompactionPlan(nodeID int64, req *datapb.DropCo
 		zap.Int64("nodeID", nodeID),
 		zap.Int64("planID", req.GetPlanID()),
 	)
-	ctx, cancel := context.WithTimeout(context.Background(), Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second))
-	defer cancel()
-	cli, err := c.getClient(ctx, nodeID)
-	if err != nil {
-		if errors.Is(err, merr.ErrNodeNotFound) {
-			log.Info("node not found, skip dropping compaction plan")
-			return nil
-		}
-		log.Warn("failed to get client", zap.Error(err))
-		return err
+	if _, ok := c.GetSession(nodeID); !ok {
+		log.Info("node not found, skip dropping compaction plan")
+		return nil
 	}
 
-	err = retry.Do(context.Background(), func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second))
-		defer cancel()
-
+	err := c.call(context.Background(), nodeID, "DropCompactionPlan", func(ctx context.Context, cli types.DataNodeClient) error {
 		resp, err := cli.DropCompactionPlan(ctx, req)
 		if err := VerifyResponse(resp, err); err != nil {
 			log.Warn("failed to drop compaction plan", zap.Error(err))
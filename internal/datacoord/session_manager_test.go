@@ -5,14 +5,18 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/testutils"
 )
 
@@ -35,10 +39,55 @@ func (s *SessionManagerSuite) SetupTest() {
 		return s.dn, nil
 	}))
 
-	s.m.AddSession(&NodeInfo{1000, "addr-1", true})
+	s.m.AddSession(&NodeInfo{1000, "addr-1", true, map[string]string{"tenant": "a"}})
 	s.MetricsEqual(metrics.DataCoordNumDataNodes, 1)
 }
 
+func (s *SessionManagerSuite) TestGetNodeLabels() {
+	s.Equal(map[string]string{"tenant": "a"}, s.m.getNodeLabels(1000))
+	s.Nil(s.m.getNodeLabels(1001))
+}
+
+func (s *SessionManagerSuite) TestCheckNodeHealth() {
+	ctx := context.Background()
+	s.ErrorIs(s.m.CheckNodeHealth(ctx, 1001), merr.ErrNodeNotFound)
+
+	s.dn.EXPECT().GetComponentStates(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+	s.Error(s.m.CheckNodeHealth(ctx, 1000))
+
+	for _, state := range []commonpb.StateCode{commonpb.StateCode_Healthy, commonpb.StateCode_Stopping} {
+		s.dn.EXPECT().GetComponentStates(mock.Anything, mock.Anything).Return(&milvuspb.ComponentStates{
+			State:  &milvuspb.ComponentInfo{StateCode: state},
+			Status: merr.Success(),
+		}, nil).Once()
+		s.NoError(s.m.CheckNodeHealth(ctx, 1000))
+	}
+
+	s.dn.EXPECT().GetComponentStates(mock.Anything, mock.Anything).Return(&milvuspb.ComponentStates{
+		State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Abnormal},
+		Status: merr.Success(),
+	}, nil).Once()
+	s.ErrorIs(s.m.CheckNodeHealth(ctx, 1000), merr.ErrServiceNotReady)
+}
+
+func (s *SessionManagerSuite) TestCallRetry() {
+	paramtable.Get().Save(Params.DataCoordCfg.SessionRPCMaxRetries.Key, "1")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SessionRPCMaxRetries.Key)
+	retries := testutil.ToFloat64(metrics.DataCoordSessionRPCRetryCounter.WithLabelValues("QuerySlot"))
+
+	s.dn.EXPECT().QuerySlot(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+	s.dn.EXPECT().QuerySlot(mock.Anything, mock.Anything).Return(&datapb.QuerySlotResponse{Status: merr.Success(), NumSlots: 1}, nil).Once()
+	resp, err := s.m.QuerySlot(1000)
+	s.NoError(err)
+	s.EqualValues(1, resp.GetNumSlots())
+	s.MetricsEqual(metrics.DataCoordSessionRPCRetryCounter.WithLabelValues("QuerySlot"), retries+1)
+
+	s.dn.EXPECT().QuerySlot(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Twice()
+	_, err = s.m.QuerySlot(1000)
+	s.Error(err)
+	s.MetricsEqual(metrics.DataCoordSessionRPCRetryCounter.WithLabelValues("QuerySlot"), retries+2)
+}
+
 func (s *SessionManagerSuite) SetupSubTest() {
 	s.SetupTest()
 }
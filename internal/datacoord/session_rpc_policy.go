@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+// rpcPolicy is the timeout of each attempt and the max retry times of an rpc to the DataNodes.
+type rpcPolicy struct {
+	timeout    time.Duration // no timeout if zero, bounded by the context of the caller only
+	maxRetries uint
+}
+
+// builtinRPCPolicies adjust the default policy for the rpcs with their own timeouts or retries,
+// the configs of dataCoord.sessionRPC.methods still override them.
+var builtinRPCPolicies = map[string]func(policy *rpcPolicy){
+	"FlushSegments": func(policy *rpcPolicy) {
+		policy.timeout = 15 * time.Second
+	},
+	"FlushChannels": func(policy *rpcPolicy) {
+		policy.timeout = 0
+	},
+	"CompactionV2": func(policy *rpcPolicy) {
+		policy.timeout = Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second)
+	},
+	"GetCompactionState": func(policy *rpcPolicy) {
+		policy.timeout = Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second)
+	},
+	"DropCompactionPlan": func(policy *rpcPolicy) {
+		policy.timeout = Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second)
+		policy.maxRetries = 9
+	},
+	"SyncSegments": func(policy *rpcPolicy) {
+		policy.timeout = 0
+		policy.maxRetries = 9
+	},
+	"NotifyChannelOperation": func(policy *rpcPolicy) {
+		policy.timeout = Params.DataCoordCfg.ChannelOperationRPCTimeout.GetAsDuration(time.Second)
+	},
+	"CheckChannelOperationProgress": func(policy *rpcPolicy) {
+		policy.timeout = Params.DataCoordCfg.ChannelOperationRPCTimeout.GetAsDuration(time.Second)
+	},
+}
+
+// getRPCPolicy returns the policy of the rpc method, from the defaults, the builtin policy of the method
+// and the configs of the method in order.
+func getRPCPolicy(method string) rpcPolicy {
+	policy := rpcPolicy{
+		timeout: Params.DataCoordCfg.SessionRPCTimeout.GetAsDuration(time.Second),
+	}
+	if maxRetries := Params.DataCoordCfg.SessionRPCMaxRetries.GetAsInt(); maxRetries > 0 {
+		policy.maxRetries = uint(maxRetries)
+	}
+	if builtin, ok := builtinRPCPolicies[method]; ok {
+		builtin(&policy)
+	}
+
+	overrides := Params.DataCoordCfg.SessionRPCMethodOverrides.GetValue()
+	parse := func(item string) (float64, bool) {
+		key := strings.ToLower(method) + "." + item
+		value, ok := overrides[key]
+		if !ok {
+			return 0, false
+		}
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || v < 0 {
+			log.RatedWarn(60, "invalid session rpc config of method, ignored", zap.String("key", key), zap.String("value", value))
+			return 0, false
+		}
+		return v, true
+	}
+	if timeout, ok := parse("timeout"); ok {
+		policy.timeout = time.Duration(timeout * float64(time.Second))
+	}
+	if maxRetries, ok := parse("maxretries"); ok {
+		policy.maxRetries = uint(maxRetries)
+	}
+	return policy
+}
+
+// call invokes the rpc method to the DataNode following the policy of the method, each attempt is bounded
+// by the timeout and the failed ones are retried up to the max retry times.
+func (c *SessionManagerImpl) call(ctx context.Context, nodeID int64, method string, fn func(ctx context.Context, cli types.DataNodeClient) error) error {
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		return err
+	}
+
+	policy := getRPCPolicy(method)
+	attempts := 0
+	invoke := func() error {
+		if attempts > 0 {
+			metrics.DataCoordSessionRPCRetryCounter.WithLabelValues(method).Inc()
+		}
+		attempts++
+		ctx := ctx
+		if policy.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, policy.timeout)
+			defer cancel()
+		}
+		return fn(ctx, cli)
+	}
+	if policy.maxRetries == 0 {
+		return invoke()
+	}
+	return retry.Do(ctx, invoke, retry.Attempts(policy.maxRetries+1))
+}
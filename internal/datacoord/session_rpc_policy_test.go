@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestGetRPCPolicy(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	prefix := Params.DataCoordCfg.SessionRPCMethodOverrides.KeyPrefix
+
+	assert.Equal(t, rpcPolicy{timeout: 10 * time.Second}, getRPCPolicy("ImportV2"))
+	assert.Equal(t, rpcPolicy{timeout: 15 * time.Second}, getRPCPolicy("FlushSegments"))
+	assert.Equal(t, rpcPolicy{maxRetries: 9}, getRPCPolicy("SyncSegments"))
+	assert.Equal(t, Params.DataCoordCfg.ChannelOperationRPCTimeout.GetAsDuration(time.Second), getRPCPolicy("NotifyChannelOperation").timeout)
+
+	params.Save(Params.DataCoordCfg.SessionRPCTimeout.Key, "5")
+	defer params.Reset(Params.DataCoordCfg.SessionRPCTimeout.Key)
+	params.Save(Params.DataCoordCfg.SessionRPCMaxRetries.Key, "2")
+	defer params.Reset(Params.DataCoordCfg.SessionRPCMaxRetries.Key)
+	assert.Equal(t, rpcPolicy{timeout: 5 * time.Second, maxRetries: 2}, getRPCPolicy("ImportV2"))
+	assert.Equal(t, rpcPolicy{timeout: 15 * time.Second, maxRetries: 2}, getRPCPolicy("FlushSegments"))
+
+	// the group configs can't be reset, use the methods only for test
+	params.SaveGroup(map[string]string{
+		prefix + "mockmethod.timeout":     "0.5",
+		prefix + "mockmethod.maxRetries":  "0",
+		prefix + "mockinvalid.timeout":    "-1",
+		prefix + "mockinvalid.maxRetries": "invalid",
+		prefix + "mockretries.maxRetries": "3",
+	})
+	assert.Equal(t, rpcPolicy{timeout: 500 * time.Millisecond}, getRPCPolicy("MockMethod"))
+	// the invalid ones are ignored
+	assert.Equal(t, rpcPolicy{timeout: 5 * time.Second, maxRetries: 2}, getRPCPolicy("MockInvalid"))
+	assert.Equal(t, rpcPolicy{timeout: 5 * time.Second, maxRetries: 3}, getRPCPolicy("MockRetries"))
+}
@@ -0,0 +1,320 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/eventlog"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// slowTaskDiagnosticsPath is the path under the root path where the diagnostics of the slow tasks are captured into,
+// the diagnostics of a task is under <kind>/<taskID>/<capture time in unix milliseconds>.
+const slowTaskDiagnosticsPath = "diagnostics/slow_tasks"
+
+// runningTask is the running index build, stats, analyze or compaction task checked by the slow task detector.
+type runningTask struct {
+	kind      string
+	taskID    int64
+	nodeID    int64
+	size      int64
+	startTime time.Time
+	// onDataNode is set if the task runs on the DataNode, otherwise on the IndexNode
+	onDataNode bool
+	// state is dumped into the diagnostics
+	state proto.Message
+}
+
+// key identifies the attempt of the task, the retried task is checked again.
+func (t *runningTask) key() string {
+	return fmt.Sprintf("%s-%d-%d", t.kind, t.taskID, t.startTime.UnixMilli())
+}
+
+// slowTaskSummary is the summary of the slow task in the diagnostics.
+type slowTaskSummary struct {
+	Kind        string `json:"kind"`
+	TaskID      int64  `json:"task_id"`
+	NodeID      int64  `json:"node_id"`
+	Size        int64  `json:"size"`
+	StartTime   string `json:"start_time"`
+	CaptureTime string `json:"capture_time"`
+	Elapsed     string `json:"elapsed"`
+	Expected    string `json:"expected"`
+	// WorkerError is the reason the diagnostics of the worker are missing
+	WorkerError string `json:"worker_error,omitempty"`
+}
+
+// expectedTaskDuration returns the duration expected for the task of the size in bytes.
+func expectedTaskDuration(size int64) time.Duration {
+	expected := Params.DataCoordCfg.SlowTaskBaseDuration.GetAsDuration(time.Second)
+	throughput := Params.DataCoordCfg.SlowTaskThroughput.GetAsFloat() * 1024 * 1024
+	if throughput > 0 {
+		expected += time.Duration(float64(size) / throughput * float64(time.Second))
+	}
+	return expected
+}
+
+// slowTaskDetector checks the running tasks periodically, the diagnostics of the task running longer than the
+// duration expected from its size are captured into object storage, including the task state, the goroutine and
+// heap profiles and the storage op metrics of the worker, and an event is recorded.
+type slowTaskDetector struct {
+	meta           *meta
+	scheduler      *taskScheduler
+	workerManager  WorkerManager
+	sessionManager SessionManager
+	chunkManager   storage.ChunkManager
+
+	// captured records the attempts of the slow tasks captured, it's accessed by the detect loop only
+	captured typeutil.Set[string]
+}
+
+func newSlowTaskDetector(meta *meta, scheduler *taskScheduler, workerManager WorkerManager,
+	sessionManager SessionManager, chunkManager storage.ChunkManager,
+) *slowTaskDetector {
+	return &slowTaskDetector{
+		meta:           meta,
+		scheduler:      scheduler,
+		workerManager:  workerManager,
+		sessionManager: sessionManager,
+		chunkManager:   chunkManager,
+		captured:       typeutil.NewSet[string](),
+	}
+}
+
+func (d *slowTaskDetector) detectLoop(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("slow task detect loop exit")
+			return
+		case <-timer.C:
+			if Params.DataCoordCfg.SlowTaskDetectEnabled.GetAsBool() && d.chunkManager != nil {
+				d.detect(ctx)
+			}
+			interval := Params.DataCoordCfg.SlowTaskDetectInterval.GetAsDuration(time.Second)
+			if interval <= 0 {
+				interval = time.Minute
+			}
+			timer.Reset(interval)
+		}
+	}
+}
+
+// detect captures the diagnostics of the slow tasks, each attempt of the task is captured once,
+// it returns the number of the tasks captured in this round.
+func (d *slowTaskDetector) detect(ctx context.Context) int {
+	tasks := append(d.listIndexTasks(), d.listCompactionTasks()...)
+	captured := typeutil.NewSet[string]()
+	num := 0
+	for _, task := range tasks {
+		key := task.key()
+		if d.captured.Contain(key) {
+			captured.Insert(key)
+			continue
+		}
+		elapsed := time.Since(task.startTime)
+		expected := expectedTaskDuration(task.size)
+		if elapsed <= expected {
+			continue
+		}
+
+		log := log.Ctx(ctx).With(zap.String("kind", task.kind), zap.Int64("taskID", task.taskID),
+			zap.Int64("nodeID", task.nodeID), zap.Int64("size", task.size),
+			zap.Duration("elapsed", elapsed), zap.Duration("expected", expected))
+		dir, err := d.capture(ctx, task, elapsed, expected)
+		if err != nil {
+			// captured again in the next round
+			log.Warn("failed to capture the diagnostics of the slow task", zap.Error(err))
+			continue
+		}
+		captured.Insert(key)
+		num++
+		log.Warn("task is slow, diagnostics captured", zap.String("path", dir))
+		metrics.DataCoordSlowTaskCounter.WithLabelValues(task.kind).Inc()
+		eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Warn,
+			fmt.Sprintf("%s task %d on node %d is slow, elapsed %s, expected %s, diagnostics captured to %s",
+				task.kind, task.taskID, task.nodeID, elapsed.Truncate(time.Second), expected.Truncate(time.Second), dir)))
+	}
+	// the attempts no longer running are forgotten
+	d.captured = captured
+	return num
+}
+
+// listIndexTasks lists the in progress tasks of the index task scheduler, the size of the analyze task is unknown.
+func (d *slowTaskDetector) listIndexTasks() []*runningTask {
+	if d.scheduler == nil {
+		return nil
+	}
+	tasks := make([]*runningTask, 0)
+	for _, info := range d.scheduler.listTasks(0, []indexpb.JobState{indexpb.JobState_JobStateInProgress}) {
+		startTime := info.GetTiming().GetNodeStartTime()
+		if startTime == 0 {
+			startTime = info.GetTiming().GetDispatchTime()
+		}
+		if startTime == 0 || info.GetNodeID() == 0 {
+			continue
+		}
+		var size int64
+		if segment := d.meta.GetSegment(info.GetSegmentID()); segment != nil {
+			size = segment.getSegmentSize()
+		}
+		tasks = append(tasks, &runningTask{
+			kind:      strings.TrimPrefix(info.GetType().String(), "JobType"),
+			taskID:    info.GetTaskID(),
+			nodeID:    info.GetNodeID(),
+			size:      size,
+			startTime: time.UnixMilli(startTime),
+			state:     info,
+		})
+	}
+	return tasks
+}
+
+// listCompactionTasks lists the executing compaction tasks, the size is the total size of the input segments.
+func (d *slowTaskDetector) listCompactionTasks() []*runningTask {
+	tasks := make([]*runningTask, 0)
+	for _, triggerTasks := range d.meta.compactionTaskMeta.GetCompactionTasks() {
+		for _, task := range triggerTasks {
+			if task.GetState() != datapb.CompactionTaskState_executing || task.GetNodeID() == NullNodeID ||
+				task.GetStartTime() == 0 {
+				continue
+			}
+			var size int64
+			for _, segmentID := range task.GetInputSegments() {
+				if segment := d.meta.GetSegment(segmentID); segment != nil {
+					size += segment.getSegmentSize()
+				}
+			}
+			tasks = append(tasks, &runningTask{
+				kind:       task.GetType().String(),
+				taskID:     task.GetPlanID(),
+				nodeID:     task.GetNodeID(),
+				size:       size,
+				startTime:  time.Unix(task.GetStartTime(), 0),
+				onDataNode: true,
+				state:      task,
+			})
+		}
+	}
+	return tasks
+}
+
+// capture writes the diagnostics of the slow task into object storage and returns the path of them,
+// the task state is captured even if the diagnostics of the worker are missing.
+func (d *slowTaskDetector) capture(ctx context.Context, task *runningTask, elapsed, expected time.Duration) (string, error) {
+	captureTime := time.Now()
+	dir := path.Join(d.chunkManager.RootPath(), slowTaskDiagnosticsPath, task.kind,
+		strconv.FormatInt(task.taskID, 10), strconv.FormatInt(captureTime.UnixMilli(), 10))
+
+	state, err := protojson.Marshal(task.state)
+	if err != nil {
+		return "", err
+	}
+	contents := map[string][]byte{
+		path.Join(dir, "task.json"): state,
+	}
+	summary := &slowTaskSummary{
+		Kind:        task.kind,
+		TaskID:      task.taskID,
+		NodeID:      task.nodeID,
+		Size:        task.size,
+		StartTime:   task.startTime.String(),
+		CaptureTime: captureTime.String(),
+		Elapsed:     elapsed.String(),
+		Expected:    expected.String(),
+	}
+	diagnostics, err := d.getWorkerDiagnostics(ctx, task)
+	if err != nil {
+		summary.WorkerError = err.Error()
+	} else {
+		contents[path.Join(dir, "goroutines.txt")] = []byte(diagnostics.Goroutines)
+		contents[path.Join(dir, "heap.pb.gz")] = diagnostics.HeapProfile
+		contents[path.Join(dir, "storage_metrics.txt")] = []byte(diagnostics.StorageMetrics)
+	}
+	summaryBytes, err := json.Marshal(summary)
+	if err != nil {
+		return "", err
+	}
+	contents[path.Join(dir, "summary.json")] = summaryBytes
+
+	if err := d.chunkManager.MultiWrite(ctx, contents); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// getWorkerDiagnostics requests the diagnostics of the worker the task runs on.
+func (d *slowTaskDetector) getWorkerDiagnostics(ctx context.Context, task *runningTask) (*metricsinfo.WorkerDiagnostics, error) {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.DiagnosticsMetrics)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, reqTimeoutInterval)
+	defer cancel()
+
+	var resp *milvuspb.GetMetricsResponse
+	if task.onDataNode {
+		session, ok := d.sessionManager.GetSession(task.nodeID)
+		if !ok {
+			return nil, merr.WrapErrNodeNotFound(task.nodeID)
+		}
+		client, err := session.GetOrCreateClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resp, err = client.GetMetrics(ctx, req)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return nil, err
+		}
+	} else {
+		client, ok := d.workerManager.GetClientByID(task.nodeID)
+		if !ok {
+			return nil, merr.WrapErrNodeNotFound(task.nodeID)
+		}
+		resp, err = client.GetMetrics(ctx, req)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return nil, err
+		}
+	}
+
+	diagnostics := &metricsinfo.WorkerDiagnostics{}
+	if err := metricsinfo.UnmarshalComponentInfos(resp.GetResponse(), diagnostics); err != nil {
+		return nil, err
+	}
+	return diagnostics, nil
+}
@@ -0,0 +1,218 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestSlowTaskDetectorSuite(t *testing.T) {
+	suite.Run(t, new(SlowTaskDetectorSuite))
+}
+
+type SlowTaskDetectorSuite struct {
+	suite.Suite
+
+	meta           *meta
+	chunkManager   *mocks.ChunkManager
+	sessionManager *MockSessionManager
+	workerManager  *MockWorkerManager
+	dataNodeClient *mocks.MockDataNodeClient
+	indexClient    *mocks.MockIndexNodeClient
+	detector       *slowTaskDetector
+
+	// written records the contents captured into object storage
+	written map[string][]byte
+}
+
+func (s *SlowTaskDetectorSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *SlowTaskDetectorSuite) SetupTest() {
+	paramtable.Get().Save(Params.DataCoordCfg.SlowTaskBaseDuration.Key, "3600")
+	paramtable.Get().Save(Params.DataCoordCfg.SlowTaskThroughput.Key, "1")
+
+	s.meta = &meta{
+		segments:           NewSegmentsInfo(),
+		compactionTaskMeta: newTestCompactionTaskMeta(s.T()),
+		indexMeta: &indexMeta{
+			buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{
+				1: {CollectionID: 10, SegmentID: 100, BuildID: 1},
+			},
+		},
+	}
+	for segmentID, memorySize := range map[int64]int64{100: 1024, 101: 1024 * 1024 * 1024} {
+		s.meta.segments.SetSegment(segmentID, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:    segmentID,
+			State: commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 1, Binlogs: []*datapb.Binlog{{MemorySize: memorySize}}},
+			},
+		}))
+	}
+	startTime := time.Now().Add(-70 * time.Minute).Unix()
+	// the small one is slow, the large one is expected to run longer
+	s.meta.compactionTaskMeta.SaveCompactionTask(&datapb.CompactionTask{
+		TriggerID: 1, PlanID: 10, NodeID: 2, Type: datapb.CompactionType_MixCompaction,
+		State: datapb.CompactionTaskState_executing, StartTime: startTime, InputSegments: []int64{100},
+	})
+	s.meta.compactionTaskMeta.SaveCompactionTask(&datapb.CompactionTask{
+		TriggerID: 1, PlanID: 11, NodeID: 2, Type: datapb.CompactionType_MixCompaction,
+		State: datapb.CompactionTaskState_executing, StartTime: startTime, InputSegments: []int64{101},
+	})
+	s.meta.compactionTaskMeta.SaveCompactionTask(&datapb.CompactionTask{
+		TriggerID: 1, PlanID: 12, NodeID: 2, Type: datapb.CompactionType_MixCompaction,
+		State: datapb.CompactionTaskState_completed, StartTime: startTime, InputSegments: []int64{100},
+	})
+
+	scheduler := &taskScheduler{
+		meta: s.meta,
+		tasks: map[int64]Task{
+			1: &indexBuildTask{
+				taskID:   1,
+				nodeID:   3,
+				taskInfo: &indexpb.IndexTaskInfo{BuildID: 1, State: commonpb.IndexState_InProgress},
+				timing:   taskTiming{nodeStartTime: time.Now().Add(-2 * time.Hour)},
+			},
+		},
+	}
+
+	s.written = make(map[string][]byte)
+	s.chunkManager = mocks.NewChunkManager(s.T())
+	s.chunkManager.EXPECT().RootPath().Return("root").Maybe()
+	s.chunkManager.EXPECT().MultiWrite(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, contents map[string][]byte) error {
+		for key, value := range contents {
+			s.written[key] = value
+		}
+		return nil
+	}).Maybe()
+
+	s.dataNodeClient = mocks.NewMockDataNodeClient(s.T())
+	s.sessionManager = NewMockSessionManager(s.T())
+	s.sessionManager.EXPECT().GetSession(int64(2)).Return(&Session{
+		info:   &NodeInfo{NodeID: 2},
+		client: s.dataNodeClient,
+	}, true).Maybe()
+	s.indexClient = mocks.NewMockIndexNodeClient(s.T())
+	s.workerManager = NewMockWorkerManager(s.T())
+	s.workerManager.EXPECT().GetClientByID(int64(3)).Return(s.indexClient, true).Maybe()
+
+	s.detector = newSlowTaskDetector(s.meta, scheduler, s.workerManager, s.sessionManager, s.chunkManager)
+}
+
+func (s *SlowTaskDetectorSuite) TearDownTest() {
+	paramtable.Get().Reset(Params.DataCoordCfg.SlowTaskBaseDuration.Key)
+	paramtable.Get().Reset(Params.DataCoordCfg.SlowTaskThroughput.Key)
+}
+
+func (s *SlowTaskDetectorSuite) findSummary(kind string, taskID string) *slowTaskSummary {
+	for key, value := range s.written {
+		dir := path.Dir(key)
+		if path.Base(key) == "summary.json" && path.Base(path.Dir(dir)) == taskID && path.Base(path.Dir(path.Dir(dir))) == kind {
+			summary := &slowTaskSummary{}
+			s.NoError(json.Unmarshal(value, summary))
+			return summary
+		}
+	}
+	return nil
+}
+
+func (s *SlowTaskDetectorSuite) TestExpectedTaskDuration() {
+	s.Equal(time.Hour, expectedTaskDuration(0))
+	s.Equal(time.Hour+1024*time.Second, expectedTaskDuration(1024*1024*1024))
+
+	paramtable.Get().Save(Params.DataCoordCfg.SlowTaskThroughput.Key, "0")
+	s.Equal(time.Hour, expectedTaskDuration(1024*1024*1024))
+}
+
+func (s *SlowTaskDetectorSuite) TestDetect() {
+	diagnostics, err := metricsinfo.MarshalComponentInfos(&metricsinfo.WorkerDiagnostics{
+		NodeID:         2,
+		Goroutines:     "goroutine profile",
+		HeapProfile:    []byte("heap profile"),
+		StorageMetrics: "storage metrics",
+	})
+	s.Require().NoError(err)
+	s.dataNodeClient.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(&milvuspb.GetMetricsResponse{
+		Status:   merr.Success(),
+		Response: diagnostics,
+	}, nil).Once()
+	s.indexClient.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(nil, errors.New("mock error")).Once()
+
+	s.Equal(2, s.detector.detect(context.TODO()))
+
+	compaction := s.findSummary(datapb.CompactionType_MixCompaction.String(), "10")
+	s.Require().NotNil(compaction)
+	s.Equal(int64(2), compaction.NodeID)
+	s.Empty(compaction.WorkerError)
+	s.Nil(s.findSummary(datapb.CompactionType_MixCompaction.String(), "11"))
+	s.Nil(s.findSummary(datapb.CompactionType_MixCompaction.String(), "12"))
+
+	// the task state is captured without the diagnostics of the worker
+	index := s.findSummary("IndexJob", "1")
+	s.Require().NotNil(index)
+	s.Equal(int64(3), index.NodeID)
+	s.Contains(index.WorkerError, "mock error")
+
+	var goroutines, heaps int
+	for key, value := range s.written {
+		switch path.Base(key) {
+		case "goroutines.txt":
+			goroutines++
+			s.Equal("goroutine profile", string(value))
+		case "heap.pb.gz":
+			heaps++
+		}
+	}
+	s.Equal(1, goroutines)
+	s.Equal(1, heaps)
+
+	// captured once for each attempt
+	s.Equal(0, s.detector.detect(context.TODO()))
+}
+
+func (s *SlowTaskDetectorSuite) TestDetectCaptureFailed() {
+	s.chunkManager.ExpectedCalls = nil
+	s.chunkManager.EXPECT().RootPath().Return("root")
+	s.chunkManager.EXPECT().MultiWrite(mock.Anything, mock.Anything).Return(errors.New("mock error"))
+	s.dataNodeClient.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
+	s.indexClient.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
+
+	s.Equal(0, s.detector.detect(context.TODO()))
+	// captured again in the next round
+	s.Equal(0, s.detector.detect(context.TODO()))
+	s.Empty(s.detector.captured)
+}
@@ -0,0 +1,212 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+)
+
+type statsMeta struct {
+	sync.RWMutex
+
+	ctx     context.Context
+	catalog metastore.DataCoordCatalog
+
+	// taskID -> stats task
+	tasks map[int64]*indexpb.StatsTask
+	// segmentID -> taskID, a segment has one stats task at most
+	segmentTasks map[int64]int64
+}
+
+func newStatsMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*statsMeta, error) {
+	mt := &statsMeta{
+		ctx:          ctx,
+		catalog:      catalog,
+		tasks:        make(map[int64]*indexpb.StatsTask),
+		segmentTasks: make(map[int64]int64),
+	}
+
+	if err := mt.reloadFromKV(); err != nil {
+		return nil, err
+	}
+	return mt, nil
+}
+
+func (m *statsMeta) reloadFromKV() error {
+	record := timerecord.NewTimeRecorder("statsMeta-reloadFromKV")
+
+	statsTasks, err := m.catalog.ListStatsTasks(m.ctx)
+	if err != nil {
+		log.Warn("statsMeta reloadFromKV load stats tasks failed", zap.Error(err))
+		return err
+	}
+
+	for _, statsTask := range statsTasks {
+		m.tasks[statsTask.GetTaskID()] = statsTask
+		m.segmentTasks[statsTask.GetSegmentID()] = statsTask.GetTaskID()
+	}
+	log.Info("statsMeta reloadFromKV done", zap.Duration("duration", record.ElapseSpan()))
+	return nil
+}
+
+func (m *statsMeta) saveTask(newTask *indexpb.StatsTask) error {
+	if err := m.catalog.SaveStatsTask(m.ctx, newTask); err != nil {
+		return err
+	}
+	m.tasks[newTask.GetTaskID()] = newTask
+	m.segmentTasks[newTask.GetSegmentID()] = newTask.GetTaskID()
+	return nil
+}
+
+func (m *statsMeta) GetTask(taskID int64) *indexpb.StatsTask {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.tasks[taskID]
+}
+
+// GetTaskBySegment returns the stats task of the segment, nil if there is none.
+func (m *statsMeta) GetTaskBySegment(segmentID int64) *indexpb.StatsTask {
+	m.RLock()
+	defer m.RUnlock()
+
+	taskID, ok := m.segmentTasks[segmentID]
+	if !ok {
+		return nil
+	}
+	return m.tasks[taskID]
+}
+
+func (m *statsMeta) AddStatsTask(task *indexpb.StatsTask) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if taskID, ok := m.segmentTasks[task.GetSegmentID()]; ok {
+		return fmt.Errorf("stats task %d of segment %d already exists", taskID, task.GetSegmentID())
+	}
+	log.Info("add stats task", zap.Int64("taskID", task.GetTaskID()),
+		zap.Int64("collectionID", task.GetCollectionID()), zap.Int64("segmentID", task.GetSegmentID()))
+	return m.saveTask(task)
+}
+
+func (m *statsMeta) DropStatsTask(taskID int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	log.Info("drop stats task", zap.Int64("taskID", taskID))
+	if err := m.catalog.DropStatsTask(m.ctx, taskID); err != nil {
+		log.Warn("drop stats task by catalog failed", zap.Int64("taskID", taskID), zap.Error(err))
+		return err
+	}
+
+	if t, ok := m.tasks[taskID]; ok {
+		delete(m.segmentTasks, t.GetSegmentID())
+		delete(m.tasks, taskID)
+	}
+	return nil
+}
+
+// UpdateVersion bumps the version of the task as the epoch of a new assignment,
+// and checkpoints the node the task is assigned to.
+func (m *statsMeta) UpdateVersion(taskID, nodeID int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[taskID]
+	if !ok {
+		return fmt.Errorf("there is no stats task with taskID: %d", taskID)
+	}
+
+	cloneT := proto.Clone(t).(*indexpb.StatsTask)
+	cloneT.Version++
+	cloneT.NodeID = nodeID
+	log.Info("update stats task version", zap.Int64("taskID", taskID), zap.Int64("newVersion", cloneT.Version),
+		zap.Int64("nodeID", nodeID))
+	return m.saveTask(cloneT)
+}
+
+func (m *statsMeta) BuildingTask(taskID, nodeID int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[taskID]
+	if !ok {
+		return fmt.Errorf("there is no stats task with taskID: %d", taskID)
+	}
+
+	cloneT := proto.Clone(t).(*indexpb.StatsTask)
+	cloneT.NodeID = nodeID
+	cloneT.State = indexpb.JobState_JobStateInProgress
+	log.Info("stats task will be building", zap.Int64("taskID", taskID), zap.Int64("nodeID", nodeID))
+
+	return m.saveTask(cloneT)
+}
+
+// FinishTask records the final state of the task, the statistics computed are persisted to the segment meta.
+func (m *statsMeta) FinishTask(taskID int64, result *indexpb.StatsResult) error {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[taskID]
+	if !ok {
+		return fmt.Errorf("there is no stats task with taskID: %d", taskID)
+	}
+
+	log.Info("finish stats task meta", zap.Int64("taskID", taskID), zap.String("state", result.GetState().String()),
+		zap.String("failReason", result.GetFailReason()))
+
+	cloneT := proto.Clone(t).(*indexpb.StatsTask)
+	cloneT.State = result.GetState()
+	cloneT.FailReason = result.GetFailReason()
+	return m.saveTask(cloneT)
+}
+
+// UpdateTaskTiming records the timing breakdown of the current attempt of the task in memory,
+// it's persisted along with the state of the task.
+func (m *statsMeta) UpdateTaskTiming(taskID int64, timing *indexpb.TaskTiming) {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[taskID]
+	if !ok {
+		return
+	}
+	cloneT := proto.Clone(t).(*indexpb.StatsTask)
+	cloneT.Timing = timing
+	m.tasks[taskID] = cloneT
+}
+
+func (m *statsMeta) GetAllTasks() map[int64]*indexpb.StatsTask {
+	m.RLock()
+	defer m.RUnlock()
+
+	tasks := make(map[int64]*indexpb.StatsTask, len(m.tasks))
+	for taskID, task := range m.tasks {
+		tasks[taskID] = task
+	}
+	return tasks
+}
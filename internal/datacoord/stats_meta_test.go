@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+type StatsMetaSuite struct {
+	suite.Suite
+
+	collectionID int64
+	partitionID  int64
+}
+
+func (s *StatsMetaSuite) SetupSuite() {
+	s.collectionID = 100
+	s.partitionID = 101
+}
+
+func (s *StatsMetaSuite) Test_StatsMeta() {
+	catalog := mocks.NewDataCoordCatalog(s.T())
+	catalog.EXPECT().ListStatsTasks(mock.Anything).Return([]*indexpb.StatsTask{
+		{
+			CollectionID: s.collectionID,
+			PartitionID:  s.partitionID,
+			SegmentID:    1000,
+			TaskID:       1,
+			State:        indexpb.JobState_JobStateInit,
+		},
+		{
+			CollectionID: s.collectionID,
+			PartitionID:  s.partitionID,
+			SegmentID:    1001,
+			TaskID:       2,
+			State:        indexpb.JobState_JobStateFinished,
+		},
+	}, nil)
+	catalog.EXPECT().SaveStatsTask(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().DropStatsTask(mock.Anything, mock.Anything).Return(nil)
+
+	sm, err := newStatsMeta(context.Background(), catalog)
+	s.NoError(err)
+	s.Equal(2, len(sm.GetAllTasks()))
+
+	s.Run("GetTask", func() {
+		s.NotNil(sm.GetTask(1))
+		s.Nil(sm.GetTask(100))
+		s.Equal(int64(2), sm.GetTaskBySegment(1001).GetTaskID())
+		s.Nil(sm.GetTaskBySegment(1002))
+	})
+
+	s.Run("AddStatsTask", func() {
+		err := sm.AddStatsTask(&indexpb.StatsTask{
+			CollectionID: s.collectionID,
+			PartitionID:  s.partitionID,
+			SegmentID:    1002,
+			TaskID:       3,
+		})
+		s.NoError(err)
+		s.Equal(3, len(sm.GetAllTasks()))
+		s.Equal(int64(3), sm.GetTaskBySegment(1002).GetTaskID())
+
+		// one stats task for a segment at most
+		err = sm.AddStatsTask(&indexpb.StatsTask{
+			CollectionID: s.collectionID,
+			PartitionID:  s.partitionID,
+			SegmentID:    1002,
+			TaskID:       4,
+		})
+		s.Error(err)
+		s.Nil(sm.GetTask(4))
+	})
+
+	s.Run("DropStatsTask", func() {
+		err := sm.DropStatsTask(3)
+		s.NoError(err)
+		s.Equal(2, len(sm.GetAllTasks()))
+		s.Nil(sm.GetTaskBySegment(1002))
+	})
+
+	s.Run("UpdateVersion", func() {
+		err := sm.UpdateVersion(1, 5)
+		s.NoError(err)
+		s.Equal(int64(1), sm.GetTask(1).GetVersion())
+		s.Equal(int64(5), sm.GetTask(1).GetNodeID())
+
+		err = sm.UpdateVersion(100, 5)
+		s.Error(err)
+	})
+
+	s.Run("BuildingTask", func() {
+		err := sm.BuildingTask(1, 5)
+		s.NoError(err)
+		s.Equal(indexpb.JobState_JobStateInProgress, sm.GetTask(1).GetState())
+	})
+
+	s.Run("FinishTask", func() {
+		err := sm.FinishTask(1, &indexpb.StatsResult{
+			TaskID:     1,
+			State:      indexpb.JobState_JobStateFailed,
+			FailReason: "failed to read binlogs",
+		})
+		s.NoError(err)
+		s.Equal(indexpb.JobState_JobStateFailed, sm.GetTask(1).GetState())
+		s.Equal("failed to read binlogs", sm.GetTask(1).GetFailReason())
+
+		err = sm.FinishTask(100, &indexpb.StatsResult{TaskID: 100})
+		s.Error(err)
+	})
+}
+
+func (s *StatsMetaSuite) Test_failCase() {
+	catalog := mocks.NewDataCoordCatalog(s.T())
+	catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, errors.New("error")).Once()
+	sm, err := newStatsMeta(context.Background(), catalog)
+	s.Error(err)
+	s.Nil(sm)
+
+	catalog.EXPECT().ListStatsTasks(mock.Anything).Return([]*indexpb.StatsTask{
+		{
+			CollectionID: s.collectionID,
+			PartitionID:  s.partitionID,
+			SegmentID:    1000,
+			TaskID:       1,
+			State:        indexpb.JobState_JobStateInit,
+		},
+	}, nil)
+	sm, err = newStatsMeta(context.Background(), catalog)
+	s.NoError(err)
+
+	catalog.EXPECT().SaveStatsTask(mock.Anything, mock.Anything).Return(errors.New("error"))
+	catalog.EXPECT().DropStatsTask(mock.Anything, mock.Anything).Return(errors.New("error"))
+
+	s.Error(sm.AddStatsTask(&indexpb.StatsTask{SegmentID: 1001, TaskID: 2}))
+	s.Nil(sm.GetTask(2))
+	s.Nil(sm.GetTaskBySegment(1001))
+
+	s.Error(sm.DropStatsTask(1))
+	s.NotNil(sm.GetTask(1))
+
+	s.Error(sm.UpdateVersion(1, 5))
+	s.Equal(int64(0), sm.GetTask(1).GetVersion())
+
+	s.Error(sm.BuildingTask(1, 5))
+	s.Equal(indexpb.JobState_JobStateInit, sm.GetTask(1).GetState())
+}
+
+func TestStatsMeta(t *testing.T) {
+	suite.Run(t, new(StatsMetaSuite))
+}
@@ -27,7 +27,6 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
-	"github.com/milvus-io/milvus/pkg/util/lock"
 )
 
 type SyncSegmentsSchedulerSuite struct {
@@ -44,8 +43,7 @@ func Test_SyncSegmentsSchedulerSuite(t *testing.T) {
 
 func (s *SyncSegmentsSchedulerSuite) initParams() {
 	s.m = &meta{
-		RWMutex: lock.RWMutex{},
-		collections: map[UniqueID]*collectionInfo{
+		collections: newCollectionInfos(map[UniqueID]*collectionInfo{
 			1: {
 				ID: 1,
 				Schema: &schemapb.CollectionSchema{
@@ -71,7 +69,7 @@ func (s *SyncSegmentsSchedulerSuite) initParams() {
 				VChannelNames: []string{"channel1", "channel2"},
 			},
 			2: nil,
-		},
+		}),
 		segments: &SegmentsInfo{
 			secondaryIndexes: segmentInfoIndexes{
 				channel2Segments: map[string]map[UniqueID]*SegmentInfo{
@@ -353,9 +351,9 @@ func (s *SyncSegmentsSchedulerSuite) Test_SyncSegmentsFail() {
 	sss := newSyncSegmentsScheduler(s.m, cm, sm)
 
 	s.Run("pk not found", func() {
-		sss.meta.collections[1].Schema.Fields[0].IsPrimaryKey = false
+		sss.meta.GetCollection(1).Schema.Fields[0].IsPrimaryKey = false
 		sss.SyncSegmentsForCollections()
-		sss.meta.collections[1].Schema.Fields[0].IsPrimaryKey = true
+		sss.meta.GetCollection(1).Schema.Fields[0].IsPrimaryKey = true
 	})
 
 	s.Run("find watcher failed", func() {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -42,6 +43,11 @@ type analyzeTask struct {
 	taskInfo *indexpb.AnalyzeResult
 
 	req *indexpb.AnalyzeRequest
+
+	// startTime is the time the task is in progress on the worker
+	startTime time.Time
+	// timing is the timing breakdown of the current attempt
+	timing taskTiming
 }
 
 func (at *analyzeTask) GetTaskID() int64 {
@@ -52,6 +58,47 @@ func (at *analyzeTask) GetNodeID() int64 {
 	return at.nodeID
 }
 
+func (at *analyzeTask) GetStartTime() time.Time {
+	return at.startTime
+}
+
+func (at *analyzeTask) SetStartTime(startTime time.Time) {
+	at.startTime = startTime
+}
+
+func (at *analyzeTask) GetQueueTime() time.Time {
+	return at.timing.enqueueTime
+}
+
+func (at *analyzeTask) SetQueueTime(queueTime time.Time) {
+	at.timing = taskTiming{enqueueTime: queueTime}
+}
+
+func (at *analyzeTask) SetDispatchTime(dispatchTime time.Time) {
+	at.timing.dispatchTime = dispatchTime
+}
+
+func (at *analyzeTask) GetTiming() taskTiming {
+	return at.timing
+}
+
+func (at *analyzeTask) Describe(mt *meta) *indexpb.ScheduledTask {
+	return &indexpb.ScheduledTask{
+		TaskID:       at.GetTaskID(),
+		Type:         indexpb.JobType_JobTypeAnalyzeJob,
+		State:        at.GetState(),
+		NodeID:       at.GetNodeID(),
+		CollectionID: mt.analyzeMeta.GetTask(at.GetTaskID()).GetCollectionID(),
+		EnqueueTime:  at.timing.enqueueTime.UnixMilli(),
+		FailReason:   at.GetFailReason(),
+		Timing:       at.timing.toProto(),
+	}
+}
+
+func (at *analyzeTask) GetCollectionID(mt *meta) UniqueID {
+	return mt.analyzeMeta.GetTask(at.GetTaskID()).GetCollectionID()
+}
+
 func (at *analyzeTask) ResetNodeID() {
 	at.nodeID = 0
 }
@@ -61,6 +108,23 @@ func (at *analyzeTask) CheckTaskHealthy(mt *meta) bool {
 	return t != nil
 }
 
+// IsPaused returns false, analyze tasks are not pausable.
+func (at *analyzeTask) IsPaused(mt *meta) bool {
+	return false
+}
+
+// PrepareRetry returns true, analyze tasks are retried without limit.
+func (at *analyzeTask) PrepareRetry(mt *meta) (bool, error) {
+	return true, nil
+}
+
+func (at *analyzeTask) VerifySource(ctx context.Context, dependency *taskScheduler) {
+}
+
+func (at *analyzeTask) IsRetryBackoff() bool {
+	return false
+}
+
 func (at *analyzeTask) SetState(state indexpb.JobState, failReason string) {
 	at.taskInfo.State = state
 	at.taskInfo.FailReason = failReason
@@ -74,11 +138,29 @@ func (at *analyzeTask) GetFailReason() string {
 	return at.taskInfo.GetFailReason()
 }
 
-func (at *analyzeTask) UpdateVersion(ctx context.Context, meta *meta) error {
-	return meta.analyzeMeta.UpdateVersion(at.GetTaskID())
+func (at *analyzeTask) GetTaskType() indexpb.JobType {
+	return indexpb.JobType_JobTypeAnalyzeJob
+}
+
+func (at *analyzeTask) GetTaskSlot() int64 {
+	return 1
+}
+
+func (at *analyzeTask) GetTaskCost() taskCost {
+	return taskCost{}
+}
+
+func (at *analyzeTask) UpdateVersion(ctx context.Context, nodeID int64, meta *meta) error {
+	if err := meta.analyzeMeta.UpdateVersion(at.GetTaskID(), nodeID); err != nil {
+		return err
+	}
+	at.nodeID = nodeID
+	at.req.Version = meta.analyzeMeta.GetTask(at.GetTaskID()).GetVersion()
+	return nil
 }
 
 func (at *analyzeTask) UpdateMetaBuildingState(nodeID int64, meta *meta) error {
+	meta.analyzeMeta.UpdateTaskTiming(at.GetTaskID(), at.timing.toProto())
 	if err := meta.analyzeMeta.BuildingTask(at.GetTaskID(), nodeID); err != nil {
 		return err
 	}
@@ -127,7 +209,7 @@ func (at *analyzeTask) PreCheck(ctx context.Context, dependency *taskScheduler)
 		FieldType:     t.FieldType,
 		Dim:           t.Dim,
 		SegmentStats:  make(map[int64]*indexpb.SegmentStats),
-		Version:       t.Version + 1,
+		Version:       t.Version,
 		StorageConfig: storageConfig,
 	}
 
@@ -148,6 +230,13 @@ func (at *analyzeTask) PreCheck(ctx context.Context, dependency *taskScheduler)
 			at.SetState(indexpb.JobState_JobStateFailed, fmt.Sprintf("segmentInfo with ID: %d is nil", segID))
 			return true
 		}
+		if info.GetBinlogsSource() != nil {
+			// the analyze workers read the binlogs by the ids of the segment, the shared binlogs can't be found
+			log.Ctx(ctx).Warn("analyze stats task is processing, but segment is cloned, fail the task",
+				zap.Int64("taskID", at.GetTaskID()), zap.Int64("segmentID", segID))
+			at.SetState(indexpb.JobState_JobStateFailed, fmt.Sprintf("segment %d is cloned, analyze is not supported", segID))
+			return true
+		}
 
 		totalSegmentsRows += info.GetNumOfRows()
 		// get binlogIDs
@@ -232,7 +321,7 @@ func (at *analyzeTask) setResult(result *indexpb.AnalyzeResult) {
 	at.taskInfo = result
 }
 
-func (at *analyzeTask) QueryResult(ctx context.Context, client types.IndexNodeClient) {
+func (at *analyzeTask) QueryResult(ctx context.Context, client types.IndexNodeClient) bool {
 	resp, err := client.QueryJobsV2(ctx, &indexpb.QueryJobsV2Request{
 		ClusterID: Params.CommonCfg.ClusterPrefix.GetValue(),
 		TaskIDs:   []int64{at.GetTaskID()},
@@ -245,7 +334,7 @@ func (at *analyzeTask) QueryResult(ctx context.Context, client types.IndexNodeCl
 		log.Ctx(ctx).Warn("query analysis task result from IndexNode fail", zap.Int64("nodeID", at.GetNodeID()),
 			zap.Error(err))
 		at.SetState(indexpb.JobState_JobStateRetry, err.Error())
-		return
+		return false
 	}
 
 	// infos length is always one.
@@ -254,20 +343,26 @@ func (at *analyzeTask) QueryResult(ctx context.Context, client types.IndexNodeCl
 			log.Ctx(ctx).Info("query analysis task info successfully",
 				zap.Int64("taskID", at.GetTaskID()), zap.String("result state", result.GetState().String()),
 				zap.String("failReason", result.GetFailReason()))
+			at.timing.setNodeStartTime(result.GetStartTime())
 			if result.GetState() == indexpb.JobState_JobStateFinished || result.GetState() == indexpb.JobState_JobStateFailed ||
 				result.GetState() == indexpb.JobState_JobStateRetry {
 				// state is retry or finished or failed
+				at.timing.completeTime = time.Now()
 				at.setResult(result)
 			} else if result.GetState() == indexpb.JobState_JobStateNone {
 				at.SetState(indexpb.JobState_JobStateRetry, "analyze task state is none in info response")
 			}
 			// inProgress or unissued/init, keep InProgress state
-			return
+			return true
 		}
 	}
 	log.Ctx(ctx).Warn("query analyze task info failed, indexNode does not have task info",
 		zap.Int64("taskID", at.GetTaskID()))
 	at.SetState(indexpb.JobState_JobStateRetry, "analyze result is not in info response")
+	return true
+}
+
+func (at *analyzeTask) UpdateProgress(meta *meta) {
 }
 
 func (at *analyzeTask) DropTaskOnWorker(ctx context.Context, client types.IndexNodeClient) bool {
@@ -290,5 +385,6 @@ func (at *analyzeTask) DropTaskOnWorker(ctx context.Context, client types.IndexN
 }
 
 func (at *analyzeTask) SetJobInfo(meta *meta) error {
+	meta.analyzeMeta.UpdateTaskTiming(at.GetTaskID(), at.timing.toProto())
 	return meta.analyzeMeta.FinishTask(at.GetTaskID(), at.taskInfo)
 }
@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+)
+
+// taskCost is the estimated resources in bytes taken by a task on the worker, 0 if unknown,
+// and the CPU features the worker is restricted to, empty if the task runs on any worker.
+type taskCost struct {
+	memory             int64
+	disk               int64
+	allowedCPUFeatures []string
+}
+
+const (
+	defaultIndexMemoryFactor = 1.5
+	// diskIndexDiskFactor is the ratio of the local disk taken by building a disk index to the raw data,
+	// the same as the IndexNode checks before building.
+	diskIndexDiskFactor = 4.0
+	// invertedIndexDiskFactor is the ratio of the local disk taken by building an inverted index to the raw data,
+	// both the raw data downloaded and the index files are written to the local disk.
+	invertedIndexDiskFactor = 2.0
+)
+
+// indexMemoryFactors are the ratios of the memory taken by building the index to the raw data,
+// the graph and the copy of the raw data take more than the quantized ones.
+var indexMemoryFactors = map[string]float64{
+	indexparamcheck.IndexHNSW:            2.0,
+	indexparamcheck.IndexScaNN:           2.0,
+	indexparamcheck.IndexFaissIvfFlat:    2.0,
+	indexparamcheck.IndexFaissBinIvfFlat: 2.0,
+	indexparamcheck.IndexFaissIvfSQ8:     1.25,
+	indexparamcheck.IndexFaissIvfPQ:      1.25,
+	indexparamcheck.IndexDISKANN:         0.5,
+}
+
+// estimateIndexBuildCost estimates the resources taken by building the index on the vector field
+// by rows × dim × index type factor, the cost of the scalar and sparse vector field is unknown
+// except the local disk taken by the inverted index, estimated by the size of the field data in the segment.
+func estimateIndexBuildCost(indexType string, dataType schemapb.DataType, dim, numRows, fieldSize int64) taskCost {
+	var rawSize float64
+	switch dataType {
+	case schemapb.DataType_BinaryVector:
+		rawSize = float64(dim) / 8 * float64(numRows)
+	case schemapb.DataType_FloatVector:
+		rawSize = float64(dim) * float64(numRows) * 4
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		rawSize = float64(dim) * float64(numRows) * 2
+	default:
+		if indexType == indexparamcheck.IndexINVERTED {
+			return taskCost{disk: int64(float64(fieldSize) * invertedIndexDiskFactor)}
+		}
+		return taskCost{}
+	}
+
+	factor, ok := indexMemoryFactors[indexType]
+	if !ok {
+		factor = defaultIndexMemoryFactor
+	}
+	cost := taskCost{memory: int64(rawSize * factor)}
+	if isDiskANNIndex(indexType) {
+		cost.disk = int64(rawSize * diskIndexDiskFactor)
+	}
+	return cost
+}
+
+// requiredDisk returns the free local disk required on the worker to take the disk cost, with the headroom.
+func requiredDisk(cost int64) int64 {
+	return int64(float64(cost) * max(Params.DataCoordCfg.IndexDiskHeadroomRatio.GetAsFloat(), 1))
+}
+
+// fitCPUFeatures checks whether the gated CPU features of the worker are all allowed, so that the index built
+// by the worker is loadable on the query nodes supporting the allowed features.
+func fitCPUFeatures(allowed, worker []string) bool {
+	return len(allowed) == 0 || len(hardware.MissingCPUFeatures(worker, allowed)) == 0
+}
+
+// fitResource checks whether the cost fits the available resource, the unknown cost or resource always fits.
+func fitResource(cost, available, capacity int64) bool {
+	return cost <= 0 || capacity <= 0 || cost <= available
+}
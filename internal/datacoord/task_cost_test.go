@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+)
+
+func Test_estimateIndexBuildCost(t *testing.T) {
+	cost := estimateIndexBuildCost(indexparamcheck.IndexHNSW, schemapb.DataType_FloatVector, 128, 1000, 0)
+	assert.Equal(t, taskCost{memory: 128 * 1000 * 4 * 2}, cost)
+
+	cost = estimateIndexBuildCost(indexparamcheck.IndexDISKANN, schemapb.DataType_FloatVector, 128, 1000, 0)
+	assert.Equal(t, taskCost{memory: 128 * 1000 * 4 / 2, disk: 128 * 1000 * 4 * 4}, cost)
+
+	cost = estimateIndexBuildCost(indexparamcheck.IndexFaissBinIvfFlat, schemapb.DataType_BinaryVector, 128, 1000, 0)
+	assert.Equal(t, taskCost{memory: 128 / 8 * 1000 * 2}, cost)
+
+	cost = estimateIndexBuildCost("unknown", schemapb.DataType_Float16Vector, 128, 1000, 0)
+	assert.Equal(t, taskCost{memory: 128 * 1000 * 2 * 3 / 2}, cost)
+
+	cost = estimateIndexBuildCost(indexparamcheck.IndexINVERTED, schemapb.DataType_VarChar, 0, 1000, 4096)
+	assert.Equal(t, taskCost{disk: 4096 * 2}, cost)
+
+	cost = estimateIndexBuildCost(indexparamcheck.IndexSTLSORT, schemapb.DataType_Int64, 0, 1000, 8000)
+	assert.Equal(t, taskCost{}, cost)
+}
+
+func Test_getFieldBinlogSize(t *testing.T) {
+	segment := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: 10, MemorySize: 30}, {LogSize: 20}}},
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{LogSize: 100, MemorySize: 300}}},
+		},
+	}}
+	assert.EqualValues(t, 50, getFieldBinlogSize(segment, 100))
+	assert.EqualValues(t, 0, getFieldBinlogSize(segment, 102))
+}
+
+func Test_fitCPUFeatures(t *testing.T) {
+	assert.True(t, fitCPUFeatures(nil, []string{"avx512f"}))
+	assert.True(t, fitCPUFeatures([]string{"amd64", "avx2"}, nil))
+	assert.True(t, fitCPUFeatures([]string{"amd64", "avx2"}, []string{"avx2"}))
+	assert.False(t, fitCPUFeatures([]string{"amd64", "avx2"}, []string{"avx512f"}))
+}
+
+func Test_fitResource(t *testing.T) {
+	assert.True(t, fitResource(0, 0, 100))
+	assert.True(t, fitResource(50, 0, 0))
+	assert.True(t, fitResource(50, 50, 100))
+	assert.False(t, fitResource(51, 50, 100))
+}
@@ -0,0 +1,155 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// TaskEventType is the lifecycle transition of the tasks in the index task scheduler.
+type TaskEventType int32
+
+const (
+	// TaskEventEnqueued is fired when the task is added to the scheduler.
+	TaskEventEnqueued TaskEventType = iota + 1
+	// TaskEventAssigned is fired when the task is assigned to the worker and marked as in progress.
+	TaskEventAssigned
+	// TaskEventFinished is fired when the finished task is removed from the scheduler.
+	TaskEventFinished
+	// TaskEventFailed is fired when the failed task is removed from the scheduler.
+	TaskEventFailed
+	// TaskEventRetried is fired when the task is reset to be assigned again after the failed attempt.
+	TaskEventRetried
+	// TaskEventForceCompleted is fired when the wedged task is forced to complete by the operator.
+	TaskEventForceCompleted
+	// TaskEventForceFailed is fired when the wedged task is forced to fail by the operator.
+	TaskEventForceFailed
+)
+
+func (t TaskEventType) String() string {
+	switch t {
+	case TaskEventEnqueued:
+		return "Enqueued"
+	case TaskEventAssigned:
+		return "Assigned"
+	case TaskEventFinished:
+		return "Finished"
+	case TaskEventFailed:
+		return "Failed"
+	case TaskEventRetried:
+		return "Retried"
+	case TaskEventForceCompleted:
+		return "ForceCompleted"
+	case TaskEventForceFailed:
+		return "ForceFailed"
+	default:
+		return "Unknown"
+	}
+}
+
+// TaskEvent is the snapshot of the task at the lifecycle transition.
+type TaskEvent struct {
+	Type       TaskEventType
+	TaskID     int64
+	TaskType   indexpb.JobType
+	NodeID     int64
+	FailReason string
+	// Reason is the reason given by the operator of the forced transitions
+	Reason string
+	Time   time.Time
+}
+
+// TaskEventHandler handles the lifecycle events of the tasks, it's called synchronously by the scheduler
+// and must not block or call back into the scheduler, the slow handlers should hand over the events
+// to their own goroutines.
+type TaskEventHandler func(event *TaskEvent)
+
+// taskEventHandlers is the registry of the handlers subscribing the task events, the zero value is ready to use.
+type taskEventHandlers struct {
+	mu       sync.RWMutex
+	handlers map[string]TaskEventHandler
+}
+
+func (h *taskEventHandlers) register(name string, handler TaskEventHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.handlers == nil {
+		h.handlers = make(map[string]TaskEventHandler)
+	}
+	h.handlers[name] = handler
+}
+
+func (h *taskEventHandlers) unregister(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.handlers, name)
+}
+
+func (h *taskEventHandlers) fire(eventType TaskEventType, task Task) {
+	h.fireWithReason(eventType, task, "")
+}
+
+func (h *taskEventHandlers) fireWithReason(eventType TaskEventType, task Task, reason string) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if len(h.handlers) == 0 {
+		return
+	}
+	event := &TaskEvent{
+		Type:       eventType,
+		TaskID:     task.GetTaskID(),
+		TaskType:   task.GetTaskType(),
+		NodeID:     task.GetNodeID(),
+		FailReason: task.GetFailReason(),
+		Reason:     reason,
+		Time:       time.Now(),
+	}
+	for name, handler := range h.handlers {
+		h.call(name, handler, event)
+	}
+}
+
+// call isolates the scheduler from the panics of the handlers.
+func (h *taskEventHandlers) call(name string, handler TaskEventHandler, event *TaskEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Warn("task event handler panics", zap.String("handler", name),
+				zap.Int64("taskID", event.TaskID), zap.Stringer("event", event.Type), zap.Any("recover", r))
+		}
+	}()
+	handler(event)
+}
+
+// RegisterTaskEventHandler subscribes the lifecycle events of the tasks in the scheduler,
+// the handler registered with the same name is replaced.
+func (s *taskScheduler) RegisterTaskEventHandler(name string, handler TaskEventHandler) {
+	s.eventHandlers.register(name, handler)
+}
+
+// UnregisterTaskEventHandler unsubscribes the lifecycle events of the tasks in the scheduler.
+func (s *taskScheduler) UnregisterTaskEventHandler(name string) {
+	s.eventHandlers.unregister(name)
+}
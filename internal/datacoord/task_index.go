@@ -18,21 +18,28 @@ package datacoord
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
 	"path"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/compatutil"
 	itypeutil "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/indexparams"
-	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -42,6 +49,31 @@ type indexBuildTask struct {
 	taskInfo *indexpb.IndexTaskInfo
 
 	req *indexpb.CreateJobRequest
+
+	// startTime is the time the task is in progress on the worker
+	startTime time.Time
+	// timing is the timing breakdown of the current attempt
+	timing taskTiming
+	// retryTime is the time the task is allowed to be assigned again after a failed attempt
+	retryTime time.Time
+	// slot is the number of the worker slots taken by the task, by the build profile of the index
+	slot int64
+	// cost is the estimated resources taken by the task, to bin-pack the tasks onto the workers
+	cost taskCost
+	// progress is the build progress in percentage reported by the worker
+	progress int32
+	// failedOnWorker is set if the build fails on the worker, the source binlogs are verified before the retry
+	failedOnWorker bool
+	// kind groups the similar builds to compare their latencies, empty if not checked yet
+	kind string
+	// speculative is the duplicate of the build launched on another worker if it straggles
+	speculative *speculativeBuild
+	// reuseChecked is set once the identical segment indexes are looked up for the task
+	reuseChecked bool
+	// filesSource is the segment index whose index files are reused by the task, nil if built by the worker
+	filesSource *model.SegmentIndex
+	// contentHash is the hash of the binlogs of the indexed field, empty if not computed
+	contentHash string
 }
 
 var _ Task = (*indexBuildTask)(nil)
@@ -54,6 +86,59 @@ func (it *indexBuildTask) GetNodeID() int64 {
 	return it.nodeID
 }
 
+func (it *indexBuildTask) GetStartTime() time.Time {
+	return it.startTime
+}
+
+func (it *indexBuildTask) SetStartTime(startTime time.Time) {
+	it.startTime = startTime
+}
+
+func (it *indexBuildTask) GetQueueTime() time.Time {
+	return it.timing.enqueueTime
+}
+
+func (it *indexBuildTask) SetQueueTime(queueTime time.Time) {
+	it.timing = taskTiming{enqueueTime: queueTime}
+}
+
+func (it *indexBuildTask) SetDispatchTime(dispatchTime time.Time) {
+	it.timing.dispatchTime = dispatchTime
+}
+
+func (it *indexBuildTask) GetTiming() taskTiming {
+	return it.timing
+}
+
+func (it *indexBuildTask) Describe(mt *meta) *indexpb.ScheduledTask {
+	task := &indexpb.ScheduledTask{
+		TaskID:      it.GetTaskID(),
+		Type:        indexpb.JobType_JobTypeIndexJob,
+		State:       it.GetState(),
+		NodeID:      it.GetNodeID(),
+		EnqueueTime: it.timing.enqueueTime.UnixMilli(),
+		FailReason:  it.GetFailReason(),
+		Timing:      it.timing.toProto(),
+	}
+	if it.speculative != nil {
+		task.SpeculativeNodeID = it.speculative.nodeID
+	}
+	if segIndex, ok := mt.indexMeta.GetIndexJob(it.GetTaskID()); ok {
+		task.CollectionID = segIndex.CollectionID
+		task.SegmentID = segIndex.SegmentID
+		task.RetryTimes = segIndex.RetryTimes
+	}
+	return task
+}
+
+func (it *indexBuildTask) GetCollectionID(mt *meta) UniqueID {
+	segIndex, ok := mt.indexMeta.GetIndexJob(it.GetTaskID())
+	if !ok {
+		return 0
+	}
+	return segIndex.CollectionID
+}
+
 func (it *indexBuildTask) ResetNodeID() {
 	it.nodeID = 0
 }
@@ -63,6 +148,95 @@ func (it *indexBuildTask) CheckTaskHealthy(mt *meta) bool {
 	return exist
 }
 
+func (it *indexBuildTask) IsPaused(mt *meta) bool {
+	return mt.indexMeta.IsTaskPaused(it.GetTaskID())
+}
+
+// PrepareRetry records the failed attempt in meta, the task is marked as failed with the fail reasons of the attempts
+// if it exceeds the max retry times, otherwise it's backed off exponentially with jitter before the next attempt.
+func (it *indexBuildTask) PrepareRetry(mt *meta) (bool, error) {
+	mt.indexMeta.UpdateBuildTiming(it.taskID, it.timing.toProto())
+	retryTimes, failReasons, err := mt.indexMeta.RecordRetry(it.taskID, it.GetFailReason())
+	if err != nil {
+		return false, err
+	}
+
+	maxRetryTimes := Params.DataCoordCfg.IndexTaskMaxRetryTimes.GetAsInt64()
+	if maxRetryTimes > 0 && retryTimes > maxRetryTimes {
+		it.SetState(indexpb.JobState_JobStateFailed, fmt.Sprintf("index build failed after %d attempts, recent fail reasons: [%s]",
+			retryTimes, strings.Join(failReasons, "; ")))
+		return false, nil
+	}
+
+	backoff := Params.DataCoordCfg.IndexTaskRetryBackoff.GetAsDuration(time.Millisecond)
+	maxBackoff := Params.DataCoordCfg.IndexTaskRetryMaxBackoff.GetAsDuration(time.Millisecond)
+	for i := int64(1); i < retryTimes && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	// equal jitter, the backoff is randomized in its upper half
+	if backoff > 0 {
+		backoff = backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+	}
+	it.retryTime = time.Now().Add(backoff)
+	return true, nil
+}
+
+// VerifySource verifies the binlogs of the segment once the build fails on the worker, instead of retrying blindly.
+// The inconsistent segment is repaired by compaction, and the corrupted one is marked and alerted,
+// the task fails in both cases. The task is retried as usual if the binlogs are intact or failed to verify.
+func (it *indexBuildTask) VerifySource(ctx context.Context, dependency *taskScheduler) {
+	if !it.failedOnWorker {
+		return
+	}
+	it.failedOnWorker = false
+	segIndex, exist := dependency.meta.indexMeta.GetIndexJob(it.taskID)
+	if !exist {
+		return
+	}
+	segment := dependency.meta.GetSegment(segIndex.SegmentID)
+	if segment == nil {
+		return
+	}
+	log := log.Ctx(ctx).With(zap.Int64("taskID", it.taskID), zap.Int64("segmentID", segIndex.SegmentID))
+	fieldID := dependency.meta.indexMeta.GetFieldIDByIndexID(segIndex.CollectionID, segIndex.IndexID)
+	integrity, reason, err := verifySegmentBinlogs(ctx, dependency.chunkManager, segment, fieldID)
+	if err != nil {
+		log.Warn("failed to verify the binlogs of the segment", zap.Error(err))
+		return
+	}
+
+	switch integrity {
+	case segmentIntact:
+		log.Info("binlogs of the segment are intact, the build failure is not caused by the source")
+		return
+	case segmentInconsistent:
+		if dependency.repairSegment != nil {
+			err := dependency.repairSegment(ctx, segment)
+			if err == nil {
+				it.SetState(indexpb.JobState_JobStateFailed, fmt.Sprintf("segment is being repaired by compaction: %s", reason))
+				return
+			}
+			log.Warn("failed to schedule the repair compaction, mark the segment as corrupted", zap.Error(err))
+		}
+	}
+
+	if err := dependency.meta.UpdateSegmentsInfo(UpdateIsCorrupted(segment.GetID())); err != nil {
+		log.Warn("failed to mark the segment as corrupted", zap.Error(err))
+		return
+	}
+	log.Error("segment is corrupted, it requires the operator to recover the binlogs or drop the segment",
+		zap.Int64("collectionID", segment.GetCollectionID()), zap.String("reason", reason))
+	metrics.DataCoordCorruptedSegmentCounter.WithLabelValues(strconv.FormatInt(segment.GetCollectionID(), 10)).Inc()
+	it.SetState(indexpb.JobState_JobStateFailed, fmt.Sprintf("segment is corrupted: %s", reason))
+}
+
+func (it *indexBuildTask) IsRetryBackoff() bool {
+	return time.Now().Before(it.retryTime)
+}
+
 func (it *indexBuildTask) SetState(state indexpb.JobState, failReason string) {
 	it.taskInfo.State = commonpb.IndexState(state)
 	it.taskInfo.FailReason = failReason
@@ -76,12 +250,35 @@ func (it *indexBuildTask) GetFailReason() string {
 	return it.taskInfo.FailReason
 }
 
-func (it *indexBuildTask) UpdateVersion(ctx context.Context, meta *meta) error {
-	return meta.indexMeta.UpdateVersion(it.taskID)
+func (it *indexBuildTask) GetTaskType() indexpb.JobType {
+	return indexpb.JobType_JobTypeIndexJob
+}
+
+func (it *indexBuildTask) GetTaskSlot() int64 {
+	if it.slot == 0 {
+		return 1
+	}
+	return it.slot
+}
+
+func (it *indexBuildTask) GetTaskCost() taskCost {
+	return it.cost
+}
+
+func (it *indexBuildTask) UpdateVersion(ctx context.Context, nodeID int64, meta *meta) error {
+	if err := meta.indexMeta.UpdateVersion(it.taskID, nodeID); err != nil {
+		return err
+	}
+	it.nodeID = nodeID
+	if segIndex, ok := meta.indexMeta.GetIndexJob(it.taskID); ok {
+		it.req.IndexVersion = segIndex.IndexVersion
+	}
+	return nil
 }
 
 func (it *indexBuildTask) UpdateMetaBuildingState(nodeID int64, meta *meta) error {
 	it.nodeID = nodeID
+	meta.indexMeta.UpdateBuildTiming(it.taskID, it.timing.toProto())
 	return meta.indexMeta.BuildIndex(it.taskID, nodeID)
 }
 
@@ -101,12 +298,24 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 	}
 	indexParams := dependency.meta.indexMeta.GetIndexParams(segIndex.CollectionID, segIndex.IndexID)
 	indexType := GetIndexType(indexParams)
+	it.kind = buildKind(indexType, segIndex.NumRows)
 	if isFlatIndex(indexType) || segIndex.NumRows < Params.DataCoordCfg.MinSegmentNumRowsToEnableIndex.GetAsInt64() {
 		log.Ctx(ctx).Info("segment does not need index really", zap.Int64("taskID", it.taskID),
 			zap.Int64("segmentID", segIndex.SegmentID), zap.Int64("num rows", segIndex.NumRows))
 		it.SetState(indexpb.JobState_JobStateFinished, "fake finished index success")
 		return true
 	}
+	if Params.DataCoordCfg.ReuseIdenticalIndex.GetAsBool() && it.reuseIndexFiles(ctx, dependency, segIndex, segment) {
+		return true
+	}
+
+	profile, err := indexparams.GetIndexBuildProfile(indexParams)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to get index build profile", zap.Int64("taskID", it.taskID), zap.Error(err))
+		it.SetState(indexpb.JobState_JobStateInit, err.Error())
+		return true
+	}
+	it.slot = indexparams.GetBuildSlots(profile)
 
 	typeParams := dependency.meta.indexMeta.GetTypeParams(segIndex.CollectionID, segIndex.IndexID)
 
@@ -137,6 +346,12 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 
 	fieldID := dependency.meta.indexMeta.GetFieldIDByIndexID(segIndex.CollectionID, segIndex.IndexID)
 	binlogIDs := getBinLogIDs(segment, fieldID)
+	binlogPaths, err := getBinLogPaths(segment, fieldID)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to get binlog paths", zap.Int64("taskID", it.taskID), zap.Error(err))
+		it.SetState(indexpb.JobState_JobStateInit, err.Error())
+		return true
+	}
 	if isDiskANNIndex(GetIndexType(indexParams)) {
 		var err error
 		indexParams, err = indexparams.UpdateDiskIndexBuildParams(Params, indexParams)
@@ -170,6 +385,9 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 		// don't return, maybe field is scalar field or sparseFloatVector
 	}
 
+	it.cost = estimateIndexBuildCost(indexType, field.GetDataType(), int64(dim), segIndex.NumRows, getFieldBinlogSize(segment, fieldID))
+	it.cost.allowedCPUFeatures = segIndex.AllowedCPUFeatures
+
 	// vector index build needs information of optional scalar fields data
 	optionalFields := make([]*indexpb.OptionalFieldInfo, 0)
 	partitionKeyIsolation := false
@@ -184,11 +402,18 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 			log.Ctx(ctx).Warn("index builder get partition key field failed", zap.Int64("taskID", it.taskID), zap.Error(err))
 		} else {
 			if typeutil.IsFieldDataTypeSupportMaterializedView(partitionKeyField) {
+				partitionKeyPaths, err := getBinLogPaths(segment, partitionKeyField.FieldID)
+				if err != nil {
+					log.Ctx(ctx).Warn("failed to get binlog paths", zap.Int64("taskID", it.taskID), zap.Error(err))
+					it.SetState(indexpb.JobState_JobStateInit, err.Error())
+					return true
+				}
 				optionalFields = append(optionalFields, &indexpb.OptionalFieldInfo{
 					FieldID:   partitionKeyField.FieldID,
 					FieldName: partitionKeyField.Name,
 					FieldType: int32(partitionKeyField.DataType),
 					DataIds:   getBinLogIDs(segment, partitionKeyField.FieldID),
+					DataPaths: partitionKeyPaths,
 				})
 				iso, isoErr := common.IsPartitionKeyIsolationPropEnabled(collectionInfo.Properties)
 				if isoErr != nil {
@@ -219,7 +444,7 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 			ClusterID:             Params.CommonCfg.ClusterPrefix.GetValue(),
 			IndexFilePrefix:       path.Join(dependency.chunkManager.RootPath(), common.SegmentIndexPath),
 			BuildID:               it.taskID,
-			IndexVersion:          segIndex.IndexVersion + 1,
+			IndexVersion:          segIndex.IndexVersion,
 			StorageConfig:         storageConfig,
 			IndexParams:           indexParams,
 			TypeParams:            typeParams,
@@ -236,6 +461,7 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 			IndexStorePath:        indexStorePath,
 			Dim:                   int64(dim),
 			DataIds:               binlogIDs,
+			DataPaths:             binlogPaths,
 			OptionalScalarFields:  optionalFields,
 			Field:                 field,
 			PartitionKeyIsolation: partitionKeyIsolation,
@@ -245,7 +471,7 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 			ClusterID:             Params.CommonCfg.ClusterPrefix.GetValue(),
 			IndexFilePrefix:       path.Join(dependency.chunkManager.RootPath(), common.SegmentIndexPath),
 			BuildID:               it.taskID,
-			IndexVersion:          segIndex.IndexVersion + 1,
+			IndexVersion:          segIndex.IndexVersion,
 			StorageConfig:         storageConfig,
 			IndexParams:           indexParams,
 			TypeParams:            typeParams,
@@ -259,6 +485,7 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 			FieldType:             field.GetDataType(),
 			Dim:                   int64(dim),
 			DataIds:               binlogIDs,
+			DataPaths:             binlogPaths,
 			OptionalScalarFields:  optionalFields,
 			Field:                 field,
 			PartitionKeyIsolation: partitionKeyIsolation,
@@ -272,17 +499,7 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 func (it *indexBuildTask) AssignTask(ctx context.Context, client types.IndexNodeClient) bool {
 	ctx, cancel := context.WithTimeout(context.Background(), reqTimeoutInterval)
 	defer cancel()
-	resp, err := client.CreateJobV2(ctx, &indexpb.CreateJobV2Request{
-		ClusterID: it.req.GetClusterID(),
-		TaskID:    it.req.GetBuildID(),
-		JobType:   indexpb.JobType_JobTypeIndexJob,
-		Request: &indexpb.CreateJobV2Request_IndexRequest{
-			IndexRequest: it.req,
-		},
-	})
-	if err == nil {
-		err = merr.Error(resp)
-	}
+	err := compatutil.CreateIndexJob(ctx, client, it.req)
 	if err != nil {
 		log.Ctx(ctx).Warn("assign index task to indexNode failed", zap.Int64("taskID", it.taskID), zap.Error(err))
 		it.SetState(indexpb.JobState_JobStateRetry, err.Error())
@@ -298,51 +515,49 @@ func (it *indexBuildTask) setResult(info *indexpb.IndexTaskInfo) {
 	it.taskInfo = info
 }
 
-func (it *indexBuildTask) QueryResult(ctx context.Context, node types.IndexNodeClient) {
-	resp, err := node.QueryJobsV2(ctx, &indexpb.QueryJobsV2Request{
-		ClusterID: Params.CommonCfg.ClusterPrefix.GetValue(),
-		TaskIDs:   []UniqueID{it.GetTaskID()},
-		JobType:   indexpb.JobType_JobTypeIndexJob,
-	})
-	if err == nil {
-		err = merr.Error(resp.GetStatus())
-	}
+func (it *indexBuildTask) QueryResult(ctx context.Context, node types.IndexNodeClient) bool {
+	results, err := compatutil.QueryIndexJobs(ctx, node, Params.CommonCfg.ClusterPrefix.GetValue(), []UniqueID{it.GetTaskID()})
 	if err != nil {
 		log.Ctx(ctx).Warn("get jobs info from IndexNode failed", zap.Int64("taskID", it.GetTaskID()),
 			zap.Int64("nodeID", it.GetNodeID()), zap.Error(err))
 		it.SetState(indexpb.JobState_JobStateRetry, err.Error())
-		return
+		return false
 	}
 
 	// indexInfos length is always one.
-	for _, info := range resp.GetIndexJobResults().GetResults() {
+	for _, info := range results {
 		if info.GetBuildID() == it.GetTaskID() {
 			log.Ctx(ctx).Info("query task index info successfully",
 				zap.Int64("taskID", it.GetTaskID()), zap.String("result state", info.GetState().String()),
 				zap.String("failReason", info.GetFailReason()))
+			it.timing.setNodeStartTime(info.GetStartTime())
 			if info.GetState() == commonpb.IndexState_Finished || info.GetState() == commonpb.IndexState_Failed ||
 				info.GetState() == commonpb.IndexState_Retry {
 				// state is retry or finished or failed
+				it.timing.completeTime = time.Now()
 				it.setResult(info)
+				it.failedOnWorker = info.GetState() != commonpb.IndexState_Finished
 			} else if info.GetState() == commonpb.IndexState_IndexStateNone {
 				it.SetState(indexpb.JobState_JobStateRetry, "index state is none in info response")
+			} else {
+				it.progress = info.GetProgress()
 			}
 			// inProgress or unissued, keep InProgress state
-			return
+			return true
 		}
 	}
 	it.SetState(indexpb.JobState_JobStateRetry, "index is not in info response")
+	return true
 }
 
-func (it *indexBuildTask) DropTaskOnWorker(ctx context.Context, client types.IndexNodeClient) bool {
-	resp, err := client.DropJobsV2(ctx, &indexpb.DropJobsV2Request{
-		ClusterID: Params.CommonCfg.ClusterPrefix.GetValue(),
-		TaskIDs:   []UniqueID{it.GetTaskID()},
-		JobType:   indexpb.JobType_JobTypeIndexJob,
-	})
-	if err == nil {
-		err = merr.Error(resp)
+func (it *indexBuildTask) UpdateProgress(meta *meta) {
+	if it.GetState() == indexpb.JobState_JobStateInProgress {
+		meta.indexMeta.UpdateBuildProgress(it.taskID, it.progress)
 	}
+}
+
+func (it *indexBuildTask) DropTaskOnWorker(ctx context.Context, client types.IndexNodeClient) bool {
+	err := compatutil.DropIndexJobs(ctx, client, Params.CommonCfg.ClusterPrefix.GetValue(), []UniqueID{it.GetTaskID()})
 	if err != nil {
 		log.Ctx(ctx).Warn("notify worker drop the index task fail", zap.Int64("taskID", it.GetTaskID()),
 			zap.Int64("nodeID", it.GetNodeID()), zap.Error(err))
@@ -354,5 +569,9 @@ func (it *indexBuildTask) DropTaskOnWorker(ctx context.Context, client types.Ind
 }
 
 func (it *indexBuildTask) SetJobInfo(meta *meta) error {
+	meta.indexMeta.UpdateBuildTiming(it.taskID, it.timing.toProto())
+	if it.filesSource != nil {
+		return meta.indexMeta.LinkIndexFiles(it.taskID, it.filesSource, it.contentHash)
+	}
 	return meta.indexMeta.FinishTask(it.taskInfo)
 }
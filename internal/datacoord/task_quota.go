@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// taskUsage is the tenant and the database of a task in the scheduler and whether it's in progress.
+type taskUsage struct {
+	jobType  indexpb.JobType
+	tenant   string
+	database string
+	running  bool
+}
+
+// taskQuotaUsage tracks the queued and the in progress tasks of each tenant and each database in the scheduler,
+// it's updated as the tasks are enqueued, dispatched and removed, so that the tenant and the database quotas
+// are checked without resolving the tenants of all the tasks in every round of scheduling.
+type taskQuotaUsage struct {
+	mu     sync.RWMutex
+	tasks  map[UniqueID]*taskUsage
+	queued map[string]int
+	// running is the number of the in progress tasks of each tenant
+	running map[string]int
+	// databaseRunning is the number of the in progress tasks of each type of each database
+	databaseRunning map[string]map[indexpb.JobType]int
+	// unresolved is the tasks whose collections are not cached when they're enqueued,
+	// their tenants are resolved again in the following rounds of scheduling
+	unresolved typeutil.UniqueSet
+}
+
+func (u *taskQuotaUsage) init() {
+	if u.tasks == nil {
+		u.tasks = make(map[UniqueID]*taskUsage)
+		u.queued = make(map[string]int)
+		u.running = make(map[string]int)
+		u.databaseRunning = make(map[string]map[indexpb.JobType]int)
+		u.unresolved = typeutil.NewUniqueSet()
+	}
+}
+
+// add counts the task into the queued tasks of the tenant, the task added already is ignored.
+func (u *taskQuotaUsage) add(task Task, tenant string, database string, resolved bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.init()
+	taskID := task.GetTaskID()
+	if _, ok := u.tasks[taskID]; ok {
+		return
+	}
+	u.tasks[taskID] = &taskUsage{jobType: task.GetTaskType(), tenant: tenant, database: database}
+	u.queued[tenant]++
+	u.setRunningLocked(taskID, task.GetState() == indexpb.JobState_JobStateInProgress)
+	if !resolved {
+		u.unresolved.Insert(taskID)
+	}
+}
+
+// remove uncounts the task removed from the scheduler.
+func (u *taskQuotaUsage) remove(taskID UniqueID) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	usage, ok := u.tasks[taskID]
+	if !ok {
+		return
+	}
+	u.setRunningLocked(taskID, false)
+	u.queued[usage.tenant]--
+	if u.queued[usage.tenant] <= 0 {
+		delete(u.queued, usage.tenant)
+	}
+	delete(u.tasks, taskID)
+	u.unresolved.Remove(taskID)
+}
+
+// resolve moves the task to the tenant resolved after it's enqueued.
+func (u *taskQuotaUsage) resolve(taskID UniqueID, tenant string, database string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	usage, ok := u.tasks[taskID]
+	if !ok || !u.unresolved.Contain(taskID) {
+		return
+	}
+	running := usage.running
+	u.setRunningLocked(taskID, false)
+	u.queued[usage.tenant]--
+	if u.queued[usage.tenant] <= 0 {
+		delete(u.queued, usage.tenant)
+	}
+	usage.tenant, usage.database = tenant, database
+	u.queued[tenant]++
+	u.setRunningLocked(taskID, running)
+	u.unresolved.Remove(taskID)
+}
+
+// setRunning updates whether the task is in progress.
+func (u *taskQuotaUsage) setRunning(taskID UniqueID, running bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.setRunningLocked(taskID, running)
+}
+
+func (u *taskQuotaUsage) setRunningLocked(taskID UniqueID, running bool) {
+	usage, ok := u.tasks[taskID]
+	if !ok || usage.running == running {
+		return
+	}
+	usage.running = running
+	if running {
+		u.running[usage.tenant]++
+		if u.databaseRunning[usage.database] == nil {
+			u.databaseRunning[usage.database] = make(map[indexpb.JobType]int)
+		}
+		u.databaseRunning[usage.database][usage.jobType]++
+		return
+	}
+	u.running[usage.tenant]--
+	if u.running[usage.tenant] <= 0 {
+		delete(u.running, usage.tenant)
+	}
+	u.databaseRunning[usage.database][usage.jobType]--
+	if u.databaseRunning[usage.database][usage.jobType] <= 0 {
+		delete(u.databaseRunning[usage.database], usage.jobType)
+		if len(u.databaseRunning[usage.database]) == 0 {
+			delete(u.databaseRunning, usage.database)
+		}
+	}
+}
+
+// listUnresolved returns the tasks whose tenants are not resolved yet.
+func (u *taskQuotaUsage) listUnresolved() []UniqueID {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.unresolved.Collect()
+}
+
+// getTenant returns the tenant of the task.
+func (u *taskQuotaUsage) getTenant(taskID UniqueID) string {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	if usage, ok := u.tasks[taskID]; ok {
+		return usage.tenant
+	}
+	return ""
+}
+
+// getDatabase returns the database of the task.
+func (u *taskQuotaUsage) getDatabase(taskID UniqueID) string {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	if usage, ok := u.tasks[taskID]; ok {
+		return usage.database
+	}
+	return ""
+}
+
+// countQueued returns the number of the tasks of the tenant in the scheduler.
+func (u *taskQuotaUsage) countQueued(tenant string) int {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.queued[tenant]
+}
+
+// countRunning returns the number of the in progress tasks of the tenant.
+func (u *taskQuotaUsage) countRunning(tenant string) int {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.running[tenant]
+}
+
+// countDatabaseRunning returns the number of the in progress tasks of the type of the database.
+func (u *taskQuotaUsage) countDatabaseRunning(database string, jobType indexpb.JobType) int {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.databaseRunning[database][jobType]
+}
+
+// listDatabaseRunning returns the number of the in progress tasks of each type of each database.
+func (u *taskQuotaUsage) listDatabaseRunning() map[string]map[indexpb.JobType]int {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	running := make(map[string]map[indexpb.JobType]int, len(u.databaseRunning))
+	for database, counts := range u.databaseRunning {
+		running[database] = make(map[indexpb.JobType]int, len(counts))
+		for jobType, count := range counts {
+			running[database][jobType] = count
+		}
+	}
+	return running
+}
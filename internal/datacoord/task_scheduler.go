@@ -18,21 +18,36 @@ package datacoord
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/samber/lo"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"golang.org/x/exp/constraints"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/config"
+	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 const (
 	reqTimeoutInterval = time.Second * 10
 )
 
+// drainCheckInterval is the interval to check whether the in progress tasks are drained.
+var drainCheckInterval = time.Second
+
 type taskScheduler struct {
 	sync.RWMutex
 
@@ -40,11 +55,17 @@ type taskScheduler struct {
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 
-	scheduleDuration time.Duration
+	// scheduleDuration is accessed by the schedule loop only,
+	// the reloaded one is sent through the scheduleDurationChan
+	scheduleDuration     time.Duration
+	scheduleDurationChan chan time.Duration
+	configHandler        config.EventHandler
 
 	// TODO @xiaocai2333: use priority queue
 	tasks      map[int64]Task
 	notifyChan chan struct{}
+	// runLock serializes processing the tasks and describing them
+	runLock sync.Mutex
 
 	meta *meta
 
@@ -53,6 +74,39 @@ type taskScheduler struct {
 	chunkManager              storage.ChunkManager
 	indexEngineVersionManager IndexEngineVersionManager
 	handler                   Handler
+	// repairSegment schedules the compaction to repair the inconsistent segment found by the source verification,
+	// the segment is marked as corrupted if it's nil
+	repairSegment func(ctx context.Context, segment *SegmentInfo) error
+
+	// backoff of dispatching tasks while all the workers are saturated,
+	// accessed by the schedule loop only
+	dispatchBackoff  time.Duration
+	nextDispatchTime time.Time
+
+	// eventHandlers are notified of the lifecycle transitions of the tasks
+	eventHandlers taskEventHandlers
+
+	// draining stops accepting and assigning tasks, the in progress ones are kept being processed
+	draining atomic.Bool
+
+	// buildLatencies is the recent latencies of the finished index builds to find the straggling ones
+	buildLatencies buildLatencies
+
+	// databaseQuotas is the background task quotas of the databases, nothing is limited if it's nil
+	databaseQuotas *databaseQuotas
+
+	// quotaUsage counts the queued and the in progress tasks of each tenant and each database
+	quotaUsage taskQuotaUsage
+}
+
+// dispatchSlots is the free slots of the workers in a round of scheduling,
+// the workers are queried on the first dispatch of the round.
+type dispatchSlots struct {
+	queried bool
+	workers map[UniqueID]*WorkerSlots
+
+	// speculationQuota is the number of the speculative duplicates of the straggling builds allowed in this round
+	speculationQuota int
 }
 
 func newTaskScheduler(
@@ -71,27 +125,106 @@ func newTaskScheduler(
 		tasks:                     make(map[int64]Task),
 		notifyChan:                make(chan struct{}, 1),
 		scheduleDuration:          Params.DataCoordCfg.IndexTaskSchedulerInterval.GetAsDuration(time.Millisecond),
+		scheduleDurationChan:      make(chan time.Duration, 1),
 		policy:                    defaultBuildIndexPolicy,
 		nodeManager:               nodeManager,
 		chunkManager:              chunkManager,
 		handler:                   handler,
 		indexEngineVersionManager: indexEngineVersionManager,
 	}
+	ts.configHandler = config.NewHandler("datacoord.taskScheduler.interval", ts.scheduleDurationHandler)
 	ts.reloadFromKV()
 	return ts
 }
 
 func (s *taskScheduler) Start() {
+	Params.Watch(Params.DataCoordCfg.IndexTaskSchedulerInterval.Key, s.configHandler)
 	s.wg.Add(1)
 	go s.schedule()
 }
 
 func (s *taskScheduler) Stop() {
+	Params.Unwatch(Params.DataCoordCfg.IndexTaskSchedulerInterval.Key, s.configHandler)
 	s.cancel()
 	s.wg.Wait()
 }
 
+// Drain stops accepting new tasks and assigning the queued ones, then waits up to the timeout for the in progress tasks
+// to finish and their results to be persisted before stopping the scheduler. The tasks not drained are resumed
+// from the meta by the next DataCoord.
+func (s *taskScheduler) Drain(timeout time.Duration) {
+	log := log.Ctx(s.ctx).With(zap.Duration("timeout", timeout))
+	s.draining.Store(true)
+	s.notify()
+	log.Info("start to drain the task scheduler")
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(s.ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	for left := s.countDrainingTasks(); left > 0; left = s.countDrainingTasks() {
+		select {
+		case <-ctx.Done():
+			log.Warn("drain the task scheduler timeout, the left tasks are resumed by the next DataCoord",
+				zap.Int("left", left))
+			s.Stop()
+			return
+		case <-ticker.C:
+			s.notify()
+		}
+	}
+	log.Info("task scheduler drained", zap.Duration("elapsed", time.Since(start)))
+	s.Stop()
+}
+
+// countDrainingTasks returns the number of the tasks to wait for while draining,
+// which are running on the workers or have results not persisted yet.
+func (s *taskScheduler) countDrainingTasks() int {
+	s.RLock()
+	defer s.RUnlock()
+
+	count := 0
+	for _, task := range s.tasks {
+		switch task.GetState() {
+		case indexpb.JobState_JobStateInProgress, indexpb.JobState_JobStateRetry,
+			indexpb.JobState_JobStateFinished, indexpb.JobState_JobStateFailed:
+			count++
+		}
+	}
+	return count
+}
+
+// scheduleDurationHandler applies the reloaded schedule interval to the schedule loop,
+// the round of scheduling in progress is not interrupted.
+func (s *taskScheduler) scheduleDurationHandler(evt *config.Event) {
+	if !evt.HasUpdated {
+		return
+	}
+	log := log.Ctx(s.ctx).With(zap.String("key", evt.Key), zap.String("value", evt.Value))
+	interval, err := strconv.ParseInt(evt.Value, 10, 64)
+	if err != nil || interval <= 0 {
+		log.Warn("invalid index task scheduler interval, keep the current one", zap.Error(err))
+		return
+	}
+	duration := time.Duration(interval) * time.Millisecond
+	for {
+		select {
+		case s.scheduleDurationChan <- duration:
+			log.Info("index task scheduler interval updated", zap.Duration("interval", duration))
+			return
+		default:
+			// drop the pending one not applied yet
+			select {
+			case <-s.scheduleDurationChan:
+			default:
+			}
+		}
+	}
+}
+
 func (s *taskScheduler) reloadFromKV() {
+	now := time.Now()
 	segments := s.meta.GetAllSegmentsUnsafe()
 	for _, segment := range segments {
 		for _, segIndex := range s.meta.indexMeta.getSegmentIndexes(segment.ID) {
@@ -99,14 +232,19 @@ func (s *taskScheduler) reloadFromKV() {
 				continue
 			}
 			if segIndex.IndexState != commonpb.IndexState_Finished && segIndex.IndexState != commonpb.IndexState_Failed {
+				state := segIndex.IndexState
+				if segIndex.Paused {
+					state = commonpb.IndexState(indexpb.JobState_JobStatePaused)
+				}
 				s.tasks[segIndex.BuildID] = &indexBuildTask{
 					taskID: segIndex.BuildID,
 					nodeID: segIndex.NodeID,
 					taskInfo: &indexpb.IndexTaskInfo{
 						BuildID:    segIndex.BuildID,
-						State:      segIndex.IndexState,
+						State:      state,
 						FailReason: segIndex.FailReason,
 					},
+					timing: taskTiming{enqueueTime: now},
 				}
 			}
 		}
@@ -123,9 +261,30 @@ func (s *taskScheduler) reloadFromKV() {
 					State:      t.State,
 					FailReason: t.FailReason,
 				},
+				timing: taskTiming{enqueueTime: now},
 			}
 		}
 	}
+	allStatsTasks := s.meta.statsMeta.GetAllTasks()
+	for taskID, t := range allStatsTasks {
+		if t.State != indexpb.JobState_JobStateFinished && t.State != indexpb.JobState_JobStateFailed {
+			s.tasks[taskID] = &statsTask{
+				taskID:    taskID,
+				segmentID: t.SegmentID,
+				nodeID:    t.NodeID,
+				taskInfo: &indexpb.StatsResult{
+					TaskID:     taskID,
+					State:      t.State,
+					FailReason: t.FailReason,
+				},
+				timing: taskTiming{enqueueTime: now},
+			}
+		}
+	}
+	for _, task := range s.tasks {
+		tenant, dbName, ok := s.getTenant(task.GetCollectionID(s.meta))
+		s.quotaUsage.add(task, tenant, dbName, ok)
+	}
 }
 
 // notify is an unblocked notify function
@@ -139,13 +298,26 @@ func (s *taskScheduler) notify() {
 func (s *taskScheduler) enqueue(task Task) {
 	defer s.notify()
 
-	s.Lock()
-	defer s.Unlock()
 	taskID := task.GetTaskID()
-	if _, ok := s.tasks[taskID]; !ok {
+	if s.draining.Load() {
+		// the task is persisted in the meta before enqueued, it's reloaded by the next DataCoord
+		log.Info("task scheduler is draining, skip enqueuing the task", zap.Int64("taskID", taskID))
+		return
+	}
+	// the tenant may be described from the rootcoord, resolve it before locking the scheduler
+	tenant, dbName, resolved := s.getTenant(task.GetCollectionID(s.meta))
+	s.Lock()
+	_, exist := s.tasks[taskID]
+	if !exist {
+		task.SetQueueTime(time.Now())
 		s.tasks[taskID] = task
+		s.quotaUsage.add(task, tenant, dbName, resolved)
 	}
+	s.Unlock()
 	log.Info("taskScheduler enqueue task", zap.Int64("taskID", taskID))
+	if !exist {
+		s.eventHandlers.fire(TaskEventEnqueued, task)
+	}
 }
 
 func (s *taskScheduler) schedule() {
@@ -167,6 +339,9 @@ func (s *taskScheduler) schedule() {
 			// !ok means indexBuild is closed.
 		case <-ticker.C:
 			s.run()
+		case duration := <-s.scheduleDurationChan:
+			s.scheduleDuration = duration
+			ticker.Reset(duration)
 		}
 	}
 }
@@ -179,21 +354,41 @@ func (s *taskScheduler) getTask(taskID UniqueID) Task {
 }
 
 func (s *taskScheduler) run() {
+	s.runLock.Lock()
+	defer s.runLock.Unlock()
+
 	// schedule policy
 	s.RLock()
-	taskIDs := make([]UniqueID, 0, len(s.tasks))
-	for tID := range s.tasks {
-		taskIDs = append(taskIDs, tID)
-	}
+	tasks := lo.Values(s.tasks)
 	s.RUnlock()
+
+	s.resolveTenants()
+	slots := &dispatchSlots{speculationQuota: speculationQuota(tasks)}
+	queues := make(map[indexpb.JobType][]UniqueID)
+	for _, task := range tasks {
+		queues[task.GetTaskType()] = append(queues[task.GetTaskType()], task.GetTaskID())
+	}
+	for jobType, queue := range queues {
+		s.policy(queue)
+		// interleave the tasks of the tenants, so that a tenant with massive tasks doesn't starve the others
+		tenantQueues := make(map[string][]UniqueID)
+		for _, taskID := range queue {
+			tenant := s.quotaUsage.getTenant(taskID)
+			tenantQueues[tenant] = append(tenantQueues[tenant], taskID)
+		}
+		queues[jobType] = fairShareTasks(tenantQueues, nil)
+	}
+	taskIDs := fairShareTasks(queues, map[indexpb.JobType]int{
+		indexpb.JobType_JobTypeIndexJob:   Params.DataCoordCfg.IndexJobWeight.GetAsInt(),
+		indexpb.JobType_JobTypeAnalyzeJob: Params.DataCoordCfg.AnalyzeJobWeight.GetAsInt(),
+		indexpb.JobType_JobTypeStatsJob:   Params.DataCoordCfg.StatsJobWeight.GetAsInt(),
+	})
 	if len(taskIDs) > 0 {
 		log.Ctx(s.ctx).Info("task scheduler", zap.Int("task num", len(taskIDs)))
 	}
 
-	s.policy(taskIDs)
-
 	for _, taskID := range taskIDs {
-		ok := s.process(taskID)
+		ok := s.process(taskID, slots)
 		if !ok {
 			log.Ctx(s.ctx).Info("there is no idle indexing node, wait a minute...")
 			break
@@ -201,51 +396,439 @@ func (s *taskScheduler) run() {
 	}
 }
 
+// fairShareTasks interleaves the queues of the tasks by the weights with the smooth weighted round-robin,
+// so that the tasks of each queue take the free slots of the workers in proportion to the weights
+// when all the queues are backed up. The weight less than 1 is taken as 1.
+func fairShareTasks[K constraints.Ordered](queues map[K][]UniqueID, weights map[K]int) []UniqueID {
+	keys := make([]K, 0, len(queues))
+	total := 0
+	for key, queue := range queues {
+		keys = append(keys, key)
+		total += len(queue)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	result := make([]UniqueID, 0, total)
+	current := make(map[K]int, len(keys))
+	for len(result) < total {
+		var picked K
+		pickedWeight, sumWeight := 0, 0
+		for _, key := range keys {
+			if len(queues[key]) == 0 {
+				continue
+			}
+			weight := max(weights[key], 1)
+			sumWeight += weight
+			current[key] += weight
+			if pickedWeight == 0 || current[key] > current[picked] {
+				picked, pickedWeight = key, weight
+			}
+		}
+		current[picked] -= sumWeight
+		result = append(result, queues[picked][0])
+		queues[picked] = queues[picked][1:]
+	}
+	return result
+}
+
+// getTenant returns the tenant and the database of the collection, the tenant is the TenantID of the database,
+// or the database itself if it has no TenantID. ok is false if the collection is not cached.
+func (s *taskScheduler) getTenant(collectionID UniqueID) (tenant string, dbName string, ok bool) {
+	coll := s.meta.GetCollection(collectionID)
+	if coll == nil {
+		return "", "", false
+	}
+	if tenant = s.databaseQuotas.getTenant(coll.DatabaseName); tenant == "" {
+		tenant = coll.DatabaseName
+	}
+	return tenant, coll.DatabaseName, true
+}
+
+// resolveTenants resolves the tenants of the tasks whose collections were not cached when they were enqueued.
+func (s *taskScheduler) resolveTenants() {
+	for _, taskID := range s.quotaUsage.listUnresolved() {
+		task := s.getTask(taskID)
+		if task == nil {
+			continue
+		}
+		if tenant, dbName, ok := s.getTenant(task.GetCollectionID(s.meta)); ok {
+			s.quotaUsage.resolve(taskID, tenant, dbName)
+		}
+	}
+}
+
+// countDatabaseTasks returns the number of the in progress tasks of each type of each database.
+func (s *taskScheduler) countDatabaseTasks() map[string]map[indexpb.JobType]int {
+	return s.quotaUsage.listDatabaseRunning()
+}
+
+// countTenantTasks returns the number of the tasks of the tenant in the scheduler,
+// the finished tasks are uncounted once they're removed in the next round of scheduling.
+func (s *taskScheduler) countTenantTasks(tenant string) int {
+	return s.quotaUsage.countQueued(tenant)
+}
+
+// listTasks describes the tasks of the collection in the states, all the tasks if not specified,
+// the tasks are described between the rounds of scheduling.
+func (s *taskScheduler) listTasks(collectionID UniqueID, states []indexpb.JobState) []*indexpb.ScheduledTask {
+	s.runLock.Lock()
+	defer s.runLock.Unlock()
+
+	s.RLock()
+	tasks := lo.Values(s.tasks)
+	s.RUnlock()
+
+	stateSet := typeutil.NewSet(states...)
+	result := make([]*indexpb.ScheduledTask, 0, len(tasks))
+	for _, task := range tasks {
+		if stateSet.Len() > 0 && !stateSet.Contain(task.GetState()) {
+			continue
+		}
+		info := task.Describe(s.meta)
+		if collectionID != 0 && info.GetCollectionID() != collectionID {
+			continue
+		}
+		result = append(result, info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetTaskID() < result[j].GetTaskID()
+	})
+	return result
+}
+
 func (s *taskScheduler) removeTask(taskID UniqueID) {
 	s.Lock()
 	defer s.Unlock()
 	delete(s.tasks, taskID)
+	s.quotaUsage.remove(taskID)
 }
 
-func (s *taskScheduler) process(taskID UniqueID) bool {
+// cancelAnalyzeTask drops the unfinished analyze task on its worker, removes it from the scheduler
+// and marks it as cancelled in meta, the task is left untouched if it failed to drop on the worker.
+func (s *taskScheduler) cancelAnalyzeTask(ctx context.Context, taskID UniqueID) error {
+	s.runLock.Lock()
+	defer s.runLock.Unlock()
+
+	t := s.meta.analyzeMeta.GetTask(taskID)
+	if t == nil {
+		return merr.WrapErrAnalyzeTaskNotFound(taskID)
+	}
+	if t.GetState() == indexpb.JobState_JobStateFinished || t.GetState() == indexpb.JobState_JobStateFailed {
+		return merr.WrapErrParameterInvalidMsg("analyze task %d is already %s", taskID, t.GetState().String())
+	}
+
 	task := s.getTask(taskID)
+	if task != nil && task.GetNodeID() != 0 {
+		client, exist := s.nodeManager.GetClientByID(task.GetNodeID())
+		if exist && !task.DropTaskOnWorker(ctx, client) {
+			return merr.WrapErrServiceInternal(fmt.Sprintf("failed to drop analyze task %d on node %d", taskID, task.GetNodeID()))
+		}
+	}
+	if err := s.meta.analyzeMeta.CancelTask(taskID); err != nil {
+		return err
+	}
+	s.removeTask(taskID)
+	log.Ctx(ctx).Info("analyze task is cancelled", zap.Int64("taskID", taskID), zap.Int64("nodeID", t.GetNodeID()))
+	return nil
+}
+
+// forceCompleteTask completes the task wedged in progress with the finished result on its worker,
+// or the finished one blocked by the worker failing to drop it. The task is detached from the worker
+// and completed by the next round of scheduling. The task without the finished result is rejected,
+// it should be forced to fail instead.
+func (s *taskScheduler) forceCompleteTask(ctx context.Context, taskID UniqueID, reason string) error {
+	s.runLock.Lock()
+	defer s.runLock.Unlock()
+
+	task, err := s.getForcedTask(taskID, reason, indexpb.JobState_JobStateInProgress, indexpb.JobState_JobStateFinished)
+	if err != nil {
+		return err
+	}
+	nodeID := task.GetNodeID()
+	if task.GetState() == indexpb.JobState_JobStateInProgress {
+		client, exist := s.nodeManager.GetClientByID(nodeID)
+		if !exist {
+			return merr.WrapErrParameterInvalidMsg("worker %d of task %d is not found, force fail it instead", nodeID, taskID)
+		}
+		task.QueryResult(ctx, client)
+		if task.GetState() != indexpb.JobState_JobStateFinished {
+			return merr.WrapErrParameterInvalidMsg("task %d has no finished result on worker %d, force fail it instead", taskID, nodeID)
+		}
+	}
+	s.detachTask(ctx, task)
+	s.eventHandlers.fireWithReason(TaskEventForceCompleted, task, reason)
+	auditForcedTask(ctx, TaskEventForceCompleted, task, nodeID, reason)
+	return nil
+}
+
+// forceFailTask fails the unfinished task wedged in the scheduler, or the failed one blocked by the worker
+// failing to drop it. The task is detached from the worker and marked as failed without retry,
+// the failure is recorded in meta by the next round of scheduling.
+func (s *taskScheduler) forceFailTask(ctx context.Context, taskID UniqueID, reason string) error {
+	s.runLock.Lock()
+	defer s.runLock.Unlock()
+
+	task, err := s.getForcedTask(taskID, reason, indexpb.JobState_JobStateInit, indexpb.JobState_JobStateInProgress,
+		indexpb.JobState_JobStateRetry, indexpb.JobState_JobStatePaused, indexpb.JobState_JobStateFailed)
+	if err != nil {
+		return err
+	}
+	nodeID := task.GetNodeID()
+	s.detachTask(ctx, task)
+	if task.GetState() != indexpb.JobState_JobStateFailed {
+		task.SetState(indexpb.JobState_JobStateFailed, fmt.Sprintf("force failed: %s", reason))
+	}
+	s.eventHandlers.fireWithReason(TaskEventForceFailed, task, reason)
+	auditForcedTask(ctx, TaskEventForceFailed, task, nodeID, reason)
+	return nil
+}
+
+// getForcedTask returns the task to be forced, the reason is required and the task must be in one of the states.
+func (s *taskScheduler) getForcedTask(taskID UniqueID, reason string, states ...indexpb.JobState) (Task, error) {
+	if reason == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("the reason of forcing task %d is required", taskID)
+	}
+	task := s.getTask(taskID)
+	if task == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("task %d is not found in the scheduler", taskID)
+	}
+	if !lo.Contains(states, task.GetState()) {
+		return nil, merr.WrapErrParameterInvalidMsg("task %d in state %s can't be forced", taskID, task.GetState().String())
+	}
+	return task, nil
+}
+
+// detachTask drops the task on its worker and detaches it from the worker, the task failed to drop
+// is left to the worker, so that the scheduler is not blocked by it.
+func (s *taskScheduler) detachTask(ctx context.Context, task Task) {
+	if task.GetNodeID() == 0 {
+		return
+	}
+	client, exist := s.nodeManager.GetClientByID(task.GetNodeID())
+	if exist && !task.DropTaskOnWorker(ctx, client) {
+		log.Ctx(ctx).Warn("failed to drop the forced task on worker, detach it anyway",
+			zap.Int64("taskID", task.GetTaskID()), zap.Int64("nodeID", task.GetNodeID()))
+	}
+	task.ResetNodeID()
+}
+
+// auditForcedTask records the forced transition of the task by the operator in the audit log and the event log.
+func auditForcedTask(ctx context.Context, eventType TaskEventType, task Task, nodeID UniqueID, reason string) {
+	log.Ctx(ctx).Info("audit: task is forced by the operator", zap.Stringer("event", eventType),
+		zap.Int64("taskID", task.GetTaskID()), zap.String("taskType", task.GetTaskType().String()),
+		zap.Int64("nodeID", nodeID), zap.String("reason", reason))
+	eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Warn,
+		fmt.Sprintf("%s task %d on node %d is %s by the operator, reason: %s",
+			task.GetTaskType().String(), task.GetTaskID(), nodeID, eventType.String(), reason)))
+}
+
+// pickWorker picks a worker with free slots to dispatch a task, and takes the slots and the resources of the task from it,
+// the task taking more slots than the free ones of the worker takes all of them.
+// The task with the estimated cost is bin-packed onto the worker with the least resources left after taking it,
+// among the ones having enough resources. The disk builds like DiskANN and inverted indexes are dispatched
+// only to the workers with enough free disk and headroom reported by GetJobStats, which fail the builds otherwise.
+// The task exceeding the capacity of all the workers is dispatched regardless of the cost of that resource,
+// otherwise it waits for the resources. The rebuild restricted to the CPU features waits for the worker
+// within the features. The task without cost is dispatched to the worker with the most free slots.
+// If none of the workers has a free slot, the dispatch is backed off exponentially,
+// the tasks are not dispatched and the workers are not queried until the backoff expires.
+func (s *taskScheduler) pickWorker(slots *dispatchSlots, taskSlot int64, cost taskCost) (UniqueID, types.IndexNodeClient) {
+	return s.pickWorkerExcept(slots, 0, taskSlot, cost)
+}
+
+// pickWorkerExcept picks a worker other than the excluded one as pickWorker does.
+func (s *taskScheduler) pickWorkerExcept(slots *dispatchSlots, excluded UniqueID, taskSlot int64, cost taskCost) (UniqueID, types.IndexNodeClient) {
+	if !slots.queried {
+		slots.queried = true
+		if time.Now().Before(s.nextDispatchTime) {
+			return 0, nil
+		}
+		slots.workers = make(map[UniqueID]*WorkerSlots)
+		var total int64
+		for nodeID, worker := range s.nodeManager.QuerySlots() {
+			if worker.Slots > 0 {
+				copied := *worker
+				slots.workers[nodeID] = &copied
+				total += worker.Slots
+			}
+		}
+		if total == 0 {
+			s.backoffDispatch()
+			return 0, nil
+		}
+		s.dispatchBackoff = 0
+		s.nextDispatchTime = time.Time{}
+	}
+
+	// the task exceeding the capacity of all the workers never fits, the memory and the disk are checked apart
+	diskRequired := requiredDisk(cost.disk)
+	fitAnyMemory := lo.SomeBy(lo.Values(slots.workers), func(worker *WorkerSlots) bool {
+		return worker.Slots > 0 && fitResource(cost.memory, worker.MemoryCapacity, worker.MemoryCapacity)
+	})
+	fitAnyDisk := lo.SomeBy(lo.Values(slots.workers), func(worker *WorkerSlots) bool {
+		return worker.Slots > 0 && fitResource(diskRequired, worker.DiskCapacity, worker.DiskCapacity)
+	})
+	fits := func(worker *WorkerSlots) bool {
+		return (!fitAnyMemory || fitResource(cost.memory, worker.AvailableMemory, worker.MemoryCapacity)) &&
+			(!fitAnyDisk || fitResource(diskRequired, worker.AvailableDisk, worker.DiskCapacity)) &&
+			fitCPUFeatures(cost.allowedCPUFeatures, worker.CPUFeatures)
+	}
+	binPacking := cost.memory > 0 && fitAnyMemory
+
+	var picked *WorkerSlots
+	for _, worker := range slots.workers {
+		if worker.NodeID == excluded || worker.Slots <= 0 || !fits(worker) {
+			continue
+		}
+		if picked == nil || betterWorker(worker, picked, binPacking) {
+			picked = worker
+		}
+	}
+	if picked == nil {
+		return 0, nil
+	}
+	picked.Slots -= min(taskSlot, picked.Slots)
+	picked.AvailableMemory -= min(cost.memory, picked.AvailableMemory)
+	picked.AvailableDisk -= min(cost.disk, picked.AvailableDisk)
+	return picked.NodeID, picked.Client
+}
+
+// betterWorker compares the workers to dispatch a task, the worker with less memory left is better for bin-packing,
+// then the one with more free slots, then the one with smaller nodeID.
+func betterWorker(worker, picked *WorkerSlots, binPacking bool) bool {
+	if binPacking && worker.AvailableMemory != picked.AvailableMemory {
+		return worker.AvailableMemory < picked.AvailableMemory
+	}
+	if worker.Slots != picked.Slots {
+		return worker.Slots > picked.Slots
+	}
+	return worker.NodeID < picked.NodeID
+}
+
+// reachTenantQuota checks whether the tenant of the task has run out of its concurrent tasks quota.
+func (s *taskScheduler) reachTenantQuota(taskID UniqueID) bool {
+	maxRunning := Params.DataCoordCfg.TenantMaxConcurrentTasks.GetAsInt()
+	return maxRunning > 0 && s.quotaUsage.countRunning(s.quotaUsage.getTenant(taskID)) >= maxRunning
+}
+
+// reachDatabaseQuota checks whether the database of the task has run out of its quota of the tasks of the type.
+func (s *taskScheduler) reachDatabaseQuota(taskID UniqueID, jobType indexpb.JobType) bool {
+	if s.databaseQuotas == nil {
+		return false
+	}
+	dbName := s.quotaUsage.getDatabase(taskID)
+	quota := s.databaseQuotas.get(dbName)
+	limit := 0
+	switch jobType {
+	case indexpb.JobType_JobTypeIndexJob:
+		limit = quota.indexTasks
+	case indexpb.JobType_JobTypeAnalyzeJob:
+		limit = quota.analyzeTasks
+	}
+	return limit > 0 && s.quotaUsage.countDatabaseRunning(dbName, jobType) >= limit
+}
+
+// hasFreeSlots checks whether any worker has free slots in this round of dispatch.
+func (slots *dispatchSlots) hasFreeSlots() bool {
+	return lo.SomeBy(lo.Values(slots.workers), func(worker *WorkerSlots) bool {
+		return worker.Slots > 0
+	})
+}
+
+func (s *taskScheduler) backoffDispatch() {
+	maxBackoff := Params.DataCoordCfg.IndexTaskSchedulerMaxBackoff.GetAsDuration(time.Millisecond)
+	s.dispatchBackoff *= 2
+	if s.dispatchBackoff < s.scheduleDuration {
+		s.dispatchBackoff = s.scheduleDuration
+	}
+	if s.dispatchBackoff > maxBackoff {
+		s.dispatchBackoff = maxBackoff
+	}
+	s.nextDispatchTime = time.Now().Add(s.dispatchBackoff)
+	log.Ctx(s.ctx).Info("all the indexing nodes are saturated, back off dispatching tasks",
+		zap.Duration("backoff", s.dispatchBackoff))
+}
+
+func (s *taskScheduler) process(taskID UniqueID, slots *dispatchSlots) bool {
+	task := s.getTask(taskID)
+	defer func() {
+		s.quotaUsage.setRunning(taskID, task.GetState() == indexpb.JobState_JobStateInProgress)
+	}()
 
 	if !task.CheckTaskHealthy(s.meta) {
 		s.removeTask(taskID)
 		return true
 	}
 	state := task.GetState()
+	// the unfinished task is paused, drop it on the worker if it's assigned
+	if (state == indexpb.JobState_JobStateInit || state == indexpb.JobState_JobStateInProgress ||
+		state == indexpb.JobState_JobStateRetry) && task.IsPaused(s.meta) {
+		task.SetState(indexpb.JobState_JobStatePaused, "")
+		state = indexpb.JobState_JobStatePaused
+	}
 	log.Ctx(s.ctx).Info("task is processing", zap.Int64("taskID", taskID),
 		zap.String("state", state.String()))
+	if state != indexpb.JobState_JobStateInProgress {
+		s.dropSpeculation(task)
+	}
 
 	switch state {
 	case indexpb.JobState_JobStateNone:
 		s.removeTask(taskID)
 
 	case indexpb.JobState_JobStateInit:
+		if task.IsRetryBackoff() || s.draining.Load() {
+			return true
+		}
+		// the assignment checkpointed but not confirmed before failover is resumed on the same worker
+		// with the same epoch, the worker accepts it without building the task twice
+		nodeID, client := s.resumeAssignment(task)
+
 		// 0. pre check task
 		skip := task.PreCheck(s.ctx, s)
 		if skip {
 			return true
 		}
 
-		// 1. pick an indexNode client
-		nodeID, client := s.nodeManager.PickClient()
 		if client == nil {
-			log.Ctx(s.ctx).Debug("pick client failed")
-			return false
-		}
-		log.Ctx(s.ctx).Info("pick client success", zap.Int64("taskID", taskID), zap.Int64("nodeID", nodeID))
+			if s.reachTenantQuota(taskID) {
+				log.Ctx(s.ctx).Info("tenant of the task reaches the concurrent tasks quota, wait for the next round",
+					zap.Int64("taskID", taskID), zap.String("tenant", s.quotaUsage.getTenant(taskID)))
+				return true
+			}
+			if s.reachDatabaseQuota(taskID, task.GetTaskType()) {
+				log.Ctx(s.ctx).Info("database of the task reaches the background task quota, wait for the next round",
+					zap.Int64("taskID", taskID), zap.String("dbName", s.quotaUsage.getDatabase(taskID)),
+					zap.String("taskType", task.GetTaskType().String()))
+				return true
+			}
+			// 1. pick an indexNode client with free slot
+			nodeID, client = s.pickWorker(slots, task.GetTaskSlot(), task.GetTaskCost())
+			if client == nil {
+				if slots.hasFreeSlots() {
+					// the free workers have not enough resources for the task, try the next one
+					log.Ctx(s.ctx).Info("no worker has enough resources for the task, wait for the next round",
+						zap.Int64("taskID", taskID), zap.Any("cost", task.GetTaskCost()))
+					return true
+				}
+				log.Ctx(s.ctx).Debug("pick client failed")
+				return false
+			}
+			log.Ctx(s.ctx).Info("pick client success", zap.Int64("taskID", taskID), zap.Int64("nodeID", nodeID))
 
-		// 2. update version
-		if err := task.UpdateVersion(s.ctx, s.meta); err != nil {
-			log.Ctx(s.ctx).Warn("update task version failed", zap.Int64("taskID", taskID), zap.Error(err))
-			return false
+			// 2. update version as the epoch of the assignment, and checkpoint the assignment
+			if err := task.UpdateVersion(s.ctx, nodeID, s.meta); err != nil {
+				log.Ctx(s.ctx).Warn("update task version failed", zap.Int64("taskID", taskID), zap.Error(err))
+				return false
+			}
+			log.Ctx(s.ctx).Info("update task version success", zap.Int64("taskID", taskID))
 		}
-		log.Ctx(s.ctx).Info("update task version success", zap.Int64("taskID", taskID))
 
 		// 3. assign task to indexNode
 		success := task.AssignTask(s.ctx, client)
+		s.nodeManager.ReportResult(nodeID, success)
 		if !success {
 			log.Ctx(s.ctx).Warn("assign task to client failed", zap.Int64("taskID", taskID),
 				zap.String("new state", task.GetState().String()), zap.String("fail reason", task.GetFailReason()))
@@ -254,6 +837,8 @@ func (s *taskScheduler) process(taskID UniqueID) bool {
 			return false
 		}
 		log.Ctx(s.ctx).Info("assign task to client success", zap.Int64("taskID", taskID), zap.Int64("nodeID", nodeID))
+		task.SetDispatchTime(time.Now())
+		observeQueueLatency(task)
 
 		// 4. update meta state
 		if err := task.UpdateMetaBuildingState(nodeID, s.meta); err != nil {
@@ -261,9 +846,14 @@ func (s *taskScheduler) process(taskID UniqueID) bool {
 			task.SetState(indexpb.JobState_JobStateRetry, "update meta building state failed")
 			return false
 		}
+		task.SetStartTime(time.Now())
 		log.Ctx(s.ctx).Info("update task meta state to InProgress success", zap.Int64("taskID", taskID),
 			zap.Int64("nodeID", nodeID))
+		s.eventHandlers.fire(TaskEventAssigned, task)
 	case indexpb.JobState_JobStateFinished, indexpb.JobState_JobStateFailed:
+		if state == indexpb.JobState_JobStateFailed {
+			task.VerifySource(s.ctx, s)
+		}
 		if err := task.SetJobInfo(s.meta); err != nil {
 			log.Ctx(s.ctx).Warn("update task info failed", zap.Error(err))
 			return true
@@ -275,6 +865,14 @@ func (s *taskScheduler) process(taskID UniqueID) bool {
 			}
 		}
 		s.removeTask(taskID)
+		if state == indexpb.JobState_JobStateFinished {
+			s.recordBuildLatency(task)
+			observeBuildLatency(task, metrics.SuccessLabel)
+			s.eventHandlers.fire(TaskEventFinished, task)
+		} else {
+			observeBuildLatency(task, metrics.FailLabel)
+			s.eventHandlers.fire(TaskEventFailed, task)
+		}
 	case indexpb.JobState_JobStateRetry:
 		client, exist := s.nodeManager.GetClientByID(task.GetNodeID())
 		if exist {
@@ -282,17 +880,102 @@ func (s *taskScheduler) process(taskID UniqueID) bool {
 				return true
 			}
 		}
-		task.SetState(indexpb.JobState_JobStateInit, "")
+		// the task built on the corrupted source fails without retry
+		task.VerifySource(s.ctx, s)
+		if task.GetState() == indexpb.JobState_JobStateFailed {
+			log.Ctx(s.ctx).Warn("source of the task is corrupted, mark it as failed", zap.Int64("taskID", taskID),
+				zap.String("fail reason", task.GetFailReason()))
+			return true
+		}
+		retry, err := task.PrepareRetry(s.meta)
+		if err != nil {
+			log.Ctx(s.ctx).Warn("prepare task retry failed", zap.Int64("taskID", taskID), zap.Error(err))
+			return true
+		}
 		task.ResetNodeID()
+		if !retry {
+			log.Ctx(s.ctx).Warn("task runs out of retries, mark it as failed", zap.Int64("taskID", taskID),
+				zap.String("fail reason", task.GetFailReason()))
+			return true
+		}
+		observeBuildLatency(task, metrics.FailLabel)
+		// the next attempt is timed from now on
+		task.SetQueueTime(time.Now())
+		task.SetState(indexpb.JobState_JobStateInit, "")
+		s.eventHandlers.fire(TaskEventRetried, task)
+	case indexpb.JobState_JobStatePaused:
+		if !task.IsPaused(s.meta) {
+			log.Ctx(s.ctx).Info("task is resumed", zap.Int64("taskID", taskID))
+			task.SetState(indexpb.JobState_JobStateInit, "")
+			return true
+		}
+		if task.GetNodeID() != 0 {
+			client, exist := s.nodeManager.GetClientByID(task.GetNodeID())
+			if exist {
+				if !task.DropTaskOnWorker(s.ctx, client) {
+					return true
+				}
+			}
+			task.ResetNodeID()
+		}
 
 	default:
 		// state: in_progress
 		client, exist := s.nodeManager.GetClientByID(task.GetNodeID())
+		if s.checkTimeout(task, client, exist) {
+			return true
+		}
 		if exist {
-			task.QueryResult(s.ctx, client)
+			s.nodeManager.ReportResult(task.GetNodeID(), task.QueryResult(s.ctx, client))
+			s.speculate(task, slots)
+			task.UpdateProgress(s.meta)
 			return true
 		}
-		task.SetState(indexpb.JobState_JobStateRetry, "")
+		task.SetState(indexpb.JobState_JobStateRetry, fmt.Sprintf("node %d is not found", task.GetNodeID()))
+	}
+	return true
+}
+
+// resumeAssignment returns the worker of the assignment checkpointed before failover,
+// the task is reassigned with a new epoch if the worker is gone.
+func (s *taskScheduler) resumeAssignment(task Task) (UniqueID, types.IndexNodeClient) {
+	nodeID := task.GetNodeID()
+	if nodeID == 0 {
+		return 0, nil
+	}
+	client, exist := s.nodeManager.GetClientByID(nodeID)
+	if !exist {
+		log.Ctx(s.ctx).Info("worker of the checkpointed assignment is gone, reassign the task",
+			zap.Int64("taskID", task.GetTaskID()), zap.Int64("nodeID", nodeID))
+		task.ResetNodeID()
+		return 0, nil
+	}
+	log.Ctx(s.ctx).Info("resume the checkpointed assignment", zap.Int64("taskID", task.GetTaskID()),
+		zap.Int64("nodeID", nodeID))
+	return nodeID, client
+}
+
+// checkTimeout drops the in progress task on the worker and marks it as Retry if it exceeds the timeout,
+// the task stays on the worker to be dropped by the retry process if it failed to drop.
+// The task reloaded in progress is timed from the first check.
+func (s *taskScheduler) checkTimeout(task Task, client types.IndexNodeClient, exist bool) bool {
+	timeout := Params.DataCoordCfg.IndexTaskTimeout.GetAsDuration(time.Second)
+	if task.GetStartTime().IsZero() {
+		task.SetStartTime(time.Now())
+		return false
+	}
+	elapsed := time.Since(task.GetStartTime())
+	if timeout <= 0 || elapsed <= timeout {
+		return false
+	}
+
+	nodeID := task.GetNodeID()
+	log.Ctx(s.ctx).Warn("task is timeout, drop it on worker and retry", zap.Int64("taskID", task.GetTaskID()),
+		zap.Int64("nodeID", nodeID), zap.Duration("elapsed", elapsed), zap.Duration("timeout", timeout))
+	if exist && task.DropTaskOnWorker(s.ctx, client) {
+		task.ResetNodeID()
 	}
+	task.SetState(indexpb.JobState_JobStateRetry, fmt.Sprintf("task timeout after %s on node %d", elapsed.Truncate(time.Second), nodeID))
+	task.SetStartTime(time.Time{})
 	return true
 }
@@ -19,7 +19,6 @@ package datacoord
 import (
 	"context"
 	"fmt"
-	"sync"
 	"testing"
 	"time"
 
@@ -37,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/config"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -638,6 +638,7 @@ func createMeta(catalog metastore.DataCoordCatalog, am *analyzeMeta, im *indexMe
 		},
 		analyzeMeta: am,
 		indexMeta:   im,
+		statsMeta:   &statsMeta{tasks: map[int64]*indexpb.StatsTask{}},
 	}
 }
 
@@ -792,7 +793,8 @@ func (s *taskSchedulerSuite) scheduler(handler Handler) {
 	in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil)
 
 	workerManager := NewMockWorkerManager(s.T())
-	workerManager.EXPECT().PickClient().Return(s.nodeID, in)
+	workerManager.EXPECT().ReportResult(mock.Anything, mock.Anything).Return().Maybe()
+	workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{s.nodeID: {NodeID: s.nodeID, Client: in, Slots: 100}})
 	workerManager.EXPECT().GetClientByID(mock.Anything).Return(in, true)
 
 	mt := createMeta(catalog, s.createAnalyzeMeta(catalog), createIndexMeta(catalog))
@@ -922,12 +924,907 @@ func (s *taskSchedulerSuite) Test_scheduler() {
 	})
 }
 
+func (s *taskSchedulerSuite) Test_pickWorker() {
+	in1 := mocks.NewMockIndexNodeClient(s.T())
+	in2 := mocks.NewMockIndexNodeClient(s.T())
+	workerManager := NewMockWorkerManager(s.T())
+	scheduler := &taskScheduler{
+		ctx:              context.Background(),
+		nodeManager:      workerManager,
+		scheduleDuration: s.duration,
+	}
+
+	s.Run("dispatch by free slots", func() {
+		workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{
+			1: {NodeID: 1, Client: in1, Slots: 2},
+			2: {NodeID: 2, Client: in2, Slots: 1},
+			3: {NodeID: 3, Client: nil, Slots: 0},
+		}).Once()
+
+		slots := &dispatchSlots{}
+		picked := make([]UniqueID, 0)
+		for {
+			nodeID, client := scheduler.pickWorker(slots, 1, taskCost{})
+			if client == nil {
+				break
+			}
+			picked = append(picked, nodeID)
+		}
+		// queried once in a round, no more tasks than the free slots are dispatched
+		s.Equal([]UniqueID{1, 1, 2}, picked)
+		s.Zero(scheduler.dispatchBackoff)
+	})
+
+	s.Run("dispatch by task slots", func() {
+		workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{
+			1: {NodeID: 1, Client: in1, Slots: 3},
+			2: {NodeID: 2, Client: in2, Slots: 1},
+		}).Once()
+
+		slots := &dispatchSlots{}
+		nodeID, client := scheduler.pickWorker(slots, 2, taskCost{})
+		s.Equal(in1, client)
+		s.EqualValues(1, nodeID)
+		s.EqualValues(1, slots.workers[1].Slots)
+
+		// takes all the free slots if not enough
+		nodeID, _ = scheduler.pickWorker(slots, 2, taskCost{})
+		s.EqualValues(1, nodeID)
+		s.EqualValues(0, slots.workers[1].Slots)
+		nodeID, _ = scheduler.pickWorker(slots, 2, taskCost{})
+		s.EqualValues(2, nodeID)
+		_, client = scheduler.pickWorker(slots, 1, taskCost{})
+		s.Nil(client)
+	})
+
+	s.Run("bin-pack by task cost", func() {
+		in3 := mocks.NewMockIndexNodeClient(s.T())
+		workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{
+			1: {NodeID: 1, Client: in1, Slots: 4, MemoryCapacity: 100, AvailableMemory: 80},
+			2: {NodeID: 2, Client: in2, Slots: 1, MemoryCapacity: 100, AvailableMemory: 40},
+			3: {NodeID: 3, Client: in3, Slots: 1, MemoryCapacity: 100, AvailableMemory: 20},
+		}).Once()
+
+		slots := &dispatchSlots{}
+		// the worker with the least memory left after taking the task
+		nodeID, _ := scheduler.pickWorker(slots, 1, taskCost{memory: 30})
+		s.EqualValues(2, nodeID)
+		s.EqualValues(10, slots.workers[2].AvailableMemory)
+
+		// waits for the resources if no worker has enough
+		_, client := scheduler.pickWorker(slots, 1, taskCost{memory: 90})
+		s.Nil(client)
+		s.True(slots.hasFreeSlots())
+
+		// dispatched regardless of the cost exceeding all the capacities
+		nodeID, _ = scheduler.pickWorker(slots, 1, taskCost{memory: 200})
+		s.EqualValues(1, nodeID)
+		s.EqualValues(0, slots.workers[1].AvailableMemory)
+
+		// the task without cost goes to the worker with the most free slots
+		nodeID, _ = scheduler.pickWorker(slots, 1, taskCost{})
+		s.EqualValues(1, nodeID)
+	})
+
+	s.Run("check free disk with headroom", func() {
+		workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{
+			1: {NodeID: 1, Client: in1, Slots: 4, DiskCapacity: 100, AvailableDisk: 50},
+			2: {NodeID: 2, Client: in2, Slots: 1, DiskCapacity: 100, AvailableDisk: 90},
+		}).Once()
+
+		slots := &dispatchSlots{}
+		// the worker with more free slots has not enough headroom
+		nodeID, _ := scheduler.pickWorker(slots, 1, taskCost{disk: 45})
+		s.EqualValues(2, nodeID)
+		s.EqualValues(45, slots.workers[2].AvailableDisk)
+
+		// the disk is checked even if the memory exceeds all the capacities
+		slots.workers[1].MemoryCapacity = 10
+		slots.workers[2].MemoryCapacity = 10
+		_, client := scheduler.pickWorker(slots, 1, taskCost{memory: 20, disk: 45})
+		s.Nil(client)
+
+		// dispatched regardless of the disk cost exceeding all the capacities
+		nodeID, _ = scheduler.pickWorker(slots, 1, taskCost{disk: 200})
+		s.EqualValues(1, nodeID)
+	})
+
+	s.Run("restrict to allowed CPU features", func() {
+		workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{
+			1: {NodeID: 1, Client: in1, Slots: 4, CPUFeatures: []string{"avx512f"}},
+			2: {NodeID: 2, Client: in2, Slots: 1},
+		}).Once()
+
+		slots := &dispatchSlots{}
+		// the worker with more free slots builds the index requiring avx512f
+		nodeID, _ := scheduler.pickWorker(slots, 1, taskCost{allowedCPUFeatures: []string{"amd64", "avx2"}})
+		s.EqualValues(2, nodeID)
+
+		// waits for the worker within the allowed features
+		_, client := scheduler.pickWorker(slots, 1, taskCost{allowedCPUFeatures: []string{"amd64", "avx2"}})
+		s.Nil(client)
+
+		nodeID, _ = scheduler.pickWorker(slots, 1, taskCost{allowedCPUFeatures: []string{"amd64", "avx512f"}})
+		s.EqualValues(1, nodeID)
+	})
+
+	s.Run("back off while saturated", func() {
+		paramtable.Get().Save(Params.DataCoordCfg.IndexTaskSchedulerMaxBackoff.Key, "300")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.IndexTaskSchedulerMaxBackoff.Key)
+
+		saturated := map[UniqueID]*WorkerSlots{1: {NodeID: 1, Client: in1, Slots: 0}}
+		workerManager.EXPECT().QuerySlots().Return(saturated).Once()
+		_, client := scheduler.pickWorker(&dispatchSlots{}, 1, taskCost{})
+		s.Nil(client)
+		s.Equal(s.duration, scheduler.dispatchBackoff)
+
+		// not queried during backoff
+		_, client = scheduler.pickWorker(&dispatchSlots{}, 1, taskCost{})
+		s.Nil(client)
+
+		workerManager.EXPECT().QuerySlots().Return(saturated).Once()
+		scheduler.nextDispatchTime = time.Now()
+		_, client = scheduler.pickWorker(&dispatchSlots{}, 1, taskCost{})
+		s.Nil(client)
+		s.Equal(2*s.duration, scheduler.dispatchBackoff)
+
+		workerManager.EXPECT().QuerySlots().Return(saturated).Once()
+		scheduler.nextDispatchTime = time.Now()
+		_, client = scheduler.pickWorker(&dispatchSlots{}, 1, taskCost{})
+		s.Nil(client)
+		s.Equal(300*time.Millisecond, scheduler.dispatchBackoff)
+
+		// reset once a slot is free
+		workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{
+			2: {NodeID: 2, Client: in2, Slots: 1},
+		}).Once()
+		scheduler.nextDispatchTime = time.Now()
+		nodeID, client := scheduler.pickWorker(&dispatchSlots{}, 1, taskCost{})
+		s.Equal(in2, client)
+		s.EqualValues(2, nodeID)
+		s.Zero(scheduler.dispatchBackoff)
+	})
+}
+
+func (s *taskSchedulerSuite) Test_pausedIndexTask() {
+	catalog := catalogmocks.NewDataCoordCatalog(s.T())
+	catalog.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(nil)
+	in := mocks.NewMockIndexNodeClient(s.T())
+	workerManager := NewMockWorkerManager(s.T())
+
+	segIdx := &model.SegmentIndex{
+		SegmentID:    segID,
+		CollectionID: s.collectionID,
+		PartitionID:  s.partitionID,
+		NumRows:      1025,
+		IndexID:      indexID,
+		BuildID:      buildID,
+		NodeID:       s.nodeID,
+		IndexState:   commonpb.IndexState_InProgress,
+	}
+	im := &indexMeta{
+		ctx:                  context.Background(),
+		catalog:              catalog,
+		indexes:              map[UniqueID]map[UniqueID]*model.Index{},
+		buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{buildID: segIdx},
+		segmentIndexes:       map[UniqueID]map[UniqueID]*model.SegmentIndex{segID: {indexID: segIdx}},
+	}
+	scheduler := &taskScheduler{
+		ctx:         context.Background(),
+		tasks:       make(map[int64]Task),
+		meta:        &meta{indexMeta: im},
+		nodeManager: workerManager,
+	}
+	scheduler.tasks[buildID] = &indexBuildTask{
+		taskID: buildID,
+		nodeID: s.nodeID,
+		taskInfo: &indexpb.IndexTaskInfo{
+			BuildID: buildID,
+			State:   commonpb.IndexState_InProgress,
+		},
+	}
+	task := scheduler.getTask(buildID)
+
+	s.Run("pause in progress task", func() {
+		_, err := im.PauseTasks(s.collectionID, nil)
+		s.NoError(err)
+
+		// failed to drop on worker, retry in the next round
+		workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true).Twice()
+		in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Status(errors.New("mock")), nil).Once()
+		s.True(scheduler.process(buildID, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStatePaused, task.GetState())
+		s.Equal(s.nodeID, task.GetNodeID())
+
+		in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		s.True(scheduler.process(buildID, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStatePaused, task.GetState())
+		s.Zero(task.GetNodeID())
+
+		// skipped while paused
+		s.True(scheduler.process(buildID, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStatePaused, task.GetState())
+	})
+
+	s.Run("reload paused task", func() {
+		scheduler := &taskScheduler{
+			tasks: make(map[int64]Task),
+			meta: &meta{
+				indexMeta:   im,
+				analyzeMeta: &analyzeMeta{tasks: map[int64]*indexpb.AnalyzeTask{}},
+				segments: &SegmentsInfo{segments: map[UniqueID]*SegmentInfo{
+					segID: NewSegmentInfo(&datapb.SegmentInfo{ID: segID, State: commonpb.SegmentState_Flushed}),
+				}},
+			},
+		}
+		scheduler.reloadFromKV()
+		s.Equal(indexpb.JobState_JobStatePaused, scheduler.getTask(buildID).GetState())
+	})
+
+	s.Run("resume", func() {
+		_, err := im.ResumeTasks(0, []UniqueID{buildID})
+		s.NoError(err)
+		s.True(scheduler.process(buildID, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStateInit, task.GetState())
+	})
+}
+
+func (s *taskSchedulerSuite) Test_taskTimeout() {
+	paramtable.Get().Save(Params.DataCoordCfg.IndexTaskTimeout.Key, "3600")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.IndexTaskTimeout.Key)
+
+	in := mocks.NewMockIndexNodeClient(s.T())
+	workerManager := NewMockWorkerManager(s.T())
+	workerManager.EXPECT().ReportResult(mock.Anything, mock.Anything).Return().Maybe()
+	workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true)
+	workerManager.EXPECT().GetClientByID(int64(0)).Return(nil, false)
+	catalog := catalogmocks.NewDataCoordCatalog(s.T())
+	scheduler := &taskScheduler{
+		ctx:         context.Background(),
+		tasks:       make(map[int64]Task),
+		meta:        &meta{analyzeMeta: s.createAnalyzeMeta(catalog)},
+		nodeManager: workerManager,
+	}
+	// analyze task 2 is in progress on the node
+	scheduler.tasks[2] = &analyzeTask{
+		taskID: 2,
+		nodeID: s.nodeID,
+		taskInfo: &indexpb.AnalyzeResult{
+			TaskID: 2,
+			State:  indexpb.JobState_JobStateInProgress,
+		},
+	}
+	task := scheduler.getTask(2)
+
+	s.Run("reloaded task is timed from the first check", func() {
+		in.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(&indexpb.QueryJobsV2Response{
+			Status:    merr.Success(),
+			ClusterID: Params.CommonCfg.ClusterPrefix.GetValue(),
+			Result: &indexpb.QueryJobsV2Response_AnalyzeJobResults{
+				AnalyzeJobResults: &indexpb.AnalyzeResults{
+					Results: []*indexpb.AnalyzeResult{{TaskID: 2, State: indexpb.JobState_JobStateInProgress}},
+				},
+			},
+		}, nil).Once()
+		s.True(scheduler.process(2, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStateInProgress, task.GetState())
+		s.False(task.GetStartTime().IsZero())
+	})
+
+	s.Run("timeout but failed to drop", func() {
+		task.SetStartTime(time.Now().Add(-2 * time.Hour))
+		in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+		s.True(scheduler.process(2, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStateRetry, task.GetState())
+		s.Contains(task.GetFailReason(), "timeout")
+		// dropped again by retry
+		s.Equal(s.nodeID, task.GetNodeID())
+		s.True(task.GetStartTime().IsZero())
+	})
+
+	s.Run("timeout", func() {
+		task.SetState(indexpb.JobState_JobStateInProgress, "")
+		task.SetStartTime(time.Now().Add(-2 * time.Hour))
+		in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		s.True(scheduler.process(2, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStateRetry, task.GetState())
+		s.Contains(task.GetFailReason(), "timeout")
+		s.Zero(task.GetNodeID())
+
+		// not dropped again
+		s.True(scheduler.process(2, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStateInit, task.GetState())
+	})
+
+	s.Run("never timeout", func() {
+		paramtable.Get().Save(Params.DataCoordCfg.IndexTaskTimeout.Key, "0")
+		task.SetState(indexpb.JobState_JobStateInProgress, "")
+		task.(*analyzeTask).nodeID = s.nodeID
+		task.SetStartTime(time.Now().Add(-2 * time.Hour))
+		in.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+		s.True(scheduler.process(2, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStateRetry, task.GetState())
+		s.NotContains(task.GetFailReason(), "timeout")
+	})
+}
+
+func (s *taskSchedulerSuite) Test_taskTiming() {
+	in := mocks.NewMockIndexNodeClient(s.T())
+	workerManager := NewMockWorkerManager(s.T())
+	workerManager.EXPECT().ReportResult(mock.Anything, mock.Anything).Return().Maybe()
+	workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true)
+	catalog := catalogmocks.NewDataCoordCatalog(s.T())
+	catalog.EXPECT().SaveAnalyzeTask(mock.Anything, mock.Anything).Return(nil)
+	scheduler := &taskScheduler{
+		ctx:         context.Background(),
+		tasks:       make(map[int64]Task),
+		meta:        &meta{analyzeMeta: s.createAnalyzeMeta(catalog)},
+		nodeManager: workerManager,
+	}
+	task := &analyzeTask{
+		taskID: 2,
+		nodeID: s.nodeID,
+		taskInfo: &indexpb.AnalyzeResult{
+			TaskID: 2,
+			State:  indexpb.JobState_JobStateInProgress,
+		},
+	}
+	now := time.Now()
+	task.SetQueueTime(now.Add(-10 * time.Second))
+	task.SetDispatchTime(now.Add(-8 * time.Second))
+	scheduler.tasks[2] = task
+
+	queryResult := func(state indexpb.JobState, startTime int64) *indexpb.QueryJobsV2Response {
+		return &indexpb.QueryJobsV2Response{
+			Status: merr.Success(),
+			Result: &indexpb.QueryJobsV2Response_AnalyzeJobResults{
+				AnalyzeJobResults: &indexpb.AnalyzeResults{
+					Results: []*indexpb.AnalyzeResult{{TaskID: 2, State: state, StartTime: startTime}},
+				},
+			},
+		}
+	}
+
+	// waiting in the queue of the worker
+	in.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(queryResult(indexpb.JobState_JobStateInProgress, 0), nil).Once()
+	s.True(scheduler.process(2, &dispatchSlots{}))
+	timing := task.GetTiming()
+	s.True(timing.nodeStartTime.IsZero())
+
+	nodeStartTime := now.Add(-5 * time.Second).UnixMilli()
+	in.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(queryResult(indexpb.JobState_JobStateInProgress, nodeStartTime), nil).Once()
+	s.True(scheduler.process(2, &dispatchSlots{}))
+	in.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(queryResult(indexpb.JobState_JobStateFinished, nodeStartTime), nil).Once()
+	s.True(scheduler.process(2, &dispatchSlots{}))
+
+	timing = task.GetTiming()
+	queueLatency, ok := timing.queueLatency()
+	s.True(ok)
+	s.Equal(2*time.Second, queueLatency)
+	buildLatency, ok := timing.buildLatency()
+	s.True(ok)
+	s.GreaterOrEqual(buildLatency, 5*time.Second)
+
+	described := scheduler.listTasks(0, nil)
+	s.Require().Len(described, 1)
+	s.Equal(now.Add(-10*time.Second).UnixMilli(), described[0].GetTiming().GetEnqueueTime())
+	s.Equal(now.Add(-8*time.Second).UnixMilli(), described[0].GetTiming().GetDispatchTime())
+	s.Equal(nodeStartTime, described[0].GetTiming().GetNodeStartTime())
+	s.NotZero(described[0].GetTiming().GetCompleteTime())
+
+	// the timing is persisted with the result
+	in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+	s.True(scheduler.process(2, &dispatchSlots{}))
+	s.Nil(scheduler.getTask(2))
+	persisted := scheduler.meta.analyzeMeta.GetTask(2)
+	s.Equal(indexpb.JobState_JobStateFinished, persisted.GetState())
+	s.Equal(nodeStartTime, persisted.GetTiming().GetNodeStartTime())
+	s.Equal(described[0].GetTiming().GetCompleteTime(), persisted.GetTiming().GetCompleteTime())
+
+	// a new attempt is timed from the start
+	task.SetQueueTime(now)
+	timing = task.GetTiming()
+	_, ok = timing.queueLatency()
+	s.False(ok)
+	reset := timing.toProto()
+	s.Equal(now.UnixMilli(), reset.GetEnqueueTime())
+	s.Zero(reset.GetDispatchTime())
+	s.Zero(reset.GetNodeStartTime())
+	s.Zero(reset.GetCompleteTime())
+}
+
+func (s *taskSchedulerSuite) Test_speculativeIndexBuild() {
+	paramtable.Get().Save(Params.DataCoordCfg.SpeculativeIndexBuildEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SpeculativeIndexBuildEnabled.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.SpeculativeIndexBuildMaxRatio.Key, "1")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SpeculativeIndexBuildMaxRatio.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.SpeculativeIndexBuildMinSamples.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SpeculativeIndexBuildMinSamples.Key)
+
+	in := mocks.NewMockIndexNodeClient(s.T())
+	spec := mocks.NewMockIndexNodeClient(s.T())
+	specNodeID := s.nodeID + 1
+	workerManager := NewMockWorkerManager(s.T())
+	workerManager.EXPECT().ReportResult(mock.Anything, mock.Anything).Return().Maybe()
+	workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true).Maybe()
+	workerManager.EXPECT().GetClientByID(specNodeID).Return(spec, true).Maybe()
+	catalog := catalogmocks.NewDataCoordCatalog(s.T())
+	segIdx := &model.SegmentIndex{
+		SegmentID:    segID,
+		CollectionID: s.collectionID,
+		IndexID:      indexID,
+		BuildID:      buildID,
+		NodeID:       s.nodeID,
+		IndexVersion: 1,
+		IndexState:   commonpb.IndexState_InProgress,
+	}
+	scheduler := &taskScheduler{
+		ctx:   context.Background(),
+		tasks: make(map[int64]Task),
+		meta: &meta{indexMeta: &indexMeta{
+			ctx:                  context.Background(),
+			catalog:              catalog,
+			indexes:              map[UniqueID]map[UniqueID]*model.Index{},
+			buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{buildID: segIdx},
+			segmentIndexes:       map[UniqueID]map[UniqueID]*model.SegmentIndex{segID: {indexID: segIdx}},
+		}},
+		nodeManager: workerManager,
+	}
+	kind := buildKind("HNSW", 1000)
+	newTask := func(nodeStartTime time.Time) *indexBuildTask {
+		task := &indexBuildTask{
+			taskID:   buildID,
+			nodeID:   s.nodeID,
+			taskInfo: &indexpb.IndexTaskInfo{BuildID: buildID, State: commonpb.IndexState_InProgress},
+			req:      &indexpb.CreateJobRequest{BuildID: buildID, IndexVersion: 1},
+			kind:     kind,
+		}
+		task.timing.nodeStartTime = nodeStartTime
+		return task
+	}
+	newSlots := func(quota int) *dispatchSlots {
+		return &dispatchSlots{
+			queried: true,
+			workers: map[UniqueID]*WorkerSlots{
+				s.nodeID:   {NodeID: s.nodeID, Client: in, Slots: 1},
+				specNodeID: {NodeID: specNodeID, Client: spec, Slots: 1},
+			},
+			speculationQuota: quota,
+		}
+	}
+	queryResult := func(state commonpb.IndexState) *indexpb.QueryJobsV2Response {
+		return &indexpb.QueryJobsV2Response{
+			Status: merr.Success(),
+			Result: &indexpb.QueryJobsV2Response_IndexJobResults{
+				IndexJobResults: &indexpb.IndexJobResults{
+					Results: []*indexpb.IndexTaskInfo{{BuildID: buildID, State: state, IndexFileKeys: []string{"file1"}}},
+				},
+			},
+		}
+	}
+
+	s.Run("not enough samples", func() {
+		task := newTask(time.Now().Add(-time.Hour))
+		scheduler.speculate(task, newSlots(1))
+		s.Nil(task.speculative)
+	})
+
+	scheduler.recordBuildLatency(&indexBuildTask{
+		kind:     kind,
+		taskInfo: &indexpb.IndexTaskInfo{State: commonpb.IndexState_Finished},
+		timing:   taskTiming{nodeStartTime: time.Now().Add(-time.Second), completeTime: time.Now()},
+	})
+	scheduler.recordBuildLatency(&indexBuildTask{
+		kind:     kind,
+		taskInfo: &indexpb.IndexTaskInfo{State: commonpb.IndexState_Finished},
+		timing:   taskTiming{nodeStartTime: time.Now().Add(-2 * time.Second), completeTime: time.Now()},
+	})
+
+	s.Run("not straggling", func() {
+		task := newTask(time.Now())
+		s.Equal(1, speculationQuota([]Task{task}))
+		scheduler.speculate(task, newSlots(1))
+		s.Nil(task.speculative)
+	})
+
+	s.Run("no quota", func() {
+		task := newTask(time.Now().Add(-time.Hour))
+		s.Zero(speculationQuota([]Task{task, &indexBuildTask{taskInfo: &indexpb.IndexTaskInfo{State: commonpb.IndexState_Unissued}}}))
+		scheduler.speculate(task, newSlots(0))
+		s.Nil(task.speculative)
+	})
+
+	s.Run("speculative build finishes first", func() {
+		task := newTask(time.Now().Add(-time.Hour))
+		spec.EXPECT().CreateJobV2(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *indexpb.CreateJobV2Request, opts ...grpc.CallOption) (*commonpb.Status, error) {
+				s.Equal(int64(1+speculativeVersionOffset), req.GetIndexRequest().GetIndexVersion())
+				return merr.Success(), nil
+			}).Once()
+		slots := newSlots(1)
+		scheduler.speculate(task, slots)
+		s.Require().NotNil(task.speculative)
+		s.Equal(specNodeID, task.speculative.nodeID)
+		s.Zero(slots.speculationQuota)
+		s.Zero(speculationQuota([]Task{task}))
+		s.Equal(specNodeID, task.Describe(scheduler.meta).GetSpeculativeNodeID())
+		// the straggler is not picked as the worker of its duplicate
+		s.EqualValues(1, slots.workers[s.nodeID].Slots)
+
+		spec.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(queryResult(commonpb.IndexState_InProgress), nil).Once()
+		scheduler.speculate(task, newSlots(0))
+		s.NotNil(task.speculative)
+		s.Equal(indexpb.JobState_JobStateInProgress, task.GetState())
+
+		spec.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(queryResult(commonpb.IndexState_Finished), nil).Once()
+		in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		scheduler.speculate(task, newSlots(0))
+		s.Nil(task.speculative)
+		s.Equal(indexpb.JobState_JobStateFinished, task.GetState())
+		s.Equal(specNodeID, task.GetNodeID())
+		job, _ := scheduler.meta.indexMeta.GetIndexJob(buildID)
+		s.Equal(specNodeID, job.NodeID)
+		s.Equal(int64(1+speculativeVersionOffset), job.IndexVersion)
+	})
+
+	s.Run("straggler finishes first", func() {
+		task := newTask(time.Now().Add(-time.Hour))
+		task.speculative = &speculativeBuild{nodeID: specNodeID, version: 1 + speculativeVersionOffset, startTime: time.Now()}
+		task.SetState(indexpb.JobState_JobStateFinished, "")
+		spec.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		scheduler.speculate(task, newSlots(0))
+		s.Nil(task.speculative)
+		job, _ := scheduler.meta.indexMeta.GetIndexJob(buildID)
+		s.Equal(s.nodeID, job.NodeID)
+		s.Equal(int64(1), job.IndexVersion)
+	})
+
+	s.Run("speculative build fails", func() {
+		task := newTask(time.Now().Add(-time.Hour))
+		task.speculative = &speculativeBuild{nodeID: specNodeID, version: 1 + speculativeVersionOffset, startTime: time.Now()}
+		spec.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(queryResult(commonpb.IndexState_Failed), nil).Once()
+		spec.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		scheduler.speculate(task, newSlots(0))
+		s.Nil(task.speculative)
+		s.Equal(indexpb.JobState_JobStateInProgress, task.GetState())
+		s.Equal(s.nodeID, task.GetNodeID())
+	})
+}
+
+func (s *taskSchedulerSuite) Test_buildLatencies() {
+	paramtable.Get().Save(Params.DataCoordCfg.SpeculativeIndexBuildMinSamples.Key, "20")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.SpeculativeIndexBuildMinSamples.Key)
+
+	latencies := make(buildLatencies)
+	for i := 1; i <= 19; i++ {
+		latencies.record("HNSW-10", time.Duration(i)*time.Second)
+	}
+	_, ok := latencies.p95("HNSW-10")
+	s.False(ok)
+	latencies.record("HNSW-10", 20*time.Second)
+	p95, ok := latencies.p95("HNSW-10")
+	s.True(ok)
+	s.Equal(19*time.Second, p95)
+	_, ok = latencies.p95("HNSW-11")
+	s.False(ok)
+
+	// only the recent latencies are kept
+	for i := 0; i < maxBuildLatencySamples; i++ {
+		latencies.record("HNSW-10", time.Second)
+	}
+	p95, ok = latencies.p95("HNSW-10")
+	s.True(ok)
+	s.Equal(time.Second, p95)
+	s.Len(latencies["HNSW-10"], maxBuildLatencySamples)
+
+	s.Equal("HNSW-10", buildKind("HNSW", 1000))
+	s.Equal("HNSW-11", buildKind("HNSW", 1024))
+}
+
+func (s *taskSchedulerSuite) Test_resumeAssignment() {
+	in := mocks.NewMockIndexNodeClient(s.T())
+	workerManager := NewMockWorkerManager(s.T())
+	workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true)
+	workerManager.EXPECT().GetClientByID(s.nodeID+1).Return(nil, false)
+	scheduler := &taskScheduler{
+		ctx:         context.Background(),
+		tasks:       make(map[int64]Task),
+		nodeManager: workerManager,
+	}
+
+	s.Run("not assigned", func() {
+		task := &analyzeTask{taskID: 1, taskInfo: &indexpb.AnalyzeResult{TaskID: 1, State: indexpb.JobState_JobStateInit}}
+		nodeID, client := scheduler.resumeAssignment(task)
+		s.Zero(nodeID)
+		s.Nil(client)
+	})
+
+	s.Run("resume on the checkpointed worker", func() {
+		task := &analyzeTask{taskID: 1, nodeID: s.nodeID, taskInfo: &indexpb.AnalyzeResult{TaskID: 1, State: indexpb.JobState_JobStateInit}}
+		nodeID, client := scheduler.resumeAssignment(task)
+		s.Equal(s.nodeID, nodeID)
+		s.Equal(in, client)
+		s.Equal(s.nodeID, task.GetNodeID())
+	})
+
+	s.Run("checkpointed worker is gone", func() {
+		task := &analyzeTask{taskID: 1, nodeID: s.nodeID + 1, taskInfo: &indexpb.AnalyzeResult{TaskID: 1, State: indexpb.JobState_JobStateInit}}
+		nodeID, client := scheduler.resumeAssignment(task)
+		s.Zero(nodeID)
+		s.Nil(client)
+		s.Zero(task.GetNodeID())
+	})
+}
+
+func (s *taskSchedulerSuite) Test_indexTaskRetry() {
+	paramtable.Get().Save(Params.DataCoordCfg.IndexTaskMaxRetryTimes.Key, "2")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.IndexTaskMaxRetryTimes.Key)
+	paramtable.Get().Save(Params.DataCoordCfg.IndexTaskRetryBackoff.Key, "3600000")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.IndexTaskRetryBackoff.Key)
+
+	in := mocks.NewMockIndexNodeClient(s.T())
+	in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	workerManager := NewMockWorkerManager(s.T())
+	workerManager.EXPECT().ReportResult(mock.Anything, mock.Anything).Return().Maybe()
+	workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true)
+	workerManager.EXPECT().GetClientByID(int64(0)).Return(nil, false)
+	catalog := catalogmocks.NewDataCoordCatalog(s.T())
+	catalog.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(nil)
+	segIdx := &model.SegmentIndex{
+		SegmentID:    segID,
+		CollectionID: s.collectionID,
+		IndexID:      indexID,
+		BuildID:      buildID,
+		NodeID:       s.nodeID,
+		IndexState:   commonpb.IndexState_InProgress,
+	}
+	scheduler := &taskScheduler{
+		ctx:   context.Background(),
+		tasks: make(map[int64]Task),
+		meta: &meta{indexMeta: &indexMeta{
+			ctx:                  context.Background(),
+			catalog:              catalog,
+			indexes:              map[UniqueID]map[UniqueID]*model.Index{},
+			buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{buildID: segIdx},
+			segmentIndexes:       map[UniqueID]map[UniqueID]*model.SegmentIndex{segID: {buildID: segIdx}},
+		}},
+		nodeManager: workerManager,
+	}
+	task := &indexBuildTask{
+		taskID:   buildID,
+		nodeID:   s.nodeID,
+		taskInfo: &indexpb.IndexTaskInfo{BuildID: buildID, State: commonpb.IndexState_InProgress},
+	}
+	scheduler.tasks[buildID] = task
+	events := make([]TaskEventType, 0)
+	scheduler.RegisterTaskEventHandler("test", func(event *TaskEvent) {
+		s.Equal(int64(buildID), event.TaskID)
+		events = append(events, event.Type)
+	})
+
+	for i := 1; i <= 2; i++ {
+		task.nodeID = s.nodeID
+		task.SetState(indexpb.JobState_JobStateRetry, fmt.Sprintf("mock error %d", i))
+		s.True(scheduler.process(buildID, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStateInit, task.GetState())
+		s.Zero(task.GetNodeID())
+		s.True(task.IsRetryBackoff())
+
+		// backing off, not assigned
+		s.True(scheduler.process(buildID, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStateInit, task.GetState())
+
+		job, _ := scheduler.meta.indexMeta.GetIndexJob(buildID)
+		s.EqualValues(i, job.RetryTimes)
+		s.Len(job.RetryFailReasons, i)
+	}
+
+	// runs out of the retries
+	task.nodeID = s.nodeID
+	task.SetState(indexpb.JobState_JobStateRetry, "mock error 3")
+	s.True(scheduler.process(buildID, &dispatchSlots{}))
+	s.Equal(indexpb.JobState_JobStateFailed, task.GetState())
+	s.Contains(task.GetFailReason(), "after 3 attempts")
+	s.Contains(task.GetFailReason(), "mock error 1; mock error 2; mock error 3")
+
+	s.True(scheduler.process(buildID, &dispatchSlots{}))
+	s.Nil(scheduler.getTask(buildID))
+	job, _ := scheduler.meta.indexMeta.GetIndexJob(buildID)
+	s.Equal(commonpb.IndexState_Failed, job.IndexState)
+	s.Equal(task.GetFailReason(), job.FailReason)
+	s.Equal([]TaskEventType{TaskEventRetried, TaskEventRetried, TaskEventFailed}, events)
+}
+
+func (s *taskSchedulerSuite) Test_taskEvents() {
+	scheduler := &taskScheduler{
+		ctx:   context.Background(),
+		meta:  &meta{analyzeMeta: &analyzeMeta{}},
+		tasks: make(map[int64]Task),
+	}
+	events := make([]*TaskEvent, 0)
+	scheduler.RegisterTaskEventHandler("audit", func(event *TaskEvent) {
+		events = append(events, event)
+	})
+	// the panic of the handler doesn't break the scheduler and the other handlers
+	scheduler.RegisterTaskEventHandler("panic", func(event *TaskEvent) {
+		panic("mock panic")
+	})
+
+	task := &analyzeTask{taskID: 1, taskInfo: &indexpb.AnalyzeResult{TaskID: 1, State: indexpb.JobState_JobStateInit}}
+	scheduler.enqueue(task)
+	// the task already in the scheduler is not enqueued again
+	scheduler.enqueue(task)
+	s.Require().Len(events, 1)
+	s.Equal(TaskEventEnqueued, events[0].Type)
+	s.Equal(int64(1), events[0].TaskID)
+	s.Equal(indexpb.JobType_JobTypeAnalyzeJob, events[0].TaskType)
+	s.Equal("Enqueued", events[0].Type.String())
+
+	scheduler.UnregisterTaskEventHandler("audit")
+	scheduler.enqueue(&analyzeTask{taskID: 2, taskInfo: &indexpb.AnalyzeResult{TaskID: 2, State: indexpb.JobState_JobStateInit}})
+	s.Len(events, 1)
+}
+
+func (s *taskSchedulerSuite) Test_cancelAnalyzeTask() {
+	catalog := catalogmocks.NewDataCoordCatalog(s.T())
+	catalog.EXPECT().SaveAnalyzeTask(mock.Anything, mock.Anything).Return(nil)
+	in := mocks.NewMockIndexNodeClient(s.T())
+	workerManager := NewMockWorkerManager(s.T())
+
+	taskID := UniqueID(1)
+	am := &analyzeMeta{
+		ctx:     context.Background(),
+		catalog: catalog,
+		tasks: map[int64]*indexpb.AnalyzeTask{
+			taskID: {
+				CollectionID: s.collectionID,
+				TaskID:       taskID,
+				NodeID:       s.nodeID,
+				State:        indexpb.JobState_JobStateInProgress,
+			},
+			taskID + 1: {
+				CollectionID: s.collectionID,
+				TaskID:       taskID + 1,
+				State:        indexpb.JobState_JobStateFinished,
+			},
+		},
+	}
+	scheduler := &taskScheduler{
+		ctx:         context.Background(),
+		tasks:       make(map[int64]Task),
+		meta:        &meta{analyzeMeta: am},
+		nodeManager: workerManager,
+	}
+	scheduler.tasks[taskID] = &analyzeTask{
+		taskID: taskID,
+		nodeID: s.nodeID,
+		taskInfo: &indexpb.AnalyzeResult{
+			TaskID: taskID,
+			State:  indexpb.JobState_JobStateInProgress,
+		},
+	}
+
+	s.Run("task not found", func() {
+		err := scheduler.cancelAnalyzeTask(context.Background(), taskID+2)
+		s.ErrorIs(err, merr.ErrAnalyzeTaskNotFound)
+	})
+
+	s.Run("task finished", func() {
+		err := scheduler.cancelAnalyzeTask(context.Background(), taskID+1)
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+	})
+
+	s.Run("drop on worker failed", func() {
+		workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true).Once()
+		in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Status(errors.New("mock")), nil).Once()
+		err := scheduler.cancelAnalyzeTask(context.Background(), taskID)
+		s.Error(err)
+		s.NotNil(scheduler.getTask(taskID))
+		s.Equal(indexpb.JobState_JobStateInProgress, am.GetTask(taskID).GetState())
+	})
+
+	s.Run("success", func() {
+		workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true).Once()
+		in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		err := scheduler.cancelAnalyzeTask(context.Background(), taskID)
+		s.NoError(err)
+		s.Nil(scheduler.getTask(taskID))
+		s.Equal(indexpb.JobState_JobStateFailed, am.GetTask(taskID).GetState())
+	})
+}
+
+func (s *taskSchedulerSuite) Test_forceTask() {
+	in := mocks.NewMockIndexNodeClient(s.T())
+	workerManager := NewMockWorkerManager(s.T())
+	scheduler := &taskScheduler{
+		ctx:         context.Background(),
+		tasks:       make(map[int64]Task),
+		nodeManager: workerManager,
+	}
+	newTask := func(taskID UniqueID, nodeID UniqueID, state indexpb.JobState) *analyzeTask {
+		task := &analyzeTask{
+			taskID:   taskID,
+			nodeID:   nodeID,
+			taskInfo: &indexpb.AnalyzeResult{TaskID: taskID, State: state},
+		}
+		scheduler.tasks[taskID] = task
+		return task
+	}
+	running := newTask(1, s.nodeID, indexpb.JobState_JobStateInProgress)
+	pending := newTask(2, 0, indexpb.JobState_JobStateInit)
+	finished := newTask(3, s.nodeID, indexpb.JobState_JobStateFinished)
+
+	events := make([]*TaskEvent, 0)
+	scheduler.RegisterTaskEventHandler("audit", func(event *TaskEvent) {
+		events = append(events, event)
+	})
+	queryResult := func(state indexpb.JobState) {
+		in.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(&indexpb.QueryJobsV2Response{
+			Status: merr.Success(),
+			Result: &indexpb.QueryJobsV2Response_AnalyzeJobResults{
+				AnalyzeJobResults: &indexpb.AnalyzeResults{
+					Results: []*indexpb.AnalyzeResult{{TaskID: running.GetTaskID(), State: state}},
+				},
+			},
+		}, nil).Once()
+	}
+
+	s.Run("invalid request", func() {
+		err := scheduler.forceFailTask(context.Background(), running.GetTaskID(), "")
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+		err = scheduler.forceFailTask(context.Background(), 10, "stuck")
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+		err = scheduler.forceCompleteTask(context.Background(), pending.GetTaskID(), "stuck")
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+		s.Empty(events)
+	})
+
+	s.Run("complete without finished result", func() {
+		workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true).Once()
+		queryResult(indexpb.JobState_JobStateInProgress)
+		err := scheduler.forceCompleteTask(context.Background(), running.GetTaskID(), "stuck")
+		s.ErrorIs(err, merr.ErrParameterInvalid)
+		s.Equal(indexpb.JobState_JobStateInProgress, running.GetState())
+		s.Equal(s.nodeID, running.GetNodeID())
+	})
+
+	s.Run("complete with finished result", func() {
+		workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true).Twice()
+		queryResult(indexpb.JobState_JobStateFinished)
+		in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		err := scheduler.forceCompleteTask(context.Background(), running.GetTaskID(), "stuck")
+		s.NoError(err)
+		s.Equal(indexpb.JobState_JobStateFinished, running.GetState())
+		s.Equal(int64(0), running.GetNodeID())
+		s.Require().Len(events, 1)
+		s.Equal(TaskEventForceCompleted, events[0].Type)
+		s.Equal("stuck", events[0].Reason)
+	})
+
+	s.Run("complete finished task failing to drop", func() {
+		workerManager.EXPECT().GetClientByID(s.nodeID).Return(in, true).Once()
+		in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Status(errors.New("mock")), nil).Once()
+		err := scheduler.forceCompleteTask(context.Background(), finished.GetTaskID(), "stuck")
+		s.NoError(err)
+		s.Equal(int64(0), finished.GetNodeID())
+	})
+
+	s.Run("fail", func() {
+		err := scheduler.forceFailTask(context.Background(), pending.GetTaskID(), "bad data")
+		s.NoError(err)
+		s.Equal(indexpb.JobState_JobStateFailed, pending.GetState())
+		s.Equal("force failed: bad data", pending.GetFailReason())
+		s.Equal(TaskEventForceFailed, events[len(events)-1].Type)
+		s.Equal("ForceFailed", events[len(events)-1].Type.String())
+	})
+}
 func (s *taskSchedulerSuite) Test_analyzeTaskFailCase() {
 	s.Run("segment info is nil", func() {
 		ctx := context.Background()
 
 		catalog := catalogmocks.NewDataCoordCatalog(s.T())
 		workerManager := NewMockWorkerManager(s.T())
+		workerManager.EXPECT().ReportResult(mock.Anything, mock.Anything).Return().Maybe()
 
 		mt := createMeta(catalog,
 			&analyzeMeta{
@@ -945,7 +1842,6 @@ func (s *taskSchedulerSuite) Test_analyzeTaskFailCase() {
 				},
 			},
 			&indexMeta{
-				RWMutex: sync.RWMutex{},
 				ctx:     ctx,
 				catalog: catalog,
 			})
@@ -985,9 +1881,9 @@ func (s *taskSchedulerSuite) Test_analyzeTaskFailCase() {
 		in := mocks.NewMockIndexNodeClient(s.T())
 
 		workerManager := NewMockWorkerManager(s.T())
+		workerManager.EXPECT().ReportResult(mock.Anything, mock.Anything).Return().Maybe()
 
 		mt := createMeta(catalog, s.createAnalyzeMeta(catalog), &indexMeta{
-			RWMutex: sync.RWMutex{},
 			ctx:     ctx,
 			catalog: catalog,
 		})
@@ -1025,10 +1921,10 @@ func (s *taskSchedulerSuite) Test_analyzeTaskFailCase() {
 		in.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
 
 		// pick client fail --> state: init
-		workerManager.EXPECT().PickClient().Return(0, nil).Once()
+		workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{}).Once()
 
 		// update version failed --> state: init
-		workerManager.EXPECT().PickClient().Return(s.nodeID, in)
+		workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{s.nodeID: {NodeID: s.nodeID, Client: in, Slots: 100}})
 		catalog.EXPECT().SaveAnalyzeTask(mock.Anything, mock.Anything).Return(errors.New("catalog update version error")).Once()
 
 		// assign task to indexNode fail --> state: retry
@@ -1219,6 +2115,7 @@ func (s *taskSchedulerSuite) Test_indexTaskFailCase() {
 		catalog := catalogmocks.NewDataCoordCatalog(s.T())
 		in := mocks.NewMockIndexNodeClient(s.T())
 		workerManager := NewMockWorkerManager(s.T())
+		workerManager.EXPECT().ReportResult(mock.Anything, mock.Anything).Return().Maybe()
 
 		mt := createMeta(catalog,
 			&analyzeMeta{
@@ -1226,7 +2123,6 @@ func (s *taskSchedulerSuite) Test_indexTaskFailCase() {
 				catalog: catalog,
 			},
 			&indexMeta{
-				RWMutex: sync.RWMutex{},
 				ctx:     ctx,
 				catalog: catalog,
 				indexes: map[UniqueID]map[UniqueID]*model.Index{
@@ -1336,7 +2232,7 @@ func (s *taskSchedulerSuite) Test_indexTaskFailCase() {
 		s.NoError(err)
 
 		// assign failed --> retry
-		workerManager.EXPECT().PickClient().Return(s.nodeID, in).Once()
+		workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{s.nodeID: {NodeID: s.nodeID, Client: in, Slots: 100}}).Once()
 		catalog.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(nil).Once()
 		handler.EXPECT().GetCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, i int64) (*collectionInfo, error) {
 			Params.Reset("common.storage.scheme")
@@ -1352,11 +2248,12 @@ func (s *taskSchedulerSuite) Test_indexTaskFailCase() {
 		}).Once()
 		in.EXPECT().CreateJobV2(mock.Anything, mock.Anything).Return(nil, errors.New("mock error")).Once()
 
-		// retry --> init
+		// retry --> init, record the retry
 		workerManager.EXPECT().GetClientByID(mock.Anything).Return(nil, false).Once()
+		catalog.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(nil).Once()
 
 		// init --> inProgress
-		workerManager.EXPECT().PickClient().Return(s.nodeID, in).Once()
+		workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{s.nodeID: {NodeID: s.nodeID, Client: in, Slots: 100}}).Once()
 		catalog.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(nil).Twice()
 		handler.EXPECT().GetCollection(mock.Anything, mock.Anything).Return(&collectionInfo{
 			ID: collID,
@@ -1411,6 +2308,237 @@ func (s *taskSchedulerSuite) Test_indexTaskFailCase() {
 	})
 }
 
+func (s *taskSchedulerSuite) Test_reloadScheduleDuration() {
+	scheduler := &taskScheduler{
+		ctx:                  context.Background(),
+		scheduleDuration:     s.duration,
+		scheduleDurationChan: make(chan time.Duration, 1),
+	}
+	key := Params.DataCoordCfg.IndexTaskSchedulerInterval.Key
+
+	scheduler.scheduleDurationHandler(&config.Event{HasUpdated: true, Key: key, Value: "200"})
+	// the pending one not applied yet is replaced
+	scheduler.scheduleDurationHandler(&config.Event{HasUpdated: true, Key: key, Value: "300"})
+	for _, value := range []string{"0", "-1", "abc"} {
+		scheduler.scheduleDurationHandler(&config.Event{HasUpdated: true, Key: key, Value: value})
+	}
+	s.Equal(300*time.Millisecond, <-scheduler.scheduleDurationChan)
+	s.Len(scheduler.scheduleDurationChan, 0)
+	s.Equal(s.duration, scheduler.scheduleDuration)
+}
+
+func (s *taskSchedulerSuite) Test_drain() {
+	drainCheckInterval = 10 * time.Millisecond
+	defer func() {
+		drainCheckInterval = time.Second
+	}()
+	newTask := func(taskID int64, state indexpb.JobState) *analyzeTask {
+		return &analyzeTask{taskID: taskID, taskInfo: &indexpb.AnalyzeResult{TaskID: taskID, State: state}}
+	}
+	newScheduler := func(tasks ...Task) *taskScheduler {
+		ctx, cancel := context.WithCancel(context.Background())
+		scheduler := &taskScheduler{
+			ctx:    ctx,
+			cancel: cancel,
+			tasks:  make(map[int64]Task),
+			meta: &meta{analyzeMeta: &analyzeMeta{
+				ctx:   context.Background(),
+				tasks: map[int64]*indexpb.AnalyzeTask{2: {TaskID: 2, State: indexpb.JobState_JobStateInit}},
+			}},
+		}
+		scheduler.configHandler = config.NewHandler("datacoord.taskScheduler.interval", scheduler.scheduleDurationHandler)
+		for _, task := range tasks {
+			scheduler.tasks[task.GetTaskID()] = task
+		}
+		return scheduler
+	}
+
+	s.Run("drained", func() {
+		inProgress := newTask(1, indexpb.JobState_JobStateInProgress)
+		scheduler := newScheduler(inProgress, newTask(2, indexpb.JobState_JobStateInit))
+		s.Equal(1, scheduler.countDrainingTasks())
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			scheduler.Drain(time.Minute)
+		}()
+		s.Eventually(func() bool {
+			return scheduler.draining.Load()
+		}, time.Second, 10*time.Millisecond)
+
+		// new tasks are not accepted, and the queued ones are not assigned
+		scheduler.enqueue(newTask(3, indexpb.JobState_JobStateInit))
+		s.Nil(scheduler.getTask(3))
+		s.True(scheduler.process(2, &dispatchSlots{}))
+		s.Equal(indexpb.JobState_JobStateInit, scheduler.getTask(2).GetState())
+
+		scheduler.removeTask(1)
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			s.Fail("drain not done")
+		}
+		s.Error(scheduler.ctx.Err())
+	})
+
+	s.Run("timeout", func() {
+		scheduler := newScheduler(newTask(1, indexpb.JobState_JobStateFinished))
+		scheduler.Drain(50 * time.Millisecond)
+		s.Error(scheduler.ctx.Err())
+		s.NotNil(scheduler.getTask(1))
+	})
+}
+
+func (s *taskSchedulerSuite) Test_fairShareTasks() {
+	weights := map[indexpb.JobType]int{
+		indexpb.JobType_JobTypeIndexJob:   3,
+		indexpb.JobType_JobTypeAnalyzeJob: 1,
+	}
+	s.Equal([]UniqueID{1, 2, 10, 3, 4, 5, 11}, fairShareTasks(map[indexpb.JobType][]UniqueID{
+		indexpb.JobType_JobTypeIndexJob:   {1, 2, 3, 4, 5},
+		indexpb.JobType_JobTypeAnalyzeJob: {10, 11},
+	}, weights))
+
+	s.Equal([]UniqueID{1, 2}, fairShareTasks(map[indexpb.JobType][]UniqueID{
+		indexpb.JobType_JobTypeIndexJob: {1, 2},
+	}, weights))
+	s.Empty(fairShareTasks(map[indexpb.JobType][]UniqueID{}, weights))
+
+	// the weight less than 1 is taken as 1
+	s.Equal([]UniqueID{1, 10, 2, 11, 3}, fairShareTasks(map[indexpb.JobType][]UniqueID{
+		indexpb.JobType_JobTypeIndexJob:   {1, 2, 3},
+		indexpb.JobType_JobTypeAnalyzeJob: {10, 11},
+	}, map[indexpb.JobType]int{indexpb.JobType_JobTypeIndexJob: 0}))
+}
+
+func (s *taskSchedulerSuite) Test_tenantQuota() {
+	paramtable.Get().Save(Params.DataCoordCfg.TenantMaxConcurrentTasks.Key, "1")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.TenantMaxConcurrentTasks.Key)
+
+	mt := &meta{
+		collections: newCollectionInfos(map[UniqueID]*collectionInfo{
+			1: {ID: 1, DatabaseName: "db1"},
+			2: {ID: 2, DatabaseName: "db2"},
+			3: {ID: 3, DatabaseName: "db3"},
+		}),
+		analyzeMeta: &analyzeMeta{
+			ctx: context.Background(),
+			tasks: map[int64]*indexpb.AnalyzeTask{
+				1: {TaskID: 1, CollectionID: 1},
+				2: {TaskID: 2, CollectionID: 1},
+				3: {TaskID: 3, CollectionID: 2},
+				4: {TaskID: 4, CollectionID: 3},
+				5: {TaskID: 5, CollectionID: 4},
+			},
+		},
+	}
+	newTask := func(taskID int64, state indexpb.JobState) Task {
+		return &analyzeTask{taskID: taskID, taskInfo: &indexpb.AnalyzeResult{TaskID: taskID, State: state}}
+	}
+	scheduler := &taskScheduler{
+		ctx:   context.Background(),
+		meta:  mt,
+		tasks: make(map[int64]Task),
+		// db1 and db3 belong to the same tenant
+		databaseQuotas: &databaseQuotas{databases: map[string]*cachedDatabase{
+			"db1": {quota: databaseQuota{analyzeTasks: 1}, tenant: "tenant1", expireAt: time.Now().Add(time.Hour)},
+			"db2": {quota: databaseQuota{indexTasks: 1}, expireAt: time.Now().Add(time.Hour)},
+			"db3": {tenant: "tenant1", expireAt: time.Now().Add(time.Hour)},
+		}},
+	}
+	scheduler.enqueue(newTask(1, indexpb.JobState_JobStateInProgress))
+	scheduler.enqueue(newTask(2, indexpb.JobState_JobStateInit))
+	scheduler.enqueue(newTask(3, indexpb.JobState_JobStateInit))
+	scheduler.enqueue(newTask(4, indexpb.JobState_JobStateInit))
+	// the collection of the task is not cached yet
+	scheduler.enqueue(newTask(5, indexpb.JobState_JobStateInit))
+
+	s.Run("tenant of the collection", func() {
+		tenant, dbName, ok := scheduler.getTenant(1)
+		s.True(ok)
+		s.Equal("tenant1", tenant)
+		s.Equal("db1", dbName)
+		// the database without TenantID is the tenant itself
+		tenant, dbName, ok = scheduler.getTenant(2)
+		s.True(ok)
+		s.Equal("db2", tenant)
+		s.Equal("db2", dbName)
+		_, _, ok = scheduler.getTenant(4)
+		s.False(ok)
+	})
+
+	s.Run("count queued tasks", func() {
+		s.Equal(3, scheduler.countTenantTasks("tenant1"))
+		s.Equal(1, scheduler.countTenantTasks("db2"))
+		s.Equal(1, scheduler.countTenantTasks(""))
+		s.Equal(0, scheduler.countTenantTasks("db1"))
+		s.Equal([]UniqueID{5}, scheduler.quotaUsage.listUnresolved())
+
+		// the tenant is resolved once the collection is cached
+		mt.collections.Insert(4, &collectionInfo{ID: 4, DatabaseName: "db2"})
+		scheduler.resolveTenants()
+		s.Equal(2, scheduler.countTenantTasks("db2"))
+		s.Equal(0, scheduler.countTenantTasks(""))
+		s.Empty(scheduler.quotaUsage.listUnresolved())
+		s.Equal("db2", scheduler.quotaUsage.getDatabase(5))
+	})
+
+	s.Run("concurrent tasks quota", func() {
+		s.Equal(1, scheduler.quotaUsage.countRunning("tenant1"))
+		s.True(scheduler.reachTenantQuota(2))
+		s.True(scheduler.reachTenantQuota(4))
+		s.False(scheduler.reachTenantQuota(3))
+		scheduler.quotaUsage.setRunning(3, true)
+		s.True(scheduler.reachTenantQuota(3))
+		scheduler.quotaUsage.setRunning(3, false)
+		s.False(scheduler.reachTenantQuota(3))
+
+		// the quota is released once the task is removed
+		scheduler.removeTask(1)
+		s.False(scheduler.reachTenantQuota(2))
+		s.Equal(2, scheduler.countTenantTasks("tenant1"))
+		scheduler.enqueue(newTask(1, indexpb.JobState_JobStateInProgress))
+
+		// the quota is not limited
+		paramtable.Get().Save(Params.DataCoordCfg.TenantMaxConcurrentTasks.Key, "0")
+		s.False(scheduler.reachTenantQuota(2))
+		paramtable.Get().Save(Params.DataCoordCfg.TenantMaxConcurrentTasks.Key, "1")
+	})
+
+	s.Run("database quota", func() {
+		// task 1 of db1 is in progress
+		s.True(scheduler.reachDatabaseQuota(2, indexpb.JobType_JobTypeAnalyzeJob))
+		// the quota of the index tasks of db1 is not limited
+		s.False(scheduler.reachDatabaseQuota(2, indexpb.JobType_JobTypeIndexJob))
+		// the quota of the analyze tasks of db2 is not limited
+		scheduler.quotaUsage.setRunning(3, true)
+		s.False(scheduler.reachDatabaseQuota(5, indexpb.JobType_JobTypeAnalyzeJob))
+		s.Equal(1, scheduler.quotaUsage.countDatabaseRunning("db2", indexpb.JobType_JobTypeAnalyzeJob))
+		s.Equal(map[string]map[indexpb.JobType]int{
+			"db1": {indexpb.JobType_JobTypeAnalyzeJob: 1},
+			"db2": {indexpb.JobType_JobTypeAnalyzeJob: 1},
+		}, scheduler.countDatabaseTasks())
+		scheduler.quotaUsage.setRunning(3, false)
+		s.Equal(map[string]map[indexpb.JobType]int{
+			"db1": {indexpb.JobType_JobTypeAnalyzeJob: 1},
+		}, scheduler.countDatabaseTasks())
+
+		// the quota is not limited
+		quotas := scheduler.databaseQuotas
+		scheduler.databaseQuotas = nil
+		s.False(scheduler.reachDatabaseQuota(2, indexpb.JobType_JobTypeAnalyzeJob))
+		scheduler.databaseQuotas = quotas
+	})
+
+	s.Run("interleave tenants", func() {
+		s.Equal([]UniqueID{1, 10, 2, 3}, fairShareTasks(map[string][]UniqueID{
+			"db1": {1, 2, 3},
+			"db2": {10},
+		}, nil))
+	})
+}
+
 func Test_taskSchedulerSuite(t *testing.T) {
 	suite.Run(t, new(taskSchedulerSuite))
 }
@@ -1422,7 +2550,8 @@ func (s *taskSchedulerSuite) Test_indexTaskWithMvOptionalScalarField() {
 	in := mocks.NewMockIndexNodeClient(s.T())
 
 	workerManager := NewMockWorkerManager(s.T())
-	workerManager.EXPECT().PickClient().Return(s.nodeID, in)
+	workerManager.EXPECT().ReportResult(mock.Anything, mock.Anything).Return().Maybe()
+	workerManager.EXPECT().QuerySlots().Return(map[UniqueID]*WorkerSlots{s.nodeID: {NodeID: s.nodeID, Client: in, Slots: 100}})
 	workerManager.EXPECT().GetClientByID(mock.Anything).Return(in, true)
 
 	minNumberOfRowsToBuild := paramtable.Get().DataCoordCfg.MinSegmentNumRowsToEnableIndex.GetAsInt64() + 1
@@ -1457,7 +2586,7 @@ func (s *taskSchedulerSuite) Test_indexTaskWithMvOptionalScalarField() {
 	}
 	mt := meta{
 		catalog: catalog,
-		collections: map[int64]*collectionInfo{
+		collections: newCollectionInfos(map[int64]*collectionInfo{
 			collID: {
 				ID: collID,
 				Schema: &schemapb.CollectionSchema{
@@ -1465,13 +2594,18 @@ func (s *taskSchedulerSuite) Test_indexTaskWithMvOptionalScalarField() {
 				},
 				CreatedAt: 0,
 			},
-		},
+		}),
 
 		analyzeMeta: &analyzeMeta{
 			ctx:     context.Background(),
 			catalog: catalog,
 		},
 
+		statsMeta: &statsMeta{
+			ctx:     context.Background(),
+			catalog: catalog,
+		},
+
 		indexMeta: &indexMeta{
 			catalog: catalog,
 			indexes: map[UniqueID]map[UniqueID]*model.Index{
@@ -1595,9 +2729,9 @@ func (s *taskSchedulerSuite) Test_indexTaskWithMvOptionalScalarField() {
 		mt.indexMeta.buildID2SegmentIndex[buildID].IndexState = commonpb.IndexState_Unissued
 		mt.indexMeta.segmentIndexes[segID][indexID].IndexState = commonpb.IndexState_Unissued
 		mt.indexMeta.indexes[collID][indexID].IndexParams[1].Value = indexparamcheck.IndexHNSW
-		mt.collections[collID].Schema.Fields[0].DataType = schemapb.DataType_FloatVector
-		mt.collections[collID].Schema.Fields[1].IsPartitionKey = true
-		mt.collections[collID].Schema.Fields[1].DataType = schemapb.DataType_VarChar
+		mt.GetCollection(collID).Schema.Fields[0].DataType = schemapb.DataType_FloatVector
+		mt.GetCollection(collID).Schema.Fields[1].IsPartitionKey = true
+		mt.GetCollection(collID).Schema.Fields[1].DataType = schemapb.DataType_VarChar
 	}
 
 	in.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).RunAndReturn(
@@ -1656,7 +2790,7 @@ func (s *taskSchedulerSuite) Test_indexTaskWithMvOptionalScalarField() {
 			schemapb.DataType_VarChar,
 			schemapb.DataType_String,
 		} {
-			mt.collections[collID].Schema.Fields[1].DataType = dataType
+			mt.GetCollection(collID).Schema.Fields[1].DataType = dataType
 			in.EXPECT().CreateJobV2(mock.Anything, mock.Anything).RunAndReturn(
 				func(ctx context.Context, in *indexpb.CreateJobV2Request, opts ...grpc.CallOption) (*commonpb.Status, error) {
 					s.NotZero(len(in.GetIndexRequest().OptionalScalarFields), "optional scalar field should be set")
@@ -1705,7 +2839,7 @@ func (s *taskSchedulerSuite) Test_indexTaskWithMvOptionalScalarField() {
 			schemapb.DataType_BinaryVector,
 			schemapb.DataType_SparseFloatVector,
 		} {
-			mt.collections[collID].Schema.Fields[0].DataType = dataType
+			mt.GetCollection(collID).Schema.Fields[0].DataType = dataType
 			in.EXPECT().CreateJobV2(mock.Anything, mock.Anything).RunAndReturn(
 				func(ctx context.Context, in *indexpb.CreateJobV2Request, opts ...grpc.CallOption) (*commonpb.Status, error) {
 					s.Zero(len(in.GetIndexRequest().OptionalScalarFields), "optional scalar field should not be set")
@@ -1735,7 +2869,7 @@ func (s *taskSchedulerSuite) Test_indexTaskWithMvOptionalScalarField() {
 			schemapb.DataType_Array,
 			schemapb.DataType_JSON,
 		} {
-			mt.collections[collID].Schema.Fields[1].DataType = dataType
+			mt.GetCollection(collID).Schema.Fields[1].DataType = dataType
 			in.EXPECT().CreateJobV2(mock.Anything, mock.Anything).RunAndReturn(
 				func(ctx context.Context, in *indexpb.CreateJobV2Request, opts ...grpc.CallOption) (*commonpb.Status, error) {
 					s.Zero(len(in.GetIndexRequest().OptionalScalarFields), "optional scalar field should not be set")
@@ -1758,7 +2892,7 @@ func (s *taskSchedulerSuite) Test_indexTaskWithMvOptionalScalarField() {
 
 	s.Run("enqueue returns empty optional field when no partition key", func() {
 		paramtable.Get().CommonCfg.EnableMaterializedView.SwapTempValue("true")
-		mt.collections[collID].Schema.Fields[1].IsPartitionKey = false
+		mt.GetCollection(collID).Schema.Fields[1].IsPartitionKey = false
 		in.EXPECT().CreateJobV2(mock.Anything, mock.Anything).RunAndReturn(
 			func(ctx context.Context, in *indexpb.CreateJobV2Request, opts ...grpc.CallOption) (*commonpb.Status, error) {
 				s.Zero(len(in.GetIndexRequest().OptionalScalarFields), "optional scalar field should not be set")
@@ -0,0 +1,264 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/compatutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// speculativeVersionOffset is added to the index version of the straggling build as the version of its speculative
+// duplicate, so that the duplicate writes the index files apart from the straggler and the later retries.
+// The version is persisted only if the duplicate finishes first, the files of the lost one are recycled by the GC.
+const speculativeVersionOffset = 1 << 32
+
+// maxBuildLatencySamples is the max number of the recent build latencies kept for each kind of the builds.
+const maxBuildLatencySamples = 200
+
+// speculativeBuild is the duplicate of the straggling index build on another worker.
+type speculativeBuild struct {
+	nodeID    int64
+	version   int64
+	startTime time.Time
+}
+
+// buildKind groups the similar index builds to compare their latencies,
+// the builds of the same index type and the same magnitude of rows are similar.
+func buildKind(indexType string, numRows int64) string {
+	return fmt.Sprintf("%s-%d", indexType, bits.Len64(uint64(numRows)))
+}
+
+// buildLatencies keeps the recent latencies of the finished index builds by the kind of the builds,
+// it's accessed by the schedule loop only.
+type buildLatencies map[string][]time.Duration
+
+func (l buildLatencies) record(kind string, latency time.Duration) {
+	samples := append(l[kind], latency)
+	if len(samples) > maxBuildLatencySamples {
+		samples = samples[len(samples)-maxBuildLatencySamples:]
+	}
+	l[kind] = samples
+}
+
+// p95 returns the p95 latency of the kind of the builds, false if there are not enough samples.
+func (l buildLatencies) p95(kind string) (time.Duration, bool) {
+	samples := l[kind]
+	if len(samples) == 0 || len(samples) < Params.DataCoordCfg.SpeculativeIndexBuildMinSamples.GetAsInt() {
+		return 0, false
+	}
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted[int(math.Ceil(float64(len(sorted))*0.95))-1], true
+}
+
+// speculationQuota returns the number of the speculative duplicates allowed to launch in this round,
+// no duplicate is launched while there are tasks waiting for the workers.
+func speculationQuota(tasks []Task) int {
+	if !Params.DataCoordCfg.SpeculativeIndexBuildEnabled.GetAsBool() {
+		return 0
+	}
+	running, speculating := 0, 0
+	for _, task := range tasks {
+		switch task.GetState() {
+		case indexpb.JobState_JobStateInit:
+			return 0
+		case indexpb.JobState_JobStateInProgress:
+			if it, ok := task.(*indexBuildTask); ok {
+				running++
+				if it.speculative != nil {
+					speculating++
+				}
+			}
+		}
+	}
+	limit := int(float64(running) * Params.DataCoordCfg.SpeculativeIndexBuildMaxRatio.GetAsFloat())
+	return max(limit-speculating, 0)
+}
+
+// recordBuildLatency records the latency of the finished index build on the worker.
+func (s *taskScheduler) recordBuildLatency(task Task) {
+	it, ok := task.(*indexBuildTask)
+	if !ok || it.kind == "" || task.GetState() != indexpb.JobState_JobStateFinished {
+		return
+	}
+	if latency, ok := it.timing.buildLatency(); ok {
+		if s.buildLatencies == nil {
+			s.buildLatencies = make(buildLatencies)
+		}
+		s.buildLatencies.record(it.kind, latency)
+	}
+}
+
+// speculate checks the in progress index build after its result is queried. The straggling build is duplicated
+// on another worker with free slots, and the first finished one of the build and its duplicate is taken.
+// The duplicate is dropped if the build ends first, or the duplicate fails.
+func (s *taskScheduler) speculate(task Task, slots *dispatchSlots) {
+	it, ok := task.(*indexBuildTask)
+	if !ok {
+		return
+	}
+	if it.speculative == nil {
+		if it.GetState() == indexpb.JobState_JobStateInProgress && slots.speculationQuota > 0 && s.isStraggling(it) {
+			if s.launchSpeculation(it, slots) {
+				slots.speculationQuota--
+			}
+		}
+		return
+	}
+
+	log := log.Ctx(s.ctx).With(zap.Int64("taskID", it.taskID), zap.Int64("nodeID", it.nodeID),
+		zap.Int64("speculativeNodeID", it.speculative.nodeID))
+	switch it.GetState() {
+	case indexpb.JobState_JobStateInProgress:
+	case indexpb.JobState_JobStateFinished:
+		log.Info("straggling index build finishes before its speculative duplicate")
+		// restore the version of the build bumped by the duplicate
+		s.meta.indexMeta.TakeBuildAttempt(it.taskID, it.nodeID, it.req.GetIndexVersion())
+		s.dropSpeculation(task)
+		return
+	default:
+		s.dropSpeculation(task)
+		return
+	}
+
+	client, exist := s.nodeManager.GetClientByID(it.speculative.nodeID)
+	if !exist {
+		log.Info("worker of the speculative index build is gone")
+		it.speculative = nil
+		return
+	}
+	result, err := querySpeculation(s.ctx, client, it.taskID)
+	s.nodeManager.ReportResult(it.speculative.nodeID, err == nil)
+	if err != nil {
+		log.Warn("failed to query the speculative index build", zap.Error(err))
+		return
+	}
+	switch result.GetState() {
+	case commonpb.IndexState_Finished:
+		log.Info("speculative index build finishes first, take its result and drop the straggler")
+		if client, exist := s.nodeManager.GetClientByID(it.nodeID); exist {
+			it.DropTaskOnWorker(s.ctx, client)
+		}
+		s.meta.indexMeta.TakeBuildAttempt(it.taskID, it.speculative.nodeID, it.speculative.version)
+		it.nodeID = it.speculative.nodeID
+		// the timing follows the duplicate taken
+		it.timing.nodeStartTime = it.speculative.startTime
+		it.timing.completeTime = time.Now()
+		it.setResult(result)
+		it.speculative = nil
+		metrics.DataCoordSpeculativeIndexBuildCounter.WithLabelValues(metrics.SuccessLabel).Inc()
+	case commonpb.IndexState_Unissued, commonpb.IndexState_InProgress:
+	default:
+		log.Info("speculative index build fails, keep the straggler building", zap.String("state", result.GetState().String()),
+			zap.String("failReason", result.GetFailReason()))
+		s.dropSpeculation(task)
+	}
+}
+
+// isStraggling checks whether the build has been building far longer than the similar builds.
+func (s *taskScheduler) isStraggling(it *indexBuildTask) bool {
+	if it.req == nil || it.kind == "" || it.timing.nodeStartTime.IsZero() {
+		return false
+	}
+	p95, ok := s.buildLatencies.p95(it.kind)
+	if !ok {
+		return false
+	}
+	threshold := time.Duration(float64(p95) * Params.DataCoordCfg.SpeculativeIndexBuildSlowRatio.GetAsFloat())
+	return time.Since(it.timing.nodeStartTime) > threshold
+}
+
+// launchSpeculation assigns the duplicate of the straggling build to another worker with free slots,
+// the version of the duplicate is kept in memory until it finishes first.
+func (s *taskScheduler) launchSpeculation(it *indexBuildTask, slots *dispatchSlots) bool {
+	nodeID, client := s.pickWorkerExcept(slots, it.nodeID, it.GetTaskSlot(), it.GetTaskCost())
+	if client == nil {
+		return false
+	}
+	log := log.Ctx(s.ctx).With(zap.Int64("taskID", it.taskID), zap.Int64("nodeID", it.nodeID),
+		zap.Int64("speculativeNodeID", nodeID))
+	req := proto.Clone(it.req).(*indexpb.CreateJobRequest)
+	req.IndexVersion += speculativeVersionOffset
+
+	ctx, cancel := context.WithTimeout(s.ctx, reqTimeoutInterval)
+	defer cancel()
+	err := compatutil.CreateIndexJob(ctx, client, req)
+	s.nodeManager.ReportResult(nodeID, err == nil)
+	if err != nil {
+		log.Warn("failed to assign the speculative index build", zap.Error(err))
+		return false
+	}
+	it.speculative = &speculativeBuild{
+		nodeID:    nodeID,
+		version:   req.GetIndexVersion(),
+		startTime: time.Now(),
+	}
+	log.Info("launch the speculative duplicate of the straggling index build",
+		zap.Duration("elapsed", time.Since(it.timing.nodeStartTime)))
+	metrics.DataCoordSpeculativeIndexBuildCounter.WithLabelValues(metrics.TotalLabel).Inc()
+	return true
+}
+
+// dropSpeculation drops the speculative duplicate of the build on its worker, the duplicate failed to drop
+// is left to be recycled with the worker.
+func (s *taskScheduler) dropSpeculation(task Task) {
+	it, ok := task.(*indexBuildTask)
+	if !ok || it.speculative == nil {
+		return
+	}
+	nodeID := it.speculative.nodeID
+	it.speculative = nil
+	client, exist := s.nodeManager.GetClientByID(nodeID)
+	if !exist {
+		return
+	}
+	if err := compatutil.DropIndexJobs(s.ctx, client, Params.CommonCfg.ClusterPrefix.GetValue(), []UniqueID{it.taskID}); err != nil {
+		log.Ctx(s.ctx).Warn("failed to drop the speculative index build", zap.Int64("taskID", it.taskID),
+			zap.Int64("speculativeNodeID", nodeID), zap.Error(err))
+		return
+	}
+	log.Ctx(s.ctx).Info("drop the speculative index build", zap.Int64("taskID", it.taskID),
+		zap.Int64("speculativeNodeID", nodeID))
+}
+
+func querySpeculation(ctx context.Context, client types.IndexNodeClient, taskID UniqueID) (*indexpb.IndexTaskInfo, error) {
+	results, err := compatutil.QueryIndexJobs(ctx, client, Params.CommonCfg.ClusterPrefix.GetValue(), []UniqueID{taskID})
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range results {
+		if info.GetBuildID() == taskID {
+			return info, nil
+		}
+	}
+	return &indexpb.IndexTaskInfo{BuildID: taskID, State: commonpb.IndexState_IndexStateNone}, nil
+}
@@ -0,0 +1,365 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var _ Task = (*statsTask)(nil)
+
+type statsTask struct {
+	taskID    int64
+	segmentID int64
+	nodeID    int64
+	taskInfo  *indexpb.StatsResult
+
+	req *indexpb.StatsRequest
+
+	// startTime is the time the task is in progress on the worker
+	startTime time.Time
+	// timing is the timing breakdown of the current attempt
+	timing taskTiming
+}
+
+func (st *statsTask) GetTaskID() int64 {
+	return st.taskID
+}
+
+func (st *statsTask) GetNodeID() int64 {
+	return st.nodeID
+}
+
+func (st *statsTask) GetStartTime() time.Time {
+	return st.startTime
+}
+
+func (st *statsTask) SetStartTime(startTime time.Time) {
+	st.startTime = startTime
+}
+
+func (st *statsTask) GetQueueTime() time.Time {
+	return st.timing.enqueueTime
+}
+
+func (st *statsTask) SetQueueTime(queueTime time.Time) {
+	st.timing = taskTiming{enqueueTime: queueTime}
+}
+
+func (st *statsTask) SetDispatchTime(dispatchTime time.Time) {
+	st.timing.dispatchTime = dispatchTime
+}
+
+func (st *statsTask) GetTiming() taskTiming {
+	return st.timing
+}
+
+func (st *statsTask) Describe(mt *meta) *indexpb.ScheduledTask {
+	return &indexpb.ScheduledTask{
+		TaskID:       st.GetTaskID(),
+		Type:         indexpb.JobType_JobTypeStatsJob,
+		State:        st.GetState(),
+		NodeID:       st.GetNodeID(),
+		CollectionID: mt.statsMeta.GetTask(st.GetTaskID()).GetCollectionID(),
+		SegmentID:    st.segmentID,
+		EnqueueTime:  st.timing.enqueueTime.UnixMilli(),
+		FailReason:   st.GetFailReason(),
+		Timing:       st.timing.toProto(),
+	}
+}
+
+func (st *statsTask) GetCollectionID(mt *meta) UniqueID {
+	return mt.statsMeta.GetTask(st.GetTaskID()).GetCollectionID()
+}
+
+func (st *statsTask) ResetNodeID() {
+	st.nodeID = 0
+}
+
+func (st *statsTask) CheckTaskHealthy(mt *meta) bool {
+	t := mt.statsMeta.GetTask(st.GetTaskID())
+	return t != nil && isSegmentHealthy(mt.GetSegment(st.segmentID))
+}
+
+// IsPaused returns false, stats tasks are not pausable.
+func (st *statsTask) IsPaused(mt *meta) bool {
+	return false
+}
+
+// PrepareRetry returns true, stats tasks are retried without limit.
+func (st *statsTask) PrepareRetry(mt *meta) (bool, error) {
+	return true, nil
+}
+
+func (st *statsTask) VerifySource(ctx context.Context, dependency *taskScheduler) {
+}
+
+func (st *statsTask) IsRetryBackoff() bool {
+	return false
+}
+
+func (st *statsTask) SetState(state indexpb.JobState, failReason string) {
+	st.taskInfo.State = state
+	st.taskInfo.FailReason = failReason
+}
+
+func (st *statsTask) GetState() indexpb.JobState {
+	return st.taskInfo.GetState()
+}
+
+func (st *statsTask) GetFailReason() string {
+	return st.taskInfo.GetFailReason()
+}
+
+func (st *statsTask) GetTaskType() indexpb.JobType {
+	return indexpb.JobType_JobTypeStatsJob
+}
+
+func (st *statsTask) GetTaskSlot() int64 {
+	return 1
+}
+
+func (st *statsTask) GetTaskCost() taskCost {
+	return taskCost{}
+}
+
+func (st *statsTask) UpdateVersion(ctx context.Context, nodeID int64, meta *meta) error {
+	if err := meta.statsMeta.UpdateVersion(st.GetTaskID(), nodeID); err != nil {
+		return err
+	}
+	st.nodeID = nodeID
+	st.req.Version = meta.statsMeta.GetTask(st.GetTaskID()).GetVersion()
+	return nil
+}
+
+func (st *statsTask) UpdateMetaBuildingState(nodeID int64, meta *meta) error {
+	meta.statsMeta.UpdateTaskTiming(st.GetTaskID(), st.timing.toProto())
+	if err := meta.statsMeta.BuildingTask(st.GetTaskID(), nodeID); err != nil {
+		return err
+	}
+	st.nodeID = nodeID
+	return nil
+}
+
+func (st *statsTask) PreCheck(ctx context.Context, dependency *taskScheduler) bool {
+	log := log.Ctx(ctx).With(zap.Int64("taskID", st.GetTaskID()), zap.Int64("segmentID", st.segmentID))
+	t := dependency.meta.statsMeta.GetTask(st.GetTaskID())
+	if t == nil {
+		log.Info("task is nil, delete it")
+		st.SetState(indexpb.JobState_JobStateNone, "stats task is nil")
+		return true
+	}
+
+	segment := dependency.meta.GetHealthySegment(st.segmentID)
+	if segment == nil {
+		log.Info("segment is not healthy, skip the stats task")
+		st.SetState(indexpb.JobState_JobStateNone, "segment is not healthy")
+		return true
+	}
+	if segment.GetBinlogsSource() != nil {
+		log.Info("the statistics of the cloned segment are copied from its source, skip the stats task")
+		st.SetState(indexpb.JobState_JobStateFinished, "")
+		return true
+	}
+
+	collInfo, err := dependency.handler.GetCollection(ctx, segment.GetCollectionID())
+	if err != nil || collInfo == nil {
+		log.Info("stats task get collection info failed", zap.Int64("collectionID", segment.GetCollectionID()), zap.Error(err))
+		st.SetState(indexpb.JobState_JobStateInit, fmt.Sprintf("failed to get collection %d", segment.GetCollectionID()))
+		return true
+	}
+
+	// the statistics are computed for the scalar fields, the sparse float vector fields and the float vector clustering key,
+	// the centroid of which tells the drift of the data since the last clustering compaction
+	fieldLogs := make([]*indexpb.FieldLogIDs, 0)
+	for _, field := range collInfo.Schema.GetFields() {
+		if common.IsSystemField(field.GetFieldID()) ||
+			(typeutil.IsVectorType(field.GetDataType()) && !typeutil.IsSparseFloatVectorType(field.GetDataType()) &&
+				!(field.GetIsClusteringKey() && field.GetDataType() == schemapb.DataType_FloatVector)) {
+			continue
+		}
+		logIDs := getBinLogIDs(segment, field.GetFieldID())
+		if len(logIDs) == 0 {
+			continue
+		}
+		fieldLogs = append(fieldLogs, &indexpb.FieldLogIDs{
+			FieldID: field.GetFieldID(),
+			LogIDs:  logIDs,
+		})
+	}
+	if len(fieldLogs) == 0 {
+		log.Info("segment has no field to compute the statistics of, skip the stats task")
+		st.SetState(indexpb.JobState_JobStateFinished, "")
+		return true
+	}
+
+	var storageConfig *indexpb.StorageConfig
+	if Params.CommonCfg.StorageType.GetValue() == "local" {
+		storageConfig = &indexpb.StorageConfig{
+			RootPath:    Params.LocalStorageCfg.Path.GetValue(),
+			StorageType: Params.CommonCfg.StorageType.GetValue(),
+		}
+	} else {
+		storageConfig = &indexpb.StorageConfig{
+			Address:          Params.MinioCfg.Address.GetValue(),
+			AccessKeyID:      Params.MinioCfg.AccessKeyID.GetValue(),
+			SecretAccessKey:  Params.MinioCfg.SecretAccessKey.GetValue(),
+			UseSSL:           Params.MinioCfg.UseSSL.GetAsBool(),
+			SslCACert:        Params.MinioCfg.SslCACert.GetValue(),
+			BucketName:       Params.MinioCfg.BucketName.GetValue(),
+			RootPath:         Params.MinioCfg.RootPath.GetValue(),
+			UseIAM:           Params.MinioCfg.UseIAM.GetAsBool(),
+			IAMEndpoint:      Params.MinioCfg.IAMEndpoint.GetValue(),
+			StorageType:      Params.CommonCfg.StorageType.GetValue(),
+			Region:           Params.MinioCfg.Region.GetValue(),
+			UseVirtualHost:   Params.MinioCfg.UseVirtualHost.GetAsBool(),
+			CloudProvider:    Params.MinioCfg.CloudProvider.GetValue(),
+			RequestTimeoutMs: Params.MinioCfg.RequestTimeoutMs.GetAsInt64(),
+		}
+	}
+	st.req = &indexpb.StatsRequest{
+		ClusterID:     Params.CommonCfg.ClusterPrefix.GetValue(),
+		TaskID:        st.GetTaskID(),
+		CollectionID:  segment.GetCollectionID(),
+		PartitionID:   segment.GetPartitionID(),
+		SegmentID:     segment.GetID(),
+		Version:       t.GetVersion(),
+		StorageConfig: storageConfig,
+		Schema:        collInfo.Schema,
+		NumRows:       segment.GetNumOfRows(),
+		FieldLogs:     fieldLogs,
+	}
+	return false
+}
+
+func (st *statsTask) AssignTask(ctx context.Context, client types.IndexNodeClient) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), reqTimeoutInterval)
+	defer cancel()
+	resp, err := client.CreateJobV2(ctx, &indexpb.CreateJobV2Request{
+		ClusterID: st.req.GetClusterID(),
+		TaskID:    st.req.GetTaskID(),
+		JobType:   indexpb.JobType_JobTypeStatsJob,
+		Request: &indexpb.CreateJobV2Request_StatsRequest{
+			StatsRequest: st.req,
+		},
+	})
+	if err == nil {
+		err = merr.Error(resp)
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn("assign stats task to indexNode failed", zap.Int64("taskID", st.GetTaskID()), zap.Error(err))
+		st.SetState(indexpb.JobState_JobStateRetry, err.Error())
+		return false
+	}
+
+	log.Ctx(ctx).Info("stats task assigned successfully", zap.Int64("taskID", st.GetTaskID()))
+	st.SetState(indexpb.JobState_JobStateInProgress, "")
+	return true
+}
+
+func (st *statsTask) setResult(result *indexpb.StatsResult) {
+	st.taskInfo = result
+}
+
+func (st *statsTask) QueryResult(ctx context.Context, client types.IndexNodeClient) bool {
+	resp, err := client.QueryJobsV2(ctx, &indexpb.QueryJobsV2Request{
+		ClusterID: Params.CommonCfg.ClusterPrefix.GetValue(),
+		TaskIDs:   []int64{st.GetTaskID()},
+		JobType:   indexpb.JobType_JobTypeStatsJob,
+	})
+	if err == nil {
+		err = merr.Error(resp.GetStatus())
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn("query stats task result from IndexNode fail", zap.Int64("nodeID", st.GetNodeID()),
+			zap.Error(err))
+		st.SetState(indexpb.JobState_JobStateRetry, err.Error())
+		return false
+	}
+
+	// infos length is always one.
+	for _, result := range resp.GetStatsJobResults().GetResults() {
+		if result.GetTaskID() == st.GetTaskID() {
+			log.Ctx(ctx).Info("query stats task info successfully",
+				zap.Int64("taskID", st.GetTaskID()), zap.String("result state", result.GetState().String()),
+				zap.String("failReason", result.GetFailReason()))
+			st.timing.setNodeStartTime(result.GetStartTime())
+			if result.GetState() == indexpb.JobState_JobStateFinished || result.GetState() == indexpb.JobState_JobStateFailed ||
+				result.GetState() == indexpb.JobState_JobStateRetry {
+				// state is retry or finished or failed
+				st.timing.completeTime = time.Now()
+				st.setResult(result)
+			} else if result.GetState() == indexpb.JobState_JobStateNone {
+				st.SetState(indexpb.JobState_JobStateRetry, "stats task state is none in info response")
+			}
+			// inProgress or unissued/init, keep InProgress state
+			return true
+		}
+	}
+	log.Ctx(ctx).Warn("query stats task info failed, indexNode does not have task info",
+		zap.Int64("taskID", st.GetTaskID()))
+	st.SetState(indexpb.JobState_JobStateRetry, "stats result is not in info response")
+	return true
+}
+
+func (st *statsTask) UpdateProgress(meta *meta) {
+}
+
+func (st *statsTask) DropTaskOnWorker(ctx context.Context, client types.IndexNodeClient) bool {
+	resp, err := client.DropJobsV2(ctx, &indexpb.DropJobsV2Request{
+		ClusterID: Params.CommonCfg.ClusterPrefix.GetValue(),
+		TaskIDs:   []UniqueID{st.GetTaskID()},
+		JobType:   indexpb.JobType_JobTypeStatsJob,
+	})
+	if err == nil {
+		err = merr.Error(resp)
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn("notify worker drop the stats task fail", zap.Int64("taskID", st.GetTaskID()),
+			zap.Int64("nodeID", st.GetNodeID()), zap.Error(err))
+		return false
+	}
+	log.Ctx(ctx).Info("drop stats task on worker success",
+		zap.Int64("taskID", st.GetTaskID()), zap.Int64("nodeID", st.GetNodeID()))
+	return true
+}
+
+// SetJobInfo persists the statistics computed to the segment meta before finishing the task,
+// so that they are served to the query pruning once the task is finished.
+func (st *statsTask) SetJobInfo(meta *meta) error {
+	if st.GetState() == indexpb.JobState_JobStateFinished && len(st.taskInfo.GetFieldStats()) > 0 {
+		if err := meta.UpdateSegmentsInfo(UpdateFieldStats(st.segmentID, st.taskInfo.GetFieldStats())); err != nil {
+			log.Warn("failed to save the field stats to the segment", zap.Int64("taskID", st.GetTaskID()),
+				zap.Int64("segmentID", st.segmentID), zap.Error(err))
+			return err
+		}
+	}
+	meta.statsMeta.UpdateTaskTiming(st.GetTaskID(), st.timing.toProto())
+	return meta.statsMeta.FinishTask(st.GetTaskID(), st.taskInfo)
+}
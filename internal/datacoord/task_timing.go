@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// taskTiming is the timing breakdown of the current attempt of the task, the stage not reached is the zero time.
+// The queue latency is the scheduling delay in the scheduler, and the build latency is the time the task takes
+// on the worker, the gap between the dispatch time and the node start time is the delay on the worker.
+type taskTiming struct {
+	// enqueueTime is the time the task is enqueued to the scheduler, or reset to be assigned again for the retry
+	enqueueTime time.Time
+	// dispatchTime is the time the task is assigned to the worker
+	dispatchTime time.Time
+	// nodeStartTime is the time the worker starts to execute the task, reported by the worker
+	nodeStartTime time.Time
+	// completeTime is the time the result of the task is found by the scheduler
+	completeTime time.Time
+}
+
+func (t *taskTiming) queueLatency() (time.Duration, bool) {
+	if t.enqueueTime.IsZero() || t.dispatchTime.IsZero() {
+		return 0, false
+	}
+	return t.dispatchTime.Sub(t.enqueueTime), true
+}
+
+func (t *taskTiming) buildLatency() (time.Duration, bool) {
+	if t.nodeStartTime.IsZero() || t.completeTime.IsZero() {
+		return 0, false
+	}
+	return t.completeTime.Sub(t.nodeStartTime), true
+}
+
+// setNodeStartTime records the start time in unix milliseconds reported by the worker, 0 if not started.
+func (t *taskTiming) setNodeStartTime(startTime int64) {
+	if startTime > 0 && t.nodeStartTime.IsZero() {
+		t.nodeStartTime = time.UnixMilli(startTime)
+	}
+}
+
+func (t *taskTiming) toProto() *indexpb.TaskTiming {
+	unixMilli := func(ts time.Time) int64 {
+		if ts.IsZero() {
+			return 0
+		}
+		return ts.UnixMilli()
+	}
+	return &indexpb.TaskTiming{
+		EnqueueTime:   unixMilli(t.enqueueTime),
+		DispatchTime:  unixMilli(t.dispatchTime),
+		NodeStartTime: unixMilli(t.nodeStartTime),
+		CompleteTime:  unixMilli(t.completeTime),
+	}
+}
+
+// observeQueueLatency records the time the task waits in the scheduler before assigned to the worker.
+func observeQueueLatency(task Task) {
+	timing := task.GetTiming()
+	if latency, ok := timing.queueLatency(); ok {
+		metrics.DataCoordIndexTaskQueueLatency.WithLabelValues(task.GetTaskType().String()).
+			Observe(float64(latency.Milliseconds()))
+	}
+}
+
+// observeBuildLatency records the time the ended attempt of the task takes on the worker,
+// the attempt not started on the worker is skipped.
+func observeBuildLatency(task Task, status string) {
+	timing := task.GetTiming()
+	if latency, ok := timing.buildLatency(); ok {
+		metrics.DataCoordIndexTaskBuildLatency.WithLabelValues(task.GetTaskType().String(), status).
+			Observe(float64(latency.Milliseconds()))
+	}
+}
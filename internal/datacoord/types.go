@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/types"
@@ -25,17 +26,50 @@ import (
 
 type Task interface {
 	GetTaskID() int64
+	GetTaskType() indexpb.JobType
 	GetNodeID() int64
+	// GetCollectionID returns the collection the task belongs to, 0 if the task is not in meta.
+	GetCollectionID(mt *meta) UniqueID
 	ResetNodeID()
 	PreCheck(ctx context.Context, dependency *taskScheduler) bool
 	CheckTaskHealthy(mt *meta) bool
+	IsPaused(mt *meta) bool
+	// PrepareRetry records the failed attempt before the task is retried,
+	// it returns false if the task runs out of the retries and is marked as failed.
+	PrepareRetry(mt *meta) (bool, error)
+	// VerifySource verifies the source data of the task after it fails on the worker,
+	// the task is marked as failed if the source is corrupted.
+	VerifySource(ctx context.Context, dependency *taskScheduler)
+	// IsRetryBackoff returns whether the task is backing off before the next attempt.
+	IsRetryBackoff() bool
 	SetState(state indexpb.JobState, failReason string)
 	GetState() indexpb.JobState
 	GetFailReason() string
-	UpdateVersion(ctx context.Context, meta *meta) error
+	// GetTaskSlot returns the number of the worker slots taken by the task.
+	GetTaskSlot() int64
+	// GetTaskCost returns the estimated resources taken by the task on the worker.
+	GetTaskCost() taskCost
+	// UpdateVersion bumps the assignment epoch of the task and checkpoints the worker it's assigned to.
+	UpdateVersion(ctx context.Context, nodeID int64, meta *meta) error
 	UpdateMetaBuildingState(nodeID int64, meta *meta) error
 	AssignTask(ctx context.Context, client types.IndexNodeClient) bool
-	QueryResult(ctx context.Context, client types.IndexNodeClient)
+	// QueryResult queries the result of the task on the worker, returns false if the worker failed to respond.
+	QueryResult(ctx context.Context, client types.IndexNodeClient) bool
+	// UpdateProgress records the progress of the task reported by the worker.
+	UpdateProgress(meta *meta)
 	DropTaskOnWorker(ctx context.Context, client types.IndexNodeClient) bool
 	SetJobInfo(meta *meta) error
+	// GetStartTime returns the time the task is found in progress on the worker, zero if not assigned.
+	GetStartTime() time.Time
+	SetStartTime(startTime time.Time)
+	// GetQueueTime returns the time the task is enqueued to the scheduler.
+	GetQueueTime() time.Time
+	// SetQueueTime starts timing a new attempt of the task from the time it's enqueued.
+	SetQueueTime(queueTime time.Time)
+	// SetDispatchTime records the time the task is assigned to the worker.
+	SetDispatchTime(dispatchTime time.Time)
+	// GetTiming returns the timing breakdown of the current attempt of the task.
+	GetTiming() taskTiming
+	// Describe returns the scheduling details of the task for debugging.
+	Describe(mt *meta) *indexpb.ScheduledTask
 }
@@ -28,6 +28,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -173,6 +174,20 @@ func getCompactedSegmentSize(s *datapb.CompactionSegment) int64 {
 	return segmentSize
 }
 
+// getCollectionInactiveSealTime returns the time without writes to seal the growing segments if it's specified
+// for the collection, or returns the global config.
+func getCollectionInactiveSealTime(properties map[string]string) (time.Duration, error) {
+	v, ok := properties[common.CollectionSegmentIdleSealKey]
+	if ok {
+		seconds, err := strconv.Atoi(v)
+		if err != nil {
+			return -1, err
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return Params.DataCoordCfg.SegmentInactiveSealTime.GetAsDuration(time.Second), nil
+}
+
 // getCollectionAutoCompactionEnabled returns whether auto compaction for collection is enabled.
 // if not set, returns global auto compaction config.
 func getCollectionAutoCompactionEnabled(properties map[string]string) (bool, error) {
@@ -225,13 +240,24 @@ func getFieldBinlogs(id UniqueID, binlogs []*datapb.FieldBinlog) *datapb.FieldBi
 	return nil
 }
 
+// mergeFieldBinlogs merges the new binlogs into the current ones. The binlog already merged with the same log id
+// is replaced instead of appended again, so that saving the binlogs of a retried sync is idempotent.
 func mergeFieldBinlogs(currentBinlogs []*datapb.FieldBinlog, newBinlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
 	for _, newBinlog := range newBinlogs {
 		fieldBinlogs := getFieldBinlogs(newBinlog.GetFieldID(), currentBinlogs)
 		if fieldBinlogs == nil {
 			currentBinlogs = append(currentBinlogs, newBinlog)
-		} else {
-			fieldBinlogs.Binlogs = append(fieldBinlogs.Binlogs, newBinlog.Binlogs...)
+			continue
+		}
+		for _, binlog := range newBinlog.GetBinlogs() {
+			_, idx, found := lo.FindIndexOf(fieldBinlogs.GetBinlogs(), func(l *datapb.Binlog) bool {
+				return l.GetLogID() == binlog.GetLogID()
+			})
+			if found && binlog.GetLogID() != 0 {
+				fieldBinlogs.Binlogs[idx] = binlog
+				continue
+			}
+			fieldBinlogs.Binlogs = append(fieldBinlogs.Binlogs, binlog)
 		}
 	}
 	return currentBinlogs
@@ -278,6 +304,60 @@ func getBinLogIDs(segment *SegmentInfo, fieldID int64) []int64 {
 	return binlogIDs
 }
 
+// getInsertBinlogs returns the insert binlogs of the segment to send to the workers. The workers build the paths
+// by the ids of the segment if absent, so the paths of the binlogs shared by a cloned segment are filled.
+func getInsertBinlogs(segment *SegmentInfo) ([]*datapb.FieldBinlog, error) {
+	if segment.GetBinlogsSource() == nil {
+		return segment.GetBinlogs(), nil
+	}
+	cloned := segment.Clone()
+	if err := binlog.DecompressInsertBinLogs(cloned.SegmentInfo); err != nil {
+		return nil, err
+	}
+	return cloned.GetBinlogs(), nil
+}
+
+// getBinLogPaths returns the insert binlog paths of the field if the segment is cloned,
+// nil is returned otherwise since the paths can be built by the ids.
+func getBinLogPaths(segment *SegmentInfo, fieldID int64) ([]string, error) {
+	if segment.GetBinlogsSource() == nil {
+		return nil, nil
+	}
+	fieldBinlogs, err := getInsertBinlogs(segment)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0)
+	for _, fieldBinLog := range fieldBinlogs {
+		if fieldBinLog.GetFieldID() == fieldID {
+			for _, binLog := range fieldBinLog.GetBinlogs() {
+				paths = append(paths, binLog.GetLogPath())
+			}
+			break
+		}
+	}
+	return paths, nil
+}
+
+// getFieldBinlogSize returns the size of the raw data of the field in the segment,
+// the memory size of the binlog is taken if known, otherwise the serialized size.
+func getFieldBinlogSize(segment *SegmentInfo, fieldID int64) int64 {
+	var size int64
+	for _, fieldBinLog := range segment.GetBinlogs() {
+		if fieldBinLog.GetFieldID() != fieldID {
+			continue
+		}
+		for _, binLog := range fieldBinLog.GetBinlogs() {
+			if binLog.GetMemorySize() > 0 {
+				size += binLog.GetMemorySize()
+			} else {
+				size += binLog.GetLogSize()
+			}
+		}
+	}
+	return size
+}
+
 func CheckCheckPointsHealth(meta *meta) error {
 	for channel, cp := range meta.GetChannelCheckpoints() {
 		collectionID := funcutil.GetCollectionIDFromVChannel(channel)
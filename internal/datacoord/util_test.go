@@ -169,6 +169,23 @@ func (suite *UtilSuite) TestGetCollectionTTL() {
 	suite.Equal(ttl, Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second))
 }
 
+func (suite *UtilSuite) TestGetCollectionInactiveSealTime() {
+	inactiveTime, err := getCollectionInactiveSealTime(map[string]string{
+		common.CollectionSegmentIdleSealKey: "600",
+	})
+	suite.NoError(err)
+	suite.Equal(10*time.Minute, inactiveTime)
+
+	_, err = getCollectionInactiveSealTime(map[string]string{
+		common.CollectionSegmentIdleSealKey: "bad_value",
+	})
+	suite.Error(err)
+
+	inactiveTime, err = getCollectionInactiveSealTime(map[string]string{})
+	suite.NoError(err)
+	suite.Equal(Params.DataCoordCfg.SegmentInactiveSealTime.GetAsDuration(time.Second), inactiveTime)
+}
+
 func (suite *UtilSuite) TestGetCollectionAutoCompactionEnabled() {
 	properties := map[string]string{
 		common.CollectionAutoCompactionKey: "true",
@@ -32,7 +32,7 @@ func NewCoordBroker(dc types.DataCoordClient, serverID int64) Broker {
 // DataCoord is the interface wraps `DataCoord` grpc call
 type DataCoord interface {
 	AssignSegmentID(ctx context.Context, reqs ...*datapb.SegmentIDRequest) ([]typeutil.UniqueID, error)
-	ReportTimeTick(ctx context.Context, msgs []*msgpb.DataNodeTtMsg) error
+	ReportTimeTick(ctx context.Context, msgs []*msgpb.DataNodeTtMsg, progress []*datapb.SegmentFlushProgress) error
 	GetSegmentInfo(ctx context.Context, segmentIDs []int64) ([]*datapb.SegmentInfo, error)
 	UpdateChannelCheckpoint(ctx context.Context, channelCPs []*msgpb.MsgPosition) error
 	SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) error
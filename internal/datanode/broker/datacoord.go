@@ -43,7 +43,7 @@ func (dc *dataCoordBroker) AssignSegmentID(ctx context.Context, reqs ...*datapb.
 	}), nil
 }
 
-func (dc *dataCoordBroker) ReportTimeTick(ctx context.Context, msgs []*msgpb.DataNodeTtMsg) error {
+func (dc *dataCoordBroker) ReportTimeTick(ctx context.Context, msgs []*msgpb.DataNodeTtMsg, progress []*datapb.SegmentFlushProgress) error {
 	log := log.Ctx(ctx)
 
 	req := &datapb.ReportDataNodeTtMsgsRequest{
@@ -51,7 +51,8 @@ func (dc *dataCoordBroker) ReportTimeTick(ctx context.Context, msgs []*msgpb.Dat
 			commonpbutil.WithMsgType(commonpb.MsgType_DataNodeTt),
 			commonpbutil.WithSourceID(dc.serverID),
 		),
-		Msgs: msgs,
+		Msgs:          msgs,
+		FlushProgress: progress,
 	}
 
 	resp, err := dc.client.ReportDataNodeTtMsgs(ctx, req)
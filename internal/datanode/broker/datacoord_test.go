@@ -98,15 +98,19 @@ func (s *dataCoordSuite) TestReportTimeTick() {
 		{Timestamp: 1000, ChannelName: "dml_0"},
 		{Timestamp: 2000, ChannelName: "dml_1"},
 	}
+	progress := []*datapb.SegmentFlushProgress{
+		{SegmentID: 100, Channel: "dml_0", PendingBinlogBytes: 1024},
+	}
 
 	s.Run("normal_case", func() {
 		s.dc.EXPECT().ReportDataNodeTtMsgs(mock.Anything, mock.Anything).
 			Run(func(_ context.Context, req *datapb.ReportDataNodeTtMsgsRequest, _ ...grpc.CallOption) {
 				s.Equal(msgs, req.GetMsgs())
+				s.Equal(progress, req.GetFlushProgress())
 			}).
 			Return(merr.Status(nil), nil)
 
-		err := s.broker.ReportTimeTick(ctx, msgs)
+		err := s.broker.ReportTimeTick(ctx, msgs, progress)
 		s.NoError(err)
 		s.resetMock()
 	})
@@ -115,7 +119,7 @@ func (s *dataCoordSuite) TestReportTimeTick() {
 		s.dc.EXPECT().ReportDataNodeTtMsgs(mock.Anything, mock.Anything).
 			Return(merr.Status(errors.New("mock")), nil)
 
-		err := s.broker.ReportTimeTick(ctx, msgs)
+		err := s.broker.ReportTimeTick(ctx, msgs, nil)
 		s.Error(err)
 		s.resetMock()
 	})
@@ -203,13 +203,13 @@ func (_c *MockBroker_GetSegmentInfo_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// ReportTimeTick provides a mock function with given fields: ctx, msgs
-func (_m *MockBroker) ReportTimeTick(ctx context.Context, msgs []*msgpb.DataNodeTtMsg) error {
-	ret := _m.Called(ctx, msgs)
+// ReportTimeTick provides a mock function with given fields: ctx, msgs, progress
+func (_m *MockBroker) ReportTimeTick(ctx context.Context, msgs []*msgpb.DataNodeTtMsg, progress []*datapb.SegmentFlushProgress) error {
+	ret := _m.Called(ctx, msgs, progress)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []*msgpb.DataNodeTtMsg) error); ok {
-		r0 = rf(ctx, msgs)
+	if rf, ok := ret.Get(0).(func(context.Context, []*msgpb.DataNodeTtMsg, []*datapb.SegmentFlushProgress) error); ok {
+		r0 = rf(ctx, msgs, progress)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -225,13 +225,14 @@ type MockBroker_ReportTimeTick_Call struct {
 // ReportTimeTick is a helper method to define mock.On call
 //   - ctx context.Context
 //   - msgs []*msgpb.DataNodeTtMsg
-func (_e *MockBroker_Expecter) ReportTimeTick(ctx interface{}, msgs interface{}) *MockBroker_ReportTimeTick_Call {
-	return &MockBroker_ReportTimeTick_Call{Call: _e.mock.On("ReportTimeTick", ctx, msgs)}
+//   - progress []*datapb.SegmentFlushProgress
+func (_e *MockBroker_Expecter) ReportTimeTick(ctx interface{}, msgs interface{}, progress interface{}) *MockBroker_ReportTimeTick_Call {
+	return &MockBroker_ReportTimeTick_Call{Call: _e.mock.On("ReportTimeTick", ctx, msgs, progress)}
 }
 
-func (_c *MockBroker_ReportTimeTick_Call) Run(run func(ctx context.Context, msgs []*msgpb.DataNodeTtMsg)) *MockBroker_ReportTimeTick_Call {
+func (_c *MockBroker_ReportTimeTick_Call) Run(run func(ctx context.Context, msgs []*msgpb.DataNodeTtMsg, progress []*datapb.SegmentFlushProgress)) *MockBroker_ReportTimeTick_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]*msgpb.DataNodeTtMsg))
+		run(args[0].(context.Context), args[1].([]*msgpb.DataNodeTtMsg), args[2].([]*datapb.SegmentFlushProgress))
 	})
 	return _c
 }
@@ -241,7 +242,7 @@ func (_c *MockBroker_ReportTimeTick_Call) Return(_a0 error) *MockBroker_ReportTi
 	return _c
 }
 
-func (_c *MockBroker_ReportTimeTick_Call) RunAndReturn(run func(context.Context, []*msgpb.DataNodeTtMsg) error) *MockBroker_ReportTimeTick_Call {
+func (_c *MockBroker_ReportTimeTick_Call) RunAndReturn(run func(context.Context, []*msgpb.DataNodeTtMsg, []*datapb.SegmentFlushProgress) error) *MockBroker_ReportTimeTick_Call {
 	_c.Call.Return(run)
 	return _c
 }
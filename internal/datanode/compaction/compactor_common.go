@@ -134,6 +134,8 @@ func serializeWrite(ctx context.Context, allocator allocator.Interface, writer *
 	_, span := otel.Tracer(typeutil.DataNodeRole).Start(ctx, "serializeWrite")
 	defer span.End()
 
+	// the codes are quantized from the same rows as the binlogs, and flushed along with them
+	codes := writer.SerializeVectorCodes()
 	blobs, tr, err := writer.SerializeYield()
 	startID, _, err := allocator.Alloc(uint32(len(blobs)))
 	if err != nil {
@@ -148,6 +150,9 @@ func serializeWrite(ctx context.Context, allocator allocator.Interface, writer *
 		key, _ := binlog.BuildLogPath(storage.InsertBinlog, writer.GetCollectionID(), writer.GetPartitionID(), writer.GetSegmentID(), fID, startID+int64(i))
 
 		kvs[key] = blobs[i].GetValue()
+		if data, ok := codes[fID]; ok {
+			kvs[binlog.BuildQuantizedLogPath(writer.GetCollectionID(), writer.GetPartitionID(), writer.GetSegmentID(), fID, startID+int64(i))] = data
+		}
 		fieldBinlogs[fID] = &datapb.FieldBinlog{
 			FieldID: fID,
 			Binlogs: []*datapb.Binlog{
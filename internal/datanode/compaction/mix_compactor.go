@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/io"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -112,10 +113,74 @@ func (t *mixCompactionTask) getNumRows() int64 {
 	return numRows
 }
 
+// duplicatePk is a primary key existing more than once in the input segments,
+// only the latest row of it is kept by the dedup compaction.
+type duplicatePk struct {
+	latest  typeutil.Timestamp
+	count   int
+	written bool
+}
+
+// loadDuplicatePks reads the pk and timestamp columns of the input segments to find the duplicate primary keys.
+func (t *mixCompactionTask) loadDuplicatePks(ctx context.Context, pkID int64) (map[interface{}]*duplicatePk, error) {
+	pks := make(map[interface{}]*duplicatePk)
+	for _, segment := range t.plan.GetSegmentBinlogs() {
+		paths := make([]string, 0)
+		for _, fieldBinlog := range segment.GetFieldBinlogs() {
+			if fieldBinlog.GetFieldID() != pkID && fieldBinlog.GetFieldID() != common.TimeStampField {
+				continue
+			}
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				paths = append(paths, binlog.GetLogPath())
+			}
+		}
+		if len(paths) == 0 {
+			continue
+		}
+		values, err := t.binlogIO.Download(ctx, paths)
+		if err != nil {
+			return nil, err
+		}
+		blobs := lo.Map(values, func(v []byte, i int) *storage.Blob {
+			return &storage.Blob{Key: paths[i], Value: v}
+		})
+		_, _, _, data, err := storage.NewInsertCodec().DeserializeAll(blobs)
+		if err != nil {
+			return nil, err
+		}
+		pkData, ok := data.Data[pkID]
+		if !ok {
+			return nil, errors.Newf("pk field %d not found in binlogs of segment %d", pkID, segment.GetSegmentID())
+		}
+		tsData, err := storage.GetTimestampFromInsertData(data)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < pkData.RowNum(); i++ {
+			pk, ts := pkData.GetRow(i), typeutil.Timestamp(tsData.Data[i])
+			if row, ok := pks[pk]; ok {
+				row.count++
+				if ts > row.latest {
+					row.latest = ts
+				}
+			} else {
+				pks[pk] = &duplicatePk{latest: ts, count: 1}
+			}
+		}
+	}
+	for pk, row := range pks {
+		if row.count < 2 {
+			delete(pks, pk)
+		}
+	}
+	return pks, nil
+}
+
 func (t *mixCompactionTask) merge(
 	ctx context.Context,
 	binlogPaths [][]string,
 	delta map[interface{}]typeutil.Timestamp,
+	duplicates map[interface{}]*duplicatePk,
 	writer *SegmentWriter,
 ) (*datapb.CompactionSegment, error) {
 	_ = t.tr.RecordSpan()
@@ -129,6 +194,7 @@ func (t *mixCompactionTask) merge(
 		syncBatchCount    int   // binlog batch count
 		remainingRowCount int64 // the number of remaining entities
 		expiredRowCount   int64 // the number of expired entities
+		duplicateRowCount int64 // the number of removed duplicate entities
 		deletedRowCount   int64 = 0
 		unflushedRowCount int64 = 0
 
@@ -147,6 +213,19 @@ func (t *mixCompactionTask) merge(
 		return false
 	}
 
+	// only the first row with the latest timestamp of a duplicate pk is kept
+	isValueDuplicated := func(v *storage.Value) bool {
+		row, ok := duplicates[v.PK.GetValue()]
+		if !ok {
+			return false
+		}
+		if row.written || uint64(v.Timestamp) < row.latest {
+			return true
+		}
+		row.written = true
+		return false
+	}
+
 	downloadTimeCost := time.Duration(0)
 	serWriteTimeCost := time.Duration(0)
 	uploadTimeCost := time.Duration(0)
@@ -193,6 +272,11 @@ func (t *mixCompactionTask) merge(
 				continue
 			}
 
+			if isValueDuplicated(v) {
+				duplicateRowCount++
+				continue
+			}
+
 			err = writer.Write(v)
 			if err != nil {
 				log.Warn("compact wrong, failed to writer row", zap.Error(err))
@@ -266,6 +350,7 @@ func (t *mixCompactionTask) merge(
 		zap.Int64("remaining row count", remainingRowCount),
 		zap.Int64("deleted row count", deletedRowCount),
 		zap.Int64("expired entities", expiredRowCount),
+		zap.Int64("duplicate entities", duplicateRowCount),
 		zap.Int("binlog batch count", syncBatchCount),
 		zap.Duration("download binlogs elapse", downloadTimeCost),
 		zap.Duration("upload binlogs elapse", uploadTimeCost),
@@ -345,7 +430,17 @@ func (t *mixCompactionTask) Compact() (*datapb.CompactionPlanResult, error) {
 		return nil, err
 	}
 
-	compactToSeg, err := t.merge(ctxTimeout, allPath, deltaPk2Ts, writer)
+	var duplicates map[interface{}]*duplicatePk
+	if t.plan.GetDedupPrimaryKeys() {
+		duplicates, err = t.loadDuplicatePks(ctxTimeout, writer.GetPkID())
+		if err != nil {
+			log.Warn("compact wrong, fail to load duplicate pks", zap.Error(err))
+			return nil, err
+		}
+		log.Info("compact with primary keys deduplicated", zap.Int("duplicate pk counts", len(duplicates)))
+	}
+
+	compactToSeg, err := t.merge(ctxTimeout, allPath, deltaPk2Ts, duplicates, writer)
 	if err != nil {
 		log.Warn("compact wrong, fail to merge", zap.Error(err))
 		return nil, err
@@ -175,6 +175,58 @@ func (s *MixCompactionTaskSuite) TestCompactDupPK() {
 	s.Empty(segment.Deltalogs)
 }
 
+func (s *MixCompactionTaskSuite) TestCompactDedupPrimaryKeys() {
+	// three segments with the same pk=100 inserted at different time,
+	// only the latest row is kept with dedup enabled
+	segments := []int64{7, 8, 9}
+	s.mockBinlogIO.EXPECT().Upload(mock.Anything, mock.Anything).Return(nil)
+	alloc := allocator.NewLocalAllocator(7777777, math.MaxInt64)
+
+	allKvs := make(map[string][]byte)
+	s.task.plan.SegmentBinlogs = make([]*datapb.CompactionSegmentBinlogs, 0)
+	s.task.plan.DedupPrimaryKeys = true
+	for i, segID := range segments {
+		segWriter, err := NewSegmentWriter(s.meta.GetSchema(), 100, segID, PartitionID, CollectionID)
+		s.Require().NoError(err)
+		err = segWriter.Write(&storage.Value{
+			PK:        storage.NewInt64PrimaryKey(100),
+			Timestamp: int64(tsoutil.ComposeTSByTime(getMilvusBirthday().Add(time.Duration(i)*time.Second), 0)),
+			Value:     getRow(100),
+		})
+		s.Require().NoError(err)
+		segWriter.writer.Flush()
+
+		kvs, fBinlogs, err := serializeWrite(context.TODO(), alloc, segWriter)
+		s.Require().NoError(err)
+		for k, v := range kvs {
+			allKvs[k] = v
+		}
+		s.plan.SegmentBinlogs = append(s.plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:    segID,
+			FieldBinlogs: lo.Values(fBinlogs),
+		})
+	}
+	s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, paths []string) ([][]byte, error) {
+			return lo.Map(paths, func(path string, _ int) []byte {
+				return allKvs[path]
+			}), nil
+		})
+
+	result, err := s.task.Compact()
+	s.NoError(err)
+	s.NotNil(result)
+	s.Equal(1, len(result.GetSegments()))
+	s.EqualValues(1, result.GetSegments()[0].GetNumOfRows())
+
+	// all rows are kept without dedup
+	s.task.plan.DedupPrimaryKeys = false
+	s.task.plan.PlanID++
+	result, err = s.task.Compact()
+	s.NoError(err)
+	s.EqualValues(3, result.GetSegments()[0].GetNumOfRows())
+}
+
 func (s *MixCompactionTaskSuite) TestCompactTwoToOne() {
 	segments := []int64{5, 6, 7}
 	alloc := allocator.NewLocalAllocator(7777777, math.MaxInt64)
@@ -262,7 +314,7 @@ func (s *MixCompactionTaskSuite) TestMergeBufferFull() {
 	segWriter, err := NewSegmentWriter(s.meta.GetSchema(), 100, 19530, PartitionID, CollectionID)
 	s.Require().NoError(err)
 
-	compactionSegment, err := s.task.merge(s.task.ctx, [][]string{lo.Keys(kvs)}, nil, segWriter)
+	compactionSegment, err := s.task.merge(s.task.ctx, [][]string{lo.Keys(kvs)}, nil, nil, segWriter)
 	s.NoError(err)
 	s.NotNil(compactionSegment)
 	s.EqualValues(2, compactionSegment.GetNumOfRows())
@@ -289,7 +341,7 @@ func (s *MixCompactionTaskSuite) TestMergeEntityExpired() {
 	segWriter, err := NewSegmentWriter(s.meta.GetSchema(), 100, 19530, PartitionID, CollectionID)
 	s.Require().NoError(err)
 
-	compactionSegment, err := s.task.merge(s.task.ctx, [][]string{lo.Keys(kvs)}, nil, segWriter)
+	compactionSegment, err := s.task.merge(s.task.ctx, [][]string{lo.Keys(kvs)}, nil, nil, segWriter)
 	s.NoError(err)
 	s.NotNil(compactionSegment)
 	s.EqualValues(0, compactionSegment.GetNumOfRows())
@@ -323,7 +375,7 @@ func (s *MixCompactionTaskSuite) TestMergeNoExpiration() {
 			segWriter, err := NewSegmentWriter(s.meta.GetSchema(), 100, 19530, PartitionID, CollectionID)
 			s.Require().NoError(err)
 
-			compactionSegment, err := s.task.merge(s.task.ctx, [][]string{lo.Keys(kvs)}, test.deletions, segWriter)
+			compactionSegment, err := s.task.merge(s.task.ctx, [][]string{lo.Keys(kvs)}, test.deletions, nil, segWriter)
 			s.NoError(err)
 			s.NotNil(compactionSegment)
 			s.EqualValues(test.expectedRowCount, compactionSegment.GetNumOfRows())
@@ -9,6 +9,7 @@ import (
 	"math"
 
 	"go.uber.org/atomic"
+	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/flushcommon/writebuffer"
@@ -103,6 +104,9 @@ type SegmentWriter struct {
 	collectionID int64
 	sch          *schemapb.CollectionSchema
 	rowCount     *atomic.Int64
+
+	// codesBuilders buffers the vectors to be quantized of each binlog batch, nil if quantization disabled
+	codesBuilders map[int64]*storage.VectorCodesBuilder
 }
 
 func (w *SegmentWriter) GetRowNum() int64 {
@@ -140,6 +144,14 @@ func (w *SegmentWriter) Write(v *storage.Value) error {
 
 	w.pkstats.Update(v.PK)
 	w.rowCount.Inc()
+	if len(w.codesBuilders) > 0 {
+		row := v.Value.(map[storage.FieldID]interface{})
+		for fieldID, builder := range w.codesBuilders {
+			if err := builder.AppendValue(row[fieldID]); err != nil {
+				return err
+			}
+		}
+	}
 	return w.writer.Write(v)
 }
 
@@ -190,6 +202,31 @@ func (w *SegmentWriter) SerializeYield() ([]*storage.Blob, *writebuffer.TimeRang
 	return fieldData, tr, nil
 }
 
+// SerializeVectorCodes quantizes the vectors written since last call, returns the codes by field id.
+// The fields failed to be quantized are skipped, and served from the raw vectors.
+func (w *SegmentWriter) SerializeVectorCodes() map[int64][]byte {
+	if len(w.codesBuilders) == 0 {
+		return nil
+	}
+	quantization := paramtable.Get().DataNodeCfg.QuantizationType.GetValue()
+	pqM := paramtable.Get().DataNodeCfg.QuantizationPQM.GetAsInt()
+	codes := make(map[int64][]byte, len(w.codesBuilders))
+	for fieldID, builder := range w.codesBuilders {
+		vectorCodes, err := builder.Build(quantization, pqM)
+		if err != nil {
+			log.Warn("failed to quantize vectors", zap.Int64("segmentID", w.segmentID), zap.Int64("fieldID", fieldID), zap.Error(err))
+			continue
+		}
+		data, err := storage.SerializeVectorCodes(vectorCodes)
+		if err != nil {
+			log.Warn("failed to serialize vector codes", zap.Int64("segmentID", w.segmentID), zap.Int64("fieldID", fieldID), zap.Error(err))
+			continue
+		}
+		codes[fieldID] = data
+	}
+	return codes
+}
+
 func (w *SegmentWriter) clear() {
 	writer, closers, _ := newBinlogWriter(w.collectionID, w.partitionID, w.segmentID, w.sch)
 	w.writer = writer
@@ -220,6 +257,14 @@ func NewSegmentWriter(sch *schemapb.CollectionSchema, maxCount int64, segID, par
 		return nil, err
 	}
 
+	var codesBuilders map[int64]*storage.VectorCodesBuilder
+	if paramtable.Get().DataNodeCfg.QuantizationType.GetValue() != "" {
+		codesBuilders, err = storage.NewVectorCodesBuilders(sch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	segWriter := SegmentWriter{
 		writer:  writer,
 		closers: closers,
@@ -232,6 +277,8 @@ func NewSegmentWriter(sch *schemapb.CollectionSchema, maxCount int64, segID, par
 		partitionID:  partID,
 		collectionID: collID,
 		rowCount:     atomic.NewInt64(0),
+
+		codesBuilders: codesBuilders,
 	}
 
 	return &segWriter, nil
@@ -200,7 +200,7 @@ func (node *DataNode) Register() error {
 }
 
 func (node *DataNode) initSession() error {
-	node.session = sessionutil.NewSession(node.ctx)
+	node.session = sessionutil.NewSession(node.ctx, sessionutil.WithServerLabels(Params.DataNodeCfg.Labels.GetValue()))
 	if node.session == nil {
 		return errors.New("failed to initialize session")
 	}
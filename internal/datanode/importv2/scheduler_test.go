@@ -431,8 +431,9 @@ func (s *SchedulerSuite) TestScheduler_ImportFile() {
 	}
 	importTask := NewImportTask(importReq, s.manager, s.syncMgr, s.cm)
 	s.manager.Add(importTask)
-	err = importTask.(*ImportTask).importFile(s.reader)
+	err = importTask.(*ImportTask).importFile(importReq.GetFiles()[0], s.reader)
 	s.NoError(err)
+	s.Equal([]int64{importReq.GetFiles()[0].GetId()}, s.manager.Get(importTask.GetTaskID()).(*ImportTask).GetCompletedFileIDs())
 }
 
 func TestScheduler(t *testing.T) {
@@ -32,6 +32,7 @@ const (
 	ImportTaskType      TaskType = 1
 	L0PreImportTaskType TaskType = 2
 	L0ImportTaskType    TaskType = 3
+	ExportTaskType      TaskType = 4
 )
 
 var ImportTaskTypeName = map[TaskType]string{
@@ -39,6 +40,7 @@ var ImportTaskTypeName = map[TaskType]string{
 	1: "ImportTask",
 	2: "L0PreImportTaskType",
 	3: "L0ImportTaskType",
+	4: "ExportTask",
 }
 
 func (t TaskType) String() string {
@@ -77,6 +79,8 @@ func UpdateState(state datapb.ImportTaskStateV2) UpdateAction {
 			t.(*L0PreImportTask).PreImportTask.State = state
 		case L0ImportTaskType:
 			t.(*L0ImportTask).ImportTaskV2.State = state
+		case ExportTaskType:
+			t.(*ExportTask).ExportTask.State = state
 		}
 	}
 }
@@ -92,6 +96,8 @@ func UpdateReason(reason string) UpdateAction {
 			t.(*L0PreImportTask).PreImportTask.Reason = reason
 		case L0ImportTaskType:
 			t.(*L0ImportTask).ImportTaskV2.Reason = reason
+		case ExportTaskType:
+			t.(*ExportTask).ExportTask.Reason = reason
 		}
 	}
 }
@@ -110,6 +116,8 @@ func UpdateFileStat(idx int, fileStat *datapb.ImportFileStats) UpdateAction {
 			t.FileStats[idx].TotalRows = fileStat.GetTotalRows()
 			t.FileStats[idx].TotalMemorySize = fileStat.GetTotalMemorySize()
 			t.FileStats[idx].HashedStats = fileStat.GetHashedStats()
+			t.FileStats[idx].RejectedRows = fileStat.GetRejectedRows()
+			t.FileStats[idx].QuarantinePath = fileStat.GetQuarantinePath()
 		}
 	}
 }
@@ -150,6 +158,35 @@ func UpdateSegmentInfo(info *datapb.ImportSegmentInfo) UpdateAction {
 	}
 }
 
+func UpdateCompletedFile(fileID int64) UpdateAction {
+	return func(task Task) {
+		var t *datapb.ImportTaskV2
+		switch it := task.(type) {
+		case *ImportTask:
+			t = it.ImportTaskV2
+		case *L0ImportTask:
+			t = it.ImportTaskV2
+		}
+		if t != nil && !lo.Contains(t.CompletedFileIDs, fileID) {
+			t.CompletedFileIDs = append(t.CompletedFileIDs, fileID)
+		}
+	}
+}
+
+func UpdateExportedSegment(segmentID int64, rows int64, files []string) UpdateAction {
+	return func(task Task) {
+		if t, ok := task.(*ExportTask); ok {
+			for _, segment := range t.GetSegments() {
+				if segment.GetSegmentID() == segmentID {
+					segment.Exported = true
+					segment.ExportedRows = rows
+					segment.OutputFiles = files
+				}
+			}
+		}
+	}
+}
+
 type Task interface {
 	Execute() []*conc.Future[any]
 	GetJobID() int64
@@ -0,0 +1,238 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importv2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/binlog"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ExportTask writes the rows of the sealed segments as Parquet files into the output path,
+// each binlog batch of a segment is written as a file named by the segment id and the batch index,
+// so that the files of a segment are overwritten instead of duplicated when the task is retried.
+type ExportTask struct {
+	*datapb.ExportTask
+	ctx    context.Context
+	cancel context.CancelFunc
+	req    *datapb.ExportRequest
+
+	manager TaskManager
+	cm      storage.ChunkManager
+}
+
+func NewExportTask(req *datapb.ExportRequest,
+	manager TaskManager,
+	cm storage.ChunkManager,
+) Task {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &ExportTask{
+		ExportTask: &datapb.ExportTask{
+			JobID:        req.GetJobID(),
+			TaskID:       req.GetTaskID(),
+			CollectionID: req.GetCollectionID(),
+			Segments:     req.GetSegments(),
+			L0Segments:   req.GetL0Segments(),
+			State:        datapb.ImportTaskStateV2_Pending,
+		},
+		ctx:     ctx,
+		cancel:  cancel,
+		req:     req,
+		manager: manager,
+		cm:      cm,
+	}
+}
+
+func (t *ExportTask) GetPartitionIDs() []int64 {
+	return lo.Uniq(lo.Map(t.GetSegments(), func(segment *datapb.ExportSegment, _ int) int64 {
+		return segment.GetPartitionID()
+	}))
+}
+
+func (t *ExportTask) GetVchannels() []string {
+	return nil
+}
+
+func (t *ExportTask) GetType() TaskType {
+	return ExportTaskType
+}
+
+func (t *ExportTask) GetSchema() *schemapb.CollectionSchema {
+	return t.req.GetSchema()
+}
+
+func (t *ExportTask) Cancel() {
+	t.cancel()
+}
+
+func (t *ExportTask) Clone() Task {
+	ctx, cancel := context.WithCancel(t.ctx)
+	return &ExportTask{
+		ExportTask: typeutil.Clone(t.ExportTask),
+		ctx:        ctx,
+		cancel:     cancel,
+		req:        t.req,
+		manager:    t.manager,
+		cm:         t.cm,
+	}
+}
+
+func (t *ExportTask) Execute() []*conc.Future[any] {
+	log.Info("start to export", WrapLogFields(t,
+		zap.Int("segments", len(t.GetSegments())),
+		zap.Int("l0Segments", len(t.GetL0Segments())),
+		zap.String("outputPath", t.req.GetOutputPath()),
+		zap.Uint64("ts", t.req.GetTs()))...)
+	t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_InProgress))
+
+	fn := func() (err error) {
+		defer func() {
+			if err != nil {
+				log.Warn("export task execute failed", WrapLogFields(t, zap.Error(err))...)
+				t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Failed), UpdateReason(err.Error()))
+			}
+		}()
+		pkField, err := typeutil.GetPrimaryFieldSchema(t.GetSchema())
+		if err != nil {
+			return err
+		}
+		deleted, err := t.readL0Deletes(pkField)
+		if err != nil {
+			return err
+		}
+		for _, segment := range t.GetSegments() {
+			start := time.Now()
+			rows, files, err := t.exportSegment(segment, pkField, deleted)
+			if err != nil {
+				return err
+			}
+			t.manager.Update(t.GetTaskID(), UpdateExportedSegment(segment.GetSegmentID(), rows, files))
+			log.Info("export segment done", WrapLogFields(t,
+				zap.Int64("segmentID", segment.GetSegmentID()),
+				zap.Int64("rows", rows),
+				zap.Int("files", len(files)),
+				zap.Duration("dur", time.Since(start)))...)
+		}
+		return nil
+	}
+
+	f := GetExecPool().Submit(func() (any, error) {
+		err := fn()
+		return err, err
+	})
+	return []*conc.Future[any]{f}
+}
+
+// readL0Deletes reads the latest delete ts of each pk from the l0 segments, the deletes after the export ts are skipped.
+func (t *ExportTask) readL0Deletes(pkField *schemapb.FieldSchema) (map[any]uint64, error) {
+	bufferSize := paramtable.Get().DataNodeCfg.ReadBufferSizeInMB.GetAsInt() * 1024 * 1024
+	deleted := make(map[any]uint64)
+	for _, segment := range t.GetL0Segments() {
+		prefix := path.Join(t.cm.RootPath(), common.SegmentDeltaLogPath,
+			metautil.JoinIDPath(t.GetCollectionID(), segment.GetPartitionID(), segment.GetSegmentID()))
+		reader, err := binlog.NewL0Reader(t.ctx, t.cm, pkField, &internalpb.ImportFile{Paths: []string{prefix}}, bufferSize)
+		if err != nil {
+			return nil, err
+		}
+		for {
+			data, err := reader.Read()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, err
+			}
+			for i, pk := range data.Pks {
+				ts := data.Tss[i]
+				if ts <= t.req.GetTs() && ts > deleted[pk.GetValue()] {
+					deleted[pk.GetValue()] = ts
+				}
+			}
+		}
+	}
+	return deleted, nil
+}
+
+func (t *ExportTask) exportSegment(segment *datapb.ExportSegment, pkField *schemapb.FieldSchema, deleted map[any]uint64) (int64, []string, error) {
+	idPath := metautil.JoinIDPath(t.GetCollectionID(), segment.GetPartitionID(), segment.GetSegmentID())
+	paths := []string{
+		path.Join(t.cm.RootPath(), common.SegmentInsertLogPath, idPath),
+		path.Join(t.cm.RootPath(), common.SegmentDeltaLogPath, idPath),
+	}
+	reader, err := binlog.NewReader(t.ctx, t.cm, t.GetSchema(), paths, 0, t.req.GetTs())
+	if err != nil {
+		return 0, nil, err
+	}
+	defer reader.Close()
+
+	var rows int64
+	files := make([]string, 0)
+	for idx := 0; ; idx++ {
+		if err = t.ctx.Err(); err != nil {
+			return 0, nil, err
+		}
+		data, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return 0, nil, err
+		}
+		data, err = FilterDeletedRows(t.GetSchema(), pkField, data, deleted)
+		if err != nil {
+			return 0, nil, err
+		}
+		if data.GetRowNum() == 0 {
+			continue
+		}
+		buf := &bytes.Buffer{}
+		err = parquet.WriteInsertData(buf, t.GetSchema(), data)
+		if err != nil {
+			return 0, nil, err
+		}
+		file := path.Join(t.req.GetOutputPath(), strconv.FormatInt(t.GetJobID(), 10),
+			fmt.Sprintf("%d_%d.parquet", segment.GetSegmentID(), idx))
+		err = t.cm.Write(t.ctx, file, buf.Bytes())
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "failed to write export file %s", file)
+		}
+		rows += int64(data.GetRowNum())
+		files = append(files, file)
+	}
+	return rows, files, nil
+}
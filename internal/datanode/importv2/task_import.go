@@ -67,6 +67,11 @@ func NewImportTask(req *datapb.ImportRequest,
 	}
 	// Setting end as math.MaxInt64 to incrementally allocate logID.
 	alloc := allocator.NewLocalAllocator(req.GetIDRange().GetBegin(), math.MaxInt64)
+	// The resumed import continues with the segments checkpointed by the completed files.
+	segmentsInfo := make(map[int64]*datapb.ImportSegmentInfo)
+	for _, info := range req.GetCheckpointSegmentsInfo() {
+		segmentsInfo[info.GetSegmentID()] = typeutil.Clone(info)
+	}
 	task := &ImportTask{
 		ImportTaskV2: &datapb.ImportTaskV2{
 			JobID:        req.GetJobID(),
@@ -76,7 +81,7 @@ func NewImportTask(req *datapb.ImportRequest,
 		},
 		ctx:          ctx,
 		cancel:       cancel,
-		segmentsInfo: make(map[int64]*datapb.ImportSegmentInfo),
+		segmentsInfo: segmentsInfo,
 		req:          req,
 		allocator:    alloc,
 		manager:      manager,
@@ -145,7 +150,7 @@ func (t *ImportTask) Execute() []*conc.Future[any] {
 		}
 		defer reader.Close()
 		start := time.Now()
-		err = t.importFile(reader)
+		err = t.importFile(file, reader)
 		if err != nil {
 			log.Warn("do import failed", WrapLogFields(t, zap.String("file", file.String()), zap.Error(err))...)
 			t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Failed), UpdateReason(err.Error()))
@@ -158,6 +163,10 @@ func (t *ImportTask) Execute() []*conc.Future[any] {
 
 	futures := make([]*conc.Future[any], 0, len(req.GetFiles()))
 	for _, file := range req.GetFiles() {
+		if lo.Contains(t.GetCompletedFileIDs(), file.GetId()) {
+			log.Info("skip the imported file", WrapLogFields(t, zap.Strings("files", file.GetPaths()))...)
+			continue
+		}
 		file := file
 		f := GetExecPool().Submit(func() (any, error) {
 			err := fn(file)
@@ -168,17 +177,29 @@ func (t *ImportTask) Execute() []*conc.Future[any] {
 	return futures
 }
 
-func (t *ImportTask) importFile(reader importutilv2.Reader) error {
+// importFile imports the file and checkpoints the segments info with the file at once,
+// so that the reported segments info only contains the binlogs of the completed files.
+func (t *ImportTask) importFile(file *internalpb.ImportFile, reader importutilv2.Reader) error {
 	syncFutures := make([]*conc.Future[struct{}], 0)
 	syncTasks := make([]syncmgr.Task, 0)
 	for {
 		data, err := reader.Read()
+		if rejecter, ok := reader.(importutilv2.RowRejecter); ok {
+			// the rejected rows have been quarantined during preimport
+			rejecter.Rejected()
+		}
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
 			return err
 		}
+		if !importutilv2.IsBackup(t.req.GetOptions()) {
+			data, _, err = FilterInvalidRows(t.GetSchema(), data, importutilv2.IsQuarantine(t.req.GetOptions()))
+			if err != nil {
+				return err
+			}
+		}
 		err = AppendSystemFieldsData(t, data)
 		if err != nil {
 			return err
@@ -198,14 +219,17 @@ func (t *ImportTask) importFile(reader importutilv2.Reader) error {
 	if err != nil {
 		return err
 	}
+	actions := make([]UpdateAction, 0, len(syncTasks)+1)
 	for _, syncTask := range syncTasks {
 		segmentInfo, err := NewImportSegmentInfo(syncTask, t.metaCaches)
 		if err != nil {
 			return err
 		}
-		t.manager.Update(t.GetTaskID(), UpdateSegmentInfo(segmentInfo))
+		actions = append(actions, UpdateSegmentInfo(segmentInfo))
 		log.Info("sync import data done", WrapLogFields(t, zap.Any("segmentInfo", segmentInfo))...)
 	}
+	actions = append(actions, UpdateCompletedFile(file.GetId()))
+	t.manager.Update(t.GetTaskID(), actions...)
 	return nil
 }
 
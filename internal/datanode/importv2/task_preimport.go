@@ -18,8 +18,10 @@ package importv2
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"path"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -32,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	importcommon "github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/conc"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -177,8 +180,13 @@ func (t *PreImportTask) readFileStat(reader importutilv2.Reader, fileIdx int) er
 	totalRows := 0
 	totalSize := 0
 	hashedStats := make(map[string]*datapb.PartitionImportStats)
+	quarantine := importutilv2.IsQuarantine(t.options)
+	rejected := make([]*importcommon.RejectedRow, 0)
 	for {
 		data, err := reader.Read()
+		if rejecter, ok := reader.(importutilv2.RowRejecter); ok {
+			rejected = append(rejected, rejecter.Rejected()...)
+		}
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
@@ -189,6 +197,14 @@ func (t *PreImportTask) readFileStat(reader importutilv2.Reader, fileIdx int) er
 		if err != nil {
 			return err
 		}
+		if !importutilv2.IsBackup(t.options) {
+			var invalid []*importcommon.RejectedRow
+			data, invalid, err = FilterInvalidRows(t.GetSchema(), data, quarantine)
+			if err != nil {
+				return err
+			}
+			rejected = append(rejected, invalid...)
+		}
 		rowsCount, err := GetRowsStats(t, data)
 		if err != nil {
 			return err
@@ -206,7 +222,35 @@ func (t *PreImportTask) readFileStat(reader importutilv2.Reader, fileIdx int) er
 		TotalRows:       int64(totalRows),
 		TotalMemorySize: int64(totalSize),
 		HashedStats:     hashedStats,
+		RejectedRows:    int64(len(rejected)),
+	}
+	if len(rejected) > 0 {
+		stat.QuarantinePath, err = t.writeQuarantine(t.GetFileStats()[fileIdx].GetImportFile().GetId(), rejected)
+		if err != nil {
+			return err
+		}
+		log.Info("rejected rows are quarantined", WrapLogFields(t, zap.Int("rejectedRows", len(rejected)),
+			zap.String("quarantinePath", stat.GetQuarantinePath()))...)
 	}
 	t.manager.Update(t.GetTaskID(), UpdateFileStat(fileIdx, stat))
 	return nil
 }
+
+// writeQuarantine writes the rejected rows of the file to the object storage as JSON lines.
+func (t *PreImportTask) writeQuarantine(fileID int64, rejected []*importcommon.RejectedRow) (string, error) {
+	content := make([]byte, 0)
+	for _, row := range rejected {
+		bs, err := json.Marshal(row)
+		if err != nil {
+			return "", err
+		}
+		content = append(append(content, bs...), '\n')
+	}
+	filePath := path.Join(t.cm.RootPath(), QuarantinePath,
+		fmt.Sprint(t.GetJobID()), fmt.Sprint(t.GetTaskID()), fmt.Sprintf("%d.json", fileID))
+	err := t.cm.Write(t.ctx, filePath, content)
+	if err != nil {
+		return "", err
+	}
+	return filePath, nil
+}
@@ -18,6 +18,7 @@ package importv2
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -34,12 +35,17 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/storage"
+	importcommon "github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/parameterutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
+// QuarantinePath is the directory of the rejected rows under the root path of the object storage.
+const QuarantinePath = "import_quarantine"
+
 func WrapTaskNotFoundError(taskID int64) error {
 	return merr.WrapErrImportFailed(fmt.Sprintf("cannot find import task with id %d", taskID))
 }
@@ -154,6 +160,133 @@ func CheckRowsEqual(schema *schemapb.CollectionSchema, data *storage.InsertData)
 	return nil
 }
 
+// ValidateRows validates the rows against the schema, including the max length of the varchar fields
+// and the format of the JSON fields, it returns the reasons of the invalid rows by the row offsets.
+func ValidateRows(schema *schemapb.CollectionSchema, data *storage.InsertData) (map[int]string, error) {
+	invalid := make(map[int]string)
+	for _, field := range schema.GetFields() {
+		fd, ok := data.Data[field.GetFieldID()]
+		if !ok {
+			continue
+		}
+		switch field.GetDataType() {
+		case schemapb.DataType_VarChar:
+			maxLength, err := parameterutil.GetMaxLength(field)
+			if err != nil {
+				return nil, err
+			}
+			for i, str := range fd.(*storage.StringFieldData).Data {
+				if _, ok := invalid[i]; !ok && int64(len(str)) > maxLength {
+					invalid[i] = fmt.Sprintf("length of varchar field '%s' exceeds max length, length: %d, max length: %d",
+						field.GetName(), len(str), maxLength)
+				}
+			}
+		case schemapb.DataType_JSON:
+			for i, bs := range fd.(*storage.JSONFieldData).Data {
+				if _, ok := invalid[i]; !ok && !json.Valid(bs) {
+					invalid[i] = fmt.Sprintf("invalid JSON value for field '%s'", field.GetName())
+				}
+			}
+		}
+	}
+	return invalid, nil
+}
+
+// FilterInvalidRows validates the rows against the schema. If quarantine is enabled, the invalid rows
+// are removed from the data and returned as the rejected rows, otherwise an error is returned.
+func FilterInvalidRows(schema *schemapb.CollectionSchema, data *storage.InsertData, quarantine bool) (*storage.InsertData, []*importcommon.RejectedRow, error) {
+	invalid, err := ValidateRows(schema, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(invalid) == 0 {
+		return data, nil, nil
+	}
+	idToField := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) int64 {
+		return field.GetFieldID()
+	})
+	if !quarantine {
+		offset := lo.Min(lo.Keys(invalid))
+		return nil, nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid row %d, %s", offset, invalid[offset]))
+	}
+
+	rowNum := GetInsertDataRowCount(data, schema)
+	rejected := make([]*importcommon.RejectedRow, 0, len(invalid))
+	filtered := &storage.InsertData{Data: make(map[int64]storage.FieldData)}
+	for fieldID := range data.Data {
+		field, ok := idToField[fieldID]
+		if !ok {
+			return nil, nil, merr.WrapErrImportFailed(fmt.Sprintf("unexpected field %d when filtering rows", fieldID))
+		}
+		filtered.Data[fieldID], err = storage.NewFieldData(field.GetDataType(), field, rowNum-len(invalid))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	for i := 0; i < rowNum; i++ {
+		reason, ok := invalid[i]
+		if !ok {
+			for fieldID, fd := range data.Data {
+				if fd.RowNum() == 0 {
+					continue
+				}
+				if err = filtered.Data[fieldID].AppendRow(fd.GetRow(i)); err != nil {
+					return nil, nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, err=%s", err.Error()))
+				}
+			}
+			continue
+		}
+		row := make(map[string]any, len(data.Data))
+		for fieldID, fd := range data.Data {
+			if fd.RowNum() == 0 {
+				continue
+			}
+			value := fd.GetRow(i)
+			if bs, ok := value.([]byte); ok && idToField[fieldID].GetDataType() == schemapb.DataType_JSON {
+				value = string(bs)
+			}
+			row[idToField[fieldID].GetName()] = value
+		}
+		rejected = append(rejected, &importcommon.RejectedRow{Row: row, Reason: reason})
+	}
+	return filtered, rejected, nil
+}
+
+// FilterDeletedRows removes the rows deleted after they were inserted, the data read from the binlogs
+// contains the system fields, and deleted maps the pk to its latest delete ts.
+func FilterDeletedRows(schema *schemapb.CollectionSchema, pkField *schemapb.FieldSchema,
+	data *storage.InsertData, deleted map[any]uint64,
+) (*storage.InsertData, error) {
+	if len(deleted) == 0 {
+		return data, nil
+	}
+	pks, tss := data.Data[pkField.GetFieldID()], data.Data[common.TimeStampField]
+	if pks == nil || tss == nil {
+		return nil, merr.WrapErrImportFailed("no pk or timestamp field data to filter the deleted rows")
+	}
+	isDeleted := func(i int) bool {
+		ts, ok := deleted[pks.GetRow(i)]
+		return ok && ts > uint64(tss.GetRow(i).(int64))
+	}
+	rowNum := data.GetRowNum()
+	if !lo.ContainsBy(lo.Range(rowNum), isDeleted) {
+		return data, nil
+	}
+	filtered, err := storage.NewInsertData(typeutil.AppendSystemFields(schema))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < rowNum; i++ {
+		if isDeleted(i) {
+			continue
+		}
+		if err = filtered.Append(data.GetRow(i)); err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, err=%s", err.Error()))
+		}
+	}
+	return filtered, nil
+}
+
 func AppendSystemFieldsData(task *ImportTask, data *storage.InsertData) error {
 	pkField, err := typeutil.GetPrimaryFieldSchema(task.GetSchema())
 	if err != nil {
@@ -223,6 +356,8 @@ func LogStats(manager TaskManager) {
 	logFunc(tasks, PreImportTaskType)
 	tasks = manager.GetBy(WithType(ImportTaskType))
 	logFunc(tasks, ImportTaskType)
+	tasks = manager.GetBy(WithType(ExportTaskType))
+	logFunc(tasks, ExportTaskType)
 }
 
 func UnsetAutoID(schema *schemapb.CollectionSchema) {
@@ -250,5 +385,25 @@ func NewMetaCache(req *datapb.ImportRequest) map[string]metacache.MetaCache {
 		})
 		metaCaches[channel] = metaCache
 	}
+	// Add the checkpointed segments with their imported rows to resume the import.
+	for _, info := range req.GetCheckpointSegmentsInfo() {
+		segment, ok := lo.Find(req.GetRequestSegments(), func(segment *datapb.ImportRequestSegment) bool {
+			return segment.GetSegmentID() == info.GetSegmentID()
+		})
+		metaCache, exist := metaCaches[segment.GetVchannel()]
+		if !ok || !exist {
+			continue
+		}
+		metaCache.AddSegment(&datapb.SegmentInfo{
+			ID:            info.GetSegmentID(),
+			State:         commonpb.SegmentState_Importing,
+			CollectionID:  req.GetCollectionID(),
+			PartitionID:   segment.GetPartitionID(),
+			InsertChannel: segment.GetVchannel(),
+			NumOfRows:     info.GetImportedRows(),
+		}, func(info *datapb.SegmentInfo) *metacache.BloomFilterSet {
+			return metacache.NewBloomFilterSet()
+		})
+	}
 	return metaCaches
 }
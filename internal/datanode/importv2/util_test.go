@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/testutil"
 	"github.com/milvus-io/milvus/pkg/common"
 )
@@ -168,3 +169,96 @@ func Test_PickSegment(t *testing.T) {
 	fn(importedSize[int64(102)])
 	fn(importedSize[int64(103)])
 }
+
+func Test_FilterInvalidRows(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  101,
+				Name:     "str",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "4",
+					},
+				},
+			},
+			{
+				FieldID:  102,
+				Name:     "json",
+				DataType: schemapb.DataType_JSON,
+			},
+		},
+	}
+	newData := func() *storage.InsertData {
+		return &storage.InsertData{
+			Data: map[int64]storage.FieldData{
+				100: &storage.Int64FieldData{Data: []int64{1, 2, 3, 4}},
+				101: &storage.StringFieldData{Data: []string{"a", "abcde", "b", "abcdef"}},
+				102: &storage.JSONFieldData{Data: [][]byte{[]byte(`{}`), []byte(`{}`), []byte(`{`), []byte(`{"x": 1}`)}},
+			},
+		}
+	}
+
+	invalid, err := ValidateRows(schema, newData())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(invalid))
+	assert.Contains(t, invalid[1], "exceeds max length")
+	assert.Contains(t, invalid[2], "invalid JSON")
+
+	_, _, err = FilterInvalidRows(schema, newData(), false)
+	assert.Error(t, err)
+
+	data, rejected, err := FilterInvalidRows(schema, newData(), true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, data.GetRowNum())
+	assert.Equal(t, int64(1), data.Data[100].GetRow(0))
+	assert.Equal(t, 3, len(rejected))
+	assert.Equal(t, map[string]any{"pk": int64(3), "str": "b", "json": "{"}, rejected[1].Row)
+
+	data = &storage.InsertData{
+		Data: map[int64]storage.FieldData{
+			100: &storage.Int64FieldData{Data: []int64{1}},
+			101: &storage.StringFieldData{Data: []string{"a"}},
+			102: &storage.JSONFieldData{Data: [][]byte{[]byte(`{}`)}},
+		},
+	}
+	filtered, rejected, err := FilterInvalidRows(schema, data, true)
+	assert.NoError(t, err)
+	assert.Equal(t, data, filtered)
+	assert.Empty(t, rejected)
+}
+
+func Test_FilterDeletedRows(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "score", DataType: schemapb.DataType_Double},
+		},
+	}
+	data := &storage.InsertData{Data: map[int64]storage.FieldData{
+		common.RowIDField:     &storage.Int64FieldData{Data: []int64{1, 2, 3}},
+		common.TimeStampField: &storage.Int64FieldData{Data: []int64{10, 10, 30}},
+		100:                   &storage.Int64FieldData{Data: []int64{1, 2, 3}},
+		101:                   &storage.DoubleFieldData{Data: []float64{0.1, 0.2, 0.3}},
+	}}
+
+	// no deletes
+	filtered, err := FilterDeletedRows(schema, schema.Fields[0], data, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, filtered.GetRowNum())
+
+	// pk 1 is deleted after insertion, pk 3 is reinserted after deletion
+	filtered, err = FilterDeletedRows(schema, schema.Fields[0], data, map[any]uint64{int64(1): 20, int64(3): 20})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, filtered.GetRowNum())
+	assert.Equal(t, []int64{2, 3}, filtered.Data[100].GetRows())
+	assert.Equal(t, []float64{0.2, 0.3}, filtered.Data[101].GetRows())
+}
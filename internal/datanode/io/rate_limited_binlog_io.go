@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+)
+
+// rateLimitWaitInterval is the interval to check whether the rate limiter allows the bytes to read or write.
+var rateLimitWaitInterval = 50 * time.Millisecond
+
+// rateLimitedBinlogIO throttles the bytes read and written by the wrapped BinlogIO.
+type rateLimitedBinlogIO struct {
+	BinlogIO
+	limiter *ratelimitutil.Limiter
+}
+
+// NewRateLimitedBinlogIO returns a BinlogIO reading and writing no more than bytesPerSecond bytes per second on average,
+// the BinlogIO is returned as it is if bytesPerSecond is not positive.
+func NewRateLimitedBinlogIO(binlogIO BinlogIO, bytesPerSecond int64) BinlogIO {
+	if bytesPerSecond <= 0 {
+		return binlogIO
+	}
+	return &rateLimitedBinlogIO{
+		BinlogIO: binlogIO,
+		limiter:  ratelimitutil.NewLimiter(ratelimitutil.Limit(bytesPerSecond), float64(bytesPerSecond)),
+	}
+}
+
+func (b *rateLimitedBinlogIO) Download(ctx context.Context, paths []string) ([][]byte, error) {
+	values, err := b.BinlogIO.Download(ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	// the size is unknown before downloading, so the bytes downloaded delay the following reads and writes
+	size := lo.SumBy(values, func(value []byte) int { return len(value) })
+	if err := b.wait(ctx, size); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func (b *rateLimitedBinlogIO) Upload(ctx context.Context, kvs map[string][]byte) error {
+	size := 0
+	for _, value := range kvs {
+		size += len(value)
+	}
+	if err := b.wait(ctx, size); err != nil {
+		return err
+	}
+	return b.BinlogIO.Upload(ctx, kvs)
+}
+
+// wait blocks until the limiter allows the bytes or the context is done.
+func (b *rateLimitedBinlogIO) wait(ctx context.Context, size int) error {
+	for !b.limiter.AllowN(time.Now(), size) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(rateLimitWaitInterval):
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRateLimitedBinlogIO(t *testing.T) {
+	t.Run("no limit", func(t *testing.T) {
+		binlogIO := NewMockBinlogIO(t)
+		assert.Equal(t, BinlogIO(binlogIO), NewRateLimitedBinlogIO(binlogIO, 0))
+	})
+
+	t.Run("throttled", func(t *testing.T) {
+		binlogIO := NewMockBinlogIO(t)
+		binlogIO.EXPECT().Upload(mock.Anything, mock.Anything).Return(nil)
+		binlogIO.EXPECT().Download(mock.Anything, mock.Anything).Return([][]byte{make([]byte, 2000)}, nil)
+		limited := NewRateLimitedBinlogIO(binlogIO, 1000)
+
+		ctx := context.Background()
+		start := time.Now()
+		// the bytes are allowed by the initial burst
+		_, err := limited.Download(ctx, []string{"a"})
+		assert.NoError(t, err)
+		// the upload waits for the 1000 bytes downloaded beyond the burst
+		err = limited.Upload(ctx, map[string][]byte{"b": make([]byte, 10)})
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		binlogIO := NewMockBinlogIO(t)
+		binlogIO.EXPECT().Upload(mock.Anything, mock.Anything).Return(nil).Once()
+		limited := NewRateLimitedBinlogIO(binlogIO, 1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		err := limited.Upload(ctx, map[string][]byte{"a": make([]byte, 100)})
+		assert.NoError(t, err)
+		cancel()
+		err = limited.Upload(ctx, map[string][]byte{"b": make([]byte, 100)})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -122,3 +122,27 @@ func (node *DataNode) getSystemInfoMetrics(_ context.Context, _ *milvuspb.GetMet
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.DataNodeRole, paramtable.GetNodeID()),
 	}, nil
 }
+
+// getDiagnosticsMetrics captures the diagnostics of the DataNode for debugging the slow tasks.
+func (node *DataNode) getDiagnosticsMetrics() *milvuspb.GetMetricsResponse {
+	componentName := metricsinfo.ConstructComponentName(typeutil.DataNodeRole, paramtable.GetNodeID())
+	diagnostics, err := metricsinfo.CaptureWorkerDiagnostics(paramtable.GetNodeID())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status:        merr.Status(err),
+			ComponentName: componentName,
+		}
+	}
+	resp, err := metricsinfo.MarshalComponentInfos(diagnostics)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status:        merr.Status(err),
+			ComponentName: componentName,
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:        merr.Success(),
+		Response:      resp,
+		ComponentName: componentName,
+	}
+}
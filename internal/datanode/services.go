@@ -189,6 +189,11 @@ func (node *DataNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRe
 		return systemInfoMetrics, nil
 	}
 
+	if metricType == metricsinfo.DiagnosticsMetrics {
+		log.Info("DataNode.GetMetrics capture diagnostics", zap.Int64("nodeID", node.GetNodeID()))
+		return node.getDiagnosticsMetrics(), nil
+	}
+
 	log.RatedWarn(60, "DataNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("nodeID", node.GetNodeID()),
 		zap.String("req", req.Request),
@@ -224,7 +229,8 @@ func (node *DataNode) CompactionV2(ctx context.Context, req *datapb.CompactionPl
 	taskCtx := tracer.Propagate(ctx, node.ctx)
 
 	var task compaction.Compactor
-	binlogIO := io.NewBinlogIO(node.chunkManager)
+	// the rate limit is allocated by DataCoord from the compaction I/O budget of the cluster
+	binlogIO := io.NewRateLimitedBinlogIO(io.NewBinlogIO(node.chunkManager), req.GetIoRateLimit())
 	switch req.GetType() {
 	case datapb.CompactionType_Level0DeleteCompaction:
 		task = compaction.NewLevelZeroCompactionTask(
@@ -479,6 +485,11 @@ func (node *DataNode) ImportV2(ctx context.Context, req *datapb.ImportRequest) (
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	// The request may be resent if the response is lost, keep the progress of the existing task.
+	if node.importTaskMgr.Get(req.GetTaskID()) != nil {
+		log.Info("import task already exists")
+		return merr.Success(), nil
+	}
 	var task importv2.Task
 	if importutilv2.IsL0Import(req.GetOptions()) {
 		task = importv2.NewL0ImportTask(req, node.importTaskMgr, node.syncMgr, node.chunkManager)
@@ -549,6 +560,9 @@ func (node *DataNode) QueryImport(ctx context.Context, req *datapb.QueryImportRe
 		ImportSegmentsInfo: task.(interface {
 			GetSegmentsInfo() []*datapb.ImportSegmentInfo
 		}).GetSegmentsInfo(),
+		CompletedFileIDs: task.(interface {
+			GetCompletedFileIDs() []int64
+		}).GetCompletedFileIDs(),
 	}, nil
 }
 
@@ -567,6 +581,56 @@ func (node *DataNode) DropImport(ctx context.Context, req *datapb.DropImportRequ
 	return merr.Success(), nil
 }
 
+func (node *DataNode) ExportV2(ctx context.Context, req *datapb.ExportRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("taskID", req.GetTaskID()),
+		zap.Int64("jobID", req.GetJobID()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int("segments", len(req.GetSegments())),
+		zap.String("outputPath", req.GetOutputPath()))
+
+	log.Info("datanode receive export request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	// The request may be resent if the response is lost, keep the progress of the existing task.
+	if node.importTaskMgr.Get(req.GetTaskID()) != nil {
+		log.Info("export task already exists")
+		return merr.Success(), nil
+	}
+	task := importv2.NewExportTask(req, node.importTaskMgr, node.chunkManager)
+	node.importTaskMgr.Add(task)
+
+	log.Info("datanode added export task")
+	return merr.Success(), nil
+}
+
+func (node *DataNode) QueryExport(ctx context.Context, req *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("taskID", req.GetTaskID()),
+		zap.Int64("jobID", req.GetJobID()))
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &datapb.QueryExportResponse{Status: merr.Status(err)}, nil
+	}
+	task, ok := node.importTaskMgr.Get(req.GetTaskID()).(*importv2.ExportTask)
+	if !ok {
+		return &datapb.QueryExportResponse{
+			Status: merr.Status(importv2.WrapTaskNotFoundError(req.GetTaskID())),
+		}, nil
+	}
+	log.RatedInfo(10, "datanode query export", zap.String("state", task.GetState().String()),
+		zap.String("reason", task.GetReason()))
+	return &datapb.QueryExportResponse{
+		Status: merr.Success(),
+		TaskID: task.GetTaskID(),
+		State:  task.GetState(),
+		Reason: task.GetReason(),
+		ExportedSegments: lo.Filter(task.GetSegments(), func(segment *datapb.ExportSegment, _ int) bool {
+			return segment.GetExported()
+		}),
+	}, nil
+}
+
 func (node *DataNode) QuerySlot(ctx context.Context, req *datapb.QuerySlotRequest) (*datapb.QuerySlotResponse, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return &datapb.QuerySlotResponse{
@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/allocator"
 	"github.com/milvus-io/milvus/internal/datanode/broker"
 	"github.com/milvus-io/milvus/internal/datanode/compaction"
+	"github.com/milvus-io/milvus/internal/datanode/importv2"
 	"github.com/milvus-io/milvus/internal/datanode/util"
 	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
 	"github.com/milvus-io/milvus/internal/flushcommon/pipeline"
@@ -98,7 +99,7 @@ func (s *DataNodeServicesSuite) SetupTest() {
 	broker := broker.NewMockBroker(s.T())
 	broker.EXPECT().GetSegmentInfo(mock.Anything, mock.Anything).
 		Return([]*datapb.SegmentInfo{}, nil).Maybe()
-	broker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything).Return(nil).Maybe()
+	broker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	broker.EXPECT().SaveBinlogPaths(mock.Anything, mock.Anything).Return(nil).Maybe()
 	broker.EXPECT().UpdateChannelCheckpoint(mock.Anything, mock.Anything).Return(nil).Maybe()
 
@@ -516,6 +517,16 @@ func (s *DataNodeServicesSuite) TestGetMetrics() {
 	log.Info("Test DataNode.GetMetrics",
 		zap.String("name", resp.ComponentName),
 		zap.String("response", resp.Response))
+
+	// diagnostics
+	req, err = metricsinfo.ConstructRequestByMetricType(metricsinfo.DiagnosticsMetrics)
+	s.Assert().NoError(err)
+	resp, err = node.GetMetrics(node.ctx, req)
+	s.Assert().NoError(err)
+	s.Assert().True(merr.Ok(resp.GetStatus()))
+	diagnostics := &metricsinfo.WorkerDiagnostics{}
+	s.Assert().NoError(metricsinfo.UnmarshalComponentInfos(resp.GetResponse(), diagnostics))
+	s.Assert().NotEmpty(diagnostics.Goroutines)
 }
 
 func (s *DataNodeServicesSuite) TestResendSegmentStats() {
@@ -1171,3 +1182,55 @@ func (s *DataNodeServicesSuite) TestDropCompactionPlan() {
 		s.True(merr.Ok(status))
 	})
 }
+
+func (s *DataNodeServicesSuite) TestExport() {
+	s.Run("node not healthy", func() {
+		s.SetupTest()
+		s.node.UpdateStateCode(commonpb.StateCode_Abnormal)
+
+		ctx := context.Background()
+		status, err := s.node.ExportV2(ctx, &datapb.ExportRequest{})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrServiceNotReady)
+
+		resp, err := s.node.QueryExport(ctx, &datapb.QueryExportRequest{})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	s.Run("normal case", func() {
+		s.SetupTest()
+		ctx := context.Background()
+		req := &datapb.ExportRequest{
+			JobID:        1,
+			TaskID:       2,
+			CollectionID: 3,
+			Segments:     []*datapb.ExportSegment{{SegmentID: 4, PartitionID: 5}},
+			OutputPath:   "export",
+		}
+		status, err := s.node.ExportV2(ctx, req)
+		s.NoError(err)
+		s.True(merr.Ok(status))
+
+		// the resent request keeps the existing task
+		status, err = s.node.ExportV2(ctx, req)
+		s.NoError(err)
+		s.True(merr.Ok(status))
+
+		resp, err := s.node.QueryExport(ctx, &datapb.QueryExportRequest{JobID: 1, TaskID: 2})
+		s.NoError(err)
+		s.True(merr.Ok(resp.GetStatus()))
+		s.Equal(int64(2), resp.GetTaskID())
+		s.Empty(resp.GetExportedSegments())
+
+		s.node.importTaskMgr.Update(2, importv2.UpdateExportedSegment(4, 10, []string{"export/1/4_0.parquet"}))
+		resp, err = s.node.QueryExport(ctx, &datapb.QueryExportRequest{JobID: 1, TaskID: 2})
+		s.NoError(err)
+		s.Equal(1, len(resp.GetExportedSegments()))
+		s.Equal(int64(10), resp.GetExportedSegments()[0].GetExportedRows())
+
+		resp, err = s.node.QueryExport(ctx, &datapb.QueryExportRequest{JobID: 1, TaskID: 100})
+		s.NoError(err)
+		s.False(merr.Ok(resp.GetStatus()))
+	})
+}
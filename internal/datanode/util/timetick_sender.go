@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/datanode/broker"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -35,6 +36,7 @@ import (
 
 type StatsUpdater interface {
 	Update(channel string, ts Timestamp, stats []*commonpb.SegmentStats)
+	UpdateFlushProgress(channel string, ts Timestamp, progress []*datapb.SegmentFlushProgress)
 }
 
 // TimeTickSender is to merge channel states updated by flow graph node and send to datacoord periodically
@@ -49,8 +51,10 @@ type TimeTickSender struct {
 
 	options []retry.Option
 
-	mu         sync.RWMutex
-	statsCache map[string]*channelStats // channel -> channelStats
+	mu             sync.RWMutex
+	statsCache     map[string]*channelStats         // channel -> channelStats
+	progressCache  map[string]*channelFlushProgress // channel -> the latest flush progress of the channel
+	silentChannels map[string]*silentChannel        // channel -> the timetick backoff of the silent channel
 }
 
 type channelStats struct {
@@ -64,13 +68,27 @@ type segmentStats struct {
 	ts uint64
 }
 
+type channelFlushProgress struct {
+	progress []*datapb.SegmentFlushProgress
+	ts       uint64
+}
+
+// silentChannel is the channel without segment stats or flush progress to report,
+// the timetick of the channel is sent at the interval doubled after each send.
+type silentChannel struct {
+	interval time.Duration
+	lastSent time.Time
+}
+
 func NewTimeTickSender(broker broker.Broker, nodeID int64, opts ...retry.Option) *TimeTickSender {
 	return &TimeTickSender{
-		nodeID:     nodeID,
-		broker:     broker,
-		statsCache: make(map[string]*channelStats),
-		options:    opts,
-		mu:         sync.RWMutex{},
+		nodeID:         nodeID,
+		broker:         broker,
+		statsCache:     make(map[string]*channelStats),
+		progressCache:  make(map[string]*channelFlushProgress),
+		silentChannels: make(map[string]*silentChannel),
+		options:        opts,
+		mu:             sync.RWMutex{},
 	}
 }
 
@@ -125,14 +143,74 @@ func (m *TimeTickSender) Update(channelName string, timestamp uint64, segStats [
 	m.statsCache[channelName].lastTs = timestamp
 }
 
-func (m *TimeTickSender) assembleDatanodeTtMsg() ([]*msgpb.DataNodeTtMsg, map[string]uint64) {
+// UpdateFlushProgress replaces the flush progress of the channel to report.
+func (m *TimeTickSender) UpdateFlushProgress(channelName string, timestamp uint64, progress []*datapb.SegmentFlushProgress) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.progressCache[channelName] = &channelFlushProgress{
+		progress: progress,
+		ts:       timestamp,
+	}
+}
+
+func (m *TimeTickSender) assembleFlushProgress() ([]*datapb.SegmentFlushProgress, map[string]uint64) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
+	var progress []*datapb.SegmentFlushProgress
+	lastSentTss := make(map[string]uint64, len(m.progressCache))
+	for channelName, chanProgress := range m.progressCache {
+		progress = append(progress, chanProgress.progress...)
+		lastSentTss[channelName] = chanProgress.ts
+	}
+	return progress, lastSentTss
+}
+
+func (m *TimeTickSender) cleanFlushProgressCache(lastSentTss map[string]uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for channelName, lastSentTs := range lastSentTss {
+		if chanProgress, ok := m.progressCache[channelName]; ok && chanProgress.ts <= lastSentTs {
+			delete(m.progressCache, channelName)
+		}
+	}
+}
+
+// suppressSilentChannel returns whether to skip the timetick of the silent channel in this round,
+// the channel resumes the normal interval as soon as it has segment stats or flush progress to report.
+func (m *TimeTickSender) suppressSilentChannel(channelName string, chanStats *channelStats, now time.Time) bool {
+	baseInterval := paramtable.Get().DataNodeCfg.DataNodeTimeTickInterval.GetAsDuration(time.Millisecond)
+	maxInterval := paramtable.Get().DataNodeCfg.SilentChannelTimeTickMaxInterval.GetAsDuration(time.Millisecond)
+	progress, hasProgress := m.progressCache[channelName]
+	if len(chanStats.segStats) > 0 || (hasProgress && len(progress.progress) > 0) || maxInterval <= baseInterval {
+		delete(m.silentChannels, channelName)
+		return false
+	}
+	silent, ok := m.silentChannels[channelName]
+	if !ok {
+		m.silentChannels[channelName] = &silentChannel{interval: baseInterval, lastSent: now}
+		return false
+	}
+	if now.Sub(silent.lastSent) < silent.interval {
+		return true
+	}
+	silent.lastSent = now
+	silent.interval = min(silent.interval*2, maxInterval)
+	return false
+}
+
+func (m *TimeTickSender) assembleDatanodeTtMsg() ([]*msgpb.DataNodeTtMsg, map[string]uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	var msgs []*msgpb.DataNodeTtMsg
 	lastSentTss := make(map[string]uint64, 0)
 
+	now := time.Now()
 	for channelName, chanStats := range m.statsCache {
+		if m.suppressSilentChannel(channelName, chanStats, now) {
+			continue
+		}
 		toSendSegmentStats := lo.Map(lo.Values(chanStats.segStats), func(stats *segmentStats, _ int) *commonpb.SegmentStats {
 			return stats.SegmentStats
 		})
@@ -147,6 +225,13 @@ func (m *TimeTickSender) assembleDatanodeTtMsg() ([]*msgpb.DataNodeTtMsg, map[st
 		})
 		lastSentTss[channelName] = chanStats.lastTs
 	}
+	// forget the silent channels no longer updated, e.g. the released channels
+	maxInterval := paramtable.Get().DataNodeCfg.SilentChannelTimeTickMaxInterval.GetAsDuration(time.Millisecond)
+	for channelName, silent := range m.silentChannels {
+		if _, ok := m.statsCache[channelName]; !ok && now.Sub(silent.lastSent) > 2*maxInterval {
+			delete(m.silentChannels, channelName)
+		}
+	}
 
 	return msgs, lastSentTss
 }
@@ -173,14 +258,16 @@ func (m *TimeTickSender) cleanStatesCache(lastSentTss map[string]uint64) {
 
 func (m *TimeTickSender) sendReport(ctx context.Context) error {
 	toSendMsgs, sendLastTss := m.assembleDatanodeTtMsg()
+	toSendProgress, progressLastTss := m.assembleFlushProgress()
 	log.RatedDebug(30, "TimeTickSender send datanode timetick message", zap.Any("toSendMsgs", toSendMsgs), zap.Any("sendLastTss", sendLastTss))
 	err := retry.Do(ctx, func() error {
-		return m.broker.ReportTimeTick(ctx, toSendMsgs)
+		return m.broker.ReportTimeTick(ctx, toSendMsgs, toSendProgress)
 	}, m.options...)
 	if err != nil {
 		log.Error("ReportDataNodeTtMsgs fail after retry", zap.Error(err))
 		return err
 	}
 	m.cleanStatesCache(sendLastTss)
+	m.cleanFlushProgressCache(progressLastTss)
 	return nil
 }
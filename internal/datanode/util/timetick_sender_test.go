@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/atomic"
@@ -30,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/datanode/broker"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 )
@@ -38,7 +40,7 @@ func TestTimetickManagerNormal(t *testing.T) {
 	ctx := context.Background()
 
 	broker := broker.NewMockBroker(t)
-	broker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything).Return(nil).Maybe()
+	broker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 
 	manager := NewTimeTickSender(broker, 0)
 
@@ -143,7 +145,7 @@ func TestTimetickManagerSendErr(t *testing.T) {
 	ctx := context.Background()
 
 	broker := broker.NewMockBroker(t)
-	broker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything).Return(errors.New("mock")).Maybe()
+	broker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("mock")).Maybe()
 
 	manager := NewTimeTickSender(broker, 0, retry.Attempts(1))
 
@@ -162,14 +164,51 @@ func TestTimetickManagerSendErr(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestTimetickManagerFlushProgress(t *testing.T) {
+	ctx := context.Background()
+
+	var reported []*datapb.SegmentFlushProgress
+	broker := broker.NewMockBroker(t)
+	broker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ []*msgpb.DataNodeTtMsg, progress []*datapb.SegmentFlushProgress) {
+			reported = progress
+		}).
+		Return(nil)
+
+	manager := NewTimeTickSender(broker, 0)
+	manager.UpdateFlushProgress("channel1", 100, []*datapb.SegmentFlushProgress{
+		{SegmentID: 1, Channel: "channel1", PendingBinlogBytes: 1024},
+	})
+	// the latest progress of the channel replaces the previous one
+	manager.UpdateFlushProgress("channel1", 200, []*datapb.SegmentFlushProgress{
+		{SegmentID: 1, Channel: "channel1", PendingBinlogBytes: 2048},
+		{SegmentID: 2, Channel: "channel1"},
+	})
+	manager.UpdateFlushProgress("channel2", 200, []*datapb.SegmentFlushProgress{
+		{SegmentID: 3, Channel: "channel2", LastSyncTime: 1000},
+	})
+
+	err := manager.sendReport(ctx)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 2, 3}, lo.Map(reported, func(p *datapb.SegmentFlushProgress, _ int) int64 {
+		return p.GetSegmentID()
+	}))
+	assert.Empty(t, manager.progressCache)
+
+	// the progress is only reported once
+	err = manager.sendReport(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, reported)
+}
+
 func TestTimetickManagerSendReport(t *testing.T) {
 	mockDataCoord := mocks.NewMockDataCoordClient(t)
 
 	called := atomic.NewBool(false)
 
 	broker := broker.NewMockBroker(t)
-	broker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything).
-		Run(func(_ context.Context, _ []*msgpb.DataNodeTtMsg) {
+	broker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _ []*msgpb.DataNodeTtMsg, _ []*datapb.SegmentFlushProgress) {
 			called.Store(true)
 		}).
 		Return(nil)
@@ -183,3 +222,38 @@ func TestTimetickManagerSendReport(t *testing.T) {
 
 	manager.Stop()
 }
+
+func TestTimetickManagerSilentChannel(t *testing.T) {
+	manager := NewTimeTickSender(broker.NewMockBroker(t), 0)
+	channelNames := func(msgs []*msgpb.DataNodeTtMsg) []string {
+		return lo.Map(msgs, func(msg *msgpb.DataNodeTtMsg, _ int) string {
+			return msg.GetChannelName()
+		})
+	}
+
+	// the silent channel is sent at first
+	manager.Update("channel1", 100, nil)
+	msgs, _ := manager.assembleDatanodeTtMsg()
+	assert.Equal(t, []string{"channel1"}, channelNames(msgs))
+
+	// then suppressed within the interval
+	manager.Update("channel1", 200, nil)
+	msgs, _ = manager.assembleDatanodeTtMsg()
+	assert.Empty(t, msgs)
+
+	// the interval doubles after each send up to the max interval
+	chanStats := manager.statsCache["channel1"]
+	now := time.Now()
+	for i := 0; i < 10; i++ {
+		now = now.Add(10 * time.Second)
+		assert.False(t, manager.suppressSilentChannel("channel1", chanStats, now))
+	}
+	assert.Equal(t, 5*time.Second, manager.silentChannels["channel1"].interval)
+	assert.True(t, manager.suppressSilentChannel("channel1", chanStats, now.Add(time.Second)))
+
+	// resume as soon as the data appears
+	manager.Update("channel1", 300, []*commonpb.SegmentStats{{SegmentID: 1, NumRows: 10}})
+	msgs, _ = manager.assembleDatanodeTtMsg()
+	assert.Equal(t, []string{"channel1"}, channelNames(msgs))
+	assert.NotContains(t, manager.silentChannels, "channel1")
+}
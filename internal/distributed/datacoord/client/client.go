@@ -439,8 +439,8 @@ func (c *Client) WatchChannels(ctx context.Context, req *datapb.WatchChannelsReq
 }
 
 // GetFlushState gets the flush state of the collection based on the provided flush ts and segment IDs.
-func (c *Client) GetFlushState(ctx context.Context, req *datapb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error) {
-	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*milvuspb.GetFlushStateResponse, error) {
+func (c *Client) GetFlushState(ctx context.Context, req *datapb.GetFlushStateRequest, opts ...grpc.CallOption) (*datapb.GetFlushStateResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetFlushStateResponse, error) {
 		return client.GetFlushState(ctx, req)
 	})
 }
@@ -727,12 +727,153 @@ func (c *Client) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDat
 	})
 }
 
+// GcDryRun lists the files the garbage collection would remove without removing them.
+func (c *Client) GcDryRun(ctx context.Context, req *datapb.GcDryRunRequest, opts ...grpc.CallOption) (*datapb.GcDryRunResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GcDryRunResponse, error) {
+		return client.GcDryRun(ctx, req)
+	})
+}
+
 func (c *Client) GcControl(ctx context.Context, req *datapb.GcControlRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
 		return client.GcControl(ctx, req)
 	})
 }
 
+func (c *Client) CheckDuplicatePrimaryKeys(ctx context.Context, req *datapb.CheckDuplicatePrimaryKeysRequest, opts ...grpc.CallOption) (*datapb.CheckDuplicatePrimaryKeysResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.CheckDuplicatePrimaryKeysResponse, error) {
+		return client.CheckDuplicatePrimaryKeys(ctx, req)
+	})
+}
+
+func (c *Client) GetDuplicatePrimaryKeysReport(ctx context.Context, req *datapb.GetDuplicatePrimaryKeysReportRequest, opts ...grpc.CallOption) (*datapb.GetDuplicatePrimaryKeysReportResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetDuplicatePrimaryKeysReportResponse, error) {
+		return client.GetDuplicatePrimaryKeysReport(ctx, req)
+	})
+}
+
+func (c *Client) ListDatabaseTaskQuotas(ctx context.Context, req *datapb.ListDatabaseTaskQuotasRequest, opts ...grpc.CallOption) (*datapb.ListDatabaseTaskQuotasResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ListDatabaseTaskQuotasResponse, error) {
+		return client.ListDatabaseTaskQuotas(ctx, req)
+	})
+}
+
+// GetCollectionDataSummaries returns the row counts, sizes and last modifications of the data of the collections.
+func (c *Client) GetCollectionDataSummaries(ctx context.Context, req *datapb.GetCollectionDataSummariesRequest, opts ...grpc.CallOption) (*datapb.GetCollectionDataSummariesResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetCollectionDataSummariesResponse, error) {
+		return client.GetCollectionDataSummaries(ctx, req)
+	})
+}
+
+// PreviewCompaction generates the mix compaction plans of the collection without executing them.
+func (c *Client) PreviewCompaction(ctx context.Context, req *datapb.PreviewCompactionRequest, opts ...grpc.CallOption) (*datapb.PreviewCompactionResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.PreviewCompactionResponse, error) {
+		return client.PreviewCompaction(ctx, req)
+	})
+}
+
+// ExecuteCompactionPlans executes the previewed compaction plans as they are.
+func (c *Client) ExecuteCompactionPlans(ctx context.Context, req *datapb.ExecuteCompactionPlansRequest, opts ...grpc.CallOption) (*datapb.ExecuteCompactionPlansResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ExecuteCompactionPlansResponse, error) {
+		return client.ExecuteCompactionPlans(ctx, req)
+	})
+}
+
+// ListExpiryCompactions returns the latest rounds of the expiry compaction of the collections.
+func (c *Client) ListExpiryCompactions(ctx context.Context, req *datapb.ListExpiryCompactionsRequest, opts ...grpc.CallOption) (*datapb.ListExpiryCompactionsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ListExpiryCompactionsResponse, error) {
+		return client.ListExpiryCompactions(ctx, req)
+	})
+}
+
+// ExportSegmentManifests returns the manifest of the sealed segments of the collection at the timestamp.
+func (c *Client) ExportSegmentManifests(ctx context.Context, req *datapb.ExportSegmentManifestsRequest, opts ...grpc.CallOption) (*datapb.ExportSegmentManifestsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ExportSegmentManifestsResponse, error) {
+		return client.ExportSegmentManifests(ctx, req)
+	})
+}
+
+// AcquireSegmentReference acquires or renews a lease on the binlogs of the segments for an external reader.
+func (c *Client) AcquireSegmentReference(ctx context.Context, req *datapb.AcquireSegmentReferenceRequest, opts ...grpc.CallOption) (*datapb.AcquireSegmentReferenceResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.AcquireSegmentReferenceResponse, error) {
+		return client.AcquireSegmentReference(ctx, req)
+	})
+}
+
+// ReleaseSegmentReference releases the lease on the binlogs of the segments acquired by an external reader.
+func (c *Client) ReleaseSegmentReference(ctx context.Context, req *datapb.ReleaseSegmentReferenceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.ReleaseSegmentReference(ctx, req)
+	})
+}
+
+// GetCompactionPlanDetails returns the details of the compaction plans.
+func (c *Client) GetCompactionPlanDetails(ctx context.Context, req *datapb.GetCompactionPlanDetailsRequest, opts ...grpc.CallOption) (*datapb.GetCompactionPlanDetailsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetCompactionPlanDetailsResponse, error) {
+		return client.GetCompactionPlanDetails(ctx, req)
+	})
+}
+
+// CloneSegments clones the flushed segments and their indexes of the source collection into the target one.
+func (c *Client) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest, opts ...grpc.CallOption) (*datapb.CloneSegmentsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.CloneSegmentsResponse, error) {
+		return client.CloneSegments(ctx, req)
+	})
+}
+
+// ExportV2 starts a job to export the sealed segments of a collection or its partitions as Parquet files.
+func (c *Client) ExportV2(ctx context.Context, req *datapb.ExportV2Request, opts ...grpc.CallOption) (*datapb.ExportV2Response, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ExportV2Response, error) {
+		return client.ExportV2(ctx, req)
+	})
+}
+
+// GetExportProgress returns the progress of the export job.
+func (c *Client) GetExportProgress(ctx context.Context, req *datapb.GetExportProgressRequest, opts ...grpc.CallOption) (*datapb.GetExportProgressResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetExportProgressResponse, error) {
+		return client.GetExportProgress(ctx, req)
+	})
+}
+
+// GetRegionReplicaStatus returns the staleness of the collections mirrored from the primary region.
+func (c *Client) GetRegionReplicaStatus(ctx context.Context, req *datapb.GetRegionReplicaStatusRequest, opts ...grpc.CallOption) (*datapb.GetRegionReplicaStatusResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetRegionReplicaStatusResponse, error) {
+		return client.GetRegionReplicaStatus(ctx, req)
+	})
+}
+
+// FailoverRegionReplica stops shipping from the primary region and turns the secondary region into the primary.
+func (c *Client) FailoverRegionReplica(ctx context.Context, req *datapb.FailoverRegionReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.FailoverRegionReplica(ctx, req)
+	})
+}
+
 func (c *Client) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*internalpb.ImportResponse, error) {
 		return client.ImportV2(ctx, in)
@@ -756,3 +897,45 @@ func (c *Client) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest
 		return client.ListIndexes(ctx, in)
 	})
 }
+
+func (c *Client) PauseIndexBuilds(ctx context.Context, in *indexpb.PauseIndexBuildsRequest, opts ...grpc.CallOption) (*indexpb.PauseIndexBuildsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.PauseIndexBuildsResponse, error) {
+		return client.PauseIndexBuilds(ctx, in)
+	})
+}
+
+func (c *Client) RebuildIncompatibleIndexes(ctx context.Context, in *indexpb.RebuildIncompatibleIndexesRequest, opts ...grpc.CallOption) (*indexpb.RebuildIncompatibleIndexesResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.RebuildIncompatibleIndexesResponse, error) {
+		return client.RebuildIncompatibleIndexes(ctx, in)
+	})
+}
+
+func (c *Client) ResumeIndexBuilds(ctx context.Context, in *indexpb.ResumeIndexBuildsRequest, opts ...grpc.CallOption) (*indexpb.ResumeIndexBuildsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.ResumeIndexBuildsResponse, error) {
+		return client.ResumeIndexBuilds(ctx, in)
+	})
+}
+
+func (c *Client) ListIndexTasks(ctx context.Context, in *indexpb.ListIndexTasksRequest, opts ...grpc.CallOption) (*indexpb.ListIndexTasksResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*indexpb.ListIndexTasksResponse, error) {
+		return client.ListIndexTasks(ctx, in)
+	})
+}
+
+func (c *Client) DropAnalyzeTask(ctx context.Context, in *indexpb.DropAnalyzeTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.DropAnalyzeTask(ctx, in)
+	})
+}
+
+func (c *Client) ForceCompleteTask(ctx context.Context, in *indexpb.ForceTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.ForceCompleteTask(ctx, in)
+	})
+}
+
+func (c *Client) ForceFailTask(ctx context.Context, in *indexpb.ForceTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.ForceFailTask(ctx, in)
+	})
+}
@@ -1225,7 +1225,7 @@ func Test_GetFlushState(t *testing.T) {
 	client.(*Client).grpcClient = mockGrpcClient
 
 	// test success
-	mockDC.EXPECT().GetFlushState(mock.Anything, mock.Anything).Return(&milvuspb.GetFlushStateResponse{
+	mockDC.EXPECT().GetFlushState(mock.Anything, mock.Anything).Return(&datapb.GetFlushStateResponse{
 		Status: merr.Success(),
 	}, nil)
 	_, err = client.GetFlushState(ctx, &datapb.GetFlushStateRequest{})
@@ -1233,7 +1233,7 @@ func Test_GetFlushState(t *testing.T) {
 
 	// test return error status
 	mockDC.ExpectedCalls = nil
-	mockDC.EXPECT().GetFlushState(mock.Anything, mock.Anything).Return(&milvuspb.GetFlushStateResponse{
+	mockDC.EXPECT().GetFlushState(mock.Anything, mock.Anything).Return(&datapb.GetFlushStateResponse{
 		Status: merr.Status(merr.ErrServiceNotReady),
 	}, nil)
 
@@ -1243,7 +1243,7 @@ func Test_GetFlushState(t *testing.T) {
 
 	// test return error
 	mockDC.ExpectedCalls = nil
-	mockDC.EXPECT().GetFlushState(mock.Anything, mock.Anything).Return(&milvuspb.GetFlushStateResponse{
+	mockDC.EXPECT().GetFlushState(mock.Anything, mock.Anything).Return(&datapb.GetFlushStateResponse{
 		Status: merr.Success(),
 	}, mockErr)
 
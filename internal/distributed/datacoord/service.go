@@ -54,6 +54,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/logutil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tikv"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // Server is the grpc server of datacoord
@@ -186,6 +187,7 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 				}
 				return s.serverID.Load()
 			}),
+			utils.NewAPILimiter(typeutil.DataCoordRole, Params, s.etcdCli).UnaryServerInterceptor(),
 			streamingserviceinterceptor.NewStreamingServiceUnaryServerInterceptor(),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
@@ -389,7 +391,7 @@ func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsReq
 }
 
 // GetFlushState gets the flush state of the collection based on the provided flush ts and segment IDs.
-func (s *Server) GetFlushState(ctx context.Context, req *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+func (s *Server) GetFlushState(ctx context.Context, req *datapb.GetFlushStateRequest) (*datapb.GetFlushStateResponse, error) {
 	return s.dataCoord.GetFlushState(ctx, req)
 }
 
@@ -483,10 +485,91 @@ func (s *Server) ReportDataNodeTtMsgs(ctx context.Context, req *datapb.ReportDat
 	return s.dataCoord.ReportDataNodeTtMsgs(ctx, req)
 }
 
+// GcDryRun lists the files the garbage collection would remove without removing them.
+func (s *Server) GcDryRun(ctx context.Context, req *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error) {
+	return s.dataCoord.GcDryRun(ctx, req)
+}
+
 func (s *Server) GcControl(ctx context.Context, req *datapb.GcControlRequest) (*commonpb.Status, error) {
 	return s.dataCoord.GcControl(ctx, req)
 }
 
+func (s *Server) CheckDuplicatePrimaryKeys(ctx context.Context, req *datapb.CheckDuplicatePrimaryKeysRequest) (*datapb.CheckDuplicatePrimaryKeysResponse, error) {
+	return s.dataCoord.CheckDuplicatePrimaryKeys(ctx, req)
+}
+
+func (s *Server) GetDuplicatePrimaryKeysReport(ctx context.Context, req *datapb.GetDuplicatePrimaryKeysReportRequest) (*datapb.GetDuplicatePrimaryKeysReportResponse, error) {
+	return s.dataCoord.GetDuplicatePrimaryKeysReport(ctx, req)
+}
+
+func (s *Server) ListDatabaseTaskQuotas(ctx context.Context, req *datapb.ListDatabaseTaskQuotasRequest) (*datapb.ListDatabaseTaskQuotasResponse, error) {
+	return s.dataCoord.ListDatabaseTaskQuotas(ctx, req)
+}
+
+func (s *Server) GetCollectionDataSummaries(ctx context.Context, req *datapb.GetCollectionDataSummariesRequest) (*datapb.GetCollectionDataSummariesResponse, error) {
+	return s.dataCoord.GetCollectionDataSummaries(ctx, req)
+}
+
+// PreviewCompaction generates the mix compaction plans of the collection without executing them.
+func (s *Server) PreviewCompaction(ctx context.Context, req *datapb.PreviewCompactionRequest) (*datapb.PreviewCompactionResponse, error) {
+	return s.dataCoord.PreviewCompaction(ctx, req)
+}
+
+// ExecuteCompactionPlans executes the previewed compaction plans as they are.
+func (s *Server) ExecuteCompactionPlans(ctx context.Context, req *datapb.ExecuteCompactionPlansRequest) (*datapb.ExecuteCompactionPlansResponse, error) {
+	return s.dataCoord.ExecuteCompactionPlans(ctx, req)
+}
+
+// ListExpiryCompactions returns the latest rounds of the expiry compaction of the collections.
+func (s *Server) ListExpiryCompactions(ctx context.Context, req *datapb.ListExpiryCompactionsRequest) (*datapb.ListExpiryCompactionsResponse, error) {
+	return s.dataCoord.ListExpiryCompactions(ctx, req)
+}
+
+// ExportSegmentManifests returns the manifest of the sealed segments of the collection at the timestamp.
+func (s *Server) ExportSegmentManifests(ctx context.Context, req *datapb.ExportSegmentManifestsRequest) (*datapb.ExportSegmentManifestsResponse, error) {
+	return s.dataCoord.ExportSegmentManifests(ctx, req)
+}
+
+// AcquireSegmentReference acquires or renews a lease on the binlogs of the segments for an external reader.
+func (s *Server) AcquireSegmentReference(ctx context.Context, req *datapb.AcquireSegmentReferenceRequest) (*datapb.AcquireSegmentReferenceResponse, error) {
+	return s.dataCoord.AcquireSegmentReference(ctx, req)
+}
+
+// ReleaseSegmentReference releases the lease on the binlogs of the segments acquired by an external reader.
+func (s *Server) ReleaseSegmentReference(ctx context.Context, req *datapb.ReleaseSegmentReferenceRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ReleaseSegmentReference(ctx, req)
+}
+
+// GetCompactionPlanDetails returns the details of the compaction plans.
+func (s *Server) GetCompactionPlanDetails(ctx context.Context, req *datapb.GetCompactionPlanDetailsRequest) (*datapb.GetCompactionPlanDetailsResponse, error) {
+	return s.dataCoord.GetCompactionPlanDetails(ctx, req)
+}
+
+// CloneSegments clones the flushed segments and their indexes of the source collection into the target one.
+func (s *Server) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	return s.dataCoord.CloneSegments(ctx, req)
+}
+
+// ExportV2 starts a job to export the sealed segments of a collection or its partitions as Parquet files.
+func (s *Server) ExportV2(ctx context.Context, req *datapb.ExportV2Request) (*datapb.ExportV2Response, error) {
+	return s.dataCoord.ExportV2(ctx, req)
+}
+
+// GetExportProgress returns the progress of the export job.
+func (s *Server) GetExportProgress(ctx context.Context, req *datapb.GetExportProgressRequest) (*datapb.GetExportProgressResponse, error) {
+	return s.dataCoord.GetExportProgress(ctx, req)
+}
+
+// GetRegionReplicaStatus returns the staleness of the collections mirrored from the primary region.
+func (s *Server) GetRegionReplicaStatus(ctx context.Context, req *datapb.GetRegionReplicaStatusRequest) (*datapb.GetRegionReplicaStatusResponse, error) {
+	return s.dataCoord.GetRegionReplicaStatus(ctx, req)
+}
+
+// FailoverRegionReplica stops shipping from the primary region and turns the secondary region into the primary.
+func (s *Server) FailoverRegionReplica(ctx context.Context, req *datapb.FailoverRegionReplicaRequest) (*commonpb.Status, error) {
+	return s.dataCoord.FailoverRegionReplica(ctx, req)
+}
+
 func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	return s.dataCoord.ImportV2(ctx, in)
 }
@@ -502,3 +585,31 @@ func (s *Server) ListImports(ctx context.Context, in *internalpb.ListImportsRequ
 func (s *Server) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	return s.dataCoord.ListIndexes(ctx, in)
 }
+
+func (s *Server) PauseIndexBuilds(ctx context.Context, in *indexpb.PauseIndexBuildsRequest) (*indexpb.PauseIndexBuildsResponse, error) {
+	return s.dataCoord.PauseIndexBuilds(ctx, in)
+}
+
+func (s *Server) RebuildIncompatibleIndexes(ctx context.Context, in *indexpb.RebuildIncompatibleIndexesRequest) (*indexpb.RebuildIncompatibleIndexesResponse, error) {
+	return s.dataCoord.RebuildIncompatibleIndexes(ctx, in)
+}
+
+func (s *Server) ResumeIndexBuilds(ctx context.Context, in *indexpb.ResumeIndexBuildsRequest) (*indexpb.ResumeIndexBuildsResponse, error) {
+	return s.dataCoord.ResumeIndexBuilds(ctx, in)
+}
+
+func (s *Server) ListIndexTasks(ctx context.Context, in *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error) {
+	return s.dataCoord.ListIndexTasks(ctx, in)
+}
+
+func (s *Server) DropAnalyzeTask(ctx context.Context, in *indexpb.DropAnalyzeTaskRequest) (*commonpb.Status, error) {
+	return s.dataCoord.DropAnalyzeTask(ctx, in)
+}
+
+func (s *Server) ForceCompleteTask(ctx context.Context, in *indexpb.ForceTaskRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ForceCompleteTask(ctx, in)
+}
+
+func (s *Server) ForceFailTask(ctx context.Context, in *indexpb.ForceTaskRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ForceFailTask(ctx, in)
+}
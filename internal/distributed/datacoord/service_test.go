@@ -165,7 +165,7 @@ func Test_NewServer(t *testing.T) {
 	})
 
 	t.Run("GetFlushState", func(t *testing.T) {
-		mockDataCoord.EXPECT().GetFlushState(mock.Anything, mock.Anything).Return(&milvuspb.GetFlushStateResponse{}, nil)
+		mockDataCoord.EXPECT().GetFlushState(mock.Anything, mock.Anything).Return(&datapb.GetFlushStateResponse{}, nil)
 		resp, err := server.GetFlushState(ctx, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
@@ -256,6 +256,18 @@ func (c *Client) DropImport(ctx context.Context, req *datapb.DropImportRequest,
 	})
 }
 
+func (c *Client) ExportV2(ctx context.Context, req *datapb.ExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
+		return client.ExportV2(ctx, req)
+	})
+}
+
+func (c *Client) QueryExport(ctx context.Context, req *datapb.QueryExportRequest, opts ...grpc.CallOption) (*datapb.QueryExportResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*datapb.QueryExportResponse, error) {
+		return client.QueryExport(ctx, req)
+	})
+}
+
 func (c *Client) QuerySlot(ctx context.Context, req *datapb.QuerySlotRequest, opts ...grpc.CallOption) (*datapb.QuerySlotResponse, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*datapb.QuerySlotResponse, error) {
 		return client.QuerySlot(ctx, req)
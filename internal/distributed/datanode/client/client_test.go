@@ -86,6 +86,12 @@ func Test_NewClient(t *testing.T) {
 
 		r14, err := client.DropCompactionPlan(ctx, nil)
 		retCheck(retNotNil, r14, err)
+
+		r15, err := client.ExportV2(ctx, nil)
+		retCheck(retNotNil, r15, err)
+
+		r16, err := client.QueryExport(ctx, nil)
+		retCheck(retNotNil, r16, err)
 	}
 
 	client.(*Client).grpcClient = &mock.GRPCClientBase[datapb.DataNodeClient]{
@@ -403,6 +403,14 @@ func (s *Server) DropImport(ctx context.Context, req *datapb.DropImportRequest)
 	return s.datanode.DropImport(ctx, req)
 }
 
+func (s *Server) ExportV2(ctx context.Context, req *datapb.ExportRequest) (*commonpb.Status, error) {
+	return s.datanode.ExportV2(ctx, req)
+}
+
+func (s *Server) QueryExport(ctx context.Context, req *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	return s.datanode.QueryExport(ctx, req)
+}
+
 func (s *Server) QuerySlot(ctx context.Context, req *datapb.QuerySlotRequest) (*datapb.QuerySlotResponse, error) {
 	return s.datanode.QuerySlot(ctx, req)
 }
@@ -177,6 +177,14 @@ func (m *MockDataNode) DropImport(ctx context.Context, req *datapb.DropImportReq
 	return m.status, m.err
 }
 
+func (m *MockDataNode) ExportV2(ctx context.Context, req *datapb.ExportRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataNode) QueryExport(ctx context.Context, req *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	return &datapb.QueryExportResponse{}, m.err
+}
+
 func (m *MockDataNode) QuerySlot(ctx context.Context, req *datapb.QuerySlotRequest) (*datapb.QuerySlotResponse, error) {
 	return &datapb.QuerySlotResponse{}, m.err
 }
@@ -330,6 +338,24 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("ExportV2", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.ExportV2(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("QueryExport", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.QueryExport(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err = server.Stop()
 	assert.NoError(t, err)
 }
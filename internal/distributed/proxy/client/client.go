@@ -193,6 +193,62 @@ func (c *Client) ListClientInfos(ctx context.Context, req *proxypb.ListClientInf
 	})
 }
 
+// CreateCollectionWithConfig creates the collection with its indexes and loads it in one call.
+func (c *Client) CreateCollectionWithConfig(ctx context.Context, req *proxypb.CreateCollectionWithConfigRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.CreateCollectionWithConfig(ctx, req)
+	})
+}
+
+// ShowCollectionsWithMetadata lists the collections with their sizes, row counts, load states and indexes.
+func (c *Client) ShowCollectionsWithMetadata(ctx context.Context, req *proxypb.ShowCollectionsWithMetadataRequest, opts ...grpc.CallOption) (*proxypb.ShowCollectionsWithMetadataResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.ShowCollectionsWithMetadataResponse, error) {
+		return client.ShowCollectionsWithMetadata(ctx, req)
+	})
+}
+
+// WatchCollections opens the stream of the changes of the collections.
+func (c *Client) WatchCollections(ctx context.Context, req *proxypb.ShowCollectionsWithMetadataRequest, opts ...grpc.CallOption) (proxypb.Proxy_WatchCollectionsClient, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	ret, err := c.grpcClient.ReCall(ctx, func(client proxypb.ProxyClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+
+		return client.WatchCollections(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(proxypb.Proxy_WatchCollectionsClient), nil
+}
+
+// CloneCollection creates a collection with the same schema, partitions, indexes and flushed data of the source.
+func (c *Client) CloneCollection(ctx context.Context, req *proxypb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.CloneCollection(ctx, req)
+	})
+}
+
 func (c *Client) GetDdChannel(ctx context.Context, req *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
 	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*milvuspb.StringResponse, error) {
 		return client.GetDdChannel(ctx, req)
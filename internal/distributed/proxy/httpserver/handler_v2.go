@@ -1980,6 +1980,9 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 		returnData["progress"] = response.GetProgress()
 		returnData["importedRows"] = response.GetImportedRows()
 		returnData["totalRows"] = response.GetTotalRows()
+		returnData["rejectedRows"] = response.GetRejectedRows()
+		returnData["priority"] = response.GetPriority()
+		returnData["queuePosition"] = response.GetQueuePosition()
 		reason := response.GetReason()
 		if reason != "" {
 			returnData["reason"] = reason
@@ -1995,6 +1998,10 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 			detail["state"] = taskProgress.GetState()
 			detail["importedRows"] = taskProgress.GetImportedRows()
 			detail["totalRows"] = taskProgress.GetTotalRows()
+			detail["rejectedRows"] = taskProgress.GetRejectedRows()
+			if taskProgress.GetQuarantinePath() != "" {
+				detail["quarantinePath"] = taskProgress.GetQuarantinePath()
+			}
 			reason = taskProgress.GetReason()
 			if reason != "" {
 				detail["reason"] = reason
@@ -299,12 +299,14 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	if enableCustomInterceptor {
 		unaryServerOption = grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			accesslog.UnaryAccessLogInterceptor,
+			proxy.TraceSamplingInterceptor(),
 			otelgrpc.UnaryServerInterceptor(opts...),
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
 			proxy.DatabaseInterceptor(),
 			proxy.UnaryServerHookInterceptor(),
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 			logutil.UnaryTraceLoggerInterceptor,
+			proxy.SLOInterceptor(),
 			proxy.RateLimitInterceptor(limiter),
 			accesslog.UnaryUpdateAccessInfoInterceptor,
 			proxy.TraceLogInterceptor,
@@ -1223,6 +1225,22 @@ func (s *Server) ListClientInfos(ctx context.Context, req *proxypb.ListClientInf
 	return s.proxy.ListClientInfos(ctx, req)
 }
 
+func (s *Server) CreateCollectionWithConfig(ctx context.Context, req *proxypb.CreateCollectionWithConfigRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateCollectionWithConfig(ctx, req)
+}
+
+func (s *Server) ShowCollectionsWithMetadata(ctx context.Context, req *proxypb.ShowCollectionsWithMetadataRequest) (*proxypb.ShowCollectionsWithMetadataResponse, error) {
+	return s.proxy.ShowCollectionsWithMetadata(ctx, req)
+}
+
+func (s *Server) WatchCollections(req *proxypb.ShowCollectionsWithMetadataRequest, stream proxypb.Proxy_WatchCollectionsServer) error {
+	return s.proxy.WatchCollections(req, stream)
+}
+
+func (s *Server) CloneCollection(ctx context.Context, req *proxypb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.CloneCollection(ctx, req)
+}
+
 func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateDatabase(ctx, request)
 }
@@ -512,3 +512,36 @@ func (c *Client) CheckQueryNodeDistribution(ctx context.Context, req *querypb.Ch
 		return client.CheckQueryNodeDistribution(ctx, req)
 	})
 }
+
+func (c *Client) SafeRemoveNode(ctx context.Context, req *querypb.SafeRemoveNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.SafeRemoveNode(ctx, req)
+	})
+}
+
+func (c *Client) GetSafeRemoveNodeProgress(ctx context.Context, req *querypb.GetSafeRemoveNodeProgressRequest, opts ...grpc.CallOption) (*querypb.GetSafeRemoveNodeProgressResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.GetSafeRemoveNodeProgressResponse, error) {
+		return client.GetSafeRemoveNodeProgress(ctx, req)
+	})
+}
+
+func (c *Client) CheckRowCountConsistency(ctx context.Context, req *querypb.CheckRowCountConsistencyRequest, opts ...grpc.CallOption) (*querypb.CheckRowCountConsistencyResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*querypb.CheckRowCountConsistencyResponse, error) {
+		return client.CheckRowCountConsistency(ctx, req)
+	})
+}
@@ -185,6 +185,15 @@ func Test_NewClient(t *testing.T) {
 
 		r39, err := client.CheckQueryNodeDistribution(ctx, nil)
 		retCheck(retNotNil, r39, err)
+
+		r40, err := client.SafeRemoveNode(ctx, nil)
+		retCheck(retNotNil, r40, err)
+
+		r41, err := client.GetSafeRemoveNodeProgress(ctx, nil)
+		retCheck(retNotNil, r41, err)
+
+		r42, err := client.CheckRowCountConsistency(ctx, nil)
+		retCheck(retNotNil, r42, err)
 	}
 
 	client.(*Client).grpcClient = &mock.GRPCClientBase[querypb.QueryCoordClient]{
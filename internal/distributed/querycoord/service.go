@@ -486,3 +486,15 @@ func (s *Server) TransferChannel(ctx context.Context, req *querypb.TransferChann
 func (s *Server) CheckQueryNodeDistribution(ctx context.Context, req *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error) {
 	return s.queryCoord.CheckQueryNodeDistribution(ctx, req)
 }
+
+func (s *Server) SafeRemoveNode(ctx context.Context, req *querypb.SafeRemoveNodeRequest) (*commonpb.Status, error) {
+	return s.queryCoord.SafeRemoveNode(ctx, req)
+}
+
+func (s *Server) GetSafeRemoveNodeProgress(ctx context.Context, req *querypb.GetSafeRemoveNodeProgressRequest) (*querypb.GetSafeRemoveNodeProgressResponse, error) {
+	return s.queryCoord.GetSafeRemoveNodeProgress(ctx, req)
+}
+
+func (s *Server) CheckRowCountConsistency(ctx context.Context, req *querypb.CheckRowCountConsistencyRequest) (*querypb.CheckRowCountConsistencyResponse, error) {
+	return s.queryCoord.CheckRowCountConsistency(ctx, req)
+}
@@ -355,6 +355,30 @@ func Test_NewServer(t *testing.T) {
 			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 		})
 
+		t.Run("SafeRemoveNode", func(t *testing.T) {
+			req := &querypb.SafeRemoveNodeRequest{}
+			mqc.EXPECT().SafeRemoveNode(mock.Anything, req).Return(merr.Success(), nil)
+			resp, err := server.SafeRemoveNode(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
+		t.Run("GetSafeRemoveNodeProgress", func(t *testing.T) {
+			req := &querypb.GetSafeRemoveNodeProgressRequest{}
+			mqc.EXPECT().GetSafeRemoveNodeProgress(mock.Anything, req).Return(&querypb.GetSafeRemoveNodeProgressResponse{Status: merr.Success()}, nil)
+			resp, err := server.GetSafeRemoveNodeProgress(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
+		t.Run("CheckRowCountConsistency", func(t *testing.T) {
+			req := &querypb.CheckRowCountConsistencyRequest{}
+			mqc.EXPECT().CheckRowCountConsistency(mock.Anything, req).Return(&querypb.CheckRowCountConsistencyResponse{Status: merr.Success()}, nil)
+			resp, err := server.CheckRowCountConsistency(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		})
+
 		err = server.Stop()
 		assert.NoError(t, err)
 	}
@@ -682,3 +682,47 @@ func (c *Client) AlterDatabase(ctx context.Context, request *rootcoordpb.AlterDa
 		return client.AlterDatabase(ctx, request)
 	})
 }
+
+func (c *Client) GetDropCollectionProgress(ctx context.Context, req *rootcoordpb.GetDropCollectionProgressRequest, opts ...grpc.CallOption) (*rootcoordpb.GetDropCollectionProgressResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*rootcoordpb.GetDropCollectionProgressResponse, error) {
+		return client.GetDropCollectionProgress(ctx, req)
+	})
+}
+
+func (c *Client) UpdatePartitionKeyStats(ctx context.Context, req *rootcoordpb.UpdatePartitionKeyStatsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.UpdatePartitionKeyStats(ctx, req)
+	})
+}
+
+func (c *Client) GetPartitionKeyStats(ctx context.Context, req *rootcoordpb.GetPartitionKeyStatsRequest, opts ...grpc.CallOption) (*rootcoordpb.GetPartitionKeyStatsResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*rootcoordpb.GetPartitionKeyStatsResponse, error) {
+		return client.GetPartitionKeyStats(ctx, req)
+	})
+}
+
+func (c *Client) GetMetaSnapshot(ctx context.Context, req *rootcoordpb.GetMetaSnapshotRequest, opts ...grpc.CallOption) (*rootcoordpb.GetMetaSnapshotResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*rootcoordpb.GetMetaSnapshotResponse, error) {
+		return client.GetMetaSnapshot(ctx, req)
+	})
+}
@@ -244,6 +244,22 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.AlterDatabase(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.GetDropCollectionProgress(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.UpdatePartitionKeyStats(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GetPartitionKeyStats(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GetMetaSnapshot(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 	}
 
 	client.(*Client).grpcClient = &mock.GRPCClientBase[rootcoordpb.RootCoordClient]{
@@ -53,6 +53,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/logutil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tikv"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // Server grpc wrapper
@@ -288,6 +289,7 @@ func (s *Server) startGrpcLoop(port int) {
 				}
 				return s.serverID.Load()
 			}),
+			utils.NewAPILimiter(typeutil.RootCoordRole, Params, s.etcdCli).UnaryServerInterceptor(),
 		)),
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			otelgrpc.StreamServerInterceptor(opts...),
@@ -533,3 +535,23 @@ func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCol
 func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.RenameCollection(ctx, request)
 }
+
+// GetDropCollectionProgress returns the stage of reclaiming the resources of the dropped collection.
+func (s *Server) GetDropCollectionProgress(ctx context.Context, in *rootcoordpb.GetDropCollectionProgressRequest) (*rootcoordpb.GetDropCollectionProgressResponse, error) {
+	return s.rootCoord.GetDropCollectionProgress(ctx, in)
+}
+
+// UpdatePartitionKeyStats merges the buckets of the partition keys into the partitions.
+func (s *Server) UpdatePartitionKeyStats(ctx context.Context, in *rootcoordpb.UpdatePartitionKeyStatsRequest) (*commonpb.Status, error) {
+	return s.rootCoord.UpdatePartitionKeyStats(ctx, in)
+}
+
+// GetPartitionKeyStats returns the buckets of the partition keys of the partitions in the collection.
+func (s *Server) GetPartitionKeyStats(ctx context.Context, in *rootcoordpb.GetPartitionKeyStatsRequest) (*rootcoordpb.GetPartitionKeyStatsResponse, error) {
+	return s.rootCoord.GetPartitionKeyStats(ctx, in)
+}
+
+// GetMetaSnapshot returns the databases and collections in bulk.
+func (s *Server) GetMetaSnapshot(ctx context.Context, in *rootcoordpb.GetMetaSnapshotRequest) (*rootcoordpb.GetMetaSnapshotResponse, error) {
+	return s.rootCoord.GetMetaSnapshot(ctx, in)
+}
@@ -64,6 +64,22 @@ func (m *mockCore) AlterDatabase(ctx context.Context, request *rootcoordpb.Alter
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (m *mockCore) GetDropCollectionProgress(ctx context.Context, request *rootcoordpb.GetDropCollectionProgressRequest) (*rootcoordpb.GetDropCollectionProgressResponse, error) {
+	return &rootcoordpb.GetDropCollectionProgressResponse{Status: merr.Success()}, nil
+}
+
+func (m *mockCore) UpdatePartitionKeyStats(ctx context.Context, request *rootcoordpb.UpdatePartitionKeyStatsRequest) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *mockCore) GetPartitionKeyStats(ctx context.Context, request *rootcoordpb.GetPartitionKeyStatsRequest) (*rootcoordpb.GetPartitionKeyStatsResponse, error) {
+	return &rootcoordpb.GetPartitionKeyStatsResponse{Status: merr.Success()}, nil
+}
+
+func (m *mockCore) GetMetaSnapshot(ctx context.Context, request *rootcoordpb.GetMetaSnapshotRequest) (*rootcoordpb.GetMetaSnapshotResponse, error) {
+	return &rootcoordpb.GetMetaSnapshotResponse{Status: merr.Success()}, nil
+}
+
 func (m *mockCore) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
@@ -210,6 +226,30 @@ func TestRun(t *testing.T) {
 			assert.True(t, merr.Ok(ret))
 		})
 
+		t.Run("GetDropCollectionProgress", func(t *testing.T) {
+			ret, err := svr.GetDropCollectionProgress(ctx, nil)
+			assert.Nil(t, err)
+			assert.True(t, merr.Ok(ret.GetStatus()))
+		})
+
+		t.Run("UpdatePartitionKeyStats", func(t *testing.T) {
+			ret, err := svr.UpdatePartitionKeyStats(ctx, nil)
+			assert.Nil(t, err)
+			assert.True(t, merr.Ok(ret))
+		})
+
+		t.Run("GetPartitionKeyStats", func(t *testing.T) {
+			ret, err := svr.GetPartitionKeyStats(ctx, nil)
+			assert.Nil(t, err)
+			assert.True(t, merr.Ok(ret.GetStatus()))
+		})
+
+		t.Run("GetMetaSnapshot", func(t *testing.T) {
+			ret, err := svr.GetMetaSnapshot(ctx, nil)
+			assert.Nil(t, err)
+			assert.True(t, merr.Ok(ret.GetStatus()))
+		})
+
 		err = svr.Stop()
 		assert.NoError(t, err)
 	}
@@ -0,0 +1,272 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"path"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// callerLimiterTTL is how long the rate limiter of an idle caller is kept.
+	callerLimiterTTL = time.Minute
+	// internalHostsTTL is how long the hosts of the registered components are cached.
+	internalHostsTTL = 10 * time.Second
+)
+
+// apiSlots is the concurrency limit of an API, the calls exceeding the limit wait in the queues,
+// and the freed slot is handed over to the internal callers first.
+type apiSlots struct {
+	inflight int
+	internal *list.List // chan struct{}
+	external *list.List // chan struct{}
+}
+
+type callerLimiter struct {
+	limiter  *ratelimitutil.Limiter
+	lastSeen time.Time
+}
+
+// APILimiter protects the coordinator from the storms of calls by limiting the concurrency of each API
+// and the rate of each external caller. The internal callers, which are the milvus components identified
+// by the peer host matching the address of a session registered in etcd, are not rate limited and served
+// first when queued. The metadata of the call is not trusted as it could be set by any client.
+type APILimiter struct {
+	role    string
+	params  *paramtable.GrpcServerConfig
+	etcdCli *clientv3.Client
+
+	mu        sync.Mutex
+	apis      map[string]*apiSlots
+	callers   map[string]*callerLimiter
+	lastPrune time.Time
+
+	internalHosts   atomic.Pointer[typeutil.Set[string]]
+	hostsExpireAt   atomic.Time
+	hostsRefreshing atomic.Bool
+}
+
+// NewAPILimiter creates an APILimiter, all the callers are external if etcdCli is nil.
+func NewAPILimiter(role string, params *paramtable.GrpcServerConfig, etcdCli *clientv3.Client) *APILimiter {
+	return &APILimiter{
+		role:      role,
+		params:    params,
+		etcdCli:   etcdCli,
+		apis:      make(map[string]*apiSlots),
+		callers:   make(map[string]*callerLimiter),
+		lastPrune: time.Now(),
+	}
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor that rejects the calls exceeding the limits
+// with the ResourceExhausted code, the internal clients retry the rejected calls with backoff.
+func (l *APILimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !l.params.APILimitEnabled.GetAsBool() {
+			return handler(ctx, req)
+		}
+		release, err := l.acquire(ctx, path.Base(info.FullMethod))
+		if err != nil {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		defer release()
+		return handler(ctx, req)
+	}
+}
+
+func (l *APILimiter) acquire(ctx context.Context, api string) (func(), error) {
+	internal, caller := l.identifyCaller(ctx)
+	if !internal && !l.allowCaller(caller) {
+		metrics.GrpcAPILimitedCounter.WithLabelValues(l.role, api, metrics.GrpcAPIRejectedLabel).Inc()
+		rate := l.params.APIRatePerCaller.GetAsFloat()
+		log.Ctx(ctx).RatedWarn(10, "too many calls from the caller, reject", zap.String("api", api),
+			zap.String("caller", caller), zap.Float64("rate", rate))
+		return nil, merr.WrapErrServiceRateLimit(rate, fmt.Sprintf("too many calls from %s", caller))
+	}
+
+	limit := l.params.APIMaxConcurrency.GetAsInt()
+	l.mu.Lock()
+	slots, ok := l.apis[api]
+	if !ok {
+		slots = &apiSlots{internal: list.New(), external: list.New()}
+		l.apis[api] = slots
+	}
+	if limit <= 0 || slots.inflight < limit {
+		slots.inflight++
+		l.mu.Unlock()
+		return func() { l.release(slots) }, nil
+	}
+	queue := slots.external
+	if internal {
+		queue = slots.internal
+	}
+	ch := make(chan struct{})
+	elem := queue.PushBack(ch)
+	l.mu.Unlock()
+
+	metrics.GrpcAPILimitedCounter.WithLabelValues(l.role, api, metrics.GrpcAPIQueuedLabel).Inc()
+	timer := time.NewTimer(l.params.APIMaxQueueTime.GetAsDuration(time.Millisecond))
+	defer timer.Stop()
+	var err error
+	select {
+	case <-ch:
+		return func() { l.release(slots) }, nil
+	case <-timer.C:
+		err = merr.WrapErrServiceRequestLimitExceeded(int32(limit), fmt.Sprintf("too many concurrent calls of %s", api))
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	select {
+	case <-ch:
+		// the slot is handed over right before giving up
+		return func() { l.release(slots) }, nil
+	default:
+	}
+	queue.Remove(elem)
+	metrics.GrpcAPILimitedCounter.WithLabelValues(l.role, api, metrics.GrpcAPIRejectedLabel).Inc()
+	log.Ctx(ctx).RatedWarn(10, "failed to wait for the api slot, reject", zap.String("api", api),
+		zap.String("caller", caller), zap.Bool("internal", internal), zap.Error(err))
+	return nil, err
+}
+
+// release hands over the slot to the first waiting call, internal callers first.
+func (l *APILimiter) release(slots *apiSlots) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, queue := range []*list.List{slots.internal, slots.external} {
+		if front := queue.Front(); front != nil {
+			queue.Remove(front)
+			close(front.Value.(chan struct{}))
+			return
+		}
+	}
+	slots.inflight--
+}
+
+func (l *APILimiter) allowCaller(caller string) bool {
+	rate := l.params.APIRatePerCaller.GetAsFloat()
+	if rate <= 0 {
+		return true
+	}
+
+	now := time.Now()
+	l.mu.Lock()
+	if now.Sub(l.lastPrune) > callerLimiterTTL {
+		for key, c := range l.callers {
+			if now.Sub(c.lastSeen) > callerLimiterTTL {
+				delete(l.callers, key)
+			}
+		}
+		l.lastPrune = now
+	}
+	c, ok := l.callers[caller]
+	if !ok {
+		c = &callerLimiter{limiter: ratelimitutil.NewLimiter(ratelimitutil.Limit(rate), rate)}
+		l.callers[caller] = c
+	}
+	c.lastSeen = now
+	l.mu.Unlock()
+
+	if c.limiter.Limit() != ratelimitutil.Limit(rate) {
+		c.limiter.SetLimit(ratelimitutil.Limit(rate))
+	}
+	return c.limiter.AllowN(now, 1)
+}
+
+// identifyCaller returns whether the caller is a milvus component, and the host of the caller.
+func (l *APILimiter) identifyCaller(ctx context.Context) (bool, string) {
+	caller := "unknown"
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return false, caller
+	}
+	caller = p.Addr.String()
+	if host, _, err := net.SplitHostPort(caller); err == nil {
+		caller = host
+	}
+	return l.getInternalHosts().Contain(caller), caller
+}
+
+// getInternalHosts returns the cached hosts of the registered components, and refreshes them in background
+// once expired, so the calls are never blocked by etcd.
+func (l *APILimiter) getInternalHosts() typeutil.Set[string] {
+	if l.etcdCli != nil && time.Now().After(l.hostsExpireAt.Load()) && l.hostsRefreshing.CompareAndSwap(false, true) {
+		go func() {
+			defer l.hostsRefreshing.Store(false)
+			hosts, err := listSessionHosts(l.etcdCli)
+			if err != nil {
+				log.RatedWarn(10, "failed to list the sessions to identify the internal callers", zap.Error(err))
+				return
+			}
+			l.internalHosts.Store(&hosts)
+			l.hostsExpireAt.Store(time.Now().Add(internalHostsTTL))
+		}()
+	}
+	if hosts := l.internalHosts.Load(); hosts != nil {
+		return *hosts
+	}
+	return typeutil.NewSet[string]()
+}
+
+// listSessionHosts returns the hosts of all the sessions registered in etcd.
+func listSessionHosts(etcdCli *clientv3.Client) (typeutil.Set[string], error) {
+	ctx, cancel := context.WithTimeout(context.Background(), internalHostsTTL)
+	defer cancel()
+	key := path.Join(paramtable.Get().EtcdCfg.MetaRootPath.GetValue(), sessionutil.DefaultServiceRoot)
+	resp, err := etcdCli.Get(ctx, key, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	hosts := typeutil.NewSet[string]()
+	for _, kv := range resp.Kvs {
+		session := &sessionutil.SessionRaw{}
+		if err := json.Unmarshal(kv.Value, session); err != nil || session.Address == "" {
+			// the server ID allocator and the other non-session keys
+			continue
+		}
+		if host, _, err := net.SplitHostPort(session.Address); err == nil {
+			hosts.Insert(host)
+		}
+	}
+	return hosts, nil
+}
@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/interceptor"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func externalCtx(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 19530}})
+}
+
+func withInternalHosts(limiter *APILimiter, hosts ...string) {
+	set := typeutil.NewSet(hosts...)
+	limiter.internalHosts.Store(&set)
+}
+
+func TestAPILimiter(t *testing.T) {
+	paramtable.Init()
+	params := &paramtable.Get().RootCoordGrpcServerCfg
+
+	t.Run("disabled", func(t *testing.T) {
+		limiter := NewAPILimiter(typeutil.RootCoordRole, params, nil)
+		resp, err := limiter.UnaryServerInterceptor()(externalCtx("127.0.0.1"), nil,
+			&grpc.UnaryServerInfo{FullMethod: "/milvus.proto.rootcoord.RootCoord/DescribeCollection"},
+			func(ctx context.Context, req any) (any, error) {
+				return "ok", nil
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("rate per caller", func(t *testing.T) {
+		paramtable.Get().Save(params.APILimitEnabled.Key, "true")
+		paramtable.Get().Save(params.APIRatePerCaller.Key, "1")
+		defer paramtable.Get().Reset(params.APILimitEnabled.Key)
+		defer paramtable.Get().Reset(params.APIRatePerCaller.Key)
+
+		limiter := NewAPILimiter(typeutil.RootCoordRole, params, nil)
+		withInternalHosts(limiter, "10.0.0.3")
+		handler := func(ctx context.Context, req any) (any, error) {
+			return "ok", nil
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.rootcoord.RootCoord/DescribeCollection"}
+		// the limiter allows the burst and punishes the later calls
+		for i := 0; i < 2; i++ {
+			_, err := limiter.UnaryServerInterceptor()(externalCtx("10.0.0.1"), nil, info, handler)
+			assert.NoError(t, err)
+		}
+		_, err := limiter.UnaryServerInterceptor()(externalCtx("10.0.0.1"), nil, info, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		// other callers are not affected
+		_, err = limiter.UnaryServerInterceptor()(externalCtx("10.0.0.2"), nil, info, handler)
+		assert.NoError(t, err)
+		// internal callers are not rate limited
+		for i := 0; i < 3; i++ {
+			_, err = limiter.UnaryServerInterceptor()(externalCtx("10.0.0.3"), nil, info, handler)
+			assert.NoError(t, err)
+		}
+		// the server ID in the metadata doesn't make the caller internal
+		forged := metadata.NewIncomingContext(externalCtx("10.0.0.1"), metadata.Pairs(interceptor.ServerIDKey, "1"))
+		_, err = limiter.UnaryServerInterceptor()(forged, nil, info, handler)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("concurrency per api", func(t *testing.T) {
+		paramtable.Get().Save(params.APIMaxConcurrency.Key, "1")
+		paramtable.Get().Save(params.APIMaxQueueTime.Key, "100")
+		paramtable.Get().Save(params.APIRatePerCaller.Key, "0")
+		defer paramtable.Get().Reset(params.APIMaxConcurrency.Key)
+		defer paramtable.Get().Reset(params.APIMaxQueueTime.Key)
+		defer paramtable.Get().Reset(params.APIRatePerCaller.Key)
+
+		limiter := NewAPILimiter(typeutil.RootCoordRole, params, nil)
+		withInternalHosts(limiter, "10.0.0.2")
+		release, err := limiter.acquire(externalCtx("10.0.0.1"), "DescribeCollection")
+		assert.NoError(t, err)
+
+		// queued then rejected
+		_, err = limiter.acquire(externalCtx("10.0.0.1"), "DescribeCollection")
+		assert.ErrorIs(t, err, merr.ErrServiceRequestLimitExceeded)
+		// other apis are not affected
+		release2, err := limiter.acquire(externalCtx("10.0.0.1"), "ShowCollections")
+		assert.NoError(t, err)
+		release2()
+
+		// the internal caller is served before the external one queued earlier
+		paramtable.Get().Save(params.APIMaxQueueTime.Key, "10000")
+		served := make(chan string, 2)
+		wait := func(ctx context.Context, name string) {
+			release, err := limiter.acquire(ctx, "DescribeCollection")
+			assert.NoError(t, err)
+			served <- name
+			release()
+		}
+		go wait(externalCtx("10.0.0.1"), "external")
+		assert.Eventually(t, func() bool {
+			limiter.mu.Lock()
+			defer limiter.mu.Unlock()
+			return limiter.apis["DescribeCollection"].external.Len() == 1
+		}, time.Second, 10*time.Millisecond)
+		go wait(externalCtx("10.0.0.2"), "internal")
+		assert.Eventually(t, func() bool {
+			limiter.mu.Lock()
+			defer limiter.mu.Unlock()
+			return limiter.apis["DescribeCollection"].internal.Len() == 1
+		}, time.Second, 10*time.Millisecond)
+
+		release()
+		assert.Equal(t, "internal", <-served)
+		assert.Equal(t, "external", <-served)
+
+		assert.Eventually(t, func() bool {
+			limiter.mu.Lock()
+			defer limiter.mu.Unlock()
+			return limiter.apis["DescribeCollection"].inflight == 0
+		}, time.Second, 10*time.Millisecond)
+	})
+	t.Run("internal hosts", func(t *testing.T) {
+		etcdConfig := &paramtable.Get().EtcdCfg
+		paramtable.Get().Save(etcdConfig.RootPath.Key, fmt.Sprintf("/test-api-limiter-%d", time.Now().UnixNano()))
+		defer paramtable.Get().Reset(etcdConfig.RootPath.Key)
+		etcdCli, err := etcd.GetEtcdClient(
+			etcdConfig.UseEmbedEtcd.GetAsBool(),
+			etcdConfig.EtcdUseSSL.GetAsBool(),
+			etcdConfig.Endpoints.GetAsStrings(),
+			etcdConfig.EtcdTLSCert.GetValue(),
+			etcdConfig.EtcdTLSKey.GetValue(),
+			etcdConfig.EtcdTLSCACert.GetValue(),
+			etcdConfig.EtcdTLSMinVersion.GetValue())
+		assert.NoError(t, err)
+		defer etcdCli.Close()
+		sessionRoot := path.Join(etcdConfig.MetaRootPath.GetValue(), sessionutil.DefaultServiceRoot)
+		_, err = etcdCli.Put(context.Background(), path.Join(sessionRoot, "proxy-1"), `{"ServerID":1,"ServerName":"proxy","Address":"10.0.0.4:19529"}`)
+		assert.NoError(t, err)
+		_, err = etcdCli.Put(context.Background(), path.Join(sessionRoot, sessionutil.DefaultIDKey), "2")
+		assert.NoError(t, err)
+
+		hosts, err := listSessionHosts(etcdCli)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"10.0.0.4"}, hosts.Collect())
+
+		limiter := NewAPILimiter(typeutil.RootCoordRole, params, etcdCli)
+		assert.Eventually(t, func() bool {
+			internal, caller := limiter.identifyCaller(externalCtx("10.0.0.4"))
+			return internal && caller == "10.0.0.4"
+		}, 5*time.Second, 10*time.Millisecond)
+		internal, _ := limiter.identifyCaller(externalCtx("10.0.0.1"))
+		assert.False(t, internal)
+	})
+}
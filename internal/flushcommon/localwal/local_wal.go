@@ -0,0 +1,398 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localwal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+)
+
+const (
+	fileSuffix = ".wal"
+	// record header: payload length + crc32 checksum of payload
+	headerSize = 8
+)
+
+var (
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	ErrCorrupted = errors.New("local wal corrupted")
+	ErrGap       = errors.New("local wal does not cover the checkpoint")
+)
+
+type walFile struct {
+	seq  int64
+	size int64
+	// max end ts of the packs in the file
+	endTs uint64
+}
+
+// WAL is the write-ahead log of the msg packs consumed by a vchannel on local disk,
+// a restarted datanode replays the packs after the channel checkpoint from it,
+// instead of re-consuming them from mq.
+//
+// The log is split into files named by sequence number, a file is removed once all the packs in it are
+// covered by the channel checkpoint. Each record is validated by checksum during replay,
+// the replay stops at the first broken record and the channel consumes the rest from mq,
+// so the log is not synced to disk on every append.
+type WAL struct {
+	mut         sync.Mutex
+	dir         string
+	maxFileSize int64
+	unmarshal   msgstream.UnmarshalDispatcher
+
+	files  []*walFile // ordered by seq, the last one is being appended if writer is not nil
+	writer *os.File
+	closed bool
+}
+
+// Open opens the log in the directory, the directory is created if not exist.
+// Either Replay or Reset shall be called before appending to the opened log.
+func Open(dir string, maxFileSize int64) (*WAL, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*walFile, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), fileSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseInt(strings.TrimSuffix(entry.Name(), fileSuffix), 10, 64)
+		if err != nil {
+			log.Warn("skip unknown file in local wal dir", zap.String("dir", dir), zap.String("file", entry.Name()))
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &walFile{seq: seq, size: info.Size()})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].seq < files[j].seq
+	})
+	return &WAL{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		unmarshal:   (&msgstream.ProtoUDFactory{}).NewUnmarshalDispatcher(),
+		files:       files,
+	}, nil
+}
+
+func (w *WAL) filePath(seq int64) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%020d%s", seq, fileSuffix))
+}
+
+// Replay reads all the records in the log and returns the packs after the checkpoint.
+// The log is truncated at the first broken record, which is most likely a torn write of crash.
+// ErrGap is returned if the packs do not start from the checkpoint, the caller shall consume from the checkpoint instead.
+func (w *WAL) Replay(checkpoint *msgpb.MsgPosition) ([]*msgstream.MsgPack, error) {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	log := log.With(zap.String("dir", w.dir), zap.Uint64("checkpointTs", checkpoint.GetTimestamp()))
+	packs := make([]*msgstream.MsgPack, 0)
+	for i, file := range w.files {
+		var offset int64
+		err := w.readFile(file, func(record *datapb.LocalWALRecord, end int64) error {
+			if record.GetEndTs() > checkpoint.GetTimestamp() {
+				pack, err := w.decode(record)
+				if err != nil {
+					return err
+				}
+				packs = append(packs, pack)
+			}
+			if record.GetEndTs() > file.endTs {
+				file.endTs = record.GetEndTs()
+			}
+			offset = end
+			return nil
+		})
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, ErrCorrupted) {
+			return nil, err
+		}
+		log.Warn("local wal corrupted, truncate it at the last valid record",
+			zap.Int64("seq", file.seq), zap.Int64("offset", offset), zap.Error(err))
+		if err := w.truncateFrom(i, offset); err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	if len(packs) > 0 && packs[0].BeginTs > checkpoint.GetTimestamp() {
+		return nil, errors.Wrapf(ErrGap, "first pack begins at %d", packs[0].BeginTs)
+	}
+	return packs, nil
+}
+
+// readFile reads the records in the file one by one, the end offset of the record is passed to fn.
+func (w *WAL) readFile(file *walFile, fn func(record *datapb.LocalWALRecord, end int64) error) error {
+	f, err := os.Open(w.filePath(file.seq))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	header := make([]byte, headerSize)
+	var offset int64
+	for {
+		_, err := io.ReadFull(reader, header)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(ErrCorrupted, "incomplete record header")
+		}
+		length := binary.LittleEndian.Uint32(header[:4])
+		checksum := binary.LittleEndian.Uint32(header[4:])
+		if int64(length) > file.size-offset-headerSize {
+			return errors.Wrapf(ErrCorrupted, "record length %d exceeds file size", length)
+		}
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			return errors.Wrap(ErrCorrupted, "incomplete record payload")
+		}
+		if crc32.Checksum(payload, crcTable) != checksum {
+			return errors.Wrap(ErrCorrupted, "checksum mismatch")
+		}
+		record := &datapb.LocalWALRecord{}
+		if err := proto.Unmarshal(payload, record); err != nil {
+			return errors.Wrap(ErrCorrupted, err.Error())
+		}
+		offset += headerSize + int64(length)
+		if err := fn(record, offset); err != nil {
+			return err
+		}
+	}
+}
+
+// truncateFrom truncates the idx-th file at offset, and removes all the files after it.
+func (w *WAL) truncateFrom(idx int, offset int64) error {
+	for _, file := range w.files[idx+1:] {
+		if err := os.Remove(w.filePath(file.seq)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Truncate(w.filePath(w.files[idx].seq), offset); err != nil {
+		return err
+	}
+	w.files[idx].size = offset
+	w.files = w.files[:idx+1]
+	return nil
+}
+
+func (w *WAL) decode(record *datapb.LocalWALRecord) (*msgstream.MsgPack, error) {
+	msgs := make([]msgstream.TsMsg, 0, len(record.GetMsgs()))
+	for _, data := range record.GetMsgs() {
+		header := commonpb.MsgHeader{}
+		if err := proto.Unmarshal(data, &header); err != nil || header.GetBase() == nil {
+			return nil, errors.Wrap(ErrCorrupted, "invalid msg header")
+		}
+		msg, err := w.unmarshal.Unmarshal(data, header.GetBase().GetMsgType())
+		if err != nil {
+			return nil, errors.Wrap(ErrCorrupted, err.Error())
+		}
+		msgs = append(msgs, msg)
+	}
+	return &msgstream.MsgPack{
+		BeginTs:        record.GetBeginTs(),
+		EndTs:          record.GetEndTs(),
+		Msgs:           msgs,
+		StartPositions: record.GetStartPositions(),
+		EndPositions:   record.GetEndPositions(),
+	}, nil
+}
+
+func encode(pack *msgstream.MsgPack) ([]byte, error) {
+	record := &datapb.LocalWALRecord{
+		BeginTs:        pack.BeginTs,
+		EndTs:          pack.EndTs,
+		StartPositions: pack.StartPositions,
+		EndPositions:   pack.EndPositions,
+		Msgs:           make([][]byte, 0, len(pack.Msgs)),
+	}
+	for _, msg := range pack.Msgs {
+		mb, err := msg.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		data, ok := mb.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("unexpected marshaled type %T of msg %s", mb, msg.Type())
+		}
+		record.Msgs = append(record.Msgs, data)
+	}
+	payload, err := proto.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, headerSize+len(payload))
+	binary.LittleEndian.PutUint32(data[:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(data[4:headerSize], crc32.Checksum(payload, crcTable))
+	copy(data[headerSize:], payload)
+	return data, nil
+}
+
+// Append appends the pack to the log, it rolls to a new file once the current file exceeds the max size.
+func (w *WAL) Append(pack *msgstream.MsgPack) error {
+	data, err := encode(pack)
+	if err != nil {
+		return err
+	}
+
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	if w.closed {
+		return nil
+	}
+	if w.writer == nil || w.files[len(w.files)-1].size >= w.maxFileSize {
+		if err := w.roll(); err != nil {
+			return err
+		}
+	}
+	if _, err := w.writer.Write(data); err != nil {
+		return err
+	}
+	current := w.files[len(w.files)-1]
+	current.size += int64(len(data))
+	if pack.EndTs > current.endTs {
+		current.endTs = pack.EndTs
+	}
+	return nil
+}
+
+// roll closes the current file and creates a new one to append.
+func (w *WAL) roll() error {
+	if w.writer != nil {
+		if err := w.writer.Close(); err != nil {
+			return err
+		}
+		w.writer = nil
+	}
+	var seq int64
+	if len(w.files) > 0 {
+		seq = w.files[len(w.files)-1].seq + 1
+	}
+	f, err := os.OpenFile(w.filePath(seq), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	w.writer = f
+	w.files = append(w.files, &walFile{seq: seq})
+	return nil
+}
+
+// Truncate removes the files of which all the packs end before the checkpoint ts.
+func (w *WAL) Truncate(ts uint64) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	idx := 0
+	for ; idx < len(w.files); idx++ {
+		file := w.files[idx]
+		if file.endTs > ts {
+			break
+		}
+		if idx == len(w.files)-1 && w.writer != nil {
+			if err := w.writer.Close(); err != nil {
+				return err
+			}
+			w.writer = nil
+		}
+		if err := os.Remove(w.filePath(file.seq)); err != nil && !os.IsNotExist(err) {
+			w.files = w.files[idx:]
+			return err
+		}
+	}
+	w.files = w.files[idx:]
+	return nil
+}
+
+// Reset removes all the files in the log, the following packs are appended to a new file.
+func (w *WAL) Reset() error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	return w.reset()
+}
+
+func (w *WAL) reset() error {
+	if w.writer != nil {
+		w.writer.Close()
+		w.writer = nil
+	}
+	for len(w.files) > 0 {
+		if err := os.Remove(w.filePath(w.files[0].seq)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		w.files = w.files[1:]
+	}
+	return nil
+}
+
+// Remove removes the log and its directory, the following appends are ignored.
+func (w *WAL) Remove() error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	w.closed = true
+	if err := w.reset(); err != nil {
+		return err
+	}
+	return os.RemoveAll(w.dir)
+}
+
+// Close closes the file being appended, the following appends are ignored.
+func (w *WAL) Close() error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	w.closed = true
+	if w.writer == nil {
+		return nil
+	}
+	err := w.writer.Close()
+	w.writer = nil
+	return err
+}
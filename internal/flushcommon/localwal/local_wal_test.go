@@ -0,0 +1,212 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localwal
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+)
+
+type LocalWALSuite struct {
+	suite.Suite
+	dir string
+}
+
+func (s *LocalWALSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+func (s *LocalWALSuite) pack(beginTs, endTs uint64) *msgstream.MsgPack {
+	msg := &msgstream.TimeTickMsg{
+		BaseMsg: msgstream.BaseMsg{
+			BeginTimestamp: endTs,
+			EndTimestamp:   endTs,
+			HashValues:     []uint32{0},
+		},
+		TimeTickMsg: &msgpb.TimeTickMsg{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_TimeTick,
+				Timestamp: endTs,
+			},
+		},
+	}
+	return &msgstream.MsgPack{
+		BeginTs:        beginTs,
+		EndTs:          endTs,
+		Msgs:           []msgstream.TsMsg{msg},
+		StartPositions: []*msgpb.MsgPosition{{ChannelName: "ch", MsgID: []byte{1}, Timestamp: beginTs}},
+		EndPositions:   []*msgpb.MsgPosition{{ChannelName: "ch", MsgID: []byte{2}, Timestamp: endTs}},
+	}
+}
+
+func (s *LocalWALSuite) open(maxFileSize int64) *WAL {
+	wal, err := Open(s.dir, maxFileSize)
+	s.Require().NoError(err)
+	return wal
+}
+
+func (s *LocalWALSuite) appendPacks(wal *WAL, n int) {
+	for i := 0; i < n; i++ {
+		s.Require().NoError(wal.Append(s.pack(uint64(i*10), uint64(i*10+10))))
+	}
+}
+
+func (s *LocalWALSuite) listFiles() []string {
+	entries, err := os.ReadDir(s.dir)
+	s.Require().NoError(err)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func (s *LocalWALSuite) TestReplay() {
+	wal := s.open(1024)
+	s.NoError(wal.Reset())
+	s.appendPacks(wal, 5)
+	s.NoError(wal.Close())
+
+	wal = s.open(1024)
+	packs, err := wal.Replay(&msgpb.MsgPosition{Timestamp: 20})
+	s.NoError(err)
+	s.Len(packs, 3)
+	s.EqualValues(20, packs[0].BeginTs)
+	s.EqualValues(50, packs[2].EndTs)
+	s.Len(packs[0].Msgs, 1)
+	s.Equal(commonpb.MsgType_TimeTick, packs[0].Msgs[0].Type())
+	s.EqualValues(30, packs[0].Msgs[0].EndTs())
+	s.EqualValues(50, packs[2].EndPositions[0].GetTimestamp())
+
+	// appended after replay
+	s.NoError(wal.Append(s.pack(50, 60)))
+	s.NoError(wal.Close())
+	wal = s.open(1024)
+	packs, err = wal.Replay(&msgpb.MsgPosition{Timestamp: 50})
+	s.NoError(err)
+	s.Len(packs, 1)
+	s.EqualValues(60, packs[0].EndTs)
+}
+
+func (s *LocalWALSuite) TestReplayGap() {
+	// each pack in a separate file
+	wal := s.open(1)
+	s.NoError(wal.Reset())
+	s.appendPacks(wal, 3)
+	s.NoError(wal.Close())
+
+	wal = s.open(1)
+	_, err := wal.Replay(&msgpb.MsgPosition{Timestamp: 5})
+	s.NoError(err)
+	s.NoError(wal.Truncate(10))
+	s.NoError(wal.Close())
+
+	wal = s.open(1)
+	_, err = wal.Replay(&msgpb.MsgPosition{Timestamp: 5})
+	s.True(errors.Is(err, ErrGap))
+}
+
+func (s *LocalWALSuite) TestReplayCorrupted() {
+	wal := s.open(1024 * 1024)
+	s.NoError(wal.Reset())
+	s.appendPacks(wal, 3)
+	s.NoError(wal.Close())
+
+	files := s.listFiles()
+	s.Require().Len(files, 1)
+	path := wal.filePath(0)
+	info, err := os.Stat(path)
+	s.Require().NoError(err)
+	// torn write of the last record
+	s.Require().NoError(os.Truncate(path, info.Size()-3))
+
+	wal = s.open(1024 * 1024)
+	packs, err := wal.Replay(&msgpb.MsgPosition{Timestamp: 0})
+	s.NoError(err)
+	s.Len(packs, 2)
+	s.EqualValues(20, packs[1].EndTs)
+	s.NoError(wal.Append(s.pack(20, 30)))
+	s.NoError(wal.Close())
+
+	// broken tail truncated, the following appends are replayed
+	wal = s.open(1024 * 1024)
+	packs, err = wal.Replay(&msgpb.MsgPosition{Timestamp: 0})
+	s.NoError(err)
+	s.Len(packs, 3)
+	s.EqualValues(30, packs[2].EndTs)
+	s.NoError(wal.Close())
+
+	// checksum mismatch
+	data, err := os.ReadFile(path)
+	s.Require().NoError(err)
+	data[headerSize] ^= 0xff
+	s.Require().NoError(os.WriteFile(path, data, 0o600))
+	wal = s.open(1024 * 1024)
+	packs, err = wal.Replay(&msgpb.MsgPosition{Timestamp: 0})
+	s.NoError(err)
+	s.Empty(packs)
+	s.NoError(wal.Close())
+}
+
+func (s *LocalWALSuite) TestTruncate() {
+	// each pack in a separate file
+	wal := s.open(1)
+	s.NoError(wal.Reset())
+	s.appendPacks(wal, 4)
+	s.Len(s.listFiles(), 4)
+
+	s.NoError(wal.Truncate(25))
+	s.Len(s.listFiles(), 2)
+
+	// active file removed
+	s.NoError(wal.Truncate(40))
+	s.Empty(s.listFiles())
+	s.NoError(wal.Append(s.pack(40, 50)))
+	s.Len(s.listFiles(), 1)
+	s.NoError(wal.Close())
+
+	wal = s.open(1)
+	packs, err := wal.Replay(&msgpb.MsgPosition{Timestamp: 40})
+	s.NoError(err)
+	s.Len(packs, 1)
+	s.NoError(wal.Close())
+}
+
+func (s *LocalWALSuite) TestRemove() {
+	wal := s.open(1024)
+	s.NoError(wal.Reset())
+	s.appendPacks(wal, 2)
+	s.NoError(wal.Remove())
+	_, err := os.Stat(s.dir)
+	s.True(os.IsNotExist(err))
+
+	// ignored after removed
+	s.NoError(wal.Append(s.pack(20, 30)))
+	_, err = os.Stat(s.dir)
+	s.True(os.IsNotExist(err))
+}
+
+func TestLocalWAL(t *testing.T) {
+	suite.Run(t, new(LocalWALSuite))
+}
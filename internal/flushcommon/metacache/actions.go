@@ -159,6 +159,29 @@ func RollStats(newStats ...*storage.PrimaryKeyStats) SegmentAction {
 	}
 }
 
+// MergeVectorStats merges the statistics of a batch of vectors into the segment.
+func MergeVectorStats(stats map[int64]*storage.VectorFieldStats) SegmentAction {
+	return func(info *SegmentInfo) {
+		if len(stats) == 0 {
+			return
+		}
+		merged := make(map[int64]*storage.VectorFieldStats, len(info.vectorStats)+len(stats))
+		for fieldID, fieldStats := range info.vectorStats {
+			merged[fieldID] = fieldStats
+		}
+		for fieldID, fieldStats := range stats {
+			if current, ok := merged[fieldID]; ok {
+				current = current.Clone()
+				current.Merge(fieldStats)
+				merged[fieldID] = current
+			} else {
+				merged[fieldID] = fieldStats.Clone()
+			}
+		}
+		info.vectorStats = merged
+	}
+}
+
 func StartSyncing(batchSize int64) SegmentAction {
 	return func(info *SegmentInfo) {
 		info.syncingRows += batchSize
@@ -23,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/storage"
 )
 
 type SegmentFilterSuite struct {
@@ -116,6 +117,32 @@ func (s *SegmentActionSuite) TestMergeActions() {
 	s.Equal(cp, info.Checkpoint())
 }
 
+func (s *SegmentActionSuite) TestMergeVectorStats() {
+	info := &SegmentInfo{}
+
+	MergeVectorStats(nil)(info)
+	s.Empty(info.GetVectorStats())
+
+	MergeVectorStats(map[int64]*storage.VectorFieldStats{
+		101: {FieldID: 101, RowNum: 2, MinNorm: 1, MaxNorm: 2, NormSum: 3, NormSquareSum: 5},
+	})(info)
+	cloned := info.Clone()
+	MergeVectorStats(map[int64]*storage.VectorFieldStats{
+		101: {FieldID: 101, RowNum: 1, InvalidNum: 1},
+		102: {FieldID: 102, RowNum: 1, MinNorm: 1, MaxNorm: 1, NormSum: 1, NormSquareSum: 1},
+	})(info)
+
+	stats := info.GetVectorStats()
+	s.Len(stats, 2)
+	s.EqualValues(3, stats[101].RowNum)
+	s.EqualValues(1, stats[101].InvalidNum)
+	s.EqualValues(1, stats[102].RowNum)
+
+	// the stats of the cloned segment are not affected
+	s.Len(cloned.GetVectorStats(), 1)
+	s.EqualValues(2, cloned.GetVectorStats()[101].RowNum)
+}
+
 func TestActions(t *testing.T) {
 	suite.Run(t, new(SegmentActionSuite))
 }
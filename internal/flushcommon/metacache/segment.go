@@ -36,6 +36,8 @@ type SegmentInfo struct {
 	bfs              *BloomFilterSet
 	level            datapb.SegmentLevel
 	syncingTasks     int32
+	// aggregated statistics of the vector fields consumed, copy on write
+	vectorStats map[int64]*storage.VectorFieldStats
 }
 
 func (s *SegmentInfo) SegmentID() int64 {
@@ -77,6 +79,12 @@ func (s *SegmentInfo) GetBloomFilterSet() *BloomFilterSet {
 	return s.bfs
 }
 
+// GetVectorStats returns the statistics of the vector fields consumed by the segment,
+// the returned stats shall not be modified.
+func (s *SegmentInfo) GetVectorStats() map[int64]*storage.VectorFieldStats {
+	return s.vectorStats
+}
+
 func (s *SegmentInfo) Level() datapb.SegmentLevel {
 	return s.level
 }
@@ -95,6 +103,7 @@ func (s *SegmentInfo) Clone() *SegmentInfo {
 		bfs:              s.bfs,
 		level:            s.level,
 		syncingTasks:     s.syncingTasks,
+		vectorStats:      s.vectorStats,
 	}
 }
 
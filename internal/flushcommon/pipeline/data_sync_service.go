@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/compaction"
 	"github.com/milvus-io/milvus/internal/datanode/io"
 	"github.com/milvus-io/milvus/internal/datanode/util"
+	"github.com/milvus-io/milvus/internal/flushcommon/localwal"
 	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
 	"github.com/milvus-io/milvus/internal/flushcommon/syncmgr"
 	"github.com/milvus-io/milvus/internal/flushcommon/writebuffer"
@@ -66,6 +67,7 @@ type DataSyncService struct {
 
 	dispClient   msgdispatcher.Client
 	chunkManager storage.ChunkManager
+	wal          *localwal.WAL // nil if local wal disabled
 
 	stopOnce sync.Once
 }
@@ -76,6 +78,8 @@ type nodeConfig struct {
 	vChannelName string
 	metacache    metacache.MetaCache
 	serverID     util.UniqueID
+	// nil if local wal disabled
+	walInput *localWALInput
 }
 
 // Start the flow graph in dataSyncService
@@ -116,6 +120,11 @@ func (dsService *DataSyncService) close() {
 		}
 
 		dsService.cancelFn()
+		if dsService.wal != nil {
+			if err := dsService.wal.Close(); err != nil {
+				log.Warn("failed to close local wal", zap.Error(err))
+			}
+		}
 
 		// clean up metrics
 		pChan := funcutil.ToPhysicalChannel(dsService.vchannelName)
@@ -239,10 +248,23 @@ func getServiceWithChannel(initCtx context.Context, params *util.PipelineParams,
 		fg: nil,
 	}
 
+	seekPos := info.GetVchan().GetSeekPosition()
+	if paramtable.Get().DataNodeCfg.LocalWALEnabled.GetAsBool() {
+		wal, replayed, pos := openLocalWAL(channelName, seekPos)
+		if wal != nil {
+			config.walInput = &localWALInput{ctx: ctx, wal: wal, replayed: replayed}
+			ds.wal = wal
+			seekPos = pos
+		}
+	}
+
 	// init flowgraph
 	fg := flowgraph.NewTimeTickedFlowGraph(params.Ctx)
-	dmStreamNode, err := newDmInputNode(initCtx, params.DispClient, info.GetVchan().GetSeekPosition(), config)
+	dmStreamNode, err := newDmInputNode(initCtx, params.DispClient, seekPos, config)
 	if err != nil {
+		if ds.wal != nil {
+			ds.wal.Close()
+		}
 		return nil, err
 	}
 
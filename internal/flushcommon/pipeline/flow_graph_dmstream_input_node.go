@@ -62,6 +62,10 @@ func newDmInputNode(initCtx context.Context, dispatcherClient msgdispatcher.Clie
 		log.Info("datanode consume successfully when register to msgDispatcher")
 	}
 
+	if dmNodeConfig.walInput != nil {
+		input = dmNodeConfig.walInput.wrap(dmNodeConfig.vChannelName, input)
+	}
+
 	name := fmt.Sprintf("dmInputNode-data-%s", dmNodeConfig.vChannelName)
 	node := flowgraph.NewInputNode(
 		input,
@@ -53,7 +53,7 @@ func TestMain(t *testing.M) {
 
 func TestFlowGraphManager(t *testing.T) {
 	mockBroker := broker.NewMockBroker(t)
-	mockBroker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockBroker.EXPECT().ReportTimeTick(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockBroker.EXPECT().SaveBinlogPaths(mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockBroker.EXPECT().GetSegmentInfo(mock.Anything, mock.Anything).Return([]*datapb.SegmentInfo{}, nil).Maybe()
 	mockBroker.EXPECT().DropVirtualChannel(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
@@ -26,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/datanode/util"
+	"github.com/milvus-io/milvus/internal/flushcommon/localwal"
 	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
 	"github.com/milvus-io/milvus/internal/flushcommon/writebuffer"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
@@ -45,6 +46,7 @@ type ttNode struct {
 	lastUpdateTime     *atomic.Time
 	cpUpdater          *util.ChannelCheckpointUpdater
 	dropMode           *atomic.Bool
+	wal                *localwal.WAL // nil if local wal disabled
 }
 
 // Name returns node name, implementing flowgraph.Node
@@ -70,8 +72,13 @@ func (ttn *ttNode) Close() {
 // Operate handles input messages, implementing flowgraph.Node
 func (ttn *ttNode) Operate(in []Msg) []Msg {
 	fgMsg := in[0].(*FlowGraphMsg)
-	if fgMsg.dropCollection {
+	if fgMsg.dropCollection && !ttn.dropMode.Load() {
 		ttn.dropMode.Store(true)
+		if ttn.wal != nil {
+			if err := ttn.wal.Remove(); err != nil {
+				log.Warn("failed to remove local wal of dropped channel", zap.String("channel", ttn.vChannelName), zap.Error(err))
+			}
+		}
 	}
 
 	// skip updating checkpoint for drop collection
@@ -120,6 +127,12 @@ func (ttn *ttNode) updateChannelCP(channelPos *msgpb.MsgPosition, curTs time.Tim
 		channelCPTs, _ := tsoutil.ParseTS(channelPos.GetTimestamp())
 		// reset flush ts to prevent frequent flush
 		ttn.writeBufferManager.NotifyCheckpointUpdated(ttn.vChannelName, channelPos.GetTimestamp())
+		// the packs covered by the checkpoint are no longer needed for recovery
+		if ttn.wal != nil {
+			if err := ttn.wal.Truncate(channelPos.GetTimestamp()); err != nil {
+				log.Warn("failed to truncate local wal", zap.String("channel", ttn.vChannelName), zap.Error(err))
+			}
+		}
 		log.Debug("UpdateChannelCheckpoint success",
 			zap.String("channel", ttn.vChannelName),
 			zap.Uint64("cpTs", channelPos.GetTimestamp()),
@@ -143,6 +156,9 @@ func newTTNode(config *nodeConfig, wbManager writebuffer.BufferManager, cpUpdate
 		cpUpdater:          cpUpdater,
 		dropMode:           atomic.NewBool(false),
 	}
+	if config.walInput != nil {
+		tt.wal = config.walInput.wal
+	}
 
 	return tt, nil
 }
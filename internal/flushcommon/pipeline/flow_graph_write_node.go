@@ -100,6 +100,13 @@ func (wNode *writeNode) Operate(in []Msg) []Msg {
 
 	wNode.updater.Update(wNode.channelName, end.GetTimestamp(), stats)
 
+	progress, err := wNode.wbManager.GetFlushProgress(wNode.channelName)
+	if err != nil {
+		log.Warn("failed to get flush progress", zap.String("channel", wNode.channelName), zap.Error(err))
+	} else {
+		wNode.updater.UpdateFlushProgress(wNode.channelName, end.GetTimestamp(), progress)
+	}
+
 	res := FlowGraphMsg{
 		TimeRange:      fgMsg.TimeRange,
 		StartPositions: fgMsg.StartPositions,
@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/flushcommon/localwal"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// openLocalWAL opens the local write-ahead log of the channel, and replays the msg packs after the checkpoint from it.
+// It returns the position to consume from mq, which is the end of the replayed packs.
+// Any failure of the log falls back to consuming from the checkpoint, and the log is rebuilt from then on.
+func openLocalWAL(channel string, checkpoint *msgpb.MsgPosition) (*localwal.WAL, []*msgstream.MsgPack, *msgpb.MsgPosition) {
+	log := log.With(zap.String("channel", channel), zap.Uint64("checkpointTs", checkpoint.GetTimestamp()))
+	params := &paramtable.Get().DataNodeCfg
+	wal, err := localwal.Open(filepath.Join(params.LocalWALPath.GetValue(), channel), params.LocalWALFileSize.GetAsSize())
+	if err != nil {
+		log.Warn("failed to open local wal, consume from mq without it", zap.Error(err))
+		return nil, nil, checkpoint
+	}
+
+	var packs []*msgstream.MsgPack
+	// the channel without checkpoint consumes from the earliest position, nothing to replay
+	if checkpoint != nil && len(checkpoint.GetMsgID()) != 0 {
+		packs, err = wal.Replay(checkpoint)
+		if err != nil {
+			log.Warn("failed to replay local wal, fall back to consume from checkpoint", zap.Error(err))
+			packs = nil
+		}
+	}
+	if len(packs) > 0 && len(packs[len(packs)-1].EndPositions) == 0 {
+		log.Warn("invalid pack without end position in local wal, fall back to consume from checkpoint")
+		packs = nil
+	}
+	if len(packs) == 0 {
+		if err := wal.Reset(); err != nil {
+			log.Warn("failed to reset local wal, consume from mq without it", zap.Error(err))
+			wal.Close()
+			return nil, nil, checkpoint
+		}
+		return wal, nil, checkpoint
+	}
+
+	last := packs[len(packs)-1]
+	seekPos := typeutil.Clone(last.EndPositions[0])
+	seekPos.ChannelName = channel
+	log.Info("replay msg packs from local wal", zap.Int("packNum", len(packs)),
+		zap.Uint64("beginTs", packs[0].BeginTs), zap.Uint64("endTs", last.EndTs))
+	return wal, packs, seekPos
+}
+
+// localWALInput is the local wal of the channel and the packs replayed from it.
+type localWALInput struct {
+	ctx      context.Context
+	wal      *localwal.WAL
+	replayed []*msgstream.MsgPack
+}
+
+// wrap emits the replayed packs first, then forwards the packs consumed from mq,
+// each of them is appended to the wal before forwarded.
+// Once an append fails, the wal is removed as it is incomplete, the following packs are forwarded only.
+func (in *localWALInput) wrap(channel string, input <-chan *msgstream.MsgPack) <-chan *msgstream.MsgPack {
+	output := make(chan *msgstream.MsgPack)
+	go func() {
+		defer close(output)
+		send := func(pack *msgstream.MsgPack) bool {
+			select {
+			case output <- pack:
+				return true
+			case <-in.ctx.Done():
+				return false
+			}
+		}
+
+		for _, pack := range in.replayed {
+			if !send(pack) {
+				return
+			}
+		}
+		in.replayed = nil
+		healthy := true
+		for pack := range input {
+			if healthy && pack != nil {
+				if err := in.wal.Append(pack); err != nil {
+					log.Warn("failed to append local wal, remove it", zap.String("channel", channel), zap.Error(err))
+					if err := in.wal.Remove(); err != nil {
+						log.Warn("failed to remove local wal", zap.String("channel", channel), zap.Error(err))
+					}
+					healthy = false
+				}
+			}
+			if !send(pack) {
+				return
+			}
+		}
+	}()
+	return output
+}
@@ -100,6 +100,7 @@ func (s *storageV1Serializer) EncodeBuffer(ctx context.Context, pack *SyncPack)
 			return nil, err
 		}
 		task.binlogBlobs = binlogBlobs
+		task.quantizedBlobs = s.serializeVectorCodes(ctx, pack)
 
 		singlePKStats, batchStatsBlob, err := s.serializeStatslog(pack)
 		if err != nil {
@@ -119,6 +120,13 @@ func (s *storageV1Serializer) EncodeBuffer(ctx context.Context, pack *SyncPack)
 				return nil, err
 			}
 			task.mergedStatsBlob = mergedStatsBlob
+
+			vectorStatsBlobs, err := s.serializeVectorStats(pack)
+			if err != nil {
+				log.Warn("failed to serialize vector stats log", zap.Error(err))
+				return nil, err
+			}
+			task.vectorStatsBlobs = vectorStatsBlobs
 		}
 
 		task.WithFlush()
@@ -225,6 +233,72 @@ func (s *storageV1Serializer) serializeMergedPkStats(pack *SyncPack) (*storage.B
 	}), segment.NumOfRows())
 }
 
+// serializeVectorStats serializes the aggregated statistics of the vector fields consumed by the segment.
+func (s *storageV1Serializer) serializeVectorStats(pack *SyncPack) (map[int64]*storage.Blob, error) {
+	segment, ok := s.metacache.GetSegmentByID(pack.segmentID)
+	if !ok {
+		return nil, merr.WrapErrSegmentNotFound(pack.segmentID)
+	}
+	vectorStats := segment.GetVectorStats()
+	if len(vectorStats) == 0 {
+		return nil, nil
+	}
+
+	blobs := make(map[int64]*storage.Blob, len(vectorStats))
+	for fieldID, stats := range vectorStats {
+		blob, err := storage.SerializeVectorStats(stats)
+		if err != nil {
+			return nil, err
+		}
+		blobs[fieldID] = blob
+	}
+	return blobs, nil
+}
+
+// serializeVectorCodes quantizes the vectors of the batch if enabled,
+// the fields failed to be quantized are skipped, and served from the raw vectors.
+func (s *storageV1Serializer) serializeVectorCodes(ctx context.Context, pack *SyncPack) map[int64]*storage.Blob {
+	quantization := paramtable.Get().DataNodeCfg.QuantizationType.GetValue()
+	if quantization == "" {
+		return nil
+	}
+	log := log.Ctx(ctx).With(zap.Int64("segmentID", pack.segmentID), zap.String("quantization", quantization))
+	builders, err := storage.NewVectorCodesBuilders(s.schema)
+	if err != nil {
+		log.Warn("failed to create vector codes builders", zap.Error(err))
+		return nil
+	}
+
+	pqM := paramtable.Get().DataNodeCfg.QuantizationPQM.GetAsInt()
+	blobs := make(map[int64]*storage.Blob, len(builders))
+	for fieldID, builder := range builders {
+		codes, err := func() (*storage.VectorCodes, error) {
+			for _, chunk := range pack.insertData {
+				if err := builder.AppendFieldData(chunk.Data[fieldID]); err != nil {
+					return nil, err
+				}
+			}
+			return builder.Build(quantization, pqM)
+		}()
+		if err != nil {
+			log.Warn("failed to quantize vectors", zap.Int64("fieldID", fieldID), zap.Error(err))
+			continue
+		}
+		data, err := storage.SerializeVectorCodes(codes)
+		if err != nil {
+			log.Warn("failed to serialize vector codes", zap.Int64("fieldID", fieldID), zap.Error(err))
+			continue
+		}
+		blobs[fieldID] = &storage.Blob{
+			Key:        fmt.Sprint(fieldID),
+			Value:      data,
+			RowNum:     int64(codes.NumRows),
+			MemorySize: codes.MemorySize(),
+		}
+	}
+	return blobs
+}
+
 func (s *storageV1Serializer) serializeDeltalog(pack *SyncPack) (*storage.Blob, error) {
 	return s.delCodec.Serialize(pack.collectionID, pack.partitionID, pack.segmentID, pack.deltaData)
 }
@@ -255,10 +255,13 @@ func (s *StorageV1SerializerSuite) TestSerializeInsert() {
 		bfs := s.getBfs()
 		segInfo := metacache.NewSegmentInfo(&datapb.SegmentInfo{}, bfs)
 		metacache.UpdateNumOfRows(1000)(segInfo)
+		metacache.MergeVectorStats(map[int64]*storage.VectorFieldStats{
+			101: {FieldID: 101, RowNum: 1000, MinNorm: 1, MaxNorm: 1, NormSum: 1000, NormSquareSum: 1000},
+		})(segInfo)
 		s.mockCache.EXPECT().UpdateSegments(mock.Anything, mock.Anything).Run(func(action metacache.SegmentAction, filters ...metacache.SegmentFilter) {
 			action(segInfo)
 		}).Return().Once()
-		s.mockCache.EXPECT().GetSegmentByID(s.segmentID).Return(segInfo, true).Once()
+		s.mockCache.EXPECT().GetSegmentByID(s.segmentID).Return(segInfo, true).Twice()
 
 		task, err := s.serializer.EncodeBuffer(ctx, pack)
 		s.NoError(err)
@@ -277,6 +280,8 @@ func (s *StorageV1SerializerSuite) TestSerializeInsert() {
 		s.Len(taskV1.binlogBlobs, 4)
 		s.NotNil(taskV1.batchStatsBlob)
 		s.NotNil(taskV1.mergedStatsBlob)
+		s.Len(taskV1.vectorStatsBlobs, 1)
+		s.Contains(taskV1.vectorStatsBlobs, int64(101))
 	})
 }
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sort"
 
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -73,13 +74,19 @@ type SyncTask struct {
 	statsBinlogs  map[int64]*datapb.FieldBinlog // map[int64]*datapb.Binlog
 	deltaBinlog   *datapb.FieldBinlog
 
-	binlogBlobs     map[int64]*storage.Blob // fieldID => blob
-	binlogMemsize   map[int64]int64         // memory size
+	binlogBlobs   map[int64]*storage.Blob // fieldID => blob
+	binlogMemsize map[int64]int64         // memory size
+	// fieldID => quantized vector codes of the binlog, written with the same log id as the binlog
+	quantizedBlobs  map[int64]*storage.Blob
 	batchStatsBlob  *storage.Blob
 	mergedStatsBlob *storage.Blob
-	deltaBlob       *storage.Blob
-	deltaRowCount   int64
+	// fieldID => aggregated vector stats of the segment, written on flush
+	vectorStatsBlobs map[int64]*storage.Blob
+	deltaBlob        *storage.Blob
+	deltaRowCount    int64
 
+	// log ids allocated for the task, kept so that a retried run writes and references the same log files
+	logIDs []int64
 	// prefetched log ids
 	ids []int64
 
@@ -141,6 +148,7 @@ func (t *SyncTask) Run(ctx context.Context) (err error) {
 		return err
 	}
 
+	t.resetLogs()
 	t.processInsertBlobs()
 	t.processStatsBlob()
 	t.processDeltaBlob()
@@ -194,27 +202,42 @@ func (t *SyncTask) Run(ctx context.Context) (err error) {
 	t.deltaBlob = nil
 	t.mergedStatsBlob = nil
 	t.batchStatsBlob = nil
+	t.vectorStatsBlobs = nil
+	t.quantizedBlobs = nil
 	t.segmentData = nil
 	return nil
 }
 
 // prefetchIDs pre-allcates ids depending on the number of blobs current task contains.
+// The ids are allocated once, the retried runs of the task reuse them.
 func (t *SyncTask) prefetchIDs() error {
-	totalIDCount := len(t.binlogBlobs)
-	if t.batchStatsBlob != nil {
-		totalIDCount++
-	}
-	if t.deltaBlob != nil {
-		totalIDCount++
-	}
-	start, _, err := t.allocator.Alloc(uint32(totalIDCount))
-	if err != nil {
-		return err
+	if t.logIDs == nil {
+		totalIDCount := len(t.binlogBlobs)
+		if t.batchStatsBlob != nil {
+			totalIDCount++
+		}
+		if t.deltaBlob != nil {
+			totalIDCount++
+		}
+		start, _, err := t.allocator.Alloc(uint32(totalIDCount))
+		if err != nil {
+			return err
+		}
+		t.logIDs = lo.RangeFrom(start, totalIDCount)
 	}
-	t.ids = lo.RangeFrom(start, totalIDCount)
+	t.ids = t.logIDs
 	return nil
 }
 
+// resetLogs clears the logs generated by the previous run of the task,
+// so that the binlogs are not referenced twice when the task is retried.
+func (t *SyncTask) resetLogs() {
+	t.insertBinlogs = make(map[int64]*datapb.FieldBinlog)
+	t.statsBinlogs = make(map[int64]*datapb.FieldBinlog)
+	t.deltaBinlog = &datapb.FieldBinlog{}
+	t.segmentData = make(map[string][]byte)
+}
+
 func (t *SyncTask) nextID() int64 {
 	if len(t.ids) == 0 {
 		panic("pre-fetched ids exhausted")
@@ -225,10 +248,19 @@ func (t *SyncTask) nextID() int64 {
 }
 
 func (t *SyncTask) processInsertBlobs() {
-	for fieldID, blob := range t.binlogBlobs {
-		k := metautil.JoinIDPath(t.collectionID, t.partitionID, t.segmentID, fieldID, t.nextID())
+	// assign the log ids in field order to name the binlogs deterministically
+	fieldIDs := lo.Keys(t.binlogBlobs)
+	sort.Slice(fieldIDs, func(i, j int) bool { return fieldIDs[i] < fieldIDs[j] })
+	for _, fieldID := range fieldIDs {
+		blob := t.binlogBlobs[fieldID]
+		logID := t.nextID()
+		k := metautil.JoinIDPath(t.collectionID, t.partitionID, t.segmentID, fieldID, logID)
 		key := path.Join(t.chunkManager.RootPath(), common.SegmentInsertLogPath, k)
 		t.segmentData[key] = blob.GetValue()
+		if codes, ok := t.quantizedBlobs[fieldID]; ok {
+			codesKey := metautil.BuildQuantizedLogPath(t.chunkManager.RootPath(), t.collectionID, t.partitionID, t.segmentID, fieldID, logID)
+			t.segmentData[codesKey] = codes.GetValue()
+		}
 		t.appendBinlog(fieldID, &datapb.Binlog{
 			EntriesNum:    blob.RowNum,
 			TimestampFrom: t.tsFrom,
@@ -248,6 +280,9 @@ func (t *SyncTask) processStatsBlob() {
 		totalRowNum := t.segment.NumOfRows()
 		t.convertBlob2StatsBinlog(t.mergedStatsBlob, t.pkField.GetFieldID(), int64(storage.CompoundStatsType), totalRowNum)
 	}
+	for fieldID, blob := range t.vectorStatsBlobs {
+		t.convertBlob2StatsBinlog(blob, fieldID, int64(storage.VectorStatsType), blob.RowNum)
+	}
 }
 
 func (t *SyncTask) processDeltaBlob() {
@@ -297,6 +297,61 @@ func (s *SyncTaskSuite) TestRunL0Segment() {
 	})
 }
 
+func (s *SyncTaskSuite) TestRunRetried() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	allocCount := 0
+	s.allocator.AllocF = func(count uint32) (int64, int64, error) {
+		allocCount++
+		return time.Now().UnixNano(), int64(count), nil
+	}
+	reqs := make([]*datapb.SaveBinlogPathsRequest, 0)
+	s.broker.EXPECT().SaveBinlogPaths(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, req *datapb.SaveBinlogPathsRequest) error {
+		reqs = append(reqs, req)
+		if len(reqs) == 1 {
+			return errors.New("mocked")
+		}
+		return nil
+	})
+	seg := metacache.NewSegmentInfo(&datapb.SegmentInfo{}, metacache.NewBloomFilterSet())
+	s.metacache.EXPECT().GetSegmentByID(s.segmentID).Return(seg, true)
+	s.metacache.EXPECT().GetSegmentsBy(mock.Anything, mock.Anything).Return(nil)
+	s.metacache.EXPECT().UpdateSegments(mock.Anything, mock.Anything).Return()
+
+	task := s.getSuiteSyncTask()
+	task.WithTimeRange(50, 100)
+	task.WithMetaWriter(BrokerMetaWriter(s.broker, 1, retry.Attempts(1)))
+	task.WithCheckpoint(&msgpb.MsgPosition{
+		ChannelName: s.channelName,
+		MsgID:       []byte{1, 2, 3, 4},
+		Timestamp:   100,
+	})
+	task.binlogBlobs[100] = &storage.Blob{Key: "100", Value: []byte("test_data")}
+	task.binlogBlobs[101] = &storage.Blob{Key: "101", Value: []byte("test_data")}
+	task.deltaBlob = &storage.Blob{Key: "100", Value: []byte("test_data")}
+
+	err := task.Run(ctx)
+	s.Error(err)
+	err = task.Run(ctx)
+	s.NoError(err)
+
+	// the retried run references the same log files
+	s.Equal(1, allocCount)
+	s.Require().Equal(2, len(reqs))
+	getPaths := func(req *datapb.SaveBinlogPathsRequest) []string {
+		paths := make([]string, 0)
+		for _, fieldBinlog := range append(req.GetField2BinlogPaths(), req.GetDeltalogs()...) {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				paths = append(paths, binlog.GetLogPath())
+			}
+		}
+		return paths
+	}
+	s.Equal(3, len(getPaths(reqs[0])))
+	s.ElementsMatch(getPaths(reqs[0]), getPaths(reqs[1]))
+}
+
 func (s *SyncTaskSuite) TestRunError() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -10,6 +10,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
 	"github.com/milvus-io/milvus/internal/flushcommon/syncmgr"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
@@ -40,6 +41,8 @@ type BufferManager interface {
 	GetCheckpoint(channel string) (*msgpb.MsgPosition, bool, error)
 	// NotifyCheckpointUpdated notify write buffer checkpoint updated to reset flushTs.
 	NotifyCheckpointUpdated(channel string, ts uint64)
+	// GetFlushProgress returns the flush progress of the unflushed segments of the provided channel.
+	GetFlushProgress(channel string) ([]*datapb.SegmentFlushProgress, error)
 
 	// Start makes the background check start to work.
 	Start()
@@ -217,6 +220,18 @@ func (m *bufferManager) GetCheckpoint(channel string) (*msgpb.MsgPosition, bool,
 	return cp, flushTs != nonFlushTS && cp.GetTimestamp() >= flushTs, nil
 }
 
+// GetFlushProgress returns the flush progress of the unflushed segments of the provided channel.
+func (m *bufferManager) GetFlushProgress(channel string) ([]*datapb.SegmentFlushProgress, error) {
+	m.mut.RLock()
+	buf, ok := m.buffers[channel]
+	m.mut.RUnlock()
+
+	if !ok {
+		return nil, merr.WrapErrChannelNotFound(channel)
+	}
+	return buf.GetFlushProgress(), nil
+}
+
 func (m *bufferManager) NotifyCheckpointUpdated(channel string, ts uint64) {
 	m.mut.Lock()
 	defer m.mut.Unlock()
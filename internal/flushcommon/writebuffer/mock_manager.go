@@ -5,6 +5,8 @@ package writebuffer
 import (
 	context "context"
 
+	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
+
 	metacache "github.com/milvus-io/milvus/internal/flushcommon/metacache"
 	mock "github.com/stretchr/testify/mock"
 
@@ -244,6 +246,60 @@ func (_c *MockBufferManager_GetCheckpoint_Call) RunAndReturn(run func(string) (*
 	return _c
 }
 
+// GetFlushProgress provides a mock function with given fields: channel
+func (_m *MockBufferManager) GetFlushProgress(channel string) ([]*datapb.SegmentFlushProgress, error) {
+	ret := _m.Called(channel)
+
+	var r0 []*datapb.SegmentFlushProgress
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*datapb.SegmentFlushProgress, error)); ok {
+		return rf(channel)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*datapb.SegmentFlushProgress); ok {
+		r0 = rf(channel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.SegmentFlushProgress)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(channel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBufferManager_GetFlushProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushProgress'
+type MockBufferManager_GetFlushProgress_Call struct {
+	*mock.Call
+}
+
+// GetFlushProgress is a helper method to define mock.On call
+//   - channel string
+func (_e *MockBufferManager_Expecter) GetFlushProgress(channel interface{}) *MockBufferManager_GetFlushProgress_Call {
+	return &MockBufferManager_GetFlushProgress_Call{Call: _e.mock.On("GetFlushProgress", channel)}
+}
+
+func (_c *MockBufferManager_GetFlushProgress_Call) Run(run func(channel string)) *MockBufferManager_GetFlushProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockBufferManager_GetFlushProgress_Call) Return(_a0 []*datapb.SegmentFlushProgress, _a1 error) *MockBufferManager_GetFlushProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBufferManager_GetFlushProgress_Call) RunAndReturn(run func(string) ([]*datapb.SegmentFlushProgress, error)) *MockBufferManager_GetFlushProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NotifyCheckpointUpdated provides a mock function with given fields: channel, ts
 func (_m *MockBufferManager) NotifyCheckpointUpdated(channel string, ts uint64) {
 	_m.Called(channel, ts)
@@ -5,9 +5,11 @@ package writebuffer
 import (
 	context "context"
 
-	msgpb "github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
 	mock "github.com/stretchr/testify/mock"
 
+	msgpb "github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+
 	msgstream "github.com/milvus-io/milvus/pkg/mq/msgstream"
 )
 
@@ -225,6 +227,49 @@ func (_c *MockWriteBuffer_GetCheckpoint_Call) RunAndReturn(run func() *msgpb.Msg
 	return _c
 }
 
+// GetFlushProgress provides a mock function with given fields:
+func (_m *MockWriteBuffer) GetFlushProgress() []*datapb.SegmentFlushProgress {
+	ret := _m.Called()
+
+	var r0 []*datapb.SegmentFlushProgress
+	if rf, ok := ret.Get(0).(func() []*datapb.SegmentFlushProgress); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.SegmentFlushProgress)
+		}
+	}
+
+	return r0
+}
+
+// MockWriteBuffer_GetFlushProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushProgress'
+type MockWriteBuffer_GetFlushProgress_Call struct {
+	*mock.Call
+}
+
+// GetFlushProgress is a helper method to define mock.On call
+func (_e *MockWriteBuffer_Expecter) GetFlushProgress() *MockWriteBuffer_GetFlushProgress_Call {
+	return &MockWriteBuffer_GetFlushProgress_Call{Call: _e.mock.On("GetFlushProgress")}
+}
+
+func (_c *MockWriteBuffer_GetFlushProgress_Call) Run(run func()) *MockWriteBuffer_GetFlushProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWriteBuffer_GetFlushProgress_Call) Return(_a0 []*datapb.SegmentFlushProgress) *MockWriteBuffer_GetFlushProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWriteBuffer_GetFlushProgress_Call) RunAndReturn(run func() []*datapb.SegmentFlushProgress) *MockWriteBuffer_GetFlushProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFlushTimestamp provides a mock function with given fields:
 func (_m *MockWriteBuffer) GetFlushTimestamp() uint64 {
 	ret := _m.Called()
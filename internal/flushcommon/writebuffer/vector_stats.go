@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package writebuffer
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// policies of vector statistics, see dataNode.vectorStats.policy
+const (
+	VectorStatsDisabled = "disabled"
+	VectorStatsFlag     = "flag"
+	VectorStatsReject   = "reject"
+)
+
+// getVectorDims returns the dimensions of the vector fields which support statistics.
+func getVectorDims(schema *schemapb.CollectionSchema) (map[int64]int, error) {
+	dims := make(map[int64]int)
+	for _, field := range schema.GetFields() {
+		if !storage.SupportVectorStats(field.GetDataType()) {
+			continue
+		}
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return nil, err
+		}
+		dims[field.GetFieldID()] = int(dim)
+	}
+	return dims, nil
+}
+
+// collectVectorStats collects the statistics of the vector fields in the insert data of the msg,
+// a batch with NaN/Inf vectors or mismatched dimension is corrupt, it is logged and counted,
+// and it returns false if the batch shall be discarded per policy.
+func (wb *writeBufferBase) collectVectorStats(msg *msgstream.InsertMsg, data *storage.InsertData) (map[int64]*storage.VectorFieldStats, bool) {
+	policy := paramtable.Get().DataNodeCfg.VectorStatsPolicy.GetValue()
+	if policy != VectorStatsFlag && policy != VectorStatsReject {
+		return nil, true
+	}
+
+	result := make(map[int64]*storage.VectorFieldStats, len(wb.vectorDims))
+	for fieldID, dim := range wb.vectorDims {
+		fieldData, ok := data.Data[fieldID]
+		if !ok {
+			continue
+		}
+		stats, err := storage.CollectVectorStats(fieldID, dim, fieldData)
+		if err == nil && !stats.Corrupted() {
+			result[fieldID] = stats
+			continue
+		}
+
+		fields := []zap.Field{
+			zap.Int64("segmentID", msg.GetSegmentID()),
+			zap.Int64("fieldID", fieldID),
+			zap.Uint64("beginTs", msg.BeginTs()),
+			zap.Int("rowNum", data.GetRowNum()),
+			zap.String("policy", policy),
+		}
+		if err != nil {
+			fields = append(fields, zap.Error(err))
+		} else {
+			fields = append(fields, zap.Stringer("stats", stats))
+		}
+		wb.logger.RatedWarn(10, "corrupt vectors consumed", fields...)
+		metrics.DataNodeCorruptVectorRows.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(wb.collectionID), policy).
+			Add(float64(data.GetRowNum()))
+		if policy == VectorStatsReject {
+			return nil, false
+		}
+		if err == nil {
+			result[fieldID] = stats
+		}
+	}
+	return result, true
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -52,6 +53,8 @@ type WriteBuffer interface {
 	GetCheckpoint() *msgpb.MsgPosition
 	// MemorySize returns the size in bytes currently used by this write buffer.
 	MemorySize() int64
+	// GetFlushProgress returns the flush progress of the unflushed segments in this write buffer.
+	GetFlushProgress() []*datapb.SegmentFlushProgress
 	// EvictBuffer evicts buffer to sync manager which match provided sync policies.
 	EvictBuffer(policies ...SyncPolicy)
 	// Close is the method to close and sink current buffer data.
@@ -127,6 +130,7 @@ type writeBufferBase struct {
 	collSchema       *schemapb.CollectionSchema
 	helper           *typeutil.SchemaHelper
 	pkField          *schemapb.FieldSchema
+	vectorDims       map[int64]int // fieldID => dim, of the vector fields which support statistics
 	estSizePerRecord int
 	metaCache        metacache.MetaCache
 
@@ -134,6 +138,7 @@ type writeBufferBase struct {
 
 	syncPolicies   []SyncPolicy
 	syncCheckpoint *checkpointCandidates
+	lastSyncTimes  *typeutil.ConcurrentMap[int64, time.Time] // segmentID => the finish time of the last sync
 	syncMgr        syncmgr.SyncManager
 	serializer     syncmgr.Serializer
 
@@ -185,6 +190,10 @@ func newWriteBufferBase(channel string, metacache metacache.MetaCache, storageV2
 	if err != nil {
 		return nil, err
 	}
+	vectorDims, err := getVectorDims(schema)
+	if err != nil {
+		return nil, err
+	}
 
 	wb := &writeBufferBase{
 		channelName:      channel,
@@ -192,6 +201,7 @@ func newWriteBufferBase(channel string, metacache metacache.MetaCache, storageV2
 		collSchema:       schema,
 		helper:           helper,
 		pkField:          pkField,
+		vectorDims:       vectorDims,
 		estSizePerRecord: estSize,
 		syncMgr:          syncMgr,
 		metaWriter:       option.metaWriter,
@@ -199,6 +209,7 @@ func newWriteBufferBase(channel string, metacache metacache.MetaCache, storageV2
 		metaCache:        metacache,
 		serializer:       serializer,
 		syncCheckpoint:   newCheckpointCandiates(),
+		lastSyncTimes:    typeutil.NewConcurrentMap[int64, time.Time](),
 		syncPolicies:     option.syncPolicies,
 		flushTimestamp:   flushTs,
 		storagev2Cache:   storageV2Cache,
@@ -252,6 +263,37 @@ func (wb *writeBufferBase) MemorySize() int64 {
 	return size
 }
 
+func (wb *writeBufferBase) GetFlushProgress() []*datapb.SegmentFlushProgress {
+	wb.mut.RLock()
+	defer wb.mut.RUnlock()
+
+	segments := wb.metaCache.GetSegmentsBy(metacache.WithSegmentState(commonpb.SegmentState_Growing, commonpb.SegmentState_Sealed))
+	unflushed := typeutil.NewSet[int64]()
+	progress := make([]*datapb.SegmentFlushProgress, 0, len(segments))
+	for _, segment := range segments {
+		unflushed.Insert(segment.SegmentID())
+		p := &datapb.SegmentFlushProgress{
+			SegmentID: segment.SegmentID(),
+			Channel:   wb.channelName,
+		}
+		if buf, ok := wb.buffers[segment.SegmentID()]; ok {
+			p.PendingBinlogBytes = buf.MemorySize()
+		}
+		if lastSyncTime, ok := wb.lastSyncTimes.Get(segment.SegmentID()); ok {
+			p.LastSyncTime = lastSyncTime.UnixMilli()
+		}
+		progress = append(progress, p)
+	}
+	// the flushed segments are not tracked anymore
+	wb.lastSyncTimes.Range(func(segmentID int64, _ time.Time) bool {
+		if !unflushed.Contain(segmentID) {
+			wb.lastSyncTimes.Remove(segmentID)
+		}
+		return true
+	})
+	return progress
+}
+
 func (wb *writeBufferBase) EvictBuffer(policies ...SyncPolicy) {
 	log := wb.logger
 	wb.mut.Lock()
@@ -357,6 +399,7 @@ func (wb *writeBufferBase) syncSegments(ctx context.Context, segmentIDs []int64)
 			if syncTask.StartPosition() != nil {
 				wb.syncCheckpoint.Remove(syncTask.SegmentID(), syncTask.StartPosition().GetTimestamp())
 			}
+			wb.lastSyncTimes.Insert(syncTask.SegmentID(), time.Now())
 			return nil
 		}))
 	}
@@ -416,6 +459,7 @@ type inData struct {
 	pkField     []storage.FieldData
 	tsField     []*storage.Int64FieldData
 	rowNum      int64
+	vectorStats map[int64]*storage.VectorFieldStats
 
 	intPKTs map[int64]int64
 	strPKTs map[string]int64
@@ -488,6 +532,11 @@ func (wb *writeBufferBase) prepareInsert(insertMsgs []*msgstream.InsertMsg) ([]*
 				return nil, err
 			}
 
+			vectorStats, ok := wb.collectVectorStats(msg, data)
+			if !ok {
+				continue
+			}
+
 			pkFieldData, err := storage.GetPkFromInsertData(wb.collSchema, data)
 			if err != nil {
 				return nil, err
@@ -529,6 +578,20 @@ func (wb *writeBufferBase) prepareInsert(insertMsgs []*msgstream.InsertMsg) ([]*
 			inData.pkField = append(inData.pkField, pkFieldData)
 			inData.tsField = append(inData.tsField, tsFieldData)
 			inData.rowNum += int64(data.GetRowNum())
+			for fieldID, stats := range vectorStats {
+				if inData.vectorStats == nil {
+					inData.vectorStats = make(map[int64]*storage.VectorFieldStats)
+				}
+				if current, ok := inData.vectorStats[fieldID]; ok {
+					current.Merge(stats)
+				} else {
+					inData.vectorStats[fieldID] = stats
+				}
+			}
+		}
+		// all the msgs of the segment are discarded
+		if len(inData.data) == 0 {
+			continue
 		}
 		result = append(result, inData)
 	}
@@ -557,8 +620,10 @@ func (wb *writeBufferBase) bufferInsert(inData *inData, startPos, endPos *msgpb.
 	segBuf := wb.getOrCreateBuffer(inData.segmentID)
 
 	totalMemSize := segBuf.insertBuffer.Buffer(inData, startPos, endPos)
-	wb.metaCache.UpdateSegments(metacache.UpdateBufferedRows(segBuf.insertBuffer.rows),
-		metacache.WithSegmentIDs(inData.segmentID))
+	wb.metaCache.UpdateSegments(metacache.MergeSegmentAction(
+		metacache.UpdateBufferedRows(segBuf.insertBuffer.rows),
+		metacache.MergeVectorStats(inData.vectorStats),
+	), metacache.WithSegmentIDs(inData.segmentID))
 
 	metrics.DataNodeFlowGraphBufferDataSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(wb.collectionID)).Add(float64(totalMemSize))
 
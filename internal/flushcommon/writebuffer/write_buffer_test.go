@@ -2,7 +2,9 @@ package writebuffer
 
 import (
 	"context"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -14,7 +16,9 @@ import (
 	"github.com/milvus-io/milvus/internal/flushcommon/metacache"
 	"github.com/milvus-io/milvus/internal/flushcommon/syncmgr"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/conc"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -381,6 +385,89 @@ func (s *WriteBufferSuite) TestDropPartitions() {
 	wb.dropPartitions([]int64{100, 101})
 }
 
+func (s *WriteBufferSuite) TestGetFlushProgress() {
+	buf, err := newSegmentBuffer(1, s.collSchema)
+	s.Require().NoError(err)
+	s.wb.mut.Lock()
+	s.wb.buffers[1] = buf
+	s.wb.mut.Unlock()
+	defer func() {
+		s.wb.mut.Lock()
+		defer s.wb.mut.Unlock()
+		s.wb.buffers = make(map[int64]*segmentBuffer)
+	}()
+	lastSyncTime := time.Now()
+	s.wb.lastSyncTimes.Insert(2, lastSyncTime)
+	s.wb.lastSyncTimes.Insert(3, lastSyncTime)
+
+	s.metacache.EXPECT().GetSegmentsBy(mock.Anything).Return([]*metacache.SegmentInfo{
+		metacache.NewSegmentInfo(&datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing}, nil),
+		metacache.NewSegmentInfo(&datapb.SegmentInfo{ID: 2, State: commonpb.SegmentState_Sealed}, nil),
+	}).Once()
+
+	progress := s.wb.GetFlushProgress()
+	s.Require().Len(progress, 2)
+	s.EqualValues(1, progress[0].GetSegmentID())
+	s.Equal(s.channelName, progress[0].GetChannel())
+	s.Equal(buf.MemorySize(), progress[0].GetPendingBinlogBytes())
+	s.Zero(progress[0].GetLastSyncTime())
+	s.EqualValues(2, progress[1].GetSegmentID())
+	s.Equal(lastSyncTime.UnixMilli(), progress[1].GetLastSyncTime())
+	// the sync time of the flushed segment is removed
+	s.False(s.wb.lastSyncTimes.Contain(3))
+}
+
+func (s *WriteBufferSuite) TestCollectVectorStats() {
+	params := paramtable.Get()
+	defer params.Reset(params.DataNodeCfg.VectorStatsPolicy.Key)
+
+	vectors := make([]float32, 128*2)
+	vectors[0] = 1
+	msg := &msgstream.InsertMsg{InsertRequest: &msgpb.InsertRequest{SegmentID: 1000}}
+	data := &storage.InsertData{Data: map[int64]storage.FieldData{
+		101: &storage.FloatVectorFieldData{Dim: 128, Data: vectors},
+	}}
+
+	s.Run("disabled", func() {
+		params.Save(params.DataNodeCfg.VectorStatsPolicy.Key, VectorStatsDisabled)
+		stats, ok := s.wb.collectVectorStats(msg, data)
+		s.True(ok)
+		s.Empty(stats)
+	})
+
+	s.Run("flag", func() {
+		params.Save(params.DataNodeCfg.VectorStatsPolicy.Key, VectorStatsFlag)
+		stats, ok := s.wb.collectVectorStats(msg, data)
+		s.True(ok)
+		s.Require().Contains(stats, int64(101))
+		s.EqualValues(2, stats[101].RowNum)
+		s.EqualValues(1, stats[101].ZeroNum)
+
+		vectors[128] = float32(math.NaN())
+		defer func() { vectors[128] = 0 }()
+		stats, ok = s.wb.collectVectorStats(msg, data)
+		s.True(ok)
+		s.EqualValues(1, stats[101].InvalidNum)
+	})
+
+	s.Run("reject", func() {
+		params.Save(params.DataNodeCfg.VectorStatsPolicy.Key, VectorStatsReject)
+		_, ok := s.wb.collectVectorStats(msg, data)
+		s.True(ok)
+
+		vectors[128] = float32(math.Inf(-1))
+		defer func() { vectors[128] = 0 }()
+		_, ok = s.wb.collectVectorStats(msg, data)
+		s.False(ok)
+
+		// dimension mismatch
+		_, ok = s.wb.collectVectorStats(msg, &storage.InsertData{Data: map[int64]storage.FieldData{
+			101: &storage.FloatVectorFieldData{Dim: 64, Data: make([]float32, 64)},
+		}})
+		s.False(ok)
+	})
+}
+
 func TestWriteBufferBase(t *testing.T) {
 	suite.Run(t, new(WriteBufferSuite))
 }
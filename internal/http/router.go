@@ -25,6 +25,9 @@ const LogLevelRouterPath = "/log/level"
 // EventLogRouterPath is path for eventlog control.
 const EventLogRouterPath = "/eventlog"
 
+// TraceSamplingRouterPath is path for Get, Update and Reset trace sampling policy at runtime.
+const TraceSamplingRouterPath = "/trace/sampling"
+
 // ExprPath is path for expression.
 const ExprPath = "/expr"
 
@@ -44,8 +47,22 @@ const (
 
 // proxy management restful api root path
 const (
-	RouteGcPause  = "/management/datacoord/garbage_collection/pause"
-	RouteGcResume = "/management/datacoord/garbage_collection/resume"
+	RouteGcPause             = "/management/datacoord/garbage_collection/pause"
+	RouteGcResume            = "/management/datacoord/garbage_collection/resume"
+	RouteGcPruneSegmentIndex = "/management/datacoord/garbage_collection/prune_segment_index"
+	RouteGetVectorStats      = "/management/datacoord/vector_stats/get"
+	RouteCheckDuplicatePK    = "/management/datacoord/duplicate_pk/check"
+	RouteGetDuplicatePK      = "/management/datacoord/duplicate_pk/get"
+	RouteListDatabaseQuotas  = "/management/datacoord/database_quota/list"
+	RouteForceCompleteTask   = "/management/datacoord/task/force_complete"
+	RouteForceFailTask       = "/management/datacoord/task/force_fail"
+	RouteCreateExport        = "/management/datacoord/export/create"
+	RouteGetExportProgress   = "/management/datacoord/export/progress"
+
+	RouteGetRegionReplicaStatus = "/management/datacoord/region_replica/status"
+	RouteFailoverRegionReplica  = "/management/datacoord/region_replica/failover"
+
+	RouteGetSLOStatus = "/management/proxy/slo/status"
 
 	RouteSuspendQueryCoordBalance = "/management/querycoord/balance/suspend"
 	RouteResumeQueryCoordBalance  = "/management/querycoord/balance/resume"
@@ -54,7 +71,11 @@ const (
 
 	RouteSuspendQueryNode           = "/management/querycoord/node/suspend"
 	RouteResumeQueryNode            = "/management/querycoord/node/resume"
+	RouteSafeRemoveQueryNode        = "/management/querycoord/node/safe_remove"
+	RouteGetSafeRemoveProgress      = "/management/querycoord/node/safe_remove/progress"
 	RouteListQueryNode              = "/management/querycoord/node/list"
 	RouteGetQueryNodeDistribution   = "/management/querycoord/distribution/get"
 	RouteCheckQueryNodeDistribution = "/management/querycoord/distribution/check"
+
+	RouteCheckRowCountConsistency = "/management/querycoord/row_count/check"
 )
@@ -140,6 +140,18 @@ func (suite *HTTPServerTestSuite) TestEventlogHandler() {
 	suite.True(strings.HasPrefix(string(body), "{\"status\":200,\"port\":"))
 }
 
+func (suite *HTTPServerTestSuite) TestTraceSamplingHandler() {
+	url := "http://localhost:" + DefaultListenPort + TraceSamplingRouterPath
+	client := http.Client{}
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	resp, err := client.Do(req)
+	suite.Nil(err)
+	defer resp.Body.Close()
+	suite.Equal(http.StatusOK, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	suite.True(strings.HasPrefix(string(body), "{\"rate\":"))
+}
+
 func (suite *HTTPServerTestSuite) TestPprofHandler() {
 	client := http.Client{}
 	testCases := []struct {
@@ -109,6 +109,7 @@ type IndexNode struct {
 	stateLock    sync.Mutex
 	indexTasks   map[taskKey]*indexTaskInfo
 	analyzeTasks map[taskKey]*analyzeTaskInfo
+	statsTasks   map[taskKey]*statsTaskInfo
 }
 
 // NewIndexNode creates a new IndexNode component.
@@ -123,6 +124,7 @@ func NewIndexNode(ctx context.Context, factory dependency.Factory) *IndexNode {
 		storageFactory: NewChunkMgrFactory(),
 		indexTasks:     make(map[taskKey]*indexTaskInfo),
 		analyzeTasks:   make(map[taskKey]*analyzeTaskInfo),
+		statsTasks:     make(map[taskKey]*statsTaskInfo),
 		lifetime:       lifetime.NewLifetime(commonpb.StateCode_Abnormal),
 	}
 	sc := NewTaskScheduler(b.loopCtx)
@@ -265,6 +267,12 @@ func (i *IndexNode) Stop() error {
 				t.cancel()
 			}
 		}
+		deletedStatsTasks := i.deleteAllStatsTasks()
+		for _, t := range deletedStatsTasks {
+			if t.cancel != nil {
+				t.cancel()
+			}
+		}
 		if i.sched != nil {
 			i.sched.Close()
 		}
@@ -29,9 +29,11 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/compatutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -138,6 +140,8 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 				failReason:          info.failReason,
 				currentIndexVersion: info.currentIndexVersion,
 				indexStoreVersion:   info.indexStoreVersion,
+				progress:            info.progress,
+				startTime:           info.startTime,
 			}
 		}
 	})
@@ -160,6 +164,11 @@ func (i *IndexNode) QueryJobs(ctx context.Context, req *indexpb.QueryJobsRequest
 			ret.IndexInfos[i].FailReason = info.failReason
 			ret.IndexInfos[i].CurrentIndexVersion = info.currentIndexVersion
 			ret.IndexInfos[i].IndexStoreVersion = info.indexStoreVersion
+			ret.IndexInfos[i].Progress = info.progress
+			ret.IndexInfos[i].StartTime = startTimeMilli(info.startTime)
+			if info.state == commonpb.IndexState_Finished {
+				ret.IndexInfos[i].RequiredCpuFeatures = getRequiredCPUFeatures()
+			}
 			log.RatedDebug(5, "querying index build task",
 				zap.Int64("indexBuildID", buildID),
 				zap.String("state", info.state.String()),
@@ -205,23 +214,33 @@ func (i *IndexNode) GetJobStats(ctx context.Context, req *indexpb.GetJobStatsReq
 	}
 	defer i.lifetime.Done()
 	unissued, active := i.sched.TaskQueue.GetTaskNum()
+	// the task with build profile may take more than one slot
+	used := i.sched.TaskQueue.GetUsedSlots()
 
-	slots := 0
-	if i.sched.buildParallel > unissued+active {
-		slots = i.sched.buildParallel - unissued - active
+	var slots int64
+	if buildParallel := i.sched.GetBuildParallel(); buildParallel > used {
+		slots = buildParallel - used
 	}
+	diskCapacity, availableDisk := getDiskResources()
 	log.Ctx(ctx).Info("Get Index Job Stats",
 		zap.Int("unissued", unissued),
 		zap.Int("active", active),
-		zap.Int("slot", slots),
+		zap.Int64("used", used),
+		zap.Int64("slot", slots),
+		zap.Int64("availableDisk", availableDisk),
 	)
 	return &indexpb.GetJobStatsResponse{
 		Status:           merr.Success(),
 		TotalJobNum:      int64(active) + int64(unissued),
 		InProgressJobNum: int64(active),
 		EnqueueJobNum:    int64(unissued),
-		TaskSlots:        int64(slots),
+		TaskSlots:        slots,
 		EnableDisk:       Params.IndexNodeCfg.EnableDisk.GetAsBool(),
+		MemoryCapacity:   int64(hardware.GetMemoryCount()),
+		AvailableMemory:  int64(hardware.GetFreeMemoryCount()),
+		DiskCapacity:     diskCapacity,
+		AvailableDisk:    availableDisk,
+		CpuFeatures:      getRequiredCPUFeatures(),
 	}, nil
 }
 
@@ -264,6 +283,11 @@ func (i *IndexNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequ
 		return metrics, nil
 	}
 
+	if metricType == metricsinfo.DiagnosticsMetrics {
+		log.Ctx(ctx).Info("IndexNode.GetMetrics capture diagnostics", zap.Int64("nodeID", paramtable.GetNodeID()))
+		return getDiagnosticsMetrics(), nil
+	}
+
 	log.Ctx(ctx).RatedWarn(60, "IndexNode.GetMetrics failed, request metric type is not implemented yet",
 		zap.Int64("nodeID", paramtable.GetNodeID()),
 		zap.String("req", req.GetRequest()),
@@ -275,6 +299,7 @@ func (i *IndexNode) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequ
 }
 
 func (i *IndexNode) CreateJobV2(ctx context.Context, req *indexpb.CreateJobV2Request) (*commonpb.Status, error) {
+	compatutil.NormalizeCreateJobV2Request(req)
 	log := log.Ctx(ctx).With(
 		zap.String("clusterID", req.GetClusterID()), zap.Int64("taskID", req.GetTaskID()),
 		zap.String("jobType", req.GetJobType().String()),
@@ -311,11 +336,14 @@ func (i *IndexNode) CreateJobV2(ctx context.Context, req *indexpb.CreateJobV2Req
 		if oldInfo := i.loadOrStoreIndexTask(indexRequest.GetClusterID(), indexRequest.GetBuildID(), &indexTaskInfo{
 			cancel: taskCancel,
 			state:  commonpb.IndexState_InProgress,
+			epoch:  indexRequest.GetIndexVersion(),
 		}); oldInfo != nil {
-			err := merr.WrapErrIndexDuplicate(indexRequest.GetIndexName(), "building index task existed")
-			log.Warn("duplicated index build task", zap.Error(err))
-			metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.FailLabel).Inc()
-			return merr.Status(err), nil
+			taskCancel()
+			status := checkTaskEpoch(log, oldInfo.epoch, indexRequest.GetIndexVersion(), indexRequest.GetIndexName())
+			if !merr.Ok(status) {
+				metrics.IndexNodeBuildIndexTaskCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.FailLabel).Inc()
+			}
+			return status, nil
 		}
 		cm, err := i.storageFactory.NewChunkManager(i.loopCtx, indexRequest.GetStorageConfig())
 		if err != nil {
@@ -361,10 +389,10 @@ func (i *IndexNode) CreateJobV2(ctx context.Context, req *indexpb.CreateJobV2Req
 		if oldInfo := i.loadOrStoreAnalyzeTask(analyzeRequest.GetClusterID(), analyzeRequest.GetTaskID(), &analyzeTaskInfo{
 			cancel: taskCancel,
 			state:  indexpb.JobState_JobStateInProgress,
+			epoch:  analyzeRequest.GetVersion(),
 		}); oldInfo != nil {
-			err := merr.WrapErrIndexDuplicate("", "analyze task already existed")
-			log.Warn("duplicated analyze task", zap.Error(err))
-			return merr.Status(err), nil
+			taskCancel()
+			return checkTaskEpoch(log, oldInfo.epoch, analyzeRequest.GetVersion(), ""), nil
 		}
 		t := &analyzeTask{
 			ident:  fmt.Sprintf("%s/%d", analyzeRequest.GetClusterID(), analyzeRequest.GetTaskID()),
@@ -382,12 +410,77 @@ func (i *IndexNode) CreateJobV2(ctx context.Context, req *indexpb.CreateJobV2Req
 		}
 		log.Info("IndexNode analyze job enqueued successfully")
 		return ret, nil
+	case indexpb.JobType_JobTypeStatsJob:
+		statsRequest := req.GetStatsRequest()
+		log.Info("receive stats job", zap.Int64("collectionID", statsRequest.GetCollectionID()),
+			zap.Int64("partitionID", statsRequest.GetPartitionID()),
+			zap.Int64("segmentID", statsRequest.GetSegmentID()),
+			zap.Int64("version", statsRequest.GetVersion()),
+			zap.Int64("numRows", statsRequest.GetNumRows()),
+			zap.Int("fields", len(statsRequest.GetFieldLogs())),
+		)
+		taskCtx, taskCancel := context.WithCancel(i.loopCtx)
+		if oldInfo := i.loadOrStoreStatsTask(statsRequest.GetClusterID(), statsRequest.GetTaskID(), &statsTaskInfo{
+			cancel: taskCancel,
+			state:  indexpb.JobState_JobStateInProgress,
+			epoch:  statsRequest.GetVersion(),
+		}); oldInfo != nil {
+			taskCancel()
+			return checkTaskEpoch(log, oldInfo.epoch, statsRequest.GetVersion(), ""), nil
+		}
+		cm, err := i.storageFactory.NewChunkManager(i.loopCtx, statsRequest.GetStorageConfig())
+		if err != nil {
+			log.Error("create chunk manager failed", zap.String("bucket", statsRequest.GetStorageConfig().GetBucketName()),
+				zap.String("accessKey", statsRequest.GetStorageConfig().GetAccessKeyID()),
+				zap.Error(err),
+			)
+			i.deleteStatsTaskInfos(ctx, []taskKey{{ClusterID: statsRequest.GetClusterID(), BuildID: statsRequest.GetTaskID()}})
+			return merr.Status(err), nil
+		}
+		t := &statsTask{
+			ident:  fmt.Sprintf("%s/%d", statsRequest.GetClusterID(), statsRequest.GetTaskID()),
+			ctx:    taskCtx,
+			cancel: taskCancel,
+			req:    statsRequest,
+			cm:     cm,
+			node:   i,
+			tr:     timerecord.NewTimeRecorder(fmt.Sprintf("ClusterID: %s, StatsTaskID: %d", req.GetClusterID(), req.GetTaskID())),
+		}
+		ret := merr.Success()
+		if err := i.sched.TaskQueue.Enqueue(t); err != nil {
+			log.Warn("IndexNode failed to schedule", zap.Error(err))
+			ret = merr.Status(err)
+			return ret, nil
+		}
+		log.Info("IndexNode stats job enqueued successfully")
+		return ret, nil
 	default:
 		log.Warn("IndexNode receive unknown type job")
 		return merr.Status(fmt.Errorf("IndexNode receive unknown type job with taskID: %d", req.GetTaskID())), nil
 	}
 }
 
+// checkTaskEpoch checks the assignment epoch of the task existing on the node against the one of the request.
+// The request of the same epoch is the assignment resumed by the coordinator after failover, it's accepted
+// without building the task again, the request of a stale epoch is rejected, and the one of a newer epoch is
+// rejected as duplicated until the coordinator drops the stale task.
+func checkTaskEpoch(logger *log.MLogger, epoch, reqEpoch int64, indexName string) *commonpb.Status {
+	log := logger.With(zap.Int64("epoch", epoch), zap.Int64("requestEpoch", reqEpoch))
+	switch {
+	case reqEpoch == epoch:
+		log.Info("task of the same epoch existed, accept the resumed assignment")
+		return merr.Success()
+	case reqEpoch < epoch:
+		err := merr.WrapErrParameterInvalidMsg("stale task epoch %d, current epoch %d", reqEpoch, epoch)
+		log.Warn("reject the task of stale epoch", zap.Error(err))
+		return merr.Status(err)
+	default:
+		err := merr.WrapErrIndexDuplicate(indexName, "task of a stale epoch existed")
+		log.Warn("duplicated task", zap.Error(err))
+		return merr.Status(err)
+	}
+}
+
 func (i *IndexNode) QueryJobsV2(ctx context.Context, req *indexpb.QueryJobsV2Request) (*indexpb.QueryJobsV2Response, error) {
 	log := log.Ctx(ctx).With(
 		zap.String("clusterID", req.GetClusterID()), zap.Int64s("taskIDs", req.GetTaskIDs()),
@@ -401,7 +494,7 @@ func (i *IndexNode) QueryJobsV2(ctx context.Context, req *indexpb.QueryJobsV2Req
 	}
 	defer i.lifetime.Done()
 
-	switch req.GetJobType() {
+	switch compatutil.NormalizeJobType(req.GetJobType()) {
 	case indexpb.JobType_JobTypeIndexJob:
 		infos := make(map[UniqueID]*indexTaskInfo)
 		i.foreachIndexTaskInfo(func(ClusterID string, buildID UniqueID, info *indexTaskInfo) {
@@ -413,6 +506,8 @@ func (i *IndexNode) QueryJobsV2(ctx context.Context, req *indexpb.QueryJobsV2Req
 					failReason:          info.failReason,
 					currentIndexVersion: info.currentIndexVersion,
 					indexStoreVersion:   info.indexStoreVersion,
+					progress:            info.progress,
+					startTime:           info.startTime,
 				}
 			}
 		})
@@ -431,6 +526,11 @@ func (i *IndexNode) QueryJobsV2(ctx context.Context, req *indexpb.QueryJobsV2Req
 				results[i].FailReason = info.failReason
 				results[i].CurrentIndexVersion = info.currentIndexVersion
 				results[i].IndexStoreVersion = info.indexStoreVersion
+				results[i].Progress = info.progress
+				results[i].StartTime = startTimeMilli(info.startTime)
+				if info.state == commonpb.IndexState_Finished {
+					results[i].RequiredCpuFeatures = getRequiredCPUFeatures()
+				}
 			}
 		}
 		log.Debug("query index jobs result success", zap.Any("results", results))
@@ -453,6 +553,7 @@ func (i *IndexNode) QueryJobsV2(ctx context.Context, req *indexpb.QueryJobsV2Req
 					State:         info.state,
 					FailReason:    info.failReason,
 					CentroidsFile: info.centroidsFile,
+					StartTime:     startTimeMilli(info.startTime),
 				})
 			}
 		}
@@ -466,6 +567,30 @@ func (i *IndexNode) QueryJobsV2(ctx context.Context, req *indexpb.QueryJobsV2Req
 				},
 			},
 		}, nil
+	case indexpb.JobType_JobTypeStatsJob:
+		results := make([]*indexpb.StatsResult, 0, len(req.GetTaskIDs()))
+		for _, taskID := range req.GetTaskIDs() {
+			info := i.getStatsTaskInfo(req.GetClusterID(), taskID)
+			if info != nil {
+				results = append(results, &indexpb.StatsResult{
+					TaskID:     taskID,
+					State:      info.state,
+					FailReason: info.failReason,
+					FieldStats: info.fieldStats,
+					StartTime:  startTimeMilli(info.startTime),
+				})
+			}
+		}
+		log.Debug("query stats jobs result success", zap.Any("results", results))
+		return &indexpb.QueryJobsV2Response{
+			Status:    merr.Success(),
+			ClusterID: req.GetClusterID(),
+			Result: &indexpb.QueryJobsV2Response_StatsJobResults{
+				StatsJobResults: &indexpb.StatsResults{
+					Results: results,
+				},
+			},
+		}, nil
 	default:
 		log.Warn("IndexNode receive querying unknown type jobs")
 		return &indexpb.QueryJobsV2Response{
@@ -488,7 +613,7 @@ func (i *IndexNode) DropJobsV2(ctx context.Context, req *indexpb.DropJobsV2Reque
 
 	log.Info("IndexNode receive DropJobs request")
 
-	switch req.GetJobType() {
+	switch compatutil.NormalizeJobType(req.GetJobType()) {
 	case indexpb.JobType_JobTypeIndexJob:
 		keys := make([]taskKey, 0, len(req.GetTaskIDs()))
 		for _, buildID := range req.GetTaskIDs() {
@@ -515,6 +640,19 @@ func (i *IndexNode) DropJobsV2(ctx context.Context, req *indexpb.DropJobsV2Reque
 		}
 		log.Info("drop analyze jobs success")
 		return merr.Success(), nil
+	case indexpb.JobType_JobTypeStatsJob:
+		keys := make([]taskKey, 0, len(req.GetTaskIDs()))
+		for _, taskID := range req.GetTaskIDs() {
+			keys = append(keys, taskKey{ClusterID: req.GetClusterID(), BuildID: taskID})
+		}
+		infos := i.deleteStatsTaskInfos(ctx, keys)
+		for _, info := range infos {
+			if info.cancel != nil {
+				info.cancel()
+			}
+		}
+		log.Info("drop stats jobs success")
+		return merr.Success(), nil
 	default:
 		log.Warn("IndexNode receive dropping unknown type jobs")
 		return merr.Status(fmt.Errorf("IndexNode receive dropping unknown type jobs")), nil
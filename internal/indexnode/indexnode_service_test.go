@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 )
@@ -73,6 +74,14 @@ func TestGetMetrics(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, merr.Ok(resp.GetStatus()))
 	t.Logf("Component: %s, Metrics: %s", resp.ComponentName, resp.Response)
+
+	diagnosticsReq, _ := metricsinfo.ConstructRequestByMetricType(metricsinfo.DiagnosticsMetrics)
+	resp, err = in.GetMetrics(ctx, diagnosticsReq)
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(resp.GetStatus()))
+	diagnostics := &metricsinfo.WorkerDiagnostics{}
+	assert.NoError(t, metricsinfo.UnmarshalComponentInfos(resp.GetResponse(), diagnostics))
+	assert.NotEmpty(t, diagnostics.Goroutines)
 }
 
 func TestGetMetricsError(t *testing.T) {
@@ -227,6 +236,21 @@ func (suite *IndexNodeServiceSuite) Test_Method() {
 	})
 }
 
+func TestCheckTaskEpoch(t *testing.T) {
+	logger := log.Ctx(context.Background())
+
+	// the resumed assignment of the same epoch is accepted
+	assert.True(t, merr.Ok(checkTaskEpoch(logger, 2, 2, "idx")))
+
+	// the stale epoch is rejected
+	status := checkTaskEpoch(logger, 2, 1, "idx")
+	assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+
+	// the newer epoch is rejected as duplicated until the stale task is dropped
+	status = checkTaskEpoch(logger, 2, 3, "idx")
+	assert.ErrorIs(t, merr.Error(status), merr.ErrIndexDuplicate)
+}
+
 func Test_IndexNodeServiceSuite(t *testing.T) {
 	suite.Run(t, new(IndexNodeServiceSuite))
 }
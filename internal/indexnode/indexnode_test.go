@@ -147,6 +147,71 @@ func TestGetSetAddress(t *testing.T) {
 	assert.Equal(t, "address", in.GetAddress())
 }
 
+func TestStoreIndexTaskProgress(t *testing.T) {
+	var (
+		factory = &mockFactory{
+			chunkMgr: &mockChunkmgr{},
+		}
+		ctx = context.TODO()
+	)
+	paramtable.Init()
+	in := NewIndexNode(ctx, factory)
+
+	in.loadOrStoreIndexTask("cluster-1", 1, &indexTaskInfo{
+		state: commonpb.IndexState_InProgress,
+	})
+	in.storeIndexTaskProgress("cluster-1", 1, indexBuildStageProgress[0])
+	// not exist
+	in.storeIndexTaskProgress("cluster-1", 2, indexBuildStageProgress[0])
+
+	progresses := make(map[UniqueID]int32)
+	in.foreachIndexTaskInfo(func(ClusterID string, buildID UniqueID, info *indexTaskInfo) {
+		progresses[buildID] = info.progress
+	})
+	assert.Equal(t, map[UniqueID]int32{1: indexBuildStageProgress[0]}, progresses)
+}
+
+func TestStoreTaskStartTime(t *testing.T) {
+	var (
+		factory = &mockFactory{
+			chunkMgr: &mockChunkmgr{},
+		}
+		ctx = context.TODO()
+	)
+	paramtable.Init()
+	in := NewIndexNode(ctx, factory)
+	in.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	in.loadOrStoreIndexTask("cluster-1", 1, &indexTaskInfo{
+		state: commonpb.IndexState_InProgress,
+	})
+	in.loadOrStoreAnalyzeTask("cluster-1", 2, &analyzeTaskInfo{
+		state: indexpb.JobState_JobStateInProgress,
+	})
+	startTime := time.Now()
+	in.storeIndexTaskStartTime("cluster-1", 1, startTime)
+	in.storeAnalyzeTaskStartTime("cluster-1", 2, startTime)
+
+	resp, err := in.QueryJobsV2(ctx, &indexpb.QueryJobsV2Request{
+		ClusterID: "cluster-1",
+		TaskIDs:   []UniqueID{1},
+		JobType:   indexpb.JobType_JobTypeIndexJob,
+	})
+	assert.NoError(t, merr.CheckRPCCall(resp, err))
+	assert.Equal(t, startTime.UnixMilli(), resp.GetIndexJobResults().GetResults()[0].GetStartTime())
+
+	resp, err = in.QueryJobsV2(ctx, &indexpb.QueryJobsV2Request{
+		ClusterID: "cluster-1",
+		TaskIDs:   []UniqueID{2},
+		JobType:   indexpb.JobType_JobTypeAnalyzeJob,
+	})
+	assert.NoError(t, merr.CheckRPCCall(resp, err))
+	assert.Equal(t, startTime.UnixMilli(), resp.GetAnalyzeJobResults().GetResults()[0].GetStartTime())
+
+	// not started
+	assert.Zero(t, startTimeMilli(time.Time{}))
+}
+
 func TestInitErr(t *testing.T) {
 	// var (
 	// 	factory = &mockFactory{}
@@ -75,3 +75,27 @@ func getSystemInfoMetrics(
 		ComponentName: metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, paramtable.GetNodeID()),
 	}, nil
 }
+
+// getDiagnosticsMetrics captures the diagnostics of the IndexNode for debugging the slow tasks.
+func getDiagnosticsMetrics() *milvuspb.GetMetricsResponse {
+	componentName := metricsinfo.ConstructComponentName(typeutil.IndexNodeRole, paramtable.GetNodeID())
+	diagnostics, err := metricsinfo.CaptureWorkerDiagnostics(paramtable.GetNodeID())
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status:        merr.Status(err),
+			ComponentName: componentName,
+		}
+	}
+	resp, err := metricsinfo.MarshalComponentInfos(diagnostics)
+	if err != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status:        merr.Status(err),
+			ComponentName: componentName,
+		}
+	}
+	return &milvuspb.GetMetricsResponse{
+		Status:        merr.Success(),
+		Response:      resp,
+		ComponentName: componentName,
+	}
+}
@@ -37,8 +37,12 @@ type task interface {
 	OnEnqueue(context.Context) error
 	SetState(state indexpb.JobState, failReason string)
 	GetState() indexpb.JobState
+	// GetSlot returns the number of the slots taken by the task.
+	GetSlot() int64
 	PreExecute(context.Context) error
 	Execute(context.Context) error
 	PostExecute(context.Context) error
+	// MarkStarted records the time the task starts to be executed, which is reported to the coordinator.
+	MarkStarted()
 	Reset()
 }
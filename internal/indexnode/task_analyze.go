@@ -186,10 +186,18 @@ func (at *analyzeTask) SetState(state indexpb.JobState, failReason string) {
 	at.node.storeAnalyzeTaskState(at.req.GetClusterID(), at.req.GetTaskID(), state, failReason)
 }
 
+func (at *analyzeTask) MarkStarted() {
+	at.node.storeAnalyzeTaskStartTime(at.req.GetClusterID(), at.req.GetTaskID(), time.Now())
+}
+
 func (at *analyzeTask) GetState() indexpb.JobState {
 	return at.node.loadAnalyzeTaskState(at.req.GetClusterID(), at.req.GetTaskID())
 }
 
+func (at *analyzeTask) GetSlot() int64 {
+	return 1
+}
+
 func (at *analyzeTask) Reset() {
 	at.ident = ""
 	at.ctx = nil
@@ -76,6 +76,7 @@ func (it *indexBuildTaskV2) parseParams() {
 			DataType: it.req.GetFieldType(),
 		}
 	}
+	it.parseBuildProfile()
 }
 
 func (it *indexBuildTaskV2) Execute(ctx context.Context) error {
@@ -121,6 +122,15 @@ func (it *indexBuildTaskV2) Execute(ctx context.Context) error {
 		}
 	}
 
+	if it.profile != nil {
+		if err := indexparams.SetBuildProfileParams(it.newIndexParams, it.profile); err != nil {
+			log.Warn("failed to set build profile params", zap.String("profile", it.profile.Name), zap.Error(err))
+			return err
+		}
+		log.Info("build index with profile", zap.String("profile", it.profile.Name),
+			zap.String(indexparams.NumBuildThreadKey, it.newIndexParams[indexparams.NumBuildThreadKey]))
+	}
+
 	storageConfig := &indexcgopb.StorageConfig{
 		Address:          it.req.GetStorageConfig().GetAddress(),
 		AccessKeyID:      it.req.GetStorageConfig().GetAccessKeyID(),
@@ -238,6 +248,8 @@ type indexBuildTask struct {
 	tr             *timerecord.TimeRecorder
 	queueDur       time.Duration
 	node           *IndexNode
+	// profile is the build profile specified in the index params, nil if not specified
+	profile *indexparams.BuildProfile
 }
 
 func newIndexBuildTask(ctx context.Context,
@@ -269,6 +281,16 @@ func (it *indexBuildTask) parseParams() {
 			DataType: it.req.GetFieldType(),
 		}
 	}
+	it.parseBuildProfile()
+}
+
+func (it *indexBuildTask) parseBuildProfile() {
+	profile, err := indexparams.GetIndexBuildProfile(it.req.GetIndexParams())
+	if err != nil {
+		// validated by datacoord, should not happen
+		log.Warn("invalid index build profile, build with the default settings", zap.Int64("buildID", it.req.GetBuildID()), zap.Error(err))
+	}
+	it.profile = profile
 }
 
 func (it *indexBuildTask) Reset() {
@@ -298,10 +320,22 @@ func (it *indexBuildTask) SetState(state indexpb.JobState, failReason string) {
 	it.node.storeIndexTaskState(it.req.GetClusterID(), it.req.GetBuildID(), commonpb.IndexState(state), failReason)
 }
 
+func (it *indexBuildTask) MarkStarted() {
+	it.node.storeIndexTaskStartTime(it.req.GetClusterID(), it.req.GetBuildID(), time.Now())
+}
+
+func (it *indexBuildTask) setProgress(progress int32) {
+	it.node.storeIndexTaskProgress(it.req.GetClusterID(), it.req.GetBuildID(), progress)
+}
+
 func (it *indexBuildTask) GetState() indexpb.JobState {
 	return indexpb.JobState(it.node.loadIndexTaskState(it.req.GetClusterID(), it.req.GetBuildID()))
 }
 
+func (it *indexBuildTask) GetSlot() int64 {
+	return indexparams.GetBuildSlots(it.profile)
+}
+
 // OnEnqueue enqueues indexing tasks.
 func (it *indexBuildTask) OnEnqueue(ctx context.Context) error {
 	it.queueDur = 0
@@ -348,7 +382,7 @@ func (it *indexBuildTask) PreExecute(ctx context.Context) error {
 		key, value := kvPair.GetKey(), kvPair.GetValue()
 		// knowhere would report error if encountered the unknown key,
 		// so skip this
-		if key == common.MmapEnabledKey {
+		if key == common.MmapEnabledKey || key == common.IndexBuildProfileKey {
 			continue
 		}
 		indexParams[key] = value
@@ -426,6 +460,15 @@ func (it *indexBuildTask) Execute(ctx context.Context) error {
 		}
 	}
 
+	if it.profile != nil {
+		if err := indexparams.SetBuildProfileParams(it.newIndexParams, it.profile); err != nil {
+			log.Warn("failed to set build profile params", zap.String("profile", it.profile.Name), zap.Error(err))
+			return err
+		}
+		log.Info("build index with profile", zap.String("profile", it.profile.Name),
+			zap.String(indexparams.NumBuildThreadKey, it.newIndexParams[indexparams.NumBuildThreadKey]))
+	}
+
 	storageConfig := &indexcgopb.StorageConfig{
 		Address:          it.req.GetStorageConfig().GetAddress(),
 		AccessKeyID:      it.req.GetStorageConfig().GetAccessKeyID(),
@@ -476,6 +519,12 @@ func (it *indexBuildTask) Execute(ctx context.Context) error {
 		PartitionKeyIsolation: it.req.GetPartitionKeyIsolation(),
 	}
 
+	if shouldBuildWithMmap(indexType, it.req.GetDim(), it.req.GetNumRows(), it.req.GetField().GetDataType()) {
+		log.Info("build index over the mmaped raw data", zap.String("indexType", indexType),
+			zap.Int64("numRows", it.req.GetNumRows()), zap.Int64("dim", it.req.GetDim()))
+		buildIndexParams.BuildWithMmap = true
+	}
+
 	log.Info("debug create index", zap.Any("buildIndexParams", buildIndexParams))
 	var err error
 	it.index, err = indexcgowrapper.CreateIndex(ctx, buildIndexParams)
@@ -21,12 +21,15 @@ import (
 	"context"
 	"fmt"
 	"runtime/debug"
+	"strconv"
 	"sync"
 
 	"github.com/cockroachdb/errors"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/config"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -44,6 +47,7 @@ type TaskQueue interface {
 	PopActiveTask(tName string) task
 	Enqueue(t task) error
 	GetTaskNum() (int, int)
+	GetUsedSlots() int64
 }
 
 // BaseTaskQueue is a basic instance of TaskQueue.
@@ -154,6 +158,25 @@ func (queue *IndexTaskQueue) GetTaskNum() (int, int) {
 	return utNum, atNum
 }
 
+// GetUsedSlots returns the slots taken by the unissued and the unfinished active tasks.
+func (queue *IndexTaskQueue) GetUsedSlots() int64 {
+	queue.utLock.Lock()
+	defer queue.utLock.Unlock()
+	queue.atLock.Lock()
+	defer queue.atLock.Unlock()
+
+	var used int64
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		used += e.Value.(task).GetSlot()
+	}
+	for _, task := range queue.activeTasks {
+		if task.GetState() != indexpb.JobState_JobStateFinished && task.GetState() != indexpb.JobState_JobStateFailed {
+			used += task.GetSlot()
+		}
+	}
+	return used
+}
+
 // NewIndexBuildTaskQueue creates a new IndexBuildTaskQueue.
 func NewIndexBuildTaskQueue(sched *TaskScheduler) *IndexTaskQueue {
 	return &IndexTaskQueue{
@@ -170,7 +193,9 @@ func NewIndexBuildTaskQueue(sched *TaskScheduler) *IndexTaskQueue {
 type TaskScheduler struct {
 	TaskQueue TaskQueue
 
-	buildParallel int
+	// buildParallel is reloaded at runtime, the tasks in progress are not affected
+	buildParallel *atomic.Int64
+	configHandler config.EventHandler
 	wg            sync.WaitGroup
 	ctx           context.Context
 	cancel        context.CancelFunc
@@ -182,16 +207,37 @@ func NewTaskScheduler(ctx context.Context) *TaskScheduler {
 	s := &TaskScheduler{
 		ctx:           ctx1,
 		cancel:        cancel,
-		buildParallel: Params.IndexNodeCfg.BuildParallel.GetAsInt(),
+		buildParallel: atomic.NewInt64(Params.IndexNodeCfg.BuildParallel.GetAsInt64()),
 	}
 	s.TaskQueue = NewIndexBuildTaskQueue(s)
+	s.configHandler = config.NewHandler("indexnode.scheduler.buildParallel", s.buildParallelHandler)
 
 	return s
 }
 
+// GetBuildParallel returns the number of the index tasks built in parallel.
+func (sched *TaskScheduler) GetBuildParallel() int64 {
+	return sched.buildParallel.Load()
+}
+
+func (sched *TaskScheduler) buildParallelHandler(evt *config.Event) {
+	if !evt.HasUpdated {
+		return
+	}
+	log := log.Ctx(sched.ctx).With(zap.String("key", evt.Key), zap.String("value", evt.Value))
+	parallel, err := strconv.ParseInt(evt.Value, 10, 64)
+	if err != nil || parallel <= 0 {
+		log.Warn("invalid index build parallel, keep the current one", zap.Error(err))
+		return
+	}
+	sched.buildParallel.Store(parallel)
+	log.Info("index build parallel updated", zap.Int64("buildParallel", parallel))
+}
+
 func (sched *TaskScheduler) scheduleIndexBuildTask() []task {
 	ret := make([]task, 0)
-	for i := 0; i < sched.buildParallel; i++ {
+	parallel := sched.GetBuildParallel()
+	for i := int64(0); i < parallel; i++ {
 		t := sched.TaskQueue.PopUnissuedTask()
 		if t == nil {
 			return ret
@@ -201,6 +247,10 @@ func (sched *TaskScheduler) scheduleIndexBuildTask() []task {
 	return ret
 }
 
+// indexBuildStageProgress is the build progress in percentage after each stage of the index task,
+// loading the data and building the index in the execute stage take most of the time.
+var indexBuildStageProgress = []int32{10, 90, 100}
+
 func getStateFromError(err error) indexpb.JobState {
 	if errors.Is(err, errCancel) {
 		return indexpb.JobState_JobStateRetry
@@ -230,13 +280,17 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 	sched.TaskQueue.AddActiveTask(t)
 	defer sched.TaskQueue.PopActiveTask(t.Name())
 	log.Ctx(t.Ctx()).Debug("process task", zap.String("task", t.Name()))
+	t.MarkStarted()
 	pipelines := []func(context.Context) error{t.PreExecute, t.Execute, t.PostExecute}
-	for _, fn := range pipelines {
+	for i, fn := range pipelines {
 		if err := wrap(fn); err != nil {
 			log.Ctx(t.Ctx()).Warn("process task failed", zap.Error(err))
 			t.SetState(getStateFromError(err), err.Error())
 			return
 		}
+		if indexBuildTask, ok := t.(*indexBuildTask); ok {
+			indexBuildTask.setProgress(indexBuildStageProgress[i])
+		}
 	}
 	t.SetState(indexpb.JobState_JobStateFinished, "")
 	if indexBuildTask, ok := t.(*indexBuildTask); ok {
@@ -269,6 +323,7 @@ func (sched *TaskScheduler) indexBuildLoop() {
 
 // Start stats the task scheduler of indexing tasks.
 func (sched *TaskScheduler) Start() error {
+	Params.Watch(Params.IndexNodeCfg.BuildParallel.Key, sched.configHandler)
 	sched.wg.Add(1)
 	go sched.indexBuildLoop()
 	return nil
@@ -276,6 +331,7 @@ func (sched *TaskScheduler) Start() error {
 
 // Close closes the task scheduler of indexing tasks.
 func (sched *TaskScheduler) Close() {
+	Params.Unwatch(Params.IndexNodeCfg.BuildParallel.Key, sched.configHandler)
 	sched.cancel()
 	sched.wg.Wait()
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/config"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -118,6 +119,9 @@ func (t *fakeTask) PostExecute(ctx context.Context) error {
 	return t.reterr[t.state]
 }
 
+func (t *fakeTask) MarkStarted() {
+}
+
 func (t *fakeTask) Reset() {
 	_taskwg.Done()
 }
@@ -131,6 +135,10 @@ func (t *fakeTask) GetState() indexpb.JobState {
 	return t.retstate
 }
 
+func (t *fakeTask) GetSlot() int64 {
+	return 1
+}
+
 var (
 	idLock sync.Mutex
 	id     = 0
@@ -205,3 +213,37 @@ func TestIndexTaskScheduler(t *testing.T) {
 		assert.Equal(t, task.GetState(), indexpb.JobState_JobStateFinished)
 	}
 }
+
+func TestIndexTaskSchedulerReloadBuildParallel(t *testing.T) {
+	paramtable.Init()
+
+	scheduler := NewTaskScheduler(context.TODO())
+	assert.Equal(t, paramtable.Get().IndexNodeCfg.BuildParallel.GetAsInt64(), scheduler.GetBuildParallel())
+
+	scheduler.buildParallelHandler(&config.Event{
+		HasUpdated: true,
+		Key:        paramtable.Get().IndexNodeCfg.BuildParallel.Key,
+		Value:      "4",
+	})
+	assert.EqualValues(t, 4, scheduler.GetBuildParallel())
+
+	for _, value := range []string{"0", "-1", "abc"} {
+		scheduler.buildParallelHandler(&config.Event{
+			HasUpdated: true,
+			Key:        paramtable.Get().IndexNodeCfg.BuildParallel.Key,
+			Value:      value,
+		})
+		assert.EqualValues(t, 4, scheduler.GetBuildParallel())
+	}
+
+	for i := 0; i < 6; i++ {
+		assert.NoError(t, scheduler.TaskQueue.Enqueue(newTask(fakeTaskSavedIndexes, nil, indexpb.JobState_JobStateFinished)))
+	}
+	for _, expected := range []int{4, 2} {
+		tasks := scheduler.scheduleIndexBuildTask()
+		assert.Len(t, tasks, expected)
+		for _, task := range tasks {
+			task.Reset()
+		}
+	}
+}
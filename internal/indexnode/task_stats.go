@@ -0,0 +1,317 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/constraints"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// statsTask computes the statistics of the fields of a segment: the null counts, the min/max values of the scalar
+// fields, the BM25 corpus statistics of the sparse float vector fields and the centroid of the float vector clustering key.
+type statsTask struct {
+	ident  string
+	ctx    context.Context
+	cancel context.CancelFunc
+	req    *indexpb.StatsRequest
+	cm     storage.ChunkManager
+
+	tr         *timerecord.TimeRecorder
+	queueDur   time.Duration
+	node       *IndexNode
+	fieldStats []*indexpb.FieldStats
+}
+
+func (st *statsTask) Ctx() context.Context {
+	return st.ctx
+}
+
+func (st *statsTask) Name() string {
+	return st.ident
+}
+
+func (st *statsTask) PreExecute(ctx context.Context) error {
+	st.queueDur = st.tr.RecordSpan()
+	log.Ctx(ctx).Info("Begin to prepare stats task", zap.String("clusterID", st.req.GetClusterID()),
+		zap.Int64("taskID", st.req.GetTaskID()), zap.Int64("segmentID", st.req.GetSegmentID()),
+		zap.Int("fields", len(st.req.GetFieldLogs())))
+	return nil
+}
+
+func (st *statsTask) Execute(ctx context.Context) error {
+	log := log.Ctx(ctx).With(zap.String("clusterID", st.req.GetClusterID()),
+		zap.Int64("taskID", st.req.GetTaskID()), zap.Int64("collectionID", st.req.GetCollectionID()),
+		zap.Int64("partitionID", st.req.GetPartitionID()), zap.Int64("segmentID", st.req.GetSegmentID()))
+	log.Info("Begin to compute the field stats")
+
+	fields := make(map[int64]*schemapb.FieldSchema)
+	for _, field := range st.req.GetSchema().GetFields() {
+		fields[field.GetFieldID()] = field
+	}
+	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{
+		ID:     st.req.GetCollectionID(),
+		Schema: st.req.GetSchema(),
+	})
+
+	st.fieldStats = make([]*indexpb.FieldStats, 0, len(st.req.GetFieldLogs()))
+	// the fields are loaded one by one to bound the memory usage
+	for _, fieldLogs := range st.req.GetFieldLogs() {
+		field, ok := fields[fieldLogs.GetFieldID()]
+		if !ok {
+			return merr.WrapErrFieldNotFound(fieldLogs.GetFieldID())
+		}
+		paths := make([]string, 0, len(fieldLogs.GetLogIDs()))
+		for _, logID := range fieldLogs.GetLogIDs() {
+			paths = append(paths, metautil.BuildInsertLogPath(st.req.GetStorageConfig().GetRootPath(),
+				st.req.GetCollectionID(), st.req.GetPartitionID(), st.req.GetSegmentID(), field.GetFieldID(), logID))
+		}
+		values, err := st.cm.MultiRead(ctx, paths)
+		if err != nil {
+			log.Warn("failed to read the binlogs", zap.Int64("fieldID", field.GetFieldID()), zap.Error(err))
+			return err
+		}
+		blobs := make([]*Blob, 0, len(values))
+		for i, value := range values {
+			blobs = append(blobs, &Blob{Key: paths[i], Value: value})
+		}
+		_, _, _, insertData, err := codec.DeserializeAll(blobs)
+		if err != nil {
+			log.Warn("failed to deserialize the binlogs", zap.Int64("fieldID", field.GetFieldID()), zap.Error(err))
+			return err
+		}
+		data, ok := insertData.Data[field.GetFieldID()]
+		if !ok {
+			return merr.WrapErrFieldNotFound(field.GetFieldID(), "no data in the binlogs")
+		}
+
+		stats, docFrequencies := computeFieldStats(field, data)
+		if stats.GetBm25Stats() != nil {
+			// the taskID is unique, it's taken as the logID of the stats log without allocating
+			path := metautil.BuildStatsLogPath(st.req.GetStorageConfig().GetRootPath(),
+				st.req.GetCollectionID(), st.req.GetPartitionID(), st.req.GetSegmentID(), field.GetFieldID(), st.req.GetTaskID())
+			if err := st.cm.Write(ctx, path, serializeDocFrequencies(docFrequencies)); err != nil {
+				log.Warn("failed to write the BM25 stats log", zap.Int64("fieldID", field.GetFieldID()), zap.Error(err))
+				return err
+			}
+			stats.Bm25Stats.LogID = st.req.GetTaskID()
+		}
+		st.fieldStats = append(st.fieldStats, stats)
+	}
+
+	log.Info("compute the field stats done", zap.Duration("cost", st.tr.RecordSpan()))
+	return nil
+}
+
+func (st *statsTask) PostExecute(ctx context.Context) error {
+	st.node.storeStatsResult(st.req.GetClusterID(), st.req.GetTaskID(), st.fieldStats)
+	st.tr.Elapse("stats task all done")
+	log.Ctx(ctx).Info("Successfully save the field stats", zap.String("clusterID", st.req.GetClusterID()),
+		zap.Int64("taskID", st.req.GetTaskID()), zap.Int64("segmentID", st.req.GetSegmentID()))
+	return nil
+}
+
+func (st *statsTask) OnEnqueue(ctx context.Context) error {
+	st.queueDur = 0
+	st.tr.RecordSpan()
+	log.Ctx(ctx).Info("IndexNode statsTask enqueued", zap.String("clusterID", st.req.GetClusterID()),
+		zap.Int64("taskID", st.req.GetTaskID()))
+	return nil
+}
+
+func (st *statsTask) SetState(state indexpb.JobState, failReason string) {
+	st.node.storeStatsTaskState(st.req.GetClusterID(), st.req.GetTaskID(), state, failReason)
+}
+
+func (st *statsTask) MarkStarted() {
+	st.node.storeStatsTaskStartTime(st.req.GetClusterID(), st.req.GetTaskID(), time.Now())
+}
+
+func (st *statsTask) GetState() indexpb.JobState {
+	return st.node.loadStatsTaskState(st.req.GetClusterID(), st.req.GetTaskID())
+}
+
+func (st *statsTask) GetSlot() int64 {
+	return 1
+}
+
+func (st *statsTask) Reset() {
+	st.ident = ""
+	st.ctx = nil
+	st.cancel = nil
+	st.req = nil
+	st.cm = nil
+	st.tr = nil
+	st.queueDur = 0
+	st.node = nil
+	st.fieldStats = nil
+}
+
+// computeFieldStats returns the statistics of the field data, along with the document frequencies of the terms
+// if the field is a sparse float vector one.
+func computeFieldStats(field *schemapb.FieldSchema, data storage.FieldData) (*indexpb.FieldStats, map[uint32]int64) {
+	stats := &indexpb.FieldStats{FieldID: field.GetFieldID()}
+	switch data := data.(type) {
+	case *storage.BoolFieldData:
+		stats.NullCount = countNulls(data.ValidData)
+	case *storage.Int8FieldData:
+		minV, maxV, nullCount, ok := minMaxOf(data.Data, data.ValidData)
+		if ok {
+			stats.Min = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: int32(minV)}}
+			stats.Max = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: int32(maxV)}}
+		}
+		stats.NullCount = nullCount
+	case *storage.Int16FieldData:
+		minV, maxV, nullCount, ok := minMaxOf(data.Data, data.ValidData)
+		if ok {
+			stats.Min = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: int32(minV)}}
+			stats.Max = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: int32(maxV)}}
+		}
+		stats.NullCount = nullCount
+	case *storage.Int32FieldData:
+		minV, maxV, nullCount, ok := minMaxOf(data.Data, data.ValidData)
+		if ok {
+			stats.Min = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: minV}}
+			stats.Max = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: maxV}}
+		}
+		stats.NullCount = nullCount
+	case *storage.Int64FieldData:
+		minV, maxV, nullCount, ok := minMaxOf(data.Data, data.ValidData)
+		if ok {
+			stats.Min = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: minV}}
+			stats.Max = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: maxV}}
+		}
+		stats.NullCount = nullCount
+	case *storage.FloatFieldData:
+		minV, maxV, nullCount, ok := minMaxOf(data.Data, data.ValidData)
+		if ok {
+			stats.Min = &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: minV}}
+			stats.Max = &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: maxV}}
+		}
+		stats.NullCount = nullCount
+	case *storage.DoubleFieldData:
+		minV, maxV, nullCount, ok := minMaxOf(data.Data, data.ValidData)
+		if ok {
+			stats.Min = &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: minV}}
+			stats.Max = &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: maxV}}
+		}
+		stats.NullCount = nullCount
+	case *storage.StringFieldData:
+		minV, maxV, nullCount, ok := minMaxOf(data.Data, data.ValidData)
+		if ok {
+			stats.Min = &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: minV}}
+			stats.Max = &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: maxV}}
+		}
+		stats.NullCount = nullCount
+	case *storage.ArrayFieldData:
+		stats.NullCount = countNulls(data.ValidData)
+	case *storage.JSONFieldData:
+		stats.NullCount = countNulls(data.ValidData)
+	case *storage.FloatVectorFieldData:
+		stats.Centroid = centroidOf(data.Data, data.Dim)
+	case *storage.SparseFloatVectorFieldData:
+		bm25Stats := &indexpb.BM25Stats{NumRows: int64(len(data.GetContents()))}
+		docFrequencies := make(map[uint32]int64)
+		for _, row := range data.GetContents() {
+			for i := 0; i < typeutil.SparseFloatRowElementCount(row); i++ {
+				bm25Stats.TotalTokens += float64(typeutil.SparseFloatRowValueAt(row, i))
+				docFrequencies[typeutil.SparseFloatRowIndexAt(row, i)]++
+			}
+		}
+		bm25Stats.NumTerms = int64(len(docFrequencies))
+		stats.Bm25Stats = bm25Stats
+		return stats, docFrequencies
+	}
+	return stats, nil
+}
+
+// minMaxOf returns the min and max of the valid values and the number of the nulls,
+// ok is false if there is no valid value.
+func minMaxOf[T constraints.Ordered](values []T, validData []bool) (minV, maxV T, nullCount int64, ok bool) {
+	for i, v := range values {
+		if len(validData) > 0 && !validData[i] {
+			nullCount++
+			continue
+		}
+		if !ok || v < minV {
+			minV = v
+		}
+		if !ok || v > maxV {
+			maxV = v
+		}
+		ok = true
+	}
+	return minV, maxV, nullCount, ok
+}
+
+// centroidOf returns the mean of the vectors, nil if there is no vector.
+func centroidOf(vectors []float32, dim int) []float32 {
+	if dim <= 0 || len(vectors) < dim {
+		return nil
+	}
+	sums := make([]float64, dim)
+	for i, v := range vectors {
+		sums[i%dim] += float64(v)
+	}
+	rows := float64(len(vectors) / dim)
+	centroid := make([]float32, dim)
+	for i, sum := range sums {
+		centroid[i] = float32(sum / rows)
+	}
+	return centroid
+}
+
+func countNulls(validData []bool) int64 {
+	nullCount := int64(0)
+	for _, valid := range validData {
+		if !valid {
+			nullCount++
+		}
+	}
+	return nullCount
+}
+
+// serializeDocFrequencies encodes the document frequencies as the little endian (uint32 term, int64 frequency)
+// pairs sorted by the terms.
+func serializeDocFrequencies(docFrequencies map[uint32]int64) []byte {
+	terms := make([]uint32, 0, len(docFrequencies))
+	for term := range docFrequencies {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool { return terms[i] < terms[j] })
+
+	buf := make([]byte, 0, len(terms)*12)
+	for _, term := range terms {
+		buf = binary.LittleEndian.AppendUint32(buf, term)
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(docFrequencies[term]))
+	}
+	return buf
+}
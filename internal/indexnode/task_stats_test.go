@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestComputeFieldStats(t *testing.T) {
+	t.Run("nullable int64", func(t *testing.T) {
+		field := &schemapb.FieldSchema{FieldID: 100, DataType: schemapb.DataType_Int64, Nullable: true}
+		stats, docFrequencies := computeFieldStats(field, &storage.Int64FieldData{
+			Data:      []int64{5, 0, -3, 9, 0},
+			ValidData: []bool{true, false, true, true, false},
+		})
+		assert.Nil(t, docFrequencies)
+		assert.Equal(t, int64(100), stats.GetFieldID())
+		assert.Equal(t, int64(2), stats.GetNullCount())
+		assert.Equal(t, int64(-3), stats.GetMin().GetLongData())
+		assert.Equal(t, int64(9), stats.GetMax().GetLongData())
+		assert.Nil(t, stats.GetBm25Stats())
+	})
+
+	t.Run("varchar", func(t *testing.T) {
+		field := &schemapb.FieldSchema{FieldID: 101, DataType: schemapb.DataType_VarChar}
+		stats, _ := computeFieldStats(field, &storage.StringFieldData{
+			Data: []string{"milvus", "apple", "zebra"},
+		})
+		assert.Equal(t, int64(0), stats.GetNullCount())
+		assert.Equal(t, "apple", stats.GetMin().GetStringData())
+		assert.Equal(t, "zebra", stats.GetMax().GetStringData())
+	})
+
+	t.Run("all nulls", func(t *testing.T) {
+		field := &schemapb.FieldSchema{FieldID: 102, DataType: schemapb.DataType_Float, Nullable: true}
+		stats, _ := computeFieldStats(field, &storage.FloatFieldData{
+			Data:      []float32{0, 0},
+			ValidData: []bool{false, false},
+		})
+		assert.Equal(t, int64(2), stats.GetNullCount())
+		assert.Nil(t, stats.GetMin())
+		assert.Nil(t, stats.GetMax())
+	})
+
+	t.Run("sparse float vector", func(t *testing.T) {
+		field := &schemapb.FieldSchema{FieldID: 103, DataType: schemapb.DataType_SparseFloatVector}
+		data := &storage.SparseFloatVectorFieldData{}
+		data.Contents = [][]byte{
+			typeutil.CreateSparseFloatRow([]uint32{1, 7}, []float32{2, 1}),
+			typeutil.CreateSparseFloatRow([]uint32{7}, []float32{3}),
+		}
+		stats, docFrequencies := computeFieldStats(field, data)
+		assert.Equal(t, int64(2), stats.GetBm25Stats().GetNumRows())
+		assert.Equal(t, float64(6), stats.GetBm25Stats().GetTotalTokens())
+		assert.Equal(t, int64(2), stats.GetBm25Stats().GetNumTerms())
+		assert.Equal(t, map[uint32]int64{1: 1, 7: 2}, docFrequencies)
+
+		buf := serializeDocFrequencies(docFrequencies)
+		assert.Equal(t, 24, len(buf))
+		assert.Equal(t, uint32(1), binary.LittleEndian.Uint32(buf[0:]))
+		assert.Equal(t, uint64(1), binary.LittleEndian.Uint64(buf[4:]))
+		assert.Equal(t, uint32(7), binary.LittleEndian.Uint32(buf[12:]))
+		assert.Equal(t, uint64(2), binary.LittleEndian.Uint64(buf[16:]))
+	})
+
+	t.Run("float vector", func(t *testing.T) {
+		field := &schemapb.FieldSchema{FieldID: 104, DataType: schemapb.DataType_FloatVector, IsClusteringKey: true}
+		data := &storage.FloatVectorFieldData{Data: []float32{1, 2, 3, 6}, Dim: 2}
+		stats, _ := computeFieldStats(field, data)
+		assert.Equal(t, []float32{2, 4}, stats.GetCentroid())
+
+		stats, _ = computeFieldStats(field, &storage.FloatVectorFieldData{Dim: 2})
+		assert.Nil(t, stats.GetCentroid())
+	})
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/indexparams"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -135,6 +136,41 @@ func (suite *IndexBuildTaskSuite) TestBuildMemoryIndex() {
 	suite.NoError(err)
 }
 
+func (suite *IndexBuildTaskSuite) TestBuildProfile() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req := &indexpb.CreateJobRequest{
+		BuildID:      1,
+		IndexVersion: 1,
+		DataPaths:    []string{suite.dataPath},
+		IndexParams: []*commonpb.KeyValuePair{
+			{Key: common.IndexTypeKey, Value: "HNSW"},
+			{Key: common.MetricTypeKey, Value: metric.L2},
+			{Key: common.IndexBuildProfileKey, Value: indexparams.BuildProfileFast},
+		},
+		TypeParams:   []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}},
+		NumRows:      int64(suite.numRows),
+		CollectionID: 1,
+		PartitionID:  2,
+		SegmentID:    3,
+		FieldID:      102,
+		FieldName:    "vec",
+		FieldType:    schemapb.DataType_FloatVector,
+	}
+	node := NewIndexNode(context.Background(), dependency.NewDefaultFactory(true))
+	t := newIndexBuildTask(ctx, cancel, req, nil, node)
+	suite.EqualValues(2, t.GetSlot())
+	suite.NoError(t.PreExecute(context.Background()))
+	suite.NotContains(t.newIndexParams, common.IndexBuildProfileKey)
+
+	// the task without profile takes one slot
+	noProfileReq := &indexpb.CreateJobRequest{BuildID: 2, IndexParams: req.GetIndexParams()[:2]}
+	queue := NewIndexBuildTaskQueue(nil)
+	suite.NoError(queue.addUnissuedTask(t))
+	suite.NoError(queue.addUnissuedTask(newIndexBuildTask(ctx, cancel, noProfileReq, nil, node)))
+	suite.EqualValues(3, queue.GetUsedSlots())
+}
+
 func TestIndexBuildTask(t *testing.T) {
 	suite.Run(t, new(IndexBuildTaskSuite))
 }
@@ -20,6 +20,12 @@ type indexTaskInfo struct {
 	failReason          string
 	currentIndexVersion int32
 	indexStoreVersion   int64
+	// epoch is the assignment epoch of the task, the index version of the request
+	epoch int64
+	// progress is the percentage of the build, updated after each stage of the task
+	progress int32
+	// startTime is the time the task starts to be executed, zero while it's waiting in the queue
+	startTime time.Time
 
 	// task statistics
 	statistic *indexpb.JobInfo
@@ -60,6 +66,24 @@ func (i *IndexNode) storeIndexTaskState(ClusterID string, buildID UniqueID, stat
 	}
 }
 
+func (i *IndexNode) storeIndexTaskProgress(ClusterID string, buildID UniqueID, progress int32) {
+	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	if task, ok := i.indexTasks[key]; ok {
+		task.progress = progress
+	}
+}
+
+func (i *IndexNode) storeIndexTaskStartTime(ClusterID string, buildID UniqueID, startTime time.Time) {
+	key := taskKey{ClusterID: ClusterID, BuildID: buildID}
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	if task, ok := i.indexTasks[key]; ok {
+		task.startTime = startTime
+	}
+}
+
 func (i *IndexNode) foreachIndexTaskInfo(fn func(ClusterID string, buildID UniqueID, info *indexTaskInfo)) {
 	i.stateLock.Lock()
 	defer i.stateLock.Unlock()
@@ -140,6 +164,10 @@ type analyzeTaskInfo struct {
 	state         indexpb.JobState
 	failReason    string
 	centroidsFile string
+	// epoch is the assignment epoch of the task, the version of the request
+	epoch int64
+	// startTime is the time the task starts to be executed, zero while it's waiting in the queue
+	startTime time.Time
 }
 
 func (i *IndexNode) loadOrStoreAnalyzeTask(clusterID string, taskID UniqueID, info *analyzeTaskInfo) *analyzeTaskInfo {
@@ -177,6 +205,15 @@ func (i *IndexNode) storeAnalyzeTaskState(clusterID string, taskID UniqueID, sta
 	}
 }
 
+func (i *IndexNode) storeAnalyzeTaskStartTime(clusterID string, taskID UniqueID, startTime time.Time) {
+	key := taskKey{ClusterID: clusterID, BuildID: taskID}
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	if task, ok := i.analyzeTasks[key]; ok {
+		task.startTime = startTime
+	}
+}
+
 func (i *IndexNode) foreachAnalyzeTaskInfo(fn func(clusterID string, taskID UniqueID, info *analyzeTaskInfo)) {
 	i.stateLock.Lock()
 	defer i.stateLock.Unlock()
@@ -235,6 +272,106 @@ func (i *IndexNode) deleteAllAnalyzeTasks() []*analyzeTaskInfo {
 	return deleted
 }
 
+type statsTaskInfo struct {
+	cancel     context.CancelFunc
+	state      indexpb.JobState
+	failReason string
+	fieldStats []*indexpb.FieldStats
+	// epoch is the assignment epoch of the task, the version of the request
+	epoch int64
+	// startTime is the time the task starts to be executed, zero while it's waiting in the queue
+	startTime time.Time
+}
+
+func (i *IndexNode) loadOrStoreStatsTask(clusterID string, taskID UniqueID, info *statsTaskInfo) *statsTaskInfo {
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	key := taskKey{ClusterID: clusterID, BuildID: taskID}
+	oldInfo, ok := i.statsTasks[key]
+	if ok {
+		return oldInfo
+	}
+	i.statsTasks[key] = info
+	return nil
+}
+
+func (i *IndexNode) loadStatsTaskState(clusterID string, taskID UniqueID) indexpb.JobState {
+	key := taskKey{ClusterID: clusterID, BuildID: taskID}
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	task, ok := i.statsTasks[key]
+	if !ok {
+		return indexpb.JobState_JobStateNone
+	}
+	return task.state
+}
+
+func (i *IndexNode) storeStatsTaskState(clusterID string, taskID UniqueID, state indexpb.JobState, failReason string) {
+	key := taskKey{ClusterID: clusterID, BuildID: taskID}
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	if task, ok := i.statsTasks[key]; ok {
+		log.Info("IndexNode store stats task state", zap.String("clusterID", clusterID), zap.Int64("taskID", taskID),
+			zap.String("state", state.String()), zap.String("fail reason", failReason))
+		task.state = state
+		task.failReason = failReason
+	}
+}
+
+func (i *IndexNode) storeStatsTaskStartTime(clusterID string, taskID UniqueID, startTime time.Time) {
+	key := taskKey{ClusterID: clusterID, BuildID: taskID}
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	if task, ok := i.statsTasks[key]; ok {
+		task.startTime = startTime
+	}
+}
+
+func (i *IndexNode) storeStatsResult(clusterID string, taskID UniqueID, fieldStats []*indexpb.FieldStats) {
+	key := taskKey{ClusterID: clusterID, BuildID: taskID}
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	if info, ok := i.statsTasks[key]; ok {
+		info.fieldStats = fieldStats
+	}
+}
+
+func (i *IndexNode) getStatsTaskInfo(clusterID string, taskID UniqueID) *statsTaskInfo {
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+
+	return i.statsTasks[taskKey{ClusterID: clusterID, BuildID: taskID}]
+}
+
+func (i *IndexNode) deleteStatsTaskInfos(ctx context.Context, keys []taskKey) []*statsTaskInfo {
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	deleted := make([]*statsTaskInfo, 0, len(keys))
+	for _, key := range keys {
+		info, ok := i.statsTasks[key]
+		if ok {
+			deleted = append(deleted, info)
+			delete(i.statsTasks, key)
+			log.Ctx(ctx).Info("delete stats task infos",
+				zap.String("clusterID", key.ClusterID), zap.Int64("taskID", key.BuildID))
+		}
+	}
+	return deleted
+}
+
+func (i *IndexNode) deleteAllStatsTasks() []*statsTaskInfo {
+	i.stateLock.Lock()
+	deletedTasks := i.statsTasks
+	i.statsTasks = make(map[taskKey]*statsTaskInfo)
+	i.stateLock.Unlock()
+
+	deleted := make([]*statsTaskInfo, 0, len(deletedTasks))
+	for _, info := range deletedTasks {
+		deleted = append(deleted, info)
+	}
+	return deleted
+}
+
 func (i *IndexNode) hasInProgressTask() bool {
 	i.stateLock.Lock()
 	defer i.stateLock.Unlock()
@@ -249,6 +386,12 @@ func (i *IndexNode) hasInProgressTask() bool {
 			return true
 		}
 	}
+
+	for _, info := range i.statsTasks {
+		if info.state == indexpb.JobState_JobStateInProgress {
+			return true
+		}
+	}
 	return false
 }
 
@@ -281,7 +424,20 @@ func (i *IndexNode) waitTaskFinish() {
 					log.Warn("progress task", zap.Any("info", info))
 				}
 			}
+			for _, info := range i.statsTasks {
+				if info.state == indexpb.JobState_JobStateInProgress {
+					log.Warn("progress task", zap.Any("info", info))
+				}
+			}
 			return
 		}
 	}
 }
+
+// startTimeMilli returns the start time of the task in unix milliseconds, 0 if not started.
+func startTimeMilli(startTime time.Time) int64 {
+	if startTime.IsZero() {
+		return 0
+	}
+	return startTime.UnixMilli()
+}
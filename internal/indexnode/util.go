@@ -18,9 +18,26 @@ package indexnode
 
 import (
 	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// mmapBuildIndexTypes are the in memory index types able to be built over the mmaped raw data.
+var mmapBuildIndexTypes = typeutil.NewSet(
+	indexparamcheck.IndexFaissIDMap,
+	indexparamcheck.IndexFaissIvfFlat,
+	indexparamcheck.IndexFaissIvfSQ8,
+	indexparamcheck.IndexFaissIvfPQ,
+	indexparamcheck.IndexFaissBinIDMap,
+	indexparamcheck.IndexFaissBinIvfFlat,
 )
 
 func estimateFieldDataSize(dim int64, numRows int64, dataType schemapb.DataType) (uint64, error) {
@@ -38,6 +55,20 @@ func estimateFieldDataSize(dim int64, numRows int64, dataType schemapb.DataType)
 	}
 }
 
+// shouldBuildWithMmap returns whether to build the index over the raw data mmaped from the local disk,
+// it cuts the peak memory of building the index on the segment large compared to the memory of IndexNode.
+func shouldBuildWithMmap(indexType string, dim int64, numRows int64, dataType schemapb.DataType) bool {
+	ratio := Params.IndexNodeCfg.BuildWithMmapMemoryRatio.GetAsFloat()
+	if ratio <= 0 || !mmapBuildIndexTypes.Contain(indexType) {
+		return false
+	}
+	fieldDataSize, err := estimateFieldDataSize(dim, numRows, dataType)
+	if err != nil || fieldDataSize == 0 {
+		return false
+	}
+	return float64(fieldDataSize) > ratio*float64(hardware.GetMemoryCount())
+}
+
 func mapToKVPairs(m map[string]string) []*commonpb.KeyValuePair {
 	kvs := make([]*commonpb.KeyValuePair, 0, len(m))
 	for k, v := range m {
@@ -48,3 +79,23 @@ func mapToKVPairs(m map[string]string) []*commonpb.KeyValuePair {
 	}
 	return kvs
 }
+
+// getDiskResources returns the capacity and the available size in bytes of the local disk to build the disk indexes,
+// both are 0 if the disk index is disabled or the used size is unknown.
+func getDiskResources() (int64, int64) {
+	if !Params.IndexNodeCfg.EnableDisk.GetAsBool() {
+		return 0, 0
+	}
+	capacity := int64(Params.IndexNodeCfg.DiskCapacityLimit.GetAsFloat() * Params.IndexNodeCfg.MaxDiskUsagePercentage.GetAsFloat())
+	used, err := indexcgowrapper.GetLocalUsedSize(paramtable.Get().LocalStorageCfg.Path.GetValue())
+	if err != nil {
+		log.Warn("IndexNode get local used size failed", zap.Error(err))
+		return 0, 0
+	}
+	return capacity, max(capacity-used, 0)
+}
+
+// getRequiredCPUFeatures returns the gated CPU features of the node, which the indexes it builds require to be loaded.
+func getRequiredCPUFeatures() []string {
+	return hardware.GetGatedCPUFeatures(paramtable.Get().CommonCfg.GatedCPUFeatures.GetAsStrings())
+}
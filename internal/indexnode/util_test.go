@@ -18,9 +18,15 @@ package indexnode
 
 import (
 	"math/rand"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 type utilSuite struct {
@@ -37,6 +43,33 @@ func (s *utilSuite) Test_mapToKVPairs() {
 	s.Equal(3, len(mapToKVPairs(indexParams)))
 }
 
+func (s *utilSuite) Test_shouldBuildWithMmap() {
+	paramtable.Init()
+	key := Params.IndexNodeCfg.BuildWithMmapMemoryRatio.Key
+	defer paramtable.Get().Reset(key)
+	// the raw data takes twice of the memory
+	numRows := int64(hardware.GetMemoryCount() / 2)
+
+	s.True(shouldBuildWithMmap(indexparamcheck.IndexFaissIvfFlat, 4, numRows, schemapb.DataType_FloatVector))
+	s.True(shouldBuildWithMmap(indexparamcheck.IndexFaissBinIDMap, 32, numRows, schemapb.DataType_BinaryVector))
+	s.False(shouldBuildWithMmap(indexparamcheck.IndexFaissIvfFlat, 4, 1, schemapb.DataType_FloatVector))
+	s.False(shouldBuildWithMmap(indexparamcheck.IndexHNSW, 4, numRows, schemapb.DataType_FloatVector))
+	s.False(shouldBuildWithMmap(indexparamcheck.IndexSparseInverted, 4, numRows, schemapb.DataType_SparseFloatVector))
+
+	paramtable.Get().Save(key, "0")
+	s.False(shouldBuildWithMmap(indexparamcheck.IndexFaissIvfFlat, 4, numRows, schemapb.DataType_FloatVector))
+}
+
+func (s *utilSuite) Test_getRequiredCPUFeatures() {
+	paramtable.Init()
+	key := paramtable.Get().CommonCfg.GatedCPUFeatures.Key
+	defer paramtable.Get().Reset(key)
+	s.Empty(getRequiredCPUFeatures())
+
+	paramtable.Get().Save(key, runtime.GOARCH+",unknown")
+	s.Equal([]string{runtime.GOARCH}, getRequiredCPUFeatures())
+}
+
 func Test_utilSuite(t *testing.T) {
 	suite.Run(t, new(utilSuite))
 }
@@ -112,6 +112,8 @@ type BinlogsIncrement struct {
 //go:generate mockery --name=DataCoordCatalog --with-expecter
 type DataCoordCatalog interface {
 	ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, error)
+	ListCollectionSegments(ctx context.Context, collectionID typeutil.UniqueID) ([]*datapb.SegmentInfo, error)
+	GetSegment(ctx context.Context, collectionID, partitionID, segmentID typeutil.UniqueID) (*datapb.SegmentInfo, error)
 	AddSegment(ctx context.Context, segment *datapb.SegmentInfo) error
 	// TODO Remove this later, we should update flush segments info for each segment separately, so far we still need transaction
 	AlterSegments(ctx context.Context, newSegments []*datapb.SegmentInfo, binlogs ...BinlogsIncrement) error
@@ -129,6 +131,18 @@ type DataCoordCatalog interface {
 	SaveChannelCheckpoints(ctx context.Context, positions []*msgpb.MsgPosition) error
 	DropChannelCheckpoint(ctx context.Context, vChannel string) error
 
+	ListGcSafeTs(ctx context.Context) (map[typeutil.UniqueID]typeutil.Timestamp, error)
+	SaveGcSafeTs(ctx context.Context, collectionID typeutil.UniqueID, ts typeutil.Timestamp) error
+	DropGcSafeTs(ctx context.Context, collectionID typeutil.UniqueID) error
+
+	ListShippedSegments(ctx context.Context) ([]*datapb.ShippedSegment, error)
+	SaveShippedSegment(ctx context.Context, segment *datapb.ShippedSegment) error
+	DropShippedSegment(ctx context.Context, primarySegmentID typeutil.UniqueID) error
+
+	ListSegmentReferences(ctx context.Context) ([]*datapb.SegmentReference, error)
+	SaveSegmentReference(ctx context.Context, reference *datapb.SegmentReference) error
+	DropSegmentReference(ctx context.Context, referenceID typeutil.UniqueID) error
+
 	CreateIndex(ctx context.Context, index *model.Index) error
 	ListIndexes(ctx context.Context) ([]*model.Index, error)
 	AlterIndexes(ctx context.Context, newIndexes []*model.Index) error
@@ -136,6 +150,7 @@ type DataCoordCatalog interface {
 
 	CreateSegmentIndex(ctx context.Context, segIdx *model.SegmentIndex) error
 	ListSegmentIndexes(ctx context.Context) ([]*model.SegmentIndex, error)
+	ListCollectionSegmentIndexes(ctx context.Context, collectionID typeutil.UniqueID) ([]*model.SegmentIndex, error)
 	AlterSegmentIndexes(ctx context.Context, newSegIdxes []*model.SegmentIndex) error
 	DropSegmentIndex(ctx context.Context, collID, partID, segID, buildID typeutil.UniqueID) error
 
@@ -149,6 +164,13 @@ type DataCoordCatalog interface {
 	ListImportTasks() ([]*datapb.ImportTaskV2, error)
 	DropImportTask(taskID int64) error
 
+	SaveExportJob(job *datapb.ExportJob) error
+	ListExportJobs() ([]*datapb.ExportJob, error)
+	DropExportJob(jobID int64) error
+	SaveExportTask(task *datapb.ExportTask) error
+	ListExportTasks() ([]*datapb.ExportTask, error)
+	DropExportTask(taskID int64) error
+
 	GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool
 
 	ListCompactionTask(ctx context.Context) ([]*datapb.CompactionTask, error)
@@ -159,6 +181,10 @@ type DataCoordCatalog interface {
 	SaveAnalyzeTask(ctx context.Context, task *indexpb.AnalyzeTask) error
 	DropAnalyzeTask(ctx context.Context, taskID typeutil.UniqueID) error
 
+	ListStatsTasks(ctx context.Context) ([]*indexpb.StatsTask, error)
+	SaveStatsTask(ctx context.Context, task *indexpb.StatsTask) error
+	DropStatsTask(ctx context.Context, taskID typeutil.UniqueID) error
+
 	ListPartitionStatsInfos(ctx context.Context) ([]*datapb.PartitionStatsInfo, error)
 	SavePartitionStatsInfo(ctx context.Context, info *datapb.PartitionStatsInfo) error
 	DropPartitionStatsInfo(ctx context.Context, info *datapb.PartitionStatsInfo) error
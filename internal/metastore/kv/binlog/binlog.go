@@ -121,7 +121,7 @@ func DecompressCompactionBinlogs(binlogs []*datapb.CompactionSegmentBinlogs) err
 
 func DecompressBinLogs(s *datapb.SegmentInfo) error {
 	collectionID, partitionID, segmentID := s.GetCollectionID(), s.GetPartitionID(), s.ID
-	err := DecompressBinLog(storage.InsertBinlog, collectionID, partitionID, segmentID, s.GetBinlogs())
+	err := DecompressInsertBinLogs(s)
 	if err != nil {
 		return err
 	}
@@ -136,6 +136,22 @@ func DecompressBinLogs(s *datapb.SegmentInfo) error {
 	return nil
 }
 
+// InsertLogOwner returns the collection, partition and segment ids of the insert binlog paths of the segment,
+// which are the ids of the source segment for a cloned segment sharing the insert binlogs.
+func InsertLogOwner(s *datapb.SegmentInfo) (collectionID, partitionID, segmentID typeutil.UniqueID) {
+	if source := s.GetBinlogsSource(); source != nil {
+		return source.GetCollectionID(), source.GetPartitionID(), source.GetSegmentID()
+	}
+	return s.GetCollectionID(), s.GetPartitionID(), s.GetID()
+}
+
+// DecompressInsertBinLogs fills the paths of the insert binlogs of the segment,
+// the paths of a cloned segment are under its source segment.
+func DecompressInsertBinLogs(s *datapb.SegmentInfo) error {
+	collectionID, partitionID, segmentID := InsertLogOwner(s)
+	return DecompressBinLog(storage.InsertBinlog, collectionID, partitionID, segmentID, s.GetBinlogs())
+}
+
 func DecompressBinLog(binlogType storage.BinlogType, collectionID, partitionID,
 	segmentID typeutil.UniqueID, fieldBinlogs []*datapb.FieldBinlog,
 ) error {
@@ -156,10 +172,7 @@ func DecompressBinLog(binlogType storage.BinlogType, collectionID, partitionID,
 
 // build a binlog path on the storage by metadata
 func BuildLogPath(binlogType storage.BinlogType, collectionID, partitionID, segmentID, fieldID, logID typeutil.UniqueID) (string, error) {
-	chunkManagerRootPath := paramtable.Get().MinioCfg.RootPath.GetValue()
-	if paramtable.Get().CommonCfg.StorageType.GetValue() == "local" {
-		chunkManagerRootPath = paramtable.Get().LocalStorageCfg.Path.GetValue()
-	}
+	chunkManagerRootPath := getChunkManagerRootPath()
 	switch binlogType {
 	case storage.InsertBinlog:
 		return metautil.BuildInsertLogPath(chunkManagerRootPath, collectionID, partitionID, segmentID, fieldID, logID), nil
@@ -172,6 +185,18 @@ func BuildLogPath(binlogType storage.BinlogType, collectionID, partitionID, segm
 	return "", merr.WrapErrParameterInvalidMsg("invalid binlog type")
 }
 
+// BuildQuantizedLogPath returns the path of the quantized vector codes of the insert binlog with the log id.
+func BuildQuantizedLogPath(collectionID, partitionID, segmentID, fieldID, logID typeutil.UniqueID) string {
+	return metautil.BuildQuantizedLogPath(getChunkManagerRootPath(), collectionID, partitionID, segmentID, fieldID, logID)
+}
+
+func getChunkManagerRootPath() string {
+	if paramtable.Get().CommonCfg.StorageType.GetValue() == "local" {
+		return paramtable.Get().LocalStorageCfg.Path.GetValue()
+	}
+	return paramtable.Get().MinioCfg.RootPath.GetValue()
+}
+
 // GetLogIDFromBingLogPath get log id from binlog path
 func GetLogIDFromBingLogPath(logPath string) (int64, error) {
 	var logID int64
@@ -288,3 +288,20 @@ func TestBinlog_Compress(t *testing.T) {
 	err = DecompressBinLog(invaildType, 1, 1, 1, segmentInfo.Binlogs)
 	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
 }
+
+func TestBinlog_DecompressClonedSegment(t *testing.T) {
+	paramtable.Init()
+	rootPath := paramtable.Get().MinioCfg.RootPath.GetValue()
+	segmentInfo := getSegment(rootPath, 0, 1, 2, 3, 1)
+	assert.NoError(t, CompressBinLogs(segmentInfo.GetBinlogs(), segmentInfo.GetDeltalogs(), segmentInfo.GetStatslogs()))
+	segmentInfo.CollectionID, segmentInfo.PartitionID, segmentInfo.ID = 10, 20, 30
+	segmentInfo.BinlogsSource = &datapb.SegmentBinlogsSource{CollectionID: 0, PartitionID: 1, SegmentID: 2}
+
+	assert.NoError(t, DecompressBinLogs(segmentInfo))
+	// the insert logs are under the source segment, the others are under the cloned segment
+	fieldID := segmentInfo.GetBinlogs()[0].GetFieldID()
+	logID := segmentInfo.GetBinlogs()[0].GetBinlogs()[0].GetLogID()
+	assert.Equal(t, metautil.BuildInsertLogPath(rootPath, 0, 1, 2, fieldID, logID), segmentInfo.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	logID = segmentInfo.GetDeltalogs()[0].GetBinlogs()[0].GetLogID()
+	assert.Equal(t, metautil.BuildDeltaLogPath(rootPath, 10, 20, 30, logID), segmentInfo.GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+}
@@ -24,11 +24,17 @@ const (
 	SegmentStatslogPathPrefix          = MetaPrefix + "/statslog"
 	ChannelRemovePrefix                = MetaPrefix + "/channel-removal"
 	ChannelCheckpointPrefix            = MetaPrefix + "/channel-cp"
+	GcSafeTsPrefix                     = MetaPrefix + "/gc-safe-ts"
+	ShippedSegmentPrefix               = MetaPrefix + "/shipped-segment"
+	SegmentReferencePrefix             = MetaPrefix + "/segment-reference"
 	ImportJobPrefix                    = MetaPrefix + "/import-job"
 	ImportTaskPrefix                   = MetaPrefix + "/import-task"
 	PreImportTaskPrefix                = MetaPrefix + "/preimport-task"
+	ExportJobPrefix                    = MetaPrefix + "/export-job"
+	ExportTaskPrefix                   = MetaPrefix + "/export-task"
 	CompactionTaskPrefix               = MetaPrefix + "/compaction-task"
 	AnalyzeTaskPrefix                  = MetaPrefix + "/analyze-task"
+	StatsTaskPrefix                    = MetaPrefix + "/stats-task"
 	PartitionStatsInfoPrefix           = MetaPrefix + "/partition-stats"
 	PartitionStatsCurrentVersionPrefix = MetaPrefix + "/current-partition-stats-version"
 
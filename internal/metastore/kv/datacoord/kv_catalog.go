@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
@@ -43,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -59,6 +61,17 @@ func NewCatalog(MetaKv kv.MetaKv, chunkManagerRootPath string, metaRootpath stri
 }
 
 func (kc *Catalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, error) {
+	return kc.listSegmentsWithBinlogs(ctx, "")
+}
+
+// ListCollectionSegments lists the segments of the collection only, with their binlogs.
+func (kc *Catalog) ListCollectionSegments(ctx context.Context, collectionID typeutil.UniqueID) ([]*datapb.SegmentInfo, error) {
+	return kc.listSegmentsWithBinlogs(ctx, fmt.Sprintf("/%d", collectionID))
+}
+
+// listSegmentsWithBinlogs lists the segments and their binlogs under the collection prefix,
+// which is empty for all the collections.
+func (kc *Catalog) listSegmentsWithBinlogs(ctx context.Context, collectionPrefix string) ([]*datapb.SegmentInfo, error) {
 	group, _ := errgroup.WithContext(ctx)
 	segments := make([]*datapb.SegmentInfo, 0)
 	insertLogs := make(map[typeutil.UniqueID][]*datapb.FieldBinlog, 1)
@@ -67,7 +80,7 @@ func (kc *Catalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, err
 
 	executeFn := func(binlogType storage.BinlogType, result map[typeutil.UniqueID][]*datapb.FieldBinlog) {
 		group.Go(func() error {
-			ret, err := kc.listBinlogs(binlogType)
+			ret, err := kc.listBinlogs(binlogType, collectionPrefix)
 			if err != nil {
 				return err
 			}
@@ -82,7 +95,7 @@ func (kc *Catalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, err
 	executeFn(storage.DeleteBinlog, deltaLogs)
 	executeFn(storage.StatsBinlog, statsLogs)
 	group.Go(func() error {
-		ret, err := kc.listSegments()
+		ret, err := kc.listSegments(collectionPrefix)
 		if err != nil {
 			return err
 		}
@@ -102,7 +115,7 @@ func (kc *Catalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, err
 	return segments, nil
 }
 
-func (kc *Catalog) listSegments() ([]*datapb.SegmentInfo, error) {
+func (kc *Catalog) listSegments(collectionPrefix string) ([]*datapb.SegmentInfo, error) {
 	segments := make([]*datapb.SegmentInfo, 0)
 
 	applyFn := func(key []byte, value []byte) error {
@@ -121,7 +134,7 @@ func (kc *Catalog) listSegments() ([]*datapb.SegmentInfo, error) {
 		return nil
 	}
 
-	err := kc.MetaKv.WalkWithPrefix(SegmentPrefix+"/", paginationSize, applyFn)
+	err := kc.MetaKv.WalkWithPrefix(SegmentPrefix+collectionPrefix+"/", paginationSize, applyFn)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +167,7 @@ func (kc *Catalog) parseBinlogKey(key string, prefixIdx int) (int64, int64, int6
 	return collectionID, partitionID, segmentID, nil
 }
 
-func (kc *Catalog) listBinlogs(binlogType storage.BinlogType) (map[typeutil.UniqueID][]*datapb.FieldBinlog, error) {
+func (kc *Catalog) listBinlogs(binlogType storage.BinlogType, collectionPrefix string) (map[typeutil.UniqueID][]*datapb.FieldBinlog, error) {
 	ret := make(map[typeutil.UniqueID][]*datapb.FieldBinlog)
 
 	var err error
@@ -173,18 +186,11 @@ func (kc *Catalog) listBinlogs(binlogType storage.BinlogType) (map[typeutil.Uniq
 		return nil, err
 	}
 
-	var prefixIdx int
-	if len(kc.metaRootpath) == 0 {
-		prefixIdx = len(logPathPrefix) + 1
-	} else {
-		prefixIdx = len(kc.metaRootpath) + 1 + len(logPathPrefix) + 1
-	}
-
+	prefixIdx := kc.binlogKeyPrefixIdx(logPathPrefix)
 	applyFn := func(key []byte, value []byte) error {
-		fieldBinlog := &datapb.FieldBinlog{}
-		err := proto.Unmarshal(value, fieldBinlog)
+		fieldBinlog, err := unmarshalFieldBinlog(value)
 		if err != nil {
-			return fmt.Errorf("failed to unmarshal datapb.FieldBinlog: %d, err:%w", fieldBinlog.FieldID, err)
+			return err
 		}
 
 		_, _, segmentID, err := kc.parseBinlogKey(string(key), prefixIdx)
@@ -192,25 +198,46 @@ func (kc *Catalog) listBinlogs(binlogType storage.BinlogType) (map[typeutil.Uniq
 			return fmt.Errorf("prefix:%s, %w", path.Join(kc.metaRootpath, logPathPrefix), err)
 		}
 
-		// set log size to memory size if memory size is zero for old segment before v2.4.3
-		for i, b := range fieldBinlog.GetBinlogs() {
-			if b.GetMemorySize() == 0 {
-				fieldBinlog.Binlogs[i].MemorySize = b.GetLogSize()
-			}
-		}
-
 		// no need to set log path and only store log id
 		ret[segmentID] = append(ret[segmentID], fieldBinlog)
 		return nil
 	}
 
-	err = kc.MetaKv.WalkWithPrefix(logPathPrefix, paginationSize, applyFn)
+	walkPrefix := logPathPrefix
+	if collectionPrefix != "" {
+		walkPrefix = logPathPrefix + collectionPrefix + "/"
+	}
+	err = kc.MetaKv.WalkWithPrefix(walkPrefix, paginationSize, applyFn)
 	if err != nil {
 		return nil, err
 	}
 	return ret, nil
 }
 
+// binlogKeyPrefixIdx returns the index of the collection id in the full keys of the binlogs.
+func (kc *Catalog) binlogKeyPrefixIdx(logPathPrefix string) int {
+	if len(kc.metaRootpath) == 0 {
+		return len(logPathPrefix) + 1
+	}
+	return len(kc.metaRootpath) + 1 + len(logPathPrefix) + 1
+}
+
+func unmarshalFieldBinlog(value []byte) (*datapb.FieldBinlog, error) {
+	fieldBinlog := &datapb.FieldBinlog{}
+	err := proto.Unmarshal(value, fieldBinlog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal datapb.FieldBinlog: %d, err:%w", fieldBinlog.FieldID, err)
+	}
+
+	// set log size to memory size if memory size is zero for old segment before v2.4.3
+	for i, b := range fieldBinlog.GetBinlogs() {
+		if b.GetMemorySize() == 0 {
+			fieldBinlog.Binlogs[i].MemorySize = b.GetLogSize()
+		}
+	}
+	return fieldBinlog, nil
+}
+
 func (kc *Catalog) applyBinlogInfo(segments []*datapb.SegmentInfo, insertLogs, deltaLogs,
 	statsLogs map[typeutil.UniqueID][]*datapb.FieldBinlog,
 ) error {
@@ -240,6 +267,58 @@ func (kc *Catalog) applyBinlogInfo(segments []*datapb.SegmentInfo, insertLogs, d
 	return nil
 }
 
+// GetSegment returns the segment with its binlogs, nil if the segment doesn't exist.
+func (kc *Catalog) GetSegment(ctx context.Context, collectionID, partitionID, segmentID typeutil.UniqueID) (*datapb.SegmentInfo, error) {
+	value, err := kc.MetaKv.Load(buildSegmentPath(collectionID, partitionID, segmentID))
+	if errors.Is(err, merr.ErrIoKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	segment := &datapb.SegmentInfo{}
+	err = proto.Unmarshal([]byte(value), segment)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make(map[storage.BinlogType]map[typeutil.UniqueID][]*datapb.FieldBinlog)
+	for binlogType, logPathPrefix := range map[storage.BinlogType]string{
+		storage.InsertBinlog: SegmentBinlogPathPrefix,
+		storage.DeleteBinlog: SegmentDeltalogPathPrefix,
+		storage.StatsBinlog:  SegmentStatslogPathPrefix,
+	} {
+		keys, values, err := kc.getBinlogsWithPrefix(binlogType, collectionID, partitionID, segmentID)
+		if err != nil {
+			return nil, err
+		}
+		prefixIdx := kc.binlogKeyPrefixIdx(logPathPrefix)
+		var fieldBinlogs []*datapb.FieldBinlog
+		for i, key := range keys {
+			// the prefix of the segment also matches the segments with the longer ids
+			_, _, id, err := kc.parseBinlogKey(key, prefixIdx)
+			if err != nil {
+				return nil, err
+			}
+			if id != segmentID {
+				continue
+			}
+			fieldBinlog, err := unmarshalFieldBinlog([]byte(values[i]))
+			if err != nil {
+				return nil, err
+			}
+			fieldBinlogs = append(fieldBinlogs, fieldBinlog)
+		}
+		logs[binlogType] = map[typeutil.UniqueID][]*datapb.FieldBinlog{segmentID: fieldBinlogs}
+	}
+
+	err = kc.applyBinlogInfo([]*datapb.SegmentInfo{segment}, logs[storage.InsertBinlog], logs[storage.DeleteBinlog], logs[storage.StatsBinlog])
+	if err != nil {
+		return nil, err
+	}
+	return segment, nil
+}
+
 func (kc *Catalog) AddSegment(ctx context.Context, segment *datapb.SegmentInfo) error {
 	kvs, err := buildSegmentAndBinlogsKvs(segment)
 	if err != nil {
@@ -520,6 +599,100 @@ func (kc *Catalog) DropChannelCheckpoint(ctx context.Context, vChannel string) e
 	return kc.MetaKv.Remove(k)
 }
 
+func (kc *Catalog) ListGcSafeTs(ctx context.Context) (map[typeutil.UniqueID]typeutil.Timestamp, error) {
+	keys, values, err := kc.MetaKv.LoadWithPrefix(GcSafeTsPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	safeTs := make(map[typeutil.UniqueID]typeutil.Timestamp)
+	for i, key := range keys {
+		ss := strings.Split(key, "/")
+		collectionID, err := strconv.ParseInt(ss[len(ss)-1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ts, err := strconv.ParseUint(values[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		safeTs[collectionID] = ts
+	}
+	return safeTs, nil
+}
+
+func (kc *Catalog) SaveGcSafeTs(ctx context.Context, collectionID typeutil.UniqueID, ts typeutil.Timestamp) error {
+	k := buildGcSafeTsKey(collectionID)
+	return kc.MetaKv.Save(k, strconv.FormatUint(ts, 10))
+}
+
+func (kc *Catalog) DropGcSafeTs(ctx context.Context, collectionID typeutil.UniqueID) error {
+	k := buildGcSafeTsKey(collectionID)
+	return kc.MetaKv.Remove(k)
+}
+
+func (kc *Catalog) ListShippedSegments(ctx context.Context) ([]*datapb.ShippedSegment, error) {
+	segments := make([]*datapb.ShippedSegment, 0)
+	_, values, err := kc.MetaKv.LoadWithPrefix(ShippedSegmentPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		segment := &datapb.ShippedSegment{}
+		err = proto.Unmarshal([]byte(value), segment)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+func (kc *Catalog) SaveShippedSegment(ctx context.Context, segment *datapb.ShippedSegment) error {
+	key := buildShippedSegmentKey(segment.GetPrimarySegmentID())
+	value, err := proto.Marshal(segment)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(key, string(value))
+}
+
+func (kc *Catalog) DropShippedSegment(ctx context.Context, primarySegmentID typeutil.UniqueID) error {
+	key := buildShippedSegmentKey(primarySegmentID)
+	return kc.MetaKv.Remove(key)
+}
+
+func (kc *Catalog) ListSegmentReferences(ctx context.Context) ([]*datapb.SegmentReference, error) {
+	references := make([]*datapb.SegmentReference, 0)
+	_, values, err := kc.MetaKv.LoadWithPrefix(SegmentReferencePrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		reference := &datapb.SegmentReference{}
+		err = proto.Unmarshal([]byte(value), reference)
+		if err != nil {
+			return nil, err
+		}
+		references = append(references, reference)
+	}
+	return references, nil
+}
+
+func (kc *Catalog) SaveSegmentReference(ctx context.Context, reference *datapb.SegmentReference) error {
+	key := buildSegmentReferenceKey(reference.GetReferenceID())
+	value, err := proto.Marshal(reference)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(key, string(value))
+}
+
+func (kc *Catalog) DropSegmentReference(ctx context.Context, referenceID typeutil.UniqueID) error {
+	key := buildSegmentReferenceKey(referenceID)
+	return kc.MetaKv.Remove(key)
+}
+
 func (kc *Catalog) getBinlogsWithPrefix(binlogType storage.BinlogType, collectionID, partitionID,
 	segmentID typeutil.UniqueID,
 ) ([]string, []string, error) {
@@ -634,9 +807,18 @@ func (kc *Catalog) CreateSegmentIndex(ctx context.Context, segIdx *model.Segment
 }
 
 func (kc *Catalog) ListSegmentIndexes(ctx context.Context) ([]*model.SegmentIndex, error) {
-	_, values, err := kc.MetaKv.LoadWithPrefix(util.SegmentIndexPrefix)
+	return kc.listSegmentIndexes(util.SegmentIndexPrefix)
+}
+
+// ListCollectionSegmentIndexes lists the segment indexes of the collection only.
+func (kc *Catalog) ListCollectionSegmentIndexes(ctx context.Context, collectionID typeutil.UniqueID) ([]*model.SegmentIndex, error) {
+	return kc.listSegmentIndexes(fmt.Sprintf("%s/%d/", util.SegmentIndexPrefix, collectionID))
+}
+
+func (kc *Catalog) listSegmentIndexes(prefix string) ([]*model.SegmentIndex, error) {
+	_, values, err := kc.MetaKv.LoadWithPrefix(prefix)
 	if err != nil {
-		log.Error("list segment index meta fail", zap.String("prefix", util.SegmentIndexPrefix), zap.Error(err))
+		log.Error("list segment index meta fail", zap.String("prefix", prefix), zap.Error(err))
 		return nil, err
 	}
 
@@ -776,6 +958,68 @@ func (kc *Catalog) DropImportTask(taskID int64) error {
 	return kc.MetaKv.Remove(key)
 }
 
+func (kc *Catalog) SaveExportJob(job *datapb.ExportJob) error {
+	key := buildExportJobKey(job.GetJobID())
+	value, err := proto.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(key, string(value))
+}
+
+func (kc *Catalog) ListExportJobs() ([]*datapb.ExportJob, error) {
+	jobs := make([]*datapb.ExportJob, 0)
+	_, values, err := kc.MetaKv.LoadWithPrefix(ExportJobPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		job := &datapb.ExportJob{}
+		err = proto.Unmarshal([]byte(value), job)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+func (kc *Catalog) DropExportJob(jobID int64) error {
+	key := buildExportJobKey(jobID)
+	return kc.MetaKv.Remove(key)
+}
+
+func (kc *Catalog) SaveExportTask(task *datapb.ExportTask) error {
+	key := buildExportTaskKey(task.GetTaskID())
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(key, string(value))
+}
+
+func (kc *Catalog) ListExportTasks() ([]*datapb.ExportTask, error) {
+	tasks := make([]*datapb.ExportTask, 0)
+	_, values, err := kc.MetaKv.LoadWithPrefix(ExportTaskPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		task := &datapb.ExportTask{}
+		err = proto.Unmarshal([]byte(value), task)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func (kc *Catalog) DropExportTask(taskID int64) error {
+	key := buildExportTaskKey(taskID)
+	return kc.MetaKv.Remove(key)
+}
+
 // GcConfirm returns true if related collection/partition is not found.
 // DataCoord will remove all the meta eventually after GC is finished.
 func (kc *Catalog) GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool {
@@ -866,6 +1110,39 @@ func (kc *Catalog) DropAnalyzeTask(ctx context.Context, taskID typeutil.UniqueID
 	return kc.MetaKv.Remove(key)
 }
 
+func (kc *Catalog) ListStatsTasks(ctx context.Context) ([]*indexpb.StatsTask, error) {
+	tasks := make([]*indexpb.StatsTask, 0)
+
+	_, values, err := kc.MetaKv.LoadWithPrefix(StatsTaskPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		task := &indexpb.StatsTask{}
+		err = proto.Unmarshal([]byte(value), task)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func (kc *Catalog) SaveStatsTask(ctx context.Context, task *indexpb.StatsTask) error {
+	key := buildStatsTaskKey(task.TaskID)
+
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(key, string(value))
+}
+
+func (kc *Catalog) DropStatsTask(ctx context.Context, taskID typeutil.UniqueID) error {
+	key := buildStatsTaskKey(taskID)
+	return kc.MetaKv.Remove(key)
+}
+
 func (kc *Catalog) ListPartitionStatsInfos(ctx context.Context) ([]*datapb.PartitionStatsInfo, error) {
 	infos := make([]*datapb.PartitionStatsInfo, 0)
 
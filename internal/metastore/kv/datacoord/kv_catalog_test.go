@@ -40,8 +40,11 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/kv/predicates"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -166,6 +169,35 @@ var (
 	}
 )
 
+func verifySegments(t *testing.T, logID int64, ret []*datapb.SegmentInfo) {
+	assert.Equal(t, 1, len(ret))
+	segment := ret[0]
+	assert.Equal(t, segmentID, segment.ID)
+	assert.Equal(t, collectionID, segment.CollectionID)
+	assert.Equal(t, partitionID, segment.PartitionID)
+
+	assert.Equal(t, 1, len(segment.Binlogs))
+	assert.Equal(t, fieldID, segment.Binlogs[0].FieldID)
+	assert.Equal(t, 1, len(segment.Binlogs[0].Binlogs))
+	assert.Equal(t, logID, segment.Binlogs[0].Binlogs[0].LogID)
+	// set log path to empty and only store log id
+	assert.Equal(t, "", segment.Binlogs[0].Binlogs[0].LogPath)
+
+	assert.Equal(t, 1, len(segment.Deltalogs))
+	assert.Equal(t, fieldID, segment.Deltalogs[0].FieldID)
+	assert.Equal(t, 1, len(segment.Deltalogs[0].Binlogs))
+	assert.Equal(t, logID, segment.Deltalogs[0].Binlogs[0].LogID)
+	// set log path to empty and only store log id
+	assert.Equal(t, "", segment.Deltalogs[0].Binlogs[0].LogPath)
+
+	assert.Equal(t, 1, len(segment.Statslogs))
+	assert.Equal(t, fieldID, segment.Statslogs[0].FieldID)
+	assert.Equal(t, 1, len(segment.Statslogs[0].Binlogs))
+	assert.Equal(t, logID, segment.Statslogs[0].Binlogs[0].LogID)
+	// set log path to empty and only store log id
+	assert.Equal(t, "", segment.Statslogs[0].Binlogs[0].LogPath)
+}
+
 func Test_ListSegments(t *testing.T) {
 	t.Run("load failed", func(t *testing.T) {
 		metakv := mocks.NewMetaKv(t)
@@ -177,35 +209,6 @@ func Test_ListSegments(t *testing.T) {
 		assert.Error(t, err)
 	})
 
-	verifySegments := func(t *testing.T, logID int64, ret []*datapb.SegmentInfo) {
-		assert.Equal(t, 1, len(ret))
-		segment := ret[0]
-		assert.Equal(t, segmentID, segment.ID)
-		assert.Equal(t, collectionID, segment.CollectionID)
-		assert.Equal(t, partitionID, segment.PartitionID)
-
-		assert.Equal(t, 1, len(segment.Binlogs))
-		assert.Equal(t, fieldID, segment.Binlogs[0].FieldID)
-		assert.Equal(t, 1, len(segment.Binlogs[0].Binlogs))
-		assert.Equal(t, logID, segment.Binlogs[0].Binlogs[0].LogID)
-		// set log path to empty and only store log id
-		assert.Equal(t, "", segment.Binlogs[0].Binlogs[0].LogPath)
-
-		assert.Equal(t, 1, len(segment.Deltalogs))
-		assert.Equal(t, fieldID, segment.Deltalogs[0].FieldID)
-		assert.Equal(t, 1, len(segment.Deltalogs[0].Binlogs))
-		assert.Equal(t, logID, segment.Deltalogs[0].Binlogs[0].LogID)
-		// set log path to empty and only store log id
-		assert.Equal(t, "", segment.Deltalogs[0].Binlogs[0].LogPath)
-
-		assert.Equal(t, 1, len(segment.Statslogs))
-		assert.Equal(t, fieldID, segment.Statslogs[0].FieldID)
-		assert.Equal(t, 1, len(segment.Statslogs[0].Binlogs))
-		assert.Equal(t, logID, segment.Statslogs[0].Binlogs[0].LogID)
-		// set log path to empty and only store log id
-		assert.Equal(t, "", segment.Statslogs[0].Binlogs[0].LogPath)
-	}
-
 	t.Run("test compatibility", func(t *testing.T) {
 		segBytes, err := proto.Marshal(segment1)
 		assert.NoError(t, err)
@@ -265,6 +268,97 @@ func Test_ListSegments(t *testing.T) {
 	})
 }
 
+func Test_ListCollectionSegments(t *testing.T) {
+	var savedKvs map[string]string
+
+	metakv := mocks.NewMetaKv(t)
+	metakv.EXPECT().MultiSave(mock.Anything).RunAndReturn(func(m map[string]string) error {
+		savedKvs = m
+		return nil
+	})
+
+	catalog := NewCatalog(metakv, rootPath, "")
+	err := catalog.AddSegment(context.TODO(), segment1)
+	assert.NoError(t, err)
+
+	walked := make([]string, 0)
+	metakv.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(s string, i int, f func([]byte, []byte) error) error {
+		walked = append(walked, s)
+		for _, k := range []string{k1, k2, k3, k5} {
+			if strings.HasPrefix(k, s) {
+				return f([]byte(k), []byte(savedKvs[k]))
+			}
+		}
+		return nil
+	})
+
+	ret, err := catalog.ListCollectionSegments(context.TODO(), collectionID)
+	assert.NoError(t, err)
+	verifySegments(t, logID, ret)
+	// only the keys of the collection are walked
+	assert.ElementsMatch(t, []string{
+		fmt.Sprintf("%s/%d/", SegmentPrefix, collectionID),
+		fmt.Sprintf("%s/%d/", SegmentBinlogPathPrefix, collectionID),
+		fmt.Sprintf("%s/%d/", SegmentDeltalogPathPrefix, collectionID),
+		fmt.Sprintf("%s/%d/", SegmentStatslogPathPrefix, collectionID),
+	}, walked)
+
+	ret, err = catalog.ListCollectionSegments(context.TODO(), collectionID+1)
+	assert.NoError(t, err)
+	assert.Empty(t, ret)
+}
+
+func Test_GetSegment(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		metakv := mocks.NewMetaKv(t)
+		metakv.EXPECT().Load(k5).Return("", merr.WrapErrIoKeyNotFound(k5))
+
+		catalog := NewCatalog(metakv, rootPath, "")
+		segment, err := catalog.GetSegment(context.TODO(), collectionID, partitionID, segmentID)
+		assert.NoError(t, err)
+		assert.Nil(t, segment)
+	})
+
+	t.Run("load failed", func(t *testing.T) {
+		metakv := mocks.NewMetaKv(t)
+		metakv.EXPECT().Load(k5).Return("", errors.New("error"))
+
+		catalog := NewCatalog(metakv, rootPath, "")
+		_, err := catalog.GetSegment(context.TODO(), collectionID, partitionID, segmentID)
+		assert.Error(t, err)
+	})
+
+	t.Run("get successfully", func(t *testing.T) {
+		var savedKvs map[string]string
+
+		metakv := mocks.NewMetaKv(t)
+		metakv.EXPECT().MultiSave(mock.Anything).RunAndReturn(func(m map[string]string) error {
+			savedKvs = m
+			return nil
+		})
+
+		catalog := NewCatalog(metakv, rootPath, "")
+		err := catalog.AddSegment(context.TODO(), segment1)
+		assert.NoError(t, err)
+
+		metakv.EXPECT().Load(k5).Return(savedKvs[k5], nil)
+		metakv.EXPECT().LoadWithPrefix(mock.Anything).RunAndReturn(func(s string) ([]string, []string, error) {
+			for _, k := range []string{k1, k2, k3} {
+				if strings.HasPrefix(k, s) {
+					// the binlogs of the segment 10 share the prefix of the segment 1
+					other := fmt.Sprintf("%s0/%d", s, fieldID)
+					return []string{k, other}, []string{savedKvs[k], savedKvs[k1]}, nil
+				}
+			}
+			return nil, nil, errors.New("should not reach here")
+		})
+
+		segment, err := catalog.GetSegment(context.TODO(), collectionID, partitionID, segmentID)
+		assert.NoError(t, err)
+		verifySegments(t, logID, []*datapb.SegmentInfo{segment})
+	})
+}
+
 func Test_AddSegments(t *testing.T) {
 	t.Run("generate binlog kvs failed", func(t *testing.T) {
 		metakv := mocks.NewMetaKv(t)
@@ -741,6 +835,43 @@ func Test_ChannelExists_SaveError(t *testing.T) {
 	assert.False(t, catalog.ChannelExists(context.TODO(), "test_channel_1"))
 }
 
+func Test_ValidateSegmentWithVectorStats(t *testing.T) {
+	vectorStatslog := &datapb.FieldBinlog{
+		FieldID: 101,
+		Binlogs: []*datapb.Binlog{{LogID: int64(storage.VectorStatsType)}},
+	}
+	segment := &datapb.SegmentInfo{
+		ID:           segmentID,
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+		NumOfRows:    100,
+		State:        commonpb.SegmentState_Flushed,
+		Binlogs: []*datapb.FieldBinlog{{
+			FieldID: 1,
+			Binlogs: []*datapb.Binlog{{LogID: 10}, {LogID: 11}},
+		}},
+	}
+
+	// vector stats logs are skipped whatever the order is
+	segment.Statslogs = []*datapb.FieldBinlog{vectorStatslog, {
+		FieldID: 100,
+		Binlogs: []*datapb.Binlog{{LogID: 20}, {LogID: int64(storage.CompoundStatsType)}},
+	}}
+	assert.NoError(t, ValidateSegment(segment))
+
+	segment.Statslogs = []*datapb.FieldBinlog{{
+		FieldID: 100,
+		Binlogs: []*datapb.Binlog{{LogID: 20}, {LogID: 21}},
+	}, vectorStatslog}
+	assert.NoError(t, ValidateSegment(segment))
+
+	segment.Statslogs = []*datapb.FieldBinlog{vectorStatslog, {
+		FieldID: 100,
+		Binlogs: []*datapb.Binlog{{LogID: 20}},
+	}}
+	assert.Error(t, ValidateSegment(segment))
+}
+
 func Test_parseBinlogKey(t *testing.T) {
 	catalog := NewCatalog(nil, "", "")
 
@@ -1104,6 +1235,29 @@ func TestCatalog_ListSegmentIndexes(t *testing.T) {
 	})
 }
 
+func TestCatalog_ListCollectionSegmentIndexes(t *testing.T) {
+	segIdx := &indexpb.SegmentIndex{
+		CollectionID: 1,
+		PartitionID:  2,
+		SegmentID:    3,
+		BuildID:      4,
+	}
+	v, err := proto.Marshal(segIdx)
+	assert.NoError(t, err)
+
+	metakv := mocks.NewMetaKv(t)
+	metakv.EXPECT().LoadWithPrefix(fmt.Sprintf("%s/%d/", util.SegmentIndexPrefix, 1)).
+		Return([]string{BuildSegmentIndexKey(1, 2, 3, 4)}, []string{string(v)}, nil)
+	catalog := &Catalog{
+		MetaKv: metakv,
+	}
+
+	segIdxes, err := catalog.ListCollectionSegmentIndexes(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(segIdxes))
+	assert.Equal(t, int64(4), segIdxes[0].BuildID)
+}
+
 func TestCatalog_AlterSegmentIndexes(t *testing.T) {
 	segIdx := &model.SegmentIndex{
 		SegmentID:     0,
@@ -1518,3 +1672,247 @@ func TestCatalog_Import(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestCatalog_Export(t *testing.T) {
+	kc := &Catalog{}
+	mockErr := errors.New("mock error")
+
+	job := &datapb.ExportJob{
+		JobID: 0,
+	}
+	task := &datapb.ExportTask{
+		JobID:  0,
+		TaskID: 1,
+	}
+
+	t.Run("SaveExportJob", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+		kc.MetaKv = txn
+		err := kc.SaveExportJob(job)
+		assert.NoError(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+		err = kc.SaveExportJob(job)
+		assert.Error(t, err)
+	})
+
+	t.Run("ListExportJobs", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		value, err := proto.Marshal(job)
+		assert.NoError(t, err)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, []string{string(value)}, nil)
+		kc.MetaKv = txn
+		jobs, err := kc.ListExportJobs()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobs))
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, []string{"@#%#^#"}, nil)
+		kc.MetaKv = txn
+		_, err = kc.ListExportJobs()
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, mockErr)
+		kc.MetaKv = txn
+		_, err = kc.ListExportJobs()
+		assert.Error(t, err)
+	})
+
+	t.Run("DropExportJob", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(buildExportJobKey(job.GetJobID())).Return(nil)
+		kc.MetaKv = txn
+		err := kc.DropExportJob(job.GetJobID())
+		assert.NoError(t, err)
+	})
+
+	t.Run("SaveExportTask", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(buildExportTaskKey(task.GetTaskID()), mock.Anything).Return(nil)
+		kc.MetaKv = txn
+		err := kc.SaveExportTask(task)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListExportTasks", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		value, err := proto.Marshal(task)
+		assert.NoError(t, err)
+		txn.EXPECT().LoadWithPrefix(ExportTaskPrefix).Return(nil, []string{string(value)}, nil)
+		kc.MetaKv = txn
+		tasks, err := kc.ListExportTasks()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(tasks))
+		assert.Equal(t, task.GetTaskID(), tasks[0].GetTaskID())
+	})
+
+	t.Run("DropExportTask", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+		err := kc.DropExportTask(task.GetTaskID())
+		assert.Error(t, err)
+	})
+}
+
+func TestCatalog_GcSafeTs(t *testing.T) {
+	kc := &Catalog{}
+	mockErr := errors.New("mock error")
+
+	t.Run("SaveGcSafeTs", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(buildGcSafeTsKey(100), "1000").Return(nil)
+		kc.MetaKv = txn
+		err := kc.SaveGcSafeTs(context.TODO(), 100, 1000)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListGcSafeTs", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(GcSafeTsPrefix).Return([]string{buildGcSafeTsKey(100)}, []string{"1000"}, nil)
+		kc.MetaKv = txn
+		safeTs, err := kc.ListGcSafeTs(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64]uint64{100: 1000}, safeTs)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(GcSafeTsPrefix).Return([]string{buildGcSafeTsKey(100)}, []string{"ts"}, nil)
+		kc.MetaKv = txn
+		_, err = kc.ListGcSafeTs(context.TODO())
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(GcSafeTsPrefix).Return(nil, nil, mockErr)
+		kc.MetaKv = txn
+		_, err = kc.ListGcSafeTs(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("DropGcSafeTs", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(buildGcSafeTsKey(100)).Return(nil)
+		kc.MetaKv = txn
+		err := kc.DropGcSafeTs(context.TODO(), 100)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCatalog_ShippedSegment(t *testing.T) {
+	kc := &Catalog{}
+	mockErr := errors.New("mock error")
+
+	segment := &datapb.ShippedSegment{
+		PrimarySegmentID: 100,
+		SegmentID:        200,
+		CollectionID:     1,
+	}
+
+	t.Run("SaveShippedSegment", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(buildShippedSegmentKey(100), mock.Anything).Return(nil)
+		kc.MetaKv = txn
+		err := kc.SaveShippedSegment(context.TODO(), segment)
+		assert.NoError(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+		err = kc.SaveShippedSegment(context.TODO(), segment)
+		assert.Error(t, err)
+	})
+
+	t.Run("ListShippedSegments", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		value, err := proto.Marshal(segment)
+		assert.NoError(t, err)
+		txn.EXPECT().LoadWithPrefix(ShippedSegmentPrefix).Return(nil, []string{string(value)}, nil)
+		kc.MetaKv = txn
+		segments, err := kc.ListShippedSegments(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(segments))
+		assert.Equal(t, int64(200), segments[0].GetSegmentID())
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(ShippedSegmentPrefix).Return(nil, []string{"@#%#^#"}, nil)
+		kc.MetaKv = txn
+		_, err = kc.ListShippedSegments(context.TODO())
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(ShippedSegmentPrefix).Return(nil, nil, mockErr)
+		kc.MetaKv = txn
+		_, err = kc.ListShippedSegments(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("DropShippedSegment", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(buildShippedSegmentKey(100)).Return(nil)
+		kc.MetaKv = txn
+		err := kc.DropShippedSegment(context.TODO(), 100)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCatalog_SegmentReference(t *testing.T) {
+	kc := &Catalog{}
+	mockErr := errors.New("mock error")
+
+	reference := &datapb.SegmentReference{
+		ReferenceID:  100,
+		CollectionID: 1,
+		SegmentIDs:   []int64{200, 201},
+		Holder:       "backup",
+		ExpireTime:   1000,
+	}
+
+	t.Run("SaveSegmentReference", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(buildSegmentReferenceKey(100), mock.Anything).Return(nil)
+		kc.MetaKv = txn
+		err := kc.SaveSegmentReference(context.TODO(), reference)
+		assert.NoError(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, mock.Anything).Return(mockErr)
+		kc.MetaKv = txn
+		err = kc.SaveSegmentReference(context.TODO(), reference)
+		assert.Error(t, err)
+	})
+
+	t.Run("ListSegmentReferences", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		value, err := proto.Marshal(reference)
+		assert.NoError(t, err)
+		txn.EXPECT().LoadWithPrefix(SegmentReferencePrefix).Return(nil, []string{string(value)}, nil)
+		kc.MetaKv = txn
+		references, err := kc.ListSegmentReferences(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(references))
+		assert.ElementsMatch(t, []int64{200, 201}, references[0].GetSegmentIDs())
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(SegmentReferencePrefix).Return(nil, []string{"@#%#^#"}, nil)
+		kc.MetaKv = txn
+		_, err = kc.ListSegmentReferences(context.TODO())
+		assert.Error(t, err)
+
+		txn = mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(SegmentReferencePrefix).Return(nil, nil, mockErr)
+		kc.MetaKv = txn
+		_, err = kc.ListSegmentReferences(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("DropSegmentReference", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(buildSegmentReferenceKey(100)).Return(nil)
+		kc.MetaKv = txn
+		err := kc.DropSegmentReference(context.TODO(), 100)
+		assert.NoError(t, err)
+	})
+}
@@ -68,9 +68,10 @@ func ValidateSegment(segment *datapb.SegmentInfo) error {
 	// if segment not merge status log(growing or new flushed by old version)
 	// segment num of binlog should same with statslogs.
 	binlogNum := len(segment.GetBinlogs()[0].GetBinlogs())
-	statslogNum := len(segment.GetStatslogs()[0].GetBinlogs())
+	pkStatslog := getPkStatslog(segment)
+	statslogNum := len(pkStatslog.GetBinlogs())
 
-	if len(segment.GetCompactionFrom()) == 0 && statslogNum != binlogNum && !hasSpecialStatslog(segment) {
+	if len(segment.GetCompactionFrom()) == 0 && statslogNum != binlogNum && !hasSpecialStatslog(pkStatslog) {
 		log.Warn("find invalid segment while bin log size didn't match stat log size",
 			zap.Any("binlogs", segment.GetBinlogs()),
 			zap.Any("stats", segment.GetStatslogs()),
@@ -82,8 +83,25 @@ func ValidateSegment(segment *datapb.SegmentInfo) error {
 	return nil
 }
 
-func hasSpecialStatslog(segment *datapb.SegmentInfo) bool {
-	for _, statslog := range segment.GetStatslogs()[0].GetBinlogs() {
+// getPkStatslog returns the stats logs of pk field, which are the first ones except vector stats logs.
+func getPkStatslog(segment *datapb.SegmentInfo) *datapb.FieldBinlog {
+	for _, fieldBinlog := range segment.GetStatslogs() {
+		isVectorStats := len(fieldBinlog.GetBinlogs()) > 0
+		for _, statslog := range fieldBinlog.GetBinlogs() {
+			if fmt.Sprint(statslog.LogID) != storage.VectorStatsType.LogIdx() {
+				isVectorStats = false
+				break
+			}
+		}
+		if !isVectorStats {
+			return fieldBinlog
+		}
+	}
+	return segment.GetStatslogs()[0]
+}
+
+func hasSpecialStatslog(pkStatslog *datapb.FieldBinlog) bool {
+	for _, statslog := range pkStatslog.GetBinlogs() {
 		logidx := fmt.Sprint(statslog.LogID)
 		if logidx == storage.CompoundStatsType.LogIdx() {
 			return true
@@ -319,6 +337,18 @@ func buildChannelCPKey(vChannel string) string {
 	return fmt.Sprintf("%s/%s", ChannelCheckpointPrefix, vChannel)
 }
 
+func buildGcSafeTsKey(collectionID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", GcSafeTsPrefix, collectionID)
+}
+
+func buildShippedSegmentKey(primarySegmentID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", ShippedSegmentPrefix, primarySegmentID)
+}
+
+func buildSegmentReferenceKey(referenceID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", SegmentReferencePrefix, referenceID)
+}
+
 func BuildIndexKey(collectionID, indexID int64) string {
 	return fmt.Sprintf("%s/%d/%d", util.FieldIndexPrefix, collectionID, indexID)
 }
@@ -347,6 +377,18 @@ func buildPreImportTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", PreImportTaskPrefix, taskID)
 }
 
+func buildExportJobKey(jobID int64) string {
+	return fmt.Sprintf("%s/%d", ExportJobPrefix, jobID)
+}
+
+func buildExportTaskKey(taskID int64) string {
+	return fmt.Sprintf("%s/%d", ExportTaskPrefix, taskID)
+}
+
 func buildAnalyzeTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", AnalyzeTaskPrefix, taskID)
 }
+
+func buildStatsTaskKey(taskID int64) string {
+	return fmt.Sprintf("%s/%d", StatsTaskPrefix, taskID)
+}
@@ -503,6 +503,7 @@ func (kc *Catalog) alterModifyPartition(oldPart *model.Partition, newPart *model
 	oldPartClone.PartitionName = newPartClone.PartitionName
 	oldPartClone.PartitionCreatedTimestamp = newPartClone.PartitionCreatedTimestamp
 	oldPartClone.State = newPartClone.State
+	oldPartClone.KeyBuckets = newPartClone.KeyBuckets
 	key := BuildPartitionKey(oldPart.CollectionID, oldPart.PartitionID)
 	value, err := proto.Marshal(model.MarshalPartitionModel(oldPartClone))
 	if err != nil {
@@ -563,6 +563,133 @@ func (_c *DataCoordCatalog_DropCurrentPartitionStatsVersion_Call) RunAndReturn(r
 	return _c
 }
 
+// DropExportJob provides a mock function with given fields: jobID
+func (_m *DataCoordCatalog) DropExportJob(jobID int64) error {
+	ret := _m.Called(jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropExportJob'
+type DataCoordCatalog_DropExportJob_Call struct {
+	*mock.Call
+}
+
+// DropExportJob is a helper method to define mock.On call
+//   - jobID int64
+func (_e *DataCoordCatalog_Expecter) DropExportJob(jobID interface{}) *DataCoordCatalog_DropExportJob_Call {
+	return &DataCoordCatalog_DropExportJob_Call{Call: _e.mock.On("DropExportJob", jobID)}
+}
+
+func (_c *DataCoordCatalog_DropExportJob_Call) Run(run func(jobID int64)) *DataCoordCatalog_DropExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropExportJob_Call) Return(_a0 error) *DataCoordCatalog_DropExportJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropExportJob_Call) RunAndReturn(run func(int64) error) *DataCoordCatalog_DropExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropExportTask provides a mock function with given fields: taskID
+func (_m *DataCoordCatalog) DropExportTask(taskID int64) error {
+	ret := _m.Called(taskID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropExportTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropExportTask'
+type DataCoordCatalog_DropExportTask_Call struct {
+	*mock.Call
+}
+
+// DropExportTask is a helper method to define mock.On call
+//   - taskID int64
+func (_e *DataCoordCatalog_Expecter) DropExportTask(taskID interface{}) *DataCoordCatalog_DropExportTask_Call {
+	return &DataCoordCatalog_DropExportTask_Call{Call: _e.mock.On("DropExportTask", taskID)}
+}
+
+func (_c *DataCoordCatalog_DropExportTask_Call) Run(run func(taskID int64)) *DataCoordCatalog_DropExportTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropExportTask_Call) Return(_a0 error) *DataCoordCatalog_DropExportTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropExportTask_Call) RunAndReturn(run func(int64) error) *DataCoordCatalog_DropExportTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropGcSafeTs provides a mock function with given fields: ctx, collectionID
+func (_m *DataCoordCatalog) DropGcSafeTs(ctx context.Context, collectionID int64) error {
+	ret := _m.Called(ctx, collectionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropGcSafeTs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropGcSafeTs'
+type DataCoordCatalog_DropGcSafeTs_Call struct {
+	*mock.Call
+}
+
+// DropGcSafeTs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *DataCoordCatalog_Expecter) DropGcSafeTs(ctx interface{}, collectionID interface{}) *DataCoordCatalog_DropGcSafeTs_Call {
+	return &DataCoordCatalog_DropGcSafeTs_Call{Call: _e.mock.On("DropGcSafeTs", ctx, collectionID)}
+}
+
+func (_c *DataCoordCatalog_DropGcSafeTs_Call) Run(run func(ctx context.Context, collectionID int64)) *DataCoordCatalog_DropGcSafeTs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropGcSafeTs_Call) Return(_a0 error) *DataCoordCatalog_DropGcSafeTs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropGcSafeTs_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropGcSafeTs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropImportJob provides a mock function with given fields: jobID
 func (_m *DataCoordCatalog) DropImportJob(jobID int64) error {
 	ret := _m.Called(jobID)
@@ -865,6 +992,135 @@ func (_c *DataCoordCatalog_DropSegmentIndex_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// DropSegmentReference provides a mock function with given fields: ctx, referenceID
+func (_m *DataCoordCatalog) DropSegmentReference(ctx context.Context, referenceID int64) error {
+	ret := _m.Called(ctx, referenceID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, referenceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropSegmentReference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSegmentReference'
+type DataCoordCatalog_DropSegmentReference_Call struct {
+	*mock.Call
+}
+
+// DropSegmentReference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - referenceID int64
+func (_e *DataCoordCatalog_Expecter) DropSegmentReference(ctx interface{}, referenceID interface{}) *DataCoordCatalog_DropSegmentReference_Call {
+	return &DataCoordCatalog_DropSegmentReference_Call{Call: _e.mock.On("DropSegmentReference", ctx, referenceID)}
+}
+
+func (_c *DataCoordCatalog_DropSegmentReference_Call) Run(run func(ctx context.Context, referenceID int64)) *DataCoordCatalog_DropSegmentReference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropSegmentReference_Call) Return(_a0 error) *DataCoordCatalog_DropSegmentReference_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropSegmentReference_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropSegmentReference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropShippedSegment provides a mock function with given fields: ctx, primarySegmentID
+func (_m *DataCoordCatalog) DropShippedSegment(ctx context.Context, primarySegmentID int64) error {
+	ret := _m.Called(ctx, primarySegmentID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, primarySegmentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropShippedSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropShippedSegment'
+type DataCoordCatalog_DropShippedSegment_Call struct {
+	*mock.Call
+}
+
+// DropShippedSegment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - primarySegmentID int64
+func (_e *DataCoordCatalog_Expecter) DropShippedSegment(ctx interface{}, primarySegmentID interface{}) *DataCoordCatalog_DropShippedSegment_Call {
+	return &DataCoordCatalog_DropShippedSegment_Call{Call: _e.mock.On("DropShippedSegment", ctx, primarySegmentID)}
+}
+
+func (_c *DataCoordCatalog_DropShippedSegment_Call) Run(run func(ctx context.Context, primarySegmentID int64)) *DataCoordCatalog_DropShippedSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropShippedSegment_Call) Return(_a0 error) *DataCoordCatalog_DropShippedSegment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropShippedSegment_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropShippedSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropStatsTask provides a mock function with given fields: ctx, taskID
+func (_m *DataCoordCatalog) DropStatsTask(ctx context.Context, taskID int64) error {
+	ret := _m.Called(ctx, taskID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropStatsTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropStatsTask'
+type DataCoordCatalog_DropStatsTask_Call struct {
+	*mock.Call
+}
+
+// DropStatsTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - taskID int64
+func (_e *DataCoordCatalog_Expecter) DropStatsTask(ctx interface{}, taskID interface{}) *DataCoordCatalog_DropStatsTask_Call {
+	return &DataCoordCatalog_DropStatsTask_Call{Call: _e.mock.On("DropStatsTask", ctx, taskID)}
+}
+
+func (_c *DataCoordCatalog_DropStatsTask_Call) Run(run func(ctx context.Context, taskID int64)) *DataCoordCatalog_DropStatsTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropStatsTask_Call) Return(_a0 error) *DataCoordCatalog_DropStatsTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropStatsTask_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropStatsTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GcConfirm provides a mock function with given fields: ctx, collectionID, partitionID
 func (_m *DataCoordCatalog) GcConfirm(ctx context.Context, collectionID int64, partitionID int64) bool {
 	ret := _m.Called(ctx, collectionID, partitionID)
@@ -964,6 +1220,63 @@ func (_c *DataCoordCatalog_GetCurrentPartitionStatsVersion_Call) RunAndReturn(ru
 	return _c
 }
 
+// GetSegment provides a mock function with given fields: ctx, collectionID, partitionID, segmentID
+func (_m *DataCoordCatalog) GetSegment(ctx context.Context, collectionID int64, partitionID int64, segmentID int64) (*datapb.SegmentInfo, error) {
+	ret := _m.Called(ctx, collectionID, partitionID, segmentID)
+
+	var r0 *datapb.SegmentInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (*datapb.SegmentInfo, error)); ok {
+		return rf(ctx, collectionID, partitionID, segmentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) *datapb.SegmentInfo); ok {
+		r0 = rf(ctx, collectionID, partitionID, segmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.SegmentInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(ctx, collectionID, partitionID, segmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_GetSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegment'
+type DataCoordCatalog_GetSegment_Call struct {
+	*mock.Call
+}
+
+// GetSegment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - partitionID int64
+//   - segmentID int64
+func (_e *DataCoordCatalog_Expecter) GetSegment(ctx interface{}, collectionID interface{}, partitionID interface{}, segmentID interface{}) *DataCoordCatalog_GetSegment_Call {
+	return &DataCoordCatalog_GetSegment_Call{Call: _e.mock.On("GetSegment", ctx, collectionID, partitionID, segmentID)}
+}
+
+func (_c *DataCoordCatalog_GetSegment_Call) Run(run func(ctx context.Context, collectionID int64, partitionID int64, segmentID int64)) *DataCoordCatalog_GetSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_GetSegment_Call) Return(_a0 *datapb.SegmentInfo, _a1 error) *DataCoordCatalog_GetSegment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_GetSegment_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (*datapb.SegmentInfo, error)) *DataCoordCatalog_GetSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAnalyzeTasks provides a mock function with given fields: ctx
 func (_m *DataCoordCatalog) ListAnalyzeTasks(ctx context.Context) ([]*indexpb.AnalyzeTask, error) {
 	ret := _m.Called(ctx)
@@ -1072,25 +1385,25 @@ func (_c *DataCoordCatalog_ListChannelCheckpoint_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// ListCompactionTask provides a mock function with given fields: ctx
-func (_m *DataCoordCatalog) ListCompactionTask(ctx context.Context) ([]*datapb.CompactionTask, error) {
-	ret := _m.Called(ctx)
+// ListCollectionSegmentIndexes provides a mock function with given fields: ctx, collectionID
+func (_m *DataCoordCatalog) ListCollectionSegmentIndexes(ctx context.Context, collectionID int64) ([]*model.SegmentIndex, error) {
+	ret := _m.Called(ctx, collectionID)
 
-	var r0 []*datapb.CompactionTask
+	var r0 []*model.SegmentIndex
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.CompactionTask, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.SegmentIndex, error)); ok {
+		return rf(ctx, collectionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.CompactionTask); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.SegmentIndex); ok {
+		r0 = rf(ctx, collectionID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*datapb.CompactionTask)
+			r0 = ret.Get(0).([]*model.SegmentIndex)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, collectionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1098,44 +1411,314 @@ func (_m *DataCoordCatalog) ListCompactionTask(ctx context.Context) ([]*datapb.C
 	return r0, r1
 }
 
-// DataCoordCatalog_ListCompactionTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCompactionTask'
-type DataCoordCatalog_ListCompactionTask_Call struct {
+// DataCoordCatalog_ListCollectionSegmentIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollectionSegmentIndexes'
+type DataCoordCatalog_ListCollectionSegmentIndexes_Call struct {
 	*mock.Call
 }
 
-// ListCompactionTask is a helper method to define mock.On call
+// ListCollectionSegmentIndexes is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *DataCoordCatalog_Expecter) ListCompactionTask(ctx interface{}) *DataCoordCatalog_ListCompactionTask_Call {
-	return &DataCoordCatalog_ListCompactionTask_Call{Call: _e.mock.On("ListCompactionTask", ctx)}
+//   - collectionID int64
+func (_e *DataCoordCatalog_Expecter) ListCollectionSegmentIndexes(ctx interface{}, collectionID interface{}) *DataCoordCatalog_ListCollectionSegmentIndexes_Call {
+	return &DataCoordCatalog_ListCollectionSegmentIndexes_Call{Call: _e.mock.On("ListCollectionSegmentIndexes", ctx, collectionID)}
 }
 
-func (_c *DataCoordCatalog_ListCompactionTask_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListCompactionTask_Call {
+func (_c *DataCoordCatalog_ListCollectionSegmentIndexes_Call) Run(run func(ctx context.Context, collectionID int64)) *DataCoordCatalog_ListCollectionSegmentIndexes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *DataCoordCatalog_ListCompactionTask_Call) Return(_a0 []*datapb.CompactionTask, _a1 error) *DataCoordCatalog_ListCompactionTask_Call {
+func (_c *DataCoordCatalog_ListCollectionSegmentIndexes_Call) Return(_a0 []*model.SegmentIndex, _a1 error) *DataCoordCatalog_ListCollectionSegmentIndexes_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DataCoordCatalog_ListCompactionTask_Call) RunAndReturn(run func(context.Context) ([]*datapb.CompactionTask, error)) *DataCoordCatalog_ListCompactionTask_Call {
+func (_c *DataCoordCatalog_ListCollectionSegmentIndexes_Call) RunAndReturn(run func(context.Context, int64) ([]*model.SegmentIndex, error)) *DataCoordCatalog_ListCollectionSegmentIndexes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListImportJobs provides a mock function with given fields:
-func (_m *DataCoordCatalog) ListImportJobs() ([]*datapb.ImportJob, error) {
-	ret := _m.Called()
+// ListCollectionSegments provides a mock function with given fields: ctx, collectionID
+func (_m *DataCoordCatalog) ListCollectionSegments(ctx context.Context, collectionID int64) ([]*datapb.SegmentInfo, error) {
+	ret := _m.Called(ctx, collectionID)
 
-	var r0 []*datapb.ImportJob
+	var r0 []*datapb.SegmentInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*datapb.ImportJob, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*datapb.SegmentInfo, error)); ok {
+		return rf(ctx, collectionID)
 	}
-	if rf, ok := ret.Get(0).(func() []*datapb.ImportJob); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*datapb.SegmentInfo); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.SegmentInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListCollectionSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollectionSegments'
+type DataCoordCatalog_ListCollectionSegments_Call struct {
+	*mock.Call
+}
+
+// ListCollectionSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *DataCoordCatalog_Expecter) ListCollectionSegments(ctx interface{}, collectionID interface{}) *DataCoordCatalog_ListCollectionSegments_Call {
+	return &DataCoordCatalog_ListCollectionSegments_Call{Call: _e.mock.On("ListCollectionSegments", ctx, collectionID)}
+}
+
+func (_c *DataCoordCatalog_ListCollectionSegments_Call) Run(run func(ctx context.Context, collectionID int64)) *DataCoordCatalog_ListCollectionSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListCollectionSegments_Call) Return(_a0 []*datapb.SegmentInfo, _a1 error) *DataCoordCatalog_ListCollectionSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListCollectionSegments_Call) RunAndReturn(run func(context.Context, int64) ([]*datapb.SegmentInfo, error)) *DataCoordCatalog_ListCollectionSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCompactionTask provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListCompactionTask(ctx context.Context) ([]*datapb.CompactionTask, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*datapb.CompactionTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.CompactionTask, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.CompactionTask); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.CompactionTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListCompactionTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCompactionTask'
+type DataCoordCatalog_ListCompactionTask_Call struct {
+	*mock.Call
+}
+
+// ListCompactionTask is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListCompactionTask(ctx interface{}) *DataCoordCatalog_ListCompactionTask_Call {
+	return &DataCoordCatalog_ListCompactionTask_Call{Call: _e.mock.On("ListCompactionTask", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListCompactionTask_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListCompactionTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListCompactionTask_Call) Return(_a0 []*datapb.CompactionTask, _a1 error) *DataCoordCatalog_ListCompactionTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListCompactionTask_Call) RunAndReturn(run func(context.Context) ([]*datapb.CompactionTask, error)) *DataCoordCatalog_ListCompactionTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExportJobs provides a mock function with given fields:
+func (_m *DataCoordCatalog) ListExportJobs() ([]*datapb.ExportJob, error) {
+	ret := _m.Called()
+
+	var r0 []*datapb.ExportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*datapb.ExportJob, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*datapb.ExportJob); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.ExportJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListExportJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportJobs'
+type DataCoordCatalog_ListExportJobs_Call struct {
+	*mock.Call
+}
+
+// ListExportJobs is a helper method to define mock.On call
+func (_e *DataCoordCatalog_Expecter) ListExportJobs() *DataCoordCatalog_ListExportJobs_Call {
+	return &DataCoordCatalog_ListExportJobs_Call{Call: _e.mock.On("ListExportJobs")}
+}
+
+func (_c *DataCoordCatalog_ListExportJobs_Call) Run(run func()) *DataCoordCatalog_ListExportJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListExportJobs_Call) Return(_a0 []*datapb.ExportJob, _a1 error) *DataCoordCatalog_ListExportJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListExportJobs_Call) RunAndReturn(run func() ([]*datapb.ExportJob, error)) *DataCoordCatalog_ListExportJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExportTasks provides a mock function with given fields:
+func (_m *DataCoordCatalog) ListExportTasks() ([]*datapb.ExportTask, error) {
+	ret := _m.Called()
+
+	var r0 []*datapb.ExportTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*datapb.ExportTask, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*datapb.ExportTask); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.ExportTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListExportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportTasks'
+type DataCoordCatalog_ListExportTasks_Call struct {
+	*mock.Call
+}
+
+// ListExportTasks is a helper method to define mock.On call
+func (_e *DataCoordCatalog_Expecter) ListExportTasks() *DataCoordCatalog_ListExportTasks_Call {
+	return &DataCoordCatalog_ListExportTasks_Call{Call: _e.mock.On("ListExportTasks")}
+}
+
+func (_c *DataCoordCatalog_ListExportTasks_Call) Run(run func()) *DataCoordCatalog_ListExportTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListExportTasks_Call) Return(_a0 []*datapb.ExportTask, _a1 error) *DataCoordCatalog_ListExportTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListExportTasks_Call) RunAndReturn(run func() ([]*datapb.ExportTask, error)) *DataCoordCatalog_ListExportTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGcSafeTs provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListGcSafeTs(ctx context.Context) (map[int64]uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 map[int64]uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[int64]uint64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[int64]uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListGcSafeTs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGcSafeTs'
+type DataCoordCatalog_ListGcSafeTs_Call struct {
+	*mock.Call
+}
+
+// ListGcSafeTs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListGcSafeTs(ctx interface{}) *DataCoordCatalog_ListGcSafeTs_Call {
+	return &DataCoordCatalog_ListGcSafeTs_Call{Call: _e.mock.On("ListGcSafeTs", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListGcSafeTs_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListGcSafeTs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListGcSafeTs_Call) Return(_a0 map[int64]uint64, _a1 error) *DataCoordCatalog_ListGcSafeTs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListGcSafeTs_Call) RunAndReturn(run func(context.Context) (map[int64]uint64, error)) *DataCoordCatalog_ListGcSafeTs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListImportJobs provides a mock function with given fields:
+func (_m *DataCoordCatalog) ListImportJobs() ([]*datapb.ImportJob, error) {
+	ret := _m.Called()
+
+	var r0 []*datapb.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*datapb.ImportJob, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*datapb.ImportJob); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
@@ -1290,16 +1873,177 @@ func (_c *DataCoordCatalog_ListIndexes_Call) RunAndReturn(run func(context.Conte
 func (_m *DataCoordCatalog) ListPartitionStatsInfos(ctx context.Context) ([]*datapb.PartitionStatsInfo, error) {
 	ret := _m.Called(ctx)
 
-	var r0 []*datapb.PartitionStatsInfo
+	var r0 []*datapb.PartitionStatsInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.PartitionStatsInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.PartitionStatsInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.PartitionStatsInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListPartitionStatsInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPartitionStatsInfos'
+type DataCoordCatalog_ListPartitionStatsInfos_Call struct {
+	*mock.Call
+}
+
+// ListPartitionStatsInfos is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListPartitionStatsInfos(ctx interface{}) *DataCoordCatalog_ListPartitionStatsInfos_Call {
+	return &DataCoordCatalog_ListPartitionStatsInfos_Call{Call: _e.mock.On("ListPartitionStatsInfos", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListPartitionStatsInfos_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListPartitionStatsInfos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListPartitionStatsInfos_Call) Return(_a0 []*datapb.PartitionStatsInfo, _a1 error) *DataCoordCatalog_ListPartitionStatsInfos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListPartitionStatsInfos_Call) RunAndReturn(run func(context.Context) ([]*datapb.PartitionStatsInfo, error)) *DataCoordCatalog_ListPartitionStatsInfos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPreImportTasks provides a mock function with given fields:
+func (_m *DataCoordCatalog) ListPreImportTasks() ([]*datapb.PreImportTask, error) {
+	ret := _m.Called()
+
+	var r0 []*datapb.PreImportTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*datapb.PreImportTask, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*datapb.PreImportTask); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.PreImportTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListPreImportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPreImportTasks'
+type DataCoordCatalog_ListPreImportTasks_Call struct {
+	*mock.Call
+}
+
+// ListPreImportTasks is a helper method to define mock.On call
+func (_e *DataCoordCatalog_Expecter) ListPreImportTasks() *DataCoordCatalog_ListPreImportTasks_Call {
+	return &DataCoordCatalog_ListPreImportTasks_Call{Call: _e.mock.On("ListPreImportTasks")}
+}
+
+func (_c *DataCoordCatalog_ListPreImportTasks_Call) Run(run func()) *DataCoordCatalog_ListPreImportTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListPreImportTasks_Call) Return(_a0 []*datapb.PreImportTask, _a1 error) *DataCoordCatalog_ListPreImportTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListPreImportTasks_Call) RunAndReturn(run func() ([]*datapb.PreImportTask, error)) *DataCoordCatalog_ListPreImportTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSegmentIndexes provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListSegmentIndexes(ctx context.Context) ([]*model.SegmentIndex, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.SegmentIndex
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.SegmentIndex, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.SegmentIndex); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SegmentIndex)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListSegmentIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSegmentIndexes'
+type DataCoordCatalog_ListSegmentIndexes_Call struct {
+	*mock.Call
+}
+
+// ListSegmentIndexes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListSegmentIndexes(ctx interface{}) *DataCoordCatalog_ListSegmentIndexes_Call {
+	return &DataCoordCatalog_ListSegmentIndexes_Call{Call: _e.mock.On("ListSegmentIndexes", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListSegmentIndexes_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListSegmentIndexes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListSegmentIndexes_Call) Return(_a0 []*model.SegmentIndex, _a1 error) *DataCoordCatalog_ListSegmentIndexes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListSegmentIndexes_Call) RunAndReturn(run func(context.Context) ([]*model.SegmentIndex, error)) *DataCoordCatalog_ListSegmentIndexes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSegmentReferences provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListSegmentReferences(ctx context.Context) ([]*datapb.SegmentReference, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*datapb.SegmentReference
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.PartitionStatsInfo, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.SegmentReference, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.PartitionStatsInfo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.SegmentReference); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*datapb.PartitionStatsInfo)
+			r0 = ret.Get(0).([]*datapb.SegmentReference)
 		}
 	}
 
@@ -1312,53 +2056,53 @@ func (_m *DataCoordCatalog) ListPartitionStatsInfos(ctx context.Context) ([]*dat
 	return r0, r1
 }
 
-// DataCoordCatalog_ListPartitionStatsInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPartitionStatsInfos'
-type DataCoordCatalog_ListPartitionStatsInfos_Call struct {
+// DataCoordCatalog_ListSegmentReferences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSegmentReferences'
+type DataCoordCatalog_ListSegmentReferences_Call struct {
 	*mock.Call
 }
 
-// ListPartitionStatsInfos is a helper method to define mock.On call
+// ListSegmentReferences is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *DataCoordCatalog_Expecter) ListPartitionStatsInfos(ctx interface{}) *DataCoordCatalog_ListPartitionStatsInfos_Call {
-	return &DataCoordCatalog_ListPartitionStatsInfos_Call{Call: _e.mock.On("ListPartitionStatsInfos", ctx)}
+func (_e *DataCoordCatalog_Expecter) ListSegmentReferences(ctx interface{}) *DataCoordCatalog_ListSegmentReferences_Call {
+	return &DataCoordCatalog_ListSegmentReferences_Call{Call: _e.mock.On("ListSegmentReferences", ctx)}
 }
 
-func (_c *DataCoordCatalog_ListPartitionStatsInfos_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListPartitionStatsInfos_Call {
+func (_c *DataCoordCatalog_ListSegmentReferences_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListSegmentReferences_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *DataCoordCatalog_ListPartitionStatsInfos_Call) Return(_a0 []*datapb.PartitionStatsInfo, _a1 error) *DataCoordCatalog_ListPartitionStatsInfos_Call {
+func (_c *DataCoordCatalog_ListSegmentReferences_Call) Return(_a0 []*datapb.SegmentReference, _a1 error) *DataCoordCatalog_ListSegmentReferences_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DataCoordCatalog_ListPartitionStatsInfos_Call) RunAndReturn(run func(context.Context) ([]*datapb.PartitionStatsInfo, error)) *DataCoordCatalog_ListPartitionStatsInfos_Call {
+func (_c *DataCoordCatalog_ListSegmentReferences_Call) RunAndReturn(run func(context.Context) ([]*datapb.SegmentReference, error)) *DataCoordCatalog_ListSegmentReferences_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListPreImportTasks provides a mock function with given fields:
-func (_m *DataCoordCatalog) ListPreImportTasks() ([]*datapb.PreImportTask, error) {
-	ret := _m.Called()
+// ListSegments provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, error) {
+	ret := _m.Called(ctx)
 
-	var r0 []*datapb.PreImportTask
+	var r0 []*datapb.SegmentInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]*datapb.PreImportTask, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.SegmentInfo, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func() []*datapb.PreImportTask); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.SegmentInfo); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*datapb.PreImportTask)
+			r0 = ret.Get(0).([]*datapb.SegmentInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1366,47 +2110,48 @@ func (_m *DataCoordCatalog) ListPreImportTasks() ([]*datapb.PreImportTask, error
 	return r0, r1
 }
 
-// DataCoordCatalog_ListPreImportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPreImportTasks'
-type DataCoordCatalog_ListPreImportTasks_Call struct {
+// DataCoordCatalog_ListSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSegments'
+type DataCoordCatalog_ListSegments_Call struct {
 	*mock.Call
 }
 
-// ListPreImportTasks is a helper method to define mock.On call
-func (_e *DataCoordCatalog_Expecter) ListPreImportTasks() *DataCoordCatalog_ListPreImportTasks_Call {
-	return &DataCoordCatalog_ListPreImportTasks_Call{Call: _e.mock.On("ListPreImportTasks")}
+// ListSegments is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListSegments(ctx interface{}) *DataCoordCatalog_ListSegments_Call {
+	return &DataCoordCatalog_ListSegments_Call{Call: _e.mock.On("ListSegments", ctx)}
 }
 
-func (_c *DataCoordCatalog_ListPreImportTasks_Call) Run(run func()) *DataCoordCatalog_ListPreImportTasks_Call {
+func (_c *DataCoordCatalog_ListSegments_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListSegments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *DataCoordCatalog_ListPreImportTasks_Call) Return(_a0 []*datapb.PreImportTask, _a1 error) *DataCoordCatalog_ListPreImportTasks_Call {
+func (_c *DataCoordCatalog_ListSegments_Call) Return(_a0 []*datapb.SegmentInfo, _a1 error) *DataCoordCatalog_ListSegments_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DataCoordCatalog_ListPreImportTasks_Call) RunAndReturn(run func() ([]*datapb.PreImportTask, error)) *DataCoordCatalog_ListPreImportTasks_Call {
+func (_c *DataCoordCatalog_ListSegments_Call) RunAndReturn(run func(context.Context) ([]*datapb.SegmentInfo, error)) *DataCoordCatalog_ListSegments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSegmentIndexes provides a mock function with given fields: ctx
-func (_m *DataCoordCatalog) ListSegmentIndexes(ctx context.Context) ([]*model.SegmentIndex, error) {
+// ListShippedSegments provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListShippedSegments(ctx context.Context) ([]*datapb.ShippedSegment, error) {
 	ret := _m.Called(ctx)
 
-	var r0 []*model.SegmentIndex
+	var r0 []*datapb.ShippedSegment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.SegmentIndex, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.ShippedSegment, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*model.SegmentIndex); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.ShippedSegment); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.SegmentIndex)
+			r0 = ret.Get(0).([]*datapb.ShippedSegment)
 		}
 	}
 
@@ -1419,48 +2164,48 @@ func (_m *DataCoordCatalog) ListSegmentIndexes(ctx context.Context) ([]*model.Se
 	return r0, r1
 }
 
-// DataCoordCatalog_ListSegmentIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSegmentIndexes'
-type DataCoordCatalog_ListSegmentIndexes_Call struct {
+// DataCoordCatalog_ListShippedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListShippedSegments'
+type DataCoordCatalog_ListShippedSegments_Call struct {
 	*mock.Call
 }
 
-// ListSegmentIndexes is a helper method to define mock.On call
+// ListShippedSegments is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *DataCoordCatalog_Expecter) ListSegmentIndexes(ctx interface{}) *DataCoordCatalog_ListSegmentIndexes_Call {
-	return &DataCoordCatalog_ListSegmentIndexes_Call{Call: _e.mock.On("ListSegmentIndexes", ctx)}
+func (_e *DataCoordCatalog_Expecter) ListShippedSegments(ctx interface{}) *DataCoordCatalog_ListShippedSegments_Call {
+	return &DataCoordCatalog_ListShippedSegments_Call{Call: _e.mock.On("ListShippedSegments", ctx)}
 }
 
-func (_c *DataCoordCatalog_ListSegmentIndexes_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListSegmentIndexes_Call {
+func (_c *DataCoordCatalog_ListShippedSegments_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListShippedSegments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *DataCoordCatalog_ListSegmentIndexes_Call) Return(_a0 []*model.SegmentIndex, _a1 error) *DataCoordCatalog_ListSegmentIndexes_Call {
+func (_c *DataCoordCatalog_ListShippedSegments_Call) Return(_a0 []*datapb.ShippedSegment, _a1 error) *DataCoordCatalog_ListShippedSegments_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DataCoordCatalog_ListSegmentIndexes_Call) RunAndReturn(run func(context.Context) ([]*model.SegmentIndex, error)) *DataCoordCatalog_ListSegmentIndexes_Call {
+func (_c *DataCoordCatalog_ListShippedSegments_Call) RunAndReturn(run func(context.Context) ([]*datapb.ShippedSegment, error)) *DataCoordCatalog_ListShippedSegments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSegments provides a mock function with given fields: ctx
-func (_m *DataCoordCatalog) ListSegments(ctx context.Context) ([]*datapb.SegmentInfo, error) {
+// ListStatsTasks provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListStatsTasks(ctx context.Context) ([]*indexpb.StatsTask, error) {
 	ret := _m.Called(ctx)
 
-	var r0 []*datapb.SegmentInfo
+	var r0 []*indexpb.StatsTask
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.SegmentInfo, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*indexpb.StatsTask, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.SegmentInfo); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) []*indexpb.StatsTask); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*datapb.SegmentInfo)
+			r0 = ret.Get(0).([]*indexpb.StatsTask)
 		}
 	}
 
@@ -1473,30 +2218,30 @@ func (_m *DataCoordCatalog) ListSegments(ctx context.Context) ([]*datapb.Segment
 	return r0, r1
 }
 
-// DataCoordCatalog_ListSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSegments'
-type DataCoordCatalog_ListSegments_Call struct {
+// DataCoordCatalog_ListStatsTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStatsTasks'
+type DataCoordCatalog_ListStatsTasks_Call struct {
 	*mock.Call
 }
 
-// ListSegments is a helper method to define mock.On call
+// ListStatsTasks is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *DataCoordCatalog_Expecter) ListSegments(ctx interface{}) *DataCoordCatalog_ListSegments_Call {
-	return &DataCoordCatalog_ListSegments_Call{Call: _e.mock.On("ListSegments", ctx)}
+func (_e *DataCoordCatalog_Expecter) ListStatsTasks(ctx interface{}) *DataCoordCatalog_ListStatsTasks_Call {
+	return &DataCoordCatalog_ListStatsTasks_Call{Call: _e.mock.On("ListStatsTasks", ctx)}
 }
 
-func (_c *DataCoordCatalog_ListSegments_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListSegments_Call {
+func (_c *DataCoordCatalog_ListStatsTasks_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListStatsTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *DataCoordCatalog_ListSegments_Call) Return(_a0 []*datapb.SegmentInfo, _a1 error) *DataCoordCatalog_ListSegments_Call {
+func (_c *DataCoordCatalog_ListStatsTasks_Call) Return(_a0 []*indexpb.StatsTask, _a1 error) *DataCoordCatalog_ListStatsTasks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *DataCoordCatalog_ListSegments_Call) RunAndReturn(run func(context.Context) ([]*datapb.SegmentInfo, error)) *DataCoordCatalog_ListSegments_Call {
+func (_c *DataCoordCatalog_ListStatsTasks_Call) RunAndReturn(run func(context.Context) ([]*indexpb.StatsTask, error)) *DataCoordCatalog_ListStatsTasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1849,6 +2594,134 @@ func (_c *DataCoordCatalog_SaveDroppedSegmentsInBatch_Call) RunAndReturn(run fun
 	return _c
 }
 
+// SaveExportJob provides a mock function with given fields: job
+func (_m *DataCoordCatalog) SaveExportJob(job *datapb.ExportJob) error {
+	ret := _m.Called(job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datapb.ExportJob) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveExportJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveExportJob'
+type DataCoordCatalog_SaveExportJob_Call struct {
+	*mock.Call
+}
+
+// SaveExportJob is a helper method to define mock.On call
+//   - job *datapb.ExportJob
+func (_e *DataCoordCatalog_Expecter) SaveExportJob(job interface{}) *DataCoordCatalog_SaveExportJob_Call {
+	return &DataCoordCatalog_SaveExportJob_Call{Call: _e.mock.On("SaveExportJob", job)}
+}
+
+func (_c *DataCoordCatalog_SaveExportJob_Call) Run(run func(job *datapb.ExportJob)) *DataCoordCatalog_SaveExportJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*datapb.ExportJob))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveExportJob_Call) Return(_a0 error) *DataCoordCatalog_SaveExportJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveExportJob_Call) RunAndReturn(run func(*datapb.ExportJob) error) *DataCoordCatalog_SaveExportJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveExportTask provides a mock function with given fields: task
+func (_m *DataCoordCatalog) SaveExportTask(task *datapb.ExportTask) error {
+	ret := _m.Called(task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*datapb.ExportTask) error); ok {
+		r0 = rf(task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveExportTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveExportTask'
+type DataCoordCatalog_SaveExportTask_Call struct {
+	*mock.Call
+}
+
+// SaveExportTask is a helper method to define mock.On call
+//   - task *datapb.ExportTask
+func (_e *DataCoordCatalog_Expecter) SaveExportTask(task interface{}) *DataCoordCatalog_SaveExportTask_Call {
+	return &DataCoordCatalog_SaveExportTask_Call{Call: _e.mock.On("SaveExportTask", task)}
+}
+
+func (_c *DataCoordCatalog_SaveExportTask_Call) Run(run func(task *datapb.ExportTask)) *DataCoordCatalog_SaveExportTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*datapb.ExportTask))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveExportTask_Call) Return(_a0 error) *DataCoordCatalog_SaveExportTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveExportTask_Call) RunAndReturn(run func(*datapb.ExportTask) error) *DataCoordCatalog_SaveExportTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveGcSafeTs provides a mock function with given fields: ctx, collectionID, ts
+func (_m *DataCoordCatalog) SaveGcSafeTs(ctx context.Context, collectionID int64, ts uint64) error {
+	ret := _m.Called(ctx, collectionID, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) error); ok {
+		r0 = rf(ctx, collectionID, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveGcSafeTs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveGcSafeTs'
+type DataCoordCatalog_SaveGcSafeTs_Call struct {
+	*mock.Call
+}
+
+// SaveGcSafeTs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - ts uint64
+func (_e *DataCoordCatalog_Expecter) SaveGcSafeTs(ctx interface{}, collectionID interface{}, ts interface{}) *DataCoordCatalog_SaveGcSafeTs_Call {
+	return &DataCoordCatalog_SaveGcSafeTs_Call{Call: _e.mock.On("SaveGcSafeTs", ctx, collectionID, ts)}
+}
+
+func (_c *DataCoordCatalog_SaveGcSafeTs_Call) Run(run func(ctx context.Context, collectionID int64, ts uint64)) *DataCoordCatalog_SaveGcSafeTs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveGcSafeTs_Call) Return(_a0 error) *DataCoordCatalog_SaveGcSafeTs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveGcSafeTs_Call) RunAndReturn(run func(context.Context, int64, uint64) error) *DataCoordCatalog_SaveGcSafeTs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveImportJob provides a mock function with given fields: job
 func (_m *DataCoordCatalog) SaveImportJob(job *datapb.ImportJob) error {
 	ret := _m.Called(job)
@@ -2018,6 +2891,135 @@ func (_c *DataCoordCatalog_SavePreImportTask_Call) RunAndReturn(run func(*datapb
 	return _c
 }
 
+// SaveSegmentReference provides a mock function with given fields: ctx, reference
+func (_m *DataCoordCatalog) SaveSegmentReference(ctx context.Context, reference *datapb.SegmentReference) error {
+	ret := _m.Called(ctx, reference)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SegmentReference) error); ok {
+		r0 = rf(ctx, reference)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveSegmentReference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSegmentReference'
+type DataCoordCatalog_SaveSegmentReference_Call struct {
+	*mock.Call
+}
+
+// SaveSegmentReference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reference *datapb.SegmentReference
+func (_e *DataCoordCatalog_Expecter) SaveSegmentReference(ctx interface{}, reference interface{}) *DataCoordCatalog_SaveSegmentReference_Call {
+	return &DataCoordCatalog_SaveSegmentReference_Call{Call: _e.mock.On("SaveSegmentReference", ctx, reference)}
+}
+
+func (_c *DataCoordCatalog_SaveSegmentReference_Call) Run(run func(ctx context.Context, reference *datapb.SegmentReference)) *DataCoordCatalog_SaveSegmentReference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.SegmentReference))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveSegmentReference_Call) Return(_a0 error) *DataCoordCatalog_SaveSegmentReference_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveSegmentReference_Call) RunAndReturn(run func(context.Context, *datapb.SegmentReference) error) *DataCoordCatalog_SaveSegmentReference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveShippedSegment provides a mock function with given fields: ctx, segment
+func (_m *DataCoordCatalog) SaveShippedSegment(ctx context.Context, segment *datapb.ShippedSegment) error {
+	ret := _m.Called(ctx, segment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ShippedSegment) error); ok {
+		r0 = rf(ctx, segment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveShippedSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveShippedSegment'
+type DataCoordCatalog_SaveShippedSegment_Call struct {
+	*mock.Call
+}
+
+// SaveShippedSegment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - segment *datapb.ShippedSegment
+func (_e *DataCoordCatalog_Expecter) SaveShippedSegment(ctx interface{}, segment interface{}) *DataCoordCatalog_SaveShippedSegment_Call {
+	return &DataCoordCatalog_SaveShippedSegment_Call{Call: _e.mock.On("SaveShippedSegment", ctx, segment)}
+}
+
+func (_c *DataCoordCatalog_SaveShippedSegment_Call) Run(run func(ctx context.Context, segment *datapb.ShippedSegment)) *DataCoordCatalog_SaveShippedSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ShippedSegment))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveShippedSegment_Call) Return(_a0 error) *DataCoordCatalog_SaveShippedSegment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveShippedSegment_Call) RunAndReturn(run func(context.Context, *datapb.ShippedSegment) error) *DataCoordCatalog_SaveShippedSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveStatsTask provides a mock function with given fields: ctx, task
+func (_m *DataCoordCatalog) SaveStatsTask(ctx context.Context, task *indexpb.StatsTask) error {
+	ret := _m.Called(ctx, task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.StatsTask) error); ok {
+		r0 = rf(ctx, task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveStatsTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveStatsTask'
+type DataCoordCatalog_SaveStatsTask_Call struct {
+	*mock.Call
+}
+
+// SaveStatsTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - task *indexpb.StatsTask
+func (_e *DataCoordCatalog_Expecter) SaveStatsTask(ctx interface{}, task interface{}) *DataCoordCatalog_SaveStatsTask_Call {
+	return &DataCoordCatalog_SaveStatsTask_Call{Call: _e.mock.On("SaveStatsTask", ctx, task)}
+}
+
+func (_c *DataCoordCatalog_SaveStatsTask_Call) Run(run func(ctx context.Context, task *indexpb.StatsTask)) *DataCoordCatalog_SaveStatsTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.StatsTask))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveStatsTask_Call) Return(_a0 error) *DataCoordCatalog_SaveStatsTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveStatsTask_Call) RunAndReturn(run func(context.Context, *indexpb.StatsTask) error) *DataCoordCatalog_SaveStatsTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShouldDropChannel provides a mock function with given fields: ctx, channel
 func (_m *DataCoordCatalog) ShouldDropChannel(ctx context.Context, channel string) bool {
 	ret := _m.Called(ctx, channel)
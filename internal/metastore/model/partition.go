@@ -12,6 +12,7 @@ type Partition struct {
 	Extra                     map[string]string // deprecated.
 	CollectionID              int64
 	State                     pb.PartitionState
+	KeyBuckets                []byte // buckets of the partition keys, nil if not tracked.
 }
 
 func (p *Partition) Available() bool {
@@ -26,6 +27,7 @@ func (p *Partition) Clone() *Partition {
 		Extra:                     common.CloneStr2Str(p.Extra),
 		CollectionID:              p.CollectionID,
 		State:                     p.State,
+		KeyBuckets:                common.CloneByteSlice(p.KeyBuckets),
 	}
 }
 
@@ -61,6 +63,7 @@ func MarshalPartitionModel(partition *Partition) *pb.PartitionInfo {
 		PartitionCreatedTimestamp: partition.PartitionCreatedTimestamp,
 		CollectionId:              partition.CollectionID,
 		State:                     partition.State,
+		PartitionKeyBuckets:       partition.KeyBuckets,
 	}
 }
 
@@ -71,5 +74,6 @@ func UnmarshalPartitionModel(info *pb.PartitionInfo) *Partition {
 		PartitionCreatedTimestamp: info.GetPartitionCreatedTimestamp(),
 		CollectionID:              info.GetCollectionId(),
 		State:                     info.GetState(),
+		KeyBuckets:                info.GetPartitionKeyBuckets(),
 	}
 }
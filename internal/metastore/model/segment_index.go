@@ -1,6 +1,8 @@
 package model
 
 import (
+	"google.golang.org/protobuf/proto"
+
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -25,6 +27,30 @@ type SegmentIndex struct {
 	WriteHandoff        bool
 	CurrentIndexVersion int32
 	IndexStoreVersion   int64
+	// paused builds are skipped by the scheduler until resumed
+	Paused bool
+	// times the build has been retried, and the recent fail reasons of the retries
+	RetryTimes       int64
+	RetryFailReasons []string
+	// the ended attempts of the build, and the start time in unix milliseconds of the current attempt
+	BuildHistory   []*indexpb.IndexBuildRecord
+	BuildStartTime int64
+	// the timing breakdown of the current attempt
+	BuildTiming *indexpb.TaskTiming
+	// the build progress in percentage reported by the worker, kept in memory only
+	Progress int32
+	// the index files of another build reused by the segment index, nil if the index is built for the segment
+	FilesSource *indexpb.IndexFilesSource
+	// the hash of the binlogs of the indexed field, empty if not computed yet
+	ContentHash string
+	// the outdated build replaced by the segment index once it's finished, 0 if it's not a rebuild
+	ReplacedBuildID int64
+	// the time in unix milliseconds the segment index is replaced by its rebuild, 0 if not replaced
+	ReplacedTime int64
+	// the gated CPU features of the node building the index, which the query nodes loading it must support
+	RequiredCPUFeatures []string
+	// the CPU features the rebuild for the incompatible query nodes is restricted to, empty if not restricted
+	AllowedCPUFeatures []string
 }
 
 func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
@@ -49,6 +75,18 @@ func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
 		IndexSize:           segIndex.SerializeSize,
 		WriteHandoff:        segIndex.WriteHandoff,
 		CurrentIndexVersion: segIndex.GetCurrentIndexVersion(),
+		Paused:              segIndex.GetPaused(),
+		RetryTimes:          segIndex.GetRetryTimes(),
+		RetryFailReasons:    common.CloneStringList(segIndex.GetRetryFailReasons()),
+		BuildHistory:        cloneBuildHistory(segIndex.GetBuildHistory()),
+		BuildStartTime:      segIndex.GetBuildStartTime(),
+		BuildTiming:         cloneTaskTiming(segIndex.GetBuildTiming()),
+		FilesSource:         cloneFilesSource(segIndex.GetFilesSource()),
+		ContentHash:         segIndex.GetContentHash(),
+		ReplacedBuildID:     segIndex.GetReplacedBuildID(),
+		ReplacedTime:        segIndex.GetReplacedTime(),
+		RequiredCPUFeatures: common.CloneStringList(segIndex.GetRequiredCpuFeatures()),
+		AllowedCPUFeatures:  common.CloneStringList(segIndex.GetAllowedCpuFeatures()),
 	}
 }
 
@@ -74,6 +112,18 @@ func MarshalSegmentIndexModel(segIdx *SegmentIndex) *indexpb.SegmentIndex {
 		SerializeSize:       segIdx.IndexSize,
 		WriteHandoff:        segIdx.WriteHandoff,
 		CurrentIndexVersion: segIdx.CurrentIndexVersion,
+		Paused:              segIdx.Paused,
+		RetryTimes:          segIdx.RetryTimes,
+		RetryFailReasons:    common.CloneStringList(segIdx.RetryFailReasons),
+		BuildHistory:        cloneBuildHistory(segIdx.BuildHistory),
+		BuildStartTime:      segIdx.BuildStartTime,
+		BuildTiming:         cloneTaskTiming(segIdx.BuildTiming),
+		FilesSource:         cloneFilesSource(segIdx.FilesSource),
+		ContentHash:         segIdx.ContentHash,
+		ReplacedBuildID:     segIdx.ReplacedBuildID,
+		ReplacedTime:        segIdx.ReplacedTime,
+		RequiredCpuFeatures: common.CloneStringList(segIdx.RequiredCPUFeatures),
+		AllowedCpuFeatures:  common.CloneStringList(segIdx.AllowedCPUFeatures),
 	}
 }
 
@@ -95,5 +145,43 @@ func CloneSegmentIndex(segIndex *SegmentIndex) *SegmentIndex {
 		IndexSize:           segIndex.IndexSize,
 		WriteHandoff:        segIndex.WriteHandoff,
 		CurrentIndexVersion: segIndex.CurrentIndexVersion,
+		Paused:              segIndex.Paused,
+		RetryTimes:          segIndex.RetryTimes,
+		RetryFailReasons:    common.CloneStringList(segIndex.RetryFailReasons),
+		BuildHistory:        cloneBuildHistory(segIndex.BuildHistory),
+		BuildStartTime:      segIndex.BuildStartTime,
+		BuildTiming:         cloneTaskTiming(segIndex.BuildTiming),
+		Progress:            segIndex.Progress,
+		FilesSource:         cloneFilesSource(segIndex.FilesSource),
+		ContentHash:         segIndex.ContentHash,
+		ReplacedBuildID:     segIndex.ReplacedBuildID,
+		ReplacedTime:        segIndex.ReplacedTime,
+		RequiredCPUFeatures: common.CloneStringList(segIndex.RequiredCPUFeatures),
+		AllowedCPUFeatures:  common.CloneStringList(segIndex.AllowedCPUFeatures),
+	}
+}
+
+func cloneBuildHistory(records []*indexpb.IndexBuildRecord) []*indexpb.IndexBuildRecord {
+	if records == nil {
+		return nil
+	}
+	cloned := make([]*indexpb.IndexBuildRecord, 0, len(records))
+	for _, record := range records {
+		cloned = append(cloned, proto.Clone(record).(*indexpb.IndexBuildRecord))
+	}
+	return cloned
+}
+
+func cloneTaskTiming(timing *indexpb.TaskTiming) *indexpb.TaskTiming {
+	if timing == nil {
+		return nil
+	}
+	return proto.Clone(timing).(*indexpb.TaskTiming)
+}
+
+func cloneFilesSource(source *indexpb.IndexFilesSource) *indexpb.IndexFilesSource {
+	if source == nil {
+		return nil
 	}
+	return proto.Clone(source).(*indexpb.IndexFilesSource)
 }
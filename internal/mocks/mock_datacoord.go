@@ -38,6 +38,61 @@ func (_m *MockDataCoord) EXPECT() *MockDataCoord_Expecter {
 	return &MockDataCoord_Expecter{mock: &_m.Mock}
 }
 
+// AcquireSegmentReference provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) AcquireSegmentReference(_a0 context.Context, _a1 *datapb.AcquireSegmentReferenceRequest) (*datapb.AcquireSegmentReferenceResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.AcquireSegmentReferenceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireSegmentReferenceRequest) (*datapb.AcquireSegmentReferenceResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireSegmentReferenceRequest) *datapb.AcquireSegmentReferenceResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.AcquireSegmentReferenceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.AcquireSegmentReferenceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_AcquireSegmentReference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireSegmentReference'
+type MockDataCoord_AcquireSegmentReference_Call struct {
+	*mock.Call
+}
+
+// AcquireSegmentReference is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.AcquireSegmentReferenceRequest
+func (_e *MockDataCoord_Expecter) AcquireSegmentReference(_a0 interface{}, _a1 interface{}) *MockDataCoord_AcquireSegmentReference_Call {
+	return &MockDataCoord_AcquireSegmentReference_Call{Call: _e.mock.On("AcquireSegmentReference", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_AcquireSegmentReference_Call) Run(run func(_a0 context.Context, _a1 *datapb.AcquireSegmentReferenceRequest)) *MockDataCoord_AcquireSegmentReference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.AcquireSegmentReferenceRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_AcquireSegmentReference_Call) Return(_a0 *datapb.AcquireSegmentReferenceResponse, _a1 error) *MockDataCoord_AcquireSegmentReference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_AcquireSegmentReference_Call) RunAndReturn(run func(context.Context, *datapb.AcquireSegmentReferenceRequest) (*datapb.AcquireSegmentReferenceResponse, error)) *MockDataCoord_AcquireSegmentReference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocSegment provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) AllocSegment(_a0 context.Context, _a1 *datapb.AllocSegmentRequest) (*datapb.AllocSegmentResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -258,6 +313,61 @@ func (_c *MockDataCoord_BroadcastAlteredCollection_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CheckDuplicatePrimaryKeys provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CheckDuplicatePrimaryKeys(_a0 context.Context, _a1 *datapb.CheckDuplicatePrimaryKeysRequest) (*datapb.CheckDuplicatePrimaryKeysResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.CheckDuplicatePrimaryKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CheckDuplicatePrimaryKeysRequest) (*datapb.CheckDuplicatePrimaryKeysResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CheckDuplicatePrimaryKeysRequest) *datapb.CheckDuplicatePrimaryKeysResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CheckDuplicatePrimaryKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CheckDuplicatePrimaryKeysRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_CheckDuplicatePrimaryKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckDuplicatePrimaryKeys'
+type MockDataCoord_CheckDuplicatePrimaryKeys_Call struct {
+	*mock.Call
+}
+
+// CheckDuplicatePrimaryKeys is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CheckDuplicatePrimaryKeysRequest
+func (_e *MockDataCoord_Expecter) CheckDuplicatePrimaryKeys(_a0 interface{}, _a1 interface{}) *MockDataCoord_CheckDuplicatePrimaryKeys_Call {
+	return &MockDataCoord_CheckDuplicatePrimaryKeys_Call{Call: _e.mock.On("CheckDuplicatePrimaryKeys", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_CheckDuplicatePrimaryKeys_Call) Run(run func(_a0 context.Context, _a1 *datapb.CheckDuplicatePrimaryKeysRequest)) *MockDataCoord_CheckDuplicatePrimaryKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CheckDuplicatePrimaryKeysRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_CheckDuplicatePrimaryKeys_Call) Return(_a0 *datapb.CheckDuplicatePrimaryKeysResponse, _a1 error) *MockDataCoord_CheckDuplicatePrimaryKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_CheckDuplicatePrimaryKeys_Call) RunAndReturn(run func(context.Context, *datapb.CheckDuplicatePrimaryKeysRequest) (*datapb.CheckDuplicatePrimaryKeysResponse, error)) *MockDataCoord_CheckDuplicatePrimaryKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -313,6 +423,61 @@ func (_c *MockDataCoord_CheckHealth_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CloneSegments provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CloneSegments(_a0 context.Context, _a1 *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.CloneSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest) *datapb.CloneSegmentsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CloneSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CloneSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_CloneSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneSegments'
+type MockDataCoord_CloneSegments_Call struct {
+	*mock.Call
+}
+
+// CloneSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.CloneSegmentsRequest
+func (_e *MockDataCoord_Expecter) CloneSegments(_a0 interface{}, _a1 interface{}) *MockDataCoord_CloneSegments_Call {
+	return &MockDataCoord_CloneSegments_Call{Call: _e.mock.On("CloneSegments", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_CloneSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.CloneSegmentsRequest)) *MockDataCoord_CloneSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CloneSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_CloneSegments_Call) Return(_a0 *datapb.CloneSegmentsResponse, _a1 error) *MockDataCoord_CloneSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_CloneSegments_Call) RunAndReturn(run func(context.Context, *datapb.CloneSegmentsRequest) (*datapb.CloneSegmentsResponse, error)) *MockDataCoord_CloneSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) CreateIndex(_a0 context.Context, _a1 *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -423,6 +588,61 @@ func (_c *MockDataCoord_DescribeIndex_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// DropAnalyzeTask provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) DropAnalyzeTask(_a0 context.Context, _a1 *indexpb.DropAnalyzeTaskRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropAnalyzeTaskRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropAnalyzeTaskRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.DropAnalyzeTaskRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_DropAnalyzeTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAnalyzeTask'
+type MockDataCoord_DropAnalyzeTask_Call struct {
+	*mock.Call
+}
+
+// DropAnalyzeTask is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.DropAnalyzeTaskRequest
+func (_e *MockDataCoord_Expecter) DropAnalyzeTask(_a0 interface{}, _a1 interface{}) *MockDataCoord_DropAnalyzeTask_Call {
+	return &MockDataCoord_DropAnalyzeTask_Call{Call: _e.mock.On("DropAnalyzeTask", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_DropAnalyzeTask_Call) Run(run func(_a0 context.Context, _a1 *indexpb.DropAnalyzeTaskRequest)) *MockDataCoord_DropAnalyzeTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.DropAnalyzeTaskRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_DropAnalyzeTask_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_DropAnalyzeTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_DropAnalyzeTask_Call) RunAndReturn(run func(context.Context, *indexpb.DropAnalyzeTaskRequest) (*commonpb.Status, error)) *MockDataCoord_DropAnalyzeTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) DropIndex(_a0 context.Context, _a1 *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -533,24 +753,24 @@ func (_c *MockDataCoord_DropVirtualChannel_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// Flush provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) Flush(_a0 context.Context, _a1 *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+// ExecuteCompactionPlans provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ExecuteCompactionPlans(_a0 context.Context, _a1 *datapb.ExecuteCompactionPlansRequest) (*datapb.ExecuteCompactionPlansResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.FlushResponse
+	var r0 *datapb.ExecuteCompactionPlansResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExecuteCompactionPlansRequest) (*datapb.ExecuteCompactionPlansResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) *datapb.FlushResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExecuteCompactionPlansRequest) *datapb.ExecuteCompactionPlansResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.FlushResponse)
+			r0 = ret.Get(0).(*datapb.ExecuteCompactionPlansResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExecuteCompactionPlansRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -559,53 +779,53 @@ func (_m *MockDataCoord) Flush(_a0 context.Context, _a1 *datapb.FlushRequest) (*
 	return r0, r1
 }
 
-// MockDataCoord_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
-type MockDataCoord_Flush_Call struct {
+// MockDataCoord_ExecuteCompactionPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteCompactionPlans'
+type MockDataCoord_ExecuteCompactionPlans_Call struct {
 	*mock.Call
 }
 
-// Flush is a helper method to define mock.On call
+// ExecuteCompactionPlans is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.FlushRequest
-func (_e *MockDataCoord_Expecter) Flush(_a0 interface{}, _a1 interface{}) *MockDataCoord_Flush_Call {
-	return &MockDataCoord_Flush_Call{Call: _e.mock.On("Flush", _a0, _a1)}
+//   - _a1 *datapb.ExecuteCompactionPlansRequest
+func (_e *MockDataCoord_Expecter) ExecuteCompactionPlans(_a0 interface{}, _a1 interface{}) *MockDataCoord_ExecuteCompactionPlans_Call {
+	return &MockDataCoord_ExecuteCompactionPlans_Call{Call: _e.mock.On("ExecuteCompactionPlans", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_Flush_Call) Run(run func(_a0 context.Context, _a1 *datapb.FlushRequest)) *MockDataCoord_Flush_Call {
+func (_c *MockDataCoord_ExecuteCompactionPlans_Call) Run(run func(_a0 context.Context, _a1 *datapb.ExecuteCompactionPlansRequest)) *MockDataCoord_ExecuteCompactionPlans_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.FlushRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ExecuteCompactionPlansRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *MockDataCoord_Flush_Call {
+func (_c *MockDataCoord_ExecuteCompactionPlans_Call) Return(_a0 *datapb.ExecuteCompactionPlansResponse, _a1 error) *MockDataCoord_ExecuteCompactionPlans_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_Flush_Call) RunAndReturn(run func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)) *MockDataCoord_Flush_Call {
+func (_c *MockDataCoord_ExecuteCompactionPlans_Call) RunAndReturn(run func(context.Context, *datapb.ExecuteCompactionPlansRequest) (*datapb.ExecuteCompactionPlansResponse, error)) *MockDataCoord_ExecuteCompactionPlans_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GcConfirm provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GcConfirm(_a0 context.Context, _a1 *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error) {
+// ExportSegmentManifests provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ExportSegmentManifests(_a0 context.Context, _a1 *datapb.ExportSegmentManifestsRequest) (*datapb.ExportSegmentManifestsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GcConfirmResponse
+	var r0 *datapb.ExportSegmentManifestsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportSegmentManifestsRequest) (*datapb.ExportSegmentManifestsResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) *datapb.GcConfirmResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportSegmentManifestsRequest) *datapb.ExportSegmentManifestsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GcConfirmResponse)
+			r0 = ret.Get(0).(*datapb.ExportSegmentManifestsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcConfirmRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportSegmentManifestsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -614,53 +834,53 @@ func (_m *MockDataCoord) GcConfirm(_a0 context.Context, _a1 *datapb.GcConfirmReq
 	return r0, r1
 }
 
-// MockDataCoord_GcConfirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcConfirm'
-type MockDataCoord_GcConfirm_Call struct {
+// MockDataCoord_ExportSegmentManifests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportSegmentManifests'
+type MockDataCoord_ExportSegmentManifests_Call struct {
 	*mock.Call
 }
 
-// GcConfirm is a helper method to define mock.On call
+// ExportSegmentManifests is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GcConfirmRequest
-func (_e *MockDataCoord_Expecter) GcConfirm(_a0 interface{}, _a1 interface{}) *MockDataCoord_GcConfirm_Call {
-	return &MockDataCoord_GcConfirm_Call{Call: _e.mock.On("GcConfirm", _a0, _a1)}
+//   - _a1 *datapb.ExportSegmentManifestsRequest
+func (_e *MockDataCoord_Expecter) ExportSegmentManifests(_a0 interface{}, _a1 interface{}) *MockDataCoord_ExportSegmentManifests_Call {
+	return &MockDataCoord_ExportSegmentManifests_Call{Call: _e.mock.On("ExportSegmentManifests", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GcConfirm_Call) Run(run func(_a0 context.Context, _a1 *datapb.GcConfirmRequest)) *MockDataCoord_GcConfirm_Call {
+func (_c *MockDataCoord_ExportSegmentManifests_Call) Run(run func(_a0 context.Context, _a1 *datapb.ExportSegmentManifestsRequest)) *MockDataCoord_ExportSegmentManifests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GcConfirmRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ExportSegmentManifestsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GcConfirm_Call) Return(_a0 *datapb.GcConfirmResponse, _a1 error) *MockDataCoord_GcConfirm_Call {
+func (_c *MockDataCoord_ExportSegmentManifests_Call) Return(_a0 *datapb.ExportSegmentManifestsResponse, _a1 error) *MockDataCoord_ExportSegmentManifests_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GcConfirm_Call) RunAndReturn(run func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)) *MockDataCoord_GcConfirm_Call {
+func (_c *MockDataCoord_ExportSegmentManifests_Call) RunAndReturn(run func(context.Context, *datapb.ExportSegmentManifestsRequest) (*datapb.ExportSegmentManifestsResponse, error)) *MockDataCoord_ExportSegmentManifests_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GcControl provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GcControl(_a0 context.Context, _a1 *datapb.GcControlRequest) (*commonpb.Status, error) {
+// ExportV2 provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ExportV2(_a0 context.Context, _a1 *datapb.ExportV2Request) (*datapb.ExportV2Response, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *commonpb.Status
+	var r0 *datapb.ExportV2Response
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportV2Request) (*datapb.ExportV2Response, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportV2Request) *datapb.ExportV2Response); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*datapb.ExportV2Response)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcControlRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportV2Request) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -669,53 +889,53 @@ func (_m *MockDataCoord) GcControl(_a0 context.Context, _a1 *datapb.GcControlReq
 	return r0, r1
 }
 
-// MockDataCoord_GcControl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcControl'
-type MockDataCoord_GcControl_Call struct {
+// MockDataCoord_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockDataCoord_ExportV2_Call struct {
 	*mock.Call
 }
 
-// GcControl is a helper method to define mock.On call
+// ExportV2 is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GcControlRequest
-func (_e *MockDataCoord_Expecter) GcControl(_a0 interface{}, _a1 interface{}) *MockDataCoord_GcControl_Call {
-	return &MockDataCoord_GcControl_Call{Call: _e.mock.On("GcControl", _a0, _a1)}
+//   - _a1 *datapb.ExportV2Request
+func (_e *MockDataCoord_Expecter) ExportV2(_a0 interface{}, _a1 interface{}) *MockDataCoord_ExportV2_Call {
+	return &MockDataCoord_ExportV2_Call{Call: _e.mock.On("ExportV2", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GcControl_Call) Run(run func(_a0 context.Context, _a1 *datapb.GcControlRequest)) *MockDataCoord_GcControl_Call {
+func (_c *MockDataCoord_ExportV2_Call) Run(run func(_a0 context.Context, _a1 *datapb.ExportV2Request)) *MockDataCoord_ExportV2_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GcControlRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ExportV2Request))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GcControl_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_GcControl_Call {
+func (_c *MockDataCoord_ExportV2_Call) Return(_a0 *datapb.ExportV2Response, _a1 error) *MockDataCoord_ExportV2_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GcControl_Call) RunAndReturn(run func(context.Context, *datapb.GcControlRequest) (*commonpb.Status, error)) *MockDataCoord_GcControl_Call {
+func (_c *MockDataCoord_ExportV2_Call) RunAndReturn(run func(context.Context, *datapb.ExportV2Request) (*datapb.ExportV2Response, error)) *MockDataCoord_ExportV2_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCollectionStatistics provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetCollectionStatistics(_a0 context.Context, _a1 *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
+// FailoverRegionReplica provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) FailoverRegionReplica(_a0 context.Context, _a1 *datapb.FailoverRegionReplicaRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetCollectionStatisticsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FailoverRegionReplicaRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) *datapb.GetCollectionStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FailoverRegionReplicaRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetCollectionStatisticsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCollectionStatisticsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FailoverRegionReplicaRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -724,53 +944,53 @@ func (_m *MockDataCoord) GetCollectionStatistics(_a0 context.Context, _a1 *datap
 	return r0, r1
 }
 
-// MockDataCoord_GetCollectionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStatistics'
-type MockDataCoord_GetCollectionStatistics_Call struct {
+// MockDataCoord_FailoverRegionReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailoverRegionReplica'
+type MockDataCoord_FailoverRegionReplica_Call struct {
 	*mock.Call
 }
 
-// GetCollectionStatistics is a helper method to define mock.On call
+// FailoverRegionReplica is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetCollectionStatisticsRequest
-func (_e *MockDataCoord_Expecter) GetCollectionStatistics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCollectionStatistics_Call {
-	return &MockDataCoord_GetCollectionStatistics_Call{Call: _e.mock.On("GetCollectionStatistics", _a0, _a1)}
+//   - _a1 *datapb.FailoverRegionReplicaRequest
+func (_e *MockDataCoord_Expecter) FailoverRegionReplica(_a0 interface{}, _a1 interface{}) *MockDataCoord_FailoverRegionReplica_Call {
+	return &MockDataCoord_FailoverRegionReplica_Call{Call: _e.mock.On("FailoverRegionReplica", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetCollectionStatistics_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetCollectionStatisticsRequest)) *MockDataCoord_GetCollectionStatistics_Call {
+func (_c *MockDataCoord_FailoverRegionReplica_Call) Run(run func(_a0 context.Context, _a1 *datapb.FailoverRegionReplicaRequest)) *MockDataCoord_FailoverRegionReplica_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetCollectionStatisticsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.FailoverRegionReplicaRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetCollectionStatistics_Call) Return(_a0 *datapb.GetCollectionStatisticsResponse, _a1 error) *MockDataCoord_GetCollectionStatistics_Call {
+func (_c *MockDataCoord_FailoverRegionReplica_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_FailoverRegionReplica_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetCollectionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)) *MockDataCoord_GetCollectionStatistics_Call {
+func (_c *MockDataCoord_FailoverRegionReplica_Call) RunAndReturn(run func(context.Context, *datapb.FailoverRegionReplicaRequest) (*commonpb.Status, error)) *MockDataCoord_FailoverRegionReplica_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCompactionState provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetCompactionState(_a0 context.Context, _a1 *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+// Flush provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) Flush(_a0 context.Context, _a1 *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetCompactionStateResponse
+	var r0 *datapb.FlushResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) *milvuspb.GetCompactionStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) *datapb.FlushResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetCompactionStateResponse)
+			r0 = ret.Get(0).(*datapb.FlushResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -779,53 +999,53 @@ func (_m *MockDataCoord) GetCompactionState(_a0 context.Context, _a1 *milvuspb.G
 	return r0, r1
 }
 
-// MockDataCoord_GetCompactionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionState'
-type MockDataCoord_GetCompactionState_Call struct {
+// MockDataCoord_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type MockDataCoord_Flush_Call struct {
 	*mock.Call
 }
 
-// GetCompactionState is a helper method to define mock.On call
+// Flush is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetCompactionStateRequest
-func (_e *MockDataCoord_Expecter) GetCompactionState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCompactionState_Call {
-	return &MockDataCoord_GetCompactionState_Call{Call: _e.mock.On("GetCompactionState", _a0, _a1)}
+//   - _a1 *datapb.FlushRequest
+func (_e *MockDataCoord_Expecter) Flush(_a0 interface{}, _a1 interface{}) *MockDataCoord_Flush_Call {
+	return &MockDataCoord_Flush_Call{Call: _e.mock.On("Flush", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetCompactionState_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetCompactionStateRequest)) *MockDataCoord_GetCompactionState_Call {
+func (_c *MockDataCoord_Flush_Call) Run(run func(_a0 context.Context, _a1 *datapb.FlushRequest)) *MockDataCoord_Flush_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.FlushRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionState_Call) Return(_a0 *milvuspb.GetCompactionStateResponse, _a1 error) *MockDataCoord_GetCompactionState_Call {
+func (_c *MockDataCoord_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *MockDataCoord_Flush_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)) *MockDataCoord_GetCompactionState_Call {
+func (_c *MockDataCoord_Flush_Call) RunAndReturn(run func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)) *MockDataCoord_Flush_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCompactionStateWithPlans provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetCompactionStateWithPlans(_a0 context.Context, _a1 *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
+// ForceCompleteTask provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ForceCompleteTask(_a0 context.Context, _a1 *indexpb.ForceTaskRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetCompactionPlansResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ForceTaskRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) *milvuspb.GetCompactionPlansResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ForceTaskRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetCompactionPlansResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionPlansRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ForceTaskRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -834,53 +1054,53 @@ func (_m *MockDataCoord) GetCompactionStateWithPlans(_a0 context.Context, _a1 *m
 	return r0, r1
 }
 
-// MockDataCoord_GetCompactionStateWithPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionStateWithPlans'
-type MockDataCoord_GetCompactionStateWithPlans_Call struct {
+// MockDataCoord_ForceCompleteTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceCompleteTask'
+type MockDataCoord_ForceCompleteTask_Call struct {
 	*mock.Call
 }
 
-// GetCompactionStateWithPlans is a helper method to define mock.On call
+// ForceCompleteTask is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetCompactionPlansRequest
-func (_e *MockDataCoord_Expecter) GetCompactionStateWithPlans(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCompactionStateWithPlans_Call {
-	return &MockDataCoord_GetCompactionStateWithPlans_Call{Call: _e.mock.On("GetCompactionStateWithPlans", _a0, _a1)}
+//   - _a1 *indexpb.ForceTaskRequest
+func (_e *MockDataCoord_Expecter) ForceCompleteTask(_a0 interface{}, _a1 interface{}) *MockDataCoord_ForceCompleteTask_Call {
+	return &MockDataCoord_ForceCompleteTask_Call{Call: _e.mock.On("ForceCompleteTask", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetCompactionPlansRequest)) *MockDataCoord_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoord_ForceCompleteTask_Call) Run(run func(_a0 context.Context, _a1 *indexpb.ForceTaskRequest)) *MockDataCoord_ForceCompleteTask_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionPlansRequest))
+		run(args[0].(context.Context), args[1].(*indexpb.ForceTaskRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Return(_a0 *milvuspb.GetCompactionPlansResponse, _a1 error) *MockDataCoord_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoord_ForceCompleteTask_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ForceCompleteTask_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)) *MockDataCoord_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoord_ForceCompleteTask_Call) RunAndReturn(run func(context.Context, *indexpb.ForceTaskRequest) (*commonpb.Status, error)) *MockDataCoord_ForceCompleteTask_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetComponentStates provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+// ForceFailTask provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ForceFailTask(_a0 context.Context, _a1 *indexpb.ForceTaskRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.ComponentStates
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ForceTaskRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) *milvuspb.ComponentStates); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ForceTaskRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ForceTaskRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -889,53 +1109,53 @@ func (_m *MockDataCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.G
 	return r0, r1
 }
 
-// MockDataCoord_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
-type MockDataCoord_GetComponentStates_Call struct {
+// MockDataCoord_ForceFailTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceFailTask'
+type MockDataCoord_ForceFailTask_Call struct {
 	*mock.Call
 }
 
-// GetComponentStates is a helper method to define mock.On call
+// ForceFailTask is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetComponentStatesRequest
-func (_e *MockDataCoord_Expecter) GetComponentStates(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetComponentStates_Call {
-	return &MockDataCoord_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates", _a0, _a1)}
+//   - _a1 *indexpb.ForceTaskRequest
+func (_e *MockDataCoord_Expecter) ForceFailTask(_a0 interface{}, _a1 interface{}) *MockDataCoord_ForceFailTask_Call {
+	return &MockDataCoord_ForceFailTask_Call{Call: _e.mock.On("ForceFailTask", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetComponentStates_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest)) *MockDataCoord_GetComponentStates_Call {
+func (_c *MockDataCoord_ForceFailTask_Call) Run(run func(_a0 context.Context, _a1 *indexpb.ForceTaskRequest)) *MockDataCoord_ForceFailTask_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest))
+		run(args[0].(context.Context), args[1].(*indexpb.ForceTaskRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockDataCoord_GetComponentStates_Call {
+func (_c *MockDataCoord_ForceFailTask_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ForceFailTask_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)) *MockDataCoord_GetComponentStates_Call {
+func (_c *MockDataCoord_ForceFailTask_Call) RunAndReturn(run func(context.Context, *indexpb.ForceTaskRequest) (*commonpb.Status, error)) *MockDataCoord_ForceFailTask_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushAllState provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetFlushAllState(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
+// GcConfirm provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GcConfirm(_a0 context.Context, _a1 *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetFlushAllStateResponse
+	var r0 *datapb.GcConfirmResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) *milvuspb.GetFlushAllStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) *datapb.GcConfirmResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetFlushAllStateResponse)
+			r0 = ret.Get(0).(*datapb.GcConfirmResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetFlushAllStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcConfirmRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -944,53 +1164,53 @@ func (_m *MockDataCoord) GetFlushAllState(_a0 context.Context, _a1 *milvuspb.Get
 	return r0, r1
 }
 
-// MockDataCoord_GetFlushAllState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushAllState'
-type MockDataCoord_GetFlushAllState_Call struct {
+// MockDataCoord_GcConfirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcConfirm'
+type MockDataCoord_GcConfirm_Call struct {
 	*mock.Call
 }
 
-// GetFlushAllState is a helper method to define mock.On call
+// GcConfirm is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetFlushAllStateRequest
-func (_e *MockDataCoord_Expecter) GetFlushAllState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushAllState_Call {
-	return &MockDataCoord_GetFlushAllState_Call{Call: _e.mock.On("GetFlushAllState", _a0, _a1)}
+//   - _a1 *datapb.GcConfirmRequest
+func (_e *MockDataCoord_Expecter) GcConfirm(_a0 interface{}, _a1 interface{}) *MockDataCoord_GcConfirm_Call {
+	return &MockDataCoord_GcConfirm_Call{Call: _e.mock.On("GcConfirm", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetFlushAllState_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest)) *MockDataCoord_GetFlushAllState_Call {
+func (_c *MockDataCoord_GcConfirm_Call) Run(run func(_a0 context.Context, _a1 *datapb.GcConfirmRequest)) *MockDataCoord_GcConfirm_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetFlushAllStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GcConfirmRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushAllState_Call) Return(_a0 *milvuspb.GetFlushAllStateResponse, _a1 error) *MockDataCoord_GetFlushAllState_Call {
+func (_c *MockDataCoord_GcConfirm_Call) Return(_a0 *datapb.GcConfirmResponse, _a1 error) *MockDataCoord_GcConfirm_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushAllState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)) *MockDataCoord_GetFlushAllState_Call {
+func (_c *MockDataCoord_GcConfirm_Call) RunAndReturn(run func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)) *MockDataCoord_GcConfirm_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushState provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetFlushState(_a0 context.Context, _a1 *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+// GcControl provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GcControl(_a0 context.Context, _a1 *datapb.GcControlRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetFlushStateResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest) *milvuspb.GetFlushStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetFlushStateResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcControlRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -999,53 +1219,53 @@ func (_m *MockDataCoord) GetFlushState(_a0 context.Context, _a1 *datapb.GetFlush
 	return r0, r1
 }
 
-// MockDataCoord_GetFlushState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushState'
-type MockDataCoord_GetFlushState_Call struct {
+// MockDataCoord_GcControl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcControl'
+type MockDataCoord_GcControl_Call struct {
 	*mock.Call
 }
 
-// GetFlushState is a helper method to define mock.On call
+// GcControl is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetFlushStateRequest
-func (_e *MockDataCoord_Expecter) GetFlushState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushState_Call {
-	return &MockDataCoord_GetFlushState_Call{Call: _e.mock.On("GetFlushState", _a0, _a1)}
+//   - _a1 *datapb.GcControlRequest
+func (_e *MockDataCoord_Expecter) GcControl(_a0 interface{}, _a1 interface{}) *MockDataCoord_GcControl_Call {
+	return &MockDataCoord_GcControl_Call{Call: _e.mock.On("GcControl", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetFlushState_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetFlushStateRequest)) *MockDataCoord_GetFlushState_Call {
+func (_c *MockDataCoord_GcControl_Call) Run(run func(_a0 context.Context, _a1 *datapb.GcControlRequest)) *MockDataCoord_GcControl_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetFlushStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GcControlRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushState_Call) Return(_a0 *milvuspb.GetFlushStateResponse, _a1 error) *MockDataCoord_GetFlushState_Call {
+func (_c *MockDataCoord_GcControl_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_GcControl_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushState_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)) *MockDataCoord_GetFlushState_Call {
+func (_c *MockDataCoord_GcControl_Call) RunAndReturn(run func(context.Context, *datapb.GcControlRequest) (*commonpb.Status, error)) *MockDataCoord_GcControl_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushedSegments provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetFlushedSegments(_a0 context.Context, _a1 *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+// GcDryRun provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GcDryRun(_a0 context.Context, _a1 *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetFlushedSegmentsResponse
+	var r0 *datapb.GcDryRunResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) *datapb.GetFlushedSegmentsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcDryRunRequest) *datapb.GcDryRunResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetFlushedSegmentsResponse)
+			r0 = ret.Get(0).(*datapb.GcDryRunResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushedSegmentsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcDryRunRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1054,53 +1274,53 @@ func (_m *MockDataCoord) GetFlushedSegments(_a0 context.Context, _a1 *datapb.Get
 	return r0, r1
 }
 
-// MockDataCoord_GetFlushedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushedSegments'
-type MockDataCoord_GetFlushedSegments_Call struct {
+// MockDataCoord_GcDryRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcDryRun'
+type MockDataCoord_GcDryRun_Call struct {
 	*mock.Call
 }
 
-// GetFlushedSegments is a helper method to define mock.On call
+// GcDryRun is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetFlushedSegmentsRequest
-func (_e *MockDataCoord_Expecter) GetFlushedSegments(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushedSegments_Call {
-	return &MockDataCoord_GetFlushedSegments_Call{Call: _e.mock.On("GetFlushedSegments", _a0, _a1)}
+//   - _a1 *datapb.GcDryRunRequest
+func (_e *MockDataCoord_Expecter) GcDryRun(_a0 interface{}, _a1 interface{}) *MockDataCoord_GcDryRun_Call {
+	return &MockDataCoord_GcDryRun_Call{Call: _e.mock.On("GcDryRun", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetFlushedSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetFlushedSegmentsRequest)) *MockDataCoord_GetFlushedSegments_Call {
+func (_c *MockDataCoord_GcDryRun_Call) Run(run func(_a0 context.Context, _a1 *datapb.GcDryRunRequest)) *MockDataCoord_GcDryRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetFlushedSegmentsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GcDryRunRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushedSegments_Call) Return(_a0 *datapb.GetFlushedSegmentsResponse, _a1 error) *MockDataCoord_GetFlushedSegments_Call {
+func (_c *MockDataCoord_GcDryRun_Call) Return(_a0 *datapb.GcDryRunResponse, _a1 error) *MockDataCoord_GcDryRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)) *MockDataCoord_GetFlushedSegments_Call {
+func (_c *MockDataCoord_GcDryRun_Call) RunAndReturn(run func(context.Context, *datapb.GcDryRunRequest) (*datapb.GcDryRunResponse, error)) *MockDataCoord_GcDryRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetImportProgress provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetImportProgress(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
+// GetCollectionDataSummaries provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetCollectionDataSummaries(_a0 context.Context, _a1 *datapb.GetCollectionDataSummariesRequest) (*datapb.GetCollectionDataSummariesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *internalpb.GetImportProgressResponse
+	var r0 *datapb.GetCollectionDataSummariesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionDataSummariesRequest) (*datapb.GetCollectionDataSummariesResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest) *internalpb.GetImportProgressResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionDataSummariesRequest) *datapb.GetCollectionDataSummariesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.GetImportProgressResponse)
+			r0 = ret.Get(0).(*datapb.GetCollectionDataSummariesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetImportProgressRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCollectionDataSummariesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1109,53 +1329,53 @@ func (_m *MockDataCoord) GetImportProgress(_a0 context.Context, _a1 *internalpb.
 	return r0, r1
 }
 
-// MockDataCoord_GetImportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportProgress'
-type MockDataCoord_GetImportProgress_Call struct {
+// MockDataCoord_GetCollectionDataSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionDataSummaries'
+type MockDataCoord_GetCollectionDataSummaries_Call struct {
 	*mock.Call
 }
 
-// GetImportProgress is a helper method to define mock.On call
+// GetCollectionDataSummaries is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *internalpb.GetImportProgressRequest
-func (_e *MockDataCoord_Expecter) GetImportProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetImportProgress_Call {
-	return &MockDataCoord_GetImportProgress_Call{Call: _e.mock.On("GetImportProgress", _a0, _a1)}
+//   - _a1 *datapb.GetCollectionDataSummariesRequest
+func (_e *MockDataCoord_Expecter) GetCollectionDataSummaries(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCollectionDataSummaries_Call {
+	return &MockDataCoord_GetCollectionDataSummaries_Call{Call: _e.mock.On("GetCollectionDataSummaries", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetImportProgress_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest)) *MockDataCoord_GetImportProgress_Call {
+func (_c *MockDataCoord_GetCollectionDataSummaries_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetCollectionDataSummariesRequest)) *MockDataCoord_GetCollectionDataSummaries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*internalpb.GetImportProgressRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetCollectionDataSummariesRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetImportProgress_Call) Return(_a0 *internalpb.GetImportProgressResponse, _a1 error) *MockDataCoord_GetImportProgress_Call {
+func (_c *MockDataCoord_GetCollectionDataSummaries_Call) Return(_a0 *datapb.GetCollectionDataSummariesResponse, _a1 error) *MockDataCoord_GetCollectionDataSummaries_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetImportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)) *MockDataCoord_GetImportProgress_Call {
+func (_c *MockDataCoord_GetCollectionDataSummaries_Call) RunAndReturn(run func(context.Context, *datapb.GetCollectionDataSummariesRequest) (*datapb.GetCollectionDataSummariesResponse, error)) *MockDataCoord_GetCollectionDataSummaries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexBuildProgress provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetIndexBuildProgress(_a0 context.Context, _a1 *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+// GetCollectionStatistics provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetCollectionStatistics(_a0 context.Context, _a1 *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.GetIndexBuildProgressResponse
+	var r0 *datapb.GetCollectionStatisticsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) *indexpb.GetIndexBuildProgressResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) *datapb.GetCollectionStatisticsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexBuildProgressResponse)
+			r0 = ret.Get(0).(*datapb.GetCollectionStatisticsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCollectionStatisticsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1164,53 +1384,53 @@ func (_m *MockDataCoord) GetIndexBuildProgress(_a0 context.Context, _a1 *indexpb
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexBuildProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexBuildProgress'
-type MockDataCoord_GetIndexBuildProgress_Call struct {
+// MockDataCoord_GetCollectionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStatistics'
+type MockDataCoord_GetCollectionStatistics_Call struct {
 	*mock.Call
 }
 
-// GetIndexBuildProgress is a helper method to define mock.On call
+// GetCollectionStatistics is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.GetIndexBuildProgressRequest
-func (_e *MockDataCoord_Expecter) GetIndexBuildProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexBuildProgress_Call {
-	return &MockDataCoord_GetIndexBuildProgress_Call{Call: _e.mock.On("GetIndexBuildProgress", _a0, _a1)}
+//   - _a1 *datapb.GetCollectionStatisticsRequest
+func (_e *MockDataCoord_Expecter) GetCollectionStatistics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCollectionStatistics_Call {
+	return &MockDataCoord_GetCollectionStatistics_Call{Call: _e.mock.On("GetCollectionStatistics", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetIndexBuildProgress_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexBuildProgressRequest)) *MockDataCoord_GetIndexBuildProgress_Call {
+func (_c *MockDataCoord_GetCollectionStatistics_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetCollectionStatisticsRequest)) *MockDataCoord_GetCollectionStatistics_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexBuildProgressRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetCollectionStatisticsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexBuildProgress_Call) Return(_a0 *indexpb.GetIndexBuildProgressResponse, _a1 error) *MockDataCoord_GetIndexBuildProgress_Call {
+func (_c *MockDataCoord_GetCollectionStatistics_Call) Return(_a0 *datapb.GetCollectionStatisticsResponse, _a1 error) *MockDataCoord_GetCollectionStatistics_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexBuildProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)) *MockDataCoord_GetIndexBuildProgress_Call {
+func (_c *MockDataCoord_GetCollectionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)) *MockDataCoord_GetCollectionStatistics_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexInfos provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetIndexInfos(_a0 context.Context, _a1 *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error) {
+// GetCompactionPlanDetails provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetCompactionPlanDetails(_a0 context.Context, _a1 *datapb.GetCompactionPlanDetailsRequest) (*datapb.GetCompactionPlanDetailsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.GetIndexInfoResponse
+	var r0 *datapb.GetCompactionPlanDetailsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCompactionPlanDetailsRequest) (*datapb.GetCompactionPlanDetailsResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) *indexpb.GetIndexInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCompactionPlanDetailsRequest) *datapb.GetCompactionPlanDetailsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexInfoResponse)
+			r0 = ret.Get(0).(*datapb.GetCompactionPlanDetailsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexInfoRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCompactionPlanDetailsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1219,53 +1439,53 @@ func (_m *MockDataCoord) GetIndexInfos(_a0 context.Context, _a1 *indexpb.GetInde
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexInfos'
-type MockDataCoord_GetIndexInfos_Call struct {
+// MockDataCoord_GetCompactionPlanDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionPlanDetails'
+type MockDataCoord_GetCompactionPlanDetails_Call struct {
 	*mock.Call
 }
 
-// GetIndexInfos is a helper method to define mock.On call
+// GetCompactionPlanDetails is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.GetIndexInfoRequest
-func (_e *MockDataCoord_Expecter) GetIndexInfos(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexInfos_Call {
-	return &MockDataCoord_GetIndexInfos_Call{Call: _e.mock.On("GetIndexInfos", _a0, _a1)}
+//   - _a1 *datapb.GetCompactionPlanDetailsRequest
+func (_e *MockDataCoord_Expecter) GetCompactionPlanDetails(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCompactionPlanDetails_Call {
+	return &MockDataCoord_GetCompactionPlanDetails_Call{Call: _e.mock.On("GetCompactionPlanDetails", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetIndexInfos_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexInfoRequest)) *MockDataCoord_GetIndexInfos_Call {
+func (_c *MockDataCoord_GetCompactionPlanDetails_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetCompactionPlanDetailsRequest)) *MockDataCoord_GetCompactionPlanDetails_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexInfoRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetCompactionPlanDetailsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexInfos_Call) Return(_a0 *indexpb.GetIndexInfoResponse, _a1 error) *MockDataCoord_GetIndexInfos_Call {
+func (_c *MockDataCoord_GetCompactionPlanDetails_Call) Return(_a0 *datapb.GetCompactionPlanDetailsResponse, _a1 error) *MockDataCoord_GetCompactionPlanDetails_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexInfos_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)) *MockDataCoord_GetIndexInfos_Call {
+func (_c *MockDataCoord_GetCompactionPlanDetails_Call) RunAndReturn(run func(context.Context, *datapb.GetCompactionPlanDetailsRequest) (*datapb.GetCompactionPlanDetailsResponse, error)) *MockDataCoord_GetCompactionPlanDetails_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexState provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetIndexState(_a0 context.Context, _a1 *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+// GetCompactionState provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetCompactionState(_a0 context.Context, _a1 *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.GetIndexStateResponse
+	var r0 *milvuspb.GetCompactionStateResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) *indexpb.GetIndexStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) *milvuspb.GetCompactionStateResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexStateResponse)
+			r0 = ret.Get(0).(*milvuspb.GetCompactionStateResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionStateRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1274,53 +1494,53 @@ func (_m *MockDataCoord) GetIndexState(_a0 context.Context, _a1 *indexpb.GetInde
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexState'
-type MockDataCoord_GetIndexState_Call struct {
+// MockDataCoord_GetCompactionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionState'
+type MockDataCoord_GetCompactionState_Call struct {
 	*mock.Call
 }
 
-// GetIndexState is a helper method to define mock.On call
+// GetCompactionState is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.GetIndexStateRequest
-func (_e *MockDataCoord_Expecter) GetIndexState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexState_Call {
-	return &MockDataCoord_GetIndexState_Call{Call: _e.mock.On("GetIndexState", _a0, _a1)}
+//   - _a1 *milvuspb.GetCompactionStateRequest
+func (_e *MockDataCoord_Expecter) GetCompactionState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCompactionState_Call {
+	return &MockDataCoord_GetCompactionState_Call{Call: _e.mock.On("GetCompactionState", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetIndexState_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexStateRequest)) *MockDataCoord_GetIndexState_Call {
+func (_c *MockDataCoord_GetCompactionState_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetCompactionStateRequest)) *MockDataCoord_GetCompactionState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStateRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionStateRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexState_Call) Return(_a0 *indexpb.GetIndexStateResponse, _a1 error) *MockDataCoord_GetIndexState_Call {
+func (_c *MockDataCoord_GetCompactionState_Call) Return(_a0 *milvuspb.GetCompactionStateResponse, _a1 error) *MockDataCoord_GetCompactionState_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)) *MockDataCoord_GetIndexState_Call {
+func (_c *MockDataCoord_GetCompactionState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)) *MockDataCoord_GetCompactionState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexStatistics provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetIndexStatistics(_a0 context.Context, _a1 *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+// GetCompactionStateWithPlans provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetCompactionStateWithPlans(_a0 context.Context, _a1 *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.GetIndexStatisticsResponse
+	var r0 *milvuspb.GetCompactionPlansResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) *indexpb.GetIndexStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) *milvuspb.GetCompactionPlansResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexStatisticsResponse)
+			r0 = ret.Get(0).(*milvuspb.GetCompactionPlansResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStatisticsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionPlansRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1329,37 +1549,642 @@ func (_m *MockDataCoord) GetIndexStatistics(_a0 context.Context, _a1 *indexpb.Ge
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexStatistics'
-type MockDataCoord_GetIndexStatistics_Call struct {
+// MockDataCoord_GetCompactionStateWithPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionStateWithPlans'
+type MockDataCoord_GetCompactionStateWithPlans_Call struct {
 	*mock.Call
 }
 
-// GetIndexStatistics is a helper method to define mock.On call
+// GetCompactionStateWithPlans is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.GetIndexStatisticsRequest
-func (_e *MockDataCoord_Expecter) GetIndexStatistics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexStatistics_Call {
-	return &MockDataCoord_GetIndexStatistics_Call{Call: _e.mock.On("GetIndexStatistics", _a0, _a1)}
+//   - _a1 *milvuspb.GetCompactionPlansRequest
+func (_e *MockDataCoord_Expecter) GetCompactionStateWithPlans(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCompactionStateWithPlans_Call {
+	return &MockDataCoord_GetCompactionStateWithPlans_Call{Call: _e.mock.On("GetCompactionStateWithPlans", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetIndexStatistics_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexStatisticsRequest)) *MockDataCoord_GetIndexStatistics_Call {
+func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetCompactionPlansRequest)) *MockDataCoord_GetCompactionStateWithPlans_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStatisticsRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionPlansRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexStatistics_Call) Return(_a0 *indexpb.GetIndexStatisticsResponse, _a1 error) *MockDataCoord_GetIndexStatistics_Call {
+func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Return(_a0 *milvuspb.GetCompactionPlansResponse, _a1 error) *MockDataCoord_GetCompactionStateWithPlans_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexStatistics_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)) *MockDataCoord_GetIndexStatistics_Call {
+func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)) *MockDataCoord_GetCompactionStateWithPlans_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetInsertBinlogPaths provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetInsertBinlogPaths(_a0 context.Context, _a1 *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error) {
+// GetComponentStates provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.ComponentStates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) *milvuspb.ComponentStates); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
+type MockDataCoord_GetComponentStates_Call struct {
+	*mock.Call
+}
+
+// GetComponentStates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetComponentStatesRequest
+func (_e *MockDataCoord_Expecter) GetComponentStates(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetComponentStates_Call {
+	return &MockDataCoord_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetComponentStates_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest)) *MockDataCoord_GetComponentStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockDataCoord_GetComponentStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)) *MockDataCoord_GetComponentStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDuplicatePrimaryKeysReport provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetDuplicatePrimaryKeysReport(_a0 context.Context, _a1 *datapb.GetDuplicatePrimaryKeysReportRequest) (*datapb.GetDuplicatePrimaryKeysReportResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetDuplicatePrimaryKeysReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetDuplicatePrimaryKeysReportRequest) (*datapb.GetDuplicatePrimaryKeysReportResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetDuplicatePrimaryKeysReportRequest) *datapb.GetDuplicatePrimaryKeysReportResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetDuplicatePrimaryKeysReportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetDuplicatePrimaryKeysReportRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetDuplicatePrimaryKeysReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDuplicatePrimaryKeysReport'
+type MockDataCoord_GetDuplicatePrimaryKeysReport_Call struct {
+	*mock.Call
+}
+
+// GetDuplicatePrimaryKeysReport is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetDuplicatePrimaryKeysReportRequest
+func (_e *MockDataCoord_Expecter) GetDuplicatePrimaryKeysReport(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetDuplicatePrimaryKeysReport_Call {
+	return &MockDataCoord_GetDuplicatePrimaryKeysReport_Call{Call: _e.mock.On("GetDuplicatePrimaryKeysReport", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetDuplicatePrimaryKeysReport_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetDuplicatePrimaryKeysReportRequest)) *MockDataCoord_GetDuplicatePrimaryKeysReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetDuplicatePrimaryKeysReportRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetDuplicatePrimaryKeysReport_Call) Return(_a0 *datapb.GetDuplicatePrimaryKeysReportResponse, _a1 error) *MockDataCoord_GetDuplicatePrimaryKeysReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetDuplicatePrimaryKeysReport_Call) RunAndReturn(run func(context.Context, *datapb.GetDuplicatePrimaryKeysReportRequest) (*datapb.GetDuplicatePrimaryKeysReportResponse, error)) *MockDataCoord_GetDuplicatePrimaryKeysReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExportProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetExportProgress(_a0 context.Context, _a1 *datapb.GetExportProgressRequest) (*datapb.GetExportProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetExportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportProgressRequest) (*datapb.GetExportProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportProgressRequest) *datapb.GetExportProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetExportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetExportProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetExportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportProgress'
+type MockDataCoord_GetExportProgress_Call struct {
+	*mock.Call
+}
+
+// GetExportProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetExportProgressRequest
+func (_e *MockDataCoord_Expecter) GetExportProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetExportProgress_Call {
+	return &MockDataCoord_GetExportProgress_Call{Call: _e.mock.On("GetExportProgress", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetExportProgress_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetExportProgressRequest)) *MockDataCoord_GetExportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetExportProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetExportProgress_Call) Return(_a0 *datapb.GetExportProgressResponse, _a1 error) *MockDataCoord_GetExportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetExportProgress_Call) RunAndReturn(run func(context.Context, *datapb.GetExportProgressRequest) (*datapb.GetExportProgressResponse, error)) *MockDataCoord_GetExportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlushAllState provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetFlushAllState(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.GetFlushAllStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) *milvuspb.GetFlushAllStateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetFlushAllStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetFlushAllStateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetFlushAllState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushAllState'
+type MockDataCoord_GetFlushAllState_Call struct {
+	*mock.Call
+}
+
+// GetFlushAllState is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetFlushAllStateRequest
+func (_e *MockDataCoord_Expecter) GetFlushAllState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushAllState_Call {
+	return &MockDataCoord_GetFlushAllState_Call{Call: _e.mock.On("GetFlushAllState", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetFlushAllState_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest)) *MockDataCoord_GetFlushAllState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetFlushAllStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushAllState_Call) Return(_a0 *milvuspb.GetFlushAllStateResponse, _a1 error) *MockDataCoord_GetFlushAllState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushAllState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)) *MockDataCoord_GetFlushAllState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlushState provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetFlushState(_a0 context.Context, _a1 *datapb.GetFlushStateRequest) (*datapb.GetFlushStateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetFlushStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest) (*datapb.GetFlushStateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest) *datapb.GetFlushStateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetFlushStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushStateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetFlushState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushState'
+type MockDataCoord_GetFlushState_Call struct {
+	*mock.Call
+}
+
+// GetFlushState is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetFlushStateRequest
+func (_e *MockDataCoord_Expecter) GetFlushState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushState_Call {
+	return &MockDataCoord_GetFlushState_Call{Call: _e.mock.On("GetFlushState", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetFlushState_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetFlushStateRequest)) *MockDataCoord_GetFlushState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetFlushStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushState_Call) Return(_a0 *datapb.GetFlushStateResponse, _a1 error) *MockDataCoord_GetFlushState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushState_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushStateRequest) (*datapb.GetFlushStateResponse, error)) *MockDataCoord_GetFlushState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlushedSegments provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetFlushedSegments(_a0 context.Context, _a1 *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetFlushedSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) *datapb.GetFlushedSegmentsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetFlushedSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushedSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetFlushedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushedSegments'
+type MockDataCoord_GetFlushedSegments_Call struct {
+	*mock.Call
+}
+
+// GetFlushedSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetFlushedSegmentsRequest
+func (_e *MockDataCoord_Expecter) GetFlushedSegments(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushedSegments_Call {
+	return &MockDataCoord_GetFlushedSegments_Call{Call: _e.mock.On("GetFlushedSegments", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetFlushedSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetFlushedSegmentsRequest)) *MockDataCoord_GetFlushedSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetFlushedSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushedSegments_Call) Return(_a0 *datapb.GetFlushedSegmentsResponse, _a1 error) *MockDataCoord_GetFlushedSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)) *MockDataCoord_GetFlushedSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImportProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetImportProgress(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.GetImportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest) *internalpb.GetImportProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetImportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetImportProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetImportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportProgress'
+type MockDataCoord_GetImportProgress_Call struct {
+	*mock.Call
+}
+
+// GetImportProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetImportProgressRequest
+func (_e *MockDataCoord_Expecter) GetImportProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetImportProgress_Call {
+	return &MockDataCoord_GetImportProgress_Call{Call: _e.mock.On("GetImportProgress", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetImportProgress_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest)) *MockDataCoord_GetImportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetImportProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetImportProgress_Call) Return(_a0 *internalpb.GetImportProgressResponse, _a1 error) *MockDataCoord_GetImportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetImportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)) *MockDataCoord_GetImportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexBuildProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetIndexBuildProgress(_a0 context.Context, _a1 *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.GetIndexBuildProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) *indexpb.GetIndexBuildProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexBuildProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexBuildProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexBuildProgress'
+type MockDataCoord_GetIndexBuildProgress_Call struct {
+	*mock.Call
+}
+
+// GetIndexBuildProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetIndexBuildProgressRequest
+func (_e *MockDataCoord_Expecter) GetIndexBuildProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexBuildProgress_Call {
+	return &MockDataCoord_GetIndexBuildProgress_Call{Call: _e.mock.On("GetIndexBuildProgress", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetIndexBuildProgress_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexBuildProgressRequest)) *MockDataCoord_GetIndexBuildProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexBuildProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexBuildProgress_Call) Return(_a0 *indexpb.GetIndexBuildProgressResponse, _a1 error) *MockDataCoord_GetIndexBuildProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexBuildProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)) *MockDataCoord_GetIndexBuildProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexInfos provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetIndexInfos(_a0 context.Context, _a1 *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.GetIndexInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) *indexpb.GetIndexInfoResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexInfoRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexInfos'
+type MockDataCoord_GetIndexInfos_Call struct {
+	*mock.Call
+}
+
+// GetIndexInfos is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetIndexInfoRequest
+func (_e *MockDataCoord_Expecter) GetIndexInfos(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexInfos_Call {
+	return &MockDataCoord_GetIndexInfos_Call{Call: _e.mock.On("GetIndexInfos", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetIndexInfos_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexInfoRequest)) *MockDataCoord_GetIndexInfos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexInfos_Call) Return(_a0 *indexpb.GetIndexInfoResponse, _a1 error) *MockDataCoord_GetIndexInfos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexInfos_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)) *MockDataCoord_GetIndexInfos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexState provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetIndexState(_a0 context.Context, _a1 *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.GetIndexStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) *indexpb.GetIndexStateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexState'
+type MockDataCoord_GetIndexState_Call struct {
+	*mock.Call
+}
+
+// GetIndexState is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetIndexStateRequest
+func (_e *MockDataCoord_Expecter) GetIndexState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexState_Call {
+	return &MockDataCoord_GetIndexState_Call{Call: _e.mock.On("GetIndexState", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetIndexState_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexStateRequest)) *MockDataCoord_GetIndexState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexState_Call) Return(_a0 *indexpb.GetIndexStateResponse, _a1 error) *MockDataCoord_GetIndexState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)) *MockDataCoord_GetIndexState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexStatistics provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetIndexStatistics(_a0 context.Context, _a1 *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.GetIndexStatisticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) *indexpb.GetIndexStatisticsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexStatisticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStatisticsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexStatistics'
+type MockDataCoord_GetIndexStatistics_Call struct {
+	*mock.Call
+}
+
+// GetIndexStatistics is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetIndexStatisticsRequest
+func (_e *MockDataCoord_Expecter) GetIndexStatistics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexStatistics_Call {
+	return &MockDataCoord_GetIndexStatistics_Call{Call: _e.mock.On("GetIndexStatistics", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetIndexStatistics_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexStatisticsRequest)) *MockDataCoord_GetIndexStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStatisticsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexStatistics_Call) Return(_a0 *indexpb.GetIndexStatisticsResponse, _a1 error) *MockDataCoord_GetIndexStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexStatistics_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)) *MockDataCoord_GetIndexStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInsertBinlogPaths provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetInsertBinlogPaths(_a0 context.Context, _a1 *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *datapb.GetInsertBinlogPathsResponse
@@ -1633,6 +2458,61 @@ func (_c *MockDataCoord_GetRecoveryInfoV2_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetRegionReplicaStatus provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetRegionReplicaStatus(_a0 context.Context, _a1 *datapb.GetRegionReplicaStatusRequest) (*datapb.GetRegionReplicaStatusResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetRegionReplicaStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRegionReplicaStatusRequest) (*datapb.GetRegionReplicaStatusResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRegionReplicaStatusRequest) *datapb.GetRegionReplicaStatusResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetRegionReplicaStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRegionReplicaStatusRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetRegionReplicaStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRegionReplicaStatus'
+type MockDataCoord_GetRegionReplicaStatus_Call struct {
+	*mock.Call
+}
+
+// GetRegionReplicaStatus is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetRegionReplicaStatusRequest
+func (_e *MockDataCoord_Expecter) GetRegionReplicaStatus(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetRegionReplicaStatus_Call {
+	return &MockDataCoord_GetRegionReplicaStatus_Call{Call: _e.mock.On("GetRegionReplicaStatus", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetRegionReplicaStatus_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetRegionReplicaStatusRequest)) *MockDataCoord_GetRegionReplicaStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetRegionReplicaStatusRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetRegionReplicaStatus_Call) Return(_a0 *datapb.GetRegionReplicaStatusResponse, _a1 error) *MockDataCoord_GetRegionReplicaStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetRegionReplicaStatus_Call) RunAndReturn(run func(context.Context, *datapb.GetRegionReplicaStatusRequest) (*datapb.GetRegionReplicaStatusResponse, error)) *MockDataCoord_GetRegionReplicaStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSegmentIndexState provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) GetSegmentIndexState(_a0 context.Context, _a1 *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2077,39 +2957,149 @@ func (_c *MockDataCoord_ImportV2_Call) RunAndReturn(run func(context.Context, *i
 func (_m *MockDataCoord) Init() error {
 	ret := _m.Called()
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDataCoord_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
+type MockDataCoord_Init_Call struct {
+	*mock.Call
+}
+
+// Init is a helper method to define mock.On call
+func (_e *MockDataCoord_Expecter) Init() *MockDataCoord_Init_Call {
+	return &MockDataCoord_Init_Call{Call: _e.mock.On("Init")}
+}
+
+func (_c *MockDataCoord_Init_Call) Run(run func()) *MockDataCoord_Init_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_Init_Call) Return(_a0 error) *MockDataCoord_Init_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDataCoord_Init_Call) RunAndReturn(run func() error) *MockDataCoord_Init_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDatabaseTaskQuotas provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListDatabaseTaskQuotas(_a0 context.Context, _a1 *datapb.ListDatabaseTaskQuotasRequest) (*datapb.ListDatabaseTaskQuotasResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.ListDatabaseTaskQuotasResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListDatabaseTaskQuotasRequest) (*datapb.ListDatabaseTaskQuotasResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListDatabaseTaskQuotasRequest) *datapb.ListDatabaseTaskQuotasResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListDatabaseTaskQuotasResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListDatabaseTaskQuotasRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ListDatabaseTaskQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDatabaseTaskQuotas'
+type MockDataCoord_ListDatabaseTaskQuotas_Call struct {
+	*mock.Call
+}
+
+// ListDatabaseTaskQuotas is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListDatabaseTaskQuotasRequest
+func (_e *MockDataCoord_Expecter) ListDatabaseTaskQuotas(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListDatabaseTaskQuotas_Call {
+	return &MockDataCoord_ListDatabaseTaskQuotas_Call{Call: _e.mock.On("ListDatabaseTaskQuotas", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ListDatabaseTaskQuotas_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListDatabaseTaskQuotasRequest)) *MockDataCoord_ListDatabaseTaskQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ListDatabaseTaskQuotasRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ListDatabaseTaskQuotas_Call) Return(_a0 *datapb.ListDatabaseTaskQuotasResponse, _a1 error) *MockDataCoord_ListDatabaseTaskQuotas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ListDatabaseTaskQuotas_Call) RunAndReturn(run func(context.Context, *datapb.ListDatabaseTaskQuotasRequest) (*datapb.ListDatabaseTaskQuotasResponse, error)) *MockDataCoord_ListDatabaseTaskQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpiryCompactions provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListExpiryCompactions(_a0 context.Context, _a1 *datapb.ListExpiryCompactionsRequest) (*datapb.ListExpiryCompactionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.ListExpiryCompactionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExpiryCompactionsRequest) (*datapb.ListExpiryCompactionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExpiryCompactionsRequest) *datapb.ListExpiryCompactionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListExpiryCompactionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListExpiryCompactionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// MockDataCoord_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
-type MockDataCoord_Init_Call struct {
+// MockDataCoord_ListExpiryCompactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpiryCompactions'
+type MockDataCoord_ListExpiryCompactions_Call struct {
 	*mock.Call
 }
 
-// Init is a helper method to define mock.On call
-func (_e *MockDataCoord_Expecter) Init() *MockDataCoord_Init_Call {
-	return &MockDataCoord_Init_Call{Call: _e.mock.On("Init")}
+// ListExpiryCompactions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ListExpiryCompactionsRequest
+func (_e *MockDataCoord_Expecter) ListExpiryCompactions(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListExpiryCompactions_Call {
+	return &MockDataCoord_ListExpiryCompactions_Call{Call: _e.mock.On("ListExpiryCompactions", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_Init_Call) Run(run func()) *MockDataCoord_Init_Call {
+func (_c *MockDataCoord_ListExpiryCompactions_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListExpiryCompactionsRequest)) *MockDataCoord_ListExpiryCompactions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(*datapb.ListExpiryCompactionsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_Init_Call) Return(_a0 error) *MockDataCoord_Init_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDataCoord_ListExpiryCompactions_Call) Return(_a0 *datapb.ListExpiryCompactionsResponse, _a1 error) *MockDataCoord_ListExpiryCompactions_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_Init_Call) RunAndReturn(run func() error) *MockDataCoord_Init_Call {
+func (_c *MockDataCoord_ListExpiryCompactions_Call) RunAndReturn(run func(context.Context, *datapb.ListExpiryCompactionsRequest) (*datapb.ListExpiryCompactionsResponse, error)) *MockDataCoord_ListExpiryCompactions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2169,6 +3159,61 @@ func (_c *MockDataCoord_ListImports_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListIndexTasks provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListIndexTasks(_a0 context.Context, _a1 *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.ListIndexTasksResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexTasksRequest) *indexpb.ListIndexTasksResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.ListIndexTasksResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ListIndexTasksRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ListIndexTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIndexTasks'
+type MockDataCoord_ListIndexTasks_Call struct {
+	*mock.Call
+}
+
+// ListIndexTasks is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.ListIndexTasksRequest
+func (_e *MockDataCoord_Expecter) ListIndexTasks(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListIndexTasks_Call {
+	return &MockDataCoord_ListIndexTasks_Call{Call: _e.mock.On("ListIndexTasks", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ListIndexTasks_Call) Run(run func(_a0 context.Context, _a1 *indexpb.ListIndexTasksRequest)) *MockDataCoord_ListIndexTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.ListIndexTasksRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ListIndexTasks_Call) Return(_a0 *indexpb.ListIndexTasksResponse, _a1 error) *MockDataCoord_ListIndexTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ListIndexTasks_Call) RunAndReturn(run func(context.Context, *indexpb.ListIndexTasksRequest) (*indexpb.ListIndexTasksResponse, error)) *MockDataCoord_ListIndexTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListIndexes provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) ListIndexes(_a0 context.Context, _a1 *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2334,6 +3379,171 @@ func (_c *MockDataCoord_MarkSegmentsDropped_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// PauseIndexBuilds provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) PauseIndexBuilds(_a0 context.Context, _a1 *indexpb.PauseIndexBuildsRequest) (*indexpb.PauseIndexBuildsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.PauseIndexBuildsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.PauseIndexBuildsRequest) (*indexpb.PauseIndexBuildsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.PauseIndexBuildsRequest) *indexpb.PauseIndexBuildsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.PauseIndexBuildsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.PauseIndexBuildsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_PauseIndexBuilds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseIndexBuilds'
+type MockDataCoord_PauseIndexBuilds_Call struct {
+	*mock.Call
+}
+
+// PauseIndexBuilds is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.PauseIndexBuildsRequest
+func (_e *MockDataCoord_Expecter) PauseIndexBuilds(_a0 interface{}, _a1 interface{}) *MockDataCoord_PauseIndexBuilds_Call {
+	return &MockDataCoord_PauseIndexBuilds_Call{Call: _e.mock.On("PauseIndexBuilds", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_PauseIndexBuilds_Call) Run(run func(_a0 context.Context, _a1 *indexpb.PauseIndexBuildsRequest)) *MockDataCoord_PauseIndexBuilds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.PauseIndexBuildsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_PauseIndexBuilds_Call) Return(_a0 *indexpb.PauseIndexBuildsResponse, _a1 error) *MockDataCoord_PauseIndexBuilds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_PauseIndexBuilds_Call) RunAndReturn(run func(context.Context, *indexpb.PauseIndexBuildsRequest) (*indexpb.PauseIndexBuildsResponse, error)) *MockDataCoord_PauseIndexBuilds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewCompaction provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) PreviewCompaction(_a0 context.Context, _a1 *datapb.PreviewCompactionRequest) (*datapb.PreviewCompactionResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.PreviewCompactionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PreviewCompactionRequest) (*datapb.PreviewCompactionResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PreviewCompactionRequest) *datapb.PreviewCompactionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.PreviewCompactionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.PreviewCompactionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_PreviewCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewCompaction'
+type MockDataCoord_PreviewCompaction_Call struct {
+	*mock.Call
+}
+
+// PreviewCompaction is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.PreviewCompactionRequest
+func (_e *MockDataCoord_Expecter) PreviewCompaction(_a0 interface{}, _a1 interface{}) *MockDataCoord_PreviewCompaction_Call {
+	return &MockDataCoord_PreviewCompaction_Call{Call: _e.mock.On("PreviewCompaction", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_PreviewCompaction_Call) Run(run func(_a0 context.Context, _a1 *datapb.PreviewCompactionRequest)) *MockDataCoord_PreviewCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.PreviewCompactionRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_PreviewCompaction_Call) Return(_a0 *datapb.PreviewCompactionResponse, _a1 error) *MockDataCoord_PreviewCompaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_PreviewCompaction_Call) RunAndReturn(run func(context.Context, *datapb.PreviewCompactionRequest) (*datapb.PreviewCompactionResponse, error)) *MockDataCoord_PreviewCompaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebuildIncompatibleIndexes provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) RebuildIncompatibleIndexes(_a0 context.Context, _a1 *indexpb.RebuildIncompatibleIndexesRequest) (*indexpb.RebuildIncompatibleIndexesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.RebuildIncompatibleIndexesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.RebuildIncompatibleIndexesRequest) (*indexpb.RebuildIncompatibleIndexesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.RebuildIncompatibleIndexesRequest) *indexpb.RebuildIncompatibleIndexesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.RebuildIncompatibleIndexesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.RebuildIncompatibleIndexesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_RebuildIncompatibleIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildIncompatibleIndexes'
+type MockDataCoord_RebuildIncompatibleIndexes_Call struct {
+	*mock.Call
+}
+
+// RebuildIncompatibleIndexes is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.RebuildIncompatibleIndexesRequest
+func (_e *MockDataCoord_Expecter) RebuildIncompatibleIndexes(_a0 interface{}, _a1 interface{}) *MockDataCoord_RebuildIncompatibleIndexes_Call {
+	return &MockDataCoord_RebuildIncompatibleIndexes_Call{Call: _e.mock.On("RebuildIncompatibleIndexes", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_RebuildIncompatibleIndexes_Call) Run(run func(_a0 context.Context, _a1 *indexpb.RebuildIncompatibleIndexesRequest)) *MockDataCoord_RebuildIncompatibleIndexes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.RebuildIncompatibleIndexesRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_RebuildIncompatibleIndexes_Call) Return(_a0 *indexpb.RebuildIncompatibleIndexesResponse, _a1 error) *MockDataCoord_RebuildIncompatibleIndexes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_RebuildIncompatibleIndexes_Call) RunAndReturn(run func(context.Context, *indexpb.RebuildIncompatibleIndexesRequest) (*indexpb.RebuildIncompatibleIndexesResponse, error)) *MockDataCoord_RebuildIncompatibleIndexes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields:
 func (_m *MockDataCoord) Register() error {
 	ret := _m.Called()
@@ -2408,6 +3618,61 @@ func (_c *MockDataCoord_RegisterStreamingCoordGRPCService_Call) RunAndReturn(run
 	return _c
 }
 
+// ReleaseSegmentReference provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ReleaseSegmentReference(_a0 context.Context, _a1 *datapb.ReleaseSegmentReferenceRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReleaseSegmentReferenceRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReleaseSegmentReferenceRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReleaseSegmentReferenceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ReleaseSegmentReference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseSegmentReference'
+type MockDataCoord_ReleaseSegmentReference_Call struct {
+	*mock.Call
+}
+
+// ReleaseSegmentReference is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ReleaseSegmentReferenceRequest
+func (_e *MockDataCoord_Expecter) ReleaseSegmentReference(_a0 interface{}, _a1 interface{}) *MockDataCoord_ReleaseSegmentReference_Call {
+	return &MockDataCoord_ReleaseSegmentReference_Call{Call: _e.mock.On("ReleaseSegmentReference", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ReleaseSegmentReference_Call) Run(run func(_a0 context.Context, _a1 *datapb.ReleaseSegmentReferenceRequest)) *MockDataCoord_ReleaseSegmentReference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ReleaseSegmentReferenceRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ReleaseSegmentReference_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ReleaseSegmentReference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ReleaseSegmentReference_Call) RunAndReturn(run func(context.Context, *datapb.ReleaseSegmentReferenceRequest) (*commonpb.Status, error)) *MockDataCoord_ReleaseSegmentReference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReportDataNodeTtMsgs provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) ReportDataNodeTtMsgs(_a0 context.Context, _a1 *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2463,6 +3728,61 @@ func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// ResumeIndexBuilds provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ResumeIndexBuilds(_a0 context.Context, _a1 *indexpb.ResumeIndexBuildsRequest) (*indexpb.ResumeIndexBuildsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.ResumeIndexBuildsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ResumeIndexBuildsRequest) (*indexpb.ResumeIndexBuildsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ResumeIndexBuildsRequest) *indexpb.ResumeIndexBuildsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.ResumeIndexBuildsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ResumeIndexBuildsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ResumeIndexBuilds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeIndexBuilds'
+type MockDataCoord_ResumeIndexBuilds_Call struct {
+	*mock.Call
+}
+
+// ResumeIndexBuilds is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.ResumeIndexBuildsRequest
+func (_e *MockDataCoord_Expecter) ResumeIndexBuilds(_a0 interface{}, _a1 interface{}) *MockDataCoord_ResumeIndexBuilds_Call {
+	return &MockDataCoord_ResumeIndexBuilds_Call{Call: _e.mock.On("ResumeIndexBuilds", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ResumeIndexBuilds_Call) Run(run func(_a0 context.Context, _a1 *indexpb.ResumeIndexBuildsRequest)) *MockDataCoord_ResumeIndexBuilds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.ResumeIndexBuildsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ResumeIndexBuilds_Call) Return(_a0 *indexpb.ResumeIndexBuildsResponse, _a1 error) *MockDataCoord_ResumeIndexBuilds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ResumeIndexBuilds_Call) RunAndReturn(run func(context.Context, *indexpb.ResumeIndexBuildsRequest) (*indexpb.ResumeIndexBuildsResponse, error)) *MockDataCoord_ResumeIndexBuilds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveBinlogPaths provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) SaveBinlogPaths(_a0 context.Context, _a1 *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
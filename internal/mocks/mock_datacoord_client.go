@@ -33,6 +33,76 @@ func (_m *MockDataCoordClient) EXPECT() *MockDataCoordClient_Expecter {
 	return &MockDataCoordClient_Expecter{mock: &_m.Mock}
 }
 
+// AcquireSegmentReference provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) AcquireSegmentReference(ctx context.Context, in *datapb.AcquireSegmentReferenceRequest, opts ...grpc.CallOption) (*datapb.AcquireSegmentReferenceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.AcquireSegmentReferenceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireSegmentReferenceRequest, ...grpc.CallOption) (*datapb.AcquireSegmentReferenceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AcquireSegmentReferenceRequest, ...grpc.CallOption) *datapb.AcquireSegmentReferenceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.AcquireSegmentReferenceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.AcquireSegmentReferenceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_AcquireSegmentReference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcquireSegmentReference'
+type MockDataCoordClient_AcquireSegmentReference_Call struct {
+	*mock.Call
+}
+
+// AcquireSegmentReference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.AcquireSegmentReferenceRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) AcquireSegmentReference(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_AcquireSegmentReference_Call {
+	return &MockDataCoordClient_AcquireSegmentReference_Call{Call: _e.mock.On("AcquireSegmentReference",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_AcquireSegmentReference_Call) Run(run func(ctx context.Context, in *datapb.AcquireSegmentReferenceRequest, opts ...grpc.CallOption)) *MockDataCoordClient_AcquireSegmentReference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.AcquireSegmentReferenceRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_AcquireSegmentReference_Call) Return(_a0 *datapb.AcquireSegmentReferenceResponse, _a1 error) *MockDataCoordClient_AcquireSegmentReference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_AcquireSegmentReference_Call) RunAndReturn(run func(context.Context, *datapb.AcquireSegmentReferenceRequest, ...grpc.CallOption) (*datapb.AcquireSegmentReferenceResponse, error)) *MockDataCoordClient_AcquireSegmentReference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocSegment provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) AllocSegment(ctx context.Context, in *datapb.AllocSegmentRequest, opts ...grpc.CallOption) (*datapb.AllocSegmentResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -313,6 +383,76 @@ func (_c *MockDataCoordClient_BroadcastAlteredCollection_Call) RunAndReturn(run
 	return _c
 }
 
+// CheckDuplicatePrimaryKeys provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) CheckDuplicatePrimaryKeys(ctx context.Context, in *datapb.CheckDuplicatePrimaryKeysRequest, opts ...grpc.CallOption) (*datapb.CheckDuplicatePrimaryKeysResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.CheckDuplicatePrimaryKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CheckDuplicatePrimaryKeysRequest, ...grpc.CallOption) (*datapb.CheckDuplicatePrimaryKeysResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CheckDuplicatePrimaryKeysRequest, ...grpc.CallOption) *datapb.CheckDuplicatePrimaryKeysResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CheckDuplicatePrimaryKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CheckDuplicatePrimaryKeysRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_CheckDuplicatePrimaryKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckDuplicatePrimaryKeys'
+type MockDataCoordClient_CheckDuplicatePrimaryKeys_Call struct {
+	*mock.Call
+}
+
+// CheckDuplicatePrimaryKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CheckDuplicatePrimaryKeysRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) CheckDuplicatePrimaryKeys(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_CheckDuplicatePrimaryKeys_Call {
+	return &MockDataCoordClient_CheckDuplicatePrimaryKeys_Call{Call: _e.mock.On("CheckDuplicatePrimaryKeys",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_CheckDuplicatePrimaryKeys_Call) Run(run func(ctx context.Context, in *datapb.CheckDuplicatePrimaryKeysRequest, opts ...grpc.CallOption)) *MockDataCoordClient_CheckDuplicatePrimaryKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CheckDuplicatePrimaryKeysRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_CheckDuplicatePrimaryKeys_Call) Return(_a0 *datapb.CheckDuplicatePrimaryKeysResponse, _a1 error) *MockDataCoordClient_CheckDuplicatePrimaryKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_CheckDuplicatePrimaryKeys_Call) RunAndReturn(run func(context.Context, *datapb.CheckDuplicatePrimaryKeysRequest, ...grpc.CallOption) (*datapb.CheckDuplicatePrimaryKeysResponse, error)) *MockDataCoordClient_CheckDuplicatePrimaryKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -383,6 +523,76 @@ func (_c *MockDataCoordClient_CheckHealth_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// CloneSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) CloneSegments(ctx context.Context, in *datapb.CloneSegmentsRequest, opts ...grpc.CallOption) (*datapb.CloneSegmentsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.CloneSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) (*datapb.CloneSegmentsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) *datapb.CloneSegmentsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.CloneSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_CloneSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneSegments'
+type MockDataCoordClient_CloneSegments_Call struct {
+	*mock.Call
+}
+
+// CloneSegments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.CloneSegmentsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) CloneSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_CloneSegments_Call {
+	return &MockDataCoordClient_CloneSegments_Call{Call: _e.mock.On("CloneSegments",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_CloneSegments_Call) Run(run func(ctx context.Context, in *datapb.CloneSegmentsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_CloneSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.CloneSegmentsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_CloneSegments_Call) Return(_a0 *datapb.CloneSegmentsResponse, _a1 error) *MockDataCoordClient_CloneSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_CloneSegments_Call) RunAndReturn(run func(context.Context, *datapb.CloneSegmentsRequest, ...grpc.CallOption) (*datapb.CloneSegmentsResponse, error)) *MockDataCoordClient_CloneSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *MockDataCoordClient) Close() error {
 	ret := _m.Called()
@@ -564,6 +774,76 @@ func (_c *MockDataCoordClient_DescribeIndex_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// DropAnalyzeTask provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) DropAnalyzeTask(ctx context.Context, in *indexpb.DropAnalyzeTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropAnalyzeTaskRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropAnalyzeTaskRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.DropAnalyzeTaskRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_DropAnalyzeTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAnalyzeTask'
+type MockDataCoordClient_DropAnalyzeTask_Call struct {
+	*mock.Call
+}
+
+// DropAnalyzeTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *indexpb.DropAnalyzeTaskRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) DropAnalyzeTask(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_DropAnalyzeTask_Call {
+	return &MockDataCoordClient_DropAnalyzeTask_Call{Call: _e.mock.On("DropAnalyzeTask",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_DropAnalyzeTask_Call) Run(run func(ctx context.Context, in *indexpb.DropAnalyzeTaskRequest, opts ...grpc.CallOption)) *MockDataCoordClient_DropAnalyzeTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*indexpb.DropAnalyzeTaskRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_DropAnalyzeTask_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_DropAnalyzeTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_DropAnalyzeTask_Call) RunAndReturn(run func(context.Context, *indexpb.DropAnalyzeTaskRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_DropAnalyzeTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropIndex provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) DropIndex(ctx context.Context, in *indexpb.DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -704,8 +984,8 @@ func (_c *MockDataCoordClient_DropVirtualChannel_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// Flush provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) Flush(ctx context.Context, in *datapb.FlushRequest, opts ...grpc.CallOption) (*datapb.FlushResponse, error) {
+// ExecuteCompactionPlans provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ExecuteCompactionPlans(ctx context.Context, in *datapb.ExecuteCompactionPlansRequest, opts ...grpc.CallOption) (*datapb.ExecuteCompactionPlansResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -715,20 +995,20 @@ func (_m *MockDataCoordClient) Flush(ctx context.Context, in *datapb.FlushReques
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.FlushResponse
+	var r0 *datapb.ExecuteCompactionPlansResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) (*datapb.FlushResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExecuteCompactionPlansRequest, ...grpc.CallOption) (*datapb.ExecuteCompactionPlansResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) *datapb.FlushResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExecuteCompactionPlansRequest, ...grpc.CallOption) *datapb.ExecuteCompactionPlansResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.FlushResponse)
+			r0 = ret.Get(0).(*datapb.ExecuteCompactionPlansResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExecuteCompactionPlansRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -737,21 +1017,21 @@ func (_m *MockDataCoordClient) Flush(ctx context.Context, in *datapb.FlushReques
 	return r0, r1
 }
 
-// MockDataCoordClient_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
-type MockDataCoordClient_Flush_Call struct {
+// MockDataCoordClient_ExecuteCompactionPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteCompactionPlans'
+type MockDataCoordClient_ExecuteCompactionPlans_Call struct {
 	*mock.Call
 }
 
-// Flush is a helper method to define mock.On call
+// ExecuteCompactionPlans is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.FlushRequest
+//   - in *datapb.ExecuteCompactionPlansRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) Flush(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_Flush_Call {
-	return &MockDataCoordClient_Flush_Call{Call: _e.mock.On("Flush",
+func (_e *MockDataCoordClient_Expecter) ExecuteCompactionPlans(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ExecuteCompactionPlans_Call {
+	return &MockDataCoordClient_ExecuteCompactionPlans_Call{Call: _e.mock.On("ExecuteCompactionPlans",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_Flush_Call) Run(run func(ctx context.Context, in *datapb.FlushRequest, opts ...grpc.CallOption)) *MockDataCoordClient_Flush_Call {
+func (_c *MockDataCoordClient_ExecuteCompactionPlans_Call) Run(run func(ctx context.Context, in *datapb.ExecuteCompactionPlansRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ExecuteCompactionPlans_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -759,23 +1039,23 @@ func (_c *MockDataCoordClient_Flush_Call) Run(run func(ctx context.Context, in *
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.FlushRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.ExecuteCompactionPlansRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *MockDataCoordClient_Flush_Call {
+func (_c *MockDataCoordClient_ExecuteCompactionPlans_Call) Return(_a0 *datapb.ExecuteCompactionPlansResponse, _a1 error) *MockDataCoordClient_ExecuteCompactionPlans_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_Flush_Call) RunAndReturn(run func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) (*datapb.FlushResponse, error)) *MockDataCoordClient_Flush_Call {
+func (_c *MockDataCoordClient_ExecuteCompactionPlans_Call) RunAndReturn(run func(context.Context, *datapb.ExecuteCompactionPlansRequest, ...grpc.CallOption) (*datapb.ExecuteCompactionPlansResponse, error)) *MockDataCoordClient_ExecuteCompactionPlans_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GcConfirm provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GcConfirm(ctx context.Context, in *datapb.GcConfirmRequest, opts ...grpc.CallOption) (*datapb.GcConfirmResponse, error) {
+// ExportSegmentManifests provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ExportSegmentManifests(ctx context.Context, in *datapb.ExportSegmentManifestsRequest, opts ...grpc.CallOption) (*datapb.ExportSegmentManifestsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -785,20 +1065,20 @@ func (_m *MockDataCoordClient) GcConfirm(ctx context.Context, in *datapb.GcConfi
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.GcConfirmResponse
+	var r0 *datapb.ExportSegmentManifestsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest, ...grpc.CallOption) (*datapb.GcConfirmResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportSegmentManifestsRequest, ...grpc.CallOption) (*datapb.ExportSegmentManifestsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest, ...grpc.CallOption) *datapb.GcConfirmResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportSegmentManifestsRequest, ...grpc.CallOption) *datapb.ExportSegmentManifestsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GcConfirmResponse)
+			r0 = ret.Get(0).(*datapb.ExportSegmentManifestsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcConfirmRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportSegmentManifestsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -807,21 +1087,21 @@ func (_m *MockDataCoordClient) GcConfirm(ctx context.Context, in *datapb.GcConfi
 	return r0, r1
 }
 
-// MockDataCoordClient_GcConfirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcConfirm'
-type MockDataCoordClient_GcConfirm_Call struct {
+// MockDataCoordClient_ExportSegmentManifests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportSegmentManifests'
+type MockDataCoordClient_ExportSegmentManifests_Call struct {
 	*mock.Call
 }
 
-// GcConfirm is a helper method to define mock.On call
+// ExportSegmentManifests is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GcConfirmRequest
+//   - in *datapb.ExportSegmentManifestsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GcConfirm(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GcConfirm_Call {
-	return &MockDataCoordClient_GcConfirm_Call{Call: _e.mock.On("GcConfirm",
+func (_e *MockDataCoordClient_Expecter) ExportSegmentManifests(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ExportSegmentManifests_Call {
+	return &MockDataCoordClient_ExportSegmentManifests_Call{Call: _e.mock.On("ExportSegmentManifests",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GcConfirm_Call) Run(run func(ctx context.Context, in *datapb.GcConfirmRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GcConfirm_Call {
+func (_c *MockDataCoordClient_ExportSegmentManifests_Call) Run(run func(ctx context.Context, in *datapb.ExportSegmentManifestsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ExportSegmentManifests_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -829,23 +1109,23 @@ func (_c *MockDataCoordClient_GcConfirm_Call) Run(run func(ctx context.Context,
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GcConfirmRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.ExportSegmentManifestsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GcConfirm_Call) Return(_a0 *datapb.GcConfirmResponse, _a1 error) *MockDataCoordClient_GcConfirm_Call {
+func (_c *MockDataCoordClient_ExportSegmentManifests_Call) Return(_a0 *datapb.ExportSegmentManifestsResponse, _a1 error) *MockDataCoordClient_ExportSegmentManifests_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GcConfirm_Call) RunAndReturn(run func(context.Context, *datapb.GcConfirmRequest, ...grpc.CallOption) (*datapb.GcConfirmResponse, error)) *MockDataCoordClient_GcConfirm_Call {
+func (_c *MockDataCoordClient_ExportSegmentManifests_Call) RunAndReturn(run func(context.Context, *datapb.ExportSegmentManifestsRequest, ...grpc.CallOption) (*datapb.ExportSegmentManifestsResponse, error)) *MockDataCoordClient_ExportSegmentManifests_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GcControl provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GcControl(ctx context.Context, in *datapb.GcControlRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// ExportV2 provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ExportV2(ctx context.Context, in *datapb.ExportV2Request, opts ...grpc.CallOption) (*datapb.ExportV2Response, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -855,20 +1135,20 @@ func (_m *MockDataCoordClient) GcControl(ctx context.Context, in *datapb.GcContr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *datapb.ExportV2Response
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportV2Request, ...grpc.CallOption) (*datapb.ExportV2Response, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportV2Request, ...grpc.CallOption) *datapb.ExportV2Response); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*datapb.ExportV2Response)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcControlRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportV2Request, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -877,21 +1157,21 @@ func (_m *MockDataCoordClient) GcControl(ctx context.Context, in *datapb.GcContr
 	return r0, r1
 }
 
-// MockDataCoordClient_GcControl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcControl'
-type MockDataCoordClient_GcControl_Call struct {
+// MockDataCoordClient_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockDataCoordClient_ExportV2_Call struct {
 	*mock.Call
 }
 
-// GcControl is a helper method to define mock.On call
+// ExportV2 is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GcControlRequest
+//   - in *datapb.ExportV2Request
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GcControl(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GcControl_Call {
-	return &MockDataCoordClient_GcControl_Call{Call: _e.mock.On("GcControl",
+func (_e *MockDataCoordClient_Expecter) ExportV2(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ExportV2_Call {
+	return &MockDataCoordClient_ExportV2_Call{Call: _e.mock.On("ExportV2",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GcControl_Call) Run(run func(ctx context.Context, in *datapb.GcControlRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GcControl_Call {
+func (_c *MockDataCoordClient_ExportV2_Call) Run(run func(ctx context.Context, in *datapb.ExportV2Request, opts ...grpc.CallOption)) *MockDataCoordClient_ExportV2_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -899,23 +1179,23 @@ func (_c *MockDataCoordClient_GcControl_Call) Run(run func(ctx context.Context,
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GcControlRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.ExportV2Request), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GcControl_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_GcControl_Call {
+func (_c *MockDataCoordClient_ExportV2_Call) Return(_a0 *datapb.ExportV2Response, _a1 error) *MockDataCoordClient_ExportV2_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GcControl_Call) RunAndReturn(run func(context.Context, *datapb.GcControlRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_GcControl_Call {
+func (_c *MockDataCoordClient_ExportV2_Call) RunAndReturn(run func(context.Context, *datapb.ExportV2Request, ...grpc.CallOption) (*datapb.ExportV2Response, error)) *MockDataCoordClient_ExportV2_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCollectionStatistics provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetCollectionStatistics(ctx context.Context, in *datapb.GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*datapb.GetCollectionStatisticsResponse, error) {
+// FailoverRegionReplica provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) FailoverRegionReplica(ctx context.Context, in *datapb.FailoverRegionReplicaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -925,20 +1205,20 @@ func (_m *MockDataCoordClient) GetCollectionStatistics(ctx context.Context, in *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.GetCollectionStatisticsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest, ...grpc.CallOption) (*datapb.GetCollectionStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FailoverRegionReplicaRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest, ...grpc.CallOption) *datapb.GetCollectionStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FailoverRegionReplicaRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetCollectionStatisticsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCollectionStatisticsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FailoverRegionReplicaRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -947,21 +1227,21 @@ func (_m *MockDataCoordClient) GetCollectionStatistics(ctx context.Context, in *
 	return r0, r1
 }
 
-// MockDataCoordClient_GetCollectionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStatistics'
-type MockDataCoordClient_GetCollectionStatistics_Call struct {
+// MockDataCoordClient_FailoverRegionReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailoverRegionReplica'
+type MockDataCoordClient_FailoverRegionReplica_Call struct {
 	*mock.Call
 }
 
-// GetCollectionStatistics is a helper method to define mock.On call
+// FailoverRegionReplica is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetCollectionStatisticsRequest
+//   - in *datapb.FailoverRegionReplicaRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetCollectionStatistics(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetCollectionStatistics_Call {
-	return &MockDataCoordClient_GetCollectionStatistics_Call{Call: _e.mock.On("GetCollectionStatistics",
+func (_e *MockDataCoordClient_Expecter) FailoverRegionReplica(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_FailoverRegionReplica_Call {
+	return &MockDataCoordClient_FailoverRegionReplica_Call{Call: _e.mock.On("FailoverRegionReplica",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetCollectionStatistics_Call) Run(run func(ctx context.Context, in *datapb.GetCollectionStatisticsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetCollectionStatistics_Call {
+func (_c *MockDataCoordClient_FailoverRegionReplica_Call) Run(run func(ctx context.Context, in *datapb.FailoverRegionReplicaRequest, opts ...grpc.CallOption)) *MockDataCoordClient_FailoverRegionReplica_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -969,23 +1249,23 @@ func (_c *MockDataCoordClient_GetCollectionStatistics_Call) Run(run func(ctx con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetCollectionStatisticsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.FailoverRegionReplicaRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetCollectionStatistics_Call) Return(_a0 *datapb.GetCollectionStatisticsResponse, _a1 error) *MockDataCoordClient_GetCollectionStatistics_Call {
+func (_c *MockDataCoordClient_FailoverRegionReplica_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_FailoverRegionReplica_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetCollectionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetCollectionStatisticsRequest, ...grpc.CallOption) (*datapb.GetCollectionStatisticsResponse, error)) *MockDataCoordClient_GetCollectionStatistics_Call {
+func (_c *MockDataCoordClient_FailoverRegionReplica_Call) RunAndReturn(run func(context.Context, *datapb.FailoverRegionReplicaRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_FailoverRegionReplica_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCompactionState provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
+// Flush provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) Flush(ctx context.Context, in *datapb.FlushRequest, opts ...grpc.CallOption) (*datapb.FlushResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -995,20 +1275,20 @@ func (_m *MockDataCoordClient) GetCompactionState(ctx context.Context, in *milvu
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.GetCompactionStateResponse
+	var r0 *datapb.FlushResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest, ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) (*datapb.FlushResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest, ...grpc.CallOption) *milvuspb.GetCompactionStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) *datapb.FlushResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetCompactionStateResponse)
+			r0 = ret.Get(0).(*datapb.FlushResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionStateRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1017,21 +1297,21 @@ func (_m *MockDataCoordClient) GetCompactionState(ctx context.Context, in *milvu
 	return r0, r1
 }
 
-// MockDataCoordClient_GetCompactionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionState'
-type MockDataCoordClient_GetCompactionState_Call struct {
+// MockDataCoordClient_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type MockDataCoordClient_Flush_Call struct {
 	*mock.Call
 }
 
-// GetCompactionState is a helper method to define mock.On call
+// Flush is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetCompactionStateRequest
+//   - in *datapb.FlushRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetCompactionState(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetCompactionState_Call {
-	return &MockDataCoordClient_GetCompactionState_Call{Call: _e.mock.On("GetCompactionState",
+func (_e *MockDataCoordClient_Expecter) Flush(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_Flush_Call {
+	return &MockDataCoordClient_Flush_Call{Call: _e.mock.On("Flush",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetCompactionState_Call) Run(run func(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetCompactionState_Call {
+func (_c *MockDataCoordClient_Flush_Call) Run(run func(ctx context.Context, in *datapb.FlushRequest, opts ...grpc.CallOption)) *MockDataCoordClient_Flush_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1039,23 +1319,23 @@ func (_c *MockDataCoordClient_GetCompactionState_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionStateRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.FlushRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetCompactionState_Call) Return(_a0 *milvuspb.GetCompactionStateResponse, _a1 error) *MockDataCoordClient_GetCompactionState_Call {
+func (_c *MockDataCoordClient_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *MockDataCoordClient_Flush_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetCompactionState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionStateRequest, ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)) *MockDataCoordClient_GetCompactionState_Call {
+func (_c *MockDataCoordClient_Flush_Call) RunAndReturn(run func(context.Context, *datapb.FlushRequest, ...grpc.CallOption) (*datapb.FlushResponse, error)) *MockDataCoordClient_Flush_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCompactionStateWithPlans provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error) {
+// ForceCompleteTask provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ForceCompleteTask(ctx context.Context, in *indexpb.ForceTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1065,20 +1345,20 @@ func (_m *MockDataCoordClient) GetCompactionStateWithPlans(ctx context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.GetCompactionPlansResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest, ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ForceTaskRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest, ...grpc.CallOption) *milvuspb.GetCompactionPlansResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ForceTaskRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetCompactionPlansResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionPlansRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ForceTaskRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1087,21 +1367,21 @@ func (_m *MockDataCoordClient) GetCompactionStateWithPlans(ctx context.Context,
 	return r0, r1
 }
 
-// MockDataCoordClient_GetCompactionStateWithPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionStateWithPlans'
-type MockDataCoordClient_GetCompactionStateWithPlans_Call struct {
+// MockDataCoordClient_ForceCompleteTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceCompleteTask'
+type MockDataCoordClient_ForceCompleteTask_Call struct {
 	*mock.Call
 }
 
-// GetCompactionStateWithPlans is a helper method to define mock.On call
+// ForceCompleteTask is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetCompactionPlansRequest
+//   - in *indexpb.ForceTaskRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetCompactionStateWithPlans(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetCompactionStateWithPlans_Call {
-	return &MockDataCoordClient_GetCompactionStateWithPlans_Call{Call: _e.mock.On("GetCompactionStateWithPlans",
+func (_e *MockDataCoordClient_Expecter) ForceCompleteTask(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ForceCompleteTask_Call {
+	return &MockDataCoordClient_ForceCompleteTask_Call{Call: _e.mock.On("ForceCompleteTask",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetCompactionStateWithPlans_Call) Run(run func(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoordClient_ForceCompleteTask_Call) Run(run func(ctx context.Context, in *indexpb.ForceTaskRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ForceCompleteTask_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1109,23 +1389,23 @@ func (_c *MockDataCoordClient_GetCompactionStateWithPlans_Call) Run(run func(ctx
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionPlansRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*indexpb.ForceTaskRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetCompactionStateWithPlans_Call) Return(_a0 *milvuspb.GetCompactionPlansResponse, _a1 error) *MockDataCoordClient_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoordClient_ForceCompleteTask_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_ForceCompleteTask_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetCompactionStateWithPlans_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionPlansRequest, ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)) *MockDataCoordClient_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoordClient_ForceCompleteTask_Call) RunAndReturn(run func(context.Context, *indexpb.ForceTaskRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_ForceCompleteTask_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetComponentStates provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+// ForceFailTask provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ForceFailTask(ctx context.Context, in *indexpb.ForceTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1135,20 +1415,20 @@ func (_m *MockDataCoordClient) GetComponentStates(ctx context.Context, in *milvu
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.ComponentStates
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ForceTaskRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) *milvuspb.ComponentStates); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ForceTaskRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ForceTaskRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1157,21 +1437,21 @@ func (_m *MockDataCoordClient) GetComponentStates(ctx context.Context, in *milvu
 	return r0, r1
 }
 
-// MockDataCoordClient_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
-type MockDataCoordClient_GetComponentStates_Call struct {
+// MockDataCoordClient_ForceFailTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceFailTask'
+type MockDataCoordClient_ForceFailTask_Call struct {
 	*mock.Call
 }
 
-// GetComponentStates is a helper method to define mock.On call
+// ForceFailTask is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetComponentStatesRequest
+//   - in *indexpb.ForceTaskRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetComponentStates(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetComponentStates_Call {
-	return &MockDataCoordClient_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates",
+func (_e *MockDataCoordClient_Expecter) ForceFailTask(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ForceFailTask_Call {
+	return &MockDataCoordClient_ForceFailTask_Call{Call: _e.mock.On("ForceFailTask",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetComponentStates_Call) Run(run func(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetComponentStates_Call {
+func (_c *MockDataCoordClient_ForceFailTask_Call) Run(run func(ctx context.Context, in *indexpb.ForceTaskRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ForceFailTask_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1179,23 +1459,23 @@ func (_c *MockDataCoordClient_GetComponentStates_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*indexpb.ForceTaskRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockDataCoordClient_GetComponentStates_Call {
+func (_c *MockDataCoordClient_ForceFailTask_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_ForceFailTask_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)) *MockDataCoordClient_GetComponentStates_Call {
+func (_c *MockDataCoordClient_ForceFailTask_Call) RunAndReturn(run func(context.Context, *indexpb.ForceTaskRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_ForceFailTask_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushAllState provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetFlushAllState(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error) {
+// GcConfirm provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GcConfirm(ctx context.Context, in *datapb.GcConfirmRequest, opts ...grpc.CallOption) (*datapb.GcConfirmResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1205,20 +1485,20 @@ func (_m *MockDataCoordClient) GetFlushAllState(ctx context.Context, in *milvusp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.GetFlushAllStateResponse
+	var r0 *datapb.GcConfirmResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest, ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest, ...grpc.CallOption) (*datapb.GcConfirmResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest, ...grpc.CallOption) *milvuspb.GetFlushAllStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest, ...grpc.CallOption) *datapb.GcConfirmResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetFlushAllStateResponse)
+			r0 = ret.Get(0).(*datapb.GcConfirmResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetFlushAllStateRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcConfirmRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1227,21 +1507,21 @@ func (_m *MockDataCoordClient) GetFlushAllState(ctx context.Context, in *milvusp
 	return r0, r1
 }
 
-// MockDataCoordClient_GetFlushAllState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushAllState'
-type MockDataCoordClient_GetFlushAllState_Call struct {
+// MockDataCoordClient_GcConfirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcConfirm'
+type MockDataCoordClient_GcConfirm_Call struct {
 	*mock.Call
 }
 
-// GetFlushAllState is a helper method to define mock.On call
+// GcConfirm is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetFlushAllStateRequest
+//   - in *datapb.GcConfirmRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetFlushAllState(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetFlushAllState_Call {
-	return &MockDataCoordClient_GetFlushAllState_Call{Call: _e.mock.On("GetFlushAllState",
+func (_e *MockDataCoordClient_Expecter) GcConfirm(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GcConfirm_Call {
+	return &MockDataCoordClient_GcConfirm_Call{Call: _e.mock.On("GcConfirm",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetFlushAllState_Call) Run(run func(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetFlushAllState_Call {
+func (_c *MockDataCoordClient_GcConfirm_Call) Run(run func(ctx context.Context, in *datapb.GcConfirmRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GcConfirm_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1249,23 +1529,23 @@ func (_c *MockDataCoordClient_GetFlushAllState_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetFlushAllStateRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GcConfirmRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetFlushAllState_Call) Return(_a0 *milvuspb.GetFlushAllStateResponse, _a1 error) *MockDataCoordClient_GetFlushAllState_Call {
+func (_c *MockDataCoordClient_GcConfirm_Call) Return(_a0 *datapb.GcConfirmResponse, _a1 error) *MockDataCoordClient_GcConfirm_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetFlushAllState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetFlushAllStateRequest, ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error)) *MockDataCoordClient_GetFlushAllState_Call {
+func (_c *MockDataCoordClient_GcConfirm_Call) RunAndReturn(run func(context.Context, *datapb.GcConfirmRequest, ...grpc.CallOption) (*datapb.GcConfirmResponse, error)) *MockDataCoordClient_GcConfirm_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushState provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetFlushState(ctx context.Context, in *datapb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error) {
+// GcControl provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GcControl(ctx context.Context, in *datapb.GcControlRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1275,20 +1555,20 @@ func (_m *MockDataCoordClient) GetFlushState(ctx context.Context, in *datapb.Get
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.GetFlushStateResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest, ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest, ...grpc.CallOption) *milvuspb.GetFlushStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetFlushStateResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushStateRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcControlRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1297,21 +1577,21 @@ func (_m *MockDataCoordClient) GetFlushState(ctx context.Context, in *datapb.Get
 	return r0, r1
 }
 
-// MockDataCoordClient_GetFlushState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushState'
-type MockDataCoordClient_GetFlushState_Call struct {
+// MockDataCoordClient_GcControl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcControl'
+type MockDataCoordClient_GcControl_Call struct {
 	*mock.Call
 }
 
-// GetFlushState is a helper method to define mock.On call
+// GcControl is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetFlushStateRequest
+//   - in *datapb.GcControlRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetFlushState(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetFlushState_Call {
-	return &MockDataCoordClient_GetFlushState_Call{Call: _e.mock.On("GetFlushState",
+func (_e *MockDataCoordClient_Expecter) GcControl(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GcControl_Call {
+	return &MockDataCoordClient_GcControl_Call{Call: _e.mock.On("GcControl",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetFlushState_Call) Run(run func(ctx context.Context, in *datapb.GetFlushStateRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetFlushState_Call {
+func (_c *MockDataCoordClient_GcControl_Call) Run(run func(ctx context.Context, in *datapb.GcControlRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GcControl_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1319,23 +1599,23 @@ func (_c *MockDataCoordClient_GetFlushState_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetFlushStateRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GcControlRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetFlushState_Call) Return(_a0 *milvuspb.GetFlushStateResponse, _a1 error) *MockDataCoordClient_GetFlushState_Call {
+func (_c *MockDataCoordClient_GcControl_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_GcControl_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetFlushState_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushStateRequest, ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)) *MockDataCoordClient_GetFlushState_Call {
+func (_c *MockDataCoordClient_GcControl_Call) RunAndReturn(run func(context.Context, *datapb.GcControlRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_GcControl_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushedSegments provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetFlushedSegments(ctx context.Context, in *datapb.GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*datapb.GetFlushedSegmentsResponse, error) {
+// GcDryRun provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GcDryRun(ctx context.Context, in *datapb.GcDryRunRequest, opts ...grpc.CallOption) (*datapb.GcDryRunResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1345,20 +1625,20 @@ func (_m *MockDataCoordClient) GetFlushedSegments(ctx context.Context, in *datap
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.GetFlushedSegmentsResponse
+	var r0 *datapb.GcDryRunResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest, ...grpc.CallOption) (*datapb.GetFlushedSegmentsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcDryRunRequest, ...grpc.CallOption) (*datapb.GcDryRunResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest, ...grpc.CallOption) *datapb.GetFlushedSegmentsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcDryRunRequest, ...grpc.CallOption) *datapb.GcDryRunResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetFlushedSegmentsResponse)
+			r0 = ret.Get(0).(*datapb.GcDryRunResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushedSegmentsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcDryRunRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1367,21 +1647,21 @@ func (_m *MockDataCoordClient) GetFlushedSegments(ctx context.Context, in *datap
 	return r0, r1
 }
 
-// MockDataCoordClient_GetFlushedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushedSegments'
-type MockDataCoordClient_GetFlushedSegments_Call struct {
+// MockDataCoordClient_GcDryRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcDryRun'
+type MockDataCoordClient_GcDryRun_Call struct {
 	*mock.Call
 }
 
-// GetFlushedSegments is a helper method to define mock.On call
+// GcDryRun is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetFlushedSegmentsRequest
+//   - in *datapb.GcDryRunRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetFlushedSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetFlushedSegments_Call {
-	return &MockDataCoordClient_GetFlushedSegments_Call{Call: _e.mock.On("GetFlushedSegments",
+func (_e *MockDataCoordClient_Expecter) GcDryRun(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GcDryRun_Call {
+	return &MockDataCoordClient_GcDryRun_Call{Call: _e.mock.On("GcDryRun",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetFlushedSegments_Call) Run(run func(ctx context.Context, in *datapb.GetFlushedSegmentsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetFlushedSegments_Call {
+func (_c *MockDataCoordClient_GcDryRun_Call) Run(run func(ctx context.Context, in *datapb.GcDryRunRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GcDryRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1389,23 +1669,23 @@ func (_c *MockDataCoordClient_GetFlushedSegments_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetFlushedSegmentsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GcDryRunRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetFlushedSegments_Call) Return(_a0 *datapb.GetFlushedSegmentsResponse, _a1 error) *MockDataCoordClient_GetFlushedSegments_Call {
+func (_c *MockDataCoordClient_GcDryRun_Call) Return(_a0 *datapb.GcDryRunResponse, _a1 error) *MockDataCoordClient_GcDryRun_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetFlushedSegments_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushedSegmentsRequest, ...grpc.CallOption) (*datapb.GetFlushedSegmentsResponse, error)) *MockDataCoordClient_GetFlushedSegments_Call {
+func (_c *MockDataCoordClient_GcDryRun_Call) RunAndReturn(run func(context.Context, *datapb.GcDryRunRequest, ...grpc.CallOption) (*datapb.GcDryRunResponse, error)) *MockDataCoordClient_GcDryRun_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetImportProgress provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
+// GetCollectionDataSummaries provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetCollectionDataSummaries(ctx context.Context, in *datapb.GetCollectionDataSummariesRequest, opts ...grpc.CallOption) (*datapb.GetCollectionDataSummariesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1415,20 +1695,20 @@ func (_m *MockDataCoordClient) GetImportProgress(ctx context.Context, in *intern
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *internalpb.GetImportProgressResponse
+	var r0 *datapb.GetCollectionDataSummariesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionDataSummariesRequest, ...grpc.CallOption) (*datapb.GetCollectionDataSummariesResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) *internalpb.GetImportProgressResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionDataSummariesRequest, ...grpc.CallOption) *datapb.GetCollectionDataSummariesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.GetImportProgressResponse)
+			r0 = ret.Get(0).(*datapb.GetCollectionDataSummariesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCollectionDataSummariesRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1437,21 +1717,21 @@ func (_m *MockDataCoordClient) GetImportProgress(ctx context.Context, in *intern
 	return r0, r1
 }
 
-// MockDataCoordClient_GetImportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportProgress'
-type MockDataCoordClient_GetImportProgress_Call struct {
+// MockDataCoordClient_GetCollectionDataSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionDataSummaries'
+type MockDataCoordClient_GetCollectionDataSummaries_Call struct {
 	*mock.Call
 }
 
-// GetImportProgress is a helper method to define mock.On call
+// GetCollectionDataSummaries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetImportProgressRequest
+//   - in *datapb.GetCollectionDataSummariesRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetImportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetImportProgress_Call {
-	return &MockDataCoordClient_GetImportProgress_Call{Call: _e.mock.On("GetImportProgress",
+func (_e *MockDataCoordClient_Expecter) GetCollectionDataSummaries(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetCollectionDataSummaries_Call {
+	return &MockDataCoordClient_GetCollectionDataSummaries_Call{Call: _e.mock.On("GetCollectionDataSummaries",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetImportProgress_Call) Run(run func(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetImportProgress_Call {
+func (_c *MockDataCoordClient_GetCollectionDataSummaries_Call) Run(run func(ctx context.Context, in *datapb.GetCollectionDataSummariesRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetCollectionDataSummaries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1459,23 +1739,23 @@ func (_c *MockDataCoordClient_GetImportProgress_Call) Run(run func(ctx context.C
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetImportProgressRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GetCollectionDataSummariesRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetImportProgress_Call) Return(_a0 *internalpb.GetImportProgressResponse, _a1 error) *MockDataCoordClient_GetImportProgress_Call {
+func (_c *MockDataCoordClient_GetCollectionDataSummaries_Call) Return(_a0 *datapb.GetCollectionDataSummariesResponse, _a1 error) *MockDataCoordClient_GetCollectionDataSummaries_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetImportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)) *MockDataCoordClient_GetImportProgress_Call {
+func (_c *MockDataCoordClient_GetCollectionDataSummaries_Call) RunAndReturn(run func(context.Context, *datapb.GetCollectionDataSummariesRequest, ...grpc.CallOption) (*datapb.GetCollectionDataSummariesResponse, error)) *MockDataCoordClient_GetCollectionDataSummaries_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexBuildProgress provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error) {
+// GetCollectionStatistics provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetCollectionStatistics(ctx context.Context, in *datapb.GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*datapb.GetCollectionStatisticsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1485,20 +1765,20 @@ func (_m *MockDataCoordClient) GetIndexBuildProgress(ctx context.Context, in *in
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *indexpb.GetIndexBuildProgressResponse
+	var r0 *datapb.GetCollectionStatisticsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest, ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest, ...grpc.CallOption) (*datapb.GetCollectionStatisticsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest, ...grpc.CallOption) *indexpb.GetIndexBuildProgressResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest, ...grpc.CallOption) *datapb.GetCollectionStatisticsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexBuildProgressResponse)
+			r0 = ret.Get(0).(*datapb.GetCollectionStatisticsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexBuildProgressRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCollectionStatisticsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1507,21 +1787,21 @@ func (_m *MockDataCoordClient) GetIndexBuildProgress(ctx context.Context, in *in
 	return r0, r1
 }
 
-// MockDataCoordClient_GetIndexBuildProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexBuildProgress'
-type MockDataCoordClient_GetIndexBuildProgress_Call struct {
+// MockDataCoordClient_GetCollectionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStatistics'
+type MockDataCoordClient_GetCollectionStatistics_Call struct {
 	*mock.Call
 }
 
-// GetIndexBuildProgress is a helper method to define mock.On call
+// GetCollectionStatistics is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *indexpb.GetIndexBuildProgressRequest
+//   - in *datapb.GetCollectionStatisticsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetIndexBuildProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetIndexBuildProgress_Call {
-	return &MockDataCoordClient_GetIndexBuildProgress_Call{Call: _e.mock.On("GetIndexBuildProgress",
+func (_e *MockDataCoordClient_Expecter) GetCollectionStatistics(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetCollectionStatistics_Call {
+	return &MockDataCoordClient_GetCollectionStatistics_Call{Call: _e.mock.On("GetCollectionStatistics",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetIndexBuildProgress_Call) Run(run func(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetIndexBuildProgress_Call {
+func (_c *MockDataCoordClient_GetCollectionStatistics_Call) Run(run func(ctx context.Context, in *datapb.GetCollectionStatisticsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetCollectionStatistics_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1529,23 +1809,23 @@ func (_c *MockDataCoordClient_GetIndexBuildProgress_Call) Run(run func(ctx conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexBuildProgressRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GetCollectionStatisticsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetIndexBuildProgress_Call) Return(_a0 *indexpb.GetIndexBuildProgressResponse, _a1 error) *MockDataCoordClient_GetIndexBuildProgress_Call {
+func (_c *MockDataCoordClient_GetCollectionStatistics_Call) Return(_a0 *datapb.GetCollectionStatisticsResponse, _a1 error) *MockDataCoordClient_GetCollectionStatistics_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetIndexBuildProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexBuildProgressRequest, ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error)) *MockDataCoordClient_GetIndexBuildProgress_Call {
+func (_c *MockDataCoordClient_GetCollectionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetCollectionStatisticsRequest, ...grpc.CallOption) (*datapb.GetCollectionStatisticsResponse, error)) *MockDataCoordClient_GetCollectionStatistics_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexInfos provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetIndexInfos(ctx context.Context, in *indexpb.GetIndexInfoRequest, opts ...grpc.CallOption) (*indexpb.GetIndexInfoResponse, error) {
+// GetCompactionPlanDetails provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetCompactionPlanDetails(ctx context.Context, in *datapb.GetCompactionPlanDetailsRequest, opts ...grpc.CallOption) (*datapb.GetCompactionPlanDetailsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1555,20 +1835,20 @@ func (_m *MockDataCoordClient) GetIndexInfos(ctx context.Context, in *indexpb.Ge
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *indexpb.GetIndexInfoResponse
+	var r0 *datapb.GetCompactionPlanDetailsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest, ...grpc.CallOption) (*indexpb.GetIndexInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCompactionPlanDetailsRequest, ...grpc.CallOption) (*datapb.GetCompactionPlanDetailsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest, ...grpc.CallOption) *indexpb.GetIndexInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCompactionPlanDetailsRequest, ...grpc.CallOption) *datapb.GetCompactionPlanDetailsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexInfoResponse)
+			r0 = ret.Get(0).(*datapb.GetCompactionPlanDetailsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexInfoRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCompactionPlanDetailsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1577,21 +1857,21 @@ func (_m *MockDataCoordClient) GetIndexInfos(ctx context.Context, in *indexpb.Ge
 	return r0, r1
 }
 
-// MockDataCoordClient_GetIndexInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexInfos'
-type MockDataCoordClient_GetIndexInfos_Call struct {
+// MockDataCoordClient_GetCompactionPlanDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionPlanDetails'
+type MockDataCoordClient_GetCompactionPlanDetails_Call struct {
 	*mock.Call
 }
 
-// GetIndexInfos is a helper method to define mock.On call
+// GetCompactionPlanDetails is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *indexpb.GetIndexInfoRequest
+//   - in *datapb.GetCompactionPlanDetailsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetIndexInfos(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetIndexInfos_Call {
-	return &MockDataCoordClient_GetIndexInfos_Call{Call: _e.mock.On("GetIndexInfos",
+func (_e *MockDataCoordClient_Expecter) GetCompactionPlanDetails(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetCompactionPlanDetails_Call {
+	return &MockDataCoordClient_GetCompactionPlanDetails_Call{Call: _e.mock.On("GetCompactionPlanDetails",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetIndexInfos_Call) Run(run func(ctx context.Context, in *indexpb.GetIndexInfoRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetIndexInfos_Call {
+func (_c *MockDataCoordClient_GetCompactionPlanDetails_Call) Run(run func(ctx context.Context, in *datapb.GetCompactionPlanDetailsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetCompactionPlanDetails_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1599,23 +1879,23 @@ func (_c *MockDataCoordClient_GetIndexInfos_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexInfoRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GetCompactionPlanDetailsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetIndexInfos_Call) Return(_a0 *indexpb.GetIndexInfoResponse, _a1 error) *MockDataCoordClient_GetIndexInfos_Call {
+func (_c *MockDataCoordClient_GetCompactionPlanDetails_Call) Return(_a0 *datapb.GetCompactionPlanDetailsResponse, _a1 error) *MockDataCoordClient_GetCompactionPlanDetails_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetIndexInfos_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexInfoRequest, ...grpc.CallOption) (*indexpb.GetIndexInfoResponse, error)) *MockDataCoordClient_GetIndexInfos_Call {
+func (_c *MockDataCoordClient_GetCompactionPlanDetails_Call) RunAndReturn(run func(context.Context, *datapb.GetCompactionPlanDetailsRequest, ...grpc.CallOption) (*datapb.GetCompactionPlanDetailsResponse, error)) *MockDataCoordClient_GetCompactionPlanDetails_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexState provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetIndexState(ctx context.Context, in *indexpb.GetIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error) {
+// GetCompactionState provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1625,20 +1905,20 @@ func (_m *MockDataCoordClient) GetIndexState(ctx context.Context, in *indexpb.Ge
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *indexpb.GetIndexStateResponse
+	var r0 *milvuspb.GetCompactionStateResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest, ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest, ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest, ...grpc.CallOption) *indexpb.GetIndexStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest, ...grpc.CallOption) *milvuspb.GetCompactionStateResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexStateResponse)
+			r0 = ret.Get(0).(*milvuspb.GetCompactionStateResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStateRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionStateRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1647,21 +1927,21 @@ func (_m *MockDataCoordClient) GetIndexState(ctx context.Context, in *indexpb.Ge
 	return r0, r1
 }
 
-// MockDataCoordClient_GetIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexState'
-type MockDataCoordClient_GetIndexState_Call struct {
+// MockDataCoordClient_GetCompactionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionState'
+type MockDataCoordClient_GetCompactionState_Call struct {
 	*mock.Call
 }
 
-// GetIndexState is a helper method to define mock.On call
+// GetCompactionState is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *indexpb.GetIndexStateRequest
+//   - in *milvuspb.GetCompactionStateRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetIndexState(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetIndexState_Call {
-	return &MockDataCoordClient_GetIndexState_Call{Call: _e.mock.On("GetIndexState",
+func (_e *MockDataCoordClient_Expecter) GetCompactionState(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetCompactionState_Call {
+	return &MockDataCoordClient_GetCompactionState_Call{Call: _e.mock.On("GetCompactionState",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetIndexState_Call) Run(run func(ctx context.Context, in *indexpb.GetIndexStateRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetIndexState_Call {
+func (_c *MockDataCoordClient_GetCompactionState_Call) Run(run func(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetCompactionState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1669,23 +1949,23 @@ func (_c *MockDataCoordClient_GetIndexState_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStateRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionStateRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetIndexState_Call) Return(_a0 *indexpb.GetIndexStateResponse, _a1 error) *MockDataCoordClient_GetIndexState_Call {
+func (_c *MockDataCoordClient_GetCompactionState_Call) Return(_a0 *milvuspb.GetCompactionStateResponse, _a1 error) *MockDataCoordClient_GetCompactionState_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStateRequest, ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error)) *MockDataCoordClient_GetIndexState_Call {
+func (_c *MockDataCoordClient_GetCompactionState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionStateRequest, ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)) *MockDataCoordClient_GetCompactionState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexStatistics provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetIndexStatistics(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error) {
+// GetCompactionStateWithPlans provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1695,20 +1975,20 @@ func (_m *MockDataCoordClient) GetIndexStatistics(ctx context.Context, in *index
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *indexpb.GetIndexStatisticsResponse
+	var r0 *milvuspb.GetCompactionPlansResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest, ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest, ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest, ...grpc.CallOption) *indexpb.GetIndexStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest, ...grpc.CallOption) *milvuspb.GetCompactionPlansResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexStatisticsResponse)
+			r0 = ret.Get(0).(*milvuspb.GetCompactionPlansResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStatisticsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionPlansRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1717,21 +1997,21 @@ func (_m *MockDataCoordClient) GetIndexStatistics(ctx context.Context, in *index
 	return r0, r1
 }
 
-// MockDataCoordClient_GetIndexStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexStatistics'
-type MockDataCoordClient_GetIndexStatistics_Call struct {
+// MockDataCoordClient_GetCompactionStateWithPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionStateWithPlans'
+type MockDataCoordClient_GetCompactionStateWithPlans_Call struct {
 	*mock.Call
 }
 
-// GetIndexStatistics is a helper method to define mock.On call
+// GetCompactionStateWithPlans is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *indexpb.GetIndexStatisticsRequest
+//   - in *milvuspb.GetCompactionPlansRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetIndexStatistics(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetIndexStatistics_Call {
-	return &MockDataCoordClient_GetIndexStatistics_Call{Call: _e.mock.On("GetIndexStatistics",
+func (_e *MockDataCoordClient_Expecter) GetCompactionStateWithPlans(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetCompactionStateWithPlans_Call {
+	return &MockDataCoordClient_GetCompactionStateWithPlans_Call{Call: _e.mock.On("GetCompactionStateWithPlans",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetIndexStatistics_Call) Run(run func(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetIndexStatistics_Call {
+func (_c *MockDataCoordClient_GetCompactionStateWithPlans_Call) Run(run func(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetCompactionStateWithPlans_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1739,23 +2019,23 @@ func (_c *MockDataCoordClient_GetIndexStatistics_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStatisticsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionPlansRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetIndexStatistics_Call) Return(_a0 *indexpb.GetIndexStatisticsResponse, _a1 error) *MockDataCoordClient_GetIndexStatistics_Call {
+func (_c *MockDataCoordClient_GetCompactionStateWithPlans_Call) Return(_a0 *milvuspb.GetCompactionPlansResponse, _a1 error) *MockDataCoordClient_GetCompactionStateWithPlans_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetIndexStatistics_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStatisticsRequest, ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error)) *MockDataCoordClient_GetIndexStatistics_Call {
+func (_c *MockDataCoordClient_GetCompactionStateWithPlans_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionPlansRequest, ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)) *MockDataCoordClient_GetCompactionStateWithPlans_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetInsertBinlogPaths provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *datapb.GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*datapb.GetInsertBinlogPathsResponse, error) {
+// GetComponentStates provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1765,20 +2045,20 @@ func (_m *MockDataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *dat
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.GetInsertBinlogPathsResponse
+	var r0 *milvuspb.ComponentStates
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest, ...grpc.CallOption) (*datapb.GetInsertBinlogPathsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest, ...grpc.CallOption) *datapb.GetInsertBinlogPathsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) *milvuspb.ComponentStates); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetInsertBinlogPathsResponse)
+			r0 = ret.Get(0).(*milvuspb.ComponentStates)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetInsertBinlogPathsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1787,21 +2067,21 @@ func (_m *MockDataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *dat
 	return r0, r1
 }
 
-// MockDataCoordClient_GetInsertBinlogPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInsertBinlogPaths'
-type MockDataCoordClient_GetInsertBinlogPaths_Call struct {
+// MockDataCoordClient_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
+type MockDataCoordClient_GetComponentStates_Call struct {
 	*mock.Call
 }
 
-// GetInsertBinlogPaths is a helper method to define mock.On call
+// GetComponentStates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetInsertBinlogPathsRequest
+//   - in *milvuspb.GetComponentStatesRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetInsertBinlogPaths(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetInsertBinlogPaths_Call {
-	return &MockDataCoordClient_GetInsertBinlogPaths_Call{Call: _e.mock.On("GetInsertBinlogPaths",
+func (_e *MockDataCoordClient_Expecter) GetComponentStates(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetComponentStates_Call {
+	return &MockDataCoordClient_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetInsertBinlogPaths_Call) Run(run func(ctx context.Context, in *datapb.GetInsertBinlogPathsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetInsertBinlogPaths_Call {
+func (_c *MockDataCoordClient_GetComponentStates_Call) Run(run func(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetComponentStates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1809,23 +2089,23 @@ func (_c *MockDataCoordClient_GetInsertBinlogPaths_Call) Run(run func(ctx contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetInsertBinlogPathsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetInsertBinlogPaths_Call) Return(_a0 *datapb.GetInsertBinlogPathsResponse, _a1 error) *MockDataCoordClient_GetInsertBinlogPaths_Call {
+func (_c *MockDataCoordClient_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockDataCoordClient_GetComponentStates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetInsertBinlogPaths_Call) RunAndReturn(run func(context.Context, *datapb.GetInsertBinlogPathsRequest, ...grpc.CallOption) (*datapb.GetInsertBinlogPathsResponse, error)) *MockDataCoordClient_GetInsertBinlogPaths_Call {
+func (_c *MockDataCoordClient_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)) *MockDataCoordClient_GetComponentStates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMetrics provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+// GetDuplicatePrimaryKeysReport provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetDuplicatePrimaryKeysReport(ctx context.Context, in *datapb.GetDuplicatePrimaryKeysReportRequest, opts ...grpc.CallOption) (*datapb.GetDuplicatePrimaryKeysReportResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1835,20 +2115,20 @@ func (_m *MockDataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetM
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.GetMetricsResponse
+	var r0 *datapb.GetDuplicatePrimaryKeysReportResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetDuplicatePrimaryKeysReportRequest, ...grpc.CallOption) (*datapb.GetDuplicatePrimaryKeysReportResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) *milvuspb.GetMetricsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetDuplicatePrimaryKeysReportRequest, ...grpc.CallOption) *datapb.GetDuplicatePrimaryKeysReportResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+			r0 = ret.Get(0).(*datapb.GetDuplicatePrimaryKeysReportResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetDuplicatePrimaryKeysReportRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1857,21 +2137,21 @@ func (_m *MockDataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetM
 	return r0, r1
 }
 
-// MockDataCoordClient_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
-type MockDataCoordClient_GetMetrics_Call struct {
+// MockDataCoordClient_GetDuplicatePrimaryKeysReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDuplicatePrimaryKeysReport'
+type MockDataCoordClient_GetDuplicatePrimaryKeysReport_Call struct {
 	*mock.Call
 }
 
-// GetMetrics is a helper method to define mock.On call
+// GetDuplicatePrimaryKeysReport is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetMetricsRequest
+//   - in *datapb.GetDuplicatePrimaryKeysReportRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetMetrics(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetMetrics_Call {
-	return &MockDataCoordClient_GetMetrics_Call{Call: _e.mock.On("GetMetrics",
+func (_e *MockDataCoordClient_Expecter) GetDuplicatePrimaryKeysReport(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetDuplicatePrimaryKeysReport_Call {
+	return &MockDataCoordClient_GetDuplicatePrimaryKeysReport_Call{Call: _e.mock.On("GetDuplicatePrimaryKeysReport",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetMetrics_Call) Run(run func(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetMetrics_Call {
+func (_c *MockDataCoordClient_GetDuplicatePrimaryKeysReport_Call) Run(run func(ctx context.Context, in *datapb.GetDuplicatePrimaryKeysReportRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetDuplicatePrimaryKeysReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1879,23 +2159,23 @@ func (_c *MockDataCoordClient_GetMetrics_Call) Run(run func(ctx context.Context,
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GetDuplicatePrimaryKeysReportRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockDataCoordClient_GetMetrics_Call {
+func (_c *MockDataCoordClient_GetDuplicatePrimaryKeysReport_Call) Return(_a0 *datapb.GetDuplicatePrimaryKeysReportResponse, _a1 error) *MockDataCoordClient_GetDuplicatePrimaryKeysReport_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)) *MockDataCoordClient_GetMetrics_Call {
+func (_c *MockDataCoordClient_GetDuplicatePrimaryKeysReport_Call) RunAndReturn(run func(context.Context, *datapb.GetDuplicatePrimaryKeysReportRequest, ...grpc.CallOption) (*datapb.GetDuplicatePrimaryKeysReportResponse, error)) *MockDataCoordClient_GetDuplicatePrimaryKeysReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPartitionStatistics provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetPartitionStatistics(ctx context.Context, in *datapb.GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*datapb.GetPartitionStatisticsResponse, error) {
+// GetExportProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetExportProgress(ctx context.Context, in *datapb.GetExportProgressRequest, opts ...grpc.CallOption) (*datapb.GetExportProgressResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1905,20 +2185,20 @@ func (_m *MockDataCoordClient) GetPartitionStatistics(ctx context.Context, in *d
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.GetPartitionStatisticsResponse
+	var r0 *datapb.GetExportProgressResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest, ...grpc.CallOption) (*datapb.GetPartitionStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportProgressRequest, ...grpc.CallOption) (*datapb.GetExportProgressResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest, ...grpc.CallOption) *datapb.GetPartitionStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportProgressRequest, ...grpc.CallOption) *datapb.GetExportProgressResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetPartitionStatisticsResponse)
+			r0 = ret.Get(0).(*datapb.GetExportProgressResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetPartitionStatisticsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetExportProgressRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1927,21 +2207,21 @@ func (_m *MockDataCoordClient) GetPartitionStatistics(ctx context.Context, in *d
 	return r0, r1
 }
 
-// MockDataCoordClient_GetPartitionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionStatistics'
-type MockDataCoordClient_GetPartitionStatistics_Call struct {
+// MockDataCoordClient_GetExportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportProgress'
+type MockDataCoordClient_GetExportProgress_Call struct {
 	*mock.Call
 }
 
-// GetPartitionStatistics is a helper method to define mock.On call
+// GetExportProgress is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetPartitionStatisticsRequest
+//   - in *datapb.GetExportProgressRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetPartitionStatistics(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetPartitionStatistics_Call {
-	return &MockDataCoordClient_GetPartitionStatistics_Call{Call: _e.mock.On("GetPartitionStatistics",
+func (_e *MockDataCoordClient_Expecter) GetExportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetExportProgress_Call {
+	return &MockDataCoordClient_GetExportProgress_Call{Call: _e.mock.On("GetExportProgress",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetPartitionStatistics_Call) Run(run func(ctx context.Context, in *datapb.GetPartitionStatisticsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetPartitionStatistics_Call {
+func (_c *MockDataCoordClient_GetExportProgress_Call) Run(run func(ctx context.Context, in *datapb.GetExportProgressRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetExportProgress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1949,23 +2229,23 @@ func (_c *MockDataCoordClient_GetPartitionStatistics_Call) Run(run func(ctx cont
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetPartitionStatisticsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GetExportProgressRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetPartitionStatistics_Call) Return(_a0 *datapb.GetPartitionStatisticsResponse, _a1 error) *MockDataCoordClient_GetPartitionStatistics_Call {
+func (_c *MockDataCoordClient_GetExportProgress_Call) Return(_a0 *datapb.GetExportProgressResponse, _a1 error) *MockDataCoordClient_GetExportProgress_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetPartitionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetPartitionStatisticsRequest, ...grpc.CallOption) (*datapb.GetPartitionStatisticsResponse, error)) *MockDataCoordClient_GetPartitionStatistics_Call {
+func (_c *MockDataCoordClient_GetExportProgress_Call) RunAndReturn(run func(context.Context, *datapb.GetExportProgressRequest, ...grpc.CallOption) (*datapb.GetExportProgressResponse, error)) *MockDataCoordClient_GetExportProgress_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecoveryInfo provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetRecoveryInfo(ctx context.Context, in *datapb.GetRecoveryInfoRequest, opts ...grpc.CallOption) (*datapb.GetRecoveryInfoResponse, error) {
+// GetFlushAllState provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetFlushAllState(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1975,20 +2255,20 @@ func (_m *MockDataCoordClient) GetRecoveryInfo(ctx context.Context, in *datapb.G
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.GetRecoveryInfoResponse
+	var r0 *milvuspb.GetFlushAllStateResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest, ...grpc.CallOption) (*datapb.GetRecoveryInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest, ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest, ...grpc.CallOption) *datapb.GetRecoveryInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest, ...grpc.CallOption) *milvuspb.GetFlushAllStateResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponse)
+			r0 = ret.Get(0).(*milvuspb.GetFlushAllStateResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetFlushAllStateRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1997,21 +2277,21 @@ func (_m *MockDataCoordClient) GetRecoveryInfo(ctx context.Context, in *datapb.G
 	return r0, r1
 }
 
-// MockDataCoordClient_GetRecoveryInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfo'
-type MockDataCoordClient_GetRecoveryInfo_Call struct {
+// MockDataCoordClient_GetFlushAllState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushAllState'
+type MockDataCoordClient_GetFlushAllState_Call struct {
 	*mock.Call
 }
 
-// GetRecoveryInfo is a helper method to define mock.On call
+// GetFlushAllState is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetRecoveryInfoRequest
+//   - in *milvuspb.GetFlushAllStateRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetRecoveryInfo(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetRecoveryInfo_Call {
-	return &MockDataCoordClient_GetRecoveryInfo_Call{Call: _e.mock.On("GetRecoveryInfo",
+func (_e *MockDataCoordClient_Expecter) GetFlushAllState(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetFlushAllState_Call {
+	return &MockDataCoordClient_GetFlushAllState_Call{Call: _e.mock.On("GetFlushAllState",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetRecoveryInfo_Call) Run(run func(ctx context.Context, in *datapb.GetRecoveryInfoRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetRecoveryInfo_Call {
+func (_c *MockDataCoordClient_GetFlushAllState_Call) Run(run func(ctx context.Context, in *milvuspb.GetFlushAllStateRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetFlushAllState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2019,23 +2299,23 @@ func (_c *MockDataCoordClient_GetRecoveryInfo_Call) Run(run func(ctx context.Con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.GetFlushAllStateRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetRecoveryInfo_Call) Return(_a0 *datapb.GetRecoveryInfoResponse, _a1 error) *MockDataCoordClient_GetRecoveryInfo_Call {
+func (_c *MockDataCoordClient_GetFlushAllState_Call) Return(_a0 *milvuspb.GetFlushAllStateResponse, _a1 error) *MockDataCoordClient_GetFlushAllState_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetRecoveryInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequest, ...grpc.CallOption) (*datapb.GetRecoveryInfoResponse, error)) *MockDataCoordClient_GetRecoveryInfo_Call {
+func (_c *MockDataCoordClient_GetFlushAllState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetFlushAllStateRequest, ...grpc.CallOption) (*milvuspb.GetFlushAllStateResponse, error)) *MockDataCoordClient_GetFlushAllState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecoveryInfoV2 provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetRecoveryInfoV2(ctx context.Context, in *datapb.GetRecoveryInfoRequestV2, opts ...grpc.CallOption) (*datapb.GetRecoveryInfoResponseV2, error) {
+// GetFlushState provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetFlushState(ctx context.Context, in *datapb.GetFlushStateRequest, opts ...grpc.CallOption) (*datapb.GetFlushStateResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2045,20 +2325,20 @@ func (_m *MockDataCoordClient) GetRecoveryInfoV2(ctx context.Context, in *datapb
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.GetRecoveryInfoResponseV2
+	var r0 *datapb.GetFlushStateResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2, ...grpc.CallOption) (*datapb.GetRecoveryInfoResponseV2, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest, ...grpc.CallOption) (*datapb.GetFlushStateResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2, ...grpc.CallOption) *datapb.GetRecoveryInfoResponseV2); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest, ...grpc.CallOption) *datapb.GetFlushStateResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponseV2)
+			r0 = ret.Get(0).(*datapb.GetFlushStateResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequestV2, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushStateRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2067,21 +2347,21 @@ func (_m *MockDataCoordClient) GetRecoveryInfoV2(ctx context.Context, in *datapb
 	return r0, r1
 }
 
-// MockDataCoordClient_GetRecoveryInfoV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfoV2'
-type MockDataCoordClient_GetRecoveryInfoV2_Call struct {
+// MockDataCoordClient_GetFlushState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushState'
+type MockDataCoordClient_GetFlushState_Call struct {
 	*mock.Call
 }
 
-// GetRecoveryInfoV2 is a helper method to define mock.On call
+// GetFlushState is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetRecoveryInfoRequestV2
+//   - in *datapb.GetFlushStateRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetRecoveryInfoV2(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetRecoveryInfoV2_Call {
-	return &MockDataCoordClient_GetRecoveryInfoV2_Call{Call: _e.mock.On("GetRecoveryInfoV2",
+func (_e *MockDataCoordClient_Expecter) GetFlushState(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetFlushState_Call {
+	return &MockDataCoordClient_GetFlushState_Call{Call: _e.mock.On("GetFlushState",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetRecoveryInfoV2_Call) Run(run func(ctx context.Context, in *datapb.GetRecoveryInfoRequestV2, opts ...grpc.CallOption)) *MockDataCoordClient_GetRecoveryInfoV2_Call {
+func (_c *MockDataCoordClient_GetFlushState_Call) Run(run func(ctx context.Context, in *datapb.GetFlushStateRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetFlushState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2089,23 +2369,23 @@ func (_c *MockDataCoordClient_GetRecoveryInfoV2_Call) Run(run func(ctx context.C
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequestV2), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GetFlushStateRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetRecoveryInfoV2_Call) Return(_a0 *datapb.GetRecoveryInfoResponseV2, _a1 error) *MockDataCoordClient_GetRecoveryInfoV2_Call {
+func (_c *MockDataCoordClient_GetFlushState_Call) Return(_a0 *datapb.GetFlushStateResponse, _a1 error) *MockDataCoordClient_GetFlushState_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetRecoveryInfoV2_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequestV2, ...grpc.CallOption) (*datapb.GetRecoveryInfoResponseV2, error)) *MockDataCoordClient_GetRecoveryInfoV2_Call {
+func (_c *MockDataCoordClient_GetFlushState_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushStateRequest, ...grpc.CallOption) (*datapb.GetFlushStateResponse, error)) *MockDataCoordClient_GetFlushState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentIndexState provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetSegmentIndexState(ctx context.Context, in *indexpb.GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetSegmentIndexStateResponse, error) {
+// GetFlushedSegments provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetFlushedSegments(ctx context.Context, in *datapb.GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*datapb.GetFlushedSegmentsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2115,20 +2395,20 @@ func (_m *MockDataCoordClient) GetSegmentIndexState(ctx context.Context, in *ind
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *indexpb.GetSegmentIndexStateResponse
+	var r0 *datapb.GetFlushedSegmentsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest, ...grpc.CallOption) (*indexpb.GetSegmentIndexStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest, ...grpc.CallOption) (*datapb.GetFlushedSegmentsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest, ...grpc.CallOption) *indexpb.GetSegmentIndexStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest, ...grpc.CallOption) *datapb.GetFlushedSegmentsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetSegmentIndexStateResponse)
+			r0 = ret.Get(0).(*datapb.GetFlushedSegmentsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetSegmentIndexStateRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushedSegmentsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2137,21 +2417,21 @@ func (_m *MockDataCoordClient) GetSegmentIndexState(ctx context.Context, in *ind
 	return r0, r1
 }
 
-// MockDataCoordClient_GetSegmentIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentIndexState'
-type MockDataCoordClient_GetSegmentIndexState_Call struct {
+// MockDataCoordClient_GetFlushedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushedSegments'
+type MockDataCoordClient_GetFlushedSegments_Call struct {
 	*mock.Call
 }
 
-// GetSegmentIndexState is a helper method to define mock.On call
+// GetFlushedSegments is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *indexpb.GetSegmentIndexStateRequest
+//   - in *datapb.GetFlushedSegmentsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetSegmentIndexState(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetSegmentIndexState_Call {
-	return &MockDataCoordClient_GetSegmentIndexState_Call{Call: _e.mock.On("GetSegmentIndexState",
+func (_e *MockDataCoordClient_Expecter) GetFlushedSegments(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetFlushedSegments_Call {
+	return &MockDataCoordClient_GetFlushedSegments_Call{Call: _e.mock.On("GetFlushedSegments",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetSegmentIndexState_Call) Run(run func(ctx context.Context, in *indexpb.GetSegmentIndexStateRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetSegmentIndexState_Call {
+func (_c *MockDataCoordClient_GetFlushedSegments_Call) Run(run func(ctx context.Context, in *datapb.GetFlushedSegmentsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetFlushedSegments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2159,23 +2439,23 @@ func (_c *MockDataCoordClient_GetSegmentIndexState_Call) Run(run func(ctx contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*indexpb.GetSegmentIndexStateRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.GetFlushedSegmentsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetSegmentIndexState_Call) Return(_a0 *indexpb.GetSegmentIndexStateResponse, _a1 error) *MockDataCoordClient_GetSegmentIndexState_Call {
+func (_c *MockDataCoordClient_GetFlushedSegments_Call) Return(_a0 *datapb.GetFlushedSegmentsResponse, _a1 error) *MockDataCoordClient_GetFlushedSegments_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetSegmentIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetSegmentIndexStateRequest, ...grpc.CallOption) (*indexpb.GetSegmentIndexStateResponse, error)) *MockDataCoordClient_GetSegmentIndexState_Call {
+func (_c *MockDataCoordClient_GetFlushedSegments_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushedSegmentsRequest, ...grpc.CallOption) (*datapb.GetFlushedSegmentsResponse, error)) *MockDataCoordClient_GetFlushedSegments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentInfo provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetSegmentInfo(ctx context.Context, in *datapb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*datapb.GetSegmentInfoResponse, error) {
+// GetImportProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2185,20 +2465,20 @@ func (_m *MockDataCoordClient) GetSegmentInfo(ctx context.Context, in *datapb.Ge
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.GetSegmentInfoResponse
+	var r0 *internalpb.GetImportProgressResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest, ...grpc.CallOption) (*datapb.GetSegmentInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest, ...grpc.CallOption) *datapb.GetSegmentInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) *internalpb.GetImportProgressResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetSegmentInfoResponse)
+			r0 = ret.Get(0).(*internalpb.GetImportProgressResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentInfoRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2207,21 +2487,21 @@ func (_m *MockDataCoordClient) GetSegmentInfo(ctx context.Context, in *datapb.Ge
 	return r0, r1
 }
 
-// MockDataCoordClient_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
-type MockDataCoordClient_GetSegmentInfo_Call struct {
+// MockDataCoordClient_GetImportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportProgress'
+type MockDataCoordClient_GetImportProgress_Call struct {
 	*mock.Call
 }
 
-// GetSegmentInfo is a helper method to define mock.On call
+// GetImportProgress is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetSegmentInfoRequest
+//   - in *internalpb.GetImportProgressRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetSegmentInfo(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetSegmentInfo_Call {
-	return &MockDataCoordClient_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo",
+func (_e *MockDataCoordClient_Expecter) GetImportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetImportProgress_Call {
+	return &MockDataCoordClient_GetImportProgress_Call{Call: _e.mock.On("GetImportProgress",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetSegmentInfo_Call) Run(run func(ctx context.Context, in *datapb.GetSegmentInfoRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetSegmentInfo_Call {
+func (_c *MockDataCoordClient_GetImportProgress_Call) Run(run func(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetImportProgress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2229,22 +2509,862 @@ func (_c *MockDataCoordClient_GetSegmentInfo_Call) Run(run func(ctx context.Cont
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentInfoRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.GetImportProgressRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetSegmentInfo_Call) Return(_a0 *datapb.GetSegmentInfoResponse, _a1 error) *MockDataCoordClient_GetSegmentInfo_Call {
+func (_c *MockDataCoordClient_GetImportProgress_Call) Return(_a0 *internalpb.GetImportProgressResponse, _a1 error) *MockDataCoordClient_GetImportProgress_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentInfoRequest, ...grpc.CallOption) (*datapb.GetSegmentInfoResponse, error)) *MockDataCoordClient_GetSegmentInfo_Call {
+func (_c *MockDataCoordClient_GetImportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)) *MockDataCoordClient_GetImportProgress_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentInfoChannel provides a mock function with given fields: ctx, in, opts
+// GetIndexBuildProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetIndexBuildProgress(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *indexpb.GetIndexBuildProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest, ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest, ...grpc.CallOption) *indexpb.GetIndexBuildProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexBuildProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexBuildProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetIndexBuildProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexBuildProgress'
+type MockDataCoordClient_GetIndexBuildProgress_Call struct {
+	*mock.Call
+}
+
+// GetIndexBuildProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *indexpb.GetIndexBuildProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetIndexBuildProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetIndexBuildProgress_Call {
+	return &MockDataCoordClient_GetIndexBuildProgress_Call{Call: _e.mock.On("GetIndexBuildProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetIndexBuildProgress_Call) Run(run func(ctx context.Context, in *indexpb.GetIndexBuildProgressRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetIndexBuildProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexBuildProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetIndexBuildProgress_Call) Return(_a0 *indexpb.GetIndexBuildProgressResponse, _a1 error) *MockDataCoordClient_GetIndexBuildProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetIndexBuildProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexBuildProgressRequest, ...grpc.CallOption) (*indexpb.GetIndexBuildProgressResponse, error)) *MockDataCoordClient_GetIndexBuildProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexInfos provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetIndexInfos(ctx context.Context, in *indexpb.GetIndexInfoRequest, opts ...grpc.CallOption) (*indexpb.GetIndexInfoResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *indexpb.GetIndexInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest, ...grpc.CallOption) (*indexpb.GetIndexInfoResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest, ...grpc.CallOption) *indexpb.GetIndexInfoResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexInfoRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetIndexInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexInfos'
+type MockDataCoordClient_GetIndexInfos_Call struct {
+	*mock.Call
+}
+
+// GetIndexInfos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *indexpb.GetIndexInfoRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetIndexInfos(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetIndexInfos_Call {
+	return &MockDataCoordClient_GetIndexInfos_Call{Call: _e.mock.On("GetIndexInfos",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetIndexInfos_Call) Run(run func(ctx context.Context, in *indexpb.GetIndexInfoRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetIndexInfos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexInfoRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetIndexInfos_Call) Return(_a0 *indexpb.GetIndexInfoResponse, _a1 error) *MockDataCoordClient_GetIndexInfos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetIndexInfos_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexInfoRequest, ...grpc.CallOption) (*indexpb.GetIndexInfoResponse, error)) *MockDataCoordClient_GetIndexInfos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexState provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetIndexState(ctx context.Context, in *indexpb.GetIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *indexpb.GetIndexStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest, ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest, ...grpc.CallOption) *indexpb.GetIndexStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexState'
+type MockDataCoordClient_GetIndexState_Call struct {
+	*mock.Call
+}
+
+// GetIndexState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *indexpb.GetIndexStateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetIndexState(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetIndexState_Call {
+	return &MockDataCoordClient_GetIndexState_Call{Call: _e.mock.On("GetIndexState",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetIndexState_Call) Run(run func(ctx context.Context, in *indexpb.GetIndexStateRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetIndexState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetIndexState_Call) Return(_a0 *indexpb.GetIndexStateResponse, _a1 error) *MockDataCoordClient_GetIndexState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStateRequest, ...grpc.CallOption) (*indexpb.GetIndexStateResponse, error)) *MockDataCoordClient_GetIndexState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexStatistics provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetIndexStatistics(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *indexpb.GetIndexStatisticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest, ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest, ...grpc.CallOption) *indexpb.GetIndexStatisticsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexStatisticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStatisticsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetIndexStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexStatistics'
+type MockDataCoordClient_GetIndexStatistics_Call struct {
+	*mock.Call
+}
+
+// GetIndexStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *indexpb.GetIndexStatisticsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetIndexStatistics(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetIndexStatistics_Call {
+	return &MockDataCoordClient_GetIndexStatistics_Call{Call: _e.mock.On("GetIndexStatistics",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetIndexStatistics_Call) Run(run func(ctx context.Context, in *indexpb.GetIndexStatisticsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetIndexStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStatisticsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetIndexStatistics_Call) Return(_a0 *indexpb.GetIndexStatisticsResponse, _a1 error) *MockDataCoordClient_GetIndexStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetIndexStatistics_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStatisticsRequest, ...grpc.CallOption) (*indexpb.GetIndexStatisticsResponse, error)) *MockDataCoordClient_GetIndexStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInsertBinlogPaths provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *datapb.GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*datapb.GetInsertBinlogPathsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.GetInsertBinlogPathsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest, ...grpc.CallOption) (*datapb.GetInsertBinlogPathsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest, ...grpc.CallOption) *datapb.GetInsertBinlogPathsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetInsertBinlogPathsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetInsertBinlogPathsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetInsertBinlogPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInsertBinlogPaths'
+type MockDataCoordClient_GetInsertBinlogPaths_Call struct {
+	*mock.Call
+}
+
+// GetInsertBinlogPaths is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetInsertBinlogPathsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetInsertBinlogPaths(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetInsertBinlogPaths_Call {
+	return &MockDataCoordClient_GetInsertBinlogPaths_Call{Call: _e.mock.On("GetInsertBinlogPaths",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetInsertBinlogPaths_Call) Run(run func(ctx context.Context, in *datapb.GetInsertBinlogPathsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetInsertBinlogPaths_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetInsertBinlogPathsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetInsertBinlogPaths_Call) Return(_a0 *datapb.GetInsertBinlogPathsResponse, _a1 error) *MockDataCoordClient_GetInsertBinlogPaths_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetInsertBinlogPaths_Call) RunAndReturn(run func(context.Context, *datapb.GetInsertBinlogPathsRequest, ...grpc.CallOption) (*datapb.GetInsertBinlogPathsResponse, error)) *MockDataCoordClient_GetInsertBinlogPaths_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetrics provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.GetMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) *milvuspb.GetMetricsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
+type MockDataCoordClient_GetMetrics_Call struct {
+	*mock.Call
+}
+
+// GetMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.GetMetricsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetMetrics(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetMetrics_Call {
+	return &MockDataCoordClient_GetMetrics_Call{Call: _e.mock.On("GetMetrics",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetMetrics_Call) Run(run func(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockDataCoordClient_GetMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)) *MockDataCoordClient_GetMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPartitionStatistics provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetPartitionStatistics(ctx context.Context, in *datapb.GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*datapb.GetPartitionStatisticsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.GetPartitionStatisticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest, ...grpc.CallOption) (*datapb.GetPartitionStatisticsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest, ...grpc.CallOption) *datapb.GetPartitionStatisticsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetPartitionStatisticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetPartitionStatisticsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetPartitionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionStatistics'
+type MockDataCoordClient_GetPartitionStatistics_Call struct {
+	*mock.Call
+}
+
+// GetPartitionStatistics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetPartitionStatisticsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetPartitionStatistics(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetPartitionStatistics_Call {
+	return &MockDataCoordClient_GetPartitionStatistics_Call{Call: _e.mock.On("GetPartitionStatistics",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetPartitionStatistics_Call) Run(run func(ctx context.Context, in *datapb.GetPartitionStatisticsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetPartitionStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetPartitionStatisticsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetPartitionStatistics_Call) Return(_a0 *datapb.GetPartitionStatisticsResponse, _a1 error) *MockDataCoordClient_GetPartitionStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetPartitionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetPartitionStatisticsRequest, ...grpc.CallOption) (*datapb.GetPartitionStatisticsResponse, error)) *MockDataCoordClient_GetPartitionStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecoveryInfo provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetRecoveryInfo(ctx context.Context, in *datapb.GetRecoveryInfoRequest, opts ...grpc.CallOption) (*datapb.GetRecoveryInfoResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.GetRecoveryInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest, ...grpc.CallOption) (*datapb.GetRecoveryInfoResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest, ...grpc.CallOption) *datapb.GetRecoveryInfoResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetRecoveryInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfo'
+type MockDataCoordClient_GetRecoveryInfo_Call struct {
+	*mock.Call
+}
+
+// GetRecoveryInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetRecoveryInfoRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetRecoveryInfo(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetRecoveryInfo_Call {
+	return &MockDataCoordClient_GetRecoveryInfo_Call{Call: _e.mock.On("GetRecoveryInfo",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetRecoveryInfo_Call) Run(run func(ctx context.Context, in *datapb.GetRecoveryInfoRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetRecoveryInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetRecoveryInfo_Call) Return(_a0 *datapb.GetRecoveryInfoResponse, _a1 error) *MockDataCoordClient_GetRecoveryInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetRecoveryInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequest, ...grpc.CallOption) (*datapb.GetRecoveryInfoResponse, error)) *MockDataCoordClient_GetRecoveryInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecoveryInfoV2 provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetRecoveryInfoV2(ctx context.Context, in *datapb.GetRecoveryInfoRequestV2, opts ...grpc.CallOption) (*datapb.GetRecoveryInfoResponseV2, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.GetRecoveryInfoResponseV2
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2, ...grpc.CallOption) (*datapb.GetRecoveryInfoResponseV2, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2, ...grpc.CallOption) *datapb.GetRecoveryInfoResponseV2); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponseV2)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequestV2, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetRecoveryInfoV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfoV2'
+type MockDataCoordClient_GetRecoveryInfoV2_Call struct {
+	*mock.Call
+}
+
+// GetRecoveryInfoV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetRecoveryInfoRequestV2
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetRecoveryInfoV2(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetRecoveryInfoV2_Call {
+	return &MockDataCoordClient_GetRecoveryInfoV2_Call{Call: _e.mock.On("GetRecoveryInfoV2",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetRecoveryInfoV2_Call) Run(run func(ctx context.Context, in *datapb.GetRecoveryInfoRequestV2, opts ...grpc.CallOption)) *MockDataCoordClient_GetRecoveryInfoV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequestV2), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetRecoveryInfoV2_Call) Return(_a0 *datapb.GetRecoveryInfoResponseV2, _a1 error) *MockDataCoordClient_GetRecoveryInfoV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetRecoveryInfoV2_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequestV2, ...grpc.CallOption) (*datapb.GetRecoveryInfoResponseV2, error)) *MockDataCoordClient_GetRecoveryInfoV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRegionReplicaStatus provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetRegionReplicaStatus(ctx context.Context, in *datapb.GetRegionReplicaStatusRequest, opts ...grpc.CallOption) (*datapb.GetRegionReplicaStatusResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.GetRegionReplicaStatusResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRegionReplicaStatusRequest, ...grpc.CallOption) (*datapb.GetRegionReplicaStatusResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRegionReplicaStatusRequest, ...grpc.CallOption) *datapb.GetRegionReplicaStatusResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetRegionReplicaStatusResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRegionReplicaStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetRegionReplicaStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRegionReplicaStatus'
+type MockDataCoordClient_GetRegionReplicaStatus_Call struct {
+	*mock.Call
+}
+
+// GetRegionReplicaStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetRegionReplicaStatusRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetRegionReplicaStatus(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetRegionReplicaStatus_Call {
+	return &MockDataCoordClient_GetRegionReplicaStatus_Call{Call: _e.mock.On("GetRegionReplicaStatus",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetRegionReplicaStatus_Call) Run(run func(ctx context.Context, in *datapb.GetRegionReplicaStatusRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetRegionReplicaStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetRegionReplicaStatusRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetRegionReplicaStatus_Call) Return(_a0 *datapb.GetRegionReplicaStatusResponse, _a1 error) *MockDataCoordClient_GetRegionReplicaStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetRegionReplicaStatus_Call) RunAndReturn(run func(context.Context, *datapb.GetRegionReplicaStatusRequest, ...grpc.CallOption) (*datapb.GetRegionReplicaStatusResponse, error)) *MockDataCoordClient_GetRegionReplicaStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentIndexState provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetSegmentIndexState(ctx context.Context, in *indexpb.GetSegmentIndexStateRequest, opts ...grpc.CallOption) (*indexpb.GetSegmentIndexStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *indexpb.GetSegmentIndexStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest, ...grpc.CallOption) (*indexpb.GetSegmentIndexStateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest, ...grpc.CallOption) *indexpb.GetSegmentIndexStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetSegmentIndexStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetSegmentIndexStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetSegmentIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentIndexState'
+type MockDataCoordClient_GetSegmentIndexState_Call struct {
+	*mock.Call
+}
+
+// GetSegmentIndexState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *indexpb.GetSegmentIndexStateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetSegmentIndexState(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetSegmentIndexState_Call {
+	return &MockDataCoordClient_GetSegmentIndexState_Call{Call: _e.mock.On("GetSegmentIndexState",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetSegmentIndexState_Call) Run(run func(ctx context.Context, in *indexpb.GetSegmentIndexStateRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetSegmentIndexState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*indexpb.GetSegmentIndexStateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetSegmentIndexState_Call) Return(_a0 *indexpb.GetSegmentIndexStateResponse, _a1 error) *MockDataCoordClient_GetSegmentIndexState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetSegmentIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetSegmentIndexStateRequest, ...grpc.CallOption) (*indexpb.GetSegmentIndexStateResponse, error)) *MockDataCoordClient_GetSegmentIndexState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentInfo provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetSegmentInfo(ctx context.Context, in *datapb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*datapb.GetSegmentInfoResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.GetSegmentInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest, ...grpc.CallOption) (*datapb.GetSegmentInfoResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest, ...grpc.CallOption) *datapb.GetSegmentInfoResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetSegmentInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentInfoRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
+type MockDataCoordClient_GetSegmentInfo_Call struct {
+	*mock.Call
+}
+
+// GetSegmentInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetSegmentInfoRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetSegmentInfo(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetSegmentInfo_Call {
+	return &MockDataCoordClient_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetSegmentInfo_Call) Run(run func(ctx context.Context, in *datapb.GetSegmentInfoRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetSegmentInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentInfoRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetSegmentInfo_Call) Return(_a0 *datapb.GetSegmentInfoResponse, _a1 error) *MockDataCoordClient_GetSegmentInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentInfoRequest, ...grpc.CallOption) (*datapb.GetSegmentInfoResponse, error)) *MockDataCoordClient_GetSegmentInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentInfoChannel provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *datapb.GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -2257,10 +3377,290 @@ func (_m *MockDataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *da
 
 	var r0 *milvuspb.StringResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentInfoChannelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetSegmentInfoChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfoChannel'
+type MockDataCoordClient_GetSegmentInfoChannel_Call struct {
+	*mock.Call
+}
+
+// GetSegmentInfoChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetSegmentInfoChannelRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetSegmentInfoChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetSegmentInfoChannel_Call {
+	return &MockDataCoordClient_GetSegmentInfoChannel_Call{Call: _e.mock.On("GetSegmentInfoChannel",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetSegmentInfoChannel_Call) Run(run func(ctx context.Context, in *datapb.GetSegmentInfoChannelRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetSegmentInfoChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentInfoChannelRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetSegmentInfoChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoordClient_GetSegmentInfoChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetSegmentInfoChannel_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentInfoChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockDataCoordClient_GetSegmentInfoChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentStates provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetSegmentStates(ctx context.Context, in *datapb.GetSegmentStatesRequest, opts ...grpc.CallOption) (*datapb.GetSegmentStatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.GetSegmentStatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest, ...grpc.CallOption) (*datapb.GetSegmentStatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest, ...grpc.CallOption) *datapb.GetSegmentStatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetSegmentStatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentStatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetSegmentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentStates'
+type MockDataCoordClient_GetSegmentStates_Call struct {
+	*mock.Call
+}
+
+// GetSegmentStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetSegmentStatesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetSegmentStates(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetSegmentStates_Call {
+	return &MockDataCoordClient_GetSegmentStates_Call{Call: _e.mock.On("GetSegmentStates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetSegmentStates_Call) Run(run func(ctx context.Context, in *datapb.GetSegmentStatesRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetSegmentStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentStatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetSegmentStates_Call) Return(_a0 *datapb.GetSegmentStatesResponse, _a1 error) *MockDataCoordClient_GetSegmentStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetSegmentStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentStatesRequest, ...grpc.CallOption) (*datapb.GetSegmentStatesResponse, error)) *MockDataCoordClient_GetSegmentStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentsByStates provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetSegmentsByStates(ctx context.Context, in *datapb.GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*datapb.GetSegmentsByStatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.GetSegmentsByStatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest, ...grpc.CallOption) (*datapb.GetSegmentsByStatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest, ...grpc.CallOption) *datapb.GetSegmentsByStatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetSegmentsByStatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentsByStatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetSegmentsByStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentsByStates'
+type MockDataCoordClient_GetSegmentsByStates_Call struct {
+	*mock.Call
+}
+
+// GetSegmentsByStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.GetSegmentsByStatesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetSegmentsByStates(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetSegmentsByStates_Call {
+	return &MockDataCoordClient_GetSegmentsByStates_Call{Call: _e.mock.On("GetSegmentsByStates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetSegmentsByStates_Call) Run(run func(ctx context.Context, in *datapb.GetSegmentsByStatesRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetSegmentsByStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentsByStatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetSegmentsByStates_Call) Return(_a0 *datapb.GetSegmentsByStatesResponse, _a1 error) *MockDataCoordClient_GetSegmentsByStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetSegmentsByStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentsByStatesRequest, ...grpc.CallOption) (*datapb.GetSegmentsByStatesResponse, error)) *MockDataCoordClient_GetSegmentsByStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatisticsChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
+type MockDataCoordClient_GetStatisticsChannel_Call struct {
+	*mock.Call
+}
+
+// GetStatisticsChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetStatisticsChannelRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetStatisticsChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetStatisticsChannel_Call {
+	return &MockDataCoordClient_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetStatisticsChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetStatisticsChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoordClient_GetStatisticsChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockDataCoordClient_GetStatisticsChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTimeTickChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2268,7 +3668,287 @@ func (_m *MockDataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *da
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentInfoChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
+type MockDataCoordClient_GetTimeTickChannel_Call struct {
+	*mock.Call
+}
+
+// GetTimeTickChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetTimeTickChannelRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) GetTimeTickChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetTimeTickChannel_Call {
+	return &MockDataCoordClient_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetTimeTickChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoordClient_GetTimeTickChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockDataCoordClient_GetTimeTickChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportV2 provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ImportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) (*internalpb.ImportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) *internalpb.ImportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ImportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ImportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportV2'
+type MockDataCoordClient_ImportV2_Call struct {
+	*mock.Call
+}
+
+// ImportV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ImportRequestInternal
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ImportV2(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ImportV2_Call {
+	return &MockDataCoordClient_ImportV2_Call{Call: _e.mock.On("ImportV2",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ImportV2_Call) Run(run func(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption)) *MockDataCoordClient_ImportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ImportRequestInternal), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ImportV2_Call) Return(_a0 *internalpb.ImportResponse, _a1 error) *MockDataCoordClient_ImportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ImportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) (*internalpb.ImportResponse, error)) *MockDataCoordClient_ImportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDatabaseTaskQuotas provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListDatabaseTaskQuotas(ctx context.Context, in *datapb.ListDatabaseTaskQuotasRequest, opts ...grpc.CallOption) (*datapb.ListDatabaseTaskQuotasResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.ListDatabaseTaskQuotasResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListDatabaseTaskQuotasRequest, ...grpc.CallOption) (*datapb.ListDatabaseTaskQuotasResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListDatabaseTaskQuotasRequest, ...grpc.CallOption) *datapb.ListDatabaseTaskQuotasResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListDatabaseTaskQuotasResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListDatabaseTaskQuotasRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ListDatabaseTaskQuotas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDatabaseTaskQuotas'
+type MockDataCoordClient_ListDatabaseTaskQuotas_Call struct {
+	*mock.Call
+}
+
+// ListDatabaseTaskQuotas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListDatabaseTaskQuotasRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ListDatabaseTaskQuotas(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListDatabaseTaskQuotas_Call {
+	return &MockDataCoordClient_ListDatabaseTaskQuotas_Call{Call: _e.mock.On("ListDatabaseTaskQuotas",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ListDatabaseTaskQuotas_Call) Run(run func(ctx context.Context, in *datapb.ListDatabaseTaskQuotasRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ListDatabaseTaskQuotas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListDatabaseTaskQuotasRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListDatabaseTaskQuotas_Call) Return(_a0 *datapb.ListDatabaseTaskQuotasResponse, _a1 error) *MockDataCoordClient_ListDatabaseTaskQuotas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListDatabaseTaskQuotas_Call) RunAndReturn(run func(context.Context, *datapb.ListDatabaseTaskQuotasRequest, ...grpc.CallOption) (*datapb.ListDatabaseTaskQuotasResponse, error)) *MockDataCoordClient_ListDatabaseTaskQuotas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpiryCompactions provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListExpiryCompactions(ctx context.Context, in *datapb.ListExpiryCompactionsRequest, opts ...grpc.CallOption) (*datapb.ListExpiryCompactionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *datapb.ListExpiryCompactionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExpiryCompactionsRequest, ...grpc.CallOption) (*datapb.ListExpiryCompactionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListExpiryCompactionsRequest, ...grpc.CallOption) *datapb.ListExpiryCompactionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ListExpiryCompactionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListExpiryCompactionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoordClient_ListExpiryCompactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpiryCompactions'
+type MockDataCoordClient_ListExpiryCompactions_Call struct {
+	*mock.Call
+}
+
+// ListExpiryCompactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ListExpiryCompactionsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataCoordClient_Expecter) ListExpiryCompactions(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListExpiryCompactions_Call {
+	return &MockDataCoordClient_ListExpiryCompactions_Call{Call: _e.mock.On("ListExpiryCompactions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataCoordClient_ListExpiryCompactions_Call) Run(run func(ctx context.Context, in *datapb.ListExpiryCompactionsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ListExpiryCompactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ListExpiryCompactionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListExpiryCompactions_Call) Return(_a0 *datapb.ListExpiryCompactionsResponse, _a1 error) *MockDataCoordClient_ListExpiryCompactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoordClient_ListExpiryCompactions_Call) RunAndReturn(run func(context.Context, *datapb.ListExpiryCompactionsRequest, ...grpc.CallOption) (*datapb.ListExpiryCompactionsResponse, error)) *MockDataCoordClient_ListExpiryCompactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListImports provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListImports(ctx context.Context, in *internalpb.ListImportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ListImportsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequestInternal, ...grpc.CallOption) (*internalpb.ListImportsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequestInternal, ...grpc.CallOption) *internalpb.ListImportsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListImportsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListImportsRequestInternal, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2277,21 +3957,21 @@ func (_m *MockDataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *da
 	return r0, r1
 }
 
-// MockDataCoordClient_GetSegmentInfoChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfoChannel'
-type MockDataCoordClient_GetSegmentInfoChannel_Call struct {
+// MockDataCoordClient_ListImports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImports'
+type MockDataCoordClient_ListImports_Call struct {
 	*mock.Call
 }
 
-// GetSegmentInfoChannel is a helper method to define mock.On call
+// ListImports is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetSegmentInfoChannelRequest
+//   - in *internalpb.ListImportsRequestInternal
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetSegmentInfoChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetSegmentInfoChannel_Call {
-	return &MockDataCoordClient_GetSegmentInfoChannel_Call{Call: _e.mock.On("GetSegmentInfoChannel",
+func (_e *MockDataCoordClient_Expecter) ListImports(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListImports_Call {
+	return &MockDataCoordClient_ListImports_Call{Call: _e.mock.On("ListImports",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetSegmentInfoChannel_Call) Run(run func(ctx context.Context, in *datapb.GetSegmentInfoChannelRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetSegmentInfoChannel_Call {
+func (_c *MockDataCoordClient_ListImports_Call) Run(run func(ctx context.Context, in *internalpb.ListImportsRequestInternal, opts ...grpc.CallOption)) *MockDataCoordClient_ListImports_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2299,23 +3979,23 @@ func (_c *MockDataCoordClient_GetSegmentInfoChannel_Call) Run(run func(ctx conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentInfoChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.ListImportsRequestInternal), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetSegmentInfoChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoordClient_GetSegmentInfoChannel_Call {
+func (_c *MockDataCoordClient_ListImports_Call) Return(_a0 *internalpb.ListImportsResponse, _a1 error) *MockDataCoordClient_ListImports_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetSegmentInfoChannel_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentInfoChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockDataCoordClient_GetSegmentInfoChannel_Call {
+func (_c *MockDataCoordClient_ListImports_Call) RunAndReturn(run func(context.Context, *internalpb.ListImportsRequestInternal, ...grpc.CallOption) (*internalpb.ListImportsResponse, error)) *MockDataCoordClient_ListImports_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentStates provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetSegmentStates(ctx context.Context, in *datapb.GetSegmentStatesRequest, opts ...grpc.CallOption) (*datapb.GetSegmentStatesResponse, error) {
+// ListIndexTasks provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListIndexTasks(ctx context.Context, in *indexpb.ListIndexTasksRequest, opts ...grpc.CallOption) (*indexpb.ListIndexTasksResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2325,20 +4005,20 @@ func (_m *MockDataCoordClient) GetSegmentStates(ctx context.Context, in *datapb.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.GetSegmentStatesResponse
+	var r0 *indexpb.ListIndexTasksResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest, ...grpc.CallOption) (*datapb.GetSegmentStatesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexTasksRequest, ...grpc.CallOption) (*indexpb.ListIndexTasksResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest, ...grpc.CallOption) *datapb.GetSegmentStatesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexTasksRequest, ...grpc.CallOption) *indexpb.ListIndexTasksResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetSegmentStatesResponse)
+			r0 = ret.Get(0).(*indexpb.ListIndexTasksResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentStatesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ListIndexTasksRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2347,21 +4027,21 @@ func (_m *MockDataCoordClient) GetSegmentStates(ctx context.Context, in *datapb.
 	return r0, r1
 }
 
-// MockDataCoordClient_GetSegmentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentStates'
-type MockDataCoordClient_GetSegmentStates_Call struct {
+// MockDataCoordClient_ListIndexTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIndexTasks'
+type MockDataCoordClient_ListIndexTasks_Call struct {
 	*mock.Call
 }
 
-// GetSegmentStates is a helper method to define mock.On call
+// ListIndexTasks is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetSegmentStatesRequest
+//   - in *indexpb.ListIndexTasksRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetSegmentStates(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetSegmentStates_Call {
-	return &MockDataCoordClient_GetSegmentStates_Call{Call: _e.mock.On("GetSegmentStates",
+func (_e *MockDataCoordClient_Expecter) ListIndexTasks(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListIndexTasks_Call {
+	return &MockDataCoordClient_ListIndexTasks_Call{Call: _e.mock.On("ListIndexTasks",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetSegmentStates_Call) Run(run func(ctx context.Context, in *datapb.GetSegmentStatesRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetSegmentStates_Call {
+func (_c *MockDataCoordClient_ListIndexTasks_Call) Run(run func(ctx context.Context, in *indexpb.ListIndexTasksRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ListIndexTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2369,23 +4049,23 @@ func (_c *MockDataCoordClient_GetSegmentStates_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentStatesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*indexpb.ListIndexTasksRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetSegmentStates_Call) Return(_a0 *datapb.GetSegmentStatesResponse, _a1 error) *MockDataCoordClient_GetSegmentStates_Call {
+func (_c *MockDataCoordClient_ListIndexTasks_Call) Return(_a0 *indexpb.ListIndexTasksResponse, _a1 error) *MockDataCoordClient_ListIndexTasks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetSegmentStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentStatesRequest, ...grpc.CallOption) (*datapb.GetSegmentStatesResponse, error)) *MockDataCoordClient_GetSegmentStates_Call {
+func (_c *MockDataCoordClient_ListIndexTasks_Call) RunAndReturn(run func(context.Context, *indexpb.ListIndexTasksRequest, ...grpc.CallOption) (*indexpb.ListIndexTasksResponse, error)) *MockDataCoordClient_ListIndexTasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentsByStates provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetSegmentsByStates(ctx context.Context, in *datapb.GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*datapb.GetSegmentsByStatesResponse, error) {
+// ListIndexes provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest, opts ...grpc.CallOption) (*indexpb.ListIndexesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2395,20 +4075,20 @@ func (_m *MockDataCoordClient) GetSegmentsByStates(ctx context.Context, in *data
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *datapb.GetSegmentsByStatesResponse
+	var r0 *indexpb.ListIndexesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest, ...grpc.CallOption) (*datapb.GetSegmentsByStatesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexesRequest, ...grpc.CallOption) (*indexpb.ListIndexesResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest, ...grpc.CallOption) *datapb.GetSegmentsByStatesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexesRequest, ...grpc.CallOption) *indexpb.ListIndexesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetSegmentsByStatesResponse)
+			r0 = ret.Get(0).(*indexpb.ListIndexesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentsByStatesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ListIndexesRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2417,21 +4097,21 @@ func (_m *MockDataCoordClient) GetSegmentsByStates(ctx context.Context, in *data
 	return r0, r1
 }
 
-// MockDataCoordClient_GetSegmentsByStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentsByStates'
-type MockDataCoordClient_GetSegmentsByStates_Call struct {
+// MockDataCoordClient_ListIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIndexes'
+type MockDataCoordClient_ListIndexes_Call struct {
 	*mock.Call
 }
 
-// GetSegmentsByStates is a helper method to define mock.On call
+// ListIndexes is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.GetSegmentsByStatesRequest
+//   - in *indexpb.ListIndexesRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetSegmentsByStates(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetSegmentsByStates_Call {
-	return &MockDataCoordClient_GetSegmentsByStates_Call{Call: _e.mock.On("GetSegmentsByStates",
+func (_e *MockDataCoordClient_Expecter) ListIndexes(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListIndexes_Call {
+	return &MockDataCoordClient_ListIndexes_Call{Call: _e.mock.On("ListIndexes",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetSegmentsByStates_Call) Run(run func(ctx context.Context, in *datapb.GetSegmentsByStatesRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetSegmentsByStates_Call {
+func (_c *MockDataCoordClient_ListIndexes_Call) Run(run func(ctx context.Context, in *indexpb.ListIndexesRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ListIndexes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2439,23 +4119,23 @@ func (_c *MockDataCoordClient_GetSegmentsByStates_Call) Run(run func(ctx context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentsByStatesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*indexpb.ListIndexesRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetSegmentsByStates_Call) Return(_a0 *datapb.GetSegmentsByStatesResponse, _a1 error) *MockDataCoordClient_GetSegmentsByStates_Call {
+func (_c *MockDataCoordClient_ListIndexes_Call) Return(_a0 *indexpb.ListIndexesResponse, _a1 error) *MockDataCoordClient_ListIndexes_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetSegmentsByStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentsByStatesRequest, ...grpc.CallOption) (*datapb.GetSegmentsByStatesResponse, error)) *MockDataCoordClient_GetSegmentsByStates_Call {
+func (_c *MockDataCoordClient_ListIndexes_Call) RunAndReturn(run func(context.Context, *indexpb.ListIndexesRequest, ...grpc.CallOption) (*indexpb.ListIndexesResponse, error)) *MockDataCoordClient_ListIndexes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStatisticsChannel provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+// ManualCompaction provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2465,20 +4145,20 @@ func (_m *MockDataCoordClient) GetStatisticsChannel(ctx context.Context, in *int
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *milvuspb.ManualCompactionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest, ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest, ...grpc.CallOption) *milvuspb.ManualCompactionResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*milvuspb.ManualCompactionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ManualCompactionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2487,21 +4167,21 @@ func (_m *MockDataCoordClient) GetStatisticsChannel(ctx context.Context, in *int
 	return r0, r1
 }
 
-// MockDataCoordClient_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
-type MockDataCoordClient_GetStatisticsChannel_Call struct {
+// MockDataCoordClient_ManualCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualCompaction'
+type MockDataCoordClient_ManualCompaction_Call struct {
 	*mock.Call
 }
 
-// GetStatisticsChannel is a helper method to define mock.On call
+// ManualCompaction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetStatisticsChannelRequest
+//   - in *milvuspb.ManualCompactionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetStatisticsChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetStatisticsChannel_Call {
-	return &MockDataCoordClient_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel",
+func (_e *MockDataCoordClient_Expecter) ManualCompaction(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ManualCompaction_Call {
+	return &MockDataCoordClient_ManualCompaction_Call{Call: _e.mock.On("ManualCompaction",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetStatisticsChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetStatisticsChannel_Call {
+func (_c *MockDataCoordClient_ManualCompaction_Call) Run(run func(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ManualCompaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2509,23 +4189,23 @@ func (_c *MockDataCoordClient_GetStatisticsChannel_Call) Run(run func(ctx contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.ManualCompactionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoordClient_GetStatisticsChannel_Call {
+func (_c *MockDataCoordClient_ManualCompaction_Call) Return(_a0 *milvuspb.ManualCompactionResponse, _a1 error) *MockDataCoordClient_ManualCompaction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockDataCoordClient_GetStatisticsChannel_Call {
+func (_c *MockDataCoordClient_ManualCompaction_Call) RunAndReturn(run func(context.Context, *milvuspb.ManualCompactionRequest, ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)) *MockDataCoordClient_ManualCompaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTimeTickChannel provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+// MarkSegmentsDropped provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) MarkSegmentsDropped(ctx context.Context, in *datapb.MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2535,20 +4215,20 @@ func (_m *MockDataCoordClient) GetTimeTickChannel(ctx context.Context, in *inter
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2557,21 +4237,21 @@ func (_m *MockDataCoordClient) GetTimeTickChannel(ctx context.Context, in *inter
 	return r0, r1
 }
 
-// MockDataCoordClient_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
-type MockDataCoordClient_GetTimeTickChannel_Call struct {
+// MockDataCoordClient_MarkSegmentsDropped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSegmentsDropped'
+type MockDataCoordClient_MarkSegmentsDropped_Call struct {
 	*mock.Call
 }
 
-// GetTimeTickChannel is a helper method to define mock.On call
+// MarkSegmentsDropped is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetTimeTickChannelRequest
+//   - in *datapb.MarkSegmentsDroppedRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) GetTimeTickChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_GetTimeTickChannel_Call {
-	return &MockDataCoordClient_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel",
+func (_e *MockDataCoordClient_Expecter) MarkSegmentsDropped(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_MarkSegmentsDropped_Call {
+	return &MockDataCoordClient_MarkSegmentsDropped_Call{Call: _e.mock.On("MarkSegmentsDropped",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption)) *MockDataCoordClient_GetTimeTickChannel_Call {
+func (_c *MockDataCoordClient_MarkSegmentsDropped_Call) Run(run func(ctx context.Context, in *datapb.MarkSegmentsDroppedRequest, opts ...grpc.CallOption)) *MockDataCoordClient_MarkSegmentsDropped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2579,23 +4259,23 @@ func (_c *MockDataCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.MarkSegmentsDroppedRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoordClient_GetTimeTickChannel_Call {
+func (_c *MockDataCoordClient_MarkSegmentsDropped_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_MarkSegmentsDropped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockDataCoordClient_GetTimeTickChannel_Call {
+func (_c *MockDataCoordClient_MarkSegmentsDropped_Call) RunAndReturn(run func(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_MarkSegmentsDropped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ImportV2 provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
+// PauseIndexBuilds provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) PauseIndexBuilds(ctx context.Context, in *indexpb.PauseIndexBuildsRequest, opts ...grpc.CallOption) (*indexpb.PauseIndexBuildsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2605,20 +4285,20 @@ func (_m *MockDataCoordClient) ImportV2(ctx context.Context, in *internalpb.Impo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *internalpb.ImportResponse
+	var r0 *indexpb.PauseIndexBuildsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) (*internalpb.ImportResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.PauseIndexBuildsRequest, ...grpc.CallOption) (*indexpb.PauseIndexBuildsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) *internalpb.ImportResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.PauseIndexBuildsRequest, ...grpc.CallOption) *indexpb.PauseIndexBuildsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.ImportResponse)
+			r0 = ret.Get(0).(*indexpb.PauseIndexBuildsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.PauseIndexBuildsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2627,21 +4307,21 @@ func (_m *MockDataCoordClient) ImportV2(ctx context.Context, in *internalpb.Impo
 	return r0, r1
 }
 
-// MockDataCoordClient_ImportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportV2'
-type MockDataCoordClient_ImportV2_Call struct {
+// MockDataCoordClient_PauseIndexBuilds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseIndexBuilds'
+type MockDataCoordClient_PauseIndexBuilds_Call struct {
 	*mock.Call
 }
 
-// ImportV2 is a helper method to define mock.On call
+// PauseIndexBuilds is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.ImportRequestInternal
+//   - in *indexpb.PauseIndexBuildsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) ImportV2(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ImportV2_Call {
-	return &MockDataCoordClient_ImportV2_Call{Call: _e.mock.On("ImportV2",
+func (_e *MockDataCoordClient_Expecter) PauseIndexBuilds(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_PauseIndexBuilds_Call {
+	return &MockDataCoordClient_PauseIndexBuilds_Call{Call: _e.mock.On("PauseIndexBuilds",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_ImportV2_Call) Run(run func(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption)) *MockDataCoordClient_ImportV2_Call {
+func (_c *MockDataCoordClient_PauseIndexBuilds_Call) Run(run func(ctx context.Context, in *indexpb.PauseIndexBuildsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_PauseIndexBuilds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2649,23 +4329,23 @@ func (_c *MockDataCoordClient_ImportV2_Call) Run(run func(ctx context.Context, i
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.ImportRequestInternal), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*indexpb.PauseIndexBuildsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_ImportV2_Call) Return(_a0 *internalpb.ImportResponse, _a1 error) *MockDataCoordClient_ImportV2_Call {
+func (_c *MockDataCoordClient_PauseIndexBuilds_Call) Return(_a0 *indexpb.PauseIndexBuildsResponse, _a1 error) *MockDataCoordClient_PauseIndexBuilds_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_ImportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ImportRequestInternal, ...grpc.CallOption) (*internalpb.ImportResponse, error)) *MockDataCoordClient_ImportV2_Call {
+func (_c *MockDataCoordClient_PauseIndexBuilds_Call) RunAndReturn(run func(context.Context, *indexpb.PauseIndexBuildsRequest, ...grpc.CallOption) (*indexpb.PauseIndexBuildsResponse, error)) *MockDataCoordClient_PauseIndexBuilds_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListImports provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) ListImports(ctx context.Context, in *internalpb.ListImportsRequestInternal, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error) {
+// PreviewCompaction provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) PreviewCompaction(ctx context.Context, in *datapb.PreviewCompactionRequest, opts ...grpc.CallOption) (*datapb.PreviewCompactionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2675,20 +4355,20 @@ func (_m *MockDataCoordClient) ListImports(ctx context.Context, in *internalpb.L
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *internalpb.ListImportsResponse
+	var r0 *datapb.PreviewCompactionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequestInternal, ...grpc.CallOption) (*internalpb.ListImportsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PreviewCompactionRequest, ...grpc.CallOption) (*datapb.PreviewCompactionResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequestInternal, ...grpc.CallOption) *internalpb.ListImportsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PreviewCompactionRequest, ...grpc.CallOption) *datapb.PreviewCompactionResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.ListImportsResponse)
+			r0 = ret.Get(0).(*datapb.PreviewCompactionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListImportsRequestInternal, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.PreviewCompactionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2697,21 +4377,21 @@ func (_m *MockDataCoordClient) ListImports(ctx context.Context, in *internalpb.L
 	return r0, r1
 }
 
-// MockDataCoordClient_ListImports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImports'
-type MockDataCoordClient_ListImports_Call struct {
+// MockDataCoordClient_PreviewCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewCompaction'
+type MockDataCoordClient_PreviewCompaction_Call struct {
 	*mock.Call
 }
 
-// ListImports is a helper method to define mock.On call
+// PreviewCompaction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.ListImportsRequestInternal
+//   - in *datapb.PreviewCompactionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) ListImports(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListImports_Call {
-	return &MockDataCoordClient_ListImports_Call{Call: _e.mock.On("ListImports",
+func (_e *MockDataCoordClient_Expecter) PreviewCompaction(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_PreviewCompaction_Call {
+	return &MockDataCoordClient_PreviewCompaction_Call{Call: _e.mock.On("PreviewCompaction",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_ListImports_Call) Run(run func(ctx context.Context, in *internalpb.ListImportsRequestInternal, opts ...grpc.CallOption)) *MockDataCoordClient_ListImports_Call {
+func (_c *MockDataCoordClient_PreviewCompaction_Call) Run(run func(ctx context.Context, in *datapb.PreviewCompactionRequest, opts ...grpc.CallOption)) *MockDataCoordClient_PreviewCompaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2719,23 +4399,23 @@ func (_c *MockDataCoordClient_ListImports_Call) Run(run func(ctx context.Context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.ListImportsRequestInternal), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.PreviewCompactionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_ListImports_Call) Return(_a0 *internalpb.ListImportsResponse, _a1 error) *MockDataCoordClient_ListImports_Call {
+func (_c *MockDataCoordClient_PreviewCompaction_Call) Return(_a0 *datapb.PreviewCompactionResponse, _a1 error) *MockDataCoordClient_PreviewCompaction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_ListImports_Call) RunAndReturn(run func(context.Context, *internalpb.ListImportsRequestInternal, ...grpc.CallOption) (*internalpb.ListImportsResponse, error)) *MockDataCoordClient_ListImports_Call {
+func (_c *MockDataCoordClient_PreviewCompaction_Call) RunAndReturn(run func(context.Context, *datapb.PreviewCompactionRequest, ...grpc.CallOption) (*datapb.PreviewCompactionResponse, error)) *MockDataCoordClient_PreviewCompaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListIndexes provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest, opts ...grpc.CallOption) (*indexpb.ListIndexesResponse, error) {
+// RebuildIncompatibleIndexes provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) RebuildIncompatibleIndexes(ctx context.Context, in *indexpb.RebuildIncompatibleIndexesRequest, opts ...grpc.CallOption) (*indexpb.RebuildIncompatibleIndexesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2745,20 +4425,20 @@ func (_m *MockDataCoordClient) ListIndexes(ctx context.Context, in *indexpb.List
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *indexpb.ListIndexesResponse
+	var r0 *indexpb.RebuildIncompatibleIndexesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexesRequest, ...grpc.CallOption) (*indexpb.ListIndexesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.RebuildIncompatibleIndexesRequest, ...grpc.CallOption) (*indexpb.RebuildIncompatibleIndexesResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexesRequest, ...grpc.CallOption) *indexpb.ListIndexesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.RebuildIncompatibleIndexesRequest, ...grpc.CallOption) *indexpb.RebuildIncompatibleIndexesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.ListIndexesResponse)
+			r0 = ret.Get(0).(*indexpb.RebuildIncompatibleIndexesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ListIndexesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.RebuildIncompatibleIndexesRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2767,21 +4447,21 @@ func (_m *MockDataCoordClient) ListIndexes(ctx context.Context, in *indexpb.List
 	return r0, r1
 }
 
-// MockDataCoordClient_ListIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIndexes'
-type MockDataCoordClient_ListIndexes_Call struct {
+// MockDataCoordClient_RebuildIncompatibleIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildIncompatibleIndexes'
+type MockDataCoordClient_RebuildIncompatibleIndexes_Call struct {
 	*mock.Call
 }
 
-// ListIndexes is a helper method to define mock.On call
+// RebuildIncompatibleIndexes is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *indexpb.ListIndexesRequest
+//   - in *indexpb.RebuildIncompatibleIndexesRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) ListIndexes(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ListIndexes_Call {
-	return &MockDataCoordClient_ListIndexes_Call{Call: _e.mock.On("ListIndexes",
+func (_e *MockDataCoordClient_Expecter) RebuildIncompatibleIndexes(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_RebuildIncompatibleIndexes_Call {
+	return &MockDataCoordClient_RebuildIncompatibleIndexes_Call{Call: _e.mock.On("RebuildIncompatibleIndexes",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_ListIndexes_Call) Run(run func(ctx context.Context, in *indexpb.ListIndexesRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ListIndexes_Call {
+func (_c *MockDataCoordClient_RebuildIncompatibleIndexes_Call) Run(run func(ctx context.Context, in *indexpb.RebuildIncompatibleIndexesRequest, opts ...grpc.CallOption)) *MockDataCoordClient_RebuildIncompatibleIndexes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2789,23 +4469,23 @@ func (_c *MockDataCoordClient_ListIndexes_Call) Run(run func(ctx context.Context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*indexpb.ListIndexesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*indexpb.RebuildIncompatibleIndexesRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_ListIndexes_Call) Return(_a0 *indexpb.ListIndexesResponse, _a1 error) *MockDataCoordClient_ListIndexes_Call {
+func (_c *MockDataCoordClient_RebuildIncompatibleIndexes_Call) Return(_a0 *indexpb.RebuildIncompatibleIndexesResponse, _a1 error) *MockDataCoordClient_RebuildIncompatibleIndexes_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_ListIndexes_Call) RunAndReturn(run func(context.Context, *indexpb.ListIndexesRequest, ...grpc.CallOption) (*indexpb.ListIndexesResponse, error)) *MockDataCoordClient_ListIndexes_Call {
+func (_c *MockDataCoordClient_RebuildIncompatibleIndexes_Call) RunAndReturn(run func(context.Context, *indexpb.RebuildIncompatibleIndexesRequest, ...grpc.CallOption) (*indexpb.RebuildIncompatibleIndexesResponse, error)) *MockDataCoordClient_RebuildIncompatibleIndexes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ManualCompaction provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+// ReleaseSegmentReference provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ReleaseSegmentReference(ctx context.Context, in *datapb.ReleaseSegmentReferenceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2815,20 +4495,20 @@ func (_m *MockDataCoordClient) ManualCompaction(ctx context.Context, in *milvusp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.ManualCompactionResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest, ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReleaseSegmentReferenceRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest, ...grpc.CallOption) *milvuspb.ManualCompactionResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReleaseSegmentReferenceRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ManualCompactionResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ManualCompactionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReleaseSegmentReferenceRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2837,21 +4517,21 @@ func (_m *MockDataCoordClient) ManualCompaction(ctx context.Context, in *milvusp
 	return r0, r1
 }
 
-// MockDataCoordClient_ManualCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualCompaction'
-type MockDataCoordClient_ManualCompaction_Call struct {
+// MockDataCoordClient_ReleaseSegmentReference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseSegmentReference'
+type MockDataCoordClient_ReleaseSegmentReference_Call struct {
 	*mock.Call
 }
 
-// ManualCompaction is a helper method to define mock.On call
+// ReleaseSegmentReference is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.ManualCompactionRequest
+//   - in *datapb.ReleaseSegmentReferenceRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) ManualCompaction(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ManualCompaction_Call {
-	return &MockDataCoordClient_ManualCompaction_Call{Call: _e.mock.On("ManualCompaction",
+func (_e *MockDataCoordClient_Expecter) ReleaseSegmentReference(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ReleaseSegmentReference_Call {
+	return &MockDataCoordClient_ReleaseSegmentReference_Call{Call: _e.mock.On("ReleaseSegmentReference",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_ManualCompaction_Call) Run(run func(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ManualCompaction_Call {
+func (_c *MockDataCoordClient_ReleaseSegmentReference_Call) Run(run func(ctx context.Context, in *datapb.ReleaseSegmentReferenceRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ReleaseSegmentReference_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2859,23 +4539,23 @@ func (_c *MockDataCoordClient_ManualCompaction_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.ManualCompactionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.ReleaseSegmentReferenceRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_ManualCompaction_Call) Return(_a0 *milvuspb.ManualCompactionResponse, _a1 error) *MockDataCoordClient_ManualCompaction_Call {
+func (_c *MockDataCoordClient_ReleaseSegmentReference_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_ReleaseSegmentReference_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_ManualCompaction_Call) RunAndReturn(run func(context.Context, *milvuspb.ManualCompactionRequest, ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)) *MockDataCoordClient_ManualCompaction_Call {
+func (_c *MockDataCoordClient_ReleaseSegmentReference_Call) RunAndReturn(run func(context.Context, *datapb.ReleaseSegmentReferenceRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_ReleaseSegmentReference_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkSegmentsDropped provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) MarkSegmentsDropped(ctx context.Context, in *datapb.MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// ReportDataNodeTtMsgs provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2887,10 +4567,10 @@ func (_m *MockDataCoordClient) MarkSegmentsDropped(ctx context.Context, in *data
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2898,7 +4578,7 @@ func (_m *MockDataCoordClient) MarkSegmentsDropped(ctx context.Context, in *data
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2907,21 +4587,21 @@ func (_m *MockDataCoordClient) MarkSegmentsDropped(ctx context.Context, in *data
 	return r0, r1
 }
 
-// MockDataCoordClient_MarkSegmentsDropped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSegmentsDropped'
-type MockDataCoordClient_MarkSegmentsDropped_Call struct {
+// MockDataCoordClient_ReportDataNodeTtMsgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportDataNodeTtMsgs'
+type MockDataCoordClient_ReportDataNodeTtMsgs_Call struct {
 	*mock.Call
 }
 
-// MarkSegmentsDropped is a helper method to define mock.On call
+// ReportDataNodeTtMsgs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.MarkSegmentsDroppedRequest
+//   - in *datapb.ReportDataNodeTtMsgsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) MarkSegmentsDropped(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_MarkSegmentsDropped_Call {
-	return &MockDataCoordClient_MarkSegmentsDropped_Call{Call: _e.mock.On("MarkSegmentsDropped",
+func (_e *MockDataCoordClient_Expecter) ReportDataNodeTtMsgs(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ReportDataNodeTtMsgs_Call {
+	return &MockDataCoordClient_ReportDataNodeTtMsgs_Call{Call: _e.mock.On("ReportDataNodeTtMsgs",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_MarkSegmentsDropped_Call) Run(run func(ctx context.Context, in *datapb.MarkSegmentsDroppedRequest, opts ...grpc.CallOption)) *MockDataCoordClient_MarkSegmentsDropped_Call {
+func (_c *MockDataCoordClient_ReportDataNodeTtMsgs_Call) Run(run func(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ReportDataNodeTtMsgs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2929,23 +4609,23 @@ func (_c *MockDataCoordClient_MarkSegmentsDropped_Call) Run(run func(ctx context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.MarkSegmentsDroppedRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*datapb.ReportDataNodeTtMsgsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_MarkSegmentsDropped_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_MarkSegmentsDropped_Call {
+func (_c *MockDataCoordClient_ReportDataNodeTtMsgs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_ReportDataNodeTtMsgs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_MarkSegmentsDropped_Call) RunAndReturn(run func(context.Context, *datapb.MarkSegmentsDroppedRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_MarkSegmentsDropped_Call {
+func (_c *MockDataCoordClient_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_ReportDataNodeTtMsgs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReportDataNodeTtMsgs provides a mock function with given fields: ctx, in, opts
-func (_m *MockDataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// ResumeIndexBuilds provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataCoordClient) ResumeIndexBuilds(ctx context.Context, in *indexpb.ResumeIndexBuildsRequest, opts ...grpc.CallOption) (*indexpb.ResumeIndexBuildsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2955,20 +4635,20 @@ func (_m *MockDataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *dat
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *indexpb.ResumeIndexBuildsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ResumeIndexBuildsRequest, ...grpc.CallOption) (*indexpb.ResumeIndexBuildsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ResumeIndexBuildsRequest, ...grpc.CallOption) *indexpb.ResumeIndexBuildsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*indexpb.ResumeIndexBuildsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ResumeIndexBuildsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2977,21 +4657,21 @@ func (_m *MockDataCoordClient) ReportDataNodeTtMsgs(ctx context.Context, in *dat
 	return r0, r1
 }
 
-// MockDataCoordClient_ReportDataNodeTtMsgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportDataNodeTtMsgs'
-type MockDataCoordClient_ReportDataNodeTtMsgs_Call struct {
+// MockDataCoordClient_ResumeIndexBuilds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeIndexBuilds'
+type MockDataCoordClient_ResumeIndexBuilds_Call struct {
 	*mock.Call
 }
 
-// ReportDataNodeTtMsgs is a helper method to define mock.On call
+// ResumeIndexBuilds is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *datapb.ReportDataNodeTtMsgsRequest
+//   - in *indexpb.ResumeIndexBuildsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockDataCoordClient_Expecter) ReportDataNodeTtMsgs(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ReportDataNodeTtMsgs_Call {
-	return &MockDataCoordClient_ReportDataNodeTtMsgs_Call{Call: _e.mock.On("ReportDataNodeTtMsgs",
+func (_e *MockDataCoordClient_Expecter) ResumeIndexBuilds(ctx interface{}, in interface{}, opts ...interface{}) *MockDataCoordClient_ResumeIndexBuilds_Call {
+	return &MockDataCoordClient_ResumeIndexBuilds_Call{Call: _e.mock.On("ResumeIndexBuilds",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockDataCoordClient_ReportDataNodeTtMsgs_Call) Run(run func(ctx context.Context, in *datapb.ReportDataNodeTtMsgsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ReportDataNodeTtMsgs_Call {
+func (_c *MockDataCoordClient_ResumeIndexBuilds_Call) Run(run func(ctx context.Context, in *indexpb.ResumeIndexBuildsRequest, opts ...grpc.CallOption)) *MockDataCoordClient_ResumeIndexBuilds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2999,17 +4679,17 @@ func (_c *MockDataCoordClient_ReportDataNodeTtMsgs_Call) Run(run func(ctx contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*datapb.ReportDataNodeTtMsgsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*indexpb.ResumeIndexBuildsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockDataCoordClient_ReportDataNodeTtMsgs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoordClient_ReportDataNodeTtMsgs_Call {
+func (_c *MockDataCoordClient_ResumeIndexBuilds_Call) Return(_a0 *indexpb.ResumeIndexBuildsResponse, _a1 error) *MockDataCoordClient_ResumeIndexBuilds_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoordClient_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context.Context, *datapb.ReportDataNodeTtMsgsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataCoordClient_ReportDataNodeTtMsgs_Call {
+func (_c *MockDataCoordClient_ResumeIndexBuilds_Call) RunAndReturn(run func(context.Context, *indexpb.ResumeIndexBuildsRequest, ...grpc.CallOption) (*indexpb.ResumeIndexBuildsResponse, error)) *MockDataCoordClient_ResumeIndexBuilds_Call {
 	_c.Call.Return(run)
 	return _c
 }
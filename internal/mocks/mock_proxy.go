@@ -419,6 +419,61 @@ func (_c *MockProxy_CheckHealth_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// CloneCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CloneCollection(_a0 context.Context, _a1 *proxypb.CloneCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CloneCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CloneCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CloneCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type MockProxy_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.CloneCollectionRequest
+func (_e *MockProxy_Expecter) CloneCollection(_a0 interface{}, _a1 interface{}) *MockProxy_CloneCollection_Call {
+	return &MockProxy_CloneCollection_Call{Call: _e.mock.On("CloneCollection", _a0, _a1)}
+}
+
+func (_c *MockProxy_CloneCollection_Call) Run(run func(_a0 context.Context, _a1 *proxypb.CloneCollectionRequest)) *MockProxy_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.CloneCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CloneCollection_Call) RunAndReturn(run func(context.Context, *proxypb.CloneCollectionRequest) (*commonpb.Status, error)) *MockProxy_CloneCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Connect provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Connect(_a0 context.Context, _a1 *milvuspb.ConnectRequest) (*milvuspb.ConnectResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -584,6 +639,61 @@ func (_c *MockProxy_CreateCollection_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// CreateCollectionWithConfig provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateCollectionWithConfig(_a0 context.Context, _a1 *proxypb.CreateCollectionWithConfigRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateCollectionWithConfigRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateCollectionWithConfigRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CreateCollectionWithConfigRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreateCollectionWithConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionWithConfig'
+type MockProxy_CreateCollectionWithConfig_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionWithConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.CreateCollectionWithConfigRequest
+func (_e *MockProxy_Expecter) CreateCollectionWithConfig(_a0 interface{}, _a1 interface{}) *MockProxy_CreateCollectionWithConfig_Call {
+	return &MockProxy_CreateCollectionWithConfig_Call{Call: _e.mock.On("CreateCollectionWithConfig", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreateCollectionWithConfig_Call) Run(run func(_a0 context.Context, _a1 *proxypb.CreateCollectionWithConfigRequest)) *MockProxy_CreateCollectionWithConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.CreateCollectionWithConfigRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreateCollectionWithConfig_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateCollectionWithConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreateCollectionWithConfig_Call) RunAndReturn(run func(context.Context, *proxypb.CreateCollectionWithConfigRequest) (*commonpb.Status, error)) *MockProxy_CreateCollectionWithConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCredential provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CreateCredential(_a0 context.Context, _a1 *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5468,6 +5578,61 @@ func (_c *MockProxy_ShowCollections_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ShowCollectionsWithMetadata provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ShowCollectionsWithMetadata(_a0 context.Context, _a1 *proxypb.ShowCollectionsWithMetadataRequest) (*proxypb.ShowCollectionsWithMetadataResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.ShowCollectionsWithMetadataResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest) (*proxypb.ShowCollectionsWithMetadataResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest) *proxypb.ShowCollectionsWithMetadataResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ShowCollectionsWithMetadataResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ShowCollectionsWithMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowCollectionsWithMetadata'
+type MockProxy_ShowCollectionsWithMetadata_Call struct {
+	*mock.Call
+}
+
+// ShowCollectionsWithMetadata is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.ShowCollectionsWithMetadataRequest
+func (_e *MockProxy_Expecter) ShowCollectionsWithMetadata(_a0 interface{}, _a1 interface{}) *MockProxy_ShowCollectionsWithMetadata_Call {
+	return &MockProxy_ShowCollectionsWithMetadata_Call{Call: _e.mock.On("ShowCollectionsWithMetadata", _a0, _a1)}
+}
+
+func (_c *MockProxy_ShowCollectionsWithMetadata_Call) Run(run func(_a0 context.Context, _a1 *proxypb.ShowCollectionsWithMetadataRequest)) *MockProxy_ShowCollectionsWithMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.ShowCollectionsWithMetadataRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ShowCollectionsWithMetadata_Call) Return(_a0 *proxypb.ShowCollectionsWithMetadataResponse, _a1 error) *MockProxy_ShowCollectionsWithMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ShowCollectionsWithMetadata_Call) RunAndReturn(run func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest) (*proxypb.ShowCollectionsWithMetadataResponse, error)) *MockProxy_ShowCollectionsWithMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShowPartitions provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ShowPartitions(_a0 context.Context, _a1 *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5968,6 +6133,49 @@ func (_c *MockProxy_Upsert_Call) RunAndReturn(run func(context.Context, *milvusp
 	return _c
 }
 
+// WatchCollections provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) WatchCollections(_a0 *proxypb.ShowCollectionsWithMetadataRequest, _a1 proxypb.Proxy_WatchCollectionsServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*proxypb.ShowCollectionsWithMetadataRequest, proxypb.Proxy_WatchCollectionsServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxy_WatchCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchCollections'
+type MockProxy_WatchCollections_Call struct {
+	*mock.Call
+}
+
+// WatchCollections is a helper method to define mock.On call
+//   - _a0 *proxypb.ShowCollectionsWithMetadataRequest
+//   - _a1 proxypb.Proxy_WatchCollectionsServer
+func (_e *MockProxy_Expecter) WatchCollections(_a0 interface{}, _a1 interface{}) *MockProxy_WatchCollections_Call {
+	return &MockProxy_WatchCollections_Call{Call: _e.mock.On("WatchCollections", _a0, _a1)}
+}
+
+func (_c *MockProxy_WatchCollections_Call) Run(run func(_a0 *proxypb.ShowCollectionsWithMetadataRequest, _a1 proxypb.Proxy_WatchCollectionsServer)) *MockProxy_WatchCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*proxypb.ShowCollectionsWithMetadataRequest), args[1].(proxypb.Proxy_WatchCollectionsServer))
+	})
+	return _c
+}
+
+func (_c *MockProxy_WatchCollections_Call) Return(_a0 error) *MockProxy_WatchCollections_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxy_WatchCollections_Call) RunAndReturn(run func(*proxypb.ShowCollectionsWithMetadataRequest, proxypb.Proxy_WatchCollectionsServer) error) *MockProxy_WatchCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockProxy creates a new instance of MockProxy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockProxy(t interface {
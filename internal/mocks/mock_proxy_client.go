@@ -31,6 +31,76 @@ func (_m *MockProxyClient) EXPECT() *MockProxyClient_Expecter {
 	return &MockProxyClient_Expecter{mock: &_m.Mock}
 }
 
+// CloneCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) CloneCollection(ctx context.Context, in *proxypb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CloneCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CloneCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CloneCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type MockProxyClient_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.CloneCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) CloneCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_CloneCollection_Call {
+	return &MockProxyClient_CloneCollection_Call{Call: _e.mock.On("CloneCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_CloneCollection_Call) Run(run func(ctx context.Context, in *proxypb.CloneCollectionRequest, opts ...grpc.CallOption)) *MockProxyClient_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.CloneCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_CloneCollection_Call) RunAndReturn(run func(context.Context, *proxypb.CloneCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_CloneCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *MockProxyClient) Close() error {
 	ret := _m.Called()
@@ -72,6 +142,76 @@ func (_c *MockProxyClient_Close_Call) RunAndReturn(run func() error) *MockProxyC
 	return _c
 }
 
+// CreateCollectionWithConfig provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) CreateCollectionWithConfig(ctx context.Context, in *proxypb.CreateCollectionWithConfigRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateCollectionWithConfigRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateCollectionWithConfigRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CreateCollectionWithConfigRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_CreateCollectionWithConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionWithConfig'
+type MockProxyClient_CreateCollectionWithConfig_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionWithConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.CreateCollectionWithConfigRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) CreateCollectionWithConfig(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_CreateCollectionWithConfig_Call {
+	return &MockProxyClient_CreateCollectionWithConfig_Call{Call: _e.mock.On("CreateCollectionWithConfig",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_CreateCollectionWithConfig_Call) Run(run func(ctx context.Context, in *proxypb.CreateCollectionWithConfigRequest, opts ...grpc.CallOption)) *MockProxyClient_CreateCollectionWithConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.CreateCollectionWithConfigRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_CreateCollectionWithConfig_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_CreateCollectionWithConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_CreateCollectionWithConfig_Call) RunAndReturn(run func(context.Context, *proxypb.CreateCollectionWithConfigRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_CreateCollectionWithConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	_va := make([]interface{}, len(opts))
@@ -982,6 +1122,76 @@ func (_c *MockProxyClient_SetRates_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ShowCollectionsWithMetadata provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ShowCollectionsWithMetadata(ctx context.Context, in *proxypb.ShowCollectionsWithMetadataRequest, opts ...grpc.CallOption) (*proxypb.ShowCollectionsWithMetadataResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.ShowCollectionsWithMetadataResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest, ...grpc.CallOption) (*proxypb.ShowCollectionsWithMetadataResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest, ...grpc.CallOption) *proxypb.ShowCollectionsWithMetadataResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ShowCollectionsWithMetadataResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ShowCollectionsWithMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShowCollectionsWithMetadata'
+type MockProxyClient_ShowCollectionsWithMetadata_Call struct {
+	*mock.Call
+}
+
+// ShowCollectionsWithMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.ShowCollectionsWithMetadataRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ShowCollectionsWithMetadata(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ShowCollectionsWithMetadata_Call {
+	return &MockProxyClient_ShowCollectionsWithMetadata_Call{Call: _e.mock.On("ShowCollectionsWithMetadata",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ShowCollectionsWithMetadata_Call) Run(run func(ctx context.Context, in *proxypb.ShowCollectionsWithMetadataRequest, opts ...grpc.CallOption)) *MockProxyClient_ShowCollectionsWithMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.ShowCollectionsWithMetadataRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ShowCollectionsWithMetadata_Call) Return(_a0 *proxypb.ShowCollectionsWithMetadataResponse, _a1 error) *MockProxyClient_ShowCollectionsWithMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ShowCollectionsWithMetadata_Call) RunAndReturn(run func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest, ...grpc.CallOption) (*proxypb.ShowCollectionsWithMetadataResponse, error)) *MockProxyClient_ShowCollectionsWithMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCredentialCache provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) UpdateCredentialCache(ctx context.Context, in *proxypb.UpdateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1052,6 +1262,76 @@ func (_c *MockProxyClient_UpdateCredentialCache_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// WatchCollections provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) WatchCollections(ctx context.Context, in *proxypb.ShowCollectionsWithMetadataRequest, opts ...grpc.CallOption) (proxypb.Proxy_WatchCollectionsClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 proxypb.Proxy_WatchCollectionsClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest, ...grpc.CallOption) (proxypb.Proxy_WatchCollectionsClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest, ...grpc.CallOption) proxypb.Proxy_WatchCollectionsClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(proxypb.Proxy_WatchCollectionsClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_WatchCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchCollections'
+type MockProxyClient_WatchCollections_Call struct {
+	*mock.Call
+}
+
+// WatchCollections is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.ShowCollectionsWithMetadataRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) WatchCollections(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_WatchCollections_Call {
+	return &MockProxyClient_WatchCollections_Call{Call: _e.mock.On("WatchCollections",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_WatchCollections_Call) Run(run func(ctx context.Context, in *proxypb.ShowCollectionsWithMetadataRequest, opts ...grpc.CallOption)) *MockProxyClient_WatchCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.ShowCollectionsWithMetadataRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_WatchCollections_Call) Return(_a0 proxypb.Proxy_WatchCollectionsClient, _a1 error) *MockProxyClient_WatchCollections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_WatchCollections_Call) RunAndReturn(run func(context.Context, *proxypb.ShowCollectionsWithMetadataRequest, ...grpc.CallOption) (proxypb.Proxy_WatchCollectionsClient, error)) *MockProxyClient_WatchCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockProxyClient creates a new instance of MockProxyClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockProxyClient(t interface {
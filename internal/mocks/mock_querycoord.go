@@ -199,6 +199,61 @@ func (_c *MockQueryCoord_CheckQueryNodeDistribution_Call) RunAndReturn(run func(
 	return _c
 }
 
+// CheckRowCountConsistency provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) CheckRowCountConsistency(_a0 context.Context, _a1 *querypb.CheckRowCountConsistencyRequest) (*querypb.CheckRowCountConsistencyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.CheckRowCountConsistencyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckRowCountConsistencyRequest) (*querypb.CheckRowCountConsistencyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckRowCountConsistencyRequest) *querypb.CheckRowCountConsistencyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.CheckRowCountConsistencyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CheckRowCountConsistencyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_CheckRowCountConsistency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckRowCountConsistency'
+type MockQueryCoord_CheckRowCountConsistency_Call struct {
+	*mock.Call
+}
+
+// CheckRowCountConsistency is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.CheckRowCountConsistencyRequest
+func (_e *MockQueryCoord_Expecter) CheckRowCountConsistency(_a0 interface{}, _a1 interface{}) *MockQueryCoord_CheckRowCountConsistency_Call {
+	return &MockQueryCoord_CheckRowCountConsistency_Call{Call: _e.mock.On("CheckRowCountConsistency", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_CheckRowCountConsistency_Call) Run(run func(_a0 context.Context, _a1 *querypb.CheckRowCountConsistencyRequest)) *MockQueryCoord_CheckRowCountConsistency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.CheckRowCountConsistencyRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_CheckRowCountConsistency_Call) Return(_a0 *querypb.CheckRowCountConsistencyResponse, _a1 error) *MockQueryCoord_CheckRowCountConsistency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_CheckRowCountConsistency_Call) RunAndReturn(run func(context.Context, *querypb.CheckRowCountConsistencyRequest) (*querypb.CheckRowCountConsistencyResponse, error)) *MockQueryCoord_CheckRowCountConsistency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateResourceGroup provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) CreateResourceGroup(_a0 context.Context, _a1 *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -694,6 +749,61 @@ func (_c *MockQueryCoord_GetReplicas_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetSafeRemoveNodeProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetSafeRemoveNodeProgress(_a0 context.Context, _a1 *querypb.GetSafeRemoveNodeProgressRequest) (*querypb.GetSafeRemoveNodeProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetSafeRemoveNodeProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSafeRemoveNodeProgressRequest) (*querypb.GetSafeRemoveNodeProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSafeRemoveNodeProgressRequest) *querypb.GetSafeRemoveNodeProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetSafeRemoveNodeProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetSafeRemoveNodeProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetSafeRemoveNodeProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSafeRemoveNodeProgress'
+type MockQueryCoord_GetSafeRemoveNodeProgress_Call struct {
+	*mock.Call
+}
+
+// GetSafeRemoveNodeProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetSafeRemoveNodeProgressRequest
+func (_e *MockQueryCoord_Expecter) GetSafeRemoveNodeProgress(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetSafeRemoveNodeProgress_Call {
+	return &MockQueryCoord_GetSafeRemoveNodeProgress_Call{Call: _e.mock.On("GetSafeRemoveNodeProgress", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetSafeRemoveNodeProgress_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetSafeRemoveNodeProgressRequest)) *MockQueryCoord_GetSafeRemoveNodeProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetSafeRemoveNodeProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetSafeRemoveNodeProgress_Call) Return(_a0 *querypb.GetSafeRemoveNodeProgressResponse, _a1 error) *MockQueryCoord_GetSafeRemoveNodeProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetSafeRemoveNodeProgress_Call) RunAndReturn(run func(context.Context, *querypb.GetSafeRemoveNodeProgressRequest) (*querypb.GetSafeRemoveNodeProgressResponse, error)) *MockQueryCoord_GetSafeRemoveNodeProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSegmentInfo provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) GetSegmentInfo(_a0 context.Context, _a1 *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1546,6 +1656,61 @@ func (_c *MockQueryCoord_ResumeNode_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// SafeRemoveNode provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) SafeRemoveNode(_a0 context.Context, _a1 *querypb.SafeRemoveNodeRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SafeRemoveNodeRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SafeRemoveNodeRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SafeRemoveNodeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_SafeRemoveNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SafeRemoveNode'
+type MockQueryCoord_SafeRemoveNode_Call struct {
+	*mock.Call
+}
+
+// SafeRemoveNode is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.SafeRemoveNodeRequest
+func (_e *MockQueryCoord_Expecter) SafeRemoveNode(_a0 interface{}, _a1 interface{}) *MockQueryCoord_SafeRemoveNode_Call {
+	return &MockQueryCoord_SafeRemoveNode_Call{Call: _e.mock.On("SafeRemoveNode", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_SafeRemoveNode_Call) Run(run func(_a0 context.Context, _a1 *querypb.SafeRemoveNodeRequest)) *MockQueryCoord_SafeRemoveNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.SafeRemoveNodeRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_SafeRemoveNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_SafeRemoveNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_SafeRemoveNode_Call) RunAndReturn(run func(context.Context, *querypb.SafeRemoveNodeRequest) (*commonpb.Status, error)) *MockQueryCoord_SafeRemoveNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetAddress provides a mock function with given fields: address
 func (_m *MockQueryCoord) SetAddress(address string) {
 	_m.Called(address)
@@ -241,6 +241,76 @@ func (_c *MockQueryCoordClient_CheckQueryNodeDistribution_Call) RunAndReturn(run
 	return _c
 }
 
+// CheckRowCountConsistency provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) CheckRowCountConsistency(ctx context.Context, in *querypb.CheckRowCountConsistencyRequest, opts ...grpc.CallOption) (*querypb.CheckRowCountConsistencyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.CheckRowCountConsistencyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckRowCountConsistencyRequest, ...grpc.CallOption) (*querypb.CheckRowCountConsistencyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.CheckRowCountConsistencyRequest, ...grpc.CallOption) *querypb.CheckRowCountConsistencyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.CheckRowCountConsistencyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.CheckRowCountConsistencyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_CheckRowCountConsistency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckRowCountConsistency'
+type MockQueryCoordClient_CheckRowCountConsistency_Call struct {
+	*mock.Call
+}
+
+// CheckRowCountConsistency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.CheckRowCountConsistencyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) CheckRowCountConsistency(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_CheckRowCountConsistency_Call {
+	return &MockQueryCoordClient_CheckRowCountConsistency_Call{Call: _e.mock.On("CheckRowCountConsistency",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_CheckRowCountConsistency_Call) Run(run func(ctx context.Context, in *querypb.CheckRowCountConsistencyRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_CheckRowCountConsistency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.CheckRowCountConsistencyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_CheckRowCountConsistency_Call) Return(_a0 *querypb.CheckRowCountConsistencyResponse, _a1 error) *MockQueryCoordClient_CheckRowCountConsistency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_CheckRowCountConsistency_Call) RunAndReturn(run func(context.Context, *querypb.CheckRowCountConsistencyRequest, ...grpc.CallOption) (*querypb.CheckRowCountConsistencyResponse, error)) *MockQueryCoordClient_CheckRowCountConsistency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *MockQueryCoordClient) Close() error {
 	ret := _m.Called()
@@ -912,6 +982,76 @@ func (_c *MockQueryCoordClient_GetReplicas_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetSafeRemoveNodeProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetSafeRemoveNodeProgress(ctx context.Context, in *querypb.GetSafeRemoveNodeProgressRequest, opts ...grpc.CallOption) (*querypb.GetSafeRemoveNodeProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetSafeRemoveNodeProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSafeRemoveNodeProgressRequest, ...grpc.CallOption) (*querypb.GetSafeRemoveNodeProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetSafeRemoveNodeProgressRequest, ...grpc.CallOption) *querypb.GetSafeRemoveNodeProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetSafeRemoveNodeProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetSafeRemoveNodeProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetSafeRemoveNodeProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSafeRemoveNodeProgress'
+type MockQueryCoordClient_GetSafeRemoveNodeProgress_Call struct {
+	*mock.Call
+}
+
+// GetSafeRemoveNodeProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetSafeRemoveNodeProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetSafeRemoveNodeProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetSafeRemoveNodeProgress_Call {
+	return &MockQueryCoordClient_GetSafeRemoveNodeProgress_Call{Call: _e.mock.On("GetSafeRemoveNodeProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetSafeRemoveNodeProgress_Call) Run(run func(ctx context.Context, in *querypb.GetSafeRemoveNodeProgressRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetSafeRemoveNodeProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetSafeRemoveNodeProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetSafeRemoveNodeProgress_Call) Return(_a0 *querypb.GetSafeRemoveNodeProgressResponse, _a1 error) *MockQueryCoordClient_GetSafeRemoveNodeProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetSafeRemoveNodeProgress_Call) RunAndReturn(run func(context.Context, *querypb.GetSafeRemoveNodeProgressRequest, ...grpc.CallOption) (*querypb.GetSafeRemoveNodeProgressResponse, error)) *MockQueryCoordClient_GetSafeRemoveNodeProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSegmentInfo provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) GetSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest, opts ...grpc.CallOption) (*querypb.GetSegmentInfoResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1892,6 +2032,76 @@ func (_c *MockQueryCoordClient_ResumeNode_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// SafeRemoveNode provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) SafeRemoveNode(ctx context.Context, in *querypb.SafeRemoveNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SafeRemoveNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SafeRemoveNodeRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SafeRemoveNodeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_SafeRemoveNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SafeRemoveNode'
+type MockQueryCoordClient_SafeRemoveNode_Call struct {
+	*mock.Call
+}
+
+// SafeRemoveNode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.SafeRemoveNodeRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) SafeRemoveNode(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_SafeRemoveNode_Call {
+	return &MockQueryCoordClient_SafeRemoveNode_Call{Call: _e.mock.On("SafeRemoveNode",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_SafeRemoveNode_Call) Run(run func(ctx context.Context, in *querypb.SafeRemoveNodeRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_SafeRemoveNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.SafeRemoveNodeRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_SafeRemoveNode_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_SafeRemoveNode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_SafeRemoveNode_Call) RunAndReturn(run func(context.Context, *querypb.SafeRemoveNodeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_SafeRemoveNode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShowCollections provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) ShowCollections(ctx context.Context, in *querypb.ShowCollectionsRequest, opts ...grpc.CallOption) (*querypb.ShowCollectionsResponse, error) {
 	_va := make([]interface{}, len(opts))
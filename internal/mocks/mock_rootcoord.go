@@ -1356,6 +1356,116 @@ func (_c *RootCoord_GetCredential_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// GetDropCollectionProgress provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) GetDropCollectionProgress(_a0 context.Context, _a1 *rootcoordpb.GetDropCollectionProgressRequest) (*rootcoordpb.GetDropCollectionProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rootcoordpb.GetDropCollectionProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetDropCollectionProgressRequest) (*rootcoordpb.GetDropCollectionProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetDropCollectionProgressRequest) *rootcoordpb.GetDropCollectionProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetDropCollectionProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetDropCollectionProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetDropCollectionProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDropCollectionProgress'
+type RootCoord_GetDropCollectionProgress_Call struct {
+	*mock.Call
+}
+
+// GetDropCollectionProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.GetDropCollectionProgressRequest
+func (_e *RootCoord_Expecter) GetDropCollectionProgress(_a0 interface{}, _a1 interface{}) *RootCoord_GetDropCollectionProgress_Call {
+	return &RootCoord_GetDropCollectionProgress_Call{Call: _e.mock.On("GetDropCollectionProgress", _a0, _a1)}
+}
+
+func (_c *RootCoord_GetDropCollectionProgress_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.GetDropCollectionProgressRequest)) *RootCoord_GetDropCollectionProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetDropCollectionProgressRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetDropCollectionProgress_Call) Return(_a0 *rootcoordpb.GetDropCollectionProgressResponse, _a1 error) *RootCoord_GetDropCollectionProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_GetDropCollectionProgress_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetDropCollectionProgressRequest) (*rootcoordpb.GetDropCollectionProgressResponse, error)) *RootCoord_GetDropCollectionProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetaSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) GetMetaSnapshot(_a0 context.Context, _a1 *rootcoordpb.GetMetaSnapshotRequest) (*rootcoordpb.GetMetaSnapshotResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rootcoordpb.GetMetaSnapshotResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetMetaSnapshotRequest) (*rootcoordpb.GetMetaSnapshotResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetMetaSnapshotRequest) *rootcoordpb.GetMetaSnapshotResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetMetaSnapshotResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetMetaSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetMetaSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetaSnapshot'
+type RootCoord_GetMetaSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetMetaSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.GetMetaSnapshotRequest
+func (_e *RootCoord_Expecter) GetMetaSnapshot(_a0 interface{}, _a1 interface{}) *RootCoord_GetMetaSnapshot_Call {
+	return &RootCoord_GetMetaSnapshot_Call{Call: _e.mock.On("GetMetaSnapshot", _a0, _a1)}
+}
+
+func (_c *RootCoord_GetMetaSnapshot_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.GetMetaSnapshotRequest)) *RootCoord_GetMetaSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetMetaSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetMetaSnapshot_Call) Return(_a0 *rootcoordpb.GetMetaSnapshotResponse, _a1 error) *RootCoord_GetMetaSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_GetMetaSnapshot_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetMetaSnapshotRequest) (*rootcoordpb.GetMetaSnapshotResponse, error)) *RootCoord_GetMetaSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMetrics provides a mock function with given fields: ctx, req
 func (_m *RootCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1466,6 +1576,61 @@ func (_c *RootCoord_GetPChannelInfo_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetPartitionKeyStats provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) GetPartitionKeyStats(_a0 context.Context, _a1 *rootcoordpb.GetPartitionKeyStatsRequest) (*rootcoordpb.GetPartitionKeyStatsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rootcoordpb.GetPartitionKeyStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetPartitionKeyStatsRequest) (*rootcoordpb.GetPartitionKeyStatsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetPartitionKeyStatsRequest) *rootcoordpb.GetPartitionKeyStatsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetPartitionKeyStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetPartitionKeyStatsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetPartitionKeyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionKeyStats'
+type RootCoord_GetPartitionKeyStats_Call struct {
+	*mock.Call
+}
+
+// GetPartitionKeyStats is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.GetPartitionKeyStatsRequest
+func (_e *RootCoord_Expecter) GetPartitionKeyStats(_a0 interface{}, _a1 interface{}) *RootCoord_GetPartitionKeyStats_Call {
+	return &RootCoord_GetPartitionKeyStats_Call{Call: _e.mock.On("GetPartitionKeyStats", _a0, _a1)}
+}
+
+func (_c *RootCoord_GetPartitionKeyStats_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.GetPartitionKeyStatsRequest)) *RootCoord_GetPartitionKeyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetPartitionKeyStatsRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetPartitionKeyStats_Call) Return(_a0 *rootcoordpb.GetPartitionKeyStatsResponse, _a1 error) *RootCoord_GetPartitionKeyStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_GetPartitionKeyStats_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetPartitionKeyStatsRequest) (*rootcoordpb.GetPartitionKeyStatsResponse, error)) *RootCoord_GetPartitionKeyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStatisticsChannel provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) GetStatisticsChannel(_a0 context.Context, _a1 *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3056,6 +3221,61 @@ func (_c *RootCoord_UpdateCredential_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// UpdatePartitionKeyStats provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) UpdatePartitionKeyStats(_a0 context.Context, _a1 *rootcoordpb.UpdatePartitionKeyStatsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.UpdatePartitionKeyStatsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.UpdatePartitionKeyStatsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.UpdatePartitionKeyStatsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_UpdatePartitionKeyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePartitionKeyStats'
+type RootCoord_UpdatePartitionKeyStats_Call struct {
+	*mock.Call
+}
+
+// UpdatePartitionKeyStats is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.UpdatePartitionKeyStatsRequest
+func (_e *RootCoord_Expecter) UpdatePartitionKeyStats(_a0 interface{}, _a1 interface{}) *RootCoord_UpdatePartitionKeyStats_Call {
+	return &RootCoord_UpdatePartitionKeyStats_Call{Call: _e.mock.On("UpdatePartitionKeyStats", _a0, _a1)}
+}
+
+func (_c *RootCoord_UpdatePartitionKeyStats_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.UpdatePartitionKeyStatsRequest)) *RootCoord_UpdatePartitionKeyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.UpdatePartitionKeyStatsRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_UpdatePartitionKeyStats_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_UpdatePartitionKeyStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_UpdatePartitionKeyStats_Call) RunAndReturn(run func(context.Context, *rootcoordpb.UpdatePartitionKeyStatsRequest) (*commonpb.Status, error)) *RootCoord_UpdatePartitionKeyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateCode provides a mock function with given fields: _a0
 func (_m *RootCoord) UpdateStateCode(_a0 commonpb.StateCode) {
 	_m.Called(_a0)
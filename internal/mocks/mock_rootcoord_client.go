@@ -1754,6 +1754,146 @@ func (_c *MockRootCoordClient_GetCredential_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetDropCollectionProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetDropCollectionProgress(ctx context.Context, in *rootcoordpb.GetDropCollectionProgressRequest, opts ...grpc.CallOption) (*rootcoordpb.GetDropCollectionProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rootcoordpb.GetDropCollectionProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetDropCollectionProgressRequest, ...grpc.CallOption) (*rootcoordpb.GetDropCollectionProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetDropCollectionProgressRequest, ...grpc.CallOption) *rootcoordpb.GetDropCollectionProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetDropCollectionProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetDropCollectionProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetDropCollectionProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDropCollectionProgress'
+type MockRootCoordClient_GetDropCollectionProgress_Call struct {
+	*mock.Call
+}
+
+// GetDropCollectionProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.GetDropCollectionProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetDropCollectionProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetDropCollectionProgress_Call {
+	return &MockRootCoordClient_GetDropCollectionProgress_Call{Call: _e.mock.On("GetDropCollectionProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetDropCollectionProgress_Call) Run(run func(ctx context.Context, in *rootcoordpb.GetDropCollectionProgressRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetDropCollectionProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetDropCollectionProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetDropCollectionProgress_Call) Return(_a0 *rootcoordpb.GetDropCollectionProgressResponse, _a1 error) *MockRootCoordClient_GetDropCollectionProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetDropCollectionProgress_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetDropCollectionProgressRequest, ...grpc.CallOption) (*rootcoordpb.GetDropCollectionProgressResponse, error)) *MockRootCoordClient_GetDropCollectionProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetaSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetMetaSnapshot(ctx context.Context, in *rootcoordpb.GetMetaSnapshotRequest, opts ...grpc.CallOption) (*rootcoordpb.GetMetaSnapshotResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rootcoordpb.GetMetaSnapshotResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetMetaSnapshotRequest, ...grpc.CallOption) (*rootcoordpb.GetMetaSnapshotResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetMetaSnapshotRequest, ...grpc.CallOption) *rootcoordpb.GetMetaSnapshotResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetMetaSnapshotResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetMetaSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetMetaSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetaSnapshot'
+type MockRootCoordClient_GetMetaSnapshot_Call struct {
+	*mock.Call
+}
+
+// GetMetaSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.GetMetaSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetMetaSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetMetaSnapshot_Call {
+	return &MockRootCoordClient_GetMetaSnapshot_Call{Call: _e.mock.On("GetMetaSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetMetaSnapshot_Call) Run(run func(ctx context.Context, in *rootcoordpb.GetMetaSnapshotRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetMetaSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetMetaSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetMetaSnapshot_Call) Return(_a0 *rootcoordpb.GetMetaSnapshotResponse, _a1 error) *MockRootCoordClient_GetMetaSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetMetaSnapshot_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetMetaSnapshotRequest, ...grpc.CallOption) (*rootcoordpb.GetMetaSnapshotResponse, error)) *MockRootCoordClient_GetMetaSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMetrics provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1894,6 +2034,76 @@ func (_c *MockRootCoordClient_GetPChannelInfo_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetPartitionKeyStats provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetPartitionKeyStats(ctx context.Context, in *rootcoordpb.GetPartitionKeyStatsRequest, opts ...grpc.CallOption) (*rootcoordpb.GetPartitionKeyStatsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rootcoordpb.GetPartitionKeyStatsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetPartitionKeyStatsRequest, ...grpc.CallOption) (*rootcoordpb.GetPartitionKeyStatsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetPartitionKeyStatsRequest, ...grpc.CallOption) *rootcoordpb.GetPartitionKeyStatsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetPartitionKeyStatsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetPartitionKeyStatsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetPartitionKeyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionKeyStats'
+type MockRootCoordClient_GetPartitionKeyStats_Call struct {
+	*mock.Call
+}
+
+// GetPartitionKeyStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.GetPartitionKeyStatsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetPartitionKeyStats(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetPartitionKeyStats_Call {
+	return &MockRootCoordClient_GetPartitionKeyStats_Call{Call: _e.mock.On("GetPartitionKeyStats",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetPartitionKeyStats_Call) Run(run func(ctx context.Context, in *rootcoordpb.GetPartitionKeyStatsRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetPartitionKeyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetPartitionKeyStatsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetPartitionKeyStats_Call) Return(_a0 *rootcoordpb.GetPartitionKeyStatsResponse, _a1 error) *MockRootCoordClient_GetPartitionKeyStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetPartitionKeyStats_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetPartitionKeyStatsRequest, ...grpc.CallOption) (*rootcoordpb.GetPartitionKeyStatsResponse, error)) *MockRootCoordClient_GetPartitionKeyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStatisticsChannel provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3434,6 +3644,76 @@ func (_c *MockRootCoordClient_UpdateCredential_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// UpdatePartitionKeyStats provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) UpdatePartitionKeyStats(ctx context.Context, in *rootcoordpb.UpdatePartitionKeyStatsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.UpdatePartitionKeyStatsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.UpdatePartitionKeyStatsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.UpdatePartitionKeyStatsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_UpdatePartitionKeyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePartitionKeyStats'
+type MockRootCoordClient_UpdatePartitionKeyStats_Call struct {
+	*mock.Call
+}
+
+// UpdatePartitionKeyStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.UpdatePartitionKeyStatsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) UpdatePartitionKeyStats(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_UpdatePartitionKeyStats_Call {
+	return &MockRootCoordClient_UpdatePartitionKeyStats_Call{Call: _e.mock.On("UpdatePartitionKeyStats",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_UpdatePartitionKeyStats_Call) Run(run func(ctx context.Context, in *rootcoordpb.UpdatePartitionKeyStatsRequest, opts ...grpc.CallOption)) *MockRootCoordClient_UpdatePartitionKeyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.UpdatePartitionKeyStatsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_UpdatePartitionKeyStats_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_UpdatePartitionKeyStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_UpdatePartitionKeyStats_Call) RunAndReturn(run func(context.Context, *rootcoordpb.UpdatePartitionKeyStatsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_UpdatePartitionKeyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRootCoordClient creates a new instance of MockRootCoordClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRootCoordClient(t interface {
@@ -0,0 +1,272 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// describeIndexParallel is the max number of the collections to describe the indexes concurrently.
+const describeIndexParallel = 8
+
+// ShowCollectionsWithMetadata lists the collections with their sizes, row counts, load states and indexes in one call.
+func (node *Proxy) ShowCollectionsWithMetadata(ctx context.Context, req *proxypb.ShowCollectionsWithMetadataRequest) (*proxypb.ShowCollectionsWithMetadataResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.ShowCollectionsWithMetadataResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ShowCollectionsWithMetadata")
+	defer sp.End()
+
+	collections, err := node.showCollectionsWithMetadata(ctx, req)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to show collections with metadata", zap.String("db", req.GetDbName()), zap.Error(err))
+		return &proxypb.ShowCollectionsWithMetadataResponse{Status: merr.Status(err)}, nil
+	}
+	return &proxypb.ShowCollectionsWithMetadataResponse{
+		Status:      merr.Success(),
+		Collections: collections,
+	}, nil
+}
+
+// WatchCollections pushes the changes of the collections checked periodically until the stream is closed,
+// the first response carries all the collections, no response is pushed if nothing changes.
+func (node *Proxy) WatchCollections(req *proxypb.ShowCollectionsWithMetadataRequest, stream proxypb.Proxy_WatchCollectionsServer) error {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return stream.Send(&proxypb.WatchCollectionsResponse{Status: merr.Status(err)})
+	}
+
+	ctx := stream.Context()
+	log := log.Ctx(ctx).With(zap.String("db", req.GetDbName()), zap.Strings("collections", req.GetCollectionNames()))
+	log.Info("start watching collections")
+	defer log.Info("stop watching collections")
+
+	var last map[string]*proxypb.CollectionMetadata
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-node.ctx.Done():
+			return merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "stopping")
+		case <-timer.C:
+		}
+
+		collections, err := node.showCollectionsWithMetadata(ctx, req)
+		if err != nil {
+			// the watch keeps going after the first response, and the changes are pushed once recovered
+			log.Warn("failed to check the changes of the collections", zap.Error(err))
+			if last == nil {
+				return stream.Send(&proxypb.WatchCollectionsResponse{Status: merr.Status(err)})
+			}
+		} else {
+			current := lo.SliceToMap(collections, func(collection *proxypb.CollectionMetadata) (string, *proxypb.CollectionMetadata) {
+				return collection.GetCollectionName(), collection
+			})
+			changed, dropped := diffCollections(last, current)
+			if last == nil || len(changed) > 0 || len(dropped) > 0 {
+				err := stream.Send(&proxypb.WatchCollectionsResponse{
+					Status:             merr.Success(),
+					ChangedCollections: changed,
+					DroppedCollections: dropped,
+				})
+				if err != nil {
+					log.Warn("failed to push the changes of the collections", zap.Error(err))
+					return err
+				}
+			}
+			last = current
+		}
+		timer.Reset(paramtable.Get().ProxyCfg.CollectionWatchInterval.GetAsDuration(time.Second))
+	}
+}
+
+// diffCollections returns the collections created or changed and the ones dropped in the current collections,
+// all the current collections are changed if there are no last ones.
+func diffCollections(last, current map[string]*proxypb.CollectionMetadata) ([]*proxypb.CollectionMetadata, []string) {
+	changed := make([]*proxypb.CollectionMetadata, 0)
+	for name, collection := range current {
+		if old, ok := last[name]; !ok || !proto.Equal(old, collection) {
+			changed = append(changed, collection)
+		}
+	}
+	dropped := make([]string, 0)
+	for name, collection := range last {
+		if now, ok := current[name]; !ok || now.GetCollectionID() != collection.GetCollectionID() {
+			dropped = append(dropped, name)
+		}
+	}
+	sortCollectionMetadata(changed)
+	sort.Strings(dropped)
+	return changed, dropped
+}
+
+func (node *Proxy) showCollectionsWithMetadata(ctx context.Context, req *proxypb.ShowCollectionsWithMetadataRequest) ([]*proxypb.CollectionMetadata, error) {
+	// the collections visible to the user only
+	showResp, err := node.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+		DbName: req.GetDbName(),
+		Type:   milvuspb.ShowType_All,
+	})
+	if err := merr.CheckRPCCall(showResp, err); err != nil {
+		return nil, err
+	}
+
+	collections := make([]*proxypb.CollectionMetadata, 0, len(showResp.GetCollectionNames()))
+	for i, name := range showResp.GetCollectionNames() {
+		if len(req.GetCollectionNames()) > 0 && !lo.Contains(req.GetCollectionNames(), name) {
+			continue
+		}
+		collections = append(collections, &proxypb.CollectionMetadata{
+			DbName:                   req.GetDbName(),
+			CollectionName:           name,
+			CollectionID:             showResp.GetCollectionIds()[i],
+			CreatedUtcTimestamp:      showResp.GetCreatedUtcTimestamps()[i],
+			LastModifiedUtcTimestamp: showResp.GetCreatedUtcTimestamps()[i],
+			LoadState:                commonpb.LoadState_LoadStateNotLoad,
+		})
+	}
+	if len(collections) == 0 {
+		return collections, nil
+	}
+	collectionIDs := lo.Map(collections, func(collection *proxypb.CollectionMetadata, _ int) int64 {
+		return collection.GetCollectionID()
+	})
+
+	summaryResp, err := node.dataCoord.GetCollectionDataSummaries(ctx, &datapb.GetCollectionDataSummariesRequest{
+		CollectionIDs: collectionIDs,
+	})
+	if err := merr.CheckRPCCall(summaryResp, err); err != nil {
+		return nil, err
+	}
+	summaries := lo.SliceToMap(summaryResp.GetSummaries(), func(summary *datapb.CollectionDataSummary) (int64, *datapb.CollectionDataSummary) {
+		return summary.GetCollectionID(), summary
+	})
+
+	// the loaded collections only
+	loadResp, err := node.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{})
+	if err := merr.CheckRPCCall(loadResp, err); err != nil {
+		return nil, err
+	}
+	loadingProgress := make(map[int64]int64, len(loadResp.GetCollectionIDs()))
+	for i, collectionID := range loadResp.GetCollectionIDs() {
+		loadingProgress[collectionID] = loadResp.GetInMemoryPercentages()[i]
+	}
+
+	for _, collection := range collections {
+		if summary, ok := summaries[collection.GetCollectionID()]; ok {
+			collection.RowCount = summary.GetNumRows()
+			collection.DataSize = summary.GetDataSize()
+			if ts := summary.GetLastModifiedTimestamp(); ts > 0 {
+				collection.LastModifiedUtcTimestamp = uint64(tsoutil.PhysicalTime(ts).UnixMilli())
+			}
+		}
+		if progress, ok := loadingProgress[collection.GetCollectionID()]; ok {
+			collection.LoadingProgress = progress
+			collection.LoadState = commonpb.LoadState_LoadStateLoading
+			if progress >= 100 {
+				collection.LoadState = commonpb.LoadState_LoadStateLoaded
+			}
+		}
+	}
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(describeIndexParallel)
+	for _, collection := range collections {
+		collection := collection
+		group.Go(func() error {
+			indexes, err := node.summarizeIndexes(groupCtx, collection)
+			if err != nil {
+				return err
+			}
+			collection.Indexes = indexes
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	sortCollectionMetadata(collections)
+	return collections, nil
+}
+
+// summarizeIndexes returns the summaries of the indexes of the collection, empty if there is no index.
+func (node *Proxy) summarizeIndexes(ctx context.Context, collection *proxypb.CollectionMetadata) ([]*proxypb.IndexSummary, error) {
+	resp, err := node.dataCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
+		CollectionID: collection.GetCollectionID(),
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		if errors.Is(err, merr.ErrIndexNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	fieldNames := make(map[int64]string)
+	if len(resp.GetIndexInfos()) > 0 {
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, collection.GetDbName(), collection.GetCollectionName())
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range schema.GetFields() {
+			fieldNames[field.GetFieldID()] = field.GetName()
+		}
+	}
+	indexes := make([]*proxypb.IndexSummary, 0, len(resp.GetIndexInfos()))
+	for _, info := range resp.GetIndexInfos() {
+		indexType, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.IndexTypeKey, info.GetIndexParams())
+		indexes = append(indexes, &proxypb.IndexSummary{
+			IndexName:        info.GetIndexName(),
+			FieldName:        fieldNames[info.GetFieldID()],
+			IndexType:        indexType,
+			State:            info.GetState(),
+			IndexedRows:      info.GetIndexedRows(),
+			TotalRows:        info.GetTotalRows(),
+			PendingIndexRows: info.GetPendingIndexRows(),
+		})
+	}
+	return indexes, nil
+}
+
+func sortCollectionMetadata(collections []*proxypb.CollectionMetadata) {
+	sort.Slice(collections, func(i, j int) bool {
+		return collections[i].GetCollectionName() < collections[j].GetCollectionName()
+	})
+}
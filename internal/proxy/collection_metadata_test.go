@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestDiffCollections(t *testing.T) {
+	collection := func(name string, id int64, rows int64) *proxypb.CollectionMetadata {
+		return &proxypb.CollectionMetadata{CollectionName: name, CollectionID: id, RowCount: rows}
+	}
+	toMap := func(collections ...*proxypb.CollectionMetadata) map[string]*proxypb.CollectionMetadata {
+		m := make(map[string]*proxypb.CollectionMetadata)
+		for _, c := range collections {
+			m[c.GetCollectionName()] = c
+		}
+		return m
+	}
+
+	// all the collections are changed at the first time
+	changed, dropped := diffCollections(nil, toMap(collection("b", 2, 0), collection("a", 1, 0)))
+	assert.Equal(t, []string{"a", "b"}, []string{changed[0].GetCollectionName(), changed[1].GetCollectionName()})
+	assert.Empty(t, dropped)
+
+	last := toMap(collection("a", 1, 0), collection("b", 2, 0), collection("c", 3, 0))
+	changed, dropped = diffCollections(last, toMap(collection("a", 1, 0), collection("b", 2, 0), collection("c", 3, 0)))
+	assert.Empty(t, changed)
+	assert.Empty(t, dropped)
+
+	// b is updated, c is dropped and recreated, a is dropped, d is created
+	changed, dropped = diffCollections(last, toMap(collection("b", 2, 10), collection("c", 4, 0), collection("d", 5, 0)))
+	assert.Len(t, changed, 3)
+	assert.Equal(t, int64(10), changed[0].GetRowCount())
+	assert.Equal(t, int64(4), changed[1].GetCollectionID())
+	assert.Equal(t, "d", changed[2].GetCollectionName())
+	assert.Equal(t, []string{"a", "c"}, dropped)
+}
+
+func TestProxy_ShowCollectionsWithMetadata_Unhealthy(t *testing.T) {
+	node := &Proxy{}
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := node.ShowCollectionsWithMetadata(context.Background(), &proxypb.ShowCollectionsWithMetadataRequest{})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+}
@@ -0,0 +1,267 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	describeCollectionMethod = "DescribeCollection"
+	describeIndexMethod      = "DescribeIndex"
+)
+
+// describeKey is the shape of a describe request, the requests of the same shape share the cached result.
+type describeKey struct {
+	method         string
+	dbName         string
+	collectionName string
+	collectionID   int64
+	indexName      string
+}
+
+func (k describeKey) String() string {
+	return fmt.Sprintf("%s-%s-%s-%d-%s", k.method, k.dbName, k.collectionName, k.collectionID, k.indexName)
+}
+
+type describeEntry struct {
+	value proto.Message
+	// collectionID is the collection the result belongs to, resolved from the response if requested by name
+	collectionID int64
+	// version is the invalidation version when the result was fetched
+	version    uint64
+	fetchTime  time.Time
+	refreshing bool
+}
+
+type describeFetchFunc func(ctx context.Context) (proto.Message, int64, error)
+
+// describeCache caches the successful results of DescribeCollection and DescribeIndex by the request shape.
+// The DDLs of a collection bump its version, the results fetched before the version are never served again.
+// The expired results within the stale-while-revalidate window are served while refreshed in background,
+// and the concurrent fetches of the same shape are merged into one.
+type describeCache struct {
+	mu      sync.Mutex
+	entries map[describeKey]*describeEntry
+	version uint64
+	// nameVersions and idVersions are the versions of the collections when they were invalidated last time
+	nameVersions map[string]uint64 // dbName/collectionName -> version
+	idVersions   map[int64]uint64  // collectionID -> version
+
+	sf conc.Singleflight[proto.Message]
+}
+
+func newDescribeCache() *describeCache {
+	return &describeCache{
+		entries:      make(map[describeKey]*describeEntry),
+		nameVersions: make(map[string]uint64),
+		idVersions:   make(map[int64]uint64),
+	}
+}
+
+func describeNameKey(dbName, collectionName string) string {
+	return dbName + "/" + collectionName
+}
+
+// DescribeCollection describes the collection through the cache, the requests at a specified timestamp bypass it.
+func (c *describeCache) DescribeCollection(ctx context.Context, rootCoord types.RootCoordClient, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	if c == nil || req.GetTimeStamp() != 0 {
+		return rootCoord.DescribeCollection(ctx, req)
+	}
+	key := describeKey{
+		method:         describeCollectionMethod,
+		dbName:         req.GetDbName(),
+		collectionName: req.GetCollectionName(),
+		collectionID:   req.GetCollectionID(),
+	}
+	value, err := c.get(ctx, key, func(ctx context.Context) (proto.Message, int64, error) {
+		resp, err := rootCoord.DescribeCollection(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp, resp.GetCollectionID(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*milvuspb.DescribeCollectionResponse), nil
+}
+
+// DescribeIndex describes the indexes of the collection through the cache, the requests at a specified timestamp bypass it.
+func (c *describeCache) DescribeIndex(ctx context.Context, dataCoord types.DataCoordClient, req *indexpb.DescribeIndexRequest, dbName, collectionName string) (*indexpb.DescribeIndexResponse, error) {
+	if c == nil || req.GetTimestamp() != 0 {
+		return dataCoord.DescribeIndex(ctx, req)
+	}
+	key := describeKey{
+		method:         describeIndexMethod,
+		dbName:         dbName,
+		collectionName: collectionName,
+		collectionID:   req.GetCollectionID(),
+		indexName:      req.GetIndexName(),
+	}
+	value, err := c.get(ctx, key, func(ctx context.Context) (proto.Message, int64, error) {
+		resp, err := dataCoord.DescribeIndex(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp, req.GetCollectionID(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*indexpb.DescribeIndexResponse), nil
+}
+
+// valid returns whether the entry is fetched after the last invalidation of its collection, must be called with c.mu held.
+func (c *describeCache) valid(key describeKey, entry *describeEntry) bool {
+	return entry.version >= c.nameVersions[describeNameKey(key.dbName, key.collectionName)] &&
+		entry.version >= c.idVersions[entry.collectionID]
+}
+
+func (c *describeCache) get(ctx context.Context, key describeKey, fetch describeFetchFunc) (proto.Message, error) {
+	if !paramtable.Get().ProxyCfg.DescribeCacheEnabled.GetAsBool() {
+		value, _, err := fetch(ctx)
+		return value, err
+	}
+	ttl := paramtable.Get().ProxyCfg.DescribeCacheTTL.GetAsDuration(time.Second)
+	staleWhileRevalidate := paramtable.Get().ProxyCfg.DescribeCacheStaleWhileRevalidate.GetAsDuration(time.Second)
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok && c.valid(key, entry) {
+		age := time.Since(entry.fetchTime)
+		if age <= ttl+staleWhileRevalidate {
+			if age > ttl && !entry.refreshing {
+				entry.refreshing = true
+				go c.refresh(key, entry, fetch)
+			}
+			value := entry.value
+			c.mu.Unlock()
+			metrics.ProxyCacheStatsCounter.WithLabelValues(nodeID, key.method, metrics.CacheHitLabel).Inc()
+			return proto.Clone(value), nil
+		}
+	}
+	c.mu.Unlock()
+
+	metrics.ProxyCacheStatsCounter.WithLabelValues(nodeID, key.method, metrics.CacheMissLabel).Inc()
+	value, err := c.load(ctx, key, fetch)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(value), nil
+}
+
+// load fetches the result and caches it if succeeded and no invalidation happened meanwhile.
+func (c *describeCache) load(ctx context.Context, key describeKey, fetch describeFetchFunc) (proto.Message, error) {
+	value, err, _ := c.sf.Do(key.String(), func() (proto.Message, error) {
+		c.mu.Lock()
+		version := c.version
+		c.mu.Unlock()
+
+		value, collectionID, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if status, ok := value.(interface{ GetStatus() *commonpb.Status }); ok && merr.Error(status.GetStatus()) != nil {
+			return value, nil
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		entry := &describeEntry{
+			value:        value,
+			collectionID: collectionID,
+			version:      version,
+			fetchTime:    time.Now(),
+		}
+		if c.valid(key, entry) {
+			c.entries[key] = entry
+		}
+		return value, nil
+	})
+	return value, err
+}
+
+// refresh reloads the expired entry in background.
+func (c *describeCache) refresh(key describeKey, entry *describeEntry, fetch describeFetchFunc) {
+	_, err := c.load(context.Background(), key, fetch)
+	if err != nil {
+		log.Warn("failed to refresh the describe cache", zap.String("key", key.String()), zap.Error(err))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.refreshing = false
+}
+
+// invalidate drops the cached results of the collection by name or id, and bumps its version,
+// so that the results being fetched are not cached either.
+func (c *describeCache) invalidate(dbName, collectionName string, collectionID int64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	if collectionName != "" {
+		c.nameVersions[describeNameKey(dbName, collectionName)] = c.version
+	}
+	if collectionID != 0 {
+		c.idVersions[collectionID] = c.version
+	}
+	for key, entry := range c.entries {
+		if (collectionName != "" && key.dbName == dbName && key.collectionName == collectionName) ||
+			(collectionID != 0 && entry.collectionID == collectionID) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// invalidateDatabase drops the cached results of all the collections in the database.
+func (c *describeCache) invalidateDatabase(dbName string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	for key, entry := range c.entries {
+		if key.dbName == dbName {
+			c.nameVersions[describeNameKey(key.dbName, key.collectionName)] = c.version
+			c.idVersions[entry.collectionID] = c.version
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestDescribeCache_DescribeCollection(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	enabledKey := paramtable.Get().ProxyCfg.DescribeCacheEnabled.Key
+	paramtable.Get().Save(enabledKey, "true")
+	defer paramtable.Get().Reset(enabledKey)
+
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	cache := newDescribeCache()
+	req := &milvuspb.DescribeCollectionRequest{DbName: "db", CollectionName: "coll"}
+
+	rootCoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status:       merr.Success(),
+		CollectionID: 100,
+	}, nil).Once()
+	for i := 0; i < 3; i++ {
+		resp, err := cache.DescribeCollection(ctx, rootCoord, req)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 100, resp.GetCollectionID())
+	}
+
+	// the requests at a specified timestamp bypass the cache
+	rootCoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status:       merr.Success(),
+		CollectionID: 99,
+	}, nil).Once()
+	resp, err := cache.DescribeCollection(ctx, rootCoord, &milvuspb.DescribeCollectionRequest{DbName: "db", CollectionName: "coll", TimeStamp: 1})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 99, resp.GetCollectionID())
+
+	// invalidated by the collection id resolved from the response
+	cache.invalidate("db", "", 100)
+	rootCoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status:       merr.Success(),
+		CollectionID: 101,
+	}, nil).Once()
+	resp, err = cache.DescribeCollection(ctx, rootCoord, req)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 101, resp.GetCollectionID())
+
+	// the failed responses are not cached
+	cache.invalidateDatabase("db")
+	rootCoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status: merr.Status(merr.WrapErrCollectionNotFound("coll")),
+	}, nil).Twice()
+	for i := 0; i < 2; i++ {
+		resp, err = cache.DescribeCollection(ctx, rootCoord, req)
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	}
+
+	// disabled
+	paramtable.Get().Save(enabledKey, "false")
+	rootCoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status:       merr.Success(),
+		CollectionID: 100,
+	}, nil).Twice()
+	for i := 0; i < 2; i++ {
+		_, err = cache.DescribeCollection(ctx, rootCoord, req)
+		assert.NoError(t, err)
+	}
+
+	var nilCache *describeCache
+	rootCoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status:       merr.Success(),
+		CollectionID: 100,
+	}, nil).Once()
+	_, err = nilCache.DescribeCollection(ctx, rootCoord, req)
+	assert.NoError(t, err)
+	nilCache.invalidate("db", "coll", 100)
+}
+
+func TestDescribeCache_DescribeIndex(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	enabledKey := paramtable.Get().ProxyCfg.DescribeCacheEnabled.Key
+	paramtable.Get().Save(enabledKey, "true")
+	defer paramtable.Get().Reset(enabledKey)
+	staleKey := paramtable.Get().ProxyCfg.DescribeCacheStaleWhileRevalidate.Key
+	paramtable.Get().Save(staleKey, "60")
+	defer paramtable.Get().Reset(staleKey)
+
+	dataCoord := mocks.NewMockDataCoordClient(t)
+	cache := newDescribeCache()
+	req := &indexpb.DescribeIndexRequest{CollectionID: 100, IndexName: "idx"}
+	key := describeKey{method: describeIndexMethod, dbName: "db", collectionName: "coll", collectionID: 100, indexName: "idx"}
+
+	dataCoord.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(&indexpb.DescribeIndexResponse{
+		Status:     merr.Success(),
+		IndexInfos: []*indexpb.IndexInfo{{IndexName: "idx", IndexedRows: 10}},
+	}, nil).Once()
+	resp, err := cache.DescribeIndex(ctx, dataCoord, req, "db", "coll")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 10, resp.GetIndexInfos()[0].GetIndexedRows())
+	// the cached result is not modified by the callers
+	resp.IndexInfos[0].IndexedRows = 0
+
+	// the expired result is served while refreshed in background
+	cache.mu.Lock()
+	cache.entries[key].fetchTime = time.Now().Add(-20 * time.Second)
+	cache.mu.Unlock()
+	refreshed := make(chan struct{})
+	dataCoord.EXPECT().DescribeIndex(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, in *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error) {
+			<-refreshed
+			return &indexpb.DescribeIndexResponse{
+				Status:     merr.Success(),
+				IndexInfos: []*indexpb.IndexInfo{{IndexName: "idx", IndexedRows: 20}},
+			}, nil
+		}).Once()
+	for i := 0; i < 2; i++ {
+		resp, err = cache.DescribeIndex(ctx, dataCoord, req, "db", "coll")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 10, resp.GetIndexInfos()[0].GetIndexedRows())
+	}
+	close(refreshed)
+	assert.Eventually(t, func() bool {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+		return !cache.entries[key].refreshing
+	}, 5*time.Second, 10*time.Millisecond)
+	resp, err = cache.DescribeIndex(ctx, dataCoord, req, "db", "coll")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 20, resp.GetIndexInfos()[0].GetIndexedRows())
+
+	// expired beyond the stale-while-revalidate window
+	cache.mu.Lock()
+	cache.entries[key].fetchTime = time.Now().Add(-time.Hour)
+	cache.mu.Unlock()
+	dataCoord.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(&indexpb.DescribeIndexResponse{
+		Status:     merr.Success(),
+		IndexInfos: []*indexpb.IndexInfo{{IndexName: "idx", IndexedRows: 30}},
+	}, nil).Once()
+	resp, err = cache.DescribeIndex(ctx, dataCoord, req, "db", "coll")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 30, resp.GetIndexInfos()[0].GetIndexedRows())
+
+	// invalidated by name
+	cache.invalidate("db", "coll", 0)
+	assert.Empty(t, cache.entries)
+}
+
+func TestDescribeCache_InvalidateWhileFetching(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	enabledKey := paramtable.Get().ProxyCfg.DescribeCacheEnabled.Key
+	paramtable.Get().Save(enabledKey, "true")
+	defer paramtable.Get().Reset(enabledKey)
+
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	cache := newDescribeCache()
+	req := &milvuspb.DescribeCollectionRequest{DbName: "db", CollectionName: "coll"}
+
+	rootCoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
+			// the DDL is broadcast before the response arrives
+			cache.invalidate("db", "coll", 0)
+			return &milvuspb.DescribeCollectionResponse{Status: merr.Success(), CollectionID: 100}, nil
+		}).Once()
+	_, err := cache.DescribeCollection(ctx, rootCoord, req)
+	assert.NoError(t, err)
+	assert.Empty(t, cache.entries)
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -155,9 +156,19 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 		}
 	}
 
+	if msgType == commonpb.MsgType_DropDatabase {
+		node.describeCache.invalidateDatabase(request.GetDbName())
+	} else {
+		node.describeCache.invalidate(request.GetDbName(), collectionName, collectionID)
+	}
+
 	if msgType == commonpb.MsgType_DropCollection {
 		// no need to handle error, since this Proxy may not create dml stream for the collection.
 		node.chMgr.removeDMLStream(request.GetCollectionID())
+		if node.insertShaper != nil {
+			node.insertShaper.removeCollection(request.GetCollectionID())
+		}
+		globalSLOTracker.removeCollection(request.GetCollectionID())
 		// clean up collection level metrics
 		metrics.CleanupProxyCollectionMetrics(paramtable.GetNodeID(), collectionName)
 		for _, alias := range aliasName {
@@ -944,6 +955,7 @@ func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.Des
 		Condition:                 NewTaskCondition(ctx),
 		DescribeCollectionRequest: request,
 		rootCoord:                 node.rootCoord,
+		describeCache:             node.describeCache,
 	}
 
 	log := log.Ctx(ctx).With(
@@ -2028,6 +2040,9 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 		return merr.Status(err), nil
 	}
 
+	// the other proxies refresh the cached index descriptions after the ttl
+	node.describeCache.invalidate(request.GetDbName(), request.GetCollectionName(), cit.collectionID)
+
 	log.Info(
 		rpcDone(method),
 		zap.Uint64("BeginTs", cit.BeginTs()),
@@ -2099,6 +2114,9 @@ func (node *Proxy) AlterIndex(ctx context.Context, request *milvuspb.AlterIndexR
 		return merr.Status(err), nil
 	}
 
+	// the other proxies refresh the cached index descriptions after the ttl
+	node.describeCache.invalidate(request.GetDbName(), request.GetCollectionName(), task.collectionID)
+
 	log.Info(
 		rpcDone(method),
 		zap.Uint64("BeginTs", task.BeginTs()),
@@ -2126,6 +2144,7 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 		Condition:            NewTaskCondition(ctx),
 		DescribeIndexRequest: request,
 		datacoord:            node.dataCoord,
+		describeCache:        node.describeCache,
 	}
 
 	method := "DescribeIndex"
@@ -2317,6 +2336,9 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 		return merr.Status(err), nil
 	}
 
+	// the other proxies refresh the cached index descriptions after the ttl
+	node.describeCache.invalidate(request.GetDbName(), request.GetCollectionName(), dit.collectionID)
+
 	log.Info(
 		rpcDone(method),
 		zap.Uint64("BeginTs", dit.BeginTs()),
@@ -2492,6 +2514,11 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 			Status: merr.Status(err),
 		}, nil
 	}
+	if err := checkRegionWritable(); err != nil {
+		return &milvuspb.MutationResult{
+			Status: merr.Status(err),
+		}, nil
+	}
 	log := log.Ctx(ctx).With(
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -2532,6 +2559,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		segIDAssigner: node.segAssigner,
 		chMgr:         node.chMgr,
 		chTicker:      node.chTicker,
+		shaper:        node.insertShaper,
 	}
 
 	constructFailedResponse := func(err error) *milvuspb.MutationResult {
@@ -2547,6 +2575,13 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		}
 	}
 
+	if err := it.shape(ctx); err != nil {
+		log.Warn("Failed to shape insert request: " + err.Error())
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.AbandonLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return constructFailedResponse(merr.WrapErrAsInputErrorWhen(err, merr.ErrCollectionNotFound, merr.ErrDatabaseNotFound)), nil
+	}
+
 	log.Debug("Enqueue insert request in Proxy")
 
 	if err := node.sched.dmQueue.Enqueue(it); err != nil {
@@ -2639,6 +2674,11 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 			Status: merr.Status(err),
 		}, nil
 	}
+	if err := checkRegionWritable(); err != nil {
+		return &milvuspb.MutationResult{
+			Status: merr.Status(err),
+		}, nil
+	}
 
 	method := "Delete"
 	tr := timerecord.NewTimeRecorder(method)
@@ -2736,6 +2776,11 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 			Status: merr.Status(err),
 		}, nil
 	}
+	if err := checkRegionWritable(); err != nil {
+		return &milvuspb.MutationResult{
+			Status: merr.Status(err),
+		}, nil
+	}
 	method := "Upsert"
 	tr := timerecord.NewTimeRecorder(method)
 
@@ -2916,6 +2961,15 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest)
 		}, nil
 	}
 
+	hints, err := parseRequestHints(request.GetBase(), request.GetSearchParams())
+	if err != nil {
+		return &milvuspb.SearchResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+	ctx, cancel := hints.withDeadline(ctx)
+	defer cancel()
+
 	method := "Search"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(
@@ -2957,6 +3011,7 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest)
 		lb:                     node.lbPolicy,
 		enableMaterializedView: node.enableMaterializedView,
 		mustUsePartitionKey:    Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
+		hints:                  hints,
 	}
 
 	log := log.Ctx(ctx).With( // TODO: it might cause some cpu consumption
@@ -3088,6 +3143,7 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest)
 		metrics.ProxyReadReqSendBytes.WithLabelValues(nodeID).Add(float64(sentSize))
 		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize), subLabel)
 	}
+	hints.annotatePartialResult(qt.result.GetStatus(), qt.partialChannels)
 	return qt.result, nil
 }
 
@@ -3130,6 +3186,16 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 		}, nil
 	}
 
+	// the hints are attached to the msg base or the rank params of the hybrid search
+	hints, err := parseRequestHints(request.GetBase(), request.GetRankParams())
+	if err != nil {
+		return &milvuspb.SearchResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+	ctx, cancel := hints.withDeadline(ctx)
+	defer cancel()
+
 	method := "HybridSearch"
 	tr := timerecord.NewTimeRecorder(method)
 	metrics.ProxyFunctionCall.WithLabelValues(
@@ -3159,6 +3225,7 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 		node:                node,
 		lb:                  node.lbPolicy,
 		mustUsePartitionKey: Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
+		hints:               hints,
 	}
 
 	log := log.Ctx(ctx).With(
@@ -3285,6 +3352,7 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 		metrics.ProxyReadReqSendBytes.WithLabelValues(nodeID).Add(float64(sentSize))
 		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize), subLabel)
 	}
+	hints.annotatePartialResult(qt.result.GetStatus(), qt.partialChannels)
 	return qt.result, nil
 }
 
@@ -3539,6 +3607,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		qc:                  node.queryCoord,
 		lb:                  node.lbPolicy,
 		mustUsePartitionKey: Params.ProxyCfg.MustUsePartitionKey.GetAsBool(),
+		cursorStore:         node.queryCursorStore,
 	}
 
 	subLabel := GetCollectionRateSubLabel(request)
@@ -3562,6 +3631,18 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		}, nil
 	}
 
+	hints, err := parseRequestHints(request.GetBase(), request.GetQueryParams())
+	if err != nil {
+		return &milvuspb.QueryResults{
+			Status: merr.Status(err),
+		}, nil
+	}
+	ctx, cancel := hints.withDeadline(ctx)
+	defer cancel()
+	qt.ctx = ctx
+	qt.Condition = NewTaskCondition(ctx)
+	qt.hints = hints
+
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Query")
 	defer sp.End()
 	method := "Query"
@@ -3605,6 +3686,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	})
 	SetReportValue(res.Status, v)
 	metrics.ProxyReportValue.WithLabelValues(nodeID, hookutil.OpTypeQuery, request.DbName, username).Add(float64(v))
+	hints.annotatePartialResult(res.GetStatus(), qt.partialChannels)
 	return res, nil
 }
 
@@ -4600,7 +4682,10 @@ func (node *Proxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStat
 	}
 	log.Debug("received get flush state response",
 		zap.Any("response", resp))
-	return resp, err
+	return &milvuspb.GetFlushStateResponse{
+		Status:  resp.GetStatus(),
+		Flushed: resp.GetFlushed(),
+	}, nil
 }
 
 // GetFlushAllState checks if all DML messages before `FlushAllTs` have been flushed.
@@ -6024,6 +6109,266 @@ func (node *Proxy) ListClientInfos(ctx context.Context, req *proxypb.ListClientI
 	}, nil
 }
 
+// createCollectionRollbackTimeout is the timeout of dropping the collection failed to set up.
+const createCollectionRollbackTimeout = 30 * time.Second
+
+// CreateCollectionWithConfig creates the collection, its indexes, and triggers loading the collection if required.
+// The collection is dropped if any of the steps fails, so that a failed request leaves no partial setup behind.
+func (node *Proxy) CreateCollectionWithConfig(ctx context.Context, req *proxypb.CreateCollectionWithConfigRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CreateCollectionWithConfig")
+	defer sp.End()
+
+	createReq := req.GetCreateCollectionRequest()
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", createReq.GetDbName()),
+		zap.String("collection", createReq.GetCollectionName()),
+		zap.Int("indexNum", len(req.GetIndexRequests())),
+		zap.Bool("load", req.GetLoadRequest() != nil),
+	)
+	log.Info("CreateCollectionWithConfig received")
+
+	if err := fillCollectionWithConfigRequest(req); err != nil {
+		log.Warn("invalid CreateCollectionWithConfig request", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := merr.CheckRPCCall(node.CreateCollection(ctx, createReq)); err != nil {
+		log.Warn("failed to create collection", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := node.setupCollection(ctx, req); err != nil {
+		log.Warn("failed to set up collection, roll back", zap.Error(err))
+		// roll back even if the request is canceled
+		rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), createCollectionRollbackTimeout)
+		defer cancel()
+		dropErr := merr.CheckRPCCall(node.DropCollection(rollbackCtx, &milvuspb.DropCollectionRequest{
+			DbName:         createReq.GetDbName(),
+			CollectionName: createReq.GetCollectionName(),
+		}))
+		if dropErr != nil {
+			log.Warn("failed to roll back the created collection", zap.Error(dropErr))
+			return merr.Status(fmt.Errorf("%w, and failed to roll back the created collection: %s", err, dropErr.Error())), nil
+		}
+		return merr.Status(err), nil
+	}
+
+	log.Info("CreateCollectionWithConfig done")
+	return merr.Success(), nil
+}
+
+// fillCollectionWithConfigRequest fills the db and collection names of the index and load requests
+// by the ones of the collection, the requests of another collection are rejected.
+func fillCollectionWithConfigRequest(req *proxypb.CreateCollectionWithConfigRequest) error {
+	createReq := req.GetCreateCollectionRequest()
+	if createReq == nil {
+		return merr.WrapErrParameterMissing("create_collection_request")
+	}
+	dbName, collectionName := createReq.GetDbName(), createReq.GetCollectionName()
+	check := func(db, collection string) error {
+		if (db != "" && db != dbName) || (collection != "" && collection != collectionName) {
+			return merr.WrapErrParameterInvalidMsg("request of collection %s.%s mismatches the created collection %s.%s",
+				db, collection, dbName, collectionName)
+		}
+		return nil
+	}
+	for _, indexReq := range req.GetIndexRequests() {
+		if err := check(indexReq.GetDbName(), indexReq.GetCollectionName()); err != nil {
+			return err
+		}
+		indexReq.DbName, indexReq.CollectionName = dbName, collectionName
+	}
+	if loadReq := req.GetLoadRequest(); loadReq != nil {
+		if err := check(loadReq.GetDbName(), loadReq.GetCollectionName()); err != nil {
+			return err
+		}
+		loadReq.DbName, loadReq.CollectionName = dbName, collectionName
+	}
+	return nil
+}
+
+// setupCollection creates the indexes of the created collection and triggers loading it.
+func (node *Proxy) setupCollection(ctx context.Context, req *proxypb.CreateCollectionWithConfigRequest) error {
+	for _, indexReq := range req.GetIndexRequests() {
+		if err := merr.CheckRPCCall(node.CreateIndex(ctx, indexReq)); err != nil {
+			return fmt.Errorf("failed to create index on field %s: %w", indexReq.GetFieldName(), err)
+		}
+	}
+	if loadReq := req.GetLoadRequest(); loadReq != nil {
+		if err := merr.CheckRPCCall(node.LoadCollection(ctx, loadReq)); err != nil {
+			return fmt.Errorf("failed to load collection: %w", err)
+		}
+	}
+	return nil
+}
+
+// CloneCollection creates the target collection with the schema, partitions and indexes of the source collection,
+// and clones the flushed segments of the source, which share the insert binlogs and index files with the source
+// until compaction rewrites them. The growing data of the source is not cloned, flush the source first to include it.
+// The target collection is dropped if cloning fails.
+func (node *Proxy) CloneCollection(ctx context.Context, req *proxypb.CloneCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CloneCollection")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.String("targetCollection", req.GetTargetCollectionName()),
+	)
+	log.Info("CloneCollection received")
+
+	if req.GetTargetCollectionName() == "" || req.GetTargetCollectionName() == req.GetCollectionName() {
+		err := merr.WrapErrParameterInvalidMsg("the target collection name should be set and differ from the source collection")
+		log.Warn("invalid CloneCollection request", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	describeResp, err := node.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+	})
+	if err := merr.CheckRPCCall(describeResp, err); err != nil {
+		log.Warn("failed to describe the source collection", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	schema := proto.Clone(describeResp.GetSchema()).(*schemapb.CollectionSchema)
+	schema.Name = req.GetTargetCollectionName()
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return merr.Status(err), nil
+	}
+	if err := merr.CheckRPCCall(node.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		DbName:           req.GetDbName(),
+		CollectionName:   req.GetTargetCollectionName(),
+		Schema:           schemaBytes,
+		ShardsNum:        describeResp.GetShardsNum(),
+		ConsistencyLevel: describeResp.GetConsistencyLevel(),
+		Properties:       describeResp.GetProperties(),
+		NumPartitions:    describeResp.GetNumPartitions(),
+	})); err != nil {
+		log.Warn("failed to create the target collection", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := node.cloneCollection(ctx, req, describeResp); err != nil {
+		log.Warn("failed to clone collection, roll back", zap.Error(err))
+		// roll back even if the request is canceled
+		rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), createCollectionRollbackTimeout)
+		defer cancel()
+		dropErr := merr.CheckRPCCall(node.DropCollection(rollbackCtx, &milvuspb.DropCollectionRequest{
+			DbName:         req.GetDbName(),
+			CollectionName: req.GetTargetCollectionName(),
+		}))
+		if dropErr != nil {
+			log.Warn("failed to roll back the target collection", zap.Error(dropErr))
+			return merr.Status(fmt.Errorf("%w, and failed to roll back the target collection: %s", err, dropErr.Error())), nil
+		}
+		return merr.Status(err), nil
+	}
+
+	log.Info("CloneCollection done")
+	return merr.Success(), nil
+}
+
+// cloneCollection creates the partitions and indexes of the source collection on the created target collection,
+// then clones the segments of the source partitions into the target partitions of the same names.
+func (node *Proxy) cloneCollection(ctx context.Context, req *proxypb.CloneCollectionRequest, source *milvuspb.DescribeCollectionResponse) error {
+	showPartitions := func(collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
+		resp, err := node.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+			DbName:         req.GetDbName(),
+			CollectionName: collectionName,
+		})
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			return nil, fmt.Errorf("failed to show partitions of collection %s: %w", collectionName, err)
+		}
+		return resp, nil
+	}
+	sourcePartitions, err := showPartitions(req.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	targetPartitions, err := showPartitions(req.GetTargetCollectionName())
+	if err != nil {
+		return err
+	}
+	// the partitions of the partition key collections are created along with the collection
+	for _, name := range sourcePartitions.GetPartitionNames() {
+		if lo.Contains(targetPartitions.GetPartitionNames(), name) {
+			continue
+		}
+		if err := merr.CheckRPCCall(node.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			DbName:         req.GetDbName(),
+			CollectionName: req.GetTargetCollectionName(),
+			PartitionName:  name,
+		})); err != nil {
+			return fmt.Errorf("failed to create partition %s: %w", name, err)
+		}
+	}
+	targetPartitions, err = showPartitions(req.GetTargetCollectionName())
+	if err != nil {
+		return err
+	}
+	targetPartitionIDs := make(map[string]int64, len(targetPartitions.GetPartitionNames()))
+	for i, name := range targetPartitions.GetPartitionNames() {
+		targetPartitionIDs[name] = targetPartitions.GetPartitionIDs()[i]
+	}
+	partitionMapping := make(map[int64]int64, len(sourcePartitions.GetPartitionIDs()))
+	for i, name := range sourcePartitions.GetPartitionNames() {
+		partitionMapping[sourcePartitions.GetPartitionIDs()[i]] = targetPartitionIDs[name]
+	}
+
+	indexResp, err := node.dataCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
+		CollectionID: source.GetCollectionID(),
+	})
+	if err := merr.CheckRPCCall(indexResp, err); err != nil && !errors.Is(err, merr.ErrIndexNotFound) {
+		return fmt.Errorf("failed to describe indexes: %w", err)
+	}
+	fieldNames := lo.SliceToMap(source.GetSchema().GetFields(), func(field *schemapb.FieldSchema) (int64, string) {
+		return field.GetFieldID(), field.GetName()
+	})
+	for _, index := range indexResp.GetIndexInfos() {
+		params := index.GetUserIndexParams()
+		if len(params) == 0 {
+			params = index.GetIndexParams()
+		}
+		if err := merr.CheckRPCCall(node.CreateIndex(ctx, &milvuspb.CreateIndexRequest{
+			DbName:         req.GetDbName(),
+			CollectionName: req.GetTargetCollectionName(),
+			FieldName:      fieldNames[index.GetFieldID()],
+			IndexName:      index.GetIndexName(),
+			ExtraParams:    params,
+		})); err != nil {
+			return fmt.Errorf("failed to create index %s: %w", index.GetIndexName(), err)
+		}
+	}
+
+	targetCollectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetTargetCollectionName())
+	if err != nil {
+		return err
+	}
+	resp, err := node.dataCoord.CloneSegments(ctx, &datapb.CloneSegmentsRequest{
+		Base:               commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		SourceCollectionID: source.GetCollectionID(),
+		TargetCollectionID: targetCollectionID,
+		PartitionMapping:   partitionMapping,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return fmt.Errorf("failed to clone segments: %w", err)
+	}
+	log.Ctx(ctx).Info("segments cloned", zap.Int("segmentNum", len(resp.GetSegmentIDs())), zap.Int64("numRows", resp.GetNumOfRows()))
+	return nil
+}
+
 func (node *Proxy) AllocTimestamp(ctx context.Context, req *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return &milvuspb.AllocTimestampResponse{Status: merr.Status(err)}, nil
@@ -6057,6 +6402,9 @@ func (node *Proxy) ImportV2(ctx context.Context, req *internalpb.ImportRequest)
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return &internalpb.ImportResponse{Status: merr.Status(err)}, nil
 	}
+	if err := checkRegionWritable(); err != nil {
+		return &internalpb.ImportResponse{Status: merr.Status(err)}, nil
+	}
 	log := log.Ctx(ctx).With(
 		zap.String("collectionName", req.GetCollectionName()),
 		zap.String("partition name", req.GetPartitionName()),
@@ -6104,6 +6452,7 @@ func (node *Proxy) ImportV2(ctx context.Context, req *internalpb.ImportRequest)
 	hasPartitionKey := typeutil.HasPartitionKey(schema.CollectionSchema)
 
 	var partitionIDs []int64
+	var partitionNames []string
 	if isBackup {
 		if req.GetPartitionName() == "" {
 			resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("partition not specified"))
@@ -6116,6 +6465,7 @@ func (node *Proxy) ImportV2(ctx context.Context, req *internalpb.ImportRequest)
 			return resp, nil
 		}
 		partitionIDs = []UniqueID{partitionID}
+		partitionNames = []string{req.GetPartitionName()}
 	} else if isL0Import {
 		if req.GetPartitionName() == "" {
 			partitionIDs = []UniqueID{common.AllPartitionsID}
@@ -6152,7 +6502,7 @@ func (node *Proxy) ImportV2(ctx context.Context, req *internalpb.ImportRequest)
 				resp.Status = merr.Status(err)
 				return resp, nil
 			}
-			_, partitionIDs, err = typeutil.RearrangePartitionsForPartitionKey(partitions)
+			partitionNames, partitionIDs, err = typeutil.RearrangePartitionsForPartitionKey(partitions)
 			if err != nil {
 				resp.Status = merr.Status(err)
 				return resp, nil
@@ -6192,6 +6542,14 @@ func (node *Proxy) ImportV2(ctx context.Context, req *internalpb.ImportRequest)
 			}
 		}
 	}
+	if hasPartitionKey && !isL0Import {
+		// the partition keys of the imported data are unknown, the partitions must not be pruned
+		if err = markAllPartitionKeyBuckets(ctx, req.GetDbName(), req.GetCollectionName(), partitionNames); err != nil {
+			log.Warn("failed to update partition key stats for import", zap.Error(err))
+			resp.Status = merr.Status(err)
+			return resp, nil
+		}
+	}
 	importRequest := &internalpb.ImportRequestInternal{
 		CollectionID:   collectionID,
 		CollectionName: req.GetCollectionName(),
@@ -47,6 +47,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	mqcommon "github.com/milvus-io/milvus/pkg/mq/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -650,7 +651,7 @@ func TestProxy_GetFlushState(t *testing.T) {
 	// set expectations
 	successStatus := &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
 	node.dataCoord.(*mocks.MockDataCoordClient).EXPECT().GetFlushState(mock.Anything, mock.Anything, mock.Anything).
-		Return(&milvuspb.GetFlushStateResponse{Status: successStatus}, nil).Maybe()
+		Return(&datapb.GetFlushStateResponse{Status: successStatus}, nil).Maybe()
 
 	t.Run("GetFlushState success", func(t *testing.T) {
 		resp, err := node.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{})
@@ -693,7 +694,7 @@ func TestProxy_GetFlushState(t *testing.T) {
 	t.Run("DataCoord GetFlushState failed", func(t *testing.T) {
 		node.dataCoord.(*mocks.MockDataCoordClient).ExpectedCalls = nil
 		node.dataCoord.(*mocks.MockDataCoordClient).EXPECT().GetFlushState(mock.Anything, mock.Anything, mock.Anything).
-			Return(&milvuspb.GetFlushStateResponse{
+			Return(&datapb.GetFlushStateResponse{
 				Status: &commonpb.Status{
 					ErrorCode: commonpb.ErrorCode_UnexpectedError,
 					Reason:    "mock err",
@@ -916,6 +917,82 @@ func TestProxy_ListClientInfos(t *testing.T) {
 	})
 }
 
+func TestProxy_CreateCollectionWithConfig(t *testing.T) {
+	t.Run("proxy unhealthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+
+		resp, err := node.CreateCollectionWithConfig(context.TODO(), &proxypb.CreateCollectionWithConfigRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	t.Run("missing create collection request", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+		resp, err := node.CreateCollectionWithConfig(context.TODO(), &proxypb.CreateCollectionWithConfigRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterMissing)
+	})
+
+	t.Run("fill requests", func(t *testing.T) {
+		req := &proxypb.CreateCollectionWithConfigRequest{
+			CreateCollectionRequest: &milvuspb.CreateCollectionRequest{DbName: "db", CollectionName: "coll"},
+			IndexRequests: []*milvuspb.CreateIndexRequest{
+				{FieldName: "vec"},
+				{DbName: "db", CollectionName: "coll", FieldName: "pk"},
+			},
+			LoadRequest: &milvuspb.LoadCollectionRequest{ReplicaNumber: 2},
+		}
+		assert.NoError(t, fillCollectionWithConfigRequest(req))
+		for _, indexReq := range req.GetIndexRequests() {
+			assert.Equal(t, "db", indexReq.GetDbName())
+			assert.Equal(t, "coll", indexReq.GetCollectionName())
+		}
+		assert.Equal(t, "db", req.GetLoadRequest().GetDbName())
+		assert.Equal(t, "coll", req.GetLoadRequest().GetCollectionName())
+	})
+
+	t.Run("mismatched collection", func(t *testing.T) {
+		req := &proxypb.CreateCollectionWithConfigRequest{
+			CreateCollectionRequest: &milvuspb.CreateCollectionRequest{DbName: "db", CollectionName: "coll"},
+			IndexRequests:           []*milvuspb.CreateIndexRequest{{CollectionName: "other", FieldName: "vec"}},
+		}
+		assert.ErrorIs(t, fillCollectionWithConfigRequest(req), merr.ErrParameterInvalid)
+
+		req = &proxypb.CreateCollectionWithConfigRequest{
+			CreateCollectionRequest: &milvuspb.CreateCollectionRequest{DbName: "db", CollectionName: "coll"},
+			LoadRequest:             &milvuspb.LoadCollectionRequest{DbName: "other"},
+		}
+		assert.ErrorIs(t, fillCollectionWithConfigRequest(req), merr.ErrParameterInvalid)
+	})
+}
+
+func TestProxy_CloneCollection(t *testing.T) {
+	t.Run("proxy unhealthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+
+		resp, err := node.CloneCollection(context.TODO(), &proxypb.CloneCollectionRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	t.Run("invalid target collection", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+		resp, err := node.CloneCollection(context.TODO(), &proxypb.CloneCollectionRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+
+		resp, err = node.CloneCollection(context.TODO(), &proxypb.CloneCollectionRequest{CollectionName: "coll", TargetCollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+}
+
 func TestProxyCreateDatabase(t *testing.T) {
 	paramtable.Init()
 
@@ -1742,6 +1819,32 @@ func TestProxy_ImportV2(t *testing.T) {
 	})
 }
 
+func TestProxy_RegionReplicaReadOnly(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	paramtable.Get().Save(paramtable.Get().CommonCfg.RegionReplicaRole.Key, util.RegionReplicaRoleSecondary)
+	defer paramtable.Get().Reset(paramtable.Get().CommonCfg.RegionReplicaRole.Key)
+
+	node := &Proxy{}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	insertResp, err := node.Insert(ctx, &milvuspb.InsertRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(insertResp.GetStatus()), merr.ErrServiceUnavailable)
+
+	deleteResp, err := node.Delete(ctx, &milvuspb.DeleteRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(deleteResp.GetStatus()), merr.ErrServiceUnavailable)
+
+	upsertResp, err := node.Upsert(ctx, &milvuspb.UpsertRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(upsertResp.GetStatus()), merr.ErrServiceUnavailable)
+
+	importResp, err := node.ImportV2(ctx, &internalpb.ImportRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(importResp.GetStatus()), merr.ErrServiceUnavailable)
+}
+
 func TestGetCollectionRateSubLabel(t *testing.T) {
 	d := "db1"
 	collectionName := "test1"
@@ -0,0 +1,172 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// shapingBucket is the token bucket of a vchannel, refilled at the shaping rate in bytes per second,
+// and holding the tokens of one second at most.
+// The tokens may be negative, which means the inserts taking them are queued until they are refilled.
+type shapingBucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+// reserve takes n tokens from the bucket and returns the delay until they are refilled,
+// the tokens are not taken if the delay exceeds the maxDelay.
+func (b *shapingBucket) reserve(now time.Time, n int, rate float64, maxDelay time.Duration) (time.Duration, bool) {
+	if b.last.IsZero() {
+		b.tokens = rate
+	} else if now.After(b.last) {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+	}
+	b.rate = rate
+	b.last = now
+	if b.tokens > rate {
+		b.tokens = rate
+	}
+
+	tokens := b.tokens - float64(n)
+	var delay time.Duration
+	if tokens < 0 {
+		delay = time.Duration(-tokens / rate * float64(time.Second))
+	}
+	if delay > maxDelay {
+		return delay, false
+	}
+	b.tokens = tokens
+	return delay, true
+}
+
+func (b *shapingBucket) cancel(n int) {
+	b.tokens += float64(n)
+}
+
+// insertShaper smooths the bursty inserts into the sustainable rate per vchannel,
+// the insert is queued until all the vchannels it goes to have the tokens for it.
+type insertShaper struct {
+	mu      sync.Mutex
+	buckets map[UniqueID]map[string]*shapingBucket
+}
+
+func newInsertShaper() *insertShaper {
+	return &insertShaper{
+		buckets: make(map[UniqueID]map[string]*shapingBucket),
+	}
+}
+
+// getInsertShapingRate returns the insert shaping rate in bytes per second per vchannel of the collection,
+// 0 means the inserts of the collection are not shaped.
+func getInsertShapingRate(info *collectionBasicInfo) float64 {
+	rate := info.insertShapingRate
+	if rate <= 0 {
+		if !Params.ProxyCfg.InsertShapingEnabled.GetAsBool() {
+			return 0
+		}
+		rate = Params.ProxyCfg.InsertShapingRatePerVChannel.GetAsFloat()
+	}
+	return rate * 1024 * 1024
+}
+
+// reserve takes the tokens of the insert from the buckets of its vchannels, sizes are the bytes to each vchannel,
+// and returns the delay until all of them are refilled.
+// If the delay exceeds the maxDelay, none of the tokens are taken and the insert is rejected as rate limited.
+func (s *insertShaper) reserve(collectionID UniqueID, sizes map[string]int, rate float64, maxDelay time.Duration) (time.Duration, func(), error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	buckets, ok := s.buckets[collectionID]
+	if !ok {
+		buckets = make(map[string]*shapingBucket)
+		s.buckets[collectionID] = buckets
+	}
+
+	now := time.Now()
+	reserved := make(map[*shapingBucket]int, len(sizes))
+	cancel := func() {
+		for bucket, size := range reserved {
+			bucket.cancel(size)
+		}
+	}
+	var delay time.Duration
+	for vchannel, size := range sizes {
+		bucket, ok := buckets[vchannel]
+		if !ok {
+			bucket = &shapingBucket{}
+			buckets[vchannel] = bucket
+		}
+		d, ok := bucket.reserve(now, size, rate, maxDelay)
+		if !ok {
+			cancel()
+			return 0, nil, merr.WrapErrServiceRateLimit(rate/1024/1024,
+				fmt.Sprintf("insert to vchannel %s would be queued for %v by insert shaping, exceeds the max queue delay %v", vchannel, d, maxDelay))
+		}
+		reserved[bucket] = size
+		delay = max(delay, d)
+	}
+	return delay, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		cancel()
+	}, nil
+}
+
+// shape queues the insert of the collection until the vchannels have the tokens for it.
+func (s *insertShaper) shape(ctx context.Context, collectionName string, collectionID UniqueID, sizes map[string]int, rate float64) error {
+	maxDelay := Params.ProxyCfg.InsertShapingMaxQueueDelay.GetAsDuration(time.Millisecond)
+	delay, cancel, err := s.reserve(collectionID, sizes, rate, maxDelay)
+	if err != nil {
+		log.Ctx(ctx).Warn("insert rejected by insert shaping", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return err
+	}
+	metrics.ProxyInsertShapingDelay.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), collectionName).Observe(float64(delay.Milliseconds()))
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Ctx(ctx).Debug("insert queued by insert shaping", zap.Int64("collectionID", collectionID), zap.Duration("delay", delay))
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		// the insert is not sent, return the tokens to the following ones
+		cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// removeCollection removes the buckets of the dropped collection.
+func (s *insertShaper) removeCollection(collectionID UniqueID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.buckets, collectionID)
+}
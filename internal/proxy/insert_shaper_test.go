@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestShapingBucket(t *testing.T) {
+	now := time.Now()
+	bucket := &shapingBucket{}
+
+	// the bucket is full initially
+	delay, ok := bucket.reserve(now, 100, 100, time.Second)
+	assert.True(t, ok)
+	assert.Zero(t, delay)
+
+	// queued until the tokens are refilled
+	delay, ok = bucket.reserve(now, 50, 100, time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, 500*time.Millisecond, delay)
+
+	// exceeds the max delay, the tokens are not taken
+	delay, ok = bucket.reserve(now, 100, 100, time.Second)
+	assert.False(t, ok)
+	assert.Equal(t, 1500*time.Millisecond, delay)
+
+	delay, ok = bucket.reserve(now.Add(500*time.Millisecond), 100, 100, time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, delay)
+
+	// the refilled tokens never exceed the tokens of one second
+	delay, ok = bucket.reserve(now.Add(time.Hour), 100, 100, time.Second)
+	assert.True(t, ok)
+	assert.Zero(t, delay)
+	assert.Zero(t, bucket.tokens)
+
+	bucket.cancel(100)
+	assert.Equal(t, 100.0, bucket.tokens)
+}
+
+func TestInsertShaper(t *testing.T) {
+	paramtable.Init()
+
+	size := 1024
+	sizes := map[string]int{"ch-0": size, "ch-1": size}
+
+	t.Run("reserve", func(t *testing.T) {
+		shaper := newInsertShaper()
+		rate := float64(size)
+
+		delay, _, err := shaper.reserve(1, sizes, rate, time.Second)
+		assert.NoError(t, err)
+		assert.Zero(t, delay)
+
+		// ch-1 is queued for one second more than ch-0
+		delay, _, err = shaper.reserve(1, map[string]int{"ch-1": size}, rate, time.Second)
+		assert.NoError(t, err)
+		assert.InDelta(t, time.Second, delay, float64(10*time.Millisecond))
+
+		// rejected by ch-1, the tokens of ch-0 are returned
+		_, _, err = shaper.reserve(1, sizes, rate, time.Second)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		assert.InDelta(t, 0, shaper.buckets[1]["ch-0"].tokens, float64(size)/10)
+
+		// the buckets of other collections are not affected
+		delay, _, err = shaper.reserve(2, sizes, rate, time.Second)
+		assert.NoError(t, err)
+		assert.Zero(t, delay)
+
+		shaper.removeCollection(1)
+		assert.NotContains(t, shaper.buckets, UniqueID(1))
+	})
+
+	t.Run("shape", func(t *testing.T) {
+		shaper := newInsertShaper()
+		rate := float64(size) * 20
+
+		// take the tokens of one second
+		for i := 0; i < 20; i++ {
+			assert.NoError(t, shaper.shape(context.Background(), "coll", 1, sizes, rate))
+		}
+		start := time.Now()
+		assert.NoError(t, shaper.shape(context.Background(), "coll", 1, sizes, rate))
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+		// the tokens of the canceled insert are returned
+		tokens := shaper.buckets[1]["ch-0"].tokens
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, shaper.shape(ctx, "coll", 1, sizes, rate), context.Canceled)
+		assert.GreaterOrEqual(t, shaper.buckets[1]["ch-0"].tokens, tokens)
+	})
+
+	t.Run("get insert shaping rate", func(t *testing.T) {
+		params := paramtable.Get()
+		assert.Zero(t, getInsertShapingRate(&collectionBasicInfo{}))
+		assert.Equal(t, 4.0*1024*1024, getInsertShapingRate(&collectionBasicInfo{insertShapingRate: 4}))
+
+		params.Save(params.ProxyCfg.InsertShapingEnabled.Key, "true")
+		defer params.Reset(params.ProxyCfg.InsertShapingEnabled.Key)
+		params.Save(params.ProxyCfg.InsertShapingRatePerVChannel.Key, "8")
+		defer params.Reset(params.ProxyCfg.InsertShapingRatePerVChannel.Key)
+		assert.Equal(t, 8.0*1024*1024, getInsertShapingRate(&collectionBasicInfo{}))
+		assert.Equal(t, 4.0*1024*1024, getInsertShapingRate(&collectionBasicInfo{insertShapingRate: 4}))
+	})
+}
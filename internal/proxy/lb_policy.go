@@ -43,6 +43,7 @@ type ChannelWorkload struct {
 	nq             int64
 	exec           executeFunc
 	retryTimes     uint
+	batch          bool
 }
 
 type CollectionWorkLoad struct {
@@ -51,6 +52,13 @@ type CollectionWorkLoad struct {
 	collectionID   int64
 	nq             int64
 	exec           executeFunc
+	// retryTimes overrides the retry times on each channel if positive
+	retryTimes uint
+	// failedChannels collects the channels failed after retries instead of failing the workload if not nil,
+	// the workload still fails if no less than half of the channels fail
+	failedChannels *typeutil.ConcurrentSet[string]
+	// batch routes the workload to the shard leaders in proxy.batchResourceGroups, see filterShardLeaders
+	batch bool
 }
 
 type LBPolicy interface {
@@ -89,6 +97,25 @@ func (lb *LBPolicyImpl) Start(ctx context.Context) {
 	lb.balancer.Start(ctx)
 }
 
+// filterShardLeaders returns the shard leaders in the batch resource groups for the batch workload,
+// and the others for the online workload. All the leaders are returned if none of them fits, or no batch resource
+// group is configured.
+func filterShardLeaders(nodes []nodeInfo, batch bool) []nodeInfo {
+	batchGroups := lo.Filter(Params.ProxyCfg.BatchResourceGroups.GetAsStrings(), func(rg string, _ int) bool {
+		return len(rg) > 0
+	})
+	if len(batchGroups) == 0 {
+		return nodes
+	}
+	filtered := lo.Filter(nodes, func(node nodeInfo, _ int) bool {
+		return lo.Contains(batchGroups, node.resourceGroup) == batch
+	})
+	if len(filtered) == 0 {
+		return nodes
+	}
+	return filtered
+}
+
 // try to select the best node from the available nodes
 func (lb *LBPolicyImpl) selectNode(ctx context.Context, workload ChannelWorkload, excludeNodes typeutil.UniqueSet) (int64, error) {
 	log := log.Ctx(ctx).With(
@@ -107,7 +134,7 @@ func (lb *LBPolicyImpl) selectNode(ctx context.Context, workload ChannelWorkload
 			return nil, err
 		}
 
-		return lo.Map(shardLeaders[workload.channel], func(node nodeInfo, _ int) int64 { return node.nodeID }), nil
+		return lo.Map(filterShardLeaders(shardLeaders[workload.channel], workload.batch), func(node nodeInfo, _ int) int64 { return node.nodeID }), nil
 	}
 
 	availableNodes := lo.Filter(workload.shardLeaders, filterAvailableNodes)
@@ -206,16 +233,20 @@ func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad
 
 	// let every request could retry at least twice, which could retry after update shard leader cache
 	retryTimes := Params.ProxyCfg.RetryTimesOnReplica.GetAsInt()
+	channelErrs := typeutil.NewConcurrentMap[string, error]()
 	wg, ctx := errgroup.WithContext(ctx)
 	for channel, nodes := range dml2leaders {
 		channel := channel
-		nodes := lo.Map(nodes, func(node nodeInfo, _ int) int64 { return node.nodeID })
+		nodes := lo.Map(filterShardLeaders(nodes, workload.batch), func(node nodeInfo, _ int) int64 { return node.nodeID })
 		channelRetryTimes := retryTimes
 		if len(nodes) > 0 {
 			channelRetryTimes *= len(nodes)
 		}
+		if workload.retryTimes > 0 {
+			channelRetryTimes = int(workload.retryTimes)
+		}
 		wg.Go(func() error {
-			return lb.ExecuteWithRetry(ctx, ChannelWorkload{
+			err := lb.ExecuteWithRetry(ctx, ChannelWorkload{
 				db:             workload.db,
 				collectionName: workload.collectionName,
 				collectionID:   workload.collectionID,
@@ -224,11 +255,30 @@ func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad
 				nq:             workload.nq,
 				exec:           workload.exec,
 				retryTimes:     uint(channelRetryTimes),
+				batch:          workload.batch,
 			})
+			if err != nil && workload.failedChannels != nil {
+				log.Ctx(ctx).Warn("channel failed, skip it as partial results", zap.String("channel", channel), zap.Error(err))
+				workload.failedChannels.Insert(channel)
+				channelErrs.Insert(channel, err)
+				return nil
+			}
+			return err
 		})
 	}
 
-	return wg.Wait()
+	if err := wg.Wait(); err != nil {
+		return err
+	}
+	if failed := channelErrs.Len(); failed > 0 && failed*2 >= len(dml2leaders) {
+		errs := make([]error, 0, failed)
+		channelErrs.Range(func(_ string, err error) bool {
+			errs = append(errs, err)
+			return true
+		})
+		return errors.Wrapf(merr.Combine(errs...), "%d of %d channels failed", failed, len(dml2leaders))
+	}
+	return nil
 }
 
 func (lb *LBPolicyImpl) UpdateCostMetrics(node int64, cost *internalpb.CostAggregation) {
@@ -19,8 +19,10 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/atomic"
@@ -72,14 +74,16 @@ func (s *LBPolicySuite) SetupTest() {
 		Status: &successStatus,
 		Shards: []*querypb.ShardLeadersList{
 			{
-				ChannelName: s.channels[0],
-				NodeIds:     s.nodes,
-				NodeAddrs:   []string{"localhost:9000", "localhost:9001", "localhost:9002", "localhost:9003", "localhost:9004"},
+				ChannelName:    s.channels[0],
+				NodeIds:        s.nodes,
+				NodeAddrs:      []string{"localhost:9000", "localhost:9001", "localhost:9002", "localhost:9003", "localhost:9004"},
+				ResourceGroups: []string{"rg_batch", "rg_batch", "rg1", "rg1", "rg1"},
 			},
 			{
-				ChannelName: s.channels[1],
-				NodeIds:     s.nodes,
-				NodeAddrs:   []string{"localhost:9000", "localhost:9001", "localhost:9002", "localhost:9003", "localhost:9004"},
+				ChannelName:    s.channels[1],
+				NodeIds:        s.nodes,
+				NodeAddrs:      []string{"localhost:9000", "localhost:9001", "localhost:9002", "localhost:9003", "localhost:9004"},
+				ResourceGroups: []string{"rg_batch", "rg_batch", "rg1", "rg1", "rg1"},
 			},
 		},
 	}, nil).Maybe()
@@ -395,6 +399,26 @@ func (s *LBPolicySuite) TestExecute() {
 	s.Error(err)
 	s.Equal(int64(11), counter.Load())
 
+	// test retry times overridden by the retry budget
+	counter.Store(0)
+	err = s.lbPolicy.Execute(ctx, CollectionWorkLoad{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		nq:             1,
+		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+			counter.Inc()
+			// fail after every channel is tried, so no channel is canceled before its first attempt
+			s.Eventually(func() bool {
+				return counter.Load() >= int64(len(s.channels))
+			}, 10*time.Second, time.Millisecond)
+			return mockErr
+		},
+		retryTimes: 1,
+	})
+	s.Error(err)
+	s.Equal(int64(len(s.channels)), counter.Load())
+
 	// test get shard leader failed
 	s.qc.ExpectedCalls = nil
 	globalMetaCache.DeprecateShardCache(dbName, s.collectionName)
@@ -411,6 +435,121 @@ func (s *LBPolicySuite) TestExecute() {
 	s.ErrorIs(err, mockErr)
 }
 
+func (s *LBPolicySuite) TestExecuteBatchWorkload() {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.ProxyCfg.BatchResourceGroups.Key, "rg_batch")
+	defer paramtable.Get().Reset(Params.ProxyCfg.BatchResourceGroups.Key)
+
+	selected := typeutil.NewConcurrentSet[int64]()
+	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, nodes []int64, nq int64) (int64, error) {
+			selected.Upsert(nodes...)
+			return nodes[0], nil
+		})
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+
+	workload := CollectionWorkLoad{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		nq:             1,
+		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+			return nil
+		},
+		batch: true,
+	}
+	s.NoError(s.lbPolicy.Execute(ctx, workload))
+	s.ElementsMatch([]int64{1, 2}, selected.Collect())
+
+	selected = typeutil.NewConcurrentSet[int64]()
+	workload.batch = false
+	s.NoError(s.lbPolicy.Execute(ctx, workload))
+	s.ElementsMatch([]int64{3, 4, 5}, selected.Collect())
+}
+
+func (s *LBPolicySuite) TestFilterShardLeaders() {
+	nodes := []nodeInfo{
+		{nodeID: 1, resourceGroup: "rg_batch"},
+		{nodeID: 2, resourceGroup: "rg1"},
+		{nodeID: 3},
+	}
+	nodeIDs := func(nodes []nodeInfo) []int64 {
+		return lo.Map(nodes, func(node nodeInfo, _ int) int64 { return node.nodeID })
+	}
+
+	// no batch resource group configured
+	s.Equal([]int64{1, 2, 3}, nodeIDs(filterShardLeaders(nodes, true)))
+
+	paramtable.Get().Save(Params.ProxyCfg.BatchResourceGroups.Key, "rg_batch,rg_export")
+	defer paramtable.Get().Reset(Params.ProxyCfg.BatchResourceGroups.Key)
+	s.Equal([]int64{1}, nodeIDs(filterShardLeaders(nodes, true)))
+	s.Equal([]int64{2, 3}, nodeIDs(filterShardLeaders(nodes, false)))
+
+	// fall back to all the leaders if none fits
+	s.Equal([]int64{2, 3}, nodeIDs(filterShardLeaders(nodes[1:], true)))
+	s.Equal([]int64{1}, nodeIDs(filterShardLeaders(nodes[:1], false)))
+}
+
+func (s *LBPolicySuite) TestExecuteWithFailedChannels() {
+	ctx := context.Background()
+	mockErr := errors.New("mock error")
+	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(1, nil)
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+
+	// half of the channels failed
+	failedChannels := typeutil.NewConcurrentSet[string]()
+	err := s.lbPolicy.Execute(ctx, CollectionWorkLoad{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		nq:             1,
+		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+			if channel == s.channels[0] {
+				return mockErr
+			}
+			return nil
+		},
+		retryTimes:     1,
+		failedChannels: failedChannels,
+	})
+	s.ErrorIs(err, mockErr)
+	s.ElementsMatch([]string{s.channels[0]}, failedChannels.Collect())
+
+	// the minority of the channels failed
+	channels := []string{"channel1", "channel2", "channel3"}
+	s.qc.ExpectedCalls = nil
+	globalMetaCache.DeprecateShardCache(dbName, s.collectionName)
+	s.qc.EXPECT().GetShardLeaders(mock.Anything, mock.Anything).Return(&querypb.GetShardLeadersResponse{
+		Status: merr.Success(),
+		Shards: lo.Map(channels, func(channel string, _ int) *querypb.ShardLeadersList {
+			return &querypb.ShardLeadersList{
+				ChannelName: channel,
+				NodeIds:     s.nodes,
+				NodeAddrs:   []string{"localhost:9000", "localhost:9001", "localhost:9002", "localhost:9003", "localhost:9004"},
+			}
+		}),
+	}, nil)
+	failedChannels = typeutil.NewConcurrentSet[string]()
+	err = s.lbPolicy.Execute(ctx, CollectionWorkLoad{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		nq:             1,
+		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+			if channel == channels[0] {
+				return mockErr
+			}
+			return nil
+		},
+		retryTimes:     1,
+		failedChannels: failedChannels,
+	})
+	s.NoError(err)
+	s.ElementsMatch([]string{channels[0]}, failedChannels.Collect())
+}
+
 func (s *LBPolicySuite) TestUpdateCostMetrics() {
 	s.lbBalancer.EXPECT().UpdateCostMetrics(mock.Anything, mock.Anything)
 	s.lbPolicy.UpdateCostMetrics(1, &internalpb.CostAggregation{})
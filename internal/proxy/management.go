@@ -17,18 +17,25 @@
 package proxy
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
+
+	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	management "github.com/milvus-io/milvus/internal/http"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 )
 
 // this file contains proxy management restful API handler
@@ -44,6 +51,54 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteGcResume,
 			HandlerFunc: proxy.ResumeDatacoordGC,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGcPruneSegmentIndex,
+			HandlerFunc: proxy.PruneDatacoordSegmentIndex,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetVectorStats,
+			HandlerFunc: proxy.GetDatacoordVectorStats,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCheckDuplicatePK,
+			HandlerFunc: proxy.CheckDatacoordDuplicatePK,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetDuplicatePK,
+			HandlerFunc: proxy.GetDatacoordDuplicatePK,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListDatabaseQuotas,
+			HandlerFunc: proxy.ListDatacoordDatabaseQuotas,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteForceCompleteTask,
+			HandlerFunc: proxy.ForceCompleteDatacoordTask,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteForceFailTask,
+			HandlerFunc: proxy.ForceFailDatacoordTask,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCreateExport,
+			HandlerFunc: proxy.CreateDatacoordExport,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetExportProgress,
+			HandlerFunc: proxy.GetDatacoordExportProgress,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetRegionReplicaStatus,
+			HandlerFunc: proxy.GetDatacoordRegionReplicaStatus,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteFailoverRegionReplica,
+			HandlerFunc: proxy.FailoverDatacoordRegionReplica,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetSLOStatus,
+			HandlerFunc: proxy.GetSLOStatus,
+		})
 		management.Register(&management.Handler{
 			Path:        management.RouteListQueryNode,
 			HandlerFunc: proxy.ListQueryNode,
@@ -68,6 +123,18 @@ func RegisterMgrRoute(proxy *Proxy) {
 			Path:        management.RouteResumeQueryNode,
 			HandlerFunc: proxy.ResumeQueryNode,
 		})
+		management.Register(&management.Handler{
+			Path:        management.RouteSafeRemoveQueryNode,
+			HandlerFunc: proxy.SafeRemoveQueryNode,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetSafeRemoveProgress,
+			HandlerFunc: proxy.GetSafeRemoveQueryNodeProgress,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCheckRowCountConsistency,
+			HandlerFunc: proxy.CheckRowCountConsistency,
+		})
 		management.Register(&management.Handler{
 			Path:        management.RouteTransferSegment,
 			HandlerFunc: proxy.TransferSegment,
@@ -126,6 +193,397 @@ func (node *Proxy) ResumeDatacoordGC(w http.ResponseWriter, req *http.Request) {
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
+func (node *Proxy) PruneDatacoordSegmentIndex(w http.ResponseWriter, req *http.Request) {
+	retentionSeconds := req.URL.Query().Get("retention_seconds")
+
+	resp, err := node.dataCoord.GcControl(req.Context(), &datapb.GcControlRequest{
+		Base:    commonpbutil.NewMsgBase(),
+		Command: datapb.GcCommand_PruneSegmentIndex,
+		Params: []*commonpb.KeyValuePair{
+			{Key: "retention", Value: retentionSeconds},
+		},
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to prune segment index, %s"}`, err.Error())))
+		return
+	}
+	if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to prune segment index, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) GetDatacoordVectorStats(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get vector stats, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get vector stats, %s"}`, err.Error())))
+		return
+	}
+
+	request, err := metricsinfo.ConstructVectorStatsRequest(collectionID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get vector stats, %s"}`, err.Error())))
+		return
+	}
+	resp, err := node.dataCoord.GetMetrics(req.Context(), request)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get vector stats, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get vector stats, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(resp.GetResponse()))
+}
+
+func (node *Proxy) CheckDatacoordDuplicatePK(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check duplicate pk, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check duplicate pk, %s"}`, err.Error())))
+		return
+	}
+	scheduleCompaction := false
+	if value := req.FormValue("schedule_compaction"); value != "" {
+		scheduleCompaction, err = strconv.ParseBool(value)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check duplicate pk, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.dataCoord.CheckDuplicatePrimaryKeys(req.Context(), &datapb.CheckDuplicatePrimaryKeysRequest{
+		Base:               commonpbutil.NewMsgBase(),
+		CollectionID:       collectionID,
+		ScheduleCompaction: scheduleCompaction,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check duplicate pk, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check duplicate pk, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(fmt.Sprintf(`{"msg": "OK", "job_id": %d}`, resp.GetJobID())))
+}
+
+func (node *Proxy) GetDatacoordDuplicatePK(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get duplicate pk report, %s"}`, err.Error())))
+		return
+	}
+
+	jobID, err := strconv.ParseInt(req.FormValue("job_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get duplicate pk report, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.GetDuplicatePrimaryKeysReport(req.Context(), &datapb.GetDuplicatePrimaryKeysReportRequest{
+		Base:  commonpbutil.NewMsgBase(),
+		JobID: jobID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get duplicate pk report, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get duplicate pk report, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	bytes, err := json.Marshal(resp.GetReport())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get duplicate pk report, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+func (node *Proxy) ListDatacoordDatabaseQuotas(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list database quotas, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.ListDatabaseTaskQuotas(req.Context(), &datapb.ListDatabaseTaskQuotasRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		DbName: req.FormValue("db_name"),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list database quotas, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list database quotas, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	bytes, err := json.Marshal(resp.GetQuotas())
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list database quotas, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// ForceCompleteDatacoordTask completes the task wedged in the index task scheduler of datacoord,
+// the task_id and the reason are required.
+func (node *Proxy) ForceCompleteDatacoordTask(w http.ResponseWriter, req *http.Request) {
+	node.forceDatacoordTask(w, req, "force complete", node.dataCoord.ForceCompleteTask)
+}
+
+// ForceFailDatacoordTask fails the task wedged in the index task scheduler of datacoord,
+// the task_id and the reason are required.
+func (node *Proxy) ForceFailDatacoordTask(w http.ResponseWriter, req *http.Request) {
+	node.forceDatacoordTask(w, req, "force fail", node.dataCoord.ForceFailTask)
+}
+
+func (node *Proxy) forceDatacoordTask(w http.ResponseWriter, req *http.Request, action string,
+	force func(ctx context.Context, req *indexpb.ForceTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error),
+) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s task, %s"}`, action, err.Error())))
+		return
+	}
+
+	taskID, err := strconv.ParseInt(req.FormValue("task_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s task, %s"}`, action, err.Error())))
+		return
+	}
+	reason := req.FormValue("reason")
+	if reason == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s task, reason is required"}`, action)))
+		return
+	}
+	resp, err := force(req.Context(), &indexpb.ForceTaskRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		TaskID: taskID,
+		Reason: reason,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s task, %s"}`, action, err.Error())))
+		return
+	}
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s task, %s"}`, action, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// CreateDatacoordExport exports the flushed segments of the collection as Parquet files under output_path/job_id/,
+// partition_ids is an optional comma separated list, all the partitions are exported if absent.
+func (node *Proxy) CreateDatacoordExport(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create export, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create export, %s"}`, err.Error())))
+		return
+	}
+	partitionIDs := make([]int64, 0)
+	if value := req.FormValue("partition_ids"); value != "" {
+		for _, s := range strings.Split(value, ",") {
+			partitionID, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create export, %s"}`, err.Error())))
+				return
+			}
+			partitionIDs = append(partitionIDs, partitionID)
+		}
+	}
+	outputPath := req.FormValue("output_path")
+	if outputPath == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"msg": "failed to create export, output_path is required"}`))
+		return
+	}
+
+	resp, err := node.dataCoord.ExportV2(req.Context(), &datapb.ExportV2Request{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		PartitionIDs: partitionIDs,
+		OutputPath:   outputPath,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create export, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create export, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(fmt.Sprintf(`{"msg": "OK", "job_id": %d}`, resp.GetJobID())))
+}
+
+func (node *Proxy) GetDatacoordExportProgress(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get export progress, %s"}`, err.Error())))
+		return
+	}
+
+	jobID, err := strconv.ParseInt(req.FormValue("job_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get export progress, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.GetExportProgress(req.Context(), &datapb.GetExportProgressRequest{
+		Base:  commonpbutil.NewMsgBase(),
+		JobID: jobID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get export progress, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get export progress, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get export progress, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// GetDatacoordRegionReplicaStatus returns the role of the region and the staleness of the collections
+// shipped from the primary region.
+func (node *Proxy) GetDatacoordRegionReplicaStatus(w http.ResponseWriter, req *http.Request) {
+	resp, err := node.dataCoord.GetRegionReplicaStatus(req.Context(), &datapb.GetRegionReplicaStatusRequest{
+		Base: commonpbutil.NewMsgBase(),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get region replica status, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get region replica status, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get region replica status, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// FailoverDatacoordRegionReplica turns the secondary region into the primary region.
+func (node *Proxy) FailoverDatacoordRegionReplica(w http.ResponseWriter, req *http.Request) {
+	resp, err := node.dataCoord.FailoverRegionReplica(req.Context(), &datapb.FailoverRegionReplicaRequest{
+		Base: commonpbutil.NewMsgBase(),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to failover region replica, %s"}`, err.Error())))
+		return
+	}
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to failover region replica, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// GetSLOStatus returns the compliance and the burn rate of the collection SLOs in the short and the long windows,
+// which are computed from the requests served by this proxy, db_name and collection_name are optional filters.
+func (node *Proxy) GetSLOStatus(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get slo status, %s"}`, err.Error())))
+		return
+	}
+
+	status := globalSLOTracker.getStatus(req.FormValue("db_name"), req.FormValue("collection_name"), time.Now())
+	bytes, err := json.Marshal(status)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get slo status, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
 func (node *Proxy) ListQueryNode(w http.ResponseWriter, req *http.Request) {
 	resp, err := node.queryCoord.ListQueryNode(req.Context(), &querypb.ListQueryNodeRequest{
 		Base: commonpbutil.NewMsgBase(),
@@ -300,6 +758,136 @@ func (node *Proxy) ResumeQueryNode(w http.ResponseWriter, req *http.Request) {
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
+func (node *Proxy) SafeRemoveQueryNode(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to remove node safely, %s"}`, err.Error())))
+		return
+	}
+
+	nodeID, err := strconv.ParseInt(req.FormValue("node_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to remove node safely, %s"}`, err.Error())))
+		return
+	}
+	resp, err := node.queryCoord.SafeRemoveNode(req.Context(), &querypb.SafeRemoveNodeRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		NodeID: nodeID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to remove node safely, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to remove node safely, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) GetSafeRemoveQueryNodeProgress(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get safe remove progress, %s"}`, err.Error())))
+		return
+	}
+
+	nodeID, err := strconv.ParseInt(req.FormValue("node_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get safe remove progress, %s"}`, err.Error())))
+		return
+	}
+	resp, err := node.queryCoord.GetSafeRemoveNodeProgress(req.Context(), &querypb.GetSafeRemoveNodeProgressRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		NodeID: nodeID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get safe remove progress, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get safe remove progress, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get safe remove progress, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+func (node *Proxy) CheckRowCountConsistency(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check row count consistency, %s"}`, err.Error())))
+		return
+	}
+
+	// check all the loaded collections if collection_id is not specified
+	collectionID := int64(0)
+	if value := req.FormValue("collection_id"); value != "" {
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check row count consistency, %s"}`, err.Error())))
+			return
+		}
+	}
+	repair := false
+	if value := req.FormValue("repair"); value != "" {
+		repair, err = strconv.ParseBool(value)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check row count consistency, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.queryCoord.CheckRowCountConsistency(req.Context(), &querypb.CheckRowCountConsistencyRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		Repair:       repair,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check row count consistency, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check row count consistency, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to check row count consistency, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
 func (node *Proxy) TransferSegment(w http.ResponseWriter, req *http.Request) {
 	err := req.ParseForm()
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/mock"
@@ -29,11 +30,15 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	management "github.com/milvus-io/milvus/internal/http"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 )
 
 type ProxyManagementSuite struct {
@@ -166,6 +171,602 @@ func (s *ProxyManagementSuite) TestResumeDatacoordGC() {
 	})
 }
 
+func (s *ProxyManagementSuite) TestPruneDatacoordSegmentIndex() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GcControl(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.GcControlRequest, options ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal(datapb.GcCommand_PruneSegmentIndex, req.GetCommand())
+			s.Equal([]*commonpb.KeyValuePair{{Key: "retention", Value: "3600"}}, req.GetParams())
+			return &commonpb.Status{}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcPruneSegmentIndex+"?retention_seconds=3600", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.PruneDatacoordSegmentIndex(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GcControl(mock.Anything, mock.Anything).Return(&commonpb.Status{}, errors.New("mock"))
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcPruneSegmentIndex, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.PruneDatacoordSegmentIndex(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GcControl(mock.Anything, mock.Anything).Return(&commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "mocked",
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGcPruneSegmentIndex, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.PruneDatacoordSegmentIndex(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetDatacoordVectorStats() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GetMetrics(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.GetMetricsRequest, options ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+			collectionID, err := metricsinfo.ParseCollectionID(req.GetRequest())
+			s.NoError(err)
+			s.EqualValues(100, collectionID)
+			return &milvuspb.GetMetricsResponse{Status: merr.Success(), Response: `{"collection_id": 100}`}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetVectorStats+"?collection_id=100", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordVectorStats(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"collection_id": 100}`, recorder.Body.String())
+	})
+
+	s.Run("invalid_collection_id", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetVectorStats+"?collection_id=abc", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordVectorStats(recorder, req)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetVectorStats+"?collection_id=100", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordVectorStats(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GetMetrics(mock.Anything, mock.Anything).Return(&milvuspb.GetMetricsResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotFound(100)),
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetVectorStats+"?collection_id=100", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordVectorStats(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestCheckDatacoordDuplicatePK() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().CheckDuplicatePrimaryKeys(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.CheckDuplicatePrimaryKeysRequest, options ...grpc.CallOption) (*datapb.CheckDuplicatePrimaryKeysResponse, error) {
+			s.EqualValues(100, req.GetCollectionID())
+			s.True(req.GetScheduleCompaction())
+			return &datapb.CheckDuplicatePrimaryKeysResponse{Status: merr.Success(), JobID: 1000}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteCheckDuplicatePK, strings.NewReader("collection_id=100&schedule_compaction=true"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.CheckDatacoordDuplicatePK(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK", "job_id": 1000}`, recorder.Body.String())
+	})
+
+	s.Run("invalid_params", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		for _, body := range []string{"collection_id=abc", "collection_id=100&schedule_compaction=abc"} {
+			req, err := http.NewRequest(http.MethodPost, management.RouteCheckDuplicatePK, strings.NewReader(body))
+			s.Require().NoError(err)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			recorder := httptest.NewRecorder()
+			s.proxy.CheckDatacoordDuplicatePK(recorder, req)
+
+			s.Equal(http.StatusBadRequest, recorder.Code)
+		}
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().CheckDuplicatePrimaryKeys(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteCheckDuplicatePK, strings.NewReader("collection_id=100"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.CheckDatacoordDuplicatePK(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().CheckDuplicatePrimaryKeys(mock.Anything, mock.Anything).Return(&datapb.CheckDuplicatePrimaryKeysResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotFound(100)),
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteCheckDuplicatePK, strings.NewReader("collection_id=100"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.CheckDatacoordDuplicatePK(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetDatacoordDuplicatePK() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GetDuplicatePrimaryKeysReport(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.GetDuplicatePrimaryKeysReportRequest, options ...grpc.CallOption) (*datapb.GetDuplicatePrimaryKeysReportResponse, error) {
+			s.EqualValues(1000, req.GetJobID())
+			return &datapb.GetDuplicatePrimaryKeysReportResponse{Status: merr.Success(), Report: &datapb.DuplicatePrimaryKeysReport{
+				JobID:           1000,
+				State:           datapb.DuplicatePrimaryKeysJobState_DuplicateJobCompleted,
+				ExactDuplicates: 1,
+			}}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetDuplicatePK+"?job_id=1000", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordDuplicatePK(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"exact_duplicates":1`)
+	})
+
+	s.Run("invalid_job_id", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetDuplicatePK+"?job_id=abc", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordDuplicatePK(recorder, req)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GetDuplicatePrimaryKeysReport(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetDuplicatePK+"?job_id=1000", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordDuplicatePK(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GetDuplicatePrimaryKeysReport(mock.Anything, mock.Anything).Return(&datapb.GetDuplicatePrimaryKeysReportResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("job not found")),
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetDuplicatePK+"?job_id=1000", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordDuplicatePK(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestListDatacoordDatabaseQuotas() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().ListDatabaseTaskQuotas(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.ListDatabaseTaskQuotasRequest, options ...grpc.CallOption) (*datapb.ListDatabaseTaskQuotasResponse, error) {
+			s.Equal("db1", req.GetDbName())
+			return &datapb.ListDatabaseTaskQuotasResponse{Status: merr.Success(), Quotas: []*datapb.DatabaseTaskQuota{{
+				DbName:            "db1",
+				MaxIndexTasks:     4,
+				RunningIndexTasks: 2,
+			}}}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteListDatabaseQuotas+"?db_name=db1", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.ListDatacoordDatabaseQuotas(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"max_index_tasks":4`)
+		s.Contains(recorder.Body.String(), `"running_index_tasks":2`)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().ListDatabaseTaskQuotas(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteListDatabaseQuotas, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.ListDatacoordDatabaseQuotas(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().ListDatabaseTaskQuotas(mock.Anything, mock.Anything).Return(&datapb.ListDatabaseTaskQuotasResponse{
+			Status: merr.Status(merr.ErrServiceNotReady),
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteListDatabaseQuotas, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.ListDatacoordDatabaseQuotas(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestForceDatacoordTask() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().ForceCompleteTask(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *indexpb.ForceTaskRequest, options ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal(int64(1), req.GetTaskID())
+			s.Equal("stuck", req.GetReason())
+			return merr.Success(), nil
+		})
+		s.datacoord.EXPECT().ForceFailTask(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteForceCompleteTask, strings.NewReader("task_id=1&reason=stuck"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.ForceCompleteDatacoordTask(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+
+		req, err = http.NewRequest(http.MethodPost, management.RouteForceFailTask, strings.NewReader("task_id=1&reason=stuck"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.ForceFailDatacoordTask(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		// test miss task id
+		req, err := http.NewRequest(http.MethodPost, management.RouteForceFailTask, strings.NewReader("reason=stuck"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.ForceFailDatacoordTask(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test miss reason
+		req, err = http.NewRequest(http.MethodPost, management.RouteForceFailTask, strings.NewReader("task_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.ForceFailDatacoordTask(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test rpc return error
+		s.datacoord.EXPECT().ForceFailTask(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err = http.NewRequest(http.MethodPost, management.RouteForceFailTask, strings.NewReader("task_id=1&reason=stuck"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.ForceFailDatacoordTask(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().ForceCompleteTask(mock.Anything, mock.Anything).Return(merr.Status(merr.ErrServiceNotReady), nil)
+		req, err := http.NewRequest(http.MethodPost, management.RouteForceCompleteTask, strings.NewReader("task_id=1&reason=stuck"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.ForceCompleteDatacoordTask(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestCreateDatacoordExport() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().ExportV2(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.ExportV2Request, options ...grpc.CallOption) (*datapb.ExportV2Response, error) {
+			s.EqualValues(100, req.GetCollectionID())
+			s.Equal([]int64{101, 102}, req.GetPartitionIDs())
+			s.Equal("backup/export", req.GetOutputPath())
+			return &datapb.ExportV2Response{Status: merr.Success(), JobID: 1000}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteCreateExport, strings.NewReader("collection_id=100&partition_ids=101,102&output_path=backup/export"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.CreateDatacoordExport(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK", "job_id": 1000}`, recorder.Body.String())
+	})
+
+	s.Run("invalid_params", func() {
+		for _, body := range []string{
+			"output_path=backup/export",
+			"collection_id=100&partition_ids=abc&output_path=backup/export",
+			"collection_id=100",
+		} {
+			s.SetupTest()
+			req, err := http.NewRequest(http.MethodPost, management.RouteCreateExport, strings.NewReader(body))
+			s.Require().NoError(err)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			recorder := httptest.NewRecorder()
+			s.proxy.CreateDatacoordExport(recorder, req)
+
+			s.Equal(http.StatusBadRequest, recorder.Code, body)
+			s.TearDownTest()
+		}
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().ExportV2(mock.Anything, mock.Anything).Return(&datapb.ExportV2Response{
+			Status: merr.Status(merr.WrapErrCollectionNotFound(100)),
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteCreateExport, strings.NewReader("collection_id=100&output_path=backup/export"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.CreateDatacoordExport(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetDatacoordExportProgress() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GetExportProgress(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.GetExportProgressRequest, options ...grpc.CallOption) (*datapb.GetExportProgressResponse, error) {
+			s.EqualValues(1000, req.GetJobID())
+			return &datapb.GetExportProgressResponse{
+				Status:           merr.Success(),
+				State:            datapb.ExportJobState_ExportJobExporting,
+				Progress:         50,
+				TotalSegments:    2,
+				ExportedSegments: 1,
+			}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetExportProgress+"?job_id=1000", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordExportProgress(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"progress":50`)
+	})
+
+	s.Run("invalid_job_id", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetExportProgress+"?job_id=abc", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordExportProgress(recorder, req)
+
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GetExportProgress(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetExportProgress+"?job_id=1000", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordExportProgress(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetDatacoordRegionReplicaStatus() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GetRegionReplicaStatus(mock.Anything, mock.Anything).Return(&datapb.GetRegionReplicaStatusResponse{
+			Status: merr.Success(),
+			Role:   "secondary",
+			Collections: []*datapb.RegionReplicaCollectionStatus{
+				{CollectionName: "coll", StalenessSeconds: 30},
+			},
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetRegionReplicaStatus, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordRegionReplicaStatus(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"role":"secondary"`)
+		s.Contains(recorder.Body.String(), `"staleness_seconds":30`)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().GetRegionReplicaStatus(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetRegionReplicaStatus, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetDatacoordRegionReplicaStatus(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestFailoverDatacoordRegionReplica() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().FailoverRegionReplica(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteFailoverRegionReplica, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.FailoverDatacoordRegionReplica(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		s.datacoord.EXPECT().FailoverRegionReplica(mock.Anything, mock.Anything).
+			Return(merr.Status(merr.WrapErrServiceUnavailable("not secondary")), nil)
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteFailoverRegionReplica, nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.FailoverDatacoordRegionReplica(recorder, req)
+
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetSLOStatus() {
+	tracker := globalSLOTracker
+	defer func() { globalSLOTracker = tracker }()
+	globalSLOTracker = newSLOTracker()
+	globalSLOTracker.observe(1, "default", "coll", &common.CollectionSLO{AvailabilityTarget: 0.99}, time.Millisecond, false, time.Now())
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetSLOStatus+"?collection_name=coll", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetSLOStatus(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"collection_name":"coll"`)
+		s.Contains(recorder.Body.String(), `"availability_target":0.99`)
+	})
+
+	s.Run("not_tracked", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, management.RouteGetSLOStatus+"?collection_name=other", nil)
+		s.Require().NoError(err)
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetSLOStatus(recorder, req)
+
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`[]`, recorder.Body.String())
+	})
+}
+
 func (s *ProxyManagementSuite) TestListQueryNode() {
 	s.Run("normal", func() {
 		s.SetupTest()
@@ -492,6 +1093,179 @@ func (s *ProxyManagementSuite) TestResumeQueryNode() {
 	})
 }
 
+func (s *ProxyManagementSuite) TestSafeRemoveQueryNode() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().SafeRemoveNode(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteSafeRemoveQueryNode, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.SafeRemoveQueryNode(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		// test miss requested param
+		req, err := http.NewRequest(http.MethodPost, management.RouteSafeRemoveQueryNode, strings.NewReader(""))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.SafeRemoveQueryNode(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test rpc return error
+		s.querycoord.EXPECT().SafeRemoveNode(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err = http.NewRequest(http.MethodPost, management.RouteSafeRemoveQueryNode, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.SafeRemoveQueryNode(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().SafeRemoveNode(mock.Anything, mock.Anything).Return(merr.Status(merr.ErrServiceNotReady), nil)
+		req, err := http.NewRequest(http.MethodPost, management.RouteSafeRemoveQueryNode, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.SafeRemoveQueryNode(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetSafeRemoveQueryNodeProgress() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().GetSafeRemoveNodeProgress(mock.Anything, mock.Anything).Return(&querypb.GetSafeRemoveNodeProgressResponse{
+			Status:            merr.Success(),
+			NodeID:            1,
+			Stage:             querypb.SafeRemoveStage_SafeRemoveStageMigrating,
+			RemainingSegments: 2,
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteGetSafeRemoveProgress, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetSafeRemoveQueryNodeProgress(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"nodeID":1,"stage":1,"remaining_segments":2}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		// test miss requested param
+		req, err := http.NewRequest(http.MethodPost, management.RouteGetSafeRemoveProgress, strings.NewReader(""))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.GetSafeRemoveQueryNodeProgress(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test rpc return failure
+		s.querycoord.EXPECT().GetSafeRemoveNodeProgress(mock.Anything, mock.Anything).Return(&querypb.GetSafeRemoveNodeProgressResponse{
+			Status: merr.Status(merr.WrapErrNodeNotFound(1)),
+		}, nil)
+		req, err = http.NewRequest(http.MethodPost, management.RouteGetSafeRemoveProgress, strings.NewReader("node_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.GetSafeRemoveQueryNodeProgress(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestCheckRowCountConsistency() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().CheckRowCountConsistency(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *querypb.CheckRowCountConsistencyRequest, opts ...grpc.CallOption) (*querypb.CheckRowCountConsistencyResponse, error) {
+			s.Equal(int64(1), req.GetCollectionID())
+			s.True(req.GetRepair())
+			return &querypb.CheckRowCountConsistencyResponse{
+				Status: merr.Success(),
+				Discrepancies: []*querypb.RowCountDiscrepancy{
+					{
+						CollectionID:    1,
+						SegmentID:       2,
+						Kind:            querypb.RowCountDiscrepancyKind_LoadedRowsMismatch,
+						NodeID:          3,
+						ExpectedRows:    10,
+						ActualRows:      8,
+						RepairScheduled: true,
+					},
+				},
+				CheckedSegments: 1,
+			}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodPost, management.RouteCheckRowCountConsistency, strings.NewReader("collection_id=1&repair=true"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.CheckRowCountConsistency(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"discrepancies":[{"collectionID":1,"segmentID":2,"kind":3,"nodeID":3,"expected_rows":10,"actual_rows":8,"repair_scheduled":true}],"checked_segments":1}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		// test invalid param
+		req, err := http.NewRequest(http.MethodPost, management.RouteCheckRowCountConsistency, strings.NewReader("repair=abc"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CheckRowCountConsistency(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		// test rpc return error
+		s.querycoord.EXPECT().CheckRowCountConsistency(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err = http.NewRequest(http.MethodPost, management.RouteCheckRowCountConsistency, strings.NewReader(""))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.CheckRowCountConsistency(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.querycoord.EXPECT().CheckRowCountConsistency(mock.Anything, mock.Anything).Return(&querypb.CheckRowCountConsistencyResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotLoaded(1)),
+		}, nil)
+		req, err := http.NewRequest(http.MethodPost, management.RouteCheckRowCountConsistency, strings.NewReader("collection_id=1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CheckRowCountConsistency(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
 func (s *ProxyManagementSuite) TestTransferSegment() {
 	s.Run("normal", func() {
 		s.SetupTest()
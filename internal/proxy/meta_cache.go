@@ -69,8 +69,14 @@ type Cache interface {
 	GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error)
 	// GetPartitionsIndex returns a partition names in partition key indexed order.
 	GetPartitionsIndex(ctx context.Context, database, collectionName string) ([]string, error)
+	// GetPartitionKeyStats returns the buckets of the partition keys of the partitions by partition name.
+	GetPartitionKeyStats(ctx context.Context, database, collectionName string) (map[string][]byte, error)
+	// UpdatePartitionKeyStats reports the buckets of the partition keys written into the partitions.
+	UpdatePartitionKeyStats(ctx context.Context, database, collectionName string, buckets map[string][]uint32) error
 	// GetCollectionSchema get collection's schema.
 	GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemaInfo, error)
+	// RefreshMetaSnapshot caches the databases and collections created since the last snapshot in bulk.
+	RefreshMetaSnapshot(ctx context.Context) error
 	GetShards(ctx context.Context, withCache bool, database, collectionName string, collectionID int64) (map[string][]nodeInfo, error)
 	DeprecateShardCache(database, collectionName string)
 	InvalidateShardLeaderCache(collections []int64)
@@ -100,6 +106,10 @@ type collectionBasicInfo struct {
 	createdUtcTimestamp   uint64
 	consistencyLevel      commonpb.ConsistencyLevel
 	partitionKeyIsolation bool
+	// insertShapingRate is the insert shaping rate in MB/s per vchannel of the collection, 0 if not set
+	insertShapingRate float64
+	// slo is the service level objectives of the collection, nil if not declared
+	slo *common.CollectionSLO
 }
 
 type collectionInfo struct {
@@ -110,6 +120,10 @@ type collectionInfo struct {
 	createdUtcTimestamp   uint64
 	consistencyLevel      commonpb.ConsistencyLevel
 	partitionKeyIsolation bool
+	insertShapingRate     float64
+	slo                   *common.CollectionSLO
+	// partitionKeyStats is the buckets of the partition keys by partition name, fetched lazily, guarded by MetaCache.mu
+	partitionKeyStats map[string][]byte
 }
 
 type databaseInfo struct {
@@ -192,6 +206,8 @@ func (info *collectionInfo) getBasicInfo() *collectionBasicInfo {
 		createdUtcTimestamp:   info.createdUtcTimestamp,
 		consistencyLevel:      info.consistencyLevel,
 		partitionKeyIsolation: info.partitionKeyIsolation,
+		insertShapingRate:     info.insertShapingRate,
+		slo:                   info.slo,
 	}
 
 	return basicInfo
@@ -268,6 +284,8 @@ type MetaCache struct {
 	shardMgr         shardClientMgr
 	sfGlobal         conc.Singleflight[*collectionInfo]
 	sfDB             conc.Singleflight[*databaseInfo]
+	// snapshotTs is the ts of the last meta snapshot fetched from rootcoord, guarded by mu
+	snapshotTs uint64
 
 	IDStart int64
 	IDCount int64
@@ -366,8 +384,29 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 		return nil, err
 	}
 
+	info, err := newCollectionInfo(collection, partitions)
+	if err != nil {
+		return nil, err
+	}
+
+	collectionName = collection.Schema.GetName()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, dbOk := m.collInfo[database]
+	if !dbOk {
+		m.collInfo[database] = make(map[string]*collectionInfo)
+	}
+	m.collInfo[database][collectionName] = info
+
+	log.Info("meta update success", zap.String("database", database), zap.String("collectionName", collectionName), zap.Int64("collectionID", collection.CollectionID))
+	return m.collInfo[database][collectionName], nil
+}
+
+// newCollectionInfo builds the cached collection info from the collection with the user fields only and its partitions.
+func newCollectionInfo(collection *milvuspb.DescribeCollectionResponse, partitions *milvuspb.ShowPartitionsResponse) (*collectionInfo, error) {
 	// check partitionID, createdTimestamp and utcstamp has sam element numbers
-	if len(partitions.PartitionNames) != len(partitions.CreatedTimestamps) || len(partitions.PartitionNames) != len(partitions.CreatedUtcTimestamps) {
+	if len(partitions.PartitionNames) != len(partitions.PartitionIDs) ||
+		len(partitions.PartitionNames) != len(partitions.CreatedTimestamps) || len(partitions.PartitionNames) != len(partitions.CreatedUtcTimestamps) {
 		return nil, merr.WrapErrParameterInvalidMsg("partition names and timestamps number is not aligned, response: %s", partitions.String())
 	}
 
@@ -380,21 +419,21 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 		}
 	})
 
-	collectionName = collection.Schema.GetName()
-	m.mu.Lock()
-	defer m.mu.Unlock()
-	_, dbOk := m.collInfo[database]
-	if !dbOk {
-		m.collInfo[database] = make(map[string]*collectionInfo)
-	}
-
 	isolation, err := common.IsPartitionKeyIsolationKvEnabled(collection.Properties...)
 	if err != nil {
 		return nil, err
 	}
+	insertShapingRate, err := common.GetCollectionInsertShapingRate(collection.Properties...)
+	if err != nil {
+		return nil, err
+	}
+	slo, err := common.CollectionLevelSLO(funcutil.KeyValuePair2Map(collection.Properties))
+	if err != nil {
+		return nil, err
+	}
 
 	schemaInfo := newSchemaInfo(collection.Schema)
-	m.collInfo[database][collectionName] = &collectionInfo{
+	return &collectionInfo{
 		collID:                collection.CollectionID,
 		schema:                schemaInfo,
 		partInfo:              parsePartitionsInfo(infos, schemaInfo.hasPartitionKeyField),
@@ -402,10 +441,9 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 		createdUtcTimestamp:   collection.CreatedUtcTimestamp,
 		consistencyLevel:      collection.ConsistencyLevel,
 		partitionKeyIsolation: isolation,
-	}
-
-	log.Info("meta update success", zap.String("database", database), zap.String("collectionName", collectionName), zap.Int64("collectionID", collection.CollectionID))
-	return m.collInfo[database][collectionName], nil
+		insertShapingRate:     insertShapingRate,
+		slo:                   slo,
+	}, nil
 }
 
 func buildSfKeyByName(database, collectionName string) string {
@@ -579,6 +617,70 @@ func (m *MetaCache) updateDBInfo(ctx context.Context) error {
 	return nil
 }
 
+// RefreshMetaSnapshot fetches the databases and collections created since the last snapshot from rootcoord in bulk,
+// the first call warms up the cache with all of them. The cached entries are kept as they are,
+// the changed ones are removed by the invalidations from rootcoord and described again on access.
+func (m *MetaCache) RefreshMetaSnapshot(ctx context.Context) error {
+	m.mu.RLock()
+	sinceTs := m.snapshotTs
+	m.mu.RUnlock()
+
+	resp, err := m.rootCoord.GetMetaSnapshot(ctx, &rootcoordpb.GetMetaSnapshotRequest{
+		Base:    commonpbutil.NewMsgBase(),
+		SinceTs: sinceTs,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to get meta snapshot", zap.Uint64("sinceTs", sinceTs), zap.Error(err))
+		return err
+	}
+
+	infos := make([]*collectionInfo, 0, len(resp.GetCollections()))
+	for _, snapshot := range resp.GetCollections() {
+		info, err := newCollectionInfo(stripSystemFields(snapshot.GetCollection()), snapshot.GetPartitions())
+		if err != nil {
+			log.Warn("failed to parse collection of meta snapshot",
+				zap.Int64("collectionID", snapshot.GetCollection().GetCollectionID()), zap.Error(err))
+			return err
+		}
+		infos = append(infos, info)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, db := range resp.GetDatabases() {
+		if _, ok := m.dbInfo[db.GetDbName()]; !ok {
+			m.dbInfo[db.GetDbName()] = &databaseInfo{
+				dbID:             db.GetDbID(),
+				createdTimestamp: db.GetCreatedTimestamp(),
+				properties:       funcutil.KeyValuePair2Map(db.GetProperties()),
+			}
+		}
+		if _, ok := m.collInfo[db.GetDbName()]; !ok {
+			m.collInfo[db.GetDbName()] = make(map[string]*collectionInfo)
+		}
+	}
+	for i, snapshot := range resp.GetCollections() {
+		database := snapshot.GetCollection().GetDbName()
+		collectionName := snapshot.GetCollection().GetSchema().GetName()
+		if _, ok := m.collInfo[database]; !ok {
+			m.collInfo[database] = make(map[string]*collectionInfo)
+		}
+		if _, ok := m.collInfo[database][collectionName]; !ok {
+			m.collInfo[database][collectionName] = infos[i]
+		}
+		// a collection missing here is still resolved by listing all the collections from rootcoord
+		if _, ok := m.dbCollectionInfo[database]; !ok {
+			m.dbCollectionInfo[database] = make(map[typeutil.UniqueID]string)
+		}
+		m.dbCollectionInfo[database][infos[i].collID] = collectionName
+	}
+	m.snapshotTs = resp.GetSnapshotTs()
+
+	log.Info("refresh meta snapshot done", zap.Uint64("sinceTs", sinceTs), zap.Uint64("snapshotTs", resp.GetSnapshotTs()),
+		zap.Int("numDatabases", len(resp.GetDatabases())), zap.Int("numCollections", len(resp.GetCollections())))
+	return nil
+}
+
 // GetCollectionInfo returns the collection information related to provided collection name
 // If the information is not found, proxy will try to fetch information for other source (RootCoord for now)
 // TODO: may cause data race of this implementation, should be refactored in future.
@@ -689,6 +791,122 @@ func (m *MetaCache) GetPartitionInfos(ctx context.Context, database, collectionN
 	return collInfo.partInfo, nil
 }
 
+func (m *MetaCache) GetPartitionKeyStats(ctx context.Context, database, collectionName string) (map[string][]byte, error) {
+	_, stats, err := m.getPartitionKeyStats(ctx, database, collectionName)
+	return stats, err
+}
+
+// getPartitionKeyStats returns the cached partition key stats of the collection, the stats are fetched
+// from rootcoord if not cached, and expired along with the collection when rootcoord changes them.
+func (m *MetaCache) getPartitionKeyStats(ctx context.Context, database, collectionName string) (*collectionInfo, map[string][]byte, error) {
+	collInfo, ok := m.getCollection(database, collectionName, 0)
+	if !ok {
+		var err error
+		collInfo, err = m.UpdateByName(ctx, database, collectionName)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	m.mu.RLock()
+	stats := collInfo.partitionKeyStats
+	m.mu.RUnlock()
+	if stats != nil {
+		return collInfo, stats, nil
+	}
+
+	resp, err := m.rootCoord.GetPartitionKeyStats(ctx, &rootcoordpb.GetPartitionKeyStatsRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		DbName:       database,
+		CollectionID: collInfo.collID,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return nil, nil, err
+	}
+	id2Name := make(map[int64]string, len(collInfo.partInfo.partitionInfos))
+	for _, partition := range collInfo.partInfo.partitionInfos {
+		id2Name[partition.partitionID] = partition.name
+	}
+	stats = make(map[string][]byte, len(resp.GetStats()))
+	for _, partitionStats := range resp.GetStats() {
+		if name, ok := id2Name[partitionStats.GetPartitionID()]; ok {
+			stats[name] = partitionStats.GetBuckets()
+		}
+	}
+
+	m.mu.Lock()
+	collInfo.partitionKeyStats = stats
+	m.mu.Unlock()
+	return collInfo, stats, nil
+}
+
+// UpdatePartitionKeyStats reports the buckets missing in the cached partition key stats to rootcoord,
+// the writes must not be acknowledged before it returns, otherwise the search may prune the partitions
+// containing them.
+func (m *MetaCache) UpdatePartitionKeyStats(ctx context.Context, database, collectionName string, buckets map[string][]uint32) error {
+	collInfo, stats, err := m.getPartitionKeyStats(ctx, database, collectionName)
+	if err != nil {
+		return err
+	}
+
+	updates := make([]*rootcoordpb.PartitionKeyStats, 0)
+	merged := make(map[string][]byte, len(stats))
+	for name, partitionBuckets := range stats {
+		merged[name] = partitionBuckets
+	}
+	for name, keyBuckets := range buckets {
+		partitionBuckets, ok := stats[name]
+		if !ok || !typeutil.IsPartitionKeyBucketsKnown(partitionBuckets) {
+			// the partition may contain any key
+			continue
+		}
+		var newBuckets []byte
+		for _, bucket := range keyBuckets {
+			if typeutil.HasPartitionKeyBucket(partitionBuckets, bucket) {
+				continue
+			}
+			if newBuckets == nil {
+				newBuckets = typeutil.NewPartitionKeyBuckets()
+			}
+			typeutil.SetPartitionKeyBucket(newBuckets, bucket)
+		}
+		if newBuckets == nil {
+			continue
+		}
+		partitionID, ok := collInfo.partInfo.name2ID[name]
+		if !ok {
+			continue
+		}
+		updates = append(updates, &rootcoordpb.PartitionKeyStats{
+			PartitionID: partitionID,
+			Buckets:     newBuckets,
+		})
+		mergedBuckets := common.CloneByteSlice(partitionBuckets)
+		typeutil.MergePartitionKeyBuckets(mergedBuckets, newBuckets)
+		merged[name] = mergedBuckets
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	resp, err := m.rootCoord.UpdatePartitionKeyStats(ctx, &rootcoordpb.UpdatePartitionKeyStatsRequest{
+		Base:           commonpbutil.NewMsgBase(),
+		DbName:         database,
+		CollectionName: collectionName,
+		CollectionID:   collInfo.collID,
+		Stats:          updates,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return err
+	}
+
+	// the stats are expired by rootcoord if changed, keep the reported buckets in case they're reported by others
+	m.mu.Lock()
+	collInfo.partitionKeyStats = merged
+	m.mu.Unlock()
+	return nil
+}
+
 // Get the collection information from rootcoord.
 func (m *MetaCache) describeCollection(ctx context.Context, database, collectionName string, collectionID int64) (*milvuspb.DescribeCollectionResponse, error) {
 	req := &milvuspb.DescribeCollectionRequest{
@@ -707,6 +925,11 @@ func (m *MetaCache) describeCollection(ctx context.Context, database, collection
 	if err != nil {
 		return nil, err
 	}
+	return stripSystemFields(coll), nil
+}
+
+// stripSystemFields returns the collection description without the system fields, which are not cached by proxy.
+func stripSystemFields(coll *milvuspb.DescribeCollectionResponse) *milvuspb.DescribeCollectionResponse {
 	resp := &milvuspb.DescribeCollectionResponse{
 		Status: coll.Status,
 		Schema: &schemapb.CollectionSchema{
@@ -730,7 +953,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, database, collection
 			resp.Schema.Fields = append(resp.Schema.Fields, field)
 		}
 	}
-	return resp, nil
+	return resp
 }
 
 func (m *MetaCache) showPartitions(ctx context.Context, dbName string, collectionName string, collectionID UniqueID) (*milvuspb.ShowPartitionsResponse, error) {
@@ -994,7 +1217,11 @@ func parseShardLeaderList2QueryNode(shardsLeaders []*querypb.ShardLeadersList) m
 		qns := make([]nodeInfo, len(leaders.GetNodeIds()))
 
 		for j := range qns {
-			qns[j] = nodeInfo{leaders.GetNodeIds()[j], leaders.GetNodeAddrs()[j]}
+			qns[j] = nodeInfo{nodeID: leaders.GetNodeIds()[j], address: leaders.GetNodeAddrs()[j]}
+			// the resource groups are not returned by the querycoord of old versions
+			if j < len(leaders.GetResourceGroups()) {
+				qns[j].resourceGroup = leaders.GetResourceGroups()[j]
+			}
 		}
 
 		shard2QueryNodes[leaders.GetChannelName()] = qns
@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -985,6 +986,117 @@ func TestGlobalMetaCache_UpdateDBInfo(t *testing.T) {
 	})
 }
 
+func TestMetaCache_RefreshMetaSnapshot(t *testing.T) {
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	queryCoord := mocks.NewMockQueryCoordClient(t)
+	shardMgr := newShardClientMgr()
+	ctx := context.Background()
+
+	cache, err := NewMetaCache(rootCoord, queryCoord, shardMgr)
+	assert.NoError(t, err)
+
+	newSnapshot := func(collectionID int64, collectionName string) *rootcoordpb.CollectionSnapshot {
+		return &rootcoordpb.CollectionSnapshot{
+			Collection: &milvuspb.DescribeCollectionResponse{
+				Status:       merr.Success(),
+				DbName:       "db1",
+				CollectionID: collectionID,
+				Schema: &schemapb.CollectionSchema{
+					Name: collectionName,
+					Fields: []*schemapb.FieldSchema{
+						{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+						{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+					},
+				},
+			},
+			Partitions: &milvuspb.ShowPartitionsResponse{
+				Status:               merr.Success(),
+				PartitionIDs:         []int64{collectionID + 1},
+				PartitionNames:       []string{"_default"},
+				CreatedTimestamps:    []uint64{1},
+				CreatedUtcTimestamps: []uint64{1},
+			},
+		}
+	}
+
+	t.Run("failed", func(t *testing.T) {
+		rootCoord.EXPECT().GetMetaSnapshot(mock.Anything, mock.Anything).Return(&rootcoordpb.GetMetaSnapshotResponse{
+			Status: merr.Status(merr.ErrServiceNotReady),
+		}, nil).Once()
+		err := cache.RefreshMetaSnapshot(ctx)
+		assert.Error(t, err)
+		assert.False(t, cache.HasDatabase(ctx, "db1"))
+	})
+
+	t.Run("warm up", func(t *testing.T) {
+		rootCoord.EXPECT().GetMetaSnapshot(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.GetMetaSnapshotRequest) bool {
+			return req.GetSinceTs() == 0
+		})).Return(&rootcoordpb.GetMetaSnapshotResponse{
+			Status:      merr.Success(),
+			SnapshotTs:  100,
+			Databases:   []*rootcoordpb.DatabaseSnapshot{{DbName: "db1", DbID: 1}, {DbName: "db2", DbID: 2}},
+			Collections: []*rootcoordpb.CollectionSnapshot{newSnapshot(1000, "coll1")},
+		}, nil).Once()
+		err := cache.RefreshMetaSnapshot(ctx)
+		assert.NoError(t, err)
+		assert.True(t, cache.HasDatabase(ctx, "db2"))
+
+		// no rpc to describe the cached collection
+		collectionID, err := cache.GetCollectionID(ctx, "db1", "coll1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1000), collectionID)
+		schema, err := cache.GetCollectionSchema(ctx, "db1", "coll1")
+		assert.NoError(t, err)
+		assert.Len(t, schema.GetFields(), 1)
+		partitions, err := cache.GetPartitions(ctx, "db1", "coll1")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int64{"_default": 1001}, partitions)
+		dbInfo, err := cache.GetDatabaseInfo(ctx, "db2")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), dbInfo.dbID)
+		dbNames, collectionNames, err := cache.GetCollectionNamesByID(ctx, []int64{1000})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"db1"}, dbNames)
+		assert.Equal(t, []string{"coll1"}, collectionNames)
+	})
+
+	t.Run("incremental refresh", func(t *testing.T) {
+		cached, ok := cache.getCollection("db1", "coll1", 0)
+		assert.True(t, ok)
+		rootCoord.EXPECT().GetMetaSnapshot(mock.Anything, mock.MatchedBy(func(req *rootcoordpb.GetMetaSnapshotRequest) bool {
+			return req.GetSinceTs() == 100
+		})).Return(&rootcoordpb.GetMetaSnapshotResponse{
+			Status:      merr.Success(),
+			SnapshotTs:  200,
+			Collections: []*rootcoordpb.CollectionSnapshot{newSnapshot(1000, "coll1"), newSnapshot(2000, "coll2")},
+		}, nil).Once()
+		err := cache.RefreshMetaSnapshot(ctx)
+		assert.NoError(t, err)
+
+		collectionID, err := cache.GetCollectionID(ctx, "db1", "coll2")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2000), collectionID)
+		// the cached collection is kept
+		info, ok := cache.getCollection("db1", "coll1", 0)
+		assert.True(t, ok)
+		assert.Same(t, cached, info)
+	})
+
+	t.Run("invalid partitions", func(t *testing.T) {
+		snapshot := newSnapshot(3000, "coll3")
+		snapshot.Partitions.CreatedTimestamps = nil
+		rootCoord.EXPECT().GetMetaSnapshot(mock.Anything, mock.Anything).Return(&rootcoordpb.GetMetaSnapshotResponse{
+			Status:      merr.Success(),
+			SnapshotTs:  300,
+			Collections: []*rootcoordpb.CollectionSnapshot{snapshot},
+		}, nil).Once()
+		err := cache.RefreshMetaSnapshot(ctx)
+		assert.Error(t, err)
+		_, ok := cache.getCollection("db1", "coll3", 0)
+		assert.False(t, ok)
+	})
+}
+
 func TestGlobalMetaCache_GetCollectionNamesByID(t *testing.T) {
 	rootCoord := mocks.NewMockRootCoordClient(t)
 	queryCoord := mocks.NewMockQueryCoordClient(t)
@@ -1119,3 +1231,82 @@ func TestMetaCache_InvalidateShardLeaderCache(t *testing.T) {
 	assert.Len(t, nodeInfos["channel-1"], 3)
 	assert.Equal(t, called.Load(), int32(2))
 }
+
+func TestMetaCache_PartitionKeyStats(t *testing.T) {
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	queryCoord := mocks.NewMockQueryCoordClient(t)
+	shardMgr := newShardClientMgr()
+	ctx := context.Background()
+
+	cache, err := NewMetaCache(rootCoord, queryCoord, shardMgr)
+	assert.NoError(t, err)
+
+	rootCoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status:       merr.Success(),
+		CollectionID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Name: "collection1",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "key", DataType: schemapb.DataType_Int64, IsPartitionKey: true},
+			},
+		},
+	}, nil)
+	rootCoord.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+		Status:               merr.Success(),
+		PartitionIDs:         []int64{10, 11, 12},
+		PartitionNames:       []string{"_default_0", "_default_1", "_default_2"},
+		CreatedTimestamps:    []uint64{100, 100, 100},
+		CreatedUtcTimestamps: []uint64{100, 100, 100},
+	}, nil)
+	buckets := typeutil.NewPartitionKeyBuckets()
+	typeutil.SetPartitionKeyBucket(buckets, 1)
+	rootCoord.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything).Return(&rootcoordpb.GetPartitionKeyStatsResponse{
+		Status: merr.Success(),
+		Stats: []*rootcoordpb.PartitionKeyStats{
+			{PartitionID: 10, Buckets: buckets},
+			{PartitionID: 11, Buckets: typeutil.NewPartitionKeyBuckets()},
+			{PartitionID: 12},
+		},
+	}, nil).Once()
+
+	stats, err := cache.GetPartitionKeyStats(ctx, dbName, "collection1")
+	assert.NoError(t, err)
+	assert.Len(t, stats, 3)
+	assert.Equal(t, buckets, stats["_default_0"])
+	assert.False(t, typeutil.IsPartitionKeyBucketsKnown(stats["_default_2"]))
+
+	// only the missing buckets of the tracked partitions are reported
+	rootCoord.EXPECT().UpdatePartitionKeyStats(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *rootcoordpb.UpdatePartitionKeyStatsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			assert.EqualValues(t, 1, req.GetCollectionID())
+			assert.Len(t, req.GetStats(), 1)
+			assert.EqualValues(t, 11, req.GetStats()[0].GetPartitionID())
+			assert.True(t, typeutil.HasPartitionKeyBucket(req.GetStats()[0].GetBuckets(), 2))
+			assert.False(t, typeutil.HasPartitionKeyBucket(req.GetStats()[0].GetBuckets(), 1))
+			return merr.Success(), nil
+		}).Once()
+	err = cache.UpdatePartitionKeyStats(ctx, dbName, "collection1", map[string][]uint32{
+		"_default_0": {1},
+		"_default_1": {2},
+		"_default_2": {3},
+	})
+	assert.NoError(t, err)
+
+	// the reported buckets are cached
+	stats, err = cache.GetPartitionKeyStats(ctx, dbName, "collection1")
+	assert.NoError(t, err)
+	assert.True(t, typeutil.HasPartitionKeyBucket(stats["_default_1"], 2))
+	err = cache.UpdatePartitionKeyStats(ctx, dbName, "collection1", map[string][]uint32{"_default_1": {2}})
+	assert.NoError(t, err)
+
+	rootCoord.EXPECT().UpdatePartitionKeyStats(mock.Anything, mock.Anything).Return(nil, errors.New("mock error")).Once()
+	err = cache.UpdatePartitionKeyStats(ctx, dbName, "collection1", map[string][]uint32{"_default_1": {3}})
+	assert.Error(t, err)
+
+	// the stats are fetched again after the collection is expired
+	cache.RemoveCollection(ctx, dbName, "collection1")
+	rootCoord.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything).Return(nil, errors.New("mock error")).Once()
+	_, err = cache.GetPartitionKeyStats(ctx, dbName, "collection1")
+	assert.Error(t, err)
+}
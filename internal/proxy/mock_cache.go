@@ -617,6 +617,62 @@ func (_c *MockCache_GetPartitionInfo_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetPartitionKeyStats provides a mock function with given fields: ctx, database, collectionName
+func (_m *MockCache) GetPartitionKeyStats(ctx context.Context, database string, collectionName string) (map[string][]byte, error) {
+	ret := _m.Called(ctx, database, collectionName)
+
+	var r0 map[string][]byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (map[string][]byte, error)); ok {
+		return rf(ctx, database, collectionName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) map[string][]byte); ok {
+		r0 = rf(ctx, database, collectionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, database, collectionName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCache_GetPartitionKeyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionKeyStats'
+type MockCache_GetPartitionKeyStats_Call struct {
+	*mock.Call
+}
+
+// GetPartitionKeyStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - database string
+//   - collectionName string
+func (_e *MockCache_Expecter) GetPartitionKeyStats(ctx interface{}, database interface{}, collectionName interface{}) *MockCache_GetPartitionKeyStats_Call {
+	return &MockCache_GetPartitionKeyStats_Call{Call: _e.mock.On("GetPartitionKeyStats", ctx, database, collectionName)}
+}
+
+func (_c *MockCache_GetPartitionKeyStats_Call) Run(run func(ctx context.Context, database string, collectionName string)) *MockCache_GetPartitionKeyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetPartitionKeyStats_Call) Return(_a0 map[string][]byte, _a1 error) *MockCache_GetPartitionKeyStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCache_GetPartitionKeyStats_Call) RunAndReturn(run func(context.Context, string, string) (map[string][]byte, error)) *MockCache_GetPartitionKeyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPartitions provides a mock function with given fields: ctx, database, collectionName
 func (_m *MockCache) GetPartitions(ctx context.Context, database string, collectionName string) (map[string]int64, error) {
 	ret := _m.Called(ctx, database, collectionName)
@@ -985,6 +1041,48 @@ func (_c *MockCache_InvalidateShardLeaderCache_Call) RunAndReturn(run func([]int
 	return _c
 }
 
+// RefreshMetaSnapshot provides a mock function with given fields: ctx
+func (_m *MockCache) RefreshMetaSnapshot(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCache_RefreshMetaSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshMetaSnapshot'
+type MockCache_RefreshMetaSnapshot_Call struct {
+	*mock.Call
+}
+
+// RefreshMetaSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCache_Expecter) RefreshMetaSnapshot(ctx interface{}) *MockCache_RefreshMetaSnapshot_Call {
+	return &MockCache_RefreshMetaSnapshot_Call{Call: _e.mock.On("RefreshMetaSnapshot", ctx)}
+}
+
+func (_c *MockCache_RefreshMetaSnapshot_Call) Run(run func(ctx context.Context)) *MockCache_RefreshMetaSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockCache_RefreshMetaSnapshot_Call) Return(_a0 error) *MockCache_RefreshMetaSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCache_RefreshMetaSnapshot_Call) RunAndReturn(run func(context.Context) error) *MockCache_RefreshMetaSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshPolicyInfo provides a mock function with given fields: op
 func (_m *MockCache) RefreshPolicyInfo(op typeutil.CacheOp) error {
 	ret := _m.Called(op)
@@ -1243,6 +1341,51 @@ func (_c *MockCache_UpdateCredential_Call) RunAndReturn(run func(*internalpb.Cre
 	return _c
 }
 
+// UpdatePartitionKeyStats provides a mock function with given fields: ctx, database, collectionName, buckets
+func (_m *MockCache) UpdatePartitionKeyStats(ctx context.Context, database string, collectionName string, buckets map[string][]uint32) error {
+	ret := _m.Called(ctx, database, collectionName, buckets)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, map[string][]uint32) error); ok {
+		r0 = rf(ctx, database, collectionName, buckets)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCache_UpdatePartitionKeyStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePartitionKeyStats'
+type MockCache_UpdatePartitionKeyStats_Call struct {
+	*mock.Call
+}
+
+// UpdatePartitionKeyStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - database string
+//   - collectionName string
+//   - buckets map[string][]uint32
+func (_e *MockCache_Expecter) UpdatePartitionKeyStats(ctx interface{}, database interface{}, collectionName interface{}, buckets interface{}) *MockCache_UpdatePartitionKeyStats_Call {
+	return &MockCache_UpdatePartitionKeyStats_Call{Call: _e.mock.On("UpdatePartitionKeyStats", ctx, database, collectionName, buckets)}
+}
+
+func (_c *MockCache_UpdatePartitionKeyStats_Call) Run(run func(ctx context.Context, database string, collectionName string, buckets map[string][]uint32)) *MockCache_UpdatePartitionKeyStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(map[string][]uint32))
+	})
+	return _c
+}
+
+func (_c *MockCache_UpdatePartitionKeyStats_Call) Return(_a0 error) *MockCache_UpdatePartitionKeyStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCache_UpdatePartitionKeyStats_Call) RunAndReturn(run func(context.Context, string, string, map[string][]uint32) error) *MockCache_UpdatePartitionKeyStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCache creates a new instance of MockCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCache(t interface {
@@ -244,6 +244,12 @@ func repackInsertDataWithPartitionKey(ctx context.Context,
 			zap.Error(err))
 		return nil, err
 	}
+	if err := updatePartitionKeyStats(ctx, insertMsg, partitionKeys, partitionNames, hashValues); err != nil {
+		log.Warn("update partition key stats failed",
+			zap.String("collectionName", insertMsg.CollectionName),
+			zap.Error(err))
+		return nil, err
+	}
 
 	for channel, rowOffsets := range channel2RowOffsets {
 		partition2RowOffsets := make(map[string][]int)
@@ -296,3 +302,30 @@ func repackInsertDataWithPartitionKey(ctx context.Context,
 
 	return msgPack, nil
 }
+
+// updatePartitionKeyStats reports the buckets of the partition keys before the data is produced,
+// so that the search never prunes the partitions containing the data.
+func updatePartitionKeyStats(ctx context.Context,
+	insertMsg *msgstream.InsertMsg,
+	partitionKeys *schemapb.FieldData,
+	partitionNames []string,
+	hashValues []uint32,
+) error {
+	keyBuckets, err := typeutil.HashKey2PartitionBuckets(partitionKeys, partitionNames)
+	if err != nil {
+		return err
+	}
+	partition2Buckets := make(map[string]typeutil.Set[uint32])
+	for idx, bucket := range keyBuckets {
+		partitionName := partitionNames[hashValues[idx]]
+		if _, ok := partition2Buckets[partitionName]; !ok {
+			partition2Buckets[partitionName] = typeutil.NewSet[uint32]()
+		}
+		partition2Buckets[partitionName].Insert(bucket)
+	}
+	buckets := make(map[string][]uint32, len(partition2Buckets))
+	for partitionName, bucketSet := range partition2Buckets {
+		buckets[partitionName] = bucketSet.Collect()
+	}
+	return globalMetaCache.UpdatePartitionKeyStats(ctx, insertMsg.GetDbName(), insertMsg.GetCollectionName(), buckets)
+}
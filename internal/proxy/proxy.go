@@ -91,6 +91,8 @@ type Proxy struct {
 	queryCoord types.QueryCoordClient
 
 	simpleLimiter *SimpleLimiter
+	insertShaper  *insertShaper
+	describeCache *describeCache
 
 	chMgr channelsMgr
 
@@ -120,6 +122,9 @@ type Proxy struct {
 	// for load balance in replicas
 	lbPolicy LBPolicy
 
+	// the cursors of the query iterators shared by the proxies
+	queryCursorStore queryCursorStore
+
 	// resource manager
 	resourceManager        resource.Manager
 	replicateStreamManager *ReplicateStreamManager
@@ -148,6 +153,8 @@ func NewProxy(ctx context.Context, factory dependency.Factory) (*Proxy, error) {
 		searchResultCh:         make(chan *internalpb.SearchResults, n),
 		shardMgr:               mgr,
 		simpleLimiter:          NewSimpleLimiter(Params.QuotaConfig.AllocWaitInterval.GetAsDuration(time.Millisecond), Params.QuotaConfig.AllocRetryTimes.GetAsUint()),
+		insertShaper:           newInsertShaper(),
+		describeCache:          newDescribeCache(),
 		lbPolicy:               lbPolicy,
 		resourceManager:        resourceManager,
 		replicateStreamManager: replicateStreamManager,
@@ -297,12 +304,52 @@ func (node *Proxy) Init() error {
 	}
 	log.Debug("init meta cache done", zap.String("role", typeutil.ProxyRole))
 
+	if Params.ProxyCfg.MetaCacheWarmupEnabled.GetAsBool() {
+		// the collections are still described on the first access if the warm up fails, so it's not fatal
+		if err := globalMetaCache.RefreshMetaSnapshot(node.ctx); err != nil {
+			log.Warn("failed to warm up meta cache", zap.String("role", typeutil.ProxyRole), zap.Error(err))
+		} else {
+			log.Debug("warm up meta cache done", zap.String("role", typeutil.ProxyRole))
+		}
+	}
+
 	node.enableMaterializedView = Params.CommonCfg.EnableMaterializedView.GetAsBool()
 
+	if node.etcdCli != nil {
+		node.queryCursorStore = newEtcdQueryCursorStore(node.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue())
+	}
+
 	log.Info("init proxy done", zap.Int64("nodeID", paramtable.GetNodeID()), zap.String("Address", node.address))
 	return nil
 }
 
+// refreshMetaSnapshotLoop starts a goroutine that caches the collections created since the last meta snapshot periodically.
+func (node *Proxy) refreshMetaSnapshotLoop() {
+	interval := Params.ProxyCfg.MetaCacheRefreshInterval.GetAsDuration(time.Second)
+	if !Params.ProxyCfg.MetaCacheWarmupEnabled.GetAsBool() || interval <= 0 {
+		return
+	}
+
+	node.wg.Add(1)
+	go func() {
+		defer node.wg.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-node.ctx.Done():
+				log.Info("refresh meta snapshot loop exit")
+				return
+			case <-ticker.C:
+				if err := globalMetaCache.RefreshMetaSnapshot(node.ctx); err != nil {
+					log.Warn("failed to refresh meta snapshot", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
 // sendChannelsTimeTickLoop starts a goroutine that synchronizes the time tick information.
 func (node *Proxy) sendChannelsTimeTickLoop() {
 	node.wg.Add(1)
@@ -413,6 +460,7 @@ func (node *Proxy) Start() error {
 	log.Debug("start channels time ticker done", zap.String("role", typeutil.ProxyRole))
 
 	node.sendChannelsTimeTickLoop()
+	node.refreshMetaSnapshotLoop()
 
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
@@ -0,0 +1,221 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	// IteratorCursorKey is the query param of the cursor token of the query iterator, the empty value starts a new cursor.
+	// The token of the cursor is returned in the extra info of the response status with the same key,
+	// the next page is queried by the token on any proxy.
+	IteratorCursorKey = "iterator_cursor"
+
+	queryCursorPrefix = "proxy/query-cursor"
+
+	queryCursorTokenLen = 16
+)
+
+// queryCursor is the state of a query iterator resumable across the proxies.
+// The pages are queried on the snapshot of the channels at the mvcc timestamp of the first page,
+// each page continues from the last primary key of the previous page.
+type queryCursor struct {
+	// User and DbName are the owner of the cursor, the cursor is only resumable by the same user in the same database.
+	User         string `json:"user"`
+	DbName       string `json:"db_name"`
+	CollectionID int64  `json:"collection_id"`
+	// CollectionVersion is the created timestamp of the collection, the cursor is invalid if the collection is recreated.
+	CollectionVersion uint64  `json:"collection_version"`
+	MvccTimestamp     uint64  `json:"mvcc_timestamp"`
+	LastIntPK         *int64  `json:"last_int_pk,omitempty"`
+	LastStrPK         *string `json:"last_str_pk,omitempty"`
+	// LeaseID is the lease of the cursor in the store, it's kept alive by each page.
+	LeaseID int64 `json:"lease_id,omitempty"`
+}
+
+// newQueryCursorToken returns a random token of a new cursor, which can't be guessed by the other users.
+func newQueryCursorToken() (string, error) {
+	buf := make([]byte, queryCursorTokenLen)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// ownedBy returns whether the cursor is created by the user in the database.
+func (c *queryCursor) ownedBy(user, dbName string) bool {
+	return c.User == user && c.DbName == dbName
+}
+
+// pkExpr returns the expr of the primary keys after the last primary key of the cursor combined with the expr,
+// the expr is returned as is if the cursor has no page yet.
+func (c *queryCursor) pkExpr(pkField string, expr string) string {
+	var cond string
+	switch {
+	case c.LastIntPK != nil:
+		cond = fmt.Sprintf("%s > %d", pkField, *c.LastIntPK)
+	case c.LastStrPK != nil:
+		cond = fmt.Sprintf("%s > %s", pkField, strconv.Quote(*c.LastStrPK))
+	default:
+		return expr
+	}
+	if expr == "" {
+		return cond
+	}
+	return fmt.Sprintf("(%s) and %s", expr, cond)
+}
+
+// advance moves the cursor to the last primary key of the page, the results are ordered by the primary keys.
+// It returns false if the page is empty, which means the iterator is exhausted.
+func (c *queryCursor) advance(pkField *schemapb.FieldSchema, fieldsData []*schemapb.FieldData) bool {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() != pkField.GetFieldID() {
+			continue
+		}
+		switch pkField.GetDataType() {
+		case schemapb.DataType_Int64:
+			data := fieldData.GetScalars().GetLongData().GetData()
+			if len(data) == 0 {
+				return false
+			}
+			c.LastIntPK = &data[len(data)-1]
+			return true
+		case schemapb.DataType_VarChar:
+			data := fieldData.GetScalars().GetStringData().GetData()
+			if len(data) == 0 {
+				return false
+			}
+			c.LastStrPK = &data[len(data)-1]
+			return true
+		}
+	}
+	return false
+}
+
+// queryCursorStore persists the query cursors shared by the proxies.
+type queryCursorStore interface {
+	Load(ctx context.Context, token string) (*queryCursor, error)
+	Save(ctx context.Context, token string, cursor *queryCursor) error
+	Remove(ctx context.Context, token string, cursor *queryCursor) error
+}
+
+// etcdQueryCursorStore keeps the cursors in etcd with the lease of proxy.queryCursorTTL, one lease per cursor
+// refreshed by each page, the abandoned cursors expire with their leases.
+type etcdQueryCursorStore struct {
+	cli      *clientv3.Client
+	rootPath string
+}
+
+func newEtcdQueryCursorStore(cli *clientv3.Client, rootPath string) *etcdQueryCursorStore {
+	return &etcdQueryCursorStore{
+		cli:      cli,
+		rootPath: rootPath,
+	}
+}
+
+func (s *etcdQueryCursorStore) key(token string) string {
+	return path.Join(s.rootPath, queryCursorPrefix, token)
+}
+
+func (s *etcdQueryCursorStore) Load(ctx context.Context, token string) (*queryCursor, error) {
+	resp, err := s.cli.Get(ctx, s.key(token))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("the iterator cursor %s is not found or expired", token)
+	}
+	cursor := &queryCursor{}
+	if err := json.Unmarshal(resp.Kvs[0].Value, cursor); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+func (s *etcdQueryCursorStore) Save(ctx context.Context, token string, cursor *queryCursor) error {
+	value, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	leaseID, err := s.keepAlive(ctx, cursor)
+	if err != nil {
+		return err
+	}
+	_, err = s.cli.Put(ctx, s.key(token), string(value), clientv3.WithLease(leaseID))
+	return err
+}
+
+// keepAlive refreshes the lease of the cursor, a new lease is granted for the new cursor or the expired lease.
+// The lease ID is recorded in the cursor before it's saved.
+func (s *etcdQueryCursorStore) keepAlive(ctx context.Context, cursor *queryCursor) (clientv3.LeaseID, error) {
+	if cursor.LeaseID != 0 {
+		_, err := s.cli.KeepAliveOnce(ctx, clientv3.LeaseID(cursor.LeaseID))
+		if err == nil {
+			return clientv3.LeaseID(cursor.LeaseID), nil
+		}
+		if !errors.Is(err, v3rpc.ErrLeaseNotFound) {
+			return 0, err
+		}
+	}
+	ttl := paramtable.Get().ProxyCfg.QueryCursorTTL.GetAsDuration(time.Second)
+	lease, err := s.cli.Grant(ctx, int64(ttl.Seconds()))
+	if err != nil {
+		return 0, err
+	}
+	cursor.LeaseID = int64(lease.ID)
+	return lease.ID, nil
+}
+
+// Remove revokes the lease of the cursor, which removes the cursor as well.
+func (s *etcdQueryCursorStore) Remove(ctx context.Context, token string, cursor *queryCursor) error {
+	if cursor.LeaseID != 0 {
+		_, err := s.cli.Revoke(ctx, clientv3.LeaseID(cursor.LeaseID))
+		if err == nil || !errors.Is(err, v3rpc.ErrLeaseNotFound) {
+			return err
+		}
+	}
+	_, err := s.cli.Delete(ctx, s.key(token))
+	return err
+}
+
+// annotateCursor returns the token of the cursor in the extra info of the response status.
+func annotateCursor(status *commonpb.Status, token string) {
+	if token == "" || status == nil {
+		return
+	}
+	if status.ExtraInfo == nil {
+		status.ExtraInfo = make(map[string]string)
+	}
+	status.ExtraInfo[IteratorCursorKey] = token
+}
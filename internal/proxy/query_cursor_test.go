@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/testutils"
+)
+
+func TestQueryCursor_PKExpr(t *testing.T) {
+	cursor := &queryCursor{}
+	assert.Equal(t, "a > 1", cursor.pkExpr("id", "a > 1"))
+	assert.Equal(t, "", cursor.pkExpr("id", ""))
+
+	pk := int64(10)
+	cursor.LastIntPK = &pk
+	assert.Equal(t, "id > 10", cursor.pkExpr("id", ""))
+	assert.Equal(t, "(a > 1 or b < 2) and id > 10", cursor.pkExpr("id", "a > 1 or b < 2"))
+
+	strPK := `x"y`
+	cursor = &queryCursor{LastStrPK: &strPK}
+	assert.Equal(t, `(a > 1) and id > "x\"y"`, cursor.pkExpr("id", "a > 1"))
+}
+
+func TestQueryCursor_Advance(t *testing.T) {
+	intPK := &schemapb.FieldSchema{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	strPK := &schemapb.FieldSchema{FieldID: 100, Name: "id", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+
+	cursor := &queryCursor{}
+	assert.False(t, cursor.advance(intPK, nil))
+	assert.False(t, cursor.advance(intPK, []*schemapb.FieldData{testutils.GenerateScalarFieldData(schemapb.DataType_Int64, "id", 0)}))
+
+	fieldsData := []*schemapb.FieldData{
+		testutils.GenerateScalarFieldData(schemapb.DataType_Int64, "a", 3),
+		testutils.GenerateScalarFieldData(schemapb.DataType_Int64, "id", 3),
+	}
+	fieldsData[0].FieldId = 101
+	fieldsData[1].FieldId = 100
+	fieldsData[1].GetScalars().GetLongData().Data = []int64{1, 5, 8}
+	assert.True(t, cursor.advance(intPK, fieldsData))
+	assert.Equal(t, int64(8), *cursor.LastIntPK)
+	assert.Equal(t, "id > 8", cursor.pkExpr("id", ""))
+
+	cursor = &queryCursor{}
+	fieldsData = []*schemapb.FieldData{testutils.GenerateScalarFieldData(schemapb.DataType_VarChar, "id", 2)}
+	fieldsData[0].FieldId = 100
+	fieldsData[0].GetScalars().GetStringData().Data = []string{"a", "b"}
+	assert.True(t, cursor.advance(strPK, fieldsData))
+	assert.Equal(t, "b", *cursor.LastStrPK)
+}
+
+func TestAnnotateCursor(t *testing.T) {
+	annotateCursor(nil, "1")
+
+	status := merr.Success()
+	annotateCursor(status, "")
+	assert.Empty(t, status.GetExtraInfo())
+
+	annotateCursor(status, "1")
+	assert.Equal(t, "1", status.GetExtraInfo()[IteratorCursorKey])
+}
+
+func TestEtcdQueryCursorStore(t *testing.T) {
+	paramtable.Init()
+	cli, err := etcd.GetEtcdClient(
+		Params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		Params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		Params.EtcdCfg.Endpoints.GetAsStrings(),
+		Params.EtcdCfg.EtcdTLSCert.GetValue(),
+		Params.EtcdCfg.EtcdTLSKey.GetValue(),
+		Params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		Params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	assert.NoError(t, err)
+	defer cli.Close()
+
+	ctx := context.Background()
+	store := newEtcdQueryCursorStore(cli, "/test/query-cursor/"+funcutil.RandomString(8))
+	_, err = store.Load(ctx, "1")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	pk := int64(10)
+	cursor := &queryCursor{CollectionID: 1, CollectionVersion: 100, MvccTimestamp: 1000, LastIntPK: &pk}
+	err = store.Save(ctx, "1", cursor)
+	assert.NoError(t, err)
+
+	assert.NotZero(t, cursor.LeaseID)
+
+	// the cursor is resumed by another proxy from the store
+	loaded, err := newEtcdQueryCursorStore(cli, store.rootPath).Load(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, loaded)
+
+	resp, err := cli.Get(ctx, store.key("1"))
+	assert.NoError(t, err)
+	assert.Equal(t, cursor.LeaseID, resp.Kvs[0].Lease)
+
+	// the next page refreshes the lease of the cursor instead of granting a new one
+	err = store.Save(ctx, "1", loaded)
+	assert.NoError(t, err)
+	assert.Equal(t, cursor.LeaseID, loaded.LeaseID)
+	resp, err = cli.Get(ctx, store.key("1"))
+	assert.NoError(t, err)
+	assert.Equal(t, cursor.LeaseID, resp.Kvs[0].Lease)
+
+	// a new lease is granted once the lease expires
+	_, err = cli.Revoke(ctx, clientv3.LeaseID(cursor.LeaseID))
+	assert.NoError(t, err)
+	err = store.Save(ctx, "1", loaded)
+	assert.NoError(t, err)
+	assert.NotEqual(t, cursor.LeaseID, loaded.LeaseID)
+
+	err = store.Remove(ctx, "1", loaded)
+	assert.NoError(t, err)
+	_, err = store.Load(ctx, "1")
+	assert.Error(t, err)
+	ttlResp, err := cli.TimeToLive(ctx, clientv3.LeaseID(loaded.LeaseID))
+	assert.NoError(t, err)
+	assert.EqualValues(t, -1, ttlResp.TTL)
+}
+
+func TestQueryCursor_Token(t *testing.T) {
+	token, err := newQueryCursorToken()
+	assert.NoError(t, err)
+	assert.Len(t, token, 2*queryCursorTokenLen)
+	another, err := newQueryCursorToken()
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, another)
+
+	cursor := &queryCursor{User: "alice", DbName: "db1"}
+	assert.True(t, cursor.ownedBy("alice", "db1"))
+	assert.False(t, cursor.ownedBy("bob", "db1"))
+	assert.False(t, cursor.ownedBy("alice", "default"))
+}
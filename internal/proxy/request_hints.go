@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// RequestTimeoutKey is the hint of the timeout in milliseconds of the request.
+	RequestTimeoutKey = "request_timeout"
+	// RetryBudgetKey is the hint of the max times to try each shard of the request.
+	RetryBudgetKey = "retry_budget"
+	// AllowPartialResultsKey is the hint to return the results of the responded shards
+	// instead of failing the request when some shards time out or less than half of the shards fail.
+	AllowPartialResultsKey = "allow_partial_results"
+	// PartialResultKey annotates the extra info of the response status whether the result is partial.
+	PartialResultKey = "partial_result"
+	// PartialShardsKey annotates the extra info of the response status with the shards missing in the partial result.
+	PartialShardsKey = "partial_shards"
+	// RequestPriorityKey is the hint of the priority of the request, online or batch,
+	// the iterator requests are batch ones if not set, see proxy.batchResourceGroups.
+	RequestPriorityKey    = "request_priority"
+	RequestPriorityOnline = "online"
+	RequestPriorityBatch  = "batch"
+
+	// shardDeadlineRatio is the ratio of the request timeout to bound the shards with partial results allowed,
+	// the rest is left to reduce the results of the responded shards.
+	shardDeadlineRatio = 0.8
+)
+
+// requestHints are the timeout and retry policy attached by the client in the common params of the request,
+// the zero value means no hint.
+type requestHints struct {
+	deadline      time.Time
+	shardDeadline time.Time
+	retryBudget   uint
+	allowPartial  bool
+	batch         bool
+}
+
+// parseRequestHints parses the hints from the properties of the msg base and the params of the request,
+// the params take precedence over the properties. The deadlines are counted from now.
+func parseRequestHints(base *commonpb.MsgBase, params []*commonpb.KeyValuePair) (requestHints, error) {
+	hints := requestHints{}
+	values := make(map[string]string)
+	for key, value := range base.GetProperties() {
+		values[key] = value
+	}
+	for _, kv := range params {
+		values[kv.GetKey()] = kv.GetValue()
+	}
+
+	if value, ok := values[RequestTimeoutKey]; ok {
+		timeoutMs, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || timeoutMs <= 0 {
+			return hints, merr.WrapErrParameterInvalidMsg("%s should be a positive integer in milliseconds, but got %s", RequestTimeoutKey, value)
+		}
+		timeout := time.Duration(timeoutMs) * time.Millisecond
+		now := time.Now()
+		hints.deadline = now.Add(timeout)
+		hints.shardDeadline = now.Add(time.Duration(float64(timeout) * shardDeadlineRatio))
+	}
+	if value, ok := values[RetryBudgetKey]; ok {
+		budget, err := strconv.ParseUint(value, 10, 32)
+		if err != nil || budget == 0 {
+			return hints, merr.WrapErrParameterInvalidMsg("%s should be a positive integer, but got %s", RetryBudgetKey, value)
+		}
+		hints.retryBudget = uint(budget)
+	}
+	if value, ok := values[AllowPartialResultsKey]; ok {
+		allowPartial, err := strconv.ParseBool(value)
+		if err != nil {
+			return hints, merr.WrapErrParameterInvalidMsg("%s should be a bool, but got %s", AllowPartialResultsKey, value)
+		}
+		hints.allowPartial = allowPartial
+	}
+	if value, ok := values[RequestPriorityKey]; ok {
+		switch strings.ToLower(value) {
+		case RequestPriorityOnline:
+		case RequestPriorityBatch:
+			hints.batch = true
+		default:
+			return hints, merr.WrapErrParameterInvalidMsg("%s should be %s or %s, but got %s", RequestPriorityKey, RequestPriorityOnline, RequestPriorityBatch, value)
+		}
+	} else if value, ok := values[IteratorField]; ok {
+		hints.batch, _ = strconv.ParseBool(value)
+	}
+	return hints, nil
+}
+
+// withDeadline bounds the ctx by the timeout hint, the deadline is propagated to the downstream calls with the ctx.
+func (h requestHints) withDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if h.deadline.IsZero() {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, h.deadline)
+}
+
+// withShardDeadline bounds the execution on a shard earlier than the request if partial results are allowed,
+// to leave the time to reduce the results of the responded shards.
+func (h requestHints) withShardDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if !h.allowPartial || h.shardDeadline.IsZero() {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, h.shardDeadline)
+}
+
+// isPartial checks whether the failed shard can be skipped as partial results,
+// only the shard timed out by its own deadline while the request is still alive is skipped.
+func (h requestHints) isPartial(ctx, shardCtx context.Context) bool {
+	return h.allowPartial && ctx.Err() == nil && shardCtx.Err() == context.DeadlineExceeded
+}
+
+// annotatePartialResult annotates the status of the response whether the result is partial and the missing shards
+// if partial results are allowed.
+func (h requestHints) annotatePartialResult(status *commonpb.Status, partialChannels *typeutil.ConcurrentSet[string]) {
+	if !h.allowPartial || status == nil {
+		return
+	}
+	if status.ExtraInfo == nil {
+		status.ExtraInfo = make(map[string]string)
+	}
+	var channels []string
+	if partialChannels != nil {
+		channels = partialChannels.Collect()
+	}
+	status.ExtraInfo[PartialResultKey] = strconv.FormatBool(len(channels) > 0)
+	if len(channels) > 0 {
+		sort.Strings(channels)
+		status.ExtraInfo[PartialShardsKey] = strings.Join(channels, ",")
+	}
+}
+
+// failedChannels returns the set to collect the failed channels of the workload if partial results are allowed.
+func (h requestHints) failedChannels(partialChannels *typeutil.ConcurrentSet[string]) *typeutil.ConcurrentSet[string] {
+	if !h.allowPartial {
+		return nil
+	}
+	return partialChannels
+}
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestParseRequestHints(t *testing.T) {
+	t.Run("no hint", func(t *testing.T) {
+		hints, err := parseRequestHints(nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, requestHints{}, hints)
+
+		ctx, cancel := hints.withDeadline(context.Background())
+		defer cancel()
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+	})
+
+	t.Run("hints in properties and params", func(t *testing.T) {
+		base := &commonpb.MsgBase{Properties: map[string]string{
+			RequestTimeoutKey: "1000",
+			RetryBudgetKey:    "5",
+		}}
+		params := []*commonpb.KeyValuePair{
+			{Key: RetryBudgetKey, Value: "2"},
+			{Key: AllowPartialResultsKey, Value: "true"},
+		}
+		hints, err := parseRequestHints(base, params)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, hints.retryBudget)
+		assert.True(t, hints.allowPartial)
+		assert.True(t, hints.shardDeadline.Before(hints.deadline))
+
+		ctx, cancel := hints.withDeadline(context.Background())
+		defer cancel()
+		deadline, ok := ctx.Deadline()
+		assert.True(t, ok)
+		assert.Equal(t, hints.deadline, deadline)
+
+		shardCtx, shardCancel := hints.withShardDeadline(ctx)
+		defer shardCancel()
+		deadline, ok = shardCtx.Deadline()
+		assert.True(t, ok)
+		assert.Equal(t, hints.shardDeadline, deadline)
+	})
+
+	t.Run("request priority", func(t *testing.T) {
+		hints, err := parseRequestHints(nil, []*commonpb.KeyValuePair{{Key: RequestPriorityKey, Value: "Batch"}})
+		assert.NoError(t, err)
+		assert.True(t, hints.batch)
+
+		// the iterators are batch requests by default
+		hints, err = parseRequestHints(nil, []*commonpb.KeyValuePair{{Key: IteratorField, Value: "True"}})
+		assert.NoError(t, err)
+		assert.True(t, hints.batch)
+
+		hints, err = parseRequestHints(nil, []*commonpb.KeyValuePair{
+			{Key: IteratorField, Value: "true"},
+			{Key: RequestPriorityKey, Value: RequestPriorityOnline},
+		})
+		assert.NoError(t, err)
+		assert.False(t, hints.batch)
+	})
+
+	t.Run("invalid hints", func(t *testing.T) {
+		for _, kv := range []*commonpb.KeyValuePair{
+			{Key: RequestTimeoutKey, Value: "abc"},
+			{Key: RequestTimeoutKey, Value: "-1"},
+			{Key: RetryBudgetKey, Value: "0"},
+			{Key: AllowPartialResultsKey, Value: "abc"},
+			{Key: RequestPriorityKey, Value: "urgent"},
+		} {
+			_, err := parseRequestHints(nil, []*commonpb.KeyValuePair{kv})
+			assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		}
+	})
+}
+
+func TestRequestHints_Partial(t *testing.T) {
+	ctx := context.Background()
+	hints := requestHints{
+		deadline:      time.Now().Add(time.Hour),
+		shardDeadline: time.Now().Add(-time.Second),
+		allowPartial:  true,
+	}
+
+	shardCtx, cancel := hints.withShardDeadline(ctx)
+	defer cancel()
+	<-shardCtx.Done()
+	assert.True(t, hints.isPartial(ctx, shardCtx))
+
+	canceledCtx, cancel2 := context.WithCancel(ctx)
+	cancel2()
+	assert.False(t, hints.isPartial(canceledCtx, shardCtx))
+	assert.False(t, requestHints{}.isPartial(ctx, shardCtx))
+
+	partialChannels := typeutil.NewConcurrentSet[string]()
+	status := merr.Success()
+	hints.annotatePartialResult(status, partialChannels)
+	assert.Equal(t, "false", status.GetExtraInfo()[PartialResultKey])
+
+	partialChannels.Insert("channel2")
+	partialChannels.Insert("channel1")
+	hints.annotatePartialResult(status, partialChannels)
+	assert.Equal(t, "true", status.GetExtraInfo()[PartialResultKey])
+	assert.Equal(t, "channel1,channel2", status.GetExtraInfo()[PartialShardsKey])
+
+	assert.Equal(t, partialChannels, hints.failedChannels(partialChannels))
+	assert.Nil(t, requestHints{}.failedChannels(partialChannels))
+
+	status = merr.Success()
+	requestHints{}.annotatePartialResult(status, partialChannels)
+	assert.NotContains(t, status.GetExtraInfo(), PartialResultKey)
+}
+
+func TestRequestHints_HybridSearch(t *testing.T) {
+	node := &Proxy{}
+	_ = node.initRateCollector()
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	// the hints in the rank params are parsed as the search ones
+	resp, err := node.HybridSearch(context.Background(), &milvuspb.HybridSearchRequest{
+		CollectionName: "coll",
+		RankParams:     []*commonpb.KeyValuePair{{Key: RetryBudgetKey, Value: "0"}},
+	})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+}
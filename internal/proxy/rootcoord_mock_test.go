@@ -1122,6 +1122,22 @@ func (coord *RootCoordMock) DescribeDatabase(ctx context.Context, in *rootcoordp
 	return &rootcoordpb.DescribeDatabaseResponse{}, nil
 }
 
+func (coord *RootCoordMock) GetDropCollectionProgress(ctx context.Context, in *rootcoordpb.GetDropCollectionProgressRequest, opts ...grpc.CallOption) (*rootcoordpb.GetDropCollectionProgressResponse, error) {
+	return &rootcoordpb.GetDropCollectionProgressResponse{}, nil
+}
+
+func (coord *RootCoordMock) UpdatePartitionKeyStats(ctx context.Context, in *rootcoordpb.UpdatePartitionKeyStatsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *RootCoordMock) GetPartitionKeyStats(ctx context.Context, in *rootcoordpb.GetPartitionKeyStatsRequest, opts ...grpc.CallOption) (*rootcoordpb.GetPartitionKeyStatsResponse, error) {
+	return &rootcoordpb.GetPartitionKeyStatsResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) GetMetaSnapshot(ctx context.Context, in *rootcoordpb.GetMetaSnapshotRequest, opts ...grpc.CallOption) (*rootcoordpb.GetMetaSnapshotResponse, error) {
+	return &rootcoordpb.GetMetaSnapshotResponse{Status: merr.Success()}, nil
+}
+
 func (coord *RootCoordMock) AlterDatabase(ctx context.Context, in *rootcoordpb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, nil
 }
@@ -291,21 +291,17 @@ func reduceSearchResultDataNoGroupBy(ctx context.Context, subSearchResultData []
 		// printSearchResultData(sData, strconv.FormatInt(int64(i), 10))
 	}
 
-	var (
-		subSearchNum = len(subSearchResultData)
-		// for results of each subSearchResultData, storing the start offset of each query of nq queries
-		subSearchNqOffset = make([][]int64, subSearchNum)
-	)
-	for i := 0; i < subSearchNum; i++ {
-		subSearchNqOffset[i] = make([]int64, subSearchResultData[i].GetNumQueries())
-		for j := int64(1); j < nq; j++ {
-			subSearchNqOffset[i][j] = subSearchNqOffset[i][j-1] + subSearchResultData[i].Topks[j-1]
-		}
+	if err := checkSearchResultEntries(subSearchResultData); err != nil {
+		log.Ctx(ctx).Warn("search results are too large to reduce", zap.Error(err))
+		return nil, err
 	}
 
 	var (
 		skipDupCnt int64
 		realTopK   int64 = -1
+		// merger picks the highest score of all sub results with a k-way heap,
+		// only one candidate per sub result is buffered at any time.
+		merger = typeutil.NewSearchResultMerger(subSearchResultData, nq)
 	)
 
 	var retSize int64
@@ -314,22 +310,17 @@ func reduceSearchResultDataNoGroupBy(ctx context.Context, subSearchResultData []
 	// reducing nq * topk results
 	for i := int64(0); i < nq; i++ {
 		var (
-			// cursor of current data of each subSearch for merging the j-th data of TopK.
-			// sum(cursors) == j
-			cursors = make([]int64, subSearchNum)
-
 			j     int64
 			idSet = make(map[interface{}]struct{}, limit)
 		)
+		merger.Reset(i)
 
 		// skip offset results
 		for k := int64(0); k < offset; k++ {
-			subSearchIdx, _ := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			subSearchIdx, _ := merger.Next()
 			if subSearchIdx == -1 {
 				break
 			}
-
-			cursors[subSearchIdx]++
 		}
 
 		// keep limit results
@@ -337,7 +328,7 @@ func reduceSearchResultDataNoGroupBy(ctx context.Context, subSearchResultData []
 			// From all the sub-query result sets of the i-th query vector,
 			//   find the sub-query result set index of the score j-th data,
 			//   and the index of the data in schemapb.SearchResultData
-			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			subSearchIdx, resultDataIdx := merger.Next()
 			if subSearchIdx == -1 {
 				break
 			}
@@ -351,11 +342,15 @@ func reduceSearchResultDataNoGroupBy(ctx context.Context, subSearchResultData []
 				ret.Results.Scores = append(ret.Results.Scores, score)
 				idSet[id] = struct{}{}
 				j++
+				// limit search result to avoid oom, fail fast instead of finishing the whole query
+				if retSize > maxOutputSize {
+					return nil, merr.WrapErrParameterTooLarge("search result",
+						fmt.Sprintf("search results exceed the maxOutputSize Limit %d", maxOutputSize))
+				}
 			} else {
 				// skip entity with same id
 				skipDupCnt++
 			}
-			cursors[subSearchIdx]++
 		}
 		if realTopK != -1 && realTopK != j {
 			log.Ctx(ctx).Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
@@ -363,11 +358,6 @@ func reduceSearchResultDataNoGroupBy(ctx context.Context, subSearchResultData []
 		}
 		realTopK = j
 		ret.Results.Topks = append(ret.Results.Topks, realTopK)
-
-		// limit search result to avoid oom
-		if retSize > maxOutputSize {
-			return nil, fmt.Errorf("search results exceed the maxOutputSize Limit %d", maxOutputSize)
-		}
 	}
 	log.Ctx(ctx).Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
 
@@ -505,6 +495,19 @@ func rankSearchResultData(ctx context.Context,
 	return ret, nil
 }
 
+// checkSearchResultEntries rejects the reduce before merging if the sub results carry more entries
+// than quotaAndLimits.limits.maxSearchResultEntries allows.
+func checkSearchResultEntries(subSearchResultData []*schemapb.SearchResultData) error {
+	maxEntries := paramtable.Get().QuotaConfig.MaxSearchResultEntries.GetAsInt64()
+	if maxEntries <= 0 {
+		return nil
+	}
+	if entries := typeutil.CountSearchResultEntries(subSearchResultData); entries > maxEntries {
+		return merr.WrapErrSearchResultEntriesTooLarge(entries, maxEntries)
+	}
+	return nil
+}
+
 func fillInEmptyResult(numQueries int64) *milvuspb.SearchResults {
 	return &milvuspb.SearchResults{
 		Status: merr.Success("search result is empty"),
@@ -0,0 +1,236 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// searchVerificationTimeout is the timeout of re-executing a sampled search by brute force.
+const searchVerificationTimeout = time.Minute
+
+// runningSearchVerifications is the number of the verifications running on the proxy.
+var runningSearchVerifications atomic.Int32
+
+// shouldVerify samples the searches to verify by brute force, the hybrid searches, the searches grouping by field
+// and the searches returning partial results are never verified.
+func (t *searchTask) shouldVerify() bool {
+	rate := paramtable.Get().ProxyCfg.SearchVerificationSampleRate.GetAsFloat()
+	if rate <= 0 || rand.Float64() >= rate {
+		return false
+	}
+	if t.SearchRequest.GetIsAdvanced() || t.allPartitionsPruned || len(t.queryInfos) != 1 ||
+		t.queryInfos[0].GetGroupByFieldId() > 0 {
+		return false
+	}
+	return t.partialChannels == nil || len(t.partialChannels.Collect()) == 0
+}
+
+// verifyInBackground re-executes the search by brute force on the same data in background,
+// and compares the results with the returned ones. It's skipped if too many verifications are running.
+func (t *searchTask) verifyInBackground(ctx context.Context) {
+	if int(runningSearchVerifications.Inc()) > paramtable.Get().ProxyCfg.SearchVerificationMaxRunning.GetAsInt() {
+		runningSearchVerifications.Dec()
+		return
+	}
+	// the returned results are copied as they are handed over to the caller
+	actual := &schemapb.SearchResultData{
+		Ids:    proto.Clone(t.result.GetResults().GetIds()).(*schemapb.IDs),
+		Scores: append([]float32{}, t.result.GetResults().GetScores()...),
+		Topks:  append([]int64{}, t.result.GetResults().GetTopks()...),
+	}
+	// the verification outlives the search, only the logger with the trace of the search is kept
+	logger := log.Ctx(ctx)
+	go func() {
+		defer runningSearchVerifications.Dec()
+		ctx, cancel := context.WithTimeout(context.Background(), searchVerificationTimeout)
+		defer cancel()
+		t.verify(ctx, logger, actual)
+	}()
+}
+
+func (t *searchTask) verify(ctx context.Context, logger *log.MLogger, actual *schemapb.SearchResultData) {
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	log := logger.With(zap.Int64("collectionID", t.GetCollectionID()), zap.String("collectionName", t.collectionName),
+		zap.Int64("nq", t.GetNq()), zap.Int64("topK", t.GetTopk()))
+
+	expected, err := t.searchByBruteForce(ctx)
+	if err != nil {
+		log.Warn("failed to verify the search by brute force", zap.Error(err))
+		metrics.ProxySearchVerificationCount.WithLabelValues(nodeID, t.collectionName, metrics.FailLabel).Inc()
+		return
+	}
+
+	recalls := searchRecalls(actual, expected.GetResults())
+	worst, sum := 0, 0.0
+	for i, recall := range recalls {
+		sum += recall
+		if recall < recalls[worst] {
+			worst = i
+		}
+	}
+	if len(recalls) == 0 {
+		metrics.ProxySearchVerificationCount.WithLabelValues(nodeID, t.collectionName, metrics.ConsistentLabel).Inc()
+		return
+	}
+	metrics.ProxySearchVerificationRecall.WithLabelValues(nodeID, t.collectionName).Observe(sum / float64(len(recalls)))
+	if recalls[worst] >= paramtable.Get().ProxyCfg.SearchVerificationRecall.GetAsFloat() {
+		metrics.ProxySearchVerificationCount.WithLabelValues(nodeID, t.collectionName, metrics.ConsistentLabel).Inc()
+		return
+	}
+	metrics.ProxySearchVerificationCount.WithLabelValues(nodeID, t.collectionName, metrics.DivergentLabel).Inc()
+	log.Warn("search results diverge from the brute force results",
+		zap.Int64s("partitionIDs", t.GetPartitionIDs()),
+		zap.Float64("avgRecall", sum/float64(len(recalls))),
+		zap.Float64s("recalls", recalls),
+		zap.Int("worstQuery", worst),
+		zap.Any("actualPKs", queryPKs(actual, worst)),
+		zap.Float32s("actualScores", queryScores(actual, worst)),
+		zap.Any("expectedPKs", queryPKs(expected.GetResults(), worst)),
+		zap.Float32s("expectedScores", queryScores(expected.GetResults(), worst)))
+}
+
+// searchByBruteForce re-executes the search by brute force, on the same data as the search by the mvcc timestamps
+// of the channels.
+func (t *searchTask) searchByBruteForce(ctx context.Context) (*milvuspb.SearchResults, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(t.SearchRequest.GetSerializedExprPlan(), plan); err != nil {
+		return nil, err
+	}
+	queryInfo := plan.GetVectorAnns().GetQueryInfo()
+	if queryInfo == nil {
+		return nil, errors.New("not a vector search plan")
+	}
+	queryInfo.ForceBruteForce = true
+	plan.OutputFieldIds = nil
+	serializedPlan, err := proto.Marshal(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	req := typeutil.Clone(t.SearchRequest)
+	req.SerializedExprPlan = serializedPlan
+	req.OutputFieldsId = nil
+	results := typeutil.NewConcurrentSet[*internalpb.SearchResults]()
+	err = t.lb.Execute(ctx, CollectionWorkLoad{
+		db:             t.request.GetDbName(),
+		collectionID:   t.SearchRequest.CollectionID,
+		collectionName: t.collectionName,
+		nq:             t.Nq,
+		exec: func(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channel string) error {
+			searchReq := typeutil.Clone(req)
+			searchReq.GetBase().TargetID = nodeID
+			if ts, ok := t.queryChannelsTs[channel]; ok {
+				searchReq.MvccTimestamp = ts
+			}
+			result, err := qn.Search(ctx, &querypb.SearchRequest{
+				Req:             searchReq,
+				DmlChannels:     []string{channel},
+				Scope:           querypb.DataScope_All,
+				TotalChannelNum: int32(1),
+			})
+			if err := merr.CheckRPCCall(result, err); err != nil {
+				return err
+			}
+			results.Insert(result)
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t.reduceResults(ctx, results.Collect(), t.Nq, t.GetTopk(), t.GetOffset(), t.queryInfos[0])
+}
+
+// searchRecalls returns the recall of the results of each query against the expected results by primary keys.
+// The result with the same score as the last expected one is taken as recalled, as any of the ties is correct.
+func searchRecalls(actual, expected *schemapb.SearchResultData) []float64 {
+	recalls := make([]float64, 0, len(expected.GetTopks()))
+	var actualOffset, expectedOffset int64
+	for i, expectedTopk := range expected.GetTopks() {
+		var actualTopk int64
+		if i < len(actual.GetTopks()) {
+			actualTopk = actual.GetTopks()[i]
+		}
+		if expectedTopk == 0 {
+			recalls = append(recalls, 1)
+			actualOffset += actualTopk
+			continue
+		}
+
+		pks := typeutil.NewSet[any]()
+		for j := expectedOffset; j < expectedOffset+expectedTopk; j++ {
+			pks.Insert(typeutil.GetPK(expected.GetIds(), j))
+		}
+		lastScore := expected.GetScores()[expectedOffset+expectedTopk-1]
+		hits := int64(0)
+		for j := actualOffset; j < actualOffset+actualTopk && hits < expectedTopk; j++ {
+			if pks.Contain(typeutil.GetPK(actual.GetIds(), j)) || actual.GetScores()[j] == lastScore {
+				hits++
+			}
+		}
+		recalls = append(recalls, float64(hits)/float64(expectedTopk))
+		actualOffset += actualTopk
+		expectedOffset += expectedTopk
+	}
+	return recalls
+}
+
+func queryPKs(data *schemapb.SearchResultData, query int) []any {
+	offset, topk := queryRange(data, query)
+	pks := make([]any, 0, topk)
+	for j := offset; j < offset+topk; j++ {
+		pks = append(pks, typeutil.GetPK(data.GetIds(), j))
+	}
+	return pks
+}
+
+func queryScores(data *schemapb.SearchResultData, query int) []float32 {
+	offset, topk := queryRange(data, query)
+	return data.GetScores()[offset : offset+topk]
+}
+
+func queryRange(data *schemapb.SearchResultData, query int) (int64, int64) {
+	if query >= len(data.GetTopks()) {
+		return 0, 0
+	}
+	var offset int64
+	for _, topk := range data.GetTopks()[:query] {
+		offset += topk
+	}
+	return offset, data.GetTopks()[query]
+}
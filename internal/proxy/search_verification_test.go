@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestSearchRecalls(t *testing.T) {
+	resultData := func(pks []int64, scores []float32, topks []int64) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			Ids:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			Scores: scores,
+			Topks:  topks,
+		}
+	}
+	expected := resultData([]int64{1, 2, 3, 4, 5, 6}, []float32{0.9, 0.8, 0.7, 0.9, 0.8, 0.8}, []int64{3, 3, 0})
+
+	// the second query returns a tie of the last expected result
+	actual := resultData([]int64{1, 3, 10, 4, 7}, []float32{0.9, 0.7, 0.6, 0.9, 0.8}, []int64{3, 2, 0})
+	assert.Equal(t, []float64{2.0 / 3, 2.0 / 3, 1}, searchRecalls(actual, expected))
+	assert.Equal(t, []float64{1, 1, 1}, searchRecalls(expected, expected))
+	assert.Equal(t, []float64{0, 0, 1}, searchRecalls(&schemapb.SearchResultData{}, expected))
+
+	assert.Equal(t, []any{int64(4), int64(7)}, queryPKs(actual, 1))
+	assert.Equal(t, []float32{0.9, 0.8}, queryScores(actual, 1))
+	assert.Empty(t, queryPKs(actual, 3))
+}
+
+func TestSearchTask_ShouldVerify(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	task := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{},
+		queryInfos:    []*planpb.QueryInfo{{Topk: 10}},
+	}
+	assert.False(t, task.shouldVerify())
+
+	params.Save(params.ProxyCfg.SearchVerificationSampleRate.Key, "1")
+	defer params.Reset(params.ProxyCfg.SearchVerificationSampleRate.Key)
+	assert.True(t, task.shouldVerify())
+
+	task.queryInfos[0].GroupByFieldId = 101
+	assert.False(t, task.shouldVerify())
+	task.queryInfos[0].GroupByFieldId = 0
+
+	task.SearchRequest.IsAdvanced = true
+	assert.False(t, task.shouldVerify())
+}
+
+func TestSearchTask_SearchByBruteForce(t *testing.T) {
+	paramtable.Init()
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{QueryInfo: &planpb.QueryInfo{Topk: 10}},
+		},
+		OutputFieldIds: []int64{101},
+	}
+	serializedPlan, err := proto.Marshal(plan)
+	assert.NoError(t, err)
+
+	qn := mocks.NewMockQueryNodeClient(t)
+	qn.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *querypb.SearchRequest, _ ...grpc.CallOption) (*internalpb.SearchResults, error) {
+			plan := &planpb.PlanNode{}
+			assert.NoError(t, proto.Unmarshal(req.GetReq().GetSerializedExprPlan(), plan))
+			assert.True(t, plan.GetVectorAnns().GetQueryInfo().GetForceBruteForce())
+			assert.Empty(t, plan.GetOutputFieldIds())
+			assert.Equal(t, uint64(1000), req.GetReq().GetMvccTimestamp())
+			return &internalpb.SearchResults{Status: merr.Success()}, nil
+		})
+	lb := NewMockLBPolicy(t)
+	lb.EXPECT().Execute(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, workload CollectionWorkLoad) error {
+		return workload.exec(ctx, 1, qn, "ch1")
+	})
+
+	task := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{
+			Base:               &commonpb.MsgBase{},
+			Nq:                 1,
+			Topk:               10,
+			SerializedExprPlan: serializedPlan,
+		},
+		request:         &milvuspb.SearchRequest{},
+		lb:              lb,
+		queryInfos:      []*planpb.QueryInfo{plan.GetVectorAnns().GetQueryInfo()},
+		queryChannelsTs: map[string]uint64{"ch1": 1000},
+	}
+	result, err := task.searchByBruteForce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0}, result.GetResults().GetTopks())
+}
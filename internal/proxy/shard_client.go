@@ -16,8 +16,9 @@ import (
 type queryNodeCreatorFunc func(ctx context.Context, addr string, nodeID int64) (types.QueryNodeClient, error)
 
 type nodeInfo struct {
-	nodeID  UniqueID
-	address string
+	nodeID        UniqueID
+	address       string
+	resourceGroup string // resource group of the replica the node serves as shard leader
 }
 
 func (n nodeInfo) String() string {
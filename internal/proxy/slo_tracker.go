@@ -0,0 +1,259 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/requestutil"
+)
+
+// sloBucketWidth is the time span of each bucket counting the requests of the collection SLO,
+// the compliance metrics are refreshed once a new bucket starts.
+const sloBucketWidth = 10 * time.Second
+
+// globalSLOTracker tracks the search and query requests served by this proxy, the SLO status is per proxy.
+var globalSLOTracker = newSLOTracker()
+
+// SLOInterceptor tracks the latency and the result of the search and query requests of the collections
+// with the SLO declared in the collection properties.
+func SLOInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		switch req.(type) {
+		case *milvuspb.SearchRequest, *milvuspb.HybridSearchRequest, *milvuspb.QueryRequest:
+		default:
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		globalSLOTracker.observeRequest(ctx, req.(reqCollName), resp, err, time.Since(start))
+		return resp, err
+	}
+}
+
+type sloBucket struct {
+	start  time.Time
+	total  int64
+	slow   int64 // the successful requests slower than the latency objective
+	failed int64
+}
+
+type collectionSLOStats struct {
+	dbName         string
+	collectionName string
+	slo            *common.CollectionSLO
+	buckets        []*sloBucket // in the order of start time, within the long window
+}
+
+// sloWindowStatus is the compliance of the SLO in a window, the compliance is the ratio of the good requests,
+// and the burn rate is the ratio of the bad requests divided by the error budget, 0 if the objective is not declared.
+type sloWindowStatus struct {
+	Window                 string  `json:"window"`
+	Requests               int64   `json:"requests"`
+	SlowRequests           int64   `json:"slow_requests"`
+	FailedRequests         int64   `json:"failed_requests"`
+	LatencyCompliance      float64 `json:"latency_compliance"`
+	LatencyBurnRate        float64 `json:"latency_burn_rate"`
+	AvailabilityCompliance float64 `json:"availability_compliance"`
+	AvailabilityBurnRate   float64 `json:"availability_burn_rate"`
+}
+
+type sloStatus struct {
+	DbName             string             `json:"db_name"`
+	CollectionName     string             `json:"collection_name"`
+	CollectionID       int64              `json:"collection_id"`
+	LatencyMs          int64              `json:"latency_ms"`
+	LatencyTarget      float64            `json:"latency_target"`
+	AvailabilityTarget float64            `json:"availability_target"`
+	Windows            []*sloWindowStatus `json:"windows"`
+}
+
+type sloTracker struct {
+	mu          sync.Mutex
+	collections map[int64]*collectionSLOStats
+}
+
+func newSLOTracker() *sloTracker {
+	return &sloTracker{
+		collections: make(map[int64]*collectionSLOStats),
+	}
+}
+
+func (t *sloTracker) observeRequest(ctx context.Context, req reqCollName, resp any, err error, latency time.Duration) {
+	if globalMetaCache == nil {
+		return
+	}
+	info, cacheErr := globalMetaCache.GetCollectionInfo(ctx, req.GetDbName(), req.GetCollectionName(), 0)
+	if cacheErr != nil {
+		return
+	}
+	if err == nil {
+		if status, ok := requestutil.GetStatusFromResponse(resp); ok {
+			err = merr.Error(status)
+		}
+	}
+	// the invalid requests are the faults of the clients, which don't consume the error budget
+	if errors.Is(err, merr.ErrParameterInvalid) {
+		return
+	}
+	t.observe(info.collID, req.GetDbName(), req.GetCollectionName(), info.slo, latency, err != nil, time.Now())
+}
+
+func (t *sloTracker) observe(collectionID int64, dbName, collectionName string, slo *common.CollectionSLO,
+	latency time.Duration, failed bool, now time.Time,
+) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	nodeID := paramtable.GetNodeID()
+	stats, ok := t.collections[collectionID]
+	if slo == nil {
+		// the SLO is removed from the collection
+		if ok {
+			delete(t.collections, collectionID)
+			metrics.CleanupProxySLOMetrics(nodeID, stats.collectionName)
+		}
+		return
+	}
+	if !ok {
+		stats = &collectionSLOStats{}
+		t.collections[collectionID] = stats
+	}
+	stats.dbName, stats.collectionName, stats.slo = dbName, collectionName, slo
+
+	start := now.Truncate(sloBucketWidth)
+	if len(stats.buckets) == 0 || stats.buckets[len(stats.buckets)-1].start.Before(start) {
+		t.refreshMetrics(stats, now)
+		expired := now.Add(-paramtable.Get().ProxyCfg.SLOLongWindow.GetAsDuration(time.Second))
+		idx := sort.Search(len(stats.buckets), func(i int) bool {
+			return !stats.buckets[i].start.Add(sloBucketWidth).Before(expired)
+		})
+		stats.buckets = append(stats.buckets[idx:], &sloBucket{start: start})
+	}
+	bucket := stats.buckets[len(stats.buckets)-1]
+	bucket.total++
+	label := metrics.SLOGoodLabel
+	switch {
+	case failed:
+		bucket.failed++
+		label = metrics.FailLabel
+	case slo.LatencyMs > 0 && latency > time.Duration(slo.LatencyMs)*time.Millisecond:
+		bucket.slow++
+		label = metrics.SLOSlowLabel
+	}
+	metrics.ProxySLORequestCount.WithLabelValues(strconv.FormatInt(nodeID, 10), collectionName, label).Inc()
+}
+
+// refreshMetrics sets the compliance and the burn rate metrics of the collection, it's called with the lock held.
+func (t *sloTracker) refreshMetrics(stats *collectionSLOStats, now time.Time) {
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	for _, window := range t.windowStatuses(stats, now) {
+		if stats.slo.LatencyTarget > 0 {
+			metrics.ProxySLOCompliance.WithLabelValues(nodeID, stats.collectionName, metrics.SLOLatencyLabel, window.Window).Set(window.LatencyCompliance)
+			metrics.ProxySLOBurnRate.WithLabelValues(nodeID, stats.collectionName, metrics.SLOLatencyLabel, window.Window).Set(window.LatencyBurnRate)
+		}
+		if stats.slo.AvailabilityTarget > 0 {
+			metrics.ProxySLOCompliance.WithLabelValues(nodeID, stats.collectionName, metrics.SLOAvailabilityLabel, window.Window).Set(window.AvailabilityCompliance)
+			metrics.ProxySLOBurnRate.WithLabelValues(nodeID, stats.collectionName, metrics.SLOAvailabilityLabel, window.Window).Set(window.AvailabilityBurnRate)
+		}
+	}
+}
+
+// windowStatuses computes the status of the short and the long windows, it's called with the lock held.
+func (t *sloTracker) windowStatuses(stats *collectionSLOStats, now time.Time) []*sloWindowStatus {
+	windows := []time.Duration{
+		paramtable.Get().ProxyCfg.SLOShortWindow.GetAsDuration(time.Second),
+		paramtable.Get().ProxyCfg.SLOLongWindow.GetAsDuration(time.Second),
+	}
+	burnRate := func(compliance, target float64) float64 {
+		if target <= 0 {
+			return 0
+		}
+		return (1 - compliance) / (1 - target)
+	}
+
+	ret := make([]*sloWindowStatus, 0, len(windows))
+	for _, window := range windows {
+		status := &sloWindowStatus{
+			Window:                 window.String(),
+			LatencyCompliance:      1,
+			AvailabilityCompliance: 1,
+		}
+		since := now.Add(-window)
+		for _, bucket := range stats.buckets {
+			if bucket.start.Add(sloBucketWidth).Before(since) {
+				continue
+			}
+			status.Requests += bucket.total
+			status.SlowRequests += bucket.slow
+			status.FailedRequests += bucket.failed
+		}
+		if status.Requests > 0 {
+			status.AvailabilityCompliance = float64(status.Requests-status.FailedRequests) / float64(status.Requests)
+		}
+		if succeeded := status.Requests - status.FailedRequests; succeeded > 0 {
+			status.LatencyCompliance = float64(succeeded-status.SlowRequests) / float64(succeeded)
+		}
+		status.LatencyBurnRate = burnRate(status.LatencyCompliance, stats.slo.LatencyTarget)
+		status.AvailabilityBurnRate = burnRate(status.AvailabilityCompliance, stats.slo.AvailabilityTarget)
+		ret = append(ret, status)
+	}
+	return ret
+}
+
+// removeCollection stops tracking the dropped collection, its metrics are cleaned up with the other collection metrics.
+func (t *sloTracker) removeCollection(collectionID int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.collections, collectionID)
+}
+
+// getStatus returns the SLO status of the tracked collections, filtered by the database and the collection name if not empty.
+func (t *sloTracker) getStatus(dbName, collectionName string, now time.Time) []*sloStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ret := make([]*sloStatus, 0)
+	for collectionID, stats := range t.collections {
+		if (dbName != "" && stats.dbName != dbName) || (collectionName != "" && stats.collectionName != collectionName) {
+			continue
+		}
+		ret = append(ret, &sloStatus{
+			DbName:             stats.dbName,
+			CollectionName:     stats.collectionName,
+			CollectionID:       collectionID,
+			LatencyMs:          stats.slo.LatencyMs,
+			LatencyTarget:      stats.slo.LatencyTarget,
+			AvailabilityTarget: stats.slo.AvailabilityTarget,
+			Windows:            t.windowStatuses(stats, now),
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].CollectionID < ret[j].CollectionID })
+	return ret
+}
@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestSLOTracker(t *testing.T) {
+	tracker := newSLOTracker()
+	slo := &common.CollectionSLO{LatencyMs: 100, LatencyTarget: 0.9, AvailabilityTarget: 0.99}
+	now := time.Now()
+
+	// 100 requests in the short window, 10 slow ones and 1 failed one
+	for i := 0; i < 100; i++ {
+		latency := 10 * time.Millisecond
+		if i < 10 {
+			latency = time.Second
+		}
+		tracker.observe(1, "default", "coll", slo, latency, i == 99, now)
+	}
+	status := tracker.getStatus("", "", now)
+	assert.Equal(t, 1, len(status))
+	assert.Equal(t, "coll", status[0].CollectionName)
+	assert.Equal(t, 2, len(status[0].Windows))
+	short := status[0].Windows[0]
+	assert.Equal(t, "5m0s", short.Window)
+	assert.Equal(t, int64(100), short.Requests)
+	assert.Equal(t, int64(10), short.SlowRequests)
+	assert.Equal(t, int64(1), short.FailedRequests)
+	assert.InDelta(t, 89.0/99, short.LatencyCompliance, 1e-9)
+	assert.InDelta(t, (1-89.0/99)/0.1, short.LatencyBurnRate, 1e-9)
+	assert.InDelta(t, 0.99, short.AvailabilityCompliance, 1e-9)
+	assert.InDelta(t, 1, short.AvailabilityBurnRate, 1e-9)
+
+	// the requests out of the short window are only counted by the long window
+	later := now.Add(10 * time.Minute)
+	tracker.observe(1, "default", "coll", slo, time.Millisecond, false, later)
+	status = tracker.getStatus("default", "coll", later)
+	assert.Equal(t, int64(1), status[0].Windows[0].Requests)
+	assert.Equal(t, 1.0, status[0].Windows[0].LatencyCompliance)
+	assert.Zero(t, status[0].Windows[0].LatencyBurnRate)
+	assert.Equal(t, int64(101), status[0].Windows[1].Requests)
+
+	// the buckets out of the long window are dropped
+	later = now.Add(2 * time.Hour)
+	tracker.observe(1, "default", "coll", slo, time.Millisecond, false, later)
+	assert.Equal(t, 1, len(tracker.collections[1].buckets))
+	assert.Equal(t, int64(1), tracker.getStatus("", "", later)[0].Windows[1].Requests)
+
+	// filtered by names
+	tracker.observe(2, "db", "coll2", &common.CollectionSLO{AvailabilityTarget: 0.9}, time.Second, false, now)
+	assert.Equal(t, 2, len(tracker.getStatus("", "", now)))
+	assert.Equal(t, 1, len(tracker.getStatus("db", "", now)))
+	assert.Equal(t, 0, len(tracker.getStatus("db", "coll", now)))
+	// slow requests don't matter without the latency objective
+	assert.Equal(t, int64(0), tracker.getStatus("db", "", now)[0].Windows[0].SlowRequests)
+
+	// the SLO is removed from the collection
+	tracker.observe(2, "db", "coll2", nil, time.Second, false, now)
+	assert.Equal(t, 0, len(tracker.getStatus("db", "", now)))
+
+	// the collection is dropped
+	tracker.removeCollection(1)
+	assert.Equal(t, 0, len(tracker.getStatus("", "", now)))
+}
+
+func TestSLOInterceptor(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	tracker := globalSLOTracker
+	defer func() { globalSLOTracker = tracker }()
+	globalSLOTracker = newSLOTracker()
+
+	mockCache := NewMockCache(t)
+	mockCache.EXPECT().GetCollectionInfo(mock.Anything, "default", "coll", int64(0)).Return(&collectionBasicInfo{
+		collID: 1,
+		slo:    &common.CollectionSLO{AvailabilityTarget: 0.99},
+	}, nil)
+	globalMetaCache = mockCache
+
+	interceptor := SLOInterceptor()
+	info := &grpc.UnaryServerInfo{}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &milvuspb.QueryResults{Status: merr.Status(merr.ErrServiceInternal)}, nil
+	}
+
+	// not tracked
+	_, err := interceptor(context.Background(), &milvuspb.InsertRequest{DbName: "default", CollectionName: "coll"}, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(globalSLOTracker.getStatus("", "", time.Now())))
+
+	_, err = interceptor(context.Background(), &milvuspb.QueryRequest{DbName: "default", CollectionName: "coll"}, info, handler)
+	assert.NoError(t, err)
+	status := globalSLOTracker.getStatus("", "", time.Now())
+	assert.Equal(t, 1, len(status))
+	assert.Equal(t, int64(1), status[0].Windows[0].FailedRequests)
+
+	// the invalid requests are not counted
+	_, err = interceptor(context.Background(), &milvuspb.SearchRequest{DbName: "default", CollectionName: "coll"}, info,
+		func(ctx context.Context, req any) (any, error) {
+			return &milvuspb.SearchResults{Status: merr.Status(merr.WrapErrParameterInvalidMsg("mock"))}, nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), globalSLOTracker.getStatus("", "", time.Now())[0].Windows[0].Requests)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -38,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -348,6 +350,9 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 	if _, err := validatePartitionKeyIsolation(t.CollectionName, hasPartitionKey, t.GetProperties()...); err != nil {
 		return err
 	}
+	if err := validateCollectionProperties(t.GetProperties()...); err != nil {
+		return err
+	}
 
 	// validate clustering key
 	if err := t.validateClusteringKey(); err != nil {
@@ -546,9 +551,10 @@ type describeCollectionTask struct {
 	baseTask
 	Condition
 	*milvuspb.DescribeCollectionRequest
-	ctx       context.Context
-	rootCoord types.RootCoordClient
-	result    *milvuspb.DescribeCollectionResponse
+	ctx           context.Context
+	rootCoord     types.RootCoordClient
+	describeCache *describeCache
+	result        *milvuspb.DescribeCollectionResponse
 }
 
 func (t *describeCollectionTask) TraceCtx() context.Context {
@@ -617,7 +623,7 @@ func (t *describeCollectionTask) Execute(ctx context.Context) error {
 		DbName:               t.GetDbName(),
 	}
 
-	result, err := t.rootCoord.DescribeCollection(ctx, t.DescribeCollectionRequest)
+	result, err := t.describeCache.DescribeCollection(ctx, t.rootCoord, t.DescribeCollectionRequest)
 	if err != nil {
 		return err
 	}
@@ -905,6 +911,48 @@ func hasLazyLoadProp(props ...*commonpb.KeyValuePair) bool {
 	return false
 }
 
+// validateCollectionProperties checks the values of the well-known collection properties read by the internal
+// policies, and the keys of the user-defined properties.
+func validateCollectionProperties(props ...*commonpb.KeyValuePair) error {
+	for _, p := range props {
+		if common.IsUserPropertyKey(p.GetKey()) && len(strings.TrimPrefix(p.GetKey(), common.UserPropertyKeyPrefix)) == 0 {
+			return merr.WrapErrParameterInvalidMsg("the name of user property is empty")
+		}
+	}
+	if _, err := common.GetCollectionInsertShapingRate(props...); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+
+	kvs := funcutil.KeyValuePair2Map(props)
+	if _, err := common.CollectionLevelCompactionAggressiveness(kvs); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	if _, _, _, err := common.CollectionLevelCompactionWindow(kvs); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	if _, _, _, err := common.CollectionLevelSegmentSwapWindow(kvs); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	if _, err := common.CollectionLevelDataNodeLabels(kvs); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	if _, err := common.CollectionLevelSLO(kvs); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	if _, _, err := common.CollectionLevelGCRetention(kvs); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	if _, _, err := common.CollectionLevelTemporaryTTL(kvs); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	if _, ok := kvs[common.CollectionRecoveryPriority]; ok {
+		if _, err := common.CollectionLevelRecoveryPriority(props); err != nil {
+			return merr.WrapErrParameterInvalidMsg(err.Error())
+		}
+	}
+	return nil
+}
+
 func validatePartitionKeyIsolation(colName string, isPartitionKeyEnabled bool, props ...*commonpb.KeyValuePair) (bool, error) {
 	iso, err := common.IsPartitionKeyIsolationKvEnabled(props...)
 	if err != nil {
@@ -938,6 +986,9 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 	}
 
 	t.CollectionID = collectionID
+	if err := validateCollectionProperties(t.Properties...); err != nil {
+		return err
+	}
 	if hasMmapProp(t.Properties...) || hasLazyLoadProp(t.Properties...) {
 		loaded, err := isCollectionLoaded(ctx, t.queryCoord, t.CollectionID)
 		if err != nil {
@@ -613,9 +613,10 @@ type describeIndexTask struct {
 	baseTask
 	Condition
 	*milvuspb.DescribeIndexRequest
-	ctx       context.Context
-	datacoord types.DataCoordClient
-	result    *milvuspb.DescribeIndexResponse
+	ctx           context.Context
+	datacoord     types.DataCoordClient
+	describeCache *describeCache
+	result        *milvuspb.DescribeIndexResponse
 
 	collectionID UniqueID
 }
@@ -684,7 +685,9 @@ func (dit *describeIndexTask) Execute(ctx context.Context) error {
 		return fmt.Errorf("failed to parse collection schema: %s", err)
 	}
 
-	resp, err := dit.datacoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{CollectionID: dit.collectionID, IndexName: dit.IndexName, Timestamp: dit.Timestamp})
+	resp, err := dit.describeCache.DescribeIndex(ctx, dit.datacoord,
+		&indexpb.DescribeIndexRequest{CollectionID: dit.collectionID, IndexName: dit.IndexName, Timestamp: dit.Timestamp},
+		dit.GetDbName(), dit.GetCollectionName())
 	if err != nil {
 		return err
 	}
@@ -34,6 +34,7 @@ type insertTask struct {
 	segIDAssigner *segIDAssigner
 	chMgr         channelsMgr
 	chTicker      channelsTimeTicker
+	shaper        *insertShaper
 	vChannels     []vChan
 	pChannels     []pChan
 	schema        *schemapb.CollectionSchema
@@ -275,6 +276,7 @@ func (it *insertTask) Execute(ctx context.Context) error {
 
 	log.Debug("assign segmentID for insert data success",
 		zap.Duration("assign segmentID duration", assignSegmentIDDur))
+
 	err = stream.Produce(msgPack)
 	if err != nil {
 		log.Warn("fail to produce insert msg", zap.Error(err))
@@ -292,6 +294,41 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	return nil
 }
 
+// shape queues the insert by the insert shaping of the collection if enabled. It's called before the insert
+// is enqueued and assigned the timestamp, so the queued insert doesn't hold back the time tick of the pchannels.
+// The rows are assumed to be hashed to the vchannels of the collection evenly.
+func (it *insertTask) shape(ctx context.Context) error {
+	if it.shaper == nil {
+		return nil
+	}
+	dbName, collectionName := it.insertMsg.GetDbName(), it.insertMsg.GetCollectionName()
+	collID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return err
+	}
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName, collID)
+	if err != nil {
+		return err
+	}
+	rate := getInsertShapingRate(collInfo)
+	if rate <= 0 {
+		return nil
+	}
+	channels, err := it.chMgr.getVChannels(collID)
+	if err != nil {
+		return err
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+	size := it.insertMsg.Size() / len(channels)
+	sizes := make(map[string]int, len(channels))
+	for _, channel := range channels {
+		sizes[channel] = size
+	}
+	return it.shaper.shape(ctx, collectionName, collID, sizes, rate)
+}
+
 func (it *insertTask) PostExecute(ctx context.Context) error {
 	return nil
 }
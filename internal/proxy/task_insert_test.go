@@ -287,6 +287,56 @@ func TestInsertTask(t *testing.T) {
 		assert.ElementsMatch(t, channels, resChannels)
 		assert.ElementsMatch(t, channels, it.pChannels)
 	})
+
+	t.Run("test shape", func(t *testing.T) {
+		collectionID := UniqueID(0)
+		collectionName := "col-0"
+		channels := []vChan{"mock-vchan-0", "mock-vchan-1"}
+		cache := NewMockCache(t)
+		cache.On("GetCollectionID",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+		).Return(collectionID, nil)
+		cache.On("GetCollectionInfo",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("int64"),
+		).Return(&collectionBasicInfo{insertShapingRate: 1}, nil)
+		globalMetaCache = cache
+		chMgr := NewMockChannelsMgr(t)
+		chMgr.EXPECT().getVChannels(collectionID).Return(channels, nil)
+		shaper := newInsertShaper()
+		it := insertTask{
+			ctx: context.Background(),
+			insertMsg: &msgstream.InsertMsg{
+				InsertRequest: &msgpb.InsertRequest{
+					CollectionName: collectionName,
+				},
+			},
+			chMgr:  chMgr,
+			shaper: shaper,
+		}
+		err := it.shape(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, shaper.buckets[collectionID], len(channels))
+		for _, channel := range channels {
+			assert.Contains(t, shaper.buckets[collectionID], channel)
+		}
+	})
+
+	t.Run("test shape without shaper", func(t *testing.T) {
+		it := insertTask{
+			ctx: context.Background(),
+			insertMsg: &msgstream.InsertMsg{
+				InsertRequest: &msgpb.InsertRequest{
+					CollectionName: "col-0",
+				},
+			},
+		}
+		assert.NoError(t, it.shape(context.Background()))
+	})
 }
 
 func TestMaxInsertSize(t *testing.T) {
@@ -61,6 +61,10 @@ type queryTask struct {
 
 	resultBuf *typeutil.ConcurrentSet[*internalpb.RetrieveResults]
 
+	hints requestHints
+	// the channels skipped as partial results for timeout
+	partialChannels *typeutil.ConcurrentSet[string]
+
 	plan             *planpb.PlanNode
 	partitionKeyMode bool
 	lb               LBPolicy
@@ -71,6 +75,11 @@ type queryTask struct {
 	allQueryCnt          int64
 	totalRelatedDataSize int64
 	mustUsePartitionKey  bool
+
+	cursorStore queryCursorStore
+	// the cursor of the query iterator if the request carries the iterator_cursor param
+	cursor      *queryCursor
+	cursorToken string
 }
 
 type queryParams struct {
@@ -249,6 +258,10 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 }
 
 func (t *queryTask) CanSkipAllocTimestamp() bool {
+	// the snapshot of the iterator cursor is pinned at the allocated timestamp
+	if _, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorCursorKey, t.request.GetQueryParams()); err == nil {
+		return false
+	}
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
 	if !useDefaultConsistency {
@@ -363,6 +376,10 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		t.request.Expr = IDs2Expr(pkField, t.ids)
 	}
 
+	if err := t.resolveCursor(ctx); err != nil {
+		return err
+	}
+
 	if err := t.createPlan(ctx); err != nil {
 		return err
 	}
@@ -435,6 +452,14 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		}
 	}
 	t.GuaranteeTimestamp = guaranteeTs
+	if t.cursor != nil {
+		// the first page pins the snapshot at its begin timestamp, the following pages query the same snapshot
+		if t.cursor.MvccTimestamp == 0 {
+			t.cursor.MvccTimestamp = t.BeginTs()
+		}
+		t.GuaranteeTimestamp = t.cursor.MvccTimestamp
+		t.MvccTimestamp = t.cursor.MvccTimestamp
+	}
 
 	deadline, ok := t.TraceCtx().Deadline()
 	if ok {
@@ -457,17 +482,27 @@ func (t *queryTask) Execute(ctx context.Context) error {
 		zap.String("requestType", "query"))
 
 	t.resultBuf = typeutil.NewConcurrentSet[*internalpb.RetrieveResults]()
+	t.partialChannels = typeutil.NewConcurrentSet[string]()
 	err := t.lb.Execute(ctx, CollectionWorkLoad{
 		db:             t.request.GetDbName(),
 		collectionID:   t.CollectionID,
 		collectionName: t.collectionName,
 		nq:             1,
 		exec:           t.queryShard,
+		retryTimes:     t.hints.retryBudget,
+		failedChannels: t.hints.failedChannels(t.partialChannels),
+		batch:          t.hints.batch,
 	})
 	if err != nil {
 		log.Warn("fail to execute query", zap.Error(err))
 		return errors.Wrap(err, "failed to query")
 	}
+	if partialChannels := t.partialChannels.Collect(); len(partialChannels) > 0 {
+		if len(t.resultBuf.Collect()) == 0 {
+			return errors.Wrap(context.DeadlineExceeded, "failed to query, none of the shards responded")
+		}
+		log.Warn("query returns partial results", zap.Strings("partialChannels", partialChannels))
+	}
 
 	log.Debug("Query Execute done.")
 	return nil
@@ -514,12 +549,93 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 	t.result.OutputFields = t.userOutputFields
+	if t.cursor != nil {
+		if err := t.saveCursor(ctx); err != nil {
+			log.Warn("fail to save the iterator cursor", zap.String("cursor", t.cursorToken), zap.Error(err))
+			return err
+		}
+	}
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
 	log.Debug("Query PostExecute done")
 	return nil
 }
 
+// resolveCursor loads the cursor of the query iterator and continues the expr from the last primary key of the cursor,
+// a new cursor is started by the empty token.
+func (t *queryTask) resolveCursor(ctx context.Context) error {
+	token, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorCursorKey, t.request.GetQueryParams())
+	if err != nil {
+		return nil
+	}
+	if t.cursorStore == nil {
+		return merr.WrapErrServiceUnavailable("the iterator cursor is not supported by the proxy")
+	}
+	if t.queryParams.limit == typeutil.Unlimited || t.queryParams.offset > 0 {
+		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("the iterator cursor should be used with limit and without offset"))
+	}
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, t.request.GetDbName(), t.collectionName, t.CollectionID)
+	if err != nil {
+		return err
+	}
+	pkField, err := t.schema.GetPkField()
+	if err != nil {
+		return err
+	}
+
+	user := GetCurUserFromContextOrDefault(ctx)
+	cursor := &queryCursor{
+		User:              user,
+		DbName:            t.request.GetDbName(),
+		CollectionID:      t.CollectionID,
+		CollectionVersion: collectionInfo.createdTimestamp,
+	}
+	if token == "" {
+		token, err = newQueryCursorToken()
+		if err != nil {
+			return err
+		}
+	} else {
+		cursor, err = t.cursorStore.Load(ctx, token)
+		if err != nil {
+			return err
+		}
+		// the cursor of the other users is taken as not found, to not tell whether the token exists
+		if !cursor.ownedBy(user, t.request.GetDbName()) {
+			return merr.WrapErrParameterInvalidMsg("the iterator cursor %s is not found or expired", token)
+		}
+		if cursor.CollectionID != t.CollectionID || cursor.CollectionVersion != collectionInfo.createdTimestamp {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg(
+				"the iterator cursor %s mismatches with the collection %s, the collection may be recreated", token, t.collectionName))
+		}
+	}
+	t.request.Expr = cursor.pkExpr(pkField.GetName(), t.request.GetExpr())
+	t.cursor = cursor
+	t.cursorToken = token
+	return nil
+}
+
+// saveCursor moves the cursor to the last primary key of the results and returns its token in the response status,
+// the cursor is removed once the iterator is exhausted.
+func (t *queryTask) saveCursor(ctx context.Context) error {
+	if t.result.Status == nil {
+		t.result.Status = merr.Success()
+	}
+	annotateCursor(t.result.Status, t.cursorToken)
+
+	pkField, err := t.schema.GetPkField()
+	if err != nil {
+		return err
+	}
+	if !t.cursor.advance(pkField, t.result.GetFieldsData()) {
+		if err := t.cursorStore.Remove(ctx, t.cursorToken, t.cursor); err != nil {
+			log.Ctx(ctx).Warn("failed to remove the exhausted iterator cursor", zap.String("cursor", t.cursorToken), zap.Error(err))
+		}
+		return nil
+	}
+	return t.cursorStore.Save(ctx, t.cursorToken, t.cursor)
+}
+
 func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channel string) error {
 	needOverrideMvcc := false
 	mvccTs := t.MvccTimestamp
@@ -548,7 +664,14 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 		zap.Int64("nodeID", nodeID),
 		zap.String("channel", channel))
 
-	result, err := qn.Query(ctx, req)
+	shardCtx, cancel := t.hints.withShardDeadline(ctx)
+	defer cancel()
+	result, err := qn.Query(shardCtx, req)
+	if err != nil && t.hints.isPartial(ctx, shardCtx) {
+		log.Warn("QueryNode query timeout, skip the channel as partial results", zap.Error(err))
+		t.partialChannels.Insert(channel)
+		return nil
+	}
 	if err != nil {
 		log.Warn("QueryNode query return error", zap.Error(err))
 		globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
@@ -61,11 +61,17 @@ type searchTask struct {
 	partitionKeyMode       bool
 	enableMaterializedView bool
 	mustUsePartitionKey    bool
+	// allPartitionsPruned is set if none of the partitions contains the searched partition keys
+	allPartitionsPruned bool
 
 	userOutputFields []string
 
 	resultBuf *typeutil.ConcurrentSet[*internalpb.SearchResults]
 
+	hints requestHints
+	// the channels skipped as partial results for timeout
+	partialChannels *typeutil.ConcurrentSet[string]
+
 	partitionIDsSet *typeutil.ConcurrentSet[UniqueID]
 
 	qc              types.QueryCoordClient
@@ -372,7 +378,8 @@ func (t *searchTask) initAdvancedSearchRequest(ctx context.Context) error {
 			if mvErr != nil {
 				return mvErr
 			}
-			partitionIDs, err2 := t.tryParsePartitionIDsFromPlan(plan)
+			// the sub search is not skipped even if all the partitions are pruned
+			partitionIDs, _, err2 := t.tryParsePartitionIDsFromPlan(plan)
 			if err2 != nil {
 				return err2
 			}
@@ -433,13 +440,14 @@ func (t *searchTask) initSearchRequest(ctx context.Context) error {
 		if mvErr != nil {
 			return mvErr
 		}
-		partitionIDs, err2 := t.tryParsePartitionIDsFromPlan(plan)
+		partitionIDs, allPruned, err2 := t.tryParsePartitionIDsFromPlan(plan)
 		if err2 != nil {
 			return err2
 		}
 		if len(partitionIDs) > 0 {
 			t.SearchRequest.PartitionIDs = partitionIDs
 		}
+		t.allPartitionsPruned = allPruned
 	}
 
 	if t.requery {
@@ -499,29 +507,46 @@ func (t *searchTask) tryGeneratePlan(params []*commonpb.KeyValuePair, dsl string
 	return plan, queryInfo, offset, nil
 }
 
-func (t *searchTask) tryParsePartitionIDsFromPlan(plan *planpb.PlanNode) ([]int64, error) {
+// tryParsePartitionIDsFromPlan returns the partitions which the partition keys in the plan are hashed to,
+// the partitions without the keys are pruned by the partition key stats. If all of them are pruned,
+// the hashed partitions are returned with allPruned set.
+func (t *searchTask) tryParsePartitionIDsFromPlan(plan *planpb.PlanNode) ([]int64, bool, error) {
 	expr, err := exprutil.ParseExprFromPlan(plan)
 	if err != nil {
 		log.Warn("failed to parse expr", zap.Error(err))
-		return nil, err
+		return nil, false, err
 	}
 	partitionKeys := exprutil.ParseKeys(expr, exprutil.PartitionKey)
 	hashedPartitionNames, err := assignPartitionKeys(t.ctx, t.request.GetDbName(), t.collectionName, partitionKeys)
 	if err != nil {
 		log.Warn("failed to assign partition keys", zap.Error(err))
-		return nil, err
+		return nil, false, err
 	}
 
 	if len(hashedPartitionNames) > 0 {
+		partitionNames := hashedPartitionNames
+		allPruned := false
+		if Params.ProxyCfg.EnablePartitionKeyPruning.GetAsBool() {
+			prunedPartitionNames, err := prunePartitionsByKeyStats(t.ctx, t.request.GetDbName(), t.collectionName, partitionKeys)
+			if err != nil {
+				log.Warn("failed to prune partitions by partition key stats", zap.Error(err))
+				return nil, false, err
+			}
+			if len(prunedPartitionNames) > 0 {
+				partitionNames = prunedPartitionNames
+			} else {
+				allPruned = true
+			}
+		}
 		// translate partition name to partition ids. Use regex-pattern to match partition name.
-		PartitionIDs, err2 := getPartitionIDs(t.ctx, t.request.GetDbName(), t.collectionName, hashedPartitionNames)
+		PartitionIDs, err2 := getPartitionIDs(t.ctx, t.request.GetDbName(), t.collectionName, partitionNames)
 		if err2 != nil {
 			log.Warn("failed to get partition ids", zap.Error(err2))
-			return nil, err2
+			return nil, false, err2
 		}
-		return PartitionIDs, nil
+		return PartitionIDs, allPruned, nil
 	}
-	return nil, nil
+	return nil, false, nil
 }
 
 func (t *searchTask) Execute(ctx context.Context) error {
@@ -532,17 +557,31 @@ func (t *searchTask) Execute(ctx context.Context) error {
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute search %d", t.ID()))
 	defer tr.CtxElapse(ctx, "done")
 
+	t.partialChannels = typeutil.NewConcurrentSet[string]()
+	if t.allPartitionsPruned {
+		log.Debug("skip search since none of the partitions contains the partition keys")
+		return nil
+	}
 	err := t.lb.Execute(ctx, CollectionWorkLoad{
 		db:             t.request.GetDbName(),
 		collectionID:   t.SearchRequest.CollectionID,
 		collectionName: t.collectionName,
 		nq:             t.Nq,
 		exec:           t.searchShard,
+		retryTimes:     t.hints.retryBudget,
+		failedChannels: t.hints.failedChannels(t.partialChannels),
+		batch:          t.hints.batch,
 	})
 	if err != nil {
 		log.Warn("search execute failed", zap.Error(err))
 		return errors.Wrap(err, "failed to search")
 	}
+	if partialChannels := t.partialChannels.Collect(); len(partialChannels) > 0 {
+		if t.resultBuf != nil && len(t.resultBuf.Collect()) == 0 {
+			return errors.Wrap(context.DeadlineExceeded, "failed to search, none of the shards responded")
+		}
+		log.Warn("search returns partial results", zap.Strings("partialChannels", partialChannels))
+	}
 
 	log.Debug("Search Execute done.",
 		zap.Int64("collection", t.GetCollectionID()),
@@ -678,7 +717,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	t.result.CollectionName = t.collectionName
 	t.fillInFieldInfo()
 
-	if t.requery {
+	if t.requery && !t.allPartitionsPruned {
 		err = t.Requery()
 		if err != nil {
 			log.Warn("failed to requery", zap.Error(err))
@@ -690,6 +729,10 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
+	if t.shouldVerify() {
+		t.verifyInBackground(ctx)
+	}
+
 	log.Debug("Search post execute done",
 		zap.Int64("collection", t.GetCollectionID()),
 		zap.Int64s("partitionIDs", t.GetPartitionIDs()))
@@ -714,7 +757,14 @@ func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.Que
 	var result *internalpb.SearchResults
 	var err error
 
-	result, err = qn.Search(ctx, req)
+	shardCtx, cancel := t.hints.withShardDeadline(ctx)
+	defer cancel()
+	result, err = qn.Search(shardCtx, req)
+	if err != nil && t.hints.isPartial(ctx, shardCtx) {
+		log.Warn("QueryNode search timeout, skip the channel as partial results", zap.Error(err))
+		t.partialChannels.Insert(channel)
+		return nil
+	}
 	if err != nil {
 		log.Warn("QueryNode search return error", zap.Error(err))
 		globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
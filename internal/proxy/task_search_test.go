@@ -2657,6 +2657,7 @@ func (s *MaterializedViewTestSuite) SetupTest() {
 			collID:                s.colID,
 			partitionKeyIsolation: true,
 		}, nil)
+	s.mockMetaCache.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	globalMetaCache = s.mockMetaCache
 }
 
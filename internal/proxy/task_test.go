@@ -3876,3 +3876,36 @@ func TestTaskPartitionKeyIsolation(t *testing.T) {
 			"can not alter partition key isolation mode if the collection already has a vector index. Please drop the index first")
 	})
 }
+
+func TestValidateCollectionProperties(t *testing.T) {
+	assert.NoError(t, validateCollectionProperties())
+	assert.NoError(t, validateCollectionProperties(
+		&commonpb.KeyValuePair{Key: "user.team", Value: "search"},
+		&commonpb.KeyValuePair{Key: common.CollectionCompactionAggressivenessKey, Value: "2"},
+		&commonpb.KeyValuePair{Key: common.CollectionCompactionWindowKey, Value: "22:00-06:00"},
+		&commonpb.KeyValuePair{Key: common.CollectionSegmentSwapWindowKey, Value: "01:00-05:00"},
+		&commonpb.KeyValuePair{Key: common.CollectionDataNodeLabelsKey, Value: "tenant=a"},
+		&commonpb.KeyValuePair{Key: common.CollectionRecoveryPriority, Value: "10"},
+		&commonpb.KeyValuePair{Key: common.CollectionSLOLatencyKey, Value: "100"},
+		&commonpb.KeyValuePair{Key: common.CollectionSLOLatencyTargetKey, Value: "0.99"},
+		&commonpb.KeyValuePair{Key: common.CollectionGCRetentionKey, Value: "72"},
+		&commonpb.KeyValuePair{Key: common.CollectionTemporaryTTLKey, Value: "86400"},
+	))
+
+	for _, prop := range []*commonpb.KeyValuePair{
+		{Key: common.UserPropertyKeyPrefix, Value: "search"},
+		{Key: common.CollectionInsertShapingRateKey, Value: "-1"},
+		{Key: common.CollectionCompactionAggressivenessKey, Value: "0"},
+		{Key: common.CollectionCompactionWindowKey, Value: "night"},
+		{Key: common.CollectionSegmentSwapWindowKey, Value: "night"},
+		{Key: common.CollectionDataNodeLabelsKey, Value: "tenant"},
+		{Key: common.CollectionRecoveryPriority, Value: "high"},
+		{Key: common.CollectionSLOLatencyKey, Value: "100"},
+		{Key: common.CollectionSLOAvailabilityTargetKey, Value: "2"},
+		{Key: common.CollectionGCRetentionKey, Value: "-1"},
+		{Key: common.CollectionTemporaryTTLKey, Value: "0"},
+	} {
+		err := validateCollectionProperties(prop)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid, prop.GetKey())
+	}
+}
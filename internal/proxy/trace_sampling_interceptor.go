@@ -0,0 +1,28 @@
+package proxy
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/pkg/tracer"
+	"github.com/milvus-io/milvus/pkg/util/requestutil"
+)
+
+// TraceSamplingInterceptor marks the request to be sampled if its collection or user is forced to be sampled
+// by the trace sampling policy, it must be placed before the tracing interceptor to affect the root span.
+func TraceSamplingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !tracer.HasForceSample() {
+			return handler(ctx, req)
+		}
+		var collectionName string
+		if getter, ok := req.(requestutil.CollectionNameGetter); ok {
+			collectionName = getter.GetCollectionName()
+		}
+		if tracer.ShouldForceSample(collectionName, GetCurUserFromContextOrDefault(ctx)) {
+			ctx = tracer.WithForceSample(ctx)
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,48 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/tracer"
+)
+
+func TestTraceSamplingInterceptor(t *testing.T) {
+	tracer.SetTracerProvider(tracetest.NewInMemoryExporter(), 0)
+	defer tracer.ResetSamplingPolicy()
+	interceptor := TraceSamplingInterceptor()
+
+	var sampled bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, sp := otel.Tracer("test").Start(ctx, "test")
+		defer sp.End()
+		sampled = sp.SpanContext().IsSampled()
+		return nil, nil
+	}
+
+	_, err := interceptor(context.Background(), &milvuspb.SearchRequest{CollectionName: "coll"}, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.False(t, sampled)
+
+	err = tracer.SetSamplingPolicy(&tracer.SamplingPolicy{ForceCollections: []string{"coll"}, ForceUsers: []string{"alice"}})
+	assert.NoError(t, err)
+
+	_, err = interceptor(context.Background(), &milvuspb.SearchRequest{CollectionName: "coll"}, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.True(t, sampled)
+
+	_, err = interceptor(context.Background(), &milvuspb.SearchRequest{CollectionName: "other"}, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.False(t, sampled)
+
+	ctx := NewContextWithMetadata(context.Background(), "alice", "default")
+	_, err = interceptor(ctx, &milvuspb.SearchRequest{CollectionName: "other"}, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.True(t, sampled)
+}
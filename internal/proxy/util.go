@@ -94,6 +94,14 @@ func isNumber(c uint8) bool {
 	return true
 }
 
+// checkRegionWritable denies the writes to the secondary region, whose collections are shipped from the primary region.
+func checkRegionWritable() error {
+	if paramtable.Get().CommonCfg.RegionReplicaRole.GetValue() == util.RegionReplicaRoleSecondary {
+		return merr.WrapErrServiceUnavailable("the secondary region is read-only, write to the primary region instead")
+	}
+	return nil
+}
+
 func validateMaxQueryResultWindow(offset int64, limit int64) error {
 	if offset < 0 {
 		return fmt.Errorf("%s [%d] is invalid, should be gte than 0", OffsetKey, offset)
@@ -1500,6 +1508,60 @@ func assignPartitionKeys(ctx context.Context, dbName string, collName string, ke
 	return hashedPartitionNames, err
 }
 
+// prunePartitionsByKeyStats returns the partitions which may contain the partition keys
+// according to the partition key stats.
+func prunePartitionsByKeyStats(ctx context.Context, dbName string, collName string, keys []*planpb.GenericValue) ([]string, error) {
+	partitionNames, err := globalMetaCache.GetPartitionsIndex(ctx, dbName, collName)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collName)
+	if err != nil {
+		return nil, err
+	}
+
+	partitionKeyFieldSchema, err := typeutil.GetPartitionKeyFieldSchema(schema.CollectionSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	partition2Buckets, err := typeutil2.HashKey2PartitionBuckets(partitionKeyFieldSchema, keys, partitionNames)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := globalMetaCache.GetPartitionKeyStats(ctx, dbName, collName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(partition2Buckets))
+	for partitionName, buckets := range partition2Buckets {
+		for _, bucket := range buckets {
+			if typeutil.HasPartitionKeyBucket(stats[partitionName], bucket) {
+				result = append(result, partitionName)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// markAllPartitionKeyBuckets reports all the buckets of the partition keys for the partitions,
+// used if the partition keys of the data written into the partitions are unknown.
+func markAllPartitionKeyBuckets(ctx context.Context, dbName string, collName string, partitionNames []string) error {
+	allBuckets := make([]uint32, typeutil.PartitionKeyBucketNum)
+	for i := range allBuckets {
+		allBuckets[i] = uint32(i)
+	}
+	buckets := make(map[string][]uint32, len(partitionNames))
+	for _, partitionName := range partitionNames {
+		buckets[partitionName] = allBuckets
+	}
+	return globalMetaCache.UpdatePartitionKeyStats(ctx, dbName, collName, buckets)
+}
+
 func ErrWithLog(logger *log.MLogger, msg string, err error) error {
 	wrapErr := errors.Wrap(err, msg)
 	if logger != nil {
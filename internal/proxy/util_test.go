@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -919,6 +920,58 @@ func TestGetRole(t *testing.T) {
 	assert.Equal(t, 1, len(roles))
 }
 
+func TestPrunePartitionsByKeyStats(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "key", DataType: schemapb.DataType_Int64, IsPartitionKey: true},
+		},
+	}
+	partitionNames := []string{"p_0", "p_1"}
+	keys := []*planpb.GenericValue{
+		{Val: &planpb.GenericValue_Int64Val{Int64Val: 1}},
+		{Val: &planpb.GenericValue_Int64Val{Int64Val: 2}},
+	}
+	keyData := &schemapb.FieldData{Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+		Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+	}}}
+	hashValues, err := typeutil.HashKey2Partitions(keyData, partitionNames)
+	assert.NoError(t, err)
+	keyBuckets, err := typeutil.HashKey2PartitionBuckets(keyData, partitionNames)
+	assert.NoError(t, err)
+
+	mockCache := NewMockCache(t)
+	mockCache.EXPECT().GetPartitionsIndex(mock.Anything, mock.Anything, mock.Anything).Return(partitionNames, nil)
+	mockCache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, mock.Anything).Return(newSchemaInfo(schema), nil)
+	globalMetaCache = mockCache
+	defer func() { globalMetaCache = nil }()
+
+	// the stats without any key prune all the partitions
+	stats := map[string][]byte{"p_0": typeutil.NewPartitionKeyBuckets(), "p_1": typeutil.NewPartitionKeyBuckets()}
+	mockCache.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything, mock.Anything).Return(stats, nil).Once()
+	result, err := prunePartitionsByKeyStats(ctx, "db", "coll", keys)
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+
+	// the partition containing the first key is kept
+	typeutil.SetPartitionKeyBucket(stats[partitionNames[hashValues[0]]], keyBuckets[0])
+	mockCache.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything, mock.Anything).Return(stats, nil).Once()
+	result, err = prunePartitionsByKeyStats(ctx, "db", "coll", keys)
+	assert.NoError(t, err)
+	assert.Contains(t, result, partitionNames[hashValues[0]])
+
+	// the partitions without the stats are never pruned
+	mockCache.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything, mock.Anything).Return(map[string][]byte{}, nil).Once()
+	result, err = prunePartitionsByKeyStats(ctx, "db", "coll", keys)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, typeutil.NewSet(partitionNames[hashValues[0]], partitionNames[hashValues[1]]).Collect(), result)
+
+	mockCache.EXPECT().GetPartitionKeyStats(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("mock error")).Once()
+	_, err = prunePartitionsByKeyStats(ctx, "db", "coll", keys)
+	assert.Error(t, err)
+}
+
 func TestPasswordVerify(t *testing.T) {
 	username := "user-test00"
 	password := "PasswordVerify"
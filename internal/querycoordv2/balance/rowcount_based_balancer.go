@@ -311,6 +311,10 @@ func (b *RowCountBasedBalancer) genStoppingChannelPlan(replica *meta.Replica, rw
 }
 
 func (b *RowCountBasedBalancer) genChannelPlan(replica *meta.Replica, rwNodes []int64) []ChannelAssignPlan {
+	if paramtable.Get().QueryCoordCfg.BalanceChannelByLoad.GetAsBool() {
+		return b.genChannelPlanByLoad(replica, rwNodes)
+	}
+
 	channelPlans := make([]ChannelAssignPlan, 0)
 	if len(rwNodes) > 1 {
 		// start to balance channels on all available nodes
@@ -349,6 +353,112 @@ func (b *RowCountBasedBalancer) genChannelPlan(replica *meta.Replica, rwNodes []
 	return channelPlans
 }
 
+// genChannelPlanByLoad moves at most one delegator of the replica from the most loaded node to the least loaded node.
+// The load of a delegator is the weighted sum of its request rate and memory usage, both normalized by the average of the nodes,
+// and the load of a node is the sum of all the delegators on it, including the ones of other collections.
+// The delegator is moved by a channel task, which watches the channel on the target node before releasing it
+// from the source node, so the shard keeps serviceable during the role transfer.
+func (b *RowCountBasedBalancer) genChannelPlanByLoad(replica *meta.Replica, rwNodes []int64) []ChannelAssignPlan {
+	if len(rwNodes) < 2 {
+		return nil
+	}
+	params := paramtable.Get()
+	rateWeight := params.QueryCoordCfg.ChannelLoadRequestRateWeight.GetAsFloat()
+	memWeight := params.QueryCoordCfg.ChannelLoadMemoryWeight.GetAsFloat()
+
+	nodeRate := make(map[int64]float64, len(rwNodes))
+	nodeMem := make(map[int64]float64, len(rwNodes))
+	totalRate, totalMem := 0.0, 0.0
+	for _, node := range rwNodes {
+		for _, view := range b.dist.LeaderViewManager.GetByFilter(meta.WithNodeID2LeaderView(node)) {
+			nodeRate[node] += view.RequestRate
+			nodeMem[node] += float64(view.MemorySize)
+		}
+		totalRate += nodeRate[node]
+		totalMem += nodeMem[node]
+	}
+	avgRate := totalRate / float64(len(rwNodes))
+	avgMem := totalMem / float64(len(rwNodes))
+	loadOf := func(rate, mem float64) float64 {
+		load := 0.0
+		if avgRate > 0 {
+			load += rateWeight * rate / avgRate
+		}
+		if avgMem > 0 {
+			load += memWeight * mem / avgMem
+		}
+		return load
+	}
+	avgLoad := loadOf(avgRate, avgMem)
+	if avgLoad <= 0 {
+		return nil
+	}
+
+	// only move delegator to the nodes which could serve it
+	versionRangeFilter := semver.MustParseRange(">2.3.x")
+	var source, target int64 = -1, -1
+	for _, node := range rwNodes {
+		load := loadOf(nodeRate[node], nodeMem[node])
+		if source == -1 || load > loadOf(nodeRate[source], nodeMem[source]) {
+			source = node
+		}
+		info := b.nodeManager.Get(node)
+		if info == nil || info.GetState() != session.NodeStateNormal || !versionRangeFilter(info.Version()) {
+			continue
+		}
+		if target == -1 || load < loadOf(nodeRate[target], nodeMem[target]) {
+			target = node
+		}
+	}
+	if target == -1 || source == target {
+		return nil
+	}
+	gap := loadOf(nodeRate[source], nodeMem[source]) - loadOf(nodeRate[target], nodeMem[target])
+	if gap <= params.QueryCoordCfg.ChannelLoadUnbalanceToleration.GetAsFloat()*avgLoad {
+		return nil
+	}
+
+	// moving a delegator with load less than the gap narrows the gap, pick the one closest to half of the gap
+	var channelToMove *meta.DmChannel
+	minDiff := math.MaxFloat64
+	for _, channel := range b.dist.ChannelDistManager.GetByCollectionAndFilter(replica.GetCollectionID(), meta.WithNodeID2Channel(source)) {
+		// the channel is being moved
+		if len(b.dist.ChannelDistManager.GetByFilter(meta.WithReplica2Channel(replica), meta.WithChannelName2Channel(channel.GetChannelName()))) > 1 {
+			continue
+		}
+		view := b.dist.LeaderViewManager.GetLeaderShardView(source, channel.GetChannelName())
+		if view == nil {
+			continue
+		}
+		load := loadOf(view.RequestRate, float64(view.MemorySize))
+		if load <= 0 || load >= gap {
+			continue
+		}
+		if diff := math.Abs(load - gap/2); diff < minDiff {
+			minDiff = diff
+			channelToMove = channel
+		}
+	}
+	if channelToMove == nil {
+		return nil
+	}
+
+	log.Info("balance delegator by load",
+		zap.Int64("collectionID", replica.GetCollectionID()),
+		zap.Int64("replicaID", replica.GetID()),
+		zap.String("channel", channelToMove.GetChannelName()),
+		zap.Int64("from", source),
+		zap.Int64("to", target),
+		zap.Float64("loadGap", gap),
+		zap.Float64("averageLoad", avgLoad))
+	return []ChannelAssignPlan{{
+		Channel: channelToMove,
+		Replica: replica,
+		From:    source,
+		To:      target,
+	}}
+}
+
 func NewRowCountBasedBalancer(
 	scheduler task.Scheduler,
 	nodeManager *session.NodeManager,
@@ -1091,6 +1091,68 @@ func (suite *RowCountBasedBalancerTestSuite) TestDisableBalanceChannel() {
 	}
 }
 
+func (suite *RowCountBasedBalancerTestSuite) TestBalanceChannelByLoad() {
+	balancer := suite.balancer
+	Params.Save(Params.QueryCoordCfg.BalanceChannelByLoad.Key, "true")
+	defer Params.Reset(Params.QueryCoordCfg.BalanceChannelByLoad.Key)
+
+	nodes := []int64{1, 2}
+	replica := utils.CreateTestReplica(1, 1, nodes)
+	for _, node := range nodes {
+		nodeInfo := session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "127.0.0.1:0",
+			Hostname: "localhost",
+			Version:  common.Version,
+		})
+		nodeInfo.SetState(session.NodeStateNormal)
+		balancer.nodeManager.Add(nodeInfo)
+	}
+
+	// node 1 serves three busy channels, node 2 serves an idle one
+	balancer.dist.ChannelDistManager.Update(1,
+		&meta.DmChannel{VchannelInfo: &datapb.VchannelInfo{CollectionID: 1, ChannelName: "v1"}, Node: 1},
+		&meta.DmChannel{VchannelInfo: &datapb.VchannelInfo{CollectionID: 1, ChannelName: "v2"}, Node: 1},
+		&meta.DmChannel{VchannelInfo: &datapb.VchannelInfo{CollectionID: 1, ChannelName: "v3"}, Node: 1},
+	)
+	balancer.dist.ChannelDistManager.Update(2,
+		&meta.DmChannel{VchannelInfo: &datapb.VchannelInfo{CollectionID: 1, ChannelName: "v4"}, Node: 2},
+	)
+	balancer.dist.LeaderViewManager.Update(1,
+		&meta.LeaderView{ID: 1, CollectionID: 1, Channel: "v1", RequestRate: 50, MemorySize: 1024},
+		&meta.LeaderView{ID: 1, CollectionID: 1, Channel: "v2", RequestRate: 300, MemorySize: 1024},
+		&meta.LeaderView{ID: 1, CollectionID: 1, Channel: "v3", RequestRate: 500, MemorySize: 1024},
+	)
+	balancer.dist.LeaderViewManager.Update(2,
+		&meta.LeaderView{ID: 2, CollectionID: 1, Channel: "v4", RequestRate: 100, MemorySize: 1024},
+	)
+
+	// the channel closest to half of the load gap is moved
+	plans := balancer.genChannelPlan(replica, nodes)
+	suite.Len(plans, 1)
+	suite.Equal("v2", plans[0].Channel.GetChannelName())
+	suite.EqualValues(1, plans[0].From)
+	suite.EqualValues(2, plans[0].To)
+
+	// balanced within toleration
+	balancer.dist.LeaderViewManager.Update(2,
+		&meta.LeaderView{ID: 2, CollectionID: 1, Channel: "v4", RequestRate: 800, MemorySize: 3072},
+	)
+	suite.Empty(balancer.genChannelPlan(replica, nodes))
+
+	// no delegator could narrow the gap
+	balancer.dist.ChannelDistManager.Update(1,
+		&meta.DmChannel{VchannelInfo: &datapb.VchannelInfo{CollectionID: 1, ChannelName: "v1"}, Node: 1},
+	)
+	balancer.dist.LeaderViewManager.Update(1,
+		&meta.LeaderView{ID: 1, CollectionID: 1, Channel: "v1", RequestRate: 900, MemorySize: 3072},
+	)
+	balancer.dist.LeaderViewManager.Update(2,
+		&meta.LeaderView{ID: 2, CollectionID: 1, Channel: "v4", RequestRate: 0, MemorySize: 0},
+	)
+	suite.Empty(balancer.genChannelPlan(replica, nodes))
+}
+
 func (suite *RowCountBasedBalancerTestSuite) TestMultiReplicaBalance() {
 	cases := []struct {
 		name               string
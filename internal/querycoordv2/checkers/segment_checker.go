@@ -19,6 +19,7 @@ package checkers
 import (
 	"context"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -36,6 +37,8 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 const initialTargetVersion = int64(0)
@@ -46,6 +49,7 @@ type SegmentChecker struct {
 	dist            *meta.DistributionManager
 	targetMgr       meta.TargetManagerInterface
 	nodeMgr         *session.NodeManager
+	broker          meta.Broker
 	getBalancerFunc GetBalancerFunc
 }
 
@@ -54,6 +58,7 @@ func NewSegmentChecker(
 	dist *meta.DistributionManager,
 	targetMgr meta.TargetManagerInterface,
 	nodeMgr *session.NodeManager,
+	broker meta.Broker,
 	getBalancerFunc GetBalancerFunc,
 ) *SegmentChecker {
 	return &SegmentChecker{
@@ -62,6 +67,7 @@ func NewSegmentChecker(
 		dist:              dist,
 		targetMgr:         targetMgr,
 		nodeMgr:           nodeMgr,
+		broker:            broker,
 		getBalancerFunc:   getBalancerFunc,
 	}
 }
@@ -410,7 +416,7 @@ func (c *SegmentChecker) createSegmentLoadTasks(ctx context.Context, segments []
 				SegmentInfo: s,
 			}
 		})
-		shardPlans := c.getBalancerFunc().AssignSegment(replica.GetCollectionID(), segmentInfos, rwNodes, false)
+		shardPlans := c.assignSegments(ctx, replica.GetCollectionID(), segmentInfos, rwNodes)
 		for i := range shardPlans {
 			shardPlans[i].Replica = replica
 		}
@@ -420,6 +426,98 @@ func (c *SegmentChecker) createSegmentLoadTasks(ctx context.Context, segments []
 	return balance.CreateSegmentTasksFromPlans(ctx, c.ID(), Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond), plans)
 }
 
+// assignSegments assigns the segments to the nodes, the segment with the indexes gated by the CPU features
+// is assigned only to the nodes supporting them. If none of the nodes supports them, the segment is not assigned
+// and the incompatible indexes are rebuilt on the index nodes within the CPU features of the nodes.
+func (c *SegmentChecker) assignSegments(ctx context.Context, collectionID int64, segments []*meta.Segment, nodes []int64) []balance.SegmentAssignPlan {
+	balancer := c.getBalancerFunc()
+	gated := lo.Filter(Params.CommonCfg.GatedCPUFeatures.GetAsStrings(), func(feature string, _ int) bool {
+		return strings.TrimSpace(feature) != ""
+	})
+	if len(gated) == 0 {
+		return balancer.AssignSegment(collectionID, segments, nodes, false)
+	}
+
+	// the segments requiring the same CPU features are assigned to the same nodes
+	groups := make(map[string][]*meta.Segment)
+	requirements := make(map[string][]string)
+	for _, segment := range segments {
+		required := c.getRequiredCPUFeatures(ctx, collectionID, segment.GetID())
+		key := strings.Join(required, ",")
+		groups[key] = append(groups[key], segment)
+		requirements[key] = required
+	}
+
+	plans := make([]balance.SegmentAssignPlan, 0, len(segments))
+	for key, group := range groups {
+		compatible := lo.Filter(nodes, func(nodeID int64, _ int) bool {
+			return c.isCompatibleNode(nodeID, requirements[key])
+		})
+		if len(compatible) == 0 {
+			for _, segment := range group {
+				c.rebuildIncompatibleIndexes(ctx, collectionID, segment.GetID(), requirements[key], nodes)
+			}
+			continue
+		}
+		plans = append(plans, balancer.AssignSegment(collectionID, group, compatible, false)...)
+	}
+	return plans
+}
+
+// getRequiredCPUFeatures returns the sorted CPU features required by the indexes of the segment,
+// the segment without index or failed to get the index info requires none.
+func (c *SegmentChecker) getRequiredCPUFeatures(ctx context.Context, collectionID, segmentID int64) []string {
+	infos, err := c.broker.GetIndexInfo(ctx, collectionID, segmentID)
+	if err != nil {
+		return nil
+	}
+	required := typeutil.NewSet[string]()
+	for _, info := range infos {
+		required.Insert(info.GetRequiredCpuFeatures()...)
+	}
+	features := required.Collect()
+	sort.Strings(features)
+	return features
+}
+
+// isCompatibleNode checks whether the node supports the required CPU features,
+// the node not reporting its CPU features is regarded as compatible.
+func (c *SegmentChecker) isCompatibleNode(nodeID int64, required []string) bool {
+	node := c.nodeMgr.Get(nodeID)
+	if node == nil {
+		return false
+	}
+	return len(node.CPUFeatures()) == 0 || len(hardware.MissingCPUFeatures(required, node.CPUFeatures())) == 0
+}
+
+// rebuildIncompatibleIndexes rebuilds the indexes of the segment within the CPU features supported by all the nodes.
+func (c *SegmentChecker) rebuildIncompatibleIndexes(ctx context.Context, collectionID, segmentID int64, required []string, nodes []int64) {
+	var supported []string
+	for _, nodeID := range nodes {
+		node := c.nodeMgr.Get(nodeID)
+		if node == nil || len(node.CPUFeatures()) == 0 {
+			continue
+		}
+		if supported == nil {
+			supported = node.CPUFeatures()
+		} else {
+			supported = lo.Intersect(supported, node.CPUFeatures())
+		}
+	}
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID), zap.Int64("segmentID", segmentID),
+		zap.Strings("required", required), zap.Strings("supported", supported))
+	if len(supported) == 0 {
+		log.RatedWarn(60, "no node supports the CPU features required by the indexes of the segment")
+		return
+	}
+	buildIDs, err := c.broker.RebuildIncompatibleIndexes(ctx, collectionID, segmentID, supported)
+	if err != nil {
+		log.RatedWarn(60, "failed to rebuild the indexes incompatible with the nodes", zap.Error(err))
+		return
+	}
+	log.RatedInfo(60, "rebuild the indexes incompatible with the nodes", zap.Int64s("buildIDs", buildIDs))
+}
+
 func (c *SegmentChecker) createSegmentReduceTasks(ctx context.Context, segments []*meta.Segment, replica *meta.Replica, scope querypb.DataScope) []task.Task {
 	ret := make([]task.Task, 0, len(segments))
 	for _, s := range segments {
@@ -28,6 +28,7 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
@@ -77,7 +78,7 @@ func (suite *SegmentCheckerTestSuite) SetupTest() {
 	targetManager := meta.NewTargetManager(suite.broker, suite.meta)
 
 	balancer := suite.createMockBalancer()
-	suite.checker = NewSegmentChecker(suite.meta, distManager, targetManager, suite.nodeMgr, func() balance.Balance { return balancer })
+	suite.checker = NewSegmentChecker(suite.meta, distManager, targetManager, suite.nodeMgr, suite.broker, func() balance.Balance { return balancer })
 
 	suite.broker.EXPECT().GetPartitions(mock.Anything, int64(1)).Return([]int64{1}, nil).Maybe()
 }
@@ -169,6 +170,78 @@ func (suite *SegmentCheckerTestSuite) TestLoadSegments() {
 	suite.Len(tasks, 1)
 }
 
+func (suite *SegmentCheckerTestSuite) TestLoadSegmentsGatedByCPUFeatures() {
+	paramtable.Get().Save(Params.CommonCfg.GatedCPUFeatures.Key, "avx512f")
+	defer paramtable.Get().Reset(Params.CommonCfg.GatedCPUFeatures.Key)
+
+	checker := suite.checker
+	// set meta
+	checker.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	checker.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:      1,
+		Address:     "localhost",
+		Hostname:    "localhost",
+		CPUFeatures: []string{"amd64", "avx2"},
+	}))
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:      2,
+		Address:     "localhost",
+		Hostname:    "localhost",
+		CPUFeatures: []string{"amd64", "avx2", "avx512f"},
+	}))
+	checker.meta.ResourceManager.HandleNodeUp(1)
+	checker.meta.ResourceManager.HandleNodeUp(2)
+
+	// set target
+	segments := []*datapb.SegmentInfo{
+		{
+			ID:            1,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+		},
+	}
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(channels, segments, nil)
+	suite.broker.EXPECT().GetIndexInfo(mock.Anything, int64(1), int64(1)).Return([]*querypb.FieldIndexInfo{
+		{FieldID: 101, RequiredCpuFeatures: []string{"avx512f"}},
+	}, nil)
+	checker.targetMgr.UpdateCollectionNextTarget(int64(1))
+
+	// set dist
+	checker.dist.ChannelDistManager.Update(2, utils.CreateTestChannel(1, 2, 1, "test-insert-channel"))
+	checker.dist.LeaderViewManager.Update(2, utils.CreateTestLeaderView(2, 1, "test-insert-channel", map[int64]int64{}, map[int64]*meta.Segment{}))
+
+	// assigned to the node supporting avx512f only
+	for i := 0; i < 3; i++ {
+		tasks := checker.Check(context.TODO())
+		suite.Len(tasks, 1)
+		suite.Len(tasks[0].Actions(), 1)
+		action, ok := tasks[0].Actions()[0].(*task.SegmentAction)
+		suite.True(ok)
+		suite.EqualValues(2, action.Node())
+		suite.EqualValues(1, action.SegmentID())
+	}
+
+	// rebuilt within the features of the nodes if none of them supports avx512f
+	suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:      2,
+		Address:     "localhost",
+		Hostname:    "localhost",
+		CPUFeatures: []string{"amd64", "avx2", "avx"},
+	}))
+	suite.broker.EXPECT().RebuildIncompatibleIndexes(mock.Anything, int64(1), int64(1), []string{"amd64", "avx2"}).
+		Return([]int64{10}, nil).Once()
+	tasks := checker.Check(context.TODO())
+	suite.Len(tasks, 0)
+}
+
 func (suite *SegmentCheckerTestSuite) TestLoadL0Segments() {
 	checker := suite.checker
 	// set meta
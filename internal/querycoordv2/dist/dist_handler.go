@@ -156,6 +156,7 @@ func (dh *distHandler) updateSegmentsDistribution(resp *querypb.GetDataDistribut
 				Version:            s.GetVersion(),
 				LastDeltaTimestamp: s.GetLastDeltaTimestamp(),
 				IndexInfo:          s.GetIndexInfo(),
+				LoadedRows:         s.GetNumOfRows(),
 			}
 		} else {
 			segment = &meta.Segment{
@@ -164,6 +165,7 @@ func (dh *distHandler) updateSegmentsDistribution(resp *querypb.GetDataDistribut
 				Version:            s.GetVersion(),
 				LastDeltaTimestamp: s.GetLastDeltaTimestamp(),
 				IndexInfo:          s.GetIndexInfo(),
+				LoadedRows:         s.GetNumOfRows(),
 			}
 		}
 		updates = append(updates, segment)
@@ -232,6 +234,8 @@ func (dh *distHandler) updateLeaderView(resp *querypb.GetDataDistributionRespons
 			TargetVersion:          lview.TargetVersion,
 			NumOfGrowingRows:       lview.GetNumOfGrowingRows(),
 			PartitionStatsVersions: lview.PartitionStatsVersions,
+			RequestRate:            lview.GetRequestRate(),
+			MemorySize:             lview.GetMemorySize(),
 		}
 		updates = append(updates, view)
 	}
@@ -227,7 +227,9 @@ func (job *LoadCollectionJob) Execute() error {
 func (job *LoadCollectionJob) PostExecute() {
 	if job.Error() != nil {
 		job.undo.RollBack()
+		return
 	}
+	syncRecoveryPriority(job.ctx, job.meta, job.broker, job.req.GetCollectionID())
 }
 
 type LoadPartitionJob struct {
@@ -417,5 +419,7 @@ func (job *LoadPartitionJob) Execute() error {
 func (job *LoadPartitionJob) PostExecute() {
 	if job.Error() != nil {
 		job.undo.RollBack()
+		return
 	}
+	syncRecoveryPriority(job.ctx, job.meta, job.broker, job.req.GetCollectionID())
 }
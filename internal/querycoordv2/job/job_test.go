@@ -26,6 +26,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore"
@@ -38,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/kv"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -1214,6 +1217,57 @@ func (suite *JobSuite) TestLoadPartitionStoreFailed() {
 	}
 }
 
+func (suite *JobSuite) TestLoadWithRecoveryPriority() {
+	ctx := context.Background()
+	collection := suite.collections[0]
+	suite.Equal(querypb.LoadType_LoadCollection, suite.loadTypes[collection])
+	// the broker is shared by the suite, restore its expectations
+	expectedCalls := suite.broker.ExpectedCalls
+	defer func() { suite.broker.ExpectedCalls = expectedCalls }()
+
+	describeWithPriority := func(priority string) {
+		suite.broker.ExpectedCalls = lo.Filter(suite.broker.ExpectedCalls, func(call *mock.Call, _ int) bool {
+			return call.Method != "DescribeCollection"
+		})
+		suite.broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+			Properties: []*commonpb.KeyValuePair{
+				{Key: common.CollectionRecoveryPriority, Value: priority},
+			},
+		}, nil)
+	}
+	load := func() {
+		job := NewLoadCollectionJob(
+			ctx,
+			&querypb.LoadCollectionRequest{CollectionID: collection},
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.collectionObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.Add(job)
+		suite.NoError(job.Wait())
+	}
+
+	describeWithPriority("10")
+	load()
+	suite.EqualValues(10, suite.meta.CollectionManager.GetRecoveryPriority(collection))
+	suite.Equal(collection, suite.meta.CollectionManager.GetAll()[0])
+
+	// altered priority takes effect by loading again
+	describeWithPriority("1")
+	load()
+	suite.EqualValues(1, suite.meta.CollectionManager.GetRecoveryPriority(collection))
+
+	// invalid priority falls back to default
+	describeWithPriority("high")
+	load()
+	suite.EqualValues(0, suite.meta.CollectionManager.GetRecoveryPriority(collection))
+}
+
 func (suite *JobSuite) TestLoadCreateReplicaFailed() {
 	// Store replica failed
 	suite.meta = meta.NewMeta(ErrorIDAllocator(), suite.store, session.NewNodeManager())
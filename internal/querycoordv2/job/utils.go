@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -117,6 +118,26 @@ func loadPartitions(ctx context.Context,
 	return nil
 }
 
+// syncRecoveryPriority syncs the recovery priority in the collection properties to the loaded collection,
+// an altered priority takes effect once the collection is loaded again.
+func syncRecoveryPriority(ctx context.Context, m *meta.Meta, broker meta.Broker, collection int64) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collection))
+	collectionInfo, err := broker.DescribeCollection(ctx, collection)
+	if err != nil {
+		log.Warn("failed to describe collection, skip syncing recovery priority", zap.Error(err))
+		return
+	}
+	// the collection without a valid priority falls back to the default priority
+	priority, err := common.CollectionLevelRecoveryPriority(collectionInfo.GetProperties())
+	if err != nil {
+		priority = 0
+	}
+	err = m.CollectionManager.UpdateRecoveryPriority(collection, priority)
+	if err != nil {
+		log.Warn("failed to update recovery priority", zap.Int32("priority", priority), zap.Error(err))
+	}
+}
+
 func releasePartitions(ctx context.Context,
 	meta *meta.Meta,
 	cluster session.Cluster,
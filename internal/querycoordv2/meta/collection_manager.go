@@ -19,6 +19,7 @@ package meta
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -364,7 +365,8 @@ func (m *CollectionManager) Exist(collectionID typeutil.UniqueID) bool {
 	return ok
 }
 
-// GetAll returns the collection ID of all loaded collections
+// GetAll returns the IDs of all the collections, ordered by recovery priority from high to low,
+// so the collections with higher priority are checked and recovered first.
 func (m *CollectionManager) GetAll() []int64 {
 	m.rwmutex.RLock()
 	defer m.rwmutex.RUnlock()
@@ -373,7 +375,40 @@ func (m *CollectionManager) GetAll() []int64 {
 	for _, collection := range m.collections {
 		ids.Insert(collection.GetCollectionID())
 	}
-	return ids.Collect()
+	collections := ids.Collect()
+	sort.SliceStable(collections, func(i, j int) bool {
+		return m.collections[collections[i]].GetRecoveryPriority() > m.collections[collections[j]].GetRecoveryPriority()
+	})
+	return collections
+}
+
+// GetRecoveryPriority returns the recovery priority of the collection, 0 if the collection not exists.
+func (m *CollectionManager) GetRecoveryPriority(collectionID typeutil.UniqueID) int32 {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return 0
+	}
+	return collection.GetRecoveryPriority()
+}
+
+// UpdateRecoveryPriority updates the recovery priority of the loaded collection.
+func (m *CollectionManager) UpdateRecoveryPriority(collectionID typeutil.UniqueID, priority int32) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	collection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+	if collection.GetRecoveryPriority() == priority {
+		return nil
+	}
+	newCollection := collection.Clone()
+	newCollection.RecoveryPriority = priority
+	return m.putCollection(true, newCollection)
 }
 
 func (m *CollectionManager) GetAllCollections() []*Collection {
@@ -220,6 +220,27 @@ func (suite *CollectionManagerSuite) TestUpdate() {
 	}
 }
 
+func (suite *CollectionManagerSuite) TestRecoveryPriority() {
+	mgr := suite.mgr
+
+	suite.NoError(mgr.UpdateRecoveryPriority(101, 10))
+	suite.NoError(mgr.UpdateRecoveryPriority(102, 5))
+	suite.Error(mgr.UpdateRecoveryPriority(-1, 10))
+	suite.EqualValues(10, mgr.GetRecoveryPriority(101))
+	suite.EqualValues(0, mgr.GetRecoveryPriority(-1))
+
+	collections := mgr.GetAll()
+	suite.Len(collections, len(suite.collections))
+	suite.EqualValues(101, collections[0])
+	suite.EqualValues(102, collections[1])
+
+	// recovery priority is persisted
+	suite.clearMemory()
+	err := mgr.Recover(suite.broker)
+	suite.NoError(err)
+	suite.EqualValues(5, mgr.GetRecoveryPriority(102))
+}
+
 func (suite *CollectionManagerSuite) TestGetFieldIndex() {
 	mgr := suite.mgr
 	mgr.PutCollection(&Collection{
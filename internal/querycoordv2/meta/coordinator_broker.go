@@ -48,6 +48,7 @@ type Broker interface {
 	ListIndexes(ctx context.Context, collectionID UniqueID) ([]*indexpb.IndexInfo, error)
 	GetSegmentInfo(ctx context.Context, segmentID ...UniqueID) (*datapb.GetSegmentInfoResponse, error)
 	GetIndexInfo(ctx context.Context, collectionID UniqueID, segmentID UniqueID) ([]*querypb.FieldIndexInfo, error)
+	RebuildIncompatibleIndexes(ctx context.Context, collectionID UniqueID, segmentID UniqueID, supportedCPUFeatures []string) ([]int64, error)
 	GetRecoveryInfoV2(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentInfo, error)
 	DescribeDatabase(ctx context.Context, dbName string) (*rootcoordpb.DescribeDatabaseResponse, error)
 	GetCollectionLoadInfo(ctx context.Context, collectionID UniqueID) ([]string, int64, error)
@@ -326,12 +327,33 @@ func (broker *CoordinatorBroker) GetIndexInfo(ctx context.Context, collectionID
 			IndexVersion:        info.GetIndexVersion(),
 			NumRows:             info.GetNumRows(),
 			CurrentIndexVersion: info.GetCurrentIndexVersion(),
+			RequiredCpuFeatures: info.GetRequiredCpuFeatures(),
 		})
 	}
 
 	return indexes, nil
 }
 
+// RebuildIncompatibleIndexes rebuilds the indexes of the segment requiring the CPU features not supported,
+// it returns the incompatible builds being rebuilt.
+func (broker *CoordinatorBroker) RebuildIncompatibleIndexes(ctx context.Context, collectionID UniqueID, segmentID UniqueID, supportedCPUFeatures []string) ([]int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+
+	resp, err := broker.dataCoord.RebuildIncompatibleIndexes(ctx, &indexpb.RebuildIncompatibleIndexesRequest{
+		Base:                 commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		CollectionID:         collectionID,
+		SegmentID:            segmentID,
+		SupportedCpuFeatures: supportedCPUFeatures,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Ctx(ctx).Warn("failed to rebuild the incompatible indexes", zap.Int64("collectionID", collectionID),
+			zap.Int64("segmentID", segmentID), zap.Error(err))
+		return nil, err
+	}
+	return resp.GetBuildIDs(), nil
+}
+
 func (broker *CoordinatorBroker) describeIndex(ctx context.Context, collectionID UniqueID) ([]*indexpb.IndexInfo, error) {
 	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
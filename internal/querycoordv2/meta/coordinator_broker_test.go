@@ -377,6 +377,42 @@ func (s *CoordinatorBrokerDataCoordSuite) TestListIndexes() {
 	})
 }
 
+func (s *CoordinatorBrokerDataCoordSuite) TestRebuildIncompatibleIndexes() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	collectionID := int64(100)
+	segmentID := int64(1000)
+	supported := []string{"amd64", "avx2"}
+
+	s.Run("normal_case", func() {
+		s.datacoord.EXPECT().RebuildIncompatibleIndexes(mock.Anything, mock.MatchedBy(func(req *indexpb.RebuildIncompatibleIndexesRequest) bool {
+			return req.GetSegmentID() == segmentID && s.Equal(supported, req.GetSupportedCpuFeatures())
+		})).Return(&indexpb.RebuildIncompatibleIndexesResponse{
+			Status:   merr.Status(nil),
+			BuildIDs: []int64{10},
+		}, nil).Once()
+		buildIDs, err := s.broker.RebuildIncompatibleIndexes(ctx, collectionID, segmentID, supported)
+		s.NoError(err)
+		s.Equal([]int64{10}, buildIDs)
+	})
+
+	s.Run("datacoord_return_error", func() {
+		s.datacoord.EXPECT().RebuildIncompatibleIndexes(mock.Anything, mock.Anything).
+			Return(nil, errors.New("mocked")).Once()
+		_, err := s.broker.RebuildIncompatibleIndexes(ctx, collectionID, segmentID, supported)
+		s.Error(err)
+	})
+
+	s.Run("datacoord_return_failure_status", func() {
+		s.datacoord.EXPECT().RebuildIncompatibleIndexes(mock.Anything, mock.Anything).
+			Return(&indexpb.RebuildIncompatibleIndexesResponse{
+				Status: merr.Status(errors.New("mocked")),
+			}, nil).Once()
+		_, err := s.broker.RebuildIncompatibleIndexes(ctx, collectionID, segmentID, supported)
+		s.Error(err)
+	})
+}
+
 func (s *CoordinatorBrokerDataCoordSuite) TestSegmentInfo() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -119,6 +119,8 @@ type LeaderView struct {
 	TargetVersion          int64
 	NumOfGrowingRows       int64
 	PartitionStatsVersions map[int64]int64
+	RequestRate            float64
+	MemorySize             int64
 }
 
 func (view *LeaderView) Clone() *LeaderView {
@@ -142,6 +144,8 @@ func (view *LeaderView) Clone() *LeaderView {
 		TargetVersion:          view.TargetVersion,
 		NumOfGrowingRows:       view.NumOfGrowingRows,
 		PartitionStatsVersions: view.PartitionStatsVersions,
+		RequestRate:            view.RequestRate,
+		MemorySize:             view.MemorySize,
 	}
 }
 
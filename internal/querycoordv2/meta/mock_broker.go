@@ -581,6 +581,63 @@ func (_c *MockBroker_ListIndexes_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
+// RebuildIncompatibleIndexes provides a mock function with given fields: ctx, collectionID, segmentID, supportedCPUFeatures
+func (_m *MockBroker) RebuildIncompatibleIndexes(ctx context.Context, collectionID int64, segmentID int64, supportedCPUFeatures []string) ([]int64, error) {
+	ret := _m.Called(ctx, collectionID, segmentID, supportedCPUFeatures)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []string) ([]int64, error)); ok {
+		return rf(ctx, collectionID, segmentID, supportedCPUFeatures)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []string) []int64); ok {
+		r0 = rf(ctx, collectionID, segmentID, supportedCPUFeatures)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []string) error); ok {
+		r1 = rf(ctx, collectionID, segmentID, supportedCPUFeatures)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroker_RebuildIncompatibleIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildIncompatibleIndexes'
+type MockBroker_RebuildIncompatibleIndexes_Call struct {
+	*mock.Call
+}
+
+// RebuildIncompatibleIndexes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - segmentID int64
+//   - supportedCPUFeatures []string
+func (_e *MockBroker_Expecter) RebuildIncompatibleIndexes(ctx interface{}, collectionID interface{}, segmentID interface{}, supportedCPUFeatures interface{}) *MockBroker_RebuildIncompatibleIndexes_Call {
+	return &MockBroker_RebuildIncompatibleIndexes_Call{Call: _e.mock.On("RebuildIncompatibleIndexes", ctx, collectionID, segmentID, supportedCPUFeatures)}
+}
+
+func (_c *MockBroker_RebuildIncompatibleIndexes_Call) Run(run func(ctx context.Context, collectionID int64, segmentID int64, supportedCPUFeatures []string)) *MockBroker_RebuildIncompatibleIndexes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *MockBroker_RebuildIncompatibleIndexes_Call) Return(_a0 []int64, _a1 error) *MockBroker_RebuildIncompatibleIndexes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBroker_RebuildIncompatibleIndexes_Call) RunAndReturn(run func(context.Context, int64, int64, []string) ([]int64, error)) *MockBroker_RebuildIncompatibleIndexes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockBroker creates a new instance of MockBroker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockBroker(t interface {
@@ -122,6 +122,7 @@ type Segment struct {
 	Version            int64                             // Version is the timestamp of loading segment
 	LastDeltaTimestamp uint64                            // The timestamp of the last delta record
 	IndexInfo          map[int64]*querypb.FieldIndexInfo // index info of loaded segment
+	LoadedRows         int64                             // The rows loaded on the node, 0 if unknown
 }
 
 func SegmentFromInfo(info *datapb.SegmentInfo) *Segment {
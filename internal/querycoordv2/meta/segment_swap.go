@@ -0,0 +1,203 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+)
+
+// segmentSwapGate schedules the swaps of the compacted segments into the loaded collections,
+// the QueryNodes download the compacted segments and release their loaded sources on each swap.
+// The swaps are admitted by the global rate of queryCoord.segmentSwap.rate in the collection.segmentSwap.window
+// of the collection, and deferred otherwise by keeping the loaded sources in the next target,
+// until they are deferred longer than queryCoord.segmentSwap.maxDelay.
+type segmentSwapGate struct {
+	mu      sync.Mutex
+	rate    float64
+	limiter *ratelimitutil.Limiter
+	// the first deferred time of the swaps by the collection and the sources, the admitted swaps are kept as well
+	// so that they're not deferred again before swapped into the current target
+	deferred map[int64]map[string]time.Time
+	admitted map[int64]map[string]struct{}
+}
+
+func newSegmentSwapGate() *segmentSwapGate {
+	return &segmentSwapGate{
+		deferred: make(map[int64]map[string]time.Time),
+		admitted: make(map[int64]map[string]struct{}),
+	}
+}
+
+// segmentSwap is the compacted segments from the same loaded sources, they're swapped or deferred together.
+type segmentSwap struct {
+	key       string
+	sources   []int64
+	compacted []int64
+}
+
+// collectSegmentSwaps returns the swaps of the compacted segments in the next target from the sources all loaded
+// in the current target, ordered by the sources.
+func collectSegmentSwaps(current map[int64]*datapb.SegmentInfo, next map[int64]*datapb.SegmentInfo) []*segmentSwap {
+	swaps := make(map[string]*segmentSwap)
+	for id, segment := range next {
+		sources := segment.GetCompactionFrom()
+		if _, ok := current[id]; ok || len(sources) == 0 {
+			continue
+		}
+		if !lo.EveryBy(sources, func(source int64) bool {
+			_, ok := current[source]
+			return ok
+		}) {
+			continue
+		}
+		sources = lo.Uniq(sources)
+		sort.Slice(sources, func(i, j int) bool { return sources[i] < sources[j] })
+		key := fmt.Sprint(sources)
+		if _, ok := swaps[key]; !ok {
+			swaps[key] = &segmentSwap{key: key, sources: sources}
+		}
+		swaps[key].compacted = append(swaps[key].compacted, id)
+	}
+	result := lo.Values(swaps)
+	sort.Slice(result, func(i, j int) bool { return result[i].key < result[j].key })
+	return result
+}
+
+// maxDelay returns queryCoord.segmentSwap.maxDelay capped by half of the GC retention of the collection,
+// the files of the dropped sources may be recycled by the GC after the retention.
+func (g *segmentSwapGate) maxDelay(gcRetention time.Duration) time.Duration {
+	return min(paramtable.Get().QueryCoordCfg.SegmentSwapMaxDelay.GetAsDuration(time.Second), gcRetention/2)
+}
+
+// allow admits a swap by the rate limit, the limiter is rebuilt if the rate is changed.
+func (g *segmentSwapGate) allow(now time.Time) bool {
+	rate := paramtable.Get().QueryCoordCfg.SegmentSwapRate.GetAsFloat()
+	if rate <= 0 {
+		return true
+	}
+	if g.limiter == nil || g.rate != rate {
+		g.rate = rate
+		g.limiter = ratelimitutil.NewLimiter(ratelimitutil.Limit(rate), rate)
+	}
+	return g.limiter.AllowN(now, 1)
+}
+
+// admit decides the swaps to defer, the state of the swaps not collected any more is cleaned.
+func (g *segmentSwapGate) admit(collectionID int64, swaps []*segmentSwap, inWindow bool, gcRetention time.Duration, now time.Time) []*segmentSwap {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	deferred := make(map[string]time.Time)
+	admitted := make(map[string]struct{})
+	result := make([]*segmentSwap, 0)
+	for _, swap := range swaps {
+		if _, ok := g.admitted[collectionID][swap.key]; ok {
+			admitted[swap.key] = struct{}{}
+			continue
+		}
+		since, ok := g.deferred[collectionID][swap.key]
+		if !ok {
+			since = now
+		}
+		if (inWindow && g.allow(now)) || now.Sub(since) >= g.maxDelay(gcRetention) {
+			admitted[swap.key] = struct{}{}
+			continue
+		}
+		deferred[swap.key] = since
+		result = append(result, swap)
+	}
+	g.deferred[collectionID] = deferred
+	g.admitted[collectionID] = admitted
+	return result
+}
+
+func (g *segmentSwapGate) remove(collectionID int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.deferred, collectionID)
+	delete(g.admitted, collectionID)
+}
+
+// deferSegmentSwaps keeps the loaded sources of the deferred swaps in the next target instead of the compacted segments,
+// the swaps are only scheduled for the loaded collections, which have the current target.
+func (mgr *TargetManager) deferSegmentSwaps(collectionID int64, segments map[int64]*datapb.SegmentInfo, channels map[string]*DmChannel) {
+	mgr.rwMutex.RLock()
+	var current map[int64]*datapb.SegmentInfo
+	if target := mgr.current.getCollectionTarget(collectionID); target != nil {
+		current = target.GetAllSegments()
+	}
+	mgr.rwMutex.RUnlock()
+
+	swaps := collectSegmentSwaps(current, segments)
+	if len(swaps) == 0 {
+		mgr.swapGate.remove(collectionID)
+		return
+	}
+
+	log := log.With(zap.Int64("collectionID", collectionID))
+	inWindow := true
+	gcRetention := paramtable.Get().DataCoordCfg.GCDropTolerance.GetAsDuration(time.Second)
+	resp, err := mgr.broker.DescribeCollection(context.TODO(), collectionID)
+	if err != nil {
+		log.Warn("failed to describe collection, swap the compacted segments without the window", zap.Error(err))
+	} else {
+		props := funcutil.KeyValuePair2Map(resp.GetProperties())
+		start, end, ok, err := common.CollectionLevelSegmentSwapWindow(props)
+		if err != nil {
+			log.Warn("collection properties segment swap window not valid, ignored", zap.Error(err))
+		} else if ok {
+			now := time.Now()
+			inWindow = common.InCompactionWindow(start, end, now.Hour()*60+now.Minute())
+		}
+		if retention, ok, err := common.CollectionLevelGCRetention(props); err == nil && ok {
+			gcRetention = retention
+		}
+	}
+
+	for _, swap := range mgr.swapGate.admit(collectionID, swaps, inWindow, gcRetention, time.Now()) {
+		sources := make(map[int64]struct{}, len(swap.sources))
+		for _, id := range swap.sources {
+			segments[id] = current[id]
+			sources[id] = struct{}{}
+		}
+		for _, id := range swap.compacted {
+			delete(segments, id)
+		}
+		for _, channel := range channels {
+			channel.DroppedSegmentIds = lo.Filter(channel.GetDroppedSegmentIds(), func(id int64, _ int) bool {
+				_, ok := sources[id]
+				return !ok
+			})
+			channel.FlushedSegmentIds = append(lo.Without(channel.GetFlushedSegmentIds(), swap.compacted...), swap.sources...)
+		}
+		log.Info("defer swapping the compacted segments", zap.Int64s("sources", swap.sources), zap.Int64s("compacted", swap.compacted))
+	}
+}
@@ -0,0 +1,86 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestCollectSegmentSwaps(t *testing.T) {
+	current := map[int64]*datapb.SegmentInfo{
+		1: {ID: 1},
+		2: {ID: 2},
+		3: {ID: 3},
+	}
+	next := map[int64]*datapb.SegmentInfo{
+		3: {ID: 3},
+		// split from the loaded segments 1 and 2
+		4: {ID: 4, CompactionFrom: []int64{2, 1}},
+		5: {ID: 5, CompactionFrom: []int64{1, 2}},
+		// the source 7 is not loaded
+		6: {ID: 6, CompactionFrom: []int64{3, 7}},
+		8: {ID: 8},
+	}
+	swaps := collectSegmentSwaps(current, next)
+	assert.Len(t, swaps, 1)
+	assert.Equal(t, []int64{1, 2}, swaps[0].sources)
+	assert.ElementsMatch(t, []int64{4, 5}, swaps[0].compacted)
+
+	assert.Empty(t, collectSegmentSwaps(nil, next))
+}
+
+func TestSegmentSwapGate(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	swaps := []*segmentSwap{
+		{key: "[1 2]", sources: []int64{1, 2}, compacted: []int64{3}},
+		{key: "[4 5]", sources: []int64{4, 5}, compacted: []int64{6}},
+	}
+
+	gate := newSegmentSwapGate()
+	now := time.Now()
+	gcRetention := 24 * time.Hour
+	assert.Empty(t, gate.admit(1, swaps, true, gcRetention, now))
+	assert.Len(t, gate.admit(1, swaps, false, gcRetention, now), 0, "the admitted swaps are not deferred again")
+
+	// deferred out of the window
+	gate.remove(1)
+	assert.Len(t, gate.admit(1, swaps, false, gcRetention, now), 2)
+	assert.Len(t, gate.admit(1, swaps, false, gcRetention, now.Add(30*time.Minute)), 2)
+	// swapped if deferred longer than the max delay
+	assert.Empty(t, gate.admit(1, swaps, false, gcRetention, now.Add(time.Hour)))
+
+	// the max delay is capped by half of the gc retention of the collection
+	assert.Equal(t, 5*time.Minute, gate.maxDelay(10*time.Minute))
+	gate.remove(1)
+	assert.Len(t, gate.admit(1, swaps, false, 10*time.Minute, now), 2)
+	assert.Empty(t, gate.admit(1, swaps, false, 10*time.Minute, now.Add(5*time.Minute)))
+
+	// rate limited
+	params.Save(params.QueryCoordCfg.SegmentSwapRate.Key, "0.01")
+	defer params.Reset(params.QueryCoordCfg.SegmentSwapRate.Key)
+	gate.remove(1)
+	deferred := gate.admit(1, swaps, true, gcRetention, now)
+	assert.Len(t, deferred, 1)
+	assert.Equal(t, "[4 5]", deferred[0].key)
+}
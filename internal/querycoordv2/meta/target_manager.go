@@ -83,14 +83,17 @@ type TargetManager struct {
 	// all remove segment/channel operation happens on Both current and next -> delete status should be consistent
 	current *target
 	next    *target
+
+	swapGate *segmentSwapGate
 }
 
 func NewTargetManager(broker Broker, meta *Meta) *TargetManager {
 	return &TargetManager{
-		broker:  broker,
-		meta:    meta,
-		current: newTarget(),
-		next:    newTarget(),
+		broker:   broker,
+		meta:     meta,
+		current:  newTarget(),
+		next:     newTarget(),
+		swapGate: newSegmentSwapGate(),
 	}
 }
 
@@ -151,6 +154,7 @@ func (mgr *TargetManager) UpdateCollectionNextTarget(collectionID int64) error {
 		log.Debug("skip empty next targets for collection")
 		return nil
 	}
+	mgr.deferSegmentSwaps(collectionID, segments, channels)
 	allocatedTarget.segments = segments
 	allocatedTarget.dmChannels = channels
 
@@ -316,6 +320,7 @@ func (mgr *TargetManager) RemoveCollection(collectionID int64) {
 
 	mgr.current.removeCollectionTarget(collectionID)
 	mgr.next.removeCollectionTarget(collectionID)
+	mgr.swapGate.remove(collectionID)
 }
 
 // RemovePartition removes all segment in the given partition,
@@ -17,6 +17,7 @@
 package meta
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -27,6 +28,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
@@ -34,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/kv"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -262,6 +266,64 @@ func (suite *TargetManagerSuite) TestUpdateNextTarget() {
 	suite.NoError(err)
 }
 
+func (suite *TargetManagerSuite) TestDeferSegmentSwaps() {
+	collectionID := int64(1003)
+	suite.meta.PutCollection(&Collection{
+		CollectionLoadInfo: &querypb.CollectionLoadInfo{
+			CollectionID:  collectionID,
+			ReplicaNumber: 1,
+		},
+	})
+	suite.meta.PutPartition(&Partition{
+		PartitionLoadInfo: &querypb.PartitionLoadInfo{
+			CollectionID: collectionID,
+			PartitionID:  1,
+		},
+	})
+
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return([]*datapb.VchannelInfo{
+		{CollectionID: collectionID, ChannelName: "channel-1", FlushedSegmentIds: []int64{11, 12}},
+	}, []*datapb.SegmentInfo{
+		{ID: 11, PartitionID: 1, InsertChannel: "channel-1"},
+		{ID: 12, PartitionID: 1, InsertChannel: "channel-1"},
+	}, nil).Once()
+	suite.NoError(suite.mgr.UpdateCollectionNextTarget(collectionID))
+	suite.True(suite.mgr.UpdateCollectionCurrentTarget(collectionID))
+
+	// segment 13 is compacted from the loaded segments 11 and 12
+	compactedChannels := []*datapb.VchannelInfo{
+		{CollectionID: collectionID, ChannelName: "channel-1", FlushedSegmentIds: []int64{13}, DroppedSegmentIds: []int64{11, 12}},
+	}
+	compactedSegments := []*datapb.SegmentInfo{
+		{ID: 13, PartitionID: 1, InsertChannel: "channel-1", CompactionFrom: []int64{11, 12}},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(compactedChannels, compactedSegments, nil).Once()
+	// the swap window is out of now
+	now := time.Now()
+	start := (now.Hour()*60 + now.Minute() + 120) % (24 * 60)
+	end := (start + 60) % (24 * 60)
+	window := fmt.Sprintf("%02d:%02d-%02d:%02d", start/60, start%60, end/60, end%60)
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, collectionID).Return(&milvuspb.DescribeCollectionResponse{
+		Status:     merr.Success(),
+		Properties: []*commonpb.KeyValuePair{{Key: common.CollectionSegmentSwapWindowKey, Value: window}},
+	}, nil).Once()
+	suite.NoError(suite.mgr.UpdateCollectionNextTarget(collectionID))
+	suite.assertSegments([]int64{11, 12}, suite.mgr.GetSealedSegmentsByCollection(collectionID, NextTarget))
+	suite.Empty(suite.mgr.GetDroppedSegmentsByChannel(collectionID, "channel-1", NextTarget))
+
+	// the swap is deferred longer than the max delay
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.SegmentSwapMaxDelay.Key, "0")
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.SegmentSwapMaxDelay.Key)
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(compactedChannels, compactedSegments, nil).Once()
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, collectionID).Return(&milvuspb.DescribeCollectionResponse{
+		Status:     merr.Success(),
+		Properties: []*commonpb.KeyValuePair{{Key: common.CollectionSegmentSwapWindowKey, Value: window}},
+	}, nil).Once()
+	suite.NoError(suite.mgr.UpdateCollectionNextTarget(collectionID))
+	suite.assertSegments([]int64{13}, suite.mgr.GetSealedSegmentsByCollection(collectionID, NextTarget))
+	suite.ElementsMatch([]int64{11, 12}, suite.mgr.GetDroppedSegmentsByChannel(collectionID, "channel-1", NextTarget))
+}
+
 func (suite *TargetManagerSuite) TestRemovePartition() {
 	collectionID := int64(1000)
 	suite.assertSegments(suite.getAllSegment(collectionID, suite.partitions[collectionID]), suite.mgr.GetSealedSegmentsByCollection(collectionID, NextTarget))
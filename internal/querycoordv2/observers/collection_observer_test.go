@@ -214,6 +214,7 @@ func (suite *CollectionObserverSuite) SetupTest() {
 	for _, collection := range suite.collections {
 		suite.broker.EXPECT().GetPartitions(mock.Anything, collection).Return(suite.partitions[collection], nil).Maybe()
 	}
+	suite.broker.EXPECT().ListIndexes(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	suite.targetObserver.Start()
 	suite.ob.Start()
 	suite.loadAll()
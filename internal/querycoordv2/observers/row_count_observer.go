@@ -0,0 +1,327 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	qctask "github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// RowCountObserver compares the row counts of the sealed segments in the current target among the segment meta,
+// the binlogs and the segments loaded on the query nodes, the loaded segments whose rows differ from their binlogs
+// could be repaired by releasing them, then the segment checker loads them again. A segment is only released while
+// another node still serves it, and at most queryCoord.rowCountCheck.maxRepairTimes times before it's left to the operators.
+type RowCountObserver struct {
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	meta      *meta.Meta
+	distMgr   *meta.DistributionManager
+	targetMgr meta.TargetManagerInterface
+	broker    meta.Broker
+	scheduler qctask.Scheduler
+
+	// serialize the periodic check and the manual check
+	checkMut sync.Mutex
+	stopOnce sync.Once
+
+	// collection id -> segment id -> the times of the repairs scheduled for the segment, guarded by checkMut
+	repairTimes map[int64]map[int64]int
+}
+
+func NewRowCountObserver(
+	meta *meta.Meta,
+	distMgr *meta.DistributionManager,
+	targetMgr meta.TargetManagerInterface,
+	broker meta.Broker,
+	scheduler qctask.Scheduler,
+) *RowCountObserver {
+	return &RowCountObserver{
+		meta:      meta,
+		distMgr:   distMgr,
+		targetMgr: targetMgr,
+		broker:    broker,
+		scheduler: scheduler,
+
+		repairTimes: make(map[int64]map[int64]int),
+	}
+}
+
+func (ob *RowCountObserver) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ob.cancel = cancel
+
+	ob.wg.Add(1)
+	go ob.schedule(ctx)
+}
+
+func (ob *RowCountObserver) Stop() {
+	ob.stopOnce.Do(func() {
+		if ob.cancel != nil {
+			ob.cancel()
+		}
+		ob.wg.Wait()
+	})
+}
+
+func (ob *RowCountObserver) schedule(ctx context.Context) {
+	defer ob.wg.Done()
+	log.Info("Start row count check loop")
+
+	interval := params.Params.QueryCoordCfg.RowCountCheckInterval.GetAsDuration(time.Second)
+	if interval <= 0 {
+		log.Info("periodic row count check is disabled")
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Stop row count observer")
+			return
+		case <-ticker.C:
+			ob.Check(ctx, 0, params.Params.QueryCoordCfg.RowCountCheckAutoRepair.GetAsBool())
+		}
+	}
+}
+
+// Check checks the collection, or all the loaded collections if collectionID is 0,
+// it returns the discrepancies found and the number of the segments checked.
+func (ob *RowCountObserver) Check(ctx context.Context, collectionID int64, repair bool) ([]*querypb.RowCountDiscrepancy, int64) {
+	ob.checkMut.Lock()
+	defer ob.checkMut.Unlock()
+
+	collections := ob.meta.GetAll()
+	// forget the repairs of the released collections
+	for id := range ob.repairTimes {
+		if !lo.Contains(collections, id) {
+			delete(ob.repairTimes, id)
+		}
+	}
+	if collectionID != 0 {
+		collections = lo.Filter(collections, func(id int64, _ int) bool { return id == collectionID })
+	}
+
+	discrepancies := make([]*querypb.RowCountDiscrepancy, 0)
+	checked := int64(0)
+	for _, collection := range collections {
+		result, num := ob.checkCollection(ctx, collection, repair)
+		discrepancies = append(discrepancies, result...)
+		checked += num
+	}
+	return discrepancies, checked
+}
+
+func (ob *RowCountObserver) checkCollection(ctx context.Context, collectionID int64, repair bool) ([]*querypb.RowCountDiscrepancy, int64) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+
+	targetSegments := ob.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget)
+	segmentIDs := make([]int64, 0, len(targetSegments))
+	for _, segment := range targetSegments {
+		if segment.GetLevel() != datapb.SegmentLevel_L0 {
+			segmentIDs = append(segmentIDs, segment.GetID())
+		}
+	}
+	if len(segmentIDs) == 0 {
+		return nil, 0
+	}
+
+	resp, err := ob.broker.DescribeCollection(ctx, collectionID)
+	if err != nil {
+		log.Warn("failed to describe collection for row count check", zap.Error(err))
+		return nil, 0
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(resp.GetSchema())
+	if err != nil {
+		log.Warn("failed to get primary key field for row count check", zap.Error(err))
+		return nil, 0
+	}
+	infos, err := ob.broker.GetSegmentInfo(ctx, segmentIDs...)
+	if err != nil {
+		log.Warn("failed to get segment info for row count check", zap.Error(err))
+		return nil, 0
+	}
+
+	discrepancies := make([]*querypb.RowCountDiscrepancy, 0)
+	checked := int64(0)
+	// only keep the repair times of the segments still mismatched, so a segment gets repaired again once it's fixed
+	repairTimes := ob.repairTimes[collectionID]
+	if repairTimes == nil {
+		repairTimes = make(map[int64]int)
+	}
+	ob.repairTimes[collectionID] = make(map[int64]int)
+	for _, info := range infos.GetInfos() {
+		binlogRows := binlogRowsByField(info)
+		pkRows, ok := binlogRows[pkField.GetFieldID()]
+		if !ok {
+			// no binlog to compare with, e.g. the segment is empty
+			continue
+		}
+		checked++
+
+		newDiscrepancy := func(kind querypb.RowCountDiscrepancyKind, expected, actual int64) *querypb.RowCountDiscrepancy {
+			return &querypb.RowCountDiscrepancy{
+				CollectionID: collectionID,
+				PartitionID:  info.GetPartitionID(),
+				SegmentID:    info.GetID(),
+				Kind:         kind,
+				ExpectedRows: expected,
+				ActualRows:   actual,
+			}
+		}
+
+		if info.GetNumOfRows() != pkRows {
+			discrepancies = append(discrepancies, newDiscrepancy(querypb.RowCountDiscrepancyKind_MetaBinlogMismatch, pkRows, info.GetNumOfRows()))
+		}
+		for fieldID, rows := range binlogRows {
+			if rows != pkRows {
+				discrepancy := newDiscrepancy(querypb.RowCountDiscrepancyKind_FieldBinlogMismatch, pkRows, rows)
+				discrepancy.FieldID = fieldID
+				discrepancies = append(discrepancies, discrepancy)
+			}
+		}
+
+		loaded := ob.distMgr.SegmentDistManager.GetByFilter(meta.WithSegmentID(info.GetID()))
+		mismatched := false
+		for _, segment := range loaded {
+			// the loaded rows are unknown if the segment is lazy loaded or the query node is of the old version
+			if segment.LoadedRows == 0 || segment.LoadedRows == pkRows {
+				continue
+			}
+			mismatched = true
+			discrepancy := newDiscrepancy(querypb.RowCountDiscrepancyKind_LoadedRowsMismatch, pkRows, segment.LoadedRows)
+			discrepancy.NodeID = segment.Node
+			replica := ob.meta.ReplicaManager.GetByCollectionAndNode(collectionID, segment.Node)
+			if replica != nil {
+				discrepancy.ReplicaID = replica.GetID()
+				if repair && ob.canRepair(segment, loaded, pkRows, repairTimes[info.GetID()]) {
+					discrepancy.RepairScheduled = ob.repair(ctx, replica, segment)
+					if discrepancy.RepairScheduled {
+						repairTimes[info.GetID()]++
+					}
+				}
+			}
+			discrepancies = append(discrepancies, discrepancy)
+		}
+		if mismatched && repairTimes[info.GetID()] > 0 {
+			ob.repairTimes[collectionID][info.GetID()] = repairTimes[info.GetID()]
+		}
+	}
+
+	ob.updateMetrics(collectionID, discrepancies)
+	for _, discrepancy := range discrepancies {
+		log.Warn("found row count discrepancy",
+			zap.Int64("segmentID", discrepancy.GetSegmentID()),
+			zap.String("kind", discrepancy.GetKind().String()),
+			zap.Int64("fieldID", discrepancy.GetFieldID()),
+			zap.Int64("nodeID", discrepancy.GetNodeID()),
+			zap.Int64("expectedRows", discrepancy.GetExpectedRows()),
+			zap.Int64("actualRows", discrepancy.GetActualRows()),
+			zap.Bool("repairScheduled", discrepancy.GetRepairScheduled()),
+		)
+	}
+	return discrepancies, checked
+}
+
+// canRepair checks whether the segment could be released from the node, it's not if no other node serves the segment
+// without a known row count mismatch, as the queries would miss it until it's loaded again, or it has been repaired
+// too many times.
+func (ob *RowCountObserver) canRepair(segment *meta.Segment, loaded []*meta.Segment, expectedRows int64, repairTimes int) bool {
+	maxRepairTimes := params.Params.QueryCoordCfg.RowCountCheckMaxRepairTimes.GetAsInt()
+	if repairTimes >= maxRepairTimes {
+		log.Warn("skip row count repair as the segment has been repaired too many times",
+			zap.Int64("collectionID", segment.GetCollectionID()),
+			zap.Int64("segmentID", segment.GetID()),
+			zap.Int64("nodeID", segment.Node),
+			zap.Int("repairTimes", repairTimes))
+		return false
+	}
+
+	served := lo.ContainsBy(loaded, func(other *meta.Segment) bool {
+		return other.Node != segment.Node && (other.LoadedRows == 0 || other.LoadedRows == expectedRows)
+	})
+	if !served {
+		log.Warn("skip row count repair as no other node serves the segment",
+			zap.Int64("collectionID", segment.GetCollectionID()),
+			zap.Int64("segmentID", segment.GetID()),
+			zap.Int64("nodeID", segment.Node))
+		return false
+	}
+	return true
+}
+
+// repair releases the segment from the node, the segment checker loads it again as it's lacked by the replica.
+func (ob *RowCountObserver) repair(ctx context.Context, replica *meta.Replica, segment *meta.Segment) bool {
+	action := qctask.NewSegmentActionWithScope(segment.Node, qctask.ActionTypeReduce, segment.GetInsertChannel(), segment.GetID(), querypb.DataScope_Historical)
+	t, err := qctask.NewSegmentTask(ctx,
+		params.Params.QueryCoordCfg.SegmentTaskTimeout.GetAsDuration(time.Millisecond),
+		utils.RowCountRepair,
+		replica.GetCollectionID(),
+		replica,
+		action,
+	)
+	if err != nil {
+		log.Warn("failed to create row count repair task", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		return false
+	}
+	if err := ob.scheduler.Add(t); err != nil {
+		log.Warn("failed to add row count repair task", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		return false
+	}
+	metrics.QueryCoordRowCountRepairCount.WithLabelValues(fmt.Sprint(replica.GetCollectionID())).Inc()
+	return true
+}
+
+func (ob *RowCountObserver) updateMetrics(collectionID int64, discrepancies []*querypb.RowCountDiscrepancy) {
+	counts := make(map[querypb.RowCountDiscrepancyKind]int)
+	for _, discrepancy := range discrepancies {
+		counts[discrepancy.GetKind()]++
+	}
+	for _, kind := range []querypb.RowCountDiscrepancyKind{
+		querypb.RowCountDiscrepancyKind_MetaBinlogMismatch,
+		querypb.RowCountDiscrepancyKind_FieldBinlogMismatch,
+		querypb.RowCountDiscrepancyKind_LoadedRowsMismatch,
+	} {
+		metrics.QueryCoordRowCountDiscrepancyNum.WithLabelValues(fmt.Sprint(collectionID), kind.String()).Set(float64(counts[kind]))
+	}
+}
+
+// binlogRowsByField returns the rows in the binlogs of each field.
+func binlogRowsByField(info *datapb.SegmentInfo) map[int64]int64 {
+	rows := make(map[int64]int64, len(info.GetBinlogs()))
+	for _, fieldBinlog := range info.GetBinlogs() {
+		rows[fieldBinlog.GetFieldID()] = lo.SumBy(fieldBinlog.GetBinlogs(), func(binlog *datapb.Binlog) int64 {
+			return binlog.GetEntriesNum()
+		})
+	}
+	return rows
+}
@@ -0,0 +1,57 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observers
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+func TestBinlogRowsByField(t *testing.T) {
+	rows := binlogRowsByField(&datapb.SegmentInfo{
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{EntriesNum: 10}, {EntriesNum: 5}}},
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{EntriesNum: 15}}},
+		},
+	})
+	assert.Equal(t, map[int64]int64{100: 15, 101: 15}, rows)
+
+	rows = binlogRowsByField(&datapb.SegmentInfo{})
+	assert.Empty(t, rows)
+}
+
+func TestRowCountObserverUpdateMetrics(t *testing.T) {
+	ob := &RowCountObserver{}
+	ob.updateMetrics(1, []*querypb.RowCountDiscrepancy{
+		{SegmentID: 1, Kind: querypb.RowCountDiscrepancyKind_LoadedRowsMismatch},
+		{SegmentID: 2, Kind: querypb.RowCountDiscrepancyKind_LoadedRowsMismatch},
+		{SegmentID: 3, Kind: querypb.RowCountDiscrepancyKind_MetaBinlogMismatch},
+	})
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.QueryCoordRowCountDiscrepancyNum.WithLabelValues("1", querypb.RowCountDiscrepancyKind_LoadedRowsMismatch.String())))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.QueryCoordRowCountDiscrepancyNum.WithLabelValues("1", querypb.RowCountDiscrepancyKind_MetaBinlogMismatch.String())))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.QueryCoordRowCountDiscrepancyNum.WithLabelValues("1", querypb.RowCountDiscrepancyKind_FieldBinlogMismatch.String())))
+
+	// the discrepancies are gone after the repair
+	ob.updateMetrics(1, nil)
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.QueryCoordRowCountDiscrepancyNum.WithLabelValues("1", querypb.RowCountDiscrepancyKind_LoadedRowsMismatch.String())))
+}
@@ -26,12 +26,16 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/lock"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -67,6 +71,10 @@ type TargetObserver struct {
 	updateChan           chan targetUpdateRequest
 	mut                  sync.Mutex                // Guard readyNotifiers
 	readyNotifiers       map[int64][]chan struct{} // CollectionID -> Notifiers
+	// collectionIndexes records the index IDs of the loaded collections seen in last check
+	collectionIndexes *typeutil.ConcurrentMap[int64, typeutil.UniqueSet]
+	// handoffCollections are the collections handing off the indexed segments since their indexes changed
+	handoffCollections *typeutil.ConcurrentSet[int64]
 
 	dispatcher *taskDispatcher[int64]
 	keylocks   *lock.KeyLock[int64]
@@ -91,6 +99,8 @@ func NewTargetObserver(
 		nextTargetLastUpdate: typeutil.NewConcurrentMap[int64, time.Time](),
 		updateChan:           make(chan targetUpdateRequest),
 		readyNotifiers:       make(map[int64][]chan struct{}),
+		collectionIndexes:    typeutil.NewConcurrentMap[int64, typeutil.UniqueSet](),
+		handoffCollections:   typeutil.NewConcurrentSet[int64](),
 		initChan:             make(chan initRequest),
 		keylocks:             lock.NewKeyLock[int64](),
 	}
@@ -196,6 +206,12 @@ func (ob *TargetObserver) check(ctx context.Context, collectionID int64) {
 		ob.updateCurrentTarget(collectionID)
 	}
 
+	if ob.checkIndexHandoff(ctx, collectionID) {
+		// pull the next target in every round during handoff,
+		// so that the indexed sealed segments are handed off without waiting for the next target expired
+		ob.nextTargetLastUpdate.Remove(collectionID)
+	}
+
 	if ob.shouldUpdateNextTarget(collectionID) {
 		// update next target in collection level
 		ob.updateNextTarget(collectionID)
@@ -250,6 +266,13 @@ func (ob *TargetObserver) clean() {
 		}
 		return true
 	})
+	ob.collectionIndexes.Range(func(collectionID int64, _ typeutil.UniqueSet) bool {
+		if !collectionSet.Contain(collectionID) {
+			ob.collectionIndexes.Remove(collectionID)
+			ob.handoffCollections.Remove(collectionID)
+		}
+		return true
+	})
 
 	ob.mut.Lock()
 	defer ob.mut.Unlock()
@@ -290,6 +313,89 @@ func (ob *TargetObserver) updateNextTarget(collectionID int64) error {
 	return nil
 }
 
+// checkIndexHandoff reports the progress of serving the sealed segments in current target with all the indexes,
+// the indexes built after the collection loaded are loaded by the index checker,
+// and the indexed segments compacted from the serving ones are handed off by the next target.
+// Returns whether the collection is handing off, since its indexes changed until all the segments are indexed.
+func (ob *TargetObserver) checkIndexHandoff(ctx context.Context, collectionID int64) bool {
+	if !ob.targetMgr.IsCurrentTargetExist(collectionID, common.AllPartitionsID) {
+		return false
+	}
+
+	log := log.Ctx(ctx).WithRateGroup(fmt.Sprintf("qcv2.TargetObserver.IndexHandoff-%d", collectionID), 1, 60).
+		With(zap.Int64("collectionID", collectionID))
+	indexInfos, err := ob.broker.ListIndexes(ctx, collectionID)
+	if err != nil {
+		log.Warn("failed to list indexes", zap.Error(err))
+		return false
+	}
+
+	indexIDs := typeutil.NewUniqueSet(lo.Map(indexInfos, func(info *indexpb.IndexInfo, _ int) int64 {
+		return info.GetIndexID()
+	})...)
+	lastIndexIDs, ok := ob.collectionIndexes.GetOrInsert(collectionID, indexIDs)
+	changed := ok && (lastIndexIDs.Len() != indexIDs.Len() || !lastIndexIDs.Contain(indexIDs.Collect()...))
+	if changed {
+		log.Info("indexes of loaded collection changed, start to hand off indexed segments",
+			zap.Int64s("oldIndexIDs", lastIndexIDs.Collect()),
+			zap.Int64s("newIndexIDs", indexIDs.Collect()))
+		ob.collectionIndexes.Insert(collectionID, indexIDs)
+		ob.handoffCollections.Insert(collectionID)
+	}
+
+	// the small segments are never indexed, see datacoord
+	minRowsToIndex := paramtable.Get().DataCoordCfg.MinSegmentNumRowsToEnableIndex.GetAsInt64()
+	segments := ob.targetMgr.GetSealedSegmentsByCollection(collectionID, meta.CurrentTarget)
+	total, indexed := 0, 0
+	for _, replica := range ob.meta.ReplicaManager.GetByCollection(collectionID) {
+		roNodes := typeutil.NewUniqueSet(replica.GetRONodes()...)
+		served := make(map[int64]bool)
+		for _, segment := range ob.distMgr.SegmentDistManager.GetByFilter(meta.WithCollectionID(collectionID), meta.WithReplica(replica)) {
+			if !roNodes.Contain(segment.Node) && isSegmentIndexed(segment, indexInfos) {
+				served[segment.GetID()] = true
+			}
+		}
+		for _, segment := range segments {
+			if segment.GetLevel() == datapb.SegmentLevel_L0 {
+				continue
+			}
+			total++
+			if len(indexInfos) == 0 || served[segment.GetID()] || segment.GetNumOfRows() < minRowsToIndex {
+				indexed++
+			}
+		}
+	}
+
+	progress := 100
+	if total > 0 {
+		progress = indexed * 100 / total
+	}
+	metrics.QueryCoordIndexHandoffProgress.WithLabelValues(fmt.Sprint(collectionID)).Set(float64(progress))
+	if !ob.handoffCollections.Contain(collectionID) {
+		return false
+	}
+	if progress < 100 {
+		log.RatedInfo(10, "handing off indexed segments",
+			zap.Int("indexedSegmentNum", indexed),
+			zap.Int("segmentNum", total))
+		return true
+	}
+	log.Info("all segments are indexed, index handoff done")
+	ob.handoffCollections.Remove(collectionID)
+	return false
+}
+
+// isSegmentIndexed returns whether the segment is served with all the indexes.
+func isSegmentIndexed(segment *meta.Segment, indexInfos []*indexpb.IndexInfo) bool {
+	for _, indexInfo := range indexInfos {
+		info, ok := segment.IndexInfo[indexInfo.GetFieldID()]
+		if !ok || info.GetIndexID() != indexInfo.GetIndexID() || !info.GetEnableIndex() {
+			return false
+		}
+	}
+	return true
+}
+
 func (ob *TargetObserver) updateNextTargetTimestamp(collectionID int64) {
 	ob.nextTargetLastUpdate.Insert(collectionID, time.Now())
 }
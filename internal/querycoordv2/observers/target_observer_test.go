@@ -18,15 +18,19 @@ package observers
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
@@ -34,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/kv"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -124,6 +129,7 @@ func (suite *TargetObserverSuite) SetupTest() {
 	}
 
 	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, mock.Anything).Return(suite.nextTargetChannels, suite.nextTargetSegments, nil)
+	suite.broker.EXPECT().ListIndexes(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	suite.observer.Start()
 }
 
@@ -170,6 +176,7 @@ func (suite *TargetObserverSuite) TestTriggerUpdateTarget() {
 	suite.broker.EXPECT().
 		GetRecoveryInfoV2(mock.Anything, mock.Anything).
 		Return(suite.nextTargetChannels, suite.nextTargetSegments, nil)
+	suite.broker.EXPECT().ListIndexes(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 	suite.Eventually(func() bool {
 		return len(suite.targetMgr.GetSealedSegmentsByCollection(suite.collectionID, meta.NextTarget)) == 3 &&
 			len(suite.targetMgr.GetDmChannelsByCollection(suite.collectionID, meta.NextTarget)) == 2
@@ -290,6 +297,58 @@ func (s *TargetObserverCheckSuite) TestCheck() {
 	s.True(s.observer.dispatcher.tasks.Contain(s.collectionID))
 }
 
+func (s *TargetObserverCheckSuite) TestCheckIndexHandoff() {
+	ctx := context.Background()
+	s.False(s.observer.checkIndexHandoff(ctx, s.collectionID))
+
+	s.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, mock.Anything).Return(
+		[]*datapb.VchannelInfo{{CollectionID: s.collectionID, ChannelName: "channel-1"}},
+		[]*datapb.SegmentInfo{
+			{ID: 11, PartitionID: s.partitionID, InsertChannel: "channel-1", NumOfRows: 2048},
+			{ID: 12, PartitionID: s.partitionID, InsertChannel: "channel-1", NumOfRows: 2048},
+			{ID: 13, PartitionID: s.partitionID, InsertChannel: "channel-1", NumOfRows: 1},
+			{ID: 14, PartitionID: s.partitionID, InsertChannel: "channel-1", NumOfRows: 2048, Level: datapb.SegmentLevel_L0},
+		}, nil)
+	s.NoError(s.targetMgr.UpdateCollectionNextTarget(s.collectionID))
+	s.True(s.targetMgr.UpdateCollectionCurrentTarget(s.collectionID))
+	progress := func() float64 {
+		return testutil.ToFloat64(metrics.QueryCoordIndexHandoffProgress.WithLabelValues(fmt.Sprint(s.collectionID)))
+	}
+
+	// no index
+	s.broker.EXPECT().ListIndexes(mock.Anything, s.collectionID).Return(nil, nil).Once()
+	s.False(s.observer.checkIndexHandoff(ctx, s.collectionID))
+	s.EqualValues(100, progress())
+
+	// index created on the loaded collection
+	indexInfos := []*indexpb.IndexInfo{{FieldID: 101, IndexID: 1001}}
+	s.broker.EXPECT().ListIndexes(mock.Anything, s.collectionID).Return(indexInfos, nil).Once()
+	s.True(s.observer.checkIndexHandoff(ctx, s.collectionID))
+	s.EqualValues(33, progress())
+
+	indexed := map[int64]*querypb.FieldIndexInfo{101: {FieldID: 101, IndexID: 1001, EnableIndex: true}}
+	s.distMgr.SegmentDistManager.Update(2,
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 11, CollectionID: s.collectionID}, Node: 2, IndexInfo: indexed},
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 12, CollectionID: s.collectionID}, Node: 2},
+	)
+	s.broker.EXPECT().ListIndexes(mock.Anything, s.collectionID).Return(indexInfos, nil).Once()
+	s.True(s.observer.checkIndexHandoff(ctx, s.collectionID))
+	s.EqualValues(66, progress())
+
+	s.distMgr.SegmentDistManager.Update(2,
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 11, CollectionID: s.collectionID}, Node: 2, IndexInfo: indexed},
+		&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 12, CollectionID: s.collectionID}, Node: 2, IndexInfo: indexed},
+	)
+	s.broker.EXPECT().ListIndexes(mock.Anything, s.collectionID).Return(indexInfos, nil).Once()
+	s.False(s.observer.checkIndexHandoff(ctx, s.collectionID))
+	s.EqualValues(100, progress())
+	s.False(s.observer.handoffCollections.Contain(s.collectionID))
+
+	// failed to list indexes
+	s.broker.EXPECT().ListIndexes(mock.Anything, s.collectionID).Return(nil, errors.New("mock error")).Once()
+	s.False(s.observer.checkIndexHandoff(ctx, s.collectionID))
+}
+
 func TestTargetObserver(t *testing.T) {
 	suite.Run(t, new(TargetObserverSuite))
 	suite.Run(t, new(TargetObserverCheckSuite))
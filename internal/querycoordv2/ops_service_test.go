@@ -19,6 +19,7 @@ package querycoordv2
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
@@ -26,6 +27,8 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
@@ -144,7 +147,15 @@ func (suite *OpsServiceSuite) SetupTest() {
 		distController:      suite.distController,
 		ctx:                 context.Background(),
 		checkerController:   suite.checkerController,
+		safeRemoveTracker:   newSafeRemoveTracker(),
 	}
+	suite.server.rowCountObserver = observers.NewRowCountObserver(
+		suite.server.meta,
+		suite.server.dist,
+		suite.server.targetMgr,
+		suite.server.broker,
+		suite.server.taskScheduler,
+	)
 	suite.server.collectionObserver = observers.NewCollectionObserver(
 		suite.server.dist,
 		suite.server.meta,
@@ -452,6 +463,69 @@ func (suite *OpsServiceSuite) TestSuspendAndResumeNode() {
 	suite.Equal(session.NodeStateNormal, node.GetState())
 }
 
+func (suite *OpsServiceSuite) TestSafeRemoveNode() {
+	interval := safeRemoveCheckInterval
+	safeRemoveCheckInterval = 10 * time.Millisecond
+	defer func() { safeRemoveCheckInterval = interval }()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	ctx := context.Background()
+	resp, err := suite.server.SafeRemoveNode(ctx, &querypb.SafeRemoveNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp))
+
+	progress, err := suite.server.GetSafeRemoveNodeProgress(ctx, &querypb.GetSafeRemoveNodeProgressRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.False(merr.Ok(progress.GetStatus()))
+
+	// test node not found
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.SafeRemoveNode(ctx, &querypb.SafeRemoveNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp))
+
+	progress, err = suite.server.GetSafeRemoveNodeProgress(ctx, &querypb.GetSafeRemoveNodeProgressRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(progress.GetStatus()), merr.ErrNodeNotFound)
+
+	for _, nodeID := range []int64{1, 2} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   nodeID,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+	}
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1}))
+
+	// test no other node in the replica to take over
+	resp, err = suite.server.SafeRemoveNode(ctx, &querypb.SafeRemoveNodeRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	suite.Eventually(func() bool {
+		progress, err := suite.server.GetSafeRemoveNodeProgress(ctx, &querypb.GetSafeRemoveNodeProgressRequest{NodeID: 1})
+		return err == nil && progress.GetStage() == querypb.SafeRemoveStage_SafeRemoveStageFailed
+	}, 5*time.Second, 10*time.Millisecond)
+	progress, err = suite.server.GetSafeRemoveNodeProgress(ctx, &querypb.GetSafeRemoveNodeProgressRequest{NodeID: 1})
+	suite.NoError(err)
+	suite.Contains(progress.GetFailReason(), "no available node")
+	suite.Equal(session.NodeStateNormal, suite.nodeMgr.Get(1).GetState())
+
+	// test success
+	resp, err = suite.server.SafeRemoveNode(ctx, &querypb.SafeRemoveNodeRequest{NodeID: 2})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	suite.Eventually(func() bool {
+		progress, err := suite.server.GetSafeRemoveNodeProgress(ctx, &querypb.GetSafeRemoveNodeProgressRequest{NodeID: 2})
+		return err == nil && progress.GetStage() == querypb.SafeRemoveStage_SafeRemoveStageRemoved
+	}, 5*time.Second, 10*time.Millisecond)
+	suite.Equal(session.NodeStateStopping, suite.nodeMgr.Get(2).GetState())
+
+	// test the node is being removed
+	suite.NoError(suite.server.safeRemoveTracker.start(3))
+	suite.Error(suite.server.safeRemoveTracker.start(3))
+}
+
 func (suite *OpsServiceSuite) TestTransferSegment() {
 	ctx := context.Background()
 
@@ -898,6 +972,119 @@ func (suite *OpsServiceSuite) TestTransferChannel() {
 	suite.Len(nodeSet.Collect(), 3)
 }
 
+func (suite *OpsServiceSuite) TestCheckRowCountConsistency() {
+	ctx := context.Background()
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := suite.server.CheckRowCountConsistency(ctx, &querypb.CheckRowCountConsistencyRequest{})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp.GetStatus()))
+
+	// test collection not loaded
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.CheckRowCountConsistency(ctx, &querypb.CheckRowCountConsistencyRequest{CollectionID: 1})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	collectionID := int64(1)
+	suite.meta.PutCollection(utils.CreateTestCollection(collectionID, 1), utils.CreateTestPartition(collectionID, 1))
+	suite.meta.ReplicaManager.Put(utils.CreateTestReplica(1, collectionID, []int64{1, 2}))
+
+	fieldBinlogs := func(pkRows, vectorRows int64) []*datapb.FieldBinlog {
+		return []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{EntriesNum: pkRows}}},
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{EntriesNum: vectorRows}}},
+		}
+	}
+	segments := []*datapb.SegmentInfo{
+		// consistent
+		{ID: 1, CollectionID: collectionID, PartitionID: 1, InsertChannel: "channel-1", NumOfRows: 10, Binlogs: fieldBinlogs(10, 10)},
+		// the meta row count differs from the binlogs
+		{ID: 2, CollectionID: collectionID, PartitionID: 1, InsertChannel: "channel-1", NumOfRows: 12, Binlogs: fieldBinlogs(10, 10)},
+		// the binlogs of the vector field miss rows
+		{ID: 3, CollectionID: collectionID, PartitionID: 1, InsertChannel: "channel-1", NumOfRows: 10, Binlogs: fieldBinlogs(10, 8)},
+		// the loaded segment misses rows
+		{ID: 4, CollectionID: collectionID, PartitionID: 1, InsertChannel: "channel-1", NumOfRows: 10, Binlogs: fieldBinlogs(10, 10)},
+	}
+	channels := []*datapb.VchannelInfo{{CollectionID: collectionID, ChannelName: "channel-1"}}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(channels, segments, nil)
+	suite.targetMgr.UpdateCollectionNextTarget(collectionID)
+	suite.targetMgr.UpdateCollectionCurrentTarget(collectionID)
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, collectionID).Return(&milvuspb.DescribeCollectionResponse{
+		Status: merr.Success(),
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	}, nil)
+	suite.broker.EXPECT().GetSegmentInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&datapb.GetSegmentInfoResponse{
+		Status: merr.Success(),
+		Infos:  segments,
+	}, nil)
+	suite.dist.SegmentDistManager.Update(1,
+		&meta.Segment{SegmentInfo: segments[0], Node: 1, LoadedRows: 10},
+		// the loaded rows are unknown
+		&meta.Segment{SegmentInfo: segments[1], Node: 1},
+		&meta.Segment{SegmentInfo: segments[2], Node: 1, LoadedRows: 10},
+		&meta.Segment{SegmentInfo: segments[3], Node: 1, LoadedRows: 8},
+	)
+
+	checkDiscrepancies := func(resp *querypb.CheckRowCountConsistencyResponse, repaired bool) {
+		suite.True(merr.Ok(resp.GetStatus()))
+		suite.EqualValues(4, resp.GetCheckedSegments())
+		suite.Len(resp.GetDiscrepancies(), 3)
+		discrepancies := lo.SliceToMap(resp.GetDiscrepancies(), func(d *querypb.RowCountDiscrepancy) (int64, *querypb.RowCountDiscrepancy) {
+			return d.GetSegmentID(), d
+		})
+		suite.Equal(querypb.RowCountDiscrepancyKind_MetaBinlogMismatch, discrepancies[2].GetKind())
+		suite.EqualValues(10, discrepancies[2].GetExpectedRows())
+		suite.EqualValues(12, discrepancies[2].GetActualRows())
+		suite.Equal(querypb.RowCountDiscrepancyKind_FieldBinlogMismatch, discrepancies[3].GetKind())
+		suite.EqualValues(101, discrepancies[3].GetFieldID())
+		suite.EqualValues(8, discrepancies[3].GetActualRows())
+		suite.Equal(querypb.RowCountDiscrepancyKind_LoadedRowsMismatch, discrepancies[4].GetKind())
+		suite.EqualValues(1, discrepancies[4].GetNodeID())
+		suite.EqualValues(1, discrepancies[4].GetReplicaID())
+		suite.EqualValues(8, discrepancies[4].GetActualRows())
+		suite.Equal(repaired, discrepancies[4].GetRepairScheduled())
+	}
+
+	// test check only
+	resp, err = suite.server.CheckRowCountConsistency(ctx, &querypb.CheckRowCountConsistencyRequest{CollectionID: collectionID})
+	suite.NoError(err)
+	checkDiscrepancies(resp, false)
+
+	// test repair, expect no task as no other node serves the segment
+	resp, err = suite.server.CheckRowCountConsistency(ctx, &querypb.CheckRowCountConsistencyRequest{Repair: true})
+	suite.NoError(err)
+	checkDiscrepancies(resp, false)
+
+	// test repair, expect generate 1 reduce segment task for the loaded rows mismatch
+	suite.dist.SegmentDistManager.Update(2, &meta.Segment{SegmentInfo: segments[3], Node: 2, LoadedRows: 10})
+	paramtable.Get().Save(paramtable.Get().QueryCoordCfg.RowCountCheckMaxRepairTimes.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().QueryCoordCfg.RowCountCheckMaxRepairTimes.Key)
+	suite.taskScheduler.EXPECT().Add(mock.Anything).RunAndReturn(func(t task.Task) error {
+		actions := t.Actions()
+		suite.Len(actions, 1)
+		suite.Equal(task.ActionTypeReduce, actions[0].Type())
+		suite.EqualValues(1, actions[0].Node())
+		suite.EqualValues(4, actions[0].(*task.SegmentAction).SegmentID())
+		suite.Equal(utils.RowCountRepair, t.Source())
+		return nil
+	}).Once()
+	resp, err = suite.server.CheckRowCountConsistency(ctx, &querypb.CheckRowCountConsistencyRequest{Repair: true})
+	suite.NoError(err)
+	checkDiscrepancies(resp, true)
+
+	// test repair, expect no task as the segment has been repaired too many times
+	resp, err = suite.server.CheckRowCountConsistency(ctx, &querypb.CheckRowCountConsistencyRequest{Repair: true})
+	suite.NoError(err)
+	checkDiscrepancies(resp, false)
+}
+
 func TestOpsService(t *testing.T) {
 	suite.Run(t, new(OpsServiceSuite))
 }
@@ -389,6 +389,78 @@ func (s *Server) TransferChannel(ctx context.Context, req *querypb.TransferChann
 	return merr.Success(), nil
 }
 
+// SafeRemoveNode removes the query node after its segments and channels are served by the other nodes,
+// the removal runs in background and its progress is reported by GetSafeRemoveNodeProgress.
+func (s *Server) SafeRemoveNode(ctx context.Context, req *querypb.SafeRemoveNodeRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	log.Info("SafeRemoveNode request received")
+
+	errMsg := "failed to remove query node safely"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if s.nodeMgr.Get(req.GetNodeID()) == nil {
+		err := merr.WrapErrNodeNotFound(req.GetNodeID(), errMsg)
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := s.safeRemoveTracker.start(req.GetNodeID()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	// stop loading new segments and channels to the node
+	if err := s.nodeMgr.Suspend(req.GetNodeID()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		s.safeRemoveTracker.setStage(req.GetNodeID(), querypb.SafeRemoveStage_SafeRemoveStageFailed, err.Error())
+		return merr.Status(err), nil
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.safeRemoveNode(s.ctx, req.GetNodeID())
+	}()
+	return merr.Success(), nil
+}
+
+func (s *Server) GetSafeRemoveNodeProgress(ctx context.Context, req *querypb.GetSafeRemoveNodeProgressRequest) (*querypb.GetSafeRemoveNodeProgressResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", req.GetNodeID()))
+	log.Debug("GetSafeRemoveNodeProgress request received")
+
+	errMsg := "failed to get the progress of removing query node safely"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetSafeRemoveNodeProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	progress, ok := s.safeRemoveTracker.get(req.GetNodeID())
+	if !ok {
+		err := merr.WrapErrNodeNotFound(req.GetNodeID(), "the node is not being removed")
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.GetSafeRemoveNodeProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	channels, segments := s.countNodeDist(req.GetNodeID())
+	return &querypb.GetSafeRemoveNodeProgressResponse{
+		Status:            merr.Success(),
+		NodeID:            req.GetNodeID(),
+		Stage:             progress.stage,
+		RemainingChannels: int64(channels),
+		RemainingSegments: int64(segments),
+		FailReason:        progress.failReason,
+		StartTime:         progress.startTime.UnixMilli(),
+		StageTime:         progress.stageTime.UnixMilli(),
+	}, nil
+}
+
 func (s *Server) CheckQueryNodeDistribution(ctx context.Context, req *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error) {
 	log := log.Ctx(ctx)
 
@@ -454,3 +526,33 @@ func (s *Server) CheckQueryNodeDistribution(ctx context.Context, req *querypb.Ch
 
 	return merr.Success(), nil
 }
+
+// CheckRowCountConsistency compares the row counts of the loaded segments among the segment meta,
+// the binlogs and the query nodes, and reloads the segments whose loaded rows differ from their binlogs if repair is set.
+func (s *Server) CheckRowCountConsistency(ctx context.Context, req *querypb.CheckRowCountConsistencyRequest) (*querypb.CheckRowCountConsistencyResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()), zap.Bool("repair", req.GetRepair()))
+	log.Info("CheckRowCountConsistency request received")
+
+	errMsg := "failed to check row count consistency"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.CheckRowCountConsistencyResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if req.GetCollectionID() != 0 && !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return &querypb.CheckRowCountConsistencyResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	discrepancies, checked := s.rowCountObserver.Check(ctx, req.GetCollectionID(), req.GetRepair())
+	return &querypb.CheckRowCountConsistencyResponse{
+		Status:          merr.Success(),
+		Discrepancies:   discrepancies,
+		CheckedSegments: checked,
+	}, nil
+}
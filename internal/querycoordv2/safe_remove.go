@@ -0,0 +1,252 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// safeRemoveCheckInterval is the interval to check whether the stage of the safe removal is done.
+var safeRemoveCheckInterval = time.Second
+
+type safeRemoveProgress struct {
+	nodeID     int64
+	stage      querypb.SafeRemoveStage
+	failReason string
+	startTime  time.Time
+	stageTime  time.Time
+}
+
+// safeRemoveTracker tracks the progress of the query nodes being removed safely,
+// the progress is kept in memory and lost if QueryCoord restarts.
+type safeRemoveTracker struct {
+	mu       sync.RWMutex
+	progress map[int64]*safeRemoveProgress
+}
+
+func newSafeRemoveTracker() *safeRemoveTracker {
+	return &safeRemoveTracker{
+		progress: make(map[int64]*safeRemoveProgress),
+	}
+}
+
+// start begins to track the removal of the node, it fails if the node is being removed.
+func (t *safeRemoveTracker) start(nodeID int64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if p, ok := t.progress[nodeID]; ok && isSafeRemoving(p.stage) {
+		return merr.WrapErrNodeStateUnexpected(nodeID, p.stage.String(), "the node is being removed")
+	}
+	now := time.Now()
+	t.progress[nodeID] = &safeRemoveProgress{
+		nodeID:    nodeID,
+		stage:     querypb.SafeRemoveStage_SafeRemoveStageMigrating,
+		startTime: now,
+		stageTime: now,
+	}
+	return nil
+}
+
+func (t *safeRemoveTracker) setStage(nodeID int64, stage querypb.SafeRemoveStage, failReason string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.progress[nodeID]
+	if !ok {
+		return
+	}
+	p.stage = stage
+	p.failReason = failReason
+	p.stageTime = time.Now()
+}
+
+func (t *safeRemoveTracker) get(nodeID int64) (safeRemoveProgress, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	p, ok := t.progress[nodeID]
+	if !ok {
+		return safeRemoveProgress{}, false
+	}
+	return *p, true
+}
+
+func isSafeRemoving(stage querypb.SafeRemoveStage) bool {
+	switch stage {
+	case querypb.SafeRemoveStage_SafeRemoveStageMigrating,
+		querypb.SafeRemoveStage_SafeRemoveStageVerifying,
+		querypb.SafeRemoveStage_SafeRemoveStageRemoving:
+		return true
+	default:
+		return false
+	}
+}
+
+// safeRemoveNode removes the node without interrupting the serving:
+// 1. the node is suspended, and its channels and segments are copied to the other nodes of the replicas
+// 2. wait until the replicas are served by the other nodes without the node
+// 3. the node is marked as stopping and removed from the resource group, its data is released by the checkers
+// the node is resumed if it fails before being marked as stopping.
+func (s *Server) safeRemoveNode(ctx context.Context, nodeID int64) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", nodeID))
+	timeout := Params.QueryCoordCfg.SafeRemoveNodeTimeout.GetAsDuration(time.Second)
+
+	fail := func(err error) {
+		log.Warn("failed to remove the query node safely, resume it", zap.Error(err))
+		if err := s.nodeMgr.Resume(nodeID); err != nil {
+			log.Warn("failed to resume the query node", zap.Error(err))
+		}
+		s.safeRemoveTracker.setStage(nodeID, querypb.SafeRemoveStage_SafeRemoveStageFailed, err.Error())
+	}
+
+	log.Info("start to migrate the segments and channels of the query node")
+	if err := s.migrateNode(ctx, nodeID); err != nil {
+		fail(err)
+		return
+	}
+
+	s.safeRemoveTracker.setStage(nodeID, querypb.SafeRemoveStage_SafeRemoveStageVerifying, "")
+	log.Info("start to verify the replicas are served without the query node")
+	if err := waitUntil(ctx, timeout, func() error { return s.checkNodeReplaced(nodeID) }); err != nil {
+		fail(err)
+		return
+	}
+
+	s.safeRemoveTracker.setStage(nodeID, querypb.SafeRemoveStage_SafeRemoveStageRemoving, "")
+	log.Info("the replicas are served without the query node, remove it")
+	s.nodeMgr.Stopping(nodeID)
+	s.checkerController.Check()
+	s.meta.ResourceManager.HandleNodeStopping(nodeID)
+	err := waitUntil(ctx, timeout, func() error {
+		if s.nodeMgr.Get(nodeID) == nil {
+			return nil
+		}
+		channels, segments := s.countNodeDist(nodeID)
+		if channels > 0 || segments > 0 {
+			return fmt.Errorf("%d channels and %d segments left on the node", channels, segments)
+		}
+		return nil
+	})
+	if err != nil {
+		// the node is stopping, it's up to the stopping balance to release the left data
+		log.Warn("failed to wait for the data of the query node released", zap.Error(err))
+		s.safeRemoveTracker.setStage(nodeID, querypb.SafeRemoveStage_SafeRemoveStageFailed, err.Error())
+		return
+	}
+	s.safeRemoveTracker.setStage(nodeID, querypb.SafeRemoveStage_SafeRemoveStageRemoved, "")
+	log.Info("the query node is removed safely")
+}
+
+// migrateNode copies the channels and the segments in target on the node to the other nodes of the replicas,
+// the copies on the node are kept serving until the node is removed.
+func (s *Server) migrateNode(ctx context.Context, nodeID int64) error {
+	for _, replica := range s.meta.ReplicaManager.GetByNode(nodeID) {
+		dstNodes := lo.Filter(replica.GetRWNodes(), func(node int64, _ int) bool {
+			info := s.nodeMgr.Get(node)
+			return node != nodeID && info != nil && info.GetState() == session.NodeStateNormal
+		})
+		if len(dstNodes) == 0 {
+			return merr.WrapErrServiceInternal(fmt.Sprintf("no available node in replica %d to take over the node %d",
+				replica.GetID(), nodeID))
+		}
+
+		channels := s.dist.ChannelDistManager.GetByCollectionAndFilter(replica.GetCollectionID(), meta.WithNodeID2Channel(nodeID))
+		channels = lo.Filter(channels, func(ch *meta.DmChannel, _ int) bool {
+			return s.targetMgr.GetDmChannel(ch.GetCollectionID(), ch.GetChannelName(), meta.CurrentTarget) != nil
+		})
+		if err := s.balanceChannels(ctx, replica.GetCollectionID(), replica, nodeID, dstNodes, channels, true, true); err != nil {
+			return errors.Wrapf(err, "failed to copy channels of replica %d", replica.GetID())
+		}
+
+		segments := s.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(replica.GetCollectionID()), meta.WithNodeID(nodeID))
+		segments = lo.Filter(segments, func(segment *meta.Segment, _ int) bool {
+			return s.targetMgr.GetSealedSegment(segment.GetCollectionID(), segment.GetID(), meta.CurrentTarget) != nil
+		})
+		if err := s.balanceSegments(ctx, replica.GetCollectionID(), replica, nodeID, dstNodes, segments, true, true); err != nil {
+			return errors.Wrapf(err, "failed to copy segments of replica %d", replica.GetID())
+		}
+	}
+	return nil
+}
+
+// checkNodeReplaced checks whether all the channels of the replicas of the node are served
+// by the available delegators on the other nodes, which don't route any segment to the node.
+func (s *Server) checkNodeReplaced(nodeID int64) error {
+	for _, replica := range s.meta.ReplicaManager.GetByNode(nodeID) {
+		currentTargets := s.targetMgr.GetSealedSegmentsByCollection(replica.GetCollectionID(), meta.CurrentTarget)
+		for channel := range s.targetMgr.GetDmChannelsByCollection(replica.GetCollectionID(), meta.CurrentTarget) {
+			views := s.dist.LeaderViewManager.GetByFilter(meta.WithReplica2LeaderView(replica), meta.WithChannelName2LeaderView(channel))
+			served := lo.ContainsBy(views, func(view *meta.LeaderView) bool {
+				if view.ID == nodeID {
+					return false
+				}
+				for _, segment := range view.Segments {
+					if segment.GetNodeID() == nodeID {
+						return false
+					}
+				}
+				return utils.CheckLeaderAvailable(s.nodeMgr, view, currentTargets) == nil
+			})
+			if !served {
+				return merr.WrapErrChannelNotAvailable(channel, fmt.Sprintf("not served without node %d in replica %d", nodeID, replica.GetID()))
+			}
+		}
+	}
+	return nil
+}
+
+// countNodeDist returns the number of the channels and the sealed segments on the node.
+func (s *Server) countNodeDist(nodeID int64) (int, int) {
+	channels := s.dist.ChannelDistManager.GetByFilter(meta.WithNodeID2Channel(nodeID))
+	segments := s.dist.SegmentDistManager.GetByFilter(meta.WithNodeID(nodeID))
+	return len(channels), len(segments)
+}
+
+// waitUntil checks the condition periodically until it's satisfied or the timeout is reached,
+// the last error of the condition is returned on timeout.
+func waitUntil(ctx context.Context, timeout time.Duration, condition func() error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(safeRemoveCheckInterval)
+	defer ticker.Stop()
+	for {
+		err := condition()
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(err, "timeout")
+		case <-ticker.C:
+		}
+	}
+}
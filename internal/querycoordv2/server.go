@@ -97,6 +97,7 @@ type Server struct {
 	cluster          session.Cluster
 	nodeMgr          *session.NodeManager
 	queryNodeCreator session.QueryNodeCreator
+	nodeDetector     *sessionutil.FailureDetector
 
 	// Schedulers
 	jobScheduler  *job.Scheduler
@@ -114,6 +115,7 @@ type Server struct {
 	replicaObserver     *observers.ReplicaObserver
 	resourceObserver    *observers.ResourceObserver
 	leaderCacheObserver *observers.LeaderCacheObserver
+	rowCountObserver    *observers.RowCountObserver
 
 	getBalancerFunc checkers.GetBalancerFunc
 	balancerMap     map[string]balance.Balance
@@ -126,6 +128,8 @@ type Server struct {
 	nodeUpEventChan chan int64
 	notifyNodeUp    chan struct{}
 
+	safeRemoveTracker *safeRemoveTracker
+
 	// proxy client manager
 	proxyCreator       proxyutil.ProxyCreator
 	proxyWatcher       proxyutil.ProxyWatcherInterface
@@ -140,6 +144,8 @@ func NewQueryCoord(ctx context.Context) (*Server, error) {
 		nodeUpEventChan: make(chan int64, 10240),
 		notifyNodeUp:    make(chan struct{}),
 		balancerMap:     make(map[string]balance.Balance),
+
+		safeRemoveTracker: newSafeRemoveTracker(),
 	}
 	server.UpdateStateCode(commonpb.StateCode_Abnormal)
 	server.queryNodeCreator = session.DefaultQueryNodeCreator
@@ -423,6 +429,14 @@ func (s *Server) initObserver() {
 		s.proxyClientManager,
 	)
 	s.dist.LeaderViewManager.SetNotifyFunc(s.leaderCacheObserver.RegisterEvent)
+
+	s.rowCountObserver = observers.NewRowCountObserver(
+		s.meta,
+		s.dist,
+		s.targetMgr,
+		s.broker,
+		s.taskScheduler,
+	)
 }
 
 func (s *Server) afterStart() {}
@@ -445,10 +459,11 @@ func (s *Server) startQueryCoord() error {
 	}
 	for _, node := range sessions {
 		s.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
-			NodeID:   node.ServerID,
-			Address:  node.Address,
-			Hostname: node.HostName,
-			Version:  node.Version,
+			NodeID:      node.ServerID,
+			Address:     node.Address,
+			Hostname:    node.HostName,
+			Version:     node.Version,
+			CPUFeatures: node.CPUFeatures,
 		}))
 		s.taskScheduler.AddExecutor(node.ServerID)
 
@@ -465,6 +480,14 @@ func (s *Server) startQueryCoord() error {
 	go s.handleNodeUpLoop()
 	go s.watchNodes(revision)
 
+	s.nodeDetector = sessionutil.NewFailureDetector(s.session, typeutil.QueryNodeRole, func() []int64 {
+		return lo.Map(s.nodeMgr.GetAll(), func(node *session.NodeInfo, _ int) int64 { return node.ID() })
+	}, func(ctx context.Context, nodeID int64) error {
+		resp, err := s.cluster.GetComponentStates(ctx, nodeID)
+		return merr.CheckRPCCall(resp, err)
+	})
+	s.nodeDetector.Start()
+
 	// check whether old node exist, if yes suspend auto balance until all old nodes down
 	s.updateBalanceConfigLoop(s.ctx)
 
@@ -495,6 +518,7 @@ func (s *Server) startServerLoop() {
 	s.targetObserver.Start()
 	s.replicaObserver.Start()
 	s.resourceObserver.Start()
+	s.rowCountObserver.Start()
 
 	log.Info("start task scheduler...")
 	s.taskScheduler.Start()
@@ -549,6 +573,13 @@ func (s *Server) Stop() error {
 	if s.leaderCacheObserver != nil {
 		s.leaderCacheObserver.Stop()
 	}
+	if s.rowCountObserver != nil {
+		s.rowCountObserver.Stop()
+	}
+
+	if s.nodeDetector != nil {
+		s.nodeDetector.Stop()
+	}
 
 	if s.distController != nil {
 		log.Info("stop dist controller...")
@@ -680,10 +711,11 @@ func (s *Server) watchNodes(revision int64) {
 					zap.String("nodeAddr", addr),
 				)
 				s.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
-					NodeID:   nodeID,
-					Address:  addr,
-					Hostname: event.Session.HostName,
-					Version:  event.Session.Version,
+					NodeID:      nodeID,
+					Address:     addr,
+					Hostname:    event.Session.HostName,
+					Version:     event.Session.Version,
+					CPUFeatures: event.Session.CPUFeatures,
 				}))
 				s.nodeUpEventChan <- nodeID
 				select {
@@ -1669,6 +1669,11 @@ func (suite *ServiceSuite) TestGetShardLeaders() {
 		suite.Len(resp.Shards, len(suite.channels[collection]))
 		for _, shard := range resp.Shards {
 			suite.Len(shard.NodeIds, int(suite.replicaNumber[collection]))
+			suite.Len(shard.ResourceGroups, len(shard.NodeIds))
+			for i, nodeID := range shard.NodeIds {
+				replica := suite.meta.ReplicaManager.GetByCollectionAndNode(collection, nodeID)
+				suite.Equal(replica.GetResourceGroup(), shard.ResourceGroups[i])
+			}
 		}
 	}
 
@@ -150,6 +150,8 @@ type ImmutableNodeInfo struct {
 	Address  string
 	Hostname string
 	Version  semver.Version
+	// the CPU features of the node, nil if not reported by the node of an older version
+	CPUFeatures []string
 }
 
 const (
@@ -188,6 +190,10 @@ func (n *NodeInfo) Hostname() string {
 	return n.immutableInfo.Hostname
 }
 
+func (n *NodeInfo) CPUFeatures() []string {
+	return n.immutableInfo.CPUFeatures
+}
+
 func (n *NodeInfo) SegmentCnt() int {
 	n.mu.RLock()
 	defer n.mu.RUnlock()
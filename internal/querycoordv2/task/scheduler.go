@@ -19,6 +19,7 @@ package task
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -627,14 +628,18 @@ func (scheduler *taskScheduler) schedule(node int64) {
 	})
 
 	// The scheduler doesn't limit the number of tasks,
-	// to commit tasks to executors as soon as possible, to reach higher merge possibility
+	// to commit tasks to executors as soon as possible, to reach higher merge possibility.
+	// The tasks of collections with higher recovery priority are committed first,
+	// so they take the execution slots of the executors before the others.
 	commmittedNum := atomic.NewInt32(0)
-	funcutil.ProcessFuncParallel(len(toProcess), hardware.GetCPUNum(), func(idx int) error {
-		if scheduler.process(toProcess[idx]) {
-			commmittedNum.Inc()
-		}
-		return nil
-	}, "process")
+	for _, tasks := range scheduler.groupByRecoveryPriority(toProcess) {
+		funcutil.ProcessFuncParallel(len(tasks), hardware.GetCPUNum(), func(idx int) error {
+			if scheduler.process(tasks[idx]) {
+				commmittedNum.Inc()
+			}
+			return nil
+		}, "process")
+	}
 
 	for _, task := range toRemove {
 		scheduler.remove(task)
@@ -654,6 +659,21 @@ func (scheduler *taskScheduler) schedule(node int64) {
 	)
 }
 
+// groupByRecoveryPriority groups the tasks by the recovery priority of their collections,
+// the groups are ordered by priority from high to low.
+func (scheduler *taskScheduler) groupByRecoveryPriority(tasks []Task) [][]Task {
+	groups := lo.GroupBy(tasks, func(task Task) int32 {
+		return scheduler.meta.CollectionManager.GetRecoveryPriority(task.CollectionID())
+	})
+	priorities := lo.Keys(groups)
+	sort.Slice(priorities, func(i, j int) bool {
+		return priorities[i] > priorities[j]
+	})
+	return lo.Map(priorities, func(priority int32, _ int) []Task {
+		return groups[priority]
+	})
+}
+
 func (scheduler *taskScheduler) isRelated(task Task, node int64) bool {
 	for _, action := range task.Actions() {
 		if action.Node() == node {
@@ -1395,6 +1395,43 @@ func (suite *TaskSuite) TestCreateTaskBehavior() {
 	suite.NotNil(leaderTask)
 }
 
+func (suite *TaskSuite) TestGroupByRecoveryPriority() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	priorities := map[int64]int32{1000: 1, 1001: 10, 1002: 0}
+	for collection, priority := range priorities {
+		suite.meta.PutCollection(&meta.Collection{
+			CollectionLoadInfo: &querypb.CollectionLoadInfo{
+				CollectionID:     collection,
+				ReplicaNumber:    1,
+				Status:           querypb.LoadStatus_Loaded,
+				RecoveryPriority: priority,
+			},
+		})
+	}
+
+	tasks := make([]Task, 0)
+	// collection 1003 not exists, with the default priority
+	for _, collection := range []int64{1000, 1001, 1002, 1003, 1001} {
+		task, err := NewSegmentTask(ctx, timeout, WrapIDSource(0), collection, meta.NilReplica,
+			NewSegmentAction(1, ActionTypeGrow, "", collection))
+		suite.NoError(err)
+		tasks = append(tasks, task)
+	}
+
+	groups := suite.scheduler.groupByRecoveryPriority(tasks)
+	suite.Len(groups, 3)
+	suite.Len(groups[0], 2)
+	for _, task := range groups[0] {
+		suite.EqualValues(1001, task.CollectionID())
+	}
+	suite.Len(groups[1], 1)
+	suite.EqualValues(1000, groups[1][0].CollectionID())
+	suite.ElementsMatch([]int64{1002, 1003}, lo.Map(groups[2], func(task Task, _ int) int64 {
+		return task.CollectionID()
+	}))
+}
+
 func (suite *TaskSuite) TestSegmentTaskReplace() {
 	ctx := context.Background()
 	timeout := 10 * time.Second
@@ -28,6 +28,7 @@ const (
 	IndexCheckerName   = "index_checker"
 	LeaderCheckerName  = "leader_checker"
 	ManualBalanceName  = "manual_balance"
+	RowCountRepairName = "row_count_repair"
 )
 
 type CheckerType int32
@@ -39,6 +40,7 @@ const (
 	IndexChecker
 	LeaderChecker
 	ManualBalance
+	RowCountRepair
 )
 
 var checkerNames = map[CheckerType]string{
@@ -48,6 +50,7 @@ var checkerNames = map[CheckerType]string{
 	IndexChecker:   IndexCheckerName,
 	LeaderChecker:  LeaderCheckerName,
 	ManualBalance:  ManualBalanceName,
+	RowCountRepair: RowCountRepairName,
 }
 
 func (s CheckerType) String() string {
@@ -137,11 +137,13 @@ func GetShardLeadersWithChannels(m *meta.Meta, targetMgr *meta.TargetManager, di
 		readableLeaders = filterDupLeaders(m.ReplicaManager, readableLeaders)
 		ids := make([]int64, 0, len(leaders))
 		addrs := make([]string, 0, len(leaders))
+		rgs := make([]string, 0, len(leaders))
 		for _, leader := range readableLeaders {
 			info := nodeMgr.Get(leader.ID)
 			if info != nil {
 				ids = append(ids, info.ID())
 				addrs = append(addrs, info.Addr())
+				rgs = append(rgs, m.ReplicaManager.GetByCollectionAndNode(leader.CollectionID, leader.ID).GetResourceGroup())
 			}
 		}
 
@@ -154,9 +156,10 @@ func GetShardLeadersWithChannels(m *meta.Meta, targetMgr *meta.TargetManager, di
 		}
 
 		ret = append(ret, &querypb.ShardLeadersList{
-			ChannelName: channel.GetChannelName(),
-			NodeIds:     ids,
-			NodeAddrs:   addrs,
+			ChannelName:    channel.GetChannelName(),
+			NodeIds:        ids,
+			NodeAddrs:      addrs,
+			ResourceGroups: rgs,
 		})
 	}
 
@@ -54,6 +54,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -67,6 +68,7 @@ type ShardDelegator interface {
 	SyncDistribution(ctx context.Context, entries ...SegmentEntry)
 	SyncPartitionStats(ctx context.Context, partVersions map[int64]int64)
 	GetPartitionStatsVersions(ctx context.Context) map[int64]int64
+	GetRequestRate() float64
 	Search(ctx context.Context, req *querypb.SearchRequest) ([]*internalpb.SearchResults, error)
 	Query(ctx context.Context, req *querypb.QueryRequest) ([]*internalpb.RetrieveResults, error)
 	QueryStream(ctx context.Context, req *querypb.QueryRequest, srv streamrpc.QueryStreamServer) error
@@ -133,8 +135,16 @@ type shardDelegator struct {
 	// in order to make add/remove growing be atomic, need lock before modify these meta info
 	growingSegmentLock sync.RWMutex
 	partitionStatsMut  sync.RWMutex
+
+	// requestRate collects the requests served by the delegator, which is reported for channel balance
+	requestRate *ratelimitutil.RateCollector
 }
 
+const (
+	requestRateLabel  = "request"
+	requestRateWindow = time.Minute
+)
+
 // getLogger returns the zap logger with pre-defined shard attributes.
 func (sd *shardDelegator) getLogger(ctx context.Context) *log.MLogger {
 	return log.Ctx(ctx).With(
@@ -149,6 +159,24 @@ func (sd *shardDelegator) Serviceable() bool {
 	return lifetime.IsWorking(sd.lifetime.GetState()) == nil
 }
 
+// GetRequestRate returns the requests per second served by the delegator recently, nq counted for search.
+func (sd *shardDelegator) GetRequestRate() float64 {
+	if sd.requestRate == nil {
+		return 0
+	}
+	rate, err := sd.requestRate.Rate(requestRateLabel, requestRateWindow)
+	if err != nil {
+		return 0
+	}
+	return rate
+}
+
+func (sd *shardDelegator) recordRequest(n int64) {
+	if sd.requestRate != nil {
+		sd.requestRate.Add(requestRateLabel, float64(n))
+	}
+}
+
 func (sd *shardDelegator) Stopped() bool {
 	return lifetime.NotStopped(sd.lifetime.GetState()) != nil
 }
@@ -277,6 +305,7 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 		)
 		return nil, fmt.Errorf("dml channel not match, delegator channel %s, search channels %v", sd.vchannelName, req.GetDmlChannels())
 	}
+	sd.recordRequest(req.GetReq().GetNq())
 
 	partitions := req.GetReq().GetPartitionIDs()
 	if !sd.collection.ExistPartition(partitions...) {
@@ -394,6 +423,7 @@ func (sd *shardDelegator) QueryStream(ctx context.Context, req *querypb.QueryReq
 		)
 		return fmt.Errorf("dml channel not match, delegator channel %s, search channels %v", sd.vchannelName, req.GetDmlChannels())
 	}
+	sd.recordRequest(1)
 
 	partitions := req.GetReq().GetPartitionIDs()
 	if !sd.collection.ExistPartition(partitions...) {
@@ -465,6 +495,7 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 		)
 		return nil, fmt.Errorf("dml channel not match, delegator channel %s, search channels %v", sd.vchannelName, req.GetDmlChannels())
 	}
+	sd.recordRequest(1)
 
 	partitions := req.GetReq().GetPartitionIDs()
 	if !sd.collection.ExistPartition(partitions...) {
@@ -873,6 +904,8 @@ func NewShardDelegator(ctx context.Context, collectionID UniqueID, replicaID Uni
 		partitionStats:   make(map[UniqueID]*storage.PartitionStatsSnapshot),
 		excludedSegments: excludedSegments,
 	}
+	sd.requestRate, _ = ratelimitutil.NewRateCollector(requestRateWindow, ratelimitutil.DefaultGranularity, false)
+	sd.requestRate.Register(requestRateLabel)
 	m := sync.Mutex{}
 	sd.tsCond = sync.NewCond(&m)
 	if sd.lifetime.Add(lifetime.NotStopped) == nil {
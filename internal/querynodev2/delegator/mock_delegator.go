@@ -178,6 +178,47 @@ func (_c *MockShardDelegator_GetPartitionStatsVersions_Call) RunAndReturn(run fu
 	return _c
 }
 
+// GetRequestRate provides a mock function with given fields:
+func (_m *MockShardDelegator) GetRequestRate() float64 {
+	ret := _m.Called()
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func() float64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	return r0
+}
+
+// MockShardDelegator_GetRequestRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestRate'
+type MockShardDelegator_GetRequestRate_Call struct {
+	*mock.Call
+}
+
+// GetRequestRate is a helper method to define mock.On call
+func (_e *MockShardDelegator_Expecter) GetRequestRate() *MockShardDelegator_GetRequestRate_Call {
+	return &MockShardDelegator_GetRequestRate_Call{Call: _e.mock.On("GetRequestRate")}
+}
+
+func (_c *MockShardDelegator_GetRequestRate_Call) Run(run func()) *MockShardDelegator_GetRequestRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShardDelegator_GetRequestRate_Call) Return(_a0 float64) *MockShardDelegator_GetRequestRate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShardDelegator_GetRequestRate_Call) RunAndReturn(run func() float64) *MockShardDelegator_GetRequestRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSegmentInfo provides a mock function with given fields: readable
 func (_m *MockShardDelegator) GetSegmentInfo(readable bool) ([]SnapshotItem, []SegmentEntry) {
 	ret := _m.Called(readable)
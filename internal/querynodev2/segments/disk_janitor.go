@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// the local directories of segcore, see internal/core/src/common/Consts.h
+	localIndexFilesDir = "index_files"
+	localRawDataDir    = "raw_datas"
+	localMmapDir       = "mmap"
+)
+
+// janitorDir is a local directory whose sub directories are named by the IDs of their owners.
+type janitorDir struct {
+	name string
+	path string
+	// owners are the IDs still on the QueryNode
+	owners typeutil.Set[int64]
+}
+
+// DiskJanitor cleans the orphan local files of the disk index, raw data and mmap,
+// which are not owned by any segment on the QueryNode, e.g. left by a crash or a failed release.
+//
+// The local files are reconciled against the segments registered in the manager, and the ones
+// modified within the grace period are kept, as they may be written by a segment still loading.
+type DiskJanitor struct {
+	manager   *Manager
+	localPath string
+	mmapPath  string
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewDiskJanitor creates the janitor of the local files of the segments in the manager.
+func NewDiskJanitor(manager *Manager) *DiskJanitor {
+	params := paramtable.Get()
+	return &DiskJanitor{
+		manager:   manager,
+		localPath: filepath.Join(params.LocalStorageCfg.Path.GetValue(), typeutil.QueryNodeRole),
+		mmapPath:  params.QueryNodeCfg.MmapDirPath.GetValue(),
+		closeCh:   make(chan struct{}),
+	}
+}
+
+func (j *DiskJanitor) Start() {
+	j.wg.Add(1)
+	go j.loop()
+}
+
+func (j *DiskJanitor) Stop() {
+	j.closeOnce.Do(func() {
+		close(j.closeCh)
+		j.wg.Wait()
+	})
+}
+
+func (j *DiskJanitor) loop() {
+	defer j.wg.Done()
+	ticker := time.NewTicker(paramtable.Get().QueryNodeCfg.DiskJanitorInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-j.closeCh:
+			log.Info("disk janitor stopped")
+			return
+		case <-ticker.C:
+			if paramtable.Get().QueryNodeCfg.DiskJanitorEnabled.GetAsBool() {
+				j.Clean(context.Background())
+			}
+		}
+	}
+}
+
+// Clean removes the orphan local files, and returns the bytes reclaimed.
+func (j *DiskJanitor) Clean(ctx context.Context) int64 {
+	log := log.Ctx(ctx)
+	// collect the live owners before listing the local files,
+	// the files of the segment loaded afterward are protected by the grace period
+	segmentIDs := typeutil.NewSet[int64]()
+	buildIDs := typeutil.NewSet[int64]()
+	for _, segment := range j.manager.Segment.GetBy() {
+		segmentIDs.Insert(segment.ID())
+		for _, index := range segment.Indexes() {
+			buildIDs.Insert(index.IndexInfo.GetBuildID())
+		}
+	}
+
+	dirs := []janitorDir{
+		{name: localIndexFilesDir, path: filepath.Join(j.localPath, localIndexFilesDir), owners: buildIDs},
+		{name: localRawDataDir, path: filepath.Join(j.localPath, localRawDataDir), owners: segmentIDs},
+	}
+	if j.mmapPath != "" {
+		dirs = append(dirs, janitorDir{name: localMmapDir, path: j.mmapPath, owners: segmentIDs})
+	}
+
+	gracePeriod := paramtable.Get().QueryNodeCfg.DiskJanitorGracePeriod.GetAsDuration(time.Second)
+	deadline := time.Now().Add(-gracePeriod)
+	var total int64
+	for _, dir := range dirs {
+		reclaimed, err := cleanOrphanDirs(dir, deadline)
+		if err != nil {
+			log.Warn("failed to clean the orphan local files", zap.String("dir", dir.path), zap.Error(err))
+		}
+		if reclaimed > 0 {
+			metrics.QueryNodeDiskJanitorReclaimedBytes.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), dir.name).Add(float64(reclaimed))
+		}
+		total += reclaimed
+	}
+	if total > 0 {
+		log.Info("disk janitor cleaned the orphan local files", zap.Int64("reclaimedBytes", total))
+	}
+	return total
+}
+
+// cleanOrphanDirs removes the sub directories of the dir whose owners are not live,
+// and not modified after the deadline. The sub directories not named by ID are skipped.
+func cleanOrphanDirs(dir janitorDir, deadline time.Time) (int64, error) {
+	entries, err := os.ReadDir(dir.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var reclaimed int64
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		id, err := strconv.ParseInt(entry.Name(), 10, 64)
+		if err != nil || dir.owners.Contain(id) {
+			continue
+		}
+		path := filepath.Join(dir.path, entry.Name())
+		size, modTime, err := statDir(path)
+		if err != nil {
+			log.Warn("failed to stat the local dir", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		if modTime.After(deadline) {
+			continue
+		}
+		if err := os.RemoveAll(path); err != nil {
+			log.Warn("failed to remove the orphan local dir", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		log.Info("orphan local dir removed", zap.String("path", path), zap.Int64("size", size), zap.Time("modTime", modTime))
+		reclaimed += size
+	}
+	return reclaimed, nil
+}
+
+// statDir returns the total size of the files under the dir, and the latest modification time of them.
+func statDir(path string) (int64, time.Time, error) {
+	var size int64
+	var modTime time.Time
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			size += info.Size()
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+		return nil
+	})
+	return size, modTime, err
+}
@@ -0,0 +1,119 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type DiskJanitorSuite struct {
+	suite.Suite
+
+	janitor *DiskJanitor
+}
+
+func (s *DiskJanitorSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *DiskJanitorSuite) SetupTest() {
+	segment := NewMockSegment(s.T())
+	segment.EXPECT().ID().Return(1).Maybe()
+	segment.EXPECT().Indexes().Return([]*IndexedFieldInfo{
+		{IndexInfo: &querypb.FieldIndexInfo{BuildID: 1001}},
+	}).Maybe()
+	segmentManager := NewMockSegmentManager(s.T())
+	segmentManager.EXPECT().GetBy().Return([]Segment{segment}).Maybe()
+
+	root := s.T().TempDir()
+	s.janitor = &DiskJanitor{
+		manager:   &Manager{Segment: segmentManager},
+		localPath: filepath.Join(root, "querynode"),
+		mmapPath:  filepath.Join(root, "mmap"),
+		closeCh:   make(chan struct{}),
+	}
+}
+
+func (s *DiskJanitorSuite) writeFile(size int, elem ...string) string {
+	path := filepath.Join(elem...)
+	s.Require().NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+	s.Require().NoError(os.WriteFile(path, make([]byte, size), 0o644))
+	return path
+}
+
+func (s *DiskJanitorSuite) age(path string, d time.Duration) {
+	modTime := time.Now().Add(-d)
+	s.Require().NoError(filepath.Walk(path, func(path string, _ os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		return os.Chtimes(path, modTime, modTime)
+	}))
+}
+
+func (s *DiskJanitorSuite) TestClean() {
+	indexDir := filepath.Join(s.janitor.localPath, localIndexFilesDir)
+	rawDataDir := filepath.Join(s.janitor.localPath, localRawDataDir)
+
+	s.writeFile(100, indexDir, "1001", "1", "10", "1", "index")
+	s.writeFile(100, indexDir, "1002", "1", "10", "2", "index")
+	s.writeFile(10, indexDir, "1002", "1", "10", "2", "index_meta")
+	s.writeFile(100, rawDataDir, "1", "101", "raw")
+	s.writeFile(100, rawDataDir, "2", "101", "raw")
+	s.writeFile(100, s.janitor.mmapPath, "2", "101")
+	s.writeFile(100, s.janitor.mmapPath, "mmap_chunk_manager", "chunk")
+	s.age(filepath.Dir(s.janitor.localPath), 2*time.Hour)
+
+	// the orphan being written is in the grace period
+	s.writeFile(100, indexDir, "1003", "1", "10", "3", "index")
+
+	s.EqualValues(310, s.janitor.Clean(context.Background()))
+
+	s.DirExists(filepath.Join(indexDir, "1001"))
+	s.NoDirExists(filepath.Join(indexDir, "1002"))
+	s.DirExists(filepath.Join(indexDir, "1003"))
+	s.DirExists(filepath.Join(rawDataDir, "1"))
+	s.NoDirExists(filepath.Join(rawDataDir, "2"))
+	s.NoDirExists(filepath.Join(s.janitor.mmapPath, "2"))
+	s.DirExists(filepath.Join(s.janitor.mmapPath, "mmap_chunk_manager"))
+
+	// nothing to clean
+	s.EqualValues(0, s.janitor.Clean(context.Background()))
+}
+
+func (s *DiskJanitorSuite) TestCleanNotExist() {
+	s.EqualValues(0, s.janitor.Clean(context.Background()))
+}
+
+func (s *DiskJanitorSuite) TestStartStop() {
+	s.janitor.Start()
+	s.janitor.Stop()
+	s.janitor.Stop()
+}
+
+func TestDiskJanitor(t *testing.T) {
+	suite.Run(t, new(DiskJanitorSuite))
+}
@@ -231,30 +231,30 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		Topks:      make([]int64, 0),
 	}
 
-	resultOffsets := make([][]int64, len(searchResultData))
 	for i := 0; i < len(searchResultData); i++ {
-		resultOffsets[i] = make([]int64, len(searchResultData[i].Topks))
-		for j := int64(1); j < nq; j++ {
-			resultOffsets[i][j] = resultOffsets[i][j-1] + searchResultData[i].Topks[j-1]
-		}
 		ret.AllSearchCount += searchResultData[i].GetAllSearchCount()
 	}
 
+	maxEntries := paramtable.Get().QuotaConfig.MaxSearchResultEntries.GetAsInt64()
+	if entries := typeutil.CountSearchResultEntries(searchResultData); maxEntries > 0 && entries > maxEntries {
+		return nil, merr.WrapErrSearchResultEntriesTooLarge(entries, maxEntries)
+	}
+
 	var skipDupCnt int64
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	merger := typeutil.NewSearchResultMerger(searchResultData, nq)
 	for i := int64(0); i < nq; i++ {
-		offsets := make([]int64, len(searchResultData))
+		merger.Reset(i)
 
 		idSet := make(map[interface{}]struct{})
 		groupByValueSet := make(map[interface{}]struct{})
 		var j int64
 		for j = 0; j < topk; {
-			sel := SelectSearchResultData(searchResultData, resultOffsets, offsets, i)
+			sel, idx := merger.Next()
 			if sel == -1 {
 				break
 			}
-			idx := resultOffsets[sel][i] + offsets[sel]
 
 			id := typeutil.GetPK(searchResultData[sel].GetIds(), idx)
 			groupByVal := typeutil.GetData(searchResultData[sel].GetGroupByFieldValue(), int(idx))
@@ -279,12 +279,16 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 					}
 					idSet[id] = struct{}{}
 					j++
+					// limit search result to avoid oom, fail fast instead of finishing the whole query
+					if retSize > maxOutputSize {
+						return nil, merr.WrapErrParameterTooLarge("search result",
+							fmt.Sprintf("search results exceed the maxOutputSize Limit %d", maxOutputSize))
+					}
 				}
 			} else {
 				// skip entity with same id
 				skipDupCnt++
 			}
-			offsets[sel]++
 		}
 
 		// if realTopK != -1 && realTopK != j {
@@ -292,11 +296,6 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		// 	// return nil, errors.New("the length (topk) between all result of query is different")
 		// }
 		ret.Topks = append(ret.Topks, j)
-
-		// limit search result to avoid oom
-		if retSize > maxOutputSize {
-			return nil, fmt.Errorf("search results exceed the maxOutputSize Limit %d", maxOutputSize)
-		}
 	}
 	log.Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
 	return ret, nil
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -738,6 +739,17 @@ func (suite *ResultSuite) TestResult_ReduceSearchResultData() {
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 5, 2, 3}, res.Ids.GetIntId().Data)
 	})
+	suite.Run("exceed max entries", func() {
+		paramtable.Get().Save(paramtable.Get().QuotaConfig.MaxSearchResultEntries.Key, "4")
+		defer paramtable.Get().Reset(paramtable.Get().QuotaConfig.MaxSearchResultEntries.Key)
+		ids := []int64{1, 2, 3, 4}
+		scores := []float32{-1.0, -2.0, -3.0, -4.0}
+		topks := []int64{int64(len(ids))}
+		data1 := genSearchResultData(nq, topk, ids, scores, topks)
+		data2 := genSearchResultData(nq, topk, ids, scores, topks)
+		_, err := ReduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data1, data2}, nq, topk)
+		suite.ErrorIs(err, merr.ErrParameterTooLarge)
+	})
 }
 
 func (suite *ResultSuite) TestResult_SearchGroupByResult() {
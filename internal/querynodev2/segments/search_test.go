@@ -163,6 +163,30 @@ func (suite *SearchSuite) TestSearchGrowing() {
 	suite.manager.Segment.Unpin(segments)
 }
 
+func (suite *SearchSuite) TestSearchStats() {
+	ctx := context.Background()
+	nq := int64(10)
+	searchReq, err := genSearchPlanAndRequests(suite.collection, []int64{suite.sealed.ID()}, IndexFaissIDMap, nq)
+	suite.Require().NoError(err)
+
+	// both segments have no index, and are searched by brute force
+	sealedResult, err := suite.sealed.Search(ctx, searchReq)
+	suite.Require().NoError(err)
+	defer DeleteSearchResults([]*SearchResult{sealedResult})
+	suite.Equal(searchStats{
+		bruteForceSearchNQ:             nq,
+		bruteForceDistanceComputations: nq * suite.sealed.RowNum(),
+	}, getSearchStats(sealedResult))
+
+	growingResult, err := suite.growing.Search(ctx, searchReq)
+	suite.Require().NoError(err)
+	defer DeleteSearchResults([]*SearchResult{growingResult})
+	suite.Equal(searchStats{
+		bruteForceSearchNQ:             nq,
+		bruteForceDistanceComputations: nq * suite.growing.RowNum(),
+	}, getSearchStats(growingResult))
+}
+
 func TestSearch(t *testing.T) {
 	suite.Run(t, new(SearchSuite))
 }
@@ -483,6 +483,22 @@ func (s *LocalSegment) RowNum() int64 {
 	return rowNum
 }
 
+// LoadedRowNum returns the number of the rows loaded into the segment including the deleted ones,
+// 0 if the data is not loaded, e.g. lazy loaded or evicted.
+func (s *LocalSegment) LoadedRowNum() int64 {
+	if !s.ptrLock.RLockIf(state.IsDataLoaded) {
+		return 0
+	}
+	defer s.ptrLock.RUnlock()
+
+	var rowCount C.int64_t
+	GetDynamicPool().Submit(func() (any, error) {
+		rowCount = C.GetRowCount(s.ptr)
+		return nil, nil
+	}).Await()
+	return int64(rowCount)
+}
+
 func (s *LocalSegment) MemSize() int64 {
 	if !s.ptrLock.RLockIf(state.IsNotReleased) {
 		return 0
@@ -596,10 +612,52 @@ func (s *LocalSegment) Search(ctx context.Context, searchReq *SearchRequest) (*S
 		return nil, err
 	}
 	metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.SearchLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
-	log.Debug("search segment done")
-	return &SearchResult{
+	searchResult := &SearchResult{
 		cSearchResult: (C.CSearchResult)(result),
-	}, nil
+	}
+	reportSearchStats(s.Collection(), getSearchStats(searchResult))
+	log.Debug("search segment done")
+	return searchResult, nil
+}
+
+// searchStats is the stats of the vector search of a segment search result, collected by segcore.
+type searchStats struct {
+	indexSearchNQ int64
+	// the nq searched on the indexes of the quantization codes, and on the graph indexes
+	quantizedSearchNQ              int64
+	graphSearchNQ                  int64
+	bruteForceSearchNQ             int64
+	bruteForceDistanceComputations int64
+}
+
+func getSearchStats(result *SearchResult) searchStats {
+	stats := C.GetSearchStats(result.cSearchResult)
+	return searchStats{
+		indexSearchNQ:                  int64(stats.index_search_nq),
+		quantizedSearchNQ:              int64(stats.quantized_search_nq),
+		graphSearchNQ:                  int64(stats.graph_search_nq),
+		bruteForceSearchNQ:             int64(stats.brute_force_search_nq),
+		bruteForceDistanceComputations: int64(stats.brute_force_distance_computations),
+	}
+}
+
+// reportSearchStats adds the search stats of a segment search result to the collection metrics.
+func reportSearchStats(collectionID int64, stats searchStats) {
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	collection := fmt.Sprint(collectionID)
+	for label, nq := range map[string]int64{
+		metrics.IndexSearchLabel:          stats.indexSearchNQ,
+		metrics.QuantizedIndexSearchLabel: stats.quantizedSearchNQ,
+		metrics.GraphIndexSearchLabel:     stats.graphSearchNQ,
+		metrics.BruteForceSearchLabel:     stats.bruteForceSearchNQ,
+	} {
+		if nq > 0 {
+			metrics.QueryNodeSegcoreSearchNQ.WithLabelValues(nodeID, collection, label).Add(float64(nq))
+		}
+	}
+	if stats.bruteForceDistanceComputations > 0 {
+		metrics.QueryNodeSegcoreDistanceComputations.WithLabelValues(nodeID, collection).Add(float64(stats.bruteForceDistanceComputations))
+	}
 }
 
 func (s *LocalSegment) Retrieve(ctx context.Context, plan *RetrievePlan) (*segcorepb.RetrieveResults, error) {
@@ -107,6 +107,8 @@ type QueryNode struct {
 
 	// segment loader
 	loader segments.Loader
+	// cleaner of the orphan local files of the segments
+	diskJanitor *segments.DiskJanitor
 
 	// Search/Query
 	scheduler       tasks.Scheduler
@@ -153,7 +155,8 @@ func NewQueryNode(ctx context.Context, factory dependency.Factory) *QueryNode {
 
 func (node *QueryNode) initSession() error {
 	minimalIndexVersion, currentIndexVersion := getIndexEngineVersion()
-	node.session = sessionutil.NewSession(node.ctx, sessionutil.WithIndexEngineVersion(minimalIndexVersion, currentIndexVersion))
+	node.session = sessionutil.NewSession(node.ctx, sessionutil.WithIndexEngineVersion(minimalIndexVersion, currentIndexVersion),
+		sessionutil.WithCPUFeatures(hardware.GetCPUFeatures()))
 	if node.session == nil {
 		return fmt.Errorf("session is nil, the etcd client connection may have failed")
 	}
@@ -378,6 +381,8 @@ func (node *QueryNode) Init() error {
 func (node *QueryNode) Start() error {
 	node.startOnce.Do(func() {
 		node.scheduler.Start()
+		node.diskJanitor = segments.NewDiskJanitor(node.manager)
+		node.diskJanitor.Start()
 
 		paramtable.SetCreateTime(time.Now())
 		paramtable.SetUpdateTime(time.Now())
@@ -463,6 +468,9 @@ func (node *QueryNode) Stop() error {
 		if node.scheduler != nil {
 			node.scheduler.Stop()
 		}
+		if node.diskJanitor != nil {
+			node.diskJanitor.Stop()
+		}
 		if node.pipelineManager != nil {
 			node.pipelineManager.Close()
 		}
@@ -1201,8 +1201,10 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 
 	sealedSegments := node.manager.Segment.GetBy(segments.WithType(commonpb.SegmentState_Sealed))
 	segmentVersionInfos := make([]*querypb.SegmentVersionInfo, 0, len(sealedSegments))
+	// counting the loaded rows calls into segcore, only do it for the periodic row count check of querycoord
+	reportLoadedRows := paramtable.Get().QueryCoordCfg.RowCountCheckInterval.GetAsInt64() > 0
 	for _, s := range sealedSegments {
-		segmentVersionInfos = append(segmentVersionInfos, &querypb.SegmentVersionInfo{
+		info := &querypb.SegmentVersionInfo{
 			ID:                 s.ID(),
 			Collection:         s.Collection(),
 			Partition:          s.Partition(),
@@ -1212,7 +1214,11 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 			IndexInfo: lo.SliceToMap(s.Indexes(), func(info *segments.IndexedFieldInfo) (int64, *querypb.FieldIndexInfo) {
 				return info.IndexInfo.FieldID, info.IndexInfo
 			}),
-		})
+		}
+		if localSegment, ok := s.(*segments.LocalSegment); ok && reportLoadedRows {
+			info.NumOfRows = localSegment.LoadedRowNum()
+		}
+		segmentVersionInfos = append(segmentVersionInfos, info)
 	}
 
 	channelVersionInfos := make([]*querypb.ChannelVersionInfo, 0)
@@ -1240,6 +1246,7 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 		}
 
 		numOfGrowingRows := int64(0)
+		memorySize := int64(0)
 		growingSegments := make(map[int64]*msgpb.MsgPosition)
 		for _, entry := range growing {
 			segment := node.manager.Segment.GetWithType(entry.SegmentID, segments.SegmentTypeGrowing)
@@ -1250,6 +1257,7 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 			}
 			growingSegments[entry.SegmentID] = segment.StartPosition()
 			numOfGrowingRows += segment.InsertCount()
+			memorySize += segment.MemSize()
 		}
 
 		leaderViews = append(leaderViews, &querypb.LeaderView{
@@ -1260,6 +1268,8 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 			TargetVersion:          delegator.GetTargetVersion(),
 			NumOfGrowingRows:       numOfGrowingRows,
 			PartitionStatsVersions: delegator.GetPartitionStatsVersions(ctx),
+			RequestRate:            delegator.GetRequestRate(),
+			MemorySize:             memorySize,
 		})
 		return true
 	})
@@ -465,6 +465,7 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 		return err
 	}
 
+	hasPartitionKey := typeutil.HasPartitionKey(t.schema)
 	partitions := make([]*model.Partition, len(partIDs))
 	for i, partID := range partIDs {
 		partitions[i] = &model.Partition{
@@ -474,6 +475,10 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 			CollectionID:              collID,
 			State:                     pb.PartitionState_PartitionCreated,
 		}
+		if hasPartitionKey {
+			// track the partition keys since the partition is empty
+			partitions[i].KeyBuckets = typeutil.NewPartitionKeyBuckets()
+		}
 	}
 
 	collInfo := model.Collection{
@@ -52,6 +52,7 @@ func (t *describeDBTask) Execute(ctx context.Context) (err error) {
 		DbName:           db.Name,
 		CreatedTimestamp: db.CreatedTime,
 		Properties:       db.Properties,
+		TenantId:         db.TenantID,
 	}
 	return nil
 }
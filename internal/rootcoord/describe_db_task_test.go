@@ -67,6 +67,7 @@ func Test_describeDatabaseTask_Execute(t *testing.T) {
 		meta := mockrootcoord.NewIMetaTable(t)
 		meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).
 			Return(&model.Database{
+				TenantID:    "tenant1",
 				Name:        "db1",
 				ID:          100,
 				CreatedTime: 1,
@@ -84,5 +85,6 @@ func Test_describeDatabaseTask_Execute(t *testing.T) {
 		assert.Equal(t, "db1", task.Rsp.GetDbName())
 		assert.Equal(t, int64(100), task.Rsp.GetDbID())
 		assert.Equal(t, uint64(1), task.Rsp.GetCreatedTimestamp())
+		assert.Equal(t, "tenant1", task.Rsp.GetTenantId())
 	})
 }
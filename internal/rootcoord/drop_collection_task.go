@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/proxyutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -88,6 +89,10 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 		state:        pb.CollectionState_CollectionDropping,
 		ts:           ts,
 	})
+	redoTask.AddSyncStep(&startDropProgressStep{
+		baseStep: baseStep{core: t.core},
+		coll:     collMeta,
+	})
 
 	redoTask.AddAsyncStep(&releaseCollectionStep{
 		baseStep:     baseStep{core: t.core},
@@ -98,6 +103,11 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 		collID:   collMeta.CollectionID,
 		partIDs:  nil,
 	})
+	redoTask.AddAsyncStep(&setDropStageStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collMeta.CollectionID,
+		stage:        rootcoordpb.DropCollectionStage_DropStageRemovingChannels,
+	})
 	redoTask.AddAsyncStep(&deleteCollectionDataStep{
 		baseStep: baseStep{core: t.core},
 		coll:     collMeta,
@@ -107,7 +117,17 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 		baseStep:  baseStep{core: t.core},
 		pChannels: collMeta.PhysicalChannelNames,
 	})
+	redoTask.AddAsyncStep(&setDropStageStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collMeta.CollectionID,
+		stage:        rootcoordpb.DropCollectionStage_DropStageCollectingGarbage,
+	})
 	redoTask.AddAsyncStep(newConfirmGCStep(t.core, collMeta.CollectionID, allPartition))
+	redoTask.AddAsyncStep(&setDropStageStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collMeta.CollectionID,
+		stage:        rootcoordpb.DropCollectionStage_DropStageDroppingMeta,
+	})
 	redoTask.AddAsyncStep(&deleteCollectionMetaStep{
 		baseStep:     baseStep{core: t.core},
 		collectionID: collMeta.CollectionID,
@@ -116,6 +136,11 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 		// wrap a step who will have these three children and connect them with ts.
 		ts: ts,
 	})
+	redoTask.AddAsyncStep(&setDropStageStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collMeta.CollectionID,
+		stage:        rootcoordpb.DropCollectionStage_DropStageDropped,
+	})
 
 	return redoTask.Execute(ctx)
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -279,5 +280,10 @@ func Test_dropCollectionTask_Execute(t *testing.T) {
 
 		<-removeCollectionMetaChan
 		assert.True(t, removeCollectionMetaCalled)
+
+		assert.Eventually(t, func() bool {
+			progress, ok := core.dropProgress.get(coll.CollectionID)
+			return ok && progress.stage == rootcoordpb.DropCollectionStage_DropStageDropped
+		}, time.Second, 10*time.Millisecond)
 	})
 }
@@ -0,0 +1,147 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+// dropProgressRetention is how long the progress of the dropped collection is kept after all resources are reclaimed.
+const dropProgressRetention = time.Hour
+
+type dropProgress struct {
+	dbID           UniqueID
+	collectionName string
+	collectionID   UniqueID
+	stage          rootcoordpb.DropCollectionStage
+	startTime      time.Time
+	stageTime      time.Time
+}
+
+// dropProgressTracker tracks the stages of the asynchronous resources reclamation of the dropped collections,
+// the progress is kept in memory and lost if RootCoord restarts before the collection is dropped again.
+type dropProgressTracker struct {
+	mu       sync.RWMutex
+	progress map[UniqueID]*dropProgress
+}
+
+func newDropProgressTracker() *dropProgressTracker {
+	return &dropProgressTracker{
+		progress: make(map[UniqueID]*dropProgress),
+	}
+}
+
+// start begins to track the dropping collection, the progress of the dropped ones are cleaned up meanwhile.
+func (t *dropProgressTracker) start(dbID UniqueID, collectionName string, collectionID UniqueID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	for id, p := range t.progress {
+		if p.stage == rootcoordpb.DropCollectionStage_DropStageDropped && now.Sub(p.stageTime) > dropProgressRetention {
+			delete(t.progress, id)
+		}
+	}
+	t.progress[collectionID] = &dropProgress{
+		dbID:           dbID,
+		collectionName: collectionName,
+		collectionID:   collectionID,
+		stage:          rootcoordpb.DropCollectionStage_DropStageReleasing,
+		startTime:      now,
+		stageTime:      now,
+	}
+}
+
+func (t *dropProgressTracker) setStage(collectionID UniqueID, stage rootcoordpb.DropCollectionStage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.progress[collectionID]
+	if !ok || p.stage >= stage {
+		return
+	}
+	p.stage = stage
+	p.stageTime = time.Now()
+}
+
+func (t *dropProgressTracker) get(collectionID UniqueID) (dropProgress, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	p, ok := t.progress[collectionID]
+	if !ok {
+		return dropProgress{}, false
+	}
+	return *p, true
+}
+
+// getByName returns the progress of the latest dropped collection with the name.
+func (t *dropProgressTracker) getByName(dbID UniqueID, collectionName string) (dropProgress, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var latest *dropProgress
+	for _, p := range t.progress {
+		if p.dbID != dbID || p.collectionName != collectionName {
+			continue
+		}
+		if latest == nil || p.startTime.After(latest.startTime) {
+			latest = p
+		}
+	}
+	if latest == nil {
+		return dropProgress{}, false
+	}
+	return *latest, true
+}
+
+// startDropProgressStep starts to track the progress of the dropping collection.
+type startDropProgressStep struct {
+	baseStep
+	coll *model.Collection
+}
+
+func (s *startDropProgressStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	s.core.dropProgress.start(s.coll.DBID, s.coll.Name, s.coll.CollectionID)
+	return nil, nil
+}
+
+func (s *startDropProgressStep) Desc() string {
+	return fmt.Sprintf("start to track drop progress of collection: %d", s.coll.CollectionID)
+}
+
+// setDropStageStep marks the dropping collection entering the stage once the previous steps are done.
+type setDropStageStep struct {
+	baseStep
+	collectionID UniqueID
+	stage        rootcoordpb.DropCollectionStage
+}
+
+func (s *setDropStageStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	s.core.dropProgress.setStage(s.collectionID, s.stage)
+	return nil, nil
+}
+
+func (s *setDropStageStep) Desc() string {
+	return fmt.Sprintf("set drop stage of collection: %d, stage: %s", s.collectionID, s.stage.String())
+}
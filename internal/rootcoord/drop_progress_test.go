@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_dropProgressTracker(t *testing.T) {
+	tracker := newDropProgressTracker()
+	tracker.start(1, "coll", 100)
+	tracker.setStage(100, rootcoordpb.DropCollectionStage_DropStageCollectingGarbage)
+	// stage never goes back
+	tracker.setStage(100, rootcoordpb.DropCollectionStage_DropStageRemovingChannels)
+	// untracked collection
+	tracker.setStage(200, rootcoordpb.DropCollectionStage_DropStageDropped)
+
+	progress, ok := tracker.get(100)
+	assert.True(t, ok)
+	assert.Equal(t, rootcoordpb.DropCollectionStage_DropStageCollectingGarbage, progress.stage)
+	_, ok = tracker.get(200)
+	assert.False(t, ok)
+
+	// the collection with the same name is created and dropped again
+	tracker.start(1, "coll", 101)
+	progress, ok = tracker.getByName(1, "coll")
+	assert.True(t, ok)
+	assert.EqualValues(t, 101, progress.collectionID)
+	_, ok = tracker.getByName(2, "coll")
+	assert.False(t, ok)
+
+	// the dropped collection is cleaned up after retention
+	tracker.setStage(100, rootcoordpb.DropCollectionStage_DropStageDropped)
+	tracker.progress[100].stageTime = time.Now().Add(-dropProgressRetention - time.Second)
+	tracker.start(1, "coll2", 102)
+	_, ok = tracker.get(100)
+	assert.False(t, ok)
+	_, ok = tracker.get(101)
+	assert.True(t, ok)
+}
+
+func TestCore_GetDropCollectionProgress(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.GetDropCollectionProgress(ctx, &rootcoordpb.GetDropCollectionProgressRequest{})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("by id", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		c.dropProgress.start(1, "coll", 100)
+		c.dropProgress.setStage(100, rootcoordpb.DropCollectionStage_DropStageDroppingMeta)
+
+		resp, err := c.GetDropCollectionProgress(ctx, &rootcoordpb.GetDropCollectionProgressRequest{CollectionID: 100})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.EqualValues(t, 100, resp.GetCollectionID())
+		assert.Equal(t, rootcoordpb.DropCollectionStage_DropStageDroppingMeta, resp.GetStage())
+		assert.LessOrEqual(t, resp.GetStartTime(), resp.GetStageTime())
+
+		resp, err = c.GetDropCollectionProgress(ctx, &rootcoordpb.GetDropCollectionProgressRequest{CollectionID: 101})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("by name", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db", mock.Anything).Return(&model.Database{ID: 1, Name: "db"}, nil)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db2", mock.Anything).Return(nil, merr.WrapErrDatabaseNotFound("db2"))
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		c.dropProgress.start(1, "coll", 100)
+
+		resp, err := c.GetDropCollectionProgress(ctx, &rootcoordpb.GetDropCollectionProgressRequest{DbName: "db", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, rootcoordpb.DropCollectionStage_DropStageReleasing, resp.GetStage())
+
+		resp, err = c.GetDropCollectionProgress(ctx, &rootcoordpb.GetDropCollectionProgressRequest{DbName: "db", CollectionName: "coll2"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+
+		resp, err = c.GetDropCollectionProgress(ctx, &rootcoordpb.GetDropCollectionProgressRequest{DbName: "db2", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrDatabaseNotFound)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	ms "github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 )
@@ -48,6 +49,8 @@ func (c *bgGarbageCollector) ReDropCollection(collMeta *model.Collection, ts Tim
 	// TODO: remove this after data gc can be notified by rpc.
 	c.s.chanTimeTick.addDmlChannels(collMeta.PhysicalChannelNames...)
 
+	c.s.dropProgress.start(collMeta.DBID, collMeta.Name, collMeta.CollectionID)
+
 	redo := newBaseRedoTask(c.s.stepExecutor)
 	redo.AddAsyncStep(&releaseCollectionStep{
 		baseStep:     baseStep{core: c.s},
@@ -58,6 +61,11 @@ func (c *bgGarbageCollector) ReDropCollection(collMeta *model.Collection, ts Tim
 		collID:   collMeta.CollectionID,
 		partIDs:  nil,
 	})
+	redo.AddAsyncStep(&setDropStageStep{
+		baseStep:     baseStep{core: c.s},
+		collectionID: collMeta.CollectionID,
+		stage:        rootcoordpb.DropCollectionStage_DropStageRemovingChannels,
+	})
 	redo.AddAsyncStep(&deleteCollectionDataStep{
 		baseStep: baseStep{core: c.s},
 		coll:     collMeta,
@@ -67,7 +75,17 @@ func (c *bgGarbageCollector) ReDropCollection(collMeta *model.Collection, ts Tim
 		baseStep:  baseStep{core: c.s},
 		pChannels: collMeta.PhysicalChannelNames,
 	})
+	redo.AddAsyncStep(&setDropStageStep{
+		baseStep:     baseStep{core: c.s},
+		collectionID: collMeta.CollectionID,
+		stage:        rootcoordpb.DropCollectionStage_DropStageCollectingGarbage,
+	})
 	redo.AddAsyncStep(newConfirmGCStep(c.s, collMeta.CollectionID, allPartition))
+	redo.AddAsyncStep(&setDropStageStep{
+		baseStep:     baseStep{core: c.s},
+		collectionID: collMeta.CollectionID,
+		stage:        rootcoordpb.DropCollectionStage_DropStageDroppingMeta,
+	})
 	redo.AddAsyncStep(&deleteCollectionMetaStep{
 		baseStep:     baseStep{core: c.s},
 		collectionID: collMeta.CollectionID,
@@ -76,6 +94,11 @@ func (c *bgGarbageCollector) ReDropCollection(collMeta *model.Collection, ts Tim
 		// wrap a step who will have these three children and connect them with ts.
 		ts: ts,
 	})
+	redo.AddAsyncStep(&setDropStageStep{
+		baseStep:     baseStep{core: c.s},
+		collectionID: collMeta.CollectionID,
+		stage:        rootcoordpb.DropCollectionStage_DropStageDropped,
+	})
 
 	// err is ignored since no sync steps will be executed.
 	_ = redo.Execute(context.Background())
@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// getMetaSnapshotTask collects the databases and the available collections created after the since ts,
+// it's scheduled as a ddl task so that no collection created before the snapshot ts is missed.
+type getMetaSnapshotTask struct {
+	baseTask
+	Req *rootcoordpb.GetMetaSnapshotRequest
+	Rsp *rootcoordpb.GetMetaSnapshotResponse
+}
+
+func (t *getMetaSnapshotTask) Prepare(ctx context.Context) error {
+	return nil
+}
+
+// Execute task execution
+func (t *getMetaSnapshotTask) Execute(ctx context.Context) error {
+	sinceTs := t.Req.GetSinceTs()
+	t.Rsp = &rootcoordpb.GetMetaSnapshotResponse{
+		Status:     merr.Success(),
+		SnapshotTs: t.GetTs(),
+	}
+
+	dbs, err := t.core.meta.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		t.Rsp.Status = merr.Status(err)
+		return err
+	}
+	for _, db := range dbs {
+		if db.CreatedTime > sinceTs {
+			t.Rsp.Databases = append(t.Rsp.Databases, &rootcoordpb.DatabaseSnapshot{
+				DbName:           db.Name,
+				DbID:             db.ID,
+				CreatedTimestamp: db.CreatedTime,
+				Properties:       db.Properties,
+			})
+		}
+
+		colls, err := t.core.meta.ListCollections(ctx, db.Name, typeutil.MaxTimestamp, true)
+		if err != nil {
+			t.Rsp.Status = merr.Status(err)
+			return err
+		}
+		for _, coll := range colls {
+			if coll.CreateTime <= sinceTs {
+				continue
+			}
+			partitions := &milvuspb.ShowPartitionsResponse{Status: merr.Success()}
+			for _, part := range coll.Partitions {
+				if !part.Available() {
+					continue
+				}
+				partitions.PartitionIDs = append(partitions.PartitionIDs, part.PartitionID)
+				partitions.PartitionNames = append(partitions.PartitionNames, part.PartitionName)
+				partitions.CreatedTimestamps = append(partitions.CreatedTimestamps, part.PartitionCreatedTimestamp)
+				physical, _ := tsoutil.ParseHybridTs(part.PartitionCreatedTimestamp)
+				partitions.CreatedUtcTimestamps = append(partitions.CreatedUtcTimestamps, uint64(physical))
+			}
+			t.Rsp.Collections = append(t.Rsp.Collections, &rootcoordpb.CollectionSnapshot{
+				Collection: convertModelToDesc(coll, t.core.meta.ListAliasesByID(coll.CollectionID), db.Name),
+				Partitions: partitions,
+			})
+		}
+	}
+	return nil
+}
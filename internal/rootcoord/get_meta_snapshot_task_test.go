@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_getMetaSnapshotTask_Execute(t *testing.T) {
+	t.Run("failed to list databases", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+		core := newTestCore(withMeta(meta))
+		task := &getMetaSnapshotTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.GetMetaSnapshotRequest{},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+		assert.Error(t, merr.Error(task.Rsp.GetStatus()))
+	})
+
+	t.Run("failed to list collections", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return([]*model.Database{{Name: "db1", ID: 1}}, nil)
+		meta.EXPECT().ListCollections(mock.Anything, "db1", mock.Anything, true).Return(nil, errors.New("mock"))
+		core := newTestCore(withMeta(meta))
+		task := &getMetaSnapshotTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.GetMetaSnapshotRequest{},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+		assert.Error(t, merr.Error(task.Rsp.GetStatus()))
+	})
+
+	t.Run("since ts", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return([]*model.Database{
+			{Name: "db1", ID: 1, CreatedTime: 10},
+			{Name: "db2", ID: 2, CreatedTime: 30},
+		}, nil)
+		meta.EXPECT().ListCollections(mock.Anything, "db1", mock.Anything, true).Return([]*model.Collection{
+			{CollectionID: 100, Name: "coll1", DBID: 1, CreateTime: 10},
+			{
+				CollectionID: 101,
+				Name:         "coll2",
+				DBID:         1,
+				CreateTime:   30,
+				Partitions: []*model.Partition{
+					{PartitionID: 1010, PartitionName: "_default", PartitionCreatedTimestamp: 30, State: etcdpb.PartitionState_PartitionCreated},
+					{PartitionID: 1011, PartitionName: "p1", PartitionCreatedTimestamp: 40, State: etcdpb.PartitionState_PartitionDropping},
+				},
+			},
+		}, nil)
+		meta.EXPECT().ListCollections(mock.Anything, "db2", mock.Anything, true).Return(nil, nil)
+		meta.EXPECT().ListAliasesByID(int64(101)).Return([]string{"alias"})
+		core := newTestCore(withMeta(meta))
+		task := &getMetaSnapshotTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &rootcoordpb.GetMetaSnapshotRequest{SinceTs: 20},
+		}
+		task.SetTs(50)
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(task.Rsp.GetStatus()))
+		assert.Equal(t, uint64(50), task.Rsp.GetSnapshotTs())
+		assert.Len(t, task.Rsp.GetDatabases(), 1)
+		assert.Equal(t, "db2", task.Rsp.GetDatabases()[0].GetDbName())
+		assert.Len(t, task.Rsp.GetCollections(), 1)
+		snapshot := task.Rsp.GetCollections()[0]
+		assert.Equal(t, int64(101), snapshot.GetCollection().GetCollectionID())
+		assert.Equal(t, "db1", snapshot.GetCollection().GetDbName())
+		assert.Equal(t, []string{"alias"}, snapshot.GetCollection().GetAliases())
+		assert.Equal(t, []int64{1010}, snapshot.GetPartitions().GetPartitionIDs())
+		assert.Equal(t, []string{"_default"}, snapshot.GetPartitions().GetPartitionNames())
+	})
+}
@@ -66,6 +66,7 @@ type IMetaTable interface {
 	GetPChannelInfo(pchannel string) *rootcoordpb.GetPChannelInfoResponse
 	AddPartition(ctx context.Context, partition *model.Partition) error
 	ChangePartitionState(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error
+	UpdatePartitionKeyBuckets(ctx context.Context, collectionID UniqueID, buckets map[UniqueID][]byte, ts Timestamp) (bool, error)
 	RemovePartition(ctx context.Context, dbID int64, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
 	CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
 	DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error
@@ -923,6 +924,36 @@ func (mt *MetaTable) ChangePartitionState(ctx context.Context, collectionID Uniq
 	return fmt.Errorf("partition not exist, collection: %d, partition: %d", collectionID, partitionID)
 }
 
+// UpdatePartitionKeyBuckets merges the buckets of the partition keys into the partitions of the collection,
+// the partitions whose buckets are not tracked are skipped. It returns whether any partition is changed.
+func (mt *MetaTable) UpdatePartitionKeyBuckets(ctx context.Context, collectionID UniqueID, buckets map[UniqueID][]byte, ts Timestamp) (bool, error) {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok || !coll.Available() {
+		return false, merr.WrapErrCollectionNotFound(collectionID)
+	}
+	changed := false
+	for idx, part := range coll.Partitions {
+		partBuckets, ok := buckets[part.PartitionID]
+		if !ok || !part.Available() {
+			continue
+		}
+		clone := part.Clone()
+		if !typeutil.MergePartitionKeyBuckets(clone.KeyBuckets, partBuckets) {
+			continue
+		}
+		ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+		if err := mt.catalog.AlterPartition(ctx1, coll.DBID, part, clone, metastore.MODIFY, ts); err != nil {
+			return changed, err
+		}
+		coll.Partitions[idx] = clone
+		changed = true
+	}
+	return changed, nil
+}
+
 func (mt *MetaTable) RemovePartition(ctx context.Context, dbID int64, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
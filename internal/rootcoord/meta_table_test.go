@@ -1695,6 +1695,64 @@ func TestMetaTable_ChangePartitionState(t *testing.T) {
 	})
 }
 
+func TestMetaTable_UpdatePartitionKeyBuckets(t *testing.T) {
+	newBuckets := func(buckets ...uint32) []byte {
+		bitmap := typeutil.NewPartitionKeyBuckets()
+		for _, bucket := range buckets {
+			typeutil.SetPartitionKeyBucket(bitmap, bucket)
+		}
+		return bitmap
+	}
+	newMeta := func(catalog *mocks.RootCoordCatalog) *MetaTable {
+		return &MetaTable{
+			catalog: catalog,
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {
+					Name: "test", CollectionID: 100, State: pb.CollectionState_CollectionCreated,
+					Partitions: []*model.Partition{
+						{CollectionID: 100, PartitionID: 500, State: pb.PartitionState_PartitionCreated, KeyBuckets: newBuckets(1)},
+						{CollectionID: 100, PartitionID: 501, State: pb.PartitionState_PartitionCreated},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("collection not exist", func(t *testing.T) {
+		meta := &MetaTable{}
+		_, err := meta.UpdatePartitionKeyBuckets(context.TODO(), 100, nil, 1000)
+		assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+	})
+
+	t.Run("failed to alter partition", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterPartition(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(errors.New("error mock AlterPartition"))
+		meta := newMeta(catalog)
+		_, err := meta.UpdatePartitionKeyBuckets(context.TODO(), 100, map[int64][]byte{500: newBuckets(2)}, 1000)
+		assert.Error(t, err)
+		assert.Equal(t, newBuckets(1), meta.collID2Meta[100].Partitions[0].KeyBuckets)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterPartition(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil).Once()
+		meta := newMeta(catalog)
+		// the partition without tracked buckets is skipped
+		changed, err := meta.UpdatePartitionKeyBuckets(context.TODO(), 100, map[int64][]byte{500: newBuckets(2), 501: newBuckets(2)}, 1000)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, newBuckets(1, 2), meta.collID2Meta[100].Partitions[0].KeyBuckets)
+		assert.Nil(t, meta.collID2Meta[100].Partitions[1].KeyBuckets)
+
+		// nothing changed
+		changed, err = meta.UpdatePartitionKeyBuckets(context.TODO(), 100, map[int64][]byte{500: newBuckets(1)}, 1000)
+		assert.NoError(t, err)
+		assert.False(t, changed)
+	})
+}
+
 func TestMetaTable_CreateDatabase(t *testing.T) {
 	db := model.NewDatabase(1, "exist", pb.DatabaseState_DatabaseCreated, nil)
 	t.Run("database already exist", func(t *testing.T) {
@@ -2153,6 +2153,61 @@ func (_c *IMetaTable_SelectUser_Call) RunAndReturn(run func(string, *milvuspb.Us
 	return _c
 }
 
+// UpdatePartitionKeyBuckets provides a mock function with given fields: ctx, collectionID, buckets, ts
+func (_m *IMetaTable) UpdatePartitionKeyBuckets(ctx context.Context, collectionID int64, buckets map[int64][]byte, ts uint64) (bool, error) {
+	ret := _m.Called(ctx, collectionID, buckets, ts)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, map[int64][]byte, uint64) (bool, error)); ok {
+		return rf(ctx, collectionID, buckets, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, map[int64][]byte, uint64) bool); ok {
+		r0 = rf(ctx, collectionID, buckets, ts)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, map[int64][]byte, uint64) error); ok {
+		r1 = rf(ctx, collectionID, buckets, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_UpdatePartitionKeyBuckets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePartitionKeyBuckets'
+type IMetaTable_UpdatePartitionKeyBuckets_Call struct {
+	*mock.Call
+}
+
+// UpdatePartitionKeyBuckets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - buckets map[int64][]byte
+//   - ts uint64
+func (_e *IMetaTable_Expecter) UpdatePartitionKeyBuckets(ctx interface{}, collectionID interface{}, buckets interface{}, ts interface{}) *IMetaTable_UpdatePartitionKeyBuckets_Call {
+	return &IMetaTable_UpdatePartitionKeyBuckets_Call{Call: _e.mock.On("UpdatePartitionKeyBuckets", ctx, collectionID, buckets, ts)}
+}
+
+func (_c *IMetaTable_UpdatePartitionKeyBuckets_Call) Run(run func(ctx context.Context, collectionID int64, buckets map[int64][]byte, ts uint64)) *IMetaTable_UpdatePartitionKeyBuckets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(map[int64][]byte), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_UpdatePartitionKeyBuckets_Call) Return(_a0 bool, _a1 error) *IMetaTable_UpdatePartitionKeyBuckets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_UpdatePartitionKeyBuckets_Call) RunAndReturn(run func(context.Context, int64, map[int64][]byte, uint64) (bool, error)) *IMetaTable_UpdatePartitionKeyBuckets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewIMetaTable creates a new instance of IMetaTable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIMetaTable(t interface {
@@ -117,6 +117,11 @@ type Core struct {
 
 	quotaCenter *QuotaCenter
 
+	timeWindowPartitionManager *timeWindowPartitionManager
+	temporaryCollectionManager *temporaryCollectionManager
+
+	dropProgress *dropProgressTracker
+
 	stateCode atomic.Int32
 	initOnce  sync.Once
 	startOnce sync.Once
@@ -139,6 +144,7 @@ func NewCore(c context.Context, factory dependency.Factory) (*Core, error) {
 		cancel:              cancel,
 		factory:             factory,
 		enableActiveStandBy: Params.RootCoordCfg.EnableActiveStandby.GetAsBool(),
+		dropProgress:        newDropProgressTracker(),
 	}
 
 	core.UpdateStateCode(commonpb.StateCode_Abnormal)
@@ -455,6 +461,8 @@ func (c *Core) initInternal() error {
 	c.broker = newServerBroker(c)
 	c.ddlTsLockManager = newDdlTsLockManager(c.tsoAllocator)
 	c.garbageCollector = newBgGarbageCollector(c)
+	c.timeWindowPartitionManager = newTimeWindowPartitionManager(c.ctx, c)
+	c.temporaryCollectionManager = newTemporaryCollectionManager(c.ctx, c)
 	c.stepExecutor = newBgStepExecutor(c.ctx)
 
 	c.proxyWatcher = proxyutil.NewProxyWatcher(
@@ -692,6 +700,8 @@ func (c *Core) startInternal() error {
 
 	c.scheduler.Start()
 	c.stepExecutor.Start()
+	c.timeWindowPartitionManager.Start()
+	c.temporaryCollectionManager.Start()
 	go func() {
 		// refresh rbac cache
 		if err := retry.Do(c.ctx, func() error {
@@ -774,6 +784,12 @@ func (c *Core) Stop() error {
 	if c.quotaCenter != nil {
 		c.quotaCenter.stop()
 	}
+	if c.timeWindowPartitionManager != nil {
+		c.timeWindowPartitionManager.Stop()
+	}
+	if c.temporaryCollectionManager != nil {
+		c.temporaryCollectionManager.Stop()
+	}
 
 	c.revokeSession()
 	c.cancelIfNotNil()
@@ -2774,6 +2790,136 @@ func (c *Core) DescribeDatabase(ctx context.Context, req *rootcoordpb.DescribeDa
 	return t.Rsp, nil
 }
 
+// GetDropCollectionProgress returns the stage of reclaiming the resources of the dropped collection.
+func (c *Core) GetDropCollectionProgress(ctx context.Context, req *rootcoordpb.GetDropCollectionProgressRequest) (*rootcoordpb.GetDropCollectionProgressResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &rootcoordpb.GetDropCollectionProgressResponse{Status: merr.Status(err)}, nil
+	}
+
+	var (
+		progress dropProgress
+		ok       bool
+	)
+	if req.GetCollectionID() > 0 {
+		progress, ok = c.dropProgress.get(req.GetCollectionID())
+		if !ok {
+			err := merr.WrapErrCollectionNotFound(req.GetCollectionID(), "no dropping collection")
+			return &rootcoordpb.GetDropCollectionProgressResponse{Status: merr.Status(err)}, nil
+		}
+	} else {
+		db, err := c.meta.GetDatabaseByName(ctx, req.GetDbName(), typeutil.MaxTimestamp)
+		if err != nil {
+			return &rootcoordpb.GetDropCollectionProgressResponse{Status: merr.Status(err)}, nil
+		}
+		progress, ok = c.dropProgress.getByName(db.ID, req.GetCollectionName())
+		if !ok {
+			err := merr.WrapErrCollectionNotFound(req.GetCollectionName(), "no dropping collection")
+			return &rootcoordpb.GetDropCollectionProgressResponse{Status: merr.Status(err)}, nil
+		}
+	}
+
+	return &rootcoordpb.GetDropCollectionProgressResponse{
+		Status:       merr.Success(),
+		CollectionID: progress.collectionID,
+		Stage:        progress.stage,
+		StartTime:    progress.startTime.UnixMilli(),
+		StageTime:    progress.stageTime.UnixMilli(),
+	}, nil
+}
+
+// UpdatePartitionKeyStats merges the buckets of the partition keys written by the proxy into the partitions,
+// the proxies are notified to refresh the stats if any partition is changed.
+func (c *Core) UpdatePartitionKeyStats(ctx context.Context, req *rootcoordpb.UpdatePartitionKeyStatsRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()), zap.Int64("collectionID", req.GetCollectionID()))
+
+	buckets := make(map[UniqueID][]byte, len(req.GetStats()))
+	for _, stats := range req.GetStats() {
+		buckets[stats.GetPartitionID()] = stats.GetBuckets()
+	}
+	ts, err := c.tsoAllocator.GenerateTSO(1)
+	if err != nil {
+		log.Warn("failed to allocate timestamp for updating partition key stats", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	changed, err := c.meta.UpdatePartitionKeyBuckets(ctx, req.GetCollectionID(), buckets, ts)
+	if err != nil {
+		log.Warn("failed to update partition key stats", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if !changed {
+		return merr.Success(), nil
+	}
+	// the stale stats may prune the partitions containing the new keys, so expire them before acknowledging the writes
+	if err := c.ExpireMetaCache(ctx, req.GetDbName(), []string{req.GetCollectionName()}, req.GetCollectionID(), "", ts); err != nil {
+		log.Warn("failed to expire meta cache for partition key stats", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
+// GetPartitionKeyStats returns the buckets of the partition keys of the partitions in the collection.
+func (c *Core) GetPartitionKeyStats(ctx context.Context, req *rootcoordpb.GetPartitionKeyStatsRequest) (*rootcoordpb.GetPartitionKeyStatsResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &rootcoordpb.GetPartitionKeyStatsResponse{Status: merr.Status(err)}, nil
+	}
+
+	coll, err := c.meta.GetCollectionByID(ctx, req.GetDbName(), req.GetCollectionID(), typeutil.MaxTimestamp, false)
+	if err != nil {
+		return &rootcoordpb.GetPartitionKeyStatsResponse{Status: merr.Status(err)}, nil
+	}
+	stats := make([]*rootcoordpb.PartitionKeyStats, 0, len(coll.Partitions))
+	for _, partition := range coll.Partitions {
+		if !partition.Available() {
+			continue
+		}
+		stats = append(stats, &rootcoordpb.PartitionKeyStats{
+			PartitionID: partition.PartitionID,
+			Buckets:     partition.KeyBuckets,
+		})
+	}
+	return &rootcoordpb.GetPartitionKeyStatsResponse{
+		Status: merr.Success(),
+		Stats:  stats,
+	}, nil
+}
+
+// GetMetaSnapshot returns the databases and collections created after the since ts in bulk,
+// the proxies fetch it to warm up the meta cache instead of describing the collections one by one.
+func (c *Core) GetMetaSnapshot(ctx context.Context, req *rootcoordpb.GetMetaSnapshotRequest) (*rootcoordpb.GetMetaSnapshotResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &rootcoordpb.GetMetaSnapshotResponse{Status: merr.Status(err)}, nil
+	}
+
+	log := log.Ctx(ctx).With(zap.Uint64("sinceTs", req.GetSinceTs()))
+	metrics.RootCoordDDLReqCounter.WithLabelValues("GetMetaSnapshot", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("GetMetaSnapshot")
+	t := &getMetaSnapshotTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to get meta snapshot", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("GetMetaSnapshot", metrics.FailLabel).Inc()
+		return &rootcoordpb.GetMetaSnapshotResponse{Status: merr.Status(err)}, nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to get meta snapshot", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("GetMetaSnapshot", metrics.FailLabel).Inc()
+		return &rootcoordpb.GetMetaSnapshotResponse{Status: merr.Status(err)}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("GetMetaSnapshot", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("GetMetaSnapshot").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	log.Info("done to get meta snapshot", zap.Uint64("ts", t.GetTs()),
+		zap.Int("numDatabases", len(t.Rsp.GetDatabases())), zap.Int("numCollections", len(t.Rsp.GetCollections())))
+	return t.Rsp, nil
+}
+
 func (c *Core) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return &milvuspb.CheckHealthResponse{
@@ -40,6 +40,7 @@ import (
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
@@ -2068,6 +2069,125 @@ func (s *RootCoordSuite) TestRestore() {
 	}
 }
 
+func TestCore_PartitionKeyStats(t *testing.T) {
+	ctx := context.Background()
+	buckets := typeutil.NewPartitionKeyBuckets()
+	typeutil.SetPartitionKeyBucket(buckets, 1)
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		status, err := c.UpdatePartitionKeyStats(ctx, &rootcoordpb.UpdatePartitionKeyStatsRequest{})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+		resp, err := c.GetPartitionKeyStats(ctx, &rootcoordpb.GetPartitionKeyStatsRequest{})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().UpdatePartitionKeyBuckets(mock.Anything, int64(100), map[int64][]byte{500: buckets}, mock.Anything).Return(true, nil).Once()
+		meta.EXPECT().UpdatePartitionKeyBuckets(mock.Anything, int64(100), mock.Anything, mock.Anything).Return(false, nil).Once()
+		meta.EXPECT().UpdatePartitionKeyBuckets(mock.Anything, int64(101), mock.Anything, mock.Anything).Return(false, merr.WrapErrCollectionNotFound(101))
+		c := newTestCore(withHealthyCode(), withMeta(meta), withTsoAllocator(newMockTsoAllocator()), withValidProxyManager())
+
+		req := &rootcoordpb.UpdatePartitionKeyStatsRequest{
+			CollectionName: "coll",
+			CollectionID:   100,
+			Stats:          []*rootcoordpb.PartitionKeyStats{{PartitionID: 500, Buckets: buckets}},
+		}
+		status, err := c.UpdatePartitionKeyStats(ctx, req)
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+		// the cache is not expired if nothing changed
+		c.proxyClientManager = proxyutil.NewMockProxyClientManager(t)
+		status, err = c.UpdatePartitionKeyStats(ctx, req)
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+
+		status, err = c.UpdatePartitionKeyStats(ctx, &rootcoordpb.UpdatePartitionKeyStatsRequest{CollectionID: 101})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("failed to expire cache", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().UpdatePartitionKeyBuckets(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+		c := newTestCore(withHealthyCode(), withMeta(meta), withTsoAllocator(newMockTsoAllocator()), withInvalidProxyManager())
+		status, err := c.UpdatePartitionKeyStats(ctx, &rootcoordpb.UpdatePartitionKeyStatsRequest{CollectionName: "coll", CollectionID: 100})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+	})
+
+	t.Run("get", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, int64(100), mock.Anything, false).Return(&model.Collection{
+			CollectionID: 100,
+			Partitions: []*model.Partition{
+				{PartitionID: 500, State: etcdpb.PartitionState_PartitionCreated, KeyBuckets: buckets},
+				{PartitionID: 501, State: etcdpb.PartitionState_PartitionCreated},
+				{PartitionID: 502, State: etcdpb.PartitionState_PartitionDropping},
+			},
+		}, nil)
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, int64(101), mock.Anything, false).Return(nil, merr.WrapErrCollectionNotFound(101))
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+
+		resp, err := c.GetPartitionKeyStats(ctx, &rootcoordpb.GetPartitionKeyStatsRequest{CollectionID: 100})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetStats(), 2)
+		assert.Equal(t, buckets, resp.GetStats()[0].GetBuckets())
+		assert.Empty(t, resp.GetStats()[1].GetBuckets())
+
+		resp, err = c.GetPartitionKeyStats(ctx, &rootcoordpb.GetPartitionKeyStatsRequest{CollectionID: 101})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+	})
+}
+
+func TestCore_GetMetaSnapshot(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.GetMetaSnapshot(ctx, &rootcoordpb.GetMetaSnapshotRequest{})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withInvalidScheduler())
+		resp, err := c.GetMetaSnapshot(ctx, &rootcoordpb.GetMetaSnapshotRequest{})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withTaskFailScheduler())
+		resp, err := c.GetMetaSnapshot(ctx, &rootcoordpb.GetMetaSnapshotRequest{})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return([]*model.Database{{Name: "db1", ID: 1, CreatedTime: 1}}, nil)
+		meta.EXPECT().ListCollections(mock.Anything, "db1", mock.Anything, true).Return([]*model.Collection{{CollectionID: 100, Name: "coll", CreateTime: 1}}, nil)
+		meta.EXPECT().ListAliasesByID(int64(100)).Return(nil)
+		sched := newMockScheduler()
+		sched.AddTaskFunc = func(t task) error {
+			t.NotifyDone(t.Execute(ctx))
+			return nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(meta), withScheduler(sched))
+		resp, err := c.GetMetaSnapshot(ctx, &rootcoordpb.GetMetaSnapshotRequest{})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetDatabases(), 1)
+		assert.Len(t, resp.GetCollections(), 1)
+	})
+}
+
 func TestRootCoordSuite(t *testing.T) {
 	suite.Run(t, new(RootCoordSuite))
 }
@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// temporaryCollectionManager drops the temporary collections once their TTL since the creation expires,
+// the collections are dropped the same way as the DropCollection requests, which releases them,
+// drops the meta and leaves the data to the GC.
+type temporaryCollectionManager struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	meta           IMetaTable
+	dropCollection func(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error)
+	now            func() time.Time
+}
+
+func newTemporaryCollectionManager(ctx context.Context, c *Core) *temporaryCollectionManager {
+	ctx, cancel := context.WithCancel(ctx)
+	return &temporaryCollectionManager{
+		ctx:            ctx,
+		cancel:         cancel,
+		meta:           c.meta,
+		dropCollection: c.DropCollection,
+		now:            time.Now,
+	}
+}
+
+func (m *temporaryCollectionManager) Start() {
+	m.wg.Add(1)
+	go m.schedule()
+}
+
+func (m *temporaryCollectionManager) Stop() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+func (m *temporaryCollectionManager) schedule() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(Params.RootCoordCfg.TemporaryCollectionCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	log.Info("temporary collection manager start")
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Info("temporary collection manager stop")
+			return
+		case <-ticker.C:
+			m.check(m.ctx)
+		}
+	}
+}
+
+func (m *temporaryCollectionManager) check(ctx context.Context) {
+	dbs, err := m.meta.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		log.Warn("failed to list databases for temporary collections", zap.Error(err))
+		return
+	}
+	now := m.now()
+	for _, db := range dbs {
+		collections, err := m.meta.ListCollections(ctx, db.Name, typeutil.MaxTimestamp, true)
+		if err != nil {
+			log.Warn("failed to list collections for temporary collections", zap.String("dbName", db.Name), zap.Error(err))
+			continue
+		}
+		for _, coll := range collections {
+			ttl, ok, err := common.CollectionLevelTemporaryTTL(funcutil.KeyValuePair2Map(coll.Properties))
+			if err != nil {
+				log.RatedWarn(60, "invalid temporary collection config", zap.String("dbName", db.Name),
+					zap.String("collection", coll.Name), zap.Error(err))
+				continue
+			}
+			if !ok {
+				continue
+			}
+			expireTime := tsoutil.PhysicalTime(coll.CreateTime).Add(ttl)
+			if now.Before(expireTime) {
+				continue
+			}
+
+			log := log.Ctx(ctx).With(zap.String("dbName", db.Name), zap.String("collection", coll.Name),
+				zap.Int64("collectionID", coll.CollectionID), zap.Time("expireTime", expireTime))
+			status, err := m.dropCollection(ctx, &milvuspb.DropCollectionRequest{
+				Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropCollection)),
+				DbName:         db.Name,
+				CollectionName: coll.Name,
+			})
+			if err = merr.CheckRPCCall(status, err); err != nil {
+				log.Warn("failed to drop expired temporary collection", zap.Error(err))
+				continue
+			}
+			log.Info("expired temporary collection dropped")
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestTemporaryCollectionManager_Check(t *testing.T) {
+	now := time.Date(2024, 7, 17, 10, 0, 0, 0, time.UTC)
+	createTime := tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0)
+	collections := []*model.Collection{
+		{CollectionID: 100, Name: "permanent", CreateTime: createTime},
+		{CollectionID: 101, Name: "expired", CreateTime: createTime, Properties: []*commonpb.KeyValuePair{
+			{Key: common.CollectionTemporaryTTLKey, Value: "1800"},
+		}},
+		{CollectionID: 102, Name: "unexpired", CreateTime: createTime, Properties: []*commonpb.KeyValuePair{
+			{Key: common.CollectionTemporaryTTLKey, Value: "7200"},
+		}},
+		{CollectionID: 103, Name: "invalid", CreateTime: createTime, Properties: []*commonpb.KeyValuePair{
+			{Key: common.CollectionTemporaryTTLKey, Value: "soon"},
+		}},
+	}
+
+	newManager := func(dropCollection func(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error)) *temporaryCollectionManager {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return([]*model.Database{{Name: "default"}}, nil)
+		meta.EXPECT().ListCollections(mock.Anything, "default", mock.Anything, true).Return(collections, nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		return &temporaryCollectionManager{
+			ctx:            ctx,
+			cancel:         cancel,
+			meta:           meta,
+			dropCollection: dropCollection,
+			now:            func() time.Time { return now },
+		}
+	}
+
+	t.Run("drop expired", func(t *testing.T) {
+		dropped := make([]string, 0)
+		m := newManager(func(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+			assert.Equal(t, "default", req.GetDbName())
+			dropped = append(dropped, req.GetCollectionName())
+			return merr.Success(), nil
+		})
+		m.check(context.Background())
+		assert.ElementsMatch(t, []string{"expired"}, dropped)
+	})
+
+	t.Run("drop failed", func(t *testing.T) {
+		calls := 0
+		m := newManager(func(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+			calls++
+			return merr.Status(merr.ErrServiceNotReady), nil
+		})
+		m.check(context.Background())
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestTemporaryCollectionManager_StartStop(t *testing.T) {
+	core := newTestCore(withMeta(mockrootcoord.NewIMetaTable(t)))
+	m := newTemporaryCollectionManager(context.Background(), core)
+	m.Start()
+	m.Stop()
+}
@@ -0,0 +1,199 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// timeWindowPartitionManager manages the partitions of the collections with time-windowed partitions enabled,
+// the partitions of the current and upcoming windows are created in advance,
+// and the partitions out of the retention windows are dropped.
+type timeWindowPartitionManager struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	meta            IMetaTable
+	createPartition func(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error)
+	dropPartition   func(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error)
+	now             func() time.Time
+}
+
+func newTimeWindowPartitionManager(ctx context.Context, c *Core) *timeWindowPartitionManager {
+	ctx, cancel := context.WithCancel(ctx)
+	return &timeWindowPartitionManager{
+		ctx:             ctx,
+		cancel:          cancel,
+		meta:            c.meta,
+		createPartition: c.CreatePartition,
+		dropPartition:   c.DropPartition,
+		now:             time.Now,
+	}
+}
+
+func (m *timeWindowPartitionManager) Start() {
+	m.wg.Add(1)
+	go m.schedule()
+}
+
+func (m *timeWindowPartitionManager) Stop() {
+	m.cancel()
+	m.wg.Wait()
+}
+
+func (m *timeWindowPartitionManager) schedule() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(Params.RootCoordCfg.TimeWindowPartitionCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	log.Info("time-windowed partition manager start")
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Info("time-windowed partition manager stop")
+			return
+		case <-ticker.C:
+			m.check(m.ctx)
+		}
+	}
+}
+
+func (m *timeWindowPartitionManager) check(ctx context.Context) {
+	dbs, err := m.meta.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		log.Warn("failed to list databases for time-windowed partitions", zap.Error(err))
+		return
+	}
+	for _, db := range dbs {
+		collections, err := m.meta.ListCollections(ctx, db.Name, typeutil.MaxTimestamp, true)
+		if err != nil {
+			log.Warn("failed to list collections for time-windowed partitions", zap.String("dbName", db.Name), zap.Error(err))
+			continue
+		}
+		for _, coll := range collections {
+			// the listed collections are shared with the meta table, get a copy
+			coll, err := m.meta.GetCollectionByID(ctx, db.Name, coll.CollectionID, typeutil.MaxTimestamp, false)
+			if err != nil {
+				continue
+			}
+			window, err := common.CollectionLevelPartitionTimeWindow(coll.Properties)
+			if err != nil {
+				log.RatedWarn(60, "invalid time-windowed partition config", zap.String("dbName", db.Name),
+					zap.String("collection", coll.Name), zap.Error(err))
+				continue
+			}
+			if window == nil {
+				continue
+			}
+			if err := checkTimeWindowField(coll, window); err != nil {
+				log.RatedWarn(60, "invalid time-windowed partition config", zap.String("dbName", db.Name),
+					zap.String("collection", coll.Name), zap.Error(err))
+				continue
+			}
+			m.checkCollection(ctx, db.Name, coll, window)
+		}
+	}
+}
+
+// checkTimeWindowField checks the timestamp field of the windows, which must be an int64 field,
+// and the collection must not be in partition key mode, which forbids creating partitions.
+func checkTimeWindowField(coll *model.Collection, window *common.PartitionTimeWindow) error {
+	var found bool
+	for _, field := range coll.Fields {
+		if field.IsPartitionKey {
+			return merr.WrapErrParameterInvalidMsg("time-windowed partitions is not supported in partition key mode")
+		}
+		if field.Name == window.Field {
+			if field.DataType != schemapb.DataType_Int64 {
+				return merr.WrapErrParameterInvalidMsg("timestamp field %s of time-windowed partitions must be int64", field.Name)
+			}
+			found = true
+		}
+	}
+	if !found {
+		return merr.WrapErrFieldNotFound(window.Field)
+	}
+	return nil
+}
+
+// checkCollection creates the partitions of the current and upcoming windows if not exist,
+// and drops the partitions older than the retention windows.
+func (m *timeWindowPartitionManager) checkCollection(ctx context.Context, dbName string, coll *model.Collection, window *common.PartitionTimeWindow) {
+	log := log.Ctx(ctx).With(zap.String("dbName", dbName), zap.String("collection", coll.Name),
+		zap.Int64("collectionID", coll.CollectionID))
+
+	current := window.WindowStart(m.now())
+	existing := typeutil.NewSet[string]()
+	for _, partition := range coll.Partitions {
+		existing.Insert(partition.PartitionName)
+	}
+
+	for i := 0; i <= Params.RootCoordCfg.TimeWindowPartitionPrecreateNum.GetAsInt(); i++ {
+		name := window.PartitionName(window.AddWindows(current, i))
+		if existing.Contain(name) {
+			continue
+		}
+		status, err := m.createPartition(ctx, &milvuspb.CreatePartitionRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreatePartition)),
+			DbName:         dbName,
+			CollectionName: coll.Name,
+			PartitionName:  name,
+		})
+		if err = merr.CheckRPCCall(status, err); err != nil {
+			log.Warn("failed to create time-windowed partition", zap.String("partition", name), zap.Error(err))
+			return
+		}
+		log.Info("time-windowed partition created", zap.String("partition", name))
+	}
+
+	if window.Retention == 0 {
+		return
+	}
+	oldest := window.AddWindows(current, 1-window.Retention)
+	for _, partition := range coll.Partitions {
+		start, ok := common.ParseTimeWindowPartitionName(partition.PartitionName)
+		if !ok || !start.Before(oldest) || !partition.Available() {
+			continue
+		}
+		status, err := m.dropPartition(ctx, &milvuspb.DropPartitionRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropPartition)),
+			DbName:         dbName,
+			CollectionName: coll.Name,
+			PartitionName:  partition.PartitionName,
+		})
+		if err = merr.CheckRPCCall(status, err); err != nil {
+			log.Warn("failed to drop expired time-windowed partition", zap.String("partition", partition.PartitionName), zap.Error(err))
+			return
+		}
+		log.Info("expired time-windowed partition dropped", zap.String("partition", partition.PartitionName),
+			zap.Time("windowStart", start))
+	}
+}
@@ -0,0 +1,158 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newTestTimeWindowPartitionManager(t *testing.T, coll *model.Collection) (*timeWindowPartitionManager, *[]string, *[]string) {
+	meta := mockrootcoord.NewIMetaTable(t)
+	meta.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return([]*model.Database{{Name: "default"}}, nil)
+	meta.EXPECT().ListCollections(mock.Anything, "default", mock.Anything, true).Return([]*model.Collection{coll}, nil)
+	meta.EXPECT().GetCollectionByID(mock.Anything, "default", coll.CollectionID, mock.Anything, false).Return(coll.Clone(), nil).Maybe()
+
+	created := make([]string, 0)
+	dropped := make([]string, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &timeWindowPartitionManager{
+		ctx:    ctx,
+		cancel: cancel,
+		meta:   meta,
+		createPartition: func(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
+			created = append(created, req.GetPartitionName())
+			return merr.Success(), nil
+		},
+		dropPartition: func(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
+			dropped = append(dropped, req.GetPartitionName())
+			return merr.Success(), nil
+		},
+		now: func() time.Time {
+			return time.Date(2024, 7, 17, 10, 0, 0, 0, time.UTC)
+		},
+	}
+	return m, &created, &dropped
+}
+
+func newTimeWindowCollection(properties ...*commonpb.KeyValuePair) *model.Collection {
+	return &model.Collection{
+		CollectionID: 100,
+		Name:         "coll",
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "ts", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "str", DataType: schemapb.DataType_VarChar},
+		},
+		Partitions: []*model.Partition{
+			{PartitionID: 1, PartitionName: "_default", State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 2, PartitionName: "_tw_20240714", State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 3, PartitionName: "_tw_20240715", State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 4, PartitionName: "_tw_20240716", State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 5, PartitionName: "_tw_20240717", State: pb.PartitionState_PartitionCreated},
+		},
+		Properties: properties,
+	}
+}
+
+func TestTimeWindowPartitionManager_Check(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.RootCoordCfg.TimeWindowPartitionPrecreateNum.Key, "2")
+	defer params.Reset(params.RootCoordCfg.TimeWindowPartitionPrecreateNum.Key)
+
+	t.Run("create and expire", func(t *testing.T) {
+		coll := newTimeWindowCollection(
+			&commonpb.KeyValuePair{Key: common.PartitionTimeWindowFieldKey, Value: "ts"},
+			&commonpb.KeyValuePair{Key: common.PartitionTimeWindowRetentionKey, Value: "3"},
+		)
+		m, created, dropped := newTestTimeWindowPartitionManager(t, coll)
+		m.check(context.Background())
+		assert.ElementsMatch(t, []string{"_tw_20240718", "_tw_20240719"}, *created)
+		assert.ElementsMatch(t, []string{"_tw_20240714"}, *dropped)
+	})
+
+	t.Run("weekly without retention", func(t *testing.T) {
+		coll := newTimeWindowCollection(
+			&commonpb.KeyValuePair{Key: common.PartitionTimeWindowFieldKey, Value: "ts"},
+			&commonpb.KeyValuePair{Key: common.PartitionTimeWindowUnitKey, Value: "week"},
+		)
+		m, created, dropped := newTestTimeWindowPartitionManager(t, coll)
+		m.check(context.Background())
+		assert.ElementsMatch(t, []string{"_tw_20240722", "_tw_20240729"}, *created)
+		assert.Empty(t, *dropped)
+	})
+
+	t.Run("create failed", func(t *testing.T) {
+		coll := newTimeWindowCollection(
+			&commonpb.KeyValuePair{Key: common.PartitionTimeWindowFieldKey, Value: "ts"},
+			&commonpb.KeyValuePair{Key: common.PartitionTimeWindowRetentionKey, Value: "1"},
+		)
+		m, _, dropped := newTestTimeWindowPartitionManager(t, coll)
+		m.createPartition = func(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
+			return merr.Status(merr.ErrServiceNotReady), nil
+		}
+		m.check(context.Background())
+		assert.Empty(t, *dropped)
+	})
+
+	t.Run("skip invalid config", func(t *testing.T) {
+		for _, coll := range []*model.Collection{
+			newTimeWindowCollection(),
+			newTimeWindowCollection(&commonpb.KeyValuePair{Key: common.PartitionTimeWindowFieldKey, Value: "str"}),
+			newTimeWindowCollection(&commonpb.KeyValuePair{Key: common.PartitionTimeWindowFieldKey, Value: "not_exist"}),
+			newTimeWindowCollection(
+				&commonpb.KeyValuePair{Key: common.PartitionTimeWindowFieldKey, Value: "ts"},
+				&commonpb.KeyValuePair{Key: common.PartitionTimeWindowUnitKey, Value: "month"},
+			),
+		} {
+			m, created, dropped := newTestTimeWindowPartitionManager(t, coll)
+			m.check(context.Background())
+			assert.Empty(t, *created)
+			assert.Empty(t, *dropped)
+		}
+	})
+
+	t.Run("skip partition key mode", func(t *testing.T) {
+		coll := newTimeWindowCollection(&commonpb.KeyValuePair{Key: common.PartitionTimeWindowFieldKey, Value: "ts"})
+		coll.Fields[2].IsPartitionKey = true
+		m, created, _ := newTestTimeWindowPartitionManager(t, coll)
+		m.check(context.Background())
+		assert.Empty(t, *created)
+	})
+}
+
+func TestTimeWindowPartitionManager_StartStop(t *testing.T) {
+	core := newTestCore(withMeta(mockrootcoord.NewIMetaTable(t)))
+	m := newTimeWindowPartitionManager(context.Background(), core)
+	m.Start()
+	m.Stop()
+}
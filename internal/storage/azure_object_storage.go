@@ -30,11 +30,16 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/service"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 )
 
+// azureBatchDeleteLimit is the max number of the sub-requests in a blob batch request.
+const azureBatchDeleteLimit = 256
+
 type AzureObjectStorage struct {
 	*service.Client
 }
@@ -201,7 +206,7 @@ func (AzureObjectStorage *AzureObjectStorage) WalkWithObjects(ctx context.Contex
 				return err
 			}
 			for _, blob := range pageResp.Segment.BlobItems {
-				if !walkFunc(&ChunkObjectInfo{FilePath: *blob.Name, ModifyTime: *blob.Properties.LastModified}) {
+				if !walkFunc(&ChunkObjectInfo{FilePath: *blob.Name, ModifyTime: *blob.Properties.LastModified, Size: lo.FromPtr(blob.Properties.ContentLength)}) {
 					return nil
 				}
 			}
@@ -217,7 +222,7 @@ func (AzureObjectStorage *AzureObjectStorage) WalkWithObjects(ctx context.Contex
 			}
 
 			for _, blob := range pageResp.Segment.BlobItems {
-				if !walkFunc(&ChunkObjectInfo{FilePath: *blob.Name, ModifyTime: *blob.Properties.LastModified}) {
+				if !walkFunc(&ChunkObjectInfo{FilePath: *blob.Name, ModifyTime: *blob.Properties.LastModified, Size: lo.FromPtr(blob.Properties.ContentLength)}) {
 					return nil
 				}
 			}
@@ -235,3 +240,36 @@ func (AzureObjectStorage *AzureObjectStorage) RemoveObject(ctx context.Context,
 	_, err := AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlockBlobClient(objectName).Delete(ctx, &blob.DeleteOptions{})
 	return checkObjectStorageError(objectName, err)
 }
+
+// RemoveObjects removes the objects with the blob batch API, which removes up to 256 objects per request.
+func (AzureObjectStorage *AzureObjectStorage) RemoveObjects(ctx context.Context, bucketName string, objectNames []string) error {
+	containerClient := AzureObjectStorage.Client.NewContainerClient(bucketName)
+	var errs []error
+	for _, batch := range lo.Chunk(objectNames, azureBatchDeleteLimit) {
+		batchBuilder, err := containerClient.NewBatchBuilder()
+		if err != nil {
+			return err
+		}
+		for _, objectName := range batch {
+			if err := batchBuilder.Delete(objectName, nil); err != nil {
+				return err
+			}
+		}
+		resp, err := containerClient.SubmitBatch(ctx, batchBuilder, nil)
+		if err != nil {
+			errs = append(errs, merr.WrapErrIoFailed(bucketName, err))
+			continue
+		}
+		for _, item := range resp.Responses {
+			if item.Error == nil {
+				continue
+			}
+			err := checkObjectStorageError(lo.FromPtr(item.BlobName), item.Error)
+			if errors.Is(err, merr.ErrIoKeyNotFound) {
+				continue
+			}
+			errs = append(errs, err)
+		}
+	}
+	return merr.Combine(errs...)
+}
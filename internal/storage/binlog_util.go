@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 
@@ -39,3 +41,37 @@ func ParseSegmentIDByBinlog(rootPath, path string) (UniqueID, error) {
 	}
 	return 0, fmt.Errorf("%s is not a valid binlog path", path)
 }
+
+// HashInsertBinlogPayloads writes the payloads of the events in the insert binlog into the writer,
+// the descriptor and the event headers carrying the ids and the write time are skipped,
+// so the copies of the same data in different segments hash the same.
+func HashInsertBinlogPayloads(w io.Writer, data []byte) error {
+	buffer := bytes.NewBuffer(data)
+	if _, err := readMagicNumber(buffer); err != nil {
+		return err
+	}
+	if _, err := ReadDescriptorEvent(buffer); err != nil {
+		return err
+	}
+	for buffer.Len() > 0 {
+		header, err := readEventHeader(buffer)
+		if err != nil {
+			return err
+		}
+		if header.TypeCode != InsertEventType {
+			return fmt.Errorf("unexpected event type %d in insert binlog", header.TypeCode)
+		}
+		eventData, err := readInsertEventDataFixPart(buffer)
+		if err != nil {
+			return err
+		}
+		next := int(header.EventLength - header.GetMemoryUsageInBytes() - eventData.GetEventDataFixPartSize())
+		if next < 0 || next > buffer.Len() {
+			return fmt.Errorf("invalid event length %d in insert binlog", header.EventLength)
+		}
+		if _, err := w.Write(buffer.Next(next)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
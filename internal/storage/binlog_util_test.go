@@ -1,9 +1,12 @@
 package storage
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 )
 
 func TestParseSegmentIDByBinlog(t *testing.T) {
@@ -82,3 +85,36 @@ func TestParseSegmentIDByBinlog(t *testing.T) {
 		})
 	}
 }
+
+func TestHashInsertBinlogPayloads(t *testing.T) {
+	writeBinlog := func(segmentID int64, startTs uint64, values []int64) []byte {
+		w := NewInsertBinlogWriter(schemapb.DataType_Int64, 10, 20, segmentID, 40, false)
+		defer w.Close()
+		e, err := w.NextInsertEventWriter()
+		assert.NoError(t, err)
+		err = e.AddDataToPayload(values, nil)
+		assert.NoError(t, err)
+		e.SetEventTimestamp(startTs, startTs+100)
+		w.SetEventTimeStamp(startTs, startTs+100)
+		w.baseBinlogWriter.descriptorEventData.AddExtra(originalSizeKey, "24")
+		err = w.Finish()
+		assert.NoError(t, err)
+		buf, err := w.GetBuffer()
+		assert.NoError(t, err)
+		return buf
+	}
+	hash := func(data []byte) []byte {
+		h := sha256.New()
+		err := HashInsertBinlogPayloads(h, data)
+		assert.NoError(t, err)
+		return h.Sum(nil)
+	}
+
+	origin := hash(writeBinlog(30, 100, []int64{1, 2, 3}))
+	// the copy in another segment written at another time
+	assert.Equal(t, origin, hash(writeBinlog(31, 1000, []int64{1, 2, 3})))
+	assert.NotEqual(t, origin, hash(writeBinlog(30, 100, []int64{1, 2, 4})))
+
+	err := HashInsertBinlogPayloads(sha256.New(), []byte{1, 2, 3})
+	assert.Error(t, err)
+}
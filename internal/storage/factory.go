@@ -48,9 +48,13 @@ func NewChunkManagerFactory(persistentStorage string, opts ...Option) *ChunkMana
 func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string) (ChunkManager, error) {
 	switch engine {
 	case "local":
-		return NewLocalChunkManager(RootPath(f.config.rootPath)), nil
+		return newMetricsChunkManager(NewLocalChunkManager(RootPath(f.config.rootPath))), nil
 	case "remote", "minio", "opendal":
-		return NewRemoteChunkManager(ctx, f.config)
+		cm, err := NewRemoteChunkManager(ctx, f.config)
+		if err != nil {
+			return nil, err
+		}
+		return newMetricsChunkManager(cm), nil
 	default:
 		return nil, errors.New("no chunk manager implemented with engine: " + engine)
 	}
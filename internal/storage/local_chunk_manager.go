@@ -159,7 +159,7 @@ func (lcm *LocalChunkManager) WalkWithPrefix(ctx context.Context, prefix string,
 				if err != nil {
 					return err
 				}
-				if !walkFunc(&ChunkObjectInfo{FilePath: filePath, ModifyTime: modTime}) {
+				if !walkFunc(&ChunkObjectInfo{FilePath: filePath, ModifyTime: modTime, Size: f.Size()}) {
 					return nil
 				}
 			}
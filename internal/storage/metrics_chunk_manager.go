@@ -0,0 +1,182 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var _ ChunkManager = (*metricsChunkManager)(nil)
+
+// metricsChunkManager records the latency and the size of the operations of the wrapped chunk manager,
+// tagged by the role of the component and the collection parsed from the paths, the operations
+// slower than the threshold are logged to diagnose the object storage.
+type metricsChunkManager struct {
+	ChunkManager
+}
+
+func newMetricsChunkManager(cm ChunkManager) *metricsChunkManager {
+	return &metricsChunkManager{ChunkManager: cm}
+}
+
+// observe records the operation started at the start time on the paths, size is the bytes read or written,
+// negative if the operation doesn't transfer data.
+func (m *metricsChunkManager) observe(ctx context.Context, op string, paths []string, start time.Time, size int64, err error) {
+	elapsed := time.Since(start)
+	collection := ""
+	if len(paths) > 0 {
+		collection = parseCollectionFromPath(paths[0])
+	}
+	role := paramtable.GetRole()
+	metrics.ChunkManagerOpLatency.WithLabelValues(role, op, collection).Observe(float64(elapsed.Milliseconds()))
+	if size >= 0 && err == nil {
+		metrics.ChunkManagerOpSize.WithLabelValues(role, op, collection).Observe(float64(size))
+	}
+
+	threshold := paramtable.Get().MinioCfg.SlowOpThresholdMs.GetAsDuration(time.Millisecond)
+	if threshold > 0 && elapsed > threshold {
+		fields := []zap.Field{
+			zap.String("op", op),
+			zap.Duration("elapsed", elapsed),
+			zap.Int("pathNum", len(paths)),
+			zap.Int64("size", size),
+			zap.Error(err),
+		}
+		if len(paths) > 0 {
+			fields = append(fields, zap.String("path", paths[0]))
+		}
+		log.Ctx(ctx).WithRateGroup("storage.slowOp", 1, 60).RatedWarn(10, "slow chunk manager operation", fields...)
+	}
+}
+
+func (m *metricsChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	start := time.Now()
+	size, err := m.ChunkManager.Size(ctx, filePath)
+	m.observe(ctx, metrics.DataStatLabel, []string{filePath}, start, -1, err)
+	return size, err
+}
+
+func (m *metricsChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	start := time.Now()
+	err := m.ChunkManager.Write(ctx, filePath, content)
+	m.observe(ctx, metrics.DataPutLabel, []string{filePath}, start, int64(len(content)), err)
+	return err
+}
+
+func (m *metricsChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	start := time.Now()
+	err := m.ChunkManager.MultiWrite(ctx, contents)
+	paths := make([]string, 0, len(contents))
+	size := 0
+	for filePath, content := range contents {
+		paths = append(paths, filePath)
+		size += len(content)
+	}
+	m.observe(ctx, metrics.DataMultiPutLabel, paths, start, int64(size), err)
+	return err
+}
+
+func (m *metricsChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	start := time.Now()
+	exist, err := m.ChunkManager.Exist(ctx, filePath)
+	m.observe(ctx, metrics.DataExistLabel, []string{filePath}, start, -1, err)
+	return exist, err
+}
+
+func (m *metricsChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	start := time.Now()
+	content, err := m.ChunkManager.Read(ctx, filePath)
+	m.observe(ctx, metrics.DataGetLabel, []string{filePath}, start, int64(len(content)), err)
+	return content, err
+}
+
+func (m *metricsChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	start := time.Now()
+	contents, err := m.ChunkManager.MultiRead(ctx, filePaths)
+	size := 0
+	for _, content := range contents {
+		size += len(content)
+	}
+	m.observe(ctx, metrics.DataMultiGetLabel, filePaths, start, int64(size), err)
+	return contents, err
+}
+
+func (m *metricsChunkManager) WalkWithPrefix(ctx context.Context, prefix string, recursive bool, walkFunc ChunkObjectWalkFunc) error {
+	start := time.Now()
+	err := m.ChunkManager.WalkWithPrefix(ctx, prefix, recursive, walkFunc)
+	m.observe(ctx, metrics.DataWalkLabel, []string{prefix}, start, -1, err)
+	return err
+}
+
+func (m *metricsChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	return m.ChunkManager.Mmap(ctx, filePath)
+}
+
+func (m *metricsChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	start := time.Now()
+	p, err := m.ChunkManager.ReadAt(ctx, filePath, off, length)
+	m.observe(ctx, metrics.DataReadAtLabel, []string{filePath}, start, int64(len(p)), err)
+	return p, err
+}
+
+func (m *metricsChunkManager) Remove(ctx context.Context, filePath string) error {
+	start := time.Now()
+	err := m.ChunkManager.Remove(ctx, filePath)
+	m.observe(ctx, metrics.DataRemoveLabel, []string{filePath}, start, -1, err)
+	return err
+}
+
+func (m *metricsChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	start := time.Now()
+	err := m.ChunkManager.MultiRemove(ctx, filePaths)
+	m.observe(ctx, metrics.DataMultiRemoveLabel, filePaths, start, -1, err)
+	return err
+}
+
+func (m *metricsChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	start := time.Now()
+	err := m.ChunkManager.RemoveWithPrefix(ctx, prefix)
+	m.observe(ctx, metrics.DataRemovePrefixLabel, []string{prefix}, start, -1, err)
+	return err
+}
+
+// parseCollectionFromPath returns the collection ID in the path of the segment logs or the partition stats,
+// empty if the path doesn't belong to a collection.
+func parseCollectionFromPath(filePath string) string {
+	elems := strings.Split(filePath, "/")
+	for i := 0; i < len(elems)-1; i++ {
+		switch elems[i] {
+		case common.SegmentInsertLogPath, common.SegmentDeltaLogPath, common.SegmentStatslogPath, common.PartitionStatsPath:
+			if _, err := strconv.ParseInt(elems[i+1], 10, 64); err == nil {
+				return elems[i+1]
+			}
+			return ""
+		}
+	}
+	return ""
+}
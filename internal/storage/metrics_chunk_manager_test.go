@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMetricsChunkManager(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	rootPath := path.Join(localPath, "test_metrics")
+	cm := newMetricsChunkManager(NewLocalChunkManager(RootPath(rootPath)))
+	defer cm.RemoveWithPrefix(ctx, rootPath)
+
+	// log every operation as a slow one
+	paramtable.Get().Save(paramtable.Get().MinioCfg.SlowOpThresholdMs.Key, "0.000001")
+	defer paramtable.Get().Reset(paramtable.Get().MinioCfg.SlowOpThresholdMs.Key)
+
+	key := path.Join(rootPath, "insert_log", "100", "101", "102", "103", "1")
+	err := cm.Write(ctx, key, []byte("123"))
+	assert.NoError(t, err)
+	err = cm.MultiWrite(ctx, map[string][]byte{key: []byte("1234")})
+	assert.NoError(t, err)
+
+	exist, err := cm.Exist(ctx, key)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	size, err := cm.Size(ctx, key)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, size)
+
+	content, err := cm.Read(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1234"), content)
+	contents, err := cm.MultiRead(ctx, []string{key})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("1234")}, contents)
+	content, err = cm.ReadAt(ctx, key, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("23"), content)
+
+	paths, _, err := ListAllChunkWithPrefix(ctx, cm, rootPath, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{key}, paths)
+
+	err = cm.Remove(ctx, key)
+	assert.NoError(t, err)
+	err = cm.MultiRemove(ctx, []string{key})
+	assert.NoError(t, err)
+	_, err = cm.Read(ctx, key)
+	assert.Error(t, err)
+}
+
+func TestParseCollectionFromPath(t *testing.T) {
+	assert.Equal(t, "100", parseCollectionFromPath("files/insert_log/100/101/102/103/1"))
+	assert.Equal(t, "100", parseCollectionFromPath("files/delta_log/100/101/102/1"))
+	assert.Equal(t, "100", parseCollectionFromPath("files/stats_log/100/101/102/103/1"))
+	assert.Equal(t, "100", parseCollectionFromPath("files/part_stats/100/101/ch/1"))
+	assert.Equal(t, "", parseCollectionFromPath("files/index_files/1/1/2/3/index"))
+	assert.Equal(t, "", parseCollectionFromPath("files/insert_log/abc"))
+	assert.Equal(t, "", parseCollectionFromPath("files/insert_log"))
+}
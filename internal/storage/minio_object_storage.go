@@ -23,6 +23,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/cockroachdb/errors"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"go.uber.org/zap"
@@ -31,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage/gcp"
 	"github.com/milvus-io/milvus/internal/storage/tencent"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 )
@@ -209,7 +211,7 @@ func (minioObjectStorage *MinioObjectStorage) WalkWithObjects(ctx context.Contex
 		if object.Err != nil {
 			return object.Err
 		}
-		if !walkFunc(&ChunkObjectInfo{FilePath: object.Key, ModifyTime: object.LastModified}) {
+		if !walkFunc(&ChunkObjectInfo{FilePath: object.Key, ModifyTime: object.LastModified, Size: object.Size}) {
 			return nil
 		}
 	}
@@ -220,3 +222,22 @@ func (minioObjectStorage *MinioObjectStorage) RemoveObject(ctx context.Context,
 	err := minioObjectStorage.Client.RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
 	return checkObjectStorageError(objectName, err)
 }
+
+// RemoveObjects removes the objects with the multi-object delete API, which removes up to 1000 objects per request.
+func (minioObjectStorage *MinioObjectStorage) RemoveObjects(ctx context.Context, bucketName string, objectNames []string) error {
+	objectsCh := make(chan minio.ObjectInfo, len(objectNames))
+	for _, objectName := range objectNames {
+		objectsCh <- minio.ObjectInfo{Key: objectName}
+	}
+	close(objectsCh)
+
+	var errs []error
+	for removeErr := range minioObjectStorage.Client.RemoveObjects(ctx, bucketName, objectsCh, minio.RemoveObjectsOptions{}) {
+		err := checkObjectStorageError(removeErr.ObjectName, removeErr.Err)
+		if errors.Is(err, merr.ErrIoKeyNotFound) {
+			continue
+		}
+		errs = append(errs, err)
+	}
+	return merr.Combine(errs...)
+}
@@ -60,6 +60,8 @@ type ObjectStorage interface {
 	// 2. underlying walking failed or context canceled, WalkWithPrefix will stop and return a error.
 	WalkWithObjects(ctx context.Context, bucketName string, prefix string, recursive bool, walkFunc ChunkObjectWalkFunc) error
 	RemoveObject(ctx context.Context, bucketName, objectName string) error
+	// RemoveObjects removes the objects in multi-object delete requests, the objects not exist are ignored.
+	RemoveObjects(ctx context.Context, bucketName string, objectNames []string) error
 }
 
 // RemoteChunkManager is responsible for read and write data stored in minio.
@@ -274,16 +276,17 @@ func (mcm *RemoteChunkManager) Remove(ctx context.Context, filePath string) erro
 	return nil
 }
 
-// MultiRemove deletes a objects with @keys.
+// MultiRemove deletes a objects with @keys in multi-object delete requests.
 func (mcm *RemoteChunkManager) MultiRemove(ctx context.Context, keys []string) error {
-	var el error
-	for _, key := range keys {
-		err := mcm.Remove(ctx, key)
-		if err != nil {
-			el = merr.Combine(el, errors.Wrapf(err, "failed to remove %s", key))
-		}
+	if len(keys) == 0 {
+		return nil
 	}
-	return el
+	err := mcm.removeObjects(ctx, mcm.bucketName, keys)
+	if err != nil {
+		log.Warn("failed to remove objects", zap.String("bucket", mcm.bucketName), zap.Int("num", len(keys)), zap.Error(err))
+		return err
+	}
+	return nil
 }
 
 // RemoveWithPrefix removes all objects with the same prefix @prefix from minio.
@@ -387,6 +390,22 @@ func (mcm *RemoteChunkManager) removeObject(ctx context.Context, bucketName, obj
 	return err
 }
 
+func (mcm *RemoteChunkManager) removeObjects(ctx context.Context, bucketName string, objectNames []string) error {
+	start := timerecord.NewTimeRecorder("removeObjects")
+
+	err := mcm.client.RemoveObjects(ctx, bucketName, objectNames)
+	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataMultiRemoveLabel, metrics.TotalLabel).Inc()
+	if err == nil {
+		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataMultiRemoveLabel).
+			Observe(float64(start.ElapseSpan().Milliseconds()))
+		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataMultiRemoveLabel, metrics.SuccessLabel).Inc()
+	} else {
+		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataMultiRemoveLabel, metrics.FailLabel).Inc()
+	}
+
+	return err
+}
+
 func checkObjectStorageError(fileName string, err error) error {
 	if err == nil {
 		return nil
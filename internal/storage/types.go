@@ -28,6 +28,10 @@ const (
 	// CompundStatsType log save multiple stats
 	// and bloom filters to one file
 	CompoundStatsType
+
+	// VectorStatsType log saves the aggregated statistics of a vector field,
+	// see VectorFieldStats
+	VectorStatsType
 )
 
 func (s StatsLogType) LogIdx() string {
@@ -45,6 +49,8 @@ type FileReader interface {
 type ChunkObjectInfo struct {
 	FilePath   string
 	ModifyTime time.Time
+	// Size is the size in bytes of the object, 0 if unknown or the object is a prefix.
+	Size int64
 }
 
 // ChunkManager is to manager chunks.
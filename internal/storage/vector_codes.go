@@ -0,0 +1,400 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// quantization types of the vector codes persisted alongside the raw vectors, see dataNode.quantization.type
+const (
+	QuantizationSQ8 = "SQ8"
+	QuantizationPQ  = "PQ"
+)
+
+const (
+	vectorCodesVersion = 1
+	// pqCentroidNum is the number of the centroids of each PQ sub-quantizer, each code takes one byte
+	pqCentroidNum = 256
+	// pqTrainSampleNum and pqTrainIterations bound the cost of training the PQ centroids of a batch
+	pqTrainSampleNum  = 2048
+	pqTrainIterations = 8
+)
+
+// VectorCodes is the quantized codes of a batch of vectors, written along with the insert binlog of the batch,
+// so that the vectors can be served from the codes with much less memory.
+// SQ8 quantizes each dimension into one byte by its min and max within the batch,
+// PQ splits the vector into M sub-vectors and encodes each by the nearest of 256 centroids trained from the batch.
+type VectorCodes struct {
+	Type    string
+	Dim     int
+	NumRows int
+	// Min and Scale of each dimension for SQ8
+	Min   []float32
+	Scale []float32
+	// M sub-quantizers for PQ, the centroids are laid out as [M][256][Dim/M]
+	M         int
+	Centroids []float32
+	// Codes of the rows, CodeSize bytes per row
+	Codes []byte
+}
+
+// SupportQuantization returns whether the vectors of the type can be quantized.
+func SupportQuantization(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_FloatVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		return true
+	default:
+		return false
+	}
+}
+
+// CodeSize returns the bytes of the code of each row.
+func (c *VectorCodes) CodeSize() int {
+	if c.Type == QuantizationPQ {
+		return c.M
+	}
+	return c.Dim
+}
+
+// MemorySize returns the memory size of the codes and the quantizer.
+func (c *VectorCodes) MemorySize() int64 {
+	return int64(len(c.Codes)) + int64(len(c.Min)+len(c.Scale)+len(c.Centroids))*4
+}
+
+// Decode reconstructs the approximate vector of the row from its code.
+func (c *VectorCodes) Decode(row int) []float32 {
+	codeSize := c.CodeSize()
+	code := c.Codes[row*codeSize : (row+1)*codeSize]
+	vector := make([]float32, c.Dim)
+	if c.Type == QuantizationPQ {
+		subDim := c.Dim / c.M
+		for m, centroid := range code {
+			offset := (m*pqCentroidNum + int(centroid)) * subDim
+			copy(vector[m*subDim:(m+1)*subDim], c.Centroids[offset:offset+subDim])
+		}
+		return vector
+	}
+	for i, v := range code {
+		vector[i] = c.Min[i] + float32(v)*c.Scale[i]
+	}
+	return vector
+}
+
+// QuantizeVectors quantizes the vectors of the dimension by the quantization type,
+// pqM is the number of the PQ sub-quantizers, which must divide the dimension.
+func QuantizeVectors(quantization string, dim int, pqM int, vectors []float32) (*VectorCodes, error) {
+	if dim <= 0 || len(vectors)%dim != 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("vector data length %d is not a multiple of dimension %d", len(vectors), dim)
+	}
+	switch quantization {
+	case QuantizationSQ8:
+		return quantizeSQ8(dim, vectors), nil
+	case QuantizationPQ:
+		if pqM <= 0 || dim%pqM != 0 {
+			return nil, merr.WrapErrParameterInvalidMsg("dimension %d is not divisible by the PQ sub-quantizer number %d", dim, pqM)
+		}
+		return quantizePQ(dim, pqM, vectors), nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unknown quantization type %s", quantization)
+	}
+}
+
+func quantizeSQ8(dim int, vectors []float32) *VectorCodes {
+	numRows := len(vectors) / dim
+	codes := &VectorCodes{
+		Type:    QuantizationSQ8,
+		Dim:     dim,
+		NumRows: numRows,
+		Min:     make([]float32, dim),
+		Scale:   make([]float32, dim),
+		Codes:   make([]byte, numRows*dim),
+	}
+	maxValues := make([]float32, dim)
+	for i := 0; i < dim; i++ {
+		codes.Min[i] = math.MaxFloat32
+		maxValues[i] = -math.MaxFloat32
+	}
+	for row := 0; row < numRows; row++ {
+		for i, v := range vectors[row*dim : (row+1)*dim] {
+			codes.Min[i] = min(codes.Min[i], v)
+			maxValues[i] = max(maxValues[i], v)
+		}
+	}
+	for i := 0; i < dim; i++ {
+		if numRows == 0 {
+			codes.Min[i] = 0
+			continue
+		}
+		codes.Scale[i] = (maxValues[i] - codes.Min[i]) / math.MaxUint8
+	}
+	for row := 0; row < numRows; row++ {
+		for i, v := range vectors[row*dim : (row+1)*dim] {
+			if codes.Scale[i] == 0 {
+				continue
+			}
+			q := math.Round(float64((v - codes.Min[i]) / codes.Scale[i]))
+			codes.Codes[row*dim+i] = byte(math.Max(0, math.Min(math.MaxUint8, q)))
+		}
+	}
+	return codes
+}
+
+func quantizePQ(dim int, m int, vectors []float32) *VectorCodes {
+	numRows := len(vectors) / dim
+	subDim := dim / m
+	codes := &VectorCodes{
+		Type:      QuantizationPQ,
+		Dim:       dim,
+		NumRows:   numRows,
+		M:         m,
+		Centroids: make([]float32, m*pqCentroidNum*subDim),
+		Codes:     make([]byte, numRows*m),
+	}
+	if numRows == 0 {
+		return codes
+	}
+
+	// train each sub-quantizer on the evenly sampled rows
+	sampleNum := min(numRows, pqTrainSampleNum)
+	samples := make([]int, sampleNum)
+	for i := range samples {
+		samples[i] = i * numRows / sampleNum
+	}
+	subVector := func(row, sub int) []float32 {
+		offset := row*dim + sub*subDim
+		return vectors[offset : offset+subDim]
+	}
+	for sub := 0; sub < m; sub++ {
+		centroids := codes.Centroids[sub*pqCentroidNum*subDim : (sub+1)*pqCentroidNum*subDim]
+		for c := 0; c < pqCentroidNum; c++ {
+			copy(centroids[c*subDim:(c+1)*subDim], subVector(samples[c%sampleNum], sub))
+		}
+		sums := make([]float32, pqCentroidNum*subDim)
+		counts := make([]int, pqCentroidNum)
+		for iter := 0; iter < pqTrainIterations; iter++ {
+			clear(sums)
+			clear(counts)
+			for _, row := range samples {
+				vector := subVector(row, sub)
+				c := nearestCentroid(centroids, subDim, vector)
+				counts[c]++
+				for i, v := range vector {
+					sums[c*subDim+i] += v
+				}
+			}
+			for c := 0; c < pqCentroidNum; c++ {
+				// the empty clusters keep their centroids
+				if counts[c] == 0 {
+					continue
+				}
+				for i := 0; i < subDim; i++ {
+					centroids[c*subDim+i] = sums[c*subDim+i] / float32(counts[c])
+				}
+			}
+		}
+		for row := 0; row < numRows; row++ {
+			codes.Codes[row*m+sub] = byte(nearestCentroid(centroids, subDim, subVector(row, sub)))
+		}
+	}
+	return codes
+}
+
+func nearestCentroid(centroids []float32, subDim int, vector []float32) int {
+	nearest, minDistance := 0, float32(math.MaxFloat32)
+	for c := 0; c < pqCentroidNum; c++ {
+		var distance float32
+		for i, v := range vector {
+			diff := v - centroids[c*subDim+i]
+			distance += diff * diff
+		}
+		if distance < minDistance {
+			nearest, minDistance = c, distance
+		}
+	}
+	return nearest
+}
+
+// VectorCodesBuilder buffers the vectors of a field and quantizes them into codes in batch.
+type VectorCodesBuilder struct {
+	dataType schemapb.DataType
+	dim      int
+	vectors  []float32
+}
+
+func NewVectorCodesBuilder(field *schemapb.FieldSchema) (*VectorCodesBuilder, error) {
+	if !SupportQuantization(field.GetDataType()) {
+		return nil, merr.WrapErrParameterInvalidMsg("quantization is not supported for %s", field.GetDataType().String())
+	}
+	dim, err := typeutil.GetDim(field)
+	if err != nil {
+		return nil, err
+	}
+	return &VectorCodesBuilder{
+		dataType: field.GetDataType(),
+		dim:      int(dim),
+	}, nil
+}
+
+// AppendValue appends a vector of the row value, []float32 for float vectors and []byte for float16 or bfloat16 vectors.
+func (b *VectorCodesBuilder) AppendValue(value any) error {
+	vector, err := toFloat32Vector(b.dataType, value)
+	if err != nil {
+		return err
+	}
+	if len(vector) != b.dim {
+		return merr.WrapErrParameterInvalidMsg("vector dimension %d mismatches with the field dimension %d", len(vector), b.dim)
+	}
+	b.vectors = append(b.vectors, vector...)
+	return nil
+}
+
+// AppendFieldData appends all the vectors of the field data.
+func (b *VectorCodesBuilder) AppendFieldData(data FieldData) error {
+	switch fd := data.(type) {
+	case *FloatVectorFieldData:
+		if err := checkVectorDim(fd.Dim, b.dim, len(fd.Data), 1); err != nil {
+			return err
+		}
+		b.vectors = append(b.vectors, fd.Data...)
+	case *Float16VectorFieldData:
+		if err := checkVectorDim(fd.Dim, b.dim, len(fd.Data), 2); err != nil {
+			return err
+		}
+		b.vectors = append(b.vectors, typeutil.Float16BytesToFloat32Vector(fd.Data)...)
+	case *BFloat16VectorFieldData:
+		if err := checkVectorDim(fd.Dim, b.dim, len(fd.Data), 2); err != nil {
+			return err
+		}
+		b.vectors = append(b.vectors, typeutil.BFloat16BytesToFloat32Vector(fd.Data)...)
+	default:
+		return merr.WrapErrParameterInvalidMsg("quantization is not supported for %T", data)
+	}
+	return nil
+}
+
+func (b *VectorCodesBuilder) RowNum() int {
+	return len(b.vectors) / b.dim
+}
+
+// Build quantizes the buffered vectors and resets the builder.
+func (b *VectorCodesBuilder) Build(quantization string, pqM int) (*VectorCodes, error) {
+	vectors := b.vectors
+	b.vectors = nil
+	return QuantizeVectors(quantization, b.dim, pqM, vectors)
+}
+
+func toFloat32Vector(dataType schemapb.DataType, value any) ([]float32, error) {
+	switch dataType {
+	case schemapb.DataType_FloatVector:
+		if v, ok := value.([]float32); ok {
+			return v, nil
+		}
+	case schemapb.DataType_Float16Vector:
+		if v, ok := value.([]byte); ok {
+			return typeutil.Float16BytesToFloat32Vector(v), nil
+		}
+	case schemapb.DataType_BFloat16Vector:
+		if v, ok := value.([]byte); ok {
+			return typeutil.BFloat16BytesToFloat32Vector(v), nil
+		}
+	}
+	return nil, merr.WrapErrParameterInvalidMsg("invalid %s value of type %T", dataType.String(), value)
+}
+
+type vectorCodesHeader struct {
+	Version      int32
+	PQ           bool
+	Dim          int32
+	NumRows      int32
+	M            int32
+	CentroidsLen int32
+}
+
+// SerializeVectorCodes serializes the codes to be saved in the quantized log.
+func SerializeVectorCodes(codes *VectorCodes) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	header := vectorCodesHeader{
+		Version:      vectorCodesVersion,
+		PQ:           codes.Type == QuantizationPQ,
+		Dim:          int32(codes.Dim),
+		NumRows:      int32(codes.NumRows),
+		M:            int32(codes.M),
+		CentroidsLen: int32(len(codes.Centroids)),
+	}
+	for _, data := range []any{header, codes.Min, codes.Scale, codes.Centroids, codes.Codes} {
+		if err := binary.Write(buf, common.Endian, data); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// DeserializeVectorCodes deserializes the codes saved in the quantized log.
+func DeserializeVectorCodes(data []byte) (*VectorCodes, error) {
+	reader := bytes.NewReader(data)
+	header := vectorCodesHeader{}
+	if err := binary.Read(reader, common.Endian, &header); err != nil {
+		return nil, err
+	}
+	if header.Version != vectorCodesVersion {
+		return nil, merr.WrapErrParameterInvalidMsg("unknown vector codes version %d", header.Version)
+	}
+	codes := &VectorCodes{
+		Type:    QuantizationSQ8,
+		Dim:     int(header.Dim),
+		NumRows: int(header.NumRows),
+		M:       int(header.M),
+	}
+	if header.PQ {
+		codes.Type = QuantizationPQ
+	} else {
+		codes.Min = make([]float32, codes.Dim)
+		codes.Scale = make([]float32, codes.Dim)
+	}
+	codes.Centroids = make([]float32, header.CentroidsLen)
+	codes.Codes = make([]byte, codes.NumRows*codes.CodeSize())
+	for _, data := range []any{codes.Min, codes.Scale, codes.Centroids, codes.Codes} {
+		if err := binary.Read(reader, common.Endian, data); err != nil {
+			return nil, err
+		}
+	}
+	return codes, nil
+}
+
+// NewVectorCodesBuilders returns the builders of the quantizable vector fields of the schema.
+func NewVectorCodesBuilders(schema *schemapb.CollectionSchema) (map[int64]*VectorCodesBuilder, error) {
+	builders := make(map[int64]*VectorCodesBuilder)
+	for _, field := range schema.GetFields() {
+		if !SupportQuantization(field.GetDataType()) {
+			continue
+		}
+		builder, err := NewVectorCodesBuilder(field)
+		if err != nil {
+			return nil, err
+		}
+		builders[field.GetFieldID()] = builder
+	}
+	return builders, nil
+}
@@ -0,0 +1,155 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func genRandomVectors(rowNum, dim int) []float32 {
+	vectors := make([]float32, rowNum*dim)
+	for i := range vectors {
+		vectors[i] = rand.Float32()*2 - 1
+	}
+	return vectors
+}
+
+func float16Bytes(vectors []float32) []byte {
+	data := make([]byte, 0, len(vectors)*2)
+	for _, v := range vectors {
+		data = append(data, typeutil.Float32ToFloat16Bytes(v)...)
+	}
+	return data
+}
+
+func TestQuantizeVectors(t *testing.T) {
+	dim, rowNum := 8, 300
+	vectors := genRandomVectors(rowNum, dim)
+
+	t.Run("sq8", func(t *testing.T) {
+		codes, err := QuantizeVectors(QuantizationSQ8, dim, 0, vectors)
+		require.NoError(t, err)
+		assert.Equal(t, rowNum, codes.NumRows)
+		assert.Equal(t, dim, codes.CodeSize())
+		assert.Len(t, codes.Codes, rowNum*dim)
+		for row := 0; row < rowNum; row++ {
+			decoded := codes.Decode(row)
+			for i, v := range decoded {
+				// the error is bounded by half of the quantization step
+				assert.InDelta(t, vectors[row*dim+i], v, float64(codes.Scale[i])/2+1e-6)
+			}
+		}
+	})
+
+	t.Run("pq", func(t *testing.T) {
+		codes, err := QuantizeVectors(QuantizationPQ, dim, 4, vectors)
+		require.NoError(t, err)
+		assert.Equal(t, rowNum, codes.NumRows)
+		assert.Equal(t, 4, codes.CodeSize())
+		assert.Len(t, codes.Codes, rowNum*4)
+		assert.Len(t, codes.Centroids, 4*pqCentroidNum*dim/4)
+		assert.Len(t, codes.Decode(0), dim)
+		// the rows less than the centroids are encoded exactly
+		codes, err = QuantizeVectors(QuantizationPQ, dim, 4, vectors[:10*dim])
+		require.NoError(t, err)
+		for row := 0; row < 10; row++ {
+			assert.InDeltaSlice(t, vectors[row*dim:(row+1)*dim], codes.Decode(row), 1e-6)
+		}
+	})
+
+	t.Run("constant dimension", func(t *testing.T) {
+		codes, err := QuantizeVectors(QuantizationSQ8, 2, 0, []float32{1, 2, 1, 3})
+		require.NoError(t, err)
+		assert.Equal(t, []float32{1, 2}, codes.Decode(0))
+		assert.Equal(t, []float32{1, 3}, codes.Decode(1))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := QuantizeVectors(QuantizationSQ8, 3, 0, vectors[:8])
+		assert.Error(t, err)
+		_, err = QuantizeVectors(QuantizationPQ, dim, 3, vectors)
+		assert.Error(t, err)
+		_, err = QuantizeVectors("unknown", dim, 0, vectors)
+		assert.Error(t, err)
+	})
+}
+
+func TestVectorCodesSerialization(t *testing.T) {
+	dim := 8
+	vectors := genRandomVectors(100, dim)
+	for _, quantization := range []string{QuantizationSQ8, QuantizationPQ} {
+		t.Run(quantization, func(t *testing.T) {
+			codes, err := QuantizeVectors(quantization, dim, 2, vectors)
+			require.NoError(t, err)
+			data, err := SerializeVectorCodes(codes)
+			require.NoError(t, err)
+			deserialized, err := DeserializeVectorCodes(data)
+			require.NoError(t, err)
+			assert.Equal(t, codes.Type, deserialized.Type)
+			assert.Equal(t, codes.Codes, deserialized.Codes)
+			assert.Equal(t, codes.Decode(10), deserialized.Decode(10))
+
+			_, err = DeserializeVectorCodes(data[:len(data)-1])
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestVectorCodesBuilder(t *testing.T) {
+	dim := 4
+	_, err := NewVectorCodesBuilder(&schemapb.FieldSchema{FieldID: 100, DataType: schemapb.DataType_BinaryVector})
+	assert.Error(t, err)
+
+	builders, err := NewVectorCodesBuilders(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}},
+			{FieldID: 102, DataType: schemapb.DataType_Float16Vector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, builders, 2)
+
+	vectors := genRandomVectors(3, dim)
+	floatBuilder := builders[101]
+	assert.NoError(t, floatBuilder.AppendValue(vectors[:dim]))
+	assert.NoError(t, floatBuilder.AppendFieldData(&FloatVectorFieldData{Dim: dim, Data: vectors[dim:]}))
+	assert.Error(t, floatBuilder.AppendValue(vectors[:dim-1]))
+	assert.Error(t, floatBuilder.AppendValue([]byte{1}))
+	assert.Error(t, floatBuilder.AppendFieldData(&Int64FieldData{Data: []int64{1}}))
+	assert.Equal(t, 3, floatBuilder.RowNum())
+	codes, err := floatBuilder.Build(QuantizationSQ8, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, codes.NumRows)
+	assert.Equal(t, 0, floatBuilder.RowNum())
+
+	float16Builder := builders[102]
+	assert.NoError(t, float16Builder.AppendValue(float16Bytes(vectors[:dim])))
+	assert.NoError(t, float16Builder.AppendFieldData(&Float16VectorFieldData{Dim: dim, Data: float16Bytes(vectors[dim:])}))
+	codes, err = float16Builder.Build(QuantizationSQ8, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, codes.NumRows)
+}
@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// VectorFieldStats is the lightweight statistics of the vectors of a field for data quality monitoring,
+// the norm distribution is described by min, max, mean and standard deviation of the valid vectors.
+type VectorFieldStats struct {
+	FieldID int64 `json:"fieldID"`
+	RowNum  int64 `json:"rowNum"`
+	// vectors with all zero elements, included in the norm distribution
+	ZeroNum int64 `json:"zeroNum"`
+	// vectors containing NaN or Inf, excluded from the norm distribution
+	InvalidNum    int64   `json:"invalidNum"`
+	MinNorm       float64 `json:"minNorm"`
+	MaxNorm       float64 `json:"maxNorm"`
+	NormSum       float64 `json:"normSum"`
+	NormSquareSum float64 `json:"normSquareSum"`
+}
+
+// SupportVectorStats returns whether the statistics is collected for the vector type.
+func SupportVectorStats(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_FloatVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		return true
+	default:
+		return false
+	}
+}
+
+func NewVectorFieldStats(fieldID int64) *VectorFieldStats {
+	return &VectorFieldStats{FieldID: fieldID}
+}
+
+// CollectVectorStats computes the statistics of the vectors in the field data,
+// an error is returned if the data does not match the dimension of the field.
+func CollectVectorStats(fieldID int64, dim int, data FieldData) (*VectorFieldStats, error) {
+	stats := NewVectorFieldStats(fieldID)
+	switch fd := data.(type) {
+	case *FloatVectorFieldData:
+		if err := checkVectorDim(fd.Dim, dim, len(fd.Data), 1); err != nil {
+			return nil, err
+		}
+		for i := 0; i+dim <= len(fd.Data); i += dim {
+			stats.update(fd.Data[i : i+dim])
+		}
+	case *Float16VectorFieldData:
+		if err := checkVectorDim(fd.Dim, dim, len(fd.Data), 2); err != nil {
+			return nil, err
+		}
+		for i := 0; i+dim*2 <= len(fd.Data); i += dim * 2 {
+			stats.update(typeutil.Float16BytesToFloat32Vector(fd.Data[i : i+dim*2]))
+		}
+	case *BFloat16VectorFieldData:
+		if err := checkVectorDim(fd.Dim, dim, len(fd.Data), 2); err != nil {
+			return nil, err
+		}
+		for i := 0; i+dim*2 <= len(fd.Data); i += dim * 2 {
+			stats.update(typeutil.BFloat16BytesToFloat32Vector(fd.Data[i : i+dim*2]))
+		}
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("vector statistics is not supported for %T", data)
+	}
+	return stats, nil
+}
+
+func checkVectorDim(dataDim, dim, length, bytesPerElem int) error {
+	if dim <= 0 || dataDim != dim {
+		return merr.WrapErrParameterInvalidMsg("vector dimension %d mismatches with the field dimension %d", dataDim, dim)
+	}
+	if length%(dim*bytesPerElem) != 0 {
+		return merr.WrapErrParameterInvalidMsg("vector data length %d is not a multiple of dimension %d", length/bytesPerElem, dim)
+	}
+	return nil
+}
+
+func (stats *VectorFieldStats) update(vector []float32) {
+	var square float64
+	for _, v := range vector {
+		f := float64(v)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			stats.RowNum++
+			stats.InvalidNum++
+			return
+		}
+		square += f * f
+	}
+	if square == 0 {
+		stats.ZeroNum++
+	}
+	norm := math.Sqrt(square)
+	if stats.validNum() == 0 || norm < stats.MinNorm {
+		stats.MinNorm = norm
+	}
+	if stats.validNum() == 0 || norm > stats.MaxNorm {
+		stats.MaxNorm = norm
+	}
+	stats.NormSum += norm
+	stats.NormSquareSum += square
+	stats.RowNum++
+}
+
+func (stats *VectorFieldStats) validNum() int64 {
+	return stats.RowNum - stats.InvalidNum
+}
+
+// Merge merges the statistics of another batch of the same field.
+func (stats *VectorFieldStats) Merge(other *VectorFieldStats) {
+	if other == nil || other.RowNum == 0 {
+		return
+	}
+	if other.validNum() > 0 {
+		if stats.validNum() == 0 || other.MinNorm < stats.MinNorm {
+			stats.MinNorm = other.MinNorm
+		}
+		if stats.validNum() == 0 || other.MaxNorm > stats.MaxNorm {
+			stats.MaxNorm = other.MaxNorm
+		}
+	}
+	stats.RowNum += other.RowNum
+	stats.ZeroNum += other.ZeroNum
+	stats.InvalidNum += other.InvalidNum
+	stats.NormSum += other.NormSum
+	stats.NormSquareSum += other.NormSquareSum
+}
+
+func (stats *VectorFieldStats) Clone() *VectorFieldStats {
+	cloned := *stats
+	return &cloned
+}
+
+// Corrupted returns whether any vector contains NaN or Inf.
+func (stats *VectorFieldStats) Corrupted() bool {
+	return stats.InvalidNum > 0
+}
+
+// MeanNorm returns the mean norm of the valid vectors.
+func (stats *VectorFieldStats) MeanNorm() float64 {
+	if stats.validNum() == 0 {
+		return 0
+	}
+	return stats.NormSum / float64(stats.validNum())
+}
+
+// StdDevNorm returns the standard deviation of the norms of the valid vectors.
+func (stats *VectorFieldStats) StdDevNorm() float64 {
+	if stats.validNum() == 0 {
+		return 0
+	}
+	mean := stats.MeanNorm()
+	variance := stats.NormSquareSum/float64(stats.validNum()) - mean*mean
+	if variance < 0 {
+		return 0
+	}
+	return math.Sqrt(variance)
+}
+
+func (stats *VectorFieldStats) String() string {
+	return fmt.Sprintf("fieldID: %d, rowNum: %d, zeroNum: %d, invalidNum: %d, norm: [min: %f, max: %f, mean: %f, stddev: %f]",
+		stats.FieldID, stats.RowNum, stats.ZeroNum, stats.InvalidNum, stats.MinNorm, stats.MaxNorm, stats.MeanNorm(), stats.StdDevNorm())
+}
+
+// SerializeVectorStats serializes the statistics to a blob of statslog,
+// which is saved with log idx of VectorStatsType under the vector field.
+func SerializeVectorStats(stats *VectorFieldStats) (*Blob, error) {
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{
+		Key:        VectorStatsType.LogIdx(),
+		Value:      data,
+		RowNum:     stats.RowNum,
+		MemorySize: int64(len(data)),
+	}, nil
+}
+
+func DeserializeVectorStats(data []byte) (*VectorFieldStats, error) {
+	stats := &VectorFieldStats{}
+	if err := json.Unmarshal(data, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
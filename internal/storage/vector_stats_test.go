@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestCollectVectorStats(t *testing.T) {
+	t.Run("float vector", func(t *testing.T) {
+		data := &FloatVectorFieldData{
+			Dim: 2,
+			Data: []float32{
+				3, 4,
+				0, 0,
+				float32(math.NaN()), 1,
+				0, 1,
+			},
+		}
+		stats, err := CollectVectorStats(101, 2, data)
+		require.NoError(t, err)
+		assert.EqualValues(t, 101, stats.FieldID)
+		assert.EqualValues(t, 4, stats.RowNum)
+		assert.EqualValues(t, 1, stats.ZeroNum)
+		assert.EqualValues(t, 1, stats.InvalidNum)
+		assert.True(t, stats.Corrupted())
+		assert.EqualValues(t, 0, stats.MinNorm)
+		assert.EqualValues(t, 5, stats.MaxNorm)
+		assert.InDelta(t, 2, stats.MeanNorm(), 1e-6)
+		assert.InDelta(t, math.Sqrt(26.0/3-4), stats.StdDevNorm(), 1e-6)
+	})
+
+	t.Run("float16 vector", func(t *testing.T) {
+		data := &Float16VectorFieldData{Dim: 2}
+		for _, v := range []float32{3, 4, 6, 8} {
+			data.Data = append(data.Data, typeutil.Float32ToFloat16Bytes(v)...)
+		}
+		stats, err := CollectVectorStats(101, 2, data)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, stats.RowNum)
+		assert.False(t, stats.Corrupted())
+		assert.InDelta(t, 5, stats.MinNorm, 1e-3)
+		assert.InDelta(t, 10, stats.MaxNorm, 1e-3)
+	})
+
+	t.Run("bfloat16 vector", func(t *testing.T) {
+		data := &BFloat16VectorFieldData{Dim: 2}
+		for _, v := range []float32{float32(math.Inf(1)), 0, 3, 4} {
+			data.Data = append(data.Data, typeutil.Float32ToBFloat16Bytes(v)...)
+		}
+		stats, err := CollectVectorStats(101, 2, data)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, stats.RowNum)
+		assert.EqualValues(t, 1, stats.InvalidNum)
+		assert.InDelta(t, 5, stats.MinNorm, 1e-3)
+		assert.InDelta(t, 5, stats.MaxNorm, 1e-3)
+	})
+
+	t.Run("dim mismatch", func(t *testing.T) {
+		_, err := CollectVectorStats(101, 4, &FloatVectorFieldData{Dim: 2, Data: []float32{1, 2, 3, 4}})
+		assert.Error(t, err)
+		_, err = CollectVectorStats(101, 2, &FloatVectorFieldData{Dim: 2, Data: []float32{1, 2, 3}})
+		assert.Error(t, err)
+		_, err = CollectVectorStats(101, 2, &Float16VectorFieldData{Dim: 2, Data: []byte{1, 2, 3}})
+		assert.Error(t, err)
+	})
+
+	t.Run("not supported", func(t *testing.T) {
+		assert.False(t, SupportVectorStats(schemapb.DataType_BinaryVector))
+		_, err := CollectVectorStats(101, 8, &BinaryVectorFieldData{Dim: 8, Data: []byte{1}})
+		assert.Error(t, err)
+	})
+}
+
+func TestVectorFieldStats_Merge(t *testing.T) {
+	stats := NewVectorFieldStats(101)
+	// all invalid, min and max are not taken
+	stats.Merge(&VectorFieldStats{FieldID: 101, RowNum: 2, InvalidNum: 2})
+	stats.Merge(nil)
+	stats.Merge(&VectorFieldStats{FieldID: 101, RowNum: 2, MinNorm: 2, MaxNorm: 3, NormSum: 5, NormSquareSum: 13})
+	stats.Merge(&VectorFieldStats{FieldID: 101, RowNum: 1, ZeroNum: 1})
+
+	assert.EqualValues(t, 5, stats.RowNum)
+	assert.EqualValues(t, 2, stats.InvalidNum)
+	assert.EqualValues(t, 1, stats.ZeroNum)
+	assert.EqualValues(t, 0, stats.MinNorm)
+	assert.EqualValues(t, 3, stats.MaxNorm)
+	assert.InDelta(t, 5.0/3, stats.MeanNorm(), 1e-6)
+
+	cloned := stats.Clone()
+	cloned.Merge(&VectorFieldStats{FieldID: 101, RowNum: 1, MinNorm: 10, MaxNorm: 10, NormSum: 10, NormSquareSum: 100})
+	assert.EqualValues(t, 5, stats.RowNum)
+	assert.EqualValues(t, 6, cloned.RowNum)
+	assert.EqualValues(t, 10, cloned.MaxNorm)
+}
+
+func TestVectorFieldStats_Serialize(t *testing.T) {
+	stats, err := CollectVectorStats(101, 2, &FloatVectorFieldData{Dim: 2, Data: []float32{3, 4, 1, 0}})
+	require.NoError(t, err)
+	blob, err := SerializeVectorStats(stats)
+	require.NoError(t, err)
+	assert.Equal(t, VectorStatsType.LogIdx(), blob.Key)
+	assert.EqualValues(t, 2, blob.RowNum)
+
+	deserialized, err := DeserializeVectorStats(blob.Value)
+	require.NoError(t, err)
+	assert.Equal(t, stats, deserialized)
+
+	_, err = DeserializeVectorStats([]byte("{"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,189 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compatutil keeps the internal requests compatible between the components of the current
+// and the previous minor version, so they are upgraded one by one without coordinated restarts.
+// The requests of the previous version are always accepted, while the ones compatible with the
+// previous version are only emitted if common.wireCompatibility.enabled is set.
+package compatutil
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// Enabled returns true if the requests compatible with the previous version are emitted.
+func Enabled() bool {
+	return paramtable.Get().CommonCfg.WireCompatibilityEnabled.GetAsBool()
+}
+
+// isUnimplemented returns true if the rpc is not served by the remote component.
+func isUnimplemented(err error) bool {
+	return errors.Is(err, merr.ErrServiceUnimplemented)
+}
+
+// NormalizeCreateJobV2Request fills the fields of the CreateJobV2 request left unset by the previous version,
+// the job type is inferred from the request of the job, and the ids are filled between the outer request and
+// the request of the job.
+func NormalizeCreateJobV2Request(req *indexpb.CreateJobV2Request) {
+	if req.GetJobType() == indexpb.JobType_JobTypeNone {
+		switch req.GetRequest().(type) {
+		case *indexpb.CreateJobV2Request_IndexRequest:
+			req.JobType = indexpb.JobType_JobTypeIndexJob
+		case *indexpb.CreateJobV2Request_AnalyzeRequest:
+			req.JobType = indexpb.JobType_JobTypeAnalyzeJob
+		case *indexpb.CreateJobV2Request_StatsRequest:
+			req.JobType = indexpb.JobType_JobTypeStatsJob
+		}
+	}
+
+	fill := func(clusterID *string, taskID *int64) {
+		if req.ClusterID == "" {
+			req.ClusterID = *clusterID
+		} else if *clusterID == "" {
+			*clusterID = req.ClusterID
+		}
+		if req.TaskID == 0 {
+			req.TaskID = *taskID
+		} else if *taskID == 0 {
+			*taskID = req.TaskID
+		}
+	}
+	switch req.GetJobType() {
+	case indexpb.JobType_JobTypeIndexJob:
+		if r := req.GetIndexRequest(); r != nil {
+			fill(&r.ClusterID, &r.BuildID)
+		}
+	case indexpb.JobType_JobTypeAnalyzeJob:
+		if r := req.GetAnalyzeRequest(); r != nil {
+			fill(&r.ClusterID, &r.TaskID)
+		}
+	case indexpb.JobType_JobTypeStatsJob:
+		if r := req.GetStatsRequest(); r != nil {
+			fill(&r.ClusterID, &r.TaskID)
+		}
+	}
+}
+
+// NormalizeJobType returns the job type of the QueryJobsV2 and DropJobsV2 requests, the requests of the previous
+// version without job type are of the index jobs.
+func NormalizeJobType(jobType indexpb.JobType) indexpb.JobType {
+	if jobType == indexpb.JobType_JobTypeNone {
+		return indexpb.JobType_JobTypeIndexJob
+	}
+	return jobType
+}
+
+// withDataPaths returns the copy of the request with the data paths built from the data ids,
+// the IndexNode of the previous version reads the data paths only.
+func withDataPaths(req *indexpb.CreateJobRequest) *indexpb.CreateJobRequest {
+	if len(req.GetDataPaths()) > 0 || len(req.GetDataIds()) == 0 {
+		return req
+	}
+	req = proto.Clone(req).(*indexpb.CreateJobRequest)
+	rootPath := req.GetStorageConfig().GetRootPath()
+	for _, id := range req.GetDataIds() {
+		req.DataPaths = append(req.DataPaths, metautil.BuildInsertLogPath(rootPath,
+			req.GetCollectionID(), req.GetPartitionID(), req.GetSegmentID(), req.GetFieldID(), id))
+	}
+	for _, field := range req.GetOptionalScalarFields() {
+		if len(field.GetDataPaths()) > 0 {
+			continue
+		}
+		for _, id := range field.GetDataIds() {
+			field.DataPaths = append(field.DataPaths, metautil.BuildInsertLogPath(rootPath,
+				req.GetCollectionID(), req.GetPartitionID(), req.GetSegmentID(), field.GetFieldID(), id))
+		}
+	}
+	return req
+}
+
+// CreateIndexJob assigns the index build job to the IndexNode by CreateJobV2, falls back to CreateJob
+// if the IndexNode doesn't serve CreateJobV2 and the compatibility is enabled.
+func CreateIndexJob(ctx context.Context, client types.IndexNodeClient, req *indexpb.CreateJobRequest) error {
+	if Enabled() {
+		req = withDataPaths(req)
+	}
+	resp, err := client.CreateJobV2(ctx, &indexpb.CreateJobV2Request{
+		ClusterID: req.GetClusterID(),
+		TaskID:    req.GetBuildID(),
+		JobType:   indexpb.JobType_JobTypeIndexJob,
+		Request: &indexpb.CreateJobV2Request_IndexRequest{
+			IndexRequest: req,
+		},
+	})
+	err = merr.CheckRPCCall(resp, err)
+	if !isUnimplemented(err) || !Enabled() {
+		return err
+	}
+	log.Ctx(ctx).Info("IndexNode doesn't serve CreateJobV2, fall back to CreateJob", zap.Int64("buildID", req.GetBuildID()))
+	resp, err = client.CreateJob(ctx, req)
+	return merr.CheckRPCCall(resp, err)
+}
+
+// QueryIndexJobs queries the index build jobs on the IndexNode by QueryJobsV2, falls back to QueryJobs
+// if the IndexNode doesn't serve QueryJobsV2 and the compatibility is enabled.
+func QueryIndexJobs(ctx context.Context, client types.IndexNodeClient, clusterID string, buildIDs []int64) ([]*indexpb.IndexTaskInfo, error) {
+	resp, err := client.QueryJobsV2(ctx, &indexpb.QueryJobsV2Request{
+		ClusterID: clusterID,
+		TaskIDs:   buildIDs,
+		JobType:   indexpb.JobType_JobTypeIndexJob,
+	})
+	err = merr.CheckRPCCall(resp, err)
+	if err == nil {
+		return resp.GetIndexJobResults().GetResults(), nil
+	}
+	if !isUnimplemented(err) || !Enabled() {
+		return nil, err
+	}
+	legacyResp, err := client.QueryJobs(ctx, &indexpb.QueryJobsRequest{
+		ClusterID: clusterID,
+		BuildIDs:  buildIDs,
+	})
+	if err := merr.CheckRPCCall(legacyResp, err); err != nil {
+		return nil, err
+	}
+	return legacyResp.GetIndexInfos(), nil
+}
+
+// DropIndexJobs drops the index build jobs on the IndexNode by DropJobsV2, falls back to DropJobs
+// if the IndexNode doesn't serve DropJobsV2 and the compatibility is enabled.
+func DropIndexJobs(ctx context.Context, client types.IndexNodeClient, clusterID string, buildIDs []int64) error {
+	resp, err := client.DropJobsV2(ctx, &indexpb.DropJobsV2Request{
+		ClusterID: clusterID,
+		TaskIDs:   buildIDs,
+		JobType:   indexpb.JobType_JobTypeIndexJob,
+	})
+	err = merr.CheckRPCCall(resp, err)
+	if !isUnimplemented(err) || !Enabled() {
+		return err
+	}
+	resp, err = client.DropJobs(ctx, &indexpb.DropJobsRequest{
+		ClusterID: clusterID,
+		BuildIDs:  buildIDs,
+	})
+	return merr.CheckRPCCall(resp, err)
+}
@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compatutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type IndexJobSuite struct {
+	suite.Suite
+
+	client         *mocks.MockIndexNodeClient
+	unimplemented  error
+	compatibleMode string
+}
+
+func (s *IndexJobSuite) SetupSuite() {
+	paramtable.Init()
+	s.compatibleMode = paramtable.Get().CommonCfg.WireCompatibilityEnabled.Key
+	s.unimplemented = merr.WrapErrServiceUnimplemented(errors.New("mock"))
+}
+
+func (s *IndexJobSuite) SetupTest() {
+	s.client = mocks.NewMockIndexNodeClient(s.T())
+	paramtable.Get().Save(s.compatibleMode, "true")
+}
+
+func (s *IndexJobSuite) TearDownTest() {
+	paramtable.Get().Reset(s.compatibleMode)
+}
+
+func (s *IndexJobSuite) TestNormalizeCreateJobV2Request() {
+	req := &indexpb.CreateJobV2Request{
+		ClusterID: "cluster",
+		TaskID:    1,
+		Request:   &indexpb.CreateJobV2Request_IndexRequest{IndexRequest: &indexpb.CreateJobRequest{}},
+	}
+	NormalizeCreateJobV2Request(req)
+	s.Equal(indexpb.JobType_JobTypeIndexJob, req.GetJobType())
+	s.Equal("cluster", req.GetIndexRequest().GetClusterID())
+	s.EqualValues(1, req.GetIndexRequest().GetBuildID())
+
+	req = &indexpb.CreateJobV2Request{
+		Request: &indexpb.CreateJobV2Request_StatsRequest{StatsRequest: &indexpb.StatsRequest{ClusterID: "cluster", TaskID: 2}},
+	}
+	NormalizeCreateJobV2Request(req)
+	s.Equal(indexpb.JobType_JobTypeStatsJob, req.GetJobType())
+	s.Equal("cluster", req.GetClusterID())
+	s.EqualValues(2, req.GetTaskID())
+
+	req = &indexpb.CreateJobV2Request{
+		TaskID:  3,
+		Request: &indexpb.CreateJobV2Request_AnalyzeRequest{AnalyzeRequest: &indexpb.AnalyzeRequest{TaskID: 4}},
+	}
+	NormalizeCreateJobV2Request(req)
+	s.Equal(indexpb.JobType_JobTypeAnalyzeJob, req.GetJobType())
+	// the ids set are kept
+	s.EqualValues(3, req.GetTaskID())
+	s.EqualValues(4, req.GetAnalyzeRequest().GetTaskID())
+
+	req = &indexpb.CreateJobV2Request{}
+	NormalizeCreateJobV2Request(req)
+	s.Equal(indexpb.JobType_JobTypeNone, req.GetJobType())
+
+	s.Equal(indexpb.JobType_JobTypeIndexJob, NormalizeJobType(indexpb.JobType_JobTypeNone))
+	s.Equal(indexpb.JobType_JobTypeStatsJob, NormalizeJobType(indexpb.JobType_JobTypeStatsJob))
+}
+
+func (s *IndexJobSuite) TestCreateIndexJob() {
+	ctx := context.Background()
+	req := &indexpb.CreateJobRequest{
+		ClusterID:     "cluster",
+		BuildID:       1,
+		CollectionID:  10,
+		PartitionID:   20,
+		SegmentID:     30,
+		FieldID:       101,
+		DataIds:       []int64{1000},
+		StorageConfig: &indexpb.StorageConfig{RootPath: "root"},
+		OptionalScalarFields: []*indexpb.OptionalFieldInfo{
+			{FieldID: 102, DataIds: []int64{2000}},
+		},
+	}
+
+	s.client.EXPECT().CreateJobV2(mock.Anything, mock.Anything).Return(nil, s.unimplemented).Once()
+	s.client.EXPECT().CreateJob(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, in *indexpb.CreateJobRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal([]string{metautil.BuildInsertLogPath("root", 10, 20, 30, 101, 1000)}, in.GetDataPaths())
+			s.Equal([]string{metautil.BuildInsertLogPath("root", 10, 20, 30, 102, 2000)}, in.GetOptionalScalarFields()[0].GetDataPaths())
+			return merr.Success(), nil
+		}).Once()
+	s.NoError(CreateIndexJob(ctx, s.client, req))
+	// the request of the task is not modified
+	s.Empty(req.GetDataPaths())
+
+	s.client.EXPECT().CreateJobV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+	s.NoError(CreateIndexJob(ctx, s.client, req))
+
+	paramtable.Get().Save(s.compatibleMode, "false")
+	s.client.EXPECT().CreateJobV2(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, in *indexpb.CreateJobV2Request, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Empty(in.GetIndexRequest().GetDataPaths())
+			return nil, s.unimplemented
+		}).Once()
+	s.ErrorIs(CreateIndexJob(ctx, s.client, req), merr.ErrServiceUnimplemented)
+}
+
+func (s *IndexJobSuite) TestQueryIndexJobs() {
+	ctx := context.Background()
+	s.client.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(&indexpb.QueryJobsV2Response{
+		Status: merr.Success(),
+		Result: &indexpb.QueryJobsV2Response_IndexJobResults{
+			IndexJobResults: &indexpb.IndexJobResults{Results: []*indexpb.IndexTaskInfo{{BuildID: 1}}},
+		},
+	}, nil).Once()
+	results, err := QueryIndexJobs(ctx, s.client, "cluster", []int64{1})
+	s.NoError(err)
+	s.Len(results, 1)
+
+	s.client.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(nil, s.unimplemented).Once()
+	s.client.EXPECT().QueryJobs(mock.Anything, mock.Anything).Return(&indexpb.QueryJobsResponse{
+		Status:     merr.Success(),
+		IndexInfos: []*indexpb.IndexTaskInfo{{BuildID: 1}, {BuildID: 2}},
+	}, nil).Once()
+	results, err = QueryIndexJobs(ctx, s.client, "cluster", []int64{1, 2})
+	s.NoError(err)
+	s.Len(results, 2)
+
+	s.client.EXPECT().QueryJobsV2(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+	_, err = QueryIndexJobs(ctx, s.client, "cluster", []int64{1})
+	s.Error(err)
+}
+
+func (s *IndexJobSuite) TestDropIndexJobs() {
+	ctx := context.Background()
+	s.client.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+	s.NoError(DropIndexJobs(ctx, s.client, "cluster", []int64{1}))
+
+	s.client.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(nil, s.unimplemented).Once()
+	s.client.EXPECT().DropJobs(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+	s.NoError(DropIndexJobs(ctx, s.client, "cluster", []int64{1}))
+
+	paramtable.Get().Save(s.compatibleMode, "false")
+	s.client.EXPECT().DropJobsV2(mock.Anything, mock.Anything).Return(nil, s.unimplemented).Once()
+	s.Error(DropIndexJobs(ctx, s.client, "cluster", []int64{1}))
+}
+
+func TestIndexJob(t *testing.T) {
+	suite.Run(t, new(IndexJobSuite))
+}
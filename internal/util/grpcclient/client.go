@@ -113,6 +113,8 @@ type ClientBase[T interface {
 	ClientMaxSendSize      int
 	ClientMaxRecvSize      int
 	CompressionEnabled     bool
+	Compressor             string
+	CompressionMethods     []string
 	RetryServiceNameConfig string
 
 	DialTimeout      time.Duration
@@ -152,6 +154,8 @@ func NewClientBase[T interface {
 		InitialBackoff:          config.InitialBackoff.GetAsFloat(),
 		MaxBackoff:              config.MaxBackoff.GetAsFloat(),
 		CompressionEnabled:      config.CompressionEnabled.GetAsBool(),
+		Compressor:              config.Compressor.GetValue(),
+		CompressionMethods:      config.CompressionMethods.GetAsStrings(),
 		minResetInterval:        config.MinResetInterval.GetAsDuration(time.Millisecond),
 		minSessionCheckInterval: config.MinSessionCheckInterval.GetAsDuration(time.Millisecond),
 		maxCancelError:          config.MaxCancelError.GetAsInt32(),
@@ -254,10 +258,15 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 	dialContext, cancel := context.WithTimeout(ctx, c.DialTimeout)
 
 	var conn *grpc.ClientConn
+	compressor := c.Compressor
+	if compressor == None {
+		compressor = Zstd
+	}
 	compress := None
 	if c.CompressionEnabled {
-		compress = Zstd
+		compress = compressor
 	}
+	compression := newCallCompression(compressor, c.CompressionMethods)
 	if c.encryption {
 		conn, err = grpc.DialContext(
 			dialContext,
@@ -274,6 +283,7 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 				otelgrpc.UnaryClientInterceptor(opts...),
 				interceptor.ClusterInjectionUnaryClientInterceptor(),
 				interceptor.ServerIDInjectionUnaryClientInterceptor(c.GetNodeID()),
+				compression.UnaryClientInterceptor(),
 			)),
 			grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(
 				otelgrpc.StreamClientInterceptor(opts...),
@@ -314,6 +324,7 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 				otelgrpc.UnaryClientInterceptor(opts...),
 				interceptor.ClusterInjectionUnaryClientInterceptor(),
 				interceptor.ServerIDInjectionUnaryClientInterceptor(c.GetNodeID()),
+				compression.UnaryClientInterceptor(),
 			)),
 			grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(
 				otelgrpc.StreamClientInterceptor(opts...),
@@ -426,6 +437,9 @@ func (c *ClientBase[T]) checkGrpcErr(ctx context.Context, err error) (needRetry,
 	case funcutil.IsGrpcErr(err, codes.Unavailable):
 		// for unavailable error in coord, force to reset coord connection
 		return true, true, !c.isNode, err
+	case funcutil.IsGrpcErr(err, codes.ResourceExhausted):
+		// the call is rejected by the api limit of the server, retry with backoff without resetting the connection
+		return true, false, false, err
 	default:
 		return true, true, false, err
 	}
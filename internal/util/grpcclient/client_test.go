@@ -385,6 +385,12 @@ func TestClientBase_CheckGrpcError(t *testing.T) {
 	assert.True(t, reset)
 	assert.True(t, forceReset)
 
+	// test rejected by api limit
+	retry, reset, forceReset, _ = base.checkGrpcErr(ctx, status.Errorf(codes.ResourceExhausted, "fake api limit"))
+	assert.True(t, retry)
+	assert.False(t, reset)
+	assert.False(t, forceReset)
+
 	// test serverId mismatch
 	retry, reset, forceReset, _ = base.checkGrpcErr(ctx, status.Errorf(codes.Unknown, merr.ErrNodeNotMatch.Error()))
 	assert.True(t, retry)
@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// callCompression compresses the calls of the methods carrying large payloads,
+// the server responds with the same compressor as the request.
+// It is created per connection, once the server rejects the compressor,
+// the following calls on the connection are not compressed anymore.
+type callCompression struct {
+	compressor  string
+	methods     typeutil.Set[string]
+	unsupported atomic.Bool
+}
+
+func newCallCompression(compressor string, methods []string) *callCompression {
+	return &callCompression{
+		compressor: compressor,
+		methods:    typeutil.NewSet(methods...),
+	}
+}
+
+func (c *callCompression) shouldCompress(fullMethod string) bool {
+	return c.compressor != None && !c.unsupported.Load() && c.methods.Contain(path.Base(fullMethod))
+}
+
+func (c *callCompression) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !c.shouldCompress(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.UseCompressor(c.compressor))...)
+		if isCompressorUnsupported(err) {
+			log.Ctx(ctx).Warn("server does not support the compressor, fall back to uncompressed calls",
+				zap.String("target", cc.Target()), zap.String("compressor", c.compressor), zap.Error(err))
+			c.unsupported.Store(true)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return err
+	}
+}
+
+// isCompressorUnsupported checks whether the error is returned by the server without the compressor installed.
+func isCompressorUnsupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	s, ok := status.FromError(err)
+	return ok && s.Code() == codes.Unimplemented && strings.Contains(s.Message(), "grpc-encoding")
+}
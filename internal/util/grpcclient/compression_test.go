@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCallCompression(t *testing.T) {
+	compression := newCallCompression(Gzip, []string{"GetRecoveryInfo"})
+	interceptor := compression.UnaryClientInterceptor()
+	cc := &grpc.ClientConn{}
+
+	var compressedCalls, calls int
+	var serverErr error
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls++
+		for _, opt := range opts {
+			if c, ok := opt.(grpc.CompressorCallOption); ok && c.CompressorType == Gzip {
+				compressedCalls++
+				return serverErr
+			}
+		}
+		return nil
+	}
+
+	assert.NoError(t, interceptor(context.Background(), "/milvus.proto.data.DataCoord/Flush", nil, nil, cc, invoker))
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 0, compressedCalls)
+
+	assert.NoError(t, interceptor(context.Background(), "/milvus.proto.data.DataCoord/GetRecoveryInfo", nil, nil, cc, invoker))
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 1, compressedCalls)
+
+	// other errors are returned as is
+	serverErr = errors.New("mock")
+	assert.Error(t, interceptor(context.Background(), "/milvus.proto.data.DataCoord/GetRecoveryInfo", nil, nil, cc, invoker))
+	assert.False(t, compression.unsupported.Load())
+
+	// server does not support the compressor, retry without compression
+	serverErr = status.Errorf(codes.Unimplemented, "grpc: Decompressor is not installed for grpc-encoding %q", Gzip)
+	calls, compressedCalls = 0, 0
+	assert.NoError(t, interceptor(context.Background(), "/milvus.proto.data.DataCoord/GetRecoveryInfo", nil, nil, cc, invoker))
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 1, compressedCalls)
+	assert.True(t, compression.unsupported.Load())
+
+	// no more compression on the connection
+	assert.NoError(t, interceptor(context.Background(), "/milvus.proto.data.DataCoord/GetRecoveryInfo", nil, nil, cc, invoker))
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 1, compressedCalls)
+}
@@ -20,14 +20,19 @@ package grpcclient
 import (
 	"bytes"
 	"io"
+	"time"
 
 	"github.com/klauspost/compress/zstd"
 	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
 )
 
 const (
 	None = ""
 	Zstd = "zstd"
+	Gzip = gzip.Name
 )
 
 type grpcCompressor struct {
@@ -42,7 +47,9 @@ func init() {
 		encoder: enc,
 		decoder: dec,
 	}
-	encoding.RegisterCompressor(c)
+	encoding.RegisterCompressor(&meteredCompressor{Compressor: c})
+	// replace the gzip compressor registered by grpc with the metered one
+	encoding.RegisterCompressor(&meteredCompressor{Compressor: encoding.GetCompressor(Gzip)})
 }
 
 func (c *grpcCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
@@ -84,3 +91,102 @@ func (c *grpcCompressor) Decompress(r io.Reader) (io.Reader, error) {
 func (c *grpcCompressor) Name() string {
 	return Zstd
 }
+
+// meteredCompressor records the bytes saved and the time cost of the underlying compressor.
+type meteredCompressor struct {
+	encoding.Compressor
+}
+
+func (c *meteredCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	compressed := &countingWriter{writer: w}
+	wc, err := c.Compressor.Compress(compressed)
+	if err != nil {
+		return nil, err
+	}
+	return &meteredWriteCloser{name: c.Name(), wc: wc, compressed: compressed}, nil
+}
+
+func (c *meteredCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	compressed := &countingReader{reader: r}
+	start := time.Now()
+	reader, err := c.Compressor.Decompress(compressed)
+	if err != nil {
+		return nil, err
+	}
+	return &meteredReader{name: c.Name(), reader: reader, compressed: compressed, cost: time.Since(start)}, nil
+}
+
+type countingWriter struct {
+	writer io.Writer
+	n      int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.n += n
+	return n, err
+}
+
+type countingReader struct {
+	reader io.Reader
+	n      int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.n += n
+	return n, err
+}
+
+type meteredWriteCloser struct {
+	name       string
+	wc         io.WriteCloser
+	compressed *countingWriter
+	raw        int
+	cost       time.Duration
+}
+
+func (w *meteredWriteCloser) Write(p []byte) (int, error) {
+	start := time.Now()
+	n, err := w.wc.Write(p)
+	w.cost += time.Since(start)
+	w.raw += n
+	return n, err
+}
+
+func (w *meteredWriteCloser) Close() error {
+	start := time.Now()
+	err := w.wc.Close()
+	w.cost += time.Since(start)
+	observeCompression(w.name, metrics.GrpcCompressLabel, w.raw, w.compressed.n, w.cost)
+	return err
+}
+
+type meteredReader struct {
+	name       string
+	reader     io.Reader
+	compressed *countingReader
+	raw        int
+	cost       time.Duration
+	observed   bool
+}
+
+func (r *meteredReader) Read(p []byte) (int, error) {
+	start := time.Now()
+	n, err := r.reader.Read(p)
+	r.cost += time.Since(start)
+	r.raw += n
+	if err == io.EOF && !r.observed {
+		r.observed = true
+		observeCompression(r.name, metrics.GrpcDecompressLabel, r.raw, r.compressed.n, r.cost)
+	}
+	return n, err
+}
+
+func observeCompression(name string, op string, raw int, compressed int, cost time.Duration) {
+	metrics.GrpcCompressionRawBytes.WithLabelValues(name, op).Add(float64(raw))
+	if raw > compressed {
+		metrics.GrpcCompressionSavedBytes.WithLabelValues(name, op).Add(float64(raw - compressed))
+	}
+	metrics.GrpcCompressionCost.WithLabelValues(name, op).Add(cost.Seconds())
+}
@@ -17,10 +17,14 @@ package grpcclient
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/encoding"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
 )
 
 func TestGrpcEncoder(t *testing.T) {
@@ -42,3 +46,33 @@ func TestGrpcEncoder(t *testing.T) {
 	reader.Read(result)
 	assert.Equal(t, data, string(result))
 }
+
+func TestGrpcEncoderMetered(t *testing.T) {
+	data := bytes.Repeat([]byte("hello compression!"), 1024)
+	for _, name := range []string{Zstd, Gzip} {
+		t.Run(name, func(t *testing.T) {
+			compressor := encoding.GetCompressor(name)
+			assert.IsType(t, &meteredCompressor{}, compressor)
+			saved := testutil.ToFloat64(metrics.GrpcCompressionSavedBytes.WithLabelValues(name, metrics.GrpcCompressLabel))
+
+			var buf bytes.Buffer
+			writer, err := compressor.Compress(&buf)
+			assert.NoError(t, err)
+			_, err = writer.Write(data)
+			assert.NoError(t, err)
+			assert.NoError(t, writer.Close())
+			assert.Less(t, buf.Len(), len(data))
+			assert.Equal(t, saved+float64(len(data)-buf.Len()),
+				testutil.ToFloat64(metrics.GrpcCompressionSavedBytes.WithLabelValues(name, metrics.GrpcCompressLabel)))
+
+			raw := testutil.ToFloat64(metrics.GrpcCompressionRawBytes.WithLabelValues(name, metrics.GrpcDecompressLabel))
+			reader, err := compressor.Decompress(bytes.NewReader(buf.Bytes()))
+			assert.NoError(t, err)
+			result, err := io.ReadAll(reader)
+			assert.NoError(t, err)
+			assert.Equal(t, data, result)
+			assert.Equal(t, raw+float64(len(data)),
+				testutil.ToFloat64(metrics.GrpcCompressionRawBytes.WithLabelValues(name, metrics.GrpcDecompressLabel)))
+		})
+	}
+}
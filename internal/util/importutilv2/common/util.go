@@ -24,6 +24,13 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
+// RejectedRow is a row rejected by the schema validation during import,
+// which is written to the quarantine file with the reason.
+type RejectedRow struct {
+	Row    any    `json:"row"`
+	Reason string `json:"reason"`
+}
+
 func FillDynamicData(data *storage.InsertData, schema *schemapb.CollectionSchema) error {
 	if !schema.GetEnableDynamicField() {
 		return nil
@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type Row = map[storage.FieldID]any
+
+type reader struct {
+	ctx    context.Context
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+
+	fileSize *atomic.Int64
+	filePath string
+	cr       *csv.Reader
+
+	bufferSize int
+	count      int64
+
+	parser RowParser
+
+	skipInvalid bool
+	rejected    []*common.RejectedRow
+}
+
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int, sep rune, nullKey string) (*reader, error) {
+	r, err := cm.Reader(ctx, path)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("read csv file failed, path=%s, err=%s", path, err.Error()))
+	}
+	count, err := estimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		return nil, err
+	}
+	cr := csv.NewReader(r)
+	cr.Comma = sep
+	cr.ReuseRecord = true
+
+	// the first line is the header which maps the columns to the fields
+	header, err := cr.Read()
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read the csv header, path=%s, err=%v", path, err))
+	}
+	parser, err := NewRowParser(schema, header, nullKey)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{
+		ctx:        ctx,
+		cm:         cm,
+		schema:     schema,
+		fileSize:   atomic.NewInt64(0),
+		filePath:   path,
+		cr:         cr,
+		bufferSize: bufferSize,
+		count:      count,
+		parser:     parser,
+	}, nil
+}
+
+func (r *reader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertData(r.schema)
+	if err != nil {
+		return nil, err
+	}
+	var cnt int64 = 0
+	for {
+		record, err := r.cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read the csv file, err=%v", err))
+		}
+		row, err := r.parser.Parse(record)
+		if err != nil {
+			if r.skipInvalid {
+				// the record is reused by the csv reader, so copy it before keeping
+				r.rejected = append(r.rejected, &common.RejectedRow{Row: append([]string{}, record...), Reason: err.Error()})
+				continue
+			}
+			return nil, err
+		}
+		err = insertData.Append(row)
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, err=%s", err.Error()))
+		}
+		cnt++
+		if cnt >= r.count {
+			cnt = 0
+			if insertData.GetMemorySize() >= r.bufferSize {
+				break
+			}
+		}
+	}
+	if insertData.GetRowNum() == 0 {
+		return nil, io.EOF
+	}
+	return insertData, nil
+}
+
+func (r *reader) Size() (int64, error) {
+	if size := r.fileSize.Load(); size != 0 {
+		return size, nil
+	}
+	size, err := r.cm.Size(r.ctx, r.filePath)
+	if err != nil {
+		return 0, err
+	}
+	r.fileSize.Store(size)
+	return size, nil
+}
+
+func (r *reader) Close() {}
+
+func (r *reader) SkipInvalidRows() {
+	r.skipInvalid = true
+}
+
+func (r *reader) Rejected() []*common.RejectedRow {
+	rejected := r.rejected
+	r.rejected = nil
+	return rejected
+}
+
+func estimateReadCountPerBatch(bufferSize int, schema *schemapb.CollectionSchema) (int64, error) {
+	sizePerRecord, err := typeutil.EstimateMaxSizePerRecord(schema)
+	if err != nil {
+		return 0, err
+	}
+	if 1000*sizePerRecord <= bufferSize {
+		return 1000, nil
+	}
+	return int64(bufferSize) / int64(sizePerRecord), nil
+}
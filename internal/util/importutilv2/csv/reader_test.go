@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type mockReader struct {
+	io.Reader
+	io.Closer
+	io.ReaderAt
+	io.Seeker
+}
+
+func TestReader(t *testing.T) {
+	paramtable.Init()
+	schema := newTestSchema()
+	content := "id|vector|score|tags|x\n" +
+		"1|[0.1, 0.2]|1.5|\"[\"\"a\"\"]\"|8\n" +
+		"2|[0.3, 0.4]||[]|\n"
+
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (storage.FileReader, error) {
+		return &mockReader{Reader: strings.NewReader(content)}, nil
+	})
+	reader, err := NewReader(context.Background(), cm, schema, "mockPath", 1024*1024, '|', "")
+	assert.NoError(t, err)
+
+	data, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, data.GetRowNum())
+	assert.Equal(t, int64(2), data.Data[1].GetRow(1))
+	assert.Equal(t, 0.5, data.Data[3].GetRow(1))
+	assert.Equal(t, []string{"a"}, data.Data[4].GetRow(0).(*schemapb.ScalarField).GetStringData().GetData())
+	assert.JSONEq(t, `{"x": "8"}`, string(data.Data[5].GetRow(0).([]byte)))
+	assert.JSONEq(t, `{}`, string(data.Data[5].GetRow(1).([]byte)))
+
+	_, err = reader.Read()
+	assert.ErrorIs(t, err, io.EOF)
+
+	// the header is missing
+	content = ""
+	_, err = NewReader(context.Background(), cm, schema, "mockPath", 1024*1024, ',', "")
+	assert.Error(t, err)
+}
+
+func TestReader_SkipInvalidRows(t *testing.T) {
+	paramtable.Init()
+	schema := newTestSchema()
+	content := "id|vector|score|tags\n" +
+		"1|[0.1, 0.2]|1.5|[]\n" +
+		"2|[0.3]|2.5|[]\n" +
+		"3|[0.5, 0.6]|3.5|[]\n"
+
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (storage.FileReader, error) {
+		return &mockReader{Reader: strings.NewReader(content)}, nil
+	})
+	reader, err := NewReader(context.Background(), cm, schema, "mockPath", 1024*1024, '|', "")
+	assert.NoError(t, err)
+	reader.SkipInvalidRows()
+
+	data, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, data.GetRowNum())
+	assert.Equal(t, int64(3), data.Data[1].GetRow(1))
+	rejected := reader.Rejected()
+	assert.Equal(t, 1, len(rejected))
+	assert.Equal(t, []string{"2", "[0.3]", "2.5", "[]"}, rejected[0].Row)
+	assert.Contains(t, rejected[0].Reason, "dim")
+	assert.Empty(t, reader.Rejected())
+
+	// the dim mismatch fails the read without skipping
+	reader, err = NewReader(context.Background(), cm, schema, "mockPath", 1024*1024, '|', "")
+	assert.NoError(t, err)
+	_, err = reader.Read()
+	assert.Error(t, err)
+}
@@ -0,0 +1,397 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type RowParser interface {
+	Parse(raw []string) (Row, error)
+}
+
+type rowParser struct {
+	nullKey      string
+	header       []string
+	id2Dim       map[int64]int
+	name2Field   map[string]*schemapb.FieldSchema
+	pkField      *schemapb.FieldSchema
+	dynamicField *schemapb.FieldSchema
+}
+
+// NewRowParser creates the parser of the CSV rows, the columns are mapped to the fields by the header.
+// The columns not defined in the schema are put into the dynamic field if it's enabled.
+func NewRowParser(schema *schemapb.CollectionSchema, header []string, nullKey string) (RowParser, error) {
+	id2Dim := make(map[int64]int)
+	for _, field := range schema.GetFields() {
+		if typeutil.IsVectorType(field.GetDataType()) && !typeutil.IsSparseFloatVectorType(field.GetDataType()) {
+			dim, err := typeutil.GetDim(field)
+			if err != nil {
+				return nil, err
+			}
+			id2Dim[field.GetFieldID()] = int(dim)
+		}
+	}
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	dynamicField := typeutil.GetDynamicField(schema)
+	name2Field := lo.SliceToMap(
+		lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+			return !typeutil.IsAutoPKField(field) && !field.GetIsDynamic()
+		}),
+		func(field *schemapb.FieldSchema) (string, *schemapb.FieldSchema) {
+			return field.GetName(), field
+		})
+
+	columns := make(map[string]struct{}, len(header))
+	for _, name := range header {
+		if _, ok := columns[name]; ok {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("duplicated column '%s' in the csv header", name))
+		}
+		columns[name] = struct{}{}
+		if name == pkField.GetName() && pkField.GetAutoID() {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("the primary key '%s' is auto-generated, no need to provide", name))
+		}
+		if _, ok := name2Field[name]; !ok && dynamicField == nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("the field '%s' is not defined in schema", name))
+		}
+	}
+	for name := range name2Field {
+		if _, ok := columns[name]; !ok {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("value of field '%s' is missed", name))
+		}
+	}
+
+	return &rowParser{
+		nullKey:      nullKey,
+		header:       append([]string(nil), header...),
+		id2Dim:       id2Dim,
+		name2Field:   name2Field,
+		pkField:      pkField,
+		dynamicField: dynamicField,
+	}, nil
+}
+
+func (r *rowParser) wrapTypeError(v string, field *schemapb.FieldSchema) error {
+	return merr.WrapErrImportFailed(fmt.Sprintf("expected type '%s' for field '%s', got value '%s'",
+		field.GetDataType().String(), field.GetName(), v))
+}
+
+func (r *rowParser) wrapDimError(actualDim int, field *schemapb.FieldSchema) error {
+	return merr.WrapErrImportFailed(fmt.Sprintf("expected dim '%d' for field '%s' with type '%s', got dim '%d'",
+		r.id2Dim[field.GetFieldID()], field.GetName(), field.GetDataType().String(), actualDim))
+}
+
+func (r *rowParser) Parse(raw []string) (Row, error) {
+	if len(raw) != len(r.header) {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("the number of values %d mismatches with the number of columns %d",
+			len(raw), len(r.header)))
+	}
+	row := make(Row)
+	dynamicValues := make(map[string]any)
+	var dynamicObject string
+	for i, name := range r.header {
+		value := raw[i]
+		if field, ok := r.name2Field[name]; ok {
+			data, err := r.parseField(field, value)
+			if err != nil {
+				return nil, err
+			}
+			row[field.GetFieldID()] = data
+			continue
+		}
+		// the null values of the dynamic columns are omitted
+		if value == r.nullKey {
+			continue
+		}
+		if name == r.dynamicField.GetName() {
+			dynamicObject = value
+		} else {
+			dynamicValues[name] = value
+		}
+	}
+	if r.dynamicField == nil {
+		return row, nil
+	}
+	err := r.combineDynamicRow(dynamicObject, dynamicValues, row)
+	if err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// combineDynamicRow combines the value of the dynamic field column, which should be a JSON object,
+// with the values of the columns not defined in the schema into the dynamic field.
+func (r *rowParser) combineDynamicRow(dynamicObject string, dynamicValues map[string]any, row Row) error {
+	if dynamicObject != "" {
+		var mp map[string]any
+		if err := json.Unmarshal([]byte(dynamicObject), &mp); err != nil {
+			return merr.WrapErrImportFailed("illegal value for dynamic field, not a JSON format string")
+		}
+		for k, v := range mp {
+			if _, ok := dynamicValues[k]; ok {
+				return merr.WrapErrImportFailed(fmt.Sprintf("duplicated key is not allowed, key=%s", k))
+			}
+			dynamicValues[k] = v
+		}
+	}
+	bs, err := json.Marshal(dynamicValues)
+	if err != nil {
+		return merr.WrapErrImportFailed(fmt.Sprintf("failed to marshal the dynamic field, err=%v", err))
+	}
+	row[r.dynamicField.GetFieldID()] = bs
+	return nil
+}
+
+// parseField parses the value of the field, the null value is replaced by the default value of the field.
+func (r *rowParser) parseField(field *schemapb.FieldSchema, value string) (any, error) {
+	if value != r.nullKey || (!field.GetNullable() && field.GetDefaultValue() == nil) {
+		return r.parseEntity(field, value)
+	}
+	defaultValue := field.GetDefaultValue()
+	if defaultValue == nil {
+		return nil, merr.WrapErrImportFailed(
+			fmt.Sprintf("the value of field '%s' is null, the null value without default value is not supported", field.GetName()))
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return defaultValue.GetBoolData(), nil
+	case schemapb.DataType_Int8:
+		return int8(defaultValue.GetIntData()), nil
+	case schemapb.DataType_Int16:
+		return int16(defaultValue.GetIntData()), nil
+	case schemapb.DataType_Int32:
+		return defaultValue.GetIntData(), nil
+	case schemapb.DataType_Int64:
+		return defaultValue.GetLongData(), nil
+	case schemapb.DataType_Float:
+		return defaultValue.GetFloatData(), nil
+	case schemapb.DataType_Double:
+		return defaultValue.GetDoubleData(), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return defaultValue.GetStringData(), nil
+	}
+	return nil, merr.WrapErrImportFailed(
+		fmt.Sprintf("unsupported default value of field '%s' with type '%s'", field.GetName(), field.GetDataType().String()))
+}
+
+func (r *rowParser) parseEntity(field *schemapb.FieldSchema, value string) (any, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		return b, nil
+	case schemapb.DataType_Int8:
+		num, err := strconv.ParseInt(value, 0, 8)
+		if err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		return int8(num), nil
+	case schemapb.DataType_Int16:
+		num, err := strconv.ParseInt(value, 0, 16)
+		if err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		return int16(num), nil
+	case schemapb.DataType_Int32:
+		num, err := strconv.ParseInt(value, 0, 32)
+		if err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		return int32(num), nil
+	case schemapb.DataType_Int64:
+		num, err := strconv.ParseInt(value, 0, 64)
+		if err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		return num, nil
+	case schemapb.DataType_Float:
+		num, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		return float32(num), typeutil.VerifyFloat(num)
+	case schemapb.DataType_Double:
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		return num, typeutil.VerifyFloat(num)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return value, nil
+	case schemapb.DataType_JSON:
+		var dummy any
+		if err := json.Unmarshal([]byte(value), &dummy); err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		return []byte(value), nil
+	case schemapb.DataType_BinaryVector:
+		var vec []uint8
+		if err := unmarshalNumbers(value, &vec); err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		if len(vec) != r.id2Dim[field.GetFieldID()]/8 {
+			return nil, r.wrapDimError(len(vec)*8, field)
+		}
+		return vec, nil
+	case schemapb.DataType_FloatVector:
+		var vec []float32
+		if err := unmarshalNumbers(value, &vec); err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		if len(vec) != r.id2Dim[field.GetFieldID()] {
+			return nil, r.wrapDimError(len(vec), field)
+		}
+		return vec, typeutil.VerifyFloats32(vec)
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		var vec []float32
+		if err := unmarshalNumbers(value, &vec); err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		if len(vec) != r.id2Dim[field.GetFieldID()] {
+			return nil, r.wrapDimError(len(vec), field)
+		}
+		bytes := make([]byte, len(vec)*2)
+		for i, v := range vec {
+			if field.GetDataType() == schemapb.DataType_Float16Vector {
+				copy(bytes[i*2:], typeutil.Float32ToFloat16Bytes(v))
+			} else {
+				copy(bytes[i*2:], typeutil.Float32ToBFloat16Bytes(v))
+			}
+		}
+		return bytes, nil
+	case schemapb.DataType_SparseFloatVector:
+		var mp map[string]any
+		dec := json.NewDecoder(strings.NewReader(value))
+		dec.UseNumber()
+		if err := dec.Decode(&mp); err != nil {
+			return nil, r.wrapTypeError(value, field)
+		}
+		return typeutil.CreateSparseFloatRowFromMap(mp)
+	case schemapb.DataType_Array:
+		return r.parseArray(field, value)
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("parse csv failed, unsupport data type: %s",
+			field.GetDataType().String()))
+	}
+}
+
+// parseArray parses the array field written as a JSON array, e.g. "[1, 2, 3]".
+func (r *rowParser) parseArray(field *schemapb.FieldSchema, value string) (*schemapb.ScalarField, error) {
+	var err error
+	switch field.GetElementType() {
+	case schemapb.DataType_Bool:
+		var values []bool
+		if err = json.Unmarshal([]byte(value), &values); err == nil {
+			return &schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: values}}}, nil
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		var values []int32
+		if err = unmarshalNumbers(value, &values); err == nil {
+			return &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: values}}}, nil
+		}
+	case schemapb.DataType_Int64:
+		var values []int64
+		if err = unmarshalNumbers(value, &values); err == nil {
+			return &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}}}, nil
+		}
+	case schemapb.DataType_Float:
+		var values []float32
+		if err = unmarshalNumbers(value, &values); err == nil {
+			return &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: values}}}, nil
+		}
+	case schemapb.DataType_Double:
+		var values []float64
+		if err = unmarshalNumbers(value, &values); err == nil {
+			return &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: values}}}, nil
+		}
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		var values []string
+		if err = json.Unmarshal([]byte(value), &values); err == nil {
+			return &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: values}}}, nil
+		}
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("parse csv failed, unsupport array element type: %s",
+			field.GetElementType().String()))
+	}
+	return nil, merr.WrapErrImportFailed(fmt.Sprintf("expected array of '%s' for field '%s', got value '%s', err=%v",
+		field.GetElementType().String(), field.GetName(), value, err))
+}
+
+// unmarshalNumbers decodes the JSON array of numbers, the bytes are decoded as numbers rather than base64 strings.
+func unmarshalNumbers[T uint8 | int32 | int64 | float32 | float64](value string, out *[]T) error {
+	var numbers []json.Number
+	dec := json.NewDecoder(strings.NewReader(value))
+	dec.UseNumber()
+	if err := dec.Decode(&numbers); err != nil {
+		return err
+	}
+	res := make([]T, len(numbers))
+	for i, number := range numbers {
+		var v T
+		switch p := any(&v).(type) {
+		case *uint8:
+			n, err := strconv.ParseUint(number.String(), 0, 8)
+			if err != nil {
+				return err
+			}
+			*p = uint8(n)
+		case *int32:
+			n, err := strconv.ParseInt(number.String(), 0, 32)
+			if err != nil {
+				return err
+			}
+			*p = int32(n)
+		case *int64:
+			n, err := strconv.ParseInt(number.String(), 0, 64)
+			if err != nil {
+				return err
+			}
+			*p = n
+		case *float32:
+			n, err := strconv.ParseFloat(number.String(), 32)
+			if err != nil {
+				return err
+			}
+			*p = float32(n)
+		case *float64:
+			n, err := strconv.ParseFloat(number.String(), 64)
+			if err != nil {
+				return err
+			}
+			*p = n
+		}
+		res[i] = v
+	}
+	*out = res
+	return nil
+}
@@ -0,0 +1,132 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func newTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      1,
+				Name:         "id",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    2,
+				Name:       "vector",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+			{
+				FieldID:      3,
+				Name:         "score",
+				DataType:     schemapb.DataType_Double,
+				Nullable:     true,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: 0.5}},
+			},
+			{
+				FieldID:     4,
+				Name:        "tags",
+				DataType:    schemapb.DataType_Array,
+				ElementType: schemapb.DataType_VarChar,
+			},
+			{
+				FieldID:   5,
+				Name:      "$meta",
+				IsDynamic: true,
+				DataType:  schemapb.DataType_JSON,
+			},
+		},
+	}
+}
+
+func TestRowParser_Parse_Valid(t *testing.T) {
+	schema := newTestSchema()
+	r, err := NewRowParser(schema, []string{"id", "vector", "score", "tags", "x", "$meta"}, "NULL")
+	assert.NoError(t, err)
+
+	type testCase struct {
+		row      []string
+		score    float64
+		dyFields map[string]any
+	}
+	cases := []testCase{
+		{row: []string{"1", "[0.1, 0.2]", "1.5", `["a", "b"]`, "8", `{"y": 8}`}, score: 1.5, dyFields: map[string]any{"x": "8", "y": float64(8)}},
+		{row: []string{"2", "[0.1, 0.2]", "NULL", `[]`, "NULL", `{}`}, score: 0.5, dyFields: map[string]any{}},
+		{row: []string{"3", "[0.1, 0.2]", "2", `["a"]`, "8", "NULL"}, score: 2, dyFields: map[string]any{"x": "8"}},
+	}
+	for _, c := range cases {
+		row, err := r.Parse(c.row)
+		assert.NoError(t, err)
+		for _, field := range schema.GetFields() {
+			_, ok := row[field.GetFieldID()]
+			assert.True(t, ok)
+		}
+		assert.Equal(t, []float32{0.1, 0.2}, row[2])
+		assert.Equal(t, c.score, row[3])
+
+		var dyFields map[string]any
+		err = json.Unmarshal(row[5].([]byte), &dyFields)
+		assert.NoError(t, err)
+		assert.Equal(t, c.dyFields, dyFields)
+	}
+}
+
+func TestRowParser_Parse_Invalid(t *testing.T) {
+	schema := newTestSchema()
+
+	// invalid header
+	_, err := NewRowParser(schema, []string{"id", "vector", "score"}, "")
+	assert.Error(t, err)
+	_, err = NewRowParser(schema, []string{"id", "vector", "score", "tags", "tags"}, "")
+	assert.Error(t, err)
+	schema.Fields = schema.Fields[:4]
+	_, err = NewRowParser(schema, []string{"id", "vector", "score", "tags", "x"}, "")
+	assert.Error(t, err)
+
+	r, err := NewRowParser(schema, []string{"id", "vector", "score", "tags"}, "")
+	assert.NoError(t, err)
+	cases := [][]string{
+		{"1", "[0.1, 0.2]", "1.5"},
+		{"a", "[0.1, 0.2]", "1.5", `["a"]`},
+		{"1", "[0.1, 0.2, 0.3]", "1.5", `["a"]`},
+		{"1", "[0.1, 0.2]", "1.5", `[1]`},
+		{"", "[0.1, 0.2]", "1.5", `["a"]`},
+	}
+	for _, c := range cases {
+		_, err = r.Parse(c)
+		assert.Error(t, err)
+	}
+
+	// the null value without default value
+	schema.Fields[2].DefaultValue = nil
+	r, err = NewRowParser(schema, []string{"id", "vector", "score", "tags"}, "")
+	assert.NoError(t, err)
+	_, err = r.Parse([]string{"1", "[0.1, 0.2]", "", `["a"]`})
+	assert.Error(t, err)
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -51,6 +52,9 @@ type reader struct {
 	isOldFormat bool
 
 	parser RowParser
+
+	skipInvalid bool
+	rejected    []*common.RejectedRow
 }
 
 func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
@@ -140,6 +144,10 @@ func (j *reader) Read() (*storage.InsertData, error) {
 		}
 		row, err := j.parser.Parse(value)
 		if err != nil {
+			if j.skipInvalid {
+				j.rejected = append(j.rejected, &common.RejectedRow{Row: value, Reason: err.Error()})
+				continue
+			}
 			return nil, err
 		}
 		err = insertData.Append(row)
@@ -182,6 +190,16 @@ func (j *reader) Size() (int64, error) {
 
 func (j *reader) Close() {}
 
+func (j *reader) SkipInvalidRows() {
+	j.skipInvalid = true
+}
+
+func (j *reader) Rejected() []*common.RejectedRow {
+	rejected := j.rejected
+	j.rejected = nil
+	return rejected
+}
+
 func estimateReadCountPerBatch(bufferSize int, schema *schemapb.CollectionSchema) (int64, error) {
 	sizePerRecord, err := typeutil.EstimateMaxSizePerRecord(schema)
 	if err != nil {
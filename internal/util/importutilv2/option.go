@@ -21,6 +21,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -35,6 +36,10 @@ const (
 	EndTs2     = "endTs"
 	BackupFlag = "backup"
 	L0Import   = "l0_import"
+	Priority   = "priority"
+	CSVSep     = "sep"
+	CSVNullKey = "nullkey"
+	Quarantine = "quarantine"
 )
 
 type Options []*commonpb.KeyValuePair
@@ -78,6 +83,16 @@ func IsBackup(options Options) bool {
 	return true
 }
 
+// IsQuarantine returns whether the rows failing the schema validation should be skipped
+// and written to the quarantine files instead of failing the whole import job.
+func IsQuarantine(options Options) bool {
+	quarantine, err := funcutil.GetAttrByKeyFromRepeatedKV(Quarantine, options)
+	if err != nil || strings.ToLower(quarantine) != "true" {
+		return false
+	}
+	return true
+}
+
 func IsL0Import(options Options) bool {
 	isL0Import, err := funcutil.GetAttrByKeyFromRepeatedKV(L0Import, options)
 	if err != nil || strings.ToLower(isL0Import) != "true" {
@@ -85,3 +100,40 @@ func IsL0Import(options Options) bool {
 	}
 	return true
 }
+
+// ParsePriority returns the scheduling priority of the import job, the jobs of higher priority are scheduled first.
+// The priority is an integer, 0 by default.
+func ParsePriority(options Options) (int64, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(Priority, options)
+	if err != nil {
+		return 0, nil
+	}
+	priority, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, merr.WrapErrImportFailed(fmt.Sprintf("parse %s failed, value=%s, err=%s", Priority, value, err))
+	}
+	return priority, nil
+}
+
+// GetCSVSep returns the delimiter of the CSV files, which should be a single character other than
+// the quote, the carriage return and the line feed, ',' by default.
+func GetCSVSep(options Options) (rune, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(CSVSep, options)
+	if err != nil || value == "" {
+		return ',', nil
+	}
+	sep := []rune(value)
+	if len(sep) != 1 || sep[0] == '"' || sep[0] == '\r' || sep[0] == '\n' || sep[0] == utf8.RuneError {
+		return 0, merr.WrapErrImportFailed(fmt.Sprintf("invalid CSV separator, value=%s", value))
+	}
+	return sep[0], nil
+}
+
+// GetCSVNullKey returns the string representing the null value in the CSV files, the empty string by default.
+func GetCSVNullKey(options Options) string {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(CSVNullKey, options)
+	if err != nil {
+		return ""
+	}
+	return value
+}
@@ -47,7 +47,8 @@ type reader struct {
 	bufferSize int
 	count      int64
 
-	frs map[int64]*FieldReader // fieldID -> FieldReader
+	frs             map[int64]*FieldReader // fieldID -> FieldReader
+	partitionValues map[int64]any          // fieldID -> value of the Hive-style partition column
 }
 
 func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
@@ -70,7 +71,15 @@ func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.Co
 		return nil, merr.WrapErrImportFailed(fmt.Sprintf("new parquet file reader failed, err=%v", err))
 	}
 
-	crs, err := CreateFieldReaders(ctx, fileReader, schema)
+	partitionColumns, err := parsePartitionColumns(path)
+	if err != nil {
+		return nil, err
+	}
+	crs, err := CreateFieldReaders(ctx, fileReader, schema, partitionColumns)
+	if err != nil {
+		return nil, err
+	}
+	partitionValues, err := createPartitionValues(schema, partitionColumns, crs)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +97,8 @@ func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.Co
 		bufferSize: bufferSize,
 		count:      count,
 		frs:        crs,
+
+		partitionValues: partitionValues,
 	}, nil
 }
 
@@ -115,11 +126,19 @@ OUTER:
 			break
 		}
 	}
+	rows := 0
 	for fieldID := range r.frs {
-		if insertData.Data[fieldID].RowNum() == 0 {
+		rows = insertData.Data[fieldID].RowNum()
+		if rows == 0 {
 			return nil, io.EOF
 		}
 	}
+	for fieldID, value := range r.partitionValues {
+		err = insertData.Data[fieldID].AppendRows(repeatPartitionValue(value, rows))
+		if err != nil {
+			return nil, err
+		}
+	}
 	err = common.FillDynamicData(insertData, r.schema)
 	if err != nil {
 		return nil, err
@@ -22,6 +22,7 @@ import (
 	"io"
 	"math/rand"
 	"os"
+	"path"
 	"testing"
 
 	"github.com/apache/arrow/go/v12/arrow/array"
@@ -277,6 +278,62 @@ func (s *ReaderSuite) TestVector() {
 	s.run(schemapb.DataType_Int32, schemapb.DataType_None)
 }
 
+func (s *ReaderSuite) TestPartitionColumns() {
+	fileSchema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    101,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "8"}},
+			},
+		},
+	}
+	schema := typeutil.Clone(fileSchema)
+	schema.Fields = append(schema.Fields,
+		&schemapb.FieldSchema{FieldID: 102, Name: "dt", DataType: schemapb.DataType_VarChar},
+		&schemapb.FieldSchema{FieldID: 103, Name: "region", DataType: schemapb.DataType_Int32},
+	)
+
+	dir := fmt.Sprintf("/tmp/test_%d_dataset/dt=2024-01-01%%2000/region=3", rand.Int())
+	s.NoError(os.MkdirAll(dir, 0o755))
+	defer os.RemoveAll(path.Dir(path.Dir(dir)))
+	filePath := path.Join(dir, "part-0.parquet")
+	wf, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE, 0o666)
+	s.NoError(err)
+	_, err = writeParquet(wf, fileSchema, s.numRows)
+	s.NoError(err)
+
+	ctx := context.Background()
+	f := storage.NewChunkManagerFactory("local", storage.RootPath("/tmp/milvus_test/test_parquet_reader/"))
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	s.NoError(err)
+	reader, err := NewReader(ctx, cm, schema, filePath, 64*1024*1024)
+	s.NoError(err)
+	res, err := reader.Read()
+	s.NoError(err)
+	s.Equal(s.numRows, res.Data[102].RowNum())
+	s.Equal(s.numRows, res.Data[103].RowNum())
+	s.Equal("2024-01-01 00", res.Data[102].GetRow(0))
+	s.Equal(int32(3), res.Data[103].GetRow(s.numRows-1))
+
+	// the value of the partition column mismatches with the field type
+	schema.Fields[3].DataType = schemapb.DataType_Bool
+	_, err = NewReader(ctx, cm, schema, filePath, 64*1024*1024)
+	s.Error(err)
+
+	// the field is neither in the file nor in the partition columns
+	schema.Fields[3].Name = "country"
+	_, err = NewReader(ctx, cm, schema, filePath, 64*1024*1024)
+	s.Error(err)
+}
+
 func TestUtil(t *testing.T) {
 	suite.Run(t, new(ReaderSuite))
 }
@@ -19,6 +19,10 @@ package parquet
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
 
 	"github.com/apache/arrow/go/v12/arrow"
 	"github.com/apache/arrow/go/v12/parquet/pqarrow"
@@ -29,6 +33,9 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
+// hiveDefaultPartition is the directory value of the null partition column written by Hive and Spark.
+const hiveDefaultPartition = "__HIVE_DEFAULT_PARTITION__"
+
 func WrapTypeErr(expect string, actual string, field *schemapb.FieldSchema) error {
 	return merr.WrapErrImportFailed(
 		fmt.Sprintf("expect '%s' type for field '%s', but got '%s' type",
@@ -42,7 +49,7 @@ func calcBufferSize(blockSize int, schema *schemapb.CollectionSchema) int {
 	return blockSize / len(schema.GetFields())
 }
 
-func CreateFieldReaders(ctx context.Context, fileReader *pqarrow.FileReader, schema *schemapb.CollectionSchema) (map[int64]*FieldReader, error) {
+func CreateFieldReaders(ctx context.Context, fileReader *pqarrow.FileReader, schema *schemapb.CollectionSchema, partitionColumns map[string]string) (map[int64]*FieldReader, error) {
 	nameToField := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) string {
 		return field.GetName()
 	})
@@ -52,7 +59,7 @@ func CreateFieldReaders(ctx context.Context, fileReader *pqarrow.FileReader, sch
 		return nil, merr.WrapErrImportFailed(fmt.Sprintf("get parquet schema failed, err=%v", err))
 	}
 
-	err = isSchemaEqual(schema, pqSchema)
+	err = isSchemaEqual(schema, pqSchema, partitionColumns)
 	if err != nil {
 		return nil, merr.WrapErrImportFailed(fmt.Sprintf("schema not equal, err=%v", err))
 	}
@@ -85,6 +92,9 @@ func CreateFieldReaders(ctx context.Context, fileReader *pqarrow.FileReader, sch
 		if typeutil.IsAutoPKField(field) || field.GetIsDynamic() {
 			continue
 		}
+		if _, ok := partitionColumns[field.GetName()]; ok {
+			continue
+		}
 		if _, ok := crs[field.GetFieldID()]; !ok {
 			return nil, merr.WrapErrImportFailed(
 				fmt.Sprintf("no parquet field for milvus file '%s'", field.GetName()))
@@ -224,7 +234,7 @@ func ConvertToArrowSchema(schema *schemapb.CollectionSchema) (*arrow.Schema, err
 	return arrow.NewSchema(arrFields, nil), nil
 }
 
-func isSchemaEqual(schema *schemapb.CollectionSchema, arrSchema *arrow.Schema) error {
+func isSchemaEqual(schema *schemapb.CollectionSchema, arrSchema *arrow.Schema, partitionColumns map[string]string) error {
 	arrNameToField := lo.KeyBy(arrSchema.Fields(), func(field arrow.Field) string {
 		return field.Name
 	})
@@ -237,6 +247,9 @@ func isSchemaEqual(schema *schemapb.CollectionSchema, arrSchema *arrow.Schema) e
 			if field.GetIsDynamic() {
 				continue
 			}
+			if _, ok = partitionColumns[field.GetName()]; ok {
+				continue
+			}
 			return merr.WrapErrImportFailed(fmt.Sprintf("field '%s' not in arrow schema", field.GetName()))
 		}
 		toArrDataType, err := convertToArrowDataType(field, false)
@@ -251,6 +264,110 @@ func isSchemaEqual(schema *schemapb.CollectionSchema, arrSchema *arrow.Schema) e
 	return nil
 }
 
+// parsePartitionColumns parses the Hive-style partition columns from the directories of the file path,
+// e.g. {"dt": "2024-01-01", "region": "us"} is parsed from "dataset/dt=2024-01-01/region=us/part-0.parquet".
+func parsePartitionColumns(filePath string) (map[string]string, error) {
+	columns := make(map[string]string)
+	for _, dir := range strings.Split(path.Dir(filePath), "/") {
+		key, value, ok := strings.Cut(dir, "=")
+		if !ok || key == "" {
+			continue
+		}
+		value, err := url.PathUnescape(value)
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("parse partition directory '%s' failed, err=%v", dir, err))
+		}
+		columns[key] = value
+	}
+	return columns, nil
+}
+
+// createPartitionValues converts the values of the partition columns for the fields absent in the parquet file,
+// the partition columns not in the schema are ignored.
+func createPartitionValues(schema *schemapb.CollectionSchema, partitionColumns map[string]string, crs map[int64]*FieldReader) (map[int64]any, error) {
+	values := make(map[int64]any)
+	for _, field := range schema.GetFields() {
+		value, ok := partitionColumns[field.GetName()]
+		if _, exist := crs[field.GetFieldID()]; !ok || exist || typeutil.IsAutoPKField(field) || field.GetIsDynamic() {
+			continue
+		}
+		if value == hiveDefaultPartition {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("null value of the partition column '%s' is not supported", field.GetName()))
+		}
+		var (
+			v   any
+			err error
+		)
+		switch field.GetDataType() {
+		case schemapb.DataType_Bool:
+			v, err = strconv.ParseBool(value)
+		case schemapb.DataType_Int8:
+			var i int64
+			i, err = strconv.ParseInt(value, 10, 8)
+			v = int8(i)
+		case schemapb.DataType_Int16:
+			var i int64
+			i, err = strconv.ParseInt(value, 10, 16)
+			v = int16(i)
+		case schemapb.DataType_Int32:
+			var i int64
+			i, err = strconv.ParseInt(value, 10, 32)
+			v = int32(i)
+		case schemapb.DataType_Int64:
+			v, err = strconv.ParseInt(value, 10, 64)
+		case schemapb.DataType_Float:
+			var f float64
+			f, err = strconv.ParseFloat(value, 32)
+			v = float32(f)
+		case schemapb.DataType_Double:
+			v, err = strconv.ParseFloat(value, 64)
+		case schemapb.DataType_VarChar, schemapb.DataType_String:
+			v = value
+		default:
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("unsupported data type '%s' for partition column '%s'",
+				field.GetDataType().String(), field.GetName()))
+		}
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("parse value '%s' of the partition column '%s' as %s failed, err=%v",
+				value, field.GetName(), field.GetDataType().String(), err))
+		}
+		values[field.GetFieldID()] = v
+	}
+	return values, nil
+}
+
+// repeatPartitionValue returns the rows filled with the value of the partition column.
+func repeatPartitionValue(value any, rows int) any {
+	switch v := value.(type) {
+	case bool:
+		return repeat(v, rows)
+	case int8:
+		return repeat(v, rows)
+	case int16:
+		return repeat(v, rows)
+	case int32:
+		return repeat(v, rows)
+	case int64:
+		return repeat(v, rows)
+	case float32:
+		return repeat(v, rows)
+	case float64:
+		return repeat(v, rows)
+	case string:
+		return repeat(v, rows)
+	}
+	return nil
+}
+
+func repeat[T any](value T, rows int) []T {
+	data := make([]T, rows)
+	for i := range data {
+		data[i] = value
+	}
+	return data
+}
+
 func estimateReadCountPerBatch(bufferSize int, schema *schemapb.CollectionSchema) (int64, error) {
 	sizePerRecord, err := typeutil.EstimateMaxSizePerRecord(schema)
 	if err != nil {
@@ -0,0 +1,183 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ConvertToExportArrowSchema converts the user fields of the collection to the arrow schema of the exported files,
+// the columns are laid out as the parquet reader imports, except that the primary key is kept even if it's auto-generated.
+func ConvertToExportArrowSchema(schema *schemapb.CollectionSchema) (*arrow.Schema, error) {
+	arrFields := make([]arrow.Field, 0)
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		arrDataType, err := convertToArrowDataType(field, false)
+		if err != nil {
+			return nil, err
+		}
+		arrFields = append(arrFields, arrow.Field{
+			Name:     field.GetName(),
+			Type:     arrDataType,
+			Nullable: true,
+			Metadata: arrow.Metadata{},
+		})
+	}
+	return arrow.NewSchema(arrFields, nil), nil
+}
+
+// WriteInsertData writes the user fields of the insert data as a Parquet file into w.
+func WriteInsertData(w io.Writer, schema *schemapb.CollectionSchema, data *storage.InsertData) error {
+	arrSchema, err := ConvertToExportArrowSchema(schema)
+	if err != nil {
+		return err
+	}
+	mem := memory.NewGoAllocator()
+	rows := data.GetRowNum()
+	columns := make([]arrow.Array, 0, len(arrSchema.Fields()))
+	defer func() {
+		for _, column := range columns {
+			column.Release()
+		}
+	}()
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok {
+			return merr.WrapErrFieldNotFound(field.GetName())
+		}
+		arrDataType, err := convertToArrowDataType(field, false)
+		if err != nil {
+			return err
+		}
+		builder := array.NewBuilder(mem, arrDataType)
+		for i := 0; i < rows; i++ {
+			err = appendValue(builder, field, fieldData.GetRow(i))
+			if err != nil {
+				builder.Release()
+				return err
+			}
+		}
+		columns = append(columns, builder.NewArray())
+		builder.Release()
+	}
+
+	record := array.NewRecord(arrSchema, columns, int64(rows))
+	defer record.Release()
+	fw, err := pqarrow.NewFileWriter(arrSchema, w,
+		parquet.NewWriterProperties(parquet.WithMaxRowGroupLength(int64(max(rows, 1)))),
+		pqarrow.DefaultWriterProps())
+	if err != nil {
+		return err
+	}
+	err = fw.Write(record)
+	if err != nil {
+		fw.Close()
+		return err
+	}
+	return fw.Close()
+}
+
+func appendValue(builder array.Builder, field *schemapb.FieldSchema, value any) error {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		builder.(*array.BooleanBuilder).Append(value.(bool))
+	case schemapb.DataType_Int8:
+		builder.(*array.Int8Builder).Append(value.(int8))
+	case schemapb.DataType_Int16:
+		builder.(*array.Int16Builder).Append(value.(int16))
+	case schemapb.DataType_Int32:
+		builder.(*array.Int32Builder).Append(value.(int32))
+	case schemapb.DataType_Int64:
+		builder.(*array.Int64Builder).Append(value.(int64))
+	case schemapb.DataType_Float:
+		builder.(*array.Float32Builder).Append(value.(float32))
+	case schemapb.DataType_Double:
+		builder.(*array.Float64Builder).Append(value.(float64))
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		builder.(*array.StringBuilder).Append(value.(string))
+	case schemapb.DataType_JSON:
+		builder.(*array.StringBuilder).Append(string(value.([]byte)))
+	case schemapb.DataType_SparseFloatVector:
+		bytes, err := json.Marshal(typeutil.SparseFloatBytesToMap(value.([]byte)))
+		if err != nil {
+			return err
+		}
+		builder.(*array.StringBuilder).Append(string(bytes))
+	case schemapb.DataType_FloatVector:
+		listBuilder := builder.(*array.ListBuilder)
+		listBuilder.Append(true)
+		listBuilder.ValueBuilder().(*array.Float32Builder).AppendValues(value.([]float32), nil)
+	case schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		listBuilder := builder.(*array.ListBuilder)
+		listBuilder.Append(true)
+		listBuilder.ValueBuilder().(*array.Uint8Builder).AppendValues(value.([]byte), nil)
+	case schemapb.DataType_Array:
+		listBuilder := builder.(*array.ListBuilder)
+		listBuilder.Append(true)
+		return appendArrayValue(listBuilder.ValueBuilder(), field, value.(*schemapb.ScalarField))
+	default:
+		return merr.WrapErrParameterInvalidMsg("unsupported data type %v", field.GetDataType().String())
+	}
+	return nil
+}
+
+func appendArrayValue(builder array.Builder, field *schemapb.FieldSchema, value *schemapb.ScalarField) error {
+	switch field.GetElementType() {
+	case schemapb.DataType_Bool:
+		builder.(*array.BooleanBuilder).AppendValues(value.GetBoolData().GetData(), nil)
+	case schemapb.DataType_Int8:
+		for _, v := range value.GetIntData().GetData() {
+			builder.(*array.Int8Builder).Append(int8(v))
+		}
+	case schemapb.DataType_Int16:
+		for _, v := range value.GetIntData().GetData() {
+			builder.(*array.Int16Builder).Append(int16(v))
+		}
+	case schemapb.DataType_Int32:
+		builder.(*array.Int32Builder).AppendValues(value.GetIntData().GetData(), nil)
+	case schemapb.DataType_Int64:
+		builder.(*array.Int64Builder).AppendValues(value.GetLongData().GetData(), nil)
+	case schemapb.DataType_Float:
+		builder.(*array.Float32Builder).AppendValues(value.GetFloatData().GetData(), nil)
+	case schemapb.DataType_Double:
+		builder.(*array.Float64Builder).AppendValues(value.GetDoubleData().GetData(), nil)
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		builder.(*array.StringBuilder).AppendValues(value.GetStringData().GetData(), nil)
+	default:
+		return merr.WrapErrParameterInvalidMsg("unsupported element type %v of array field %s",
+			field.GetElementType().String(), field.GetName())
+	}
+	return nil
+}
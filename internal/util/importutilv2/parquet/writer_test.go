@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/testutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestWriteInsertData(t *testing.T) {
+	paramtable.Init()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{
+				FieldID:    101,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "8"}},
+			},
+			{
+				FieldID:    102,
+				Name:       "str",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "256"}},
+			},
+			{FieldID: 103, Name: "json", DataType: schemapb.DataType_JSON},
+			{
+				FieldID:     104,
+				Name:        "arr",
+				DataType:    schemapb.DataType_Array,
+				ElementType: schemapb.DataType_Int32,
+				TypeParams:  []*commonpb.KeyValuePair{{Key: common.MaxCapacityKey, Value: "16"}},
+			},
+		},
+	}
+	insertData, err := testutil.CreateInsertData(schema, 10)
+	assert.NoError(t, err)
+
+	filePath := fmt.Sprintf("/tmp/test_%d_writer.parquet", rand.Int())
+	defer os.Remove(filePath)
+	wf, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE, 0o666)
+	assert.NoError(t, err)
+	err = WriteInsertData(wf, schema, insertData)
+	assert.NoError(t, err)
+	wf.Close()
+
+	// the exported file is importable
+	ctx := context.Background()
+	f := storage.NewChunkManagerFactory("local", storage.RootPath("/tmp/milvus_test/test_parquet_writer/"))
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	userSchema := &schemapb.CollectionSchema{Fields: schema.GetFields()[2:]}
+	reader, err := NewReader(ctx, cm, userSchema, filePath, 64*1024*1024)
+	assert.NoError(t, err)
+	data, err := reader.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, data.GetRowNum())
+	for fieldID, fieldData := range data.Data {
+		assert.GreaterOrEqual(t, fieldID, int64(common.StartOfUserFieldID))
+		for i := 0; i < 10; i++ {
+			expect := insertData.Data[fieldID].GetRow(i)
+			if typeutil.GetField(schema, fieldID).GetDataType() == schemapb.DataType_Array {
+				assert.Equal(t, expect.(*schemapb.ScalarField).GetIntData().GetData(),
+					fieldData.GetRow(i).(*schemapb.ScalarField).GetIntData().GetData())
+				continue
+			}
+			assert.Equal(t, expect, fieldData.GetRow(i))
+		}
+	}
+
+	// the auto-generated primary key is kept
+	schema.Fields[2].AutoID = true
+	arrSchema, err := ConvertToExportArrowSchema(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(arrSchema.Fields()))
+	assert.Equal(t, "pk", arrSchema.Field(0).Name)
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/binlog"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/csv"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/json"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/numpy"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
@@ -43,6 +45,16 @@ type Reader interface {
 	Close()
 }
 
+// RowRejecter is implemented by the row-based readers, which are able to skip
+// the rows failing to be parsed instead of failing the whole read.
+type RowRejecter interface {
+	// SkipInvalidRows makes the reader skip the invalid rows.
+	SkipInvalidRows()
+
+	// Rejected returns the rows skipped since the last call.
+	Rejected() []*common.RejectedRow
+}
+
 func NewReader(ctx context.Context,
 	cm storage.ChunkManager,
 	schema *schemapb.CollectionSchema,
@@ -59,6 +71,23 @@ func NewReader(ctx context.Context,
 		return binlog.NewReader(ctx, cm, schema, paths, tsStart, tsEnd)
 	}
 
+	reader, err := newFileReader(ctx, cm, schema, importFile, options, bufferSize)
+	if err != nil {
+		return nil, err
+	}
+	if rejecter, ok := reader.(RowRejecter); ok && IsQuarantine(options) {
+		rejecter.SkipInvalidRows()
+	}
+	return reader, nil
+}
+
+func newFileReader(ctx context.Context,
+	cm storage.ChunkManager,
+	schema *schemapb.CollectionSchema,
+	importFile *internalpb.ImportFile,
+	options Options,
+	bufferSize int,
+) (Reader, error) {
 	fileType, err := GetFileType(importFile)
 	if err != nil {
 		return nil, err
@@ -70,6 +99,12 @@ func NewReader(ctx context.Context,
 		return numpy.NewReader(ctx, cm, schema, importFile.GetPaths(), bufferSize)
 	case Parquet:
 		return parquet.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize)
+	case CSV:
+		sep, err := GetCSVSep(options)
+		if err != nil {
+			return nil, err
+		}
+		return csv.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize, sep, GetCSVNullKey(options))
 	}
 	return nil, merr.WrapErrImportFailed("unexpected import file")
 }
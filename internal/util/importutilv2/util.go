@@ -19,6 +19,7 @@ package importutilv2
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/samber/lo"
 
@@ -33,10 +34,12 @@ const (
 	JSON    FileType = 1
 	Numpy   FileType = 2
 	Parquet FileType = 3
+	CSV     FileType = 4
 
 	JSONFileExt    = ".json"
 	NumpyFileExt   = ".npy"
 	ParquetFileExt = ".parquet"
+	CSVFileExt     = ".csv"
 )
 
 var FileTypeName = map[int]string{
@@ -44,6 +47,7 @@ var FileTypeName = map[int]string{
 	1: "JSON",
 	2: "Numpy",
 	3: "Parquet",
+	4: "CSV",
 }
 
 func (f FileType) String() string {
@@ -54,6 +58,9 @@ func GetFileType(file *internalpb.ImportFile) (FileType, error) {
 	if len(file.GetPaths()) == 0 {
 		return Invalid, merr.WrapErrImportFailed("no file to import")
 	}
+	if IsParquetDataset(file) {
+		return Parquet, nil
+	}
 	exts := lo.Map(file.GetPaths(), func(path string, _ int) string {
 		return filepath.Ext(path)
 	})
@@ -80,6 +87,31 @@ func GetFileType(file *internalpb.ImportFile) (FileType, error) {
 			return Invalid, merr.WrapErrImportFailed("for Parquet import, accepts only one file")
 		}
 		return Parquet, nil
+	case CSVFileExt:
+		if len(file.GetPaths()) != 1 {
+			return Invalid, merr.WrapErrImportFailed("for CSV import, accepts only one file")
+		}
+		return CSV, nil
 	}
 	return Invalid, merr.WrapErrImportFailed(fmt.Sprintf("unexpect file type, files=%v", file.GetPaths()))
 }
+
+// IsParquetDataset returns whether the import file is a directory of Parquet files, the directory path
+// ends with a slash and the Parquet files may be placed in the Hive-style partition directories, e.g. dt=2024-01-01/.
+func IsParquetDataset(file *internalpb.ImportFile) bool {
+	return len(file.GetPaths()) == 1 && strings.HasSuffix(file.GetPaths()[0], "/")
+}
+
+// IsParquetDatasetFile returns whether the object listed in the Parquet dataset is a data file,
+// the hidden files and the files written by the engines like _SUCCESS or _temporary/ are skipped.
+func IsParquetDatasetFile(dataset string, path string) bool {
+	if filepath.Ext(path) != ParquetFileExt {
+		return false
+	}
+	for _, dir := range strings.Split(strings.TrimPrefix(path, dataset), "/") {
+		if strings.HasPrefix(dir, "_") || strings.HasPrefix(dir, ".") {
+			return false
+		}
+	}
+	return true
+}
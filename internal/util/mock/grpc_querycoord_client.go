@@ -181,3 +181,15 @@ func (m *GrpcQueryCoordClient) TransferChannel(ctx context.Context, req *querypb
 func (m *GrpcQueryCoordClient) CheckQueryNodeDistribution(ctx context.Context, req *querypb.CheckQueryNodeDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
+
+func (m *GrpcQueryCoordClient) SafeRemoveNode(ctx context.Context, req *querypb.SafeRemoveNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) GetSafeRemoveNodeProgress(ctx context.Context, req *querypb.GetSafeRemoveNodeProgressRequest, opts ...grpc.CallOption) (*querypb.GetSafeRemoveNodeProgressResponse, error) {
+	return &querypb.GetSafeRemoveNodeProgressResponse{}, m.Err
+}
+
+func (m *GrpcQueryCoordClient) CheckRowCountConsistency(ctx context.Context, req *querypb.CheckRowCountConsistencyRequest, opts ...grpc.CallOption) (*querypb.CheckRowCountConsistencyResponse, error) {
+	return &querypb.CheckRowCountConsistencyResponse{}, m.Err
+}
@@ -41,6 +41,22 @@ func (m *GrpcRootCoordClient) DescribeDatabase(ctx context.Context, in *rootcoor
 	return &rootcoordpb.DescribeDatabaseResponse{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) GetDropCollectionProgress(ctx context.Context, in *rootcoordpb.GetDropCollectionProgressRequest, opts ...grpc.CallOption) (*rootcoordpb.GetDropCollectionProgressResponse, error) {
+	return &rootcoordpb.GetDropCollectionProgressResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) UpdatePartitionKeyStats(ctx context.Context, in *rootcoordpb.UpdatePartitionKeyStatsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetPartitionKeyStats(ctx context.Context, in *rootcoordpb.GetPartitionKeyStatsRequest, opts ...grpc.CallOption) (*rootcoordpb.GetPartitionKeyStatsResponse, error) {
+	return &rootcoordpb.GetPartitionKeyStatsResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetMetaSnapshot(ctx context.Context, in *rootcoordpb.GetMetaSnapshotRequest, opts ...grpc.CallOption) (*rootcoordpb.GetMetaSnapshotResponse, error) {
+	return &rootcoordpb.GetMetaSnapshotResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -0,0 +1,200 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// ProbeFunc probes the health of the node, nil error means the node is alive.
+type ProbeFunc func(ctx context.Context, nodeID int64) error
+
+// FailureDetector probes the nodes of a role directly, so a dead node is detected before its session expires.
+// A node failed the probe for consecutive times is declared dead by evicting its session,
+// then all the watchers handle it as offline just like its session expired.
+// To protect against flapping:
+//  1. a node is only declared dead after failureThreshold consecutive failures, any success resets the count;
+//  2. a node declared dead within the cool down is left to its session expiry;
+//  3. if too many nodes fail at the same time, the coordinator itself is likely partitioned,
+//     no node is declared dead in that round.
+type FailureDetector struct {
+	session   SessionInterface
+	role      string
+	listNodes func() []int64
+	probe     ProbeFunc
+
+	probeInterval func() time.Duration
+	probeTimeout  func() time.Duration
+
+	// accessed by the detect loop only
+	failures   map[int64]int
+	declaredAt map[int64]time.Time
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// DetectorOption provides a way to set params in FailureDetector
+type DetectorOption func(d *FailureDetector)
+
+// WithProbeInterval overrides common.session.fastDetection.probeInterval for the role.
+func WithProbeInterval(interval func() time.Duration) DetectorOption {
+	return func(d *FailureDetector) { d.probeInterval = interval }
+}
+
+// WithProbeTimeout overrides common.session.fastDetection.probeTimeout for the role.
+func WithProbeTimeout(timeout func() time.Duration) DetectorOption {
+	return func(d *FailureDetector) { d.probeTimeout = timeout }
+}
+
+func NewFailureDetector(session SessionInterface, role string, listNodes func() []int64, probe ProbeFunc, opts ...DetectorOption) *FailureDetector {
+	d := &FailureDetector{
+		session:   session,
+		role:      role,
+		listNodes: listNodes,
+		probe:     probe,
+		probeInterval: func() time.Duration {
+			return paramtable.Get().CommonCfg.SessionFastDetectionProbeInterval.GetAsDuration(time.Second)
+		},
+		probeTimeout: func() time.Duration {
+			return paramtable.Get().CommonCfg.SessionFastDetectionProbeTimeout.GetAsDuration(time.Second)
+		},
+		failures:   make(map[int64]int),
+		declaredAt: make(map[int64]time.Time),
+		closeCh:    make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+func (d *FailureDetector) Start() {
+	d.wg.Add(1)
+	go d.loop()
+}
+
+func (d *FailureDetector) Stop() {
+	d.closeOnce.Do(func() {
+		close(d.closeCh)
+		d.wg.Wait()
+	})
+}
+
+func (d *FailureDetector) loop() {
+	defer d.wg.Done()
+	ticker := time.NewTicker(d.probeInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.closeCh:
+			log.Info("failure detector stopped", zap.String("role", d.role))
+			return
+		case <-ticker.C:
+			d.detect()
+		}
+	}
+}
+
+// detect runs a round of probe, and returns the nodes declared dead.
+func (d *FailureDetector) detect() []int64 {
+	params := &paramtable.Get().CommonCfg
+	if !params.SessionFastDetectionEnabled.GetAsBool() {
+		d.failures = make(map[int64]int)
+		return nil
+	}
+	log := log.With(zap.String("role", d.role))
+
+	nodes := d.listNodes()
+	errs := make([]error, len(nodes))
+	timeout := d.probeTimeout()
+	wg := sync.WaitGroup{}
+	for i, nodeID := range nodes {
+		wg.Add(1)
+		go func(i int, nodeID int64) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			errs[i] = d.probe(ctx, nodeID)
+		}(i, nodeID)
+	}
+	wg.Wait()
+
+	threshold := params.SessionFastDetectionFailureThreshold.GetAsInt()
+	failures := make(map[int64]int, len(d.failures))
+	suspects := make([]int64, 0)
+	for i, nodeID := range nodes {
+		if errs[i] == nil {
+			continue
+		}
+		failures[nodeID] = d.failures[nodeID] + 1
+		log.Warn("node failed health probe", zap.Int64("nodeID", nodeID), zap.Int("failures", failures[nodeID]), zap.Error(errs[i]))
+		if failures[nodeID] >= threshold {
+			suspects = append(suspects, nodeID)
+		}
+	}
+	// the nodes which are gone or probed successfully are reset
+	d.failures = failures
+
+	coolDown := params.SessionFastDetectionCoolDown.GetAsDuration(time.Second)
+	for nodeID, declaredAt := range d.declaredAt {
+		if time.Since(declaredAt) >= coolDown {
+			delete(d.declaredAt, nodeID)
+		}
+	}
+	if len(suspects) == 0 {
+		return nil
+	}
+
+	limit := int(float64(len(nodes)) * params.SessionFastDetectionMaxEvictRatio.GetAsFloat())
+	if limit < 1 {
+		limit = 1
+	}
+	if len(suspects) > limit {
+		log.Warn("too many nodes failed health probe at the same time, leave them to session expiry",
+			zap.Int64s("suspects", suspects), zap.Int("nodeNum", len(nodes)), zap.Int("limit", limit))
+		return nil
+	}
+
+	dead := make([]int64, 0, len(suspects))
+	for _, nodeID := range suspects {
+		if _, ok := d.declaredAt[nodeID]; ok {
+			log.Warn("node declared dead within cool down, leave it to session expiry", zap.Int64("nodeID", nodeID))
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		err := d.session.EvictSession(ctx, d.role, nodeID)
+		cancel()
+		if err != nil {
+			log.Warn("failed to evict session of dead node", zap.Int64("nodeID", nodeID), zap.Error(err))
+			continue
+		}
+		log.Warn("node declared dead by health probe", zap.Int64("nodeID", nodeID), zap.Int("failures", d.failures[nodeID]))
+		d.declaredAt[nodeID] = time.Now()
+		delete(d.failures, nodeID)
+		dead = append(dead, nodeID)
+	}
+	return dead
+}
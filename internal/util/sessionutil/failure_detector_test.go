@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type FailureDetectorSuite struct {
+	suite.Suite
+
+	session  *MockSession
+	nodes    []int64
+	failed   typeutil.UniqueSet
+	detector *FailureDetector
+}
+
+func (s *FailureDetectorSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *FailureDetectorSuite) SetupTest() {
+	paramtable.Get().Save(paramtable.Get().CommonCfg.SessionFastDetectionEnabled.Key, "true")
+	paramtable.Get().Save(paramtable.Get().CommonCfg.SessionFastDetectionFailureThreshold.Key, "2")
+	paramtable.Get().Save(paramtable.Get().CommonCfg.SessionFastDetectionMaxEvictRatio.Key, "0.5")
+
+	s.session = NewMockSession(s.T())
+	s.nodes = []int64{1, 2, 3, 4}
+	s.failed = typeutil.NewUniqueSet()
+	s.detector = NewFailureDetector(s.session, "querynode",
+		func() []int64 { return s.nodes },
+		func(ctx context.Context, nodeID int64) error {
+			if s.failed.Contain(nodeID) {
+				return errors.New("mock probe failure")
+			}
+			return nil
+		})
+}
+
+func (s *FailureDetectorSuite) TearDownTest() {
+	paramtable.Get().Reset(paramtable.Get().CommonCfg.SessionFastDetectionEnabled.Key)
+	paramtable.Get().Reset(paramtable.Get().CommonCfg.SessionFastDetectionFailureThreshold.Key)
+	paramtable.Get().Reset(paramtable.Get().CommonCfg.SessionFastDetectionMaxEvictRatio.Key)
+}
+
+func (s *FailureDetectorSuite) TestDeclareDead() {
+	s.failed.Insert(1)
+	s.Empty(s.detector.detect())
+
+	s.session.EXPECT().EvictSession(mock.Anything, "querynode", int64(1)).Return(nil).Once()
+	s.Equal([]int64{1}, s.detector.detect())
+
+	// declared dead within cool down, left to session expiry
+	s.Empty(s.detector.detect())
+	s.Empty(s.detector.detect())
+}
+
+func (s *FailureDetectorSuite) TestFlapping() {
+	s.failed.Insert(1)
+	s.Empty(s.detector.detect())
+	// success resets the failures
+	s.failed.Remove(1)
+	s.Empty(s.detector.detect())
+	s.failed.Insert(1)
+	s.Empty(s.detector.detect())
+	s.Equal(1, s.detector.failures[1])
+}
+
+func (s *FailureDetectorSuite) TestTooManyFailures() {
+	s.failed.Insert(1, 2, 3)
+	s.Empty(s.detector.detect())
+	s.Empty(s.detector.detect())
+	s.Len(s.detector.failures, 3)
+}
+
+func (s *FailureDetectorSuite) TestEvictFailed() {
+	s.failed.Insert(1)
+	s.session.EXPECT().EvictSession(mock.Anything, "querynode", int64(1)).Return(errors.New("mock")).Once()
+	s.session.EXPECT().EvictSession(mock.Anything, "querynode", int64(1)).Return(nil).Once()
+	s.detector.detect()
+	s.Empty(s.detector.detect())
+	s.Equal([]int64{1}, s.detector.detect())
+}
+
+func (s *FailureDetectorSuite) TestDisabled() {
+	paramtable.Get().Save(paramtable.Get().CommonCfg.SessionFastDetectionEnabled.Key, "false")
+	s.failed.Insert(1)
+	s.Empty(s.detector.detect())
+	s.Empty(s.detector.detect())
+
+	s.detector.Start()
+	s.detector.Stop()
+}
+
+func (s *FailureDetectorSuite) TestProbeOptions() {
+	var deadline time.Time
+	detector := NewFailureDetector(s.session, "datanode",
+		func() []int64 { return []int64{1} },
+		func(ctx context.Context, nodeID int64) error {
+			deadline, _ = ctx.Deadline()
+			return nil
+		},
+		WithProbeInterval(func() time.Duration { return time.Millisecond }),
+		WithProbeTimeout(func() time.Duration { return time.Hour }))
+	s.Equal(time.Millisecond, detector.probeInterval())
+
+	s.Empty(detector.detect())
+	s.Greater(time.Until(deadline), time.Minute)
+}
+
+func TestFailureDetector(t *testing.T) {
+	suite.Run(t, new(FailureDetectorSuite))
+}
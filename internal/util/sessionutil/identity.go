@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionutil
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// nodeIdentity is the identity of a node persisted in local file,
+// a node restarted within the grace period reclaims its previous server id with it.
+// The modification time of the file is refreshed by session keepalive,
+// so it records the last time the node was alive.
+type nodeIdentity struct {
+	ServerID int64 `json:"ServerID"`
+}
+
+// loadNodeIdentity loads the identity from file, it returns false if the file does not exist,
+// is broken or has not been refreshed within the grace period.
+func loadNodeIdentity(file string, gracePeriod time.Duration) (*nodeIdentity, bool) {
+	log := log.With(zap.String("identityFile", file))
+	info, err := os.Stat(file)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn("failed to stat node identity file", zap.Error(err))
+		}
+		return nil, false
+	}
+	if elapsed := time.Since(info.ModTime()); elapsed > gracePeriod {
+		log.Info("node identity expired, will not reclaim previous server id",
+			zap.Duration("elapsed", elapsed), zap.Duration("gracePeriod", gracePeriod))
+		return nil, false
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		log.Warn("failed to read node identity file", zap.Error(err))
+		return nil, false
+	}
+	identity := &nodeIdentity{}
+	if err := json.Unmarshal(data, identity); err != nil || identity.ServerID <= 0 {
+		log.Warn("invalid node identity file", zap.String("content", string(data)), zap.Error(err))
+		return nil, false
+	}
+	return identity, true
+}
+
+// saveNodeIdentity writes the identity to a temp file and renames it, so a crash never leaves a broken file.
+func saveNodeIdentity(file string, identity *nodeIdentity) error {
+	data, err := json.Marshal(identity)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+		return err
+	}
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
+
+// touchNodeIdentity refreshes the modification time of the identity file.
+func touchNodeIdentity(file string) error {
+	now := time.Now()
+	return os.Chtimes(file, now, now)
+}
@@ -65,6 +65,50 @@ func (_c *MockSession_Disconnected_Call) RunAndReturn(run func() bool) *MockSess
 	return _c
 }
 
+// EvictSession provides a mock function with given fields: ctx, role, serverID
+func (_m *MockSession) EvictSession(ctx context.Context, role string, serverID int64) error {
+	ret := _m.Called(ctx, role, serverID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, role, serverID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_EvictSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvictSession'
+type MockSession_EvictSession_Call struct {
+	*mock.Call
+}
+
+// EvictSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role string
+//   - serverID int64
+func (_e *MockSession_Expecter) EvictSession(ctx interface{}, role interface{}, serverID interface{}) *MockSession_EvictSession_Call {
+	return &MockSession_EvictSession_Call{Call: _e.mock.On("EvictSession", ctx, role, serverID)}
+}
+
+func (_c *MockSession_EvictSession_Call) Run(run func(ctx context.Context, role string, serverID int64)) *MockSession_EvictSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockSession_EvictSession_Call) Return(_a0 error) *MockSession_EvictSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_EvictSession_Call) RunAndReturn(run func(context.Context, string, int64) error) *MockSession_EvictSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ForceActiveStandby provides a mock function with given fields: activateFunc
 func (_m *MockSession) ForceActiveStandby(activateFunc func() error) error {
 	ret := _m.Called(activateFunc)
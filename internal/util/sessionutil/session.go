@@ -39,6 +39,7 @@ type SessionInterface interface {
 	LivenessCheck(ctx context.Context, callback func())
 	Stop()
 	Revoke(timeout time.Duration)
+	EvictSession(ctx context.Context, role string, serverID int64) error
 	UpdateRegistered(b bool)
 	Registered() bool
 	SetDisconnected(b bool)
@@ -100,8 +100,10 @@ type SessionRaw struct {
 	IndexEngineVersion IndexEngineVersion `json:"IndexEngineVersion,omitempty"`
 	LeaseID            *clientv3.LeaseID  `json:"LeaseID,omitempty"`
 
-	HostName   string `json:"HostName,omitempty"`
-	EnableDisk bool   `json:"EnableDisk,omitempty"`
+	HostName     string            `json:"HostName,omitempty"`
+	EnableDisk   bool              `json:"EnableDisk,omitempty"`
+	ServerLabels map[string]string `json:"ServerLabels,omitempty"`
+	CPUFeatures  []string          `json:"CPUFeatures,omitempty"`
 }
 
 func (s *SessionRaw) GetAddress() string {
@@ -151,6 +153,15 @@ type Session struct {
 	sessionTTL        int64
 	sessionRetryTimes int64
 	reuseNodeID       bool
+	// ttl and retry times set by options are not overridden by the component configs
+	ttlByOption        bool
+	retryTimesByOption bool
+
+	// identityFile persists the server id, so a node restarted within identityGracePeriod
+	// reclaims its previous server id and takes over its stale session.
+	identityFile        string
+	identityGracePeriod time.Duration
+	reclaimed           bool
 
 	isStopped atomic.Bool // set to true if stop method is invoked
 }
@@ -158,17 +169,31 @@ type Session struct {
 type SessionOption func(session *Session)
 
 func WithTTL(ttl int64) SessionOption {
-	return func(session *Session) { session.sessionTTL = ttl }
+	return func(session *Session) {
+		session.sessionTTL = ttl
+		session.ttlByOption = true
+	}
 }
 
 func WithRetryTimes(n int64) SessionOption {
-	return func(session *Session) { session.sessionRetryTimes = n }
+	return func(session *Session) {
+		session.sessionRetryTimes = n
+		session.retryTimesByOption = true
+	}
 }
 
 func WithResueNodeID(b bool) SessionOption {
 	return func(session *Session) { session.reuseNodeID = b }
 }
 
+// WithIdentityFile persists the server id into file, empty file means disable.
+func WithIdentityFile(file string, gracePeriod time.Duration) SessionOption {
+	return func(session *Session) {
+		session.identityFile = file
+		session.identityGracePeriod = gracePeriod
+	}
+}
+
 // WithIndexEngineVersion should be only used by querynode.
 func WithIndexEngineVersion(minimal, current int32) SessionOption {
 	return func(session *Session) {
@@ -183,6 +208,21 @@ func WithEnableDisk(enableDisk bool) SessionOption {
 	}
 }
 
+// WithServerLabels publishes the labels of the server in the session.
+func WithServerLabels(labels map[string]string) SessionOption {
+	return func(s *Session) {
+		s.ServerLabels = labels
+	}
+}
+
+// WithCPUFeatures publishes the CPU features of the server in the session,
+// which decide the indexes the server is able to load.
+func WithCPUFeatures(features []string) SessionOption {
+	return func(s *Session) {
+		s.CPUFeatures = features
+	}
+}
+
 func (s *Session) apply(opts ...SessionOption) {
 	for _, opt := range opts {
 		opt(s)
@@ -242,11 +282,15 @@ func NewSessionWithEtcd(ctx context.Context, metaRoot string, client *clientv3.C
 		sessionRetryTimes: paramtable.Get().CommonCfg.SessionRetryTimes.GetAsInt64(),
 		reuseNodeID:       true,
 		isStopped:         *atomic.NewBool(false),
+
+		identityFile:        paramtable.Get().CommonCfg.SessionIdentityFile.GetValue(),
+		identityGracePeriod: paramtable.Get().CommonCfg.SessionIdentityGracePeriod.GetAsDuration(time.Second),
 	}
 
 	// integration test create cluster with different nodeId in one process
 	if paramtable.Get().IntegrationTestCfg.IntegrationMode.GetAsBool() {
 		session.reuseNodeID = false
+		session.identityFile = ""
 	}
 
 	session.apply(opts...)
@@ -280,13 +324,61 @@ func (s *Session) Init(serverName, address string, exclusive bool, triggerKill b
 	s.Address = address
 	s.Exclusive = exclusive
 	s.TriggerKill = triggerKill
+	s.applyComponentConfig()
 	s.checkIDExist()
 	serverID, err := s.getServerID()
 	if err != nil {
 		panic(err)
 	}
 	s.ServerID = serverID
-	log.Info("start server", zap.String("name", serverName), zap.String("address", address), zap.Int64("id", s.ServerID))
+	s.persistIdentity()
+	log.Info("start server", zap.String("name", serverName), zap.String("address", address), zap.Int64("id", s.ServerID), zap.Bool("reclaimed", s.reclaimed))
+}
+
+// applyComponentConfig overrides the session ttl and retry times with the configs of the component.
+func (s *Session) applyComponentConfig() {
+	overrides := paramtable.Get().CommonCfg.SessionComponentOverrides.GetValue()
+	parse := func(item string) (int64, bool) {
+		key := strings.ToLower(s.ServerName) + "." + item
+		value, ok := overrides[key]
+		if !ok {
+			return 0, false
+		}
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || v <= 0 {
+			log.Warn("invalid session config of component, ignored", zap.String("key", key), zap.String("value", value))
+			return 0, false
+		}
+		return v, true
+	}
+	if ttl, ok := parse("ttl"); ok && !s.ttlByOption {
+		s.sessionTTL = ttl
+	}
+	if retryTimes, ok := parse("retrytimes"); ok && !s.retryTimesByOption {
+		s.sessionRetryTimes = retryTimes
+	}
+}
+
+func (s *Session) loadIdentity() (*nodeIdentity, bool) {
+	if s.identityFile == "" {
+		return nil, false
+	}
+	return loadNodeIdentity(s.identityFile, s.identityGracePeriod)
+}
+
+// persistIdentity saves the server id into the identity file, and marks the session as reclaimed
+// if the server id is the same as the previous one, so that the stale session could be taken over.
+func (s *Session) persistIdentity() {
+	if s.identityFile == "" {
+		return
+	}
+	if identity, ok := s.loadIdentity(); ok && identity.ServerID == s.ServerID {
+		s.reclaimed = true
+	}
+	if err := saveNodeIdentity(s.identityFile, &nodeIdentity{ServerID: s.ServerID}); err != nil {
+		log.Warn("failed to save node identity, server id will not be reclaimed after restart",
+			zap.String("identityFile", s.identityFile), zap.Error(err))
+	}
 }
 
 // String makes Session struct able to be logged by zap
@@ -319,9 +411,16 @@ func (s *Session) getServerID() (int64, error) {
 			return nodeID, nil
 		}
 	}
-	nodeID, err := s.getServerIDWithKey(DefaultIDKey)
-	if err != nil {
-		return nodeID, err
+	var nodeID int64
+	if identity, ok := s.loadIdentity(); ok {
+		log.Info("reclaim server id from node identity", zap.String("identityFile", s.identityFile), zap.Int64("serverID", identity.ServerID))
+		nodeID = identity.ServerID
+	} else {
+		var err error
+		nodeID, err = s.getServerIDWithKey(DefaultIDKey)
+		if err != nil {
+			return nodeID, err
+		}
 	}
 	if s.reuseNodeID {
 		paramtable.SetNodeID(nodeID)
@@ -459,7 +558,7 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 			return err
 		}
 
-		if txnResp != nil && !txnResp.Succeeded {
+		if txnResp != nil && !txnResp.Succeeded && !s.takeOverSession(completeKey, string(sessionJSON), resp.ID) {
 			s.handleRestart(completeKey)
 			return fmt.Errorf("function CompareAndSwap error for compare is false for key: %s", s.ServerName)
 		}
@@ -513,6 +612,45 @@ func (s *Session) handleRestart(key string) {
 	}
 }
 
+// takeOverSession replaces the stale session left by the previous incarnation of a node which reclaimed its server id,
+// the session key is re-attached to the new lease, so watchers see a session update instead of a delete and an add,
+// and the assignments of the node are kept.
+func (s *Session) takeOverSession(key string, sessionJSON string, leaseID clientv3.LeaseID) bool {
+	if !s.reclaimed {
+		return false
+	}
+	log := log.With(zap.String("key", key), zap.Int64("serverID", s.ServerID))
+	resp, err := s.etcdCli.Get(s.ctx, key)
+	if err != nil || len(resp.Kvs) == 0 {
+		log.Warn("failed to read stale session from etcd", zap.Error(err))
+		return false
+	}
+	kv := resp.Kvs[0]
+	stale := &Session{}
+	if err := json.Unmarshal(kv.Value, stale); err != nil {
+		log.Warn("failed to unmarshal stale session from etcd", zap.Error(err))
+		return false
+	}
+	if stale.ServerID != s.ServerID || stale.ServerName != s.ServerName {
+		return false
+	}
+	txnResp, err := s.etcdCli.Txn(s.ctx).If(
+		clientv3.Compare(clientv3.ModRevision(key), "=", kv.ModRevision)).
+		Then(clientv3.OpPut(key, sessionJSON, clientv3.WithLease(leaseID))).Commit()
+	if err != nil || !txnResp.Succeeded {
+		log.Warn("failed to take over stale session", zap.Error(err))
+		return false
+	}
+	if stale.LeaseID != nil {
+		// the key is detached from the stale lease already, revoke it to release resources
+		if _, err := s.etcdCli.Revoke(s.ctx, *stale.LeaseID); err != nil {
+			log.Warn("failed to revoke stale session lease", zap.Int64("leaseID", int64(*stale.LeaseID)), zap.Error(err))
+		}
+	}
+	log.Info("take over stale session of previous incarnation", zap.String("address", stale.Address))
+	return true
+}
+
 // processKeepAliveResponse processes the response of etcd keepAlive interface
 // If keepAlive fails for unexpected error, it will send a signal to the channel.
 func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveResponse) {
@@ -574,6 +712,10 @@ func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveRes
 				if resp == nil {
 					log.Warn("session keepalive response failed")
 					s.safeCloseLiveCh()
+				} else if s.identityFile != "" {
+					if err := touchNodeIdentity(s.identityFile); err != nil {
+						log.RatedWarn(60, "failed to refresh node identity", zap.String("identityFile", s.identityFile), zap.Error(err))
+					}
 				}
 			}
 		}
@@ -966,6 +1108,32 @@ func (s *Session) Revoke(timeout time.Duration) {
 	log.Info("revoke session successfully", zap.String("sessionKey", s.activeKey))
 }
 
+// EvictSession deletes the session of the given server, so all the watchers handle the server as offline,
+// and the server itself exits once its liveness check finds the session key deleted.
+// The session is deleted only if it is not modified since read, so a session re-registered is kept.
+func (s *Session) EvictSession(ctx context.Context, role string, serverID int64) error {
+	key := path.Join(s.metaRoot, DefaultServiceRoot, fmt.Sprintf("%s-%d", role, serverID))
+	resp, err := s.etcdCli.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil
+	}
+	txnResp, err := s.etcdCli.Txn(ctx).If(
+		clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision)).
+		Then(clientv3.OpDelete(key)).Commit()
+	if err != nil {
+		return err
+	}
+	if !txnResp.Succeeded {
+		log.Info("session modified during eviction, skip it", zap.String("key", key))
+		return nil
+	}
+	log.Info("evict session", zap.String("key", key))
+	return nil
+}
+
 // UpdateRegistered update the state of registered.
 func (s *Session) UpdateRegistered(b bool) {
 	s.registered.Store(b)
@@ -435,6 +435,7 @@ func TestSesssionMarshal(t *testing.T) {
 		},
 		Version: common.Version,
 	}
+	WithServerLabels(map[string]string{"tenant": "a"})(s)
 
 	bs, err := json.Marshal(s)
 	require.NoError(t, err)
@@ -446,6 +447,7 @@ func TestSesssionMarshal(t *testing.T) {
 	assert.Equal(t, s.ServerName, s2.ServerName)
 	assert.Equal(t, s.Address, s2.Address)
 	assert.Equal(t, s.Version.String(), s2.Version.String())
+	assert.Equal(t, map[string]string{"tenant": "a"}, s2.ServerLabels)
 }
 
 func TestSessionUnmarshal(t *testing.T) {
@@ -952,6 +954,85 @@ func (s *SessionSuite) TestRevoke() {
 	}
 }
 
+func (s *SessionSuite) TestReclaimServerID() {
+	ctx := context.Background()
+	identityFile := path.Join(s.tmpDir, funcutil.GenRandomStr(), "identity")
+
+	sess1 := NewSessionWithEtcd(ctx, s.metaRoot, s.client, WithResueNodeID(false), WithIdentityFile(identityFile, time.Minute))
+	sess1.Init("test", "addr1", false, false)
+	sess1.Register()
+	s.False(sess1.reclaimed)
+	// simulate crash, the stale session is left in etcd
+	sess1.cancelKeepAlive()
+
+	sess2 := NewSessionWithEtcd(ctx, s.metaRoot, s.client, WithResueNodeID(false), WithIdentityFile(identityFile, time.Minute))
+	sess2.Init("test", "addr2", false, false)
+	s.Equal(sess1.ServerID, sess2.ServerID)
+	s.True(sess2.reclaimed)
+	sess2.Register()
+	defer sess2.Stop()
+
+	resp, err := s.client.Get(ctx, sess2.getCompleteKey())
+	s.Require().NoError(err)
+	s.Require().Len(resp.Kvs, 1)
+	session := &Session{}
+	s.Require().NoError(json.Unmarshal(resp.Kvs[0].Value, session))
+	s.Equal("addr2", session.Address)
+	s.Equal(*sess2.LeaseID, *session.LeaseID)
+
+	// identity expired, allocate a new server id
+	past := time.Now().Add(-time.Hour)
+	s.Require().NoError(os.Chtimes(identityFile, past, past))
+	sess3 := NewSessionWithEtcd(ctx, s.metaRoot, s.client, WithResueNodeID(false), WithIdentityFile(identityFile, time.Minute))
+	sess3.Init("test", "addr3", false, false)
+	s.NotEqual(sess2.ServerID, sess3.ServerID)
+	s.False(sess3.reclaimed)
+
+	identity, ok := loadNodeIdentity(identityFile, time.Minute)
+	s.True(ok)
+	s.Equal(sess3.ServerID, identity.ServerID)
+
+	// broken identity file
+	s.Require().NoError(os.WriteFile(identityFile, []byte("broken"), 0o600))
+	_, ok = loadNodeIdentity(identityFile, time.Minute)
+	s.False(ok)
+}
+
+func (s *SessionSuite) TestEvictSession() {
+	ctx := context.Background()
+	sess := NewSessionWithEtcd(ctx, s.metaRoot, s.client, WithResueNodeID(false))
+	sess.Init("test", "addr", false, false)
+	sess.Register()
+	defer sess.Stop()
+
+	coord := NewSessionWithEtcd(ctx, s.metaRoot, s.client)
+	s.NoError(coord.EvictSession(ctx, "test", sess.ServerID))
+	resp, err := s.client.Get(ctx, sess.getCompleteKey())
+	s.Require().NoError(err)
+	s.Len(resp.Kvs, 0)
+
+	// evict non-exist session
+	s.NoError(coord.EvictSession(ctx, "test", sess.ServerID+1))
+}
+
+func (s *SessionSuite) TestComponentConfig() {
+	prefix := paramtable.Get().CommonCfg.SessionComponentOverrides.KeyPrefix
+	paramtable.Get().SaveGroup(map[string]string{
+		prefix + "componentConfig.ttl":        "15",
+		prefix + "componentConfig.retryTimes": "invalid",
+	})
+
+	ctx := context.Background()
+	sess := NewSessionWithEtcd(ctx, s.metaRoot, s.client, WithResueNodeID(false))
+	sess.Init("componentConfig", "addr", false, false)
+	s.EqualValues(15, sess.sessionTTL)
+	s.Equal(paramtable.Get().CommonCfg.SessionRetryTimes.GetAsInt64(), sess.sessionRetryTimes)
+
+	sess = NewSessionWithEtcd(ctx, s.metaRoot, s.client, WithResueNodeID(false), WithTTL(5))
+	sess.Init("componentConfig", "addr", false, false)
+	s.EqualValues(5, sess.sessionTTL)
+}
+
 func (s *SessionSuite) TestForceActiveWithLeaseID() {
 	ctx := context.Background()
 	role := "test"
@@ -10,15 +10,50 @@ import (
 
 // HashKey2Partitions hash partition keys to partitions
 func HashKey2Partitions(fieldSchema *schemapb.FieldSchema, keys []*planpb.GenericValue, partitionNames []string) ([]string, error) {
+	hashValues, err := hashPartitionKeys(fieldSchema, keys)
+	if err != nil {
+		return nil, err
+	}
 	selectedPartitions := make(map[string]struct{})
 	numPartitions := uint32(len(partitionNames))
+	for _, value := range hashValues {
+		partitionName := partitionNames[value%numPartitions]
+		selectedPartitions[partitionName] = struct{}{}
+	}
+
+	result := make([]string, 0)
+	for partitionName := range selectedPartitions {
+		result = append(result, partitionName)
+	}
+
+	return result, nil
+}
+
+// HashKey2PartitionBuckets hash partition keys to partitions, and returns the buckets of the keys
+// in the partition key stats of each selected partition
+func HashKey2PartitionBuckets(fieldSchema *schemapb.FieldSchema, keys []*planpb.GenericValue, partitionNames []string) (map[string][]uint32, error) {
+	hashValues, err := hashPartitionKeys(fieldSchema, keys)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]uint32)
+	numPartitions := uint32(len(partitionNames))
+	for _, value := range hashValues {
+		partitionName := partitionNames[value%numPartitions]
+		result[partitionName] = append(result[partitionName], typeutil.PartitionKeyBucket(value, numPartitions))
+	}
+
+	return result, nil
+}
+
+func hashPartitionKeys(fieldSchema *schemapb.FieldSchema, keys []*planpb.GenericValue) ([]uint32, error) {
+	hashValues := make([]uint32, 0, len(keys))
 	switch fieldSchema.GetDataType() {
 	case schemapb.DataType_Int64:
 		for _, key := range keys {
 			if int64Val, ok := key.GetVal().(*planpb.GenericValue_Int64Val); ok {
 				value, _ := typeutil.Hash32Int64(int64Val.Int64Val)
-				partitionName := partitionNames[value%numPartitions]
-				selectedPartitions[partitionName] = struct{}{}
+				hashValues = append(hashValues, value)
 			} else {
 				return nil, errors.New("the data type of the data and the schema do not match")
 			}
@@ -27,8 +62,7 @@ func HashKey2Partitions(fieldSchema *schemapb.FieldSchema, keys []*planpb.Generi
 		for _, key := range keys {
 			if stringVal, ok := key.GetVal().(*planpb.GenericValue_StringVal); ok {
 				value := typeutil.HashString2Uint32(stringVal.StringVal)
-				partitionName := partitionNames[value%numPartitions]
-				selectedPartitions[partitionName] = struct{}{}
+				hashValues = append(hashValues, value)
 			} else {
 				return nil, errors.New("the data type of the data and the schema do not match")
 			}
@@ -37,10 +71,5 @@ func HashKey2Partitions(fieldSchema *schemapb.FieldSchema, keys []*planpb.Generi
 		return nil, errors.New("currently only support DataType Int64 or VarChar as partition keys")
 	}
 
-	result := make([]string, 0)
-	for partitionName := range selectedPartitions {
-		result = append(result, partitionName)
-	}
-
-	return result, nil
+	return hashValues, nil
 }
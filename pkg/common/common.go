@@ -19,8 +19,10 @@ package common
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 
@@ -90,6 +92,9 @@ const (
 	// SegmentStatslogPath storage path const for segment stats log.
 	SegmentStatslogPath = `stats_log`
 
+	// SegmentQuantizedLogPath storage path const for the quantized vector codes of segment insert binlog.
+	SegmentQuantizedLogPath = `quantized_log`
+
 	// SegmentIndexPath storage path const for segment index files.
 	SegmentIndexPath = `index_files`
 
@@ -125,6 +130,9 @@ const (
 	IsSparseKey               = "is_sparse"
 	AutoIndexName             = "AUTOINDEX"
 	BitmapCardinalityLimitKey = "bitmap_cardinality_limit"
+
+	// the preset of the executor settings to build the index with, see indexparams.BuildProfile
+	IndexBuildProfileKey = "build_profile"
 )
 
 //  Collection properties key
@@ -132,6 +140,8 @@ const (
 const (
 	CollectionTTLConfigKey      = "collection.ttl.seconds"
 	CollectionAutoCompactionKey = "collection.autocompaction.enabled"
+	// CollectionSegmentIdleSealKey is the seconds without writes to seal the growing segments of the collection
+	CollectionSegmentIdleSealKey = "collection.segment.idleSeal.seconds"
 
 	// rate limit
 	CollectionInsertRateMaxKey   = "collection.insertRate.max.mb"
@@ -148,6 +158,13 @@ const (
 	CollectionSearchRateMinKey   = "collection.searchRate.min.vps"
 	CollectionDiskQuotaKey       = "collection.diskProtection.diskQuota.mb"
 
+	// the sustainable insert rate per vchannel to smooth the inserts of the collection into at proxy
+	CollectionInsertShapingRateKey = "collection.insertShaping.rate.mb"
+
+	// the labels of the datanodes dedicated to the collection, e.g. "tenant=a,disk=ssd",
+	// the vchannels of the collection are only watched by the datanodes with all the labels
+	CollectionDataNodeLabelsKey = "collection.datanode.labels"
+
 	PartitionDiskQuotaKey = "partition.diskProtection.diskQuota.mb"
 
 	// database level properties
@@ -157,11 +174,53 @@ const (
 	DatabaseMaxCollectionsKey   = "database.max.collections"
 	DatabaseForceDenyWritingKey = "database.force.deny.writing"
 
+	// background task quotas of the database in datacoord, 0 or not set means unlimited
+	DatabaseMaxIndexTasksKey      = "database.index.maxConcurrentTasks"
+	DatabaseMaxAnalyzeTasksKey    = "database.analyze.maxConcurrentTasks"
+	DatabaseMaxCompactionSlotsKey = "database.compaction.maxSlots"
+
 	// collection level load properties
 	CollectionReplicaNumber  = "collection.replica.number"
 	CollectionResourceGroups = "collection.resource_groups"
+	// collections with higher recovery priority are loaded first after the cluster restarts
+	CollectionRecoveryPriority = "collection.recovery.priority"
+
+	// the thresholds of the automatic single compaction of the collection are divided by the aggressiveness,
+	// e.g. 2 compacts the segments with half of the deleted or expired entities, 1 if not set
+	CollectionCompactionAggressivenessKey = "collection.compaction.aggressiveness"
+	// the daily window in local time the automatic compaction of the collection runs in, e.g. "22:00-06:00",
+	// no restriction if not set
+	CollectionCompactionWindowKey = "collection.compaction.window"
+	// the daily window in local time the compacted segments are swapped into the loaded collection, e.g. "01:00-05:00",
+	// the swaps are deferred out of the window, no restriction if not set
+	CollectionSegmentSwapWindowKey = "collection.segmentSwap.window"
+
+	// the service level objectives of the search and query requests of the collection tracked by proxy,
+	// e.g. latency.ms=100 and latency.target=0.99 expects 99% of the successful requests done in 100ms,
+	// availability.target=0.999 expects 99.9% of the requests to succeed
+	CollectionSLOLatencyKey            = "collection.slo.latency.ms"
+	CollectionSLOLatencyTargetKey      = "collection.slo.latency.target"
+	CollectionSLOAvailabilityTargetKey = "collection.slo.availability.target"
+
+	// the hours the binlogs of the dropped and compacted segments of the collection are retained before GC,
+	// to serve the snapshot reads and the CDC catch-up, e.g. 72, overrides dataCoord.gc.dropTolerance if set
+	CollectionGCRetentionKey = "collection.gc.retention.hours"
+
+	// the seconds a temporary collection lives after its creation, e.g. 86400, rootcoord drops the collection
+	// once it's expired, unlike collection.ttl.seconds which expires the entities only
+	CollectionTemporaryTTLKey = "collection.temporary.ttl.seconds"
+
+	// time-windowed partitions, a partition is created for each window of the timestamp field,
+	// and dropped once it's out of the retention windows
+	PartitionTimeWindowFieldKey     = "partition.timewindow.field"
+	PartitionTimeWindowUnitKey      = "partition.timewindow.unit"
+	PartitionTimeWindowRetentionKey = "partition.timewindow.retention"
 )
 
+// UserPropertyKeyPrefix is the prefix of the user-defined properties, e.g. "user.team",
+// which are stored and returned as they are without affecting any behavior.
+const UserPropertyKeyPrefix = "user."
+
 // common properties
 const (
 	MmapEnabledKey           = "mmap.enabled"
@@ -241,6 +300,24 @@ func IsPartitionKeyIsolationKvEnabled(kvs ...*commonpb.KeyValuePair) (bool, erro
 	return false, nil
 }
 
+// GetCollectionInsertShapingRate returns the insert shaping rate in MB/s per vchannel set in the collection properties,
+// 0 if not set.
+func GetCollectionInsertShapingRate(kvs ...*commonpb.KeyValuePair) (float64, error) {
+	for _, kv := range kvs {
+		if kv.Key == CollectionInsertShapingRateKey {
+			rate, err := strconv.ParseFloat(kv.Value, 64)
+			if err != nil {
+				return 0, errors.Wrap(err, "failed to parse insert shaping rate")
+			}
+			if rate < 0 {
+				return 0, fmt.Errorf("invalid insert shaping rate %v, should not be negative", rate)
+			}
+			return rate, nil
+		}
+	}
+	return 0, nil
+}
+
 func IsPartitionKeyIsolationPropEnabled(props map[string]string) (bool, error) {
 	val, ok := props[PartitionKeyIsolationKey]
 	if !ok {
@@ -253,6 +330,176 @@ func IsPartitionKeyIsolationPropEnabled(props map[string]string) (bool, error) {
 	return iso, nil
 }
 
+// CollectionLevelDataNodeLabels returns the labels of the datanodes dedicated to the collection,
+// nil if the collection is not isolated.
+func CollectionLevelDataNodeLabels(props map[string]string) (map[string]string, error) {
+	val, ok := props[CollectionDataNodeLabelsKey]
+	if !ok || len(strings.TrimSpace(val)) == 0 {
+		return nil, nil
+	}
+	labels := make(map[string]string)
+	for _, item := range strings.Split(val, ",") {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 || len(strings.TrimSpace(kv[0])) == 0 {
+			return nil, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", CollectionDataNodeLabelsKey, val)
+		}
+		// the label keys of the datanodes are case-insensitive configs
+		labels[strings.ToLower(strings.TrimSpace(kv[0]))] = strings.TrimSpace(kv[1])
+	}
+	return labels, nil
+}
+
+// CollectionLevelCompactionAggressiveness returns the compaction aggressiveness in the collection properties,
+// 1 if not set.
+func CollectionLevelCompactionAggressiveness(props map[string]string) (float64, error) {
+	val, ok := props[CollectionCompactionAggressivenessKey]
+	if !ok {
+		return 1, nil
+	}
+	aggressiveness, err := strconv.ParseFloat(val, 64)
+	if err != nil || aggressiveness <= 0 {
+		return 1, fmt.Errorf("invalid collection property: [key=%s] [value=%s], should be a positive number", CollectionCompactionAggressivenessKey, val)
+	}
+	return aggressiveness, nil
+}
+
+// CollectionLevelCompactionWindow returns the start and end minute of the day of the compaction window in the
+// collection properties, the window crosses midnight if end is less than start. ok is false if not set.
+func CollectionLevelCompactionWindow(props map[string]string) (start, end int, ok bool, err error) {
+	val, ok := props[CollectionCompactionWindowKey]
+	if !ok {
+		return 0, 0, false, nil
+	}
+	start, end, err = ParseDailyWindow(val)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("invalid collection property: [key=%s] [value=%s], should be like 22:00-06:00", CollectionCompactionWindowKey, val)
+	}
+	return start, end, true, nil
+}
+
+// CollectionLevelSegmentSwapWindow returns the start and end minute of the day of the segment swap window in the
+// collection properties, the window crosses midnight if end is less than start. ok is false if not set.
+func CollectionLevelSegmentSwapWindow(props map[string]string) (start, end int, ok bool, err error) {
+	val, ok := props[CollectionSegmentSwapWindowKey]
+	if !ok {
+		return 0, 0, false, nil
+	}
+	start, end, err = ParseDailyWindow(val)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("invalid collection property: [key=%s] [value=%s], should be like 01:00-05:00", CollectionSegmentSwapWindowKey, val)
+	}
+	return start, end, true, nil
+}
+
+// CollectionLevelGCRetention returns the retention of the dropped segments in the collection properties,
+// ok is false if not set.
+func CollectionLevelGCRetention(props map[string]string) (retention time.Duration, ok bool, err error) {
+	val, ok := props[CollectionGCRetentionKey]
+	if !ok {
+		return 0, false, nil
+	}
+	hours, err := strconv.ParseFloat(val, 64)
+	if err != nil || !(hours >= 0 && hours <= float64(math.MaxInt64/int64(time.Hour))) {
+		return 0, false, fmt.Errorf("invalid collection property: [key=%s] [value=%s], should be a non-negative number of hours", CollectionGCRetentionKey, val)
+	}
+	return time.Duration(hours * float64(time.Hour)), true, nil
+}
+
+// CollectionLevelTemporaryTTL returns the TTL of the temporary collection in the collection properties,
+// ok is false if the collection is not temporary.
+func CollectionLevelTemporaryTTL(props map[string]string) (ttl time.Duration, ok bool, err error) {
+	val, ok := props[CollectionTemporaryTTLKey]
+	if !ok {
+		return 0, false, nil
+	}
+	seconds, err := strconv.ParseInt(val, 10, 64)
+	if err != nil || seconds <= 0 || seconds > math.MaxInt64/int64(time.Second) {
+		return 0, false, fmt.Errorf("invalid collection property: [key=%s] [value=%s], should be a positive number of seconds", CollectionTemporaryTTLKey, val)
+	}
+	return time.Duration(seconds) * time.Second, true, nil
+}
+
+// CollectionSLO is the service level objectives declared in the collection properties,
+// the targets are the expected ratios of the good requests, 0 if the objective is not declared.
+type CollectionSLO struct {
+	LatencyMs          int64
+	LatencyTarget      float64
+	AvailabilityTarget float64
+}
+
+// CollectionLevelSLO returns the service level objectives in the collection properties, nil if not declared.
+func CollectionLevelSLO(props map[string]string) (*CollectionSLO, error) {
+	parseTarget := func(key string) (float64, error) {
+		val, ok := props[key]
+		if !ok {
+			return 0, nil
+		}
+		target, err := strconv.ParseFloat(val, 64)
+		if err != nil || target <= 0 || target >= 1 {
+			return 0, fmt.Errorf("invalid collection property: [key=%s] [value=%s], should be a ratio in (0, 1)", key, val)
+		}
+		return target, nil
+	}
+
+	slo := &CollectionSLO{}
+	var err error
+	if val, ok := props[CollectionSLOLatencyKey]; ok {
+		slo.LatencyMs, err = strconv.ParseInt(val, 10, 64)
+		if err != nil || slo.LatencyMs <= 0 {
+			return nil, fmt.Errorf("invalid collection property: [key=%s] [value=%s], should be a positive integer", CollectionSLOLatencyKey, val)
+		}
+	}
+	if slo.LatencyTarget, err = parseTarget(CollectionSLOLatencyTargetKey); err != nil {
+		return nil, err
+	}
+	if (slo.LatencyMs > 0) != (slo.LatencyTarget > 0) {
+		return nil, fmt.Errorf("collection properties %s and %s should be set together", CollectionSLOLatencyKey, CollectionSLOLatencyTargetKey)
+	}
+	if slo.AvailabilityTarget, err = parseTarget(CollectionSLOAvailabilityTargetKey); err != nil {
+		return nil, err
+	}
+	if slo.LatencyTarget == 0 && slo.AvailabilityTarget == 0 {
+		return nil, nil
+	}
+	return slo, nil
+}
+
+// ParseDailyWindow parses the daily window like 22:00-06:00, it returns the start and end minute of the day,
+// the window crosses midnight if end is less than start.
+func ParseDailyWindow(val string) (start, end int, err error) {
+	invalid := fmt.Errorf("invalid daily window %s, should be like 22:00-06:00", val)
+	bounds := strings.Split(val, "-")
+	if len(bounds) != 2 {
+		return 0, 0, invalid
+	}
+	minutes := make([]int, 0, 2)
+	for _, bound := range bounds {
+		var hour, minute int
+		if n, err := fmt.Sscanf(strings.TrimSpace(bound), "%d:%d", &hour, &minute); err != nil || n != 2 ||
+			hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+			return 0, 0, invalid
+		}
+		minutes = append(minutes, hour*60+minute)
+	}
+	if minutes[0] == minutes[1] {
+		return 0, 0, invalid
+	}
+	return minutes[0], minutes[1], nil
+}
+
+// InCompactionWindow returns true if the minute of the day is in the window [start, end).
+func InCompactionWindow(start, end, minute int) bool {
+	if start < end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+// IsUserPropertyKey returns true if the key is of a user-defined property.
+func IsUserPropertyKey(key string) bool {
+	return strings.HasPrefix(key, UserPropertyKeyPrefix)
+}
+
 const (
 	// LatestVerision is the magic number for watch latest revision
 	LatestRevision = int64(-1)
@@ -327,3 +574,19 @@ func CollectionLevelResourceGroups(kvs []*commonpb.KeyValuePair) ([]string, erro
 
 	return nil, fmt.Errorf("collection property not found: %s", CollectionReplicaNumber)
 }
+
+// CollectionLevelRecoveryPriority returns the recovery priority in the collection properties.
+func CollectionLevelRecoveryPriority(kvs []*commonpb.KeyValuePair) (int32, error) {
+	for _, kv := range kvs {
+		if kv.Key == CollectionRecoveryPriority {
+			priority, err := strconv.ParseInt(kv.Value, 10, 32)
+			if err != nil {
+				return 0, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", kv.Key, kv.Value)
+			}
+
+			return int32(priority), nil
+		}
+	}
+
+	return 0, fmt.Errorf("collection property not found: %s", CollectionRecoveryPriority)
+}
@@ -3,6 +3,7 @@ package common
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -149,3 +150,174 @@ func TestCommonPartitionKeyIsolation(t *testing.T) {
 		assert.False(t, res)
 	})
 }
+
+func TestCollectionRecoveryPriority(t *testing.T) {
+	props := []*commonpb.KeyValuePair{
+		{
+			Key:   CollectionRecoveryPriority,
+			Value: "10",
+		},
+	}
+	priority, err := CollectionLevelRecoveryPriority(props)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(10), priority)
+
+	// test prop not found
+	_, err = CollectionLevelRecoveryPriority(nil)
+	assert.Error(t, err)
+
+	// test invalid prop value
+	props[0].Value = "high"
+	_, err = CollectionLevelRecoveryPriority(props)
+	assert.Error(t, err)
+}
+
+func TestGetCollectionInsertShapingRate(t *testing.T) {
+	rate, err := GetCollectionInsertShapingRate()
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, rate)
+
+	rate, err = GetCollectionInsertShapingRate(&commonpb.KeyValuePair{Key: CollectionInsertShapingRateKey, Value: "8.5"})
+	assert.NoError(t, err)
+	assert.Equal(t, 8.5, rate)
+
+	_, err = GetCollectionInsertShapingRate(&commonpb.KeyValuePair{Key: CollectionInsertShapingRateKey, Value: "fast"})
+	assert.ErrorContains(t, err, "failed to parse insert shaping rate")
+
+	_, err = GetCollectionInsertShapingRate(&commonpb.KeyValuePair{Key: CollectionInsertShapingRateKey, Value: "-1"})
+	assert.Error(t, err)
+}
+
+func TestCollectionLevelDataNodeLabels(t *testing.T) {
+	labels, err := CollectionLevelDataNodeLabels(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, labels)
+
+	labels, err = CollectionLevelDataNodeLabels(map[string]string{CollectionDataNodeLabelsKey: "tenant=a, Disk=ssd"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"tenant": "a", "disk": "ssd"}, labels)
+
+	_, err = CollectionLevelDataNodeLabels(map[string]string{CollectionDataNodeLabelsKey: "tenant"})
+	assert.Error(t, err)
+	_, err = CollectionLevelDataNodeLabels(map[string]string{CollectionDataNodeLabelsKey: "=a"})
+	assert.Error(t, err)
+}
+
+func TestCollectionLevelCompactionProperties(t *testing.T) {
+	aggressiveness, err := CollectionLevelCompactionAggressiveness(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, aggressiveness)
+	aggressiveness, err = CollectionLevelCompactionAggressiveness(map[string]string{CollectionCompactionAggressivenessKey: "2.5"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, aggressiveness)
+	for _, val := range []string{"high", "0", "-1"} {
+		_, err = CollectionLevelCompactionAggressiveness(map[string]string{CollectionCompactionAggressivenessKey: val})
+		assert.Error(t, err)
+	}
+
+	_, _, ok, err := CollectionLevelCompactionWindow(nil)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	start, end, ok, err := CollectionLevelCompactionWindow(map[string]string{CollectionCompactionWindowKey: "22:00-06:30"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 22*60, start)
+	assert.Equal(t, 6*60+30, end)
+	for _, val := range []string{"22:00", "24:00-06:00", "22:00-22:00", "a-b"} {
+		_, _, _, err = CollectionLevelCompactionWindow(map[string]string{CollectionCompactionWindowKey: val})
+		assert.Error(t, err)
+	}
+
+	_, _, ok, err = CollectionLevelSegmentSwapWindow(nil)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	start, end, ok, err = CollectionLevelSegmentSwapWindow(map[string]string{CollectionSegmentSwapWindowKey: "01:00-05:00"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 60, start)
+	assert.Equal(t, 5*60, end)
+	_, _, _, err = CollectionLevelSegmentSwapWindow(map[string]string{CollectionSegmentSwapWindowKey: "01:00"})
+	assert.Error(t, err)
+
+	start, end, err = ParseDailyWindow("01:00-02:00")
+	assert.NoError(t, err)
+	assert.Equal(t, 60, start)
+	assert.Equal(t, 120, end)
+	_, _, err = ParseDailyWindow("")
+	assert.Error(t, err)
+
+	assert.True(t, InCompactionWindow(22*60, 6*60, 23*60))
+	assert.True(t, InCompactionWindow(22*60, 6*60, 60))
+	assert.False(t, InCompactionWindow(22*60, 6*60, 12*60))
+	assert.True(t, InCompactionWindow(60, 120, 90))
+	assert.False(t, InCompactionWindow(60, 120, 120))
+
+	assert.True(t, IsUserPropertyKey("user.team"))
+	assert.False(t, IsUserPropertyKey(CollectionTTLConfigKey))
+}
+
+func TestCollectionLevelSLO(t *testing.T) {
+	slo, err := CollectionLevelSLO(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, slo)
+
+	slo, err = CollectionLevelSLO(map[string]string{
+		CollectionSLOLatencyKey:       "100",
+		CollectionSLOLatencyTargetKey: "0.99",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &CollectionSLO{LatencyMs: 100, LatencyTarget: 0.99}, slo)
+
+	slo, err = CollectionLevelSLO(map[string]string{CollectionSLOAvailabilityTargetKey: "0.999"})
+	assert.NoError(t, err)
+	assert.Equal(t, &CollectionSLO{AvailabilityTarget: 0.999}, slo)
+
+	for _, props := range []map[string]string{
+		{CollectionSLOLatencyKey: "100"},
+		{CollectionSLOLatencyTargetKey: "0.99"},
+		{CollectionSLOLatencyKey: "-1", CollectionSLOLatencyTargetKey: "0.99"},
+		{CollectionSLOLatencyKey: "100", CollectionSLOLatencyTargetKey: "1"},
+		{CollectionSLOAvailabilityTargetKey: "high"},
+		{CollectionSLOAvailabilityTargetKey: "0"},
+	} {
+		_, err = CollectionLevelSLO(props)
+		assert.Error(t, err, props)
+	}
+}
+
+func TestCollectionLevelGCRetention(t *testing.T) {
+	_, ok, err := CollectionLevelGCRetention(nil)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	retention, ok, err := CollectionLevelGCRetention(map[string]string{CollectionGCRetentionKey: "72"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 72*time.Hour, retention)
+
+	retention, ok, err = CollectionLevelGCRetention(map[string]string{CollectionGCRetentionKey: "0.5"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Minute, retention)
+
+	for _, val := range []string{"long", "-1", "NaN", "Inf", "1e20"} {
+		_, _, err = CollectionLevelGCRetention(map[string]string{CollectionGCRetentionKey: val})
+		assert.Error(t, err, val)
+	}
+}
+
+func TestCollectionLevelTemporaryTTL(t *testing.T) {
+	_, ok, err := CollectionLevelTemporaryTTL(nil)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ttl, ok, err := CollectionLevelTemporaryTTL(map[string]string{CollectionTemporaryTTLKey: "3600"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, time.Hour, ttl)
+
+	for _, val := range []string{"long", "0", "-1", "0.5", "99999999999999999"} {
+		_, _, err = CollectionLevelTemporaryTTL(map[string]string{CollectionTemporaryTTLKey: val})
+		assert.Error(t, err, val)
+	}
+}
@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+const (
+	TimeWindowUnitDay  = "day"
+	TimeWindowUnitWeek = "week"
+
+	// TimeWindowPartitionPrefix is the name prefix of the time-windowed partitions,
+	// followed by the start date of the window in UTC, e.g. _tw_20240708.
+	TimeWindowPartitionPrefix = "_tw_"
+	timeWindowDateLayout      = "20060102"
+)
+
+// PartitionTimeWindow is the time-windowed partition config of a collection.
+// The timestamp field holds the unix time in seconds of the rows, rows are written into the partition of their window.
+type PartitionTimeWindow struct {
+	Field string
+	Unit  string
+	// the number of the latest windows retained, older partitions are dropped, 0 means retained forever
+	Retention int
+}
+
+// CollectionLevelPartitionTimeWindow returns the time-windowed partition config in the collection properties,
+// nil is returned if it's not enabled.
+func CollectionLevelPartitionTimeWindow(kvs []*commonpb.KeyValuePair) (*PartitionTimeWindow, error) {
+	var window *PartitionTimeWindow
+	var unit, retention string
+	for _, kv := range kvs {
+		switch kv.Key {
+		case PartitionTimeWindowFieldKey:
+			window = &PartitionTimeWindow{Field: kv.Value}
+		case PartitionTimeWindowUnitKey:
+			unit = kv.Value
+		case PartitionTimeWindowRetentionKey:
+			retention = kv.Value
+		}
+	}
+	if window == nil {
+		return nil, nil
+	}
+	if len(window.Field) == 0 {
+		return nil, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", PartitionTimeWindowFieldKey, window.Field)
+	}
+
+	window.Unit = strings.ToLower(unit)
+	switch window.Unit {
+	case "":
+		window.Unit = TimeWindowUnitDay
+	case TimeWindowUnitDay, TimeWindowUnitWeek:
+	default:
+		return nil, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", PartitionTimeWindowUnitKey, unit)
+	}
+
+	if len(retention) > 0 {
+		num, err := strconv.Atoi(retention)
+		if err != nil || num < 0 {
+			return nil, fmt.Errorf("invalid collection property: [key=%s] [value=%s]", PartitionTimeWindowRetentionKey, retention)
+		}
+		window.Retention = num
+	}
+	return window, nil
+}
+
+// WindowStart returns the start of the window containing t, the windows are aligned to days in UTC,
+// and weeks start on Monday.
+func (w *PartitionTimeWindow) WindowStart(t time.Time) time.Time {
+	t = t.UTC()
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if w.Unit == TimeWindowUnitWeek {
+		// days since Monday
+		offset := (int(start.Weekday()) + 6) % 7
+		start = start.AddDate(0, 0, -offset)
+	}
+	return start
+}
+
+// AddWindows returns the start of the n-th window after the window starting at start,
+// n could be negative.
+func (w *PartitionTimeWindow) AddWindows(start time.Time, n int) time.Time {
+	if w.Unit == TimeWindowUnitWeek {
+		return start.AddDate(0, 0, 7*n)
+	}
+	return start.AddDate(0, 0, n)
+}
+
+// PartitionName returns the name of the partition for the rows at t.
+func (w *PartitionTimeWindow) PartitionName(t time.Time) string {
+	return TimeWindowPartitionPrefix + w.WindowStart(t).Format(timeWindowDateLayout)
+}
+
+// ParseTimeWindowPartitionName returns the window start of the time-windowed partition,
+// false is returned if it's not a time-windowed partition.
+func ParseTimeWindowPartitionName(name string) (time.Time, bool) {
+	if !strings.HasPrefix(name, TimeWindowPartitionPrefix) {
+		return time.Time{}, false
+	}
+	start, err := time.ParseInLocation(timeWindowDateLayout, strings.TrimPrefix(name, TimeWindowPartitionPrefix), time.UTC)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return start, true
+}
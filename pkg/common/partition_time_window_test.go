@@ -0,0 +1,70 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+)
+
+func TestCollectionLevelPartitionTimeWindow(t *testing.T) {
+	window, err := CollectionLevelPartitionTimeWindow(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, window)
+
+	props := []*commonpb.KeyValuePair{
+		{Key: PartitionTimeWindowFieldKey, Value: "event_time"},
+	}
+	window, err = CollectionLevelPartitionTimeWindow(props)
+	assert.NoError(t, err)
+	assert.Equal(t, &PartitionTimeWindow{Field: "event_time", Unit: TimeWindowUnitDay}, window)
+
+	props = append(props,
+		&commonpb.KeyValuePair{Key: PartitionTimeWindowUnitKey, Value: "Week"},
+		&commonpb.KeyValuePair{Key: PartitionTimeWindowRetentionKey, Value: "4"},
+	)
+	window, err = CollectionLevelPartitionTimeWindow(props)
+	assert.NoError(t, err)
+	assert.Equal(t, &PartitionTimeWindow{Field: "event_time", Unit: TimeWindowUnitWeek, Retention: 4}, window)
+
+	// invalid values
+	for _, kv := range []*commonpb.KeyValuePair{
+		{Key: PartitionTimeWindowFieldKey, Value: ""},
+		{Key: PartitionTimeWindowUnitKey, Value: "month"},
+		{Key: PartitionTimeWindowRetentionKey, Value: "-1"},
+		{Key: PartitionTimeWindowRetentionKey, Value: "forever"},
+	} {
+		_, err = CollectionLevelPartitionTimeWindow(append(props, kv))
+		assert.Error(t, err, kv.String())
+	}
+}
+
+func TestPartitionTimeWindow(t *testing.T) {
+	// Wednesday
+	ts := time.Date(2024, 7, 10, 23, 30, 0, 0, time.FixedZone("UTC-8", -8*3600))
+
+	day := &PartitionTimeWindow{Unit: TimeWindowUnitDay}
+	start := day.WindowStart(ts)
+	assert.Equal(t, time.Date(2024, 7, 11, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, "_tw_20240711", day.PartitionName(ts))
+	assert.Equal(t, time.Date(2024, 7, 12, 0, 0, 0, 0, time.UTC), day.AddWindows(start, 1))
+	assert.Equal(t, time.Date(2024, 7, 9, 0, 0, 0, 0, time.UTC), day.AddWindows(start, -2))
+
+	week := &PartitionTimeWindow{Unit: TimeWindowUnitWeek}
+	start = week.WindowStart(ts)
+	assert.Equal(t, time.Date(2024, 7, 8, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, "_tw_20240708", week.PartitionName(ts))
+	assert.Equal(t, start, week.WindowStart(start))
+	assert.Equal(t, time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC), week.AddWindows(start, 1))
+	assert.Equal(t, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), week.AddWindows(start, -1))
+
+	parsed, ok := ParseTimeWindowPartitionName("_tw_20240708")
+	assert.True(t, ok)
+	assert.Equal(t, start, parsed)
+	_, ok = ParseTimeWindowPartitionName("_default")
+	assert.False(t, ok)
+	_, ok = ParseTimeWindowPartitionName("_tw_2024")
+	assert.False(t, ok)
+}
@@ -28,6 +28,7 @@ const (
 	InsertFileLabel          = "insert_file"
 	DeleteFileLabel          = "delete_file"
 	StatFileLabel            = "stat_file"
+	QuantizedFileLabel       = "quantized_file"
 	IndexFileLabel           = "index_file"
 	segmentFileTypeLabelName = "segment_file_type"
 )
@@ -128,6 +129,26 @@ var (
 			channelNameLabelName,
 		})
 
+	DataCoordGcSafeTsUnixSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "gc_safe_ts_unix_seconds",
+			Help:      "the oldest timestamp in unix seconds the snapshot reads of the collection are safe from garbage collection",
+		}, []string{
+			collectionIDLabelName,
+		})
+
+	DataCoordRegionReplicaStalenessSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "region_replica_staleness_seconds",
+			Help:      "seconds the collection mirrored by the secondary region lags behind the writes to the primary region",
+		}, []string{
+			collectionIDLabelName,
+		})
+
 	DataCoordStoredBinlogSize = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -193,6 +214,32 @@ var (
 			statusLabelName,
 		})
 
+	DataCoordCompactionIORateAllocated = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "compaction_io_rate_allocated",
+			Help:      "read and write bytes per second allocated to the running compaction plans from the compaction I/O budget",
+		})
+
+	DataCoordChannelWriteRate = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "channel_write_rate",
+			Help:      "rows written per second of the vchannel reported by the datanodes",
+		}, []string{
+			channelNameLabelName,
+		})
+
+	DataCoordHotChannelNum = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "hot_channel_num",
+			Help:      "number of the vchannels with write rates much higher than the average",
+		})
+
 	DataCoordCompactionLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -301,6 +348,84 @@ var (
 			Help:      "number of IndexNodes managed by IndexCoord",
 		}, []string{})
 
+	// IndexNodeQuarantineCounter records the number of times each IndexNode is quarantined.
+	IndexNodeQuarantineCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "index_node_quarantine_count",
+			Help:      "number of times the IndexNode is quarantined for the repeated failures",
+		}, []string{nodeIDLabelName})
+
+	// DataCoordCorruptedSegmentCounter records the number of the segments found corrupted by the source verification.
+	DataCoordCorruptedSegmentCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "corrupted_segment_count",
+			Help:      "number of the segments with missing or truncated binlogs found by the failed index builds",
+		}, []string{collectionIDLabelName})
+
+	// DataCoordIndexTaskQueueLatency records the time the index and analyze tasks wait in the scheduler
+	// before being assigned to the workers.
+	DataCoordIndexTaskQueueLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "index_task_queue_latency",
+			Help:      "latency from the task enqueued to assigned to the worker (in milliseconds)",
+			Buckets:   longTaskBuckets,
+		}, []string{taskTypeLabel})
+
+	// DataCoordIndexTaskBuildLatency records the time the index and analyze tasks take on the workers,
+	// from the worker starting to execute the task to the result found by the scheduler.
+	DataCoordIndexTaskBuildLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "index_task_build_latency",
+			Help:      "latency from the worker starting to execute the task to the task completed (in milliseconds)",
+			Buckets:   longTaskBuckets,
+		}, []string{taskTypeLabel, statusLabelName})
+
+	// DataCoordSpeculativeIndexBuildCounter records the number of the speculative duplicates of the straggling
+	// index builds launched, and the number of them finished before the stragglers.
+	DataCoordSpeculativeIndexBuildCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "speculative_index_build_count",
+			Help:      "number of the speculative index builds, the total ones launched and the successful ones taken",
+		}, []string{statusLabelName})
+
+	// DataCoordSlowTaskCounter records the number of the slow tasks whose diagnostics are captured.
+	DataCoordSlowTaskCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "slow_task_count",
+			Help:      "number of the slow tasks whose diagnostics are captured",
+		}, []string{taskTypeLabel})
+
+	// DataCoordSessionRPCRetryCounter records the number of the retries of the rpcs to the datanodes.
+	DataCoordSessionRPCRetryCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "session_rpc_retry_count",
+			Help:      "number of the retries of the rpcs to the datanodes",
+		}, []string{functionLabelName})
+
+	// DataCoordIndexUpgradeProgress records the progress of upgrading the segment indexes of the collection
+	// to the current index engine version.
+	DataCoordIndexUpgradeProgress = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "index_upgrade_progress",
+			Help:      "progress in percentage of upgrading the segment indexes to the current index engine version",
+		}, []string{collectionIDLabelName})
+
 	ImportTasks = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -319,12 +444,17 @@ func RegisterDataCoord(registry *prometheus.Registry) {
 	registry.MustRegister(DataCoordBulkVectors)
 	registry.MustRegister(DataCoordConsumeDataNodeTimeTickLag)
 	registry.MustRegister(DataCoordCheckpointUnixSeconds)
+	registry.MustRegister(DataCoordGcSafeTsUnixSeconds)
+	registry.MustRegister(DataCoordRegionReplicaStalenessSeconds)
 	registry.MustRegister(DataCoordStoredBinlogSize)
 	registry.MustRegister(DataCoordStoredIndexFilesSize)
 	registry.MustRegister(DataCoordSegmentBinLogFileCount)
 	registry.MustRegister(DataCoordDmlChannelNum)
 	registry.MustRegister(DataCoordCompactedSegmentSize)
 	registry.MustRegister(DataCoordCompactionTaskNum)
+	registry.MustRegister(DataCoordCompactionIORateAllocated)
+	registry.MustRegister(DataCoordChannelWriteRate)
+	registry.MustRegister(DataCoordHotChannelNum)
 	registry.MustRegister(DataCoordCompactionLatency)
 	registry.MustRegister(DataCoordSizeStoredL0Segment)
 	registry.MustRegister(DataCoordRateStoredL0Segment)
@@ -332,6 +462,14 @@ func RegisterDataCoord(registry *prometheus.Registry) {
 	registry.MustRegister(IndexRequestCounter)
 	registry.MustRegister(IndexTaskNum)
 	registry.MustRegister(IndexNodeNum)
+	registry.MustRegister(IndexNodeQuarantineCounter)
+	registry.MustRegister(DataCoordCorruptedSegmentCounter)
+	registry.MustRegister(DataCoordIndexTaskQueueLatency)
+	registry.MustRegister(DataCoordIndexTaskBuildLatency)
+	registry.MustRegister(DataCoordSpeculativeIndexBuildCounter)
+	registry.MustRegister(DataCoordSlowTaskCounter)
+	registry.MustRegister(DataCoordSessionRPCRetryCounter)
+	registry.MustRegister(DataCoordIndexUpgradeProgress)
 	registry.MustRegister(ImportTasks)
 	registry.MustRegister(GarbageCollectorFileScanDuration)
 	registry.MustRegister(GarbageCollectorRunCount)
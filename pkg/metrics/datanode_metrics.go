@@ -46,6 +46,18 @@ var (
 			msgTypeLabelName,
 		})
 
+	DataNodeCorruptVectorRows = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "corrupt_vector_rows",
+			Help:      "count of consumed vectors containing NaN or Inf, or mismatching the dimension",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+			statusLabelName,
+		})
+
 	DataNodeFlushedSize = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: milvusNamespace,
@@ -237,6 +249,7 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeMsgDispatcherTtLag)
 	registry.MustRegister(DataNodeConsumeMsgCount)
 	registry.MustRegister(DataNodeConsumeBytesCount)
+	registry.MustRegister(DataNodeCorruptVectorRows)
 	// in memory
 	registry.MustRegister(DataNodeFlowGraphBufferDataSize)
 	// output related
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	GrpcCompressLabel   = "compress"
+	GrpcDecompressLabel = "decompress"
+
+	GrpcAPIQueuedLabel   = "queued"
+	GrpcAPIRejectedLabel = "rejected"
+
+	compressorLabelName    = "compressor"
+	compressionOpLabelName = "op"
+)
+
+var (
+	GrpcCompressionRawBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "grpc",
+			Name:      "compression_raw_bytes",
+			Help:      "uncompressed bytes of the compressed grpc messages",
+		}, []string{compressorLabelName, compressionOpLabelName})
+
+	GrpcCompressionSavedBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "grpc",
+			Name:      "compression_saved_bytes",
+			Help:      "bytes saved on the wire by compressing grpc messages",
+		}, []string{compressorLabelName, compressionOpLabelName})
+
+	GrpcCompressionCost = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "grpc",
+			Name:      "compression_cost_seconds",
+			Help:      "time spent in compressing and decompressing grpc messages",
+		}, []string{compressorLabelName, compressionOpLabelName})
+
+	GrpcAPILimitedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "grpc",
+			Name:      "api_limited_count",
+			Help:      "count of the calls queued or rejected by the api limit of the coordinators",
+		}, []string{roleNameLabelName, functionLabelName, statusLabelName})
+)
+
+// RegisterGrpcMetrics registers grpc metrics
+func RegisterGrpcMetrics(registry prometheus.Registerer) {
+	registry.MustRegister(GrpcCompressionRawBytes)
+	registry.MustRegister(GrpcCompressionSavedBytes)
+	registry.MustRegister(GrpcCompressionCost)
+	registry.MustRegister(GrpcAPILimitedCounter)
+}
@@ -74,6 +74,19 @@ const (
 	Executing = "executing"
 	Done      = "done"
 
+	IndexSearchLabel          = "index"
+	QuantizedIndexSearchLabel = "quantized_index"
+	GraphIndexSearchLabel     = "graph_index"
+	BruteForceSearchLabel     = "brute_force"
+
+	ConsistentLabel = "consistent"
+	DivergentLabel  = "divergent"
+
+	SLOLatencyLabel      = "latency"
+	SLOAvailabilityLabel = "availability"
+	SLOGoodLabel         = "good"
+	SLOSlowLabel         = "slow"
+
 	compactionTypeLabelName  = "compaction_type"
 	isVectorFieldLabelName   = "is_vector_field"
 	segmentPruneLabelName    = "segment_prune_label"
@@ -111,6 +124,10 @@ const (
 	lockOp                   = "lock_op"
 	loadTypeName             = "load_type"
 	pathLabelName            = "path"
+	searchTypeLabelName      = "search_type"
+	sloTypeLabelName         = "slo_type"
+	sloWindowLabelName       = "slo_window"
+	discrepancyKindLabelName = "discrepancy_kind"
 
 	// entities label
 	LoadedLabel         = "loaded"
@@ -149,6 +166,19 @@ var (
 			lockOp,
 		})
 
+	// LockWaitDuration records the durations waiting to acquire the instrumented locks,
+	// the contended locks have the long tails.
+	LockWaitDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Name:      "lock_wait_duration",
+			Help:      "duration in milliseconds waiting to acquire the lock",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 50, 100, 500, 1000, 5000},
+		}, []string{
+			lockName,
+			lockType,
+		})
+
 	metricRegisterer prometheus.Registerer
 )
 
@@ -166,7 +196,9 @@ func GetRegisterer() prometheus.Registerer {
 func Register(r prometheus.Registerer) {
 	r.MustRegister(NumNodes)
 	r.MustRegister(LockCosts)
+	r.MustRegister(LockWaitDuration)
 	r.MustRegister(BuildInfo)
 	r.MustRegister(RuntimeInfo)
+	RegisterGrpcMetrics(r)
 	metricRegisterer = r
 }
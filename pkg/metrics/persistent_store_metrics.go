@@ -25,7 +25,22 @@ const (
 	DataWalkLabel   = "walk"
 	DataStatLabel   = "stat"
 
+	DataMultiGetLabel     = "multi_get"
+	DataMultiPutLabel     = "multi_put"
+	DataMultiRemoveLabel  = "multi_remove"
+	DataRemovePrefixLabel = "remove_prefix"
+	DataReadAtLabel       = "read_at"
+	DataExistLabel        = "exist"
+
 	persistentDataOpType = "persistent_data_op_type"
+
+	LocalStorageEtcdLabel     = "etcd"
+	LocalStorageMQLabel       = "mq"
+	LocalStorageDataLabel     = "data"
+	localStorageTypeLabel     = "local_storage_type"
+	EmbedEtcdDBSizeLabel      = "size"
+	EmbedEtcdDBSizeInUseLabel = "in_use"
+	embedEtcdDBSizeTypeLabel  = "size_type"
 )
 
 var (
@@ -54,6 +69,42 @@ var (
 			Name:      "op_count",
 			Help:      "count of persistent data operation",
 		}, []string{persistentDataOpType, statusLabelName})
+
+	// ChunkManagerOpLatency records the latency in milliseconds of the chunk manager operations,
+	// the collection is empty if it's not known from the paths.
+	ChunkManagerOpLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "chunk_manager_op_latency",
+			Help:      "latency in milliseconds of the chunk manager operations",
+			Buckets:   buckets,
+		}, []string{roleNameLabelName, persistentDataOpType, collectionIDLabelName})
+
+	ChunkManagerOpSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "chunk_manager_op_size",
+			Help:      "bytes read or written by the chunk manager operations",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 12), // 1KB ~ 4GB
+		}, []string{roleNameLabelName, persistentDataOpType, collectionIDLabelName})
+
+	LocalStorageDiskUsage = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "local_disk_usage",
+			Help:      "disk usage in bytes of the local data directories of standalone milvus",
+		}, []string{localStorageTypeLabel, pathLabelName})
+
+	EmbedEtcdDBSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "embed_etcd_db_size",
+			Help:      "allocated and in use size in bytes of the embedded etcd db",
+		}, []string{embedEtcdDBSizeTypeLabel})
 )
 
 // RegisterStorageMetrics registers storage metrics
@@ -61,4 +112,8 @@ func RegisterStorageMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(PersistentDataKvSize)
 	registry.MustRegister(PersistentDataRequestLatency)
 	registry.MustRegister(PersistentDataOpCounter)
+	registry.MustRegister(ChunkManagerOpLatency)
+	registry.MustRegister(ChunkManagerOpSize)
+	registry.MustRegister(LocalStorageDiskUsage)
+	registry.MustRegister(EmbedEtcdDBSize)
 }
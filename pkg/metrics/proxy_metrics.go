@@ -158,6 +158,65 @@ var (
 			Buckets:   longTaskBuckets, // unit: ms
 		}, []string{nodeIDLabelName, msgTypeLabelName})
 
+	// ProxyInsertShapingDelay records the delay of the inserts queued by the insert shaping.
+	ProxyInsertShapingDelay = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "insert_shaping_delay",
+			Help:      "delay of the inserts queued by the insert shaping",
+			Buckets:   buckets, // unit: ms
+		}, []string{nodeIDLabelName, collectionName})
+
+	// ProxySearchVerificationCount records the sampled searches verified by brute force by the status,
+	// which is consistent, divergent or fail.
+	ProxySearchVerificationCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "search_verification_count",
+			Help:      "count of the sampled searches verified by brute force",
+		}, []string{nodeIDLabelName, collectionName, statusLabelName})
+
+	// ProxySearchVerificationRecall records the recall of the sampled searches against the brute force results.
+	ProxySearchVerificationRecall = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "search_verification_recall",
+			Help:      "recall of the sampled searches against the brute force results",
+			Buckets:   []float64{0.5, 0.6, 0.7, 0.8, 0.85, 0.9, 0.95, 0.98, 0.99, 1},
+		}, []string{nodeIDLabelName, collectionName})
+
+	// ProxySLORequestCount records the search and query requests of the collections with SLO by the status,
+	// which is good, slow or fail.
+	ProxySLORequestCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "slo_request_count",
+			Help:      "count of the search and query requests tracked by the collection SLO",
+		}, []string{nodeIDLabelName, collectionName, statusLabelName})
+
+	// ProxySLOCompliance records the ratio of the good requests of the collection SLO in the window.
+	ProxySLOCompliance = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "slo_compliance",
+			Help:      "ratio of the good requests of the collection SLO in the window",
+		}, []string{nodeIDLabelName, collectionName, sloTypeLabelName, sloWindowLabelName})
+
+	// ProxySLOBurnRate records how fast the error budget of the collection SLO is consumed in the window,
+	// 1 means the budget is exactly used up at the end of the SLO period.
+	ProxySLOBurnRate = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "slo_burn_rate",
+			Help:      "error budget burn rate of the collection SLO in the window",
+		}, []string{nodeIDLabelName, collectionName, sloTypeLabelName, sloWindowLabelName})
+
 	// ProxyAssignSegmentIDLatency record the latency that Proxy get segmentID from dataCoord.
 	ProxyAssignSegmentIDLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -437,6 +496,12 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxySlowQueryCount)
 	registry.MustRegister(ProxyReportValue)
 	registry.MustRegister(ProxyReqInQueueLatency)
+	registry.MustRegister(ProxyInsertShapingDelay)
+	registry.MustRegister(ProxySearchVerificationCount)
+	registry.MustRegister(ProxySearchVerificationRecall)
+	registry.MustRegister(ProxySLORequestCount)
+	registry.MustRegister(ProxySLOCompliance)
+	registry.MustRegister(ProxySLOBurnRate)
 }
 
 func CleanupProxyDBMetrics(nodeID int64, dbName string) {
@@ -495,6 +560,19 @@ func CleanupProxyCollectionMetrics(nodeID int64, collection string) {
 		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
 		collectionName:  collection,
 	})
+	ProxyInsertShapingDelay.DeletePartialMatch(prometheus.Labels{
+		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
+		collectionName:  collection,
+	})
+	ProxySearchVerificationCount.DeletePartialMatch(prometheus.Labels{
+		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
+		collectionName:  collection,
+	})
+	ProxySearchVerificationRecall.DeletePartialMatch(prometheus.Labels{
+		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
+		collectionName:  collection,
+	})
+	CleanupProxySLOMetrics(nodeID, collection)
 
 	ProxyCollectionSQLatency.Delete(prometheus.Labels{
 		nodeIDLabelName:    strconv.FormatInt(nodeID, 10),
@@ -541,3 +619,19 @@ func CleanupProxyCollectionMetrics(nodeID int64, collection string) {
 		msgTypeLabelName: UpsertLabel, collectionName: collection,
 	})
 }
+
+// CleanupProxySLOMetrics removes the SLO metrics of the collection, e.g. once its SLO is removed.
+func CleanupProxySLOMetrics(nodeID int64, collection string) {
+	ProxySLORequestCount.DeletePartialMatch(prometheus.Labels{
+		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
+		collectionName:  collection,
+	})
+	ProxySLOCompliance.DeletePartialMatch(prometheus.Labels{
+		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
+		collectionName:  collection,
+	})
+	ProxySLOBurnRate.DeletePartialMatch(prometheus.Labels{
+		nodeIDLabelName: strconv.FormatInt(nodeID, 10),
+		collectionName:  collection,
+	})
+}
@@ -132,6 +132,30 @@ var (
 			Help:      "latency of all kind of task in query coord scheduler scheduler",
 			Buckets:   longTaskBuckets,
 		}, []string{collectionIDLabelName, taskTypeLabel, channelNameLabelName})
+
+	QueryCoordIndexHandoffProgress = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "index_handoff_progress",
+			Help:      "percentage of the sealed segments in current target which are served with all the indexes of the collection",
+		}, []string{collectionIDLabelName})
+
+	QueryCoordRowCountDiscrepancyNum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "row_count_discrepancy_num",
+			Help:      "number of the row count discrepancies of the loaded segments found by the last row count check",
+		}, []string{collectionIDLabelName, discrepancyKindLabelName})
+
+	QueryCoordRowCountRepairCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryCoordRole,
+			Name:      "row_count_repair_count",
+			Help:      "count of the segment reloads scheduled to repair the loaded rows mismatches",
+		}, []string{collectionIDLabelName})
 )
 
 // RegisterQueryCoord registers QueryCoord metrics
@@ -146,10 +170,18 @@ func RegisterQueryCoord(registry *prometheus.Registry) {
 	registry.MustRegister(QueryCoordNumQueryNodes)
 	registry.MustRegister(QueryCoordCurrentTargetCheckpointUnixSeconds)
 	registry.MustRegister(QueryCoordTaskLatency)
+	registry.MustRegister(QueryCoordIndexHandoffProgress)
+	registry.MustRegister(QueryCoordRowCountDiscrepancyNum)
+	registry.MustRegister(QueryCoordRowCountRepairCount)
 }
 
 func CleanQueryCoordMetricsWithCollectionID(collectionID int64) {
 	QueryCoordTaskLatency.DeletePartialMatch(prometheus.Labels{
 		collectionIDLabelName: fmt.Sprint(collectionID),
 	})
+	QueryCoordIndexHandoffProgress.DeleteLabelValues(fmt.Sprint(collectionID))
+	QueryCoordRowCountDiscrepancyNum.DeletePartialMatch(prometheus.Labels{
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	})
+	QueryCoordRowCountRepairCount.DeleteLabelValues(fmt.Sprint(collectionID))
 }
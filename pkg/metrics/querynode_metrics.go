@@ -520,6 +520,18 @@ var (
 			nodeIDLabelName,
 		})
 
+	// QueryNodeDiskJanitorReclaimedBytes records the bytes of the orphan local files cleaned by the disk janitor.
+	QueryNodeDiskJanitorReclaimedBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "disk_janitor_reclaimed_bytes",
+			Help:      "bytes of the orphan local files cleaned by the disk janitor",
+		}, []string{
+			nodeIDLabelName,
+			pathLabelName,
+		})
+
 	StoppingBalanceNodeNum = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -753,6 +765,32 @@ var (
 		}, []string{
 			nodeIDLabelName,
 		})
+
+	// QueryNodeSegcoreSearchNQ records the nq searched in segcore by index or brute force,
+	// the nq searched on the quantized and the graph indexes are also counted as searched by index.
+	QueryNodeSegcoreSearchNQ = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "segcore_search_nq",
+			Help:      "the number of the query vectors searched in segcore by index or brute force, quantized_index and graph_index are the subsets of index",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+			searchTypeLabelName,
+		})
+
+	// QueryNodeSegcoreDistanceComputations records the distance computations of the brute force searches in segcore.
+	QueryNodeSegcoreDistanceComputations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "segcore_distance_computations",
+			Help:      "the number of the distance computations of the brute force searches in segcore",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
 )
 
 // RegisterQueryNode registers QueryNode metrics
@@ -793,6 +831,7 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeSegmentSearchLatencyPerVector)
 	registry.MustRegister(QueryNodeWatchDmlChannelLatency)
 	registry.MustRegister(QueryNodeDiskUsedSize)
+	registry.MustRegister(QueryNodeDiskJanitorReclaimedBytes)
 	registry.MustRegister(QueryNodeProcessCost)
 	registry.MustRegister(QueryNodeWaitProcessingMsgCount)
 	registry.MustRegister(StoppingBalanceNodeNum)
@@ -819,6 +858,8 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeSegmentPruneBias)
 	registry.MustRegister(QueryNodeApplyBFCost)
 	registry.MustRegister(QueryNodeForwardDeleteCost)
+	registry.MustRegister(QueryNodeSegcoreSearchNQ)
+	registry.MustRegister(QueryNodeSegcoreDistanceComputations)
 	// Add cgo metrics
 	RegisterCGOMetrics(registry)
 }
@@ -851,4 +892,16 @@ func CleanupQueryNodeCollectionMetrics(nodeID int64, collectionID int64) {
 				nodeIDLabelName:       nodeIDLabel,
 				collectionIDLabelName: collectionIDLabel,
 			})
+	QueryNodeSegcoreSearchNQ.
+		DeletePartialMatch(
+			prometheus.Labels{
+				nodeIDLabelName:       nodeIDLabel,
+				collectionIDLabelName: collectionIDLabel,
+			})
+	QueryNodeSegcoreDistanceComputations.
+		DeletePartialMatch(
+			prometheus.Labels{
+				nodeIDLabelName:       nodeIDLabel,
+				collectionIDLabelName: collectionIDLabel,
+			})
 }
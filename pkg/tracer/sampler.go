@@ -0,0 +1,232 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	sdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// SamplingPolicy is the trace sampling policy which could be changed at runtime.
+type SamplingPolicy struct {
+	// Rate is the default fraction of the sampled root spans.
+	Rate float64 `json:"rate"`
+	// MethodRates overrides the fraction of the root spans by the RPC method name, e.g. Search.
+	MethodRates map[string]float64 `json:"method_rates,omitempty"`
+	// ForceCollections and ForceUsers are the collections and users whose requests are always sampled.
+	ForceCollections []string `json:"force_collections,omitempty"`
+	ForceUsers       []string `json:"force_users,omitempty"`
+}
+
+func (p *SamplingPolicy) validate() error {
+	check := func(rate float64) error {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("invalid sample rate %v, should be in [0, 1]", rate)
+		}
+		return nil
+	}
+	if err := check(p.Rate); err != nil {
+		return err
+	}
+	for method, rate := range p.MethodRates {
+		if err := check(rate); err != nil {
+			return fmt.Errorf("method %s: %w", method, err)
+		}
+	}
+	return nil
+}
+
+type forceSampleKey struct{}
+
+// WithForceSample marks the spans started with the context to be sampled regardless of the rates.
+func WithForceSample(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceSampleKey{}, true)
+}
+
+func isForceSampled(ctx context.Context) bool {
+	forced, _ := ctx.Value(forceSampleKey{}).(bool)
+	return forced
+}
+
+// policySampler samples the spans by the sampling policy,
+// the spans with parent follow the decision of the parent unless they are forced to be sampled.
+type policySampler struct {
+	mu               sync.RWMutex
+	policy           *SamplingPolicy
+	defaultSampler   sdk.Sampler
+	methodSamplers   map[string]sdk.Sampler
+	forceCollections typeutil.Set[string]
+	forceUsers       typeutil.Set[string]
+
+	// configRate is the rate configured by trace.sampleFraction, restored on reset
+	configRate float64
+}
+
+var _ sdk.Sampler = (*policySampler)(nil)
+
+var globalSampler = newPolicySampler(0)
+
+func newPolicySampler(rate float64) *policySampler {
+	s := &policySampler{configRate: rate}
+	s.setPolicy(&SamplingPolicy{Rate: rate})
+	return s
+}
+
+func (s *policySampler) setPolicy(policy *SamplingPolicy) {
+	methodSamplers := make(map[string]sdk.Sampler, len(policy.MethodRates))
+	for method, rate := range policy.MethodRates {
+		methodSamplers[method] = sdk.TraceIDRatioBased(rate)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policy = policy
+	s.defaultSampler = sdk.TraceIDRatioBased(policy.Rate)
+	s.methodSamplers = methodSamplers
+	s.forceCollections = typeutil.NewSet(policy.ForceCollections...)
+	s.forceUsers = typeutil.NewSet(policy.ForceUsers...)
+}
+
+func (s *policySampler) ShouldSample(p sdk.SamplingParameters) sdk.SamplingResult {
+	if p.ParentContext != nil && isForceSampled(p.ParentContext) {
+		return sdk.AlwaysSample().ShouldSample(p)
+	}
+	if parent := trace.SpanContextFromContext(p.ParentContext); parent.IsValid() {
+		if parent.IsSampled() {
+			return sdk.AlwaysSample().ShouldSample(p)
+		}
+		return sdk.NeverSample().ShouldSample(p)
+	}
+
+	s.mu.RLock()
+	sampler := s.defaultSampler
+	// the span name of grpc is the full method, e.g. milvus.proto.milvus.MilvusService/Search
+	if methodSampler, ok := s.methodSamplers[p.Name[strings.LastIndex(p.Name, "/")+1:]]; ok {
+		sampler = methodSampler
+	}
+	s.mu.RUnlock()
+	return sampler.ShouldSample(p)
+}
+
+func (s *policySampler) Description() string {
+	return "PolicySampler"
+}
+
+func (s *policySampler) shouldForceSample(collection, user string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return (collection != "" && s.forceCollections.Contain(collection)) || (user != "" && s.forceUsers.Contain(user))
+}
+
+func (s *policySampler) hasForceSample() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.forceCollections.Len() > 0 || s.forceUsers.Len() > 0
+}
+
+// GetSamplingPolicy returns the current trace sampling policy.
+func GetSamplingPolicy() *SamplingPolicy {
+	globalSampler.mu.RLock()
+	defer globalSampler.mu.RUnlock()
+	return globalSampler.policy
+}
+
+// SetSamplingPolicy replaces the trace sampling policy, it takes effect on the spans started afterward.
+func SetSamplingPolicy(policy *SamplingPolicy) error {
+	if err := policy.validate(); err != nil {
+		return err
+	}
+	globalSampler.setPolicy(policy)
+	log.Info("trace sampling policy updated", zap.Float64("rate", policy.Rate), zap.Any("methodRates", policy.MethodRates),
+		zap.Strings("forceCollections", policy.ForceCollections), zap.Strings("forceUsers", policy.ForceUsers))
+	return nil
+}
+
+// ResetSamplingPolicy restores the trace sampling policy to the configured sample fraction.
+func ResetSamplingPolicy() {
+	globalSampler.mu.RLock()
+	rate := globalSampler.configRate
+	globalSampler.mu.RUnlock()
+	globalSampler.setPolicy(&SamplingPolicy{Rate: rate})
+	log.Info("trace sampling policy reset", zap.Float64("rate", rate))
+}
+
+// initSamplingPolicy initializes the trace sampling policy with the configured sample fraction.
+func initSamplingPolicy(rate float64) {
+	globalSampler.mu.Lock()
+	globalSampler.configRate = rate
+	globalSampler.mu.Unlock()
+	globalSampler.setPolicy(&SamplingPolicy{Rate: rate})
+}
+
+// HasForceSample returns whether any collection or user is forced to be sampled.
+func HasForceSample() bool {
+	return globalSampler.hasForceSample()
+}
+
+// ShouldForceSample returns whether the requests of the collection or the user are forced to be sampled.
+func ShouldForceSample(collection, user string) bool {
+	return globalSampler.shouldForceSample(collection, user)
+}
+
+// SamplingPolicyHandler returns the http handler to get the trace sampling policy by GET,
+// update it by PUT with the policy in json, and reset it by DELETE.
+func SamplingPolicyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			policy := &SamplingPolicy{}
+			if err := json.NewDecoder(req.Body).Decode(policy); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(fmt.Sprintf(`{"msg": "failed to decode sampling policy, %s"}`, err.Error())))
+				return
+			}
+			if err := SetSamplingPolicy(policy); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set sampling policy, %s"}`, err.Error())))
+				return
+			}
+		case http.MethodDelete:
+			ResetSamplingPolicy()
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "method %s is not allowed"}`, req.Method)))
+			return
+		}
+
+		bytes, err := json.Marshal(GetSamplingPolicy())
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get sampling policy, %s"}`, err.Error())))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(bytes)
+	})
+}
@@ -0,0 +1,111 @@
+package tracer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func startSpan(ctx context.Context, name string) trace.Span {
+	_, sp := otel.Tracer("test").Start(ctx, name)
+	sp.End()
+	return sp
+}
+
+func TestPolicySampler(t *testing.T) {
+	SetTracerProvider(tracetest.NewInMemoryExporter(), 0)
+	defer ResetSamplingPolicy()
+
+	t.Run("default rate", func(t *testing.T) {
+		assert.False(t, startSpan(context.Background(), "milvus.proto.milvus.MilvusService/Search").SpanContext().IsSampled())
+
+		require.NoError(t, SetSamplingPolicy(&SamplingPolicy{Rate: 1}))
+		assert.True(t, startSpan(context.Background(), "milvus.proto.milvus.MilvusService/Search").SpanContext().IsSampled())
+	})
+
+	t.Run("method rate", func(t *testing.T) {
+		require.NoError(t, SetSamplingPolicy(&SamplingPolicy{Rate: 0, MethodRates: map[string]float64{"Search": 1}}))
+		assert.True(t, startSpan(context.Background(), "milvus.proto.milvus.MilvusService/Search").SpanContext().IsSampled())
+		assert.False(t, startSpan(context.Background(), "milvus.proto.milvus.MilvusService/Query").SpanContext().IsSampled())
+	})
+
+	t.Run("follow parent", func(t *testing.T) {
+		require.NoError(t, SetSamplingPolicy(&SamplingPolicy{Rate: 1}))
+		ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+		defer parent.End()
+		require.NoError(t, SetSamplingPolicy(&SamplingPolicy{Rate: 0}))
+		assert.True(t, startSpan(ctx, "child").SpanContext().IsSampled())
+
+		ctx, parent = otel.Tracer("test").Start(context.Background(), "parent")
+		defer parent.End()
+		require.NoError(t, SetSamplingPolicy(&SamplingPolicy{Rate: 1}))
+		assert.False(t, startSpan(ctx, "child").SpanContext().IsSampled())
+	})
+
+	t.Run("force sample", func(t *testing.T) {
+		require.NoError(t, SetSamplingPolicy(&SamplingPolicy{Rate: 0, ForceCollections: []string{"coll"}, ForceUsers: []string{"alice"}}))
+		assert.True(t, HasForceSample())
+		assert.True(t, ShouldForceSample("coll", ""))
+		assert.True(t, ShouldForceSample("other", "alice"))
+		assert.False(t, ShouldForceSample("other", "bob"))
+		assert.True(t, startSpan(WithForceSample(context.Background()), "Search").SpanContext().IsSampled())
+
+		ResetSamplingPolicy()
+		assert.False(t, HasForceSample())
+	})
+
+	t.Run("invalid rate", func(t *testing.T) {
+		assert.Error(t, SetSamplingPolicy(&SamplingPolicy{Rate: 2}))
+		assert.Error(t, SetSamplingPolicy(&SamplingPolicy{MethodRates: map[string]float64{"Search": -1}}))
+	})
+}
+
+func TestSamplingPolicyHandler(t *testing.T) {
+	SetTracerProvider(tracetest.NewInMemoryExporter(), 0.1)
+	defer ResetSamplingPolicy()
+	handler := SamplingPolicyHandler()
+
+	serve := func(method string, body []byte) (int, *SamplingPolicy) {
+		req := httptest.NewRequest(method, "/trace/sampling", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		policy := &SamplingPolicy{}
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), policy))
+		}
+		return w.Code, policy
+	}
+
+	code, policy := serve(http.MethodGet, nil)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 0.1, policy.Rate)
+
+	body, err := json.Marshal(&SamplingPolicy{Rate: 0.5, MethodRates: map[string]float64{"Search": 1}, ForceUsers: []string{"alice"}})
+	require.NoError(t, err)
+	code, policy = serve(http.MethodPut, body)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 0.5, policy.Rate)
+	assert.Equal(t, 1.0, policy.MethodRates["Search"])
+	assert.Equal(t, []string{"alice"}, policy.ForceUsers)
+
+	code, _ = serve(http.MethodPut, []byte(`{"rate": 2}`))
+	assert.Equal(t, http.StatusBadRequest, code)
+	code, _ = serve(http.MethodPut, []byte(`invalid`))
+	assert.Equal(t, http.StatusBadRequest, code)
+	code, _ = serve(http.MethodPatch, nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+
+	code, policy = serve(http.MethodDelete, nil)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 0.1, policy.Rate)
+	assert.Empty(t, policy.ForceUsers)
+}
@@ -62,7 +62,10 @@ func CloseTracerProvider(ctx context.Context) error {
 	return nil
 }
 
+// SetTracerProvider sets the global tracer provider, the spans are sampled by the sampling policy,
+// which is initialized with the trace id ratio and could be changed at runtime by SetSamplingPolicy.
 func SetTracerProvider(exp sdk.SpanExporter, traceIDRatio float64) {
+	initSamplingPolicy(traceIDRatio)
 	tp := sdk.NewTracerProvider(
 		sdk.WithBatcher(exp),
 		sdk.WithResource(resource.NewWithAttributes(
@@ -70,9 +73,7 @@ func SetTracerProvider(exp sdk.SpanExporter, traceIDRatio float64) {
 			semconv.ServiceNameKey.String(paramtable.GetRole()),
 			attribute.Int64("NodeID", paramtable.GetNodeID()),
 		)),
-		sdk.WithSampler(sdk.ParentBased(
-			sdk.TraceIDRatioBased(traceIDRatio),
-		)),
+		sdk.WithSampler(globalSampler),
 	)
 	otel.SetTracerProvider(tp)
 }
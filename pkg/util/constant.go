@@ -28,6 +28,9 @@ const (
 	MetaStoreTypeEtcd = "etcd"
 	MetaStoreTypeTiKV = "tikv"
 
+	RegionReplicaRolePrimary   = "primary"
+	RegionReplicaRoleSecondary = "secondary"
+
 	SegmentMetaPrefix    = "queryCoord-segmentMeta"
 	ChangeInfoMetaPrefix = "queryCoord-sealedSegmentChangeInfo"
 
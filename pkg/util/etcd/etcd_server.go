@@ -1,11 +1,14 @@
 package etcd
 
 import (
+	"os"
 	"sync"
 
+	"github.com/cockroachdb/errors"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.etcd.io/etcd/server/v3/embed"
 	"go.etcd.io/etcd/server/v3/etcdserver/api/v3client"
+	"go.etcd.io/etcd/server/v3/etcdserver/api/v3compactor"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/log"
@@ -18,6 +21,21 @@ var (
 	etcdServer *embed.Etcd
 )
 
+// EmbedOption is the option to configure the embedded etcd server.
+type EmbedOption func(cfg *embed.Config)
+
+// WithAutoCompactionRetention enables periodic auto compaction of the embedded etcd with the given retention,
+// e.g. "1h". It does not override the retention set in the etcd config file.
+func WithAutoCompactionRetention(retention string) EmbedOption {
+	return func(cfg *embed.Config) {
+		if retention == "" || (cfg.AutoCompactionRetention != "" && cfg.AutoCompactionRetention != "0") {
+			return
+		}
+		cfg.AutoCompactionMode = v3compactor.ModePeriodic
+		cfg.AutoCompactionRetention = retention
+	}
+}
+
 // GetEmbedEtcdClient returns client of embed etcd server
 func GetEmbedEtcdClient() (*clientv3.Client, error) {
 	client := v3client.New(etcdServer.Server)
@@ -31,6 +49,7 @@ func InitEtcdServer(
 	dataDir string,
 	logPath string,
 	logLevel string,
+	opts ...EmbedOption,
 ) error {
 	if useEmbedEtcd {
 		var initError error
@@ -41,21 +60,33 @@ func InitEtcdServer(
 				cfgFromFile, err := embed.ConfigFromFile(path)
 				if err != nil {
 					initError = err
+					return
 				}
 				cfg = cfgFromFile
 			} else {
 				cfg = embed.NewConfig()
 			}
+			if err := os.MkdirAll(dataDir, os.ModePerm); err != nil {
+				log.Error("failed to create embedded Etcd data dir", zap.String("data", dataDir), zap.Error(err))
+				initError = err
+				return
+			}
 			cfg.Dir = dataDir
 			cfg.LogOutputs = []string{logPath}
 			cfg.LogLevel = logLevel
+			for _, opt := range opts {
+				opt(cfg)
+			}
 			e, err := embed.StartEtcd(cfg)
 			if err != nil {
 				log.Error("failed to init embedded Etcd server", zap.Error(err))
 				initError = err
+				return
 			}
 			etcdServer = e
-			log.Info("finish init Etcd config", zap.String("path", path), zap.String("data", dataDir))
+			log.Info("finish init Etcd config", zap.String("path", path), zap.String("data", dataDir),
+				zap.String("autoCompactionMode", cfg.AutoCompactionMode),
+				zap.String("autoCompactionRetention", cfg.AutoCompactionRetention))
 		})
 		return initError
 	}
@@ -66,6 +97,36 @@ func HasServer() bool {
 	return etcdServer != nil
 }
 
+// GetEmbedEtcdDBSize returns the allocated size and the in use size of the embedded etcd backend db in bytes.
+func GetEmbedEtcdDBSize() (int64, int64, error) {
+	if etcdServer == nil {
+		return 0, 0, errors.New("embedded etcd server not started")
+	}
+	be := etcdServer.Server.Backend()
+	return be.Size(), be.SizeInUse(), nil
+}
+
+// DefragEmbedEtcd defragments the backend db of the embedded etcd if the ratio of the free space
+// exceeds the threshold, it returns whether the defragmentation happened.
+// Defragmentation blocks all reads and writes of etcd, so it shall not be triggered frequently.
+func DefragEmbedEtcd(threshold float64) (bool, error) {
+	size, inUse, err := GetEmbedEtcdDBSize()
+	if err != nil {
+		return false, err
+	}
+	if size <= 0 || float64(size-inUse)/float64(size) < threshold {
+		return false, nil
+	}
+	log.Info("start to defragment embedded Etcd", zap.Int64("dbSize", size), zap.Int64("dbSizeInUse", inUse))
+	if err := etcdServer.Server.Backend().Defrag(); err != nil {
+		log.Warn("failed to defragment embedded Etcd", zap.Error(err))
+		return false, err
+	}
+	newSize, newInUse, _ := GetEmbedEtcdDBSize()
+	log.Info("defragment embedded Etcd done", zap.Int64("dbSize", newSize), zap.Int64("dbSizeInUse", newInUse))
+	return true, nil
+}
+
 // StopEtcdServer stops embedded etcd server singleton.
 func StopEtcdServer() {
 	if etcdServer != nil {
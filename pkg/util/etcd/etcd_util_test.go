@@ -42,6 +42,18 @@ func TestEtcd(t *testing.T) {
 	assert.False(t, resp.Count < 1)
 	assert.Equal(t, string(resp.Kvs[0].Value), "value")
 
+	size, inUse, err := GetEmbedEtcdDBSize()
+	assert.NoError(t, err)
+	assert.True(t, size > 0)
+	assert.True(t, inUse <= size)
+
+	defraged, err := DefragEmbedEtcd(1.1)
+	assert.NoError(t, err)
+	assert.False(t, defraged)
+	defraged, err = DefragEmbedEtcd(0)
+	assert.NoError(t, err)
+	assert.True(t, defraged)
+
 	_, err = GetEtcdClient(false, true, []string{},
 		"../../../configs/cert/client.pem",
 		"../../../configs/cert/client.key",
@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package hardware
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+
+	"golang.org/x/sys/cpu"
+)
+
+var (
+	cpuFeaturesOnce sync.Once
+	cpuFeatures     []string
+)
+
+// GetCPUFeatures returns the architecture and the SIMD instruction sets supported by the CPU,
+// like amd64, avx2 and avx512f on x86 or arm64, neon and sve on arm.
+func GetCPUFeatures() []string {
+	cpuFeaturesOnce.Do(func() {
+		cpuFeatures = detectCPUFeatures()
+	})
+	return cpuFeatures
+}
+
+type cpuFeature struct {
+	name      string
+	supported bool
+}
+
+func detectCPUFeatures() []string {
+	features := []string{runtime.GOARCH}
+	var candidates []cpuFeature
+	switch runtime.GOARCH {
+	case "amd64", "386":
+		candidates = []cpuFeature{
+			{"sse4_2", cpu.X86.HasSSE42},
+			{"avx", cpu.X86.HasAVX},
+			{"avx2", cpu.X86.HasAVX2},
+			{"avx512f", cpu.X86.HasAVX512F},
+			{"avx512dq", cpu.X86.HasAVX512DQ},
+			{"avx512bw", cpu.X86.HasAVX512BW},
+			{"avx512vl", cpu.X86.HasAVX512VL},
+		}
+	case "arm64":
+		candidates = []cpuFeature{
+			{"neon", cpu.ARM64.HasASIMD},
+			{"sve", cpu.ARM64.HasSVE},
+		}
+	}
+	for _, candidate := range candidates {
+		if candidate.supported {
+			features = append(features, candidate.name)
+		}
+	}
+	return features
+}
+
+// GetGatedCPUFeatures returns the CPU features of the node among the gated ones,
+// the index built on the node requires them to be loaded.
+func GetGatedCPUFeatures(gated []string) []string {
+	gatedSet := make(map[string]struct{}, len(gated))
+	for _, feature := range gated {
+		if feature = strings.ToLower(strings.TrimSpace(feature)); feature != "" {
+			gatedSet[feature] = struct{}{}
+		}
+	}
+	features := make([]string, 0)
+	for _, feature := range GetCPUFeatures() {
+		if _, ok := gatedSet[feature]; ok {
+			features = append(features, feature)
+		}
+	}
+	return features
+}
+
+// MissingCPUFeatures returns the required CPU features not in the supported ones.
+func MissingCPUFeatures(required, supported []string) []string {
+	supportedSet := make(map[string]struct{}, len(supported))
+	for _, feature := range supported {
+		supportedSet[feature] = struct{}{}
+	}
+	var missing []string
+	for _, feature := range required {
+		if _, ok := supportedSet[feature]; !ok {
+			missing = append(missing, feature)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package hardware
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetCPUFeatures(t *testing.T) {
+	features := GetCPUFeatures()
+	assert.Contains(t, features, runtime.GOARCH)
+
+	assert.Empty(t, GetGatedCPUFeatures(nil))
+	assert.Empty(t, GetGatedCPUFeatures([]string{""}))
+	assert.Equal(t, []string{runtime.GOARCH}, GetGatedCPUFeatures([]string{" " + runtime.GOARCH, "unknown"}))
+}
+
+func Test_MissingCPUFeatures(t *testing.T) {
+	assert.Empty(t, MissingCPUFeatures(nil, []string{"amd64"}))
+	assert.Empty(t, MissingCPUFeatures([]string{"avx2"}, []string{"amd64", "avx2", "avx512f"}))
+	assert.Equal(t, []string{"avx512f"}, MissingCPUFeatures([]string{"avx2", "avx512f"}, []string{"amd64", "avx2"}))
+	assert.Equal(t, []string{"sve"}, MissingCPUFeatures([]string{"sve"}, nil))
+}
@@ -0,0 +1,137 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexparams
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const (
+	BuildProfileFast      = "fast"
+	BuildProfileEconomy   = "economy"
+	BuildProfileLowMemory = "low-memory"
+
+	// intermediateCompressionRatio is the ratio of the pq code budget of the profile compressing the intermediate data
+	intermediateCompressionRatio = 0.5
+)
+
+// BuildProfile is the preset of the executor settings on indexNode to build an index,
+// specified by the build_profile index param.
+type BuildProfile struct {
+	Name string
+	// BuildThreadRatio is the ratio of the cpu cores to build the index with
+	BuildThreadRatio float64
+	// MemoryBudgetRatio is the ratio of the free memory to build the disk index with
+	MemoryBudgetRatio float64
+	// CompressIntermediate compresses the intermediate vectors of the disk index build harder,
+	// which reduces the memory usage at the cost of the build quality
+	CompressIntermediate bool
+	// Slots is the number of the worker slots taken by the build
+	Slots int64
+}
+
+var buildProfiles = map[string]*BuildProfile{
+	BuildProfileFast: {
+		Name:              BuildProfileFast,
+		BuildThreadRatio:  1.0,
+		MemoryBudgetRatio: 1.0,
+		Slots:             2,
+	},
+	BuildProfileEconomy: {
+		Name:              BuildProfileEconomy,
+		BuildThreadRatio:  0.25,
+		MemoryBudgetRatio: 0.5,
+		Slots:             1,
+	},
+	BuildProfileLowMemory: {
+		Name:                 BuildProfileLowMemory,
+		BuildThreadRatio:     0.5,
+		MemoryBudgetRatio:    0.25,
+		CompressIntermediate: true,
+		Slots:                1,
+	},
+}
+
+func init() {
+	configableIndexParams.Insert(common.IndexBuildProfileKey)
+}
+
+// GetBuildProfile returns the build profile by name.
+func GetBuildProfile(name string) (*BuildProfile, error) {
+	profile, ok := buildProfiles[name]
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s, expected: %s, %s, %s", common.IndexBuildProfileKey, name,
+			BuildProfileFast, BuildProfileEconomy, BuildProfileLowMemory)
+	}
+	return profile, nil
+}
+
+// GetIndexBuildProfile returns the build profile specified in the index params, nil if not specified.
+func GetIndexBuildProfile(indexParams []*commonpb.KeyValuePair) (*BuildProfile, error) {
+	for _, param := range indexParams {
+		if param.GetKey() == common.IndexBuildProfileKey {
+			return GetBuildProfile(param.GetValue())
+		}
+	}
+	return nil, nil
+}
+
+// GetBuildSlots returns the number of the worker slots taken by the build with the profile,
+// the build without profile takes one slot.
+func GetBuildSlots(profile *BuildProfile) int64 {
+	if profile == nil {
+		return 1
+	}
+	return profile.Slots
+}
+
+// SetBuildProfileParams overrides the build params with the build profile on indexNode,
+// the memory budget and the intermediate compression only take effect on the disk index,
+// so the disk index build params must be set before.
+func SetBuildProfileParams(indexParams map[string]string, profile *BuildProfile) error {
+	threadNum := int(float64(hardware.GetCPUNum()) * profile.BuildThreadRatio)
+	if threadNum < 1 {
+		threadNum = 1
+	}
+	indexParams[NumBuildThreadKey] = strconv.Itoa(threadNum)
+
+	scale := func(key string, ratio float64) error {
+		value, ok := indexParams[key]
+		if !ok {
+			return nil
+		}
+		budget, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid index param %s: %s, %w", key, value, err)
+		}
+		indexParams[key] = fmt.Sprintf("%f", budget*ratio)
+		return nil
+	}
+	if err := scale(BuildDramBudgetKey, profile.MemoryBudgetRatio); err != nil {
+		return err
+	}
+	if profile.CompressIntermediate {
+		return scale(PQCodeBudgetKey, intermediateCompressionRatio)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexparams
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestBuildProfile(t *testing.T) {
+	t.Run("get build profile", func(t *testing.T) {
+		profile, err := GetIndexBuildProfile([]*commonpb.KeyValuePair{
+			{Key: common.IndexTypeKey, Value: "HNSW"},
+			{Key: common.IndexBuildProfileKey, Value: BuildProfileFast},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, BuildProfileFast, profile.Name)
+		assert.EqualValues(t, 2, GetBuildSlots(profile))
+
+		profile, err = GetIndexBuildProfile([]*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}})
+		assert.NoError(t, err)
+		assert.Nil(t, profile)
+		assert.EqualValues(t, 1, GetBuildSlots(profile))
+
+		_, err = GetIndexBuildProfile([]*commonpb.KeyValuePair{{Key: common.IndexBuildProfileKey, Value: "turbo"}})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		assert.True(t, IsConfigableIndexParam(common.IndexBuildProfileKey))
+	})
+
+	t.Run("set build profile params", func(t *testing.T) {
+		profile, err := GetBuildProfile(BuildProfileEconomy)
+		assert.NoError(t, err)
+		indexParams := map[string]string{common.IndexTypeKey: "HNSW"}
+		assert.NoError(t, SetBuildProfileParams(indexParams, profile))
+		threadNum, err := strconv.Atoi(indexParams[NumBuildThreadKey])
+		assert.NoError(t, err)
+		assert.Equal(t, max(1, hardware.GetCPUNum()/4), threadNum)
+		assert.NotContains(t, indexParams, BuildDramBudgetKey)
+		assert.NotContains(t, indexParams, PQCodeBudgetKey)
+	})
+
+	t.Run("set disk index build profile params", func(t *testing.T) {
+		profile, err := GetBuildProfile(BuildProfileLowMemory)
+		assert.NoError(t, err)
+		indexParams := map[string]string{
+			common.IndexTypeKey: "DISKANN",
+			BuildDramBudgetKey:  "8.000000",
+			PQCodeBudgetKey:     "1.000000",
+		}
+		assert.NoError(t, SetBuildProfileParams(indexParams, profile))
+		assert.Equal(t, "2.000000", indexParams[BuildDramBudgetKey])
+		assert.Equal(t, "0.500000", indexParams[PQCodeBudgetKey])
+
+		profile, err = GetBuildProfile(BuildProfileFast)
+		assert.NoError(t, err)
+		indexParams = map[string]string{
+			BuildDramBudgetKey: "8.000000",
+			PQCodeBudgetKey:    "1.000000",
+		}
+		assert.NoError(t, SetBuildProfileParams(indexParams, profile))
+		assert.Equal(t, strconv.Itoa(hardware.GetCPUNum()), indexParams[NumBuildThreadKey])
+		assert.Equal(t, "8.000000", indexParams[BuildDramBudgetKey])
+		assert.Equal(t, "1.000000", indexParams[PQCodeBudgetKey])
+
+		indexParams = map[string]string{BuildDramBudgetKey: "invalid"}
+		assert.Error(t, SetBuildProfileParams(indexParams, profile))
+	})
+}
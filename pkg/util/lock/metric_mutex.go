@@ -20,6 +20,8 @@ type MetricsRWMutex struct {
 	mutex          sync.RWMutex
 	lockName       string
 	acquireTimeMap map[string]time.Time
+	// enabled caches common.locks.metrics.enable, which is not refreshable
+	enabled bool
 }
 
 const (
@@ -27,10 +29,13 @@ const (
 	writeLock = "WRITE_LOCK"
 	hold      = "HOLD"
 	acquire   = "ACQUIRE"
+
+	// anySource is the source of the locks whose callers are not tracked
+	anySource = "ANY"
 )
 
 func (mRWLock *MetricsRWMutex) RLock(source string) {
-	if paramtable.Get().CommonCfg.EnableLockMetrics.GetAsBool() {
+	if mRWLock.enabled {
 		before := time.Now()
 		mRWLock.mutex.RLock()
 		mRWLock.acquireTimeMap[source] = time.Now()
@@ -41,7 +46,7 @@ func (mRWLock *MetricsRWMutex) RLock(source string) {
 }
 
 func (mRWLock *MetricsRWMutex) Lock(source string) {
-	if paramtable.Get().CommonCfg.EnableLockMetrics.GetAsBool() {
+	if mRWLock.enabled {
 		before := time.Now()
 		mRWLock.mutex.Lock()
 		mRWLock.acquireTimeMap[source] = time.Now()
@@ -66,7 +71,7 @@ func (mRWLock *MetricsRWMutex) RUnLock(source string) {
 }
 
 func (mRWLock *MetricsRWMutex) maybeLogUnlockDuration(source string, lockType string) error {
-	if paramtable.Get().CommonCfg.EnableLockMetrics.GetAsBool() {
+	if mRWLock.enabled {
 		acquireTime, ok := mRWLock.acquireTimeMap[source]
 		if ok {
 			logLock(time.Since(acquireTime), mRWLock.lockName, source, lockType, hold)
@@ -91,6 +96,54 @@ func logLock(duration time.Duration, lockName string, source string, lockType st
 			zap.Duration("time_cost", duration))
 	}
 	metrics.LockCosts.WithLabelValues(lockName, source, lockType, opType).Set(float64(duration.Milliseconds()))
+	if opType == acquire {
+		metrics.LockWaitDuration.WithLabelValues(lockName, lockType).Observe(float64(duration.Nanoseconds()) / float64(time.Millisecond))
+	}
+}
+
+// NamedRWMutex is a metrics lock for the hot structures whose callers don't pass the lock sources,
+// it's a drop-in replacement of RWMutex reporting the durations waiting to acquire the lock under its name
+// if the lock metrics are enabled. The zero value is a RWMutex without the metrics.
+type NamedRWMutex struct {
+	mutex    RWMutex
+	lockName string
+	enabled  bool
+}
+
+// NewNamedRWMutex returns a NamedRWMutex reporting the metrics with the name.
+func NewNamedRWMutex(name string) NamedRWMutex {
+	return NamedRWMutex{
+		lockName: name,
+		enabled:  paramtable.Get().CommonCfg.EnableLockMetrics.GetAsBool(),
+	}
+}
+
+func (m *NamedRWMutex) Lock() {
+	if !m.enabled {
+		m.mutex.Lock()
+		return
+	}
+	before := time.Now()
+	m.mutex.Lock()
+	logLock(time.Since(before), m.lockName, anySource, writeLock, acquire)
+}
+
+func (m *NamedRWMutex) Unlock() {
+	m.mutex.Unlock()
+}
+
+func (m *NamedRWMutex) RLock() {
+	if !m.enabled {
+		m.mutex.RLock()
+		return
+	}
+	before := time.Now()
+	m.mutex.RLock()
+	logLock(time.Since(before), m.lockName, anySource, readLock, acquire)
+}
+
+func (m *NamedRWMutex) RUnlock() {
+	m.mutex.RUnlock()
 }
 
 // currently, we keep metricsLockManager as a communal gate for metrics lock
@@ -99,5 +152,6 @@ func (mlManager *MetricsLockManager) applyRWLock(name string) *MetricsRWMutex {
 	return &MetricsRWMutex{
 		lockName:       name,
 		acquireTimeMap: make(map[string]time.Time, 0),
+		enabled:        paramtable.Get().CommonCfg.EnableLockMetrics.GetAsBool(),
 	}
 }
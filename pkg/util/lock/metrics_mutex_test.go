@@ -5,8 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -70,3 +72,41 @@ func TestMetricsLockRLock(t *testing.T) {
 	wg.Wait()
 	assert.Equal(t, 0, len(testRWLock.acquireTimeMap))
 }
+
+func TestNamedRWMutex(t *testing.T) {
+	params := paramtable.Get()
+	params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	params.Save(params.CommonCfg.EnableLockMetrics.Key, "true")
+	defer params.Reset(params.CommonCfg.EnableLockMetrics.Key)
+
+	metrics.LockWaitDuration.Reset()
+	mu := NewNamedRWMutex("testNamedLock")
+	mu.Lock()
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			defer wg.Done()
+			mu.RLock()
+			defer mu.RUnlock()
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	mu.Unlock()
+	wg.Wait()
+	mu.Lock()
+	mu.Unlock()
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.LockWaitDuration, "milvus_lock_wait_duration"))
+
+	// the flag is cached when the lock is created, the zero value records nothing
+	params.Save(params.CommonCfg.EnableLockMetrics.Key, "false")
+	mu.Lock()
+	mu.Unlock()
+	assert.True(t, mu.enabled)
+	var unnamed NamedRWMutex
+	unnamed.Lock()
+	unnamed.Unlock()
+	unnamed.RLock()
+	unnamed.RUnlock()
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.LockWaitDuration, "milvus_lock_wait_duration"))
+}
@@ -942,6 +942,13 @@ func WrapErrParameterTooLarge(name string, msg ...string) error {
 	return err
 }
 
+// WrapErrSearchResultEntriesTooLarge wraps ErrParameterTooLarge for the search results carrying more entries
+// than quotaAndLimits.limits.maxSearchResultEntries allows.
+func WrapErrSearchResultEntriesTooLarge(entries, maxEntries int64) error {
+	return WrapErrParameterTooLarge("search result",
+		fmt.Sprintf("search results have %d entries to reduce, exceed the maxSearchResultEntries limit %d, please reduce nq or topk", entries, maxEntries))
+}
+
 // Metrics related
 func WrapErrMetricNotFound(name string, msg ...string) error {
 	err := wrapFields(ErrMetricNotFound, value("metric", name))
@@ -47,6 +47,12 @@ func GetSegmentIDFromInsertLogPath(logPath string) typeutil.UniqueID {
 	return getSegmentIDFromPath(logPath, 3)
 }
 
+// BuildQuantizedLogPath returns the path of the quantized vector codes of the insert binlog with the same log id.
+func BuildQuantizedLogPath(rootPath string, collectionID, partitionID, segmentID, fieldID, logID typeutil.UniqueID) string {
+	k := JoinIDPath(collectionID, partitionID, segmentID, fieldID, logID)
+	return path.Join(rootPath, common.SegmentQuantizedLogPath, k)
+}
+
 func BuildStatsLogPath(rootPath string, collectionID, partitionID, segmentID, fieldID, logID typeutil.UniqueID) string {
 	k := JoinIDPath(collectionID, partitionID, segmentID, fieldID, logID)
 	return path.Join(rootPath, common.SegmentStatslogPath, k)
@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"bytes"
+	"runtime/pprof"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+// WorkerDiagnostics records the snapshot of the worker captured for debugging the slow tasks.
+type WorkerDiagnostics struct {
+	NodeID int64 `json:"node_id"`
+	// Goroutines is the goroutine profile in text
+	Goroutines string `json:"goroutines"`
+	// HeapProfile is the heap profile in the gzipped pprof format
+	HeapProfile []byte `json:"heap_profile"`
+	// StorageMetrics is the object storage op metrics in the prometheus text format
+	StorageMetrics string `json:"storage_metrics"`
+}
+
+// CaptureWorkerDiagnostics captures the goroutine and heap profiles and the storage op metrics of the process.
+func CaptureWorkerDiagnostics(nodeID int64) (*WorkerDiagnostics, error) {
+	goroutines := &bytes.Buffer{}
+	if err := pprof.Lookup("goroutine").WriteTo(goroutines, 1); err != nil {
+		return nil, err
+	}
+	heap := &bytes.Buffer{}
+	if err := pprof.Lookup("heap").WriteTo(heap, 0); err != nil {
+		return nil, err
+	}
+
+	registry := prometheus.NewRegistry()
+	metrics.RegisterStorageMetrics(registry)
+	families, err := registry.Gather()
+	if err != nil {
+		return nil, err
+	}
+	storageMetrics := &bytes.Buffer{}
+	encoder := expfmt.NewEncoder(storageMetrics, expfmt.FmtText)
+	for _, family := range families {
+		if err := encoder.Encode(family); err != nil {
+			return nil, err
+		}
+	}
+
+	return &WorkerDiagnostics{
+		NodeID:         nodeID,
+		Goroutines:     goroutines.String(),
+		HeapProfile:    heap.Bytes(),
+		StorageMetrics: storageMetrics.String(),
+	}, nil
+}
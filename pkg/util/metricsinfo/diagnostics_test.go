@@ -0,0 +1,35 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+)
+
+func TestCaptureWorkerDiagnostics(t *testing.T) {
+	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataRemoveLabel, metrics.TotalLabel).Inc()
+
+	diagnostics, err := CaptureWorkerDiagnostics(1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), diagnostics.NodeID)
+	assert.Contains(t, diagnostics.Goroutines, "TestCaptureWorkerDiagnostics")
+	assert.NotEmpty(t, diagnostics.HeapProfile)
+	assert.Contains(t, diagnostics.StorageMetrics, "milvus_storage_op_count")
+
+	// captured repeatedly
+	_, err = CaptureWorkerDiagnostics(1)
+	assert.NoError(t, err)
+}
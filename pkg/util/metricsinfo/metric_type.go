@@ -14,6 +14,7 @@ package metricsinfo
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -30,6 +31,15 @@ const (
 
 	// CollectionStorageMetrics means users request for collection storage metrics.
 	CollectionStorageMetrics = "collection_storage"
+
+	// VectorStatsMetrics means users request for vector statistics of a collection.
+	VectorStatsMetrics = "vector_stats"
+
+	// DiagnosticsMetrics means the coordinator requests for the diagnostics of the worker running a slow task.
+	DiagnosticsMetrics = "diagnostics"
+
+	// CollectionIDKey is the key of collection id in GetMetrics request.
+	CollectionIDKey = "collection_id"
 )
 
 // ParseMetricType returns the metric type of req
@@ -62,3 +72,36 @@ func ConstructRequestByMetricType(metricType string) (*milvuspb.GetMetricsReques
 		Request: string(binary),
 	}, nil
 }
+
+// ConstructVectorStatsRequest constructs a request of vector statistics of the collection.
+func ConstructVectorStatsRequest(collectionID int64) (*milvuspb.GetMetricsRequest, error) {
+	m := map[string]interface{}{
+		MetricTypeKey:   VectorStatsMetrics,
+		CollectionIDKey: collectionID,
+	}
+	binary, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct vector stats request: %s", err.Error())
+	}
+	return &milvuspb.GetMetricsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_SystemInfo),
+		),
+		Request: string(binary),
+	}, nil
+}
+
+// ParseCollectionID returns the collection id of req.
+func ParseCollectionID(req string) (int64, error) {
+	m := make(map[string]interface{})
+	decoder := json.NewDecoder(strings.NewReader(req))
+	decoder.UseNumber()
+	if err := decoder.Decode(&m); err != nil {
+		return 0, fmt.Errorf("failed to decode the request: %s", err.Error())
+	}
+	value, ok := m[CollectionIDKey].(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("%s not found in request", CollectionIDKey)
+	}
+	return value.Int64()
+}
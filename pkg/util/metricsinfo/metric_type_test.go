@@ -77,3 +77,22 @@ func Test_ConstructRequestByMetricType(t *testing.T) {
 		}
 	}
 }
+
+func Test_ConstructVectorStatsRequest(t *testing.T) {
+	req, err := ConstructVectorStatsRequest(449372178523563137)
+	assert.NoError(t, err)
+
+	metricType, err := ParseMetricType(req.GetRequest())
+	assert.NoError(t, err)
+	assert.Equal(t, VectorStatsMetrics, metricType)
+	collectionID, err := ParseCollectionID(req.GetRequest())
+	assert.NoError(t, err)
+	assert.EqualValues(t, 449372178523563137, collectionID)
+
+	_, err = ParseCollectionID("not in json format")
+	assert.Error(t, err)
+	_, err = ParseCollectionID(`{"metric_type": "vector_stats"}`)
+	assert.Error(t, err)
+	_, err = ParseCollectionID(`{"collection_id": "abc"}`)
+	assert.Error(t, err)
+}
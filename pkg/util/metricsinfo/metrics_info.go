@@ -187,12 +187,43 @@ type DataCoordCollectionMetrics struct {
 	Collections map[int64]*DataCoordCollectionInfo
 }
 
+// ChannelWriteInfo is the write rate of a vchannel reported by the datanodes.
+type ChannelWriteInfo struct {
+	Name          string  `json:"name"`
+	RowsPerSecond float64 `json:"rows_per_second"`
+	Hot           bool    `json:"hot"`
+}
+
+type DataCoordChannelWriteMetrics struct {
+	Channels []*ChannelWriteInfo `json:"channels"`
+}
+
+// VectorFieldStatsInfo is the aggregated statistics of a vector field in the flushed segments of a collection.
+type VectorFieldStatsInfo struct {
+	FieldID int64 `json:"field_id"`
+	// number of segments with statistics, the segments flushed without statistics are not included
+	SegmentNum int64   `json:"segment_num"`
+	RowNum     int64   `json:"row_num"`
+	ZeroNum    int64   `json:"zero_num"`
+	InvalidNum int64   `json:"invalid_num"`
+	MinNorm    float64 `json:"min_norm"`
+	MaxNorm    float64 `json:"max_norm"`
+	MeanNorm   float64 `json:"mean_norm"`
+	StdDevNorm float64 `json:"stddev_norm"`
+}
+
+type CollectionVectorStats struct {
+	CollectionID int64                   `json:"collection_id"`
+	Fields       []*VectorFieldStatsInfo `json:"fields"`
+}
+
 // DataCoordInfos implements ComponentInfos
 type DataCoordInfos struct {
 	BaseComponentInfos
-	SystemConfigurations DataCoordConfiguration      `json:"system_configurations"`
-	QuotaMetrics         *DataCoordQuotaMetrics      `json:"quota_metrics"`
-	CollectionMetrics    *DataCoordCollectionMetrics `json:"collection_metrics"`
+	SystemConfigurations DataCoordConfiguration        `json:"system_configurations"`
+	QuotaMetrics         *DataCoordQuotaMetrics        `json:"quota_metrics"`
+	CollectionMetrics    *DataCoordCollectionMetrics   `json:"collection_metrics"`
+	ChannelWriteMetrics  *DataCoordChannelWriteMetrics `json:"channel_write_metrics"`
 }
 
 // RootCoordConfiguration records the configuration of RootCoord.
@@ -19,6 +19,7 @@ package paramtable
 import (
 	"fmt"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 	"sync"
@@ -230,6 +231,7 @@ type commonConfig struct {
 	GracefulTime                        ParamItem `refreshable:"true"`
 	GracefulStopTimeout                 ParamItem `refreshable:"true"`
 	BitmapIndexCardinalityBound         ParamItem `refreshable:"false"`
+	GatedCPUFeatures                    ParamItem `refreshable:"false"`
 
 	StorageType ParamItem `refreshable:"false"`
 	SimdType    ParamItem `refreshable:"false"`
@@ -240,8 +242,20 @@ type commonConfig struct {
 
 	ClusterName ParamItem `refreshable:"false"`
 
-	SessionTTL        ParamItem `refreshable:"false"`
-	SessionRetryTimes ParamItem `refreshable:"false"`
+	SessionTTL                 ParamItem  `refreshable:"false"`
+	SessionRetryTimes          ParamItem  `refreshable:"false"`
+	SessionIdentityFile        ParamItem  `refreshable:"false"`
+	SessionIdentityGracePeriod ParamItem  `refreshable:"false"`
+	SessionComponentOverrides  ParamGroup `refreshable:"false"`
+
+	SessionFastDetectionEnabled          ParamItem `refreshable:"true"`
+	SessionFastDetectionProbeInterval    ParamItem `refreshable:"false"`
+	SessionFastDetectionProbeTimeout     ParamItem `refreshable:"true"`
+	SessionFastDetectionFailureThreshold ParamItem `refreshable:"true"`
+	SessionFastDetectionCoolDown         ParamItem `refreshable:"true"`
+	SessionFastDetectionMaxEvictRatio    ParamItem `refreshable:"true"`
+
+	WireCompatibilityEnabled ParamItem `refreshable:"true"`
 
 	PreCreatedTopicEnabled ParamItem `refreshable:"true"`
 	TopicNames             ParamItem `refreshable:"true"`
@@ -276,6 +290,8 @@ type commonConfig struct {
 	OverloadedMemoryThresholdPercentage ParamItem `refreshable:"false"`
 	MaximumGOGCConfig                   ParamItem `refreshable:"false"`
 	MinimumGOGCConfig                   ParamItem `refreshable:"false"`
+
+	RegionReplicaRole ParamItem `refreshable:"true"`
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -482,6 +498,17 @@ This configuration is only used by querynode and indexnode, it selects CPU instr
 	}
 	p.BitmapIndexCardinalityBound.Init(base.mgr)
 
+	p.GatedCPUFeatures = ParamItem{
+		Key:          "common.gatedCPUFeatures",
+		Version:      "2.4.7",
+		DefaultValue: "",
+		Doc: `The CPU features like avx512f and sve gating the placement of the index builds, separated by commas.
+The index records the gated features of the node building it, and is loaded only on the query nodes supporting them,
+or rebuilt on a compatible index node otherwise. Empty means no index is gated.`,
+		Export: true,
+	}
+	p.GatedCPUFeatures.Init(base.mgr)
+
 	p.EnableMaterializedView = ParamItem{
 		Key:          "common.materializedView.enabled",
 		Version:      "2.4.6",
@@ -663,6 +690,101 @@ like the old password verification when updating the credential`,
 	}
 	p.SessionRetryTimes.Init(base.mgr)
 
+	p.SessionIdentityFile = ParamItem{
+		Key:          "common.session.identityFile",
+		Version:      "2.4.7",
+		DefaultValue: "",
+		Doc: `file to persist the server id of the node, a node restarted within the identityGracePeriod reclaims its previous
+server id and takes over its stale session, so its assignments are kept. Empty means disable.
+The file must be on a disk that survives restarts and must not be shared by different nodes.`,
+		Export: true,
+	}
+	p.SessionIdentityFile.Init(base.mgr)
+
+	p.SessionIdentityGracePeriod = ParamItem{
+		Key:          "common.session.identityGracePeriod",
+		Version:      "2.4.7",
+		DefaultValue: "60",
+		Doc:          "grace period in seconds since the node was last alive, within which the restarted node reclaims its previous server id",
+		Export:       true,
+	}
+	p.SessionIdentityGracePeriod.Init(base.mgr)
+
+	p.SessionComponentOverrides = ParamGroup{
+		KeyPrefix: "common.session.components.",
+		Version:   "2.4.7",
+		Doc: `session ttl and retry times of specified component, which override the common ones,
+e.g. common.session.components.querynode.ttl: 15`,
+	}
+	p.SessionComponentOverrides.Init(base.mgr)
+
+	p.SessionFastDetectionEnabled = ParamItem{
+		Key:          "common.session.fastDetection.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `whether coordinators probe the health of the nodes directly besides watching their sessions,
+a node failed the probe for failureThreshold consecutive times is declared dead before its session expires`,
+		Export: true,
+	}
+	p.SessionFastDetectionEnabled.Init(base.mgr)
+
+	p.SessionFastDetectionProbeInterval = ParamItem{
+		Key:          "common.session.fastDetection.probeInterval",
+		Version:      "2.4.7",
+		DefaultValue: "5",
+		Doc:          "interval in seconds between two rounds of health probe",
+		Export:       true,
+	}
+	p.SessionFastDetectionProbeInterval.Init(base.mgr)
+
+	p.SessionFastDetectionProbeTimeout = ParamItem{
+		Key:          "common.session.fastDetection.probeTimeout",
+		Version:      "2.4.7",
+		DefaultValue: "3",
+		Doc:          "timeout in seconds of a single health probe",
+		Export:       true,
+	}
+	p.SessionFastDetectionProbeTimeout.Init(base.mgr)
+
+	p.SessionFastDetectionFailureThreshold = ParamItem{
+		Key:          "common.session.fastDetection.failureThreshold",
+		Version:      "2.4.7",
+		DefaultValue: "3",
+		Doc:          "consecutive failed probes before a node is declared dead",
+		Export:       true,
+	}
+	p.SessionFastDetectionFailureThreshold.Init(base.mgr)
+
+	p.SessionFastDetectionCoolDown = ParamItem{
+		Key:          "common.session.fastDetection.coolDown",
+		Version:      "2.4.7",
+		DefaultValue: "300",
+		Doc:          "a node declared dead is left to its session expiry if it has been declared dead within the cool down seconds, to avoid flapping",
+		Export:       true,
+	}
+	p.SessionFastDetectionCoolDown.Init(base.mgr)
+
+	p.SessionFastDetectionMaxEvictRatio = ParamItem{
+		Key:          "common.session.fastDetection.maxEvictRatio",
+		Version:      "2.4.7",
+		DefaultValue: "0.3",
+		Doc: `max ratio of the nodes declared dead in one round, at least one node is allowed.
+If more nodes fail the probe at the same time, the coordinator itself is likely partitioned, the nodes are left to their session expiry`,
+		Export: true,
+	}
+	p.SessionFastDetectionMaxEvictRatio.Init(base.mgr)
+
+	p.WireCompatibilityEnabled = ParamItem{
+		Key:          "common.wireCompatibility.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `whether the components emit the internal requests compatible with the components of the previous minor version,
+e.g. fall back to the legacy rpcs not served by them. Enable it during the rolling upgrade across minor versions,
+the requests of the previous version are always accepted`,
+		Export: true,
+	}
+	p.WireCompatibilityEnabled.Init(base.mgr)
+
 	p.PreCreatedTopicEnabled = ParamItem{
 		Key:          "common.preCreatedTopic.enabled",
 		Version:      "2.3.0",
@@ -873,6 +995,17 @@ like the old password verification when updating the credential`,
 		DefaultValue: "30",
 	}
 	p.MinimumGOGCConfig.Init(base.mgr)
+
+	p.RegionReplicaRole = ParamItem{
+		Key:          "common.regionReplica.role",
+		Version:      "2.4.7",
+		DefaultValue: "primary",
+		Doc: `primary or secondary, the secondary region serves the read-only replicas of the collections of the same names
+shipped from the primary region by DataCoord, the writes are denied by the proxies.
+The failover switch turns the secondary region into primary by this config in etcd, a restart is required to turn a primary into secondary`,
+		Export: true,
+	}
+	p.RegionReplicaRole.Init(base.mgr)
 }
 
 type gpuConfig struct {
@@ -1065,6 +1198,11 @@ type rootCoordConfig struct {
 	MaxDatabaseNum              ParamItem `refreshable:"false"`
 	MaxGeneralCapacity          ParamItem `refreshable:"true"`
 	GracefulStopTimeout         ParamItem `refreshable:"true"`
+
+	TimeWindowPartitionCheckInterval ParamItem `refreshable:"false"`
+	TimeWindowPartitionPrecreateNum  ParamItem `refreshable:"true"`
+
+	TemporaryCollectionCheckInterval ParamItem `refreshable:"false"`
 }
 
 func (p *rootCoordConfig) init(base *BaseTable) {
@@ -1136,6 +1274,33 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
+
+	p.TimeWindowPartitionCheckInterval = ParamItem{
+		Key:          "rootCoord.timeWindowPartition.checkInterval",
+		Version:      "2.4.7",
+		DefaultValue: "60",
+		Doc:          "interval in seconds to create and drop the time-windowed partitions of collections",
+		Export:       true,
+	}
+	p.TimeWindowPartitionCheckInterval.Init(base.mgr)
+
+	p.TimeWindowPartitionPrecreateNum = ParamItem{
+		Key:          "rootCoord.timeWindowPartition.precreateNum",
+		Version:      "2.4.7",
+		DefaultValue: "1",
+		Doc:          "number of the upcoming windows to create partitions for in advance, besides the current window",
+		Export:       true,
+	}
+	p.TimeWindowPartitionPrecreateNum.Init(base.mgr)
+
+	p.TemporaryCollectionCheckInterval = ParamItem{
+		Key:          "rootCoord.temporaryCollection.checkInterval",
+		Version:      "2.4.7",
+		DefaultValue: "60",
+		Doc:          "interval in seconds to drop the expired temporary collections",
+		Export:       true,
+	}
+	p.TemporaryCollectionCheckInterval.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1184,6 +1349,13 @@ type proxyConfig struct {
 	RetryTimesOnHealthCheck      ParamItem `refreshable:"true"`
 	PartitionNameRegexp          ParamItem `refreshable:"true"`
 	MustUsePartitionKey          ParamItem `refreshable:"true"`
+	EnablePartitionKeyPruning    ParamItem `refreshable:"true"`
+	SearchVerificationSampleRate ParamItem `refreshable:"true"`
+	SearchVerificationRecall     ParamItem `refreshable:"true"`
+	SearchVerificationMaxRunning ParamItem `refreshable:"true"`
+	CollectionWatchInterval      ParamItem `refreshable:"true"`
+	MetaCacheWarmupEnabled       ParamItem `refreshable:"false"`
+	MetaCacheRefreshInterval     ParamItem `refreshable:"false"`
 	SkipAutoIDCheck              ParamItem `refreshable:"true"`
 	SkipPartitionKeyCheck        ParamItem `refreshable:"true"`
 	EnablePublicPrivilege        ParamItem `refreshable:"false"`
@@ -1198,6 +1370,24 @@ type proxyConfig struct {
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 
 	SlowQuerySpanInSeconds ParamItem `refreshable:"true"`
+
+	// insert shaping
+	InsertShapingEnabled         ParamItem `refreshable:"true"`
+	InsertShapingRatePerVChannel ParamItem `refreshable:"true"`
+	InsertShapingMaxQueueDelay   ParamItem `refreshable:"true"`
+
+	BatchResourceGroups ParamItem `refreshable:"true"`
+
+	QueryCursorTTL ParamItem `refreshable:"true"`
+
+	// collection SLO
+	SLOShortWindow ParamItem `refreshable:"true"`
+	SLOLongWindow  ParamItem `refreshable:"true"`
+
+	// describe cache
+	DescribeCacheEnabled              ParamItem `refreshable:"true"`
+	DescribeCacheTTL                  ParamItem `refreshable:"true"`
+	DescribeCacheStaleWhileRevalidate ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -1532,6 +1722,70 @@ please adjust in embedded Milvus: false`,
 	}
 	p.MustUsePartitionKey.Init(base.mgr)
 
+	p.EnablePartitionKeyPruning = ParamItem{
+		Key:          "proxy.enablePartitionKeyPruning",
+		Version:      "2.4.7",
+		DefaultValue: "true",
+		Doc:          "switch for whether proxy skips the partitions without the searched partition keys according to the partition key stats",
+		Export:       true,
+	}
+	p.EnablePartitionKeyPruning.Init(base.mgr)
+
+	p.SearchVerificationSampleRate = ParamItem{
+		Key:          "proxy.searchVerification.sampleRate",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc: `the fraction of the searches re-executed by brute force on the same data to verify the results, 0 disables the verification.
+It's a debug mode to monitor the recall and correctness of the searches in the testing clusters, don't enable it in production`,
+		Export: true,
+	}
+	p.SearchVerificationSampleRate.Init(base.mgr)
+
+	p.SearchVerificationRecall = ParamItem{
+		Key:          "proxy.searchVerification.recallThreshold",
+		Version:      "2.4.7",
+		DefaultValue: "0.9",
+		Doc:          "the verified search with the recall against the brute force results below the threshold is logged as divergent",
+		Export:       true,
+	}
+	p.SearchVerificationRecall.Init(base.mgr)
+
+	p.SearchVerificationMaxRunning = ParamItem{
+		Key:          "proxy.searchVerification.maxRunning",
+		Version:      "2.4.7",
+		DefaultValue: "1",
+		Doc:          "the max number of the verifications running concurrently, the sampled searches beyond it are not verified",
+		Export:       true,
+	}
+	p.SearchVerificationMaxRunning.Init(base.mgr)
+
+	p.CollectionWatchInterval = ParamItem{
+		Key:          "proxy.collectionWatchInterval",
+		Version:      "2.4.7",
+		DefaultValue: "5",
+		Doc:          "interval in seconds to check the changes of the collections watched by WatchCollections",
+		Export:       true,
+	}
+	p.CollectionWatchInterval.Init(base.mgr)
+
+	p.MetaCacheWarmupEnabled = ParamItem{
+		Key:          "proxy.metaCacheWarmup.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "true",
+		Doc:          "switch for whether proxy fetches the meta snapshot of all the collections from rootcoord at startup instead of describing them on the first access",
+		Export:       true,
+	}
+	p.MetaCacheWarmupEnabled.Init(base.mgr)
+
+	p.MetaCacheRefreshInterval = ParamItem{
+		Key:          "proxy.metaCacheWarmup.refreshInterval",
+		Version:      "2.4.7",
+		DefaultValue: "60",
+		Doc:          "interval in seconds to fetch the collections created since the last meta snapshot, 0 disables the refresh",
+		Export:       true,
+	}
+	p.MetaCacheRefreshInterval.Init(base.mgr)
+
 	p.SkipAutoIDCheck = ParamItem{
 		Key:          "proxy.skipAutoIDCheck",
 		Version:      "2.4.1",
@@ -1600,6 +1854,101 @@ please adjust in embedded Milvus: false`,
 		Export:       true,
 	}
 	p.SlowQuerySpanInSeconds.Init(base.mgr)
+
+	p.InsertShapingEnabled = ParamItem{
+		Key:          "proxy.insertShaping.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `whether to smooth the inserts of all the collections into the sustainable rate per vchannel,
+the collection with the collection.insertShaping.rate.mb property is shaped regardless of it`,
+		Export: true,
+	}
+	p.InsertShapingEnabled.Init(base.mgr)
+
+	p.InsertShapingRatePerVChannel = ParamItem{
+		Key:          "proxy.insertShaping.ratePerVChannel",
+		Version:      "2.4.7",
+		DefaultValue: "16",
+		Doc:          "MB/s, the sustainable insert rate per vchannel of the collections without the collection.insertShaping.rate.mb property",
+		Export:       true,
+	}
+	p.InsertShapingRatePerVChannel.Init(base.mgr)
+
+	p.InsertShapingMaxQueueDelay = ParamItem{
+		Key:          "proxy.insertShaping.maxQueueDelay",
+		Version:      "2.4.7",
+		DefaultValue: "5000",
+		Doc:          "ms, the max delay of an insert queued by the shaping, the insert to be delayed longer is rejected as rate limited",
+		Export:       true,
+	}
+	p.InsertShapingMaxQueueDelay.Init(base.mgr)
+
+	p.DescribeCacheEnabled = ParamItem{
+		Key:          "proxy.describeCache.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `whether to cache the results of DescribeCollection and DescribeIndex by the shape of the requests,
+the cached results of a collection are invalidated by its DDLs`,
+		Export: true,
+	}
+	p.DescribeCacheEnabled.Init(base.mgr)
+
+	p.DescribeCacheTTL = ParamItem{
+		Key:          "proxy.describeCache.ttl",
+		Version:      "2.4.7",
+		DefaultValue: "10",
+		Doc:          "seconds, the cached describe results are fresh within the ttl, it also bounds the staleness of the index build progress",
+		Export:       true,
+	}
+	p.DescribeCacheTTL.Init(base.mgr)
+
+	p.DescribeCacheStaleWhileRevalidate = ParamItem{
+		Key:          "proxy.describeCache.staleWhileRevalidate",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc:          "seconds, the expired describe results are served within the window after the ttl while they are refreshed in background, 0 means disable",
+		Export:       true,
+	}
+	p.DescribeCacheStaleWhileRevalidate.Init(base.mgr)
+
+	p.BatchResourceGroups = ParamItem{
+		Key:          "proxy.batchResourceGroups",
+		Version:      "2.4.7",
+		DefaultValue: "",
+		Doc: `comma separated resource groups of the querynodes serving the batch requests, e.g. the iterators and exports,
+the requests with request_priority=batch or iterator=true are routed to the replicas in these resource groups,
+and the others to the replicas in the other resource groups, the requests fall back to any replica if none fits`,
+		Export: true,
+	}
+	p.BatchResourceGroups.Init(base.mgr)
+
+	p.QueryCursorTTL = ParamItem{
+		Key:          "proxy.queryCursorTTL",
+		Version:      "2.4.7",
+		DefaultValue: "3600",
+		Doc: `seconds, the ttl of the query iterator cursors persisted in the meta store, the ttl is renewed by each page,
+so the cursor can be resumed by any proxy within the ttl after the last page`,
+		Export: true,
+	}
+	p.QueryCursorTTL.Init(base.mgr)
+
+	p.SLOShortWindow = ParamItem{
+		Key:          "proxy.slo.shortWindow",
+		Version:      "2.4.7",
+		DefaultValue: "300",
+		Doc:          "seconds, the short window to compute the compliance and the burn rate of the collection SLO, it detects the fast burns",
+		Export:       true,
+	}
+	p.SLOShortWindow.Init(base.mgr)
+
+	p.SLOLongWindow = ParamItem{
+		Key:          "proxy.slo.longWindow",
+		Version:      "2.4.7",
+		DefaultValue: "3600",
+		Doc:          "seconds, the long window to compute the compliance and the burn rate of the collection SLO, it detects the slow burns",
+		Export:       true,
+	}
+	p.SLOLongWindow.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1621,6 +1970,10 @@ type queryCoordConfig struct {
 	// ---- Balance ---
 	AutoBalance                         ParamItem `refreshable:"true"`
 	AutoBalanceChannel                  ParamItem `refreshable:"true"`
+	BalanceChannelByLoad                ParamItem `refreshable:"true"`
+	ChannelLoadRequestRateWeight        ParamItem `refreshable:"true"`
+	ChannelLoadMemoryWeight             ParamItem `refreshable:"true"`
+	ChannelLoadUnbalanceToleration      ParamItem `refreshable:"true"`
 	Balancer                            ParamItem `refreshable:"true"`
 	GlobalRowCountFactor                ParamItem `refreshable:"true"`
 	ScoreUnbalanceTolerationFactor      ParamItem `refreshable:"true"`
@@ -1678,6 +2031,14 @@ type queryCoordConfig struct {
 	CollectionObserverInterval        ParamItem `refreshable:"false"`
 	CheckExecutedFlagInterval         ParamItem `refreshable:"false"`
 	CollectionBalanceSegmentBatchSize ParamItem `refreshable:"true"`
+	SafeRemoveNodeTimeout             ParamItem `refreshable:"true"`
+
+	SegmentSwapRate     ParamItem `refreshable:"true"`
+	SegmentSwapMaxDelay ParamItem `refreshable:"true"`
+
+	RowCountCheckInterval       ParamItem `refreshable:"false"`
+	RowCountCheckAutoRepair     ParamItem `refreshable:"true"`
+	RowCountCheckMaxRepairTimes ParamItem `refreshable:"true"`
 }
 
 func (p *queryCoordConfig) init(base *BaseTable) {
@@ -1742,6 +2103,44 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.AutoBalanceChannel.Init(base.mgr)
 
+	p.BalanceChannelByLoad = ParamItem{
+		Key:          "queryCoord.balanceChannelByLoad",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `balance the delegators by their request rate and memory usage instead of the channel count,
+takes effect only if autoBalanceChannel is enabled`,
+		Export: true,
+	}
+	p.BalanceChannelByLoad.Init(base.mgr)
+
+	p.ChannelLoadRequestRateWeight = ParamItem{
+		Key:          "queryCoord.channelLoadRequestRateWeight",
+		Version:      "2.4.7",
+		DefaultValue: "1",
+		Doc:          "weight of the request rate in the load of a delegator when balancing channels by load",
+		Export:       true,
+	}
+	p.ChannelLoadRequestRateWeight.Init(base.mgr)
+
+	p.ChannelLoadMemoryWeight = ParamItem{
+		Key:          "queryCoord.channelLoadMemoryWeight",
+		Version:      "2.4.7",
+		DefaultValue: "1",
+		Doc:          "weight of the memory usage in the load of a delegator when balancing channels by load",
+		Export:       true,
+	}
+	p.ChannelLoadMemoryWeight.Init(base.mgr)
+
+	p.ChannelLoadUnbalanceToleration = ParamItem{
+		Key:          "queryCoord.channelLoadUnbalanceToleration",
+		Version:      "2.4.7",
+		DefaultValue: "0.3",
+		Doc: `the least load difference between the most and the least loaded nodes, relative to the average load,
+to move a delegator when balancing channels by load`,
+		Export: true,
+	}
+	p.ChannelLoadUnbalanceToleration.Init(base.mgr)
+
 	p.Balancer = ParamItem{
 		Key:          "queryCoord.balancer",
 		Version:      "2.0.0",
@@ -2200,6 +2599,65 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 		Export:       false,
 	}
 	p.CollectionBalanceSegmentBatchSize.Init(base.mgr)
+
+	p.SafeRemoveNodeTimeout = ParamItem{
+		Key:          "queryCoord.safeRemoveNodeTimeout",
+		Version:      "2.4.7",
+		DefaultValue: "1800",
+		Doc: `timeout in seconds of each stage of safely removing a query node, the removal fails and the node is resumed
+if its segments and channels are not served by the other nodes in time`,
+		Export: true,
+	}
+	p.SafeRemoveNodeTimeout.Init(base.mgr)
+
+	p.SegmentSwapRate = ParamItem{
+		Key:          "queryCoord.segmentSwap.rate",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc: `the max number of compacted segments per second swapped into the loaded collections in place of their loaded sources,
+the other swaps are deferred to the next target update, 0 means no limit`,
+		Export: true,
+	}
+	p.SegmentSwapRate.Init(base.mgr)
+
+	p.SegmentSwapMaxDelay = ParamItem{
+		Key:          "queryCoord.segmentSwap.maxDelay",
+		Version:      "2.4.7",
+		DefaultValue: "3600",
+		Doc: `seconds, the compacted segments deferred by the rate limit or the collection.segmentSwap.window property longer than it
+are swapped anyway, it's capped by half of the GC retention of the collection to keep the files of the loaded sources`,
+		Export: true,
+	}
+	p.SegmentSwapMaxDelay.Init(base.mgr)
+
+	p.RowCountCheckInterval = ParamItem{
+		Key:          "queryCoord.rowCountCheck.interval",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc: `seconds, the interval to compare the row counts of the loaded segments among the segment meta, the binlogs
+and the query nodes, 0 means the check only runs on demand by the management API, and the query nodes don't report
+their loaded rows so only the segment meta and the binlogs are compared`,
+		Export: true,
+	}
+	p.RowCountCheckInterval.Init(base.mgr)
+
+	p.RowCountCheckAutoRepair = ParamItem{
+		Key:          "queryCoord.rowCountCheck.autoRepair",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc:          "whether the periodic check reloads the segments whose loaded rows differ from their binlogs",
+		Export:       true,
+	}
+	p.RowCountCheckAutoRepair.Init(base.mgr)
+
+	p.RowCountCheckMaxRepairTimes = ParamItem{
+		Key:          "queryCoord.rowCountCheck.maxRepairTimes",
+		Version:      "2.4.7",
+		DefaultValue: "3",
+		Doc:          "the max times to reload a segment whose loaded rows keep differing from its binlogs",
+		Export:       true,
+	}
+	p.RowCountCheckMaxRepairTimes.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -2300,6 +2758,11 @@ type queryNodeConfig struct {
 	UseStreamComputing                      ParamItem `refreshable:"false"`
 	QueryStreamBatchSize                    ParamItem `refreshable:"false"`
 	BloomFilterApplyParallelFactor          ParamItem `refreshable:"true"`
+
+	// local disk janitor
+	DiskJanitorEnabled     ParamItem `refreshable:"true"`
+	DiskJanitorInterval    ParamItem `refreshable:"false"`
+	DiskJanitorGracePeriod ParamItem `refreshable:"true"`
 }
 
 func (p *queryNodeConfig) init(base *BaseTable) {
@@ -2933,22 +3396,59 @@ user-task-polling:
 		Export:       true,
 	}
 	p.BloomFilterApplyParallelFactor.Init(base.mgr)
+
+	p.DiskJanitorEnabled = ParamItem{
+		Key:          "queryNode.diskJanitor.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "true",
+		Doc: `whether to clean the orphan local files of the disk index, raw data and mmap,
+which are not owned by any segment on the QueryNode, e.g. left by a crash`,
+		Export: true,
+	}
+	p.DiskJanitorEnabled.Init(base.mgr)
+
+	p.DiskJanitorInterval = ParamItem{
+		Key:          "queryNode.diskJanitor.interval",
+		Version:      "2.4.7",
+		DefaultValue: "600",
+		Doc:          "seconds, the interval to reconcile the local files against the segments on the QueryNode",
+		Export:       true,
+	}
+	p.DiskJanitorInterval.Init(base.mgr)
+
+	p.DiskJanitorGracePeriod = ParamItem{
+		Key:          "queryNode.diskJanitor.gracePeriod",
+		Version:      "2.4.7",
+		DefaultValue: "3600",
+		Doc:          "seconds, the orphan local files modified within the grace period are kept, as they may be written by a loading segment",
+		Export:       true,
+	}
+	p.DiskJanitorGracePeriod.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
 // --- datacoord ---
 type dataCoordConfig struct {
 	// --- CHANNEL ---
-	WatchTimeoutInterval         ParamItem `refreshable:"false"`
-	LegacyVersionWithoutRPCWatch ParamItem `refreshable:"false"`
-	ChannelBalanceSilentDuration ParamItem `refreshable:"true"`
-	ChannelBalanceInterval       ParamItem `refreshable:"true"`
-	ChannelCheckInterval         ParamItem `refreshable:"true"`
-	ChannelOperationRPCTimeout   ParamItem `refreshable:"true"`
+	WatchTimeoutInterval           ParamItem `refreshable:"false"`
+	LegacyVersionWithoutRPCWatch   ParamItem `refreshable:"false"`
+	ChannelBalanceSilentDuration   ParamItem `refreshable:"true"`
+	ChannelBalanceInterval         ParamItem `refreshable:"true"`
+	ChannelCheckInterval           ParamItem `refreshable:"true"`
+	ChannelOperationRPCTimeout     ParamItem `refreshable:"true"`
+	ChannelBalanceByLoad           ParamItem `refreshable:"true"`
+	ChannelLoadRowRateWeight       ParamItem `refreshable:"true"`
+	ChannelLoadCountWeight         ParamItem `refreshable:"true"`
+	ChannelLoadUnbalanceToleration ParamItem `refreshable:"true"`
+	ChannelWriteRateWindow         ParamItem `refreshable:"true"`
+	HotChannelWriteRateRatio       ParamItem `refreshable:"true"`
+	HotChannelGrowingSizeRatio     ParamItem `refreshable:"true"`
 
 	// --- SEGMENTS ---
 	SegmentMaxSize                 ParamItem `refreshable:"false"`
 	DiskSegmentMaxSize             ParamItem `refreshable:"true"`
+	SegmentSizePolicy              ParamItem `refreshable:"false"`
+	SegmentVarCharAvgLength        ParamItem `refreshable:"true"`
 	SegmentSealProportion          ParamItem `refreshable:"false"`
 	SegmentSealProportionJitter    ParamItem `refreshable:"true"`
 	SegAssignmentExpiration        ParamItem `refreshable:"false"`
@@ -2956,15 +3456,25 @@ type dataCoordConfig struct {
 	SegmentMaxLifetime             ParamItem `refreshable:"false"`
 	SegmentMaxIdleTime             ParamItem `refreshable:"false"`
 	SegmentMinSizeFromIdleToSealed ParamItem `refreshable:"false"`
+	SegmentInactiveSealTime        ParamItem `refreshable:"true"`
 	SegmentMaxBinlogFileNumber     ParamItem `refreshable:"false"`
 	GrowingSegmentsMemSizeInMB     ParamItem `refreshable:"true"`
 	AutoUpgradeSegmentIndex        ParamItem `refreshable:"true"`
+	IndexUpgradeEnabled            ParamItem `refreshable:"true"`
+	IndexUpgradeInterval           ParamItem `refreshable:"false"`
+	IndexUpgradeMaxSegments        ParamItem `refreshable:"true"`
+	IndexUpgradePolicy             ParamItem `refreshable:"true"`
+	IndexUpgradeWindow             ParamItem `refreshable:"true"`
+	ReuseIdenticalIndex            ParamItem `refreshable:"true"`
 	SegmentFlushInterval           ParamItem `refreshable:"true"`
 
 	// compaction
 	EnableCompaction     ParamItem `refreshable:"false"`
 	EnableAutoCompaction ParamItem `refreshable:"true"`
 	IndexBasedCompaction ParamItem `refreshable:"true"`
+	// DelayOnIndexBuilding delays the mix compaction of the segments whose index builds are in progress
+	DelayOnIndexBuilding        ParamItem `refreshable:"true"`
+	DelayOnIndexBuildingMaxTime ParamItem `refreshable:"true"`
 
 	CompactionRPCTimeout              ParamItem `refreshable:"true"`
 	CompactionMaxParallelTasks        ParamItem `refreshable:"true"`
@@ -2976,7 +3486,10 @@ type dataCoordConfig struct {
 	SegmentExpansionRate              ParamItem `refreshable:"true"`
 	CompactionTimeoutInSeconds        ParamItem `refreshable:"true"`
 	CompactionDropToleranceInSeconds  ParamItem `refreshable:"true"`
+	CompactionHistorySize             ParamItem `refreshable:"true"`
 	CompactionGCIntervalInSeconds     ParamItem `refreshable:"true"`
+	CompactionIOBudget                ParamItem `refreshable:"true"`
+	CompactionMinIORatePerPlan        ParamItem `refreshable:"true"`
 	CompactionCheckIntervalInSeconds  ParamItem `refreshable:"false"`
 	SingleCompactionRatioThreshold    ParamItem `refreshable:"true"`
 	SingleCompactionDeltaLogMaxSize   ParamItem `refreshable:"true"`
@@ -2993,6 +3506,7 @@ type dataCoordConfig struct {
 	ClusteringCompactionMinInterval          ParamItem `refreshable:"true"`
 	ClusteringCompactionMaxInterval          ParamItem `refreshable:"true"`
 	ClusteringCompactionNewDataSizeThreshold ParamItem `refreshable:"true"`
+	ClusteringCompactionDriftThreshold       ParamItem `refreshable:"true"`
 	ClusteringCompactionPreferSegmentSize    ParamItem `refreshable:"true"`
 	ClusteringCompactionMaxSegmentSize       ParamItem `refreshable:"true"`
 	ClusteringCompactionMaxTrainSizeRatio    ParamItem `refreshable:"true"`
@@ -3003,6 +3517,11 @@ type dataCoordConfig struct {
 	ClusteringCompactionMaxClusterSizeRatio  ParamItem `refreshable:"true"`
 	ClusteringCompactionMaxClusterSize       ParamItem `refreshable:"true"`
 
+	// Expiry Compaction
+	ExpiryCompactionEnable          ParamItem `refreshable:"true"`
+	ExpiryCompactionTriggerInterval ParamItem `refreshable:"false"`
+	ExpiryCompactionRatioThreshold  ParamItem `refreshable:"true"`
+
 	// LevelZero Segment
 	EnableLevelZeroSegment                   ParamItem `refreshable:"false"`
 	LevelZeroCompactionTriggerMinSize        ParamItem `refreshable:"true"`
@@ -3016,14 +3535,57 @@ type dataCoordConfig struct {
 	GCMissingTolerance      ParamItem `refreshable:"false"`
 	GCDropTolerance         ParamItem `refreshable:"false"`
 	GCRemoveConcurrent      ParamItem `refreshable:"false"`
+	GCRemoveBatchSize       ParamItem `refreshable:"true"`
+	GCRemoveQPS             ParamItem `refreshable:"true"`
 	GCScanIntervalInHour    ParamItem `refreshable:"false"`
+	GCSegmentIndexRetention ParamItem `refreshable:"false"`
 	EnableActiveStandby     ParamItem `refreshable:"false"`
 
-	BindIndexNodeMode          ParamItem `refreshable:"false"`
-	IndexNodeAddress           ParamItem `refreshable:"false"`
-	WithCredential             ParamItem `refreshable:"false"`
-	IndexNodeID                ParamItem `refreshable:"false"`
-	IndexTaskSchedulerInterval ParamItem `refreshable:"false"`
+	StandbyWarmCacheEnabled        ParamItem `refreshable:"false"`
+	StandbyWarmCacheCatchUpTimeout ParamItem `refreshable:"true"`
+
+	RegionReplicaShipInterval           ParamItem `refreshable:"true"`
+	RegionReplicaPrimaryEtcdEndpoints   ParamItem `refreshable:"false"`
+	RegionReplicaPrimaryMetaRootPath    ParamItem `refreshable:"false"`
+	RegionReplicaPrimaryAddress         ParamItem `refreshable:"false"`
+	RegionReplicaPrimaryBucketName      ParamItem `refreshable:"false"`
+	RegionReplicaPrimaryRootPath        ParamItem `refreshable:"false"`
+	RegionReplicaPrimaryAccessKeyID     ParamItem `refreshable:"false"`
+	RegionReplicaPrimarySecretAccessKey ParamItem `refreshable:"false"`
+	RegionReplicaPrimaryUseSSL          ParamItem `refreshable:"false"`
+
+	// segment reference
+	SegmentReferenceDefaultLease ParamItem `refreshable:"true"`
+	SegmentReferenceMaxLease     ParamItem `refreshable:"true"`
+
+	BindIndexNodeMode            ParamItem `refreshable:"false"`
+	IndexNodeAddress             ParamItem `refreshable:"false"`
+	WithCredential               ParamItem `refreshable:"false"`
+	IndexNodeID                  ParamItem `refreshable:"false"`
+	IndexTaskSchedulerInterval   ParamItem `refreshable:"true"`
+	IndexTaskSchedulerMaxBackoff ParamItem `refreshable:"true"`
+	IndexJobWeight               ParamItem `refreshable:"true"`
+	AnalyzeJobWeight             ParamItem `refreshable:"true"`
+	StatsJobWeight               ParamItem `refreshable:"true"`
+	EnableStatsJob               ParamItem `refreshable:"true"`
+	TenantMaxConcurrentTasks     ParamItem `refreshable:"true"`
+	TenantMaxQueuedTasks         ParamItem `refreshable:"true"`
+	DatabaseQuotaCacheTTL        ParamItem `refreshable:"true"`
+	IndexDiskHeadroomRatio       ParamItem `refreshable:"true"`
+	IndexTaskTimeout             ParamItem `refreshable:"true"`
+	IndexTaskDrainTimeout        ParamItem `refreshable:"true"`
+	IndexTaskMaxRetryTimes       ParamItem `refreshable:"true"`
+	IndexTaskRetryBackoff        ParamItem `refreshable:"true"`
+	IndexTaskRetryMaxBackoff     ParamItem `refreshable:"true"`
+
+	SpeculativeIndexBuildEnabled    ParamItem `refreshable:"true"`
+	SpeculativeIndexBuildSlowRatio  ParamItem `refreshable:"true"`
+	SpeculativeIndexBuildMaxRatio   ParamItem `refreshable:"true"`
+	SpeculativeIndexBuildMinSamples ParamItem `refreshable:"true"`
+
+	IndexNodeQuarantineFailureThreshold ParamItem `refreshable:"true"`
+	IndexNodeQuarantineFailureWindow    ParamItem `refreshable:"true"`
+	IndexNodeQuarantineCoolDown         ParamItem `refreshable:"true"`
 
 	MinSegmentNumRowsToEnableIndex ParamItem `refreshable:"true"`
 	BrokerTimeout                  ParamItem `refreshable:"false"`
@@ -3036,17 +3598,33 @@ type dataCoordConfig struct {
 	FilesPerPreImportTask    ParamItem `refreshable:"true"`
 	ImportTaskRetention      ParamItem `refreshable:"true"`
 	MaxSizeInMBPerImportTask ParamItem `refreshable:"true"`
+	MaxSizeInMBPerExportTask ParamItem `refreshable:"true"`
 	ImportScheduleInterval   ParamItem `refreshable:"true"`
 	ImportCheckIntervalHigh  ParamItem `refreshable:"true"`
 	ImportCheckIntervalLow   ParamItem `refreshable:"true"`
 	MaxFilesPerImportReq     ParamItem `refreshable:"true"`
 	WaitForIndex             ParamItem `refreshable:"true"`
+	MaxImportTasksPerNode    ParamItem `refreshable:"true"`
 
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 
 	ClusteringCompactionSlotUsage ParamItem `refreshable:"true"`
 	MixCompactionSlotUsage        ParamItem `refreshable:"true"`
 	L0DeleteCompactionSlotUsage   ParamItem `refreshable:"true"`
+
+	DuplicatePKMaxSamples ParamItem `refreshable:"true"`
+
+	SlowTaskDetectEnabled  ParamItem `refreshable:"true"`
+	SlowTaskDetectInterval ParamItem `refreshable:"true"`
+	SlowTaskBaseDuration   ParamItem `refreshable:"true"`
+	SlowTaskThroughput     ParamItem `refreshable:"true"`
+
+	DataNodeHealthCheckInterval ParamItem `refreshable:"false"`
+	DataNodeHealthCheckTimeout  ParamItem `refreshable:"true"`
+
+	SessionRPCTimeout         ParamItem  `refreshable:"true"`
+	SessionRPCMaxRetries      ParamItem  `refreshable:"true"`
+	SessionRPCMethodOverrides ParamGroup `refreshable:"true"`
 }
 
 func (p *dataCoordConfig) init(base *BaseTable) {
@@ -3104,26 +3682,114 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	}
 	p.ChannelOperationRPCTimeout.Init(base.mgr)
 
-	p.SegmentMaxSize = ParamItem{
-		Key:          "dataCoord.segment.maxSize",
-		Version:      "2.0.0",
-		DefaultValue: "1024",
-		Doc:          "Maximum size of a segment in MB",
-		Export:       true,
+	p.ChannelBalanceByLoad = ParamItem{
+		Key:          "dataCoord.channel.balanceByLoad",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `balance the channels by the row throughput and the channel count of the datanodes instead of the channel count only,
+a channel is moved from the most loaded datanode to the least loaded one in each balance round`,
+		Export: true,
 	}
-	p.SegmentMaxSize.Init(base.mgr)
+	p.ChannelBalanceByLoad.Init(base.mgr)
 
-	p.DiskSegmentMaxSize = ParamItem{
-		Key:          "dataCoord.segment.diskSegmentMaxSize",
-		Version:      "2.0.0",
-		DefaultValue: "2048",
-		Doc:          "Maximun size of a segment in MB for collection which has Disk index",
+	p.ChannelLoadRowRateWeight = ParamItem{
+		Key:          "dataCoord.channel.loadRowRateWeight",
+		Version:      "2.4.7",
+		DefaultValue: "1",
+		Doc:          "weight of the rows inserted per second in the load of a channel when balancing channels by load",
 		Export:       true,
 	}
-	p.DiskSegmentMaxSize.Init(base.mgr)
+	p.ChannelLoadRowRateWeight.Init(base.mgr)
 
-	p.SegmentSealProportion = ParamItem{
-		Key:          "dataCoord.segment.sealProportion",
+	p.ChannelLoadCountWeight = ParamItem{
+		Key:          "dataCoord.channel.loadCountWeight",
+		Version:      "2.4.7",
+		DefaultValue: "1",
+		Doc:          "weight of the channel count in the load of a datanode when balancing channels by load",
+		Export:       true,
+	}
+	p.ChannelLoadCountWeight.Init(base.mgr)
+
+	p.ChannelLoadUnbalanceToleration = ParamItem{
+		Key:          "dataCoord.channel.loadUnbalanceToleration",
+		Version:      "2.4.7",
+		DefaultValue: "0.3",
+		Doc: `the least load difference between the most and the least loaded datanodes, relative to the average load,
+to move a channel when balancing channels by load`,
+		Export: true,
+	}
+	p.ChannelLoadUnbalanceToleration.Init(base.mgr)
+
+	p.ChannelWriteRateWindow = ParamItem{
+		Key:          "dataCoord.channel.writeRateWindow",
+		Version:      "2.4.7",
+		DefaultValue: "10",
+		Doc:          "window in seconds to sample the write rates of the channels reported by the datanodes",
+		Export:       true,
+	}
+	p.ChannelWriteRateWindow.Init(base.mgr)
+
+	p.HotChannelWriteRateRatio = ParamItem{
+		Key:          "dataCoord.channel.hotWriteRateRatio",
+		Version:      "2.4.7",
+		DefaultValue: "3",
+		Doc: `a channel is hot if its write rate exceeds the ratio of the average write rate of the channels,
+0 to disable the hot channel detection`,
+		Export: true,
+	}
+	p.HotChannelWriteRateRatio.Init(base.mgr)
+
+	p.HotChannelGrowingSizeRatio = ParamItem{
+		Key:          "dataCoord.channel.hotGrowingSizeRatio",
+		Version:      "2.4.7",
+		DefaultValue: "0.5",
+		Doc: `the largest growing segment of a hot channel is sealed once the total size of the growing segments of the channel
+exceeds the ratio of dataCoord.sealPolicy.channel.growingSegmentsMemSize, to bound the memory of the datanodes`,
+		Export: true,
+	}
+	p.HotChannelGrowingSizeRatio.Init(base.mgr)
+
+	p.SegmentMaxSize = ParamItem{
+		Key:          "dataCoord.segment.maxSize",
+		Version:      "2.0.0",
+		DefaultValue: "1024",
+		Doc:          "Maximum size of a segment in MB",
+		Export:       true,
+	}
+	p.SegmentMaxSize.Init(base.mgr)
+
+	p.DiskSegmentMaxSize = ParamItem{
+		Key:          "dataCoord.segment.diskSegmentMaxSize",
+		Version:      "2.0.0",
+		DefaultValue: "2048",
+		Doc:          "Maximun size of a segment in MB for collection which has Disk index",
+		Export:       true,
+	}
+	p.DiskSegmentMaxSize.Init(base.mgr)
+
+	p.SegmentSizePolicy = ParamItem{
+		Key:          "dataCoord.segment.sizePolicy",
+		Version:      "2.4.7",
+		DefaultValue: "schema",
+		Doc: `The policy to estimate the max number of rows of the new segments, options: schema, prediction.
+schema: estimate the size per row by the schema, with the varchar fields of at most 256 bytes.
+prediction: predict the size per row by the binlog sizes of the recently flushed segments of the collection,
+or by the schema with the average length of the varchar fields if not enough rows are flushed.`,
+		Export: true,
+	}
+	p.SegmentSizePolicy.Init(base.mgr)
+
+	p.SegmentVarCharAvgLength = ParamItem{
+		Key:          "dataCoord.segment.varCharAvgLength",
+		Version:      "2.4.7",
+		DefaultValue: "256",
+		Doc:          "The average length in bytes of the varchar fields to predict the size per row by the schema, capped by the max_length of the field",
+		Export:       true,
+	}
+	p.SegmentVarCharAvgLength.Init(base.mgr)
+
+	p.SegmentSealProportion = ParamItem{
+		Key:          "dataCoord.segment.sealProportion",
 		Version:      "2.0.0",
 		DefaultValue: "0.12",
 		Export:       true,
@@ -3186,6 +3852,17 @@ The max idle time of segment in seconds, 10*60.`,
 	}
 	p.SegmentMinSizeFromIdleToSealed.Init(base.mgr)
 
+	p.SegmentInactiveSealTime = ParamItem{
+		Key:          "dataCoord.segment.inactiveSealTime",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc: `The time in seconds to seal the growing segments which receive no writes regardless of their sizes,
+so the data of the low-traffic collections gets flushed and indexed. 0 means disable,
+it's overridden by the collection property collection.segment.idleSeal.seconds.`,
+		Export: true,
+	}
+	p.SegmentInactiveSealTime.Init(base.mgr)
+
 	p.SegmentMaxBinlogFileNumber = ParamItem{
 		Key:          "dataCoord.segment.maxBinlogFileNumber",
 		Version:      "2.2.0",
@@ -3231,6 +3908,26 @@ exceeds this threshold, the largest growing segment will be sealed.`,
 	}
 	p.IndexBasedCompaction.Init(base.mgr)
 
+	p.DelayOnIndexBuilding = ParamItem{
+		Key:          "dataCoord.compaction.delayOnIndexBuilding",
+		Version:      "2.4.7",
+		DefaultValue: "true",
+		Doc: `Whether to delay the mix compaction of the segments whose index builds are in progress until the builds finish,
+so that the index work nearly finished is not thrown away. The manual compaction is not delayed`,
+		Export: true,
+	}
+	p.DelayOnIndexBuilding.Init(base.mgr)
+
+	p.DelayOnIndexBuildingMaxTime = ParamItem{
+		Key:          "dataCoord.compaction.delayOnIndexBuildingMaxTime",
+		Version:      "2.4.7",
+		DefaultValue: "1800",
+		Doc: `The max time in seconds to delay the mix compaction of the segment whose index build is in progress,
+the compaction is not delayed any longer once the build runs longer than it`,
+		Export: true,
+	}
+	p.DelayOnIndexBuildingMaxTime.Init(base.mgr)
+
 	p.CompactionRPCTimeout = ParamItem{
 		Key:          "dataCoord.compaction.rpcTimeout",
 		Version:      "2.2.12",
@@ -3315,6 +4012,15 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.CompactionDropToleranceInSeconds.Init(base.mgr)
 
+	p.CompactionHistorySize = ParamItem{
+		Key:          "dataCoord.compaction.historySize",
+		Version:      "2.4.7",
+		DefaultValue: "1000",
+		Doc:          "The number of the latest finished compaction plans whose details are retained in memory",
+		Export:       true,
+	}
+	p.CompactionHistorySize.Init(base.mgr)
+
 	p.CompactionGCIntervalInSeconds = ParamItem{
 		Key:          "dataCoord.compaction.gcInterval",
 		Version:      "2.4.7",
@@ -3324,6 +4030,25 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.CompactionGCIntervalInSeconds.Init(base.mgr)
 
+	p.CompactionIOBudget = ParamItem{
+		Key:          "dataCoord.compaction.ioBudget.total",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc: `The read and write bandwidth in MB/s shared by all the running compaction plans across the cluster,
+it's allocated to the plans when they are assigned to the datanodes and the plans are queued if it's exhausted, 0 means no limit`,
+		Export: true,
+	}
+	p.CompactionIOBudget.Init(base.mgr)
+
+	p.CompactionMinIORatePerPlan = ParamItem{
+		Key:          "dataCoord.compaction.ioBudget.minPerPlan",
+		Version:      "2.4.7",
+		DefaultValue: "16",
+		Doc:          "The minimum read and write bandwidth in MB/s allocated to a compaction plan, the plan is queued if the left budget is less than it",
+		Export:       true,
+	}
+	p.CompactionMinIORatePerPlan.Init(base.mgr)
+
 	p.CompactionCheckIntervalInSeconds = ParamItem{
 		Key:          "dataCoord.compaction.check.interval",
 		Version:      "2.0.0",
@@ -3482,6 +4207,15 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.ClusteringCompactionNewDataSizeThreshold.Init(base.mgr)
 
+	p.ClusteringCompactionDriftThreshold = ParamItem{
+		Key:          "dataCoord.compaction.clustering.driftThreshold",
+		Version:      "2.4.7",
+		Doc:          "If the center of the new data drifts from the center of the analyzed centroids by more than driftThreshold times their mean radius, execute clustering compaction. Vector clustering key only, non-positive to disable",
+		DefaultValue: "0.5",
+		Export:       true,
+	}
+	p.ClusteringCompactionDriftThreshold.Init(base.mgr)
+
 	p.ClusteringCompactionTimeoutInSeconds = ParamItem{
 		Key:          "dataCoord.compaction.clustering.timeout",
 		Version:      "2.4.6",
@@ -3561,6 +4295,33 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.ClusteringCompactionMaxClusterSize.Init(base.mgr)
 
+	p.ExpiryCompactionEnable = ParamItem{
+		Key:          "dataCoord.compaction.expiry.enable",
+		Version:      "2.4.7",
+		DefaultValue: "true",
+		Doc:          "Enable expiry compaction, which compacts the segments with the rows expired by the collection TTL periodically",
+		Export:       true,
+	}
+	p.ExpiryCompactionEnable.Init(base.mgr)
+
+	p.ExpiryCompactionTriggerInterval = ParamItem{
+		Key:          "dataCoord.compaction.expiry.triggerInterval",
+		Version:      "2.4.7",
+		DefaultValue: "3600",
+		Doc:          "expiry compaction trigger interval in seconds",
+		Export:       true,
+	}
+	p.ExpiryCompactionTriggerInterval.Init(base.mgr)
+
+	p.ExpiryCompactionRatioThreshold = ParamItem{
+		Key:          "dataCoord.compaction.expiry.ratioThreshold",
+		Version:      "2.4.7",
+		DefaultValue: "0.3",
+		Doc:          "The segment is compacted by expiry compaction if the ratio of its expired rows is no less than it",
+		Export:       true,
+	}
+	p.ExpiryCompactionRatioThreshold.Init(base.mgr)
+
 	p.EnableGarbageCollection = ParamItem{
 		Key:          "dataCoord.enableGarbageCollection",
 		Version:      "2.0.0",
@@ -3616,6 +4377,33 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GCRemoveConcurrent.Init(base.mgr)
 
+	p.GCRemoveBatchSize = ParamItem{
+		Key:          "dataCoord.gc.removeBatchSize",
+		Version:      "2.4.7",
+		DefaultValue: "1000",
+		Doc:          "max number of objects removed by one batched delete request of garbage collection",
+		Export:       true,
+	}
+	p.GCRemoveBatchSize.Init(base.mgr)
+
+	p.GCRemoveQPS = ParamItem{
+		Key:          "dataCoord.gc.removeQPS",
+		Version:      "2.4.7",
+		DefaultValue: "50",
+		Doc:          "max number of batched delete requests per second sent to object storage by garbage collection, 0 means no limit",
+		Export:       true,
+	}
+	p.GCRemoveQPS.Init(base.mgr)
+
+	p.GCSegmentIndexRetention = ParamItem{
+		Key:          "dataCoord.gc.segmentIndexRetention",
+		Version:      "2.4.7",
+		DefaultValue: "604800", // 7 * 24 hours
+		Doc:          "retention duration in seconds of the finished or failed segment index records of dropped segments, records older than it will be pruned from meta, 0 means disable",
+		Export:       true,
+	}
+	p.GCSegmentIndexRetention.Init(base.mgr)
+
 	p.EnableActiveStandby = ParamItem{
 		Key:          "dataCoord.enableActiveStandby",
 		Version:      "2.0.0",
@@ -3624,6 +4412,119 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.EnableActiveStandby.Init(base.mgr)
 
+	p.StandbyWarmCacheEnabled = ParamItem{
+		Key:          "dataCoord.standby.warmCache.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "true",
+		Doc: `whether the standby DataCoord replays the segment changes of the meta store into a warm cache,
+so that the activation only catches up the recent changes instead of reloading all the segments, etcd meta store only`,
+		Export: true,
+	}
+	p.StandbyWarmCacheEnabled.Init(base.mgr)
+
+	p.StandbyWarmCacheCatchUpTimeout = ParamItem{
+		Key:          "dataCoord.standby.warmCache.catchUpTimeout",
+		Version:      "2.4.7",
+		DefaultValue: "30",
+		Doc:          "seconds, the segments are reloaded from the meta store if the warm cache fails to catch up in it on activation",
+		Export:       true,
+	}
+	p.StandbyWarmCacheCatchUpTimeout.Init(base.mgr)
+
+	p.RegionReplicaShipInterval = ParamItem{
+		Key:          "dataCoord.regionReplica.shipInterval",
+		Version:      "2.4.7",
+		DefaultValue: "60",
+		Doc:          "seconds, the interval the secondary region ships the flushed segments, the delta logs and the indexes from the primary region",
+		Export:       true,
+	}
+	p.RegionReplicaShipInterval.Init(base.mgr)
+
+	p.RegionReplicaPrimaryEtcdEndpoints = ParamItem{
+		Key:          "dataCoord.regionReplica.primary.etcd.endpoints",
+		Version:      "2.4.7",
+		DefaultValue: "",
+		Doc:          "comma separated etcd endpoints of the primary region read by the secondary region, the tls configs are shared with the local etcd",
+		Export:       true,
+	}
+	p.RegionReplicaPrimaryEtcdEndpoints.Init(base.mgr)
+
+	p.RegionReplicaPrimaryMetaRootPath = ParamItem{
+		Key:          "dataCoord.regionReplica.primary.etcd.metaRootPath",
+		Version:      "2.4.7",
+		DefaultValue: "by-dev/meta",
+		Doc:          "the meta root path of the primary region in its etcd",
+		Export:       true,
+	}
+	p.RegionReplicaPrimaryMetaRootPath.Init(base.mgr)
+
+	p.RegionReplicaPrimaryAddress = ParamItem{
+		Key:          "dataCoord.regionReplica.primary.minio.address",
+		Version:      "2.4.7",
+		DefaultValue: "",
+		Doc:          "the object storage address of the primary region with port, the storage type and the other configs are shared with the local minio",
+		Export:       true,
+	}
+	p.RegionReplicaPrimaryAddress.Init(base.mgr)
+
+	p.RegionReplicaPrimaryBucketName = ParamItem{
+		Key:          "dataCoord.regionReplica.primary.minio.bucketName",
+		Version:      "2.4.7",
+		DefaultValue: "a-bucket",
+		Export:       true,
+	}
+	p.RegionReplicaPrimaryBucketName.Init(base.mgr)
+
+	p.RegionReplicaPrimaryRootPath = ParamItem{
+		Key:          "dataCoord.regionReplica.primary.minio.rootPath",
+		Version:      "2.4.7",
+		DefaultValue: "files",
+		Export:       true,
+	}
+	p.RegionReplicaPrimaryRootPath.Init(base.mgr)
+
+	p.RegionReplicaPrimaryAccessKeyID = ParamItem{
+		Key:          "dataCoord.regionReplica.primary.minio.accessKeyID",
+		Version:      "2.4.7",
+		DefaultValue: "minioadmin",
+		Export:       true,
+	}
+	p.RegionReplicaPrimaryAccessKeyID.Init(base.mgr)
+
+	p.RegionReplicaPrimarySecretAccessKey = ParamItem{
+		Key:          "dataCoord.regionReplica.primary.minio.secretAccessKey",
+		Version:      "2.4.7",
+		DefaultValue: "minioadmin",
+		Export:       true,
+	}
+	p.RegionReplicaPrimarySecretAccessKey.Init(base.mgr)
+
+	p.RegionReplicaPrimaryUseSSL = ParamItem{
+		Key:          "dataCoord.regionReplica.primary.minio.useSSL",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.RegionReplicaPrimaryUseSSL.Init(base.mgr)
+
+	p.SegmentReferenceDefaultLease = ParamItem{
+		Key:          "dataCoord.segmentReference.defaultLease",
+		Version:      "2.4.7",
+		DefaultValue: "600",
+		Doc:          "seconds, the lease of a segment reference acquired by an external reader without a lease, the referenced binlogs are kept from GC until it expires",
+		Export:       true,
+	}
+	p.SegmentReferenceDefaultLease.Init(base.mgr)
+
+	p.SegmentReferenceMaxLease = ParamItem{
+		Key:          "dataCoord.segmentReference.maxLease",
+		Version:      "2.4.7",
+		DefaultValue: "86400",
+		Doc:          "seconds, the maximum lease of a segment reference, the longer leases requested are clamped to it",
+		Export:       true,
+	}
+	p.SegmentReferenceMaxLease.Init(base.mgr)
+
 	p.MinSegmentNumRowsToEnableIndex = ParamItem{
 		Key:          "indexCoord.segment.minSegmentNumRowsToEnableIndex",
 		Version:      "2.0.0",
@@ -3671,6 +4572,208 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.IndexTaskSchedulerInterval.Init(base.mgr)
 
+	p.IndexTaskSchedulerMaxBackoff = ParamItem{
+		Key:          "indexCoord.scheduler.maxBackoff",
+		Version:      "2.4.7",
+		DefaultValue: "10000",
+		Doc:          "max backoff in milliseconds of dispatching index tasks while all the IndexNodes have no free slot",
+		Export:       true,
+	}
+	p.IndexTaskSchedulerMaxBackoff.Init(base.mgr)
+
+	p.IndexJobWeight = ParamItem{
+		Key:          "indexCoord.scheduler.indexJobWeight",
+		Version:      "2.4.7",
+		DefaultValue: "3",
+		Doc: `weight of the index jobs to share the IndexNode slots with the analyze jobs,
+the pending jobs of the types are dispatched interleaved by the weights`,
+		Export: true,
+	}
+	p.IndexJobWeight.Init(base.mgr)
+
+	p.AnalyzeJobWeight = ParamItem{
+		Key:          "indexCoord.scheduler.analyzeJobWeight",
+		Version:      "2.4.7",
+		DefaultValue: "1",
+		Doc:          "weight of the analyze jobs to share the IndexNode slots with the index jobs",
+		Export:       true,
+	}
+	p.AnalyzeJobWeight.Init(base.mgr)
+
+	p.StatsJobWeight = ParamItem{
+		Key:          "indexCoord.scheduler.statsJobWeight",
+		Version:      "2.4.7",
+		DefaultValue: "1",
+		Doc:          "weight of the stats jobs to share the IndexNode slots with the index and analyze jobs",
+		Export:       true,
+	}
+	p.StatsJobWeight.Init(base.mgr)
+
+	p.EnableStatsJob = ParamItem{
+		Key:          "indexCoord.scheduler.enableStatsJob",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `whether to compute the statistics of the fields of the flushed segments on the IndexNodes,
+the null counts, min-max values and BM25 statistics are persisted to the segment meta to prune the segments`,
+		Export: true,
+	}
+	p.EnableStatsJob.Init(base.mgr)
+
+	p.TenantMaxConcurrentTasks = ParamItem{
+		Key:          "indexCoord.scheduler.tenantMaxConcurrentTasks",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc: `max number of the analyze and index tasks of a tenant running on the IndexNodes concurrently,
+the tenant is the database of the collection, 0 means unlimited`,
+		Export: true,
+	}
+	p.TenantMaxConcurrentTasks.Init(base.mgr)
+
+	p.TenantMaxQueuedTasks = ParamItem{
+		Key:          "indexCoord.scheduler.tenantMaxQueuedTasks",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc: `max number of the unfinished analyze and index tasks of a tenant in the scheduler,
+the index creation of the tenant is rejected once exceeded, 0 means unlimited`,
+		Export: true,
+	}
+	p.TenantMaxQueuedTasks.Init(base.mgr)
+
+	p.DatabaseQuotaCacheTTL = ParamItem{
+		Key:          "indexCoord.scheduler.databaseQuotaCacheTTL",
+		Version:      "2.4.7",
+		DefaultValue: "60",
+		Doc: `time in seconds to cache the background task quotas set by the database properties before describing the database again,
+the index, analyze and compaction tasks of a database are limited by the quotas`,
+		Export: true,
+	}
+	p.DatabaseQuotaCacheTTL.Init(base.mgr)
+
+	p.IndexDiskHeadroomRatio = ParamItem{
+		Key:          "indexCoord.scheduler.diskHeadroomRatio",
+		Version:      "2.4.7",
+		DefaultValue: "1.2",
+		Doc: `ratio of the free local disk required on the IndexNode to the estimated disk usage of a DiskANN or inverted index build,
+the IndexNodes without enough free disk are skipped to dispatch the build`,
+		Export: true,
+	}
+	p.IndexDiskHeadroomRatio.Init(base.mgr)
+
+	p.IndexTaskTimeout = ParamItem{
+		Key:          "indexCoord.scheduler.taskTimeout",
+		Version:      "2.4.7",
+		DefaultValue: "10800",
+		Doc: `timeout in seconds of an in progress analyze or index task, the timed out task is dropped on the IndexNode and retried,
+0 means never time out`,
+		Export: true,
+	}
+	p.IndexTaskTimeout.Init(base.mgr)
+
+	p.IndexTaskDrainTimeout = ParamItem{
+		Key:          "indexCoord.scheduler.drainTimeout",
+		Version:      "2.4.7",
+		DefaultValue: "10",
+		Doc: `timeout in seconds to wait for the in progress analyze and index tasks to finish while DataCoord is stopping,
+the unfinished tasks are resumed by the next DataCoord, 0 means stop without waiting`,
+		Export: true,
+	}
+	p.IndexTaskDrainTimeout.Init(base.mgr)
+
+	p.IndexTaskMaxRetryTimes = ParamItem{
+		Key:          "indexCoord.scheduler.maxRetryTimes",
+		Version:      "2.4.7",
+		DefaultValue: "10",
+		Doc: `max times to retry a failed index build, the build is marked as failed permanently once it runs out of the retries,
+0 means retry forever`,
+		Export: true,
+	}
+	p.IndexTaskMaxRetryTimes.Init(base.mgr)
+
+	p.IndexTaskRetryBackoff = ParamItem{
+		Key:          "indexCoord.scheduler.retryBackoff",
+		Version:      "2.4.7",
+		DefaultValue: "1000",
+		Doc:          "initial backoff in milliseconds of retrying a failed index build, doubled on each retry with jitter",
+		Export:       true,
+	}
+	p.IndexTaskRetryBackoff.Init(base.mgr)
+
+	p.IndexTaskRetryMaxBackoff = ParamItem{
+		Key:          "indexCoord.scheduler.retryMaxBackoff",
+		Version:      "2.4.7",
+		DefaultValue: "60000",
+		Doc:          "max backoff in milliseconds of retrying a failed index build",
+		Export:       true,
+	}
+	p.IndexTaskRetryMaxBackoff.Init(base.mgr)
+
+	p.SpeculativeIndexBuildEnabled = ParamItem{
+		Key:          "indexCoord.speculation.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `whether to launch a speculative duplicate of the straggling index build on another IndexNode,
+the first finished one is taken and the other one is dropped`,
+		Export: true,
+	}
+	p.SpeculativeIndexBuildEnabled.Init(base.mgr)
+
+	p.SpeculativeIndexBuildSlowRatio = ParamItem{
+		Key:          "indexCoord.speculation.slowRatio",
+		Version:      "2.4.7",
+		DefaultValue: "3",
+		Doc: `the index build is straggling once it has been building longer than the p95 latency of the similar builds
+multiplied by the ratio, the similar builds are of the same index type and the same magnitude of rows`,
+		Export: true,
+	}
+	p.SpeculativeIndexBuildSlowRatio.Init(base.mgr)
+
+	p.SpeculativeIndexBuildMaxRatio = ParamItem{
+		Key:          "indexCoord.speculation.maxRatio",
+		Version:      "2.4.7",
+		DefaultValue: "0.05",
+		Doc:          "max ratio of the speculative duplicates to the in progress index builds",
+		Export:       true,
+	}
+	p.SpeculativeIndexBuildMaxRatio.Init(base.mgr)
+
+	p.SpeculativeIndexBuildMinSamples = ParamItem{
+		Key:          "indexCoord.speculation.minSamples",
+		Version:      "2.4.7",
+		DefaultValue: "20",
+		Doc:          "min number of the finished similar builds to estimate the p95 latency, no speculation before that",
+		Export:       true,
+	}
+	p.SpeculativeIndexBuildMinSamples.Init(base.mgr)
+
+	p.IndexNodeQuarantineFailureThreshold = ParamItem{
+		Key:          "indexCoord.quarantine.failureThreshold",
+		Version:      "2.4.7",
+		DefaultValue: "5",
+		Doc: `the IndexNode is quarantined once the requests to create or query the tasks on it fail so many times within the failure window,
+the quarantined IndexNode is not assigned new tasks, 0 means never quarantine`,
+		Export: true,
+	}
+	p.IndexNodeQuarantineFailureThreshold.Init(base.mgr)
+
+	p.IndexNodeQuarantineFailureWindow = ParamItem{
+		Key:          "indexCoord.quarantine.failureWindow",
+		Version:      "2.4.7",
+		DefaultValue: "60",
+		Doc:          "window in seconds to count the failed requests to an IndexNode",
+		Export:       true,
+	}
+	p.IndexNodeQuarantineFailureWindow.Init(base.mgr)
+
+	p.IndexNodeQuarantineCoolDown = ParamItem{
+		Key:          "indexCoord.quarantine.coolDown",
+		Version:      "2.4.7",
+		DefaultValue: "300",
+		Doc: `cool-down in seconds of a quarantined IndexNode, the IndexNode is put on probation after the cool-down,
+and quarantined again on the first failure during the probation`,
+		Export: true,
+	}
+	p.IndexNodeQuarantineCoolDown.Init(base.mgr)
+
 	p.BrokerTimeout = ParamItem{
 		Key:          "dataCoord.brokerTimeout",
 		Version:      "2.3.0",
@@ -3711,6 +4814,63 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.AutoUpgradeSegmentIndex.Init(base.mgr)
 
+	p.IndexUpgradeEnabled = ParamItem{
+		Key:          "dataCoord.indexUpgrade.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `whether to upgrade the segment indexes built by the older index engine versions in background,
+the segment indexes are upgraded to the current index engine version following dataCoord.indexUpgrade.policy`,
+		Export: true,
+	}
+	p.IndexUpgradeEnabled.Init(base.mgr)
+
+	p.IndexUpgradeInterval = ParamItem{
+		Key:          "dataCoord.indexUpgrade.interval",
+		Version:      "2.4.7",
+		DefaultValue: "60",
+		Doc:          "the interval in seconds of checking the segments to upgrade the index",
+		Export:       true,
+	}
+	p.IndexUpgradeInterval.Init(base.mgr)
+
+	p.IndexUpgradeMaxSegments = ParamItem{
+		Key:          "dataCoord.indexUpgrade.maxSegmentsPerInterval",
+		Version:      "2.4.7",
+		DefaultValue: "10",
+		Doc:          "max number of the segments to upgrade the index in each interval",
+		Export:       true,
+	}
+	p.IndexUpgradeMaxSegments.Init(base.mgr)
+
+	p.IndexUpgradePolicy = ParamItem{
+		Key:          "dataCoord.indexUpgrade.policy",
+		Version:      "2.4.7",
+		DefaultValue: "rewrite",
+		Doc: `how to upgrade the outdated segment indexes, "rewrite" rewrites the segments by compaction,
+"reindex" rebuilds the indexes of the segments and swaps them with the outdated ones once finished`,
+		Export: true,
+	}
+	p.IndexUpgradePolicy.Init(base.mgr)
+
+	p.IndexUpgradeWindow = ParamItem{
+		Key:          "dataCoord.indexUpgrade.window",
+		Version:      "2.4.7",
+		DefaultValue: "",
+		Doc:          "the daily off-peak window to upgrade the index like 22:00-06:00 in the local time, any time if empty",
+		Export:       true,
+	}
+	p.IndexUpgradeWindow.Init(base.mgr)
+
+	p.ReuseIdenticalIndex = ParamItem{
+		Key:          "dataCoord.reuseIdenticalIndex",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `whether to reuse the index files built on the identical binlogs with the same index params instead of building again,
+e.g. the segments of the cloned or restored collections, the binlogs of the indexed field are read to compare their hash`,
+		Export: true,
+	}
+	p.ReuseIdenticalIndex.Init(base.mgr)
+
 	p.SegmentFlushInterval = ParamItem{
 		Key:          "dataCoord.segmentFlushInterval",
 		Version:      "2.4.6",
@@ -3751,6 +4911,16 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.MaxSizeInMBPerImportTask.Init(base.mgr)
 
+	p.MaxSizeInMBPerExportTask = ParamItem{
+		Key:          "dataCoord.import.maxSizeInMBPerExportTask",
+		Version:      "2.4.7",
+		Doc:          "The segments of an export job are grouped by size, this parameter represents the sum of segment sizes in each group (each ExportTask).",
+		DefaultValue: "2048",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxSizeInMBPerExportTask.Init(base.mgr)
+
 	p.ImportScheduleInterval = ParamItem{
 		Key:          "dataCoord.import.scheduleInterval",
 		Version:      "2.4.0",
@@ -3801,6 +4971,16 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.WaitForIndex.Init(base.mgr)
 
+	p.MaxImportTasksPerNode = ParamItem{
+		Key:          "dataCoord.import.maxConcurrentTaskPerDataNode",
+		Version:      "2.4.7",
+		Doc:          "The maximum number of the concurrent preimport and import tasks dispatched to each DataNode, 0 means limited by the slots of the DataNode only.",
+		DefaultValue: "0",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxImportTasksPerNode.Init(base.mgr)
+
 	p.GracefulStopTimeout = ParamItem{
 		Key:          "dataCoord.gracefulStopTimeout",
 		Version:      "2.3.7",
@@ -3839,6 +5019,98 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 		Export:       true,
 	}
 	p.L0DeleteCompactionSlotUsage.Init(base.mgr)
+
+	p.DuplicatePKMaxSamples = ParamItem{
+		Key:          "dataCoord.duplicatePK.maxSamples",
+		Version:      "2.4.7",
+		Doc:          "max number of the duplicate primary keys listed in the report of duplicate primary keys check.",
+		DefaultValue: "100",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.DuplicatePKMaxSamples.Init(base.mgr)
+
+	p.SlowTaskDetectEnabled = ParamItem{
+		Key:          "dataCoord.slowTask.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "true",
+		Doc: `whether to capture the diagnostics of the slow index builds, stats, analyze and compaction tasks into object storage,
+the task is slow once it runs longer than the duration expected from its size`,
+		Export: true,
+	}
+	p.SlowTaskDetectEnabled.Init(base.mgr)
+
+	p.SlowTaskDetectInterval = ParamItem{
+		Key:          "dataCoord.slowTask.checkInterval",
+		Version:      "2.4.7",
+		DefaultValue: "60",
+		Doc:          "interval in seconds to check the running tasks for the slow ones",
+		Export:       true,
+	}
+	p.SlowTaskDetectInterval.Init(base.mgr)
+
+	p.SlowTaskBaseDuration = ParamItem{
+		Key:          "dataCoord.slowTask.baseDuration",
+		Version:      "2.4.7",
+		DefaultValue: "1800",
+		Doc:          "expected duration in seconds of a task regardless of its size",
+		Export:       true,
+	}
+	p.SlowTaskBaseDuration.Init(base.mgr)
+
+	p.SlowTaskThroughput = ParamItem{
+		Key:          "dataCoord.slowTask.throughput",
+		Version:      "2.4.7",
+		DefaultValue: "4",
+		Doc:          "expected throughput in MB per second of a task, the size of the task divided by it is added to the base duration",
+		Export:       true,
+	}
+	p.SlowTaskThroughput.Init(base.mgr)
+
+	p.DataNodeHealthCheckInterval = ParamItem{
+		Key:          "dataCoord.dataNodeHealthCheck.interval",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc: `interval in seconds between two rounds of health check of the datanodes when common.session.fastDetection is enabled,
+0 means following common.session.fastDetection.probeInterval`,
+		Export: true,
+	}
+	p.DataNodeHealthCheckInterval.Init(base.mgr)
+
+	p.DataNodeHealthCheckTimeout = ParamItem{
+		Key:          "dataCoord.dataNodeHealthCheck.timeout",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc:          "timeout in seconds of a single health check of a datanode, 0 means following common.session.fastDetection.probeTimeout",
+		Export:       true,
+	}
+	p.DataNodeHealthCheckTimeout.Init(base.mgr)
+
+	p.SessionRPCTimeout = ParamItem{
+		Key:          "dataCoord.sessionRPC.timeout",
+		Version:      "2.4.7",
+		DefaultValue: "10",
+		Doc:          "default timeout in seconds of each attempt of the rpcs from datacoord to the datanodes",
+		Export:       true,
+	}
+	p.SessionRPCTimeout.Init(base.mgr)
+
+	p.SessionRPCMaxRetries = ParamItem{
+		Key:          "dataCoord.sessionRPC.maxRetries",
+		Version:      "2.4.7",
+		DefaultValue: "0",
+		Doc:          "default max retry times of the failed rpcs from datacoord to the datanodes",
+		Export:       true,
+	}
+	p.SessionRPCMaxRetries.Init(base.mgr)
+
+	p.SessionRPCMethodOverrides = ParamGroup{
+		KeyPrefix: "dataCoord.sessionRPC.methods.",
+		Version:   "2.4.7",
+		Doc: `timeout in seconds and max retry times of specified rpc method to the datanodes, which override the default ones,
+e.g. dataCoord.sessionRPC.methods.importv2.timeout: 30, dataCoord.sessionRPC.methods.importv2.maxRetries: 3, 0 timeout means no timeout`,
+	}
+	p.SessionRPCMethodOverrides.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -3877,6 +5149,8 @@ type dataNodeConfig struct {
 
 	// DataNode send timetick interval per collection
 	DataNodeTimeTickInterval ParamItem `refreshable:"false"`
+	// the max interval to send timetick of the channels without data
+	SilentChannelTimeTickMaxInterval ParamItem `refreshable:"true"`
 
 	// Skip BF
 	SkipBFStatsLoad ParamItem `refreshable:"true"`
@@ -3909,6 +5183,20 @@ type dataNodeConfig struct {
 	ClusteringCompactionWorkerPoolSize    ParamItem `refreshable:"true"`
 
 	BloomFilterApplyParallelFactor ParamItem `refreshable:"true"`
+
+	// local write-ahead log
+	LocalWALEnabled  ParamItem `refreshable:"false"`
+	LocalWALPath     ParamItem `refreshable:"false"`
+	LocalWALFileSize ParamItem `refreshable:"true"`
+
+	// vector statistics
+	VectorStatsPolicy ParamItem `refreshable:"true"`
+
+	// vector quantization
+	QuantizationType ParamItem `refreshable:"true"`
+	QuantizationPQM  ParamItem `refreshable:"true"`
+
+	Labels ParamGroup `refreshable:"false"`
 }
 
 func (p *dataNodeConfig) init(base *BaseTable) {
@@ -4091,6 +5379,17 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.DataNodeTimeTickInterval.Init(base.mgr)
 
+	p.SilentChannelTimeTickMaxInterval = ParamItem{
+		Key:          "dataNode.timetick.silentChannelMaxInterval",
+		Version:      "2.4.7",
+		DefaultValue: "5000",
+		Doc: `ms, the max interval to send the timetick of the silent channels without inserted data,
+the interval of a silent channel doubles from dataNode.timetick.interval up to it,
+and resumes as soon as the data appears. Set it no larger than dataNode.timetick.interval to disable.`,
+		Export: true,
+	}
+	p.SilentChannelTimeTickMaxInterval.Init(base.mgr)
+
 	p.SkipBFStatsLoad = ParamItem{
 		Key:          "dataNode.skip.BFStats.Load",
 		Version:      "2.2.5",
@@ -4253,17 +5552,96 @@ if this parameter <= 0, will set it as 10`,
 		Export:       true,
 	}
 	p.BloomFilterApplyParallelFactor.Init(base.mgr)
+
+	p.LocalWALEnabled = ParamItem{
+		Key:          "dataNode.localWAL.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc: `persist the consumed msg packs of each channel in a write-ahead log on local disk,
+a restarted datanode rebuilds its write buffer from the log instead of re-consuming the backlog from mq,
+the log is validated by checksum and the channel falls back to consume from mq on any corruption`,
+		Export: true,
+	}
+	p.LocalWALEnabled.Init(base.mgr)
+
+	p.LocalWALPath = ParamItem{
+		Key:          "dataNode.localWAL.path",
+		Version:      "2.4.7",
+		DefaultValue: "",
+		Formatter: func(v string) string {
+			if len(v) == 0 {
+				return path.Join(base.Get("localStorage.path"), "datanode_wal")
+			}
+			return v
+		},
+		Doc:    "directory of the local write-ahead log, default to localStorage.path/datanode_wal, must not be shared by different datanodes",
+		Export: true,
+	}
+	p.LocalWALPath.Init(base.mgr)
+
+	p.LocalWALFileSize = ParamItem{
+		Key:          "dataNode.localWAL.fileSize",
+		Version:      "2.4.7",
+		DefaultValue: "64m",
+		Doc:          "the local write-ahead log rolls to a new file once the file exceeds this size, the files covered by the channel checkpoint are removed",
+		Export:       true,
+	}
+	p.LocalWALFileSize.Init(base.mgr)
+
+	p.VectorStatsPolicy = ParamItem{
+		Key:          "dataNode.vectorStats.policy",
+		Version:      "2.4.7",
+		DefaultValue: "disabled",
+		Doc: `policy of vector statistics collected at ingest, the statistics includes zero vectors,
+vectors containing NaN or Inf and the norm distribution, persisted in statslog of the flushed segments.
+disabled: no statistics is collected;
+flag: collect the statistics, and log the corrupt batches with NaN/Inf vectors or mismatched dimension;
+reject: collect the statistics, and discard the corrupt batches`,
+		Export: true,
+	}
+	p.VectorStatsPolicy.Init(base.mgr)
+
+	p.QuantizationType = ParamItem{
+		Key:          "dataNode.quantization.type",
+		Version:      "2.4.7",
+		DefaultValue: "",
+		Doc: `type of the quantized codes persisted alongside the raw vectors during flush and compaction,
+the codes are kept next to the binlogs and removed along with them, the QueryNodes still load the raw vectors.
+SQ8: quantize each dimension into one byte; PQ: product quantization with pqM sub-quantizers of 256 centroids;
+empty means no quantized codes are persisted`,
+		Export: true,
+	}
+	p.QuantizationType.Init(base.mgr)
+
+	p.QuantizationPQM = ParamItem{
+		Key:          "dataNode.quantization.pqM",
+		Version:      "2.4.7",
+		DefaultValue: "8",
+		Doc:          "the number of the PQ sub-quantizers, the vector fields with the dimension not divisible by it are not quantized",
+		Export:       true,
+	}
+	p.QuantizationPQM.Init(base.mgr)
+
+	p.Labels = ParamGroup{
+		KeyPrefix: "dataNode.labels.",
+		Version:   "2.4.7",
+		Doc: `labels of the datanode published in its session, the collections with property collection.datanode.labels
+are only watched by the datanodes with all the labels, e.g. dataNode.labels.tenant: a`,
+	}
+	p.Labels.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
 // --- indexnode ---
 type indexNodeConfig struct {
-	BuildParallel ParamItem `refreshable:"false"`
+	BuildParallel ParamItem `refreshable:"true"`
 	// enable disk
 	EnableDisk             ParamItem `refreshable:"false"`
 	DiskCapacityLimit      ParamItem `refreshable:"true"`
 	MaxDiskUsagePercentage ParamItem `refreshable:"true"`
 
+	BuildWithMmapMemoryRatio ParamItem `refreshable:"true"`
+
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 }
 
@@ -4322,6 +5700,16 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 	}
 	p.MaxDiskUsagePercentage.Init(base.mgr)
 
+	p.BuildWithMmapMemoryRatio = ParamItem{
+		Key:          "indexNode.buildWithMmapMemoryRatio",
+		Version:      "2.4.7",
+		DefaultValue: "0.1",
+		Doc: `the FLAT and IVF indexes are built over the raw data mmaped from the local disk instead of loaded in memory,
+if the raw data of the segment is larger than the ratio of the memory of IndexNode, 0 means never build with mmap`,
+		Export: true,
+	}
+	p.BuildWithMmapMemoryRatio.Init(base.mgr)
+
 	p.GracefulStopTimeout = ParamItem{
 		Key:          "indexNode.gracefulStopTimeout",
 		Version:      "2.2.1",
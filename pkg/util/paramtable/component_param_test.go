@@ -17,6 +17,7 @@
 package paramtable
 
 import (
+	"path"
 	"testing"
 	"time"
 
@@ -58,6 +59,8 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.IndexSliceSize.GetAsInt64(), int64(DefaultIndexSliceSize))
 		t.Logf("knowhere index slice size = %d", Params.IndexSliceSize.GetAsInt64())
 
+		assert.Equal(t, "", Params.GatedCPUFeatures.GetValue())
+
 		assert.Equal(t, Params.GracefulTime.GetAsInt64(), int64(DefaultGracefulTime))
 		t.Logf("default grafeful time = %d", Params.GracefulTime.GetAsInt64())
 
@@ -120,6 +123,8 @@ func TestComponentParam(t *testing.T) {
 
 		assert.Equal(t, 1000, params.CommonCfg.BloomFilterApplyBatchSize.GetAsInt())
 
+		assert.False(t, Params.WireCompatibilityEnabled.GetAsBool())
+
 		params.Save("common.gcenabled", "false")
 		assert.False(t, Params.GCEnabled.GetAsBool())
 		params.Save("common.gchelper.enabled", "false")
@@ -130,6 +135,7 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 100, Params.MaximumGOGCConfig.GetAsInt())
 		params.Save("common.gchelper.minimumGoGC", "80")
 		assert.Equal(t, 80, Params.MinimumGOGCConfig.GetAsInt())
+		assert.Equal(t, "primary", Params.RegionReplicaRole.GetValue())
 	})
 
 	t.Run("test rootCoordConfig", func(t *testing.T) {
@@ -145,6 +151,10 @@ func TestComponentParam(t *testing.T) {
 		params.Save("rootCoord.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
 
+		assert.Equal(t, time.Minute, Params.TimeWindowPartitionCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 1, Params.TimeWindowPartitionPrecreateNum.GetAsInt())
+		assert.Equal(t, time.Minute, Params.TemporaryCollectionCheckInterval.GetAsDuration(time.Second))
+
 		SetCreateTime(time.Now())
 		SetUpdateTime(time.Now())
 	})
@@ -190,12 +200,33 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.RetryTimesOnReplica.GetAsInt(), 2)
 		assert.EqualValues(t, Params.HealthCheckTimeout.GetAsInt64(), 3000)
 
+		assert.False(t, Params.InsertShapingEnabled.GetAsBool())
+		assert.Equal(t, 16.0, Params.InsertShapingRatePerVChannel.GetAsFloat())
+		assert.Equal(t, 5*time.Second, Params.InsertShapingMaxQueueDelay.GetAsDuration(time.Millisecond))
+		assert.False(t, Params.DescribeCacheEnabled.GetAsBool())
+		assert.Equal(t, 10*time.Second, Params.DescribeCacheTTL.GetAsDuration(time.Second))
+		assert.Equal(t, time.Duration(0), Params.DescribeCacheStaleWhileRevalidate.GetAsDuration(time.Second))
+
+		assert.Empty(t, Params.BatchResourceGroups.GetValue())
+		params.Save(Params.BatchResourceGroups.Key, "rg1,rg2")
+		assert.Equal(t, []string{"rg1", "rg2"}, Params.BatchResourceGroups.GetAsStrings())
+		assert.Equal(t, time.Hour, Params.QueryCursorTTL.GetAsDuration(time.Second))
+		assert.Equal(t, 5*time.Minute, Params.SLOShortWindow.GetAsDuration(time.Second))
+		assert.Equal(t, time.Hour, Params.SLOLongWindow.GetAsDuration(time.Second))
+
 		params.Save("proxy.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
 
 		assert.False(t, Params.MustUsePartitionKey.GetAsBool())
 		params.Save("proxy.mustUsePartitionKey", "true")
 		assert.True(t, Params.MustUsePartitionKey.GetAsBool())
+		assert.True(t, Params.EnablePartitionKeyPruning.GetAsBool())
+		assert.Equal(t, 0.0, Params.SearchVerificationSampleRate.GetAsFloat())
+		assert.Equal(t, 0.9, Params.SearchVerificationRecall.GetAsFloat())
+		assert.Equal(t, 1, Params.SearchVerificationMaxRunning.GetAsInt())
+		assert.Equal(t, 5*time.Second, Params.CollectionWatchInterval.GetAsDuration(time.Second))
+		assert.True(t, Params.MetaCacheWarmupEnabled.GetAsBool())
+		assert.Equal(t, 60*time.Second, Params.MetaCacheRefreshInterval.GetAsDuration(time.Second))
 
 		assert.False(t, Params.SkipAutoIDCheck.GetAsBool())
 		params.Save("proxy.skipAutoIDCheck", "true")
@@ -342,6 +373,12 @@ func TestComponentParam(t *testing.T) {
 
 		assert.Equal(t, 0.1, Params.DelegatorMemoryOverloadFactor.GetAsFloat())
 		assert.Equal(t, 5, Params.CollectionBalanceSegmentBatchSize.GetAsInt())
+		assert.Equal(t, 30*time.Minute, Params.SafeRemoveNodeTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, 0.0, Params.SegmentSwapRate.GetAsFloat())
+		assert.Equal(t, time.Hour, Params.SegmentSwapMaxDelay.GetAsDuration(time.Second))
+		assert.Equal(t, time.Duration(0), Params.RowCountCheckInterval.GetAsDuration(time.Second))
+		assert.False(t, Params.RowCountCheckAutoRepair.GetAsBool())
+		assert.Equal(t, 3, Params.RowCountCheckMaxRepairTimes.GetAsInt())
 	})
 
 	t.Run("test queryNodeConfig", func(t *testing.T) {
@@ -443,6 +480,10 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 3*time.Second, Params.LazyLoadRequestResourceRetryInterval.GetAsDuration(time.Millisecond))
 
 		assert.Equal(t, 4, Params.BloomFilterApplyParallelFactor.GetAsInt())
+
+		assert.True(t, Params.DiskJanitorEnabled.GetAsBool())
+		assert.Equal(t, 10*time.Minute, Params.DiskJanitorInterval.GetAsDuration(time.Second))
+		assert.Equal(t, time.Hour, Params.DiskJanitorGracePeriod.GetAsDuration(time.Second))
 	})
 
 	t.Run("test dataCoordConfig", func(t *testing.T) {
@@ -451,19 +492,36 @@ func TestComponentParam(t *testing.T) {
 		assert.True(t, Params.EnableGarbageCollection.GetAsBool())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.True(t, Params.StandbyWarmCacheEnabled.GetAsBool())
+		assert.Equal(t, 30*time.Second, Params.StandbyWarmCacheCatchUpTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, 60*time.Second, Params.RegionReplicaShipInterval.GetAsDuration(time.Second))
+		assert.Equal(t, "", Params.RegionReplicaPrimaryEtcdEndpoints.GetValue())
+		assert.Equal(t, "by-dev/meta", Params.RegionReplicaPrimaryMetaRootPath.GetValue())
+		assert.Equal(t, 600*time.Second, Params.SegmentReferenceDefaultLease.GetAsDuration(time.Second))
+		assert.Equal(t, 86400*time.Second, Params.SegmentReferenceMaxLease.GetAsDuration(time.Second))
 		assert.Equal(t, int64(4096), Params.GrowingSegmentsMemSizeInMB.GetAsInt64())
 
 		assert.Equal(t, true, Params.AutoBalance.GetAsBool())
 		assert.Equal(t, 10, Params.CheckAutoBalanceConfigInterval.GetAsInt())
 		assert.Equal(t, false, Params.AutoUpgradeSegmentIndex.GetAsBool())
+		assert.False(t, Params.IndexUpgradeEnabled.GetAsBool())
+		assert.Equal(t, time.Minute, Params.IndexUpgradeInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10, Params.IndexUpgradeMaxSegments.GetAsInt())
+		assert.Equal(t, "rewrite", Params.IndexUpgradePolicy.GetValue())
+		assert.Equal(t, "", Params.IndexUpgradeWindow.GetValue())
+		assert.False(t, Params.ReuseIdenticalIndex.GetAsBool())
+		assert.True(t, Params.DelayOnIndexBuilding.GetAsBool())
+		assert.Equal(t, 30*time.Minute, Params.DelayOnIndexBuildingMaxTime.GetAsDuration(time.Second))
 		assert.Equal(t, 2, Params.FilesPerPreImportTask.GetAsInt())
 		assert.Equal(t, 10800*time.Second, Params.ImportTaskRetention.GetAsDuration(time.Second))
 		assert.Equal(t, 6144, Params.MaxSizeInMBPerImportTask.GetAsInt())
+		assert.Equal(t, 2048, Params.MaxSizeInMBPerExportTask.GetAsInt())
 		assert.Equal(t, 2*time.Second, Params.ImportScheduleInterval.GetAsDuration(time.Second))
 		assert.Equal(t, 2*time.Second, Params.ImportCheckIntervalHigh.GetAsDuration(time.Second))
 		assert.Equal(t, 120*time.Second, Params.ImportCheckIntervalLow.GetAsDuration(time.Second))
 		assert.Equal(t, 1024, Params.MaxFilesPerImportReq.GetAsInt())
 		assert.Equal(t, true, Params.WaitForIndex.GetAsBool())
+		assert.Equal(t, 0, Params.MaxImportTasksPerNode.GetAsInt())
 
 		params.Save("datacoord.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
@@ -472,6 +530,9 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, float64(100), Params.CompactionGCIntervalInSeconds.GetAsDuration(time.Second).Seconds())
 		params.Save("dataCoord.compaction.dropTolerance", "100")
 		assert.Equal(t, float64(100), Params.CompactionDropToleranceInSeconds.GetAsDuration(time.Second).Seconds())
+		assert.Equal(t, 1000, Params.CompactionHistorySize.GetAsInt())
+		assert.Equal(t, 0.0, Params.CompactionIOBudget.GetAsFloat())
+		assert.Equal(t, 16.0, Params.CompactionMinIORatePerPlan.GetAsFloat())
 
 		params.Save("dataCoord.compaction.clustering.enable", "true")
 		assert.Equal(t, true, Params.ClusteringCompactionEnable.GetAsBool())
@@ -483,16 +544,72 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, int64(10*1024*1024), Params.ClusteringCompactionNewDataSizeThreshold.GetAsSize())
 		params.Save("dataCoord.compaction.clustering.newDataSizeThreshold", "10g")
 		assert.Equal(t, int64(10*1024*1024*1024), Params.ClusteringCompactionNewDataSizeThreshold.GetAsSize())
+		assert.Equal(t, 0.5, Params.ClusteringCompactionDriftThreshold.GetAsFloat())
 		params.Save("dataCoord.compaction.clustering.maxSegmentSize", "100m")
 		assert.Equal(t, int64(100*1024*1024), Params.ClusteringCompactionMaxSegmentSize.GetAsSize())
 		params.Save("dataCoord.compaction.clustering.preferSegmentSize", "10m")
 		assert.Equal(t, int64(10*1024*1024), Params.ClusteringCompactionPreferSegmentSize.GetAsSize())
+
+		assert.Equal(t, true, Params.ExpiryCompactionEnable.GetAsBool())
+		assert.Equal(t, 3600*time.Second, Params.ExpiryCompactionTriggerInterval.GetAsDuration(time.Second))
+		params.Save("dataCoord.compaction.expiry.ratioThreshold", "0.5")
+		assert.Equal(t, 0.5, Params.ExpiryCompactionRatioThreshold.GetAsFloat())
 		params.Save("dataCoord.slot.clusteringCompactionUsage", "10")
 		assert.Equal(t, 10, Params.ClusteringCompactionSlotUsage.GetAsInt())
 		params.Save("dataCoord.slot.mixCompactionUsage", "5")
 		assert.Equal(t, 5, Params.MixCompactionSlotUsage.GetAsInt())
 		params.Save("dataCoord.slot.l0DeleteCompactionUsage", "4")
 		assert.Equal(t, 4, Params.L0DeleteCompactionSlotUsage.GetAsInt())
+
+		assert.Equal(t, 100, Params.DuplicatePKMaxSamples.GetAsInt())
+		assert.False(t, Params.ChannelBalanceByLoad.GetAsBool())
+		assert.Equal(t, 1.0, Params.ChannelLoadRowRateWeight.GetAsFloat())
+		assert.Equal(t, 1.0, Params.ChannelLoadCountWeight.GetAsFloat())
+		assert.Equal(t, 0.3, Params.ChannelLoadUnbalanceToleration.GetAsFloat())
+		assert.Equal(t, 10*time.Second, Params.ChannelWriteRateWindow.GetAsDuration(time.Second))
+		assert.Equal(t, 3.0, Params.HotChannelWriteRateRatio.GetAsFloat())
+		assert.Equal(t, 0.5, Params.HotChannelGrowingSizeRatio.GetAsFloat())
+
+		assert.Equal(t, 1000, Params.GCRemoveBatchSize.GetAsInt())
+
+		assert.True(t, Params.SlowTaskDetectEnabled.GetAsBool())
+		assert.Equal(t, time.Minute, Params.SlowTaskDetectInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 30*time.Minute, Params.SlowTaskBaseDuration.GetAsDuration(time.Second))
+		assert.Equal(t, 4.0, Params.SlowTaskThroughput.GetAsFloat())
+
+		assert.Equal(t, 0, Params.DataNodeHealthCheckInterval.GetAsInt())
+		assert.Equal(t, 0, Params.DataNodeHealthCheckTimeout.GetAsInt())
+		params.Save("dataCoord.dataNodeHealthCheck.timeout", "1")
+		assert.Equal(t, time.Second, Params.DataNodeHealthCheckTimeout.GetAsDuration(time.Second))
+
+		assert.Equal(t, 10*time.Second, Params.SessionRPCTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, 0, Params.SessionRPCMaxRetries.GetAsInt())
+		params.SaveGroup(map[string]string{Params.SessionRPCMethodOverrides.KeyPrefix + "importv2.timeout": "30"})
+		assert.Equal(t, map[string]string{"importv2.timeout": "30"}, Params.SessionRPCMethodOverrides.GetValue())
+
+		params.Save("dataCoord.gc.removeQPS", "10")
+		assert.Equal(t, 10.0, Params.GCRemoveQPS.GetAsFloat())
+
+		assert.Equal(t, 3*time.Hour, Params.IndexTaskTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Second, Params.IndexTaskDrainTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, 10, Params.IndexTaskMaxRetryTimes.GetAsInt())
+		assert.Equal(t, time.Second, Params.IndexTaskRetryBackoff.GetAsDuration(time.Millisecond))
+		assert.Equal(t, time.Minute, Params.IndexTaskRetryMaxBackoff.GetAsDuration(time.Millisecond))
+		assert.False(t, Params.SpeculativeIndexBuildEnabled.GetAsBool())
+		assert.Equal(t, 3.0, Params.SpeculativeIndexBuildSlowRatio.GetAsFloat())
+		assert.Equal(t, 0.05, Params.SpeculativeIndexBuildMaxRatio.GetAsFloat())
+		assert.Equal(t, 20, Params.SpeculativeIndexBuildMinSamples.GetAsInt())
+		assert.Equal(t, 3, Params.IndexJobWeight.GetAsInt())
+		assert.Equal(t, 1, Params.AnalyzeJobWeight.GetAsInt())
+		assert.Equal(t, 1, Params.StatsJobWeight.GetAsInt())
+		assert.False(t, Params.EnableStatsJob.GetAsBool())
+		assert.Equal(t, 0, Params.TenantMaxConcurrentTasks.GetAsInt())
+		assert.Equal(t, 0, Params.TenantMaxQueuedTasks.GetAsInt())
+		assert.Equal(t, 60*time.Second, Params.DatabaseQuotaCacheTTL.GetAsDuration(time.Second))
+		assert.Equal(t, 1.2, Params.IndexDiskHeadroomRatio.GetAsFloat())
+		assert.Equal(t, 5, Params.IndexNodeQuarantineFailureThreshold.GetAsInt())
+		assert.Equal(t, time.Minute, Params.IndexNodeQuarantineFailureWindow.GetAsDuration(time.Second))
+		assert.Equal(t, 5*time.Minute, Params.IndexNodeQuarantineCoolDown.GetAsDuration(time.Second))
 	})
 
 	t.Run("test dataNodeConfig", func(t *testing.T) {
@@ -554,6 +671,21 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, int64(2), Params.ClusteringCompactionWorkerPoolSize.GetAsInt64())
 
 		assert.Equal(t, 4, Params.BloomFilterApplyParallelFactor.GetAsInt())
+
+		// local wal
+		assert.False(t, Params.LocalWALEnabled.GetAsBool())
+		assert.Equal(t, path.Join(params.LocalStorageCfg.Path.GetValue(), "datanode_wal"), Params.LocalWALPath.GetValue())
+		params.Save("dataNode.localWAL.path", "/tmp/milvus_wal")
+		assert.Equal(t, "/tmp/milvus_wal", Params.LocalWALPath.GetValue())
+		assert.Equal(t, int64(64*1024*1024), Params.LocalWALFileSize.GetAsSize())
+		assert.Empty(t, Params.QuantizationType.GetValue())
+		assert.Equal(t, 8, Params.QuantizationPQM.GetAsInt())
+
+		assert.Equal(t, 5*time.Second, Params.SilentChannelTimeTickMaxInterval.GetAsDuration(time.Millisecond))
+
+		assert.Empty(t, Params.Labels.GetValue())
+		params.SaveGroup(map[string]string{Params.Labels.KeyPrefix + "tenant": "a"})
+		assert.Equal(t, map[string]string{"tenant": "a"}, Params.Labels.GetValue())
 	})
 
 	t.Run("test indexNodeConfig", func(t *testing.T) {
@@ -563,6 +695,8 @@ func TestComponentParam(t *testing.T) {
 
 		params.Save("indexnode.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
+
+		assert.Equal(t, 0.1, Params.BuildWithMmapMemoryRatio.GetAsFloat())
 	})
 
 	t.Run("test streamingCoordConfig", func(t *testing.T) {
@@ -629,6 +763,9 @@ func TestCachedParam(t *testing.T) {
 
 	assert.Equal(t, int64(1024), params.DataCoordCfg.SegmentMaxSize.GetAsInt64())
 	assert.Equal(t, int64(1024), params.DataCoordCfg.SegmentMaxSize.GetAsInt64())
+	assert.Equal(t, "schema", params.DataCoordCfg.SegmentSizePolicy.GetValue())
+	assert.Equal(t, time.Duration(0), params.DataCoordCfg.SegmentInactiveSealTime.GetAsDuration(time.Second))
+	assert.Equal(t, 256, params.DataCoordCfg.SegmentVarCharAvgLength.GetAsInt())
 
 	assert.Equal(t, 0.85, params.QuotaConfig.DataNodeMemoryLowWaterLevel.GetAsFloat())
 	assert.Equal(t, 0.85, params.QuotaConfig.DataNodeMemoryLowWaterLevel.GetAsFloat())
@@ -148,6 +148,11 @@ type GrpcServerConfig struct {
 	ServerMaxRecvSize ParamItem `refreshable:"false"`
 
 	GracefulStopTimeout ParamItem `refreshable:"true"`
+
+	APILimitEnabled   ParamItem `refreshable:"true"`
+	APIMaxConcurrency ParamItem `refreshable:"true"`
+	APIMaxQueueTime   ParamItem `refreshable:"true"`
+	APIRatePerCaller  ParamItem `refreshable:"true"`
 }
 
 func (p *GrpcServerConfig) Init(domain string, base *BaseTable) {
@@ -205,6 +210,42 @@ func (p *GrpcServerConfig) Init(domain string, base *BaseTable) {
 		Export:       true,
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
+
+	p.APILimitEnabled = ParamItem{
+		Key:          p.Domain + ".grpc.apiLimit.enabled",
+		Version:      "2.4.7",
+		DefaultValue: "false",
+		Doc:          "whether to limit the concurrency of each API and the rate of each external caller, only works for the coordinators",
+		Export:       true,
+	}
+	p.APILimitEnabled.Init(base.mgr)
+
+	p.APIMaxConcurrency = ParamItem{
+		Key:          p.Domain + ".grpc.apiLimit.maxConcurrency",
+		Version:      "2.4.7",
+		DefaultValue: "256",
+		Doc:          "max number of the concurrent calls of each API, the exceeding calls are queued and the internal callers are served first",
+		Export:       true,
+	}
+	p.APIMaxConcurrency.Init(base.mgr)
+
+	p.APIMaxQueueTime = ParamItem{
+		Key:          p.Domain + ".grpc.apiLimit.maxQueueTime",
+		Version:      "2.4.7",
+		DefaultValue: "3000",
+		Doc:          "ms, max time of a call waiting in the queue before rejected",
+		Export:       true,
+	}
+	p.APIMaxQueueTime.Init(base.mgr)
+
+	p.APIRatePerCaller = ParamItem{
+		Key:          p.Domain + ".grpc.apiLimit.ratePerCaller",
+		Version:      "2.4.7",
+		DefaultValue: "100",
+		Doc:          "max number of the calls per second of each external caller, the internal callers are not limited, 0 means unlimited",
+		Export:       true,
+	}
+	p.APIRatePerCaller.Init(base.mgr)
 }
 
 // GrpcClientConfig is configuration for grpc client.
@@ -212,6 +253,8 @@ type GrpcClientConfig struct {
 	grpcConfig
 
 	CompressionEnabled ParamItem `refreshable:"false"`
+	Compressor         ParamItem `refreshable:"false"`
+	CompressionMethods ParamItem `refreshable:"false"`
 
 	ClientMaxSendSize ParamItem `refreshable:"false"`
 	ClientMaxRecvSize ParamItem `refreshable:"false"`
@@ -431,6 +474,37 @@ func (p *GrpcClientConfig) Init(domain string, base *BaseTable) {
 	}
 	p.CompressionEnabled.Init(base.mgr)
 
+	p.Compressor = ParamItem{
+		Key:          "grpc.client.compressor",
+		Version:      "2.4.7",
+		DefaultValue: "zstd",
+		Formatter: func(v string) string {
+			switch v {
+			case "zstd", "gzip":
+				return v
+			default:
+				log.Warn("Unsupported grpc.client.compressor, set to default",
+					zap.String("role", p.Domain), zap.String("grpc.client.compressor", v))
+				return "zstd"
+			}
+		},
+		Doc:    "compressor of the compressed internal grpc calls, zstd or gzip",
+		Export: true,
+	}
+	p.Compressor.Init(base.mgr)
+
+	p.CompressionMethods = ParamItem{
+		Key:          "grpc.client.compressionMethods",
+		Version:      "2.4.7",
+		DefaultValue: "",
+		Doc: `internal grpc methods carrying large payloads, which are compressed even if compressionEnabled is false,
+e.g. GetRecoveryInfoV2,GetDataDistribution,SyncDistribution,ImportV2, empty by default.
+The response is compressed with the same compressor as the request. The compression is negotiated per connection,
+the calls fall back to uncompressed if the server does not support the compressor`,
+		Export: true,
+	}
+	p.CompressionMethods.Init(base.mgr)
+
 	p.MinResetInterval = ParamItem{
 		Key:          "grpc.client.minResetInterval",
 		DefaultValue: "1000",
@@ -496,7 +570,7 @@ func (p *GrpcClientConfig) Init(domain string, base *BaseTable) {
 func (p *GrpcClientConfig) GetDialOptionsFromConfig() []grpc.DialOption {
 	compress := ""
 	if p.CompressionEnabled.GetAsBool() {
-		compress = "zstd"
+		compress = p.Compressor.GetValue()
 	}
 	return []grpc.DialOption{
 		grpc.WithDefaultCallOptions(
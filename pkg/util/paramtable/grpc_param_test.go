@@ -69,6 +69,13 @@ func TestGrpcServerParams(t *testing.T) {
 
 	base.Save(serverConfig.GracefulStopTimeout.Key, "1")
 	assert.Equal(t, serverConfig.GracefulStopTimeout.GetAsInt(), 1)
+
+	assert.False(t, serverConfig.APILimitEnabled.GetAsBool())
+	assert.Equal(t, 256, serverConfig.APIMaxConcurrency.GetAsInt())
+	assert.Equal(t, 3*time.Second, serverConfig.APIMaxQueueTime.GetAsDuration(time.Millisecond))
+	assert.Equal(t, 100.0, serverConfig.APIRatePerCaller.GetAsFloat())
+	base.Save(role+".grpc.apiLimit.enabled", "true")
+	assert.True(t, serverConfig.APILimitEnabled.GetAsBool())
 }
 
 func TestGrpcClientParams(t *testing.T) {
@@ -150,6 +157,7 @@ func TestGrpcClientParams(t *testing.T) {
 	assert.Equal(t, clientConfig.CompressionEnabled.GetAsBool(), DefaultCompressionEnabled)
 	base.Save(clientConfig.CompressionEnabled.Key, "true")
 	assert.Equal(t, true, clientConfig.CompressionEnabled.GetAsBool())
+	assert.Empty(t, clientConfig.CompressionMethods.GetValue())
 
 	assert.Equal(t, clientConfig.MinResetInterval.GetValue(), "1000")
 	base.Save("grpc.client.minResetInterval", "abc")
@@ -131,6 +131,7 @@ type quotaConfig struct {
 	NQLimit                        ParamItem `refreshable:"true"`
 	MaxQueryResultWindow           ParamItem `refreshable:"true"`
 	MaxOutputSize                  ParamItem `refreshable:"true"`
+	MaxSearchResultEntries         ParamItem `refreshable:"true"`
 	MaxInsertSize                  ParamItem `refreshable:"true"`
 	MaxResourceGroupNumOfQueryNode ParamItem `refreshable:"true"`
 
@@ -1544,6 +1545,16 @@ Check https://milvus.io/docs/limitations.md for more details.`,
 	}
 	p.MaxOutputSize.Init(base.mgr)
 
+	p.MaxSearchResultEntries = ParamItem{
+		Key:          "quotaAndLimits.limits.maxSearchResultEntries",
+		Version:      "2.4.7",
+		DefaultValue: "-1",
+		Doc: `maximum number of entries that a single search reduce may merge from all sub results,
+which is roughly nq * topk * number of shards/segments, -1 means no limit`,
+		Export: true,
+	}
+	p.MaxSearchResultEntries.Init(base.mgr)
+
 	p.MaxInsertSize = ParamItem{
 		Key:          "quotaAndLimits.limits.maxInsertSize",
 		Version:      "2.4.1",
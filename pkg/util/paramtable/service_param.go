@@ -105,9 +105,12 @@ type EtcdConfig struct {
 	RequestTimeout    ParamItem          `refreshable:"false"`
 
 	// --- Embed ETCD ---
-	UseEmbedEtcd ParamItem `refreshable:"false"`
-	ConfigPath   ParamItem `refreshable:"false"`
-	DataDir      ParamItem `refreshable:"false"`
+	UseEmbedEtcd                 ParamItem `refreshable:"false"`
+	ConfigPath                   ParamItem `refreshable:"false"`
+	DataDir                      ParamItem `refreshable:"false"`
+	EmbedAutoCompactionRetention ParamItem `refreshable:"false"`
+	EmbedMaintenanceInterval     ParamItem `refreshable:"false"`
+	EmbedDefragThreshold         ParamItem `refreshable:"true"`
 
 	// --- ETCD Authentication ---
 	EtcdEnableAuth   ParamItem `refreshable:"false"`
@@ -154,6 +157,33 @@ func (p *EtcdConfig) Init(base *BaseTable) {
 	}
 	p.DataDir.Init(base.mgr)
 
+	p.EmbedAutoCompactionRetention = ParamItem{
+		Key:          "etcd.embed.autoCompactionRetention",
+		DefaultValue: "1h",
+		Version:      "2.4.7",
+		Doc:          "Embedded Etcd only. Retention of the periodic auto compaction of embedded Etcd, empty means disable. Config in etcd.config.path takes precedence.",
+		Export:       true,
+	}
+	p.EmbedAutoCompactionRetention.Init(base.mgr)
+
+	p.EmbedMaintenanceInterval = ParamItem{
+		Key:          "etcd.embed.maintenanceInterval",
+		DefaultValue: "3600",
+		Version:      "2.4.7",
+		Doc:          "Embedded Etcd only. Interval in seconds to check the db size of embedded Etcd and defragment it if needed.",
+		Export:       true,
+	}
+	p.EmbedMaintenanceInterval.Init(base.mgr)
+
+	p.EmbedDefragThreshold = ParamItem{
+		Key:          "etcd.embed.defragThreshold",
+		DefaultValue: "0.5",
+		Version:      "2.4.7",
+		Doc:          "Embedded Etcd only. Defragment embedded Etcd when the ratio of free space in its db exceeds the threshold, should be in (0, 1].",
+		Export:       true,
+	}
+	p.EmbedDefragThreshold.Init(base.mgr)
+
 	p.RootPath = ParamItem{
 		Key:          "etcd.rootPath",
 		Version:      "2.0.0",
@@ -429,7 +459,8 @@ func (p *TiKVConfig) Init(base *BaseTable) {
 }
 
 type LocalStorageConfig struct {
-	Path ParamItem `refreshable:"false"`
+	Path                    ParamItem `refreshable:"false"`
+	DiskUsageReportInterval ParamItem `refreshable:"false"`
 }
 
 func (p *LocalStorageConfig) Init(base *BaseTable) {
@@ -441,6 +472,15 @@ func (p *LocalStorageConfig) Init(base *BaseTable) {
 		Export:       true,
 	}
 	p.Path.Init(base.mgr)
+
+	p.DiskUsageReportInterval = ParamItem{
+		Key:          "localStorage.diskUsageReportInterval",
+		Version:      "2.4.7",
+		DefaultValue: "60",
+		Doc:          "Standalone only. Interval in seconds to report the disk usage of local data directories (embedded Etcd, rocksmq/natsmq and local storage).",
+		Export:       true,
+	}
+	p.DiskUsageReportInterval.Init(base.mgr)
 }
 
 type MetaStoreConfig struct {
@@ -1089,6 +1129,7 @@ type MinioConfig struct {
 	UseVirtualHost     ParamItem `refreshable:"false"`
 	RequestTimeoutMs   ParamItem `refreshable:"false"`
 	ListObjectsMaxKeys ParamItem `refreshable:"true"`
+	SlowOpThresholdMs  ParamItem `refreshable:"true"`
 }
 
 func (p *MinioConfig) Init(base *BaseTable) {
@@ -1266,4 +1307,13 @@ Leave it empty if you want to use AWS default endpoint`,
 		Export: true,
 	}
 	p.ListObjectsMaxKeys.Init(base.mgr)
+
+	p.SlowOpThresholdMs = ParamItem{
+		Key:          "minio.slowOpThresholdMs",
+		Version:      "2.4.7",
+		DefaultValue: "3000",
+		Doc:          "the chunk manager operations taking longer than the threshold in milliseconds are logged, 0 means never log",
+		Export:       true,
+	}
+	p.SlowOpThresholdMs.Init(base.mgr)
 }
@@ -101,8 +101,33 @@ func HashPK2Channels(primaryKeys *schemapb.IDs, shardNames []string) []uint32 {
 
 // HashKey2Partitions hash partition keys to partitions
 func HashKey2Partitions(keys *schemapb.FieldData, partitionNames []string) ([]uint32, error) {
-	var hashValues []uint32
+	hashValues, err := hashPartitionKeys(keys)
+	if err != nil {
+		return nil, err
+	}
 	numPartitions := uint32(len(partitionNames))
+	for i := range hashValues {
+		hashValues[i] %= numPartitions
+	}
+	return hashValues, nil
+}
+
+// HashKey2PartitionBuckets hash partition keys to the buckets of the partition key stats of the partitions
+// they are hashed to.
+func HashKey2PartitionBuckets(keys *schemapb.FieldData, partitionNames []string) ([]uint32, error) {
+	hashValues, err := hashPartitionKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+	numPartitions := uint32(len(partitionNames))
+	for i := range hashValues {
+		hashValues[i] = PartitionKeyBucket(hashValues[i], numPartitions)
+	}
+	return hashValues, nil
+}
+
+func hashPartitionKeys(keys *schemapb.FieldData) ([]uint32, error) {
+	var hashValues []uint32
 	switch keys.Field.(type) {
 	case *schemapb.FieldData_Scalars:
 		scalarField := keys.GetScalars()
@@ -111,13 +136,13 @@ func HashKey2Partitions(keys *schemapb.FieldData, partitionNames []string) ([]ui
 			longKeys := scalarField.GetLongData().Data
 			for _, key := range longKeys {
 				value, _ := Hash32Int64(key)
-				hashValues = append(hashValues, value%numPartitions)
+				hashValues = append(hashValues, value)
 			}
 		case *schemapb.ScalarField_StringData:
 			stringKeys := scalarField.GetStringData().Data
 			for _, key := range stringKeys {
 				value := HashString2Uint32(key)
-				hashValues = append(hashValues, value%numPartitions)
+				hashValues = append(hashValues, value)
 			}
 		default:
 			return nil, errors.New("currently only support DataType Int64 or VarChar as partition key Field")
@@ -129,6 +154,61 @@ func HashKey2Partitions(keys *schemapb.FieldData, partitionNames []string) ([]ui
 	return hashValues, nil
 }
 
+// PartitionKeyBucketNum is the number of the buckets of the partition key stats of a partition,
+// the stats record the buckets of the keys in the partition to prune the partitions without the searched keys.
+const PartitionKeyBucketNum = 1024
+
+// PartitionKeyBucket returns the bucket of the key with the hash value in the partition key stats,
+// the part of the hash value selecting the partition is excluded.
+func PartitionKeyBucket(hashValue uint32, numPartitions uint32) uint32 {
+	return hashValue / numPartitions % PartitionKeyBucketNum
+}
+
+// NewPartitionKeyBuckets returns the partition key stats without any bucket.
+func NewPartitionKeyBuckets() []byte {
+	return make([]byte, PartitionKeyBucketNum/8)
+}
+
+// IsPartitionKeyBucketsKnown returns whether the partition key stats are tracked,
+// the partitions created before tracking the stats are considered to contain all the buckets.
+func IsPartitionKeyBucketsKnown(buckets []byte) bool {
+	return len(buckets) == PartitionKeyBucketNum/8
+}
+
+// HasPartitionKeyBucket returns whether the partition key stats contain the bucket.
+func HasPartitionKeyBucket(buckets []byte, bucket uint32) bool {
+	if !IsPartitionKeyBucketsKnown(buckets) {
+		return true
+	}
+	bucket %= PartitionKeyBucketNum
+	return buckets[bucket/8]&(1<<(bucket%8)) != 0
+}
+
+// SetPartitionKeyBucket adds the bucket to the partition key stats, returns false if it's contained already.
+func SetPartitionKeyBucket(buckets []byte, bucket uint32) bool {
+	if HasPartitionKeyBucket(buckets, bucket) {
+		return false
+	}
+	bucket %= PartitionKeyBucketNum
+	buckets[bucket/8] |= 1 << (bucket % 8)
+	return true
+}
+
+// MergePartitionKeyBuckets adds the buckets of src to dst, returns whether dst is changed.
+func MergePartitionKeyBuckets(dst []byte, src []byte) bool {
+	if !IsPartitionKeyBucketsKnown(dst) || !IsPartitionKeyBucketsKnown(src) {
+		return false
+	}
+	changed := false
+	for i := range dst {
+		if merged := dst[i] | src[i]; merged != dst[i] {
+			dst[i] = merged
+			changed = true
+		}
+	}
+	return changed
+}
+
 // this method returns a static sequence for partitions for partiton key mode
 func RearrangePartitionsForPartitionKey(partitions map[string]int64) ([]string, []int64, error) {
 	// Make sure the order of the partition names got every time is the same
@@ -232,3 +232,54 @@ func TestRearrangePartitionsForPartitionKey(t *testing.T) {
 		"p_0": 1,
 	})
 }
+
+func TestHashKey2PartitionBuckets(t *testing.T) {
+	partitionNames := []string{"p_0", "p_1", "p_2"}
+	keys := &schemapb.FieldData{
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{Data: []string{"a", "b", "c"}},
+				},
+			},
+		},
+	}
+	partitions, err := HashKey2Partitions(keys, partitionNames)
+	assert.NoError(t, err)
+	buckets, err := HashKey2PartitionBuckets(keys, partitionNames)
+	assert.NoError(t, err)
+	assert.Equal(t, len(partitions), len(buckets))
+	for i, key := range []string{"a", "b", "c"} {
+		hashValue := HashString2Uint32(key)
+		assert.Equal(t, hashValue%3, partitions[i])
+		assert.Equal(t, hashValue/3%PartitionKeyBucketNum, buckets[i])
+	}
+
+	_, err = HashKey2PartitionBuckets(&schemapb.FieldData{
+		Field: &schemapb.FieldData_Vectors{},
+	}, partitionNames)
+	assert.Error(t, err)
+}
+
+func TestPartitionKeyBuckets(t *testing.T) {
+	// the unknown stats contain all the buckets
+	assert.False(t, IsPartitionKeyBucketsKnown(nil))
+	assert.True(t, HasPartitionKeyBucket(nil, 1))
+	assert.False(t, SetPartitionKeyBucket(nil, 1))
+
+	buckets := NewPartitionKeyBuckets()
+	assert.True(t, IsPartitionKeyBucketsKnown(buckets))
+	assert.False(t, HasPartitionKeyBucket(buckets, 9))
+	assert.True(t, SetPartitionKeyBucket(buckets, 9))
+	assert.False(t, SetPartitionKeyBucket(buckets, 9))
+	assert.True(t, HasPartitionKeyBucket(buckets, 9))
+	assert.False(t, HasPartitionKeyBucket(buckets, 8))
+
+	other := NewPartitionKeyBuckets()
+	SetPartitionKeyBucket(other, PartitionKeyBucketNum-1)
+	assert.True(t, MergePartitionKeyBuckets(buckets, other))
+	assert.False(t, MergePartitionKeyBuckets(buckets, other))
+	assert.True(t, HasPartitionKeyBucket(buckets, PartitionKeyBucketNum-1))
+	assert.True(t, HasPartitionKeyBucket(buckets, 9))
+	assert.False(t, MergePartitionKeyBuckets(nil, other))
+}
@@ -102,7 +102,28 @@ func EstimateAvgSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 	return estimateSizeBy(schema, avg)
 }
 
+// EstimateSizePerRecordWithVarCharLength returns the estimate size of a record in a collection, with the
+// varchar fields of the given average length, which is capped by the max_length of the field.
+func EstimateSizePerRecordWithVarCharLength(schema *schemapb.CollectionSchema, avgLength int) (int, error) {
+	return estimateSizeWith(schema, func(fieldSchema *schemapb.FieldSchema) (int, error) {
+		maxLength, err := getVarFieldLength(fieldSchema, max)
+		if err != nil {
+			return 0, err
+		}
+		if fieldSchema.GetDataType() == schemapb.DataType_VarChar && avgLength < maxLength {
+			return avgLength, nil
+		}
+		return maxLength, nil
+	})
+}
+
 func estimateSizeBy(schema *schemapb.CollectionSchema, policy getVariableFieldLengthPolicy) (int, error) {
+	return estimateSizeWith(schema, func(fieldSchema *schemapb.FieldSchema) (int, error) {
+		return getVarFieldLength(fieldSchema, policy)
+	})
+}
+
+func estimateSizeWith(schema *schemapb.CollectionSchema, varFieldLength func(fieldSchema *schemapb.FieldSchema) (int, error)) (int, error) {
 	res := 0
 	for _, fs := range schema.Fields {
 		switch fs.DataType {
@@ -115,7 +136,7 @@ func estimateSizeBy(schema *schemapb.CollectionSchema, policy getVariableFieldLe
 		case schemapb.DataType_Int64, schemapb.DataType_Double:
 			res += 8
 		case schemapb.DataType_VarChar, schemapb.DataType_Array, schemapb.DataType_JSON:
-			maxLengthPerRow, err := getVarFieldLength(fs, policy)
+			maxLengthPerRow, err := varFieldLength(fs)
 			if err != nil {
 				return 0, err
 			}
@@ -175,6 +175,17 @@ func TestSchema(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("EstimateSizePerRecordWithVarCharLength", func(t *testing.T) {
+		size, err := EstimateSizePerRecordWithVarCharLength(schema, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, 680-25+DynamicFieldMaxLength*3, size)
+
+		// capped by the max_length
+		size, err = EstimateSizePerRecordWithVarCharLength(schema, 1000)
+		assert.NoError(t, err)
+		assert.Equal(t, 680+DynamicFieldMaxLength*3, size)
+	})
+
 	t.Run("SchemaHelper", func(t *testing.T) {
 		_, err := CreateSchemaHelper(nil)
 		assert.Error(t, err)
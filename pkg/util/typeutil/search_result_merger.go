@@ -0,0 +1,152 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"container/heap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+// SearchResultMerger merges the per-query sorted results of several SearchResultData
+// with a k-way heap. The heap holds at most one candidate per sub result, so picking
+// the next highest score costs O(log n) and no intermediate buffer grows with topk.
+type SearchResultMerger struct {
+	data []*schemapb.SearchResultData
+	// nqOffsets[i][qi] is the start offset of the qi-th query in data[i]
+	nqOffsets [][]int64
+	cursors   []int64
+	qi        int64
+	h         searchResultHeap
+	// candidates[i] is the heap slot of data[i], reused across the entries and the queries
+	candidates []searchResultCandidate
+}
+
+type searchResultCandidate struct {
+	subIdx int
+	idx    int64
+	score  float32
+	pk     interface{}
+}
+
+type searchResultHeap []*searchResultCandidate
+
+func (h searchResultHeap) Len() int { return len(h) }
+
+// Less puts the higher score first, and the smaller pk first if scores are the same.
+func (h searchResultHeap) Less(i, j int) bool {
+	if h[i].score != h[j].score {
+		return h[i].score > h[j].score
+	}
+	if ComparePK(h[i].pk, h[j].pk) {
+		return true
+	}
+	if ComparePK(h[j].pk, h[i].pk) {
+		return false
+	}
+	return h[i].subIdx < h[j].subIdx
+}
+
+func (h searchResultHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *searchResultHeap) Push(x interface{}) {
+	*h = append(*h, x.(*searchResultCandidate))
+}
+
+func (h *searchResultHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*h = old[0 : n-1]
+	return x
+}
+
+// NewSearchResultMerger creates a merger over the given sub search results, every sub result
+// must contain nq queries.
+func NewSearchResultMerger(data []*schemapb.SearchResultData, nq int64) *SearchResultMerger {
+	nqOffsets := make([][]int64, len(data))
+	for i, d := range data {
+		nqOffsets[i] = make([]int64, nq)
+		for j := int64(1); j < nq; j++ {
+			nqOffsets[i][j] = nqOffsets[i][j-1] + d.GetTopks()[j-1]
+		}
+	}
+	return &SearchResultMerger{
+		data:       data,
+		nqOffsets:  nqOffsets,
+		cursors:    make([]int64, len(data)),
+		h:          make(searchResultHeap, 0, len(data)),
+		candidates: make([]searchResultCandidate, len(data)),
+	}
+}
+
+// Reset prepares the merger to merge the results of the qi-th query.
+func (m *SearchResultMerger) Reset(qi int64) {
+	m.qi = qi
+	m.h = m.h[:0]
+	for i := range m.data {
+		m.cursors[i] = 0
+		m.pushCandidate(i)
+	}
+	heap.Init(&m.h)
+}
+
+func (m *SearchResultMerger) pushCandidate(subIdx int) {
+	if m.cursors[subIdx] >= m.data[subIdx].GetTopks()[m.qi] {
+		return
+	}
+	candidate := &m.candidates[subIdx]
+	candidate.subIdx = subIdx
+	m.loadCandidate(candidate)
+	m.h = append(m.h, candidate)
+}
+
+// loadCandidate fills the candidate with the entry at the cursor of its sub result.
+func (m *SearchResultMerger) loadCandidate(candidate *searchResultCandidate) {
+	candidate.idx = m.nqOffsets[candidate.subIdx][m.qi] + m.cursors[candidate.subIdx]
+	candidate.score = m.data[candidate.subIdx].GetScores()[candidate.idx]
+	candidate.pk = GetPK(m.data[candidate.subIdx].GetIds(), candidate.idx)
+}
+
+// Next returns the sub result index and the data index of the next highest score of current query,
+// and moves the cursor of that sub result forward. It returns (-1, -1) when all results are consumed.
+func (m *SearchResultMerger) Next() (int, int64) {
+	if m.h.Len() == 0 {
+		return -1, -1
+	}
+	top := m.h[0]
+	subIdx, idx := top.subIdx, top.idx
+	m.cursors[subIdx]++
+	if m.cursors[subIdx] < m.data[subIdx].GetTopks()[m.qi] {
+		// reuse the heap slot for the next entry of the same sub result
+		m.loadCandidate(top)
+		heap.Fix(&m.h, 0)
+	} else {
+		heap.Pop(&m.h)
+	}
+	return subIdx, idx
+}
+
+// CountSearchResultEntries returns the total number of entries carried by the sub search results.
+func CountSearchResultEntries(data []*schemapb.SearchResultData) int64 {
+	var total int64
+	for _, d := range data {
+		total += int64(len(d.GetScores()))
+	}
+	return total
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+func newMergerTestData(ids []int64, scores []float32, topks []int64) *schemapb.SearchResultData {
+	return &schemapb.SearchResultData{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{Data: ids},
+			},
+		},
+		Scores: scores,
+		Topks:  topks,
+	}
+}
+
+func TestSearchResultMerger(t *testing.T) {
+	data := []*schemapb.SearchResultData{
+		newMergerTestData([]int64{1, 2, 3, 11, 12}, []float32{0.9, 0.7, 0.5, 0.8, 0.6}, []int64{3, 2}),
+		newMergerTestData([]int64{4, 2, 13}, []float32{0.8, 0.7, 0.9}, []int64{2, 1}),
+		newMergerTestData([]int64{}, []float32{}, []int64{0, 0}),
+	}
+	assert.Equal(t, int64(8), CountSearchResultEntries(data))
+
+	merger := NewSearchResultMerger(data, 2)
+
+	collect := func() []int64 {
+		pks := make([]int64, 0)
+		for {
+			sel, idx := merger.Next()
+			if sel == -1 {
+				assert.Equal(t, int64(-1), idx)
+				return pks
+			}
+			pks = append(pks, GetPK(data[sel].GetIds(), idx).(int64))
+		}
+	}
+
+	merger.Reset(0)
+	// same score 0.7 is ordered by pk, duplicates are kept for the caller to skip
+	assert.Equal(t, []int64{1, 4, 2, 2, 3}, collect())
+
+	merger.Reset(1)
+	assert.Equal(t, []int64{13, 11, 12}, collect())
+
+	// reset to an earlier query restarts from the beginning
+	merger.Reset(0)
+	sel, idx := merger.Next()
+	assert.Equal(t, 0, sel)
+	assert.Equal(t, int64(0), idx)
+}